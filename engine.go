@@ -15,12 +15,14 @@
 package sqle
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	querypb "github.com/dolthub/vitess/go/vt/proto/query"
@@ -146,6 +148,8 @@ type Engine struct {
 	mu                *sync.Mutex
 	Version           sql.AnalyzerVersion
 	EventScheduler    *eventscheduler.EventScheduler
+
+	schemaChangeListeners []sql.SchemaChangeListener
 }
 
 type ColumnWithRawDefault struct {
@@ -201,7 +205,20 @@ func (e *Engine) AnalyzeQuery(
 	query string,
 ) (sql.Node, error) {
 	query = planbuilder.RemoveSpaceAndDelimiter(query, ';')
-	parsed, err := planbuilder.Parse(ctx, e.Analyzer.Catalog, query)
+	// the statement is parsed before it's bound, so that the warnings of the previous statement are cleared before
+	// binding adds its own
+	stmt, _, _, err := planbuilder.ParseOnly(ctx, query, false)
+	clearWarnings(ctx, stmt)
+	if err != nil {
+		if errors.Is(err, sqlparser.ErrEmpty) {
+			ctx.Warn(0, "query was empty after trimming comments, so it will be ignored")
+			return plan.NothingImpl, nil
+		}
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	binder := planbuilder.New(ctx, e.Analyzer.Catalog)
+	binder.SetParserOptions(sql.LoadSqlMode(ctx).ParserOptions())
+	parsed, err := binder.BindOnly(stmt, query)
 	if err != nil {
 		return nil, err
 	}
@@ -230,6 +247,7 @@ func (e *Engine) PrepareParsedQuery(
 	statementKey, query string,
 	stmt sqlparser.Statement,
 ) (sql.Node, error) {
+	clearWarnings(ctx, stmt)
 	binder := planbuilder.New(ctx, e.Analyzer.Catalog)
 	node, err := binder.BindOnly(stmt, query)
 
@@ -378,15 +396,43 @@ func (e *Engine) QueryWithBindings(ctx *sql.Context, query string, parsed sqlpar
 		return nil, nil, err
 	}
 
+	// the statement is parsed before it's bound so that the warnings of the previous statement can be cleared first,
+	// keeping any warnings from binding. Statements that fail to parse are left to the binder to report.
+	traceHook := ctx.TraceHook()
+	if parsed == nil {
+		parseStart := time.Now()
+		stmt, _, _, perr := planbuilder.ParseOnly(ctx, query, false)
+		traceHook.OnParse(ctx, query, time.Since(parseStart), perr)
+		if perr == nil {
+			parsed = stmt
+		}
+	}
+
+	analyzeStart := time.Now()
 	bound, err := e.bindQuery(ctx, query, parsed, bindings, err, binder)
 	if err != nil {
+		traceHook.OnAnalyze(ctx, query, nil, time.Since(analyzeStart), err)
 		return nil, nil, err
 	}
 
+	// SET_VAR hints override system variables for the execution of this statement only, and are restored when its
+	// iterator is closed, or immediately if it fails to build
+	restoreSetVars, setVarWarnings := applySetVarHints(ctx, binder.SetVarHints())
+	built := false
+	defer func() {
+		if !built {
+			restoreSetVars(ctx)
+		}
+	}()
+
 	analyzed, err := e.analyzeNode(ctx, query, bound)
+	traceHook.OnAnalyze(ctx, query, analyzed, time.Since(analyzeStart), err)
 	if err != nil {
 		return nil, nil, err
 	}
+	for _, warning := range setVarWarnings {
+		ctx.Session.Warn(warning)
+	}
 
 	if bindCtx := binder.BindCtx(); bindCtx != nil {
 		if unused := bindCtx.UnusedBindings(); len(unused) > 0 {
@@ -399,20 +445,149 @@ func (e *Engine) QueryWithBindings(ctx *sql.Context, query string, parsed sqlpar
 		return nil, nil, err
 	}
 
+	err = e.acquireMetadataLocks(ctx, analyzed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemaChangeListeners, schemaChanges, err := e.beforeSchemaChange(ctx, query, analyzed)
+	if err != nil {
+		err2 := clearAutocommitTransaction(ctx)
+		if err2 != nil {
+			return nil, nil, errors.Wrap(err, "unable to clear autocommit transaction: "+err2.Error())
+		}
+		e.releaseMetadataLocks(ctx)
+		return nil, nil, err
+	}
+
+	// A MAX_EXECUTION_TIME hint bounds the execution of this statement only
+	var cancel context.CancelFunc
+	if timeout := binder.MaxExecutionTime(); timeout > 0 {
+		var timeoutCtx context.Context
+		timeoutCtx, cancel = context.WithTimeout(ctx.Context, timeout)
+		ctx = ctx.WithContext(timeoutCtx)
+	}
+
+	// A RESOURCE_GROUP hint runs this statement only in the resource group named
+	restoreResourceGroup := e.applyResourceGroupHint(ctx, binder.ResourceGroupHint())
+
+	executeStart := time.Now()
 	iter, err := e.Analyzer.ExecBuilder.Build(ctx, analyzed, nil)
 	if err != nil {
+		traceHook.OnExecute(ctx, query, 0, time.Since(executeStart), err)
+		if cancel != nil {
+			cancel()
+		}
+		if restoreResourceGroup != nil {
+			restoreResourceGroup(ctx)
+		}
 		err2 := clearAutocommitTransaction(ctx)
 		if err2 != nil {
 			return nil, nil, errors.Wrap(err, "unable to clear autocommit transaction: "+err2.Error())
 		}
+		e.releaseMetadataLocks(ctx)
 
 		return nil, nil, err
 	}
 	iter = rowexec.AddExpressionCloser(analyzed, iter)
+	iter = rowexec.AddMetadataLockRelease(iter, e.Analyzer.Catalog.MetadataLocks)
+	if cancel != nil {
+		iter = rowexec.AddMaxExecutionTime(ctx, cancel, iter)
+	}
+	if len(binder.SetVarHints()) > 0 {
+		iter = rowexec.AddSetVarHintRestore(iter, restoreSetVars)
+	}
+	if restoreResourceGroup != nil {
+		iter = rowexec.AddResourceGroupHintRestore(iter, restoreResourceGroup)
+	}
+	if len(schemaChanges) > 0 {
+		iter = &schemaChangeIter{iter: iter, listeners: schemaChangeListeners, events: schemaChanges}
+	}
+	if traceHook != sql.NoopTraceHook {
+		iter = rowexec.AddTraceHook(iter, traceHook, query, executeStart)
+	}
+	built = true
 
 	return analyzed.Schema(), iter, nil
 }
 
+// applySetVarHints sets the session variables named by the SET_VAR optimizer hints given, and returns a function that
+// restores their previous values. Hints that cannot be applied, as well as all but the first hint for any variable,
+// are ignored, and are described by the warnings returned.
+func applySetVarHints(ctx *sql.Context, hints []planbuilder.SetVarHint) (func(*sql.Context) error, []*sql.Warning) {
+	var warnings []*sql.Warning
+	var applied []planbuilder.SetVarHint
+	seen := make(map[string]bool)
+	for _, hint := range hints {
+		if seen[hint.Name] {
+			warnings = append(warnings, &sql.Warning{
+				Level:   "Warning",
+				Code:    3126, // ER_WARN_CONFLICTING_HINT
+				Message: fmt.Sprintf("Hint SET_VAR(%s=%v) is ignored as conflicting/duplicated", hint.Name, hint.Value),
+			})
+			continue
+		}
+		seen[hint.Name] = true
+
+		prev, err := ctx.GetSessionVariable(ctx, hint.Name)
+		if err == nil {
+			err = ctx.SetSessionVariable(ctx, hint.Name, hint.Value)
+		}
+		if err != nil {
+			mysqlErr := sql.CastSQLError(err)
+			warnings = append(warnings, &sql.Warning{
+				Level:   "Warning",
+				Code:    mysqlErr.Number(),
+				Message: mysqlErr.Message,
+			})
+			continue
+		}
+		applied = append(applied, planbuilder.SetVarHint{Name: hint.Name, Value: prev})
+	}
+
+	return func(ctx *sql.Context) error {
+		var err error
+		for i := len(applied) - 1; i >= 0; i-- {
+			if setErr := ctx.SetSessionVariable(ctx, applied[i].Name, applied[i].Value); setErr != nil && err == nil {
+				err = setErr
+			}
+		}
+		applied = nil
+		return err
+	}, warnings
+}
+
+// applyResourceGroupHint assigns the thread of the session to the resource group named by a RESOURCE_GROUP optimizer
+// hint for the execution of the statement, and returns a function that moves it back to the group of the session, or
+// nil if there is no hint to apply. As in MySQL, a hint that can't be applied is ignored with a warning.
+func (e *Engine) applyResourceGroupHint(ctx *sql.Context, name string) func(*sql.Context) error {
+	registry := e.Analyzer.Catalog.ResourceGroups
+	if name == "" || registry == nil {
+		return nil
+	}
+	privDb := e.Analyzer.Catalog.MySQLDb
+	if !privDb.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(plan.DynamicPrivilege_ResourceGroupAdmin)) &&
+		!privDb.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(plan.DynamicPrivilege_ResourceGroupUser)) {
+		ctx.Session.Warn(&sql.Warning{
+			Level:   "Warning",
+			Code:    1227, // ER_SPECIFIC_ACCESS_DENIED_ERROR
+			Message: "Access denied; you need (at least one of) the RESOURCE_GROUP_ADMIN OR RESOURCE_GROUP_USER privilege(s) for this operation",
+		})
+		return nil
+	}
+	restore, err := registry.AssignStatement(ctx, name)
+	if err != nil {
+		mysqlErr := sql.CastSQLError(err)
+		ctx.Session.Warn(&sql.Warning{
+			Level:   "Warning",
+			Code:    mysqlErr.Number(),
+			Message: mysqlErr.Message,
+		})
+		return nil
+	}
+	return restore
+}
+
 // PrepQueryPlanForExecution prepares a query plan for execution and returns the result schema with a row iterator to
 // begin spooling results
 func (e *Engine) PrepQueryPlanForExecution(ctx *sql.Context, query string, plan sql.Node) (sql.Schema, sql.RowIter, error) {
@@ -433,16 +608,23 @@ func (e *Engine) PrepQueryPlanForExecution(ctx *sql.Context, query string, plan
 		return nil, nil, err
 	}
 
+	err = e.acquireMetadataLocks(ctx, plan)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	iter, err := e.Analyzer.ExecBuilder.Build(ctx, plan, nil)
 	if err != nil {
 		err2 := clearAutocommitTransaction(ctx)
 		if err2 != nil {
 			return nil, nil, errors.Wrap(err, "unable to clear autocommit transaction: "+err2.Error())
 		}
+		e.releaseMetadataLocks(ctx)
 
 		return nil, nil, err
 	}
 	iter = rowexec.AddExpressionCloser(plan, iter)
+	iter = rowexec.AddMetadataLockRelease(iter, e.Analyzer.Catalog.MetadataLocks)
 
 	return plan.Schema(), iter, nil
 }
@@ -562,14 +744,49 @@ func (e *Engine) analyzeNode(ctx *sql.Context, query string, bound sql.Node) (sq
 		e.PreparedDataCache.UncacheStmt(ctx.Session.ID(), n.Name)
 		return bound, nil
 	default:
+		if t := newOptimizerTracer(ctx, query, bound); t != nil {
+			analyzed, err := e.Analyzer.Analyze(ctx.WithOptimizerTracer(t), bound, nil)
+			if err != nil {
+				return nil, err
+			}
+			return analyzed, sql.FinishOptimizerTrace(ctx, t)
+		}
 		return e.Analyzer.Analyze(ctx, bound, nil)
 	}
 }
 
+// newOptimizerTracer returns a tracer for the analysis of |bound| if the session has enabled optimizer tracing, or nil
+// otherwise. Statements that set variables or read the optimizer trace are not traced, so that they don't displace
+// the trace being inspected.
+func newOptimizerTracer(ctx *sql.Context, query string, bound sql.Node) *sql.OptimizerTracer {
+	t := sql.NewOptimizerTracerForSession(ctx, query)
+	if t == nil {
+		return nil
+	}
+	if _, ok := bound.(*plan.Set); ok {
+		return nil
+	}
+	readsTrace := false
+	transform.Inspect(bound, func(n sql.Node) bool {
+		if rt, ok := n.(*plan.ResolvedTable); ok && rt.Database() != nil &&
+			strings.EqualFold(rt.Database().Name(), sql.InformationSchemaDatabaseName) &&
+			strings.EqualFold(rt.Name(), "optimizer_trace") {
+			readsTrace = true
+		}
+		return !readsTrace
+	})
+	if readsTrace {
+		return nil
+	}
+	return t
+}
+
 // bindQuery binds any bind variables to the plan node or query given and returns it.
 // |parsed| is the parsed AST without bindings applied, if the statement was previously parsed / prepared.
 // If it wasn't (|parsed| is nil), then the query is parsed.
 func (e *Engine) bindQuery(ctx *sql.Context, query string, parsed sqlparser.Statement, bindings map[string]*querypb.BindVariable, err error, binder *planbuilder.Builder) (sql.Node, error) {
+	clearWarnings(ctx, parsed)
+
 	var bound sql.Node
 	if parsed == nil {
 		bound, err = binder.ParseOne(query)
@@ -596,6 +813,47 @@ func (e *Engine) bindQuery(ctx *sql.Context, query string, parsed sqlparser.Stat
 	return bound, nil
 }
 
+// clearWarnings clears the warnings of the previous statement before |stmt| is bound, unless |stmt| reads them. Every
+// statement that the engine binds passes through here, so that bind and analysis warnings belong to their statement.
+func clearWarnings(ctx *sql.Context, stmt sqlparser.Statement) {
+	if !readsDiagnostics(stmt) {
+		ctx.ClearWarnings()
+	}
+}
+
+// readsDiagnostics returns whether |stmt| reads the warnings of the previous statement, which are kept for it. These are
+// SHOW WARNINGS, SHOW ERRORS, and selects of the warning_count and error_count system variables without a FROM clause.
+func readsDiagnostics(stmt sqlparser.Statement) bool {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Show:
+		switch strings.ToLower(stmt.Type) {
+		case "warnings", "errors":
+			return true
+		}
+	case *sqlparser.Select:
+		if len(stmt.From) > 0 || stmt.With != nil {
+			return false
+		}
+		for _, expr := range stmt.SelectExprs {
+			aliased, ok := expr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return false
+			}
+			col, ok := aliased.Expr.(*sqlparser.ColName)
+			if !ok || !col.Qualifier.IsEmpty() {
+				return false
+			}
+			switch strings.ToLower(col.Name.String()) {
+			case "@@warning_count", "@@session.warning_count", "@@error_count", "@@session.error_count":
+			default:
+				return false
+			}
+		}
+		return len(stmt.SelectExprs) > 0
+	}
+	return false
+}
+
 // bindExecuteQueryNode returns the
 func (e *Engine) bindExecuteQueryNode(ctx *sql.Context, query string, eq *plan.ExecuteQuery, bindings map[string]*querypb.BindVariable, binder *planbuilder.Builder) (sql.Node, error) {
 	prep, ok := e.PreparedDataCache.GetCachedStmt(ctx.Session.ID(), eq.Name)
@@ -670,11 +928,70 @@ func clearAutocommitTransaction(ctx *sql.Context) error {
 	return nil
 }
 
-// CloseSession deletes session specific prepared statement data
+// acquireMetadataLocks takes the metadata locks that |node| needs for the session of |ctx|, waiting up to
+// @@lock_wait_timeout seconds for the locks of other sessions to be released. If they cannot be acquired, the
+// autocommit transaction begun for the statement is cleared.
+func (e *Engine) acquireMetadataLocks(ctx *sql.Context, node sql.Node) error {
+	ddlMode := plan.DDLTransactionMode_None
+	if qp, ok := node.(*plan.QueryProcess); ok {
+		node = qp.Child()
+	}
+	if tc, ok := node.(*plan.TransactionCommittingNode); ok {
+		node = tc.Child()
+		ddlMode = tc.DDLMode
+	}
+	if _, ok := node.(*plan.StartTransaction); ok {
+		// START TRANSACTION commits the current transaction, which ends its locks
+		e.Analyzer.Catalog.MetadataLocks.ReleaseAll(ctx.Session)
+	}
+
+	requests := plan.MetadataLockRequests(node)
+	if len(requests) == 0 {
+		return nil
+	}
+	if ddlMode == plan.DDLTransactionMode_Transactional {
+		// schema changes made within a transaction are isolated from other sessions until it commits, so they don't
+		// need to wait for the transactions of other sessions to end
+		for i := range requests {
+			requests[i].Type = sql.MetadataLockShared
+		}
+	}
+	val, err := ctx.GetSessionVariable(ctx, "lock_wait_timeout")
+	if err != nil {
+		return err
+	}
+	timeout, _, err := types.Int64.Convert(val)
+	if err != nil {
+		return err
+	}
+
+	err = e.Analyzer.Catalog.MetadataLocks.Acquire(ctx, requests, time.Duration(timeout.(int64))*time.Second)
+	if err != nil {
+		if err2 := clearAutocommitTransaction(ctx); err2 != nil {
+			return errors.Wrap(err, "unable to clear autocommit transaction: "+err2.Error())
+		}
+		e.releaseMetadataLocks(ctx)
+	}
+	return err
+}
+
+// releaseMetadataLocks releases the metadata locks of the session of |ctx|, unless it is still in a transaction.
+func (e *Engine) releaseMetadataLocks(ctx *sql.Context) {
+	if ctx.GetTransaction() == nil {
+		e.Analyzer.Catalog.MetadataLocks.ReleaseAll(ctx.Session)
+	}
+}
+
+// CloseSession deletes session specific prepared statement data, and releases the metadata locks and resource group
+// assignment of the session
 func (e *Engine) CloseSession(connID uint32) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	e.PreparedDataCache.DeleteSessionData(connID)
+	e.Analyzer.Catalog.MetadataLocks.ReleaseConnection(connID)
+	if e.Analyzer.Catalog.ResourceGroups != nil {
+		e.Analyzer.Catalog.ResourceGroups.ReleaseConnection(connID)
+	}
 }
 
 // Count number of BindVars in given tree
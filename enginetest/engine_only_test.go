@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"regexp"
 	"runtime"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -40,6 +42,7 @@ import (
 	"github.com/dolthub/go-mysql-server/server"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -733,7 +736,70 @@ func TestTriggerViewWarning(t *testing.T) {
 
 	enginetest.TestQueryWithContext(t, ctx, e, harness, "insert into mytable values (4, 'fourth row')", []sql.Row{{types.NewOkResult(1)}}, nil, nil)
 	enginetest.TestQueryWithContext(t, ctx, e, harness, "SHOW WARNINGS", []sql.Row{{"Warning", 0, "trigger on view is not supported; 'DROP TRIGGER  view_trig' to fix"}}, nil, nil)
-	enginetest.AssertErrWithCtx(t, e, harness, ctx, "insert into myview values (5, 'fifth row')", nil, "expected insert destination to be resolved or unresolved table")
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "insert into myview values (5, 'fifth row')", []sql.Row{{types.NewOkResult(1)}}, nil, nil)
+}
+
+func TestOverloadedFunctions(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	literal := func(s string) sql.CreateFuncNArgs {
+		return func(args ...sql.Expression) (sql.Expression, error) {
+			return expression.NewLiteral(fmt.Sprintf("%s(%d)", s, len(args)), types.LongText), nil
+		}
+	}
+	ctx := harness.NewContext()
+	e.EngineAnalyzer().Catalog.RegisterFunction(ctx,
+		sql.NewOverloadedFunction("describe_args",
+			sql.FunctionOverload{ArgTypes: []sql.Type{types.Int64}, Fn: literal("integer")},
+			sql.FunctionOverload{ArgTypes: []sql.Type{types.LongText}, Fn: literal("string")},
+			sql.FunctionOverload{ArgTypes: []sql.Type{types.Int64, types.Int64}, Fn: literal("integers")},
+		),
+		sql.NewOverloadedFunction("ambiguous",
+			sql.FunctionOverload{ArgTypes: []sql.Type{types.Int64, nil}, Fn: literal("first")},
+			sql.FunctionOverload{ArgTypes: []sql.Type{nil, types.Int64}, Fn: literal("second")},
+		),
+	)
+	// overloads may be added to a registered function
+	e.EngineAnalyzer().Catalog.RegisterFunction(ctx,
+		sql.NewOverloadedFunction("describe_args",
+			sql.FunctionOverload{ArgTypes: []sql.Type{nil}, Variadic: true, Fn: literal("variadic")},
+		),
+	)
+
+	enginetest.TestScriptWithEngine(t, e, harness, queries.ScriptTest{
+		Name: "overloaded functions",
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "select describe_args(1), describe_args(cast(1 as unsigned)), describe_args('a')",
+				Expected: []sql.Row{{"integer(1)", "integer(1)", "string(1)"}},
+			},
+			{
+				// NULL matches the integer and string overloads equally well
+				Query:       "select describe_args(null)",
+				ExpectedErr: sql.ErrAmbiguousFunctionCall,
+			},
+			{
+				Query:    "select describe_args(1, 2), describe_args(1, 'a'), describe_args(now()), describe_args(), describe_args(1, 2, 3)",
+				Expected: []sql.Row{{"integers(2)", "variadic(2)", "variadic(1)", "variadic(0)", "variadic(3)"}},
+			},
+			{
+				Query:    "select ambiguous(1, 'a'), ambiguous('a', 1)",
+				Expected: []sql.Row{{"first(2)", "second(2)"}},
+			},
+			{
+				Query:       "select ambiguous(1, 1)",
+				ExpectedErr: sql.ErrAmbiguousFunctionCall,
+			},
+			{
+				Query:       "select ambiguous('a', 'b')",
+				ExpectedErr: sql.ErrNoMatchingFunctionOverload,
+			},
+		},
+	})
 }
 
 func TestCollationCoercion(t *testing.T) {
@@ -1046,6 +1112,94 @@ func TestAlterTableWithBadSchema(t *testing.T) {
 	}
 }
 
+// TestConvertTimezoneColumns tests that the values of DATETIME columns declared as stored in UTC are converted to and
+// from the time zone of each session.
+func TestConvertTimezoneColumns(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	pro := harness.Provider()
+	harness.NewDatabases("mydb")
+	ctx := harness.NewContext()
+	sqlDb, err := pro.Database(ctx, "mydb")
+	require.NoError(t, err)
+	db := sqlDb.(*memory.HistoryDatabase)
+
+	sch := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, PrimaryKey: true, Source: "mytable"},
+		{Name: "dt", Type: types.Datetime, Nullable: true, Source: "mytable", ConvertTimezone: true},
+		{Name: "local", Type: types.Datetime, Nullable: true, Source: "mytable"},
+	})
+	harness.NewTableAsOf(db, "mytable", sch, nil)
+
+	engine, err := harness.NewEngine(t)
+	require.NoError(t, err)
+
+	newSession := func(timeZone string) *sql.Context {
+		ctx := harness.NewSession()
+		ctx.SetCurrentDatabase("mydb")
+		enginetest.RunQueryWithContext(t, engine, harness, ctx, fmt.Sprintf("set time_zone = '%s'", timeZone))
+		return ctx
+	}
+	plusTwo := newSession("+02:00")
+	utc := newSession("+00:00")
+	minusFive := newSession("-05:00")
+
+	enginetest.RunQueryWithContext(t, engine, harness, plusTwo, "create index idx_dt on mytable (dt)")
+	enginetest.RunQueryWithContext(t, engine, harness, plusTwo, "insert into mytable values (1, '2024-01-01 12:00:00', '2024-01-01 12:00:00'), (2, '2024-01-01 01:30:00', '2024-01-01 01:30:00'), (3, null, null)")
+
+	dt := func(s string) time.Time {
+		tm, err := time.Parse(sql.TimestampDatetimeLayout, s)
+		require.NoError(t, err)
+		return tm
+	}
+	query := "select pk, dt, local from mytable order by pk"
+	enginetest.TestQueryWithContext(t, plusTwo, engine, harness, query, []sql.Row{
+		{1, dt("2024-01-01 12:00:00"), dt("2024-01-01 12:00:00")},
+		{2, dt("2024-01-01 01:30:00"), dt("2024-01-01 01:30:00")},
+		{3, nil, nil},
+	}, nil, nil)
+	enginetest.TestQueryWithContext(t, utc, engine, harness, query, []sql.Row{
+		{1, dt("2024-01-01 10:00:00"), dt("2024-01-01 12:00:00")},
+		{2, dt("2023-12-31 23:30:00"), dt("2024-01-01 01:30:00")},
+		{3, nil, nil},
+	}, nil, nil)
+	enginetest.TestQueryWithContext(t, minusFive, engine, harness, query, []sql.Row{
+		{1, dt("2024-01-01 05:00:00"), dt("2024-01-01 12:00:00")},
+		{2, dt("2023-12-31 18:30:00"), dt("2024-01-01 01:30:00")},
+		{3, nil, nil},
+	}, nil, nil)
+
+	// values are stored in UTC
+	table, ok, err := db.GetTableInsensitive(ctx, "mytable")
+	require.NoError(t, err)
+	require.True(t, ok)
+	partitions, err := table.Partitions(ctx)
+	require.NoError(t, err)
+	stored, err := sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, table, partitions))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []sql.Row{
+		{int64(1), dt("2024-01-01 10:00:00"), dt("2024-01-01 12:00:00")},
+		{int64(2), dt("2023-12-31 23:30:00"), dt("2024-01-01 01:30:00")},
+		{int64(3), nil, nil},
+	}, stored)
+
+	// filters and index lookups compare against values in the session time zone
+	enginetest.TestQueryWithContext(t, minusFive, engine, harness, "select pk from mytable where dt = '2024-01-01 05:00:00'", []sql.Row{{1}}, nil, nil)
+	enginetest.TestQueryWithContext(t, minusFive, engine, harness, "select pk from mytable where dt < '2024-01-01 00:00:00'", []sql.Row{{2}}, nil, nil)
+	enginetest.TestQueryWithContext(t, utc, engine, harness, "select pk from mytable where dt between '2024-01-01 09:00:00' and '2024-01-01 11:00:00'", []sql.Row{{1}}, nil, nil)
+
+	// updates, deletes and duplicate key updates write values from the session time zone
+	enginetest.RunQueryWithContext(t, engine, harness, minusFive, "update mytable set dt = '2024-06-01 00:00:00' where dt = '2023-12-31 18:30:00'")
+	enginetest.RunQueryWithContext(t, engine, harness, utc, "insert into mytable values (1, '2024-01-01 00:00:00', null) on duplicate key update dt = date_add(dt, interval 1 hour)")
+	enginetest.RunQueryWithContext(t, engine, harness, plusTwo, "replace into mytable values (3, '2024-03-01 00:00:00', null)")
+	enginetest.TestQueryWithContext(t, utc, engine, harness, "select pk, dt from mytable order by pk", []sql.Row{
+		{1, dt("2024-01-01 11:00:00")},
+		{2, dt("2024-06-01 05:00:00")},
+		{3, dt("2024-02-29 22:00:00")},
+	}, nil, nil)
+	enginetest.RunQueryWithContext(t, engine, harness, plusTwo, "delete from mytable where dt = '2024-01-01 13:00:00'")
+	enginetest.TestQueryWithContext(t, plusTwo, engine, harness, "select pk from mytable order by pk", []sql.Row{{2}, {3}}, nil, nil)
+}
+
 func newDatabase() (*sql2.DB, func()) {
 	// Grab an empty port so that tests do not fail if a specific port is already in use
 	listener, err := net.Listen("tcp", ":0")
@@ -1080,3 +1234,951 @@ func newDatabase() (*sql2.DB, func()) {
 	}
 	return db, func() { srv.Close() }
 }
+
+type testBinlogPrimaryController struct {
+	replicas []binlogreplication.ConnectedReplica
+	logs     []binlogreplication.BinaryLogFile
+	events   []binlogreplication.BinlogEvent
+}
+
+var _ binlogreplication.BinlogPrimaryController = (*testBinlogPrimaryController)(nil)
+
+func (c *testBinlogPrimaryController) GetBinlogReplicaStatuses(_ *sql.Context) ([]binlogreplication.ConnectedReplica, error) {
+	return c.replicas, nil
+}
+
+func (c *testBinlogPrimaryController) ListBinaryLogs(_ *sql.Context) ([]binlogreplication.BinaryLogFile, error) {
+	return c.logs, nil
+}
+
+func (c *testBinlogPrimaryController) ListBinlogEvents(_ *sql.Context, logName string, startPos uint64, limit int64) ([]binlogreplication.BinlogEvent, error) {
+	if logName == "" && len(c.logs) > 0 {
+		logName = c.logs[0].Name
+	}
+	var events []binlogreplication.BinlogEvent
+	for _, event := range c.events {
+		if limit >= 0 && int64(len(events)) == limit {
+			break
+		}
+		if event.LogName == logName && event.Pos >= startPos {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func TestBinlogPrimaryStatements(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.EngineAnalyzer().Catalog.BinlogPrimaryController = &testBinlogPrimaryController{
+		replicas: []binlogreplication.ConnectedReplica{
+			{ServerId: 2, Host: "replica1", Port: 3306, SourceId: 1, ReplicaUuid: "3e11fa47-71ca-11e1-9e33-c80aa9429562"},
+		},
+		logs: []binlogreplication.BinaryLogFile{
+			{Name: "binlog.000001", Size: 200},
+			{Name: "binlog.000002", Size: 157, Encrypted: true},
+		},
+		events: []binlogreplication.BinlogEvent{
+			{LogName: "binlog.000001", Pos: 4, EventType: "Format_desc", ServerId: 1, EndLogPos: 126, Info: "Server ver: 8.0.33, Binlog ver: 4"},
+			{LogName: "binlog.000001", Pos: 126, EventType: "Previous_gtids", ServerId: 1, EndLogPos: 157},
+			{LogName: "binlog.000001", Pos: 157, EventType: "Rotate", ServerId: 1, EndLogPos: 200, Info: "binlog.000002;pos=4"},
+			{LogName: "binlog.000002", Pos: 4, EventType: "Format_desc", ServerId: 1, EndLogPos: 126, Info: "Server ver: 8.0.33, Binlog ver: 4"},
+			{LogName: "binlog.000002", Pos: 126, EventType: "Previous_gtids", ServerId: 1, EndLogPos: 157},
+		},
+	}
+
+	enginetest.TestScriptWithEngine(t, e, harness, queries.ScriptTest{
+		Name: "binlog primary statements",
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "show replicas",
+				Expected: []sql.Row{{uint32(2), "replica1", uint16(3306), uint32(1), "3e11fa47-71ca-11e1-9e33-c80aa9429562"}},
+			},
+			{
+				Query:    "SHOW SLAVE HOSTS;",
+				Expected: []sql.Row{{uint32(2), "replica1", uint16(3306), uint32(1), "3e11fa47-71ca-11e1-9e33-c80aa9429562"}},
+			},
+			{
+				Query:    "show binary logs",
+				Expected: []sql.Row{{"binlog.000001", uint64(200), "No"}, {"binlog.000002", uint64(157), "Yes"}},
+			},
+			{
+				Query:    "show master logs",
+				Expected: []sql.Row{{"binlog.000001", uint64(200), "No"}, {"binlog.000002", uint64(157), "Yes"}},
+			},
+			{
+				Query: "show binlog events",
+				Expected: []sql.Row{
+					{"binlog.000001", uint64(4), "Format_desc", uint32(1), uint64(126), "Server ver: 8.0.33, Binlog ver: 4"},
+					{"binlog.000001", uint64(126), "Previous_gtids", uint32(1), uint64(157), ""},
+					{"binlog.000001", uint64(157), "Rotate", uint32(1), uint64(200), "binlog.000002;pos=4"},
+				},
+			},
+			{
+				Query: "show binlog events in 'binlog.000002'",
+				Expected: []sql.Row{
+					{"binlog.000002", uint64(4), "Format_desc", uint32(1), uint64(126), "Server ver: 8.0.33, Binlog ver: 4"},
+					{"binlog.000002", uint64(126), "Previous_gtids", uint32(1), uint64(157), ""},
+				},
+			},
+			{
+				Query: "show binlog events from 126",
+				Expected: []sql.Row{
+					{"binlog.000001", uint64(126), "Previous_gtids", uint32(1), uint64(157), ""},
+					{"binlog.000001", uint64(157), "Rotate", uint32(1), uint64(200), "binlog.000002;pos=4"},
+				},
+			},
+			{
+				Query: "show binlog events in 'binlog.000001' from 4 limit 1, 1",
+				Expected: []sql.Row{
+					{"binlog.000001", uint64(126), "Previous_gtids", uint32(1), uint64(157), ""},
+				},
+			},
+			{
+				Query: "show binlog events limit 1",
+				Expected: []sql.Row{
+					{"binlog.000001", uint64(4), "Format_desc", uint32(1), uint64(126), "Server ver: 8.0.33, Binlog ver: 4"},
+				},
+			},
+		},
+	})
+}
+
+type testBinlogReplicaController struct {
+	untilOptions  []binlogreplication.ReplicationOption
+	sourceOptions []binlogreplication.ReplicationOption
+	status        *binlogreplication.ReplicaStatus
+}
+
+var _ binlogreplication.BinlogReplicaController = (*testBinlogReplicaController)(nil)
+
+func (c *testBinlogReplicaController) StartReplica(_ *sql.Context, untilOptions []binlogreplication.ReplicationOption) error {
+	c.untilOptions = untilOptions
+	return nil
+}
+
+func (c *testBinlogReplicaController) StopReplica(_ *sql.Context) error {
+	return nil
+}
+
+func (c *testBinlogReplicaController) SetReplicationSourceOptions(_ *sql.Context, options []binlogreplication.ReplicationOption) error {
+	c.sourceOptions = options
+	return nil
+}
+
+func (c *testBinlogReplicaController) SetReplicationFilterOptions(_ *sql.Context, _ []binlogreplication.ReplicationOption) error {
+	return nil
+}
+
+func (c *testBinlogReplicaController) GetReplicaStatus(_ *sql.Context) (*binlogreplication.ReplicaStatus, error) {
+	return c.status, nil
+}
+
+func (c *testBinlogReplicaController) ResetReplica(_ *sql.Context, _ bool) error {
+	return nil
+}
+
+func TestStartReplicaUntil(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	controller := &testBinlogReplicaController{}
+	e.EngineAnalyzer().Catalog.BinlogReplicaController = controller
+
+	ctx := enginetest.NewContext(harness)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "start replica until source_log_file='binlog.000002', source_log_pos=1234", []sql.Row{}, nil, nil)
+	require.Equal(t, []binlogreplication.ReplicationOption{
+		*binlogreplication.NewReplicationOption("SOURCE_LOG_FILE", binlogreplication.StringReplicationOptionValue{Value: "binlog.000002"}),
+		*binlogreplication.NewReplicationOption("SOURCE_LOG_POS", binlogreplication.IntegerReplicationOptionValue{Value: 1234}),
+	}, controller.untilOptions)
+
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "start replica", []sql.Row{}, nil, nil)
+	require.Empty(t, controller.untilOptions)
+}
+
+func TestChangeReplicationSourceOptions(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	controller := &testBinlogReplicaController{}
+	e.EngineAnalyzer().Catalog.BinlogReplicaController = controller
+
+	ctx := enginetest.NewContext(harness)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "change replication source to source_host='localhost', source_port=3307, source_connect_retry=5, source_heartbeat_period=2.5", []sql.Row{}, nil, nil)
+	require.Equal(t, []binlogreplication.ReplicationOption{
+		*binlogreplication.NewReplicationOption("SOURCE_HOST", binlogreplication.StringReplicationOptionValue{Value: "localhost"}),
+		*binlogreplication.NewReplicationOption("SOURCE_PORT", binlogreplication.IntegerReplicationOptionValue{Value: 3307}),
+		*binlogreplication.NewReplicationOption("SOURCE_CONNECT_RETRY", binlogreplication.IntegerReplicationOptionValue{Value: 5}),
+		*binlogreplication.NewReplicationOption("SOURCE_HEARTBEAT_PERIOD", binlogreplication.DecimalReplicationOptionValue{Value: 2.5}),
+	}, controller.sourceOptions)
+
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "change replication source to source_heartbeat_period=30", []sql.Row{}, nil, nil)
+	require.Equal(t, []binlogreplication.ReplicationOption{
+		*binlogreplication.NewReplicationOption("SOURCE_HEARTBEAT_PERIOD", binlogreplication.DecimalReplicationOptionValue{Value: 30}),
+	}, controller.sourceOptions)
+
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "change replication source to source_auto_position=1, source_ssl=0, source_user='root', source_heartbeat_period=1", []sql.Row{}, nil, nil)
+	require.Equal(t, []binlogreplication.ReplicationOption{
+		*binlogreplication.NewReplicationOption("SOURCE_AUTO_POSITION", binlogreplication.BooleanReplicationOptionValue{Value: true}),
+		*binlogreplication.NewReplicationOption("SOURCE_SSL", binlogreplication.BooleanReplicationOptionValue{Value: false}),
+		*binlogreplication.NewReplicationOption("SOURCE_USER", binlogreplication.StringReplicationOptionValue{Value: "root"}),
+		*binlogreplication.NewReplicationOption("SOURCE_HEARTBEAT_PERIOD", binlogreplication.DecimalReplicationOptionValue{Value: 1}),
+	}, controller.sourceOptions)
+	var types []binlogreplication.ReplicationOptionType
+	for _, option := range controller.sourceOptions {
+		types = append(types, option.Typed())
+	}
+	require.Equal(t, []binlogreplication.ReplicationOptionType{
+		binlogreplication.ReplicationOptionTypeBoolean,
+		binlogreplication.ReplicationOptionTypeBoolean,
+		binlogreplication.ReplicationOptionTypeString,
+		binlogreplication.ReplicationOptionTypeDecimal,
+	}, types)
+
+	controller.sourceOptions = nil
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "change replication source to source_port='abc'", sql.ErrInvalidReplicationOptionValue)
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "change replication source to source_connect_retry=1.5", sql.ErrInvalidReplicationOptionValue)
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "change replication source to source_auto_position=2", sql.ErrInvalidReplicationOptionValue)
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "change replication source to source_host='localhost', source_heartbeat_period=4294968", sql.ErrReplicaHeartbeatOutOfRange)
+	require.Nil(t, controller.sourceOptions)
+}
+
+func TestShowReplicaStatus(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	ctx := enginetest.NewContext(harness)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "show replica status", []sql.Row{}, nil, nil)
+
+	controller := &testBinlogReplicaController{}
+	e.EngineAnalyzer().Catalog.BinlogReplicaController = controller
+	// a replica that was never configured has no status
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "show replica status", []sql.Row{}, nil, nil)
+
+	controller.status = &binlogreplication.ReplicaStatus{
+		SourceHost:        "localhost",
+		SourcePort:        3307,
+		ReplicaIoRunning:  binlogreplication.ReplicaIoRunning,
+		ReplicaSqlRunning: binlogreplication.ReplicaSqlNotRunning,
+		LastSqlErrNumber:  1062,
+		LastSqlError:      "duplicate key",
+	}
+	sch, iter, err := e.Query(ctx, "show replica status")
+	require.NoError(t, err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(t, err)
+	require.Len(t, sch, 56)
+	require.Len(t, rows, 1)
+	require.Len(t, rows[0], len(sch))
+
+	status := make(map[string]interface{})
+	for i, col := range sch {
+		status[col.Name] = rows[0][i]
+	}
+	require.Equal(t, "localhost", status["Source_Host"])
+	require.EqualValues(t, 3307, status["Source_Port"])
+	require.Equal(t, "Yes", status["Replica_IO_Running"])
+	require.Equal(t, "No", status["Replica_SQL_Running"])
+	require.EqualValues(t, 1062, status["Last_Errno"])
+	require.Equal(t, "duplicate key", status["Last_Error"])
+	require.EqualValues(t, 1062, status["Last_SQL_Errno"])
+	require.Equal(t, "duplicate key", status["Last_SQL_Error"])
+	require.Equal(t, "", status["Channel_Name"])
+}
+
+func TestPerformanceSchemaReplicationStatus(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	ctx := enginetest.NewContext(harness)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "select * from performance_schema.replication_connection_status", []sql.Row{}, nil, nil)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "select * from performance_schema.replication_applier_status", []sql.Row{}, nil, nil)
+
+	controller := &testBinlogReplicaController{}
+	e.EngineAnalyzer().Catalog.BinlogReplicaController = controller
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "select * from performance_schema.replication_applier_status_by_worker", []sql.Row{}, nil, nil)
+
+	errTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	controller.status = &binlogreplication.ReplicaStatus{
+		SourceServerUuid:      "3e11fa47-71ca-11e1-9e33-c80aa9429562",
+		ReplicaIoRunning:      binlogreplication.ReplicaIoConnecting,
+		ReplicaSqlRunning:     binlogreplication.ReplicaSqlRunning,
+		LastIoErrNumber:       2003,
+		LastIoError:           "error connecting to source",
+		LastIoErrorTimestamp:  &errTime,
+		RetrievedGtidSet:      "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5",
+		LastSqlErrNumber:      0,
+		LastSqlError:          "",
+		LastSqlErrorTimestamp: nil,
+	}
+	enginetest.TestQueryWithContext(t, ctx, e, harness,
+		"select source_uuid, service_state, received_transaction_set, last_error_number, last_error_message, last_error_timestamp from performance_schema.replication_connection_status",
+		[]sql.Row{{"3e11fa47-71ca-11e1-9e33-c80aa9429562", "CONNECTING", "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5", int32(2003), "error connecting to source", errTime}}, nil, nil)
+	enginetest.TestQueryWithContext(t, ctx, e, harness,
+		"select channel_name, service_state from performance_schema.replication_applier_status",
+		[]sql.Row{{"", "ON"}}, nil, nil)
+	enginetest.TestQueryWithContext(t, ctx, e, harness,
+		"select worker_id, service_state, last_error_number, last_error_message, last_error_timestamp from performance_schema.replication_applier_status_by_worker",
+		[]sql.Row{{uint64(0), "ON", int32(0), "", nil}}, nil, nil)
+}
+
+// nonTransactionalDDLProvider hides the transactional DDL support of the memory provider, so that DDL statements
+// implicitly commit and have to wait for the transactions of other sessions, as in MySQL.
+type nonTransactionalDDLProvider struct {
+	sql.MutableDatabaseProvider
+}
+
+func TestMetadataLocks(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.NewDatabases("mydb")
+	e := sqle.New(analyzer.NewDefault(nonTransactionalDDLProvider{harness.Provider()}), new(sqle.Config))
+	defer e.Close()
+
+	clientA := enginetest.NewSession(harness)
+	clientB := enginetest.NewSession(harness)
+	pl := sqle.NewProcessList()
+	pl.AddConnection(clientB.ID(), "localhost")
+	pl.ConnectionReady(clientB.Session)
+	clientB.ApplyOpts(sql.WithProcessList(pl))
+
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "create table t (pk int primary key)")
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "insert into t values (1)")
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "start transaction")
+	enginetest.TestQueryWithContext(t, clientA, e, harness, "select * from t", []sql.Row{{1}}, nil, nil)
+
+	// the open transaction of client a keeps client b from altering the table
+	enginetest.RunQueryWithContext(t, e, harness, clientB, "set lock_wait_timeout = 1")
+	enginetest.AssertErrWithCtx(t, e, harness, clientB, "alter table t add column c int", sql.ErrLockWaitTimeout)
+
+	enginetest.RunQueryWithContext(t, e, harness, clientB, "set lock_wait_timeout = 60")
+	done := make(chan error)
+	go func() {
+		ctx, err := pl.BeginQuery(clientB, "alter table t add column c int")
+		if err != nil {
+			done <- err
+			return
+		}
+		defer pl.EndQuery(ctx)
+		_, iter, err := e.Query(ctx, "alter table t add column c int")
+		if err == nil {
+			_, err = sql.RowIterToRows(ctx, iter)
+		}
+		done <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		procs := pl.Processes()
+		return len(procs) == 1 && procs[0].State == sql.MetadataLockWaitState
+	}, 10*time.Second, 10*time.Millisecond)
+	enginetest.TestQueryWithContext(t, clientA, e, harness,
+		"select object_type, object_schema, object_name, lock_type, lock_duration, lock_status from performance_schema.metadata_locks",
+		[]sql.Row{
+			{"TABLE", "mydb", "t", "SHARED", "TRANSACTION", "GRANTED"},
+			{"TABLE", "mydb", "t", "EXCLUSIVE", "TRANSACTION", "PENDING"},
+		}, nil, nil)
+
+	// the alter proceeds once client a commits
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "commit")
+	require.NoError(t, <-done)
+	enginetest.TestQueryWithContext(t, clientA, e, harness, "select * from t", []sql.Row{{1, nil}}, nil, nil)
+	enginetest.TestQueryWithContext(t, clientA, e, harness, "select * from performance_schema.metadata_locks", []sql.Row{}, nil, nil)
+
+	// dropping a trigger changes the definition of its table, as does creating or dropping a view on the view
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "create trigger t_bi before insert on t for each row set new.c = 0")
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "create view v as select pk from t")
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "start transaction")
+	enginetest.TestQueryWithContext(t, clientA, e, harness, "select * from v", []sql.Row{{1}}, nil, nil)
+	enginetest.RunQueryWithContext(t, e, harness, clientB, "set lock_wait_timeout = 1")
+	enginetest.AssertErrWithCtx(t, e, harness, clientB, "drop trigger t_bi", sql.ErrLockWaitTimeout)
+	enginetest.AssertErrWithCtx(t, e, harness, clientB, "drop view v", sql.ErrLockWaitTimeout)
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "commit")
+	enginetest.RunQueryWithContext(t, e, harness, clientB, "drop trigger t_bi")
+	enginetest.RunQueryWithContext(t, e, harness, clientB, "drop view v")
+}
+
+// recordingScheduler is a sql.ResourceGroupScheduler that records the resource group assignments of threads.
+type recordingScheduler struct {
+	assignments map[uint32][]sql.ResourceGroup
+}
+
+func (s *recordingScheduler) AssignResourceGroup(ctx *sql.Context, connID uint32, group sql.ResourceGroup) error {
+	s.assignments[connID] = append(s.assignments[connID], group)
+	return nil
+}
+
+func TestResourceGroups(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.NewDatabases("mydb")
+	e := sqle.New(analyzer.NewDefault(harness.Provider()), new(sqle.Config))
+	defer e.Close()
+	scheduler := &recordingScheduler{assignments: make(map[uint32][]sql.ResourceGroup)}
+	e.Analyzer.Catalog.ResourceGroups.Scheduler = scheduler
+
+	clientA := enginetest.NewSession(harness)
+	clientB := enginetest.NewSession(harness)
+	pl := sqle.NewProcessList()
+	for i, client := range []*sql.Context{clientA, clientB} {
+		client.Session.SetConnectionId(uint32(i + 1))
+		pl.AddConnection(client.ID(), "localhost")
+		pl.ConnectionReady(client.Session)
+		client.ApplyOpts(sql.WithProcessList(pl))
+	}
+	threadsQuery := "select thread_id, resource_group from performance_schema.threads order by thread_id"
+
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "create resource group batch type = user vcpu = 0 thread_priority = 19")
+	batch := sql.ResourceGroup{
+		Name:           "batch",
+		Type:           sql.ResourceGroupType_User,
+		Enabled:        true,
+		VCPUs:          []sql.ResourceGroupVCPURange{{Start: 0, End: 0}},
+		ThreadPriority: 19,
+	}
+	require.Empty(t, scheduler.assignments)
+	enginetest.TestQueryWithContext(t, clientA, e, harness, threadsQuery, []sql.Row{
+		{uint64(clientA.ID()), sql.DefaultUserResourceGroup},
+		{uint64(clientB.ID()), sql.DefaultUserResourceGroup},
+	}, nil, nil)
+
+	// a session can assign itself, or another thread, to a group
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "set resource group batch")
+	require.Equal(t, []sql.ResourceGroup{batch}, scheduler.assignments[clientA.ID()])
+	enginetest.TestQueryWithContext(t, clientB, e, harness, threadsQuery, []sql.Row{
+		{uint64(clientA.ID()), "batch"},
+		{uint64(clientB.ID()), sql.DefaultUserResourceGroup},
+	}, nil, nil)
+	enginetest.RunQueryWithContext(t, e, harness, clientA, fmt.Sprintf("set resource group batch for %d", clientB.ID()))
+	require.Equal(t, []sql.ResourceGroup{batch}, scheduler.assignments[clientB.ID()])
+	enginetest.AssertErrWithCtx(t, e, harness, clientA, "set resource group batch for 1000", sql.ErrResourceGroupBindFailed)
+
+	// a RESOURCE_GROUP hint assigns the thread for the statement only
+	enginetest.TestQueryWithContext(t, clientB, e, harness,
+		fmt.Sprintf("select /*+ RESOURCE_GROUP(USR_default) */ resource_group from performance_schema.threads where thread_id = %d", clientB.ID()),
+		[]sql.Row{{sql.DefaultUserResourceGroup}}, nil, nil)
+	require.Len(t, scheduler.assignments[clientB.ID()], 3)
+	require.Equal(t, sql.DefaultUserResourceGroup, scheduler.assignments[clientB.ID()][1].Name)
+	require.Equal(t, batch, scheduler.assignments[clientB.ID()][2])
+	scheduler.assignments = make(map[uint32][]sql.ResourceGroup)
+
+	// altering the group passes its new attributes to the scheduler for each of its threads
+	enginetest.RunQueryWithContext(t, e, harness, clientA, "alter resource group batch vcpu = 0 thread_priority = 7")
+	batch.ThreadPriority = 7
+	require.Equal(t, []sql.ResourceGroup{batch}, scheduler.assignments[clientA.ID()])
+	require.Equal(t, []sql.ResourceGroup{batch}, scheduler.assignments[clientB.ID()])
+
+	// dropping the group moves its threads back to the default group, and so does closing a session
+	enginetest.AssertErrWithCtx(t, e, harness, clientA, "drop resource group batch", sql.ErrResourceGroupBusy)
+	e.CloseSession(clientA.ID())
+	require.Equal(t, sql.DefaultUserResourceGroup, e.Analyzer.Catalog.ResourceGroups.ThreadGroup(clientA.ID()))
+	enginetest.RunQueryWithContext(t, e, harness, clientB, "drop resource group batch force")
+	require.Equal(t, sql.DefaultUserResourceGroup, scheduler.assignments[clientB.ID()][1].Name)
+	enginetest.TestQueryWithContext(t, clientB, e, harness, threadsQuery, []sql.Row{
+		{uint64(clientA.ID()), sql.DefaultUserResourceGroup},
+		{uint64(clientB.ID()), sql.DefaultUserResourceGroup},
+	}, nil, nil)
+}
+
+func TestCustomSystemVariables(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.NewDatabases("mydb")
+	e := sqle.New(analyzer.NewDefault(harness.Provider()), new(sqle.Config))
+	defer e.Close()
+	ctx := enginetest.NewSession(harness)
+
+	// variables may be registered after a session has already started
+	err := sql.SystemVariables.RegisterSystemVariables([]sql.SystemVariable{
+		{
+			Name:    "custom_session_var",
+			Scope:   sql.SystemVariableScope_Session,
+			Dynamic: true,
+			Type:    types.NewSystemIntType("custom_session_var", 0, 100, false),
+			Default: int64(10),
+		},
+		{
+			Name:    "custom_global_var",
+			Scope:   sql.SystemVariableScope_Global,
+			Dynamic: false,
+			Type:    types.NewSystemStringType("custom_global_var"),
+			Default: "read only",
+		},
+	})
+	require.NoError(t, err)
+	err = sql.SystemVariables.RegisterSystemVariables([]sql.SystemVariable{{
+		Name:  "CUSTOM_SESSION_VAR",
+		Scope: sql.SystemVariableScope_Both,
+		Type:  types.NewSystemStringType("custom_session_var"),
+	}})
+	require.True(t, sql.ErrSystemVariableAlreadyExists.Is(err))
+
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "select @@custom_session_var, @@session.custom_session_var, @@global.custom_global_var",
+		[]sql.Row{{int64(10), int64(10), "read only"}}, nil, nil)
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "set custom_session_var = 42")
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "show variables like 'custom_%'",
+		[]sql.Row{{"custom_global_var", "read only"}, {"custom_session_var", int64(42)}}, nil, nil)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "select * from performance_schema.session_variables where variable_name like 'custom_%'",
+		[]sql.Row{{"custom_global_var", "read only"}, {"custom_session_var", "42"}}, nil, nil)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "select * from performance_schema.global_variables where variable_name like 'custom_%'",
+		[]sql.Row{{"custom_global_var", "read only"}, {"custom_session_var", "10"}}, nil, nil)
+
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "set global custom_session_var = 1", sql.ErrSystemVariableSessionOnly)
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "set global custom_global_var = 'changed'", sql.ErrSystemVariableReadOnly)
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "set custom_session_var = 1000", nil)
+
+	// new sessions start with the default value
+	enginetest.TestQueryWithContext(t, enginetest.NewSession(harness), e, harness, "select @@custom_session_var", []sql.Row{{int64(10)}}, nil, nil)
+}
+
+// countingExpression returns the value of its child, counting how many times it is evaluated.
+type countingExpression struct {
+	expression.UnaryExpression
+	count *int
+}
+
+func (c *countingExpression) Type() sql.Type {
+	return c.Child.Type()
+}
+
+func (c *countingExpression) String() string {
+	return fmt.Sprintf("counted(%s)", c.Child)
+}
+
+func (c *countingExpression) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	*c.count++
+	return c.Child.Eval(ctx, row)
+}
+
+func (c *countingExpression) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return &countingExpression{UnaryExpression: expression.UnaryExpression{Child: children[0]}, count: c.count}, nil
+}
+
+func TestReuseProjectedExpressions(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	var count int
+	ctx := harness.NewContext()
+	e.EngineAnalyzer().Catalog.RegisterFunction(ctx, sql.Function1{
+		Name: "counted",
+		Fn: func(e sql.Expression) sql.Expression {
+			return &countingExpression{UnaryExpression: expression.UnaryExpression{Child: e}, count: &count}
+		},
+	})
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "create table t (pk int primary key, x int)")
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "insert into t values (1, 3), (2, 1), (3, 2), (4, 1)")
+
+	tests := []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{
+			query:    "select counted(x) as v from t order by counted(x), pk",
+			expected: []sql.Row{{1}, {1}, {2}, {3}},
+		},
+		{
+			query:    "select counted(x) as v from t order by v desc",
+			expected: []sql.Row{{3}, {2}, {1}, {1}},
+		},
+		{
+			query:    "select distinct counted(x) as v from t order by counted(x)",
+			expected: []sql.Row{{1}, {2}, {3}},
+		},
+		{
+			query:    "select counted(x) as v from t order by counted(x) limit 2",
+			expected: []sql.Row{{1}, {1}},
+		},
+		{
+			query:    "select counted(x) as v, count(*) from t group by counted(x) order by 1",
+			expected: []sql.Row{{1, 2}, {2, 1}, {3, 1}},
+		},
+		{
+			query:    "select counted(x) as v, count(*) from t group by v having v > 1 order by 1",
+			expected: []sql.Row{{2, 1}, {3, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			count = 0
+			enginetest.TestQueryWithContext(t, ctx, e, harness, tt.query, tt.expected, nil, nil)
+			// each of the four rows evaluates the expression once
+			require.Equal(t, 4, count)
+		})
+	}
+}
+
+// schemaChangeRecorder is a sql.SchemaChangeListener that records the events it is notified of, and vetoes the
+// changes that |veto| returns an error for.
+type schemaChangeRecorder struct {
+	before []sql.SchemaChangeEvent
+	after  []sql.SchemaChangeEvent
+	veto   func(event sql.SchemaChangeEvent) error
+}
+
+var _ sql.SchemaChangeListener = (*schemaChangeRecorder)(nil)
+
+func (r *schemaChangeRecorder) BeforeSchemaChange(_ *sql.Context, event sql.SchemaChangeEvent) error {
+	r.before = append(r.before, event)
+	if r.veto != nil {
+		return r.veto(event)
+	}
+	return nil
+}
+
+func (r *schemaChangeRecorder) AfterSchemaChange(_ *sql.Context, event sql.SchemaChangeEvent) {
+	r.after = append(r.after, event)
+}
+
+func schemaColumnNames(sch sql.Schema) []string {
+	var names []string
+	for _, col := range sch {
+		names = append(names, col.Name)
+	}
+	return names
+}
+
+func TestSchemaChangeListeners(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.NewDatabases("mydb")
+	e := enginetest.NewEngineWithProvider(t, harness, harness.Provider())
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	recorder := &schemaChangeRecorder{}
+	e.AddSchemaChangeListener(recorder)
+
+	statements := []string{
+		"create table t (pk int primary key, a int)",
+		"alter table t add column b int, drop column a, rename column pk to id",
+		"create index idx on t (b)",
+		"create view v as select id from t",
+		"create trigger trg before insert on t for each row set new.b = 1",
+		"drop trigger trg",
+		"drop view v",
+		"drop index idx on t",
+		"drop table t",
+	}
+	for _, statement := range statements {
+		enginetest.RunQueryWithContext(t, e, harness, ctx, statement)
+	}
+	// statements that fail analysis are not notified
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "alter table t add column c int", sql.ErrTableNotFound)
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "create table t2 (pk int primary key, pk int)", sql.ErrDuplicateColumn)
+
+	type event struct {
+		typ       sql.SchemaChangeType
+		table     string
+		name      string
+		oldSchema []string
+		newSchema []string
+	}
+	expected := []event{
+		{typ: sql.SchemaChangeType_CreateTable, table: "t", newSchema: []string{"pk", "a"}},
+		{typ: sql.SchemaChangeType_AlterTable, table: "t", oldSchema: []string{"pk", "a"}, newSchema: []string{"id", "b"}},
+		{typ: sql.SchemaChangeType_CreateIndex, table: "t", name: "idx", oldSchema: []string{"id", "b"}, newSchema: []string{"id", "b"}},
+		{typ: sql.SchemaChangeType_CreateView, table: "v", newSchema: []string{"id"}},
+		{typ: sql.SchemaChangeType_CreateTrigger, table: "t", name: "trg"},
+		{typ: sql.SchemaChangeType_DropTrigger, name: "trg"},
+		{typ: sql.SchemaChangeType_DropView, table: "v"},
+		{typ: sql.SchemaChangeType_DropIndex, table: "t", name: "idx", oldSchema: []string{"id", "b"}, newSchema: []string{"id", "b"}},
+		{typ: sql.SchemaChangeType_DropTable, table: "t", oldSchema: []string{"id", "b"}},
+	}
+	for _, events := range [][]sql.SchemaChangeEvent{recorder.before, recorder.after} {
+		require.Len(t, events, len(expected))
+		for i, ev := range events {
+			require.Equal(t, expected[i], event{
+				typ:       ev.Type,
+				table:     ev.Table,
+				name:      ev.Name,
+				oldSchema: schemaColumnNames(ev.OldSchema),
+				newSchema: schemaColumnNames(ev.NewSchema),
+			})
+			require.Equal(t, "mydb", ev.Database)
+			require.Equal(t, statements[i], ev.Query)
+		}
+	}
+
+	t.Run("veto", func(t *testing.T) {
+		// vetoes any change that drops a column of a table
+		vetoDropColumn := &schemaChangeRecorder{
+			veto: func(event sql.SchemaChangeEvent) error {
+				for _, col := range event.OldSchema {
+					if event.NewSchema != nil && !event.NewSchema.Contains(col.Name, col.Source) {
+						return fmt.Errorf("column %s is still in use", col.Name)
+					}
+				}
+				return nil
+			},
+		}
+		e.AddSchemaChangeListener(vetoDropColumn)
+
+		enginetest.RunQueryWithContext(t, e, harness, ctx, "create table t (pk int primary key, a int, b int)")
+		_, iter, err := e.Query(ctx, "alter table t add column c int, drop column a")
+		require.Error(t, err)
+		require.Nil(t, iter)
+		require.Equal(t, "column a is still in use", err.Error())
+		// changes that keep every column are not vetoed
+		enginetest.RunQueryWithContext(t, e, harness, ctx, "alter table t modify column b bigint")
+
+		enginetest.TestQueryWithContext(t, ctx, e, harness, "select column_name, data_type from information_schema.columns where table_name = 't' order by ordinal_position",
+			[]sql.Row{{"pk", "int"}, {"a", "int"}, {"b", "bigint"}}, nil, nil)
+
+		require.Len(t, vetoDropColumn.before, 3)
+		require.Len(t, vetoDropColumn.after, 2)
+		require.Equal(t, sql.SchemaChangeType_AlterTable, vetoDropColumn.before[1].Type)
+		require.Equal(t, []string{"pk", "b", "c"}, schemaColumnNames(vetoDropColumn.before[1].NewSchema))
+		require.Equal(t, "alter table t modify column b bigint", vetoDropColumn.after[1].Query)
+	})
+}
+
+// countingTraceHook is a sql.TraceHook that counts the notifications it receives.
+type countingTraceHook struct {
+	parsed        []string
+	analyzed      []string
+	analyzeErrs   []error
+	rowsRead      int64
+	executedRows  []int64
+	executeErrors []error
+}
+
+var _ sql.TraceHook = (*countingTraceHook)(nil)
+
+func (h *countingTraceHook) OnParse(_ *sql.Context, query string, _ time.Duration, _ error) {
+	h.parsed = append(h.parsed, query)
+}
+
+func (h *countingTraceHook) OnAnalyze(_ *sql.Context, query string, _ sql.Node, _ time.Duration, err error) {
+	h.analyzed = append(h.analyzed, query)
+	h.analyzeErrs = append(h.analyzeErrs, err)
+}
+
+func (h *countingTraceHook) OnRowRead(*sql.Context, string, sql.Row) {
+	h.rowsRead++
+}
+
+func (h *countingTraceHook) OnExecute(_ *sql.Context, _ string, rows int64, _ time.Duration, err error) {
+	h.executedRows = append(h.executedRows, rows)
+	h.executeErrors = append(h.executeErrors, err)
+}
+
+func TestTraceHook(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData, setup.MytableData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	hook := &countingTraceHook{}
+	ctx := sql.NewContext(context.Background(), sql.WithSession(enginetest.NewContext(harness).Session), sql.WithTraceHook(hook))
+
+	queries := []string{
+		"select * from mytable",
+		"select i from mytable where i > 1",
+		"select count(*) from mytable",
+	}
+	var returned []int64
+	for _, query := range queries {
+		_, iter, err := e.Query(ctx.WithQuery(query), query)
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		returned = append(returned, int64(len(rows)))
+	}
+
+	require.Equal(t, []int64{3, 2, 1}, returned)
+	require.Equal(t, queries, hook.parsed)
+	require.Equal(t, queries, hook.analyzed)
+	require.Equal(t, int64(6), hook.rowsRead)
+	require.Equal(t, returned, hook.executedRows)
+	require.Equal(t, []error{nil, nil, nil}, hook.executeErrors)
+
+	// a query that fails analysis is not executed
+	_, _, err = e.Query(ctx, "select x from mytable")
+	require.Error(t, err)
+	require.Len(t, hook.analyzed, 4)
+	require.Error(t, hook.analyzeErrs[3])
+	require.Len(t, hook.executedRows, 3)
+}
+
+func TestLimitZeroDoesNotExecute(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	var count int
+	ctx := harness.NewContext()
+	e.EngineAnalyzer().Catalog.RegisterFunction(ctx, sql.Function1{
+		Name: "counted",
+		Fn: func(e sql.Expression) sql.Expression {
+			return &countingExpression{UnaryExpression: expression.UnaryExpression{Child: e}, count: &count}
+		},
+	})
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "create table t (pk int primary key, x varchar(10))")
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "insert into t values (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd')")
+
+	tests := []struct {
+		query   string
+		columns []string
+		types   []sql.Type
+	}{
+		{
+			query:   "select * from t where counted(x) is not null limit 0",
+			columns: []string{"pk", "x"},
+			types:   []sql.Type{types.Int32, types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_Default)},
+		},
+		{
+			query:   "select counted(x) as v, pk + 1 from t limit 0",
+			columns: []string{"v", "pk + 1"},
+			types:   []sql.Type{types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_Default), types.Int64},
+		},
+		{
+			query:   "select * from t where counted(x) is not null order by x desc limit 0",
+			columns: []string{"pk", "x"},
+			types:   []sql.Type{types.Int32, types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_Default)},
+		},
+		{
+			query:   "select a.pk, count(*) as c from t a join t b on counted(a.x) = b.x group by a.pk order by c limit 0",
+			columns: []string{"pk", "c"},
+			types:   []sql.Type{types.Int32, types.Int64},
+		},
+		{
+			query:   "select pk from t where counted(x) is not null union select pk + 10 from t limit 0",
+			columns: []string{"pk"},
+			types:   []sql.Type{types.Int64},
+		},
+		{
+			query:   "select * from t where x = (select max(counted(x)) from t) limit 0",
+			columns: []string{"pk", "x"},
+			types:   []sql.Type{types.Int32, types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_Default)},
+		},
+		{
+			query:   "select * from t a join (select max(counted(x)) m from t) b on a.x = b.m limit 0",
+			columns: []string{"pk", "x", "m"},
+			types:   []sql.Type{types.Int32, types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_Default), types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_Default)},
+		},
+		{
+			query:   "select * from (select pk from t where counted(x) is not null limit 0) s",
+			columns: []string{"pk"},
+			types:   []sql.Type{types.Int32},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			count = 0
+			sch, iter, err := e.Query(ctx, tt.query)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+			require.Empty(t, rows)
+			require.Equal(t, 0, count, "rows of t were read")
+
+			require.Len(t, sch, len(tt.columns))
+			for i, col := range sch {
+				require.Equal(t, tt.columns[i], col.Name)
+				require.Equal(t, tt.types[i], col.Type)
+			}
+		})
+	}
+
+	// SQL_CALC_FOUND_ROWS needs the rows to be counted
+	count = 0
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "select sql_calc_found_rows * from t where counted(x) is not null limit 0", []sql.Row{}, nil, nil)
+	require.Equal(t, 4, count)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "select found_rows()", []sql.Row{{int64(4)}}, nil, nil)
+}
+
+func TestExplainAnalyze(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	ctx := harness.NewContext()
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "create table a (pk int primary key, x int)")
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "create table b (pk int primary key, y int)")
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "insert into a values (1, 1), (2, 1), (3, 2), (4, 3)")
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "insert into b values (1, 10), (2, 20), (3, 30)")
+
+	// timings vary from run to run, so they are replaced before comparing
+	timeRegex := regexp.MustCompile(`time=(\d+\.\d{3})\.\.(\d+\.\d{3})`)
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{
+			query: "explain analyze select /*+ LOOKUP_JOIN(a,b) */ a.pk, b.y from a join b on a.x = b.pk",
+			expected: []string{
+				"-> Project (actual time=T rows=4 loops=1)",
+				"    -> LookupJoin (actual time=T rows=4 loops=1)",
+				"        -> TableScan on a (actual time=T rows=4 loops=1)",
+				"        -> IndexedTableAccess(b) (actual time=T rows=1 loops=4)",
+			},
+		},
+		{
+			query: "explain analyze select x, count(*) from a group by x",
+			expected: []string{
+				"-> Project (actual time=T rows=3 loops=1)",
+				"    -> GroupBy (actual time=T rows=3 loops=1)",
+				"        -> TableScan on a (actual time=T rows=4 loops=1)",
+			},
+		},
+		{
+			query: "explain analyze select * from a where x > 1 limit 1",
+			expected: []string{
+				"-> Limit(1) (actual time=T rows=1 loops=1)",
+				"    -> Filter (actual time=T rows=1 loops=1)",
+				"        -> TableScan on a (actual time=T rows=3 loops=1)",
+			},
+		},
+		{
+			query: "explain analyze select * from a where x > 5",
+			expected: []string{
+				"-> Filter (actual time=T rows=0 loops=1)",
+				"    -> TableScan on a (actual time=T rows=4 loops=1)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, iter, err := e.Query(ctx, tt.query)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+			var actual []string
+			for _, row := range rows {
+				line := row[0].(string)
+				if m := timeRegex.FindStringSubmatch(line); m != nil {
+					firstRow, err := strconv.ParseFloat(m[1], 64)
+					require.NoError(t, err)
+					allRows, err := strconv.ParseFloat(m[2], 64)
+					require.NoError(t, err)
+					require.LessOrEqual(t, firstRow, allRows, line)
+				}
+				actual = append(actual, timeRegex.ReplaceAllString(line, "time=T"))
+			}
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
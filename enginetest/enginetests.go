@@ -510,7 +510,7 @@ func TestIndexQueryPlans(t *testing.T, harness Harness) {
 		RunQueryWithContext(t, e, harness, ctx, `CREATE INDEX idx1 ON otherdb.a (y);`)
 
 		TestQueryWithContext(t, ctx, e, harness, "SHOW INDEXES FROM otherdb.a", []sql.Row{
-			{"a", 1, "idx1", 1, "y", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+			{"a", 1, "idx1", 1, "y", nil, nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
 		}, nil, nil)
 
 	})
@@ -3597,8 +3597,79 @@ func TestWindowFunctions(t *testing.T, harness Harness) {
 		{5, "s"},
 	}, nil, nil)
 
+	TestQueryWithContext(t, ctx, e, harness, `SELECT subject, name, nth_value(name, 2) OVER (partition by subject order by mark desc, name) FROM results order by subject, mark desc, name`, []sql.Row{
+		{"English", "Swarna", nil},
+		{"English", "Ankita", "Ankita"},
+		{"English", "Pratibha", "Ankita"},
+		{"Maths", "Pratibha", nil},
+		{"Maths", "Swarna", "Swarna"},
+		{"Maths", "Ankita", "Swarna"},
+		{"Science", "Ankita", nil},
+		{"Science", "Pratibha", "Pratibha"},
+		{"Science", "Swarna", "Pratibha"},
+	}, nil, nil)
+
+	TestQueryWithContext(t, ctx, e, harness, `SELECT subject, name, nth_value(mark, 2) FROM LAST OVER (partition by subject order by mark desc, name rows between unbounded preceding and unbounded following) FROM results order by subject, mark desc, name`, []sql.Row{
+		{"English", "Swarna", 90},
+		{"English", "Ankita", 90},
+		{"English", "Pratibha", 90},
+		{"Maths", "Pratibha", 85},
+		{"Maths", "Swarna", 85},
+		{"Maths", "Ankita", 85},
+		{"Science", "Ankita", 80},
+		{"Science", "Pratibha", 80},
+		{"Science", "Swarna", 80},
+	}, nil, nil)
+
+	TestQueryWithContext(t, ctx, e, harness, `SELECT subject, name, nth_value(name, 3) over (partition by subject order by mark desc, name rows between 1 preceding and 1 following) FROM results order by subject, mark desc, name`, []sql.Row{
+		{"English", "Swarna", nil},
+		{"English", "Ankita", "Pratibha"},
+		{"English", "Pratibha", nil},
+		{"Maths", "Pratibha", nil},
+		{"Maths", "Swarna", "Ankita"},
+		{"Maths", "Ankita", nil},
+		{"Science", "Ankita", nil},
+		{"Science", "Pratibha", "Swarna"},
+		{"Science", "Swarna", nil},
+	}, nil, nil)
+
+	RunQueryWithContext(t, e, harness, ctx, "CREATE TABLE nulls_tbl (a int primary key, b int, c int)")
+	RunQueryWithContext(t, e, harness, ctx, "INSERT INTO nulls_tbl VALUES (1,1,NULL), (2,1,10), (3,1,NULL), (4,1,30), (5,2,NULL), (6,2,NULL)")
+
+	TestQueryWithContext(t, ctx, e, harness, `SELECT a, nth_value(c, 2) FROM FIRST RESPECT NULLS over (partition by b order by a) FROM nulls_tbl order by a`, []sql.Row{
+		{1, nil}, {2, 10}, {3, 10}, {4, 10}, {5, nil}, {6, nil},
+	}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT a, nth_value(c, 2) ignore nulls over (partition by b order by a rows between unbounded preceding and unbounded following) FROM nulls_tbl order by a`, []sql.Row{
+		{1, 30}, {2, 30}, {3, 30}, {4, 30}, {5, nil}, {6, nil},
+	}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT a, nth_value(c, 1) from last ignore nulls over (partition by b order by a) FROM nulls_tbl order by a`, []sql.Row{
+		{1, nil}, {2, 10}, {3, 10}, {4, 30}, {5, nil}, {6, nil},
+	}, nil, nil)
+
+	TestQueryWithContext(t, ctx, e, harness, `SELECT a, lag(c) ignore nulls over (partition by b order by a) FROM nulls_tbl order by a`, []sql.Row{
+		{1, nil}, {2, nil}, {3, 10}, {4, 10}, {5, nil}, {6, nil},
+	}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT a, lead(c) ignore nulls over (partition by b order by a) FROM nulls_tbl order by a`, []sql.Row{
+		{1, 10}, {2, 30}, {3, 30}, {4, nil}, {5, nil}, {6, nil},
+	}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT a, lag(c, 1, -1) respect nulls over (partition by b order by a) FROM nulls_tbl order by a`, []sql.Row{
+		{1, -1}, {2, nil}, {3, 10}, {4, nil}, {5, -1}, {6, nil},
+	}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT a, lead(c, 2, -1) ignore nulls over (partition by b order by a) FROM nulls_tbl order by a`, []sql.Row{
+		{1, 30}, {2, -1}, {3, -1}, {4, -1}, {5, -1}, {6, -1},
+	}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT a, lag(c, 2, -1) ignore nulls over (order by a) FROM nulls_tbl order by a`, []sql.Row{
+		{1, -1}, {2, -1}, {3, -1}, {4, -1}, {5, 10}, {6, 10},
+	}, nil, nil)
+
+	AssertErr(t, e, harness, "SELECT a, nth_value(c, 0) over (partition by b) FROM nulls_tbl", sql.ErrInvalidArgument)
+	AssertErr(t, e, harness, "SELECT a, nth_value(c, b) over (partition by b) FROM nulls_tbl", expression.ErrInvalidOffset)
+	AssertErr(t, e, harness, "SELECT a, nth_value(c, 2, 'from last') over (partition by b) FROM nulls_tbl", sql.ErrInvalidArgumentNumber)
+
 	AssertErr(t, e, harness, "SELECT a, lag(a, -1) over (partition by c) FROM t1", expression.ErrInvalidOffset)
 	AssertErr(t, e, harness, "SELECT a, lag(a, 's') over (partition by c) FROM t1", expression.ErrInvalidOffset)
+	AssertErr(t, e, harness, "SELECT a, lag(a, 1, null, 'ignore nulls') over (partition by c) FROM t1", sql.ErrInvalidArgumentNumber)
+	AssertErr(t, e, harness, "SELECT a, lead(a, 1, null, 'ignore nulls') over (partition by c) FROM t1", sql.ErrInvalidArgumentNumber)
 
 	RunQueryWithContext(t, e, harness, ctx, "CREATE TABLE t2 (a int, b int, c int)")
 	RunQueryWithContext(t, e, harness, ctx, "INSERT INTO t2 VALUES (1,1,1), (3,2,2), (7,4,5)")
@@ -3715,6 +3786,19 @@ func TestWindowRangeFrames(t *testing.T, harness Harness) {
 	TestQueryWithContext(t, ctx, e, harness, `SELECT count(y) over (partition by z order by date range between interval '1' DAY following and interval '2' DAY following) FROM c order by x`, []sql.Row{{1}, {1}, {1}, {1}, {1}, {0}, {2}, {2}, {0}, {0}}, nil, nil)
 	TestQueryWithContext(t, ctx, e, harness, `SELECT count(y) over (partition by z order by date range between interval '1' DAY preceding and interval '2' DAY following) FROM c order by x`, []sql.Row{{4}, {4}, {4}, {5}, {2}, {2}, {4}, {4}, {4}, {4}}, nil, nil)
 
+	// peers at the frame boundaries, in ascending and descending order
+	RunQueryWithContext(t, e, harness, ctx, "CREATE TABLE d (x INTEGER PRIMARY KEY, y INTEGER, n INTEGER, date DATE)")
+	RunQueryWithContext(t, e, harness, ctx, "INSERT INTO d VALUES (1,1,1,'2024-01-01'), (2,2,5,'2024-01-05'), (3,3,8,'2024-01-08'), (4,4,8,'2024-01-08'), (5,5,15,'2024-01-15'), (6,6,16,'2024-01-16')")
+	TestQueryWithContext(t, ctx, e, harness, `SELECT sum(y) over (order by date range between interval 7 DAY preceding and current row) FROM d order by x`, []sql.Row{{float64(1)}, {float64(3)}, {float64(10)}, {float64(10)}, {float64(12)}, {float64(11)}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT sum(y) over (order by date desc range between interval 7 DAY preceding and current row) FROM d order by x`, []sql.Row{{float64(10)}, {float64(9)}, {float64(12)}, {float64(12)}, {float64(11)}, {float64(6)}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT sum(y) over (order by date desc range between current row and interval 1 WEEK following) FROM d order by x`, []sql.Row{{float64(1)}, {float64(3)}, {float64(10)}, {float64(10)}, {float64(12)}, {float64(11)}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT sum(y) over (order by n range between 7 preceding and current row) FROM d order by x`, []sql.Row{{float64(1)}, {float64(3)}, {float64(10)}, {float64(10)}, {float64(12)}, {float64(11)}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT sum(y) over (order by n desc range between 7 preceding and current row) FROM d order by x`, []sql.Row{{float64(10)}, {float64(9)}, {float64(12)}, {float64(12)}, {float64(11)}, {float64(6)}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT sum(y) over (order by n desc range between 3 preceding and 3 following) FROM d order by x`, []sql.Row{{float64(1)}, {float64(9)}, {float64(9)}, {float64(9)}, {float64(11)}, {float64(11)}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT count(*) over (order by date desc) FROM d order by x`, []sql.Row{{6}, {5}, {4}, {4}, {2}, {1}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, `SELECT rank() over (order by date desc range between interval 7 DAY preceding and current row), dense_rank() over (order by date desc range between interval 7 DAY preceding and current row), row_number() over (order by x desc range between 1 preceding and current row) FROM d order by x`,
+		[]sql.Row{{uint64(6), uint64(5), 6}, {uint64(5), uint64(4), 5}, {uint64(3), uint64(3), 4}, {uint64(3), uint64(3), 3}, {uint64(2), uint64(2), 2}, {uint64(1), uint64(1), 1}}, nil, nil)
+
 	AssertErr(t, e, harness, "SELECT sum(y) over (partition by z range between unbounded preceding and interval '1' DAY following) FROM c order by x", aggregation.ErrRangeInvalidOrderBy)
 	AssertErr(t, e, harness, "SELECT sum(y) over (partition by z order by date range interval 'e' DAY preceding) FROM c order by x", sql.ErrInvalidValue)
 }
@@ -4168,6 +4252,19 @@ func TestClearWarnings(t *testing.T, harness Harness) {
 	require.NoError(err)
 
 	require.Equal(0, len(ctx.Session.Warnings()))
+
+	// statements that are only prepared or analyzed clear the warnings of the previous statement too
+	for _, run := range []func(*sql.Context, string) (sql.Node, error){e.PrepareQuery, e.AnalyzeQuery} {
+		_, iter, err = e.Query(ctx, "drop table if exists table1, table2, table3;")
+		require.NoError(err)
+		err = iter.Close(ctx)
+		require.NoError(err)
+		require.Equal(3, len(ctx.Session.Warnings()))
+
+		_, err = run(ctx, "SELECT * FROM mytable LIMIT 1")
+		require.NoError(err)
+		require.Equal(0, len(ctx.Session.Warnings()))
+	}
 }
 
 func TestUse(t *testing.T, harness Harness) {
@@ -4273,6 +4370,12 @@ func TestTransactionScripts(t *testing.T, harness Harness) {
 	}
 }
 
+func TestTransactionalDDL(t *testing.T, harness Harness) {
+	for _, script := range queries.TransactionalDDLTests {
+		TestTransactionScript(t, harness, script)
+	}
+}
+
 func TestConcurrentProcessList(t *testing.T, harness Harness) {
 	require := require.New(t)
 	pl := sqle.NewProcessList()
@@ -4730,9 +4833,10 @@ func TestColumnDefaults(t *testing.T, harness Harness) {
 
 func TestPersist(t *testing.T, harness Harness, newPersistableSess func(ctx *sql.Context) sql.PersistableSession) {
 	q := []struct {
-		Name            string
+		SetUpScript     []string
 		Query           string
 		Expected        []sql.Row
+		ExpectedErr     *errors.Kind
 		ExpectedGlobal  interface{}
 		ExpectedPersist interface{}
 	}{
@@ -4751,6 +4855,34 @@ func TestPersist(t *testing.T, harness Harness, newPersistableSess func(ctx *sql
 			Expected:        []sql.Row{{}},
 			ExpectedGlobal:  int64(151),
 			ExpectedPersist: int64(1000),
+		}, {
+			SetUpScript:     []string{"SET PERSIST max_connections = 1000;"},
+			Query:           "SET PERSIST max_connections = DEFAULT;",
+			Expected:        []sql.Row{{}},
+			ExpectedGlobal:  int64(151),
+			ExpectedPersist: int64(151),
+		}, {
+			Query:       "SET PERSIST max_connections = 'abc';",
+			ExpectedErr: sql.ErrInvalidSystemVariableValue,
+		}, {
+			Query:       "SET PERSIST_ONLY no_such_variable = 1;",
+			ExpectedErr: sql.ErrUnknownSystemVariable,
+		}, {
+			// the global value is unchanged
+			SetUpScript:    []string{"SET PERSIST max_connections = 1000;"},
+			Query:          "RESET PERSIST max_connections;",
+			Expected:       []sql.Row{{}},
+			ExpectedGlobal: int64(1000),
+		}, {
+			SetUpScript: []string{"SET PERSIST_ONLY max_connections = 1000;"},
+			Query:       "RESET PERSIST;",
+			Expected:    []sql.Row{{}},
+		}, {
+			Query:       "RESET PERSIST max_connections;",
+			ExpectedErr: sql.ErrPersistedVariableNotFound,
+		}, {
+			Query:    "RESET PERSIST IF EXISTS max_connections;",
+			Expected: []sql.Row{{}},
 		},
 	}
 
@@ -4759,11 +4891,19 @@ func TestPersist(t *testing.T, harness Harness, newPersistableSess func(ctx *sql
 	defer e.Close()
 
 	for _, tt := range q {
-		t.Run(tt.Name, func(t *testing.T) {
+		t.Run(tt.Query, func(t *testing.T) {
 			variables.InitSystemVariables()
 			ctx := NewContext(harness)
 			ctx.Session = newPersistableSess(ctx)
 
+			for _, s := range tt.SetUpScript {
+				RunQueryWithContext(t, e, harness, ctx, s)
+			}
+
+			if tt.ExpectedErr != nil {
+				AssertErrWithCtx(t, e, harness, ctx, tt.Query, tt.ExpectedErr)
+				return
+			}
 			TestQueryWithContext(t, ctx, e, harness, tt.Query, tt.Expected, nil, nil)
 
 			if tt.ExpectedGlobal != nil {
@@ -4774,16 +4914,41 @@ func TestPersist(t *testing.T, harness Harness, newPersistableSess func(ctx *sql
 				TestQueryWithContext(t, ctx, e, harness, showGlobalVarsQuery, []sql.Row{{"max_connections", tt.ExpectedGlobal}}, nil, nil)
 			}
 
-			if tt.ExpectedPersist != nil {
-				res, err := ctx.Session.(sql.PersistableSession).GetPersistedValue("max_connections")
-				require.NoError(t, err)
-				assert.Equal(t,
-					tt.ExpectedPersist, res)
-			}
+			res, err := ctx.Session.(sql.PersistableSession).GetPersistedValue("max_connections")
+			require.NoError(t, err)
+			assert.Equal(t, tt.ExpectedPersist, res)
 		})
 	}
 }
 
+// TestPersistAcrossRestart tests that system variables set with SET PERSIST and SET PERSIST_ONLY are restored by
+// sql.LoadPersistedSystemVariables when the server restarts. |newPersistableSess| must return sessions that share the
+// same persisted variables.
+func TestPersistAcrossRestart(t *testing.T, harness Harness, newPersistableSess func(ctx *sql.Context) sql.PersistableSession) {
+	harness.Setup(setup.MydbData, setup.MytableData)
+	e := mustNewEngine(t, harness)
+	defer e.Close()
+	defer variables.InitSystemVariables()
+
+	variables.InitSystemVariables()
+	ctx := NewContext(harness)
+	ctx.Session = newPersistableSess(ctx)
+	RunQueryWithContext(t, e, harness, ctx, "SET PERSIST max_connections = 1000")
+	RunQueryWithContext(t, e, harness, ctx, "SET PERSIST_ONLY net_read_timeout = 99")
+	TestQueryWithContext(t, ctx, e, harness, "SELECT @@global.max_connections, @@global.net_read_timeout", []sql.Row{{1000, 30}}, nil, nil)
+
+	// a restart resets the global values to their defaults, and then loads the persisted ones
+	variables.InitSystemVariables()
+	TestQueryWithContext(t, ctx, e, harness, "SELECT @@global.max_connections, @@global.net_read_timeout", []sql.Row{{151, 30}}, nil, nil)
+	require.NoError(t, sql.LoadPersistedSystemVariables(newPersistableSess(ctx)))
+
+	// sessions started after the restart see the persisted values
+	ctx = NewContext(harness)
+	ctx.Session = newPersistableSess(ctx)
+	TestQueryWithContext(t, ctx, e, harness, "SELECT @@global.max_connections, @@global.net_read_timeout", []sql.Row{{1000, 99}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, "SELECT @@session.net_read_timeout", []sql.Row{{99}}, nil, nil)
+}
+
 func TestValidateSession(t *testing.T, harness Harness, newSessFunc func(ctx *sql.Context) sql.PersistableSession, count *int) {
 	queries := []string{"SHOW TABLES;", "SELECT i from mytable;"}
 	harness.Setup(setup.MydbData, setup.MytableData)
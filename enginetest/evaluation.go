@@ -501,7 +501,7 @@ func injectBindVarsAndPrepare(
 				return false, nil
 			}
 			switch n.Type {
-			case sqlparser.HexNum, sqlparser.HexVal:
+			case sqlparser.HexNum, sqlparser.HexVal, sqlparser.BitVal:
 				return false, nil
 			}
 			expr := b.ConvertVal(n)
@@ -500,7 +500,7 @@ order by 1;`,
 			},
 			{
 				q:     "select * from xy where x != (select u from uv limit 1 offset 5);",
-				types: []plan.JoinType{plan.JoinTypeAnti},
+				types: []plan.JoinType{plan.JoinTypeAntiIncludeNulls},
 				exp:   []sql.Row{},
 			},
 		},
@@ -597,7 +597,7 @@ WHERE EXISTS (
 select x from xy where
   not exists (select a from ab where a = x and a = 1) and
   not exists (select a from ab where a = x and a = 2)`,
-				types: []plan.JoinType{plan.JoinTypeLeftOuterHashExcludeNulls, plan.JoinTypeLeftOuterMerge},
+				types: []plan.JoinType{plan.JoinTypeLeftOuterHash, plan.JoinTypeLeftOuterMerge},
 				exp:   []sql.Row{{0}, {3}},
 			},
 			{
@@ -896,6 +896,39 @@ where u in (select * from rec);`,
 			},
 		},
 	},
+	{
+		name: "anti joins with nulls",
+		setup: []string{
+			"CREATE table xy (x int primary key, y int);",
+			"CREATE table uv (u int primary key, v int);",
+			"insert into xy values (1,1), (2,2), (3,NULL);",
+			"insert into uv values (1,1), (2,NULL);",
+		},
+		tests: []JoinPlanTest{
+			{
+				// a NULL comparison isn't a match for NOT EXISTS
+				q:     "select x from xy where not exists (select 1 from uv where uv.v = xy.y) order by x",
+				types: []plan.JoinType{plan.JoinTypeLeftOuter},
+				exp:   []sql.Row{{2}, {3}},
+			},
+			{
+				q:     "select /*+ ANTI_JOIN(xy,uv) */ x from xy where not exists (select 1 from uv where uv.v = xy.y) order by x",
+				types: []plan.JoinType{plan.JoinTypeAnti},
+				exp:   []sql.Row{{2}, {3}},
+			},
+			{
+				// but NOT IN is NULL if the subquery has a NULL
+				q:     "select x from xy where y not in (select v from uv) order by x",
+				types: []plan.JoinType{plan.JoinTypeLeftOuterExcludeNulls},
+				exp:   []sql.Row{},
+			},
+			{
+				q:     "select x from xy where y not in (select v from uv where v is not null) order by x",
+				types: []plan.JoinType{plan.JoinTypeLeftOuterExcludeNulls},
+				exp:   []sql.Row{{2}},
+			},
+		},
+	},
 	{
 		name: "join concat tests",
 		setup: []string{
@@ -1027,7 +1060,7 @@ join uv d on d.u = c.x`,
 			},
 			{
 				q:     "select /*+ ANTI_JOIN(xy,uv) */ 1 from xy where x not in (select u from uv)",
-				types: []plan.JoinType{plan.JoinTypeAnti},
+				types: []plan.JoinType{plan.JoinTypeAntiIncludeNulls},
 			},
 			{
 				q:     "select /*+ LOOKUP_JOIN(xy,uv) */ 1 from xy where x in (select u from uv)",
@@ -1047,6 +1080,62 @@ join uv d on d.u = c.x`,
 			},
 		},
 	},
+	{
+		name: "semijoin strategy hint",
+		setup: []string{
+			"CREATE table xy (x int primary key, y int);",
+			"CREATE table uv (u int primary key, v int, key(v));",
+			"insert into xy values (1,0), (2,1), (0,2), (3,3);",
+			"insert into uv values (0,1), (1,1), (2,2), (3,2);",
+		},
+		tests: []JoinPlanTest{
+			{
+				q:     "select /*+ SEMIJOIN(FIRSTMATCH) */ x from xy where y in (select v from uv) order by x",
+				types: []plan.JoinType{plan.JoinTypeSemi},
+				exp:   []sql.Row{{0}, {2}},
+			},
+			{
+				q:     "select /*+ SEMIJOIN(FIRSTMATCH) */ x from xy where x in (select v from uv) order by x",
+				types: []plan.JoinType{plan.JoinTypeSemi},
+				exp:   []sql.Row{{1}, {2}},
+			},
+			{
+				q:     "select /*+ SEMIJOIN(MATERIALIZATION) */ x from xy where y in (select v from uv) order by x",
+				types: []plan.JoinType{plan.JoinTypeHash},
+				exp:   []sql.Row{{0}, {2}},
+			},
+			{
+				q:     "select /*+ SEMIJOIN(MATERIALIZATION) */ x from xy where x in (select v from uv) order by x",
+				types: []plan.JoinType{plan.JoinTypeMerge},
+				exp:   []sql.Row{{1}, {2}},
+			},
+			{
+				q:     "select /*+ SEMIJOIN(LOOSESCAN) */ x from xy where x in (select v from uv) order by x",
+				types: []plan.JoinType{plan.JoinTypeLookup},
+				exp:   []sql.Row{{1}, {2}},
+			},
+			{
+				q:     "select /*+ SEMIJOIN(@subq1 FIRSTMATCH, LOOSESCAN) */ x from xy where x in (select v from uv) order by x",
+				types: []plan.JoinType{plan.JoinTypeSemi},
+				exp:   []sql.Row{{1}, {2}},
+			},
+			{
+				q:     "select /*+ NO_SEMIJOIN(FIRSTMATCH, LOOSESCAN) */ x from xy where x in (select v from uv) order by x",
+				types: []plan.JoinType{plan.JoinTypeMerge},
+				exp:   []sql.Row{{1}, {2}},
+			},
+			{
+				q:     "select /*+ NO_SEMIJOIN(MATERIALIZATION, LOOSESCAN) */ x from xy where x in (select v from uv) order by x",
+				types: []plan.JoinType{plan.JoinTypeSemi},
+				exp:   []sql.Row{{1}, {2}},
+			},
+			{
+				q:     "select /*+ SEMIJOIN(FIRSTMATCH) */ x from xy where x not in (select v from uv) order by x",
+				types: []plan.JoinType{plan.JoinTypeLeftOuterMerge},
+				exp:   []sql.Row{{0}, {3}},
+			},
+		},
+	},
 	{
 		// This is a regression test for https://github.com/dolthub/go-mysql-server/pull/1889.
 		// We should always prefer a more specific index over a less specific index for lookups.
@@ -754,6 +754,10 @@ func TestReadOnly(t *testing.T) {
 	enginetest.TestReadOnly(t, enginetest.NewDefaultMemoryHarness(), true /* testStoredProcedures */)
 }
 
+func TestTransactionalDDL(t *testing.T) {
+	enginetest.TestTransactionalDDL(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestViews(t *testing.T) {
 	enginetest.TestViews(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -854,6 +858,19 @@ func TestPersist(t *testing.T) {
 	enginetest.TestPersist(t, harness, newSess)
 }
 
+func TestPersistAcrossRestart(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	if harness.IsUsingServer() {
+		t.Skip("this test depends on Context, which ServerEngine does not depend on or update the current context")
+	}
+	persistedGlobals := memory.GlobalsMap{}
+	newSess := func(_ *sql.Context) sql.PersistableSession {
+		ctx := harness.NewSession()
+		return ctx.Session.(*memory.Session).SetGlobals(persistedGlobals)
+	}
+	enginetest.TestPersistAcrossRestart(t, harness, newSess)
+}
+
 func TestValidateSession(t *testing.T) {
 	count := 0
 	incrementValidateCb := func() {
@@ -915,6 +915,124 @@ var AlterTableScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "rebuild with FORCE and ENGINE",
+		SetUpScript: []string{
+			"create table t (pk int primary key auto_increment, v int, unique key (v), check (v < 100))",
+			"insert into t (v) values (10), (20), (30)",
+			"delete from t where pk = 3",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t force",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "ALTER TABLE t ENGINE = InnoDB",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "alter table mydb.t engine innodb, algorithm = copy, lock = shared",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "alter table `t` algorithm=default, lock=exclusive, force",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select * from t where v = 20",
+				Expected: []sql.Row{{2, 20}},
+			},
+			{
+				// the AUTO_INCREMENT sequence isn't restarted by the rebuild
+				Query:    "insert into t (v) values (40)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 4}}},
+			},
+			{
+				Query:       "insert into t (v) values (20)",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:       "alter table t force, algorithm=inplace",
+				ExpectedErr: sql.ErrAlterAlgorithmNotSupported,
+			},
+			{
+				Query:       "alter table t force, algorithm=instant",
+				ExpectedErr: sql.ErrAlterAlgorithmNotSupported,
+			},
+			{
+				Query:       "alter table t force, lock=none",
+				ExpectedErr: sql.ErrAlterLockNotSupported,
+			},
+			{
+				Query:       "alter table t engine=MyISAM",
+				ExpectedErr: sql.ErrUnsupportedTableEngine,
+			},
+			{
+				// ALGORITHM and LOCK on their own also rebuild the table
+				Query:    "alter table t algorithm=copy",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:       "alter table t algorithm=fast",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+			{
+				Query:       "alter table not_exist force",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:    "ALTER TABLE t ENGINE=InnoDB, FORCE",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "ALTER TABLE t ADD COLUMN c INT, FORCE",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "alter table t add column d int, engine=MyISAM",
+				ExpectedErr: sql.ErrUnsupportedTableEngine,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 10, nil}, {2, 20, nil}, {4, 40, nil}},
+			},
+		},
+	},
+	{
+		Name: "modify column restating the definition keeps show create table unchanged",
+		SetUpScript: []string{
+			"create table t (pk int primary key auto_increment comment 'it''s the key', g geometry not null srid 4326, c varchar(20) character set latin1 collate latin1_german1_ci default 'x' comment 'cc', ts timestamp(6) default current_timestamp(6) on update current_timestamp(6), e enum('a','b') default 'b', s set('x','y','z') default 'x,z')",
+			"alter table t modify column pk int not null auto_increment comment 'it''s the key'",
+			"alter table t modify column g geometry not null srid 4326",
+			"alter table t modify column c varchar(20) character set latin1 collate latin1_german1_ci default 'x' comment 'cc'",
+			"alter table t modify column ts timestamp(6) default current_timestamp(6) on update current_timestamp(6)",
+			"alter table t modify column e enum('a','b') default 'b', modify column s set('x','y','z') default 'x,z'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `pk` int NOT NULL AUTO_INCREMENT COMMENT 'it''s the key',\n" +
+					"  `g` geometry NOT NULL /*!80003 SRID 4326 */,\n" +
+					"  `c` varchar(20) CHARACTER SET latin1 COLLATE latin1_german1_ci DEFAULT 'x' COMMENT 'cc',\n" +
+					"  `ts` timestamp(6) DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),\n" +
+					"  `e` enum('a','b') DEFAULT 'b',\n" +
+					"  `s` set('x','y','z') DEFAULT 'x,z',\n" +
+					"  PRIMARY KEY (`pk`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				// omitted attributes are dropped, as the new definition comes only from the statement
+				Query:    "alter table t modify column c varchar(20) character set latin1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select column_default, collation_name, column_comment from information_schema.columns where table_name = 't' and column_name = 'c'",
+				Expected: []sql.Row{{nil, "latin1_swedish_ci", ""}},
+			},
+		},
+	},
 }
 
 var RenameTableScripts = []ScriptTest{
@@ -1465,6 +1583,85 @@ var AddDropPrimaryKeyScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "ADD and MODIFY column FIRST / AFTER with indexes, defaults and generated columns",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int, c int default (b * 10), s int as (a + b) stored, key (c), key bc (b, c));",
+			"insert into t (a, b) values (1, 2), (2, 3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table t add column d int default (a + 100) first;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by a;",
+				Expected: []sql.Row{{101, 1, 2, 20, 3}, {102, 2, 3, 30, 5}},
+			},
+			{
+				Query:    "alter table t modify column b int after s;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by a;",
+				Expected: []sql.Row{{101, 1, 20, 3, 2}, {102, 2, 30, 5, 3}},
+			},
+			{
+				Query:    "insert into t (a, b) values (3, 4);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from t order by a;",
+				Expected: []sql.Row{{101, 1, 20, 3, 2}, {102, 2, 30, 5, 3}, {103, 3, 40, 7, 4}},
+			},
+			{
+				Query:    "select a, c from t where c = 40;",
+				Expected: []sql.Row{{3, 40}},
+			},
+			{
+				Query:    "select a from t where b = 4 and c = 40;",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "alter table t modify column s int as (a + b) stored first;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table t add column v int as (b + 1) virtual after a;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by a;",
+				Expected: []sql.Row{{3, 101, 1, 3, 20, 2}, {5, 102, 2, 4, 30, 3}, {7, 103, 3, 5, 40, 4}},
+			},
+			{
+				Query: "show create table t;",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `s` int GENERATED ALWAYS AS ((`a` + `b`)) STORED,\n" +
+					"  `d` int DEFAULT ((`a` + 100)),\n" +
+					"  `a` int NOT NULL,\n" +
+					"  `v` int GENERATED ALWAYS AS ((`b` + 1)),\n" +
+					"  `c` int DEFAULT ((`b` * 10)),\n" +
+					"  `b` int,\n" +
+					"  PRIMARY KEY (`a`),\n" +
+					"  KEY `bc` (`b`,`c`),\n" +
+					"  KEY `c` (`c`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "update t set b = 10 where a = 1;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "select a, s, v, b from t where b = 10 and c = 20;",
+				Expected: []sql.Row{{1, 11, 11, 10}},
+			},
+			{
+				Query:    "select a from t where v = 5;",
+				Expected: []sql.Row{{3}},
+			},
+		},
+	},
 }
 
 var AddColumnScripts = []ScriptTest{
@@ -106,6 +106,50 @@ var AnsiQuotesTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "ANSI_QUOTES: toggling the mode, escaped quotes and mixed quoting",
+		SetUpScript: []string{
+			"create table t (pk int primary key, `a\"b` int, c varchar(10));",
+			"insert into t values (1, 2, 'three');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// Without ANSI_QUOTES, double quotes delimit strings
+				Query:    `select "pk", "a""b" from t;`,
+				Expected: []sql.Row{{"pk", `a"b`}},
+			},
+			{
+				Query:       `select "pk" from "t";`,
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+			{
+				Query:    `SET @@sql_mode='ANSI_QUOTES';`,
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    `select "pk" from "t";`,
+				Expected: []sql.Row{{1}},
+			},
+			{
+				// A doubled double quote escapes a double quote within an identifier
+				Query:    `select "a""b" from t;`,
+				Expected: []sql.Row{{2}},
+			},
+			{
+				// Backticks, double quotes and single quoted strings can all be used in one query
+				Query:    "select \"t\".\"pk\", `a\"b`, 'c', \"c\" from `t` where \"c\" = 'three';",
+				Expected: []sql.Row{{1, 2, "c", "three"}},
+			},
+			{
+				Query:    `SET @@sql_mode='';`,
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    `select "pk", "c" from t where c = "three";`,
+				Expected: []sql.Row{{"pk", "c"}},
+			},
+		},
+	},
 	{
 		Name: "ANSI_QUOTES: views",
 		SetUpScript: []string{
@@ -720,6 +720,66 @@ var CharsetCollationEngineTests = []CharsetCollationEngineTest{
 			},
 		},
 	},
+	{
+		Name: "National strings and character set introducers",
+		Queries: []CharsetCollationEngineTestQuery{
+			{
+				Query: "SELECT N'héllo', n'wörld';",
+				Expected: []sql.Row{
+					{"héllo", "wörld"},
+				},
+			},
+			{
+				Query: "SELECT COLLATION(N'héllo'), LENGTH(N'héllo'), CHAR_LENGTH(N'héllo');",
+				Expected: []sql.Row{
+					{"utf8mb3_general_ci", int32(6), int32(5)},
+				},
+			},
+			{
+				Query: "SELECT _utf8mb4'héllo', COLLATION(_utf8mb4'héllo'), LENGTH(_utf8mb4'héllo');",
+				Expected: []sql.Row{
+					{"héllo", "utf8mb4_0900_ai_ci", int32(6)},
+				},
+			},
+			{
+				// each byte of the UTF-8 encoded string is read as its own latin1 character
+				Query: "SELECT _latin1'héllo', COLLATION(_latin1'héllo'), CHAR_LENGTH(_latin1'héllo');",
+				Expected: []sql.Row{
+					{"hÃ©llo", "latin1_swedish_ci", int32(6)},
+				},
+			},
+			{
+				Query: "SELECT N'héllo' = N'HÉLLO', N'héllo' = _utf8mb4'héllo' COLLATE utf8mb4_0900_bin;",
+				Expected: []sql.Row{
+					{true, true},
+				},
+			},
+			{
+				// the unicode collation wins when only one side is unicode
+				Query: "SELECT _utf8mb4'hello' = _latin1'hello', _utf8mb4'hello' COLLATE utf8mb4_0900_bin = _latin1'hello' COLLATE latin1_bin;",
+				Expected: []sql.Row{
+					{true, true},
+				},
+			},
+			{
+				// same coercibility, both unicode
+				Query:   "SELECT _utf8mb4'héllo' COLLATE utf8mb4_0900_ai_ci = N'héllo' COLLATE utf8mb3_bin;",
+				ErrKind: sql.ErrCollationIllegalMix,
+			},
+			{
+				// same coercibility, both not unicode
+				Query:   "SELECT _latin1'hello' COLLATE latin1_swedish_ci < _latin1'hello' COLLATE latin1_bin;",
+				ErrKind: sql.ErrCollationIllegalMix,
+			},
+			{
+				// a column named N is still a column, rather than a national string
+				Query: "SELECT N 'x' FROM (SELECT 'héllo' AS N) sq;",
+				Expected: []sql.Row{
+					{"héllo"},
+				},
+			},
+		},
+	},
 	{
 		Name: "LENGTH() function",
 		Queries: []CharsetCollationEngineTestQuery{
@@ -47,6 +47,31 @@ WHERE TABLE_SCHEMA = 'mydb' AND TABLE_NAME = 'checks' AND TC.TABLE_SCHEMA = CC.C
 			},
 		},
 	},
+	{
+		Name: "unnamed constraints are named after their table",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int PRIMARY KEY, b int, CHECK (b > 0), CONSTRAINT t_chk_4 CHECK (b < 10))",
+			"ALTER TABLE t ADD CHECK (a > 0)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SHOW CREATE TABLE t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `a` int NOT NULL,\n  `b` int,\n  PRIMARY KEY (`a`),\n  CONSTRAINT `t_chk_1` CHECK ((`b` > 0)),\n  CONSTRAINT `t_chk_4` CHECK ((`b` < 10)),\n  CONSTRAINT `t_chk_5` CHECK ((`a` > 0))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:            "ALTER TABLE t DROP CHECK t_chk_1",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "ALTER TABLE t DROP CONSTRAINT t_chk_5",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "SELECT CONSTRAINT_NAME FROM information_schema.CHECK_CONSTRAINTS WHERE CONSTRAINT_SCHEMA = 'mydb' AND CONSTRAINT_NAME LIKE 't\\_chk\\_%'",
+				Expected: []sql.Row{{"t_chk_4"}},
+			},
+		},
+	},
 	{
 		Name: "check statements in CREATE TABLE statements",
 		SetUpScript: []string{
@@ -418,6 +443,51 @@ var ChecksOnInsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "multi-row insert with check constraint violation",
+		SetUpScript: []string{
+			"CREATE TABLE t3 (a INTEGER PRIMARY KEY, b INTEGER, CONSTRAINT chk7 CHECK (b > 0), CONSTRAINT chk8 CHECK (b < 100) NOT ENFORCED)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO t3 VALUES (1,1), (2,-2), (3,3)",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				// the statement is rolled back as a whole
+				Query:    "SELECT * FROM t3",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "INSERT INTO t3 VALUES (1,1), (2,200), (3,3)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 3}}},
+			},
+			{
+				Query:    "INSERT IGNORE INTO t3 VALUES (4,4), (5,-5), (6,6)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2}}},
+			},
+			{
+				Query:    "SELECT * FROM t3 ORDER BY a",
+				Expected: []sql.Row{{1, 1}, {2, 200}, {3, 3}, {4, 4}, {6, 6}},
+			},
+			{
+				Query:       "INSERT INTO t3 VALUES (1,1) ON DUPLICATE KEY UPDATE b = -1",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:       "REPLACE INTO t3 VALUES (1,-1)",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:       "UPDATE t3 SET b = b - 3",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "SELECT * FROM t3 ORDER BY a",
+				Expected: []sql.Row{{1, 1}, {2, 200}, {3, 3}, {4, 4}, {6, 6}},
+			},
+		},
+	},
 	{
 		Name: "insert into table from table",
 		SetUpScript: []string{
@@ -535,6 +605,56 @@ var ChecksOnUpdateScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "multi-column checks and checks calling functions on update",
+		SetUpScript: []string{
+			"CREATE TABLE ranges (id INT PRIMARY KEY, lo INT, hi INT, code VARCHAR(10), " +
+				"CONSTRAINT range_chk CHECK (lo <= hi), " +
+				"CONSTRAINT code_chk CHECK (upper(code) = code AND length(code) >= 2))",
+			"INSERT INTO ranges VALUES (1, 1, 10, 'AB'), (2, 5, 5, 'CDE'), (3, NULL, 3, NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "UPDATE ranges SET lo = 11 WHERE id = 1",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				// the failing row keeps its old values
+				Query:    "SELECT * FROM ranges WHERE id = 1",
+				Expected: []sql.Row{{1, 1, 10, "AB"}},
+			},
+			{
+				// the row with id 1 is updated before the row with id 2 fails, and the whole statement is rolled back
+				Query:       "UPDATE ranges SET hi = hi - 5",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "SELECT * FROM ranges ORDER BY id",
+				Expected: []sql.Row{{1, 1, 10, "AB"}, {2, 5, 5, "CDE"}, {3, nil, 3, nil}},
+			},
+			{
+				Query:       "UPDATE ranges SET code = 'cd' WHERE id = 2",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:       "UPDATE ranges SET code = 'C' WHERE id = 2",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				// checks that evaluate to NULL pass
+				Query:    "UPDATE ranges SET lo = NULL, code = NULL WHERE id = 1",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "UPDATE ranges SET lo = 0, hi = 100, code = 'XYZ'",
+				Expected: []sql.Row{{newUpdateResult(3, 3)}},
+			},
+			{
+				Query:    "SELECT * FROM ranges ORDER BY id",
+				Expected: []sql.Row{{1, 0, 100, "XYZ"}, {2, 0, 100, "XYZ"}, {3, 0, 100, "XYZ"}},
+			},
+		},
+	},
 }
 
 var DisallowedCheckConstraintsScripts = []ScriptTest{
@@ -249,6 +249,43 @@ var ForeignKeyTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Unnamed FOREIGN KEY names are generated",
+		SetUpScript: []string{
+			"CREATE TABLE sibling (id int PRIMARY KEY, v1 int, v2 int, FOREIGN KEY (v1) REFERENCES parent(v1), CONSTRAINT sibling_ibfk_5 FOREIGN KEY (v2) REFERENCES parent(v2));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// unnamed foreign keys are numbered after the largest number of the generated names of the table
+				Query:    "ALTER TABLE sibling ADD FOREIGN KEY (id) REFERENCES parent(id);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE sibling;",
+				Expected: []sql.Row{{"sibling", "CREATE TABLE `sibling` (\n  `id` int NOT NULL,\n  `v1` int,\n  `v2` int,\n  PRIMARY KEY (`id`),\n  KEY `v1` (`v1`),\n  KEY `v2` (`v2`),\n  CONSTRAINT `sibling_ibfk_1` FOREIGN KEY (`v1`) REFERENCES `parent` (`v1`),\n  CONSTRAINT `sibling_ibfk_5` FOREIGN KEY (`v2`) REFERENCES `parent` (`v2`),\n  CONSTRAINT `sibling_ibfk_6` FOREIGN KEY (`id`) REFERENCES `parent` (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "ALTER TABLE sibling DROP FOREIGN KEY sibling_ibfk_1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER TABLE sibling DROP CONSTRAINT sibling_ibfk_6;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT constraint_name FROM information_schema.table_constraints WHERE table_name = 'sibling' AND constraint_type = 'FOREIGN KEY';",
+				Expected: []sql.Row{{"sibling_ibfk_5"}},
+			},
+			{
+				Query:    "ALTER TABLE child ADD FOREIGN KEY (v1) REFERENCES parent(v1);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT constraint_name FROM information_schema.table_constraints WHERE table_name = 'child' AND constraint_type = 'FOREIGN KEY';",
+				Expected: []sql.Row{{"child_ibfk_1"}},
+			},
+		},
+	},
 	{
 		Name: "ALTER TABLE SET NULL on non-nullable column",
 		SetUpScript: []string{
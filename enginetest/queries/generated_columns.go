@@ -764,6 +764,77 @@ var GeneratedColumnTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "generated columns referring to other generated columns",
+		SetUpScript: []string{
+			"create table t1 (a int primary key, b int as (a + 1) stored, c int as (a * 2) virtual)",
+			"create table t2 (a int primary key, c int as (b * 10) virtual, b int as (a + 1) virtual, d int as (c + b) stored, index idx_c (c))",
+			"insert into t1 (a) values (1), (2)",
+			"insert into t2 (a) values (1), (2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from t1 order by a",
+				Expected: []sql.Row{{1, 2, 2}, {2, 3, 4}},
+			},
+			{
+				Query:       "update t1 set c = 5",
+				ExpectedErr: sql.ErrGeneratedColumnValue,
+			},
+			{
+				Query:    "select * from t2 order by a",
+				Expected: []sql.Row{{1, 20, 2, 22}, {2, 30, 3, 33}},
+			},
+			{
+				Query:    "update t2 set a = 7 where a = 2",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "select * from t2 order by a",
+				Expected: []sql.Row{{1, 20, 2, 22}, {7, 80, 8, 88}},
+			},
+			{
+				Query:    "select * from t2 where c = 80",
+				Expected: []sql.Row{{7, 80, 8, 88}},
+			},
+			{
+				Query:    "select * from t2 where d = 22",
+				Expected: []sql.Row{{1, 20, 2, 22}},
+			},
+			{
+				Query:    "create index idx_d on t2 (d)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select a from t2 where d > 30 order by a",
+				Expected: []sql.Row{{7}},
+			},
+			{
+				Query:    "alter table t2 add column e int as (c + d) virtual first",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t2 order by a",
+				Expected: []sql.Row{{42, 1, 20, 2, 22}, {168, 7, 80, 8, 88}},
+			},
+			{
+				Query:       "create table t3 (a int primary key, b int as (b + 1))",
+				ExpectedErr: sql.ErrGeneratedColumnCycle,
+			},
+			{
+				Query:       "create table t3 (a int primary key, b int as (c + 1), c int as (b + 1) stored)",
+				ExpectedErr: sql.ErrGeneratedColumnCycle,
+			},
+			{
+				Query:       "alter table t2 modify column b int as (d + 1) virtual",
+				ExpectedErr: sql.ErrGeneratedColumnCycle,
+			},
+			{
+				Query:       "alter table t1 add column e int as (e + a)",
+				ExpectedErr: sql.ErrGeneratedColumnCycle,
+			},
+		},
+	},
 }
 
 var BrokenGeneratedColumnTests = []ScriptTest{
@@ -322,23 +322,23 @@ var InfoSchemaQueries = []QueryTest{
 	{
 		Query: `SHOW INDEXES FROM mytaBLE`,
 		Expected: []sql.Row{
-			{"mytable", 0, "PRIMARY", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 0, "mytable_s", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 2, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 2, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "PRIMARY", 1, "i", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "mytable_s", 1, "s", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 1, "i", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 2, "s", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 1, "s", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 2, "i", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
 		},
 	},
 	{
 		Query: `SHOW KEYS FROM mytaBLE`,
 		Expected: []sql.Row{
-			{"mytable", 0, "PRIMARY", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 0, "mytable_s", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 2, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "idx_si", 2, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "PRIMARY", 1, "i", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "mytable_s", 1, "s", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 1, "i", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 2, "s", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 1, "s", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "idx_si", 2, "i", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
 		},
 	},
 	{
@@ -537,8 +537,8 @@ var InfoSchemaQueries = []QueryTest{
 		Expected: []sql.Row{},
 	},
 	{
-		Query:    `SELECT * FROM information_schema.resource_groups`,
-		Expected: []sql.Row{},
+		Query:    `SELECT resource_group_name, resource_group_type, resource_group_enable, thread_priority FROM information_schema.resource_groups ORDER BY 1`,
+		Expected: []sql.Row{{"SYS_default", "SYSTEM", uint64(1), int32(0)}, {"USR_default", "USER", uint64(1), int32(0)}},
 	},
 	{
 		Query:    `SELECT * FROM information_schema.role_column_grants`,
@@ -561,8 +561,10 @@ var InfoSchemaQueries = []QueryTest{
 		Expected: []sql.Row{},
 	},
 	{
-		Query:    `SELECT * FROM information_schema.view_table_usage`,
-		Expected: []sql.Row{},
+		Query: `SELECT * FROM information_schema.view_table_usage`,
+		Expected: []sql.Row{
+			{"def", "mydb", "myview", "def", "mydb", "mytable"},
+		},
 	},
 	{
 		Query:    `SELECT * from information_schema.innodb_buffer_page`,
@@ -776,6 +778,85 @@ var SkippedInfoSchemaQueries = []QueryTest{
 }
 
 var InfoSchemaScripts = []ScriptTest{
+	{
+		Name: "information_schema.optimizer_trace",
+		SetUpScript: []string{
+			"create table xy (x int primary key, y int, index y_idx (y));",
+			"create table uv (u int primary key, v int);",
+			"insert into xy values (1, 1), (2, 2), (3, 3);",
+			"insert into uv values (1, 1), (2, 2), (3, 3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// tracing is disabled by default
+				Query:    "select * from xy where y = 2",
+				Expected: []sql.Row{{2, 2}},
+			},
+			{
+				Query:    "select count(*) from information_schema.optimizer_trace",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "set optimizer_trace = 'enabled=on'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select u, y from xy join uv on x = u where y = 2",
+				Expected: []sql.Row{{2, 2}},
+			},
+			{
+				Query: "select query, trace like '%y_idx%', trace like '%\"chosen\": true%', missing_bytes_beyond_max_mem_size from information_schema.optimizer_trace",
+				Expected: []sql.Row{
+					{"select u, y from xy join uv on x = u where y = 2", true, true, 0},
+				},
+			},
+			{
+				Query:    "select json_valid(trace), json_length(trace, '$.steps') > 0, trace like '%\"rule_application\"%' from information_schema.optimizer_trace",
+				Expected: []sql.Row{{true, true, true}},
+			},
+			{
+				Query:    "select json_extract(trace, '$.steps[*].index_selection.table'), json_extract(trace, '$.steps[*].index_selection.considered_indexes[*].index'), json_extract(trace, '$.steps[*].index_selection.considered_indexes[*].chosen') from information_schema.optimizer_trace",
+				Expected: []sql.Row{{types.MustJSON(`["xy"]`), types.MustJSON(`[["PRIMARY", "y_idx"]]`), types.MustJSON(`[[false, true]]`)}},
+			},
+			{
+				// only the most recent trace is kept by default
+				Query:    "select x from xy where x = 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select query from information_schema.optimizer_trace",
+				Expected: []sql.Row{{"select x from xy where x = 1"}},
+			},
+			{
+				Query:    "set optimizer_trace_offset = -2, optimizer_trace_limit = 2",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select x from xy where x = 2",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select x from xy where x = 3",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select query from information_schema.optimizer_trace",
+				Expected: []sql.Row{{"select x from xy where x = 2"}, {"select x from xy where x = 3"}},
+			},
+			{
+				Query:    "set optimizer_trace_offset = -1, optimizer_trace_limit = 1, optimizer_trace_max_mem_size = 10",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select x from xy where x = 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select length(trace), missing_bytes_beyond_max_mem_size > 0 from information_schema.optimizer_trace",
+				Expected: []sql.Row{{10, true}},
+			},
+		},
+	},
 	{
 		Name: "foreign key that references dropped table",
 		SetUpScript: []string{
@@ -987,8 +1068,8 @@ FROM INFORMATION_SCHEMA.TRIGGERS WHERE trigger_schema = 'mydb'`,
 			{
 				Query: "SELECT * FROM information_schema.statistics where table_name='t'",
 				Expected: []sql.Row{
-					{"def", "mydb", "t", 1, "mydb", "myindex", 1, "test_score", "A", 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
-					{"def", "mydb", "t", 0, "mydb", "PRIMARY", 1, "pk", "A", 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"def", "mydb", "t", 1, "mydb", "myindex", 1, "test_score", "A", nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"def", "mydb", "t", 0, "mydb", "PRIMARY", 1, "pk", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
 				},
 			},
 		},
@@ -1123,7 +1204,7 @@ FROM INFORMATION_SCHEMA.TRIGGERS WHERE trigger_schema = 'mydb'`,
 				Query: "SELECT * FROM INFORMATION_SCHEMA.COLUMNS WHERE table_schema = 'foo'",
 				Expected: []sql.Row{
 					{"def", "foo", "t", "i", uint32(1), nil, "YES", "int", nil, nil, int64(10), int64(0), nil, nil, nil, "int", "", "", "insert,references,select,update", "", "", nil},
-					{"def", "foo", "v", "", uint32(0), nil, "", nil, nil, nil, nil, nil, nil, "", "", "", "", "", "select", "", "", nil},
+					{"def", "foo", "v", "i", uint32(1), nil, "YES", "int", nil, nil, int64(10), int64(0), nil, nil, nil, "int", "", "", "insert,references,select,update", "", "", nil},
 				},
 			},
 		},
@@ -1236,10 +1317,16 @@ FROM INFORMATION_SCHEMA.TRIGGERS WHERE trigger_schema = 'mydb'`,
 				},
 			},
 			{
-				// TODO: cardinality not supported
-				Skip:     true,
 				Query:    `select cardinality from information_schema.statistics where table_schema = 'mydb' and table_name = 'ptable' ORDER BY INDEX_NAME`,
-				Expected: []sql.Row{{2}, {2}, {2}, {2}, {2}},
+				Expected: []sql.Row{{nil}, {nil}, {nil}, {nil}, {nil}},
+			},
+			{
+				Query:    `analyze table ptable`,
+				Expected: []sql.Row{{"ptable", "analyze", "status", "OK"}},
+			},
+			{
+				Query:    `select cardinality from information_schema.statistics where table_schema = 'mydb' and table_name = 'ptable' ORDER BY INDEX_NAME, SEQ_IN_INDEX`,
+				Expected: []sql.Row{{3}, {3}, {3}, {3}, {3}},
 			},
 			{
 				Query: `SELECT seq_in_index, sub_part, index_name, index_type, CASE non_unique WHEN 0 THEN 'TRUE' ELSE 'FALSE' END AS is_unique, column_name
@@ -1500,6 +1587,56 @@ WHERE TABLE_SCHEMA = 'mydb' AND TABLE_NAME = 'checks' AND TC.TABLE_SCHEMA = CC.C
 			},
 		},
 	},
+	{
+		Name: "information_schema.table_constraints and check_constraints list every constraint type",
+		SetUpScript: []string{
+			"create table parent (id int primary key)",
+			"create table child (id int primary key, pid int, u int unique, v int, constraint uk_v unique (v), constraint fk_parent foreign key (pid) references parent(id), constraint ck_v check (v > 0) not enforced, check (u < 100))",
+			"create table keyless (a int, b int unique)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select * from information_schema.table_constraints where table_schema = 'mydb' and table_name = 'child' order by constraint_type, constraint_name",
+				Expected: []sql.Row{
+					{"def", "mydb", "child_chk_1", "mydb", "child", "CHECK", "YES"},
+					{"def", "mydb", "ck_v", "mydb", "child", "CHECK", "NO"},
+					{"def", "mydb", "fk_parent", "mydb", "child", "FOREIGN KEY", "YES"},
+					{"def", "mydb", "PRIMARY", "mydb", "child", "PRIMARY KEY", "YES"},
+					{"def", "mydb", "u", "mydb", "child", "UNIQUE", "YES"},
+					{"def", "mydb", "uk_v", "mydb", "child", "UNIQUE", "YES"},
+				},
+			},
+			{
+				Query: "select constraint_name, check_clause from information_schema.check_constraints where constraint_schema = 'mydb' order by constraint_name",
+				Expected: []sql.Row{
+					{"child_chk_1", "(u < 100)"},
+					{"ck_v", "(v > 0)"},
+				},
+			},
+			{
+				Query: "select constraint_name, constraint_type from information_schema.table_constraints where table_schema = 'mydb' and table_name = 'keyless'",
+				Expected: []sql.Row{
+					{"b", "UNIQUE"},
+				},
+			},
+			{
+				Query:    "alter table child drop constraint ck_v",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "select constraint_name, enforced from information_schema.table_constraints where table_schema = 'mydb' and table_name = 'child' and constraint_type = 'CHECK'",
+				Expected: []sql.Row{
+					{"child_chk_1", "YES"},
+				},
+			},
+			{
+				Query: "select constraint_name from information_schema.check_constraints where constraint_schema = 'mydb'",
+				Expected: []sql.Row{
+					{"child_chk_1"},
+				},
+			},
+		},
+	},
 	{
 		Name: "column specific tests on information_schema.routines table",
 		SetUpScript: []string{
@@ -1580,6 +1717,32 @@ from information_schema.routines where routine_schema = 'mydb' and routine_type
 			},
 		},
 	},
+	{
+		Name: "information_schema.view_table_usage lists the tables each view uses",
+		SetUpScript: []string{
+			"create table customers (id int primary key, name varchar(20))",
+			"create table orders (id int primary key, customer_id int, total int)",
+			"create view customer_orders as select c.name, o.total from customers c join orders o on c.id = o.customer_id",
+			"create view big_spenders as with t as (select * from customer_orders) select name from t where total > (select avg(total) from mydb.orders)",
+			"create view large_orders as select id, total from orders where total > 100 with check option",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select * from information_schema.view_table_usage where view_name in ('customer_orders', 'big_spenders', 'large_orders') order by view_name, table_name",
+				Expected: []sql.Row{
+					{"def", "mydb", "big_spenders", "def", "mydb", "customer_orders"},
+					{"def", "mydb", "big_spenders", "def", "mydb", "orders"},
+					{"def", "mydb", "customer_orders", "def", "mydb", "customers"},
+					{"def", "mydb", "customer_orders", "def", "mydb", "orders"},
+					{"def", "mydb", "large_orders", "def", "mydb", "orders"},
+				},
+			},
+			{
+				Query:    "select table_name, specific_schema, specific_name from information_schema.view_routine_usage",
+				Expected: []sql.Row{},
+			},
+		},
+	},
 	{
 		Name: "information_schema.schemata shows all column values",
 		SetUpScript: []string{
@@ -2049,6 +2049,126 @@ var InsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "out of range numeric values in strict mode",
+		SetUpScript: []string{
+			"create table t (pk int primary key, i8 tinyint, u8 tinyint unsigned, u64 bigint unsigned, d decimal(4,2), f float);",
+			"insert into t values (1, 0, 0, 0, 0, 0);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "insert into t (pk, i8) values (2, 300);",
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
+			},
+			{
+				Query:       "insert into t (pk, i8) values (2, 1), (3, -129);",
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
+			},
+			{
+				Query:          "insert into t (pk, u8) values (2, -1);",
+				ExpectedErrStr: "Out of range value for column 'u8' at row 1",
+			},
+			{
+				Query:       "insert into t (pk, u64) values (2, -5);",
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
+			},
+			{
+				Query:       "insert into t (pk, d) values (2, 100);",
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
+			},
+			{
+				Query:       "insert into t (pk, f) values (2, 1e40);",
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
+			},
+			{
+				Query:       "update t set i8 = i8 - 200;",
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
+			},
+			{
+				Query:       "insert into t (pk) values (1) on duplicate key update u8 = 256;",
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
+			},
+			{
+				Query:            "start transaction;",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "insert into t (pk) values (2);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "insert into t (pk, i8) values (3, 1), (4, 128);",
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
+			},
+			{
+				Query:            "commit;",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, 0, uint64(0), uint64(0), "0.00", 0.0}, {2, nil, nil, nil, nil, nil}},
+			},
+			{
+				Query:                           "insert ignore into t (pk, i8, u8) values (5, 300, -1);",
+				Expected:                        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningsCount:           2,
+				ExpectedWarningMessageSubstring: "Out of range value for column",
+			},
+			{
+				Query:    "select pk, i8, u8 from t where pk = 5;",
+				Expected: []sql.Row{{5, 127, uint64(0)}},
+			},
+		},
+	},
+	{
+		Name: "out of range numeric values without strict mode are clamped",
+		SetUpScript: []string{
+			"set sql_mode = 'NO_ENGINE_SUBSTITUTION';",
+			"create table t (pk int primary key, i8 tinyint, u8 tinyint unsigned, i64 bigint, u64 bigint unsigned, d decimal(4,2), f float, db double);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:                           "insert into t values (1, 300, 300, 1e20, 1e20, 100, 1e40, 1e308);",
+				Expected:                        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningsCount:           6,
+				ExpectedWarningMessageSubstring: "at row 1",
+			},
+			{
+				Query:                           "insert into t values (2, -300, -1, -1e20, -5, -100, -1e40, -1e308);",
+				Expected:                        []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningsCount:           6,
+				ExpectedWarningMessageSubstring: "Out of range value for column",
+			},
+			{
+				Query: "select * from t order by pk;",
+				Expected: []sql.Row{
+					{1, 127, uint64(255), int64(math.MaxInt64), uint64(math.MaxUint64), "99.99", float32(math.MaxFloat32), 1e308},
+					{2, -128, uint64(0), int64(math.MinInt64), uint64(0), "-99.99", float32(-math.MaxFloat32), -1e308},
+				},
+			},
+			{
+				Query:                           "update t set i8 = i8 * 2, u8 = u8 + 1 where pk = 1;",
+				Expected:                        []sql.Row{{newUpdateResult(1, 0)}},
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningsCount:           2,
+				ExpectedWarningMessageSubstring: "Out of range value for column",
+			},
+			{
+				Query:                           "insert into t (pk) values (2) on duplicate key update i8 = -1000;",
+				Expected:                        []sql.Row{{types.NewOkResult(0)}},
+				ExpectedWarning:                 mysql.ERWarnDataOutOfRange,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Out of range value for column 'i8' at row 1",
+			},
+			{
+				Query:    "select pk, i8, u8 from t order by pk;",
+				Expected: []sql.Row{{1, 127, uint64(255)}, {2, -128, uint64(0)}},
+			},
+		},
+	},
 }
 
 var InsertDuplicateKeyKeyless = []ScriptTest{
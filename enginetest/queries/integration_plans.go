@@ -4476,10 +4476,7 @@ WHERE
 	   ) AOEV5`,
 		ExpectedPlan: "Union all\n" +
 			" ├─ Project\n" +
-			" │   ├─ columns: [convert\n" +
-			" │   │   ├─ type: char\n" +
-			" │   │   └─ T4IBQ:0!null\n" +
-			" │   │   as T4IBQ, DL754:1!null, BDNYB:2!null, ADURZ:3!null, TPXBU:4, NO52D:5!null, IDPK7:6!null]\n" +
+			" │   ├─ columns: [coerce(T4IBQ:0!null, longtext) as T4IBQ, DL754:1!null, BDNYB:2!null, ADURZ:3!null, TPXBU:4, NO52D:5!null, IDPK7:6!null]\n" +
 			" │   └─ Project\n" +
 			" │       ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sl3s5.TOFPN:62!null as DL754, sn.id:51!null as BDNYB, sl3s5.ADURZ:64!null as ADURZ, Subquery\n" +
 			" │       │   ├─ cacheable: false\n" +
@@ -4660,182 +4657,178 @@ WHERE
 			" │                               ├─ name: YK2GW\n" +
 			" │                               └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [aoev5.t4ibq:0!null as t4ibq, vumuy.DL754:1!null, vumuy.BDNYB:2!null, vumuy.ADURZ:3!null, vumuy.TPXBU:4, vumuy.NO52D:5!null, vumuy.IDPK7:6!null]\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [aoev5.t4ibq:6!null, vumuy.DL754:0!null, vumuy.BDNYB:1!null, vumuy.ADURZ:2!null, vumuy.TPXBU:3, vumuy.NO52D:4!null, vumuy.IDPK7:5!null]\n" +
-			"         └─ CrossHashJoin\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: vumuy\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ isLateral: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   ├─ colSet: (207-212)\n" +
-			"             │   ├─ tableId: 21\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [sl3s5.TOFPN:11!null as DL754, sn.id:0!null as BDNYB, sl3s5.ADURZ:13!null as ADURZ, Subquery\n" +
-			"             │       │   ├─ cacheable: false\n" +
-			"             │       │   ├─ alias-string: select aac.BTXC5 from TPXBU as aac where aac.id = SL3S5.M22QN\n" +
-			"             │       │   └─ Project\n" +
-			"             │       │       ├─ columns: [aac.BTXC5:23]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ aac.id:22!null\n" +
-			"             │       │           │   └─ sl3s5.M22QN:12!null\n" +
-			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.id]\n" +
-			"             │       │                   ├─ keys: [sl3s5.M22QN:12!null]\n" +
-			"             │       │                   ├─ colSet: (201-203)\n" +
-			"             │       │                   ├─ tableId: 20\n" +
-			"             │       │                   └─ Table\n" +
-			"             │       │                       ├─ name: TPXBU\n" +
-			"             │       │                       └─ columns: [id btxc5]\n" +
-			"             │       │   as TPXBU, sl3s5.NO52D:14!null as NO52D, sl3s5.IDPK7:15!null as IDPK7]\n" +
-			"             │       └─ Project\n" +
-			"             │           ├─ columns: [sn.id:6!null, sn.BRQP2:7!null, sn.FFTBJ:8!null, sn.A7XO2:9, sn.KBO7R:10!null, sn.ECDKM:11, sn.NUMK2:12!null, sn.LETOE:13!null, sn.YKSSU:14, sn.FHCYT:15, sl3s5.BDNYB:0!null, sl3s5.TOFPN:1!null, sl3s5.M22QN:2!null, sl3s5.ADURZ:3!null, sl3s5.NO52D:4!null, sl3s5.IDPK7:5!null, sl3s5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, sl3s5.ADURZ:3!null as ADURZ, Subquery\n" +
-			"             │           │   ├─ cacheable: false\n" +
-			"             │           │   ├─ alias-string: select aac.BTXC5 from TPXBU as aac where aac.id = SL3S5.M22QN\n" +
-			"             │           │   └─ Project\n" +
-			"             │           │       ├─ columns: [aac.BTXC5:17]\n" +
-			"             │           │       └─ Filter\n" +
-			"             │           │           ├─ Eq\n" +
-			"             │           │           │   ├─ aac.id:16!null\n" +
-			"             │           │           │   └─ sl3s5.M22QN:2!null\n" +
-			"             │           │           └─ TableAlias(aac)\n" +
-			"             │           │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │           │                   ├─ index: [TPXBU.id]\n" +
-			"             │           │                   ├─ keys: [sl3s5.M22QN:2!null]\n" +
-			"             │           │                   ├─ colSet: (201-203)\n" +
-			"             │           │                   ├─ tableId: 20\n" +
-			"             │           │                   └─ Table\n" +
-			"             │           │                       ├─ name: TPXBU\n" +
-			"             │           │                       └─ columns: [id btxc5]\n" +
-			"             │           │   as TPXBU, sl3s5.NO52D:4!null as NO52D, sl3s5.IDPK7:5!null as IDPK7]\n" +
-			"             │           └─ LookupJoin\n" +
-			"             │               ├─ SubqueryAlias\n" +
-			"             │               │   ├─ name: sl3s5\n" +
-			"             │               │   ├─ outerVisibility: false\n" +
-			"             │               │   ├─ isLateral: false\n" +
-			"             │               │   ├─ cacheable: true\n" +
-			"             │               │   ├─ colSet: (192-197)\n" +
-			"             │               │   ├─ tableId: 19\n" +
-			"             │               │   └─ Project\n" +
-			"             │               │       ├─ columns: [sn.id:0!null as BDNYB, ci.FTQLQ:23!null as TOFPN, ct.M22QN:13!null as M22QN, cec.ADURZ:26!null as ADURZ, cec.NO52D:25!null as NO52D, ct.S3Q3Y:19!null as IDPK7]\n" +
-			"             │               │       └─ HashJoin\n" +
-			"             │               │           ├─ Eq\n" +
-			"             │               │           │   ├─ cec.id:24!null\n" +
-			"             │               │           │   └─ ct.OVE3E:14!null\n" +
-			"             │               │           ├─ HashJoin\n" +
-			"             │               │           │   ├─ Eq\n" +
-			"             │               │           │   │   ├─ ci.id:22!null\n" +
-			"             │               │           │   │   └─ ct.FZ2R5:11!null\n" +
-			"             │               │           │   ├─ MergeJoin\n" +
-			"             │               │           │   │   ├─ cmp: Eq\n" +
-			"             │               │           │   │   │   ├─ sn.BRQP2:1!null\n" +
-			"             │               │           │   │   │   └─ ct.LUEVY:12!null\n" +
-			"             │               │           │   │   ├─ sel: Eq\n" +
-			"             │               │           │   │   │   ├─ ct.M22QN:13!null\n" +
-			"             │               │           │   │   │   └─ Subquery\n" +
-			"             │               │           │   │   │       ├─ cacheable: true\n" +
-			"             │               │           │   │   │       ├─ alias-string: select aac.id from TPXBU as aac where BTXC5 = 'WT'\n" +
-			"             │               │           │   │   │       └─ Project\n" +
-			"             │               │           │   │   │           ├─ columns: [aac.id:22!null]\n" +
-			"             │               │           │   │   │           └─ Filter\n" +
-			"             │               │           │   │   │               ├─ Eq\n" +
-			"             │               │           │   │   │               │   ├─ aac.BTXC5:23\n" +
-			"             │               │           │   │   │               │   └─ WT (longtext)\n" +
-			"             │               │           │   │   │               └─ TableAlias(aac)\n" +
-			"             │               │           │   │   │                   └─ IndexedTableAccess(TPXBU)\n" +
-			"             │               │           │   │   │                       ├─ index: [TPXBU.BTXC5]\n" +
-			"             │               │           │   │   │                       ├─ static: [{[WT, WT]}]\n" +
-			"             │               │           │   │   │                       ├─ colSet: (172-174)\n" +
-			"             │               │           │   │   │                       ├─ tableId: 16\n" +
-			"             │               │           │   │   │                       └─ Table\n" +
-			"             │               │           │   │   │                           ├─ name: TPXBU\n" +
-			"             │               │           │   │   │                           └─ columns: [id btxc5]\n" +
-			"             │               │           │   │   ├─ TableAlias(sn)\n" +
-			"             │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │               │           │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"             │               │           │   │   │       ├─ colSet: (150-159)\n" +
-			"             │               │           │   │   │       ├─ tableId: 14\n" +
-			"             │               │           │   │   │       └─ Table\n" +
-			"             │               │           │   │   │           ├─ name: NOXN3\n" +
-			"             │               │           │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             │               │           │   │   └─ Filter\n" +
-			"             │               │           │   │       ├─ Eq\n" +
-			"             │               │           │   │       │   ├─ ct.ZRV3B:10!null\n" +
-			"             │               │           │   │       │   └─ = (longtext)\n" +
-			"             │               │           │   │       └─ TableAlias(ct)\n" +
-			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			"             │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
-			"             │               │           │   │               ├─ static: [{[NULL, ∞)}]\n" +
-			"             │               │           │   │               ├─ colSet: (160-171)\n" +
-			"             │               │           │   │               ├─ tableId: 15\n" +
-			"             │               │           │   │               └─ Table\n" +
-			"             │               │           │   │                   ├─ name: FLQLP\n" +
-			"             │               │           │   │                   └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
-			"             │               │           │   └─ HashLookup\n" +
-			"             │               │           │       ├─ left-key: TUPLE(ct.FZ2R5:11!null)\n" +
-			"             │               │           │       ├─ right-key: TUPLE(ci.id:0!null)\n" +
-			"             │               │           │       └─ Filter\n" +
-			"             │               │           │           ├─ HashIn\n" +
-			"             │               │           │           │   ├─ ci.FTQLQ:1!null\n" +
-			"             │               │           │           │   └─ TUPLE(SQ1 (longtext))\n" +
-			"             │               │           │           └─ TableAlias(ci)\n" +
-			"             │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
-			"             │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
-			"             │               │           │                   ├─ static: [{[SQ1, SQ1]}]\n" +
-			"             │               │           │                   ├─ colSet: (175-179)\n" +
-			"             │               │           │                   ├─ tableId: 17\n" +
-			"             │               │           │                   └─ Table\n" +
-			"             │               │           │                       ├─ name: JDLNA\n" +
-			"             │               │           │                       └─ columns: [id ftqlq]\n" +
-			"             │               │           └─ HashLookup\n" +
-			"             │               │               ├─ left-key: TUPLE(ct.OVE3E:14!null)\n" +
-			"             │               │               ├─ right-key: TUPLE(cec.id:0!null)\n" +
-			"             │               │               └─ TableAlias(cec)\n" +
-			"             │               │                   └─ Table\n" +
-			"             │               │                       ├─ name: SFEGG\n" +
-			"             │               │                       ├─ columns: [id no52d adurz]\n" +
-			"             │               │                       ├─ colSet: (180-185)\n" +
-			"             │               │                       └─ tableId: 18\n" +
-			"             │               └─ TableAlias(sn)\n" +
-			"             │                   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       ├─ index: [NOXN3.id]\n" +
-			"             │                       ├─ keys: [sl3s5.BDNYB:0!null]\n" +
-			"             │                       ├─ colSet: (140-149)\n" +
-			"             │                       ├─ tableId: 13\n" +
-			"             │                       └─ Table\n" +
-			"             │                           ├─ name: NOXN3\n" +
-			"             │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             └─ HashLookup\n" +
-			"                 ├─ left-key: TUPLE()\n" +
-			"                 ├─ right-key: TUPLE()\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: aoev5\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     ├─ colSet: (214)\n" +
-			"                     ├─ tableId: 23\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [temp_aoev5.t4ibq:0!null]\n" +
-			"                         └─ Values() as temp_AOEV5\n" +
-			"                             ├─ Row(\n" +
-			"                             │  1 (longtext))\n" +
-			"                             ├─ Row(\n" +
-			"                             │  2 (longtext))\n" +
-			"                             ├─ Row(\n" +
-			"                             │  3 (longtext))\n" +
-			"                             ├─ Row(\n" +
-			"                             │  4 (longtext))\n" +
-			"                             └─ Row(\n" +
-			"                                5 (longtext))\n" +
+			"     ├─ columns: [aoev5.T4IBQ:6!null, vumuy.DL754:0!null, vumuy.BDNYB:1!null, vumuy.ADURZ:2!null, vumuy.TPXBU:3, vumuy.NO52D:4!null, vumuy.IDPK7:5!null]\n" +
+			"     └─ CrossHashJoin\n" +
+			"         ├─ SubqueryAlias\n" +
+			"         │   ├─ name: vumuy\n" +
+			"         │   ├─ outerVisibility: false\n" +
+			"         │   ├─ isLateral: false\n" +
+			"         │   ├─ cacheable: true\n" +
+			"         │   ├─ colSet: (207-212)\n" +
+			"         │   ├─ tableId: 21\n" +
+			"         │   └─ Project\n" +
+			"         │       ├─ columns: [sl3s5.TOFPN:11!null as DL754, sn.id:0!null as BDNYB, sl3s5.ADURZ:13!null as ADURZ, Subquery\n" +
+			"         │       │   ├─ cacheable: false\n" +
+			"         │       │   ├─ alias-string: select aac.BTXC5 from TPXBU as aac where aac.id = SL3S5.M22QN\n" +
+			"         │       │   └─ Project\n" +
+			"         │       │       ├─ columns: [aac.BTXC5:23]\n" +
+			"         │       │       └─ Filter\n" +
+			"         │       │           ├─ Eq\n" +
+			"         │       │           │   ├─ aac.id:22!null\n" +
+			"         │       │           │   └─ sl3s5.M22QN:12!null\n" +
+			"         │       │           └─ TableAlias(aac)\n" +
+			"         │       │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │       │                   ├─ index: [TPXBU.id]\n" +
+			"         │       │                   ├─ keys: [sl3s5.M22QN:12!null]\n" +
+			"         │       │                   ├─ colSet: (201-203)\n" +
+			"         │       │                   ├─ tableId: 20\n" +
+			"         │       │                   └─ Table\n" +
+			"         │       │                       ├─ name: TPXBU\n" +
+			"         │       │                       └─ columns: [id btxc5]\n" +
+			"         │       │   as TPXBU, sl3s5.NO52D:14!null as NO52D, sl3s5.IDPK7:15!null as IDPK7]\n" +
+			"         │       └─ Project\n" +
+			"         │           ├─ columns: [sn.id:6!null, sn.BRQP2:7!null, sn.FFTBJ:8!null, sn.A7XO2:9, sn.KBO7R:10!null, sn.ECDKM:11, sn.NUMK2:12!null, sn.LETOE:13!null, sn.YKSSU:14, sn.FHCYT:15, sl3s5.BDNYB:0!null, sl3s5.TOFPN:1!null, sl3s5.M22QN:2!null, sl3s5.ADURZ:3!null, sl3s5.NO52D:4!null, sl3s5.IDPK7:5!null, sl3s5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, sl3s5.ADURZ:3!null as ADURZ, Subquery\n" +
+			"         │           │   ├─ cacheable: false\n" +
+			"         │           │   ├─ alias-string: select aac.BTXC5 from TPXBU as aac where aac.id = SL3S5.M22QN\n" +
+			"         │           │   └─ Project\n" +
+			"         │           │       ├─ columns: [aac.BTXC5:17]\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ Eq\n" +
+			"         │           │           │   ├─ aac.id:16!null\n" +
+			"         │           │           │   └─ sl3s5.M22QN:2!null\n" +
+			"         │           │           └─ TableAlias(aac)\n" +
+			"         │           │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │           │                   ├─ index: [TPXBU.id]\n" +
+			"         │           │                   ├─ keys: [sl3s5.M22QN:2!null]\n" +
+			"         │           │                   ├─ colSet: (201-203)\n" +
+			"         │           │                   ├─ tableId: 20\n" +
+			"         │           │                   └─ Table\n" +
+			"         │           │                       ├─ name: TPXBU\n" +
+			"         │           │                       └─ columns: [id btxc5]\n" +
+			"         │           │   as TPXBU, sl3s5.NO52D:4!null as NO52D, sl3s5.IDPK7:5!null as IDPK7]\n" +
+			"         │           └─ LookupJoin\n" +
+			"         │               ├─ SubqueryAlias\n" +
+			"         │               │   ├─ name: sl3s5\n" +
+			"         │               │   ├─ outerVisibility: false\n" +
+			"         │               │   ├─ isLateral: false\n" +
+			"         │               │   ├─ cacheable: true\n" +
+			"         │               │   ├─ colSet: (192-197)\n" +
+			"         │               │   ├─ tableId: 19\n" +
+			"         │               │   └─ Project\n" +
+			"         │               │       ├─ columns: [sn.id:0!null as BDNYB, ci.FTQLQ:23!null as TOFPN, ct.M22QN:13!null as M22QN, cec.ADURZ:26!null as ADURZ, cec.NO52D:25!null as NO52D, ct.S3Q3Y:19!null as IDPK7]\n" +
+			"         │               │       └─ HashJoin\n" +
+			"         │               │           ├─ Eq\n" +
+			"         │               │           │   ├─ cec.id:24!null\n" +
+			"         │               │           │   └─ ct.OVE3E:14!null\n" +
+			"         │               │           ├─ HashJoin\n" +
+			"         │               │           │   ├─ Eq\n" +
+			"         │               │           │   │   ├─ ci.id:22!null\n" +
+			"         │               │           │   │   └─ ct.FZ2R5:11!null\n" +
+			"         │               │           │   ├─ MergeJoin\n" +
+			"         │               │           │   │   ├─ cmp: Eq\n" +
+			"         │               │           │   │   │   ├─ sn.BRQP2:1!null\n" +
+			"         │               │           │   │   │   └─ ct.LUEVY:12!null\n" +
+			"         │               │           │   │   ├─ sel: Eq\n" +
+			"         │               │           │   │   │   ├─ ct.M22QN:13!null\n" +
+			"         │               │           │   │   │   └─ Subquery\n" +
+			"         │               │           │   │   │       ├─ cacheable: true\n" +
+			"         │               │           │   │   │       ├─ alias-string: select aac.id from TPXBU as aac where BTXC5 = 'WT'\n" +
+			"         │               │           │   │   │       └─ Project\n" +
+			"         │               │           │   │   │           ├─ columns: [aac.id:22!null]\n" +
+			"         │               │           │   │   │           └─ Filter\n" +
+			"         │               │           │   │   │               ├─ Eq\n" +
+			"         │               │           │   │   │               │   ├─ aac.BTXC5:23\n" +
+			"         │               │           │   │   │               │   └─ WT (longtext)\n" +
+			"         │               │           │   │   │               └─ TableAlias(aac)\n" +
+			"         │               │           │   │   │                   └─ IndexedTableAccess(TPXBU)\n" +
+			"         │               │           │   │   │                       ├─ index: [TPXBU.BTXC5]\n" +
+			"         │               │           │   │   │                       ├─ static: [{[WT, WT]}]\n" +
+			"         │               │           │   │   │                       ├─ colSet: (172-174)\n" +
+			"         │               │           │   │   │                       ├─ tableId: 16\n" +
+			"         │               │           │   │   │                       └─ Table\n" +
+			"         │               │           │   │   │                           ├─ name: TPXBU\n" +
+			"         │               │           │   │   │                           └─ columns: [id btxc5]\n" +
+			"         │               │           │   │   ├─ TableAlias(sn)\n" +
+			"         │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"         │               │           │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"         │               │           │   │   │       ├─ colSet: (150-159)\n" +
+			"         │               │           │   │   │       ├─ tableId: 14\n" +
+			"         │               │           │   │   │       └─ Table\n" +
+			"         │               │           │   │   │           ├─ name: NOXN3\n" +
+			"         │               │           │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         │               │           │   │   └─ Filter\n" +
+			"         │               │           │   │       ├─ Eq\n" +
+			"         │               │           │   │       │   ├─ ct.ZRV3B:10!null\n" +
+			"         │               │           │   │       │   └─ = (longtext)\n" +
+			"         │               │           │   │       └─ TableAlias(ct)\n" +
+			"         │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
+			"         │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
+			"         │               │           │   │               ├─ static: [{[NULL, ∞)}]\n" +
+			"         │               │           │   │               ├─ colSet: (160-171)\n" +
+			"         │               │           │   │               ├─ tableId: 15\n" +
+			"         │               │           │   │               └─ Table\n" +
+			"         │               │           │   │                   ├─ name: FLQLP\n" +
+			"         │               │           │   │                   └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"         │               │           │   └─ HashLookup\n" +
+			"         │               │           │       ├─ left-key: TUPLE(ct.FZ2R5:11!null)\n" +
+			"         │               │           │       ├─ right-key: TUPLE(ci.id:0!null)\n" +
+			"         │               │           │       └─ Filter\n" +
+			"         │               │           │           ├─ HashIn\n" +
+			"         │               │           │           │   ├─ ci.FTQLQ:1!null\n" +
+			"         │               │           │           │   └─ TUPLE(SQ1 (longtext))\n" +
+			"         │               │           │           └─ TableAlias(ci)\n" +
+			"         │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
+			"         │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
+			"         │               │           │                   ├─ static: [{[SQ1, SQ1]}]\n" +
+			"         │               │           │                   ├─ colSet: (175-179)\n" +
+			"         │               │           │                   ├─ tableId: 17\n" +
+			"         │               │           │                   └─ Table\n" +
+			"         │               │           │                       ├─ name: JDLNA\n" +
+			"         │               │           │                       └─ columns: [id ftqlq]\n" +
+			"         │               │           └─ HashLookup\n" +
+			"         │               │               ├─ left-key: TUPLE(ct.OVE3E:14!null)\n" +
+			"         │               │               ├─ right-key: TUPLE(cec.id:0!null)\n" +
+			"         │               │               └─ TableAlias(cec)\n" +
+			"         │               │                   └─ Table\n" +
+			"         │               │                       ├─ name: SFEGG\n" +
+			"         │               │                       ├─ columns: [id no52d adurz]\n" +
+			"         │               │                       ├─ colSet: (180-185)\n" +
+			"         │               │                       └─ tableId: 18\n" +
+			"         │               └─ TableAlias(sn)\n" +
+			"         │                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │                       ├─ index: [NOXN3.id]\n" +
+			"         │                       ├─ keys: [sl3s5.BDNYB:0!null]\n" +
+			"         │                       ├─ colSet: (140-149)\n" +
+			"         │                       ├─ tableId: 13\n" +
+			"         │                       └─ Table\n" +
+			"         │                           ├─ name: NOXN3\n" +
+			"         │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         └─ HashLookup\n" +
+			"             ├─ left-key: TUPLE()\n" +
+			"             ├─ right-key: TUPLE()\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: aoev5\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ isLateral: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 ├─ colSet: (214)\n" +
+			"                 ├─ tableId: 23\n" +
+			"                 └─ Values() as temp_AOEV5\n" +
+			"                     ├─ Row(\n" +
+			"                     │  1 (longtext))\n" +
+			"                     ├─ Row(\n" +
+			"                     │  2 (longtext))\n" +
+			"                     ├─ Row(\n" +
+			"                     │  3 (longtext))\n" +
+			"                     ├─ Row(\n" +
+			"                     │  4 (longtext))\n" +
+			"                     └─ Row(\n" +
+			"                        5 (longtext))\n" +
 			"",
 		ExpectedEstimates: "Union all\n" +
 			" ├─ Project\n" +
-			" │   ├─ columns: [convert(T4IBQ, char) as T4IBQ, DL754, BDNYB, ADURZ, TPXBU, NO52D, IDPK7]\n" +
+			" │   ├─ columns: [coerce(T4IBQ, longtext) as T4IBQ, DL754, BDNYB, ADURZ, TPXBU, NO52D, IDPK7]\n" +
 			" │   └─ Project\n" +
 			" │       ├─ columns: [cla.FTQLQ as T4IBQ, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
 			" │       │   ├─ cacheable: false\n" +
@@ -4940,123 +4933,119 @@ WHERE
 			" │                           ├─ index: [YK2GW.id]\n" +
 			" │                           └─ keys: bs.IXUXU\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [aoev5.t4ibq as t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [aoev5.t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
-			"         └─ CrossHashJoin\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: vumuy\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ isLateral: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
-			"             │       │   ├─ cacheable: false\n" +
-			"             │       │   └─ Project\n" +
-			"             │       │       ├─ columns: [aac.BTXC5]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ (aac.id = sl3s5.M22QN)\n" +
-			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.id]\n" +
-			"             │       │                   ├─ columns: [id btxc5]\n" +
-			"             │       │                   └─ keys: sl3s5.M22QN\n" +
-			"             │       │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
-			"             │       └─ Project\n" +
-			"             │           ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, sl3s5.BDNYB, sl3s5.TOFPN, sl3s5.M22QN, sl3s5.ADURZ, sl3s5.NO52D, sl3s5.IDPK7, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
-			"             │           │   ├─ cacheable: false\n" +
-			"             │           │   └─ Project\n" +
-			"             │           │       ├─ columns: [aac.BTXC5]\n" +
-			"             │           │       └─ Filter\n" +
-			"             │           │           ├─ (aac.id = sl3s5.M22QN)\n" +
-			"             │           │           └─ TableAlias(aac)\n" +
-			"             │           │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │           │                   ├─ index: [TPXBU.id]\n" +
-			"             │           │                   ├─ columns: [id btxc5]\n" +
-			"             │           │                   └─ keys: sl3s5.M22QN\n" +
-			"             │           │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
-			"             │           └─ LookupJoin\n" +
-			"             │               ├─ SubqueryAlias\n" +
-			"             │               │   ├─ name: sl3s5\n" +
-			"             │               │   ├─ outerVisibility: false\n" +
-			"             │               │   ├─ isLateral: false\n" +
-			"             │               │   ├─ cacheable: true\n" +
-			"             │               │   └─ Project\n" +
-			"             │               │       ├─ columns: [sn.id as BDNYB, ci.FTQLQ as TOFPN, ct.M22QN as M22QN, cec.ADURZ as ADURZ, cec.NO52D as NO52D, ct.S3Q3Y as IDPK7]\n" +
-			"             │               │       └─ HashJoin\n" +
-			"             │               │           ├─ (cec.id = ct.OVE3E)\n" +
-			"             │               │           ├─ HashJoin\n" +
-			"             │               │           │   ├─ (ci.id = ct.FZ2R5)\n" +
-			"             │               │           │   ├─ MergeJoin\n" +
-			"             │               │           │   │   ├─ cmp: (sn.BRQP2 = ct.LUEVY)\n" +
-			"             │               │           │   │   ├─ sel: (ct.M22QN = Subquery\n" +
-			"             │               │           │   │   │   ├─ cacheable: true\n" +
-			"             │               │           │   │   │   └─ Project\n" +
-			"             │               │           │   │   │       ├─ columns: [aac.id]\n" +
-			"             │               │           │   │   │       └─ Filter\n" +
-			"             │               │           │   │   │           ├─ (aac.BTXC5 = 'WT')\n" +
-			"             │               │           │   │   │           └─ TableAlias(aac)\n" +
-			"             │               │           │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │               │           │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"             │               │           │   │   │                   ├─ filters: [{[WT, WT]}]\n" +
-			"             │               │           │   │   │                   └─ columns: [id btxc5]\n" +
-			"             │               │           │   │   │  )\n" +
-			"             │               │           │   │   ├─ TableAlias(sn)\n" +
-			"             │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
-			"             │               │           │   │   └─ Filter\n" +
-			"             │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
-			"             │               │           │   │       └─ TableAlias(ct)\n" +
-			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			"             │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
-			"             │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
-			"             │               │           │   └─ HashLookup\n" +
-			"             │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
-			"             │               │           │       ├─ right-key: (ci.id)\n" +
-			"             │               │           │       └─ Filter\n" +
-			"             │               │           │           ├─ (ci.FTQLQ HASH IN ('SQ1'))\n" +
-			"             │               │           │           └─ TableAlias(ci)\n" +
-			"             │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
-			"             │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
-			"             │               │           │                   ├─ filters: [{[SQ1, SQ1]}]\n" +
-			"             │               │           │                   └─ columns: [id ftqlq]\n" +
-			"             │               │           └─ HashLookup\n" +
-			"             │               │               ├─ left-key: (ct.OVE3E)\n" +
-			"             │               │               ├─ right-key: (cec.id)\n" +
-			"             │               │               └─ TableAlias(cec)\n" +
-			"             │               │                   └─ Table\n" +
-			"             │               │                       ├─ name: SFEGG\n" +
-			"             │               │                       └─ columns: [id no52d adurz]\n" +
-			"             │               └─ TableAlias(sn)\n" +
-			"             │                   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       ├─ index: [NOXN3.id]\n" +
-			"             │                       └─ keys: sl3s5.BDNYB\n" +
-			"             └─ HashLookup\n" +
-			"                 ├─ left-key: ()\n" +
-			"                 ├─ right-key: ()\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: aoev5\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [temp_aoev5.t4ibq]\n" +
-			"                         └─ Values() as temp_AOEV5\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '1')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '2')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '3')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '4')\n" +
-			"                             └─ Row(\n" +
-			"                                '5')\n" +
+			"     ├─ columns: [aoev5.T4IBQ, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
+			"     └─ CrossHashJoin\n" +
+			"         ├─ SubqueryAlias\n" +
+			"         │   ├─ name: vumuy\n" +
+			"         │   ├─ outerVisibility: false\n" +
+			"         │   ├─ isLateral: false\n" +
+			"         │   ├─ cacheable: true\n" +
+			"         │   └─ Project\n" +
+			"         │       ├─ columns: [sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
+			"         │       │   ├─ cacheable: false\n" +
+			"         │       │   └─ Project\n" +
+			"         │       │       ├─ columns: [aac.BTXC5]\n" +
+			"         │       │       └─ Filter\n" +
+			"         │       │           ├─ (aac.id = sl3s5.M22QN)\n" +
+			"         │       │           └─ TableAlias(aac)\n" +
+			"         │       │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │       │                   ├─ index: [TPXBU.id]\n" +
+			"         │       │                   ├─ columns: [id btxc5]\n" +
+			"         │       │                   └─ keys: sl3s5.M22QN\n" +
+			"         │       │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
+			"         │       └─ Project\n" +
+			"         │           ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, sl3s5.BDNYB, sl3s5.TOFPN, sl3s5.M22QN, sl3s5.ADURZ, sl3s5.NO52D, sl3s5.IDPK7, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
+			"         │           │   ├─ cacheable: false\n" +
+			"         │           │   └─ Project\n" +
+			"         │           │       ├─ columns: [aac.BTXC5]\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ (aac.id = sl3s5.M22QN)\n" +
+			"         │           │           └─ TableAlias(aac)\n" +
+			"         │           │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │           │                   ├─ index: [TPXBU.id]\n" +
+			"         │           │                   ├─ columns: [id btxc5]\n" +
+			"         │           │                   └─ keys: sl3s5.M22QN\n" +
+			"         │           │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
+			"         │           └─ LookupJoin\n" +
+			"         │               ├─ SubqueryAlias\n" +
+			"         │               │   ├─ name: sl3s5\n" +
+			"         │               │   ├─ outerVisibility: false\n" +
+			"         │               │   ├─ isLateral: false\n" +
+			"         │               │   ├─ cacheable: true\n" +
+			"         │               │   └─ Project\n" +
+			"         │               │       ├─ columns: [sn.id as BDNYB, ci.FTQLQ as TOFPN, ct.M22QN as M22QN, cec.ADURZ as ADURZ, cec.NO52D as NO52D, ct.S3Q3Y as IDPK7]\n" +
+			"         │               │       └─ HashJoin\n" +
+			"         │               │           ├─ (cec.id = ct.OVE3E)\n" +
+			"         │               │           ├─ HashJoin\n" +
+			"         │               │           │   ├─ (ci.id = ct.FZ2R5)\n" +
+			"         │               │           │   ├─ MergeJoin\n" +
+			"         │               │           │   │   ├─ cmp: (sn.BRQP2 = ct.LUEVY)\n" +
+			"         │               │           │   │   ├─ sel: (ct.M22QN = Subquery\n" +
+			"         │               │           │   │   │   ├─ cacheable: true\n" +
+			"         │               │           │   │   │   └─ Project\n" +
+			"         │               │           │   │   │       ├─ columns: [aac.id]\n" +
+			"         │               │           │   │   │       └─ Filter\n" +
+			"         │               │           │   │   │           ├─ (aac.BTXC5 = 'WT')\n" +
+			"         │               │           │   │   │           └─ TableAlias(aac)\n" +
+			"         │               │           │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │               │           │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"         │               │           │   │   │                   ├─ filters: [{[WT, WT]}]\n" +
+			"         │               │           │   │   │                   └─ columns: [id btxc5]\n" +
+			"         │               │           │   │   │  )\n" +
+			"         │               │           │   │   ├─ TableAlias(sn)\n" +
+			"         │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"         │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │               │           │   │   └─ Filter\n" +
+			"         │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
+			"         │               │           │   │       └─ TableAlias(ct)\n" +
+			"         │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
+			"         │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
+			"         │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
+			"         │               │           │   └─ HashLookup\n" +
+			"         │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
+			"         │               │           │       ├─ right-key: (ci.id)\n" +
+			"         │               │           │       └─ Filter\n" +
+			"         │               │           │           ├─ (ci.FTQLQ HASH IN ('SQ1'))\n" +
+			"         │               │           │           └─ TableAlias(ci)\n" +
+			"         │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
+			"         │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
+			"         │               │           │                   ├─ filters: [{[SQ1, SQ1]}]\n" +
+			"         │               │           │                   └─ columns: [id ftqlq]\n" +
+			"         │               │           └─ HashLookup\n" +
+			"         │               │               ├─ left-key: (ct.OVE3E)\n" +
+			"         │               │               ├─ right-key: (cec.id)\n" +
+			"         │               │               └─ TableAlias(cec)\n" +
+			"         │               │                   └─ Table\n" +
+			"         │               │                       ├─ name: SFEGG\n" +
+			"         │               │                       └─ columns: [id no52d adurz]\n" +
+			"         │               └─ TableAlias(sn)\n" +
+			"         │                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │                       ├─ index: [NOXN3.id]\n" +
+			"         │                       └─ keys: sl3s5.BDNYB\n" +
+			"         └─ HashLookup\n" +
+			"             ├─ left-key: ()\n" +
+			"             ├─ right-key: ()\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: aoev5\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ isLateral: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 └─ Values() as temp_AOEV5\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '1')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '2')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '3')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '4')\n" +
+			"                     └─ Row(\n" +
+			"                        '5')\n" +
 			"",
 		ExpectedAnalysis: "Union all\n" +
 			" ├─ Project\n" +
-			" │   ├─ columns: [convert(T4IBQ, char) as T4IBQ, DL754, BDNYB, ADURZ, TPXBU, NO52D, IDPK7]\n" +
+			" │   ├─ columns: [coerce(T4IBQ, longtext) as T4IBQ, DL754, BDNYB, ADURZ, TPXBU, NO52D, IDPK7]\n" +
 			" │   └─ Project\n" +
 			" │       ├─ columns: [cla.FTQLQ as T4IBQ, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
 			" │       │   ├─ cacheable: false\n" +
@@ -5161,119 +5150,115 @@ WHERE
 			" │                           ├─ index: [YK2GW.id]\n" +
 			" │                           └─ keys: bs.IXUXU\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [aoev5.t4ibq as t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [aoev5.t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
-			"         └─ CrossHashJoin\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: vumuy\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ isLateral: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
-			"             │       │   ├─ cacheable: false\n" +
-			"             │       │   └─ Project\n" +
-			"             │       │       ├─ columns: [aac.BTXC5]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ (aac.id = sl3s5.M22QN)\n" +
-			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.id]\n" +
-			"             │       │                   ├─ columns: [id btxc5]\n" +
-			"             │       │                   └─ keys: sl3s5.M22QN\n" +
-			"             │       │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
-			"             │       └─ Project\n" +
-			"             │           ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, sl3s5.BDNYB, sl3s5.TOFPN, sl3s5.M22QN, sl3s5.ADURZ, sl3s5.NO52D, sl3s5.IDPK7, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
-			"             │           │   ├─ cacheable: false\n" +
-			"             │           │   └─ Project\n" +
-			"             │           │       ├─ columns: [aac.BTXC5]\n" +
-			"             │           │       └─ Filter\n" +
-			"             │           │           ├─ (aac.id = sl3s5.M22QN)\n" +
-			"             │           │           └─ TableAlias(aac)\n" +
-			"             │           │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │           │                   ├─ index: [TPXBU.id]\n" +
-			"             │           │                   ├─ columns: [id btxc5]\n" +
-			"             │           │                   └─ keys: sl3s5.M22QN\n" +
-			"             │           │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
-			"             │           └─ LookupJoin\n" +
-			"             │               ├─ SubqueryAlias\n" +
-			"             │               │   ├─ name: sl3s5\n" +
-			"             │               │   ├─ outerVisibility: false\n" +
-			"             │               │   ├─ isLateral: false\n" +
-			"             │               │   ├─ cacheable: true\n" +
-			"             │               │   └─ Project\n" +
-			"             │               │       ├─ columns: [sn.id as BDNYB, ci.FTQLQ as TOFPN, ct.M22QN as M22QN, cec.ADURZ as ADURZ, cec.NO52D as NO52D, ct.S3Q3Y as IDPK7]\n" +
-			"             │               │       └─ HashJoin\n" +
-			"             │               │           ├─ (cec.id = ct.OVE3E)\n" +
-			"             │               │           ├─ HashJoin\n" +
-			"             │               │           │   ├─ (ci.id = ct.FZ2R5)\n" +
-			"             │               │           │   ├─ MergeJoin\n" +
-			"             │               │           │   │   ├─ cmp: (sn.BRQP2 = ct.LUEVY)\n" +
-			"             │               │           │   │   ├─ sel: (ct.M22QN = Subquery\n" +
-			"             │               │           │   │   │   ├─ cacheable: true\n" +
-			"             │               │           │   │   │   └─ Project\n" +
-			"             │               │           │   │   │       ├─ columns: [aac.id]\n" +
-			"             │               │           │   │   │       └─ Filter\n" +
-			"             │               │           │   │   │           ├─ (aac.BTXC5 = 'WT')\n" +
-			"             │               │           │   │   │           └─ TableAlias(aac)\n" +
-			"             │               │           │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │               │           │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"             │               │           │   │   │                   ├─ filters: [{[WT, WT]}]\n" +
-			"             │               │           │   │   │                   └─ columns: [id btxc5]\n" +
-			"             │               │           │   │   │  )\n" +
-			"             │               │           │   │   ├─ TableAlias(sn)\n" +
-			"             │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
-			"             │               │           │   │   └─ Filter\n" +
-			"             │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
-			"             │               │           │   │       └─ TableAlias(ct)\n" +
-			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			"             │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
-			"             │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
-			"             │               │           │   └─ HashLookup\n" +
-			"             │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
-			"             │               │           │       ├─ right-key: (ci.id)\n" +
-			"             │               │           │       └─ Filter\n" +
-			"             │               │           │           ├─ (ci.FTQLQ HASH IN ('SQ1'))\n" +
-			"             │               │           │           └─ TableAlias(ci)\n" +
-			"             │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
-			"             │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
-			"             │               │           │                   ├─ filters: [{[SQ1, SQ1]}]\n" +
-			"             │               │           │                   └─ columns: [id ftqlq]\n" +
-			"             │               │           └─ HashLookup\n" +
-			"             │               │               ├─ left-key: (ct.OVE3E)\n" +
-			"             │               │               ├─ right-key: (cec.id)\n" +
-			"             │               │               └─ TableAlias(cec)\n" +
-			"             │               │                   └─ Table\n" +
-			"             │               │                       ├─ name: SFEGG\n" +
-			"             │               │                       └─ columns: [id no52d adurz]\n" +
-			"             │               └─ TableAlias(sn)\n" +
-			"             │                   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       ├─ index: [NOXN3.id]\n" +
-			"             │                       └─ keys: sl3s5.BDNYB\n" +
-			"             └─ HashLookup\n" +
-			"                 ├─ left-key: ()\n" +
-			"                 ├─ right-key: ()\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: aoev5\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [temp_aoev5.t4ibq]\n" +
-			"                         └─ Values() as temp_AOEV5\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '1')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '2')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '3')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '4')\n" +
-			"                             └─ Row(\n" +
-			"                                '5')\n" +
+			"     ├─ columns: [aoev5.T4IBQ, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
+			"     └─ CrossHashJoin\n" +
+			"         ├─ SubqueryAlias\n" +
+			"         │   ├─ name: vumuy\n" +
+			"         │   ├─ outerVisibility: false\n" +
+			"         │   ├─ isLateral: false\n" +
+			"         │   ├─ cacheable: true\n" +
+			"         │   └─ Project\n" +
+			"         │       ├─ columns: [sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
+			"         │       │   ├─ cacheable: false\n" +
+			"         │       │   └─ Project\n" +
+			"         │       │       ├─ columns: [aac.BTXC5]\n" +
+			"         │       │       └─ Filter\n" +
+			"         │       │           ├─ (aac.id = sl3s5.M22QN)\n" +
+			"         │       │           └─ TableAlias(aac)\n" +
+			"         │       │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │       │                   ├─ index: [TPXBU.id]\n" +
+			"         │       │                   ├─ columns: [id btxc5]\n" +
+			"         │       │                   └─ keys: sl3s5.M22QN\n" +
+			"         │       │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
+			"         │       └─ Project\n" +
+			"         │           ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, sl3s5.BDNYB, sl3s5.TOFPN, sl3s5.M22QN, sl3s5.ADURZ, sl3s5.NO52D, sl3s5.IDPK7, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
+			"         │           │   ├─ cacheable: false\n" +
+			"         │           │   └─ Project\n" +
+			"         │           │       ├─ columns: [aac.BTXC5]\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ (aac.id = sl3s5.M22QN)\n" +
+			"         │           │           └─ TableAlias(aac)\n" +
+			"         │           │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │           │                   ├─ index: [TPXBU.id]\n" +
+			"         │           │                   ├─ columns: [id btxc5]\n" +
+			"         │           │                   └─ keys: sl3s5.M22QN\n" +
+			"         │           │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
+			"         │           └─ LookupJoin\n" +
+			"         │               ├─ SubqueryAlias\n" +
+			"         │               │   ├─ name: sl3s5\n" +
+			"         │               │   ├─ outerVisibility: false\n" +
+			"         │               │   ├─ isLateral: false\n" +
+			"         │               │   ├─ cacheable: true\n" +
+			"         │               │   └─ Project\n" +
+			"         │               │       ├─ columns: [sn.id as BDNYB, ci.FTQLQ as TOFPN, ct.M22QN as M22QN, cec.ADURZ as ADURZ, cec.NO52D as NO52D, ct.S3Q3Y as IDPK7]\n" +
+			"         │               │       └─ HashJoin\n" +
+			"         │               │           ├─ (cec.id = ct.OVE3E)\n" +
+			"         │               │           ├─ HashJoin\n" +
+			"         │               │           │   ├─ (ci.id = ct.FZ2R5)\n" +
+			"         │               │           │   ├─ MergeJoin\n" +
+			"         │               │           │   │   ├─ cmp: (sn.BRQP2 = ct.LUEVY)\n" +
+			"         │               │           │   │   ├─ sel: (ct.M22QN = Subquery\n" +
+			"         │               │           │   │   │   ├─ cacheable: true\n" +
+			"         │               │           │   │   │   └─ Project\n" +
+			"         │               │           │   │   │       ├─ columns: [aac.id]\n" +
+			"         │               │           │   │   │       └─ Filter\n" +
+			"         │               │           │   │   │           ├─ (aac.BTXC5 = 'WT')\n" +
+			"         │               │           │   │   │           └─ TableAlias(aac)\n" +
+			"         │               │           │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │               │           │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"         │               │           │   │   │                   ├─ filters: [{[WT, WT]}]\n" +
+			"         │               │           │   │   │                   └─ columns: [id btxc5]\n" +
+			"         │               │           │   │   │  )\n" +
+			"         │               │           │   │   ├─ TableAlias(sn)\n" +
+			"         │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"         │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │               │           │   │   └─ Filter\n" +
+			"         │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
+			"         │               │           │   │       └─ TableAlias(ct)\n" +
+			"         │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
+			"         │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
+			"         │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
+			"         │               │           │   └─ HashLookup\n" +
+			"         │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
+			"         │               │           │       ├─ right-key: (ci.id)\n" +
+			"         │               │           │       └─ Filter\n" +
+			"         │               │           │           ├─ (ci.FTQLQ HASH IN ('SQ1'))\n" +
+			"         │               │           │           └─ TableAlias(ci)\n" +
+			"         │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
+			"         │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
+			"         │               │           │                   ├─ filters: [{[SQ1, SQ1]}]\n" +
+			"         │               │           │                   └─ columns: [id ftqlq]\n" +
+			"         │               │           └─ HashLookup\n" +
+			"         │               │               ├─ left-key: (ct.OVE3E)\n" +
+			"         │               │               ├─ right-key: (cec.id)\n" +
+			"         │               │               └─ TableAlias(cec)\n" +
+			"         │               │                   └─ Table\n" +
+			"         │               │                       ├─ name: SFEGG\n" +
+			"         │               │                       └─ columns: [id no52d adurz]\n" +
+			"         │               └─ TableAlias(sn)\n" +
+			"         │                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │                       ├─ index: [NOXN3.id]\n" +
+			"         │                       └─ keys: sl3s5.BDNYB\n" +
+			"         └─ HashLookup\n" +
+			"             ├─ left-key: ()\n" +
+			"             ├─ right-key: ()\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: aoev5\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ isLateral: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 └─ Values() as temp_AOEV5\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '1')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '2')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '3')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '4')\n" +
+			"                     └─ Row(\n" +
+			"                        '5')\n" +
 			"",
 	},
 	{
@@ -5378,10 +5363,7 @@ WHERE
 	   ) AOEV5`,
 		ExpectedPlan: "Union all\n" +
 			" ├─ Project\n" +
-			" │   ├─ columns: [convert\n" +
-			" │   │   ├─ type: char\n" +
-			" │   │   └─ T4IBQ:0!null\n" +
-			" │   │   as T4IBQ, DL754:1!null, BDNYB:2!null, ADURZ:3!null, TPXBU:4, NO52D:5!null, IDPK7:6!null]\n" +
+			" │   ├─ columns: [coerce(T4IBQ:0!null, longtext) as T4IBQ, DL754:1!null, BDNYB:2!null, ADURZ:3!null, TPXBU:4, NO52D:5!null, IDPK7:6!null]\n" +
 			" │   └─ Project\n" +
 			" │       ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sl3s5.TOFPN:62!null as DL754, sn.id:51!null as BDNYB, sl3s5.ADURZ:64!null as ADURZ, Subquery\n" +
 			" │       │   ├─ cacheable: false\n" +
@@ -5546,182 +5528,178 @@ WHERE
 			" │                               ├─ name: YK2GW\n" +
 			" │                               └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [aoev5.t4ibq:0!null as t4ibq, vumuy.DL754:1!null, vumuy.BDNYB:2!null, vumuy.ADURZ:3!null, vumuy.TPXBU:4, vumuy.NO52D:5!null, vumuy.IDPK7:6!null]\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [aoev5.t4ibq:6!null, vumuy.DL754:0!null, vumuy.BDNYB:1!null, vumuy.ADURZ:2!null, vumuy.TPXBU:3, vumuy.NO52D:4!null, vumuy.IDPK7:5!null]\n" +
-			"         └─ CrossHashJoin\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: vumuy\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ isLateral: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   ├─ colSet: (207-212)\n" +
-			"             │   ├─ tableId: 21\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [sl3s5.TOFPN:11!null as DL754, sn.id:0!null as BDNYB, sl3s5.ADURZ:13!null as ADURZ, Subquery\n" +
-			"             │       │   ├─ cacheable: false\n" +
-			"             │       │   ├─ alias-string: select aac.BTXC5 from TPXBU as aac where aac.id = SL3S5.M22QN\n" +
-			"             │       │   └─ Project\n" +
-			"             │       │       ├─ columns: [aac.BTXC5:23]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ aac.id:22!null\n" +
-			"             │       │           │   └─ sl3s5.M22QN:12!null\n" +
-			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.id]\n" +
-			"             │       │                   ├─ keys: [sl3s5.M22QN:12!null]\n" +
-			"             │       │                   ├─ colSet: (201-203)\n" +
-			"             │       │                   ├─ tableId: 20\n" +
-			"             │       │                   └─ Table\n" +
-			"             │       │                       ├─ name: TPXBU\n" +
-			"             │       │                       └─ columns: [id btxc5]\n" +
-			"             │       │   as TPXBU, sl3s5.NO52D:14!null as NO52D, sl3s5.IDPK7:15!null as IDPK7]\n" +
-			"             │       └─ Project\n" +
-			"             │           ├─ columns: [sn.id:6!null, sn.BRQP2:7!null, sn.FFTBJ:8!null, sn.A7XO2:9, sn.KBO7R:10!null, sn.ECDKM:11, sn.NUMK2:12!null, sn.LETOE:13!null, sn.YKSSU:14, sn.FHCYT:15, sl3s5.BDNYB:0!null, sl3s5.TOFPN:1!null, sl3s5.M22QN:2!null, sl3s5.ADURZ:3!null, sl3s5.NO52D:4!null, sl3s5.IDPK7:5!null, sl3s5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, sl3s5.ADURZ:3!null as ADURZ, Subquery\n" +
-			"             │           │   ├─ cacheable: false\n" +
-			"             │           │   ├─ alias-string: select aac.BTXC5 from TPXBU as aac where aac.id = SL3S5.M22QN\n" +
-			"             │           │   └─ Project\n" +
-			"             │           │       ├─ columns: [aac.BTXC5:17]\n" +
-			"             │           │       └─ Filter\n" +
-			"             │           │           ├─ Eq\n" +
-			"             │           │           │   ├─ aac.id:16!null\n" +
-			"             │           │           │   └─ sl3s5.M22QN:2!null\n" +
-			"             │           │           └─ TableAlias(aac)\n" +
-			"             │           │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │           │                   ├─ index: [TPXBU.id]\n" +
-			"             │           │                   ├─ keys: [sl3s5.M22QN:2!null]\n" +
-			"             │           │                   ├─ colSet: (201-203)\n" +
-			"             │           │                   ├─ tableId: 20\n" +
-			"             │           │                   └─ Table\n" +
-			"             │           │                       ├─ name: TPXBU\n" +
-			"             │           │                       └─ columns: [id btxc5]\n" +
-			"             │           │   as TPXBU, sl3s5.NO52D:4!null as NO52D, sl3s5.IDPK7:5!null as IDPK7]\n" +
-			"             │           └─ LookupJoin\n" +
-			"             │               ├─ SubqueryAlias\n" +
-			"             │               │   ├─ name: sl3s5\n" +
-			"             │               │   ├─ outerVisibility: false\n" +
-			"             │               │   ├─ isLateral: false\n" +
-			"             │               │   ├─ cacheable: true\n" +
-			"             │               │   ├─ colSet: (192-197)\n" +
-			"             │               │   ├─ tableId: 19\n" +
-			"             │               │   └─ Project\n" +
-			"             │               │       ├─ columns: [sn.id:0!null as BDNYB, ci.FTQLQ:23!null as TOFPN, ct.M22QN:13!null as M22QN, cec.ADURZ:26!null as ADURZ, cec.NO52D:25!null as NO52D, ct.S3Q3Y:19!null as IDPK7]\n" +
-			"             │               │       └─ HashJoin\n" +
-			"             │               │           ├─ Eq\n" +
-			"             │               │           │   ├─ cec.id:24!null\n" +
-			"             │               │           │   └─ ct.OVE3E:14!null\n" +
-			"             │               │           ├─ HashJoin\n" +
-			"             │               │           │   ├─ Eq\n" +
-			"             │               │           │   │   ├─ ci.id:22!null\n" +
-			"             │               │           │   │   └─ ct.FZ2R5:11!null\n" +
-			"             │               │           │   ├─ MergeJoin\n" +
-			"             │               │           │   │   ├─ cmp: Eq\n" +
-			"             │               │           │   │   │   ├─ sn.BRQP2:1!null\n" +
-			"             │               │           │   │   │   └─ ct.LUEVY:12!null\n" +
-			"             │               │           │   │   ├─ sel: Eq\n" +
-			"             │               │           │   │   │   ├─ ct.M22QN:13!null\n" +
-			"             │               │           │   │   │   └─ Subquery\n" +
-			"             │               │           │   │   │       ├─ cacheable: true\n" +
-			"             │               │           │   │   │       ├─ alias-string: select aac.id from TPXBU as aac where BTXC5 = 'WT'\n" +
-			"             │               │           │   │   │       └─ Project\n" +
-			"             │               │           │   │   │           ├─ columns: [aac.id:22!null]\n" +
-			"             │               │           │   │   │           └─ Filter\n" +
-			"             │               │           │   │   │               ├─ Eq\n" +
-			"             │               │           │   │   │               │   ├─ aac.BTXC5:23\n" +
-			"             │               │           │   │   │               │   └─ WT (longtext)\n" +
-			"             │               │           │   │   │               └─ TableAlias(aac)\n" +
-			"             │               │           │   │   │                   └─ IndexedTableAccess(TPXBU)\n" +
-			"             │               │           │   │   │                       ├─ index: [TPXBU.BTXC5]\n" +
-			"             │               │           │   │   │                       ├─ static: [{[WT, WT]}]\n" +
-			"             │               │           │   │   │                       ├─ colSet: (172-174)\n" +
-			"             │               │           │   │   │                       ├─ tableId: 16\n" +
-			"             │               │           │   │   │                       └─ Table\n" +
-			"             │               │           │   │   │                           ├─ name: TPXBU\n" +
-			"             │               │           │   │   │                           └─ columns: [id btxc5]\n" +
-			"             │               │           │   │   ├─ TableAlias(sn)\n" +
-			"             │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │               │           │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"             │               │           │   │   │       ├─ colSet: (150-159)\n" +
-			"             │               │           │   │   │       ├─ tableId: 14\n" +
-			"             │               │           │   │   │       └─ Table\n" +
-			"             │               │           │   │   │           ├─ name: NOXN3\n" +
-			"             │               │           │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             │               │           │   │   └─ Filter\n" +
-			"             │               │           │   │       ├─ Eq\n" +
-			"             │               │           │   │       │   ├─ ct.ZRV3B:10!null\n" +
-			"             │               │           │   │       │   └─ = (longtext)\n" +
-			"             │               │           │   │       └─ TableAlias(ct)\n" +
-			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			"             │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
-			"             │               │           │   │               ├─ static: [{[NULL, ∞)}]\n" +
-			"             │               │           │   │               ├─ colSet: (160-171)\n" +
-			"             │               │           │   │               ├─ tableId: 15\n" +
-			"             │               │           │   │               └─ Table\n" +
-			"             │               │           │   │                   ├─ name: FLQLP\n" +
-			"             │               │           │   │                   └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
-			"             │               │           │   └─ HashLookup\n" +
-			"             │               │           │       ├─ left-key: TUPLE(ct.FZ2R5:11!null)\n" +
-			"             │               │           │       ├─ right-key: TUPLE(ci.id:0!null)\n" +
-			"             │               │           │       └─ Filter\n" +
-			"             │               │           │           ├─ HashIn\n" +
-			"             │               │           │           │   ├─ ci.FTQLQ:1!null\n" +
-			"             │               │           │           │   └─ TUPLE(SQ1 (longtext))\n" +
-			"             │               │           │           └─ TableAlias(ci)\n" +
-			"             │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
-			"             │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
-			"             │               │           │                   ├─ static: [{[SQ1, SQ1]}]\n" +
-			"             │               │           │                   ├─ colSet: (175-179)\n" +
-			"             │               │           │                   ├─ tableId: 17\n" +
-			"             │               │           │                   └─ Table\n" +
-			"             │               │           │                       ├─ name: JDLNA\n" +
-			"             │               │           │                       └─ columns: [id ftqlq]\n" +
-			"             │               │           └─ HashLookup\n" +
-			"             │               │               ├─ left-key: TUPLE(ct.OVE3E:14!null)\n" +
-			"             │               │               ├─ right-key: TUPLE(cec.id:0!null)\n" +
-			"             │               │               └─ TableAlias(cec)\n" +
-			"             │               │                   └─ Table\n" +
-			"             │               │                       ├─ name: SFEGG\n" +
-			"             │               │                       ├─ columns: [id no52d adurz]\n" +
-			"             │               │                       ├─ colSet: (180-185)\n" +
-			"             │               │                       └─ tableId: 18\n" +
-			"             │               └─ TableAlias(sn)\n" +
-			"             │                   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       ├─ index: [NOXN3.id]\n" +
-			"             │                       ├─ keys: [sl3s5.BDNYB:0!null]\n" +
-			"             │                       ├─ colSet: (140-149)\n" +
-			"             │                       ├─ tableId: 13\n" +
-			"             │                       └─ Table\n" +
-			"             │                           ├─ name: NOXN3\n" +
-			"             │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             └─ HashLookup\n" +
-			"                 ├─ left-key: TUPLE()\n" +
-			"                 ├─ right-key: TUPLE()\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: aoev5\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     ├─ colSet: (214)\n" +
-			"                     ├─ tableId: 23\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [temp_aoev5.t4ibq:0!null]\n" +
-			"                         └─ Values() as temp_AOEV5\n" +
-			"                             ├─ Row(\n" +
-			"                             │  1 (longtext))\n" +
-			"                             ├─ Row(\n" +
-			"                             │  2 (longtext))\n" +
-			"                             ├─ Row(\n" +
-			"                             │  3 (longtext))\n" +
-			"                             ├─ Row(\n" +
-			"                             │  4 (longtext))\n" +
-			"                             └─ Row(\n" +
-			"                                5 (longtext))\n" +
+			"     ├─ columns: [aoev5.T4IBQ:6!null, vumuy.DL754:0!null, vumuy.BDNYB:1!null, vumuy.ADURZ:2!null, vumuy.TPXBU:3, vumuy.NO52D:4!null, vumuy.IDPK7:5!null]\n" +
+			"     └─ CrossHashJoin\n" +
+			"         ├─ SubqueryAlias\n" +
+			"         │   ├─ name: vumuy\n" +
+			"         │   ├─ outerVisibility: false\n" +
+			"         │   ├─ isLateral: false\n" +
+			"         │   ├─ cacheable: true\n" +
+			"         │   ├─ colSet: (207-212)\n" +
+			"         │   ├─ tableId: 21\n" +
+			"         │   └─ Project\n" +
+			"         │       ├─ columns: [sl3s5.TOFPN:11!null as DL754, sn.id:0!null as BDNYB, sl3s5.ADURZ:13!null as ADURZ, Subquery\n" +
+			"         │       │   ├─ cacheable: false\n" +
+			"         │       │   ├─ alias-string: select aac.BTXC5 from TPXBU as aac where aac.id = SL3S5.M22QN\n" +
+			"         │       │   └─ Project\n" +
+			"         │       │       ├─ columns: [aac.BTXC5:23]\n" +
+			"         │       │       └─ Filter\n" +
+			"         │       │           ├─ Eq\n" +
+			"         │       │           │   ├─ aac.id:22!null\n" +
+			"         │       │           │   └─ sl3s5.M22QN:12!null\n" +
+			"         │       │           └─ TableAlias(aac)\n" +
+			"         │       │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │       │                   ├─ index: [TPXBU.id]\n" +
+			"         │       │                   ├─ keys: [sl3s5.M22QN:12!null]\n" +
+			"         │       │                   ├─ colSet: (201-203)\n" +
+			"         │       │                   ├─ tableId: 20\n" +
+			"         │       │                   └─ Table\n" +
+			"         │       │                       ├─ name: TPXBU\n" +
+			"         │       │                       └─ columns: [id btxc5]\n" +
+			"         │       │   as TPXBU, sl3s5.NO52D:14!null as NO52D, sl3s5.IDPK7:15!null as IDPK7]\n" +
+			"         │       └─ Project\n" +
+			"         │           ├─ columns: [sn.id:6!null, sn.BRQP2:7!null, sn.FFTBJ:8!null, sn.A7XO2:9, sn.KBO7R:10!null, sn.ECDKM:11, sn.NUMK2:12!null, sn.LETOE:13!null, sn.YKSSU:14, sn.FHCYT:15, sl3s5.BDNYB:0!null, sl3s5.TOFPN:1!null, sl3s5.M22QN:2!null, sl3s5.ADURZ:3!null, sl3s5.NO52D:4!null, sl3s5.IDPK7:5!null, sl3s5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, sl3s5.ADURZ:3!null as ADURZ, Subquery\n" +
+			"         │           │   ├─ cacheable: false\n" +
+			"         │           │   ├─ alias-string: select aac.BTXC5 from TPXBU as aac where aac.id = SL3S5.M22QN\n" +
+			"         │           │   └─ Project\n" +
+			"         │           │       ├─ columns: [aac.BTXC5:17]\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ Eq\n" +
+			"         │           │           │   ├─ aac.id:16!null\n" +
+			"         │           │           │   └─ sl3s5.M22QN:2!null\n" +
+			"         │           │           └─ TableAlias(aac)\n" +
+			"         │           │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │           │                   ├─ index: [TPXBU.id]\n" +
+			"         │           │                   ├─ keys: [sl3s5.M22QN:2!null]\n" +
+			"         │           │                   ├─ colSet: (201-203)\n" +
+			"         │           │                   ├─ tableId: 20\n" +
+			"         │           │                   └─ Table\n" +
+			"         │           │                       ├─ name: TPXBU\n" +
+			"         │           │                       └─ columns: [id btxc5]\n" +
+			"         │           │   as TPXBU, sl3s5.NO52D:4!null as NO52D, sl3s5.IDPK7:5!null as IDPK7]\n" +
+			"         │           └─ LookupJoin\n" +
+			"         │               ├─ SubqueryAlias\n" +
+			"         │               │   ├─ name: sl3s5\n" +
+			"         │               │   ├─ outerVisibility: false\n" +
+			"         │               │   ├─ isLateral: false\n" +
+			"         │               │   ├─ cacheable: true\n" +
+			"         │               │   ├─ colSet: (192-197)\n" +
+			"         │               │   ├─ tableId: 19\n" +
+			"         │               │   └─ Project\n" +
+			"         │               │       ├─ columns: [sn.id:0!null as BDNYB, ci.FTQLQ:23!null as TOFPN, ct.M22QN:13!null as M22QN, cec.ADURZ:26!null as ADURZ, cec.NO52D:25!null as NO52D, ct.S3Q3Y:19!null as IDPK7]\n" +
+			"         │               │       └─ HashJoin\n" +
+			"         │               │           ├─ Eq\n" +
+			"         │               │           │   ├─ cec.id:24!null\n" +
+			"         │               │           │   └─ ct.OVE3E:14!null\n" +
+			"         │               │           ├─ HashJoin\n" +
+			"         │               │           │   ├─ Eq\n" +
+			"         │               │           │   │   ├─ ci.id:22!null\n" +
+			"         │               │           │   │   └─ ct.FZ2R5:11!null\n" +
+			"         │               │           │   ├─ MergeJoin\n" +
+			"         │               │           │   │   ├─ cmp: Eq\n" +
+			"         │               │           │   │   │   ├─ sn.BRQP2:1!null\n" +
+			"         │               │           │   │   │   └─ ct.LUEVY:12!null\n" +
+			"         │               │           │   │   ├─ sel: Eq\n" +
+			"         │               │           │   │   │   ├─ ct.M22QN:13!null\n" +
+			"         │               │           │   │   │   └─ Subquery\n" +
+			"         │               │           │   │   │       ├─ cacheable: true\n" +
+			"         │               │           │   │   │       ├─ alias-string: select aac.id from TPXBU as aac where BTXC5 = 'WT'\n" +
+			"         │               │           │   │   │       └─ Project\n" +
+			"         │               │           │   │   │           ├─ columns: [aac.id:22!null]\n" +
+			"         │               │           │   │   │           └─ Filter\n" +
+			"         │               │           │   │   │               ├─ Eq\n" +
+			"         │               │           │   │   │               │   ├─ aac.BTXC5:23\n" +
+			"         │               │           │   │   │               │   └─ WT (longtext)\n" +
+			"         │               │           │   │   │               └─ TableAlias(aac)\n" +
+			"         │               │           │   │   │                   └─ IndexedTableAccess(TPXBU)\n" +
+			"         │               │           │   │   │                       ├─ index: [TPXBU.BTXC5]\n" +
+			"         │               │           │   │   │                       ├─ static: [{[WT, WT]}]\n" +
+			"         │               │           │   │   │                       ├─ colSet: (172-174)\n" +
+			"         │               │           │   │   │                       ├─ tableId: 16\n" +
+			"         │               │           │   │   │                       └─ Table\n" +
+			"         │               │           │   │   │                           ├─ name: TPXBU\n" +
+			"         │               │           │   │   │                           └─ columns: [id btxc5]\n" +
+			"         │               │           │   │   ├─ TableAlias(sn)\n" +
+			"         │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"         │               │           │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"         │               │           │   │   │       ├─ colSet: (150-159)\n" +
+			"         │               │           │   │   │       ├─ tableId: 14\n" +
+			"         │               │           │   │   │       └─ Table\n" +
+			"         │               │           │   │   │           ├─ name: NOXN3\n" +
+			"         │               │           │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         │               │           │   │   └─ Filter\n" +
+			"         │               │           │   │       ├─ Eq\n" +
+			"         │               │           │   │       │   ├─ ct.ZRV3B:10!null\n" +
+			"         │               │           │   │       │   └─ = (longtext)\n" +
+			"         │               │           │   │       └─ TableAlias(ct)\n" +
+			"         │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
+			"         │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
+			"         │               │           │   │               ├─ static: [{[NULL, ∞)}]\n" +
+			"         │               │           │   │               ├─ colSet: (160-171)\n" +
+			"         │               │           │   │               ├─ tableId: 15\n" +
+			"         │               │           │   │               └─ Table\n" +
+			"         │               │           │   │                   ├─ name: FLQLP\n" +
+			"         │               │           │   │                   └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"         │               │           │   └─ HashLookup\n" +
+			"         │               │           │       ├─ left-key: TUPLE(ct.FZ2R5:11!null)\n" +
+			"         │               │           │       ├─ right-key: TUPLE(ci.id:0!null)\n" +
+			"         │               │           │       └─ Filter\n" +
+			"         │               │           │           ├─ HashIn\n" +
+			"         │               │           │           │   ├─ ci.FTQLQ:1!null\n" +
+			"         │               │           │           │   └─ TUPLE(SQ1 (longtext))\n" +
+			"         │               │           │           └─ TableAlias(ci)\n" +
+			"         │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
+			"         │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
+			"         │               │           │                   ├─ static: [{[SQ1, SQ1]}]\n" +
+			"         │               │           │                   ├─ colSet: (175-179)\n" +
+			"         │               │           │                   ├─ tableId: 17\n" +
+			"         │               │           │                   └─ Table\n" +
+			"         │               │           │                       ├─ name: JDLNA\n" +
+			"         │               │           │                       └─ columns: [id ftqlq]\n" +
+			"         │               │           └─ HashLookup\n" +
+			"         │               │               ├─ left-key: TUPLE(ct.OVE3E:14!null)\n" +
+			"         │               │               ├─ right-key: TUPLE(cec.id:0!null)\n" +
+			"         │               │               └─ TableAlias(cec)\n" +
+			"         │               │                   └─ Table\n" +
+			"         │               │                       ├─ name: SFEGG\n" +
+			"         │               │                       ├─ columns: [id no52d adurz]\n" +
+			"         │               │                       ├─ colSet: (180-185)\n" +
+			"         │               │                       └─ tableId: 18\n" +
+			"         │               └─ TableAlias(sn)\n" +
+			"         │                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │                       ├─ index: [NOXN3.id]\n" +
+			"         │                       ├─ keys: [sl3s5.BDNYB:0!null]\n" +
+			"         │                       ├─ colSet: (140-149)\n" +
+			"         │                       ├─ tableId: 13\n" +
+			"         │                       └─ Table\n" +
+			"         │                           ├─ name: NOXN3\n" +
+			"         │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         └─ HashLookup\n" +
+			"             ├─ left-key: TUPLE()\n" +
+			"             ├─ right-key: TUPLE()\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: aoev5\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ isLateral: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 ├─ colSet: (214)\n" +
+			"                 ├─ tableId: 23\n" +
+			"                 └─ Values() as temp_AOEV5\n" +
+			"                     ├─ Row(\n" +
+			"                     │  1 (longtext))\n" +
+			"                     ├─ Row(\n" +
+			"                     │  2 (longtext))\n" +
+			"                     ├─ Row(\n" +
+			"                     │  3 (longtext))\n" +
+			"                     ├─ Row(\n" +
+			"                     │  4 (longtext))\n" +
+			"                     └─ Row(\n" +
+			"                        5 (longtext))\n" +
 			"",
 		ExpectedEstimates: "Union all\n" +
 			" ├─ Project\n" +
-			" │   ├─ columns: [convert(T4IBQ, char) as T4IBQ, DL754, BDNYB, ADURZ, TPXBU, NO52D, IDPK7]\n" +
+			" │   ├─ columns: [coerce(T4IBQ, longtext) as T4IBQ, DL754, BDNYB, ADURZ, TPXBU, NO52D, IDPK7]\n" +
 			" │   └─ Project\n" +
 			" │       ├─ columns: [cla.FTQLQ as T4IBQ, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
 			" │       │   ├─ cacheable: false\n" +
@@ -5818,123 +5796,119 @@ WHERE
 			" │                           ├─ index: [YK2GW.id]\n" +
 			" │                           └─ keys: bs.IXUXU\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [aoev5.t4ibq as t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [aoev5.t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
-			"         └─ CrossHashJoin\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: vumuy\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ isLateral: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
-			"             │       │   ├─ cacheable: false\n" +
-			"             │       │   └─ Project\n" +
-			"             │       │       ├─ columns: [aac.BTXC5]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ (aac.id = sl3s5.M22QN)\n" +
-			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.id]\n" +
-			"             │       │                   ├─ columns: [id btxc5]\n" +
-			"             │       │                   └─ keys: sl3s5.M22QN\n" +
-			"             │       │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
-			"             │       └─ Project\n" +
-			"             │           ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, sl3s5.BDNYB, sl3s5.TOFPN, sl3s5.M22QN, sl3s5.ADURZ, sl3s5.NO52D, sl3s5.IDPK7, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
-			"             │           │   ├─ cacheable: false\n" +
-			"             │           │   └─ Project\n" +
-			"             │           │       ├─ columns: [aac.BTXC5]\n" +
-			"             │           │       └─ Filter\n" +
-			"             │           │           ├─ (aac.id = sl3s5.M22QN)\n" +
-			"             │           │           └─ TableAlias(aac)\n" +
-			"             │           │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │           │                   ├─ index: [TPXBU.id]\n" +
-			"             │           │                   ├─ columns: [id btxc5]\n" +
-			"             │           │                   └─ keys: sl3s5.M22QN\n" +
-			"             │           │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
-			"             │           └─ LookupJoin\n" +
-			"             │               ├─ SubqueryAlias\n" +
-			"             │               │   ├─ name: sl3s5\n" +
-			"             │               │   ├─ outerVisibility: false\n" +
-			"             │               │   ├─ isLateral: false\n" +
-			"             │               │   ├─ cacheable: true\n" +
-			"             │               │   └─ Project\n" +
-			"             │               │       ├─ columns: [sn.id as BDNYB, ci.FTQLQ as TOFPN, ct.M22QN as M22QN, cec.ADURZ as ADURZ, cec.NO52D as NO52D, ct.S3Q3Y as IDPK7]\n" +
-			"             │               │       └─ HashJoin\n" +
-			"             │               │           ├─ (cec.id = ct.OVE3E)\n" +
-			"             │               │           ├─ HashJoin\n" +
-			"             │               │           │   ├─ (ci.id = ct.FZ2R5)\n" +
-			"             │               │           │   ├─ MergeJoin\n" +
-			"             │               │           │   │   ├─ cmp: (sn.BRQP2 = ct.LUEVY)\n" +
-			"             │               │           │   │   ├─ sel: (ct.M22QN = Subquery\n" +
-			"             │               │           │   │   │   ├─ cacheable: true\n" +
-			"             │               │           │   │   │   └─ Project\n" +
-			"             │               │           │   │   │       ├─ columns: [aac.id]\n" +
-			"             │               │           │   │   │       └─ Filter\n" +
-			"             │               │           │   │   │           ├─ (aac.BTXC5 = 'WT')\n" +
-			"             │               │           │   │   │           └─ TableAlias(aac)\n" +
-			"             │               │           │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │               │           │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"             │               │           │   │   │                   ├─ filters: [{[WT, WT]}]\n" +
-			"             │               │           │   │   │                   └─ columns: [id btxc5]\n" +
-			"             │               │           │   │   │  )\n" +
-			"             │               │           │   │   ├─ TableAlias(sn)\n" +
-			"             │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
-			"             │               │           │   │   └─ Filter\n" +
-			"             │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
-			"             │               │           │   │       └─ TableAlias(ct)\n" +
-			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			"             │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
-			"             │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
-			"             │               │           │   └─ HashLookup\n" +
-			"             │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
-			"             │               │           │       ├─ right-key: (ci.id)\n" +
-			"             │               │           │       └─ Filter\n" +
-			"             │               │           │           ├─ (ci.FTQLQ HASH IN ('SQ1'))\n" +
-			"             │               │           │           └─ TableAlias(ci)\n" +
-			"             │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
-			"             │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
-			"             │               │           │                   ├─ filters: [{[SQ1, SQ1]}]\n" +
-			"             │               │           │                   └─ columns: [id ftqlq]\n" +
-			"             │               │           └─ HashLookup\n" +
-			"             │               │               ├─ left-key: (ct.OVE3E)\n" +
-			"             │               │               ├─ right-key: (cec.id)\n" +
-			"             │               │               └─ TableAlias(cec)\n" +
-			"             │               │                   └─ Table\n" +
-			"             │               │                       ├─ name: SFEGG\n" +
-			"             │               │                       └─ columns: [id no52d adurz]\n" +
-			"             │               └─ TableAlias(sn)\n" +
-			"             │                   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       ├─ index: [NOXN3.id]\n" +
-			"             │                       └─ keys: sl3s5.BDNYB\n" +
-			"             └─ HashLookup\n" +
-			"                 ├─ left-key: ()\n" +
-			"                 ├─ right-key: ()\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: aoev5\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [temp_aoev5.t4ibq]\n" +
-			"                         └─ Values() as temp_AOEV5\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '1')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '2')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '3')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '4')\n" +
-			"                             └─ Row(\n" +
-			"                                '5')\n" +
+			"     ├─ columns: [aoev5.T4IBQ, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
+			"     └─ CrossHashJoin\n" +
+			"         ├─ SubqueryAlias\n" +
+			"         │   ├─ name: vumuy\n" +
+			"         │   ├─ outerVisibility: false\n" +
+			"         │   ├─ isLateral: false\n" +
+			"         │   ├─ cacheable: true\n" +
+			"         │   └─ Project\n" +
+			"         │       ├─ columns: [sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
+			"         │       │   ├─ cacheable: false\n" +
+			"         │       │   └─ Project\n" +
+			"         │       │       ├─ columns: [aac.BTXC5]\n" +
+			"         │       │       └─ Filter\n" +
+			"         │       │           ├─ (aac.id = sl3s5.M22QN)\n" +
+			"         │       │           └─ TableAlias(aac)\n" +
+			"         │       │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │       │                   ├─ index: [TPXBU.id]\n" +
+			"         │       │                   ├─ columns: [id btxc5]\n" +
+			"         │       │                   └─ keys: sl3s5.M22QN\n" +
+			"         │       │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
+			"         │       └─ Project\n" +
+			"         │           ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, sl3s5.BDNYB, sl3s5.TOFPN, sl3s5.M22QN, sl3s5.ADURZ, sl3s5.NO52D, sl3s5.IDPK7, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
+			"         │           │   ├─ cacheable: false\n" +
+			"         │           │   └─ Project\n" +
+			"         │           │       ├─ columns: [aac.BTXC5]\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ (aac.id = sl3s5.M22QN)\n" +
+			"         │           │           └─ TableAlias(aac)\n" +
+			"         │           │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │           │                   ├─ index: [TPXBU.id]\n" +
+			"         │           │                   ├─ columns: [id btxc5]\n" +
+			"         │           │                   └─ keys: sl3s5.M22QN\n" +
+			"         │           │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
+			"         │           └─ LookupJoin\n" +
+			"         │               ├─ SubqueryAlias\n" +
+			"         │               │   ├─ name: sl3s5\n" +
+			"         │               │   ├─ outerVisibility: false\n" +
+			"         │               │   ├─ isLateral: false\n" +
+			"         │               │   ├─ cacheable: true\n" +
+			"         │               │   └─ Project\n" +
+			"         │               │       ├─ columns: [sn.id as BDNYB, ci.FTQLQ as TOFPN, ct.M22QN as M22QN, cec.ADURZ as ADURZ, cec.NO52D as NO52D, ct.S3Q3Y as IDPK7]\n" +
+			"         │               │       └─ HashJoin\n" +
+			"         │               │           ├─ (cec.id = ct.OVE3E)\n" +
+			"         │               │           ├─ HashJoin\n" +
+			"         │               │           │   ├─ (ci.id = ct.FZ2R5)\n" +
+			"         │               │           │   ├─ MergeJoin\n" +
+			"         │               │           │   │   ├─ cmp: (sn.BRQP2 = ct.LUEVY)\n" +
+			"         │               │           │   │   ├─ sel: (ct.M22QN = Subquery\n" +
+			"         │               │           │   │   │   ├─ cacheable: true\n" +
+			"         │               │           │   │   │   └─ Project\n" +
+			"         │               │           │   │   │       ├─ columns: [aac.id]\n" +
+			"         │               │           │   │   │       └─ Filter\n" +
+			"         │               │           │   │   │           ├─ (aac.BTXC5 = 'WT')\n" +
+			"         │               │           │   │   │           └─ TableAlias(aac)\n" +
+			"         │               │           │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │               │           │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"         │               │           │   │   │                   ├─ filters: [{[WT, WT]}]\n" +
+			"         │               │           │   │   │                   └─ columns: [id btxc5]\n" +
+			"         │               │           │   │   │  )\n" +
+			"         │               │           │   │   ├─ TableAlias(sn)\n" +
+			"         │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"         │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │               │           │   │   └─ Filter\n" +
+			"         │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
+			"         │               │           │   │       └─ TableAlias(ct)\n" +
+			"         │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
+			"         │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
+			"         │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
+			"         │               │           │   └─ HashLookup\n" +
+			"         │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
+			"         │               │           │       ├─ right-key: (ci.id)\n" +
+			"         │               │           │       └─ Filter\n" +
+			"         │               │           │           ├─ (ci.FTQLQ HASH IN ('SQ1'))\n" +
+			"         │               │           │           └─ TableAlias(ci)\n" +
+			"         │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
+			"         │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
+			"         │               │           │                   ├─ filters: [{[SQ1, SQ1]}]\n" +
+			"         │               │           │                   └─ columns: [id ftqlq]\n" +
+			"         │               │           └─ HashLookup\n" +
+			"         │               │               ├─ left-key: (ct.OVE3E)\n" +
+			"         │               │               ├─ right-key: (cec.id)\n" +
+			"         │               │               └─ TableAlias(cec)\n" +
+			"         │               │                   └─ Table\n" +
+			"         │               │                       ├─ name: SFEGG\n" +
+			"         │               │                       └─ columns: [id no52d adurz]\n" +
+			"         │               └─ TableAlias(sn)\n" +
+			"         │                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │                       ├─ index: [NOXN3.id]\n" +
+			"         │                       └─ keys: sl3s5.BDNYB\n" +
+			"         └─ HashLookup\n" +
+			"             ├─ left-key: ()\n" +
+			"             ├─ right-key: ()\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: aoev5\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ isLateral: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 └─ Values() as temp_AOEV5\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '1')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '2')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '3')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '4')\n" +
+			"                     └─ Row(\n" +
+			"                        '5')\n" +
 			"",
 		ExpectedAnalysis: "Union all\n" +
 			" ├─ Project\n" +
-			" │   ├─ columns: [convert(T4IBQ, char) as T4IBQ, DL754, BDNYB, ADURZ, TPXBU, NO52D, IDPK7]\n" +
+			" │   ├─ columns: [coerce(T4IBQ, longtext) as T4IBQ, DL754, BDNYB, ADURZ, TPXBU, NO52D, IDPK7]\n" +
 			" │   └─ Project\n" +
 			" │       ├─ columns: [cla.FTQLQ as T4IBQ, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
 			" │       │   ├─ cacheable: false\n" +
@@ -6031,119 +6005,115 @@ WHERE
 			" │                           ├─ index: [YK2GW.id]\n" +
 			" │                           └─ keys: bs.IXUXU\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [aoev5.t4ibq as t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [aoev5.t4ibq, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
-			"         └─ CrossHashJoin\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: vumuy\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ isLateral: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
-			"             │       │   ├─ cacheable: false\n" +
-			"             │       │   └─ Project\n" +
-			"             │       │       ├─ columns: [aac.BTXC5]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ (aac.id = sl3s5.M22QN)\n" +
-			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.id]\n" +
-			"             │       │                   ├─ columns: [id btxc5]\n" +
-			"             │       │                   └─ keys: sl3s5.M22QN\n" +
-			"             │       │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
-			"             │       └─ Project\n" +
-			"             │           ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, sl3s5.BDNYB, sl3s5.TOFPN, sl3s5.M22QN, sl3s5.ADURZ, sl3s5.NO52D, sl3s5.IDPK7, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
-			"             │           │   ├─ cacheable: false\n" +
-			"             │           │   └─ Project\n" +
-			"             │           │       ├─ columns: [aac.BTXC5]\n" +
-			"             │           │       └─ Filter\n" +
-			"             │           │           ├─ (aac.id = sl3s5.M22QN)\n" +
-			"             │           │           └─ TableAlias(aac)\n" +
-			"             │           │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │           │                   ├─ index: [TPXBU.id]\n" +
-			"             │           │                   ├─ columns: [id btxc5]\n" +
-			"             │           │                   └─ keys: sl3s5.M22QN\n" +
-			"             │           │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
-			"             │           └─ LookupJoin\n" +
-			"             │               ├─ SubqueryAlias\n" +
-			"             │               │   ├─ name: sl3s5\n" +
-			"             │               │   ├─ outerVisibility: false\n" +
-			"             │               │   ├─ isLateral: false\n" +
-			"             │               │   ├─ cacheable: true\n" +
-			"             │               │   └─ Project\n" +
-			"             │               │       ├─ columns: [sn.id as BDNYB, ci.FTQLQ as TOFPN, ct.M22QN as M22QN, cec.ADURZ as ADURZ, cec.NO52D as NO52D, ct.S3Q3Y as IDPK7]\n" +
-			"             │               │       └─ HashJoin\n" +
-			"             │               │           ├─ (cec.id = ct.OVE3E)\n" +
-			"             │               │           ├─ HashJoin\n" +
-			"             │               │           │   ├─ (ci.id = ct.FZ2R5)\n" +
-			"             │               │           │   ├─ MergeJoin\n" +
-			"             │               │           │   │   ├─ cmp: (sn.BRQP2 = ct.LUEVY)\n" +
-			"             │               │           │   │   ├─ sel: (ct.M22QN = Subquery\n" +
-			"             │               │           │   │   │   ├─ cacheable: true\n" +
-			"             │               │           │   │   │   └─ Project\n" +
-			"             │               │           │   │   │       ├─ columns: [aac.id]\n" +
-			"             │               │           │   │   │       └─ Filter\n" +
-			"             │               │           │   │   │           ├─ (aac.BTXC5 = 'WT')\n" +
-			"             │               │           │   │   │           └─ TableAlias(aac)\n" +
-			"             │               │           │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │               │           │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"             │               │           │   │   │                   ├─ filters: [{[WT, WT]}]\n" +
-			"             │               │           │   │   │                   └─ columns: [id btxc5]\n" +
-			"             │               │           │   │   │  )\n" +
-			"             │               │           │   │   ├─ TableAlias(sn)\n" +
-			"             │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
-			"             │               │           │   │   └─ Filter\n" +
-			"             │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
-			"             │               │           │   │       └─ TableAlias(ct)\n" +
-			"             │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			"             │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
-			"             │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
-			"             │               │           │   └─ HashLookup\n" +
-			"             │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
-			"             │               │           │       ├─ right-key: (ci.id)\n" +
-			"             │               │           │       └─ Filter\n" +
-			"             │               │           │           ├─ (ci.FTQLQ HASH IN ('SQ1'))\n" +
-			"             │               │           │           └─ TableAlias(ci)\n" +
-			"             │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
-			"             │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
-			"             │               │           │                   ├─ filters: [{[SQ1, SQ1]}]\n" +
-			"             │               │           │                   └─ columns: [id ftqlq]\n" +
-			"             │               │           └─ HashLookup\n" +
-			"             │               │               ├─ left-key: (ct.OVE3E)\n" +
-			"             │               │               ├─ right-key: (cec.id)\n" +
-			"             │               │               └─ TableAlias(cec)\n" +
-			"             │               │                   └─ Table\n" +
-			"             │               │                       ├─ name: SFEGG\n" +
-			"             │               │                       └─ columns: [id no52d adurz]\n" +
-			"             │               └─ TableAlias(sn)\n" +
-			"             │                   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                       ├─ index: [NOXN3.id]\n" +
-			"             │                       └─ keys: sl3s5.BDNYB\n" +
-			"             └─ HashLookup\n" +
-			"                 ├─ left-key: ()\n" +
-			"                 ├─ right-key: ()\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: aoev5\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ isLateral: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [temp_aoev5.t4ibq]\n" +
-			"                         └─ Values() as temp_AOEV5\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '1')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '2')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '3')\n" +
-			"                             ├─ Row(\n" +
-			"                             │  '4')\n" +
-			"                             └─ Row(\n" +
-			"                                '5')\n" +
+			"     ├─ columns: [aoev5.T4IBQ, vumuy.DL754, vumuy.BDNYB, vumuy.ADURZ, vumuy.TPXBU, vumuy.NO52D, vumuy.IDPK7]\n" +
+			"     └─ CrossHashJoin\n" +
+			"         ├─ SubqueryAlias\n" +
+			"         │   ├─ name: vumuy\n" +
+			"         │   ├─ outerVisibility: false\n" +
+			"         │   ├─ isLateral: false\n" +
+			"         │   ├─ cacheable: true\n" +
+			"         │   └─ Project\n" +
+			"         │       ├─ columns: [sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
+			"         │       │   ├─ cacheable: false\n" +
+			"         │       │   └─ Project\n" +
+			"         │       │       ├─ columns: [aac.BTXC5]\n" +
+			"         │       │       └─ Filter\n" +
+			"         │       │           ├─ (aac.id = sl3s5.M22QN)\n" +
+			"         │       │           └─ TableAlias(aac)\n" +
+			"         │       │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │       │                   ├─ index: [TPXBU.id]\n" +
+			"         │       │                   ├─ columns: [id btxc5]\n" +
+			"         │       │                   └─ keys: sl3s5.M22QN\n" +
+			"         │       │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
+			"         │       └─ Project\n" +
+			"         │           ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, sl3s5.BDNYB, sl3s5.TOFPN, sl3s5.M22QN, sl3s5.ADURZ, sl3s5.NO52D, sl3s5.IDPK7, sl3s5.TOFPN as DL754, sn.id as BDNYB, sl3s5.ADURZ as ADURZ, Subquery\n" +
+			"         │           │   ├─ cacheable: false\n" +
+			"         │           │   └─ Project\n" +
+			"         │           │       ├─ columns: [aac.BTXC5]\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ (aac.id = sl3s5.M22QN)\n" +
+			"         │           │           └─ TableAlias(aac)\n" +
+			"         │           │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │           │                   ├─ index: [TPXBU.id]\n" +
+			"         │           │                   ├─ columns: [id btxc5]\n" +
+			"         │           │                   └─ keys: sl3s5.M22QN\n" +
+			"         │           │   as TPXBU, sl3s5.NO52D as NO52D, sl3s5.IDPK7 as IDPK7]\n" +
+			"         │           └─ LookupJoin\n" +
+			"         │               ├─ SubqueryAlias\n" +
+			"         │               │   ├─ name: sl3s5\n" +
+			"         │               │   ├─ outerVisibility: false\n" +
+			"         │               │   ├─ isLateral: false\n" +
+			"         │               │   ├─ cacheable: true\n" +
+			"         │               │   └─ Project\n" +
+			"         │               │       ├─ columns: [sn.id as BDNYB, ci.FTQLQ as TOFPN, ct.M22QN as M22QN, cec.ADURZ as ADURZ, cec.NO52D as NO52D, ct.S3Q3Y as IDPK7]\n" +
+			"         │               │       └─ HashJoin\n" +
+			"         │               │           ├─ (cec.id = ct.OVE3E)\n" +
+			"         │               │           ├─ HashJoin\n" +
+			"         │               │           │   ├─ (ci.id = ct.FZ2R5)\n" +
+			"         │               │           │   ├─ MergeJoin\n" +
+			"         │               │           │   │   ├─ cmp: (sn.BRQP2 = ct.LUEVY)\n" +
+			"         │               │           │   │   ├─ sel: (ct.M22QN = Subquery\n" +
+			"         │               │           │   │   │   ├─ cacheable: true\n" +
+			"         │               │           │   │   │   └─ Project\n" +
+			"         │               │           │   │   │       ├─ columns: [aac.id]\n" +
+			"         │               │           │   │   │       └─ Filter\n" +
+			"         │               │           │   │   │           ├─ (aac.BTXC5 = 'WT')\n" +
+			"         │               │           │   │   │           └─ TableAlias(aac)\n" +
+			"         │               │           │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │               │           │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"         │               │           │   │   │                   ├─ filters: [{[WT, WT]}]\n" +
+			"         │               │           │   │   │                   └─ columns: [id btxc5]\n" +
+			"         │               │           │   │   │  )\n" +
+			"         │               │           │   │   ├─ TableAlias(sn)\n" +
+			"         │               │           │   │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │               │           │   │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"         │               │           │   │   │       └─ filters: [{[NULL, ∞)}]\n" +
+			"         │               │           │   │   └─ Filter\n" +
+			"         │               │           │   │       ├─ (ct.ZRV3B = '=')\n" +
+			"         │               │           │   │       └─ TableAlias(ct)\n" +
+			"         │               │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
+			"         │               │           │   │               ├─ index: [FLQLP.LUEVY]\n" +
+			"         │               │           │   │               └─ filters: [{[NULL, ∞)}]\n" +
+			"         │               │           │   └─ HashLookup\n" +
+			"         │               │           │       ├─ left-key: (ct.FZ2R5)\n" +
+			"         │               │           │       ├─ right-key: (ci.id)\n" +
+			"         │               │           │       └─ Filter\n" +
+			"         │               │           │           ├─ (ci.FTQLQ HASH IN ('SQ1'))\n" +
+			"         │               │           │           └─ TableAlias(ci)\n" +
+			"         │               │           │               └─ IndexedTableAccess(JDLNA)\n" +
+			"         │               │           │                   ├─ index: [JDLNA.FTQLQ]\n" +
+			"         │               │           │                   ├─ filters: [{[SQ1, SQ1]}]\n" +
+			"         │               │           │                   └─ columns: [id ftqlq]\n" +
+			"         │               │           └─ HashLookup\n" +
+			"         │               │               ├─ left-key: (ct.OVE3E)\n" +
+			"         │               │               ├─ right-key: (cec.id)\n" +
+			"         │               │               └─ TableAlias(cec)\n" +
+			"         │               │                   └─ Table\n" +
+			"         │               │                       ├─ name: SFEGG\n" +
+			"         │               │                       └─ columns: [id no52d adurz]\n" +
+			"         │               └─ TableAlias(sn)\n" +
+			"         │                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │                       ├─ index: [NOXN3.id]\n" +
+			"         │                       └─ keys: sl3s5.BDNYB\n" +
+			"         └─ HashLookup\n" +
+			"             ├─ left-key: ()\n" +
+			"             ├─ right-key: ()\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: aoev5\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ isLateral: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 └─ Values() as temp_AOEV5\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '1')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '2')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '3')\n" +
+			"                     ├─ Row(\n" +
+			"                     │  '4')\n" +
+			"                     └─ Row(\n" +
+			"                        '5')\n" +
 			"",
 	},
 	{
@@ -6442,8 +6412,8 @@ WHERE
 	   E2I7U TYMVL ON (sn.FFTBJ = TYMVL.id)
 	ORDER BY M6T2N ASC`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [(row_number() over ( order by sn.id asc):0!null - 1 (tinyint)) as M6T2N, s7egw.TW55N:1!null as FJVD7, tymvl.TW55N:2!null as KBXXJ, sn.NUMK2:3!null, sn.LETOE:4!null, sn.id:5!null as XLFIA]\n" +
-			" └─ Sort((row_number() over ( order by sn.id asc):0!null - 1 (tinyint)) as M6T2N ASC nullsFirst)\n" +
+			" ├─ columns: [M6T2N:6!null, s7egw.TW55N:1!null as FJVD7, tymvl.TW55N:2!null as KBXXJ, sn.NUMK2:3!null, sn.LETOE:4!null, sn.id:5!null as XLFIA]\n" +
+			" └─ Sort(M6T2N:6!null ASC nullsFirst)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [row_number() over ( order by sn.id asc):0!null, s7egw.TW55N:1!null, tymvl.TW55N:2!null, sn.NUMK2:3!null, sn.LETOE:4!null, sn.id:5!null, (row_number() over ( order by sn.id asc):0!null - 1 (tinyint)) as M6T2N, s7egw.TW55N:1!null as FJVD7, tymvl.TW55N:2!null as KBXXJ, sn.id:5!null as XLFIA]\n" +
 			"         └─ Window\n" +
@@ -6489,8 +6459,8 @@ WHERE
 			"                                 └─ columns: [id tw55n]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [(row_number() over ( order by sn.id asc) - 1) as M6T2N, s7egw.TW55N as FJVD7, tymvl.TW55N as KBXXJ, sn.NUMK2, sn.LETOE, sn.id as XLFIA]\n" +
-			" └─ Sort((row_number() over ( order by sn.id asc) - 1) as M6T2N ASC)\n" +
+			" ├─ columns: [M6T2N, s7egw.TW55N as FJVD7, tymvl.TW55N as KBXXJ, sn.NUMK2, sn.LETOE, sn.id as XLFIA]\n" +
+			" └─ Sort(M6T2N ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [row_number() over ( order by sn.id asc), s7egw.TW55N, tymvl.TW55N, sn.NUMK2, sn.LETOE, sn.id, (row_number() over ( order by sn.id asc) - 1) as M6T2N, s7egw.TW55N as FJVD7, tymvl.TW55N as KBXXJ, sn.id as XLFIA]\n" +
 			"         └─ Window(row_number() over ( order by sn.id ASC), s7egw.TW55N, tymvl.TW55N, sn.NUMK2, sn.LETOE, sn.id)\n" +
@@ -6517,8 +6487,8 @@ WHERE
 			"                             └─ columns: [id tw55n]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [(row_number() over ( order by sn.id asc) - 1) as M6T2N, s7egw.TW55N as FJVD7, tymvl.TW55N as KBXXJ, sn.NUMK2, sn.LETOE, sn.id as XLFIA]\n" +
-			" └─ Sort((row_number() over ( order by sn.id asc) - 1) as M6T2N ASC)\n" +
+			" ├─ columns: [M6T2N, s7egw.TW55N as FJVD7, tymvl.TW55N as KBXXJ, sn.NUMK2, sn.LETOE, sn.id as XLFIA]\n" +
+			" └─ Sort(M6T2N ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [row_number() over ( order by sn.id asc), s7egw.TW55N, tymvl.TW55N, sn.NUMK2, sn.LETOE, sn.id, (row_number() over ( order by sn.id asc) - 1) as M6T2N, s7egw.TW55N as FJVD7, tymvl.TW55N as KBXXJ, sn.id as XLFIA]\n" +
 			"         └─ Window(row_number() over ( order by sn.id ASC), s7egw.TW55N, tymvl.TW55N, sn.NUMK2, sn.LETOE, sn.id)\n" +
@@ -6758,19 +6728,7 @@ WHERE
 	   YYKXN
 	`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [athcu.T4IBQ:1!null as T4IBQ, athcu.TW55N:3!null as TW55N, CASE  WHEN fc.OZTQF:11!null IS NULL THEN 0 (tinyint) WHEN IN\n" +
-			" │   ├─ left: athcu.SJ5DU:5\n" +
-			" │   └─ right: TUPLE(log (longtext), com (longtext), ex (longtext))\n" +
-			" │   THEN 0 (tinyint) WHEN Eq\n" +
-			" │   ├─ athcu.SOWRY:4!null\n" +
-			" │   └─ CRZ2X (longtext)\n" +
-			" │   THEN 0 (tinyint) WHEN Eq\n" +
-			" │   ├─ athcu.SOWRY:4!null\n" +
-			" │   └─ z (longtext)\n" +
-			" │   THEN fc.OZTQF:11!null WHEN Eq\n" +
-			" │   ├─ athcu.SOWRY:4!null\n" +
-			" │   └─ o (longtext)\n" +
-			" │   THEN (fc.OZTQF:11!null - 1 (tinyint)) END as OZTQF]\n" +
+			" ├─ columns: [athcu.T4IBQ:1!null as T4IBQ, athcu.TW55N:3!null as TW55N, OZTQF:16]\n" +
 			" └─ Sort(athcu.YYKXN:2!null ASC nullsFirst)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [athcu.B2TX3:0!null, athcu.T4IBQ:1!null, athcu.YYKXN:2!null, athcu.TW55N:3!null, athcu.SOWRY:4!null, athcu.SJ5DU:5, fc.id:6!null, fc.GXLUB:7!null, fc.LUEVY:8!null, fc.XQDYT:9!null, fc.AMYXQ:10!null, fc.OZTQF:11!null, fc.Z35GY:12!null, fc.KKGN5:13, athcu.T4IBQ:1!null as T4IBQ, athcu.TW55N:3!null as TW55N, CASE  WHEN fc.OZTQF:11!null IS NULL THEN 0 (tinyint) WHEN IN\n" +
@@ -6890,7 +6848,7 @@ WHERE
 			"                         └─ columns: [id gxlub luevy xqdyt amyxq oztqf z35gy kkgn5]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [athcu.T4IBQ as T4IBQ, athcu.TW55N as TW55N, CASE  WHEN fc.OZTQF IS NULL THEN 0 WHEN (athcu.SJ5DU IN ('log', 'com', 'ex')) THEN 0 WHEN (athcu.SOWRY = 'CRZ2X') THEN 0 WHEN (athcu.SOWRY = 'z') THEN fc.OZTQF WHEN (athcu.SOWRY = 'o') THEN (fc.OZTQF - 1) END as OZTQF]\n" +
+			" ├─ columns: [athcu.T4IBQ as T4IBQ, athcu.TW55N as TW55N, OZTQF]\n" +
 			" └─ Sort(athcu.YYKXN ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [athcu.B2TX3, athcu.T4IBQ, athcu.YYKXN, athcu.TW55N, athcu.SOWRY, athcu.SJ5DU, fc.id, fc.GXLUB, fc.LUEVY, fc.XQDYT, fc.AMYXQ, fc.OZTQF, fc.Z35GY, fc.KKGN5, athcu.T4IBQ as T4IBQ, athcu.TW55N as TW55N, CASE  WHEN fc.OZTQF IS NULL THEN 0 WHEN (athcu.SJ5DU IN ('log', 'com', 'ex')) THEN 0 WHEN (athcu.SOWRY = 'CRZ2X') THEN 0 WHEN (athcu.SOWRY = 'z') THEN fc.OZTQF WHEN (athcu.SOWRY = 'o') THEN (fc.OZTQF - 1) END as OZTQF]\n" +
@@ -6961,7 +6919,7 @@ WHERE
 			"                     └─ keys: athcu.B2TX3, athcu.YYKXN\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [athcu.T4IBQ as T4IBQ, athcu.TW55N as TW55N, CASE  WHEN fc.OZTQF IS NULL THEN 0 WHEN (athcu.SJ5DU IN ('log', 'com', 'ex')) THEN 0 WHEN (athcu.SOWRY = 'CRZ2X') THEN 0 WHEN (athcu.SOWRY = 'z') THEN fc.OZTQF WHEN (athcu.SOWRY = 'o') THEN (fc.OZTQF - 1) END as OZTQF]\n" +
+			" ├─ columns: [athcu.T4IBQ as T4IBQ, athcu.TW55N as TW55N, OZTQF]\n" +
 			" └─ Sort(athcu.YYKXN ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [athcu.B2TX3, athcu.T4IBQ, athcu.YYKXN, athcu.TW55N, athcu.SOWRY, athcu.SJ5DU, fc.id, fc.GXLUB, fc.LUEVY, fc.XQDYT, fc.AMYXQ, fc.OZTQF, fc.Z35GY, fc.KKGN5, athcu.T4IBQ as T4IBQ, athcu.TW55N as TW55N, CASE  WHEN fc.OZTQF IS NULL THEN 0 WHEN (athcu.SJ5DU IN ('log', 'com', 'ex')) THEN 0 WHEN (athcu.SOWRY = 'CRZ2X') THEN 0 WHEN (athcu.SOWRY = 'z') THEN fc.OZTQF WHEN (athcu.SOWRY = 'o') THEN (fc.OZTQF - 1) END as OZTQF]\n" +
@@ -9480,10 +9438,7 @@ WHERE
 			"                 │           ├─ colSet: (133-136)\n" +
 			"                 │           ├─ tableId: 16\n" +
 			"                 │           └─ Project\n" +
-			"                 │               ├─ columns: [nd.TW55N:13!null as KUXQY, sn.id:0!null as BDNYB, nma.DZLIM:28!null as YHVEZ, CASE  WHEN LessThan\n" +
-			"                 │               │   ├─ nd.TCE7A:20\n" +
-			"                 │               │   └─ 0.9 (decimal(2,1))\n" +
-			"                 │               │   THEN 1 (tinyint) ELSE 0 (tinyint) END as YAZ4X]\n" +
+			"                 │               ├─ columns: [nd.TW55N:13!null as KUXQY, sn.id:0!null as BDNYB, nma.DZLIM:28!null as YHVEZ, YAZ4X:33!null]\n" +
 			"                 │               └─ Sort(sn.id:0!null ASC nullsFirst)\n" +
 			"                 │                   └─ Project\n" +
 			"                 │                       ├─ columns: [sn.id:0!null, sn.BRQP2:1!null, sn.FFTBJ:2!null, sn.A7XO2:3, sn.KBO7R:4!null, sn.ECDKM:5, sn.NUMK2:6!null, sn.LETOE:7!null, sn.YKSSU:8, sn.FHCYT:9, nd.id:10!null, nd.DKCAJ:11!null, nd.KNG7T:12, nd.TW55N:13!null, nd.QRQXW:14!null, nd.ECXAJ:15!null, nd.FGG57:16, nd.ZH72S:17, nd.FSK67:18!null, nd.XQDYT:19!null, nd.TCE7A:20, nd.IWV2H:21, nd.HPCMS:22!null, nd.N5CC2:23, nd.FHCYT:24, nd.ETAQ7:25, nd.A75X7:26, nma.id:27!null, nma.DZLIM:28!null, nma.F3YUE:29, nd.TW55N:13!null as KUXQY, sn.id:0!null as BDNYB, nma.DZLIM:28!null as YHVEZ, CASE  WHEN LessThan\n" +
@@ -9628,7 +9583,7 @@ WHERE
 			"                 │           ├─ isLateral: false\n" +
 			"                 │           ├─ cacheable: true\n" +
 			"                 │           └─ Project\n" +
-			"                 │               ├─ columns: [nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, CASE  WHEN (nd.TCE7A < 0.9) THEN 1 ELSE 0 END as YAZ4X]\n" +
+			"                 │               ├─ columns: [nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, YAZ4X]\n" +
 			"                 │               └─ Sort(sn.id ASC)\n" +
 			"                 │                   └─ Project\n" +
 			"                 │                       ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, nd.id, nd.DKCAJ, nd.KNG7T, nd.TW55N, nd.QRQXW, nd.ECXAJ, nd.FGG57, nd.ZH72S, nd.FSK67, nd.XQDYT, nd.TCE7A, nd.IWV2H, nd.HPCMS, nd.N5CC2, nd.FHCYT, nd.ETAQ7, nd.A75X7, nma.id, nma.DZLIM, nma.F3YUE, nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, CASE  WHEN (nd.TCE7A < 0.9) THEN 1 ELSE 0 END as YAZ4X]\n" +
@@ -9747,7 +9702,7 @@ WHERE
 			"                 │           ├─ isLateral: false\n" +
 			"                 │           ├─ cacheable: true\n" +
 			"                 │           └─ Project\n" +
-			"                 │               ├─ columns: [nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, CASE  WHEN (nd.TCE7A < 0.9) THEN 1 ELSE 0 END as YAZ4X]\n" +
+			"                 │               ├─ columns: [nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, YAZ4X]\n" +
 			"                 │               └─ Sort(sn.id ASC)\n" +
 			"                 │                   └─ Project\n" +
 			"                 │                       ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, nd.id, nd.DKCAJ, nd.KNG7T, nd.TW55N, nd.QRQXW, nd.ECXAJ, nd.FGG57, nd.ZH72S, nd.FSK67, nd.XQDYT, nd.TCE7A, nd.IWV2H, nd.HPCMS, nd.N5CC2, nd.FHCYT, nd.ETAQ7, nd.A75X7, nma.id, nma.DZLIM, nma.F3YUE, nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, CASE  WHEN (nd.TCE7A < 0.9) THEN 1 ELSE 0 END as YAZ4X]\n" +
@@ -9980,10 +9935,7 @@ WHERE
 			"                 │           ├─ colSet: (133-136)\n" +
 			"                 │           ├─ tableId: 16\n" +
 			"                 │           └─ Project\n" +
-			"                 │               ├─ columns: [nd.TW55N:13!null as KUXQY, sn.id:0!null as BDNYB, nma.DZLIM:28!null as YHVEZ, CASE  WHEN LessThan\n" +
-			"                 │               │   ├─ nd.TCE7A:20\n" +
-			"                 │               │   └─ 0.9 (decimal(2,1))\n" +
-			"                 │               │   THEN 1 (tinyint) ELSE 0 (tinyint) END as YAZ4X]\n" +
+			"                 │               ├─ columns: [nd.TW55N:13!null as KUXQY, sn.id:0!null as BDNYB, nma.DZLIM:28!null as YHVEZ, YAZ4X:33!null]\n" +
 			"                 │               └─ Sort(sn.id:0!null ASC nullsFirst)\n" +
 			"                 │                   └─ Project\n" +
 			"                 │                       ├─ columns: [sn.id:0!null, sn.BRQP2:1!null, sn.FFTBJ:2!null, sn.A7XO2:3, sn.KBO7R:4!null, sn.ECDKM:5, sn.NUMK2:6!null, sn.LETOE:7!null, sn.YKSSU:8, sn.FHCYT:9, nd.id:10!null, nd.DKCAJ:11!null, nd.KNG7T:12, nd.TW55N:13!null, nd.QRQXW:14!null, nd.ECXAJ:15!null, nd.FGG57:16, nd.ZH72S:17, nd.FSK67:18!null, nd.XQDYT:19!null, nd.TCE7A:20, nd.IWV2H:21, nd.HPCMS:22!null, nd.N5CC2:23, nd.FHCYT:24, nd.ETAQ7:25, nd.A75X7:26, nma.id:27!null, nma.DZLIM:28!null, nma.F3YUE:29, nd.TW55N:13!null as KUXQY, sn.id:0!null as BDNYB, nma.DZLIM:28!null as YHVEZ, CASE  WHEN LessThan\n" +
@@ -10126,7 +10078,7 @@ WHERE
 			"                 │           ├─ isLateral: false\n" +
 			"                 │           ├─ cacheable: true\n" +
 			"                 │           └─ Project\n" +
-			"                 │               ├─ columns: [nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, CASE  WHEN (nd.TCE7A < 0.9) THEN 1 ELSE 0 END as YAZ4X]\n" +
+			"                 │               ├─ columns: [nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, YAZ4X]\n" +
 			"                 │               └─ Sort(sn.id ASC)\n" +
 			"                 │                   └─ Project\n" +
 			"                 │                       ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, nd.id, nd.DKCAJ, nd.KNG7T, nd.TW55N, nd.QRQXW, nd.ECXAJ, nd.FGG57, nd.ZH72S, nd.FSK67, nd.XQDYT, nd.TCE7A, nd.IWV2H, nd.HPCMS, nd.N5CC2, nd.FHCYT, nd.ETAQ7, nd.A75X7, nma.id, nma.DZLIM, nma.F3YUE, nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, CASE  WHEN (nd.TCE7A < 0.9) THEN 1 ELSE 0 END as YAZ4X]\n" +
@@ -10243,7 +10195,7 @@ WHERE
 			"                 │           ├─ isLateral: false\n" +
 			"                 │           ├─ cacheable: true\n" +
 			"                 │           └─ Project\n" +
-			"                 │               ├─ columns: [nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, CASE  WHEN (nd.TCE7A < 0.9) THEN 1 ELSE 0 END as YAZ4X]\n" +
+			"                 │               ├─ columns: [nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, YAZ4X]\n" +
 			"                 │               └─ Sort(sn.id ASC)\n" +
 			"                 │                   └─ Project\n" +
 			"                 │                       ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, nd.id, nd.DKCAJ, nd.KNG7T, nd.TW55N, nd.QRQXW, nd.ECXAJ, nd.FGG57, nd.ZH72S, nd.FSK67, nd.XQDYT, nd.TCE7A, nd.IWV2H, nd.HPCMS, nd.N5CC2, nd.FHCYT, nd.ETAQ7, nd.A75X7, nma.id, nma.DZLIM, nma.F3YUE, nd.TW55N as KUXQY, sn.id as BDNYB, nma.DZLIM as YHVEZ, CASE  WHEN (nd.TCE7A < 0.9) THEN 1 ELSE 0 END as YAZ4X]\n" +
@@ -10678,252 +10630,233 @@ WHERE
 			"         │   │   │   ├─ colSet: (111-121)\n" +
 			"         │   │   │   ├─ tableId: 16\n" +
 			"         │   │   │   └─ Union distinct\n" +
-			"         │   │   │       ├─ Project\n" +
-			"         │   │   │       │   ├─ columns: [jchir.FJDP5:0!null, jchir.BJUF2:1!null, jchir.PSMU6:2!null, jchir.M22QN:3!null, jchir.GE5EL:4, jchir.F7A4Q:5, jchir.ESFVY:6!null, jchir.CC4AX:7, jchir.SL76B:8!null, convert\n" +
-			"         │   │   │       │   │   ├─ type: char\n" +
-			"         │   │   │       │   │   └─ jchir.QNI57:9!null\n" +
-			"         │   │   │       │   │   as QNI57, TDEIU:10 as TDEIU]\n" +
-			"         │   │   │       │   └─ Union distinct\n" +
-			"         │   │   │       │       ├─ Project\n" +
-			"         │   │   │       │       │   ├─ columns: [jchir.FJDP5:0!null, jchir.BJUF2:1!null, jchir.PSMU6:2!null, jchir.M22QN:3!null, jchir.GE5EL:4, jchir.F7A4Q:5, jchir.ESFVY:6!null, jchir.CC4AX:7, jchir.SL76B:8!null, jchir.QNI57:9!null, convert\n" +
-			"         │   │   │       │       │   │   ├─ type: char\n" +
-			"         │   │   │       │       │   │   └─ jchir.TDEIU:10!null\n" +
-			"         │   │   │       │       │   │   as TDEIU]\n" +
-			"         │   │   │       │       │   └─ SubqueryAlias\n" +
-			"         │   │   │       │       │       ├─ name: jchir\n" +
-			"         │   │   │       │       │       ├─ outerVisibility: false\n" +
-			"         │   │   │       │       │       ├─ isLateral: false\n" +
-			"         │   │   │       │       │       ├─ cacheable: true\n" +
-			"         │   │   │       │       │       ├─ colSet: (87-97)\n" +
-			"         │   │   │       │       │       ├─ tableId: 10\n" +
-			"         │   │   │       │       │       └─ Filter\n" +
-			"         │   │   │       │       │           ├─ Or\n" +
-			"         │   │   │       │       │           │   ├─ AND\n" +
-			"         │   │   │       │       │           │   │   ├─ NOT\n" +
-			"         │   │   │       │       │           │   │   │   └─ QNI57:9!null IS NULL\n" +
-			"         │   │   │       │       │           │   │   └─ TDEIU:10!null IS NULL\n" +
-			"         │   │   │       │       │           │   └─ AND\n" +
-			"         │   │   │       │       │           │       ├─ QNI57:9!null IS NULL\n" +
-			"         │   │   │       │       │           │       └─ NOT\n" +
-			"         │   │   │       │       │           │           └─ TDEIU:10!null IS NULL\n" +
-			"         │   │   │       │       │           └─ Project\n" +
-			"         │   │   │       │       │               ├─ columns: [ism.FV24E:0!null as FJDP5, cpmfe.id:12!null as BJUF2, cpmfe.TW55N:13!null as PSMU6, ism.M22QN:2!null as M22QN, g3yxs.GE5EL:8, g3yxs.F7A4Q:9, g3yxs.ESFVY:6!null, CASE  WHEN IN\n" +
-			"         │   │   │       │       │               │   ├─ left: g3yxs.SL76B:7!null\n" +
-			"         │   │   │       │       │               │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
-			"         │   │   │       │       │               │   THEN 0 (tinyint) WHEN IN\n" +
-			"         │   │   │       │       │               │   ├─ left: g3yxs.SL76B:7!null\n" +
-			"         │   │   │       │       │               │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
-			"         │   │   │       │       │               │   THEN 1 (tinyint) WHEN IN\n" +
-			"         │   │   │       │       │               │   ├─ left: g3yxs.SL76B:7!null\n" +
-			"         │   │   │       │       │               │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
-			"         │   │   │       │       │               │   THEN 2 (tinyint) WHEN IN\n" +
-			"         │   │   │       │       │               │   ├─ left: g3yxs.SL76B:7!null\n" +
-			"         │   │   │       │       │               │   └─ right: TUPLE(Y62X7 (longtext))\n" +
-			"         │   │   │       │       │               │   THEN 3 (tinyint) END as CC4AX, g3yxs.SL76B:7!null as SL76B, yqif4.id:15!null as QNI57, yvhjz.id:18!null as TDEIU]\n" +
-			"         │   │   │       │       │               └─ Filter\n" +
-			"         │   │   │       │       │                   ├─ Or\n" +
-			"         │   │   │       │       │                   │   ├─ NOT\n" +
-			"         │   │   │       │       │                   │   │   └─ yqif4.id:15!null IS NULL\n" +
-			"         │   │   │       │       │                   │   └─ NOT\n" +
-			"         │   │   │       │       │                   │       └─ yvhjz.id:18!null IS NULL\n" +
-			"         │   │   │       │       │                   └─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │       │                       ├─ Eq\n" +
-			"         │   │   │       │       │                       │   ├─ yvhjz.BRQP2:19!null\n" +
-			"         │   │   │       │       │                       │   └─ ism.UJ6XY:1!null\n" +
-			"         │   │   │       │       │                       ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │       │                       │   ├─ Eq\n" +
-			"         │   │   │       │       │                       │   │   ├─ yqif4.BRQP2:16!null\n" +
-			"         │   │   │       │       │                       │   │   └─ ism.FV24E:0!null\n" +
-			"         │   │   │       │       │                       │   ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │       │                       │   │   ├─ NOT\n" +
-			"         │   │   │       │       │                       │   │   │   └─ Eq\n" +
-			"         │   │   │       │       │                       │   │   │       ├─ cpmfe.id:12!null\n" +
-			"         │   │   │       │       │                       │   │   │       └─ ism.FV24E:0!null\n" +
-			"         │   │   │       │       │                       │   │   ├─ LeftOuterHashJoin\n" +
-			"         │   │   │       │       │                       │   │   │   ├─ Eq\n" +
-			"         │   │   │       │       │                       │   │   │   │   ├─ nhmxw.id:10!null\n" +
-			"         │   │   │       │       │                       │   │   │   │   └─ ism.PRUV2:4\n" +
-			"         │   │   │       │       │                       │   │   │   ├─ MergeJoin\n" +
-			"         │   │   │       │       │                       │   │   │   │   ├─ cmp: Eq\n" +
-			"         │   │   │       │       │                       │   │   │   │   │   ├─ ism.NZ4MQ:3!null\n" +
-			"         │   │   │       │       │                       │   │   │   │   │   └─ g3yxs.id:5!null\n" +
-			"         │   │   │       │       │                       │   │   │   │   ├─ TableAlias(ism)\n" +
-			"         │   │   │       │       │                       │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       ├─ colSet: (15-23)\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       ├─ tableId: 4\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       └─ Table\n" +
-			"         │   │   │       │       │                       │   │   │   │   │           ├─ name: HDDVB\n" +
-			"         │   │   │       │       │                       │   │   │   │   │           └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"         │   │   │       │       │                       │   │   │   │   └─ TableAlias(g3yxs)\n" +
-			"         │   │   │       │       │                       │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
-			"         │   │   │       │       │                       │   │   │   │           ├─ index: [YYBCX.id]\n" +
-			"         │   │   │       │       │                       │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │       │                       │   │   │   │           ├─ colSet: (24-31)\n" +
-			"         │   │   │       │       │                       │   │   │   │           ├─ tableId: 5\n" +
-			"         │   │   │       │       │                       │   │   │   │           └─ Table\n" +
-			"         │   │   │       │       │                       │   │   │   │               ├─ name: YYBCX\n" +
-			"         │   │   │       │       │                       │   │   │   │               └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"         │   │   │       │       │                       │   │   │   └─ HashLookup\n" +
-			"         │   │   │       │       │                       │   │   │       ├─ left-key: TUPLE(ism.PRUV2:4)\n" +
-			"         │   │   │       │       │                       │   │   │       ├─ right-key: TUPLE(nhmxw.id:0!null)\n" +
-			"         │   │   │       │       │                       │   │   │       └─ TableAlias(nhmxw)\n" +
-			"         │   │   │       │       │                       │   │   │           └─ Table\n" +
-			"         │   │   │       │       │                       │   │   │               ├─ name: WGSDC\n" +
-			"         │   │   │       │       │                       │   │   │               ├─ columns: [id nohhr]\n" +
-			"         │   │   │       │       │                       │   │   │               ├─ colSet: (32-41)\n" +
-			"         │   │   │       │       │                       │   │   │               └─ tableId: 6\n" +
-			"         │   │   │       │       │                       │   │   └─ TableAlias(cpmfe)\n" +
-			"         │   │   │       │       │                       │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │   │       │       │                       │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"         │   │   │       │       │                       │   │           ├─ keys: [nhmxw.NOHHR:11!null]\n" +
-			"         │   │   │       │       │                       │   │           ├─ colSet: (42-58)\n" +
-			"         │   │   │       │       │                       │   │           ├─ tableId: 7\n" +
-			"         │   │   │       │       │                       │   │           └─ Table\n" +
-			"         │   │   │       │       │                       │   │               ├─ name: E2I7U\n" +
-			"         │   │   │       │       │                       │   │               └─ columns: [id tw55n zh72s]\n" +
-			"         │   │   │       │       │                       │   └─ TableAlias(yqif4)\n" +
-			"         │   │   │       │       │                       │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │       │                       │           ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │       │                       │           ├─ keys: [ism.UJ6XY:1!null]\n" +
-			"         │   │   │       │       │                       │           ├─ colSet: (59-68)\n" +
-			"         │   │   │       │       │                       │           ├─ tableId: 8\n" +
-			"         │   │   │       │       │                       │           └─ Table\n" +
-			"         │   │   │       │       │                       │               ├─ name: NOXN3\n" +
-			"         │   │   │       │       │                       │               └─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   │       │       │                       └─ TableAlias(yvhjz)\n" +
-			"         │   │   │       │       │                           └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │       │                               ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │       │                               ├─ keys: [ism.FV24E:0!null]\n" +
-			"         │   │   │       │       │                               ├─ colSet: (69-78)\n" +
-			"         │   │   │       │       │                               ├─ tableId: 9\n" +
-			"         │   │   │       │       │                               └─ Table\n" +
-			"         │   │   │       │       │                                   ├─ name: NOXN3\n" +
-			"         │   │   │       │       │                                   └─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       ├─ Union distinct\n" +
+			"         │   │   │       │   ├─ SubqueryAlias\n" +
+			"         │   │   │       │   │   ├─ name: jchir\n" +
+			"         │   │   │       │   │   ├─ outerVisibility: false\n" +
+			"         │   │   │       │   │   ├─ isLateral: false\n" +
+			"         │   │   │       │   │   ├─ cacheable: true\n" +
+			"         │   │   │       │   │   ├─ colSet: (87-97)\n" +
+			"         │   │   │       │   │   ├─ tableId: 10\n" +
+			"         │   │   │       │   │   └─ Filter\n" +
+			"         │   │   │       │   │       ├─ Or\n" +
+			"         │   │   │       │   │       │   ├─ AND\n" +
+			"         │   │   │       │   │       │   │   ├─ NOT\n" +
+			"         │   │   │       │   │       │   │   │   └─ QNI57:9!null IS NULL\n" +
+			"         │   │   │       │   │       │   │   └─ TDEIU:10!null IS NULL\n" +
+			"         │   │   │       │   │       │   └─ AND\n" +
+			"         │   │   │       │   │       │       ├─ QNI57:9!null IS NULL\n" +
+			"         │   │   │       │   │       │       └─ NOT\n" +
+			"         │   │   │       │   │       │           └─ TDEIU:10!null IS NULL\n" +
+			"         │   │   │       │   │       └─ Project\n" +
+			"         │   │   │       │   │           ├─ columns: [ism.FV24E:0!null as FJDP5, cpmfe.id:12!null as BJUF2, cpmfe.TW55N:13!null as PSMU6, ism.M22QN:2!null as M22QN, g3yxs.GE5EL:8, g3yxs.F7A4Q:9, g3yxs.ESFVY:6!null, CASE  WHEN IN\n" +
+			"         │   │   │       │   │           │   ├─ left: g3yxs.SL76B:7!null\n" +
+			"         │   │   │       │   │           │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
+			"         │   │   │       │   │           │   THEN 0 (tinyint) WHEN IN\n" +
+			"         │   │   │       │   │           │   ├─ left: g3yxs.SL76B:7!null\n" +
+			"         │   │   │       │   │           │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
+			"         │   │   │       │   │           │   THEN 1 (tinyint) WHEN IN\n" +
+			"         │   │   │       │   │           │   ├─ left: g3yxs.SL76B:7!null\n" +
+			"         │   │   │       │   │           │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
+			"         │   │   │       │   │           │   THEN 2 (tinyint) WHEN IN\n" +
+			"         │   │   │       │   │           │   ├─ left: g3yxs.SL76B:7!null\n" +
+			"         │   │   │       │   │           │   └─ right: TUPLE(Y62X7 (longtext))\n" +
+			"         │   │   │       │   │           │   THEN 3 (tinyint) END as CC4AX, g3yxs.SL76B:7!null as SL76B, yqif4.id:15!null as QNI57, yvhjz.id:18!null as TDEIU]\n" +
+			"         │   │   │       │   │           └─ Filter\n" +
+			"         │   │   │       │   │               ├─ Or\n" +
+			"         │   │   │       │   │               │   ├─ NOT\n" +
+			"         │   │   │       │   │               │   │   └─ yqif4.id:15!null IS NULL\n" +
+			"         │   │   │       │   │               │   └─ NOT\n" +
+			"         │   │   │       │   │               │       └─ yvhjz.id:18!null IS NULL\n" +
+			"         │   │   │       │   │               └─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │   │                   ├─ Eq\n" +
+			"         │   │   │       │   │                   │   ├─ yvhjz.BRQP2:19!null\n" +
+			"         │   │   │       │   │                   │   └─ ism.UJ6XY:1!null\n" +
+			"         │   │   │       │   │                   ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │   │                   │   ├─ Eq\n" +
+			"         │   │   │       │   │                   │   │   ├─ yqif4.BRQP2:16!null\n" +
+			"         │   │   │       │   │                   │   │   └─ ism.FV24E:0!null\n" +
+			"         │   │   │       │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │   │                   │   │   ├─ NOT\n" +
+			"         │   │   │       │   │                   │   │   │   └─ Eq\n" +
+			"         │   │   │       │   │                   │   │   │       ├─ cpmfe.id:12!null\n" +
+			"         │   │   │       │   │                   │   │   │       └─ ism.FV24E:0!null\n" +
+			"         │   │   │       │   │                   │   │   ├─ LeftOuterHashJoin\n" +
+			"         │   │   │       │   │                   │   │   │   ├─ Eq\n" +
+			"         │   │   │       │   │                   │   │   │   │   ├─ nhmxw.id:10!null\n" +
+			"         │   │   │       │   │                   │   │   │   │   └─ ism.PRUV2:4\n" +
+			"         │   │   │       │   │                   │   │   │   ├─ MergeJoin\n" +
+			"         │   │   │       │   │                   │   │   │   │   ├─ cmp: Eq\n" +
+			"         │   │   │       │   │                   │   │   │   │   │   ├─ ism.NZ4MQ:3!null\n" +
+			"         │   │   │       │   │                   │   │   │   │   │   └─ g3yxs.id:5!null\n" +
+			"         │   │   │       │   │                   │   │   │   │   ├─ TableAlias(ism)\n" +
+			"         │   │   │       │   │                   │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       ├─ colSet: (15-23)\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       ├─ tableId: 4\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       └─ Table\n" +
+			"         │   │   │       │   │                   │   │   │   │   │           ├─ name: HDDVB\n" +
+			"         │   │   │       │   │                   │   │   │   │   │           └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"         │   │   │       │   │                   │   │   │   │   └─ TableAlias(g3yxs)\n" +
+			"         │   │   │       │   │                   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
+			"         │   │   │       │   │                   │   │   │   │           ├─ index: [YYBCX.id]\n" +
+			"         │   │   │       │   │                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │   │                   │   │   │   │           ├─ colSet: (24-31)\n" +
+			"         │   │   │       │   │                   │   │   │   │           ├─ tableId: 5\n" +
+			"         │   │   │       │   │                   │   │   │   │           └─ Table\n" +
+			"         │   │   │       │   │                   │   │   │   │               ├─ name: YYBCX\n" +
+			"         │   │   │       │   │                   │   │   │   │               └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"         │   │   │       │   │                   │   │   │   └─ HashLookup\n" +
+			"         │   │   │       │   │                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:4)\n" +
+			"         │   │   │       │   │                   │   │   │       ├─ right-key: TUPLE(nhmxw.id:0!null)\n" +
+			"         │   │   │       │   │                   │   │   │       └─ TableAlias(nhmxw)\n" +
+			"         │   │   │       │   │                   │   │   │           └─ Table\n" +
+			"         │   │   │       │   │                   │   │   │               ├─ name: WGSDC\n" +
+			"         │   │   │       │   │                   │   │   │               ├─ columns: [id nohhr]\n" +
+			"         │   │   │       │   │                   │   │   │               ├─ colSet: (32-41)\n" +
+			"         │   │   │       │   │                   │   │   │               └─ tableId: 6\n" +
+			"         │   │   │       │   │                   │   │   └─ TableAlias(cpmfe)\n" +
+			"         │   │   │       │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"         │   │   │       │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"         │   │   │       │   │                   │   │           ├─ keys: [nhmxw.NOHHR:11!null]\n" +
+			"         │   │   │       │   │                   │   │           ├─ colSet: (42-58)\n" +
+			"         │   │   │       │   │                   │   │           ├─ tableId: 7\n" +
+			"         │   │   │       │   │                   │   │           └─ Table\n" +
+			"         │   │   │       │   │                   │   │               ├─ name: E2I7U\n" +
+			"         │   │   │       │   │                   │   │               └─ columns: [id tw55n zh72s]\n" +
+			"         │   │   │       │   │                   │   └─ TableAlias(yqif4)\n" +
+			"         │   │   │       │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │   │                   │           ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │   │                   │           ├─ keys: [ism.UJ6XY:1!null]\n" +
+			"         │   │   │       │   │                   │           ├─ colSet: (59-68)\n" +
+			"         │   │   │       │   │                   │           ├─ tableId: 8\n" +
+			"         │   │   │       │   │                   │           └─ Table\n" +
+			"         │   │   │       │   │                   │               ├─ name: NOXN3\n" +
+			"         │   │   │       │   │                   │               └─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │   │                   └─ TableAlias(yvhjz)\n" +
+			"         │   │   │       │   │                       └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │   │                           ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │   │                           ├─ keys: [ism.FV24E:0!null]\n" +
+			"         │   │   │       │   │                           ├─ colSet: (69-78)\n" +
+			"         │   │   │       │   │                           ├─ tableId: 9\n" +
+			"         │   │   │       │   │                           └─ Table\n" +
+			"         │   │   │       │   │                               ├─ name: NOXN3\n" +
+			"         │   │   │       │   │                               └─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │   └─ Project\n" +
+			"         │   │   │       │       ├─ columns: [jchir.FJDP5:0!null, jchir.BJUF2:1!null, jchir.PSMU6:2!null, jchir.M22QN:3!null, jchir.GE5EL:4, jchir.F7A4Q:5, jchir.ESFVY:6!null, jchir.CC4AX:7, jchir.SL76B:8!null, jchir.QNI57:9!null, coerce(TDEIU:10, varchar(24)) as TDEIU]\n" +
 			"         │   │   │       │       └─ Project\n" +
-			"         │   │   │       │           ├─ columns: [jchir.FJDP5:0!null, jchir.BJUF2:1!null, jchir.PSMU6:2!null, jchir.M22QN:3!null, jchir.GE5EL:4, jchir.F7A4Q:5, jchir.ESFVY:6!null, jchir.CC4AX:7, jchir.SL76B:8!null, jchir.QNI57:9!null, convert\n" +
-			"         │   │   │       │           │   ├─ type: char\n" +
-			"         │   │   │       │           │   └─ TDEIU:10\n" +
-			"         │   │   │       │           │   as TDEIU]\n" +
-			"         │   │   │       │           └─ Project\n" +
-			"         │   │   │       │               ├─ columns: [jchir.FJDP5:0!null, jchir.BJUF2:1!null, jchir.PSMU6:2!null, jchir.M22QN:3!null, jchir.GE5EL:4, jchir.F7A4Q:5, jchir.ESFVY:6!null, jchir.CC4AX:7, jchir.SL76B:8!null, jchir.QNI57:9!null, NULL (null) as TDEIU]\n" +
-			"         │   │   │       │               └─ SubqueryAlias\n" +
-			"         │   │   │       │                   ├─ name: jchir\n" +
-			"         │   │   │       │                   ├─ outerVisibility: false\n" +
-			"         │   │   │       │                   ├─ isLateral: false\n" +
-			"         │   │   │       │                   ├─ cacheable: true\n" +
-			"         │   │   │       │                   ├─ colSet: (87-97)\n" +
-			"         │   │   │       │                   ├─ tableId: 10\n" +
-			"         │   │   │       │                   └─ Filter\n" +
-			"         │   │   │       │                       ├─ AND\n" +
-			"         │   │   │       │                       │   ├─ NOT\n" +
-			"         │   │   │       │                       │   │   └─ QNI57:9!null IS NULL\n" +
-			"         │   │   │       │                       │   └─ NOT\n" +
-			"         │   │   │       │                       │       └─ TDEIU:10!null IS NULL\n" +
-			"         │   │   │       │                       └─ Project\n" +
-			"         │   │   │       │                           ├─ columns: [ism.FV24E:0!null as FJDP5, cpmfe.id:12!null as BJUF2, cpmfe.TW55N:13!null as PSMU6, ism.M22QN:2!null as M22QN, g3yxs.GE5EL:8, g3yxs.F7A4Q:9, g3yxs.ESFVY:6!null, CASE  WHEN IN\n" +
-			"         │   │   │       │                           │   ├─ left: g3yxs.SL76B:7!null\n" +
-			"         │   │   │       │                           │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
-			"         │   │   │       │                           │   THEN 0 (tinyint) WHEN IN\n" +
-			"         │   │   │       │                           │   ├─ left: g3yxs.SL76B:7!null\n" +
-			"         │   │   │       │                           │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
-			"         │   │   │       │                           │   THEN 1 (tinyint) WHEN IN\n" +
-			"         │   │   │       │                           │   ├─ left: g3yxs.SL76B:7!null\n" +
-			"         │   │   │       │                           │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
-			"         │   │   │       │                           │   THEN 2 (tinyint) WHEN IN\n" +
-			"         │   │   │       │                           │   ├─ left: g3yxs.SL76B:7!null\n" +
-			"         │   │   │       │                           │   └─ right: TUPLE(Y62X7 (longtext))\n" +
-			"         │   │   │       │                           │   THEN 3 (tinyint) END as CC4AX, g3yxs.SL76B:7!null as SL76B, yqif4.id:15!null as QNI57, yvhjz.id:18!null as TDEIU]\n" +
-			"         │   │   │       │                           └─ Filter\n" +
-			"         │   │   │       │                               ├─ Or\n" +
-			"         │   │   │       │                               │   ├─ NOT\n" +
-			"         │   │   │       │                               │   │   └─ yqif4.id:15!null IS NULL\n" +
-			"         │   │   │       │                               │   └─ NOT\n" +
-			"         │   │   │       │                               │       └─ yvhjz.id:18!null IS NULL\n" +
-			"         │   │   │       │                               └─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │                                   ├─ Eq\n" +
-			"         │   │   │       │                                   │   ├─ yvhjz.BRQP2:19!null\n" +
-			"         │   │   │       │                                   │   └─ ism.UJ6XY:1!null\n" +
-			"         │   │   │       │                                   ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │                                   │   ├─ Eq\n" +
-			"         │   │   │       │                                   │   │   ├─ yqif4.BRQP2:16!null\n" +
-			"         │   │   │       │                                   │   │   └─ ism.FV24E:0!null\n" +
-			"         │   │   │       │                                   │   ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │                                   │   │   ├─ NOT\n" +
-			"         │   │   │       │                                   │   │   │   └─ Eq\n" +
-			"         │   │   │       │                                   │   │   │       ├─ cpmfe.id:12!null\n" +
-			"         │   │   │       │                                   │   │   │       └─ ism.FV24E:0!null\n" +
-			"         │   │   │       │                                   │   │   ├─ LeftOuterHashJoin\n" +
-			"         │   │   │       │                                   │   │   │   ├─ Eq\n" +
-			"         │   │   │       │                                   │   │   │   │   ├─ nhmxw.id:10!null\n" +
-			"         │   │   │       │                                   │   │   │   │   └─ ism.PRUV2:4\n" +
-			"         │   │   │       │                                   │   │   │   ├─ MergeJoin\n" +
-			"         │   │   │       │                                   │   │   │   │   ├─ cmp: Eq\n" +
-			"         │   │   │       │                                   │   │   │   │   │   ├─ ism.NZ4MQ:3!null\n" +
-			"         │   │   │       │                                   │   │   │   │   │   └─ g3yxs.id:5!null\n" +
-			"         │   │   │       │                                   │   │   │   │   ├─ TableAlias(ism)\n" +
-			"         │   │   │       │                                   │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
-			"         │   │   │       │                                   │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
-			"         │   │   │       │                                   │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │                                   │   │   │   │   │       ├─ colSet: (15-23)\n" +
-			"         │   │   │       │                                   │   │   │   │   │       ├─ tableId: 4\n" +
-			"         │   │   │       │                                   │   │   │   │   │       └─ Table\n" +
-			"         │   │   │       │                                   │   │   │   │   │           ├─ name: HDDVB\n" +
-			"         │   │   │       │                                   │   │   │   │   │           └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"         │   │   │       │                                   │   │   │   │   └─ TableAlias(g3yxs)\n" +
-			"         │   │   │       │                                   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
-			"         │   │   │       │                                   │   │   │   │           ├─ index: [YYBCX.id]\n" +
-			"         │   │   │       │                                   │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │                                   │   │   │   │           ├─ colSet: (24-31)\n" +
-			"         │   │   │       │                                   │   │   │   │           ├─ tableId: 5\n" +
-			"         │   │   │       │                                   │   │   │   │           └─ Table\n" +
-			"         │   │   │       │                                   │   │   │   │               ├─ name: YYBCX\n" +
-			"         │   │   │       │                                   │   │   │   │               └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"         │   │   │       │                                   │   │   │   └─ HashLookup\n" +
-			"         │   │   │       │                                   │   │   │       ├─ left-key: TUPLE(ism.PRUV2:4)\n" +
-			"         │   │   │       │                                   │   │   │       ├─ right-key: TUPLE(nhmxw.id:0!null)\n" +
-			"         │   │   │       │                                   │   │   │       └─ TableAlias(nhmxw)\n" +
-			"         │   │   │       │                                   │   │   │           └─ Table\n" +
-			"         │   │   │       │                                   │   │   │               ├─ name: WGSDC\n" +
-			"         │   │   │       │                                   │   │   │               ├─ columns: [id nohhr]\n" +
-			"         │   │   │       │                                   │   │   │               ├─ colSet: (32-41)\n" +
-			"         │   │   │       │                                   │   │   │               └─ tableId: 6\n" +
-			"         │   │   │       │                                   │   │   └─ TableAlias(cpmfe)\n" +
-			"         │   │   │       │                                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │   │       │                                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"         │   │   │       │                                   │   │           ├─ keys: [nhmxw.NOHHR:11!null]\n" +
-			"         │   │   │       │                                   │   │           ├─ colSet: (42-58)\n" +
-			"         │   │   │       │                                   │   │           ├─ tableId: 7\n" +
-			"         │   │   │       │                                   │   │           └─ Table\n" +
-			"         │   │   │       │                                   │   │               ├─ name: E2I7U\n" +
-			"         │   │   │       │                                   │   │               └─ columns: [id tw55n zh72s]\n" +
-			"         │   │   │       │                                   │   └─ TableAlias(yqif4)\n" +
-			"         │   │   │       │                                   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │                                   │           ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │                                   │           ├─ keys: [ism.UJ6XY:1!null]\n" +
-			"         │   │   │       │                                   │           ├─ colSet: (59-68)\n" +
-			"         │   │   │       │                                   │           ├─ tableId: 8\n" +
-			"         │   │   │       │                                   │           └─ Table\n" +
-			"         │   │   │       │                                   │               ├─ name: NOXN3\n" +
-			"         │   │   │       │                                   │               └─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   │       │                                   └─ TableAlias(yvhjz)\n" +
-			"         │   │   │       │                                       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │                                           ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │                                           ├─ keys: [ism.FV24E:0!null]\n" +
-			"         │   │   │       │                                           ├─ colSet: (69-78)\n" +
-			"         │   │   │       │                                           ├─ tableId: 9\n" +
-			"         │   │   │       │                                           └─ Table\n" +
-			"         │   │   │       │                                               ├─ name: NOXN3\n" +
-			"         │   │   │       │                                               └─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │           ├─ columns: [jchir.FJDP5:0!null, jchir.BJUF2:1!null, jchir.PSMU6:2!null, jchir.M22QN:3!null, jchir.GE5EL:4, jchir.F7A4Q:5, jchir.ESFVY:6!null, jchir.CC4AX:7, jchir.SL76B:8!null, jchir.QNI57:9!null, NULL (null) as TDEIU]\n" +
+			"         │   │   │       │           └─ SubqueryAlias\n" +
+			"         │   │   │       │               ├─ name: jchir\n" +
+			"         │   │   │       │               ├─ outerVisibility: false\n" +
+			"         │   │   │       │               ├─ isLateral: false\n" +
+			"         │   │   │       │               ├─ cacheable: true\n" +
+			"         │   │   │       │               ├─ colSet: (87-97)\n" +
+			"         │   │   │       │               ├─ tableId: 10\n" +
+			"         │   │   │       │               └─ Filter\n" +
+			"         │   │   │       │                   ├─ AND\n" +
+			"         │   │   │       │                   │   ├─ NOT\n" +
+			"         │   │   │       │                   │   │   └─ QNI57:9!null IS NULL\n" +
+			"         │   │   │       │                   │   └─ NOT\n" +
+			"         │   │   │       │                   │       └─ TDEIU:10!null IS NULL\n" +
+			"         │   │   │       │                   └─ Project\n" +
+			"         │   │   │       │                       ├─ columns: [ism.FV24E:0!null as FJDP5, cpmfe.id:12!null as BJUF2, cpmfe.TW55N:13!null as PSMU6, ism.M22QN:2!null as M22QN, g3yxs.GE5EL:8, g3yxs.F7A4Q:9, g3yxs.ESFVY:6!null, CASE  WHEN IN\n" +
+			"         │   │   │       │                       │   ├─ left: g3yxs.SL76B:7!null\n" +
+			"         │   │   │       │                       │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
+			"         │   │   │       │                       │   THEN 0 (tinyint) WHEN IN\n" +
+			"         │   │   │       │                       │   ├─ left: g3yxs.SL76B:7!null\n" +
+			"         │   │   │       │                       │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
+			"         │   │   │       │                       │   THEN 1 (tinyint) WHEN IN\n" +
+			"         │   │   │       │                       │   ├─ left: g3yxs.SL76B:7!null\n" +
+			"         │   │   │       │                       │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
+			"         │   │   │       │                       │   THEN 2 (tinyint) WHEN IN\n" +
+			"         │   │   │       │                       │   ├─ left: g3yxs.SL76B:7!null\n" +
+			"         │   │   │       │                       │   └─ right: TUPLE(Y62X7 (longtext))\n" +
+			"         │   │   │       │                       │   THEN 3 (tinyint) END as CC4AX, g3yxs.SL76B:7!null as SL76B, yqif4.id:15!null as QNI57, yvhjz.id:18!null as TDEIU]\n" +
+			"         │   │   │       │                       └─ Filter\n" +
+			"         │   │   │       │                           ├─ Or\n" +
+			"         │   │   │       │                           │   ├─ NOT\n" +
+			"         │   │   │       │                           │   │   └─ yqif4.id:15!null IS NULL\n" +
+			"         │   │   │       │                           │   └─ NOT\n" +
+			"         │   │   │       │                           │       └─ yvhjz.id:18!null IS NULL\n" +
+			"         │   │   │       │                           └─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │                               ├─ Eq\n" +
+			"         │   │   │       │                               │   ├─ yvhjz.BRQP2:19!null\n" +
+			"         │   │   │       │                               │   └─ ism.UJ6XY:1!null\n" +
+			"         │   │   │       │                               ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │                               │   ├─ Eq\n" +
+			"         │   │   │       │                               │   │   ├─ yqif4.BRQP2:16!null\n" +
+			"         │   │   │       │                               │   │   └─ ism.FV24E:0!null\n" +
+			"         │   │   │       │                               │   ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │                               │   │   ├─ NOT\n" +
+			"         │   │   │       │                               │   │   │   └─ Eq\n" +
+			"         │   │   │       │                               │   │   │       ├─ cpmfe.id:12!null\n" +
+			"         │   │   │       │                               │   │   │       └─ ism.FV24E:0!null\n" +
+			"         │   │   │       │                               │   │   ├─ LeftOuterHashJoin\n" +
+			"         │   │   │       │                               │   │   │   ├─ Eq\n" +
+			"         │   │   │       │                               │   │   │   │   ├─ nhmxw.id:10!null\n" +
+			"         │   │   │       │                               │   │   │   │   └─ ism.PRUV2:4\n" +
+			"         │   │   │       │                               │   │   │   ├─ MergeJoin\n" +
+			"         │   │   │       │                               │   │   │   │   ├─ cmp: Eq\n" +
+			"         │   │   │       │                               │   │   │   │   │   ├─ ism.NZ4MQ:3!null\n" +
+			"         │   │   │       │                               │   │   │   │   │   └─ g3yxs.id:5!null\n" +
+			"         │   │   │       │                               │   │   │   │   ├─ TableAlias(ism)\n" +
+			"         │   │   │       │                               │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
+			"         │   │   │       │                               │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
+			"         │   │   │       │                               │   │   │   │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │                               │   │   │   │   │       ├─ colSet: (15-23)\n" +
+			"         │   │   │       │                               │   │   │   │   │       ├─ tableId: 4\n" +
+			"         │   │   │       │                               │   │   │   │   │       └─ Table\n" +
+			"         │   │   │       │                               │   │   │   │   │           ├─ name: HDDVB\n" +
+			"         │   │   │       │                               │   │   │   │   │           └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"         │   │   │       │                               │   │   │   │   └─ TableAlias(g3yxs)\n" +
+			"         │   │   │       │                               │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
+			"         │   │   │       │                               │   │   │   │           ├─ index: [YYBCX.id]\n" +
+			"         │   │   │       │                               │   │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │                               │   │   │   │           ├─ colSet: (24-31)\n" +
+			"         │   │   │       │                               │   │   │   │           ├─ tableId: 5\n" +
+			"         │   │   │       │                               │   │   │   │           └─ Table\n" +
+			"         │   │   │       │                               │   │   │   │               ├─ name: YYBCX\n" +
+			"         │   │   │       │                               │   │   │   │               └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"         │   │   │       │                               │   │   │   └─ HashLookup\n" +
+			"         │   │   │       │                               │   │   │       ├─ left-key: TUPLE(ism.PRUV2:4)\n" +
+			"         │   │   │       │                               │   │   │       ├─ right-key: TUPLE(nhmxw.id:0!null)\n" +
+			"         │   │   │       │                               │   │   │       └─ TableAlias(nhmxw)\n" +
+			"         │   │   │       │                               │   │   │           └─ Table\n" +
+			"         │   │   │       │                               │   │   │               ├─ name: WGSDC\n" +
+			"         │   │   │       │                               │   │   │               ├─ columns: [id nohhr]\n" +
+			"         │   │   │       │                               │   │   │               ├─ colSet: (32-41)\n" +
+			"         │   │   │       │                               │   │   │               └─ tableId: 6\n" +
+			"         │   │   │       │                               │   │   └─ TableAlias(cpmfe)\n" +
+			"         │   │   │       │                               │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"         │   │   │       │                               │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"         │   │   │       │                               │   │           ├─ keys: [nhmxw.NOHHR:11!null]\n" +
+			"         │   │   │       │                               │   │           ├─ colSet: (42-58)\n" +
+			"         │   │   │       │                               │   │           ├─ tableId: 7\n" +
+			"         │   │   │       │                               │   │           └─ Table\n" +
+			"         │   │   │       │                               │   │               ├─ name: E2I7U\n" +
+			"         │   │   │       │                               │   │               └─ columns: [id tw55n zh72s]\n" +
+			"         │   │   │       │                               │   └─ TableAlias(yqif4)\n" +
+			"         │   │   │       │                               │       └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │                               │           ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │                               │           ├─ keys: [ism.UJ6XY:1!null]\n" +
+			"         │   │   │       │                               │           ├─ colSet: (59-68)\n" +
+			"         │   │   │       │                               │           ├─ tableId: 8\n" +
+			"         │   │   │       │                               │           └─ Table\n" +
+			"         │   │   │       │                               │               ├─ name: NOXN3\n" +
+			"         │   │   │       │                               │               └─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │                               └─ TableAlias(yvhjz)\n" +
+			"         │   │   │       │                                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │                                       ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │                                       ├─ keys: [ism.FV24E:0!null]\n" +
+			"         │   │   │       │                                       ├─ colSet: (69-78)\n" +
+			"         │   │   │       │                                       ├─ tableId: 9\n" +
+			"         │   │   │       │                                       └─ Table\n" +
+			"         │   │   │       │                                           ├─ name: NOXN3\n" +
+			"         │   │   │       │                                           └─ columns: [id brqp2 fftbj]\n" +
 			"         │   │   │       └─ Project\n" +
-			"         │   │   │           ├─ columns: [jchir.FJDP5:0!null, jchir.BJUF2:1!null, jchir.PSMU6:2!null, jchir.M22QN:3!null, jchir.GE5EL:4, jchir.F7A4Q:5, jchir.ESFVY:6!null, jchir.CC4AX:7, jchir.SL76B:8!null, convert\n" +
-			"         │   │   │           │   ├─ type: char\n" +
-			"         │   │   │           │   └─ QNI57:9\n" +
-			"         │   │   │           │   as QNI57, convert\n" +
-			"         │   │   │           │   ├─ type: char\n" +
-			"         │   │   │           │   └─ jchir.TDEIU:10!null\n" +
-			"         │   │   │           │   as TDEIU]\n" +
+			"         │   │   │           ├─ columns: [jchir.FJDP5:0!null, jchir.BJUF2:1!null, jchir.PSMU6:2!null, jchir.M22QN:3!null, jchir.GE5EL:4, jchir.F7A4Q:5, jchir.ESFVY:6!null, jchir.CC4AX:7, jchir.SL76B:8!null, coerce(QNI57:9, varchar(24)) as QNI57, jchir.TDEIU:10!null]\n" +
 			"         │   │   │           └─ Project\n" +
 			"         │   │   │               ├─ columns: [jchir.FJDP5:0!null, jchir.BJUF2:1!null, jchir.PSMU6:2!null, jchir.M22QN:3!null, jchir.GE5EL:4, jchir.F7A4Q:5, jchir.ESFVY:6!null, jchir.CC4AX:7, jchir.SL76B:8!null, NULL (null) as QNI57, jchir.TDEIU:10!null]\n" +
 			"         │   │   │               └─ SubqueryAlias\n" +
@@ -11229,123 +11162,119 @@ WHERE
 			"         │   │   │   ├─ isLateral: false\n" +
 			"         │   │   │   ├─ cacheable: true\n" +
 			"         │   │   │   └─ Union distinct\n" +
-			"         │   │   │       ├─ Project\n" +
-			"         │   │   │       │   ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, convert(jchir.QNI57, char) as QNI57, TDEIU as TDEIU]\n" +
-			"         │   │   │       │   └─ Union distinct\n" +
-			"         │   │   │       │       ├─ Project\n" +
-			"         │   │   │       │       │   ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, jchir.QNI57, convert(jchir.TDEIU, char) as TDEIU]\n" +
-			"         │   │   │       │       │   └─ SubqueryAlias\n" +
-			"         │   │   │       │       │       ├─ name: jchir\n" +
-			"         │   │   │       │       │       ├─ outerVisibility: false\n" +
-			"         │   │   │       │       │       ├─ isLateral: false\n" +
-			"         │   │   │       │       │       ├─ cacheable: true\n" +
-			"         │   │   │       │       │       └─ Filter\n" +
-			"         │   │   │       │       │           ├─ (((NOT(QNI57 IS NULL)) AND TDEIU IS NULL) OR (QNI57 IS NULL AND (NOT(TDEIU IS NULL))))\n" +
-			"         │   │   │       │       │           └─ Project\n" +
-			"         │   │   │       │       │               ├─ columns: [ism.FV24E as FJDP5, cpmfe.id as BJUF2, cpmfe.TW55N as PSMU6, ism.M22QN as M22QN, g3yxs.GE5EL, g3yxs.F7A4Q, g3yxs.ESFVY, CASE  WHEN (g3yxs.SL76B IN ('FO422', 'SJ53H')) THEN 0 WHEN (g3yxs.SL76B IN ('DCV4Z', 'UOSM4', 'FUGIP', 'H5MCC', 'YKEQE', 'D3AKL')) THEN 1 WHEN (g3yxs.SL76B IN ('QJEXM', 'J6S7P', 'VT7FI')) THEN 2 WHEN (g3yxs.SL76B IN ('Y62X7')) THEN 3 END as CC4AX, g3yxs.SL76B as SL76B, yqif4.id as QNI57, yvhjz.id as TDEIU]\n" +
-			"         │   │   │       │       │               └─ Filter\n" +
-			"         │   │   │       │       │                   ├─ ((NOT(yqif4.id IS NULL)) OR (NOT(yvhjz.id IS NULL)))\n" +
-			"         │   │   │       │       │                   └─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │       │                       ├─ (yvhjz.BRQP2 = ism.UJ6XY)\n" +
-			"         │   │   │       │       │                       ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │       │                       │   ├─ (yqif4.BRQP2 = ism.FV24E)\n" +
-			"         │   │   │       │       │                       │   ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │       │                       │   │   ├─ (NOT((cpmfe.id = ism.FV24E)))\n" +
-			"         │   │   │       │       │                       │   │   ├─ LeftOuterHashJoin\n" +
-			"         │   │   │       │       │                       │   │   │   ├─ (nhmxw.id = ism.PRUV2)\n" +
-			"         │   │   │       │       │                       │   │   │   ├─ MergeJoin\n" +
-			"         │   │   │       │       │                       │   │   │   │   ├─ cmp: (ism.NZ4MQ = g3yxs.id)\n" +
-			"         │   │   │       │       │                       │   │   │   │   ├─ TableAlias(ism)\n" +
-			"         │   │   │       │       │                       │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"         │   │   │       │       │                       │   │   │   │   └─ TableAlias(g3yxs)\n" +
-			"         │   │   │       │       │                       │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
-			"         │   │   │       │       │                       │   │   │   │           ├─ index: [YYBCX.id]\n" +
-			"         │   │   │       │       │                       │   │   │   │           ├─ filters: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │       │                       │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"         │   │   │       │       │                       │   │   │   └─ HashLookup\n" +
-			"         │   │   │       │       │                       │   │   │       ├─ left-key: (ism.PRUV2)\n" +
-			"         │   │   │       │       │                       │   │   │       ├─ right-key: (nhmxw.id)\n" +
-			"         │   │   │       │       │                       │   │   │       └─ TableAlias(nhmxw)\n" +
-			"         │   │   │       │       │                       │   │   │           └─ Table\n" +
-			"         │   │   │       │       │                       │   │   │               ├─ name: WGSDC\n" +
-			"         │   │   │       │       │                       │   │   │               └─ columns: [id nohhr]\n" +
-			"         │   │   │       │       │                       │   │   └─ TableAlias(cpmfe)\n" +
-			"         │   │   │       │       │                       │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │   │       │       │                       │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"         │   │   │       │       │                       │   │           ├─ columns: [id tw55n zh72s]\n" +
-			"         │   │   │       │       │                       │   │           └─ keys: nhmxw.NOHHR\n" +
-			"         │   │   │       │       │                       │   └─ TableAlias(yqif4)\n" +
-			"         │   │   │       │       │                       │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │       │                       │           ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │       │                       │           ├─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   │       │       │                       │           └─ keys: ism.UJ6XY\n" +
-			"         │   │   │       │       │                       └─ TableAlias(yvhjz)\n" +
-			"         │   │   │       │       │                           └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │       │                               ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │       │                               ├─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   │       │       │                               └─ keys: ism.FV24E\n" +
+			"         │   │   │       ├─ Union distinct\n" +
+			"         │   │   │       │   ├─ SubqueryAlias\n" +
+			"         │   │   │       │   │   ├─ name: jchir\n" +
+			"         │   │   │       │   │   ├─ outerVisibility: false\n" +
+			"         │   │   │       │   │   ├─ isLateral: false\n" +
+			"         │   │   │       │   │   ├─ cacheable: true\n" +
+			"         │   │   │       │   │   └─ Filter\n" +
+			"         │   │   │       │   │       ├─ (((NOT(QNI57 IS NULL)) AND TDEIU IS NULL) OR (QNI57 IS NULL AND (NOT(TDEIU IS NULL))))\n" +
+			"         │   │   │       │   │       └─ Project\n" +
+			"         │   │   │       │   │           ├─ columns: [ism.FV24E as FJDP5, cpmfe.id as BJUF2, cpmfe.TW55N as PSMU6, ism.M22QN as M22QN, g3yxs.GE5EL, g3yxs.F7A4Q, g3yxs.ESFVY, CASE  WHEN (g3yxs.SL76B IN ('FO422', 'SJ53H')) THEN 0 WHEN (g3yxs.SL76B IN ('DCV4Z', 'UOSM4', 'FUGIP', 'H5MCC', 'YKEQE', 'D3AKL')) THEN 1 WHEN (g3yxs.SL76B IN ('QJEXM', 'J6S7P', 'VT7FI')) THEN 2 WHEN (g3yxs.SL76B IN ('Y62X7')) THEN 3 END as CC4AX, g3yxs.SL76B as SL76B, yqif4.id as QNI57, yvhjz.id as TDEIU]\n" +
+			"         │   │   │       │   │           └─ Filter\n" +
+			"         │   │   │       │   │               ├─ ((NOT(yqif4.id IS NULL)) OR (NOT(yvhjz.id IS NULL)))\n" +
+			"         │   │   │       │   │               └─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │   │                   ├─ (yvhjz.BRQP2 = ism.UJ6XY)\n" +
+			"         │   │   │       │   │                   ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │   │                   │   ├─ (yqif4.BRQP2 = ism.FV24E)\n" +
+			"         │   │   │       │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │   │                   │   │   ├─ (NOT((cpmfe.id = ism.FV24E)))\n" +
+			"         │   │   │       │   │                   │   │   ├─ LeftOuterHashJoin\n" +
+			"         │   │   │       │   │                   │   │   │   ├─ (nhmxw.id = ism.PRUV2)\n" +
+			"         │   │   │       │   │                   │   │   │   ├─ MergeJoin\n" +
+			"         │   │   │       │   │                   │   │   │   │   ├─ cmp: (ism.NZ4MQ = g3yxs.id)\n" +
+			"         │   │   │       │   │                   │   │   │   │   ├─ TableAlias(ism)\n" +
+			"         │   │   │       │   │                   │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"         │   │   │       │   │                   │   │   │   │   └─ TableAlias(g3yxs)\n" +
+			"         │   │   │       │   │                   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
+			"         │   │   │       │   │                   │   │   │   │           ├─ index: [YYBCX.id]\n" +
+			"         │   │   │       │   │                   │   │   │   │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │   │                   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"         │   │   │       │   │                   │   │   │   └─ HashLookup\n" +
+			"         │   │   │       │   │                   │   │   │       ├─ left-key: (ism.PRUV2)\n" +
+			"         │   │   │       │   │                   │   │   │       ├─ right-key: (nhmxw.id)\n" +
+			"         │   │   │       │   │                   │   │   │       └─ TableAlias(nhmxw)\n" +
+			"         │   │   │       │   │                   │   │   │           └─ Table\n" +
+			"         │   │   │       │   │                   │   │   │               ├─ name: WGSDC\n" +
+			"         │   │   │       │   │                   │   │   │               └─ columns: [id nohhr]\n" +
+			"         │   │   │       │   │                   │   │   └─ TableAlias(cpmfe)\n" +
+			"         │   │   │       │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"         │   │   │       │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"         │   │   │       │   │                   │   │           ├─ columns: [id tw55n zh72s]\n" +
+			"         │   │   │       │   │                   │   │           └─ keys: nhmxw.NOHHR\n" +
+			"         │   │   │       │   │                   │   └─ TableAlias(yqif4)\n" +
+			"         │   │   │       │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │   │                   │           ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │   │                   │           ├─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │   │                   │           └─ keys: ism.UJ6XY\n" +
+			"         │   │   │       │   │                   └─ TableAlias(yvhjz)\n" +
+			"         │   │   │       │   │                       └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │   │                           ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │   │                           ├─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │   │                           └─ keys: ism.FV24E\n" +
+			"         │   │   │       │   └─ Project\n" +
+			"         │   │   │       │       ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, jchir.QNI57, coerce(TDEIU, varchar(24)) as TDEIU]\n" +
 			"         │   │   │       │       └─ Project\n" +
-			"         │   │   │       │           ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, jchir.QNI57, convert(TDEIU, char) as TDEIU]\n" +
-			"         │   │   │       │           └─ Project\n" +
-			"         │   │   │       │               ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, jchir.QNI57, NULL as TDEIU]\n" +
-			"         │   │   │       │               └─ SubqueryAlias\n" +
-			"         │   │   │       │                   ├─ name: jchir\n" +
-			"         │   │   │       │                   ├─ outerVisibility: false\n" +
-			"         │   │   │       │                   ├─ isLateral: false\n" +
-			"         │   │   │       │                   ├─ cacheable: true\n" +
-			"         │   │   │       │                   └─ Filter\n" +
-			"         │   │   │       │                       ├─ ((NOT(QNI57 IS NULL)) AND (NOT(TDEIU IS NULL)))\n" +
-			"         │   │   │       │                       └─ Project\n" +
-			"         │   │   │       │                           ├─ columns: [ism.FV24E as FJDP5, cpmfe.id as BJUF2, cpmfe.TW55N as PSMU6, ism.M22QN as M22QN, g3yxs.GE5EL, g3yxs.F7A4Q, g3yxs.ESFVY, CASE  WHEN (g3yxs.SL76B IN ('FO422', 'SJ53H')) THEN 0 WHEN (g3yxs.SL76B IN ('DCV4Z', 'UOSM4', 'FUGIP', 'H5MCC', 'YKEQE', 'D3AKL')) THEN 1 WHEN (g3yxs.SL76B IN ('QJEXM', 'J6S7P', 'VT7FI')) THEN 2 WHEN (g3yxs.SL76B IN ('Y62X7')) THEN 3 END as CC4AX, g3yxs.SL76B as SL76B, yqif4.id as QNI57, yvhjz.id as TDEIU]\n" +
-			"         │   │   │       │                           └─ Filter\n" +
-			"         │   │   │       │                               ├─ ((NOT(yqif4.id IS NULL)) OR (NOT(yvhjz.id IS NULL)))\n" +
-			"         │   │   │       │                               └─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │                                   ├─ (yvhjz.BRQP2 = ism.UJ6XY)\n" +
-			"         │   │   │       │                                   ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │                                   │   ├─ (yqif4.BRQP2 = ism.FV24E)\n" +
-			"         │   │   │       │                                   │   ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │                                   │   │   ├─ (NOT((cpmfe.id = ism.FV24E)))\n" +
-			"         │   │   │       │                                   │   │   ├─ LeftOuterHashJoin\n" +
-			"         │   │   │       │                                   │   │   │   ├─ (nhmxw.id = ism.PRUV2)\n" +
-			"         │   │   │       │                                   │   │   │   ├─ MergeJoin\n" +
-			"         │   │   │       │                                   │   │   │   │   ├─ cmp: (ism.NZ4MQ = g3yxs.id)\n" +
-			"         │   │   │       │                                   │   │   │   │   ├─ TableAlias(ism)\n" +
-			"         │   │   │       │                                   │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
-			"         │   │   │       │                                   │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
-			"         │   │   │       │                                   │   │   │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │                                   │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"         │   │   │       │                                   │   │   │   │   └─ TableAlias(g3yxs)\n" +
-			"         │   │   │       │                                   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
-			"         │   │   │       │                                   │   │   │   │           ├─ index: [YYBCX.id]\n" +
-			"         │   │   │       │                                   │   │   │   │           ├─ filters: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │                                   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"         │   │   │       │                                   │   │   │   └─ HashLookup\n" +
-			"         │   │   │       │                                   │   │   │       ├─ left-key: (ism.PRUV2)\n" +
-			"         │   │   │       │                                   │   │   │       ├─ right-key: (nhmxw.id)\n" +
-			"         │   │   │       │                                   │   │   │       └─ TableAlias(nhmxw)\n" +
-			"         │   │   │       │                                   │   │   │           └─ Table\n" +
-			"         │   │   │       │                                   │   │   │               ├─ name: WGSDC\n" +
-			"         │   │   │       │                                   │   │   │               └─ columns: [id nohhr]\n" +
-			"         │   │   │       │                                   │   │   └─ TableAlias(cpmfe)\n" +
-			"         │   │   │       │                                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │   │       │                                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"         │   │   │       │                                   │   │           ├─ columns: [id tw55n zh72s]\n" +
-			"         │   │   │       │                                   │   │           └─ keys: nhmxw.NOHHR\n" +
-			"         │   │   │       │                                   │   └─ TableAlias(yqif4)\n" +
-			"         │   │   │       │                                   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │                                   │           ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │                                   │           ├─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   │       │                                   │           └─ keys: ism.UJ6XY\n" +
-			"         │   │   │       │                                   └─ TableAlias(yvhjz)\n" +
-			"         │   │   │       │                                       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │                                           ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │                                           ├─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   │       │                                           └─ keys: ism.FV24E\n" +
+			"         │   │   │       │           ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, jchir.QNI57, NULL as TDEIU]\n" +
+			"         │   │   │       │           └─ SubqueryAlias\n" +
+			"         │   │   │       │               ├─ name: jchir\n" +
+			"         │   │   │       │               ├─ outerVisibility: false\n" +
+			"         │   │   │       │               ├─ isLateral: false\n" +
+			"         │   │   │       │               ├─ cacheable: true\n" +
+			"         │   │   │       │               └─ Filter\n" +
+			"         │   │   │       │                   ├─ ((NOT(QNI57 IS NULL)) AND (NOT(TDEIU IS NULL)))\n" +
+			"         │   │   │       │                   └─ Project\n" +
+			"         │   │   │       │                       ├─ columns: [ism.FV24E as FJDP5, cpmfe.id as BJUF2, cpmfe.TW55N as PSMU6, ism.M22QN as M22QN, g3yxs.GE5EL, g3yxs.F7A4Q, g3yxs.ESFVY, CASE  WHEN (g3yxs.SL76B IN ('FO422', 'SJ53H')) THEN 0 WHEN (g3yxs.SL76B IN ('DCV4Z', 'UOSM4', 'FUGIP', 'H5MCC', 'YKEQE', 'D3AKL')) THEN 1 WHEN (g3yxs.SL76B IN ('QJEXM', 'J6S7P', 'VT7FI')) THEN 2 WHEN (g3yxs.SL76B IN ('Y62X7')) THEN 3 END as CC4AX, g3yxs.SL76B as SL76B, yqif4.id as QNI57, yvhjz.id as TDEIU]\n" +
+			"         │   │   │       │                       └─ Filter\n" +
+			"         │   │   │       │                           ├─ ((NOT(yqif4.id IS NULL)) OR (NOT(yvhjz.id IS NULL)))\n" +
+			"         │   │   │       │                           └─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │                               ├─ (yvhjz.BRQP2 = ism.UJ6XY)\n" +
+			"         │   │   │       │                               ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │                               │   ├─ (yqif4.BRQP2 = ism.FV24E)\n" +
+			"         │   │   │       │                               │   ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │                               │   │   ├─ (NOT((cpmfe.id = ism.FV24E)))\n" +
+			"         │   │   │       │                               │   │   ├─ LeftOuterHashJoin\n" +
+			"         │   │   │       │                               │   │   │   ├─ (nhmxw.id = ism.PRUV2)\n" +
+			"         │   │   │       │                               │   │   │   ├─ MergeJoin\n" +
+			"         │   │   │       │                               │   │   │   │   ├─ cmp: (ism.NZ4MQ = g3yxs.id)\n" +
+			"         │   │   │       │                               │   │   │   │   ├─ TableAlias(ism)\n" +
+			"         │   │   │       │                               │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
+			"         │   │   │       │                               │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
+			"         │   │   │       │                               │   │   │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │                               │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"         │   │   │       │                               │   │   │   │   └─ TableAlias(g3yxs)\n" +
+			"         │   │   │       │                               │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
+			"         │   │   │       │                               │   │   │   │           ├─ index: [YYBCX.id]\n" +
+			"         │   │   │       │                               │   │   │   │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │                               │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"         │   │   │       │                               │   │   │   └─ HashLookup\n" +
+			"         │   │   │       │                               │   │   │       ├─ left-key: (ism.PRUV2)\n" +
+			"         │   │   │       │                               │   │   │       ├─ right-key: (nhmxw.id)\n" +
+			"         │   │   │       │                               │   │   │       └─ TableAlias(nhmxw)\n" +
+			"         │   │   │       │                               │   │   │           └─ Table\n" +
+			"         │   │   │       │                               │   │   │               ├─ name: WGSDC\n" +
+			"         │   │   │       │                               │   │   │               └─ columns: [id nohhr]\n" +
+			"         │   │   │       │                               │   │   └─ TableAlias(cpmfe)\n" +
+			"         │   │   │       │                               │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"         │   │   │       │                               │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"         │   │   │       │                               │   │           ├─ columns: [id tw55n zh72s]\n" +
+			"         │   │   │       │                               │   │           └─ keys: nhmxw.NOHHR\n" +
+			"         │   │   │       │                               │   └─ TableAlias(yqif4)\n" +
+			"         │   │   │       │                               │       └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │                               │           ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │                               │           ├─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │                               │           └─ keys: ism.UJ6XY\n" +
+			"         │   │   │       │                               └─ TableAlias(yvhjz)\n" +
+			"         │   │   │       │                                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │                                       ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │                                       ├─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │                                       └─ keys: ism.FV24E\n" +
 			"         │   │   │       └─ Project\n" +
-			"         │   │   │           ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, convert(QNI57, char) as QNI57, convert(jchir.TDEIU, char) as TDEIU]\n" +
+			"         │   │   │           ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, coerce(QNI57, varchar(24)) as QNI57, jchir.TDEIU]\n" +
 			"         │   │   │           └─ Project\n" +
 			"         │   │   │               ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, NULL as QNI57, jchir.TDEIU]\n" +
 			"         │   │   │               └─ SubqueryAlias\n" +
@@ -11572,123 +11501,119 @@ WHERE
 			"         │   │   │   ├─ isLateral: false\n" +
 			"         │   │   │   ├─ cacheable: true\n" +
 			"         │   │   │   └─ Union distinct\n" +
-			"         │   │   │       ├─ Project\n" +
-			"         │   │   │       │   ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, convert(jchir.QNI57, char) as QNI57, TDEIU as TDEIU]\n" +
-			"         │   │   │       │   └─ Union distinct\n" +
-			"         │   │   │       │       ├─ Project\n" +
-			"         │   │   │       │       │   ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, jchir.QNI57, convert(jchir.TDEIU, char) as TDEIU]\n" +
-			"         │   │   │       │       │   └─ SubqueryAlias\n" +
-			"         │   │   │       │       │       ├─ name: jchir\n" +
-			"         │   │   │       │       │       ├─ outerVisibility: false\n" +
-			"         │   │   │       │       │       ├─ isLateral: false\n" +
-			"         │   │   │       │       │       ├─ cacheable: true\n" +
-			"         │   │   │       │       │       └─ Filter\n" +
-			"         │   │   │       │       │           ├─ (((NOT(QNI57 IS NULL)) AND TDEIU IS NULL) OR (QNI57 IS NULL AND (NOT(TDEIU IS NULL))))\n" +
-			"         │   │   │       │       │           └─ Project\n" +
-			"         │   │   │       │       │               ├─ columns: [ism.FV24E as FJDP5, cpmfe.id as BJUF2, cpmfe.TW55N as PSMU6, ism.M22QN as M22QN, g3yxs.GE5EL, g3yxs.F7A4Q, g3yxs.ESFVY, CASE  WHEN (g3yxs.SL76B IN ('FO422', 'SJ53H')) THEN 0 WHEN (g3yxs.SL76B IN ('DCV4Z', 'UOSM4', 'FUGIP', 'H5MCC', 'YKEQE', 'D3AKL')) THEN 1 WHEN (g3yxs.SL76B IN ('QJEXM', 'J6S7P', 'VT7FI')) THEN 2 WHEN (g3yxs.SL76B IN ('Y62X7')) THEN 3 END as CC4AX, g3yxs.SL76B as SL76B, yqif4.id as QNI57, yvhjz.id as TDEIU]\n" +
-			"         │   │   │       │       │               └─ Filter\n" +
-			"         │   │   │       │       │                   ├─ ((NOT(yqif4.id IS NULL)) OR (NOT(yvhjz.id IS NULL)))\n" +
-			"         │   │   │       │       │                   └─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │       │                       ├─ (yvhjz.BRQP2 = ism.UJ6XY)\n" +
-			"         │   │   │       │       │                       ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │       │                       │   ├─ (yqif4.BRQP2 = ism.FV24E)\n" +
-			"         │   │   │       │       │                       │   ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │       │                       │   │   ├─ (NOT((cpmfe.id = ism.FV24E)))\n" +
-			"         │   │   │       │       │                       │   │   ├─ LeftOuterHashJoin\n" +
-			"         │   │   │       │       │                       │   │   │   ├─ (nhmxw.id = ism.PRUV2)\n" +
-			"         │   │   │       │       │                       │   │   │   ├─ MergeJoin\n" +
-			"         │   │   │       │       │                       │   │   │   │   ├─ cmp: (ism.NZ4MQ = g3yxs.id)\n" +
-			"         │   │   │       │       │                       │   │   │   │   ├─ TableAlias(ism)\n" +
-			"         │   │   │       │       │                       │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │       │                       │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"         │   │   │       │       │                       │   │   │   │   └─ TableAlias(g3yxs)\n" +
-			"         │   │   │       │       │                       │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
-			"         │   │   │       │       │                       │   │   │   │           ├─ index: [YYBCX.id]\n" +
-			"         │   │   │       │       │                       │   │   │   │           ├─ filters: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │       │                       │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"         │   │   │       │       │                       │   │   │   └─ HashLookup\n" +
-			"         │   │   │       │       │                       │   │   │       ├─ left-key: (ism.PRUV2)\n" +
-			"         │   │   │       │       │                       │   │   │       ├─ right-key: (nhmxw.id)\n" +
-			"         │   │   │       │       │                       │   │   │       └─ TableAlias(nhmxw)\n" +
-			"         │   │   │       │       │                       │   │   │           └─ Table\n" +
-			"         │   │   │       │       │                       │   │   │               ├─ name: WGSDC\n" +
-			"         │   │   │       │       │                       │   │   │               └─ columns: [id nohhr]\n" +
-			"         │   │   │       │       │                       │   │   └─ TableAlias(cpmfe)\n" +
-			"         │   │   │       │       │                       │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │   │       │       │                       │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"         │   │   │       │       │                       │   │           ├─ columns: [id tw55n zh72s]\n" +
-			"         │   │   │       │       │                       │   │           └─ keys: nhmxw.NOHHR\n" +
-			"         │   │   │       │       │                       │   └─ TableAlias(yqif4)\n" +
-			"         │   │   │       │       │                       │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │       │                       │           ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │       │                       │           ├─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   │       │       │                       │           └─ keys: ism.UJ6XY\n" +
-			"         │   │   │       │       │                       └─ TableAlias(yvhjz)\n" +
-			"         │   │   │       │       │                           └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │       │                               ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │       │                               ├─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   │       │       │                               └─ keys: ism.FV24E\n" +
+			"         │   │   │       ├─ Union distinct\n" +
+			"         │   │   │       │   ├─ SubqueryAlias\n" +
+			"         │   │   │       │   │   ├─ name: jchir\n" +
+			"         │   │   │       │   │   ├─ outerVisibility: false\n" +
+			"         │   │   │       │   │   ├─ isLateral: false\n" +
+			"         │   │   │       │   │   ├─ cacheable: true\n" +
+			"         │   │   │       │   │   └─ Filter\n" +
+			"         │   │   │       │   │       ├─ (((NOT(QNI57 IS NULL)) AND TDEIU IS NULL) OR (QNI57 IS NULL AND (NOT(TDEIU IS NULL))))\n" +
+			"         │   │   │       │   │       └─ Project\n" +
+			"         │   │   │       │   │           ├─ columns: [ism.FV24E as FJDP5, cpmfe.id as BJUF2, cpmfe.TW55N as PSMU6, ism.M22QN as M22QN, g3yxs.GE5EL, g3yxs.F7A4Q, g3yxs.ESFVY, CASE  WHEN (g3yxs.SL76B IN ('FO422', 'SJ53H')) THEN 0 WHEN (g3yxs.SL76B IN ('DCV4Z', 'UOSM4', 'FUGIP', 'H5MCC', 'YKEQE', 'D3AKL')) THEN 1 WHEN (g3yxs.SL76B IN ('QJEXM', 'J6S7P', 'VT7FI')) THEN 2 WHEN (g3yxs.SL76B IN ('Y62X7')) THEN 3 END as CC4AX, g3yxs.SL76B as SL76B, yqif4.id as QNI57, yvhjz.id as TDEIU]\n" +
+			"         │   │   │       │   │           └─ Filter\n" +
+			"         │   │   │       │   │               ├─ ((NOT(yqif4.id IS NULL)) OR (NOT(yvhjz.id IS NULL)))\n" +
+			"         │   │   │       │   │               └─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │   │                   ├─ (yvhjz.BRQP2 = ism.UJ6XY)\n" +
+			"         │   │   │       │   │                   ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │   │                   │   ├─ (yqif4.BRQP2 = ism.FV24E)\n" +
+			"         │   │   │       │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │   │                   │   │   ├─ (NOT((cpmfe.id = ism.FV24E)))\n" +
+			"         │   │   │       │   │                   │   │   ├─ LeftOuterHashJoin\n" +
+			"         │   │   │       │   │                   │   │   │   ├─ (nhmxw.id = ism.PRUV2)\n" +
+			"         │   │   │       │   │                   │   │   │   ├─ MergeJoin\n" +
+			"         │   │   │       │   │                   │   │   │   │   ├─ cmp: (ism.NZ4MQ = g3yxs.id)\n" +
+			"         │   │   │       │   │                   │   │   │   │   ├─ TableAlias(ism)\n" +
+			"         │   │   │       │   │                   │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │   │                   │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"         │   │   │       │   │                   │   │   │   │   └─ TableAlias(g3yxs)\n" +
+			"         │   │   │       │   │                   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
+			"         │   │   │       │   │                   │   │   │   │           ├─ index: [YYBCX.id]\n" +
+			"         │   │   │       │   │                   │   │   │   │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │   │                   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"         │   │   │       │   │                   │   │   │   └─ HashLookup\n" +
+			"         │   │   │       │   │                   │   │   │       ├─ left-key: (ism.PRUV2)\n" +
+			"         │   │   │       │   │                   │   │   │       ├─ right-key: (nhmxw.id)\n" +
+			"         │   │   │       │   │                   │   │   │       └─ TableAlias(nhmxw)\n" +
+			"         │   │   │       │   │                   │   │   │           └─ Table\n" +
+			"         │   │   │       │   │                   │   │   │               ├─ name: WGSDC\n" +
+			"         │   │   │       │   │                   │   │   │               └─ columns: [id nohhr]\n" +
+			"         │   │   │       │   │                   │   │   └─ TableAlias(cpmfe)\n" +
+			"         │   │   │       │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"         │   │   │       │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"         │   │   │       │   │                   │   │           ├─ columns: [id tw55n zh72s]\n" +
+			"         │   │   │       │   │                   │   │           └─ keys: nhmxw.NOHHR\n" +
+			"         │   │   │       │   │                   │   └─ TableAlias(yqif4)\n" +
+			"         │   │   │       │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │   │                   │           ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │   │                   │           ├─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │   │                   │           └─ keys: ism.UJ6XY\n" +
+			"         │   │   │       │   │                   └─ TableAlias(yvhjz)\n" +
+			"         │   │   │       │   │                       └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │   │                           ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │   │                           ├─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │   │                           └─ keys: ism.FV24E\n" +
+			"         │   │   │       │   └─ Project\n" +
+			"         │   │   │       │       ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, jchir.QNI57, coerce(TDEIU, varchar(24)) as TDEIU]\n" +
 			"         │   │   │       │       └─ Project\n" +
-			"         │   │   │       │           ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, jchir.QNI57, convert(TDEIU, char) as TDEIU]\n" +
-			"         │   │   │       │           └─ Project\n" +
-			"         │   │   │       │               ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, jchir.QNI57, NULL as TDEIU]\n" +
-			"         │   │   │       │               └─ SubqueryAlias\n" +
-			"         │   │   │       │                   ├─ name: jchir\n" +
-			"         │   │   │       │                   ├─ outerVisibility: false\n" +
-			"         │   │   │       │                   ├─ isLateral: false\n" +
-			"         │   │   │       │                   ├─ cacheable: true\n" +
-			"         │   │   │       │                   └─ Filter\n" +
-			"         │   │   │       │                       ├─ ((NOT(QNI57 IS NULL)) AND (NOT(TDEIU IS NULL)))\n" +
-			"         │   │   │       │                       └─ Project\n" +
-			"         │   │   │       │                           ├─ columns: [ism.FV24E as FJDP5, cpmfe.id as BJUF2, cpmfe.TW55N as PSMU6, ism.M22QN as M22QN, g3yxs.GE5EL, g3yxs.F7A4Q, g3yxs.ESFVY, CASE  WHEN (g3yxs.SL76B IN ('FO422', 'SJ53H')) THEN 0 WHEN (g3yxs.SL76B IN ('DCV4Z', 'UOSM4', 'FUGIP', 'H5MCC', 'YKEQE', 'D3AKL')) THEN 1 WHEN (g3yxs.SL76B IN ('QJEXM', 'J6S7P', 'VT7FI')) THEN 2 WHEN (g3yxs.SL76B IN ('Y62X7')) THEN 3 END as CC4AX, g3yxs.SL76B as SL76B, yqif4.id as QNI57, yvhjz.id as TDEIU]\n" +
-			"         │   │   │       │                           └─ Filter\n" +
-			"         │   │   │       │                               ├─ ((NOT(yqif4.id IS NULL)) OR (NOT(yvhjz.id IS NULL)))\n" +
-			"         │   │   │       │                               └─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │                                   ├─ (yvhjz.BRQP2 = ism.UJ6XY)\n" +
-			"         │   │   │       │                                   ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │                                   │   ├─ (yqif4.BRQP2 = ism.FV24E)\n" +
-			"         │   │   │       │                                   │   ├─ LeftOuterLookupJoin\n" +
-			"         │   │   │       │                                   │   │   ├─ (NOT((cpmfe.id = ism.FV24E)))\n" +
-			"         │   │   │       │                                   │   │   ├─ LeftOuterHashJoin\n" +
-			"         │   │   │       │                                   │   │   │   ├─ (nhmxw.id = ism.PRUV2)\n" +
-			"         │   │   │       │                                   │   │   │   ├─ MergeJoin\n" +
-			"         │   │   │       │                                   │   │   │   │   ├─ cmp: (ism.NZ4MQ = g3yxs.id)\n" +
-			"         │   │   │       │                                   │   │   │   │   ├─ TableAlias(ism)\n" +
-			"         │   │   │       │                                   │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
-			"         │   │   │       │                                   │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
-			"         │   │   │       │                                   │   │   │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │                                   │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"         │   │   │       │                                   │   │   │   │   └─ TableAlias(g3yxs)\n" +
-			"         │   │   │       │                                   │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
-			"         │   │   │       │                                   │   │   │   │           ├─ index: [YYBCX.id]\n" +
-			"         │   │   │       │                                   │   │   │   │           ├─ filters: [{[NULL, ∞)}]\n" +
-			"         │   │   │       │                                   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"         │   │   │       │                                   │   │   │   └─ HashLookup\n" +
-			"         │   │   │       │                                   │   │   │       ├─ left-key: (ism.PRUV2)\n" +
-			"         │   │   │       │                                   │   │   │       ├─ right-key: (nhmxw.id)\n" +
-			"         │   │   │       │                                   │   │   │       └─ TableAlias(nhmxw)\n" +
-			"         │   │   │       │                                   │   │   │           └─ Table\n" +
-			"         │   │   │       │                                   │   │   │               ├─ name: WGSDC\n" +
-			"         │   │   │       │                                   │   │   │               └─ columns: [id nohhr]\n" +
-			"         │   │   │       │                                   │   │   └─ TableAlias(cpmfe)\n" +
-			"         │   │   │       │                                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"         │   │   │       │                                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"         │   │   │       │                                   │   │           ├─ columns: [id tw55n zh72s]\n" +
-			"         │   │   │       │                                   │   │           └─ keys: nhmxw.NOHHR\n" +
-			"         │   │   │       │                                   │   └─ TableAlias(yqif4)\n" +
-			"         │   │   │       │                                   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │                                   │           ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │                                   │           ├─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   │       │                                   │           └─ keys: ism.UJ6XY\n" +
-			"         │   │   │       │                                   └─ TableAlias(yvhjz)\n" +
-			"         │   │   │       │                                       └─ IndexedTableAccess(NOXN3)\n" +
-			"         │   │   │       │                                           ├─ index: [NOXN3.FFTBJ]\n" +
-			"         │   │   │       │                                           ├─ columns: [id brqp2 fftbj]\n" +
-			"         │   │   │       │                                           └─ keys: ism.FV24E\n" +
+			"         │   │   │       │           ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, jchir.QNI57, NULL as TDEIU]\n" +
+			"         │   │   │       │           └─ SubqueryAlias\n" +
+			"         │   │   │       │               ├─ name: jchir\n" +
+			"         │   │   │       │               ├─ outerVisibility: false\n" +
+			"         │   │   │       │               ├─ isLateral: false\n" +
+			"         │   │   │       │               ├─ cacheable: true\n" +
+			"         │   │   │       │               └─ Filter\n" +
+			"         │   │   │       │                   ├─ ((NOT(QNI57 IS NULL)) AND (NOT(TDEIU IS NULL)))\n" +
+			"         │   │   │       │                   └─ Project\n" +
+			"         │   │   │       │                       ├─ columns: [ism.FV24E as FJDP5, cpmfe.id as BJUF2, cpmfe.TW55N as PSMU6, ism.M22QN as M22QN, g3yxs.GE5EL, g3yxs.F7A4Q, g3yxs.ESFVY, CASE  WHEN (g3yxs.SL76B IN ('FO422', 'SJ53H')) THEN 0 WHEN (g3yxs.SL76B IN ('DCV4Z', 'UOSM4', 'FUGIP', 'H5MCC', 'YKEQE', 'D3AKL')) THEN 1 WHEN (g3yxs.SL76B IN ('QJEXM', 'J6S7P', 'VT7FI')) THEN 2 WHEN (g3yxs.SL76B IN ('Y62X7')) THEN 3 END as CC4AX, g3yxs.SL76B as SL76B, yqif4.id as QNI57, yvhjz.id as TDEIU]\n" +
+			"         │   │   │       │                       └─ Filter\n" +
+			"         │   │   │       │                           ├─ ((NOT(yqif4.id IS NULL)) OR (NOT(yvhjz.id IS NULL)))\n" +
+			"         │   │   │       │                           └─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │                               ├─ (yvhjz.BRQP2 = ism.UJ6XY)\n" +
+			"         │   │   │       │                               ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │                               │   ├─ (yqif4.BRQP2 = ism.FV24E)\n" +
+			"         │   │   │       │                               │   ├─ LeftOuterLookupJoin\n" +
+			"         │   │   │       │                               │   │   ├─ (NOT((cpmfe.id = ism.FV24E)))\n" +
+			"         │   │   │       │                               │   │   ├─ LeftOuterHashJoin\n" +
+			"         │   │   │       │                               │   │   │   ├─ (nhmxw.id = ism.PRUV2)\n" +
+			"         │   │   │       │                               │   │   │   ├─ MergeJoin\n" +
+			"         │   │   │       │                               │   │   │   │   ├─ cmp: (ism.NZ4MQ = g3yxs.id)\n" +
+			"         │   │   │       │                               │   │   │   │   ├─ TableAlias(ism)\n" +
+			"         │   │   │       │                               │   │   │   │   │   └─ IndexedTableAccess(HDDVB)\n" +
+			"         │   │   │       │                               │   │   │   │   │       ├─ index: [HDDVB.NZ4MQ]\n" +
+			"         │   │   │       │                               │   │   │   │   │       ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │                               │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"         │   │   │       │                               │   │   │   │   └─ TableAlias(g3yxs)\n" +
+			"         │   │   │       │                               │   │   │   │       └─ IndexedTableAccess(YYBCX)\n" +
+			"         │   │   │       │                               │   │   │   │           ├─ index: [YYBCX.id]\n" +
+			"         │   │   │       │                               │   │   │   │           ├─ filters: [{[NULL, ∞)}]\n" +
+			"         │   │   │       │                               │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"         │   │   │       │                               │   │   │   └─ HashLookup\n" +
+			"         │   │   │       │                               │   │   │       ├─ left-key: (ism.PRUV2)\n" +
+			"         │   │   │       │                               │   │   │       ├─ right-key: (nhmxw.id)\n" +
+			"         │   │   │       │                               │   │   │       └─ TableAlias(nhmxw)\n" +
+			"         │   │   │       │                               │   │   │           └─ Table\n" +
+			"         │   │   │       │                               │   │   │               ├─ name: WGSDC\n" +
+			"         │   │   │       │                               │   │   │               └─ columns: [id nohhr]\n" +
+			"         │   │   │       │                               │   │   └─ TableAlias(cpmfe)\n" +
+			"         │   │   │       │                               │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"         │   │   │       │                               │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"         │   │   │       │                               │   │           ├─ columns: [id tw55n zh72s]\n" +
+			"         │   │   │       │                               │   │           └─ keys: nhmxw.NOHHR\n" +
+			"         │   │   │       │                               │   └─ TableAlias(yqif4)\n" +
+			"         │   │   │       │                               │       └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │                               │           ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │                               │           ├─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │                               │           └─ keys: ism.UJ6XY\n" +
+			"         │   │   │       │                               └─ TableAlias(yvhjz)\n" +
+			"         │   │   │       │                                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │   │   │       │                                       ├─ index: [NOXN3.FFTBJ]\n" +
+			"         │   │   │       │                                       ├─ columns: [id brqp2 fftbj]\n" +
+			"         │   │   │       │                                       └─ keys: ism.FV24E\n" +
 			"         │   │   │       └─ Project\n" +
-			"         │   │   │           ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, convert(QNI57, char) as QNI57, convert(jchir.TDEIU, char) as TDEIU]\n" +
+			"         │   │   │           ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, coerce(QNI57, varchar(24)) as QNI57, jchir.TDEIU]\n" +
 			"         │   │   │           └─ Project\n" +
 			"         │   │   │               ├─ columns: [jchir.FJDP5, jchir.BJUF2, jchir.PSMU6, jchir.M22QN, jchir.GE5EL, jchir.F7A4Q, jchir.ESFVY, jchir.CC4AX, jchir.SL76B, NULL as QNI57, jchir.TDEIU]\n" +
 			"         │   │   │               └─ SubqueryAlias\n" +
@@ -16331,16 +16256,17 @@ ORDER BY cla.FTQLQ ASC`,
 			"                 │           │       │       └─ Table\n" +
 			"                 │           │       │           ├─ name: THNTS\n" +
 			"                 │           │       │           └─ columns: [id nfryn ixuxu fhcyt]\n" +
-			"                 │           │       └─ Project\n" +
-			"                 │           │           ├─ columns: [hgmq6.GXLUB:1!null]\n" +
-			"                 │           │           └─ IndexedTableAccess(HGMQ6)\n" +
-			"                 │           │               ├─ index: [HGMQ6.GXLUB]\n" +
-			"                 │           │               ├─ static: [{[NULL, ∞)}]\n" +
-			"                 │           │               ├─ colSet: (35-51)\n" +
-			"                 │           │               ├─ tableId: 3\n" +
-			"                 │           │               └─ Table\n" +
-			"                 │           │                   ├─ name: HGMQ6\n" +
-			"                 │           │                   └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
+			"                 │           │       └─ Distinct\n" +
+			"                 │           │           └─ Project\n" +
+			"                 │           │               ├─ columns: [hgmq6.GXLUB:1!null]\n" +
+			"                 │           │               └─ IndexedTableAccess(HGMQ6)\n" +
+			"                 │           │                   ├─ index: [HGMQ6.GXLUB]\n" +
+			"                 │           │                   ├─ static: [{[NULL, ∞)}]\n" +
+			"                 │           │                   ├─ colSet: (35-51)\n" +
+			"                 │           │                   ├─ tableId: 3\n" +
+			"                 │           │                   └─ Table\n" +
+			"                 │           │                       ├─ name: HGMQ6\n" +
+			"                 │           │                       └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
 			"                 │           └─ Project\n" +
 			"                 │               ├─ columns: [amyxq.GXLUB:1!null]\n" +
 			"                 │               └─ IndexedTableAccess(AMYXQ)\n" +
@@ -16380,11 +16306,12 @@ ORDER BY cla.FTQLQ ASC`,
 			"                 │           │       │   └─ IndexedTableAccess(THNTS)\n" +
 			"                 │           │       │       ├─ index: [THNTS.id]\n" +
 			"                 │           │       │       └─ filters: [{[NULL, ∞)}]\n" +
-			"                 │           │       └─ Project\n" +
-			"                 │           │           ├─ columns: [hgmq6.GXLUB]\n" +
-			"                 │           │           └─ IndexedTableAccess(HGMQ6)\n" +
-			"                 │           │               ├─ index: [HGMQ6.GXLUB]\n" +
-			"                 │           │               └─ filters: [{[NULL, ∞)}]\n" +
+			"                 │           │       └─ Distinct\n" +
+			"                 │           │           └─ Project\n" +
+			"                 │           │               ├─ columns: [hgmq6.GXLUB]\n" +
+			"                 │           │               └─ IndexedTableAccess(HGMQ6)\n" +
+			"                 │           │                   ├─ index: [HGMQ6.GXLUB]\n" +
+			"                 │           │                   └─ filters: [{[NULL, ∞)}]\n" +
 			"                 │           └─ Project\n" +
 			"                 │               ├─ columns: [amyxq.GXLUB]\n" +
 			"                 │               └─ IndexedTableAccess(AMYXQ)\n" +
@@ -16417,11 +16344,12 @@ ORDER BY cla.FTQLQ ASC`,
 			"                 │           │       │   └─ IndexedTableAccess(THNTS)\n" +
 			"                 │           │       │       ├─ index: [THNTS.id]\n" +
 			"                 │           │       │       └─ filters: [{[NULL, ∞)}]\n" +
-			"                 │           │       └─ Project\n" +
-			"                 │           │           ├─ columns: [hgmq6.GXLUB]\n" +
-			"                 │           │           └─ IndexedTableAccess(HGMQ6)\n" +
-			"                 │           │               ├─ index: [HGMQ6.GXLUB]\n" +
-			"                 │           │               └─ filters: [{[NULL, ∞)}]\n" +
+			"                 │           │       └─ Distinct\n" +
+			"                 │           │           └─ Project\n" +
+			"                 │           │               ├─ columns: [hgmq6.GXLUB]\n" +
+			"                 │           │               └─ IndexedTableAccess(HGMQ6)\n" +
+			"                 │           │                   ├─ index: [HGMQ6.GXLUB]\n" +
+			"                 │           │                   └─ filters: [{[NULL, ∞)}]\n" +
 			"                 │           └─ Project\n" +
 			"                 │               ├─ columns: [amyxq.GXLUB]\n" +
 			"                 │               └─ IndexedTableAccess(AMYXQ)\n" +
@@ -16568,16 +16496,17 @@ ORDER BY cla.FTQLQ ASC`,
 			"                 │               │       └─ Table\n" +
 			"                 │               │           ├─ name: THNTS\n" +
 			"                 │               │           └─ columns: [id nfryn ixuxu fhcyt]\n" +
-			"                 │               └─ Project\n" +
-			"                 │                   ├─ columns: [amyxq.GXLUB:1!null]\n" +
-			"                 │                   └─ IndexedTableAccess(AMYXQ)\n" +
-			"                 │                       ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
-			"                 │                       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"                 │                       ├─ colSet: (35-42)\n" +
-			"                 │                       ├─ tableId: 3\n" +
-			"                 │                       └─ Table\n" +
-			"                 │                           ├─ name: AMYXQ\n" +
-			"                 │                           └─ columns: [id gxlub luevy xqdyt amyxq oztqf z35gy kkgn5]\n" +
+			"                 │               └─ Distinct\n" +
+			"                 │                   └─ Project\n" +
+			"                 │                       ├─ columns: [amyxq.GXLUB:1!null]\n" +
+			"                 │                       └─ IndexedTableAccess(AMYXQ)\n" +
+			"                 │                           ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
+			"                 │                           ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 │                           ├─ colSet: (35-42)\n" +
+			"                 │                           ├─ tableId: 3\n" +
+			"                 │                           └─ Table\n" +
+			"                 │                               ├─ name: AMYXQ\n" +
+			"                 │                               └─ columns: [id gxlub luevy xqdyt amyxq oztqf z35gy kkgn5]\n" +
 			"                 └─ HashLookup\n" +
 			"                     ├─ left-key: TUPLE(bs.IXUXU:0)\n" +
 			"                     ├─ right-key: TUPLE(cla.id:0!null)\n" +
@@ -16606,11 +16535,12 @@ ORDER BY cla.FTQLQ ASC`,
 			"                 │               │   └─ IndexedTableAccess(THNTS)\n" +
 			"                 │               │       ├─ index: [THNTS.id]\n" +
 			"                 │               │       └─ filters: [{[NULL, ∞)}]\n" +
-			"                 │               └─ Project\n" +
-			"                 │                   ├─ columns: [amyxq.GXLUB]\n" +
-			"                 │                   └─ IndexedTableAccess(AMYXQ)\n" +
-			"                 │                       ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
-			"                 │                       └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 │               └─ Distinct\n" +
+			"                 │                   └─ Project\n" +
+			"                 │                       ├─ columns: [amyxq.GXLUB]\n" +
+			"                 │                       └─ IndexedTableAccess(AMYXQ)\n" +
+			"                 │                           ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
+			"                 │                           └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"                 └─ HashLookup\n" +
 			"                     ├─ left-key: (bs.IXUXU)\n" +
 			"                     ├─ right-key: (cla.id)\n" +
@@ -16637,11 +16567,12 @@ ORDER BY cla.FTQLQ ASC`,
 			"                 │               │   └─ IndexedTableAccess(THNTS)\n" +
 			"                 │               │       ├─ index: [THNTS.id]\n" +
 			"                 │               │       └─ filters: [{[NULL, ∞)}]\n" +
-			"                 │               └─ Project\n" +
-			"                 │                   ├─ columns: [amyxq.GXLUB]\n" +
-			"                 │                   └─ IndexedTableAccess(AMYXQ)\n" +
-			"                 │                       ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
-			"                 │                       └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                 │               └─ Distinct\n" +
+			"                 │                   └─ Project\n" +
+			"                 │                       ├─ columns: [amyxq.GXLUB]\n" +
+			"                 │                       └─ IndexedTableAccess(AMYXQ)\n" +
+			"                 │                           ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
+			"                 │                           └─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
 			"                 └─ HashLookup\n" +
 			"                     ├─ left-key: (bs.IXUXU)\n" +
 			"                     ├─ right-key: (cla.id)\n" +
@@ -16767,22 +16698,7 @@ LEFT JOIN XGSJM YBBG5
     ON YPGDA.I3L5A = YBBG5.id
 ORDER BY LUEVY`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [ypgda.LUEVY:0!null as LUEVY, ypgda.TW55N:1!null as TW55N, ypgda.IYDZV:2 as IYDZV,  (longtext) as IIISV, ypgda.QRQXW:3!null as QRQXW, ypgda.CAECS:4 as CAECS, ypgda.CJLLY:5!null as CJLLY, ypgda.SHP7H:6!null as SHP7H, ypgda.HARAZ:7 as HARAZ,  (longtext) as ECUWU,  (longtext) as LDMO7, CASE  WHEN Eq\n" +
-			" │   ├─ ybbg5.DZLIM:13!null\n" +
-			" │   └─ HGUEM (longtext)\n" +
-			" │   THEN s30 (longtext) WHEN Eq\n" +
-			" │   ├─ ybbg5.DZLIM:13!null\n" +
-			" │   └─ YUHMV (longtext)\n" +
-			" │   THEN r90 (longtext) WHEN Eq\n" +
-			" │   ├─ ybbg5.DZLIM:13!null\n" +
-			" │   └─ T3JIU (longtext)\n" +
-			" │   THEN r50 (longtext) WHEN Eq\n" +
-			" │   ├─ ybbg5.DZLIM:13!null\n" +
-			" │   └─ s (longtext)\n" +
-			" │   THEN s (longtext) WHEN Eq\n" +
-			" │   ├─ ybbg5.DZLIM:13!null\n" +
-			" │   └─ AX25H (longtext)\n" +
-			" │   THEN r70 (longtext) WHEN ybbg5.DZLIM:13!null IS NULL THEN  (longtext) ELSE ybbg5.DZLIM:13!null END as UBUYI, ypgda.FUG6J:9 as FUG6J, ypgda.NF5AM:10 as NF5AM, ypgda.FRCVC:11!null as FRCVC]\n" +
+			" ├─ columns: [ypgda.LUEVY:0!null as LUEVY, ypgda.TW55N:1!null as TW55N, ypgda.IYDZV:2 as IYDZV,  (longtext) as IIISV, ypgda.QRQXW:3!null as QRQXW, ypgda.CAECS:4 as CAECS, ypgda.CJLLY:5!null as CJLLY, ypgda.SHP7H:6!null as SHP7H, ypgda.HARAZ:7 as HARAZ,  (longtext) as ECUWU,  (longtext) as LDMO7, UBUYI:26!null, ypgda.FUG6J:9 as FUG6J, ypgda.NF5AM:10 as NF5AM, ypgda.FRCVC:11!null as FRCVC]\n" +
 			" └─ Sort(ypgda.LUEVY:0!null as LUEVY ASC nullsFirst)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [ypgda.LUEVY:0!null, ypgda.TW55N:1!null, ypgda.IYDZV:2, ypgda.QRQXW:3!null, ypgda.CAECS:4, ypgda.CJLLY:5!null, ypgda.SHP7H:6!null, ypgda.HARAZ:7, ypgda.I3L5A:8, ypgda.FUG6J:9, ypgda.NF5AM:10, ypgda.FRCVC:11!null, ybbg5.id:12!null, ybbg5.DZLIM:13!null, ybbg5.F3YUE:14, ypgda.LUEVY:0!null as LUEVY, ypgda.TW55N:1!null as TW55N, ypgda.IYDZV:2 as IYDZV,  (longtext) as IIISV, ypgda.QRQXW:3!null as QRQXW, ypgda.CAECS:4 as CAECS, ypgda.CJLLY:5!null as CJLLY, ypgda.SHP7H:6!null as SHP7H, ypgda.HARAZ:7 as HARAZ,  (longtext) as ECUWU,  (longtext) as LDMO7, CASE  WHEN Eq\n" +
@@ -16878,7 +16794,7 @@ ORDER BY LUEVY`,
 			"                             └─ columns: [id dzlim f3yue]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [ypgda.LUEVY as LUEVY, ypgda.TW55N as TW55N, ypgda.IYDZV as IYDZV, '' as IIISV, ypgda.QRQXW as QRQXW, ypgda.CAECS as CAECS, ypgda.CJLLY as CJLLY, ypgda.SHP7H as SHP7H, ypgda.HARAZ as HARAZ, '' as ECUWU, '' as LDMO7, CASE  WHEN (ybbg5.DZLIM = 'HGUEM') THEN 's30' WHEN (ybbg5.DZLIM = 'YUHMV') THEN 'r90' WHEN (ybbg5.DZLIM = 'T3JIU') THEN 'r50' WHEN (ybbg5.DZLIM = 's') THEN 's' WHEN (ybbg5.DZLIM = 'AX25H') THEN 'r70' WHEN ybbg5.DZLIM IS NULL THEN '' ELSE ybbg5.DZLIM END as UBUYI, ypgda.FUG6J as FUG6J, ypgda.NF5AM as NF5AM, ypgda.FRCVC as FRCVC]\n" +
+			" ├─ columns: [ypgda.LUEVY as LUEVY, ypgda.TW55N as TW55N, ypgda.IYDZV as IYDZV, '' as IIISV, ypgda.QRQXW as QRQXW, ypgda.CAECS as CAECS, ypgda.CJLLY as CJLLY, ypgda.SHP7H as SHP7H, ypgda.HARAZ as HARAZ, '' as ECUWU, '' as LDMO7, UBUYI, ypgda.FUG6J as FUG6J, ypgda.NF5AM as NF5AM, ypgda.FRCVC as FRCVC]\n" +
 			" └─ Sort(ypgda.LUEVY as LUEVY ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [ypgda.LUEVY, ypgda.TW55N, ypgda.IYDZV, ypgda.QRQXW, ypgda.CAECS, ypgda.CJLLY, ypgda.SHP7H, ypgda.HARAZ, ypgda.I3L5A, ypgda.FUG6J, ypgda.NF5AM, ypgda.FRCVC, ybbg5.id, ybbg5.DZLIM, ybbg5.F3YUE, ypgda.LUEVY as LUEVY, ypgda.TW55N as TW55N, ypgda.IYDZV as IYDZV, '' as IIISV, ypgda.QRQXW as QRQXW, ypgda.CAECS as CAECS, ypgda.CJLLY as CJLLY, ypgda.SHP7H as SHP7H, ypgda.HARAZ as HARAZ, '' as ECUWU, '' as LDMO7, CASE  WHEN (ybbg5.DZLIM = 'HGUEM') THEN 's30' WHEN (ybbg5.DZLIM = 'YUHMV') THEN 'r90' WHEN (ybbg5.DZLIM = 'T3JIU') THEN 'r50' WHEN (ybbg5.DZLIM = 's') THEN 's' WHEN (ybbg5.DZLIM = 'AX25H') THEN 'r70' WHEN ybbg5.DZLIM IS NULL THEN '' ELSE ybbg5.DZLIM END as UBUYI, ypgda.FUG6J as FUG6J, ypgda.NF5AM as NF5AM, ypgda.FRCVC as FRCVC]\n" +
@@ -16932,7 +16848,7 @@ ORDER BY LUEVY`,
 			"                         └─ columns: [id dzlim f3yue]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [ypgda.LUEVY as LUEVY, ypgda.TW55N as TW55N, ypgda.IYDZV as IYDZV, '' as IIISV, ypgda.QRQXW as QRQXW, ypgda.CAECS as CAECS, ypgda.CJLLY as CJLLY, ypgda.SHP7H as SHP7H, ypgda.HARAZ as HARAZ, '' as ECUWU, '' as LDMO7, CASE  WHEN (ybbg5.DZLIM = 'HGUEM') THEN 's30' WHEN (ybbg5.DZLIM = 'YUHMV') THEN 'r90' WHEN (ybbg5.DZLIM = 'T3JIU') THEN 'r50' WHEN (ybbg5.DZLIM = 's') THEN 's' WHEN (ybbg5.DZLIM = 'AX25H') THEN 'r70' WHEN ybbg5.DZLIM IS NULL THEN '' ELSE ybbg5.DZLIM END as UBUYI, ypgda.FUG6J as FUG6J, ypgda.NF5AM as NF5AM, ypgda.FRCVC as FRCVC]\n" +
+			" ├─ columns: [ypgda.LUEVY as LUEVY, ypgda.TW55N as TW55N, ypgda.IYDZV as IYDZV, '' as IIISV, ypgda.QRQXW as QRQXW, ypgda.CAECS as CAECS, ypgda.CJLLY as CJLLY, ypgda.SHP7H as SHP7H, ypgda.HARAZ as HARAZ, '' as ECUWU, '' as LDMO7, UBUYI, ypgda.FUG6J as FUG6J, ypgda.NF5AM as NF5AM, ypgda.FRCVC as FRCVC]\n" +
 			" └─ Sort(ypgda.LUEVY as LUEVY ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [ypgda.LUEVY, ypgda.TW55N, ypgda.IYDZV, ypgda.QRQXW, ypgda.CAECS, ypgda.CJLLY, ypgda.SHP7H, ypgda.HARAZ, ypgda.I3L5A, ypgda.FUG6J, ypgda.NF5AM, ypgda.FRCVC, ybbg5.id, ybbg5.DZLIM, ybbg5.F3YUE, ypgda.LUEVY as LUEVY, ypgda.TW55N as TW55N, ypgda.IYDZV as IYDZV, '' as IIISV, ypgda.QRQXW as QRQXW, ypgda.CAECS as CAECS, ypgda.CJLLY as CJLLY, ypgda.SHP7H as SHP7H, ypgda.HARAZ as HARAZ, '' as ECUWU, '' as LDMO7, CASE  WHEN (ybbg5.DZLIM = 'HGUEM') THEN 's30' WHEN (ybbg5.DZLIM = 'YUHMV') THEN 'r90' WHEN (ybbg5.DZLIM = 'T3JIU') THEN 'r50' WHEN (ybbg5.DZLIM = 's') THEN 's' WHEN (ybbg5.DZLIM = 'AX25H') THEN 'r70' WHEN ybbg5.DZLIM IS NULL THEN '' ELSE ybbg5.DZLIM END as UBUYI, ypgda.FUG6J as FUG6J, ypgda.NF5AM as NF5AM, ypgda.FRCVC as FRCVC]\n" +
@@ -17066,7 +16982,7 @@ LEFT JOIN
     ON sn.A7XO2 = it.id
 ORDER BY sn.id ASC`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [tvqg4.TW55N:13!null as FJVD7, lsm32.TW55N:30!null as KBXXJ, sn.NUMK2:6!null as NUMK2, CASE  WHEN it.DZLIM:45!null IS NULL THEN N/A (longtext) ELSE it.DZLIM:45!null END as TP6BK, sn.ECDKM:5 as ECDKM, sn.KBO7R:4!null as KBO7R, CASE  WHEN sn.YKSSU:8 IS NULL THEN N/A (longtext) ELSE sn.YKSSU:8 END as RQI4M, CASE  WHEN sn.FHCYT:9 IS NULL THEN N/A (longtext) ELSE sn.FHCYT:9 END as RNVLS, sn.LETOE:7!null as LETOE]\n" +
+			" ├─ columns: [tvqg4.TW55N:13!null as FJVD7, lsm32.TW55N:30!null as KBXXJ, sn.NUMK2:6!null as NUMK2, TP6BK:50!null, sn.ECDKM:5 as ECDKM, sn.KBO7R:4!null as KBO7R, RQI4M:53, RNVLS:54, sn.LETOE:7!null as LETOE]\n" +
 			" └─ Sort(sn.id:0!null ASC nullsFirst)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [sn.id:0!null, sn.BRQP2:1!null, sn.FFTBJ:2!null, sn.A7XO2:3, sn.KBO7R:4!null, sn.ECDKM:5, sn.NUMK2:6!null, sn.LETOE:7!null, sn.YKSSU:8, sn.FHCYT:9, tvqg4.id:10!null, tvqg4.DKCAJ:11!null, tvqg4.KNG7T:12, tvqg4.TW55N:13!null, tvqg4.QRQXW:14!null, tvqg4.ECXAJ:15!null, tvqg4.FGG57:16, tvqg4.ZH72S:17, tvqg4.FSK67:18!null, tvqg4.XQDYT:19!null, tvqg4.TCE7A:20, tvqg4.IWV2H:21, tvqg4.HPCMS:22!null, tvqg4.N5CC2:23, tvqg4.FHCYT:24, tvqg4.ETAQ7:25, tvqg4.A75X7:26, lsm32.id:27!null, lsm32.DKCAJ:28!null, lsm32.KNG7T:29, lsm32.TW55N:30!null, lsm32.QRQXW:31!null, lsm32.ECXAJ:32!null, lsm32.FGG57:33, lsm32.ZH72S:34, lsm32.FSK67:35!null, lsm32.XQDYT:36!null, lsm32.TCE7A:37, lsm32.IWV2H:38, lsm32.HPCMS:39!null, lsm32.N5CC2:40, lsm32.FHCYT:41, lsm32.ETAQ7:42, lsm32.A75X7:43, it.id:44!null, it.DZLIM:45!null, it.F3YUE:46, tvqg4.TW55N:13!null as FJVD7, lsm32.TW55N:30!null as KBXXJ, sn.NUMK2:6!null as NUMK2, CASE  WHEN it.DZLIM:45!null IS NULL THEN N/A (longtext) ELSE it.DZLIM:45!null END as TP6BK, sn.ECDKM:5 as ECDKM, sn.KBO7R:4!null as KBO7R, CASE  WHEN sn.YKSSU:8 IS NULL THEN N/A (longtext) ELSE sn.YKSSU:8 END as RQI4M, CASE  WHEN sn.FHCYT:9 IS NULL THEN N/A (longtext) ELSE sn.FHCYT:9 END as RNVLS, sn.LETOE:7!null as LETOE]\n" +
@@ -17114,7 +17030,7 @@ ORDER BY sn.id ASC`,
 			"                         └─ columns: [id dzlim f3yue]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [tvqg4.TW55N as FJVD7, lsm32.TW55N as KBXXJ, sn.NUMK2 as NUMK2, CASE  WHEN it.DZLIM IS NULL THEN 'N/A' ELSE it.DZLIM END as TP6BK, sn.ECDKM as ECDKM, sn.KBO7R as KBO7R, CASE  WHEN sn.YKSSU IS NULL THEN 'N/A' ELSE sn.YKSSU END as RQI4M, CASE  WHEN sn.FHCYT IS NULL THEN 'N/A' ELSE sn.FHCYT END as RNVLS, sn.LETOE as LETOE]\n" +
+			" ├─ columns: [tvqg4.TW55N as FJVD7, lsm32.TW55N as KBXXJ, sn.NUMK2 as NUMK2, TP6BK, sn.ECDKM as ECDKM, sn.KBO7R as KBO7R, RQI4M, RNVLS, sn.LETOE as LETOE]\n" +
 			" └─ Sort(sn.id ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, tvqg4.id, tvqg4.DKCAJ, tvqg4.KNG7T, tvqg4.TW55N, tvqg4.QRQXW, tvqg4.ECXAJ, tvqg4.FGG57, tvqg4.ZH72S, tvqg4.FSK67, tvqg4.XQDYT, tvqg4.TCE7A, tvqg4.IWV2H, tvqg4.HPCMS, tvqg4.N5CC2, tvqg4.FHCYT, tvqg4.ETAQ7, tvqg4.A75X7, lsm32.id, lsm32.DKCAJ, lsm32.KNG7T, lsm32.TW55N, lsm32.QRQXW, lsm32.ECXAJ, lsm32.FGG57, lsm32.ZH72S, lsm32.FSK67, lsm32.XQDYT, lsm32.TCE7A, lsm32.IWV2H, lsm32.HPCMS, lsm32.N5CC2, lsm32.FHCYT, lsm32.ETAQ7, lsm32.A75X7, it.id, it.DZLIM, it.F3YUE, tvqg4.TW55N as FJVD7, lsm32.TW55N as KBXXJ, sn.NUMK2 as NUMK2, CASE  WHEN it.DZLIM IS NULL THEN 'N/A' ELSE it.DZLIM END as TP6BK, sn.ECDKM as ECDKM, sn.KBO7R as KBO7R, CASE  WHEN sn.YKSSU IS NULL THEN 'N/A' ELSE sn.YKSSU END as RQI4M, CASE  WHEN sn.FHCYT IS NULL THEN 'N/A' ELSE sn.FHCYT END as RNVLS, sn.LETOE as LETOE]\n" +
@@ -17144,7 +17060,7 @@ ORDER BY sn.id ASC`,
 			"                     └─ keys: sn.A7XO2\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [tvqg4.TW55N as FJVD7, lsm32.TW55N as KBXXJ, sn.NUMK2 as NUMK2, CASE  WHEN it.DZLIM IS NULL THEN 'N/A' ELSE it.DZLIM END as TP6BK, sn.ECDKM as ECDKM, sn.KBO7R as KBO7R, CASE  WHEN sn.YKSSU IS NULL THEN 'N/A' ELSE sn.YKSSU END as RQI4M, CASE  WHEN sn.FHCYT IS NULL THEN 'N/A' ELSE sn.FHCYT END as RNVLS, sn.LETOE as LETOE]\n" +
+			" ├─ columns: [tvqg4.TW55N as FJVD7, lsm32.TW55N as KBXXJ, sn.NUMK2 as NUMK2, TP6BK, sn.ECDKM as ECDKM, sn.KBO7R as KBO7R, RQI4M, RNVLS, sn.LETOE as LETOE]\n" +
 			" └─ Sort(sn.id ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [sn.id, sn.BRQP2, sn.FFTBJ, sn.A7XO2, sn.KBO7R, sn.ECDKM, sn.NUMK2, sn.LETOE, sn.YKSSU, sn.FHCYT, tvqg4.id, tvqg4.DKCAJ, tvqg4.KNG7T, tvqg4.TW55N, tvqg4.QRQXW, tvqg4.ECXAJ, tvqg4.FGG57, tvqg4.ZH72S, tvqg4.FSK67, tvqg4.XQDYT, tvqg4.TCE7A, tvqg4.IWV2H, tvqg4.HPCMS, tvqg4.N5CC2, tvqg4.FHCYT, tvqg4.ETAQ7, tvqg4.A75X7, lsm32.id, lsm32.DKCAJ, lsm32.KNG7T, lsm32.TW55N, lsm32.QRQXW, lsm32.ECXAJ, lsm32.FGG57, lsm32.ZH72S, lsm32.FSK67, lsm32.XQDYT, lsm32.TCE7A, lsm32.IWV2H, lsm32.HPCMS, lsm32.N5CC2, lsm32.FHCYT, lsm32.ETAQ7, lsm32.A75X7, it.id, it.DZLIM, it.F3YUE, tvqg4.TW55N as FJVD7, lsm32.TW55N as KBXXJ, sn.NUMK2 as NUMK2, CASE  WHEN it.DZLIM IS NULL THEN 'N/A' ELSE it.DZLIM END as TP6BK, sn.ECDKM as ECDKM, sn.KBO7R as KBO7R, CASE  WHEN sn.YKSSU IS NULL THEN 'N/A' ELSE sn.YKSSU END as RQI4M, CASE  WHEN sn.FHCYT IS NULL THEN 'N/A' ELSE sn.FHCYT END as RNVLS, sn.LETOE as LETOE]\n" +
@@ -17255,7 +17171,7 @@ LEFT JOIN
     ON AYFCD.FFTBJ = FA75Y.id
 ORDER BY rn.id ASC`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [sdllr.TW55N:29!null as FZX4Y, jgt2h.LETOE:13!null as QWTOI, riiw6.TW55N:46!null as PDX5Y, ayfcd.NUMK2:22!null as V45YB, ayfcd.LETOE:23!null as DAGQN, fa75y.TW55N:63!null as SFQTS, rn.HVHRZ:3!null as HVHRZ, CASE  WHEN rn.YKSSU:4 IS NULL THEN N/A (longtext) ELSE rn.YKSSU:4 END as RQI4M, CASE  WHEN rn.FHCYT:5 IS NULL THEN N/A (longtext) ELSE rn.FHCYT:5 END as RNVLS]\n" +
+			" ├─ columns: [sdllr.TW55N:29!null as FZX4Y, jgt2h.LETOE:13!null as QWTOI, riiw6.TW55N:46!null as PDX5Y, ayfcd.NUMK2:22!null as V45YB, ayfcd.LETOE:23!null as DAGQN, fa75y.TW55N:63!null as SFQTS, rn.HVHRZ:3!null as HVHRZ, RQI4M:84, RNVLS:85]\n" +
 			" └─ Sort(rn.id:0!null ASC nullsFirst)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [rn.id:0!null, rn.WNUNU:1!null, rn.HHVLX:2!null, rn.HVHRZ:3!null, rn.YKSSU:4, rn.FHCYT:5, jgt2h.id:6!null, jgt2h.BRQP2:7!null, jgt2h.FFTBJ:8!null, jgt2h.A7XO2:9, jgt2h.KBO7R:10!null, jgt2h.ECDKM:11, jgt2h.NUMK2:12!null, jgt2h.LETOE:13!null, jgt2h.YKSSU:14, jgt2h.FHCYT:15, ayfcd.id:16!null, ayfcd.BRQP2:17!null, ayfcd.FFTBJ:18!null, ayfcd.A7XO2:19, ayfcd.KBO7R:20!null, ayfcd.ECDKM:21, ayfcd.NUMK2:22!null, ayfcd.LETOE:23!null, ayfcd.YKSSU:24, ayfcd.FHCYT:25, sdllr.id:26!null, sdllr.DKCAJ:27!null, sdllr.KNG7T:28, sdllr.TW55N:29!null, sdllr.QRQXW:30!null, sdllr.ECXAJ:31!null, sdllr.FGG57:32, sdllr.ZH72S:33, sdllr.FSK67:34!null, sdllr.XQDYT:35!null, sdllr.TCE7A:36, sdllr.IWV2H:37, sdllr.HPCMS:38!null, sdllr.N5CC2:39, sdllr.FHCYT:40, sdllr.ETAQ7:41, sdllr.A75X7:42, riiw6.id:43!null, riiw6.DKCAJ:44!null, riiw6.KNG7T:45, riiw6.TW55N:46!null, riiw6.QRQXW:47!null, riiw6.ECXAJ:48!null, riiw6.FGG57:49, riiw6.ZH72S:50, riiw6.FSK67:51!null, riiw6.XQDYT:52!null, riiw6.TCE7A:53, riiw6.IWV2H:54, riiw6.HPCMS:55!null, riiw6.N5CC2:56, riiw6.FHCYT:57, riiw6.ETAQ7:58, riiw6.A75X7:59, fa75y.id:60!null, fa75y.DKCAJ:61!null, fa75y.KNG7T:62, fa75y.TW55N:63!null, fa75y.QRQXW:64!null, fa75y.ECXAJ:65!null, fa75y.FGG57:66, fa75y.ZH72S:67, fa75y.FSK67:68!null, fa75y.XQDYT:69!null, fa75y.TCE7A:70, fa75y.IWV2H:71, fa75y.HPCMS:72!null, fa75y.N5CC2:73, fa75y.FHCYT:74, fa75y.ETAQ7:75, fa75y.A75X7:76, sdllr.TW55N:29!null as FZX4Y, jgt2h.LETOE:13!null as QWTOI, riiw6.TW55N:46!null as PDX5Y, ayfcd.NUMK2:22!null as V45YB, ayfcd.LETOE:23!null as DAGQN, fa75y.TW55N:63!null as SFQTS, rn.HVHRZ:3!null as HVHRZ, CASE  WHEN rn.YKSSU:4 IS NULL THEN N/A (longtext) ELSE rn.YKSSU:4 END as RQI4M, CASE  WHEN rn.FHCYT:5 IS NULL THEN N/A (longtext) ELSE rn.FHCYT:5 END as RNVLS]\n" +
@@ -17323,7 +17239,7 @@ ORDER BY rn.id ASC`,
 			"                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [sdllr.TW55N as FZX4Y, jgt2h.LETOE as QWTOI, riiw6.TW55N as PDX5Y, ayfcd.NUMK2 as V45YB, ayfcd.LETOE as DAGQN, fa75y.TW55N as SFQTS, rn.HVHRZ as HVHRZ, CASE  WHEN rn.YKSSU IS NULL THEN 'N/A' ELSE rn.YKSSU END as RQI4M, CASE  WHEN rn.FHCYT IS NULL THEN 'N/A' ELSE rn.FHCYT END as RNVLS]\n" +
+			" ├─ columns: [sdllr.TW55N as FZX4Y, jgt2h.LETOE as QWTOI, riiw6.TW55N as PDX5Y, ayfcd.NUMK2 as V45YB, ayfcd.LETOE as DAGQN, fa75y.TW55N as SFQTS, rn.HVHRZ as HVHRZ, RQI4M, RNVLS]\n" +
 			" └─ Sort(rn.id ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [rn.id, rn.WNUNU, rn.HHVLX, rn.HVHRZ, rn.YKSSU, rn.FHCYT, jgt2h.id, jgt2h.BRQP2, jgt2h.FFTBJ, jgt2h.A7XO2, jgt2h.KBO7R, jgt2h.ECDKM, jgt2h.NUMK2, jgt2h.LETOE, jgt2h.YKSSU, jgt2h.FHCYT, ayfcd.id, ayfcd.BRQP2, ayfcd.FFTBJ, ayfcd.A7XO2, ayfcd.KBO7R, ayfcd.ECDKM, ayfcd.NUMK2, ayfcd.LETOE, ayfcd.YKSSU, ayfcd.FHCYT, sdllr.id, sdllr.DKCAJ, sdllr.KNG7T, sdllr.TW55N, sdllr.QRQXW, sdllr.ECXAJ, sdllr.FGG57, sdllr.ZH72S, sdllr.FSK67, sdllr.XQDYT, sdllr.TCE7A, sdllr.IWV2H, sdllr.HPCMS, sdllr.N5CC2, sdllr.FHCYT, sdllr.ETAQ7, sdllr.A75X7, riiw6.id, riiw6.DKCAJ, riiw6.KNG7T, riiw6.TW55N, riiw6.QRQXW, riiw6.ECXAJ, riiw6.FGG57, riiw6.ZH72S, riiw6.FSK67, riiw6.XQDYT, riiw6.TCE7A, riiw6.IWV2H, riiw6.HPCMS, riiw6.N5CC2, riiw6.FHCYT, riiw6.ETAQ7, riiw6.A75X7, fa75y.id, fa75y.DKCAJ, fa75y.KNG7T, fa75y.TW55N, fa75y.QRQXW, fa75y.ECXAJ, fa75y.FGG57, fa75y.ZH72S, fa75y.FSK67, fa75y.XQDYT, fa75y.TCE7A, fa75y.IWV2H, fa75y.HPCMS, fa75y.N5CC2, fa75y.FHCYT, fa75y.ETAQ7, fa75y.A75X7, sdllr.TW55N as FZX4Y, jgt2h.LETOE as QWTOI, riiw6.TW55N as PDX5Y, ayfcd.NUMK2 as V45YB, ayfcd.LETOE as DAGQN, fa75y.TW55N as SFQTS, rn.HVHRZ as HVHRZ, CASE  WHEN rn.YKSSU IS NULL THEN 'N/A' ELSE rn.YKSSU END as RQI4M, CASE  WHEN rn.FHCYT IS NULL THEN 'N/A' ELSE rn.FHCYT END as RNVLS]\n" +
@@ -17365,7 +17281,7 @@ ORDER BY rn.id ASC`,
 			"                     └─ keys: ayfcd.FFTBJ\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [sdllr.TW55N as FZX4Y, jgt2h.LETOE as QWTOI, riiw6.TW55N as PDX5Y, ayfcd.NUMK2 as V45YB, ayfcd.LETOE as DAGQN, fa75y.TW55N as SFQTS, rn.HVHRZ as HVHRZ, CASE  WHEN rn.YKSSU IS NULL THEN 'N/A' ELSE rn.YKSSU END as RQI4M, CASE  WHEN rn.FHCYT IS NULL THEN 'N/A' ELSE rn.FHCYT END as RNVLS]\n" +
+			" ├─ columns: [sdllr.TW55N as FZX4Y, jgt2h.LETOE as QWTOI, riiw6.TW55N as PDX5Y, ayfcd.NUMK2 as V45YB, ayfcd.LETOE as DAGQN, fa75y.TW55N as SFQTS, rn.HVHRZ as HVHRZ, RQI4M, RNVLS]\n" +
 			" └─ Sort(rn.id ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [rn.id, rn.WNUNU, rn.HHVLX, rn.HVHRZ, rn.YKSSU, rn.FHCYT, jgt2h.id, jgt2h.BRQP2, jgt2h.FFTBJ, jgt2h.A7XO2, jgt2h.KBO7R, jgt2h.ECDKM, jgt2h.NUMK2, jgt2h.LETOE, jgt2h.YKSSU, jgt2h.FHCYT, ayfcd.id, ayfcd.BRQP2, ayfcd.FFTBJ, ayfcd.A7XO2, ayfcd.KBO7R, ayfcd.ECDKM, ayfcd.NUMK2, ayfcd.LETOE, ayfcd.YKSSU, ayfcd.FHCYT, sdllr.id, sdllr.DKCAJ, sdllr.KNG7T, sdllr.TW55N, sdllr.QRQXW, sdllr.ECXAJ, sdllr.FGG57, sdllr.ZH72S, sdllr.FSK67, sdllr.XQDYT, sdllr.TCE7A, sdllr.IWV2H, sdllr.HPCMS, sdllr.N5CC2, sdllr.FHCYT, sdllr.ETAQ7, sdllr.A75X7, riiw6.id, riiw6.DKCAJ, riiw6.KNG7T, riiw6.TW55N, riiw6.QRQXW, riiw6.ECXAJ, riiw6.FGG57, riiw6.ZH72S, riiw6.FSK67, riiw6.XQDYT, riiw6.TCE7A, riiw6.IWV2H, riiw6.HPCMS, riiw6.N5CC2, riiw6.FHCYT, riiw6.ETAQ7, riiw6.A75X7, fa75y.id, fa75y.DKCAJ, fa75y.KNG7T, fa75y.TW55N, fa75y.QRQXW, fa75y.ECXAJ, fa75y.FGG57, fa75y.ZH72S, fa75y.FSK67, fa75y.XQDYT, fa75y.TCE7A, fa75y.IWV2H, fa75y.HPCMS, fa75y.N5CC2, fa75y.FHCYT, fa75y.ETAQ7, fa75y.A75X7, sdllr.TW55N as FZX4Y, jgt2h.LETOE as QWTOI, riiw6.TW55N as PDX5Y, ayfcd.NUMK2 as V45YB, ayfcd.LETOE as DAGQN, fa75y.TW55N as SFQTS, rn.HVHRZ as HVHRZ, CASE  WHEN rn.YKSSU IS NULL THEN 'N/A' ELSE rn.YKSSU END as RQI4M, CASE  WHEN rn.FHCYT IS NULL THEN 'N/A' ELSE rn.FHCYT END as RNVLS]\n" +
@@ -17558,10 +17474,7 @@ SELECT
     FROM NOXN3
     ORDER BY id ASC`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [CASE  WHEN Eq\n" +
-			" │   ├─ noxn3.NUMK2:6!null\n" +
-			" │   └─ 2 (tinyint)\n" +
-			" │   THEN noxn3.ECDKM:5 ELSE 0 (tinyint) END as RGXLL]\n" +
+			" ├─ columns: [RGXLL:10]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [noxn3.id:0!null, noxn3.BRQP2:1!null, noxn3.FFTBJ:2!null, noxn3.A7XO2:3, noxn3.KBO7R:4!null, noxn3.ECDKM:5, noxn3.NUMK2:6!null, noxn3.LETOE:7!null, noxn3.YKSSU:8, noxn3.FHCYT:9, CASE  WHEN Eq\n" +
 			"     │   ├─ noxn3.NUMK2:6!null\n" +
@@ -17577,7 +17490,7 @@ SELECT
 			"             └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [CASE  WHEN (noxn3.NUMK2 = 2) THEN noxn3.ECDKM ELSE 0 END as RGXLL]\n" +
+			" ├─ columns: [RGXLL]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [noxn3.id, noxn3.BRQP2, noxn3.FFTBJ, noxn3.A7XO2, noxn3.KBO7R, noxn3.ECDKM, noxn3.NUMK2, noxn3.LETOE, noxn3.YKSSU, noxn3.FHCYT, CASE  WHEN (noxn3.NUMK2 = 2) THEN noxn3.ECDKM ELSE 0 END as RGXLL]\n" +
 			"     └─ IndexedTableAccess(NOXN3)\n" +
@@ -17586,7 +17499,7 @@ SELECT
 			"         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [CASE  WHEN (noxn3.NUMK2 = 2) THEN noxn3.ECDKM ELSE 0 END as RGXLL]\n" +
+			" ├─ columns: [RGXLL]\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [noxn3.id, noxn3.BRQP2, noxn3.FFTBJ, noxn3.A7XO2, noxn3.KBO7R, noxn3.ECDKM, noxn3.NUMK2, noxn3.LETOE, noxn3.YKSSU, noxn3.FHCYT, CASE  WHEN (noxn3.NUMK2 = 2) THEN noxn3.ECDKM ELSE 0 END as RGXLL]\n" +
 			"     └─ IndexedTableAccess(NOXN3)\n" +
@@ -20127,16 +20040,7 @@ FROM
 			"         ├─ columns: [id:0!null, FV24E:1!null, UJ6XY:2!null, M22QN:3!null, NZ4MQ:4!null, ETPQV:5, PRUV2:6, YKSSU:7, FHCYT:8]\n" +
 			"         └─ Union distinct\n" +
 			"             ├─ Project\n" +
-			"             │   ├─ columns: [id:0!null, convert\n" +
-			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ FV24E:1!null\n" +
-			"             │   │   as FV24E, convert\n" +
-			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ UJ6XY:2!null\n" +
-			"             │   │   as UJ6XY, M22QN:3!null, NZ4MQ:4, ETPQV:5!null, convert\n" +
-			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ PRUV2:6\n" +
-			"             │   │   as PRUV2, YKSSU:7, FHCYT:8]\n" +
+			"             │   ├─ columns: [id:0!null, coerce(FV24E:1!null, longtext) as FV24E, coerce(UJ6XY:2!null, longtext) as UJ6XY, M22QN:3!null, NZ4MQ:4, ETPQV:5!null, coerce(PRUV2:6, varchar(24)) as PRUV2, YKSSU:7, FHCYT:8]\n" +
 			"             │   └─ Project\n" +
 			"             │       ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, bpnw2.FV24E:1!null as FV24E, bpnw2.UJ6XY:2!null as UJ6XY, bpnw2.M22QN:3!null as M22QN, bpnw2.NZ4MQ:4 as NZ4MQ, bpnw2.MU3KG:0!null as ETPQV, NULL (null) as PRUV2, bpnw2.YKSSU:6 as YKSSU, bpnw2.FHCYT:5 as FHCYT]\n" +
 			"             │       └─ SubqueryAlias\n" +
@@ -20267,19 +20171,34 @@ FROM
 			"             │                                           ├─ name: E2I7U\n" +
 			"             │                                           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [id:0!null, FV24E:1 as FV24E, UJ6XY:2 as UJ6XY, M22QN:3, NZ4MQ:4, ETPQV:5!null, convert\n" +
-			"                 │   ├─ type: char\n" +
-			"                 │   └─ PRUV2:6!null\n" +
-			"                 │   as PRUV2, YKSSU:7, FHCYT:8]\n" +
+			"                 ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, bpnw2.FV24E:1 as FV24E, bpnw2.UJ6XY:2 as UJ6XY, Subquery\n" +
+			"                 │   ├─ cacheable: false\n" +
+			"                 │   ├─ alias-string: select aac.id from TPXBU as aac where aac.BTXC5 = BPNW2.SYPKF\n" +
+			"                 │   └─ Project\n" +
+			"                 │       ├─ columns: [aac.id:17!null]\n" +
+			"                 │       └─ Filter\n" +
+			"                 │           ├─ Eq\n" +
+			"                 │           │   ├─ aac.BTXC5:18\n" +
+			"                 │           │   └─ bpnw2.SYPKF:3\n" +
+			"                 │           └─ TableAlias(aac)\n" +
+			"                 │               └─ IndexedTableAccess(TPXBU)\n" +
+			"                 │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"                 │                   ├─ keys: [bpnw2.SYPKF:3]\n" +
+			"                 │                   ├─ colSet: (230-232)\n" +
+			"                 │                   ├─ tableId: 18\n" +
+			"                 │                   └─ Table\n" +
+			"                 │                       ├─ name: TPXBU\n" +
+			"                 │                       └─ columns: [id btxc5]\n" +
+			"                 │   as M22QN, bpnw2.NZ4MQ:4 as NZ4MQ, bpnw2.MU3KG:0!null as ETPQV, bpnw2.I4NDZ:7!null as PRUV2, bpnw2.YKSSU:6 as YKSSU, bpnw2.FHCYT:5 as FHCYT]\n" +
 			"                 └─ Project\n" +
-			"                     ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, bpnw2.FV24E:1 as FV24E, bpnw2.UJ6XY:2 as UJ6XY, Subquery\n" +
+			"                     ├─ columns: [bpnw2.MU3KG:0!null, bpnw2.FV24E:1, bpnw2.UJ6XY:2, bpnw2.SYPKF:3, bpnw2.NZ4MQ:4, bpnw2.FHCYT:5, bpnw2.YKSSU:6, bpnw2.I4NDZ:7!null, lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, bpnw2.FV24E:1 as FV24E, bpnw2.UJ6XY:2 as UJ6XY, Subquery\n" +
 			"                     │   ├─ cacheable: false\n" +
 			"                     │   ├─ alias-string: select aac.id from TPXBU as aac where aac.BTXC5 = BPNW2.SYPKF\n" +
 			"                     │   └─ Project\n" +
-			"                     │       ├─ columns: [aac.id:17!null]\n" +
+			"                     │       ├─ columns: [aac.id:8!null]\n" +
 			"                     │       └─ Filter\n" +
 			"                     │           ├─ Eq\n" +
-			"                     │           │   ├─ aac.BTXC5:18\n" +
+			"                     │           │   ├─ aac.BTXC5:9\n" +
 			"                     │           │   └─ bpnw2.SYPKF:3\n" +
 			"                     │           └─ TableAlias(aac)\n" +
 			"                     │               └─ IndexedTableAccess(TPXBU)\n" +
@@ -20291,45 +20210,82 @@ FROM
 			"                     │                       ├─ name: TPXBU\n" +
 			"                     │                       └─ columns: [id btxc5]\n" +
 			"                     │   as M22QN, bpnw2.NZ4MQ:4 as NZ4MQ, bpnw2.MU3KG:0!null as ETPQV, bpnw2.I4NDZ:7!null as PRUV2, bpnw2.YKSSU:6 as YKSSU, bpnw2.FHCYT:5 as FHCYT]\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [bpnw2.MU3KG:0!null, bpnw2.FV24E:1, bpnw2.UJ6XY:2, bpnw2.SYPKF:3, bpnw2.NZ4MQ:4, bpnw2.FHCYT:5, bpnw2.YKSSU:6, bpnw2.I4NDZ:7!null, lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, bpnw2.FV24E:1 as FV24E, bpnw2.UJ6XY:2 as UJ6XY, Subquery\n" +
-			"                         │   ├─ cacheable: false\n" +
-			"                         │   ├─ alias-string: select aac.id from TPXBU as aac where aac.BTXC5 = BPNW2.SYPKF\n" +
-			"                         │   └─ Project\n" +
-			"                         │       ├─ columns: [aac.id:8!null]\n" +
-			"                         │       └─ Filter\n" +
-			"                         │           ├─ Eq\n" +
-			"                         │           │   ├─ aac.BTXC5:9\n" +
-			"                         │           │   └─ bpnw2.SYPKF:3\n" +
-			"                         │           └─ TableAlias(aac)\n" +
-			"                         │               └─ IndexedTableAccess(TPXBU)\n" +
-			"                         │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"                         │                   ├─ keys: [bpnw2.SYPKF:3]\n" +
-			"                         │                   ├─ colSet: (230-232)\n" +
-			"                         │                   ├─ tableId: 18\n" +
-			"                         │                   └─ Table\n" +
-			"                         │                       ├─ name: TPXBU\n" +
-			"                         │                       └─ columns: [id btxc5]\n" +
-			"                         │   as M22QN, bpnw2.NZ4MQ:4 as NZ4MQ, bpnw2.MU3KG:0!null as ETPQV, bpnw2.I4NDZ:7!null as PRUV2, bpnw2.YKSSU:6 as YKSSU, bpnw2.FHCYT:5 as FHCYT]\n" +
-			"                         └─ SubqueryAlias\n" +
-			"                             ├─ name: bpnw2\n" +
-			"                             ├─ outerVisibility: false\n" +
-			"                             ├─ isLateral: false\n" +
-			"                             ├─ cacheable: true\n" +
-			"                             ├─ colSet: (219-226)\n" +
-			"                             ├─ tableId: 17\n" +
-			"                             └─ Distinct\n" +
+			"                     └─ SubqueryAlias\n" +
+			"                         ├─ name: bpnw2\n" +
+			"                         ├─ outerVisibility: false\n" +
+			"                         ├─ isLateral: false\n" +
+			"                         ├─ cacheable: true\n" +
+			"                         ├─ colSet: (219-226)\n" +
+			"                         ├─ tableId: 17\n" +
+			"                         └─ Distinct\n" +
+			"                             └─ Project\n" +
+			"                                 ├─ columns: [tizhk.id:0!null as MU3KG, CASE  WHEN NOT\n" +
+			"                                 │   └─ nhmxw.FZXV5:15 IS NULL\n" +
+			"                                 │   THEN Subquery\n" +
+			"                                 │   ├─ cacheable: false\n" +
+			"                                 │   ├─ alias-string: select overridden_nd_mutant.id from E2I7U as overridden_nd_mutant where overridden_nd_mutant.TW55N = NHMXW.FZXV5\n" +
+			"                                 │   └─ Project\n" +
+			"                                 │       ├─ columns: [overridden_nd_mutant.id:62!null]\n" +
+			"                                 │       └─ Filter\n" +
+			"                                 │           ├─ Eq\n" +
+			"                                 │           │   ├─ overridden_nd_mutant.TW55N:63!null\n" +
+			"                                 │           │   └─ nhmxw.FZXV5:15\n" +
+			"                                 │           └─ TableAlias(overridden_nd_mutant)\n" +
+			"                                 │               └─ IndexedTableAccess(E2I7U)\n" +
+			"                                 │                   ├─ index: [E2I7U.TW55N]\n" +
+			"                                 │                   ├─ keys: [nhmxw.FZXV5:15]\n" +
+			"                                 │                   ├─ colSet: (170-186)\n" +
+			"                                 │                   ├─ tableId: 14\n" +
+			"                                 │                   └─ Table\n" +
+			"                                 │                       ├─ name: E2I7U\n" +
+			"                                 │                       └─ columns: [id tw55n]\n" +
+			"                                 │   ELSE j4jyp.id:20!null END as FV24E, CASE  WHEN NOT\n" +
+			"                                 │   └─ nhmxw.DQYGV:16 IS NULL\n" +
+			"                                 │   THEN Subquery\n" +
+			"                                 │   ├─ cacheable: false\n" +
+			"                                 │   ├─ alias-string: select overridden_QI2IEner.id from E2I7U as overridden_QI2IEner where overridden_QI2IEner.TW55N = NHMXW.DQYGV\n" +
+			"                                 │   └─ Project\n" +
+			"                                 │       ├─ columns: [overridden_qi2iener.id:62!null]\n" +
+			"                                 │       └─ Filter\n" +
+			"                                 │           ├─ Eq\n" +
+			"                                 │           │   ├─ overridden_qi2iener.TW55N:63!null\n" +
+			"                                 │           │   └─ nhmxw.DQYGV:16\n" +
+			"                                 │           └─ TableAlias(overridden_qi2iener)\n" +
+			"                                 │               └─ IndexedTableAccess(E2I7U)\n" +
+			"                                 │                   ├─ index: [E2I7U.TW55N]\n" +
+			"                                 │                   ├─ keys: [nhmxw.DQYGV:16]\n" +
+			"                                 │                   ├─ colSet: (188-204)\n" +
+			"                                 │                   ├─ tableId: 15\n" +
+			"                                 │                   └─ Table\n" +
+			"                                 │                       ├─ name: E2I7U\n" +
+			"                                 │                       └─ columns: [id tw55n]\n" +
+			"                                 │   ELSE rhuzn.id:37!null END as UJ6XY, tizhk.SYPKF:3 as SYPKF, Subquery\n" +
+			"                                 │   ├─ cacheable: false\n" +
+			"                                 │   ├─ alias-string: select G3YXS.id from YYBCX as G3YXS where CONCAT(G3YXS.ESFVY, '(MI:', G3YXS.SL76B, ')') = TIZHK.IDUT2\n" +
+			"                                 │   └─ Project\n" +
+			"                                 │       ├─ columns: [g3yxs.id:62!null]\n" +
+			"                                 │       └─ Filter\n" +
+			"                                 │           ├─ Eq\n" +
+			"                                 │           │   ├─ concat(g3yxs.ESFVY:63!null,(MI: (longtext),g3yxs.SL76B:64!null,) (longtext))\n" +
+			"                                 │           │   └─ tizhk.IDUT2:4\n" +
+			"                                 │           └─ TableAlias(g3yxs)\n" +
+			"                                 │               └─ Table\n" +
+			"                                 │                   ├─ name: YYBCX\n" +
+			"                                 │                   ├─ columns: [id esfvy sl76b]\n" +
+			"                                 │                   ├─ colSet: (207-214)\n" +
+			"                                 │                   └─ tableId: 16\n" +
+			"                                 │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU, nhmxw.id:10!null as I4NDZ]\n" +
 			"                                 └─ Project\n" +
-			"                                     ├─ columns: [tizhk.id:0!null as MU3KG, CASE  WHEN NOT\n" +
+			"                                     ├─ columns: [tizhk.id:0!null, tizhk.TVNW2:1, tizhk.ZHITY:2, tizhk.SYPKF:3, tizhk.IDUT2:4, tizhk.O6QJ3:5, tizhk.NO2JA:6, tizhk.YKSSU:7, tizhk.FHCYT:8, tizhk.QZ6VT:9, nhmxw.id:10!null, nhmxw.NOHHR:11!null, nhmxw.AVPYF:12!null, nhmxw.SYPKF:13!null, nhmxw.IDUT2:14!null, nhmxw.FZXV5:15, nhmxw.DQYGV:16, nhmxw.SWCQV:17!null, nhmxw.YKSSU:18, nhmxw.FHCYT:19, j4jyp.id:20!null, j4jyp.DKCAJ:21!null, j4jyp.KNG7T:22, j4jyp.TW55N:23!null, j4jyp.QRQXW:24!null, j4jyp.ECXAJ:25!null, j4jyp.FGG57:26, j4jyp.ZH72S:27, j4jyp.FSK67:28!null, j4jyp.XQDYT:29!null, j4jyp.TCE7A:30, j4jyp.IWV2H:31, j4jyp.HPCMS:32!null, j4jyp.N5CC2:33, j4jyp.FHCYT:34, j4jyp.ETAQ7:35, j4jyp.A75X7:36, rhuzn.id:37!null, rhuzn.DKCAJ:38!null, rhuzn.KNG7T:39, rhuzn.TW55N:40!null, rhuzn.QRQXW:41!null, rhuzn.ECXAJ:42!null, rhuzn.FGG57:43, rhuzn.ZH72S:44, rhuzn.FSK67:45!null, rhuzn.XQDYT:46!null, rhuzn.TCE7A:47, rhuzn.IWV2H:48, rhuzn.HPCMS:49!null, rhuzn.N5CC2:50, rhuzn.FHCYT:51, rhuzn.ETAQ7:52, rhuzn.A75X7:53, tizhk.id:0!null as MU3KG, CASE  WHEN NOT\n" +
 			"                                     │   └─ nhmxw.FZXV5:15 IS NULL\n" +
 			"                                     │   THEN Subquery\n" +
 			"                                     │   ├─ cacheable: false\n" +
 			"                                     │   ├─ alias-string: select overridden_nd_mutant.id from E2I7U as overridden_nd_mutant where overridden_nd_mutant.TW55N = NHMXW.FZXV5\n" +
 			"                                     │   └─ Project\n" +
-			"                                     │       ├─ columns: [overridden_nd_mutant.id:62!null]\n" +
+			"                                     │       ├─ columns: [overridden_nd_mutant.id:54!null]\n" +
 			"                                     │       └─ Filter\n" +
 			"                                     │           ├─ Eq\n" +
-			"                                     │           │   ├─ overridden_nd_mutant.TW55N:63!null\n" +
+			"                                     │           │   ├─ overridden_nd_mutant.TW55N:55!null\n" +
 			"                                     │           │   └─ nhmxw.FZXV5:15\n" +
 			"                                     │           └─ TableAlias(overridden_nd_mutant)\n" +
 			"                                     │               └─ IndexedTableAccess(E2I7U)\n" +
@@ -20346,10 +20302,10 @@ FROM
 			"                                     │   ├─ cacheable: false\n" +
 			"                                     │   ├─ alias-string: select overridden_QI2IEner.id from E2I7U as overridden_QI2IEner where overridden_QI2IEner.TW55N = NHMXW.DQYGV\n" +
 			"                                     │   └─ Project\n" +
-			"                                     │       ├─ columns: [overridden_qi2iener.id:62!null]\n" +
+			"                                     │       ├─ columns: [overridden_qi2iener.id:54!null]\n" +
 			"                                     │       └─ Filter\n" +
 			"                                     │           ├─ Eq\n" +
-			"                                     │           │   ├─ overridden_qi2iener.TW55N:63!null\n" +
+			"                                     │           │   ├─ overridden_qi2iener.TW55N:55!null\n" +
 			"                                     │           │   └─ nhmxw.DQYGV:16\n" +
 			"                                     │           └─ TableAlias(overridden_qi2iener)\n" +
 			"                                     │               └─ IndexedTableAccess(E2I7U)\n" +
@@ -20364,10 +20320,10 @@ FROM
 			"                                     │   ├─ cacheable: false\n" +
 			"                                     │   ├─ alias-string: select G3YXS.id from YYBCX as G3YXS where CONCAT(G3YXS.ESFVY, '(MI:', G3YXS.SL76B, ')') = TIZHK.IDUT2\n" +
 			"                                     │   └─ Project\n" +
-			"                                     │       ├─ columns: [g3yxs.id:62!null]\n" +
+			"                                     │       ├─ columns: [g3yxs.id:54!null]\n" +
 			"                                     │       └─ Filter\n" +
 			"                                     │           ├─ Eq\n" +
-			"                                     │           │   ├─ concat(g3yxs.ESFVY:63!null,(MI: (longtext),g3yxs.SL76B:64!null,) (longtext))\n" +
+			"                                     │           │   ├─ concat(g3yxs.ESFVY:55!null,(MI: (longtext),g3yxs.SL76B:56!null,) (longtext))\n" +
 			"                                     │           │   └─ tizhk.IDUT2:4\n" +
 			"                                     │           └─ TableAlias(g3yxs)\n" +
 			"                                     │               └─ Table\n" +
@@ -20376,124 +20332,67 @@ FROM
 			"                                     │                   ├─ colSet: (207-214)\n" +
 			"                                     │                   └─ tableId: 16\n" +
 			"                                     │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU, nhmxw.id:10!null as I4NDZ]\n" +
-			"                                     └─ Project\n" +
-			"                                         ├─ columns: [tizhk.id:0!null, tizhk.TVNW2:1, tizhk.ZHITY:2, tizhk.SYPKF:3, tizhk.IDUT2:4, tizhk.O6QJ3:5, tizhk.NO2JA:6, tizhk.YKSSU:7, tizhk.FHCYT:8, tizhk.QZ6VT:9, nhmxw.id:10!null, nhmxw.NOHHR:11!null, nhmxw.AVPYF:12!null, nhmxw.SYPKF:13!null, nhmxw.IDUT2:14!null, nhmxw.FZXV5:15, nhmxw.DQYGV:16, nhmxw.SWCQV:17!null, nhmxw.YKSSU:18, nhmxw.FHCYT:19, j4jyp.id:20!null, j4jyp.DKCAJ:21!null, j4jyp.KNG7T:22, j4jyp.TW55N:23!null, j4jyp.QRQXW:24!null, j4jyp.ECXAJ:25!null, j4jyp.FGG57:26, j4jyp.ZH72S:27, j4jyp.FSK67:28!null, j4jyp.XQDYT:29!null, j4jyp.TCE7A:30, j4jyp.IWV2H:31, j4jyp.HPCMS:32!null, j4jyp.N5CC2:33, j4jyp.FHCYT:34, j4jyp.ETAQ7:35, j4jyp.A75X7:36, rhuzn.id:37!null, rhuzn.DKCAJ:38!null, rhuzn.KNG7T:39, rhuzn.TW55N:40!null, rhuzn.QRQXW:41!null, rhuzn.ECXAJ:42!null, rhuzn.FGG57:43, rhuzn.ZH72S:44, rhuzn.FSK67:45!null, rhuzn.XQDYT:46!null, rhuzn.TCE7A:47, rhuzn.IWV2H:48, rhuzn.HPCMS:49!null, rhuzn.N5CC2:50, rhuzn.FHCYT:51, rhuzn.ETAQ7:52, rhuzn.A75X7:53, tizhk.id:0!null as MU3KG, CASE  WHEN NOT\n" +
-			"                                         │   └─ nhmxw.FZXV5:15 IS NULL\n" +
-			"                                         │   THEN Subquery\n" +
-			"                                         │   ├─ cacheable: false\n" +
-			"                                         │   ├─ alias-string: select overridden_nd_mutant.id from E2I7U as overridden_nd_mutant where overridden_nd_mutant.TW55N = NHMXW.FZXV5\n" +
-			"                                         │   └─ Project\n" +
-			"                                         │       ├─ columns: [overridden_nd_mutant.id:54!null]\n" +
-			"                                         │       └─ Filter\n" +
-			"                                         │           ├─ Eq\n" +
-			"                                         │           │   ├─ overridden_nd_mutant.TW55N:55!null\n" +
-			"                                         │           │   └─ nhmxw.FZXV5:15\n" +
-			"                                         │           └─ TableAlias(overridden_nd_mutant)\n" +
-			"                                         │               └─ IndexedTableAccess(E2I7U)\n" +
-			"                                         │                   ├─ index: [E2I7U.TW55N]\n" +
-			"                                         │                   ├─ keys: [nhmxw.FZXV5:15]\n" +
-			"                                         │                   ├─ colSet: (170-186)\n" +
-			"                                         │                   ├─ tableId: 14\n" +
-			"                                         │                   └─ Table\n" +
-			"                                         │                       ├─ name: E2I7U\n" +
-			"                                         │                       └─ columns: [id tw55n]\n" +
-			"                                         │   ELSE j4jyp.id:20!null END as FV24E, CASE  WHEN NOT\n" +
-			"                                         │   └─ nhmxw.DQYGV:16 IS NULL\n" +
-			"                                         │   THEN Subquery\n" +
-			"                                         │   ├─ cacheable: false\n" +
-			"                                         │   ├─ alias-string: select overridden_QI2IEner.id from E2I7U as overridden_QI2IEner where overridden_QI2IEner.TW55N = NHMXW.DQYGV\n" +
-			"                                         │   └─ Project\n" +
-			"                                         │       ├─ columns: [overridden_qi2iener.id:54!null]\n" +
-			"                                         │       └─ Filter\n" +
-			"                                         │           ├─ Eq\n" +
-			"                                         │           │   ├─ overridden_qi2iener.TW55N:55!null\n" +
-			"                                         │           │   └─ nhmxw.DQYGV:16\n" +
-			"                                         │           └─ TableAlias(overridden_qi2iener)\n" +
-			"                                         │               └─ IndexedTableAccess(E2I7U)\n" +
-			"                                         │                   ├─ index: [E2I7U.TW55N]\n" +
-			"                                         │                   ├─ keys: [nhmxw.DQYGV:16]\n" +
-			"                                         │                   ├─ colSet: (188-204)\n" +
-			"                                         │                   ├─ tableId: 15\n" +
-			"                                         │                   └─ Table\n" +
-			"                                         │                       ├─ name: E2I7U\n" +
-			"                                         │                       └─ columns: [id tw55n]\n" +
-			"                                         │   ELSE rhuzn.id:37!null END as UJ6XY, tizhk.SYPKF:3 as SYPKF, Subquery\n" +
-			"                                         │   ├─ cacheable: false\n" +
-			"                                         │   ├─ alias-string: select G3YXS.id from YYBCX as G3YXS where CONCAT(G3YXS.ESFVY, '(MI:', G3YXS.SL76B, ')') = TIZHK.IDUT2\n" +
-			"                                         │   └─ Project\n" +
-			"                                         │       ├─ columns: [g3yxs.id:54!null]\n" +
-			"                                         │       └─ Filter\n" +
-			"                                         │           ├─ Eq\n" +
-			"                                         │           │   ├─ concat(g3yxs.ESFVY:55!null,(MI: (longtext),g3yxs.SL76B:56!null,) (longtext))\n" +
-			"                                         │           │   └─ tizhk.IDUT2:4\n" +
-			"                                         │           └─ TableAlias(g3yxs)\n" +
-			"                                         │               └─ Table\n" +
-			"                                         │                   ├─ name: YYBCX\n" +
-			"                                         │                   ├─ columns: [id esfvy sl76b]\n" +
-			"                                         │                   ├─ colSet: (207-214)\n" +
-			"                                         │                   └─ tableId: 16\n" +
-			"                                         │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU, nhmxw.id:10!null as I4NDZ]\n" +
-			"                                         └─ Filter\n" +
-			"                                             ├─ NOT\n" +
-			"                                             │   └─ nhmxw.id:10!null IS NULL\n" +
-			"                                             └─ LeftOuterLookupJoin\n" +
-			"                                                 ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │   ├─ AND\n" +
-			"                                                 │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   │   ├─ Eq\n" +
-			"                                                 │   │   │   │   │   │   ├─ nhmxw.SWCQV:17!null\n" +
-			"                                                 │   │   │   │   │   │   └─ 0 (tinyint)\n" +
-			"                                                 │   │   │   │   │   └─ Eq\n" +
-			"                                                 │   │   │   │   │       ├─ nhmxw.AVPYF:12!null\n" +
-			"                                                 │   │   │   │   │       └─ tizhk.ZHITY:2\n" +
-			"                                                 │   │   │   │   └─ Eq\n" +
-			"                                                 │   │   │   │       ├─ nhmxw.SYPKF:13!null\n" +
-			"                                                 │   │   │   │       └─ tizhk.SYPKF:3\n" +
-			"                                                 │   │   │   └─ Eq\n" +
-			"                                                 │   │   │       ├─ nhmxw.IDUT2:14!null\n" +
-			"                                                 │   │   │       └─ tizhk.IDUT2:4\n" +
-			"                                                 │   │   ├─ Filter\n" +
-			"                                                 │   │   │   ├─ HashIn\n" +
-			"                                                 │   │   │   │   ├─ tizhk.id:0!null\n" +
-			"                                                 │   │   │   │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"                                                 │   │   │   └─ TableAlias(tizhk)\n" +
-			"                                                 │   │   │       └─ IndexedTableAccess(WRZVO)\n" +
-			"                                                 │   │   │           ├─ index: [WRZVO.id]\n" +
-			"                                                 │   │   │           ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
-			"                                                 │   │   │           ├─ colSet: (115-124)\n" +
-			"                                                 │   │   │           ├─ tableId: 10\n" +
-			"                                                 │   │   │           └─ Table\n" +
-			"                                                 │   │   │               ├─ name: WRZVO\n" +
-			"                                                 │   │   │               └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			"                                                 │   │   └─ TableAlias(nhmxw)\n" +
-			"                                                 │   │       └─ IndexedTableAccess(WGSDC)\n" +
-			"                                                 │   │           ├─ index: [WGSDC.NOHHR]\n" +
-			"                                                 │   │           ├─ keys: [tizhk.TVNW2:1]\n" +
-			"                                                 │   │           ├─ colSet: (125-134)\n" +
-			"                                                 │   │           ├─ tableId: 11\n" +
-			"                                                 │   │           └─ Table\n" +
-			"                                                 │   │               ├─ name: WGSDC\n" +
-			"                                                 │   │               └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"                                                 │   └─ TableAlias(j4jyp)\n" +
-			"                                                 │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                 │           ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                 │           ├─ keys: [tizhk.TVNW2:1]\n" +
-			"                                                 │           ├─ colSet: (135-151)\n" +
-			"                                                 │           ├─ tableId: 12\n" +
-			"                                                 │           └─ Table\n" +
-			"                                                 │               ├─ name: E2I7U\n" +
-			"                                                 │               └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"                                                 └─ TableAlias(rhuzn)\n" +
-			"                                                     └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                         ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                         ├─ keys: [tizhk.ZHITY:2]\n" +
-			"                                                         ├─ colSet: (152-168)\n" +
-			"                                                         ├─ tableId: 13\n" +
-			"                                                         └─ Table\n" +
-			"                                                             ├─ name: E2I7U\n" +
-			"                                                             └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                                     └─ Filter\n" +
+			"                                         ├─ NOT\n" +
+			"                                         │   └─ nhmxw.id:10!null IS NULL\n" +
+			"                                         └─ LeftOuterLookupJoin\n" +
+			"                                             ├─ LeftOuterLookupJoin\n" +
+			"                                             │   ├─ LeftOuterLookupJoin\n" +
+			"                                             │   │   ├─ AND\n" +
+			"                                             │   │   │   ├─ AND\n" +
+			"                                             │   │   │   │   ├─ AND\n" +
+			"                                             │   │   │   │   │   ├─ Eq\n" +
+			"                                             │   │   │   │   │   │   ├─ nhmxw.SWCQV:17!null\n" +
+			"                                             │   │   │   │   │   │   └─ 0 (tinyint)\n" +
+			"                                             │   │   │   │   │   └─ Eq\n" +
+			"                                             │   │   │   │   │       ├─ nhmxw.AVPYF:12!null\n" +
+			"                                             │   │   │   │   │       └─ tizhk.ZHITY:2\n" +
+			"                                             │   │   │   │   └─ Eq\n" +
+			"                                             │   │   │   │       ├─ nhmxw.SYPKF:13!null\n" +
+			"                                             │   │   │   │       └─ tizhk.SYPKF:3\n" +
+			"                                             │   │   │   └─ Eq\n" +
+			"                                             │   │   │       ├─ nhmxw.IDUT2:14!null\n" +
+			"                                             │   │   │       └─ tizhk.IDUT2:4\n" +
+			"                                             │   │   ├─ Filter\n" +
+			"                                             │   │   │   ├─ HashIn\n" +
+			"                                             │   │   │   │   ├─ tizhk.id:0!null\n" +
+			"                                             │   │   │   │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
+			"                                             │   │   │   └─ TableAlias(tizhk)\n" +
+			"                                             │   │   │       └─ IndexedTableAccess(WRZVO)\n" +
+			"                                             │   │   │           ├─ index: [WRZVO.id]\n" +
+			"                                             │   │   │           ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
+			"                                             │   │   │           ├─ colSet: (115-124)\n" +
+			"                                             │   │   │           ├─ tableId: 10\n" +
+			"                                             │   │   │           └─ Table\n" +
+			"                                             │   │   │               ├─ name: WRZVO\n" +
+			"                                             │   │   │               └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
+			"                                             │   │   └─ TableAlias(nhmxw)\n" +
+			"                                             │   │       └─ IndexedTableAccess(WGSDC)\n" +
+			"                                             │   │           ├─ index: [WGSDC.NOHHR]\n" +
+			"                                             │   │           ├─ keys: [tizhk.TVNW2:1]\n" +
+			"                                             │   │           ├─ colSet: (125-134)\n" +
+			"                                             │   │           ├─ tableId: 11\n" +
+			"                                             │   │           └─ Table\n" +
+			"                                             │   │               ├─ name: WGSDC\n" +
+			"                                             │   │               └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"                                             │   └─ TableAlias(j4jyp)\n" +
+			"                                             │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                                             │           ├─ index: [E2I7U.ZH72S]\n" +
+			"                                             │           ├─ keys: [tizhk.TVNW2:1]\n" +
+			"                                             │           ├─ colSet: (135-151)\n" +
+			"                                             │           ├─ tableId: 12\n" +
+			"                                             │           └─ Table\n" +
+			"                                             │               ├─ name: E2I7U\n" +
+			"                                             │               └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                                             └─ TableAlias(rhuzn)\n" +
+			"                                                 └─ IndexedTableAccess(E2I7U)\n" +
+			"                                                     ├─ index: [E2I7U.ZH72S]\n" +
+			"                                                     ├─ keys: [tizhk.ZHITY:2]\n" +
+			"                                                     ├─ colSet: (152-168)\n" +
+			"                                                     ├─ tableId: 13\n" +
+			"                                                     └─ Table\n" +
+			"                                                         ├─ name: E2I7U\n" +
+			"                                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
 	{
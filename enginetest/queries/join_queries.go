@@ -1161,6 +1161,94 @@ var JoinScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Outer join on true keeps unmatched rows",
+		SetUpScript: []string{
+			"CREATE TABLE `a` (aa int);",
+			"INSERT INTO `a` VALUES (1), (2);",
+			"CREATE TABLE `b` (bb int);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM a LEFT JOIN b ON true ORDER BY aa;",
+				Expected: []sql.Row{{1, nil}, {2, nil}},
+			},
+			{
+				Query:    "SELECT * FROM b RIGHT JOIN a ON true ORDER BY aa;",
+				Expected: []sql.Row{{nil, 1}, {nil, 2}},
+			},
+			{
+				Query:    "SELECT * FROM a JOIN b ON true;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "star expansion coalesces USING and NATURAL join columns",
+		SetUpScript: []string{
+			"create table a (id int primary key, k int, av int);",
+			"create table b (id int primary key, k int, bv int);",
+			"create table c (id int primary key, k int, cv int);",
+			"insert into a values (1, 1, 11), (2, 2, 12);",
+			"insert into b values (2, 2, 22), (3, 3, 23);",
+			"insert into c values (2, 2, 32), (3, 3, 33), (4, 4, 44);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from a join b using (id) join c using (id);",
+				Expected: []sql.Row{{2, 2, 12, 2, 22, 2, 32}},
+			},
+			{
+				Query:    "select a.*, b.*, c.* from a join b using (id) join c using (id);",
+				Expected: []sql.Row{{2, 2, 12, 2, 2, 22, 2, 2, 32}},
+			},
+			{
+				// the coalesced id comes from b when there is no matching row in a
+				Query: "select * from a right join b using (id) left join c using (id) order by id;",
+				Expected: []sql.Row{
+					{2, 2, 22, 2, 12, 2, 32},
+					{3, 3, 23, nil, nil, 3, 33},
+				},
+			},
+			{
+				Query: "select * from b left join a using (id) right join c using (id) order by id;",
+				Expected: []sql.Row{
+					{2, 2, 32, 2, 22, 2, 12},
+					{3, 3, 33, 3, 23, nil, nil},
+					{4, 4, 44, nil, nil, nil, nil},
+				},
+			},
+			{
+				Query: "select * from a natural left join b order by id;",
+				Expected: []sql.Row{
+					{1, 1, 11, nil},
+					{2, 2, 12, 22},
+				},
+			},
+			{
+				Query: "select * from a natural left join b natural left join c order by id;",
+				Expected: []sql.Row{
+					{1, 1, 11, nil, nil},
+					{2, 2, 12, 22, 32},
+				},
+			},
+			{
+				Query: "select * from c natural left join b natural left join a order by id;",
+				Expected: []sql.Row{
+					{2, 2, 32, 22, 12},
+					{3, 3, 33, 23, nil},
+					{4, 4, 44, nil, nil},
+				},
+			},
+			{
+				Query: "select a.*, b.* from a natural left join b order by a.id;",
+				Expected: []sql.Row{
+					{1, 1, 11, nil, nil, nil},
+					{2, 2, 12, 2, 2, 22},
+				},
+			},
+		},
+	},
 }
 
 var LateralJoinScriptTests = []ScriptTest{
@@ -1339,6 +1427,67 @@ LATERAL (
 			},
 		},
 	},
+	{
+		Name: "lateral join top-n per group and aggregates over the outer row",
+		SetUpScript: []string{
+			"create table grp (id int primary key, name varchar(10));",
+			"create table score (id int primary key, gid int, pts int);",
+			"insert into grp values (1, 'a'), (2, 'b'), (3, 'c');",
+			"insert into score values (1, 1, 10), (2, 1, 30), (3, 1, 20), (4, 2, 5), (5, 2, 50), (6, 2, 40), (7, 2, 45);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select g.name, s.pts from grp g, lateral (select pts from score where score.gid = g.id order by pts desc limit 2) s order by g.name, s.pts desc;",
+				Expected: []sql.Row{
+					{"a", 30},
+					{"a", 20},
+					{"b", 50},
+					{"b", 45},
+				},
+			},
+			{
+				Query: "select g.name, s.pts from grp g, lateral (select pts from score where score.gid = g.id order by pts limit 1 offset 1) s order by g.name;",
+				Expected: []sql.Row{
+					{"a", 20},
+					{"b", 40},
+				},
+			},
+			{
+				Query: "select g.name, s.pts from grp g left join lateral (select pts from score where score.gid = g.id order by pts desc limit 1) s on true order by g.name;",
+				Expected: []sql.Row{
+					{"a", 30},
+					{"b", 50},
+					{"c", nil},
+				},
+			},
+			{
+				Query: "select g.name, s.cnt, s.total, s.mx from grp g, lateral (select count(*) cnt, sum(pts) total, max(pts) mx from score where score.gid = g.id) s order by g.name;",
+				Expected: []sql.Row{
+					{"a", 3, float64(60), 30},
+					{"b", 4, float64(140), 50},
+					{"c", 0, nil, nil},
+				},
+			},
+			{
+				Query: "select g.name, s.above from grp g, lateral (select count(*) above from score where score.gid = g.id and score.pts > g.id * 15) s order by g.name;",
+				Expected: []sql.Row{
+					{"a", 2},
+					{"b", 3},
+					{"c", 0},
+				},
+			},
+			{
+				Query: "select g.name, s.gid, s.total from grp g, lateral (select gid, sum(pts) total from score where gid <= g.id group by gid having sum(pts) > 50) s order by g.name, s.gid;",
+				Expected: []sql.Row{
+					{"a", 1, float64(60)},
+					{"b", 1, float64(60)},
+					{"b", 2, float64(140)},
+					{"c", 1, float64(60)},
+					{"c", 2, float64(140)},
+				},
+			},
+		},
+	},
 	{
 		Name: "lateral join with subquery",
 		SetUpScript: []string{
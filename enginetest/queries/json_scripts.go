@@ -58,6 +58,45 @@ var JsonScripts = []ScriptTest{
 			//		{nil},
 			//	},
 			//},
+			{
+				Query: `select json_value('{"price": 12.345}', '$.price' returning decimal(4,2))`,
+				Expected: []sql.Row{
+					{"12.35"},
+				},
+			},
+			{
+				Query: `select json_value('{"a": 12.5}', '$.a' returning decimal(4,2))`,
+				Expected: []sql.Row{
+					{"12.50"},
+				},
+			},
+			{
+				Query: `select json_value(y, '$[2]' returning char(5) default 'none' on empty) from xy where x < 2`,
+				Expected: []sql.Row{
+					{"none"},
+					{"c"},
+				},
+			},
+			{
+				Query:       `select json_value(y, '$[2]' error on empty) from xy where x = 0`,
+				ExpectedErr: sql.ErrJSONValueNotFound,
+			},
+			{
+				Query: `select json_value(y, '$[0]' returning signed default -1 on error) from xy where x = 0`,
+				Expected: []sql.Row{
+					{int64(-1)},
+				},
+			},
+			{
+				Query:       `select json_value(y, '$[0]' returning signed error on error) from xy where x = 0`,
+				ExpectedErr: sql.ErrInvalidJSONValueForCast,
+			},
+			{
+				Query: `select json_value(y, '$.a' default 'object' on error) from xy where x = 3`,
+				Expected: []sql.Row{
+					{"object"},
+				},
+			},
 		},
 	},
 	{
@@ -756,7 +756,7 @@ JSON_TABLE(
 				// From MySQL docs
 				Query: "SELECT * FROM JSON_TABLE('[{\"a\":\"3\"},{\"a\":2},{\"b\":1},{\"a\":0},{\"a\":[1,2]}]', \"$[*]\" COLUMNS (rowid FOR ORDINALITY, ac VARCHAR(100) PATH \"$.a\" DEFAULT '111' ON EMPTY DEFAULT '999' ON ERROR, aj JSON PATH \"$.a\" DEFAULT '{\"x\": 333}' ON EMPTY, bx INT EXISTS PATH \"$.b\")) AS tt;",
 				Expected: []sql.Row{
-					{1, "3", types.MustJSON("3"), 0},
+					{1, "3", types.MustJSON(`"3"`), 0},
 					{2, "2", types.MustJSON("2"), 0},
 					{3, "111", types.MustJSON("{\"x\": 333}"), 1},
 					{4, "0", types.MustJSON("0"), 0},
@@ -779,46 +779,55 @@ JSON_TABLE(
 			},
 		},
 	},
-}
-
-var BrokenJSONTableScriptTests = []ScriptTest{
 	{
-		// wrong error
-		Name: "json_table out of cte",
-		SetUpScript: []string{
-			"create table t (i int, j json)",
-			`insert into t values (1, '["test"]')`,
-		},
+		Name:        "test NULL values and coercion errors",
+		SetUpScript: []string{},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:       "with tt as (select * from t) select * from json_table(tt.j, '$[*]' columns (a varchar(10) path '$')) as jt;",
-				ExpectedErr: sql.ErrUnknownTable,
+				// a JSON null is not an error
+				Query: "SELECT * FROM JSON_TABLE('[ {\"c1\": null} ]', '$[*]' COLUMNS( c1 INT PATH '$.c1' ERROR ON ERROR )) as jt;",
+				Expected: []sql.Row{
+					{nil},
+				},
 			},
 			{
-				Query:       "with tt as (select * from t) select * from tt, json_table(tt.j, '$[*]' columns (a varchar(10) path '$')) as jt;",
-				ExpectedErr: sql.ErrInvalidArgument,
+				Query: "SELECT * FROM JSON_TABLE('[{\"a\":\"x\"},{\"a\":\"5\"}]', '$[*]' COLUMNS(a INT PATH '$.a' NULL ON ERROR)) AS jt;",
+				Expected: []sql.Row{
+					{nil},
+					{5},
+				},
 			},
-		},
-	},
-	{
-		// Unsupported functionality
-		Name:        "json_table out of cte",
-		SetUpScript: []string{},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query: "SELECT * FROM JSON_TABLE('[ {\"c1\": null} ]', '$[*]' COLUMNS( c1 INT PATH '$.c1' ERROR ON ERROR )) as jt;",
+				Query: "SELECT * FROM JSON_TABLE('[{\"a\":\"x\"},{\"a\":\"5\"}]', '$[*]' COLUMNS(a INT PATH '$.a' DEFAULT '-1' ON ERROR)) AS jt;",
 				Expected: []sql.Row{
-					{nil},
+					{-1},
+					{5},
 				},
 			},
 			{
 				Query: "SELECT * FROM JSON_TABLE('[{\"a\":\"3\"},{\"a\":2},{\"b\":1},{\"a\":0},{\"a\":[1,2]}]', \"$[*]\" COLUMNS(rowid FOR ORDINALITY, ac VARCHAR(100) PATH \"$.a\" DEFAULT '111' ON EMPTY DEFAULT '999' ON ERROR, aj JSON PATH \"$.a\" DEFAULT '{\"x\": 333}' ON EMPTY, bx INT EXISTS PATH \"$.b\")) AS tt;",
 				Expected: []sql.Row{
-					{1, 3, "3", 0},
-					{2, 2, 2, 0},
-					{3, 111, types.MustJSON("{\"x\": 333}"), 1},
-					{4, 0, 0, 0},
-					{5, 999, types.MustJSON("[1, 2]"), 0},
+					{1, "3", types.MustJSON(`"3"`), 0},
+					{2, "2", types.MustJSON("2"), 0},
+					{3, "111", types.MustJSON("{\"x\": 333}"), 1},
+					{4, "0", types.MustJSON("0"), 0},
+					{5, "999", types.MustJSON("[1, 2]"), 0},
+				},
+			},
+		},
+	},
+	{
+		Name:        "test NESTED with filters and sibling ordinality",
+		SetUpScript: []string{},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM JSON_TABLE('[ {\"a\": 1, \"b\": [11,111]}, {\"a\": 2, \"b\": [22,222]}, {\"a\":3}]', '$[*]' COLUMNS(a INT PATH '$.a', NESTED PATH '$.b[*]' COLUMNS (b INT PATH '$'))) AS jt;",
+				Expected: []sql.Row{
+					{1, 11},
+					{1, 111},
+					{2, 22},
+					{2, 222},
+					{3, nil},
 				},
 			},
 			{
@@ -830,6 +839,36 @@ var BrokenJSONTableScriptTests = []ScriptTest{
 					{2, 222},
 				},
 			},
+			{
+				Query: "SELECT * FROM JSON_TABLE('[{\"a\":1,\"b\":[1,2],\"c\":[3,4]}]', '$[*]' COLUMNS(id FOR ORDINALITY, a INT PATH '$.a', NESTED PATH '$.b[*]' COLUMNS (bid FOR ORDINALITY, b INT PATH '$'), NESTED PATH '$.c[*]' COLUMNS (cid FOR ORDINALITY, c INT PATH '$'))) AS jt;",
+				Expected: []sql.Row{
+					{1, 1, 1, 1, nil, nil},
+					{1, 1, 2, 2, nil, nil},
+					{1, 1, nil, nil, 1, 3},
+					{1, 1, nil, nil, 2, 4},
+				},
+			},
+		},
+	},
+}
+
+var BrokenJSONTableScriptTests = []ScriptTest{
+	{
+		// wrong error
+		Name: "json_table out of cte",
+		SetUpScript: []string{
+			"create table t (i int, j json)",
+			`insert into t values (1, '["test"]')`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "with tt as (select * from t) select * from json_table(tt.j, '$[*]' columns (a varchar(10) path '$')) as jt;",
+				ExpectedErr: sql.ErrUnknownTable,
+			},
+			{
+				Query:       "with tt as (select * from t) select * from tt, json_table(tt.j, '$[*]' columns (a varchar(10) path '$')) as jt;",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
 		},
 	},
 }
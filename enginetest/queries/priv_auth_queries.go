@@ -112,12 +112,18 @@ var UserPrivTests = []UserPrivilegeTest{
 			"CREATE USER 'replica-admin'@localhost;",
 			"CREATE USER 'replica-client'@localhost;",
 			"CREATE USER 'replica-reload'@localhost;",
+			"CREATE USER 'replica-super'@localhost;",
+			"CREATE USER 'binlog-admin'@localhost;",
 			// REPLICATION_SLAVE_ADMIN allows: start replica,
 			"GRANT REPLICATION_SLAVE_ADMIN ON *.* TO 'replica-admin'@localhost;",
 			// REPLICATION CLIENT allows: show replica status
 			"GRANT REPLICATION CLIENT ON *.* to 'replica-client'@localhost;",
 			// RELOAD allows: reset replica
 			"GRANT RELOAD ON *.* TO 'replica-reload'@localhost;",
+			// SUPER allows all replication commands
+			"GRANT SUPER ON *.* TO 'replica-super'@localhost;",
+			// BINLOG_ADMIN allows: reset binary logs and gtids
+			"GRANT BINLOG_ADMIN ON *.* TO 'binlog-admin'@localhost;",
 		},
 		Assertions: []UserPrivilegeTestAssertion{
 			// START REPLICA
@@ -152,6 +158,12 @@ var UserPrivTests = []UserPrivilegeTest{
 				Query:       "START REPLICA",
 				ExpectedErr: plan.ErrNoReplicationController,
 			},
+			{
+				User:        "replica-super",
+				Host:        "localhost",
+				Query:       "START REPLICA",
+				ExpectedErr: plan.ErrNoReplicationController,
+			},
 
 			// STOP REPLICA
 			{
@@ -185,6 +197,12 @@ var UserPrivTests = []UserPrivilegeTest{
 				Query:       "STOP REPLICA",
 				ExpectedErr: plan.ErrNoReplicationController,
 			},
+			{
+				User:        "replica-super",
+				Host:        "localhost",
+				Query:       "STOP REPLICA",
+				ExpectedErr: plan.ErrNoReplicationController,
+			},
 
 			// RESET REPLICA
 			{
@@ -218,6 +236,98 @@ var UserPrivTests = []UserPrivilegeTest{
 				Query:       "RESET REPLICA",
 				ExpectedErr: plan.ErrNoReplicationController,
 			},
+			{
+				User:        "replica-super",
+				Host:        "localhost",
+				Query:       "RESET REPLICA",
+				ExpectedErr: plan.ErrNoReplicationController,
+			},
+
+			// RESET REPLICA ALL
+			{
+				User:        "user",
+				Host:        "localhost",
+				Query:       "RESET REPLICA ALL",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "replica-admin",
+				Host:        "localhost",
+				Query:       "RESET REPLICA ALL",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "replica-client",
+				Host:        "localhost",
+				Query:       "RESET REPLICA ALL",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				// ErrNoReplicationController means the priv check passed
+				User:        "replica-reload",
+				Host:        "localhost",
+				Query:       "RESET REPLICA ALL",
+				ExpectedErr: plan.ErrNoReplicationController,
+			},
+			{
+				User:        "replica-super",
+				Host:        "localhost",
+				Query:       "RESET REPLICA ALL",
+				ExpectedErr: plan.ErrNoReplicationController,
+			},
+
+			// RESET BINARY LOGS AND GTIDS
+			{
+				User:        "user",
+				Host:        "localhost",
+				Query:       "RESET BINARY LOGS AND GTIDS",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "replica-admin",
+				Host:        "localhost",
+				Query:       "RESET BINARY LOGS AND GTIDS",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "replica-reload",
+				Host:        "localhost",
+				Query:       "RESET BINARY LOGS AND GTIDS",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				// ErrNoSourceController means the priv check passed
+				User:        "binlog-admin",
+				Host:        "localhost",
+				Query:       "RESET BINARY LOGS AND GTIDS",
+				ExpectedErr: plan.ErrNoSourceController,
+			},
+			{
+				User:        "replica-super",
+				Host:        "localhost",
+				Query:       "RESET BINARY LOGS AND GTIDS",
+				ExpectedErr: plan.ErrNoSourceController,
+			},
+
+			// RESET MASTER
+			{
+				User:        "user",
+				Host:        "localhost",
+				Query:       "RESET MASTER",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "binlog-admin",
+				Host:        "localhost",
+				Query:       "RESET MASTER",
+				ExpectedErr: plan.ErrNoSourceController,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "RESET MASTER TO 5",
+				ExpectedErr: plan.ErrNoSourceController,
+			},
 
 			// SHOW REPLICA STATUS
 			{
@@ -283,6 +393,12 @@ var UserPrivTests = []UserPrivilegeTest{
 				Query:       "CHANGE REPLICATION SOURCE TO SOURCE_HOST='localhost';",
 				ExpectedErr: plan.ErrNoReplicationController,
 			},
+			{
+				User:        "replica-super",
+				Host:        "localhost",
+				Query:       "CHANGE REPLICATION SOURCE TO SOURCE_HOST='localhost';",
+				ExpectedErr: plan.ErrNoReplicationController,
+			},
 
 			// CHANGE REPLICATION FILTER
 			{
@@ -316,6 +432,93 @@ var UserPrivTests = []UserPrivilegeTest{
 				Query:       "CHANGE REPLICATION FILTER REPLICATE_IGNORE_TABLE=(db01.t1);",
 				ExpectedErr: plan.ErrNoReplicationController,
 			},
+			{
+				User:        "replica-super",
+				Host:        "localhost",
+				Query:       "CHANGE REPLICATION FILTER REPLICATE_IGNORE_TABLE=(db01.t1);",
+				ExpectedErr: plan.ErrNoReplicationController,
+			},
+		},
+	},
+	{
+		Name: "Resource group privileges",
+		SetUpScript: []string{
+			"CREATE USER user@localhost;",
+			"CREATE USER 'rg-admin'@localhost;",
+			"CREATE USER 'rg-user'@localhost;",
+			// RESOURCE_GROUP_ADMIN allows: create, alter, drop and set resource group
+			"GRANT RESOURCE_GROUP_ADMIN ON *.* TO 'rg-admin'@localhost;",
+			// RESOURCE_GROUP_USER allows: set resource group
+			"GRANT RESOURCE_GROUP_USER ON *.* TO 'rg-user'@localhost;",
+			"CREATE RESOURCE GROUP batch TYPE = USER;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "user",
+				Host:        "localhost",
+				Query:       "CREATE RESOURCE GROUP rg TYPE = USER;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "rg-user",
+				Host:        "localhost",
+				Query:       "CREATE RESOURCE GROUP rg TYPE = USER;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "rg-admin",
+				Host:     "localhost",
+				Query:    "CREATE RESOURCE GROUP rg TYPE = USER;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:        "rg-user",
+				Host:        "localhost",
+				Query:       "ALTER RESOURCE GROUP rg THREAD_PRIORITY = 5;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "rg-admin",
+				Host:     "localhost",
+				Query:    "ALTER RESOURCE GROUP rg THREAD_PRIORITY = 5;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:        "user",
+				Host:        "localhost",
+				Query:       "SET RESOURCE GROUP batch;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "rg-user",
+				Host:     "localhost",
+				Query:    "SET RESOURCE GROUP batch;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "rg-admin",
+				Host:     "localhost",
+				Query:    "SET RESOURCE GROUP batch;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:        "rg-user",
+				Host:        "localhost",
+				Query:       "DROP RESOURCE GROUP rg;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "rg-admin",
+				Host:     "localhost",
+				Query:    "DROP RESOURCE GROUP rg;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT resource_group_name FROM information_schema.resource_groups ORDER BY 1;",
+				Expected: []sql.Row{{"batch"}, {"SYS_default"}, {"USR_default"}},
+			},
 		},
 	},
 	{
@@ -1554,6 +1757,231 @@ var UserPrivTests = []UserPrivilegeTest{
 			},
 		},
 	},
+	{
+		Name: "privileges are additive across levels and only revoked at the named level",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO test VALUES (1);",
+			"CREATE USER tester@localhost;",
+			"GRANT SELECT ON *.* TO tester@localhost;",
+			"GRANT SELECT, INSERT ON mydb.* TO tester@localhost;",
+			"GRANT SELECT, UPDATE ON mydb.test TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{
+					{"GRANT SELECT ON *.* TO `tester`@`localhost`"},
+					{"GRANT SELECT, INSERT ON `mydb`.* TO `tester`@`localhost`"},
+					{"GRANT SELECT, UPDATE ON `mydb`.`test` TO `tester`@`localhost`"},
+				},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "REVOKE SELECT ON mydb.* FROM tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.test;/*1*/",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "REVOKE SELECT ON *.* FROM tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.test;/*2*/",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "REVOKE ALL ON mydb.* FROM tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `tester`@`localhost`"},
+					{"GRANT SELECT, UPDATE ON `mydb`.`test` TO `tester`@`localhost`"},
+				},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "REVOKE SELECT ON mydb.* FROM tester@localhost;",
+				ExpectedErr: sql.ErrRevokeUserDoesNotExist,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.test;/*3*/",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "REVOKE SELECT, UPDATE ON mydb.test FROM tester@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "REVOKE SELECT ON mydb.test FROM tester@localhost;",
+				ExpectedErr: sql.ErrRevokeTableGrantDoesNotExist,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.test;/*4*/",
+				ExpectedErr: sql.ErrDatabaseAccessDeniedForUser,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `tester`@`localhost`"},
+				},
+			},
+		},
+	},
+	{
+		Name: "grant option is tracked and required at each level",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY);",
+			"CREATE USER granter@localhost;",
+			"CREATE USER grantee@localhost;",
+			"GRANT SELECT ON *.* TO granter@localhost;",
+			"GRANT SELECT ON mydb.* TO granter@localhost WITH GRANT OPTION;",
+			"GRANT GRANT OPTION ON mydb.test TO grantee@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR granter@localhost;",
+				Expected: []sql.Row{
+					{"GRANT SELECT ON *.* TO `granter`@`localhost`"},
+					{"GRANT SELECT ON `mydb`.* TO `granter`@`localhost` WITH GRANT OPTION"},
+				},
+			},
+			{
+				// The grant option on the current database does not allow granting global privileges
+				User:        "granter",
+				Host:        "localhost",
+				Query:       "GRANT SELECT ON *.* TO grantee@localhost;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "granter",
+				Host:        "localhost",
+				Query:       "GRANT INSERT ON mydb.* TO grantee@localhost;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "granter",
+				Host:     "localhost",
+				Query:    "GRANT SELECT ON mydb.* TO grantee@localhost WITH GRANT OPTION;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "granter",
+				Host:     "localhost",
+				Query:    "GRANT SELECT ON mydb.test TO grantee@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR grantee@localhost;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `grantee`@`localhost`"},
+					{"GRANT SELECT ON `mydb`.* TO `grantee`@`localhost` WITH GRANT OPTION"},
+					{"GRANT SELECT ON `mydb`.`test` TO `grantee`@`localhost` WITH GRANT OPTION"},
+				},
+			},
+			{
+				User:        "granter",
+				Host:        "localhost",
+				Query:       "REVOKE SELECT ON *.* FROM grantee@localhost;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "granter",
+				Host:     "localhost",
+				Query:    "REVOKE SELECT, GRANT OPTION ON mydb.* FROM grantee@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR grantee@localhost;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `grantee`@`localhost`"},
+					{"GRANT SELECT ON `mydb`.`test` TO `grantee`@`localhost` WITH GRANT OPTION"},
+				},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "REVOKE SELECT ON mydb.test FROM grantee@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR grantee@localhost;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `grantee`@`localhost`"},
+					{"GRANT USAGE ON `mydb`.`test` TO `grantee`@`localhost` WITH GRANT OPTION"},
+				},
+			},
+		},
+	},
+	{
+		Name: "SHOW GRANTS lists grants in canonical order",
+		SetUpScript: []string{
+			"CREATE ROLE role1;",
+			"CREATE USER tester@localhost;",
+			"GRANT role1 TO tester@localhost;",
+			"GRANT EXECUTE ON PROCEDURE mydb.proc1 TO tester@localhost;",
+			"GRANT SELECT ON otherdb.tbl TO tester@localhost;",
+			"GRANT SELECT ON mydb.test TO tester@localhost;",
+			"GRANT INSERT ON otherdb.* TO tester@localhost;",
+			"GRANT INSERT ON mydb.* TO tester@localhost;",
+			"GRANT REPLICATION_SLAVE_ADMIN ON *.* TO tester@localhost;",
+			"GRANT UPDATE ON *.* TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{
+					{"GRANT UPDATE ON *.* TO `tester`@`localhost`"},
+					{"GRANT REPLICATION_SLAVE_ADMIN ON *.* TO `tester`@`localhost`"},
+					{"GRANT INSERT ON `mydb`.* TO `tester`@`localhost`"},
+					{"GRANT INSERT ON `otherdb`.* TO `tester`@`localhost`"},
+					{"GRANT SELECT ON `mydb`.`test` TO `tester`@`localhost`"},
+					{"GRANT SELECT ON `otherdb`.`tbl` TO `tester`@`localhost`"},
+					{"GRANT EXECUTE ON PROCEDURE `mydb`.`proc1` TO `tester`@`localhost`"},
+					{"GRANT `role1`@`%` TO `tester`@`localhost`"},
+				},
+			},
+		},
+	},
 	{
 		Name: "SHOW DATABASES shows `mysql` database",
 		SetUpScript: []string{
@@ -2133,6 +2561,122 @@ var UserPrivTests = []UserPrivilegeTest{
 			},
 		},
 	},
+	{
+		Name: "views and procedures with SQL SECURITY DEFINER run with the privileges of their definer",
+		SetUpScript: []string{
+			"CREATE USER tester@localhost;",
+			"CREATE USER definer@localhost;",
+			"CREATE USER reader@localhost;",
+			"CREATE USER admin@localhost;",
+			"GRANT SELECT ON mydb.mytable TO definer@localhost;",
+			"GRANT EXECUTE, CREATE ROUTINE, CREATE VIEW ON mydb.* TO tester@localhost;",
+			"GRANT CREATE ROUTINE ON mydb.* TO admin@localhost;",
+			"GRANT SET_USER_ID ON *.* TO admin@localhost;",
+			"CREATE DEFINER = `definer`@`localhost` VIEW mydb.v_definer AS SELECT i FROM mydb.mytable;",
+			"CREATE DEFINER = `definer`@`localhost` SQL SECURITY INVOKER VIEW mydb.v_invoker AS SELECT i FROM mydb.mytable;",
+			"CREATE DEFINER = `tester`@`localhost` SQL SECURITY DEFINER VIEW mydb.v_tester AS SELECT i FROM mydb.mytable;",
+			"CREATE DEFINER = `definer`@`localhost` PROCEDURE mydb.p_definer() SELECT i FROM mydb.mytable ORDER BY i;",
+			"CREATE DEFINER = `definer`@`localhost` PROCEDURE mydb.p_invoker() SQL SECURITY INVOKER SELECT i FROM mydb.mytable ORDER BY i;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT i FROM mydb.mytable ORDER BY i;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				// selecting from a view requires the SELECT privilege on the view itself
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT i FROM mydb.v_definer ORDER BY i;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT SELECT ON mydb.v_definer TO reader@localhost;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "reader",
+				Host:     "localhost",
+				Query:    "SELECT i FROM mydb.v_definer ORDER BY i;",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				User:        "reader",
+				Host:        "localhost",
+				Query:       "SELECT i FROM mydb.mytable ORDER BY i;",
+				ExpectedErr: sql.ErrTableAccessDeniedForUser,
+			},
+			{
+				// a view or a procedure can only be defined for another account with SET_USER_ID or SUPER
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER = `root`@`localhost` PROCEDURE mydb.p_root() SELECT i FROM mydb.mytable;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER = `root`@`localhost` VIEW mydb.v_root AS SELECT i FROM mydb.mytable;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE DEFINER = `tester`@`localhost` PROCEDURE mydb.p_self() SELECT i FROM mydb.mytable;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "admin",
+				Host:     "localhost",
+				Query:    "CREATE DEFINER = `definer`@`localhost` PROCEDURE mydb.p_admin() SELECT i FROM mydb.mytable;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT i FROM mydb.v_invoker ORDER BY i;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "SELECT i FROM mydb.v_tester ORDER BY i;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT i FROM mydb.v_invoker ORDER BY i;",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CALL mydb.p_definer();",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CALL mydb.p_invoker();",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT table_name, security_type, definer FROM information_schema.views WHERE table_name LIKE 'v\\_%' ORDER BY 1;",
+				Expected: []sql.Row{
+					{"v_definer", "DEFINER", "definer@localhost"},
+					{"v_invoker", "INVOKER", "definer@localhost"},
+					{"v_tester", "DEFINER", "tester@localhost"},
+				},
+			},
+		},
+	},
 }
 
 // NoopPlaintextPlugin is used to authenticate plaintext user plugins
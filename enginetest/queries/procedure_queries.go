@@ -1286,6 +1286,11 @@ END;`,
 				Query:    "CALL duplicate_key();",
 				Expected: []sql.Row{{7}},
 			},
+			{
+				// the handled error is kept in the diagnostics area
+				Query:    "SHOW WARNINGS;",
+				Expected: []sql.Row{{"Error", 1062, "duplicate primary key given: [0]"}},
+			},
 		},
 	},
 	{
@@ -688,6 +688,18 @@ var SpatialQueryTests = []QueryTest{
 			{nil},
 		},
 	},
+	{
+		Query: `SELECT ST_DISTANCE(POINT(1, 1), LINESTRING(POINT(0, 0), POINT(2, 0))), ST_DISTANCE(POINT(3, 0), POLYGON(LINESTRING(POINT(0, 0), POINT(1, 0), POINT(1, 1), POINT(0, 0))))`,
+		Expected: []sql.Row{
+			{1.0, 2.0},
+		},
+	},
+	{
+		Query: `SELECT ROUND(ST_DISTANCE(ST_SRID(POINT(0, 0), 4326), ST_SRID(POINT(0, 1), 4326)), 3), ROUND(ST_DISTANCE(ST_SRID(POINT(0, 0), 4326), ST_SRID(POINT(1, 0), 4326), 'kilometre'), 3)`,
+		Expected: []sql.Row{
+			{111319.491, 110.574},
+		},
+	},
 	{
 		Query: `SELECT st_startpoint(g) from geometry_table ORDER BY g`,
 		Expected: []sql.Row{
@@ -886,7 +898,7 @@ var QueryTests = []QueryTest{
 			},
 			{
 				Name: "y",
-				Type: types.Int64,
+				Type: types.Int8,
 			},
 		},
 		Expected: []sql.Row{
@@ -1526,6 +1538,36 @@ Select * from (
 			{2},
 		},
 	},
+	{
+		Query:    `VALUES ROW(1,2), ROW(3,4)`,
+		Expected: []sql.Row{{1, 2}, {3, 4}},
+		ExpectedColumns: sql.Schema{
+			{
+				Name: "column_0",
+				Type: types.Int8,
+			},
+			{
+				Name: "column_1",
+				Type: types.Int8,
+			},
+		},
+	},
+	{
+		Query:    `SELECT 1,2 UNION VALUES ROW(3,4)`,
+		Expected: []sql.Row{{1, 2}, {3, 4}},
+	},
+	{
+		Query:    `VALUES ROW(1,2), ROW(3,4) UNION SELECT 5,6`,
+		Expected: []sql.Row{{1, 2}, {3, 4}, {5, 6}},
+	},
+	{
+		Query:    `VALUES ROW(1,2), ROW(3,4) ORDER BY column_0 DESC LIMIT 1`,
+		Expected: []sql.Row{{3, 4}},
+	},
+	{
+		Query:    `SELECT 1 IN (VALUES ROW(1))`,
+		Expected: []sql.Row{{true}},
+	},
 	{
 		Query:    `SELECT DISTINCT val FROM (values row(1), row(1.00), row(2), row(2)) a (val);`,
 		Expected: []sql.Row{{"1.00"}, {"2.00"}},
@@ -2389,13 +2431,13 @@ Select * from (
 	{
 		Query: "with recursive t (n) as (select sum(1) from dual union all select (2.00) from dual) select sum(n) from t;",
 		Expected: []sql.Row{
-			{"3.00"},
+			{3.0},
 		},
 	},
 	{
 		Query: "with recursive t (n) as (select sum(1) from dual union all select (2.00/3.0) from dual) select sum(n) from t;",
 		Expected: []sql.Row{
-			{"1.666667"},
+			{1.666667},
 		},
 	},
 	{
@@ -3291,6 +3333,14 @@ Select * from (
 		Query:    "SELECT i FROM (SELECT i FROM (SELECT i FROM mytable ORDER BY i DESC  LIMIT 1) sq1) sq2 WHERE i = 3;",
 		Expected: []sql.Row{{3}},
 	},
+	{
+		Query:    "SELECT i, s FROM mytable ORDER BY s DESC LIMIT 2 OFFSET 1;",
+		Expected: []sql.Row{{2, "second row"}, {1, "first row"}},
+	},
+	{
+		Query:    "SELECT i, s FROM mytable ORDER BY s, i DESC LIMIT 2;",
+		Expected: []sql.Row{{1, "first row"}, {2, "second row"}},
+	},
 	{
 		Query:    "SELECT i FROM (SELECT i FROM mytable WHERE i > 1) sq LIMIT 1;",
 		Expected: []sql.Row{{2}},
@@ -4423,6 +4473,22 @@ Select * from (
 		Query:    "SELECT 0x12345;",
 		Expected: []sql.Row{{[]uint8{0x1, 0x23, 0x45}}},
 	},
+	{
+		Query:    "SELECT b'1010', x'DEADBEEF', 0b1010, 0xDEADBEEF, b'', 0b0;",
+		Expected: []sql.Row{{uint64(10), []uint8{0xde, 0xad, 0xbe, 0xef}, uint64(10), []uint8{0xde, 0xad, 0xbe, 0xef}, uint64(0), uint64(0)}},
+	},
+	{
+		Query:    "SELECT b'1010' + 1, 0b1010 + 1, x'0A' + 1, 0x0A + 1;",
+		Expected: []sql.Row{{int64(11), int64(11), float64(11), float64(11)}},
+	},
+	{
+		Query:    "SELECT b'1010' - 20, 0b1010 * 3, x'0A' - 0.5, 0x0A * 2.5;",
+		Expected: []sql.Row{{int64(-10), int64(30), float64(9.5), float64(25)}},
+	},
+	{
+		Query:    "SELECT 0b1010 | 0b0101, hex(0b1010), 0b11111111 = 255;",
+		Expected: []sql.Row{{uint64(15), "A", true}},
+	},
 	{
 		Query:    "SELECT i FROM mytable WHERE i BETWEEN 1 AND 2",
 		Expected: []sql.Row{{int64(1)}, {int64(2)}},
@@ -5537,7 +5603,7 @@ Select * from (
 	{
 		Query: `SHOW VARIABLES WHERE Variable_name > 'version' and variable_name like '%_%'`,
 		Expected: []sql.Row{
-			{"version_comment", "Dolt"}, {"version_compile_machine", ""}, {"version_compile_os", ""}, {"version_compile_zlib", ""}, {"wait_timeout", 28800}, {"windowing_use_high_precision", 1},
+			{"version_comment", "Dolt"}, {"version_compile_machine", ""}, {"version_compile_os", ""}, {"version_compile_zlib", ""}, {"wait_timeout", 28800}, {"warning_count", 0}, {"windowing_use_high_precision", 1},
 		},
 	},
 	{
@@ -6010,6 +6076,31 @@ Select * from (
 			{types.MustJSON(`{"b": 2}`)},
 		},
 	},
+	{
+		Query:    `SELECT CASE i WHEN 1 THEN i WHEN 2 THEN 'two' ELSE 3.5 END FROM mytable ORDER BY i`,
+		Expected: []sql.Row{{"1"}, {"two"}, {"3.5"}},
+	},
+	{
+		Query:    `SELECT CASE WHEN i = 1 THEN NULL WHEN i = 2 THEN NULL ELSE i END FROM mytable ORDER BY i`,
+		Expected: []sql.Row{{nil}, {nil}, {int64(3)}},
+	},
+	{
+		Query:    `SELECT CASE WHEN i = 1 THEN 1 ELSE 2.5 END FROM mytable ORDER BY i`,
+		Expected: []sql.Row{{"1"}, {"2.5"}, {"2.5"}},
+	},
+	{
+		Query:    `SELECT CASE WHEN i = 1 THEN CAST('2020-01-01' AS DATE) ELSE CAST('2020-01-02 10:00:00' AS DATETIME) END FROM mytable ORDER BY i`,
+		Expected: []sql.Row{{time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)}, {time.Date(2020, time.January, 2, 10, 0, 0, 0, time.UTC)}, {time.Date(2020, time.January, 2, 10, 0, 0, 0, time.UTC)}},
+	},
+	{
+		// The operand and all WHEN values are compared as doubles, since they mix strings and numbers
+		Query:    `SELECT CASE '1.0' WHEN '1' THEN 'string' WHEN 1 THEN 'number' ELSE 'none' END`,
+		Expected: []sql.Row{{"string"}},
+	},
+	{
+		Query:    `SELECT CASE i WHEN '1.0' THEN 'one' WHEN 2 THEN 'two' ELSE 'other' END FROM mytable ORDER BY i`,
+		Expected: []sql.Row{{"one"}, {"two"}, {"other"}},
+	},
 	{
 		// NOTE: utf8_general_ci is collation of utf8mb3, which was deprecated and now removed in MySQL
 		Query: "SHOW COLLATION WHERE `Collation` IN ('binary', 'utf8_general_ci', 'utf8mb4_0900_ai_ci')",
@@ -10101,6 +10192,20 @@ var ErrorQueries = []QueryErrorTest{
 		Query:       "select x from mytable",
 		ExpectedErr: sql.ErrColumnNotFound,
 	},
+	{
+		// the 0b prefix of a bit-value literal is case-sensitive, so this is an identifier
+		Query:       "select 0B1010",
+		ExpectedErr: sql.ErrColumnNotFound,
+	},
+	{
+		Query:       "select 0b102",
+		ExpectedErr: sql.ErrColumnNotFound,
+	},
+	{
+		// a quoted identifier is never a bit-value literal
+		Query:       "select `0b101`",
+		ExpectedErr: sql.ErrColumnNotFound,
+	},
 	{
 		Query:       "select mytable.x from mytable",
 		ExpectedErr: sql.ErrTableColumnNotFound,
@@ -10558,6 +10663,18 @@ var ErrorQueries = []QueryErrorTest{
 		Query:          `select cot(0)`,
 		ExpectedErrStr: "DOUBLE out of range for COT",
 	},
+	{
+		Query:       `SELECT ST_DISTANCE(POINT(0, 0), ST_SRID(POINT(0, 1), 4326))`,
+		ExpectedErr: sql.ErrDiffSRIDs,
+	},
+	{
+		Query:          `SELECT ST_DISTANCE(ST_SRID(POINT(0, 0), 4326), ST_SRID(POINT(0, 1), 4326), 'parsec')`,
+		ExpectedErrStr: "There's no unit of measure named 'parsec'.",
+	},
+	{
+		Query:          `SELECT ST_DISTANCE(POINT(0, 0), POINT(0, 1), 'metre')`,
+		ExpectedErrStr: "the geometry passed to function st_distance is in SRID 0, which doesn't specify a length unit. Can't convert to 'metre'.",
+	},
 }
 
 var BrokenErrorQueries = []QueryErrorTest{
@@ -17,6 +17,184 @@
 package queries
 
 var PlanTests = []QueryPlanTest{
+	{
+		Query: `select abs(y) as v from xy order by abs(y)`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [v:2]\n" +
+			" └─ Sort(v:2 ASC nullsFirst)\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [xy.x:0!null, xy.y:1, abs(xy.y) as v]\n" +
+			"         └─ ProcessTable\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: xy\n" +
+			"                 └─ columns: [x y]\n" +
+			"",
+		ExpectedEstimates: "Project\n" +
+			" ├─ columns: [v]\n" +
+			" └─ Sort(v ASC)\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [xy.x, xy.y, abs(xy.y) as v]\n" +
+			"         └─ Table\n" +
+			"             ├─ name: xy\n" +
+			"             └─ columns: [x y]\n" +
+			"",
+		ExpectedAnalysis: "Project\n" +
+			" ├─ columns: [v]\n" +
+			" └─ Sort(v ASC)\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [xy.x, xy.y, abs(xy.y) as v]\n" +
+			"         └─ Table\n" +
+			"             ├─ name: xy\n" +
+			"             └─ columns: [x y]\n" +
+			"",
+	},
+	{
+		Query: `select distinct abs(y) as v from xy order by abs(y)`,
+		ExpectedPlan: "Distinct\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [v:2]\n" +
+			"     └─ Sort(v:2 ASC nullsFirst)\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [xy.x:0!null, xy.y:1, abs(xy.y) as v]\n" +
+			"             └─ ProcessTable\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: xy\n" +
+			"                     └─ columns: [x y]\n" +
+			"",
+		ExpectedEstimates: "Distinct\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [v]\n" +
+			"     └─ Sort(v ASC)\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [xy.x, xy.y, abs(xy.y) as v]\n" +
+			"             └─ Table\n" +
+			"                 └─ name: xy\n" +
+			"",
+		ExpectedAnalysis: "Distinct\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [v]\n" +
+			"     └─ Sort(v ASC)\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [xy.x, xy.y, abs(xy.y) as v]\n" +
+			"             └─ Table\n" +
+			"                 └─ name: xy\n" +
+			"",
+	},
+	{
+		Query: `select abs(y) as v, count(*) from xy group by abs(y)`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [v:2, count(1):0!null as count(*)]\n" +
+			" └─ GroupBy\n" +
+			"     ├─ select: COUNT(1 (bigint)), xy.y:0, v:1\n" +
+			"     ├─ group: v:1\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [xy.y:0, abs(xy.y) as v]\n" +
+			"         └─ ProcessTable\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: xy\n" +
+			"                 └─ columns: [y]\n" +
+			"",
+		ExpectedEstimates: "Project\n" +
+			" ├─ columns: [v, count(1) as count(*)]\n" +
+			" └─ GroupBy\n" +
+			"     ├─ SelectedExprs(COUNT(1), xy.y, v)\n" +
+			"     ├─ Grouping(v)\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [xy.y, abs(xy.y) as v]\n" +
+			"         └─ Table\n" +
+			"             ├─ name: xy\n" +
+			"             └─ columns: [y]\n" +
+			"",
+		ExpectedAnalysis: "Project\n" +
+			" ├─ columns: [v, count(1) as count(*)]\n" +
+			" └─ GroupBy\n" +
+			"     ├─ SelectedExprs(COUNT(1), xy.y, v)\n" +
+			"     ├─ Grouping(v)\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [xy.y, abs(xy.y) as v]\n" +
+			"         └─ Table\n" +
+			"             ├─ name: xy\n" +
+			"             └─ columns: [y]\n" +
+			"",
+	},
+	{
+		Query: `select abs(y) as v, count(*) from xy group by v having v > 1`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [v:2, count(1):0!null as count(*)]\n" +
+			" └─ Having\n" +
+			"     ├─ GreaterThan\n" +
+			"     │   ├─ v:2\n" +
+			"     │   └─ 1 (tinyint)\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [count(1):0!null, xy.y:1, v:2]\n" +
+			"         └─ GroupBy\n" +
+			"             ├─ select: COUNT(1 (bigint)), xy.y:1, v:2\n" +
+			"             ├─ group: v:2\n" +
+			"             └─ Project\n" +
+			"                 ├─ columns: [xy.x:0!null, xy.y:1, abs(xy.y) as v]\n" +
+			"                 └─ ProcessTable\n" +
+			"                     └─ Table\n" +
+			"                         ├─ name: xy\n" +
+			"                         └─ columns: [x y]\n" +
+			"",
+		ExpectedEstimates: "Project\n" +
+			" ├─ columns: [v, count(1) as count(*)]\n" +
+			" └─ Having((v > 1))\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [count(1), xy.y, v]\n" +
+			"         └─ GroupBy\n" +
+			"             ├─ SelectedExprs(COUNT(1), xy.y, v)\n" +
+			"             ├─ Grouping(v)\n" +
+			"             └─ Project\n" +
+			"                 ├─ columns: [xy.x, xy.y, abs(xy.y) as v]\n" +
+			"                 └─ Table\n" +
+			"                     └─ name: xy\n" +
+			"",
+		ExpectedAnalysis: "Project\n" +
+			" ├─ columns: [v, count(1) as count(*)]\n" +
+			" └─ Having((v > 1))\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [count(1), xy.y, v]\n" +
+			"         └─ GroupBy\n" +
+			"             ├─ SelectedExprs(COUNT(1), xy.y, v)\n" +
+			"             ├─ Grouping(v)\n" +
+			"             └─ Project\n" +
+			"                 ├─ columns: [xy.x, xy.y, abs(xy.y) as v]\n" +
+			"                 └─ Table\n" +
+			"                     └─ name: xy\n" +
+			"",
+	},
+	{
+		Query: `select rand() as r from xy order by rand()`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [rand() as r]\n" +
+			" └─ Sort(rand() ASC nullsFirst)\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [xy.x:0!null, xy.y:1, rand() as r]\n" +
+			"         └─ ProcessTable\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: xy\n" +
+			"                 └─ columns: [x y]\n" +
+			"",
+		ExpectedEstimates: "Project\n" +
+			" ├─ columns: [rand() as r]\n" +
+			" └─ Sort(rand() ASC)\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [xy.x, xy.y, rand() as r]\n" +
+			"         └─ Table\n" +
+			"             ├─ name: xy\n" +
+			"             └─ columns: [x y]\n" +
+			"",
+		ExpectedAnalysis: "Project\n" +
+			" ├─ columns: [rand() as r]\n" +
+			" └─ Sort(rand() ASC)\n" +
+			"     └─ Project\n" +
+			"         ├─ columns: [xy.x, xy.y, rand() as r]\n" +
+			"         └─ Table\n" +
+			"             ├─ name: xy\n" +
+			"             └─ columns: [x y]\n" +
+			"",
+	},
 	{
 		Query: `select x from xy where y in (select xy.x from xy join (select t2.y from xy t2 where exists (select t3.y from xy t3 where t3.y = xy.x)) t1);`,
 		ExpectedPlan: "Project\n" +
@@ -1709,41 +1887,36 @@ Select * from (
 			" ├─ colSet: (7)\n" +
 			" ├─ tableId: 7\n" +
 			" └─ Union distinct\n" +
-			"     ├─ Project\n" +
-			"     │   ├─ columns: [cte.s:0!null as s]\n" +
-			"     │   └─ SubqueryAlias\n" +
-			"     │       ├─ name: cte\n" +
-			"     │       ├─ outerVisibility: false\n" +
-			"     │       ├─ isLateral: false\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       ├─ colSet: (2)\n" +
-			"     │       ├─ tableId: 1\n" +
-			"     │       └─ RecursiveCTE\n" +
-			"     │           └─ Union distinct\n" +
-			"     │               ├─ Project\n" +
-			"     │               │   ├─ columns: [1 (tinyint)]\n" +
-			"     │               │   └─ Table\n" +
-			"     │               │       ├─ name: \n" +
-			"     │               │       ├─ columns: []\n" +
-			"     │               │       ├─ colSet: ()\n" +
-			"     │               │       └─ tableId: 0\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [xy.x:1!null]\n" +
-			"     │                   └─ LookupJoin\n" +
-			"     │                       ├─ RecursiveTable(cte)\n" +
-			"     │                       └─ IndexedTableAccess(xy)\n" +
-			"     │                           ├─ index: [xy.x]\n" +
-			"     │                           ├─ keys: [cte.s:0!null]\n" +
-			"     │                           ├─ colSet: (3,4)\n" +
-			"     │                           ├─ tableId: 3\n" +
-			"     │                           └─ Table\n" +
-			"     │                               ├─ name: xy\n" +
-			"     │                               └─ columns: [x]\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: cte\n" +
+			"     │   ├─ outerVisibility: false\n" +
+			"     │   ├─ isLateral: false\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   ├─ colSet: (2)\n" +
+			"     │   ├─ tableId: 1\n" +
+			"     │   └─ RecursiveCTE\n" +
+			"     │       └─ Union distinct\n" +
+			"     │           ├─ Project\n" +
+			"     │           │   ├─ columns: [1 (tinyint)]\n" +
+			"     │           │   └─ Table\n" +
+			"     │           │       ├─ name: \n" +
+			"     │           │       ├─ columns: []\n" +
+			"     │           │       ├─ colSet: ()\n" +
+			"     │           │       └─ tableId: 0\n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x:1!null]\n" +
+			"     │               └─ LookupJoin\n" +
+			"     │                   ├─ RecursiveTable(cte)\n" +
+			"     │                   └─ IndexedTableAccess(xy)\n" +
+			"     │                       ├─ index: [xy.x]\n" +
+			"     │                       ├─ keys: [cte.s:0!null]\n" +
+			"     │                       ├─ colSet: (3,4)\n" +
+			"     │                       ├─ tableId: 3\n" +
+			"     │                       └─ Table\n" +
+			"     │                           ├─ name: xy\n" +
+			"     │                           └─ columns: [x]\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [convert\n" +
-			"         │   ├─ type: signed\n" +
-			"         │   └─ xy.x:0!null\n" +
-			"         │   as x]\n" +
+			"         ├─ columns: [coerce(xy.x:0!null, bigint) as x]\n" +
 			"         └─ Project\n" +
 			"             ├─ columns: [xy.x:0!null]\n" +
 			"             └─ Filter\n" +
@@ -1796,29 +1969,27 @@ Select * from (
 			" ├─ isLateral: false\n" +
 			" ├─ cacheable: true\n" +
 			" └─ Union distinct\n" +
-			"     ├─ Project\n" +
-			"     │   ├─ columns: [cte.s as s]\n" +
-			"     │   └─ SubqueryAlias\n" +
-			"     │       ├─ name: cte\n" +
-			"     │       ├─ outerVisibility: false\n" +
-			"     │       ├─ isLateral: false\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ RecursiveCTE\n" +
-			"     │           └─ Union distinct\n" +
-			"     │               ├─ Project\n" +
-			"     │               │   ├─ columns: [1]\n" +
-			"     │               │   └─ Table\n" +
-			"     │               │       └─ name: \n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [xy.x]\n" +
-			"     │                   └─ LookupJoin\n" +
-			"     │                       ├─ RecursiveTable(cte)\n" +
-			"     │                       └─ IndexedTableAccess(xy)\n" +
-			"     │                           ├─ index: [xy.x]\n" +
-			"     │                           ├─ columns: [x]\n" +
-			"     │                           └─ keys: cte.s\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: cte\n" +
+			"     │   ├─ outerVisibility: false\n" +
+			"     │   ├─ isLateral: false\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   └─ RecursiveCTE\n" +
+			"     │       └─ Union distinct\n" +
+			"     │           ├─ Project\n" +
+			"     │           │   ├─ columns: [1]\n" +
+			"     │           │   └─ Table\n" +
+			"     │           │       └─ name: \n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x]\n" +
+			"     │               └─ LookupJoin\n" +
+			"     │                   ├─ RecursiveTable(cte)\n" +
+			"     │                   └─ IndexedTableAccess(xy)\n" +
+			"     │                       ├─ index: [xy.x]\n" +
+			"     │                       ├─ columns: [x]\n" +
+			"     │                       └─ keys: cte.s\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [convert(xy.x, signed) as x]\n" +
+			"         ├─ columns: [coerce(xy.x, bigint) as x]\n" +
 			"         └─ Project\n" +
 			"             ├─ columns: [xy.x]\n" +
 			"             └─ Filter\n" +
@@ -1856,29 +2027,27 @@ Select * from (
 			" ├─ isLateral: false\n" +
 			" ├─ cacheable: true\n" +
 			" └─ Union distinct\n" +
-			"     ├─ Project\n" +
-			"     │   ├─ columns: [cte.s as s]\n" +
-			"     │   └─ SubqueryAlias\n" +
-			"     │       ├─ name: cte\n" +
-			"     │       ├─ outerVisibility: false\n" +
-			"     │       ├─ isLateral: false\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ RecursiveCTE\n" +
-			"     │           └─ Union distinct\n" +
-			"     │               ├─ Project\n" +
-			"     │               │   ├─ columns: [1]\n" +
-			"     │               │   └─ Table\n" +
-			"     │               │       └─ name: \n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [xy.x]\n" +
-			"     │                   └─ LookupJoin\n" +
-			"     │                       ├─ RecursiveTable(cte)\n" +
-			"     │                       └─ IndexedTableAccess(xy)\n" +
-			"     │                           ├─ index: [xy.x]\n" +
-			"     │                           ├─ columns: [x]\n" +
-			"     │                           └─ keys: cte.s\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: cte\n" +
+			"     │   ├─ outerVisibility: false\n" +
+			"     │   ├─ isLateral: false\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   └─ RecursiveCTE\n" +
+			"     │       └─ Union distinct\n" +
+			"     │           ├─ Project\n" +
+			"     │           │   ├─ columns: [1]\n" +
+			"     │           │   └─ Table\n" +
+			"     │           │       └─ name: \n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x]\n" +
+			"     │               └─ LookupJoin\n" +
+			"     │                   ├─ RecursiveTable(cte)\n" +
+			"     │                   └─ IndexedTableAccess(xy)\n" +
+			"     │                       ├─ index: [xy.x]\n" +
+			"     │                       ├─ columns: [x]\n" +
+			"     │                       └─ keys: cte.s\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [convert(xy.x, signed) as x]\n" +
+			"         ├─ columns: [coerce(xy.x, bigint) as x]\n" +
 			"         └─ Project\n" +
 			"             ├─ columns: [xy.x]\n" +
 			"             └─ Filter\n" +
@@ -1927,41 +2096,36 @@ Select * from (
 			" ├─ colSet: (7)\n" +
 			" ├─ tableId: 7\n" +
 			" └─ Union distinct\n" +
-			"     ├─ Project\n" +
-			"     │   ├─ columns: [cte.s:0!null as s]\n" +
-			"     │   └─ SubqueryAlias\n" +
-			"     │       ├─ name: cte\n" +
-			"     │       ├─ outerVisibility: false\n" +
-			"     │       ├─ isLateral: false\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       ├─ colSet: (2)\n" +
-			"     │       ├─ tableId: 1\n" +
-			"     │       └─ RecursiveCTE\n" +
-			"     │           └─ Union distinct\n" +
-			"     │               ├─ Project\n" +
-			"     │               │   ├─ columns: [1 (tinyint)]\n" +
-			"     │               │   └─ Table\n" +
-			"     │               │       ├─ name: \n" +
-			"     │               │       ├─ columns: []\n" +
-			"     │               │       ├─ colSet: ()\n" +
-			"     │               │       └─ tableId: 0\n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [xy.x:1!null]\n" +
-			"     │                   └─ LookupJoin\n" +
-			"     │                       ├─ RecursiveTable(cte)\n" +
-			"     │                       └─ IndexedTableAccess(xy)\n" +
-			"     │                           ├─ index: [xy.x]\n" +
-			"     │                           ├─ keys: [cte.s:0!null]\n" +
-			"     │                           ├─ colSet: (3,4)\n" +
-			"     │                           ├─ tableId: 3\n" +
-			"     │                           └─ Table\n" +
-			"     │                               ├─ name: xy\n" +
-			"     │                               └─ columns: [x]\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: cte\n" +
+			"     │   ├─ outerVisibility: false\n" +
+			"     │   ├─ isLateral: false\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   ├─ colSet: (2)\n" +
+			"     │   ├─ tableId: 1\n" +
+			"     │   └─ RecursiveCTE\n" +
+			"     │       └─ Union distinct\n" +
+			"     │           ├─ Project\n" +
+			"     │           │   ├─ columns: [1 (tinyint)]\n" +
+			"     │           │   └─ Table\n" +
+			"     │           │       ├─ name: \n" +
+			"     │           │       ├─ columns: []\n" +
+			"     │           │       ├─ colSet: ()\n" +
+			"     │           │       └─ tableId: 0\n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x:1!null]\n" +
+			"     │               └─ LookupJoin\n" +
+			"     │                   ├─ RecursiveTable(cte)\n" +
+			"     │                   └─ IndexedTableAccess(xy)\n" +
+			"     │                       ├─ index: [xy.x]\n" +
+			"     │                       ├─ keys: [cte.s:0!null]\n" +
+			"     │                       ├─ colSet: (3,4)\n" +
+			"     │                       ├─ tableId: 3\n" +
+			"     │                       └─ Table\n" +
+			"     │                           ├─ name: xy\n" +
+			"     │                           └─ columns: [x]\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [convert\n" +
-			"         │   ├─ type: signed\n" +
-			"         │   └─ xy.x:0!null\n" +
-			"         │   as x]\n" +
+			"         ├─ columns: [coerce(xy.x:0!null, bigint) as x]\n" +
 			"         └─ Project\n" +
 			"             ├─ columns: [xy.x:1!null]\n" +
 			"             └─ LookupJoin\n" +
@@ -2012,29 +2176,27 @@ Select * from (
 			" ├─ isLateral: false\n" +
 			" ├─ cacheable: true\n" +
 			" └─ Union distinct\n" +
-			"     ├─ Project\n" +
-			"     │   ├─ columns: [cte.s as s]\n" +
-			"     │   └─ SubqueryAlias\n" +
-			"     │       ├─ name: cte\n" +
-			"     │       ├─ outerVisibility: false\n" +
-			"     │       ├─ isLateral: false\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ RecursiveCTE\n" +
-			"     │           └─ Union distinct\n" +
-			"     │               ├─ Project\n" +
-			"     │               │   ├─ columns: [1]\n" +
-			"     │               │   └─ Table\n" +
-			"     │               │       └─ name: \n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [xy.x]\n" +
-			"     │                   └─ LookupJoin\n" +
-			"     │                       ├─ RecursiveTable(cte)\n" +
-			"     │                       └─ IndexedTableAccess(xy)\n" +
-			"     │                           ├─ index: [xy.x]\n" +
-			"     │                           ├─ columns: [x]\n" +
-			"     │                           └─ keys: cte.s\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: cte\n" +
+			"     │   ├─ outerVisibility: false\n" +
+			"     │   ├─ isLateral: false\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   └─ RecursiveCTE\n" +
+			"     │       └─ Union distinct\n" +
+			"     │           ├─ Project\n" +
+			"     │           │   ├─ columns: [1]\n" +
+			"     │           │   └─ Table\n" +
+			"     │           │       └─ name: \n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x]\n" +
+			"     │               └─ LookupJoin\n" +
+			"     │                   ├─ RecursiveTable(cte)\n" +
+			"     │                   └─ IndexedTableAccess(xy)\n" +
+			"     │                       ├─ index: [xy.x]\n" +
+			"     │                       ├─ columns: [x]\n" +
+			"     │                       └─ keys: cte.s\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [convert(xy.x, signed) as x]\n" +
+			"         ├─ columns: [coerce(xy.x, bigint) as x]\n" +
 			"         └─ Project\n" +
 			"             ├─ columns: [xy.x]\n" +
 			"             └─ LookupJoin\n" +
@@ -2069,29 +2231,27 @@ Select * from (
 			" ├─ isLateral: false\n" +
 			" ├─ cacheable: true\n" +
 			" └─ Union distinct\n" +
-			"     ├─ Project\n" +
-			"     │   ├─ columns: [cte.s as s]\n" +
-			"     │   └─ SubqueryAlias\n" +
-			"     │       ├─ name: cte\n" +
-			"     │       ├─ outerVisibility: false\n" +
-			"     │       ├─ isLateral: false\n" +
-			"     │       ├─ cacheable: true\n" +
-			"     │       └─ RecursiveCTE\n" +
-			"     │           └─ Union distinct\n" +
-			"     │               ├─ Project\n" +
-			"     │               │   ├─ columns: [1]\n" +
-			"     │               │   └─ Table\n" +
-			"     │               │       └─ name: \n" +
-			"     │               └─ Project\n" +
-			"     │                   ├─ columns: [xy.x]\n" +
-			"     │                   └─ LookupJoin\n" +
-			"     │                       ├─ RecursiveTable(cte)\n" +
-			"     │                       └─ IndexedTableAccess(xy)\n" +
-			"     │                           ├─ index: [xy.x]\n" +
-			"     │                           ├─ columns: [x]\n" +
-			"     │                           └─ keys: cte.s\n" +
+			"     ├─ SubqueryAlias\n" +
+			"     │   ├─ name: cte\n" +
+			"     │   ├─ outerVisibility: false\n" +
+			"     │   ├─ isLateral: false\n" +
+			"     │   ├─ cacheable: true\n" +
+			"     │   └─ RecursiveCTE\n" +
+			"     │       └─ Union distinct\n" +
+			"     │           ├─ Project\n" +
+			"     │           │   ├─ columns: [1]\n" +
+			"     │           │   └─ Table\n" +
+			"     │           │       └─ name: \n" +
+			"     │           └─ Project\n" +
+			"     │               ├─ columns: [xy.x]\n" +
+			"     │               └─ LookupJoin\n" +
+			"     │                   ├─ RecursiveTable(cte)\n" +
+			"     │                   └─ IndexedTableAccess(xy)\n" +
+			"     │                       ├─ index: [xy.x]\n" +
+			"     │                       ├─ columns: [x]\n" +
+			"     │                       └─ keys: cte.s\n" +
 			"     └─ Project\n" +
-			"         ├─ columns: [convert(xy.x, signed) as x]\n" +
+			"         ├─ columns: [coerce(xy.x, bigint) as x]\n" +
 			"         └─ Project\n" +
 			"             ├─ columns: [xy.x]\n" +
 			"             └─ LookupJoin\n" +
@@ -4604,6 +4764,7 @@ Select * from (
 			"     │       └─ IndexedTableAccess(ab)\n" +
 			"     │           ├─ index: [ab.a]\n" +
 			"     │           ├─ static: [{[1, 1]}]\n" +
+			"     │           ├─ limit: 1\n" +
 			"     │           ├─ colSet: (3,4)\n" +
 			"     │           ├─ tableId: 2\n" +
 			"     │           └─ Table\n" +
@@ -4622,7 +4783,8 @@ Select * from (
 			"     │       └─ IndexedTableAccess(ab)\n" +
 			"     │           ├─ index: [ab.a]\n" +
 			"     │           ├─ filters: [{[1, 1]}]\n" +
-			"     │           └─ columns: [a b]\n" +
+			"     │           ├─ columns: [a b]\n" +
+			"     │           └─ limit: 1\n" +
 			"     └─ Table\n" +
 			"         └─ name: ab\n" +
 			"",
@@ -4634,7 +4796,8 @@ Select * from (
 			"     │       └─ IndexedTableAccess(ab)\n" +
 			"     │           ├─ index: [ab.a]\n" +
 			"     │           ├─ filters: [{[1, 1]}]\n" +
-			"     │           └─ columns: [a b]\n" +
+			"     │           ├─ columns: [a b]\n" +
+			"     │           └─ limit: 1\n" +
 			"     └─ Table\n" +
 			"         └─ name: ab\n" +
 			"",
@@ -5850,7 +6013,8 @@ inner join pq on true
 			" ├─ CrossHashJoin (estimated cost=113.050 rows=5)\n" +
 			" │   ├─ SubqueryAlias\n" +
 			" │   │   ├─ name: alias1\n" +
-			" │   │   ├─ outerVisibility: false\n │   │   ├─ isLateral: false\n" +
+			" │   │   ├─ outerVisibility: false\n" +
+			" │   │   ├─ isLateral: false\n" +
 			" │   │   ├─ cacheable: true\n" +
 			" │   │   └─ Project\n" +
 			" │   │       ├─ columns: [ab.a, ab.b, xy.x, xy.y]\n" +
@@ -8996,54 +9160,53 @@ inner join pq on true
 	{
 		Query: `SELECT a.* FROM mytable a inner join mytable b on (a.i = b.s) WHERE a.s is not null`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:0!null, a.s:1!null]\n" +
-			" └─ LookupJoin\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.s,mytable.i]\n" +
-			"     │       ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     │       ├─ colSet: (1,2)\n" +
-			"     │       ├─ tableId: 1\n" +
+			" ├─ columns: [a.i:1!null, a.s:2!null]\n" +
+			" └─ InnerJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ a.i:1!null\n" +
+			"     │   └─ b.s:0!null\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ ProcessTable\n" +
 			"     │       └─ Table\n" +
 			"     │           ├─ name: mytable\n" +
-			"     │           └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
+			"     │           └─ columns: [s]\n" +
+			"     └─ TableAlias(a)\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ keys: [a.i:0!null]\n" +
-			"             ├─ colSet: (3,4)\n" +
-			"             ├─ tableId: 2\n" +
+			"             ├─ index: [mytable.s,mytable.i]\n" +
+			"             ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			"             ├─ colSet: (1,2)\n" +
+			"             ├─ tableId: 1\n" +
 			"             └─ Table\n" +
 			"                 ├─ name: mytable\n" +
-			"                 └─ columns: [s]\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ LookupJoin (estimated cost=3.300 rows=1)\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.s,mytable.i]\n" +
-			"     │       ├─ filters: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     │       └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
+			" └─ InnerJoin (estimated cost=4.030 rows=3)\n" +
+			"     ├─ (a.i = b.s)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [s]\n" +
+			"     └─ TableAlias(a)\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ columns: [s]\n" +
-			"             └─ keys: a.i\n" +
+			"             ├─ index: [mytable.s,mytable.i]\n" +
+			"             ├─ filters: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ LookupJoin (estimated cost=3.300 rows=1) (actual rows=0 loops=1)\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.s,mytable.i]\n" +
-			"     │       ├─ filters: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     │       └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
+			" └─ InnerJoin (estimated cost=4.030 rows=3) (actual rows=0 loops=1)\n" +
+			"     ├─ (a.i = b.s)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [s]\n" +
+			"     └─ TableAlias(a)\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ columns: [s]\n" +
-			"             └─ keys: a.i\n" +
+			"             ├─ index: [mytable.s,mytable.i]\n" +
+			"             ├─ filters: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -9071,7 +9234,7 @@ inner join pq on true
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ InnerJoin (estimated cost=4.030 rows=1)\n" +
+			" └─ InnerJoin (estimated cost=4.030 rows=3)\n" +
 			"     ├─ (a.i = b.s)\n" +
 			"     ├─ TableAlias(b)\n" +
 			"     │   └─ Table\n" +
@@ -9085,7 +9248,7 @@ inner join pq on true
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ InnerJoin (estimated cost=4.030 rows=1) (actual rows=0 loops=1)\n" +
+			" └─ InnerJoin (estimated cost=4.030 rows=3) (actual rows=0 loops=1)\n" +
 			"     ├─ (a.i = b.s)\n" +
 			"     ├─ TableAlias(b)\n" +
 			"     │   └─ Table\n" +
@@ -9101,129 +9264,122 @@ inner join pq on true
 	{
 		Query: `SELECT a.* FROM mytable a inner join mytable b on (a.i = b.s) WHERE a.s not in ('1', '2', '3', '4')`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:0!null, a.s:1!null]\n" +
-			" └─ LookupJoin\n" +
-			"     ├─ Filter\n" +
-			"     │   ├─ NOT\n" +
-			"     │   │   └─ HashIn\n" +
-			"     │   │       ├─ a.s:1!null\n" +
-			"     │   │       └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext), 4 (longtext))\n" +
-			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.s,mytable.i]\n" +
-			"     │           ├─ static: [{(NULL, 1), [NULL, ∞)}, {(1, 2), [NULL, ∞)}, {(2, 3), [NULL, ∞)}, {(3, 4), [NULL, ∞)}, {(4, ∞), [NULL, ∞)}]\n" +
-			"     │           ├─ colSet: (1,2)\n" +
-			"     │           ├─ tableId: 1\n" +
-			"     │           └─ Table\n" +
-			"     │               ├─ name: mytable\n" +
-			"     │               └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ keys: [a.i:0!null]\n" +
-			"             ├─ colSet: (3,4)\n" +
-			"             ├─ tableId: 2\n" +
-			"             └─ Table\n" +
-			"                 ├─ name: mytable\n" +
-			"                 └─ columns: [s]\n" +
+			" ├─ columns: [a.i:1!null, a.s:2!null]\n" +
+			" └─ InnerJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ a.i:1!null\n" +
+			"     │   └─ b.s:0!null\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ ProcessTable\n" +
+			"     │       └─ Table\n" +
+			"     │           ├─ name: mytable\n" +
+			"     │           └─ columns: [s]\n" +
+			"     └─ Filter\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ HashIn\n" +
+			"         │       ├─ a.s:1!null\n" +
+			"         │       └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext), 4 (longtext))\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.s,mytable.i]\n" +
+			"                 ├─ static: [{(NULL, 1), [NULL, ∞)}, {(1, 2), [NULL, ∞)}, {(2, 3), [NULL, ∞)}, {(3, 4), [NULL, ∞)}, {(4, ∞), [NULL, ∞)}]\n" +
+			"                 ├─ colSet: (1,2)\n" +
+			"                 ├─ tableId: 1\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: mytable\n" +
+			"                     └─ columns: [i s]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ LookupJoin (estimated cost=3.300 rows=0)\n" +
-			"     ├─ Filter\n" +
-			"     │   ├─ (NOT((a.s HASH IN ('1', '2', '3', '4'))))\n" +
-			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.s,mytable.i]\n" +
-			"     │           ├─ filters: [{(NULL, 1), [NULL, ∞)}, {(1, 2), [NULL, ∞)}, {(2, 3), [NULL, ∞)}, {(3, 4), [NULL, ∞)}, {(4, ∞), [NULL, ∞)}]\n" +
-			"     │           └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ columns: [s]\n" +
-			"             └─ keys: a.i\n" +
+			" └─ InnerJoin (estimated cost=4.030 rows=0)\n" +
+			"     ├─ (a.i = b.s)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [s]\n" +
+			"     └─ Filter\n" +
+			"         ├─ (NOT((a.s HASH IN ('1', '2', '3', '4'))))\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.s,mytable.i]\n" +
+			"                 ├─ filters: [{(NULL, 1), [NULL, ∞)}, {(1, 2), [NULL, ∞)}, {(2, 3), [NULL, ∞)}, {(3, 4), [NULL, ∞)}, {(4, ∞), [NULL, ∞)}]\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ LookupJoin (estimated cost=3.300 rows=0) (actual rows=0 loops=1)\n" +
-			"     ├─ Filter\n" +
-			"     │   ├─ (NOT((a.s HASH IN ('1', '2', '3', '4'))))\n" +
-			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.s,mytable.i]\n" +
-			"     │           ├─ filters: [{(NULL, 1), [NULL, ∞)}, {(1, 2), [NULL, ∞)}, {(2, 3), [NULL, ∞)}, {(3, 4), [NULL, ∞)}, {(4, ∞), [NULL, ∞)}]\n" +
-			"     │           └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ columns: [s]\n" +
-			"             └─ keys: a.i\n" +
+			" └─ InnerJoin (estimated cost=4.030 rows=0) (actual rows=0 loops=1)\n" +
+			"     ├─ (a.i = b.s)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [s]\n" +
+			"     └─ Filter\n" +
+			"         ├─ (NOT((a.s HASH IN ('1', '2', '3', '4'))))\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.s,mytable.i]\n" +
+			"                 ├─ filters: [{(NULL, 1), [NULL, ∞)}, {(1, 2), [NULL, ∞)}, {(2, 3), [NULL, ∞)}, {(3, 4), [NULL, ∞)}, {(4, ∞), [NULL, ∞)}]\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.* FROM mytable a inner join mytable b on (a.i = b.s) WHERE a.i in (1, 2, 3, 4)`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:0!null, a.s:1!null]\n" +
-			" └─ MergeJoin\n" +
-			"     ├─ cmp: Eq\n" +
-			"     │   ├─ a.i:0!null\n" +
-			"     │   └─ b.s:2!null\n" +
-			"     ├─ Filter\n" +
-			"     │   ├─ HashIn\n" +
-			"     │   │   ├─ a.i:0!null\n" +
-			"     │   │   └─ TUPLE(1 (tinyint), 2 (tinyint), 3 (tinyint), 4 (tinyint))\n" +
-			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.i,mytable.s]\n" +
-			"     │           ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     │           ├─ colSet: (1,2)\n" +
-			"     │           ├─ tableId: 1\n" +
-			"     │           └─ Table\n" +
-			"     │               ├─ name: mytable\n" +
-			"     │               └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ static: [{[NULL, ∞)}]\n" +
-			"             ├─ colSet: (3,4)\n" +
-			"             ├─ tableId: 2\n" +
-			"             └─ Table\n" +
-			"                 ├─ name: mytable\n" +
-			"                 └─ columns: [s]\n" +
+			" ├─ columns: [a.i:1!null, a.s:2!null]\n" +
+			" └─ InnerJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ a.i:1!null\n" +
+			"     │   └─ b.s:0!null\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ ProcessTable\n" +
+			"     │       └─ Table\n" +
+			"     │           ├─ name: mytable\n" +
+			"     │           └─ columns: [s]\n" +
+			"     └─ Filter\n" +
+			"         ├─ HashIn\n" +
+			"         │   ├─ a.i:0!null\n" +
+			"         │   └─ TUPLE(1 (tinyint), 2 (tinyint), 3 (tinyint), 4 (tinyint))\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.i]\n" +
+			"                 ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}, {[4, 4]}]\n" +
+			"                 ├─ colSet: (1,2)\n" +
+			"                 ├─ tableId: 1\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: mytable\n" +
+			"                     └─ columns: [i s]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ MergeJoin (estimated cost=5.070 rows=2)\n" +
-			"     ├─ cmp: (a.i = b.s)\n" +
-			"     ├─ Filter\n" +
-			"     │   ├─ (a.i HASH IN (1, 2, 3, 4))\n" +
-			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.i,mytable.s]\n" +
-			"     │           ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     │           └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ filters: [{[NULL, ∞)}]\n" +
-			"             └─ columns: [s]\n" +
+			" └─ InnerJoin (estimated cost=7.060 rows=2)\n" +
+			"     ├─ (a.i = b.s)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [s]\n" +
+			"     └─ Filter\n" +
+			"         ├─ (a.i HASH IN (1, 2, 3, 4))\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.i]\n" +
+			"                 ├─ filters: [{[1, 1]}, {[2, 2]}, {[3, 3]}, {[4, 4]}]\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ MergeJoin (estimated cost=5.070 rows=2) (actual rows=0 loops=1)\n" +
-			"     ├─ cmp: (a.i = b.s)\n" +
-			"     ├─ Filter\n" +
-			"     │   ├─ (a.i HASH IN (1, 2, 3, 4))\n" +
-			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable)\n" +
-			"     │           ├─ index: [mytable.i,mytable.s]\n" +
-			"     │           ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"     │           └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ filters: [{[NULL, ∞)}]\n" +
-			"             └─ columns: [s]\n" +
+			" └─ InnerJoin (estimated cost=7.060 rows=2) (actual rows=0 loops=1)\n" +
+			"     ├─ (a.i = b.s)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [s]\n" +
+			"     └─ Filter\n" +
+			"         ├─ (a.i HASH IN (1, 2, 3, 4))\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.i]\n" +
+			"                 ├─ filters: [{[1, 1]}, {[2, 2]}, {[3, 3]}, {[4, 4]}]\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -9761,7 +9917,7 @@ inner join pq on true
 		Query: `SELECT a.* FROM mytable a, mytable b where a.s = b.i OR a.i = 1`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [a.i:1!null, a.s:2!null]\n" +
-			" └─ LookupJoin\n" +
+			" └─ InnerJoin\n" +
 			"     ├─ Or\n" +
 			"     │   ├─ Eq\n" +
 			"     │   │   ├─ a.s:2!null\n" +
@@ -9775,67 +9931,37 @@ inner join pq on true
 			"     │           ├─ name: mytable\n" +
 			"     │           └─ columns: [i]\n" +
 			"     └─ TableAlias(a)\n" +
-			"         └─ Concat\n" +
-			"             ├─ TableAlias(a)\n" +
-			"             │   └─ IndexedTableAccess(mytable)\n" +
-			"             │       ├─ index: [mytable.i]\n" +
-			"             │       ├─ keys: [1 (tinyint)]\n" +
-			"             │       ├─ colSet: (1,2)\n" +
-			"             │       ├─ tableId: 1\n" +
-			"             │       └─ Table\n" +
-			"             │           ├─ name: mytable\n" +
-			"             │           └─ columns: [i s]\n" +
-			"             └─ TableAlias(a)\n" +
-			"                 └─ IndexedTableAccess(mytable)\n" +
-			"                     ├─ index: [mytable.s,mytable.i]\n" +
-			"                     ├─ keys: [b.i:0!null]\n" +
-			"                     ├─ colSet: (1,2)\n" +
-			"                     ├─ tableId: 1\n" +
-			"                     └─ Table\n" +
-			"                         ├─ name: mytable\n" +
-			"                         └─ columns: [i s]\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mytable\n" +
+			"             ├─ columns: [i s]\n" +
+			"             ├─ colSet: (1,2)\n" +
+			"             └─ tableId: 1\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ LookupJoin (estimated cost=-2.705 rows=3)\n" +
+			" └─ InnerJoin (estimated cost=10.090 rows=3)\n" +
 			"     ├─ ((a.s = b.i) OR (a.i = 1))\n" +
 			"     ├─ TableAlias(b)\n" +
 			"     │   └─ Table\n" +
 			"     │       ├─ name: mytable\n" +
 			"     │       └─ columns: [i]\n" +
 			"     └─ TableAlias(a)\n" +
-			"         └─ Concat\n" +
-			"             ├─ TableAlias(a)\n" +
-			"             │   └─ IndexedTableAccess(mytable)\n" +
-			"             │       ├─ index: [mytable.i]\n" +
-			"             │       ├─ columns: [i s]\n" +
-			"             │       └─ keys: 1\n" +
-			"             └─ TableAlias(a)\n" +
-			"                 └─ IndexedTableAccess(mytable)\n" +
-			"                     ├─ index: [mytable.s,mytable.i]\n" +
-			"                     ├─ columns: [i s]\n" +
-			"                     └─ keys: b.i\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ LookupJoin (estimated cost=-2.705 rows=3) (actual rows=3 loops=1)\n" +
+			" └─ InnerJoin (estimated cost=10.090 rows=3) (actual rows=3 loops=1)\n" +
 			"     ├─ ((a.s = b.i) OR (a.i = 1))\n" +
 			"     ├─ TableAlias(b)\n" +
 			"     │   └─ Table\n" +
 			"     │       ├─ name: mytable\n" +
 			"     │       └─ columns: [i]\n" +
 			"     └─ TableAlias(a)\n" +
-			"         └─ Concat\n" +
-			"             ├─ TableAlias(a)\n" +
-			"             │   └─ IndexedTableAccess(mytable)\n" +
-			"             │       ├─ index: [mytable.i]\n" +
-			"             │       ├─ columns: [i s]\n" +
-			"             │       └─ keys: 1\n" +
-			"             └─ TableAlias(a)\n" +
-			"                 └─ IndexedTableAccess(mytable)\n" +
-			"                     ├─ index: [mytable.s,mytable.i]\n" +
-			"                     ├─ columns: [i s]\n" +
-			"                     └─ keys: b.i\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -10215,140 +10341,111 @@ inner join pq on true
 	{
 		Query: `SELECT a.* FROM mytable a, mytable b, mytable c, mytable d where a.i = b.i AND b.i = c.i AND (c.i = d.s OR c.i = 2)`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:2!null, a.s:3!null]\n" +
-			" └─ InnerJoin\n" +
-			"     ├─ AND\n" +
-			"     │   ├─ Eq\n" +
-			"     │   │   ├─ b.i:4!null\n" +
-			"     │   │   └─ c.i:1!null\n" +
-			"     │   └─ Eq\n" +
-			"     │       ├─ a.i:2!null\n" +
-			"     │       └─ c.i:1!null\n" +
-			"     ├─ LookupJoin\n" +
+			" ├─ columns: [a.i:3!null, a.s:4!null]\n" +
+			" └─ LookupJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ a.i:3!null\n" +
+			"     │   └─ c.i:2!null\n" +
+			"     ├─ InnerJoin\n" +
 			"     │   ├─ Or\n" +
 			"     │   │   ├─ Eq\n" +
-			"     │   │   │   ├─ c.i:1!null\n" +
+			"     │   │   │   ├─ c.i:2!null\n" +
 			"     │   │   │   └─ d.s:0!null\n" +
 			"     │   │   └─ Eq\n" +
-			"     │   │       ├─ c.i:1!null\n" +
+			"     │   │       ├─ c.i:2!null\n" +
 			"     │   │       └─ 2 (tinyint)\n" +
 			"     │   ├─ TableAlias(d)\n" +
 			"     │   │   └─ ProcessTable\n" +
 			"     │   │       └─ Table\n" +
 			"     │   │           ├─ name: mytable\n" +
 			"     │   │           └─ columns: [s]\n" +
-			"     │   └─ TableAlias(c)\n" +
-			"     │       └─ Concat\n" +
-			"     │           ├─ TableAlias(c)\n" +
-			"     │           │   └─ IndexedTableAccess(mytable)\n" +
-			"     │           │       ├─ index: [mytable.i]\n" +
-			"     │           │       ├─ keys: [2 (tinyint)]\n" +
-			"     │           │       ├─ colSet: (5,6)\n" +
-			"     │           │       ├─ tableId: 3\n" +
-			"     │           │       └─ Table\n" +
-			"     │           │           ├─ name: mytable\n" +
-			"     │           │           └─ columns: [i]\n" +
-			"     │           └─ TableAlias(c)\n" +
-			"     │               └─ IndexedTableAccess(mytable)\n" +
-			"     │                   ├─ index: [mytable.i]\n" +
-			"     │                   ├─ keys: [d.s:0!null]\n" +
-			"     │                   ├─ colSet: (5,6)\n" +
-			"     │                   ├─ tableId: 3\n" +
-			"     │                   └─ Table\n" +
-			"     │                       ├─ name: mytable\n" +
-			"     │                       └─ columns: [i]\n" +
-			"     └─ MergeJoin\n" +
-			"         ├─ cmp: Eq\n" +
-			"         │   ├─ a.i:2!null\n" +
-			"         │   └─ b.i:4!null\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i,mytable.s]\n" +
-			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         │       ├─ colSet: (1,2)\n" +
-			"         │       ├─ tableId: 1\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: mytable\n" +
-			"         │           └─ columns: [i s]\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ static: [{[NULL, ∞)}]\n" +
-			"                 ├─ colSet: (3,4)\n" +
-			"                 ├─ tableId: 2\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: mytable\n" +
-			"                     └─ columns: [i]\n" +
+			"     │   └─ MergeJoin\n" +
+			"     │       ├─ cmp: Eq\n" +
+			"     │       │   ├─ b.i:1!null\n" +
+			"     │       │   └─ c.i:2!null\n" +
+			"     │       ├─ TableAlias(b)\n" +
+			"     │       │   └─ IndexedTableAccess(mytable)\n" +
+			"     │       │       ├─ index: [mytable.i,mytable.s]\n" +
+			"     │       │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"     │       │       ├─ colSet: (3,4)\n" +
+			"     │       │       ├─ tableId: 2\n" +
+			"     │       │       └─ Table\n" +
+			"     │       │           ├─ name: mytable\n" +
+			"     │       │           └─ columns: [i]\n" +
+			"     │       └─ TableAlias(c)\n" +
+			"     │           └─ IndexedTableAccess(mytable)\n" +
+			"     │               ├─ index: [mytable.i]\n" +
+			"     │               ├─ static: [{[NULL, ∞)}]\n" +
+			"     │               ├─ colSet: (5,6)\n" +
+			"     │               ├─ tableId: 3\n" +
+			"     │               └─ Table\n" +
+			"     │                   ├─ name: mytable\n" +
+			"     │                   └─ columns: [i]\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ keys: [b.i:1!null]\n" +
+			"             ├─ colSet: (1,2)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: mytable\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ InnerJoin (estimated cost=10.090 rows=3)\n" +
-			"     ├─ ((b.i = c.i) AND (a.i = c.i))\n" +
-			"     ├─ LookupJoin (estimated cost=-2.705 rows=3)\n" +
+			" └─ LookupJoin (estimated cost=9.900 rows=3)\n" +
+			"     ├─ (a.i = c.i)\n" +
+			"     ├─ InnerJoin (estimated cost=10.090 rows=3)\n" +
 			"     │   ├─ ((c.i = d.s) OR (c.i = 2))\n" +
 			"     │   ├─ TableAlias(d)\n" +
 			"     │   │   └─ Table\n" +
 			"     │   │       ├─ name: mytable\n" +
 			"     │   │       └─ columns: [s]\n" +
-			"     │   └─ TableAlias(c)\n" +
-			"     │       └─ Concat\n" +
-			"     │           ├─ TableAlias(c)\n" +
-			"     │           │   └─ IndexedTableAccess(mytable)\n" +
-			"     │           │       ├─ index: [mytable.i]\n" +
-			"     │           │       ├─ columns: [i]\n" +
-			"     │           │       └─ keys: 2\n" +
-			"     │           └─ TableAlias(c)\n" +
-			"     │               └─ IndexedTableAccess(mytable)\n" +
-			"     │                   ├─ index: [mytable.i]\n" +
-			"     │                   ├─ columns: [i]\n" +
-			"     │                   └─ keys: d.s\n" +
-			"     └─ MergeJoin (estimated cost=6.090 rows=3)\n" +
-			"         ├─ cmp: (a.i = b.i)\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i,mytable.s]\n" +
-			"         │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         │       └─ columns: [i s]\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ filters: [{[NULL, ∞)}]\n" +
-			"                 └─ columns: [i]\n" +
+			"     │   └─ MergeJoin (estimated cost=6.090 rows=3)\n" +
+			"     │       ├─ cmp: (b.i = c.i)\n" +
+			"     │       ├─ TableAlias(b)\n" +
+			"     │       │   └─ IndexedTableAccess(mytable)\n" +
+			"     │       │       ├─ index: [mytable.i,mytable.s]\n" +
+			"     │       │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"     │       │       └─ columns: [i]\n" +
+			"     │       └─ TableAlias(c)\n" +
+			"     │           └─ IndexedTableAccess(mytable)\n" +
+			"     │               ├─ index: [mytable.i]\n" +
+			"     │               ├─ filters: [{[NULL, ∞)}]\n" +
+			"     │               └─ columns: [i]\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ columns: [i s]\n" +
+			"             └─ keys: b.i\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ InnerJoin (estimated cost=10.090 rows=3) (actual rows=3 loops=1)\n" +
-			"     ├─ ((b.i = c.i) AND (a.i = c.i))\n" +
-			"     ├─ LookupJoin (estimated cost=-2.705 rows=3) (actual rows=3 loops=1)\n" +
+			" └─ LookupJoin (estimated cost=9.900 rows=3) (actual rows=3 loops=1)\n" +
+			"     ├─ (a.i = c.i)\n" +
+			"     ├─ InnerJoin (estimated cost=10.090 rows=3) (actual rows=3 loops=1)\n" +
 			"     │   ├─ ((c.i = d.s) OR (c.i = 2))\n" +
 			"     │   ├─ TableAlias(d)\n" +
 			"     │   │   └─ Table\n" +
 			"     │   │       ├─ name: mytable\n" +
 			"     │   │       └─ columns: [s]\n" +
-			"     │   └─ TableAlias(c)\n" +
-			"     │       └─ Concat\n" +
-			"     │           ├─ TableAlias(c)\n" +
-			"     │           │   └─ IndexedTableAccess(mytable)\n" +
-			"     │           │       ├─ index: [mytable.i]\n" +
-			"     │           │       ├─ columns: [i]\n" +
-			"     │           │       └─ keys: 2\n" +
-			"     │           └─ TableAlias(c)\n" +
-			"     │               └─ IndexedTableAccess(mytable)\n" +
-			"     │                   ├─ index: [mytable.i]\n" +
-			"     │                   ├─ columns: [i]\n" +
-			"     │                   └─ keys: d.s\n" +
-			"     └─ MergeJoin (estimated cost=6.090 rows=3) (actual rows=3 loops=3)\n" +
-			"         ├─ cmp: (a.i = b.i)\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i,mytable.s]\n" +
-			"         │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         │       └─ columns: [i s]\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ filters: [{[NULL, ∞)}]\n" +
-			"                 └─ columns: [i]\n" +
+			"     │   └─ MergeJoin (estimated cost=6.090 rows=3) (actual rows=3 loops=3)\n" +
+			"     │       ├─ cmp: (b.i = c.i)\n" +
+			"     │       ├─ TableAlias(b)\n" +
+			"     │       │   └─ IndexedTableAccess(mytable)\n" +
+			"     │       │       ├─ index: [mytable.i,mytable.s]\n" +
+			"     │       │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"     │       │       └─ columns: [i]\n" +
+			"     │       └─ TableAlias(c)\n" +
+			"     │           └─ IndexedTableAccess(mytable)\n" +
+			"     │               ├─ index: [mytable.i]\n" +
+			"     │               ├─ filters: [{[NULL, ∞)}]\n" +
+			"     │               └─ columns: [i]\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ columns: [i s]\n" +
+			"             └─ keys: b.i\n" +
 			"",
 	},
 	{
@@ -10508,82 +10605,52 @@ inner join pq on true
 	{
 		Query: `SELECT a.* FROM mytable a CROSS JOIN mytable b where a.i = b.i OR a.i = b.s`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:0!null, a.s:1!null]\n" +
-			" └─ LookupJoin\n" +
+			" ├─ columns: [a.i:2!null, a.s:3!null]\n" +
+			" └─ InnerJoin\n" +
 			"     ├─ Or\n" +
 			"     │   ├─ Eq\n" +
-			"     │   │   ├─ a.i:0!null\n" +
-			"     │   │   └─ b.i:2!null\n" +
+			"     │   │   ├─ a.i:2!null\n" +
+			"     │   │   └─ b.i:0!null\n" +
 			"     │   └─ Eq\n" +
-			"     │       ├─ a.i:0!null\n" +
-			"     │       └─ b.s:3!null\n" +
-			"     ├─ TableAlias(a)\n" +
+			"     │       ├─ a.i:2!null\n" +
+			"     │       └─ b.s:1!null\n" +
+			"     ├─ TableAlias(b)\n" +
 			"     │   └─ ProcessTable\n" +
 			"     │       └─ Table\n" +
 			"     │           ├─ name: mytable\n" +
 			"     │           └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ Concat\n" +
-			"             ├─ TableAlias(b)\n" +
-			"             │   └─ IndexedTableAccess(mytable)\n" +
-			"             │       ├─ index: [mytable.s,mytable.i]\n" +
-			"             │       ├─ keys: [a.i:0!null]\n" +
-			"             │       ├─ colSet: (3,4)\n" +
-			"             │       ├─ tableId: 2\n" +
-			"             │       └─ Table\n" +
-			"             │           ├─ name: mytable\n" +
-			"             │           └─ columns: [i s]\n" +
-			"             └─ TableAlias(b)\n" +
-			"                 └─ IndexedTableAccess(mytable)\n" +
-			"                     ├─ index: [mytable.i]\n" +
-			"                     ├─ keys: [a.i:0!null]\n" +
-			"                     ├─ colSet: (3,4)\n" +
-			"                     ├─ tableId: 2\n" +
-			"                     └─ Table\n" +
-			"                         ├─ name: mytable\n" +
-			"                         └─ columns: [i s]\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mytable\n" +
+			"             ├─ columns: [i s]\n" +
+			"             ├─ colSet: (1,2)\n" +
+			"             └─ tableId: 1\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ LookupJoin (estimated cost=-2.705 rows=3)\n" +
+			" └─ InnerJoin (estimated cost=10.090 rows=3)\n" +
 			"     ├─ ((a.i = b.i) OR (a.i = b.s))\n" +
-			"     ├─ TableAlias(a)\n" +
+			"     ├─ TableAlias(b)\n" +
 			"     │   └─ Table\n" +
 			"     │       ├─ name: mytable\n" +
 			"     │       └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ Concat\n" +
-			"             ├─ TableAlias(b)\n" +
-			"             │   └─ IndexedTableAccess(mytable)\n" +
-			"             │       ├─ index: [mytable.s,mytable.i]\n" +
-			"             │       ├─ columns: [i s]\n" +
-			"             │       └─ keys: a.i\n" +
-			"             └─ TableAlias(b)\n" +
-			"                 └─ IndexedTableAccess(mytable)\n" +
-			"                     ├─ index: [mytable.i]\n" +
-			"                     ├─ columns: [i s]\n" +
-			"                     └─ keys: a.i\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ LookupJoin (estimated cost=-2.705 rows=3) (actual rows=3 loops=1)\n" +
+			" └─ InnerJoin (estimated cost=10.090 rows=3) (actual rows=3 loops=1)\n" +
 			"     ├─ ((a.i = b.i) OR (a.i = b.s))\n" +
-			"     ├─ TableAlias(a)\n" +
+			"     ├─ TableAlias(b)\n" +
 			"     │   └─ Table\n" +
 			"     │       ├─ name: mytable\n" +
 			"     │       └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ Concat\n" +
-			"             ├─ TableAlias(b)\n" +
-			"             │   └─ IndexedTableAccess(mytable)\n" +
-			"             │       ├─ index: [mytable.s,mytable.i]\n" +
-			"             │       ├─ columns: [i s]\n" +
-			"             │       └─ keys: a.i\n" +
-			"             └─ TableAlias(b)\n" +
-			"                 └─ IndexedTableAccess(mytable)\n" +
-			"                     ├─ index: [mytable.i]\n" +
-			"                     ├─ columns: [i s]\n" +
-			"                     └─ keys: a.i\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -10914,140 +10981,111 @@ inner join pq on true
 	{
 		Query: `SELECT a.* FROM mytable a CROSS JOIN mytable b CROSS JOIN mytable c CROSS JOIN mytable d where a.i = b.i AND b.i = c.i AND (c.i = d.s OR c.i = 2)`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:2!null, a.s:3!null]\n" +
-			" └─ InnerJoin\n" +
-			"     ├─ AND\n" +
-			"     │   ├─ Eq\n" +
-			"     │   │   ├─ b.i:4!null\n" +
-			"     │   │   └─ c.i:1!null\n" +
-			"     │   └─ Eq\n" +
-			"     │       ├─ a.i:2!null\n" +
-			"     │       └─ c.i:1!null\n" +
-			"     ├─ LookupJoin\n" +
+			" ├─ columns: [a.i:3!null, a.s:4!null]\n" +
+			" └─ LookupJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ a.i:3!null\n" +
+			"     │   └─ c.i:2!null\n" +
+			"     ├─ InnerJoin\n" +
 			"     │   ├─ Or\n" +
 			"     │   │   ├─ Eq\n" +
-			"     │   │   │   ├─ c.i:1!null\n" +
+			"     │   │   │   ├─ c.i:2!null\n" +
 			"     │   │   │   └─ d.s:0!null\n" +
-			"     │   │   └─ Eq\n" +
-			"     │   │       ├─ c.i:1!null\n" +
-			"     │   │       └─ 2 (tinyint)\n" +
-			"     │   ├─ TableAlias(d)\n" +
-			"     │   │   └─ ProcessTable\n" +
-			"     │   │       └─ Table\n" +
-			"     │   │           ├─ name: mytable\n" +
-			"     │   │           └─ columns: [s]\n" +
-			"     │   └─ TableAlias(c)\n" +
-			"     │       └─ Concat\n" +
-			"     │           ├─ TableAlias(c)\n" +
-			"     │           │   └─ IndexedTableAccess(mytable)\n" +
-			"     │           │       ├─ index: [mytable.i]\n" +
-			"     │           │       ├─ keys: [2 (tinyint)]\n" +
-			"     │           │       ├─ colSet: (5,6)\n" +
-			"     │           │       ├─ tableId: 3\n" +
-			"     │           │       └─ Table\n" +
-			"     │           │           ├─ name: mytable\n" +
-			"     │           │           └─ columns: [i]\n" +
-			"     │           └─ TableAlias(c)\n" +
-			"     │               └─ IndexedTableAccess(mytable)\n" +
-			"     │                   ├─ index: [mytable.i]\n" +
-			"     │                   ├─ keys: [d.s:0!null]\n" +
-			"     │                   ├─ colSet: (5,6)\n" +
-			"     │                   ├─ tableId: 3\n" +
-			"     │                   └─ Table\n" +
-			"     │                       ├─ name: mytable\n" +
-			"     │                       └─ columns: [i]\n" +
-			"     └─ MergeJoin\n" +
-			"         ├─ cmp: Eq\n" +
-			"         │   ├─ a.i:2!null\n" +
-			"         │   └─ b.i:4!null\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i,mytable.s]\n" +
-			"         │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         │       ├─ colSet: (1,2)\n" +
-			"         │       ├─ tableId: 1\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: mytable\n" +
-			"         │           └─ columns: [i s]\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ static: [{[NULL, ∞)}]\n" +
-			"                 ├─ colSet: (3,4)\n" +
-			"                 ├─ tableId: 2\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: mytable\n" +
-			"                     └─ columns: [i]\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ c.i:2!null\n" +
+			"     │   │       └─ 2 (tinyint)\n" +
+			"     │   ├─ TableAlias(d)\n" +
+			"     │   │   └─ ProcessTable\n" +
+			"     │   │       └─ Table\n" +
+			"     │   │           ├─ name: mytable\n" +
+			"     │   │           └─ columns: [s]\n" +
+			"     │   └─ MergeJoin\n" +
+			"     │       ├─ cmp: Eq\n" +
+			"     │       │   ├─ b.i:1!null\n" +
+			"     │       │   └─ c.i:2!null\n" +
+			"     │       ├─ TableAlias(b)\n" +
+			"     │       │   └─ IndexedTableAccess(mytable)\n" +
+			"     │       │       ├─ index: [mytable.i,mytable.s]\n" +
+			"     │       │       ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"     │       │       ├─ colSet: (3,4)\n" +
+			"     │       │       ├─ tableId: 2\n" +
+			"     │       │       └─ Table\n" +
+			"     │       │           ├─ name: mytable\n" +
+			"     │       │           └─ columns: [i]\n" +
+			"     │       └─ TableAlias(c)\n" +
+			"     │           └─ IndexedTableAccess(mytable)\n" +
+			"     │               ├─ index: [mytable.i]\n" +
+			"     │               ├─ static: [{[NULL, ∞)}]\n" +
+			"     │               ├─ colSet: (5,6)\n" +
+			"     │               ├─ tableId: 3\n" +
+			"     │               └─ Table\n" +
+			"     │                   ├─ name: mytable\n" +
+			"     │                   └─ columns: [i]\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ keys: [b.i:1!null]\n" +
+			"             ├─ colSet: (1,2)\n" +
+			"             ├─ tableId: 1\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: mytable\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ InnerJoin (estimated cost=10.090 rows=3)\n" +
-			"     ├─ ((b.i = c.i) AND (a.i = c.i))\n" +
-			"     ├─ LookupJoin (estimated cost=-2.705 rows=3)\n" +
+			" └─ LookupJoin (estimated cost=9.900 rows=3)\n" +
+			"     ├─ (a.i = c.i)\n" +
+			"     ├─ InnerJoin (estimated cost=10.090 rows=3)\n" +
 			"     │   ├─ ((c.i = d.s) OR (c.i = 2))\n" +
 			"     │   ├─ TableAlias(d)\n" +
 			"     │   │   └─ Table\n" +
 			"     │   │       ├─ name: mytable\n" +
 			"     │   │       └─ columns: [s]\n" +
-			"     │   └─ TableAlias(c)\n" +
-			"     │       └─ Concat\n" +
-			"     │           ├─ TableAlias(c)\n" +
-			"     │           │   └─ IndexedTableAccess(mytable)\n" +
-			"     │           │       ├─ index: [mytable.i]\n" +
-			"     │           │       ├─ columns: [i]\n" +
-			"     │           │       └─ keys: 2\n" +
-			"     │           └─ TableAlias(c)\n" +
-			"     │               └─ IndexedTableAccess(mytable)\n" +
-			"     │                   ├─ index: [mytable.i]\n" +
-			"     │                   ├─ columns: [i]\n" +
-			"     │                   └─ keys: d.s\n" +
-			"     └─ MergeJoin (estimated cost=6.090 rows=3)\n" +
-			"         ├─ cmp: (a.i = b.i)\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i,mytable.s]\n" +
-			"         │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         │       └─ columns: [i s]\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ filters: [{[NULL, ∞)}]\n" +
-			"                 └─ columns: [i]\n" +
+			"     │   └─ MergeJoin (estimated cost=6.090 rows=3)\n" +
+			"     │       ├─ cmp: (b.i = c.i)\n" +
+			"     │       ├─ TableAlias(b)\n" +
+			"     │       │   └─ IndexedTableAccess(mytable)\n" +
+			"     │       │       ├─ index: [mytable.i,mytable.s]\n" +
+			"     │       │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"     │       │       └─ columns: [i]\n" +
+			"     │       └─ TableAlias(c)\n" +
+			"     │           └─ IndexedTableAccess(mytable)\n" +
+			"     │               ├─ index: [mytable.i]\n" +
+			"     │               ├─ filters: [{[NULL, ∞)}]\n" +
+			"     │               └─ columns: [i]\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ columns: [i s]\n" +
+			"             └─ keys: b.i\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ InnerJoin (estimated cost=10.090 rows=3) (actual rows=3 loops=1)\n" +
-			"     ├─ ((b.i = c.i) AND (a.i = c.i))\n" +
-			"     ├─ LookupJoin (estimated cost=-2.705 rows=3) (actual rows=3 loops=1)\n" +
+			" └─ LookupJoin (estimated cost=9.900 rows=3) (actual rows=3 loops=1)\n" +
+			"     ├─ (a.i = c.i)\n" +
+			"     ├─ InnerJoin (estimated cost=10.090 rows=3) (actual rows=3 loops=1)\n" +
 			"     │   ├─ ((c.i = d.s) OR (c.i = 2))\n" +
 			"     │   ├─ TableAlias(d)\n" +
 			"     │   │   └─ Table\n" +
 			"     │   │       ├─ name: mytable\n" +
 			"     │   │       └─ columns: [s]\n" +
-			"     │   └─ TableAlias(c)\n" +
-			"     │       └─ Concat\n" +
-			"     │           ├─ TableAlias(c)\n" +
-			"     │           │   └─ IndexedTableAccess(mytable)\n" +
-			"     │           │       ├─ index: [mytable.i]\n" +
-			"     │           │       ├─ columns: [i]\n" +
-			"     │           │       └─ keys: 2\n" +
-			"     │           └─ TableAlias(c)\n" +
-			"     │               └─ IndexedTableAccess(mytable)\n" +
-			"     │                   ├─ index: [mytable.i]\n" +
-			"     │                   ├─ columns: [i]\n" +
-			"     │                   └─ keys: d.s\n" +
-			"     └─ MergeJoin (estimated cost=6.090 rows=3) (actual rows=3 loops=3)\n" +
-			"         ├─ cmp: (a.i = b.i)\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ IndexedTableAccess(mytable)\n" +
-			"         │       ├─ index: [mytable.i,mytable.s]\n" +
-			"         │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"         │       └─ columns: [i s]\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(mytable)\n" +
-			"                 ├─ index: [mytable.i]\n" +
-			"                 ├─ filters: [{[NULL, ∞)}]\n" +
-			"                 └─ columns: [i]\n" +
+			"     │   └─ MergeJoin (estimated cost=6.090 rows=3) (actual rows=3 loops=3)\n" +
+			"     │       ├─ cmp: (b.i = c.i)\n" +
+			"     │       ├─ TableAlias(b)\n" +
+			"     │       │   └─ IndexedTableAccess(mytable)\n" +
+			"     │       │       ├─ index: [mytable.i,mytable.s]\n" +
+			"     │       │       ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"     │       │       └─ columns: [i]\n" +
+			"     │       └─ TableAlias(c)\n" +
+			"     │           └─ IndexedTableAccess(mytable)\n" +
+			"     │               ├─ index: [mytable.i]\n" +
+			"     │               ├─ filters: [{[NULL, ∞)}]\n" +
+			"     │               └─ columns: [i]\n" +
+			"     └─ TableAlias(a)\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ columns: [i s]\n" +
+			"             └─ keys: b.i\n" +
 			"",
 	},
 	{
@@ -11149,54 +11187,53 @@ inner join pq on true
 	{
 		Query: `SELECT a.* FROM mytable a inner join mytable b on (a.i = b.s) WHERE a.i BETWEEN 10 AND 20`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.i:0!null, a.s:1!null]\n" +
-			" └─ LookupJoin\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
-			"     │       ├─ static: [{[10, 20]}]\n" +
-			"     │       ├─ colSet: (1,2)\n" +
-			"     │       ├─ tableId: 1\n" +
+			" ├─ columns: [a.i:1!null, a.s:2!null]\n" +
+			" └─ InnerJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ a.i:1!null\n" +
+			"     │   └─ b.s:0!null\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ ProcessTable\n" +
 			"     │       └─ Table\n" +
 			"     │           ├─ name: mytable\n" +
-			"     │           └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
+			"     │           └─ columns: [s]\n" +
+			"     └─ TableAlias(a)\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ keys: [a.i:0!null]\n" +
-			"             ├─ colSet: (3,4)\n" +
-			"             ├─ tableId: 2\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ static: [{[10, 20]}]\n" +
+			"             ├─ colSet: (1,2)\n" +
+			"             ├─ tableId: 1\n" +
 			"             └─ Table\n" +
 			"                 ├─ name: mytable\n" +
-			"                 └─ columns: [s]\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ LookupJoin (estimated cost=3.300 rows=1)\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
-			"     │       ├─ filters: [{[10, 20]}]\n" +
-			"     │       └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
+			" └─ InnerJoin (estimated cost=4.030 rows=3)\n" +
+			"     ├─ (a.i = b.s)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [s]\n" +
+			"     └─ TableAlias(a)\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ columns: [s]\n" +
-			"             └─ keys: a.i\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ filters: [{[10, 20]}]\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [a.i, a.s]\n" +
-			" └─ LookupJoin (estimated cost=3.300 rows=1) (actual rows=0 loops=1)\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ IndexedTableAccess(mytable)\n" +
-			"     │       ├─ index: [mytable.i]\n" +
-			"     │       ├─ filters: [{[10, 20]}]\n" +
-			"     │       └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
+			" └─ InnerJoin (estimated cost=4.030 rows=3) (actual rows=0 loops=1)\n" +
+			"     ├─ (a.i = b.s)\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [s]\n" +
+			"     └─ TableAlias(a)\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.s]\n" +
-			"             ├─ columns: [s]\n" +
-			"             └─ keys: a.i\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ filters: [{[10, 20]}]\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -12518,6 +12555,7 @@ inner join pq on true
 			" └─ IndexedTableAccess(datetime_table)\n" +
 			"     ├─ index: [datetime_table.date_col]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     ├─ limit: 100\n" +
 			"     ├─ colSet: (1-5)\n" +
 			"     ├─ tableId: 1\n" +
 			"     └─ Table\n" +
@@ -12528,13 +12566,15 @@ inner join pq on true
 			" └─ IndexedTableAccess(datetime_table)\n" +
 			"     ├─ index: [datetime_table.date_col]\n" +
 			"     ├─ filters: [{[NULL, ∞)}]\n" +
-			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"     ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"     └─ limit: 100\n" +
 			"",
 		ExpectedAnalysis: "Limit(100)\n" +
 			" └─ IndexedTableAccess(datetime_table)\n" +
 			"     ├─ index: [datetime_table.date_col]\n" +
 			"     ├─ filters: [{[NULL, ∞)}]\n" +
-			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"     ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"     └─ limit: 100\n" +
 			"",
 	},
 	{
@@ -12544,21 +12584,24 @@ inner join pq on true
 			"     └─ IndexedTableAccess(datetime_table)\n" +
 			"         ├─ index: [datetime_table.date_col]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"         ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"         └─ limit: 200\n" +
 			"",
 		ExpectedEstimates: "Limit(100)\n" +
 			" └─ Offset(100)\n" +
 			"     └─ IndexedTableAccess(datetime_table)\n" +
 			"         ├─ index: [datetime_table.date_col]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"         ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"         └─ limit: 200\n" +
 			"",
 		ExpectedAnalysis: "Limit(100)\n" +
 			" └─ Offset(100)\n" +
 			"     └─ IndexedTableAccess(datetime_table)\n" +
 			"         ├─ index: [datetime_table.date_col]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"         ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			"         └─ limit: 200\n" +
 			"",
 	},
 	{
@@ -16806,6 +16849,7 @@ inner join pq on true
 			" │       └─ IndexedTableAccess(one_pk)\n" +
 			" │           ├─ index: [one_pk.pk]\n" +
 			" │           ├─ static: [{[1, 1]}]\n" +
+			" │           ├─ limit: 1\n" +
 			" │           ├─ colSet: (14-19)\n" +
 			" │           ├─ tableId: 3\n" +
 			" │           └─ Table\n" +
@@ -16840,7 +16884,8 @@ inner join pq on true
 			" │       └─ IndexedTableAccess(one_pk)\n" +
 			" │           ├─ index: [one_pk.pk]\n" +
 			" │           ├─ filters: [{[1, 1]}]\n" +
-			" │           └─ columns: [pk]\n" +
+			" │           ├─ columns: [pk]\n" +
+			" │           └─ limit: 1\n" +
 			" │   as (SELECT pk from one_pk where pk = 1 limit 1)]\n" +
 			" └─ Sort(t1.pk ASC, t2.pk2 ASC)\n" +
 			"     └─ CrossJoin\n" +
@@ -16861,7 +16906,8 @@ inner join pq on true
 			" │       └─ IndexedTableAccess(one_pk)\n" +
 			" │           ├─ index: [one_pk.pk]\n" +
 			" │           ├─ filters: [{[1, 1]}]\n" +
-			" │           └─ columns: [pk]\n" +
+			" │           ├─ columns: [pk]\n" +
+			" │           └─ limit: 1\n" +
 			" │   as (SELECT pk from one_pk where pk = 1 limit 1)]\n" +
 			" └─ Sort(t1.pk ASC, t2.pk2 ASC)\n" +
 			"     └─ CrossJoin\n" +
@@ -22378,6 +22424,7 @@ With c as (
 			"             ├─ index: [mytable.i]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             ├─ reverse: true\n" +
+			"             ├─ limit: 1\n" +
 			"             ├─ colSet: (1,2)\n" +
 			"             ├─ tableId: 1\n" +
 			"             └─ Table\n" +
@@ -22396,7 +22443,8 @@ With c as (
 			"             ├─ index: [mytable.i]\n" +
 			"             ├─ filters: [{[NULL, ∞)}]\n" +
 			"             ├─ columns: [i]\n" +
-			"             └─ reverse: true\n" +
+			"             ├─ reverse: true\n" +
+			"             └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "SubqueryAlias\n" +
 			" ├─ name: sq\n" +
@@ -22410,7 +22458,8 @@ With c as (
 			"             ├─ index: [mytable.i]\n" +
 			"             ├─ filters: [{[NULL, ∞)}]\n" +
 			"             ├─ columns: [i]\n" +
-			"             └─ reverse: true\n" +
+			"             ├─ reverse: true\n" +
+			"             └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -22438,6 +22487,7 @@ With c as (
 			"                 ├─ index: [mytable.i]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 ├─ reverse: true\n" +
+			"                 ├─ limit: 1\n" +
 			"                 ├─ colSet: (1,2)\n" +
 			"                 ├─ tableId: 1\n" +
 			"                 └─ Table\n" +
@@ -22461,7 +22511,8 @@ With c as (
 			"                 ├─ index: [mytable.i]\n" +
 			"                 ├─ filters: [{[NULL, ∞)}]\n" +
 			"                 ├─ columns: [i]\n" +
-			"                 └─ reverse: true\n" +
+			"                 ├─ reverse: true\n" +
+			"                 └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "SubqueryAlias\n" +
 			" ├─ name: sq2\n" +
@@ -22480,7 +22531,8 @@ With c as (
 			"                 ├─ index: [mytable.i]\n" +
 			"                 ├─ filters: [{[NULL, ∞)}]\n" +
 			"                 ├─ columns: [i]\n" +
-			"                 └─ reverse: true\n" +
+			"                 ├─ reverse: true\n" +
+			"                 └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -22876,6 +22928,7 @@ With c as (
 			" │           └─ IndexedTableAccess(one_pk)\n" +
 			" │               ├─ index: [one_pk.pk]\n" +
 			" │               ├─ static: [{(NULL, 2)}]\n" +
+			" │               ├─ limit: 1\n" +
 			" │               ├─ colSet: (1-6)\n" +
 			" │               ├─ tableId: 1\n" +
 			" │               └─ Table\n" +
@@ -22895,6 +22948,7 @@ With c as (
 			"             └─ IndexedTableAccess(mytable)\n" +
 			"                 ├─ index: [mytable.i]\n" +
 			"                 ├─ static: [{(1, ∞)}]\n" +
+			"                 ├─ limit: 1\n" +
 			"                 ├─ colSet: (8,9)\n" +
 			"                 ├─ tableId: 3\n" +
 			"                 └─ Table\n" +
@@ -22913,7 +22967,8 @@ With c as (
 			" │           └─ IndexedTableAccess(one_pk)\n" +
 			" │               ├─ index: [one_pk.pk]\n" +
 			" │               ├─ filters: [{(NULL, 2)}]\n" +
-			" │               └─ columns: [pk]\n" +
+			" │               ├─ columns: [pk]\n" +
+			" │               └─ limit: 1\n" +
 			" └─ HashLookup\n" +
 			"     ├─ left-key: ()\n" +
 			"     ├─ right-key: ()\n" +
@@ -22926,7 +22981,8 @@ With c as (
 			"             └─ IndexedTableAccess(mytable)\n" +
 			"                 ├─ index: [mytable.i]\n" +
 			"                 ├─ filters: [{(1, ∞)}]\n" +
-			"                 └─ columns: [i]\n" +
+			"                 ├─ columns: [i]\n" +
+			"                 └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "CrossHashJoin (estimated cost=402.250 rows=125) (actual rows=0 loops=1)\n" +
 			" ├─ SubqueryAlias\n" +
@@ -22940,7 +22996,8 @@ With c as (
 			" │           └─ IndexedTableAccess(one_pk)\n" +
 			" │               ├─ index: [one_pk.pk]\n" +
 			" │               ├─ filters: [{(NULL, 2)}]\n" +
-			" │               └─ columns: [pk]\n" +
+			" │               ├─ columns: [pk]\n" +
+			" │               └─ limit: 1\n" +
 			" └─ HashLookup\n" +
 			"     ├─ left-key: ()\n" +
 			"     ├─ right-key: ()\n" +
@@ -22953,7 +23010,8 @@ With c as (
 			"             └─ IndexedTableAccess(mytable)\n" +
 			"                 ├─ index: [mytable.i]\n" +
 			"                 ├─ filters: [{(1, ∞)}]\n" +
-			"                 └─ columns: [i]\n" +
+			"                 ├─ columns: [i]\n" +
+			"                 └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -23567,6 +23625,7 @@ WHERE keyless.c0 IN (
 			" └─ IndexedTableAccess(one_pk)\n" +
 			"     ├─ index: [one_pk.pk]\n" +
 			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     ├─ limit: 10\n" +
 			"     ├─ colSet: (1-6)\n" +
 			"     ├─ tableId: 1\n" +
 			"     └─ Table\n" +
@@ -23577,13 +23636,15 @@ WHERE keyless.c0 IN (
 			" └─ IndexedTableAccess(one_pk)\n" +
 			"     ├─ index: [one_pk.pk]\n" +
 			"     ├─ filters: [{[NULL, ∞)}]\n" +
-			"     └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"     ├─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"     └─ limit: 10\n" +
 			"",
 		ExpectedAnalysis: "Limit(10)\n" +
 			" └─ IndexedTableAccess(one_pk)\n" +
 			"     ├─ index: [one_pk.pk]\n" +
 			"     ├─ filters: [{[NULL, ∞)}]\n" +
-			"     └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"     ├─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"     └─ limit: 10\n" +
 			"",
 	},
 	{
@@ -23593,21 +23654,76 @@ WHERE keyless.c0 IN (
 			"     └─ IndexedTableAccess(one_pk)\n" +
 			"         ├─ index: [one_pk.pk]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"         ├─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"         └─ limit: 15\n" +
 			"",
 		ExpectedEstimates: "Limit(10)\n" +
 			" └─ Offset(5)\n" +
 			"     └─ IndexedTableAccess(one_pk)\n" +
 			"         ├─ index: [one_pk.pk]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"         ├─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"         └─ limit: 15\n" +
 			"",
 		ExpectedAnalysis: "Limit(10)\n" +
 			" └─ Offset(5)\n" +
 			"     └─ IndexedTableAccess(one_pk)\n" +
 			"         ├─ index: [one_pk.pk]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"         ├─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"         └─ limit: 15\n" +
+			"",
+	},
+	{
+		Query: `SELECT i, s FROM mytable ORDER BY s DESC LIMIT 2 OFFSET 1;`,
+		ExpectedPlan: "Limit(2)\n" +
+			" └─ Offset(1)\n" +
+			"     └─ IndexedTableAccess(mytable)\n" +
+			"         ├─ index: [mytable.s,mytable.i]\n" +
+			"         ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"         ├─ columns: [i s]\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 3\n" +
+			"",
+		ExpectedEstimates: "Limit(2)\n" +
+			" └─ Offset(1)\n" +
+			"     └─ IndexedTableAccess(mytable)\n" +
+			"         ├─ index: [mytable.s,mytable.i]\n" +
+			"         ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"         ├─ columns: [i s]\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 3\n" +
+			"",
+		ExpectedAnalysis: "Limit(2)\n" +
+			" └─ Offset(1)\n" +
+			"     └─ IndexedTableAccess(mytable)\n" +
+			"         ├─ index: [mytable.s,mytable.i]\n" +
+			"         ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"         ├─ columns: [i s]\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 3\n" +
+			"",
+	},
+	{
+		Query: `SELECT i, s FROM mytable ORDER BY s, i DESC LIMIT 2;`,
+		ExpectedPlan: "Limit(2)\n" +
+			" └─ TopN(Limit: [2 (bigint)]; mytable.s:1!null ASC nullsFirst, mytable.i:0!null DESC nullsFirst)\n" +
+			"     └─ ProcessTable\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: [i s]\n" +
+			"",
+		ExpectedEstimates: "Limit(2)\n" +
+			" └─ TopN(Limit: [2]; mytable.s ASC, mytable.i DESC)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         └─ columns: [i s]\n" +
+			"",
+		ExpectedAnalysis: "Limit(2)\n" +
+			" └─ TopN(Limit: [2]; mytable.s ASC, mytable.i DESC)\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         └─ columns: [i s]\n" +
 			"",
 	},
 	{
@@ -24101,6 +24217,7 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         ├─ reverse: true\n" +
+			"         ├─ limit: 1\n" +
 			"         ├─ colSet: (1,2)\n" +
 			"         ├─ tableId: 1\n" +
 			"         └─ Table\n" +
@@ -24114,7 +24231,8 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
 			"         ├─ columns: [x]\n" +
-			"         └─ reverse: true\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "Limit(1)\n" +
 			" └─ Project\n" +
@@ -24123,7 +24241,8 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
 			"         ├─ columns: [x]\n" +
-			"         └─ reverse: true\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -24134,6 +24253,7 @@ WHERE keyless.c0 IN (
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
+			"         ├─ limit: 1\n" +
 			"         ├─ colSet: (1,2)\n" +
 			"         ├─ tableId: 1\n" +
 			"         └─ Table\n" +
@@ -24146,7 +24266,8 @@ WHERE keyless.c0 IN (
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [x]\n" +
+			"         ├─ columns: [x]\n" +
+			"         └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "Limit(1)\n" +
 			" └─ Project\n" +
@@ -24154,7 +24275,8 @@ WHERE keyless.c0 IN (
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [x]\n" +
+			"         ├─ columns: [x]\n" +
+			"         └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -24197,6 +24319,7 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         ├─ reverse: true\n" +
+			"         ├─ limit: 1\n" +
 			"         ├─ colSet: (1,2)\n" +
 			"         ├─ tableId: 1\n" +
 			"         └─ Table\n" +
@@ -24210,7 +24333,8 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
 			"         ├─ columns: [x]\n" +
-			"         └─ reverse: true\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "Limit(1)\n" +
 			" └─ Project\n" +
@@ -24219,7 +24343,8 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
 			"         ├─ columns: [x]\n" +
-			"         └─ reverse: true\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -24231,6 +24356,7 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         ├─ reverse: true\n" +
+			"         ├─ limit: 1\n" +
 			"         ├─ colSet: (1,2)\n" +
 			"         ├─ tableId: 1\n" +
 			"         └─ Table\n" +
@@ -24244,7 +24370,8 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
 			"         ├─ columns: [x]\n" +
-			"         └─ reverse: true\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "Limit(1)\n" +
 			" └─ Project\n" +
@@ -24253,7 +24380,8 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
 			"         ├─ columns: [x]\n" +
-			"         └─ reverse: true\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -24265,6 +24393,7 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ static: [{[NULL, ∞)}]\n" +
 			"         ├─ reverse: true\n" +
+			"         ├─ limit: 1\n" +
 			"         ├─ colSet: (1,2)\n" +
 			"         ├─ tableId: 1\n" +
 			"         └─ Table\n" +
@@ -24278,7 +24407,8 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
 			"         ├─ columns: [x]\n" +
-			"         └─ reverse: true\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "Limit(1)\n" +
 			" └─ Project\n" +
@@ -24287,7 +24417,8 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{[NULL, ∞)}]\n" +
 			"         ├─ columns: [x]\n" +
-			"         └─ reverse: true\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -24298,6 +24429,7 @@ WHERE keyless.c0 IN (
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ static: [{(0, ∞)}]\n" +
+			"         ├─ limit: 1\n" +
 			"         ├─ colSet: (1,2)\n" +
 			"         ├─ tableId: 1\n" +
 			"         └─ Table\n" +
@@ -24310,7 +24442,8 @@ WHERE keyless.c0 IN (
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{(0, ∞)}]\n" +
-			"         └─ columns: [x]\n" +
+			"         ├─ columns: [x]\n" +
+			"         └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "Limit(1)\n" +
 			" └─ Project\n" +
@@ -24318,7 +24451,8 @@ WHERE keyless.c0 IN (
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{(0, ∞)}]\n" +
-			"         └─ columns: [x]\n" +
+			"         ├─ columns: [x]\n" +
+			"         └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -24330,6 +24464,7 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ static: [{(NULL, 3)}]\n" +
 			"         ├─ reverse: true\n" +
+			"         ├─ limit: 1\n" +
 			"         ├─ colSet: (1,2)\n" +
 			"         ├─ tableId: 1\n" +
 			"         └─ Table\n" +
@@ -24343,7 +24478,8 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{(NULL, 3)}]\n" +
 			"         ├─ columns: [x]\n" +
-			"         └─ reverse: true\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "Limit(1)\n" +
 			" └─ Project\n" +
@@ -24352,7 +24488,8 @@ WHERE keyless.c0 IN (
 			"         ├─ index: [xy.x]\n" +
 			"         ├─ filters: [{(NULL, 3)}]\n" +
 			"         ├─ columns: [x]\n" +
-			"         └─ reverse: true\n" +
+			"         ├─ reverse: true\n" +
+			"         └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -24445,6 +24582,7 @@ WHERE keyless.c0 IN (
 			"             ├─ index: [xy.x]\n" +
 			"             ├─ static: [{[NULL, ∞)}]\n" +
 			"             ├─ reverse: true\n" +
+			"             ├─ limit: 1\n" +
 			"             ├─ colSet: (1,2)\n" +
 			"             ├─ tableId: 1\n" +
 			"             └─ Table\n" +
@@ -24463,7 +24601,8 @@ WHERE keyless.c0 IN (
 			"             ├─ index: [xy.x]\n" +
 			"             ├─ filters: [{[NULL, ∞)}]\n" +
 			"             ├─ columns: [x]\n" +
-			"             └─ reverse: true\n" +
+			"             ├─ reverse: true\n" +
+			"             └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "SubqueryAlias\n" +
 			" ├─ name: sq\n" +
@@ -24477,7 +24616,8 @@ WHERE keyless.c0 IN (
 			"             ├─ index: [xy.x]\n" +
 			"             ├─ filters: [{[NULL, ∞)}]\n" +
 			"             ├─ columns: [x]\n" +
-			"             └─ reverse: true\n" +
+			"             ├─ reverse: true\n" +
+			"             └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -24498,6 +24638,7 @@ WHERE keyless.c0 IN (
 			"                 ├─ index: [xy.x]\n" +
 			"                 ├─ static: [{[NULL, ∞)}]\n" +
 			"                 ├─ reverse: true\n" +
+			"                 ├─ limit: 1\n" +
 			"                 ├─ colSet: (1,2)\n" +
 			"                 ├─ tableId: 1\n" +
 			"                 └─ Table\n" +
@@ -24518,7 +24659,8 @@ WHERE keyless.c0 IN (
 			"                 ├─ index: [xy.x]\n" +
 			"                 ├─ filters: [{[NULL, ∞)}]\n" +
 			"                 ├─ columns: [x]\n" +
-			"                 └─ reverse: true\n" +
+			"                 ├─ reverse: true\n" +
+			"                 └─ limit: 1\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [(cte.i + 100) as i + 100]\n" +
@@ -24534,7 +24676,8 @@ WHERE keyless.c0 IN (
 			"                 ├─ index: [xy.x]\n" +
 			"                 ├─ filters: [{[NULL, ∞)}]\n" +
 			"                 ├─ columns: [x]\n" +
-			"                 └─ reverse: true\n" +
+			"                 ├─ reverse: true\n" +
+			"                 └─ limit: 1\n" +
 			"",
 	},
 	{
@@ -25354,37 +25497,42 @@ order by x, y;
 	},
 	{
 		Query: `SELECT /*+ LOOKUP_JOIN(xy,mytable) JOIN_ORDER(xy,mytable) */ * FROM xy INNER JOIN mytable ON ((xy.x)=(mytable.s));`,
-		ExpectedPlan: "LookupJoin\n" +
-			" ├─ ProcessTable\n" +
-			" │   └─ Table\n" +
-			" │       ├─ name: xy\n" +
-			" │       └─ columns: [x y]\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.s]\n" +
-			"     ├─ keys: [xy.x:0!null]\n" +
-			"     ├─ colSet: (3,4)\n" +
-			"     ├─ tableId: 2\n" +
-			"     └─ Table\n" +
-			"         ├─ name: mytable\n" +
-			"         └─ columns: [i s]\n" +
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [xy.x:2!null, xy.y:3, mytable.i:0!null, mytable.s:1!null]\n" +
+			" └─ InnerJoin\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ xy.x:2!null\n" +
+			"     │   └─ mytable.s:1!null\n" +
+			"     ├─ ProcessTable\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [i s]\n" +
+			"     └─ ProcessTable\n" +
+			"         └─ Table\n" +
+			"             ├─ name: xy\n" +
+			"             └─ columns: [x y]\n" +
 			"",
-		ExpectedEstimates: "LookupJoin (estimated cost=1006.900 rows=3)\n" +
-			" ├─ Table\n" +
-			" │   ├─ name: xy\n" +
-			" │   └─ columns: [x y]\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.s]\n" +
-			"     ├─ columns: [i s]\n" +
-			"     └─ keys: xy.x\n" +
+		ExpectedEstimates: "Project\n" +
+			" ├─ columns: [xy.x, xy.y, mytable.i, mytable.s]\n" +
+			" └─ InnerJoin (estimated cost=3031.000 rows=3)\n" +
+			"     ├─ (xy.x = mytable.s)\n" +
+			"     ├─ Table\n" +
+			"     │   ├─ name: mytable\n" +
+			"     │   └─ columns: [i s]\n" +
+			"     └─ Table\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
-		ExpectedAnalysis: "LookupJoin (estimated cost=1006.900 rows=3) (actual rows=0 loops=1)\n" +
-			" ├─ Table\n" +
-			" │   ├─ name: xy\n" +
-			" │   └─ columns: [x y]\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.s]\n" +
-			"     ├─ columns: [i s]\n" +
-			"     └─ keys: xy.x\n" +
+		ExpectedAnalysis: "Project\n" +
+			" ├─ columns: [xy.x, xy.y, mytable.i, mytable.s]\n" +
+			" └─ InnerJoin (estimated cost=3031.000 rows=3) (actual rows=3 loops=1)\n" +
+			"     ├─ (xy.x = mytable.s)\n" +
+			"     ├─ Table\n" +
+			"     │   ├─ name: mytable\n" +
+			"     │   └─ columns: [i s]\n" +
+			"     └─ Table\n" +
+			"         ├─ name: xy\n" +
+			"         └─ columns: [x y]\n" +
 			"",
 	},
 }
@@ -119,6 +119,62 @@ var RegexTests = []RegexTest{
 			{"XXXXX XXX"},
 		},
 	},
+	{
+		Query:    `SELECT REGEXP_INSTR("dog cat dog", "dog")`,
+		Expected: []sql.Row{{1}},
+	},
+	{
+		Query:    `SELECT REGEXP_INSTR("dog cat dog", "dog", 2)`,
+		Expected: []sql.Row{{9}},
+	},
+	{
+		Query:    `SELECT REGEXP_INSTR("dog cat dog", "dog", 1, 2, 1)`,
+		Expected: []sql.Row{{12}},
+	},
+	{
+		Query:    `SELECT REGEXP_INSTR("DOG cat dog", "dog", 1, 1, 0, "c")`,
+		Expected: []sql.Row{{9}},
+	},
+	{
+		Query:    `SELECT REGEXP_INSTR("😀😀abc😀", "abc")`,
+		Expected: []sql.Row{{3}},
+	},
+	{
+		Query:       `SELECT REGEXP_INSTR("dog cat dog", "dog", 1, 1, 2)`,
+		ExpectedErr: sql.ErrInvalidArgument,
+	},
+	{
+		Query: `SELECT REGEXP_INSTR(s, "row") from mytable`,
+		Expected: []sql.Row{
+			{7},
+			{8},
+			{7},
+		},
+	},
+	{
+		Query:    `SELECT REGEXP_SUBSTR("abc def ghi", "[a-z]+", 1, 3)`,
+		Expected: []sql.Row{{"ghi"}},
+	},
+	{
+		Query:    `SELECT REGEXP_SUBSTR("abc def ghi", "[a-z]+", 1, 4)`,
+		Expected: []sql.Row{{nil}},
+	},
+	{
+		Query:    `SELECT REGEXP_SUBSTR("ABC def", "[a-z]+", 1, 1, "i")`,
+		Expected: []sql.Row{{"ABC"}},
+	},
+	{
+		Query:    `SELECT REGEXP_SUBSTR("😀é😀wörld", "w.r")`,
+		Expected: []sql.Row{{"wör"}},
+	},
+	{
+		Query: `SELECT REGEXP_SUBSTR(s, "[a-z]+", 1, 2) from mytable`,
+		Expected: []sql.Row{
+			{"row"},
+			{"row"},
+			{"row"},
+		},
+	},
 	{
 		Query:    `SELECT 20 REGEXP '^[-]?2[0-9]+$'`,
 		Expected: []sql.Row{{true}},
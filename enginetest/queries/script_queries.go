@@ -18,6 +18,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/sqltypes"
 	querypb "github.com/dolthub/vitess/go/vt/proto/query"
 	"gopkg.in/src-d/go-errors.v1"
@@ -121,6 +122,263 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "MAX_EXECUTION_TIME optimizer hint",
+		SetUpScript: []string{
+			"create table t (i int primary key);",
+			"insert into t values (1), (2), (3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "select /*+ MAX_EXECUTION_TIME(100) */ sleep(5)",
+				ExpectedErr: sql.ErrQueryTimeout,
+			},
+			{
+				Query:       "select /*+ MAX_EXECUTION_TIME(100) */ i, sleep(1) from t",
+				ExpectedErr: sql.ErrQueryTimeout,
+			},
+			{
+				Query:    "select /*+ MAX_EXECUTION_TIME(10000) */ i, sleep(0.01) from t order by i",
+				Expected: []sql.Row{{1, 0}, {2, 0}, {3, 0}},
+			},
+			{
+				// a timeout of zero means no limit
+				Query:    "select /*+ MAX_EXECUTION_TIME(0) */ sleep(0.2)",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				// malformed hints are ignored, like other optimizer hints
+				Query:       "select /*+ MAX_EXECUTION_TIME(-1) MAX_EXECUTION_TIME(1.5) MAX_EXECUTION_TIME(100) */ sleep(5)",
+				ExpectedErr: sql.ErrQueryTimeout,
+			},
+			{
+				// the hint only applies to the statement it is attached to
+				Query:    "select sleep(0.2)",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "SET_VAR optimizer hint",
+		SetUpScript: []string{
+			"create table parent (i int primary key);",
+			"create table child (i int primary key, p int, foreign key (p) references parent (i));",
+			"insert into parent values (1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select @@sort_buffer_size",
+				Expected: []sql.Row{{uint64(262144)}},
+			},
+			{
+				Query:    "select /*+ SET_VAR(sort_buffer_size=16M) */ @@sort_buffer_size",
+				Expected: []sql.Row{{uint64(16777216)}},
+			},
+			{
+				// the variable is restored once the statement completes
+				Query:    "select @@sort_buffer_size",
+				Expected: []sql.Row{{uint64(262144)}},
+			},
+			{
+				Query:    "select /*+ SET_VAR(sort_buffer_size = 1048576) SET_VAR(sql_mode = 'ANSI_QUOTES') */ @@sort_buffer_size, @@sql_mode",
+				Expected: []sql.Row{{uint64(1048576), "ANSI_QUOTES"}},
+			},
+			{
+				Query:    "select @@sort_buffer_size, @@sql_mode",
+				Expected: []sql.Row{{uint64(262144), "NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES"}},
+			},
+			{
+				// only the first hint for a variable is honored
+				Query:           "select /*+ SET_VAR(sort_buffer_size=1M) SET_VAR(sort_buffer_size=2M) */ @@sort_buffer_size",
+				Expected:        []sql.Row{{uint64(1048576)}},
+				ExpectedWarning: 3126,
+			},
+			{
+				// hints are only honored on the top-level statement, not on subqueries
+				Query:    "select (select /*+ SET_VAR(sort_buffer_size=1M) */ @@sort_buffer_size)",
+				Expected: []sql.Row{{uint64(262144)}},
+			},
+			{
+				Query:    "select /*+ SET_VAR(sort_buffer_size=1M) */ (select /*+ SET_VAR(sort_buffer_size=2M) */ @@sort_buffer_size)",
+				Expected: []sql.Row{{uint64(1048576)}},
+			},
+			{
+				Query:           "select /*+ SET_VAR(no_such_variable=1) */ 1",
+				Expected:        []sql.Row{{1}},
+				ExpectedWarning: mysql.ERUnknownSystemVariable,
+			},
+			{
+				Query:       "insert into child values (1, 2)",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:    "insert /*+ SET_VAR(foreign_key_checks=OFF) */ into child values (1, 2)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select @@foreign_key_checks",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:       "insert into child values (2, 3)",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+		},
+	},
+	{
+		Name: "NO_MERGE optimizer hint",
+		SetUpScript: []string{
+			"create table t (i int primary key, j int);",
+			"insert into t values (1, 10), (2, 20), (3, 30);",
+			"create view v as select i, j from t;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// without the hint, the outer filter is merged into the derived table
+				Query: "explain select * from (select i, j from t) dt where dt.j = 20",
+				Expected: []sql.Row{
+					{"SubqueryAlias"},
+					{" ├─ name: dt"},
+					{" ├─ outerVisibility: false"},
+					{" ├─ isLateral: false"},
+					{" ├─ cacheable: true"},
+					{" └─ Filter"},
+					{"     ├─ (t.j = 20)"},
+					{"     └─ Table"},
+					{"         ├─ name: t"},
+					{"         └─ columns: [i j]"},
+				},
+			},
+			{
+				Query: "explain select /*+ NO_MERGE(dt) */ * from (select i, j from t) dt where dt.j = 20",
+				Expected: []sql.Row{
+					{"Filter"},
+					{" ├─ (dt.j = 20)"},
+					{" └─ SubqueryAlias"},
+					{"     ├─ name: dt"},
+					{"     ├─ outerVisibility: false"},
+					{"     ├─ isLateral: false"},
+					{"     ├─ cacheable: true"},
+					{"     ├─ materialized: true"},
+					{"     └─ Table"},
+					{"         ├─ name: t"},
+					{"         └─ columns: [i j]"},
+				},
+			},
+			{
+				// a hint without table names applies to every derived table in the query block
+				Query: "explain select /*+ NO_MERGE() */ * from (select i, j from t) dt where dt.j = 20",
+				Expected: []sql.Row{
+					{"Filter"},
+					{" ├─ (dt.j = 20)"},
+					{" └─ SubqueryAlias"},
+					{"     ├─ name: dt"},
+					{"     ├─ outerVisibility: false"},
+					{"     ├─ isLateral: false"},
+					{"     ├─ cacheable: true"},
+					{"     ├─ materialized: true"},
+					{"     └─ Table"},
+					{"         ├─ name: t"},
+					{"         └─ columns: [i j]"},
+				},
+			},
+			{
+				Query: "explain select /*+ NO_MERGE(other) */ * from (select i, j from t) dt where dt.j = 20",
+				Expected: []sql.Row{
+					{"SubqueryAlias"},
+					{" ├─ name: dt"},
+					{" ├─ outerVisibility: false"},
+					{" ├─ isLateral: false"},
+					{" ├─ cacheable: true"},
+					{" └─ Filter"},
+					{"     ├─ (t.j = 20)"},
+					{"     └─ Table"},
+					{"         ├─ name: t"},
+					{"         └─ columns: [i j]"},
+				},
+			},
+			{
+				Query: "explain select /*+ NO_MERGE(v) */ * from v where v.j = 20",
+				Expected: []sql.Row{
+					{"Filter"},
+					{" ├─ (v.j = 20)"},
+					{" └─ SubqueryAlias"},
+					{"     ├─ name: v"},
+					{"     ├─ outerVisibility: false"},
+					{"     ├─ isLateral: false"},
+					{"     ├─ cacheable: true"},
+					{"     ├─ materialized: true"},
+					{"     └─ Table"},
+					{"         ├─ name: t"},
+					{"         └─ columns: [i j]"},
+				},
+			},
+			{
+				Query:    "select /*+ NO_MERGE(dt) */ * from (select i, j from t) dt where dt.j = 20",
+				Expected: []sql.Row{{2, 20}},
+			},
+			{
+				Query:    "select /*+ NO_MERGE(v) */ * from v where v.j = 20",
+				Expected: []sql.Row{{2, 20}},
+			},
+		},
+	},
+	{
+		Name: "NOT IN subquery with NULLs",
+		SetUpScript: []string{
+			"create table a (x int);",
+			"create table b (y int);",
+			"create table c (y int primary key);",
+			"insert into a values (1), (2), (null);",
+			"insert into b values (1), (null);",
+			"insert into c values (1), (3);",
+			"create table pairs (x int, z int);",
+			"insert into pairs values (1, 1), (2, 2), (1, null);",
+			"create table nullpairs (y int, w int);",
+			"insert into nullpairs values (1, null), (3, 3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// a NULL in the subquery makes every non-matching comparison unknown
+				Query:    "select x from a where x not in (select y from b)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select x from a where not (x in (select y from b))",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select x, x not in (select y from b), x in (select y from b) from a order by x",
+				Expected: []sql.Row{{nil, nil, nil}, {1, false, true}, {2, nil, nil}},
+			},
+			{
+				// without NULLs in the subquery, only a NULL on the left is unknown
+				Query:    "select x from a where x not in (select y from b where y is not null) order by x",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select x from a where x not in (select y from c) order by x",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select x, x not in (select y from c) from a order by x",
+				Expected: []sql.Row{{nil, nil}, {1, false}, {2, true}},
+			},
+			{
+				// NULL NOT IN an empty result is true
+				Query:    "select x from a where x not in (select y from b where y > 5) order by x",
+				Expected: []sql.Row{{nil}, {1}, {2}},
+			},
+			{
+				Query:    "select x, z, (x, z) not in (select y, w from nullpairs) from pairs order by x, z",
+				Expected: []sql.Row{{1, nil, nil}, {1, 1, nil}, {2, 2, true}},
+			},
+			{
+				Query:    "select x, z from pairs where (x, z) not in (select y, w from nullpairs) order by x, z",
+				Expected: []sql.Row{{2, 2}},
+			},
+		},
+	},
 	{
 		Name: "GMS issue 2349",
 		SetUpScript: []string{
@@ -211,6 +469,123 @@ CREATE TABLE tab3 (
 			},
 		},
 	},
+	{
+		Name: "set op type unification",
+		SetUpScript: []string{
+			"create table a (i int, v varchar(10), t tinyint, d decimal(5,2), dt datetime, f float, u int unsigned);",
+			"create table b (i int, v varchar(20), t bigint, d decimal(10,4), dt date, f double, u int);",
+			"insert into a values (1, '0123456789', 1, 123.45, '2020-01-01 10:00:00', 1.5, 4000000000);",
+			"insert into b values (2, '01234567890123456789', 9223372036854775807, 123456.1234, '2021-01-01', 2.5, -1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select v from a union select v from b order by 1",
+				ExpectedColumns: sql.Schema{
+					{
+						Name: "v",
+						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
+					},
+				},
+				Expected: []sql.Row{{"0123456789"}, {"01234567890123456789"}},
+			},
+			{
+				Query: "select i from a union select v from b order by 1",
+				ExpectedColumns: sql.Schema{
+					{
+						Name: "i",
+						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
+					},
+				},
+				Expected: []sql.Row{{"01234567890123456789"}, {"1"}},
+			},
+			{
+				Query: "select t from a union select t from b order by 1",
+				ExpectedColumns: sql.Schema{
+					{
+						Name: "t",
+						Type: types.Int64,
+					},
+				},
+				Expected: []sql.Row{{1}, {9223372036854775807}},
+			},
+			{
+				Query: "select u from a union select u from b order by 1",
+				ExpectedColumns: sql.Schema{
+					{
+						Name: "u",
+						Type: types.Int64,
+					},
+				},
+				Expected: []sql.Row{{-1}, {4000000000}},
+			},
+			{
+				Query: "select d from a union select d from b order by 1",
+				ExpectedColumns: sql.Schema{
+					{
+						Name: "d",
+						Type: types.MustCreateDecimalType(10, 4),
+					},
+				},
+				Expected: []sql.Row{{"123.4500"}, {"123456.1234"}},
+			},
+			{
+				Query: "select f from a union select f from b order by 1",
+				ExpectedColumns: sql.Schema{
+					{
+						Name: "f",
+						Type: types.Float64,
+					},
+				},
+				Expected: []sql.Row{{1.5}, {2.5}},
+			},
+			{
+				Query: "select dt from a union select dt from b order by 1",
+				ExpectedColumns: sql.Schema{
+					{
+						Name: "dt",
+						Type: types.Datetime,
+					},
+				},
+				Expected: []sql.Row{
+					{time.Date(2020, time.January, 1, 10, 0, 0, 0, time.UTC)},
+					{time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			{
+				Query: "select dt from b union select i from a order by 1",
+				ExpectedColumns: sql.Schema{
+					{
+						Name: "dt",
+						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 11),
+					},
+				},
+				Expected: []sql.Row{{"1"}, {"2021-01-01"}},
+			},
+			{
+				Query:    "create table c as select v, i, t, d, dt, f, u from a union all select v, v, t, d, dt, f, u from b;",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query: "show create table c",
+				Expected: []sql.Row{{"c", "CREATE TABLE `c` (\n" +
+					"  `v` varchar(20),\n" +
+					"  `i` varchar(20),\n" +
+					"  `t` bigint,\n" +
+					"  `d` decimal(10,4),\n" +
+					"  `dt` datetime,\n" +
+					"  `f` double,\n" +
+					"  `u` bigint\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query: "select * from c order by t",
+				Expected: []sql.Row{
+					{"0123456789", "1", 1, "123.4500", time.Date(2020, time.January, 1, 10, 0, 0, 0, time.UTC), 1.5, 4000000000},
+					{"01234567890123456789", "01234567890123456789", 9223372036854775807, "123456.1234", time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), 2.5, -1},
+				},
+			},
+		},
+	},
 	{
 		Name: "set op schema merge",
 		SetUpScript: []string{
@@ -578,6 +953,62 @@ CREATE TABLE tab3 (
 					{2, 3},
 				},
 			},
+			{
+				Query: "select * from a except (select * from b intersect select * from c) order by m;",
+				Expected: []sql.Row{
+					{1, 2},
+					{2, 3},
+				},
+			},
+			{
+				Query: "(select * from a intersect select * from b) union select * from c order by m, n;",
+				Expected: []sql.Row{
+					{1, 2},
+					{1, 3},
+					{3, 4},
+				},
+			},
+			{
+				Query: "select * from l except all (select * from r intersect all select * from l);",
+				Expected: []sql.Row{
+					{1},
+					{1},
+				},
+			},
+			{
+				Query: "(select * from l except all select * from r) intersect all select * from l;",
+				Expected: []sql.Row{
+					{1},
+					{1},
+				},
+			},
+
+			// Result column types are unified across both sides
+			{
+				Query: "select i from x intersect select i from t2 order by i;",
+				Expected: []sql.Row{
+					{float64(1)},
+					{float64(3)},
+				},
+				ExpectedColumns: sql.Schema{
+					{Name: "i", Type: types.Float64},
+				},
+			},
+			{
+				Query: "select i from x except all select '2' order by i;",
+				Expected: []sql.Row{
+					{"1"},
+					{"3"},
+				},
+			},
+			{
+				Query:       "table a intersect table x;",
+				ExpectedErr: planbuilder.ErrUnionSchemasDifferentLength,
+			},
+			{
+				Query:       "table a except table x;",
+				ExpectedErr: planbuilder.ErrUnionSchemasDifferentLength,
+			},
 
 			// CTE tests
 			{
@@ -636,39 +1067,98 @@ CREATE TABLE tab3 (
 		},
 	},
 	{
-		Name: "create table casing",
+		Name: "recursive cte union distinct over graph cycles",
 		SetUpScript: []string{
-			"create table t (lower varchar(20) primary key, UPPER varchar(20), MiXeD varchar(20), un_der varchar(20), `da-sh` varchar(20));",
-			"insert into t values ('a','b','c','d','e')",
+			"create table edges (src int, dst int);",
+			"insert into edges values (1, 2), (2, 3), (3, 1), (3, 4), (4, 4), (2, 1);",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query: `select * from t`,
-				ExpectedColumns: sql.Schema{
-					{
-						Name: "lower",
-						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
-					},
-					{
-						Name: "UPPER",
-						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
-					},
-					{
-						Name: "MiXeD",
-						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
-					},
-					{
-						Name: "un_der",
-						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
-					},
-					{
-						Name: "da-sh",
-						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
-					},
-				},
-				Expected: []sql.Row{{"a", "b", "c", "d", "e"}},
+				Query:    "with recursive r(n) as (select 1 union select dst from edges join r on src = n) select n from r order by n;",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
 			},
-		},
+			{
+				// the anchor produces duplicate seed rows
+				Query:    "with recursive r(n) as (select src from edges union select dst from edges join r on src = n) select n from r order by n;",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "with recursive r(n) as (select 4 union all select 4 union select dst from edges join r on src = n) select n from r order by n;",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "with recursive tc(a, b) as (select src, dst from edges union select tc.a, e.dst from tc join edges e on tc.b = e.src) select count(*) from tc;",
+				Expected: []sql.Row{{13}},
+			},
+			{
+				Query:    "with recursive tc(a, b) as (select src, dst from edges union select tc.a, e.dst from tc join edges e on tc.b = e.src) select b from tc where a = 4;",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:       "with recursive r(n) as (select 1 union all select dst from edges join r on src = n where (src, dst) in ((1, 2), (2, 3), (3, 1))) select count(*) from r;",
+				ExpectedErr: sql.ErrCteRecursionLimitExceeded,
+			},
+			{
+				Query:          "with recursive r(n) as (select 1 union all select n + 1 from r where n < 1001) select count(*) from r;",
+				ExpectedErrStr: "Recursive query aborted after 1001 iterations. Try increasing @@cte_max_recursion_depth to a larger value.",
+			},
+			{
+				Query:    "with recursive r(n) as (select 1 union all select n + 1 from r where n < 1000) select count(*) from r;",
+				Expected: []sql.Row{{1000}},
+			},
+			{
+				Query:    "set @@cte_max_recursion_depth = 5;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "with recursive r(n) as (select 1 union all select n + 1 from r where n < 5) select count(*) from r;",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:       "with recursive r(n) as (select 1 union all select n + 1 from r where n < 6) select count(*) from r;",
+				ExpectedErr: sql.ErrCteRecursionLimitExceeded,
+			},
+			{
+				// cycles still terminate when every row of the recursive part is a duplicate
+				Query:    "with recursive r(n) as (select 1 union select dst from edges join r on src = n) select count(*) from r;",
+				Expected: []sql.Row{{4}},
+			},
+		},
+	},
+	{
+		Name: "create table casing",
+		SetUpScript: []string{
+			"create table t (lower varchar(20) primary key, UPPER varchar(20), MiXeD varchar(20), un_der varchar(20), `da-sh` varchar(20));",
+			"insert into t values ('a','b','c','d','e')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: `select * from t`,
+				ExpectedColumns: sql.Schema{
+					{
+						Name: "lower",
+						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
+					},
+					{
+						Name: "UPPER",
+						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
+					},
+					{
+						Name: "MiXeD",
+						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
+					},
+					{
+						Name: "un_der",
+						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
+					},
+					{
+						Name: "da-sh",
+						Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20),
+					},
+				},
+				Expected: []sql.Row{{"a", "b", "c", "d", "e"}},
+			},
+		},
 	},
 	{
 		Name: "alter table out of range value error of column type change",
@@ -1304,6 +1794,28 @@ CREATE TABLE tab3 (
 				Query:          `SELECT id FROM tab1 WHERE id > 3 UNION select s INTO @mustSingleVar FROM tab2 WHERE s < 'f' ORDER BY s DESC`,
 				ExpectedErrStr: "INTO clause is not allowed at position 98 near 'ORDER'",
 			},
+			{
+				Query:    `SELECT v1, id FROM tab1 WHERE id = 2 INTO @myV1, @myId`,
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    `SELECT @myV1, @myId`,
+				Expected: []sql.Row{{3, 2}},
+			},
+			{
+				// no rows leaves the variables unchanged, so unset variables are still NULL
+				Query:           `SELECT id, v1 FROM tab1 WHERE id > 10 INTO @noId, @myV1`,
+				Expected:        []sql.Row{{}},
+				ExpectedWarning: 1329,
+			},
+			{
+				Query:    `SELECT @noId, @myV1`,
+				Expected: []sql.Row{{nil, 3}},
+			},
+			{
+				Query:       `SELECT id, v1 FROM tab1 WHERE id > 10 INTO @noId`,
+				ExpectedErr: sql.ErrColumnNumberDoesNotMatch,
+			},
 		},
 	},
 	{
@@ -2121,6 +2633,110 @@ CREATE TABLE tab3 (
 			},
 		},
 	},
+	{
+		Name: "Group Concat ORDER BY multiple columns",
+		SetUpScript: []string{
+			"create table gc (g int, a int, b varchar(10), c int)",
+			"insert into gc values (1, 1, 'x', 3), (1, 1, 'y', 1), (1, 2, 'x', 2), (1, 2, 'y', 4), (1, 1, 'x', 5)",
+			"insert into gc values (2, 3, 'z', NULL), (2, NULL, 'w', 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select g, group_concat(b order by a desc, b asc) from gc group by g order by g",
+				Expected: []sql.Row{{1, "x,y,x,x,y"}, {2, "z,w"}},
+			},
+			{
+				Query:    "select g, group_concat(c order by a asc, b desc, c desc) from gc group by g order by g",
+				Expected: []sql.Row{{1, "1,5,3,4,2"}, {2, "1"}},
+			},
+			{
+				Query:    "select g, group_concat(c order by b, c separator '') from gc group by g order by g",
+				Expected: []sql.Row{{1, "23514"}, {2, "1"}},
+			},
+			{
+				Query:    "select g, group_concat(distinct b order by b desc separator '|') from gc group by g order by g",
+				Expected: []sql.Row{{1, "y|x"}, {2, "z|w"}},
+			},
+			{
+				Query:    "select g, group_concat(distinct a, b order by a, b desc separator ';') from gc group by g order by g",
+				Expected: []sql.Row{{1, "1y;1x;2y;2x"}, {2, "3z"}},
+			},
+			{
+				Query:    "select group_concat(a, '-', b order by a desc, b) from gc",
+				Expected: []sql.Row{{"3-z,2-x,2-y,1-x,1-x,1-y"}},
+			},
+			{
+				Query:    "set group_concat_max_len = 6",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:                 "select group_concat(b order by a, b) from gc",
+				Expected:              []sql.Row{{"w,x,x,"}},
+				ExpectedWarning:       1260,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:    "select group_concat(distinct b order by b) from gc where g = 1",
+				Expected: []sql.Row{{"x,y"}},
+			},
+		},
+	},
+	{
+		Name: "Group Concat and JSON aggregation result limits",
+		SetUpScript: []string{
+			"create table t (i int primary key, s varchar(2000))",
+			"insert into t values (1, 'é'), (2, 'é'), (3, 'é')",
+			"insert into t select i + 3, repeat('a', 1000) from t",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "set group_concat_max_len = 4",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:                 "select group_concat(s order by i) from t where i <= 3",
+				Expected:              []sql.Row{{"é,"}},
+				ExpectedWarning:       1260,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:                 "select group_concat(v) from (select 'aaañ' as v) sq",
+				Expected:              []sql.Row{{"aaa"}},
+				ExpectedWarning:       1260,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:    "set group_concat_max_len = 5",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:                 "select group_concat(s order by i desc) from t where i <= 3",
+				Expected:              []sql.Row{{"é,é"}},
+				ExpectedWarning:       1260,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:    "set max_allowed_packet = 2048",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select json_length(json_arrayagg(s)) from t where i <= 5",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:                 "select json_arrayagg(s) from t",
+				Expected:              []sql.Row{{nil}},
+				ExpectedWarning:       1301,
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:                 "select json_objectagg(i, s) from t",
+				Expected:              []sql.Row{{nil}},
+				ExpectedWarning:       1301,
+				ExpectedWarningsCount: 1,
+			},
+		},
+	},
 	{
 		Name: "CONVERT USING still converts between incompatible character sets",
 		SetUpScript: []string{
@@ -2666,7 +3282,58 @@ CREATE TABLE tab3 (
 			},
 			{
 				Query:       "INSERT INTO small_test VALUES (12.1);",
-				ExpectedErr: types.ErrConvertToDecimalLimit,
+				ExpectedErr: sql.ErrValueOutOfRangeForColumn,
+			},
+		},
+	},
+	{
+		Name: "TIMESTAMP fractional seconds precision",
+		SetUpScript: []string{
+			"create table t (pk int primary key, ts6 timestamp(6), ts3 timestamp(3), ts timestamp, index (ts6));",
+			"insert into t values (1, '2024-01-02 03:04:05.123456', '2024-01-02 03:04:05.123456', '2024-01-02 03:04:05.123456');",
+			"insert into t values (2, '2024-01-02 03:04:05.123457', '2024-01-02 03:04:05.1', '2024-01-02 03:04:05');",
+			"insert into t values (3, '2024-01-02 03:04:05.000001', '2024-01-02 03:04:05', '2024-01-02 03:04:06');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select pk, ts6, ts3, ts from t order by ts6;",
+				Expected: []sql.Row{
+					{3, time.Date(2024, 1, 2, 3, 4, 5, 1000, time.UTC), time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC)},
+					{1, time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC), time.Date(2024, 1, 2, 3, 4, 5, 123000000, time.UTC), time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+					{2, time.Date(2024, 1, 2, 3, 4, 5, 123457000, time.UTC), time.Date(2024, 1, 2, 3, 4, 5, 100000000, time.UTC), time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+				},
+			},
+			{
+				Query:    "select pk from t where ts6 = '2024-01-02 03:04:05.123456';",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select pk from t where ts6 > '2024-01-02 03:04:05.123456' order by pk;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select pk from t where ts3 = '2024-01-02 03:04:05.123' order by pk;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select count(distinct ts6), count(distinct ts) from t;",
+				Expected: []sql.Row{{3, 2}},
+			},
+			{
+				Query:    "select date_format(ts6, '%f') from t where pk = 1;",
+				Expected: []sql.Row{{"123456"}},
+			},
+			{
+				Query:    "select microsecond(current_timestamp(6)) = microsecond(now(6)), microsecond(current_timestamp()), microsecond(current_timestamp);",
+				Expected: []sql.Row{{true, uint64(0), uint64(0)}},
+			},
+			{
+				Query:          "select current_timestamp(7);",
+				ExpectedErrStr: "Too-big precision 7 for 'now'. Maximum is 6.",
+			},
+			{
+				Query:          "create table bad (ts timestamp(7));",
+				ExpectedErrStr: "TIMESTAMP supports precision from 0 to 6",
 			},
 		},
 	},
@@ -3198,190 +3865,879 @@ CREATE TABLE tab3 (
 				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:    "SHOW CREATE TABLE test;",
-				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v2` varchar(255) COLLATE utf8mb4_bin NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "SHOW CREATE TABLE test;",
+				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v2` varchar(255) COLLATE utf8mb4_bin NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "CREATE TABLE test2 (pk BIGINT PRIMARY KEY, v1 VARCHAR(255) CHARACTER SET utf8mb4 BINARY);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE test2;",
+				Expected: []sql.Row{{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) COLLATE utf8mb4_bin,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "sum() and avg() on DECIMAL type column returns the DECIMAL type result",
+		SetUpScript: []string{
+			"create table decimal_table (id int, val decimal(18,16));",
+			"insert into decimal_table values (1,-2.5633000000000384);",
+			"insert into decimal_table values (2,2.5633000000000370);",
+			"insert into decimal_table values (3,0.0000000000000004);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT val FROM decimal_table;",
+				Expected: []sql.Row{{"-2.5633000000000384"}, {"2.5633000000000370"}, {"0.0000000000000004"}},
+			},
+			{
+				Query:    "SELECT sum(val) FROM decimal_table;",
+				Expected: []sql.Row{{"-0.0000000000000010"}},
+			},
+			{
+				Query:    "SELECT avg(val) FROM decimal_table;",
+				Expected: []sql.Row{{"-0.00000000000000033333"}},
+			},
+		},
+	},
+	{
+		Name: "sum() and avg() on non-DECIMAL type column returns the DOUBLE type result",
+		SetUpScript: []string{
+			"create table float_table (id int primary key, val1 double, val2 float);",
+			"insert into float_table values (1,-2.5633000000000384, 2.3);",
+			"insert into float_table values (2,2.5633000000000370, 2.4);",
+			"insert into float_table values (3,0.0000000000000004, 5.3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT sum(id), sum(val1), sum(val2) FROM float_table ORDER BY id;",
+				Expected: []sql.Row{{float64(6), -9.322676295501879e-16, 10.000000238418579}},
+			},
+			{
+				Query:    "SELECT sum(id), sum(val1), sum(val2) FROM float_table ORDER BY id;",
+				Expected: []sql.Row{{float64(6), -9.322676295501879e-16, 10.000000238418579}},
+			},
+			{
+				Query:    "SELECT avg(id), avg(val1), avg(val2) FROM float_table ORDER BY id;",
+				Expected: []sql.Row{{float64(2), -3.107558765167293e-16, 3.333333412806193}},
+			},
+		},
+	},
+	{
+		Name: "compare DECIMAL type columns with different precision and scale",
+		SetUpScript: []string{
+			"create table t (id int primary key, val1 decimal(2, 1), val2 decimal(3, 1));",
+			"insert into t values (1, 1.2, 1.1), (2, 1.2, 10.1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select if(val1 < val2, 'YES', 'NO') from t order by id;",
+				Expected: []sql.Row{{"NO"}, {"YES"}},
+			},
+		},
+	},
+	{
+		Name: "basic test on tables dual and `dual`",
+		SetUpScript: []string{
+			"CREATE TABLE `dual` (id int)",
+			"INSERT INTO `dual` VALUES (2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * from `dual`;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT 3 from dual;",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:       "SELECT * from dual;",
+				ExpectedErr: sql.ErrNoTablesUsed,
+			},
+		},
+	},
+	{
+		Name: "having clause without groupby clause, all rows implicitly form a single aggregate group",
+		SetUpScript: []string{
+			"create table numbers (val int);",
+			"insert into numbers values (1), (2), (3);",
+			"insert into numbers values (2), (4);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select val from numbers;",
+				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+			},
+			{
+				Query:    "select val as a from numbers having a = val;",
+				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+			},
+			{
+				Query:    "select val as a from numbers group by val having a = val;",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "select val as a from numbers as t1 group by t1.val having a = t1.val;",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "select t1.val as a from numbers as t1 group by 1 having a = t1.val;",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "select t1.val as a from numbers as t1 having a = t1.val;",
+				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+			},
+			{
+				Query:    "select count(*) from numbers having count(*) = 5;",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				// MySQL returns `Unknown column 'val' in 'having clause'` error for this query,
+				// but GMS builds GroupBy for any aggregate function.
+				Skip:  true,
+				Query: "select count(*) from numbers having count(*) > val;",
+				//ExpectedErrStr:   "found HAVING clause with no GROUP BY", // not the exact error we want
+			},
+			{
+				Query:    "select count(*) from numbers group by val having count(*) < val;",
+				Expected: []sql.Row{{1}, {1}},
+			},
+		},
+	},
+	{
+		Name: "using having and group by clauses in subquery ",
+		SetUpScript: []string{
+			"CREATE TABLE t (i int, t varchar(2));",
+			"insert into t values (1, 'a'), (1, 'a2'), (2, 'b'), (3, 'c'), (3, 'c2'), (4, 'd'), (5, 'e'), (5, 'e2');", //, (6, 'f'), (7, 'g'), (7, 'g2')
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select i from t group by i having count(1) = 1 order by i asc",
+				Expected: []sql.Row{{2}, {4}},
+			},
+			{
+				Query:    "select i from t group by i having count(1) != 1 order by i asc",
+				Expected: []sql.Row{{1}, {3}, {5}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t group by i having count(1) = 1) order by i, t asc;",
+				Expected: []sql.Row{{2, "b"}, {4, "d"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t group by i having count(1) != 1) order by i, t asc;",
+				Expected: []sql.Row{{1, "a"}, {1, "a2"}, {3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t where i = 2 group by i having count(1) = 1) order by i, t asc;",
+				Expected: []sql.Row{{2, "b"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t where i = 3 group by i having count(1) != 1) order by i, t asc;",
+				Expected: []sql.Row{{3, "c"}, {3, "c2"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1) order by i, t asc;",
+				Expected: []sql.Row{{3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1 order by i desc) order by i, t asc;",
+				Expected: []sql.Row{{3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1) order by i desc, t asc;",
+				Expected: []sql.Row{{5, "e"}, {5, "e2"}, {3, "c"}, {3, "c2"}},
+			},
+		},
+	},
+	{
+		Name: "correlated subqueries in having clause",
+		SetUpScript: []string{
+			"create table parent (id int primary key, grp int, v int);",
+			"create table child (id int primary key, grp int, total int);",
+			"insert into parent values (1, 1, 10), (2, 1, 20), (3, 2, 5), (4, 3, 7);",
+			"insert into child values (1, 1, 30), (2, 2, 6), (3, 3, 7);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// group key that is not projected
+				Query:    "select sum(v) from parent group by grp having exists (select 1 from child where child.grp = parent.grp) order by 1;",
+				Expected: []sql.Row{{float64(5)}, {float64(7)}, {float64(30)}},
+			},
+			{
+				// aggregate that is not projected
+				Query:    "select count(*) from parent group by grp having exists (select 1 from child where child.total = sum(parent.v)) order by 1;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "select grp from parent group by grp having exists (select 1 from child where child.grp = parent.grp and child.total = sum(parent.v)) order by grp;",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "select grp, count(*) from parent group by grp having (select total from child where child.grp = parent.grp) > avg(parent.v) order by grp;",
+				Expected: []sql.Row{{1, 2}, {2, 1}},
+			},
+			{
+				Query:    "select grp from parent group by grp having not exists (select 1 from child where exists (select 1 from child c2 where c2.total = min(parent.v) and c2.grp = parent.grp)) order by grp;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				// the inner aggregate belongs to the subquery
+				Query:    "select grp from parent group by grp having (select max(total) from child where child.grp = parent.grp) = sum(v) order by grp;",
+				Expected: []sql.Row{{1}, {3}},
+			},
+		},
+	},
+	{
+		Name: "values table value constructor",
+		SetUpScript: []string{
+			"create table xy (x int primary key, y decimal(10,2));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select column_0, column_1 from (values row(1, 2), row(300, -5)) v order by 1;",
+				Expected: []sql.Row{{1, 2}, {300, -5}},
+			},
+			{
+				Query:    "select * from (values row(18446744073709551615), row(-1)) v (a) order by 1;",
+				Expected: []sql.Row{{"-1"}, {"18446744073709551615"}},
+			},
+			{
+				Query:    "select * from (values row(1, 2.5), row(3.25, 4)) v (a, b) order by 1;",
+				Expected: []sql.Row{{"1.00", "2.5"}, {"3.25", "4.0"}},
+			},
+			{
+				Query:    "select * from (values row(1, 'a'), row(2.5e0, 3)) v (a, b) order by 1;",
+				Expected: []sql.Row{{1.0, "a"}, {2.5, "3"}},
+			},
+			{
+				Query:    "select b from (values row(1, 'a'), row(2, 'b'), row(3, 'c')) v (a, b) where a > 1 order by b;",
+				Expected: []sql.Row{{"b"}, {"c"}},
+			},
+			{
+				Query:       "select * from (values row(1, 2), row(3)) v;",
+				ExpectedErr: sql.ErrValuesRowColumnCountMismatch,
+			},
+			{
+				Query:    "insert into xy select * from (values row(1, 1.5), row(2, 300)) v;",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select * from xy order by x;",
+				Expected: []sql.Row{{1, "1.50"}, {2, "300.00"}},
+			},
+		},
+	},
+	{
+		Name: "star expansion of aliased subqueries",
+		SetUpScript: []string{
+			"create table xy (x int primary key, y int);",
+			"insert into xy values (1, 2), (3, 4);",
+			"create table uv (u int primary key, v int);",
+			"insert into uv values (1, 5), (3, 6);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select t.* from (select 1 as a) as t;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select u.* from (select t.* from (select x as a, y as b from xy) as t) as u order by 1;",
+				Expected: []sql.Row{{1, 2}, {3, 4}},
+			},
+			{
+				Query: "select t.* from (select * from xy) as t (Cc, d) order by 1;",
+				ExpectedColumns: sql.Schema{
+					{Name: "Cc", Type: types.Int32},
+					{Name: "d", Type: types.Int32},
+				},
+				Expected: []sql.Row{{1, 2}, {3, 4}},
+			},
+			{
+				Query:    "select * from (select * from xy) as t (c, d) where t.c > 1;",
+				Expected: []sql.Row{{3, 4}},
+			},
+			{
+				Query: "select u.* from (select t.* from (select * from xy) as t (c, d)) as u order by 1;",
+				ExpectedColumns: sql.Schema{
+					{Name: "c", Type: types.Int32},
+					{Name: "d", Type: types.Int32},
+				},
+				Expected: []sql.Row{{1, 2}, {3, 4}},
+			},
+			{
+				Query: "select u.* from (select t.* from (select * from xy) as t (c, d)) as u (e, f) order by 1;",
+				ExpectedColumns: sql.Schema{
+					{Name: "e", Type: types.Int32},
+					{Name: "f", Type: types.Int32},
+				},
+				Expected: []sql.Row{{1, 2}, {3, 4}},
+			},
+			{
+				Query: "with t (c, d) as (select * from xy) select t.* from t order by 1;",
+				ExpectedColumns: sql.Schema{
+					{Name: "c", Type: types.Int32},
+					{Name: "d", Type: types.Int32},
+				},
+				Expected: []sql.Row{{1, 2}, {3, 4}},
+			},
+			{
+				Query:    "select t.* from (select * from xy join uv on x = u) as t order by 1;",
+				Expected: []sql.Row{{1, 2, 1, 5}, {3, 4, 3, 6}},
+			},
+			{
+				Query:    "select s.* from (select t.* from (select * from xy join uv on x = u) as t) as s order by 1;",
+				Expected: []sql.Row{{1, 2, 1, 5}, {3, 4, 3, 6}},
+			},
+			{
+				Query:    "select t.* from (select a.*, b.v from (select * from xy) a join uv b on a.x = b.u) as t order by 1;",
+				Expected: []sql.Row{{1, 2, 5}, {3, 4, 6}},
+			},
+			{
+				Query:    "with c as (select * from xy join uv on x = u) select a.* from c as a order by 1;",
+				Expected: []sql.Row{{1, 2, 1, 5}, {3, 4, 3, 6}},
+			},
+			{
+				// the alias shadows the table with the same name
+				Query:    "select uv.* from (select * from xy) as uv join uv as real_uv on uv.x = real_uv.u order by 1;",
+				Expected: []sql.Row{{1, 2}, {3, 4}},
+			},
+			{
+				// a qualified star only expands the tables of its own query
+				Query:       "select t.* from (select * from xy) as t where exists (select t.* from uv);",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+		},
+	},
+	{
+		Name: "show columns from views",
+		SetUpScript: []string{
+			"create table parent (id int primary key, name varchar(20) not null, amount decimal(10,2));",
+			"create table child (id int primary key, parent_id int, note text, created datetime);",
+			"create view parent_child as select p.id, p.name, c.note, c.created, p.amount from parent p join child c on p.id = c.parent_id;",
+			"create view nested_view as select name, count(*) as cnt from parent_child group by name;",
+			"create database otherdb;",
+			"create table otherdb.t (k bigint unsigned);",
+			"create view otherdb.v as select k from otherdb.t;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show columns from parent_child;",
+				Expected: []sql.Row{
+					{"id", "int", "NO", "", "NULL", ""},
+					{"name", "varchar(20)", "NO", "", "NULL", ""},
+					{"note", "text", "YES", "", "NULL", ""},
+					{"created", "datetime", "YES", "", "NULL", ""},
+					{"amount", "decimal(10,2)", "YES", "", "NULL", ""},
+				},
+			},
+			{
+				Query: "show full columns from parent_child where Field = 'name';",
+				Expected: []sql.Row{
+					{"name", "varchar(20)", "utf8mb4_0900_bin", "NO", "", "NULL", "", "", ""},
+				},
+			},
+			{
+				Query: "describe nested_view;",
+				Expected: []sql.Row{
+					{"name", "varchar(20)", "NO", "", "NULL", ""},
+					{"cnt", "bigint", "NO", "", "NULL", ""},
+				},
+			},
+			{
+				Query: "show columns from parent_child like 'c%';",
+				Expected: []sql.Row{
+					{"created", "datetime", "YES", "", "NULL", ""},
+				},
+			},
+			{
+				Query: "show columns from v from otherdb;",
+				Expected: []sql.Row{
+					{"k", "bigint unsigned", "YES", "", "NULL", ""},
+				},
+			},
+			{
+				Query: "show columns from t in otherdb;",
+				Expected: []sql.Row{
+					{"k", "bigint unsigned", "YES", "", "NULL", ""},
+				},
+			},
+		},
+	},
+	{
+		Name: "sql_big_result and sql_small_result select modifiers",
+		SetUpScript: []string{
+			"create table t (a int, b varchar(10) collate utf8mb4_0900_ai_ci, c int);",
+			"insert into t values (1, 'x', 1), (2, 'Y', 2), (1, 'y', 3), (3, 'x', 4), (2, 'X', 5), (null, 'x', 6), (null, null, 7);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "explain select sql_big_result a, count(*) from t group by a",
+				Expected: []sql.Row{
+					{"Project"},
+					{" ├─ columns: [t.a, count(1)]"},
+					{" └─ GroupBy"},
+					{"     ├─ SelectedExprs(COUNT(1), t.a)"},
+					{"     ├─ Grouping(t.a)"},
+					{"     ├─ Strategy(sort)"},
+					{"     └─ Table"},
+					{"         ├─ name: t"},
+					{"         └─ columns: [a]"},
+				},
+			},
+			{
+				Query: "explain select sql_small_result a, count(*) from t group by a",
+				Expected: []sql.Row{
+					{"Project"},
+					{" ├─ columns: [t.a, count(1)]"},
+					{" └─ GroupBy"},
+					{"     ├─ SelectedExprs(COUNT(1), t.a)"},
+					{"     ├─ Grouping(t.a)"},
+					{"     ├─ Strategy(hash)"},
+					{"     └─ Table"},
+					{"         ├─ name: t"},
+					{"         └─ columns: [a]"},
+				},
+			},
+			{
+				Query:    "select sql_big_result a, count(*), sum(c) from t group by a order by a",
+				Expected: []sql.Row{{nil, 2, float64(13)}, {1, 2, float64(4)}, {2, 2, float64(7)}, {3, 1, float64(4)}},
+			},
+			{
+				Query:    "select sql_small_result a, count(*), sum(c) from t group by a order by a",
+				Expected: []sql.Row{{nil, 2, float64(13)}, {1, 2, float64(4)}, {2, 2, float64(7)}, {3, 1, float64(4)}},
+			},
+			{
+				// rows are grouped by the collation of the grouping column with either strategy
+				Query:    "select sql_big_result count(*), sum(c) from t group by b order by 2",
+				Expected: []sql.Row{{2, float64(5)}, {1, float64(7)}, {4, float64(16)}},
+			},
+			{
+				Query:    "select sql_small_result count(*), sum(c) from t group by b order by 2",
+				Expected: []sql.Row{{2, float64(5)}, {1, float64(7)}, {4, float64(16)}},
+			},
+			{
+				Query:    "select distinct sql_no_cache sql_big_result a + 1 as x, sum(c) from t group by x having sum(c) > 3 order by x",
+				Expected: []sql.Row{{nil, float64(13)}, {2, float64(4)}, {3, float64(7)}, {4, float64(4)}},
+			},
+			{
+				Query: "explain select distinct sql_big_result a from t",
+				Expected: []sql.Row{
+					{"OrderedDistinct"},
+					{" └─ Sort(t.a ASC)"},
+					{"     └─ Project"},
+					{"         ├─ columns: [t.a]"},
+					{"         └─ Table"},
+					{"             └─ name: t"},
+				},
+			},
+			{
+				Query: "explain select distinct sql_small_result a from t",
+				Expected: []sql.Row{
+					{"Distinct"},
+					{" └─ Project"},
+					{"     ├─ columns: [t.a]"},
+					{"     └─ Table"},
+					{"         └─ name: t"},
+				},
+			},
+			{
+				Query:    "select distinct sql_big_result a from t",
+				Expected: []sql.Row{{nil}, {1}, {2}, {3}},
+			},
+			{
+				Query:    "select distinct sql_small_result a from t order by a",
+				Expected: []sql.Row{{nil}, {1}, {2}, {3}},
+			},
+			{
+				// the rows of a query with an ORDER BY aren't sorted again for SQL_BIG_RESULT
+				Query:    "select distinct sql_big_result a from t order by a desc",
+				Expected: []sql.Row{{3}, {2}, {1}, {nil}},
+			},
+			{
+				Query:    "select c from t where a in (select sql_big_result a from t group by a) order by c",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}, {5}},
+			},
+			{
+				Query:    "select sql_big_result count(*) from t",
+				Expected: []sql.Row{{7}},
+			},
+			{
+				Query:    "select sql_big_result 'abc', a from t group by a order by a",
+				Expected: []sql.Row{{"abc", nil}, {"abc", 1}, {"abc", 2}, {"abc", 3}},
+			},
+			{
+				Query:    `select sql_small_result "it's", count(*) from t group by a order by a`,
+				Expected: []sql.Row{{"it's", 2}, {"it's", 2}, {"it's", 2}, {"it's", 1}},
+			},
+			{
+				Query:    "select sql_big_result `a` from t group by a order by a",
+				Expected: []sql.Row{{nil}, {1}, {2}, {3}},
+			},
+		},
+	},
+	{
+		Name: "OFFSET ... FETCH row limits",
+		SetUpScript: []string{
+			"create table t (i int primary key, j int);",
+			"insert into t values (1, 1), (2, 1), (3, 2), (4, 2), (5, 2), (6, 3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select i from t order by i offset 2 rows fetch next 2 rows only",
+				Expected: []sql.Row{{3}, {4}},
+			},
+			{
+				Query:    "select i from t order by i offset 1 row fetch first 1 row only",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select i from t order by i fetch first 3 rows only",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    "select i from t order by i offset 4 rows",
+				Expected: []sql.Row{{5}, {6}},
+			},
+			{
+				Query:    "select i from t order by i offset 6 rows fetch next 2 rows only",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select i, j from t order by j fetch first 3 rows with ties",
+				Expected: []sql.Row{{1, 1}, {2, 1}, {3, 2}, {4, 2}, {5, 2}},
+			},
+			{
+				Query:    "select i, j from t order by j offset 1 row fetch next 1 row with ties",
+				Expected: []sql.Row{{2, 1}},
+			},
+			{
+				Query:    "select i, j from t order by j desc offset 1 row fetch next 1 row with ties",
+				Expected: []sql.Row{{3, 2}, {4, 2}, {5, 2}},
+			},
+			{
+				Query:    "select i from t where i in (select i from t order by i offset 4 rows) order by i",
+				Expected: []sql.Row{{5}, {6}},
+			},
+			{
+				Query:    "select i from t where i < 3 union all select i from t where i > 4 order by i offset 1 row fetch first 2 rows only",
+				Expected: []sql.Row{{2}, {5}},
+			},
+			{
+				Query:          "select i from t fetch first 3 rows with ties",
+				ExpectedErrStr: "WITH TIES requires an ORDER BY clause",
+			},
+			{
+				Query:          "select i from t union select j from t order by 1 fetch first 1 row with ties",
+				ExpectedErrStr: "WITH TIES is not supported with UNION, INTERSECT, or EXCEPT",
+			},
+		},
+	},
+	{
+		Name: "PERCENT and WITH TIES row limits",
+		SetUpScript: []string{
+			"create table t (pk int primary key, score int, name varchar(10));",
+			"insert into t values (1, 90, 'a'), (2, 80, 'b'), (3, 80, 'c'), (4, 70, 'd'), (5, 70, 'e'), (6, 70, 'f'), (7, 60, 'g');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// 7 rows * 30% = 2.1 rows, which is rounded up
+				Query:    "select pk from t order by pk limit 30 percent",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    "select pk from t order by pk fetch first 50 percent rows only",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "select pk from t order by pk limit 100 percent",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}, {5}, {6}, {7}},
+			},
+			{
+				Query:    "select pk from t order by pk limit 200 percent",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}, {5}, {6}, {7}},
+			},
+			{
+				Query:    "select pk from t order by pk limit 0 percent",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select pk from t where pk > 7 limit 50 percent",
+				Expected: []sql.Row{},
+			},
+			{
+				// the rows skipped by the offset count towards the total
+				Query:    "select pk from t order by pk offset 2 rows fetch next 50 percent rows only",
+				Expected: []sql.Row{{3}, {4}, {5}, {6}},
+			},
+			{
+				Query:    "select name from t order by score desc, pk fetch first 2 rows with ties",
+				Expected: []sql.Row{{"a"}, {"b"}},
+			},
+			{
+				Query:    "select name from t order by score desc fetch first 2 rows with ties",
+				Expected: []sql.Row{{"a"}, {"b"}, {"c"}},
+			},
+			{
+				Query:    "select name from t order by score desc limit 4 with ties",
+				Expected: []sql.Row{{"a"}, {"b"}, {"c"}, {"d"}, {"e"}, {"f"}},
+			},
+			{
+				Query:    "select name from t order by score desc fetch first row with ties",
+				Expected: []sql.Row{{"a"}},
+			},
+			{
+				// 7 rows * 50% = 3.5 rows, which is rounded up, and the rows tied with the fourth row follow it
+				Query:    "select pk, score from t order by score desc limit 50 percent with ties",
+				Expected: []sql.Row{{1, 90}, {2, 80}, {3, 80}, {4, 70}, {5, 70}, {6, 70}},
+			},
+			{
+				Query:    "select score from t order by score fetch first 10 percent rows with ties",
+				Expected: []sql.Row{{60}},
+			},
+			{
+				Query:    "select sql_calc_found_rows name from t order by score desc fetch first 2 rows with ties",
+				Expected: []sql.Row{{"a"}, {"b"}, {"c"}},
+			},
+			{
+				Query:    "select found_rows()",
+				Expected: []sql.Row{{7}},
+			},
+			{
+				Query:    "select x from (select score as x from t order by score desc fetch first 1 percent rows with ties) sq where x > 80",
+				Expected: []sql.Row{{90}},
+			},
+			{
+				// the options are read the same way when the first select expression is quoted
+				Query:    "select 'xyz', name from t order by score desc fetch first 2 rows with ties",
+				Expected: []sql.Row{{"xyz", "a"}, {"xyz", "b"}, {"xyz", "c"}},
+			},
+			{
+				Query:    "select 'it''s', pk from t order by pk limit 30 percent",
+				Expected: []sql.Row{{"it's", 1}, {"it's", 2}, {"it's", 3}},
+			},
+			{
+				Query:    "select `name`, score from t order by score desc limit 2 with ties",
+				Expected: []sql.Row{{"a", 90}, {"b", 80}, {"c", 80}},
+			},
+			{
+				Query:    "prepare stmt from 'select pk from t order by pk limit ? percent'",
+				Expected: []sql.Row{{types.OkResult{Info: plan.PrepareInfo{}}}},
+			},
+			{
+				Query:    "set @pct = 30",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "execute stmt using @pct",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:          "select name from t fetch first 2 rows with ties",
+				ExpectedErrStr: "WITH TIES requires an ORDER BY clause",
+			},
+			{
+				Query:          "select distinct score from t order by score fetch first 2 rows with ties",
+				ExpectedErrStr: "WITH TIES is not supported with DISTINCT",
+			},
+			{
+				Query:          "select pk from t union select pk from t order by pk limit 50 percent",
+				ExpectedErrStr: "PERCENT is not supported with UNION, INTERSECT, or EXCEPT",
+			},
+		},
+	},
+	{
+		Name: "resource groups",
+		SetUpScript: []string{
+			"create resource group batch type = user vcpu = 0 thread_priority = 10",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select resource_group_name, resource_group_type, resource_group_enable, vcpu_ids, thread_priority from information_schema.resource_groups where resource_group_name = 'batch'",
+				Expected: []sql.Row{{"batch", "USER", uint64(1), "0", int32(10)}},
+			},
+			{
+				Query:    "select resource_group_name, resource_group_type, thread_priority from information_schema.resource_groups order by 1",
+				Expected: []sql.Row{{"batch", "USER", int32(10)}, {"SYS_default", "SYSTEM", int32(0)}, {"USR_default", "USER", int32(0)}},
+			},
+			{
+				Query:       "create resource group BATCH type = user",
+				ExpectedErr: sql.ErrResourceGroupExists,
+			},
+			{
+				Query:       "create resource group rg type = user thread_priority = -1",
+				ExpectedErr: sql.ErrInvalidThreadPriority,
+			},
+			{
+				Query:       "create resource group rg type = system thread_priority = 1",
+				ExpectedErr: sql.ErrInvalidThreadPriority,
+			},
+			{
+				Query:       "create resource group rg type = user vcpu = 1-0",
+				ExpectedErr: sql.ErrInvalidVCPURange,
+			},
+			{
+				Query:    "alter resource group batch thread_priority = 5 disable",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select resource_group_enable, thread_priority from information_schema.resource_groups where resource_group_name = 'batch'",
+				Expected: []sql.Row{{uint64(0), int32(5)}},
+			},
+			{
+				Query:       "set resource group batch",
+				ExpectedErr: sql.ErrResourceGroupDisabled,
+			},
+			{
+				Query:    "alter resource group batch enable",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:    "CREATE TABLE test2 (pk BIGINT PRIMARY KEY, v1 VARCHAR(255) CHARACTER SET utf8mb4 BINARY);",
+				Query:    "set resource group batch",
 				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:    "SHOW CREATE TABLE test2;",
-				Expected: []sql.Row{{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) COLLATE utf8mb4_bin,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:       "set resource group SYS_default",
+				ExpectedErr: sql.ErrResourceGroupBindFailed,
 			},
-		},
-	},
-	{
-		Name: "sum() and avg() on DECIMAL type column returns the DECIMAL type result",
-		SetUpScript: []string{
-			"create table decimal_table (id int, val decimal(18,16));",
-			"insert into decimal_table values (1,-2.5633000000000384);",
-			"insert into decimal_table values (2,2.5633000000000370);",
-			"insert into decimal_table values (3,0.0000000000000004);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT val FROM decimal_table;",
-				Expected: []sql.Row{{"-2.5633000000000384"}, {"2.5633000000000370"}, {"0.0000000000000004"}},
+				Query:       "set resource group missing",
+				ExpectedErr: sql.ErrResourceGroupNotExists,
 			},
 			{
-				Query:    "SELECT sum(val) FROM decimal_table;",
-				Expected: []sql.Row{{"-0.0000000000000010"}},
+				Query:       "alter resource group usr_default thread_priority = 1",
+				ExpectedErr: sql.ErrResourceGroupOperationDisallowed,
 			},
 			{
-				Query:    "SELECT avg(val) FROM decimal_table;",
-				Expected: []sql.Row{{"-0.00000000000000033333"}},
+				Query:       "drop resource group sys_default",
+				ExpectedErr: sql.ErrResourceGroupOperationDisallowed,
 			},
-		},
-	},
-	{
-		Name: "sum() and avg() on non-DECIMAL type column returns the DOUBLE type result",
-		SetUpScript: []string{
-			"create table float_table (id int primary key, val1 double, val2 float);",
-			"insert into float_table values (1,-2.5633000000000384, 2.3);",
-			"insert into float_table values (2,2.5633000000000370, 2.4);",
-			"insert into float_table values (3,0.0000000000000004, 5.3);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT sum(id), sum(val1), sum(val2) FROM float_table ORDER BY id;",
-				Expected: []sql.Row{{float64(6), -9.322676295501879e-16, 10.000000238418579}},
+				Query:                 "select /*+ RESOURCE_GROUP(missing) */ 1",
+				Expected:              []sql.Row{{1}},
+				ExpectedWarning:       3651,
+				ExpectedWarningsCount: 1,
 			},
 			{
-				Query:    "SELECT sum(id), sum(val1), sum(val2) FROM float_table ORDER BY id;",
-				Expected: []sql.Row{{float64(6), -9.322676295501879e-16, 10.000000238418579}},
+				Query:    "drop resource group batch force",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:    "SELECT avg(id), avg(val1), avg(val2) FROM float_table ORDER BY id;",
-				Expected: []sql.Row{{float64(2), -3.107558765167293e-16, 3.333333412806193}},
+				Query:       "drop resource group batch",
+				ExpectedErr: sql.ErrResourceGroupNotExists,
 			},
 		},
 	},
 	{
-		Name: "compare DECIMAL type columns with different precision and scale",
+		Name: "select list aliases are visible to GROUP BY, HAVING and ORDER BY",
 		SetUpScript: []string{
-			"create table t (id int primary key, val1 decimal(2, 1), val2 decimal(3, 1));",
-			"insert into t values (1, 1.2, 1.1), (2, 1.2, 10.1);",
+			"create table t (x int primary key, y int)",
+			"insert into t values (1, 10), (2, 20), (3, 10)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select if(val1 < val2, 'YES', 'NO') from t order by id;",
-				Expected: []sql.Row{{"NO"}, {"YES"}},
+				// aliases aren't visible to other expressions in the select list
+				Query:       "select x + 1 as a, a + 1 from t",
+				ExpectedErr: sql.ErrMisusedAlias,
 			},
-		},
-	},
-	{
-		Name: "basic test on tables dual and `dual`",
-		SetUpScript: []string{
-			"CREATE TABLE `dual` (id int)",
-			"INSERT INTO `dual` VALUES (2)",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT * from `dual`;",
-				Expected: []sql.Row{{2}},
+				Query:       "select a + 1, x + 1 as a from t",
+				ExpectedErr: sql.ErrColumnNotFound,
 			},
 			{
-				Query:    "SELECT 3 from dual;",
-				Expected: []sql.Row{{3}},
+				Query:       "select x + 1 as a from t where a > 1",
+				ExpectedErr: sql.ErrColumnNotFound,
 			},
 			{
-				Query:       "SELECT * from dual;",
-				ExpectedErr: sql.ErrNoTablesUsed,
+				Query:    "select y div 10 as g, count(*) from t group by g order by g",
+				Expected: []sql.Row{{1, 2}, {2, 1}},
 			},
-		},
-	},
-	{
-		Name: "having clause without groupby clause, all rows implicitly form a single aggregate group",
-		SetUpScript: []string{
-			"create table numbers (val int);",
-			"insert into numbers values (1), (2), (3);",
-			"insert into numbers values (2), (4);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select val from numbers;",
-				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+				Query:    "select y div 10 as g, count(*) from t group by g + 0 order by g",
+				Expected: []sql.Row{{1, 2}, {2, 1}},
 			},
 			{
-				Query:    "select val as a from numbers having a = val;",
-				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+				Query:       "select sum(x) as s from t group by s",
+				ExpectedErr: sql.ErrWrongGroupField,
 			},
 			{
-				Query:    "select val as a from numbers group by val having a = val;",
-				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+				Query:       "select sum(x) as s from t group by s + 1",
+				ExpectedErr: sql.ErrWrongGroupField,
 			},
 			{
-				Query:    "select val as a from numbers as t1 group by t1.val having a = t1.val;",
-				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+				// a GROUP BY name that is both a column and an alias is the column
+				Query:    "select y as x, count(*) from t group by x order by x",
+				Expected: []sql.Row{{10, 1}, {10, 1}, {20, 1}},
 			},
 			{
-				Query:    "select t1.val as a from numbers as t1 group by 1 having a = t1.val;",
-				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+				Query:    "select y, count(*) as c from t group by y having c > 1",
+				Expected: []sql.Row{{10, 2}},
 			},
 			{
-				Query:    "select t1.val as a from numbers as t1 having a = t1.val;",
-				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+				Query:    "select y div 10 as g, count(*) as c from t group by g having c + g > 2 order by g",
+				Expected: []sql.Row{{1, 2}, {2, 1}},
 			},
 			{
-				Query:    "select count(*) from numbers having count(*) = 5;",
-				Expected: []sql.Row{{5}},
+				Query:    "select x, y * 2 as s from t having s > 20",
+				Expected: []sql.Row{{2, 40}},
 			},
 			{
-				// MySQL returns `Unknown column 'val' in 'having clause'` error for this query,
-				// but GMS builds GroupBy for any aggregate function.
-				Skip:  true,
-				Query: "select count(*) from numbers having count(*) > val;",
-				//ExpectedErrStr:   "found HAVING clause with no GROUP BY", // not the exact error we want
+				// a HAVING name that is both a GROUP BY column and an alias is the column
+				Query:    "select x as y, count(*) from t group by y having y > 10",
+				Expected: []sql.Row{{2, 1}},
 			},
 			{
-				Query:    "select count(*) from numbers group by val having count(*) < val;",
-				Expected: []sql.Row{{1}, {1}},
+				Query:    "select x * 2 as a from t order by a desc",
+				Expected: []sql.Row{{6}, {4}, {2}},
 			},
-		},
-	},
-	{
-		Name: "using having and group by clauses in subquery ",
-		SetUpScript: []string{
-			"CREATE TABLE t (i int, t varchar(2));",
-			"insert into t values (1, 'a'), (1, 'a2'), (2, 'b'), (3, 'c'), (3, 'c2'), (4, 'd'), (5, 'e'), (5, 'e2');", //, (6, 'f'), (7, 'g'), (7, 'g2')
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select i from t group by i having count(1) = 1 order by i asc",
-				Expected: []sql.Row{{2}, {4}},
+				Query:    "select x * 2 as a from t order by a + 1 desc",
+				Expected: []sql.Row{{6}, {4}, {2}},
 			},
 			{
-				Query:    "select i from t group by i having count(1) != 1 order by i asc",
-				Expected: []sql.Row{{1}, {3}, {5}},
+				Query:    "select sum(x) as s from t group by y order by s + 1 desc",
+				Expected: []sql.Row{{float64(4)}, {float64(2)}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t group by i having count(1) = 1) order by i, t asc;",
-				Expected: []sql.Row{{2, "b"}, {4, "d"}},
+				// an ORDER BY name that is both a column and an alias is the alias
+				Query:    "select -x as x from t order by x",
+				Expected: []sql.Row{{-3}, {-2}, {-1}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t group by i having count(1) != 1) order by i, t asc;",
-				Expected: []sql.Row{{1, "a"}, {1, "a2"}, {3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+				// but in an ORDER BY expression, the column is found first
+				Query:    "select y as x from t order by -x",
+				Expected: []sql.Row{{10}, {20}, {10}},
 			},
+		},
+	},
+	{
+		Name: "cast to and from json",
+		SetUpScript: []string{
+			`create table t (pk int primary key, j json, s varchar(100))`,
+			`insert into t values (1, '{"b": 1, "a": [true, null]}', '{"x": "y"}'), (2, '"a\\"b"', '[1, 2.50]')`,
+		},
+		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select * from t where i in (select i from t where i = 2 group by i having count(1) = 1) order by i, t asc;",
-				Expected: []sql.Row{{2, "b"}},
+				Query:    "select pk, cast(s as json) from t order by pk",
+				Expected: []sql.Row{{1, types.MustJSON(`{"x": "y"}`)}, {2, types.MustJSON(`[1, 2.50]`)}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t where i = 3 group by i having count(1) != 1) order by i, t asc;",
-				Expected: []sql.Row{{3, "c"}, {3, "c2"}},
+				Query:    "select pk, cast(j as char) from t order by pk",
+				Expected: []sql.Row{{1, `{"a": [true, null], "b": 1}`}, {2, `"a\"b"`}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1) order by i, t asc;",
-				Expected: []sql.Row{{3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+				Query:    `select cast(cast('{"b":1,"aa":2}' as json) as char)`,
+				Expected: []sql.Row{{`{"b": 1, "aa": 2}`}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1 order by i desc) order by i, t asc;",
-				Expected: []sql.Row{{3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+				Query:    `select cast(cast('[1, 2]' as json) as char(3))`,
+				Expected: []sql.Row{{`[1,`}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1) order by i desc, t asc;",
-				Expected: []sql.Row{{5, "e"}, {5, "e2"}, {3, "c"}, {3, "c2"}},
+				Query:       `select cast('{"a": ' as json)`,
+				ExpectedErr: sql.ErrInvalidJSONText,
 			},
 		},
 	},
@@ -4201,8 +5557,8 @@ CREATE TABLE tab3 (
 			{
 				Query: "show index from TABLE_one;",
 				Expected: []sql.Row{
-					{"table_One", 0, "PRIMARY", 1, "Id", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-					{"table_One", 1, "idx_one", 1, "Val1", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"table_One", 0, "PRIMARY", 1, "Id", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"table_One", 1, "idx_one", 1, "Val1", nil, nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
 				},
 			},
 			{
@@ -4222,9 +5578,9 @@ CREATE TABLE tab3 (
 			{
 				Query: "show index from tABLEtwo;",
 				Expected: []sql.Row{
-					{"TableTwo", 0, "PRIMARY", 1, "iD", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-					{"TableTwo", 1, "idx_one", 1, "VAL2", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
-					{"TableTwo", 1, "idx_one", 2, "vAL3", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"TableTwo", 0, "PRIMARY", 1, "iD", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"TableTwo", 1, "idx_one", 1, "VAL2", nil, nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"TableTwo", 1, "idx_one", 2, "vAL3", nil, nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
 				},
 			},
 			{
@@ -4322,8 +5678,6 @@ CREATE TABLE tab3 (
 				ExpectedErr: sql.ErrTableNotFound,
 			},
 			{
-				// TODO: ALTER VIEWs are not supported
-				Skip:        true,
 				Query:       "ALTER VIEW b AS SELECT * FROM nonexistenttable;",
 				ExpectedErr: sql.ErrTableNotFound,
 			},
@@ -5897,9 +7251,231 @@ where
 			},
 		},
 	},
+	{
+		Name: "SHOW REPLICAS without a binlog primary controller",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "show replicas",
+				ExpectedErr: plan.ErrNoPrimaryController,
+			},
+			{
+				Query:       "show slave hosts",
+				ExpectedErr: plan.ErrNoPrimaryController,
+			},
+		},
+	},
+	{
+		Name: "START REPLICA UNTIL without a binlog replica controller",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "start replica until source_log_file='binlog.000002', source_log_pos=1234",
+				ExpectedErr: plan.ErrNoReplicationController,
+			},
+			{
+				Query:       "start replica until source_log_file='binlog.000002'",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+		},
+	},
+	{
+		Name: "SHOW BINARY LOGS and SHOW BINLOG EVENTS without a binlog primary controller",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "show binary logs",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "show master logs",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "show binlog events",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "show binlog events in 'binlog.000001' from 4 limit 1, 2",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "implicit type conversions in join conditions",
+		SetUpScript: []string{
+			"create table a (id int primary key, d date, dec1 decimal(10,2))",
+			"create table b (id_str varchar(20) primary key, ds varchar(20), f double, s varchar(20), key (ds), key (f))",
+			"insert into a values (10, '2020-01-02', 1.5), (20, '2020-01-03', 2.25), (30, '2020-01-04', 0.1)",
+			"insert into b values ('10x', '2020-01-02', 1.5, '10'), ('20', '2020-01-03 00:00:00', 2.25, '20x'), ('abc', 'nope', 0.1, 'abc')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select a.id, b.id_str from a join b on a.id = b.id_str order by 1",
+				Expected: []sql.Row{{10, "10x"}, {20, "20"}},
+			},
+			{
+				Query:    "select /*+ LOOKUP_JOIN(a, b) JOIN_ORDER(a, b) */ a.id, b.id_str from a join b on a.id = b.id_str order by 1",
+				Expected: []sql.Row{{10, "10x"}, {20, "20"}},
+			},
+			{
+				Query:    "select a.id, b.ds from a join b on a.d = b.ds order by 1",
+				Expected: []sql.Row{{10, "2020-01-02"}, {20, "2020-01-03 00:00:00"}},
+			},
+			{
+				Query:    "select a.id, b.f from a join b on a.dec1 = b.f order by 1",
+				Expected: []sql.Row{{10, 1.5}, {20, 2.25}, {30, 0.1}},
+			},
+			{
+				Query:                 "select id_str from b where s = 20",
+				Expected:              []sql.Row{{"20"}},
+				ExpectedWarning:       1292,
+				ExpectedWarningsCount: 2,
+			},
+			{
+				Query:    "select id_str from b where id_str = 10",
+				Expected: []sql.Row{{"10x"}},
+			},
+			{
+				Query:    "select id from a where id = '10'",
+				Expected: []sql.Row{{10}},
+			},
+			{
+				Query:            "explain select a.id from a join b on a.id = b.id_str",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "show warnings",
+				Expected: []sql.Row{{"Warning", 1739, "Cannot use ref access on index 'PRIMARY' due to type or collation conversion on field 'id_str'"}},
+			},
+			{
+				Query:            "explain select * from b where ds = 20200102",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "show warnings",
+				Expected: []sql.Row{{"Warning", 1739, "Cannot use range access on index 'ds' due to type or collation conversion on field 'ds'"}},
+			},
+			{
+				Query:            "explain select a.id from a join b on a.dec1 = b.f",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "show warnings",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "implicit type conversions in where clauses",
+		SetUpScript: []string{
+			"create table t (i int primary key, s varchar(20), d date, key (s), key (d))",
+			"insert into t values (1, '1', '2020-01-01'), (5, '5.0', '2020-01-05'), (6, '6abc', '2020-01-06'), (7, ' 7', '2020-01-07')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "select i from t where s = 5",
+				Expected:        []sql.Row{{5}},
+				ExpectedIndexes: []string{},
+			},
+			{
+				Query:    "select i from t where s = 6",
+				Expected: []sql.Row{{6}},
+			},
+			{
+				Query: "show warnings",
+				Expected: []sql.Row{
+					{"Warning", 1739, "Cannot use range access on index 's' due to type or collation conversion on field 's'"},
+					{"Warning", 1292, "Truncated incorrect DOUBLE value: '6abc'"},
+				},
+			},
+			{
+				Query:    "select i from t where s = 7",
+				Expected: []sql.Row{{7}},
+			},
+			{
+				Query:    "select i from t where s in (5, 7) order by i",
+				Expected: []sql.Row{{5}, {7}},
+			},
+			{
+				Query:           "select i from t where i = '5'",
+				Expected:        []sql.Row{{5}},
+				ExpectedIndexes: []string{"primary"},
+			},
+			{
+				Query:    "select i from t where i = '5.0'",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:           "select i from t where i in ('5', '6') order by i",
+				Expected:        []sql.Row{{5}, {6}},
+				ExpectedIndexes: []string{"primary"},
+			},
+			{
+				Query:                 "select i from t where i in ('5', '6abc') order by i",
+				Expected:              []sql.Row{{5}, {6}},
+				ExpectedWarning:       1292,
+				ExpectedWarningsCount: 3,
+			},
+			{
+				Query:           "select i from t where d = '2020-01-05'",
+				Expected:        []sql.Row{{5}},
+				ExpectedIndexes: []string{"d"},
+			},
+			{
+				Query:    "select i from t where d = '2020-01-05 00:00:00'",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "select i from t where d > '2020-01-05' order by i",
+				Expected: []sql.Row{{6}, {7}},
+			},
+			{
+				Query:    "select i from t where d = 20200105",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "select i from t where d in (200106, '2020-01-07') order by i",
+				Expected: []sql.Row{{6}, {7}},
+			},
+		},
+	},
 }
 
 var SpatialScriptTests = []ScriptTest{
+	{
+		Name: "st_within, st_contains and st_intersects",
+		SetUpScript: []string{
+			"create table shapes (i int primary key, g geometry);",
+			"insert into shapes values (1, st_geomfromtext('point(2 2)')), (2, st_geomfromtext('point(4 2)')), (3, st_geomfromtext('point(5 5)')), (4, st_geomfromtext('point(9 9)'))," +
+				" (5, st_geomfromtext('polygon((1 1,3 1,3 3,1 3,1 1))')), (6, st_geomfromtext('polygon((3 3,7 3,7 7,3 7,3 3))')), (7, st_geomfromtext('linestring(1 1,7 7)'));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// a point on the boundary of a polygon intersects it, but isn't within it
+				Query:    "select i, st_within(g, st_geomfromtext('polygon((0 0,4 0,4 4,0 4,0 0))')), st_contains(st_geomfromtext('polygon((0 0,4 0,4 4,0 4,0 0))'), g), st_intersects(g, st_geomfromtext('polygon((0 0,4 0,4 4,0 4,0 0))')) from shapes order by i",
+				Expected: []sql.Row{{1, true, true, true}, {2, false, false, true}, {3, false, false, false}, {4, false, false, false}, {5, true, true, true}, {6, false, false, true}, {7, false, false, true}},
+			},
+			{
+				// the point (5 5) is in the hole of the polygon
+				Query:    "select i, st_within(g, st_geomfromtext('polygon((0 0,10 0,10 10,0 10,0 0),(4 4,6 4,6 6,4 6,4 4))')), st_contains(st_geomfromtext('polygon((0 0,10 0,10 10,0 10,0 0),(4 4,6 4,6 6,4 6,4 4))'), g), st_intersects(st_geomfromtext('polygon((0 0,10 0,10 10,0 10,0 0),(4 4,6 4,6 6,4 6,4 4))'), g) from shapes order by i",
+				Expected: []sql.Row{{1, true, true, true}, {2, true, true, true}, {3, false, false, false}, {4, true, true, true}, {5, true, true, true}, {6, false, false, true}, {7, false, false, true}},
+			},
+			{
+				Query:    "select i from shapes where st_contains(st_geomfromtext('polygon((0 0,8 0,8 8,0 8,0 0))'), g) order by i",
+				Expected: []sql.Row{{1}, {2}, {3}, {5}, {6}, {7}},
+			},
+			{
+				Query:    "select st_contains(g, null), st_within(null, g) from shapes where i = 1",
+				Expected: []sql.Row{{nil, nil}},
+			},
+			{
+				Query:       "select st_contains(st_geomfromtext('polygon((0 0,4 0,4 4,0 4,0 0))'), st_srid(point(1, 1), 4326))",
+				ExpectedErr: sql.ErrDiffSRIDs,
+			},
+			{
+				Query:       "select st_within(st_srid(point(1, 1), 4326), st_geomfromtext('polygon((0 0,4 0,4 4,0 4,0 0))'))",
+				ExpectedErr: sql.ErrDiffSRIDs,
+			},
+		},
+	},
 	{
 		Name: "create table using default point value",
 		SetUpScript: []string{
@@ -7115,9 +8691,8 @@ var CreateDatabaseScripts = []ScriptTest{
 			},
 			{
 				SkipResultCheckOnServerEngine: true, // tracking issue here, https://github.com/dolthub/dolt/issues/6921.
-				// TODO: There should only be one warning (the warnings are not clearing for create database query) AND 'PREPARE' statements should not create warning from its query
-				Query:    "SHOW WARNINGS /* 2 */",
-				Expected: []sql.Row{{"Warning", 1235, "Setting CHARACTER SET, COLLATION and ENCRYPTION are not supported yet"}, {"Warning", 1235, "Setting CHARACTER SET, COLLATION and ENCRYPTION are not supported yet"}},
+				Query:                         "SHOW WARNINGS /* 2 */",
+				Expected:                      []sql.Row{{"Warning", 1235, "Setting CHARACTER SET, COLLATION and ENCRYPTION are not supported yet"}},
 			},
 			{
 				Query:       "CREATE DATABASE mydb",
@@ -7232,10 +8807,8 @@ var DropDatabaseScripts = []ScriptTest{
 				Expected: []sql.Row{{types.OkResult{RowsAffected: 1}}},
 			},
 			{
-				// TODO: there should not be warning
-				//  https://github.com/dolthub/dolt/issues/6921
 				Query:    "SHOW WARNINGS",
-				Expected: []sql.Row{{"Note", 1008, "Can't drop database mydb; database doesn't exist "}},
+				Expected: []sql.Row{},
 			},
 			{
 				Query:    "SELECT DATABASE()",
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/stats"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -128,6 +129,56 @@ var StatisticsQueries = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "index cardinality is the distinct count of each index prefix",
+		SetUpScript: []string{
+			"CREATE TABLE t (i bigint primary key, j bigint, k varchar(10) collate utf8mb4_0900_ai_ci, key jk (j, k))",
+			"INSERT INTO t VALUES (1, 1, 'a'), (2, 1, 'A'), (3, 1, 'b'), (4, 2, 'a'), (5, null, null), (6, null, null)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW INDEX FROM t",
+				Expected: []sql.Row{
+					{"t", 0, "PRIMARY", 1, "i", nil, nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"t", 1, "jk", 1, "j", nil, nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"t", 1, "jk", 2, "k", nil, nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query:    "ANALYZE TABLE t",
+				Expected: []sql.Row{{"t", "analyze", "status", "OK"}},
+			},
+			{
+				Query: "SHOW INDEX FROM t",
+				Expected: []sql.Row{
+					{"t", 0, "PRIMARY", 1, "i", nil, 6, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"t", 1, "jk", 1, "j", nil, 3, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+					{"t", 1, "jk", 2, "k", nil, 4, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query:    "SELECT index_name, seq_in_index, cardinality FROM information_schema.statistics WHERE table_name = 't' ORDER BY index_name, seq_in_index",
+				Expected: []sql.Row{{"jk", 1, 3}, {"jk", 2, 4}, {"PRIMARY", 1, 6}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (7, 3, 'c'), (8, 3, 'd')",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				// cardinality isn't updated until the table is analyzed again
+				Query:    "SELECT index_name, seq_in_index, cardinality FROM information_schema.statistics WHERE table_name = 't' ORDER BY index_name, seq_in_index",
+				Expected: []sql.Row{{"jk", 1, 3}, {"jk", 2, 4}, {"PRIMARY", 1, 6}},
+			},
+			{
+				Query:    "ANALYZE TABLE t",
+				Expected: []sql.Row{{"t", "analyze", "status", "OK"}},
+			},
+			{
+				Query:    "SELECT index_name, seq_in_index, cardinality FROM information_schema.statistics WHERE table_name = 't' ORDER BY index_name, seq_in_index",
+				Expected: []sql.Row{{"jk", 1, 4}, {"jk", 2, 6}, {"PRIMARY", 1, 8}},
+			},
+		},
+	},
 	{
 		Name: "analyze empty table creates stats with 0s",
 		SetUpScript: []string{
@@ -242,4 +293,40 @@ analyze table xy update histogram on (x,y) using data
 			},
 		},
 	},
+	{
+		Name: "index choice follows analyzed cardinality",
+		SetUpScript: []string{
+			"create table t (i int primary key, a int, b int, key (a), key (b))",
+			"insert into t values (1, 1, 1), (2, 2, 1), (3, 3, 1), (4, 4, 1), (5, 5, 1), (6, 6, 1), (7, 7, 1), (8, 8, 1)",
+			"analyze table t",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select index_name, cardinality from information_schema.statistics where table_name = 't' order by index_name",
+				Expected: []sql.Row{{"a", 8}, {"b", 1}, {"PRIMARY", 8}},
+			},
+			{
+				Query:           "select i from t where a = 1 and b = 1",
+				Expected:        []sql.Row{{1}},
+				ExpectedIndexes: []string{"a"},
+			},
+			{
+				Query:    "update t set a = 1, b = i",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 7, Info: plan.UpdateInfo{Matched: 8, Updated: 7}}}},
+			},
+			{
+				Query:    "analyze table t",
+				Expected: []sql.Row{{"t", "analyze", "status", "OK"}},
+			},
+			{
+				Query:    "select index_name, cardinality from information_schema.statistics where table_name = 't' order by index_name",
+				Expected: []sql.Row{{"a", 1}, {"b", 8}, {"PRIMARY", 8}},
+			},
+			{
+				Query:           "select i from t where a = 1 and b = 1",
+				Expected:        []sql.Row{{1}},
+				ExpectedIndexes: []string{"b"},
+			},
+		},
+	},
 }
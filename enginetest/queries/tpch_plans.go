@@ -563,20 +563,21 @@ order by
 			"                     │       └─ Table\n" +
 			"                     │           ├─ name: orders\n" +
 			"                     │           └─ columns: [o_orderkey o_custkey o_orderstatus o_totalprice o_orderdate o_orderpriority o_clerk o_shippriority o_comment]\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [lineitem.l_orderkey:0!null]\n" +
-			"                         └─ Filter\n" +
-			"                             ├─ LessThan\n" +
-			"                             │   ├─ lineitem.l_commitdate:11!null\n" +
-			"                             │   └─ lineitem.l_receiptdate:12!null\n" +
-			"                             └─ IndexedTableAccess(lineitem)\n" +
-			"                                 ├─ index: [lineitem.L_ORDERKEY,lineitem.L_LINENUMBER]\n" +
-			"                                 ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"                                 ├─ colSet: (10-25)\n" +
-			"                                 ├─ tableId: 2\n" +
-			"                                 └─ Table\n" +
-			"                                     ├─ name: lineitem\n" +
-			"                                     └─ columns: [l_orderkey l_partkey l_suppkey l_linenumber l_quantity l_extendedprice l_discount l_tax l_returnflag l_linestatus l_shipdate l_commitdate l_receiptdate l_shipinstruct l_shipmode l_comment]\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [lineitem.l_orderkey:0!null]\n" +
+			"                             └─ Filter\n" +
+			"                                 ├─ LessThan\n" +
+			"                                 │   ├─ lineitem.l_commitdate:11!null\n" +
+			"                                 │   └─ lineitem.l_receiptdate:12!null\n" +
+			"                                 └─ IndexedTableAccess(lineitem)\n" +
+			"                                     ├─ index: [lineitem.L_ORDERKEY,lineitem.L_LINENUMBER]\n" +
+			"                                     ├─ static: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                                     ├─ colSet: (10-25)\n" +
+			"                                     ├─ tableId: 2\n" +
+			"                                     └─ Table\n" +
+			"                                         ├─ name: lineitem\n" +
+			"                                         └─ columns: [l_orderkey l_partkey l_suppkey l_linenumber l_quantity l_extendedprice l_discount l_tax l_returnflag l_linestatus l_shipdate l_commitdate l_receiptdate l_shipinstruct l_shipmode l_comment]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
 			" ├─ columns: [orders.o_orderpriority, count(1) as order_count]\n" +
@@ -595,14 +596,15 @@ order by
 			"                     │   └─ IndexedTableAccess(orders)\n" +
 			"                     │       ├─ index: [orders.O_ORDERKEY]\n" +
 			"                     │       └─ filters: [{[NULL, ∞)}]\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [lineitem.l_orderkey]\n" +
-			"                         └─ Filter\n" +
-			"                             ├─ (lineitem.l_commitdate < lineitem.l_receiptdate)\n" +
-			"                             └─ IndexedTableAccess(lineitem)\n" +
-			"                                 ├─ index: [lineitem.L_ORDERKEY,lineitem.L_LINENUMBER]\n" +
-			"                                 ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"                                 └─ columns: [l_orderkey l_partkey l_suppkey l_linenumber l_quantity l_extendedprice l_discount l_tax l_returnflag l_linestatus l_shipdate l_commitdate l_receiptdate l_shipinstruct l_shipmode l_comment]\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [lineitem.l_orderkey]\n" +
+			"                             └─ Filter\n" +
+			"                                 ├─ (lineitem.l_commitdate < lineitem.l_receiptdate)\n" +
+			"                                 └─ IndexedTableAccess(lineitem)\n" +
+			"                                     ├─ index: [lineitem.L_ORDERKEY,lineitem.L_LINENUMBER]\n" +
+			"                                     ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                                     └─ columns: [l_orderkey l_partkey l_suppkey l_linenumber l_quantity l_extendedprice l_discount l_tax l_returnflag l_linestatus l_shipdate l_commitdate l_receiptdate l_shipinstruct l_shipmode l_comment]\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
 			" ├─ columns: [orders.o_orderpriority, count(1) as order_count]\n" +
@@ -621,14 +623,15 @@ order by
 			"                     │   └─ IndexedTableAccess(orders)\n" +
 			"                     │       ├─ index: [orders.O_ORDERKEY]\n" +
 			"                     │       └─ filters: [{[NULL, ∞)}]\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [lineitem.l_orderkey]\n" +
-			"                         └─ Filter\n" +
-			"                             ├─ (lineitem.l_commitdate < lineitem.l_receiptdate)\n" +
-			"                             └─ IndexedTableAccess(lineitem)\n" +
-			"                                 ├─ index: [lineitem.L_ORDERKEY,lineitem.L_LINENUMBER]\n" +
-			"                                 ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
-			"                                 └─ columns: [l_orderkey l_partkey l_suppkey l_linenumber l_quantity l_extendedprice l_discount l_tax l_returnflag l_linestatus l_shipdate l_commitdate l_receiptdate l_shipinstruct l_shipmode l_comment]\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [lineitem.l_orderkey]\n" +
+			"                             └─ Filter\n" +
+			"                                 ├─ (lineitem.l_commitdate < lineitem.l_receiptdate)\n" +
+			"                                 └─ IndexedTableAccess(lineitem)\n" +
+			"                                     ├─ index: [lineitem.L_ORDERKEY,lineitem.L_LINENUMBER]\n" +
+			"                                     ├─ filters: [{[NULL, ∞), [NULL, ∞)}]\n" +
+			"                                     └─ columns: [l_orderkey l_partkey l_suppkey l_linenumber l_quantity l_extendedprice l_discount l_tax l_returnflag l_linestatus l_shipdate l_commitdate l_receiptdate l_shipinstruct l_shipmode l_comment]\n" +
 			"",
 	},
 	{
@@ -1173,7 +1176,7 @@ group by
 order by
 	o_year;`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [all_nations.o_year:2!null, (sum(case  when (all_nations.nation = 'brazil') then all_nations.volume else 0 end):0!null / sum(all_nations.volume):1!null) as mkt_share]\n" +
+			" ├─ columns: [all_nations.o_year:2!null, mkt_share:3!null]\n" +
 			" └─ Sort(all_nations.o_year:2!null ASC nullsFirst)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [sum(case  when (all_nations.nation = 'brazil') then all_nations.volume else 0 end):0!null, sum(all_nations.volume):1!null, all_nations.o_year:2!null, (sum(case  when (all_nations.nation = 'brazil') then all_nations.volume else 0 end):0!null / sum(all_nations.volume):1!null) as mkt_share]\n" +
@@ -1283,7 +1286,7 @@ order by
 			"                                     └─ columns: [n_nationkey n_name]\n" +
 			"",
 		ExpectedEstimates: "Project\n" +
-			" ├─ columns: [all_nations.o_year, (sum(case  when (all_nations.nation = 'brazil') then all_nations.volume else 0 end) / sum(all_nations.volume)) as mkt_share]\n" +
+			" ├─ columns: [all_nations.o_year, mkt_share]\n" +
 			" └─ Sort(all_nations.o_year ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [sum(case  when (all_nations.nation = 'brazil') then all_nations.volume else 0 end), sum(all_nations.volume), all_nations.o_year, (sum(case  when (all_nations.nation = 'brazil') then all_nations.volume else 0 end) / sum(all_nations.volume)) as mkt_share]\n" +
@@ -1348,7 +1351,7 @@ order by
 			"                                 └─ keys: supplier.s_nationkey\n" +
 			"",
 		ExpectedAnalysis: "Project\n" +
-			" ├─ columns: [all_nations.o_year, (sum(case  when (all_nations.nation = 'brazil') then all_nations.volume else 0 end) / sum(all_nations.volume)) as mkt_share]\n" +
+			" ├─ columns: [all_nations.o_year, mkt_share]\n" +
 			" └─ Sort(all_nations.o_year ASC)\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [sum(case  when (all_nations.nation = 'brazil') then all_nations.volume else 0 end), sum(all_nations.volume), all_nations.o_year, (sum(case  when (all_nations.nation = 'brazil') then all_nations.volume else 0 end) / sum(all_nations.volume)) as mkt_share]\n" +
@@ -3774,7 +3777,7 @@ order by
 			"                             ├─ columns: [customer.C_CUSTKEY:0!null, customer.C_NAME:1!null, customer.C_ADDRESS:2!null, customer.C_NATIONKEY:3!null, customer.C_PHONE:4!null, customer.C_ACCTBAL:5!null, customer.C_MKTSEGMENT:6!null, customer.C_COMMENT:7!null]\n" +
 			"                             └─ Filter\n" +
 			"                                 ├─ orders.o_custkey:8!null IS NULL\n" +
-			"                                 └─ LeftOuterHashJoinExcludeNulls\n" +
+			"                                 └─ LeftOuterHashJoin\n" +
 			"                                     ├─ Eq\n" +
 			"                                     │   ├─ orders.o_custkey:8!null\n" +
 			"                                     │   └─ customer.c_custkey:0!null\n" +
@@ -3831,7 +3834,7 @@ order by
 			"                             ├─ columns: [customer.C_CUSTKEY, customer.C_NAME, customer.C_ADDRESS, customer.C_NATIONKEY, customer.C_PHONE, customer.C_ACCTBAL, customer.C_MKTSEGMENT, customer.C_COMMENT]\n" +
 			"                             └─ Filter\n" +
 			"                                 ├─ orders.o_custkey IS NULL\n" +
-			"                                 └─ LeftOuterHashJoinExcludeNulls\n" +
+			"                                 └─ LeftOuterHashJoin\n" +
 			"                                     ├─ (orders.o_custkey = customer.c_custkey)\n" +
 			"                                     ├─ Filter\n" +
 			"                                     │   ├─ (SUBSTRING(customer.c_phone, 1, 2) HASH IN ('13', '31', '23', '29', '30', '18', '17'))\n" +
@@ -3879,7 +3882,7 @@ order by
 			"                             ├─ columns: [customer.C_CUSTKEY, customer.C_NAME, customer.C_ADDRESS, customer.C_NATIONKEY, customer.C_PHONE, customer.C_ACCTBAL, customer.C_MKTSEGMENT, customer.C_COMMENT]\n" +
 			"                             └─ Filter\n" +
 			"                                 ├─ orders.o_custkey IS NULL\n" +
-			"                                 └─ LeftOuterHashJoinExcludeNulls\n" +
+			"                                 └─ LeftOuterHashJoin\n" +
 			"                                     ├─ (orders.o_custkey = customer.c_custkey)\n" +
 			"                                     ├─ Filter\n" +
 			"                                     │   ├─ (SUBSTRING(customer.c_phone, 1, 2) HASH IN ('13', '31', '23', '29', '30', '18', '17'))\n" +
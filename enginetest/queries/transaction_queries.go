@@ -1065,3 +1065,217 @@ var TransactionTests = []TransactionTest{
 		},
 	},
 }
+
+// TransactionalDDLTests test DDL statements in transactions, for databases whose provider implements
+// sql.TransactionalDDLProvider. Databases are never created or dropped transactionally.
+var TransactionalDDLTests = []TransactionTest{
+	{
+		Name: "transactional DDL rollback",
+		SetUpScript: []string{
+			"create table t1 (pk int primary key, val int)",
+			"insert into t1 values (0, 0)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "/* client a */ start transaction",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ create table t2 (pk int primary key, val int)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "/* client a */ insert into t2 values (1, 1), (2, 2)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "/* client a */ alter table t1 add column extra int",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "/* client a */ insert into t1 values (1, 1, 1)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "/* client a */ select * from t2 order by pk",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				Query:    "/* client a */ rollback",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "/* client a */ select * from t2",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:    "/* client a */ select * from t1 order by pk",
+				Expected: []sql.Row{{0, 0}},
+			},
+			{
+				Query:    "/* client a */ start transaction",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ drop table t1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "/* client a */ rollback",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ select * from t1 order by pk",
+				Expected: []sql.Row{{0, 0}},
+			},
+			{
+				Query:    "/* client a */ start transaction",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ create table t2 (pk int primary key, val int)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "/* client a */ insert into t2 values (1, 1)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "/* client a */ commit",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client b */ select * from t2 order by pk",
+				Expected: []sql.Row{{1, 1}},
+			},
+		},
+	},
+	{
+		Name: "transactional DDL is isolated from other sessions",
+		SetUpScript: []string{
+			"create table t1 (pk int primary key, val int)",
+			"insert into t1 values (0, 0)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "/* client a */ start transaction",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client b */ start transaction",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ create table t2 (pk int primary key)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "/* client a */ drop table t1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "/* client a */ show tables",
+				Expected: []sql.Row{{"t2"}},
+			},
+			{
+				Query:       "/* client b */ select * from t2",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:    "/* client b */ show tables",
+				Expected: []sql.Row{{"t1"}},
+			},
+			{
+				Query:    "/* client a */ rollback",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client b */ select * from t1 order by pk",
+				Expected: []sql.Row{{0, 0}},
+			},
+			{
+				Query:    "/* client a */ start transaction",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ rename table t1 to t3",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "/* client b */ select * from t1 order by pk",
+				Expected: []sql.Row{{0, 0}},
+			},
+			{
+				Query:       "/* client b */ select * from t3",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:    "/* client a */ commit",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client b */ show tables",
+				Expected: []sql.Row{{"t3"}},
+			},
+			{
+				Query:    "/* client b */ select * from t3 order by pk",
+				Expected: []sql.Row{{0, 0}},
+			},
+		},
+	},
+	{
+		Name: "DDL without transactional DDL support implicitly commits",
+		SetUpScript: []string{
+			"create table t1 (pk int primary key, val int)",
+			"insert into t1 values (0, 0)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "/* client a */ start transaction",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ insert into t1 values (1, 1)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				// databases are never created transactionally
+				Query:    "/* client a */ create database otherdb",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query: "/* client a */ show warnings",
+				// MySQL doesn't warn about implicit commits
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ rollback",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client b */ select * from t1 order by pk",
+				Expected: []sql.Row{{0, 0}, {1, 1}},
+			},
+			{
+				Query:    "/* client a */ start transaction",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "/* client a */ create table t2 (pk int primary key)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "/* client a */ drop database otherdb",
+				ExpectedErr: sql.ErrMixedTransactionalDDL,
+			},
+			{
+				Query:    "/* client a */ rollback",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "/* client a */ select * from t2",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+		},
+	},
+}
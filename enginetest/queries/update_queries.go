@@ -712,7 +712,7 @@ var UpdateIgnoreScripts = []ScriptTest{
 			{
 				Query:           "UPDATE IGNORE checksTable SET pk = pk + 1 where pk = 4",
 				Expected:        []sql.Row{{newUpdateResult(1, 0)}},
-				ExpectedWarning: mysql.ERUnknownError,
+				ExpectedWarning: 3819,
 			},
 			{
 				Query:    "SELECT * from checksTable ORDER BY pk",
@@ -897,6 +897,60 @@ var OnUpdateExprScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "datetime on update current_timestamp",
+		SetUpScript: []string{
+			"create table t (i int primary key, last_updated datetime on update current_timestamp, dt datetime(3) default now(3) on update now(3));",
+			"insert into t(i, dt) values (1, '2020-10-02'), (2, '2020-10-02');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show columns from t",
+				Expected: []sql.Row{
+					{"i", "int", "NO", "PRI", "NULL", ""},
+					{"last_updated", "datetime", "YES", "", "NULL", "on update CURRENT_TIMESTAMP"},
+					{"dt", "datetime(3)", "YES", "", "CURRENT_TIMESTAMP(3)", "DEFAULT_GENERATED on update CURRENT_TIMESTAMP(3)"},
+				},
+			},
+			{
+				Query: "select column_name, extra from information_schema.columns where table_name = 't' order by ordinal_position",
+				Expected: []sql.Row{
+					{"i", ""},
+					{"last_updated", "on update CURRENT_TIMESTAMP"},
+					{"dt", "DEFAULT_GENERATED on update CURRENT_TIMESTAMP(3)"},
+				},
+			},
+			{
+				Query: "update t set i = 10 where i = 1;",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}},
+				},
+			},
+			{
+				SkipResultCheckOnServerEngine: true,
+				Query:                         "select * from t order by i;",
+				Expected: []sql.Row{
+					{2, nil, Oct2Midnight},
+					{10, Dec15_1_30, Dec15_1_30},
+				},
+			},
+			{
+				// columns listed in the SET clause are not updated automatically
+				Query: "update t set i = 20, dt = '2020-10-02' where i = 2;",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}},
+				},
+			},
+			{
+				SkipResultCheckOnServerEngine: true,
+				Query:                         "select * from t order by i;",
+				Expected: []sql.Row{
+					{10, Dec15_1_30, Dec15_1_30},
+					{20, Dec15_1_30, Oct2Midnight},
+				},
+			},
+		},
+	},
 	{
 		Name: "precision 3",
 		SetUpScript: []string{
@@ -404,6 +404,94 @@ var VariableQueries = []ScriptTest{
 			{1234, 1234},
 		},
 	},
+	{
+		Name: "set assignments are made from left to right",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "set @@session.auto_increment_increment = @@global.auto_increment_increment + 4, @x = @@session.auto_increment_increment * 2",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @x, @@auto_increment_increment",
+				Expected: []sql.Row{{10, 5}},
+			},
+			{
+				Query:    "set @a = 1, @b = @a + 1, @a = @b * 10, @c = @a",
+				Expected: []sql.Row{{}},
+			},
+			{
+				// the later assignments see the types of the values assigned before them
+				Query:    "select @a, @b, @c",
+				Expected: []sql.Row{{20, 2, 20}},
+			},
+			{
+				Query:    "set @@auto_increment_increment = default, @d = @@auto_increment_increment, @@sql_select_limit = @d + 1",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@auto_increment_increment, @d, @@sql_select_limit",
+				Expected: []sql.Row{{1, 1, 2}},
+			},
+			{
+				Query:    "set @m = 5, @@auto_increment_increment = @m, @n = @@auto_increment_increment + @m, @@sql_select_limit = default",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @m, @@auto_increment_increment, @n, @@sql_select_limit",
+				Expected: []sql.Row{{5, 5, 10, math.MaxInt32}},
+			},
+		},
+	},
+	{
+		Name: "user vars are coerced to the types they store",
+		SetUpScript: []string{
+			"create table t (pk int primary key, d decimal(10,3), b bit(8), j json)",
+			`insert into t values (1, 12.345, b'101', '{"a": [1, 2]}')`,
+			"set @d = (select d from t), @b = (select b from t), @j = (select j from t), @n = null",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select @d, @d + 1",
+				Expected: []sql.Row{{"12.345", "13.345"}},
+				ExpectedColumns: sql.Schema{
+					{Name: "@d", Type: types.MustCreateDecimalType(10, 3)},
+					{Name: "@d + 1", Type: types.MustCreateDecimalType(11, 3)},
+				},
+			},
+			{
+				Query:    "select @b, @b + 1",
+				Expected: []sql.Row{{uint64(5), 6}},
+				ExpectedColumns: sql.Schema{
+					{Name: "@b", Type: types.Uint64},
+					{Name: "@b + 1", Type: types.Int64},
+				},
+			},
+			{
+				Query:    "select @j, json_extract(@j, '$.a[1]'), collation(@j)",
+				Expected: []sql.Row{{`{"a": [1, 2]}`, types.MustJSON("2"), "utf8mb4_bin"}},
+			},
+			{
+				Query:    "select @n, @n + 1, @n is null",
+				Expected: []sql.Row{{nil, nil, true}},
+			},
+			{
+				Query:    "select d, b, j into @d2, @b2, @j2 from t",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @d2, @b2, @j2",
+				Expected: []sql.Row{{"12.345", uint64(5), `{"a": [1, 2]}`}},
+			},
+			{
+				Query:    "set @s = '10'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @s + 1, @s = 10",
+				Expected: []sql.Row{{11.0, true}},
+			},
+		},
+	},
 	{
 		Name: "local is session",
 		SetUpScript: []string{
@@ -198,4 +198,353 @@ CREATE TABLE tab1 (
 			},
 		},
 	},
+	{
+		Name: "insert and update through views",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int)",
+			"create view v as select a, b from t where a < 10",
+			"create view renamed (x, y) as select a, b + 1 from t",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into v values (1, 1), (20, 20)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert into renamed (x) values (2)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "insert into renamed (y) values (3)",
+				ExpectedErr: sql.ErrColumnNotUpdatable,
+			},
+			{
+				Query:       "insert into v (c) values (3)",
+				ExpectedErr: sql.ErrUnknownColumn,
+			},
+			{
+				// only the rows of the view are updated
+				Query:    "update v set b = 5",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "select * from t order by a",
+				Expected: []sql.Row{{1, 5}, {2, 5}, {20, 20}},
+			},
+		},
+	},
+	{
+		Name: "views with check option",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int)",
+			"insert into t values (1, 1), (2, 2)",
+			"create view cascaded_v as select a, b from t where a < 10 with check option",
+			"create view local_v as select * from cascaded_v where a > 0 with local check option",
+			"create view unchecked_v as select a, b from t where a < 10",
+			"create view local_over_unchecked_v as select * from unchecked_v where a > 0 with local check option",
+			"create view cascaded_over_unchecked_v as select * from unchecked_v where a > 0 with cascaded check option",
+			"create view positive_b_v as select a, b from t where b > 0 with check option",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "show create view cascaded_v",
+				Expected: []sql.Row{{"cascaded_v", "CREATE VIEW `cascaded_v` AS select a, b from t where a < 10 WITH CASCADED CHECK OPTION", "utf8mb4", "utf8mb4_0900_bin"}},
+			},
+			{
+				Query: "select table_name, check_option from information_schema.views where table_schema = 'mydb' order by 1",
+				Expected: []sql.Row{
+					{"cascaded_over_unchecked_v", "CASCADED"},
+					{"cascaded_v", "CASCADED"},
+					{"local_over_unchecked_v", "LOCAL"},
+					{"local_v", "LOCAL"},
+					{"positive_b_v", "CASCADED"},
+					{"unchecked_v", "NONE"},
+				},
+			},
+			{
+				Query:    "insert into cascaded_v values (3, 3)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:          "insert into cascaded_v values (10, 10)",
+				ExpectedErrStr: "CHECK OPTION failed 'mydb.cascaded_v'",
+			},
+			{
+				// a row that the condition is NULL for isn't a row of the view
+				Query:       "insert into positive_b_v (a) values (4)",
+				ExpectedErr: sql.ErrViewCheckOptionFailed,
+			},
+			{
+				Query:          "update cascaded_v set a = 11 where a = 1",
+				ExpectedErrStr: "CHECK OPTION failed 'mydb.cascaded_v'",
+			},
+			{
+				Query:          "insert into local_v values (-1, -1)",
+				ExpectedErrStr: "CHECK OPTION failed 'mydb.local_v'",
+			},
+			{
+				// the view that local_v is defined on has a check option of its own
+				Query:          "insert into local_v values (11, 11)",
+				ExpectedErrStr: "CHECK OPTION failed 'mydb.cascaded_v'",
+			},
+			{
+				// the view that local_over_unchecked_v is defined on has no check option
+				Query:    "insert into local_over_unchecked_v values (12, 12)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:          "insert into local_over_unchecked_v values (-2, -2)",
+				ExpectedErrStr: "CHECK OPTION failed 'mydb.local_over_unchecked_v'",
+			},
+			{
+				Query:          "insert into cascaded_over_unchecked_v values (13, 13)",
+				ExpectedErrStr: "CHECK OPTION failed 'mydb.unchecked_v'",
+			},
+			{
+				Query:          "update cascaded_over_unchecked_v set a = a + 10 where a = 2",
+				ExpectedErrStr: "CHECK OPTION failed 'mydb.unchecked_v'",
+			},
+			{
+				Query:    "insert into unchecked_v values (14, 14)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "update local_v set b = b + 1",
+				Expected: []sql.Row{{newUpdateResult(3, 3)}},
+			},
+			{
+				Query:    "select * from t order by a",
+				Expected: []sql.Row{{1, 2}, {2, 3}, {3, 4}, {12, 12}, {14, 14}},
+			},
+			{
+				Query:       "create view grouped_v as select b, count(*) from t group by b with check option",
+				ExpectedErr: sql.ErrViewCheckOptionNonUpdatable,
+			},
+		},
+	},
+	{
+		Name: "check option on join views",
+		SetUpScript: []string{
+			"create table t1 (a int primary key, b int)",
+			"create table t2 (a int primary key, c int)",
+			"insert into t1 values (1, 10), (2, -20)",
+			"insert into t2 values (1, 100), (2, 200)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "create view inner_v as select t1.a, t1.b, t2.c from t1 join t2 on t1.a = t2.a where t1.b > 0 with check option",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from inner_v",
+				Expected: []sql.Row{{1, 10, 100}},
+			},
+			{
+				Query:    "create view cross_v as select t1.a, t2.c from t1, t2 where t1.a = t2.a with local check option",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "create view left_v as select t1.a, t2.c from t1 left join t2 on t1.a = t2.a with check option",
+				ExpectedErr: sql.ErrViewCheckOptionNonUpdatable,
+			},
+			{
+				Query:       "create view union_v as select a from t1 union select a from t2 with check option",
+				ExpectedErr: sql.ErrViewCheckOptionNonUpdatable,
+			},
+			{
+				Query:       "create view distinct_v as select distinct b from t1 with check option",
+				ExpectedErr: sql.ErrViewCheckOptionNonUpdatable,
+			},
+		},
+	},
+	{
+		Name: "create or replace view",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int)",
+			"insert into t values (1, 10), (2, 20), (3, 30)",
+			"create view v1 as select a, b from t where a < 3",
+			"create view v2 as select a from v1 where b > 10",
+			"create definer = `someone`@`%` view v3 as select a from t",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from v2",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "create or replace view v1 as select a + 10 as a, b from t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				// views defined on the replaced view use its new definition
+				Query:    "select * from v2 order by a",
+				Expected: []sql.Row{{12}, {13}},
+			},
+			{
+				Query:    "show create view v1",
+				Expected: []sql.Row{{"v1", "CREATE VIEW `v1` AS select a + 10 as a, b from t", "utf8mb4", "utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "create or replace view v4 as select b from v1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from v4 order by b",
+				Expected: []sql.Row{{10}, {20}, {30}},
+			},
+			{
+				Query:       "create or replace view t as select 1",
+				ExpectedErr: sql.ErrTableAlreadyExists,
+			},
+			{
+				Query:    "create or replace view v3 as select b from t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				// a replaced view keeps its definer
+				Query:    "select table_name, definer, view_definition from information_schema.views where table_name = 'v3'",
+				Expected: []sql.Row{{"v3", "someone@%", "select b from t"}},
+			},
+			{
+				Query:    "create or replace definer = `other`@`localhost` view v3 as select a from t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select table_name, definer from information_schema.views where table_name = 'v3'",
+				Expected: []sql.Row{{"v3", "other@localhost"}},
+			},
+		},
+	},
+	{
+		Name: "alter view",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int)",
+			"insert into t values (1, 10), (2, 20), (3, 30)",
+			"create view v1 as select a, b from t where a < 3",
+			"create view v2 as select a from v1 where b > 10",
+			"create definer = `someone`@`%` view v3 as select a from t",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "alter view v4 as select a from t",
+				ExpectedErr: sql.ErrViewDoesNotExist,
+			},
+			{
+				Query:       "select * from v4",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:       "alter view t as select 1",
+				ExpectedErr: sql.ErrViewDoesNotExist,
+			},
+			{
+				Query:    "alter view v1 as select a + 10 as a, b from t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				// views defined on the altered view use its new definition
+				Query:    "select * from v2 order by a",
+				Expected: []sql.Row{{12}, {13}},
+			},
+			{
+				Query:    "show create view v1",
+				Expected: []sql.Row{{"v1", "CREATE VIEW `v1` AS select a + 10 as a, b from t", "utf8mb4", "utf8mb4_0900_bin"}},
+			},
+			{
+				Query:       "alter view v1 as select a from nonexistent",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				// a failed ALTER VIEW leaves the view unchanged
+				Query:    "select * from v1 order by a",
+				Expected: []sql.Row{{11, 10}, {12, 20}, {13, 30}},
+			},
+			{
+				Query:    "alter SQL SECURITY INVOKER view v3 (x) as select b from t where a = 1",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				// an altered view keeps its definer
+				Query:    "select table_name, definer, security_type, view_definition from information_schema.views where table_name = 'v3'",
+				Expected: []sql.Row{{"v3", "someone@%", "INVOKER", "select b from t where a = 1"}},
+			},
+			{
+				Query:    "select * from v3",
+				Expected: []sql.Row{{10}},
+			},
+			{
+				Query:    "alter view v1 as select a, b from t where b > 10 with check option",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "insert into v1 values (4, 5)",
+				ExpectedErr: sql.ErrViewCheckOptionFailed,
+			},
+		},
+	},
+	{
+		Name: "views of unions",
+		SetUpScript: []string{
+			"create table a (x int primary key, y varchar(10))",
+			"create table b (p bigint primary key, q text)",
+			"insert into a values (1, 'one'), (2, 'two')",
+			"insert into b values (3, 'three')",
+			"create view u as select x, y from a union select p, q from b",
+			"create view d as select distinct x from a",
+			"create view c as select count(*) as n from a",
+			"create view s as select x, y from a",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// the columns are named by the first SELECT, and typed by the union of both
+				Query:    "select * from u order by x",
+				Expected: []sql.Row{{int64(1), "one"}, {int64(2), "two"}, {int64(3), "three"}},
+			},
+			{
+				Query:    "select column_name, data_type, ordinal_position from information_schema.columns where table_name = 'u' order by ordinal_position",
+				Expected: []sql.Row{{"x", "bigint", uint32(1)}, {"y", "text", uint32(2)}},
+			},
+			{
+				Query:    "select table_name, is_updatable from information_schema.views where table_schema = 'mydb' order by table_name",
+				Expected: []sql.Row{{"c", "NO"}, {"d", "NO"}, {"s", "YES"}, {"u", "NO"}},
+			},
+			{
+				Query:       "insert into u values (4, 'four')",
+				ExpectedErr: sql.ErrNonUpdatableTable,
+			},
+			{
+				Query:       "update u set y = 'uno' where x = 1",
+				ExpectedErr: sql.ErrNonUpdatableTable,
+			},
+			{
+				Query:       "delete from u where x = 1",
+				ExpectedErr: sql.ErrNonUpdatableTable,
+			},
+			{
+				Query:       "insert into d values (4)",
+				ExpectedErr: sql.ErrNonUpdatableTable,
+			},
+			{
+				Query:       "update c set n = 1",
+				ExpectedErr: sql.ErrNonUpdatableTable,
+			},
+			{
+				// nothing was written through the views
+				Query:    "select * from a order by x",
+				Expected: []sql.Row{{1, "one"}, {2, "two"}},
+			},
+			{
+				Query:    "alter table b drop column q",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "select * from u",
+				ExpectedErr: sql.ErrInvalidRefInView,
+			},
+			{
+				Query:    "select * from s order by x",
+				Expected: []sql.Row{{1, "one"}, {2, "two"}},
+			},
+		},
+	},
 }
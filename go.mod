@@ -12,7 +12,6 @@ require (
 	github.com/gocraft/dbr/v2 v2.7.2
 	github.com/google/uuid v1.3.0
 	github.com/hashicorp/golang-lru v0.5.4
-	github.com/lestrrat-go/strftime v1.0.4
 	github.com/pkg/errors v0.9.1
 	github.com/pmezard/go-difflib v1.0.0
 	github.com/shopspring/decimal v1.2.0
@@ -44,3 +43,5 @@ require (
 )
 
 go 1.20
+
+replace github.com/dolthub/vitess => ./vitess
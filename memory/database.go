@@ -17,6 +17,7 @@ package memory
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -52,7 +53,9 @@ var _ sql.SchemaValidator = (*BaseDatabase)(nil)
 
 // BaseDatabase is an in-memory database that can't store views, only for testing the engine
 type BaseDatabase struct {
-	name              string
+	name string
+	// mu guards tables, which sessions change when they commit DDL statements
+	mu                *sync.RWMutex
 	tables            map[string]MemTable
 	fkColl            *ForeignKeyCollection
 	triggers          []sql.TriggerDefinition
@@ -60,6 +63,8 @@ type BaseDatabase struct {
 	events            []sql.EventDefinition
 	primaryKeyIndexes bool
 	collation         sql.CollationID
+	// autoIncrements holds the AUTO_INCREMENT values persisted by tables, by lower case table name. Guarded by mu.
+	autoIncrements map[string]uint64
 }
 
 var _ MemoryDatabase = (*Database)(nil)
@@ -76,9 +81,11 @@ func NewDatabase(name string) *Database {
 // NewViewlessDatabase creates a new database that doesn't persist views. Used only for testing. Use NewDatabase.
 func NewViewlessDatabase(name string) *BaseDatabase {
 	return &BaseDatabase{
-		name:   name,
-		tables: map[string]MemTable{},
-		fkColl: newForeignKeyCollection(),
+		name:           name,
+		mu:             &sync.RWMutex{},
+		tables:         map[string]MemTable{},
+		fkColl:         newForeignKeyCollection(),
+		autoIncrements: map[string]uint64{},
 	}
 }
 
@@ -103,6 +110,8 @@ func (d *BaseDatabase) Name() string {
 
 // Tables returns all tables in the database.
 func (d *BaseDatabase) Tables() map[string]sql.Table {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
 	tables := make(map[string]sql.Table, len(d.tables))
 	for name, table := range d.tables {
 		tables[name] = table
@@ -110,8 +119,35 @@ func (d *BaseDatabase) Tables() map[string]sql.Table {
 	return tables
 }
 
+// sessionTables returns all tables in the database as seen by the session in |ctx|, which includes the tables created,
+// dropped, and renamed by DDL statements in its current transaction.
+func (d *BaseDatabase) sessionTables(ctx *sql.Context) map[string]MemTable {
+	d.mu.RLock()
+	tables := make(map[string]MemTable, len(d.tables))
+	for name, table := range d.tables {
+		tables[name] = table
+	}
+	d.mu.RUnlock()
+
+	if sess, ok := ctx.Session.(*Session); ok {
+		for name, table := range sess.pendingTables[d] {
+			if table == nil {
+				delete(tables, name)
+			} else {
+				tables[name] = table
+			}
+		}
+	}
+	return tables
+}
+
 func (d *BaseDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
-	tbl, ok := sql.GetTableInsensitive(tblName, d.Tables())
+	tables := d.sessionTables(ctx)
+	sqlTables := make(map[string]sql.Table, len(tables))
+	for name, table := range tables {
+		sqlTables[name] = table
+	}
+	tbl, ok := sql.GetTableInsensitive(tblName, sqlTables)
 	if !ok {
 		return nil, false, nil
 	}
@@ -132,6 +168,8 @@ func (d *BaseDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sq
 
 // putTable writes the table given into database storage. A table with this name must already be present.
 func (d *BaseDatabase) putTable(t *Table) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 	lowerName := strings.ToLower(t.name)
 	for name, table := range d.tables {
 		if strings.ToLower(name) == lowerName {
@@ -143,9 +181,32 @@ func (d *BaseDatabase) putTable(t *Table) {
 	panic(fmt.Sprintf("table %s not found", t.name))
 }
 
+// applyTableChanges writes the tables created, dropped, and renamed by a transaction into database storage. A nil
+// table in |changes| is a dropped table.
+func (d *BaseDatabase) applyTableChanges(changes map[string]MemTable) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for name, table := range changes {
+		if table == nil {
+			delete(d.tables, name)
+			delete(d.autoIncrements, strings.ToLower(name))
+		} else {
+			d.tables[name] = table
+		}
+	}
+}
+
+// persistAutoIncrement saves the AUTO_INCREMENT value of the table named.
+func (d *BaseDatabase) persistAutoIncrement(name string, val uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.autoIncrements[strings.ToLower(name)] = val
+}
+
 func (d *BaseDatabase) GetTableNames(ctx *sql.Context) ([]string, error) {
-	tblNames := make([]string, 0, len(d.tables))
-	for k := range d.tables {
+	tables := d.sessionTables(ctx)
+	tblNames := make([]string, 0, len(tables))
+	for k := range tables {
 		tblNames = append(tblNames, k)
 	}
 
@@ -153,11 +214,12 @@ func (d *BaseDatabase) GetTableNames(ctx *sql.Context) ([]string, error) {
 }
 
 func (d *BaseDatabase) CreateFulltextTableNames(ctx *sql.Context, parentTableName string, parentIndexName string) (fulltext.IndexTableNames, error) {
+	tables := d.sessionTables(ctx)
 	var tablePrefix string
 OuterLoop:
 	for i := uint64(0); true; i++ {
 		tablePrefix = strings.ToLower(fmt.Sprintf("%s_%s_%d", parentTableName, parentIndexName, i))
-		for tableName := range d.tables {
+		for tableName := range tables {
 			if strings.HasPrefix(strings.ToLower(tableName), tablePrefix) {
 				continue OuterLoop
 			}
@@ -225,17 +287,23 @@ func (db *HistoryDatabase) AddTableAsOf(name string, t sql.Table, asOf interface
 	}
 
 	db.Revisions[strings.ToLower(name)][asOf] = t
-	db.tables[name] = t.(MemTable)
+	db.AddTable(name, t.(MemTable))
 }
 
-// AddTable adds a new table to the database.
+// AddTable adds a new table to the database. A table added with existing data, such as one reloaded after a restart,
+// resumes its AUTO_INCREMENT sequence after both the value persisted for its name and the largest value it contains.
 func (d *BaseDatabase) AddTable(name string, t MemTable) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if table, ok := t.(*Table); ok {
+		table.loadAutoIncrement(d.autoIncrements[strings.ToLower(name)])
+	}
 	d.tables[name] = t
 }
 
 // CreateTable creates a table with the given name and schema
 func (d *BaseDatabase) CreateTable(ctx *sql.Context, name string, schema sql.PrimaryKeySchema, collation sql.CollationID, comment string) error {
-	_, ok := d.tables[name]
+	_, ok := d.sessionTables(ctx)[name]
 	if ok {
 		return sql.ErrTableAlreadyExists.New(name)
 	}
@@ -247,8 +315,8 @@ func (d *BaseDatabase) CreateTable(ctx *sql.Context, name string, schema sql.Pri
 		table.EnablePrimaryKeyIndexes()
 	}
 
-	d.tables[name] = table
 	sess := SessionFromContext(ctx)
+	sess.putTableChange(ctx, d, name, table)
 	sess.putTable(table.data)
 
 	return nil
@@ -256,7 +324,7 @@ func (d *BaseDatabase) CreateTable(ctx *sql.Context, name string, schema sql.Pri
 
 // CreateIndexedTable creates a table with the given name and schema
 func (d *BaseDatabase) CreateIndexedTable(ctx *sql.Context, name string, sch sql.PrimaryKeySchema, idxDef sql.IndexDef, collation sql.CollationID) error {
-	_, ok := d.tables[name]
+	_, ok := d.sessionTables(ctx)[name]
 	if ok {
 		return sql.ErrTableAlreadyExists.New(name)
 	}
@@ -278,31 +346,32 @@ func (d *BaseDatabase) CreateIndexedTable(ctx *sql.Context, name string, sch sql
 		}
 	}
 
-	d.tables[name] = table
+	SessionFromContext(ctx).putTableChange(ctx, d, name, table)
 	return nil
 }
 
 // DropTable drops the table with the given name
 func (d *BaseDatabase) DropTable(ctx *sql.Context, name string) error {
-	t, ok := d.tables[name]
+	t, ok := d.sessionTables(ctx)[name]
 	if !ok {
 		return sql.ErrTableNotFound.New(name)
 	}
 
-	SessionFromContext(ctx).dropTable(t.(*Table).data)
-
-	delete(d.tables, name)
+	sess := SessionFromContext(ctx)
+	sess.dropTable(t.(*Table).data)
+	sess.putTableChange(ctx, d, name, nil)
 	return nil
 }
 
 func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) error {
-	tbl, ok := d.tables[oldName]
+	tables := d.sessionTables(ctx)
+	tbl, ok := tables[oldName]
 	if !ok {
 		// Should be impossible (engine already checks this condition)
 		return sql.ErrTableNotFound.New(oldName)
 	}
 
-	_, ok = d.tables[newName]
+	_, ok = tables[newName]
 	if ok {
 		return sql.ErrTableAlreadyExists.New(newName)
 	}
@@ -324,8 +393,8 @@ func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) er
 	}
 	memTbl.data.tableName = newName
 
-	d.tables[newName] = memTbl
-	delete(d.tables, oldName)
+	sess.putTableChange(ctx, d, oldName, nil)
+	sess.putTableChange(ctx, d, newName, memTbl)
 	sess.putTable(memTbl.data)
 
 	return nil
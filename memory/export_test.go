@@ -0,0 +1,34 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import "github.com/dolthub/go-mysql-server/sql"
+
+// CorruptSecondaryIndex removes every entry of the secondary index named |index| of |t| for the session of |ctx|, so
+// that tests can check that the engine regenerates the index.
+func CorruptSecondaryIndex(ctx *sql.Context, t *Table, index string) {
+	data := t.sessionTableData(ctx)
+	data.secondaryIndexStorage[indexName(index)] = nil
+}
+
+// ReloadTable returns a copy of |t| for |db| with the rows it has for the session of |ctx| but a reset AUTO_INCREMENT
+// value, like a table read back from storage after a restart.
+func ReloadTable(ctx *sql.Context, t *Table, db *BaseDatabase) *Table {
+	data := t.sessionTableData(ctx).copy()
+	if data.autoColIdx >= 0 {
+		data.autoIncVal = 1
+	}
+	return data.Table(db)
+}
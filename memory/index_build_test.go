@@ -0,0 +1,125 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// newIndexBuildEngine returns an engine with a table t of |numPartitions| partitions holding the rows (i, i % |mod|)
+// for i in [0, |numRows|). The table is keyless so that large tables can be inserted quickly.
+func newIndexBuildEngine(t testing.TB, numPartitions, numRows, mod int) (*sqle.Engine, *sql.Context) {
+	db := memory.NewDatabase("mydb")
+	pro := memory.NewDBProvider(db)
+	e := sqle.NewDefault(pro)
+	ctx := newContext(pro)
+	ctx.SetCurrentDatabase("mydb")
+
+	sch := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "t"},
+		{Name: "v", Type: types.Int64, Source: "t", Nullable: true},
+	})
+	db.AddTable("t", memory.NewPartitionedTable(db.BaseDatabase, "t", sch, db.GetForeignKeyCollection(), numPartitions))
+
+	for start := 0; start < numRows; start += 1000 {
+		var values []string
+		for i := start; i < start+1000 && i < numRows; i++ {
+			values = append(values, fmt.Sprintf("(%d, %d)", i, i%mod))
+		}
+		mustExec(t, e, ctx, "insert into t values "+strings.Join(values, ", "))
+	}
+	return e, ctx
+}
+
+func mustExec(t testing.TB, e *sqle.Engine, ctx *sql.Context, q string) []sql.Row {
+	rows, err := exec(e, ctx, q)
+	require.NoError(t, err, q)
+	return rows
+}
+
+func exec(e *sqle.Engine, ctx *sql.Context, q string) ([]sql.Row, error) {
+	_, iter, err := e.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowIterToRows(ctx, iter)
+}
+
+func TestBulkLoadIndex(t *testing.T) {
+	for _, threads := range []int{1, 4} {
+		t.Run(fmt.Sprintf("%d threads", threads), func(t *testing.T) {
+			t.Run("duplicates across partitions", func(t *testing.T) {
+				e, ctx := newIndexBuildEngine(t, 8, 2000, 1000)
+				mustExec(t, e, ctx, fmt.Sprintf("set innodb_ddl_threads = %d", threads))
+
+				// Every value of v appears twice, in rows that are hashed into different partitions
+				_, err := exec(e, ctx, "alter table t add unique index v_idx (v)")
+				require.Error(t, err)
+				require.True(t, sql.ErrUniqueKeyViolation.Is(err), err.Error())
+				require.Contains(t, err.Error(), "[0]")
+
+				rows := mustExec(t, e, ctx, "show indexes from t where Key_name = 'v_idx'")
+				require.Empty(t, rows)
+			})
+
+			t.Run("unique values", func(t *testing.T) {
+				e, ctx := newIndexBuildEngine(t, 8, 2000, 2000)
+				mustExec(t, e, ctx, fmt.Sprintf("set innodb_ddl_threads = %d", threads))
+				mustExec(t, e, ctx, "insert into t values (2000, NULL), (2001, NULL)")
+
+				mustExec(t, e, ctx, "alter table t add unique index v_idx (v)")
+				require.Equal(t, []sql.Row{{int64(1234)}}, mustExec(t, e, ctx, "select pk from t where v = 1234"))
+				require.Equal(t, []sql.Row{{int64(1998)}, {int64(1999)}}, mustExec(t, e, ctx, "select pk from t where v >= 1998 order by v"))
+				require.Equal(t, []sql.Row{{int64(2000)}, {int64(2001)}}, mustExec(t, e, ctx, "select pk from t where v is null order by pk"))
+
+				_, err := exec(e, ctx, "insert into t values (3000, 5)")
+				require.True(t, sql.ErrUniqueKeyViolation.Is(sql.UnwrapError(err)), fmt.Sprint(err))
+			})
+
+			t.Run("non-unique index", func(t *testing.T) {
+				e, ctx := newIndexBuildEngine(t, 8, 2000, 10)
+				mustExec(t, e, ctx, fmt.Sprintf("set innodb_ddl_threads = %d", threads))
+
+				mustExec(t, e, ctx, "alter table t add index v_idx (v)")
+				require.Equal(t, []sql.Row{{int64(200)}}, mustExec(t, e, ctx, "select count(*) from t where v = 3"))
+			})
+		})
+	}
+}
+
+func BenchmarkAddUniqueIndex(b *testing.B) {
+	for _, threads := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("%d threads", threads), func(b *testing.B) {
+			e, ctx := newIndexBuildEngine(b, 16, 100_000, 100_000)
+			mustExec(b, e, ctx, fmt.Sprintf("set innodb_ddl_threads = %d", threads))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				mustExec(b, e, ctx, "alter table t add unique index v_idx (v)")
+				b.StopTimer()
+				mustExec(b, e, ctx, "alter table t drop index v_idx")
+				b.StartTimer()
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ var _ sql.DatabaseProvider = (*DbProvider)(nil)
 var _ sql.MutableDatabaseProvider = (*DbProvider)(nil)
 var _ sql.TableFunctionProvider = (*DbProvider)(nil)
 var _ sql.ExternalStoredProcedureProvider = (*DbProvider)(nil)
+var _ sql.TransactionalDDLProvider = (*DbProvider)(nil)
 
 // DbProvider is a provider for in-memory databases
 type DbProvider struct {
@@ -130,6 +131,13 @@ func (pro *DbProvider) HasDatabase(_ *sql.Context, name string) bool {
 	return ok
 }
 
+// SupportsTransactionalDDL implements the sql.TransactionalDDLProvider interface. Tables created, dropped, or
+// renamed within a transaction are visible only to the session until it commits, and all other schema changes are made
+// to the session's copy of the table's data, like any other write.
+func (pro *DbProvider) SupportsTransactionalDDL(ctx *sql.Context, dbName string) bool {
+	return pro.HasDatabase(ctx, dbName)
+}
+
 // AllDatabases returns the Database with the given name if it exists.
 func (pro *DbProvider) AllDatabases(*sql.Context) []sql.Database {
 	pro.mu.RLock()
@@ -0,0 +1,101 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestRebuildTable(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	pro := memory.NewDBProvider(db)
+	e := sqle.NewDefault(pro)
+	ctx := newContext(pro)
+	ctx.SetCurrentDatabase("mydb")
+
+	query := func(ctx *sql.Context, q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+	mustQuery := func(q string) []sql.Row {
+		rows, err := query(ctx, q)
+		require.NoError(t, err, q)
+		return rows
+	}
+
+	mustQuery("create table t (pk int primary key, v int, key v_idx (v), check (v < 100))")
+	mustQuery("insert into t values (1, 10), (2, 20), (3, 30)")
+	// the rebuild replaces the table in the database
+	getTable := func() *memory.Table {
+		tbl, ok, err := db.GetTableInsensitive(ctx, "t")
+		require.NoError(t, err)
+		require.True(t, ok)
+		return tbl.(*memory.Table)
+	}
+
+	t.Run("the copy rebuild regenerates secondary indexes", func(t *testing.T) {
+		memory.CorruptSecondaryIndex(ctx, getTable(), "v_idx")
+		require.Empty(t, mustQuery("select pk from t where v = 20"))
+
+		require.Equal(t, []sql.Row{{types.NewOkResult(3)}}, mustQuery("alter table t force"))
+		require.Equal(t, []sql.Row{{int32(2)}}, mustQuery("select pk from t where v = 20"))
+		require.Equal(t, []sql.Row{{int32(2)}, {int32(3)}}, mustQuery("select pk from t where v > 10 order by pk"))
+	})
+
+	t.Run("the table is read through a tracked process", func(t *testing.T) {
+		analyzed, err := e.AnalyzeQuery(ctx, "alter table t force")
+		require.NoError(t, err)
+		tracked := false
+		transform.Inspect(analyzed, func(n sql.Node) bool {
+			if rt, ok := n.(*plan.ResolvedTable); ok {
+				_, tracked = rt.Table.(*plan.ProcessIndexableTable)
+				if !tracked {
+					_, tracked = rt.Table.(*plan.ProcessTable)
+				}
+			}
+			return true
+		})
+		require.True(t, tracked)
+	})
+
+	t.Run("killed rebuilds leave the table unchanged", func(t *testing.T) {
+		killed, cancel := ctx.NewSubContext()
+		cancel()
+		_, err := query(killed, "alter table t force")
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, []sql.Row{{int32(1), int32(10)}, {int32(2), int32(20)}, {int32(3), int32(30)}}, mustQuery("select * from t order by pk"))
+	})
+
+	t.Run("the copy rebuild validates check constraints", func(t *testing.T) {
+		// inserting directly into the table skips the check constraint
+		require.NoError(t, getTable().Insert(ctx, sql.NewRow(int32(4), int32(400))))
+		_, err := query(ctx, "alter table t force")
+		require.True(t, sql.ErrCheckConstraintViolated.Is(err), "unexpected error: %v", err)
+		require.Len(t, mustQuery("select * from t"), 4)
+	})
+}
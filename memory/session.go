@@ -27,6 +27,10 @@ type Session struct {
 	dbProvider       sql.DatabaseProvider
 	tables           map[tableKey]*TableData
 	editAccumulators map[tableKey]tableEditAccumulator
+	// pendingTables holds the tables created, dropped, and renamed by DDL statements in the current transaction, by
+	// database and table name. A nil table is a dropped table. They are written to their databases when the
+	// transaction commits.
+	pendingTables    map[*BaseDatabase]map[string]MemTable
 	persistedGlobals GlobalsMap
 	validateCallback func()
 }
@@ -43,6 +47,7 @@ func NewSession(baseSession *sql.BaseSession, provider sql.DatabaseProvider) *Se
 		dbProvider:       provider,
 		tables:           make(map[tableKey]*TableData),
 		editAccumulators: make(map[tableKey]tableEditAccumulator),
+		persistedGlobals: make(GlobalsMap),
 	}
 }
 
@@ -114,15 +119,39 @@ func (s *Session) dropTable(d *TableData) {
 	delete(s.tables, key(d))
 }
 
+// putTableChange records that the table named in the database given was created, renamed, or dropped (for a nil
+// |table|). Within a transaction, the change is visible only to this session until the transaction commits. Otherwise
+// it's written to the database immediately.
+func (s *Session) putTableChange(ctx *sql.Context, d *BaseDatabase, name string, table MemTable) {
+	if ctx.GetTransaction() == nil {
+		d.applyTableChanges(map[string]MemTable{name: table})
+		return
+	}
+	if s.pendingTables == nil {
+		s.pendingTables = make(map[*BaseDatabase]map[string]MemTable)
+	}
+	if s.pendingTables[d] == nil {
+		s.pendingTables[d] = make(map[string]MemTable)
+	}
+	s.pendingTables[d][name] = table
+}
+
 // StartTransaction clears session state and returns a new transaction object.
 // Because we don't support concurrency, we store table data changes in the session, rather than the transaction itself.
 func (s *Session) StartTransaction(ctx *sql.Context, tCharacteristic sql.TransactionCharacteristic) (sql.Transaction, error) {
 	s.tables = make(map[tableKey]*TableData)
 	s.editAccumulators = make(map[tableKey]tableEditAccumulator)
+	s.pendingTables = nil
 	return &Transaction{tCharacteristic == sql.ReadOnly}, nil
 }
 
 func (s *Session) CommitTransaction(ctx *sql.Context, tx sql.Transaction) error {
+	// tables created in this transaction must exist before their data is written
+	for d, changes := range s.pendingTables {
+		d.applyTableChanges(changes)
+	}
+	s.pendingTables = nil
+
 	for key := range s.tables {
 		if key.db == "" && key.table == "" {
 			// dual table
@@ -144,7 +173,11 @@ func (s *Session) CommitTransaction(ctx *sql.Context, tx sql.Transaction) error
 		default:
 			return fmt.Errorf("unknown database type %T", db)
 		}
-		baseDb.putTable(s.tables[key].Table(baseDb))
+		table := s.tables[key].Table(baseDb)
+		baseDb.putTable(table)
+		if err = table.PersistAutoIncrement(ctx); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -153,6 +186,7 @@ func (s *Session) CommitTransaction(ctx *sql.Context, tx sql.Transaction) error
 func (s *Session) Rollback(ctx *sql.Context, transaction sql.Transaction) error {
 	s.tables = make(map[tableKey]*TableData)
 	s.editAccumulators = make(map[tableKey]tableEditAccumulator)
+	s.pendingTables = nil
 	return nil
 }
 
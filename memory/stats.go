@@ -24,7 +24,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cespare/xxhash/v2"
+
 	"github.com/dolthub/go-mysql-server/sql/stats"
+	"github.com/dolthub/go-mysql-server/sql/types"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -86,6 +89,10 @@ func (s *StatsProv) estimateStats(ctx *sql.Context, table sql.Table, keys map[st
 	if err != nil {
 		return err
 	}
+	prefixCnts, err := s.distinctCounts(ctx, table, keys)
+	if err != nil {
+		return err
+	}
 
 	var dataLen uint64
 	var rowCount uint64
@@ -120,16 +127,14 @@ func (s *StatsProv) estimateStats(ctx *sql.Context, table sql.Table, keys map[st
 			}
 		}
 		sort.Slice(keyVals, func(i, j int) bool {
-			k := 0
-			for k < len(ordinals) && keyVals[i][k] == keyVals[j][k] {
-				k++
-			}
-			if k == len(ordinals) {
-				return true
+			// values like []byte can't be compared with ==, so defer to each column's type
+			for k, ord := range ordinals {
+				cmp, _ := sch[ord].Type.Compare(keyVals[i][k], keyVals[j][k])
+				if cmp != 0 {
+					return cmp < 0
+				}
 			}
-			col := sch[ordinals[k]]
-			cmp, _ := col.Type.Compare(keyVals[i][k], keyVals[j][k])
-			return cmp <= 0
+			return false
 		})
 
 		// quick and dirty histogram buckets
@@ -161,7 +166,8 @@ func (s *StatsProv) estimateStats(ctx *sql.Context, table sql.Table, keys map[st
 			return err
 		}
 
-		stat := stats.NewStatistic(rowCount, rowCount, 0, dataLen, time.Now(), qual, cols, types, buckets, sql.IndexClassDefault, nil)
+		cnts := prefixCnts[key]
+		stat := stats.NewStatistic(rowCount, cnts[len(cnts)-1], 0, dataLen, time.Now(), qual, cols, types, buckets, sql.IndexClassDefault, nil).WithPrefixDistinctCounts(cnts)
 
 		// functional dependencies
 		fds, idxCols, err := stats.IndexFds(table.Name(), sch, indexes[strings.ToLower(qual.Index())])
@@ -175,6 +181,84 @@ func (s *StatsProv) estimateStats(ctx *sql.Context, table sql.Table, keys map[st
 	return nil
 }
 
+// distinctCounts returns the exact number of distinct values of each prefix of each key's columns, as identified by
+// their ordinals in the table schema. Unlike the histograms, which are built from a sample, these read every row.
+func (s *StatsProv) distinctCounts(ctx *sql.Context, table sql.Table, keys map[statsKey][]int) (map[statsKey][]uint64, error) {
+	seen := make(map[statsKey][]map[uint64]struct{})
+	for key, ordinals := range keys {
+		seen[key] = make([]map[uint64]struct{}, len(ordinals))
+		for i := range ordinals {
+			seen[key][i] = make(map[uint64]struct{})
+		}
+	}
+
+	sch := table.Schema()
+	partIter, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		part, err := partIter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		rowIter, err := table.PartitionRows(ctx, part)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			row, err := rowIter.Next(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			for key, ordinals := range keys {
+				// each prefix's hash extends the hash of the prefix before it
+				hash := xxhash.New()
+				for i, ord := range ordinals {
+					if err := writeDistinctKey(hash, sch[ord].Type, row[ord]); err != nil {
+						return nil, err
+					}
+					seen[key][i][hash.Sum64()] = struct{}{}
+				}
+			}
+		}
+		if err := rowIter.Close(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	ret := make(map[statsKey][]uint64, len(seen))
+	for key, prefixes := range seen {
+		cnts := make([]uint64, len(prefixes))
+		for i, vals := range prefixes {
+			cnts[i] = uint64(len(vals))
+		}
+		ret[key] = cnts
+	}
+	return ret, nil
+}
+
+// writeDistinctKey writes |v| to |hash| such that values that compare as equal in the type given hash identically.
+func writeDistinctKey(hash *xxhash.Digest, typ sql.Type, v interface{}) error {
+	// separate each value with a nil byte
+	if _, err := hash.Write([]byte{0}); err != nil {
+		return err
+	}
+	if st, ok := typ.(sql.StringType); ok && v != nil {
+		str, err := types.ConvertToString(v, st)
+		if err != nil {
+			return err
+		}
+		return st.Collation().WriteWeightString(hash, str)
+	}
+	_, err := fmt.Fprintf(hash, "%v", v)
+	return err
+}
+
 // reservoirSample selects a random subset of values from the table.
 // Algorithm L from: https://dl.acm.org/doi/pdf/10.1145/198429.198435
 func (s *StatsProv) reservoirSample(ctx *sql.Context, table sql.Table) ([]sql.Row, error) {
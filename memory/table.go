@@ -83,7 +83,9 @@ var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
 var _ sql.PrimaryKeyTable = (*Table)(nil)
 var _ fulltext.IndexAlterableTable = (*Table)(nil)
 var _ sql.IndexBuildingTable = (*Table)(nil)
+var _ sql.IndexBulkLoadingTable = (*Table)(nil)
 var _ sql.Databaseable = (*Table)(nil)
+var _ sql.BatchTable = (*Table)(nil)
 
 // NewTable creates a new Table with the given name and schema. Assigns the default collation, therefore if a different
 // collation is desired, please use NewTableWithCollation.
@@ -487,6 +489,15 @@ func (i *indexScanRowIter) Close(context *sql.Context) error {
 	return nil
 }
 
+// PartitionBatches implements the sql.BatchTable interface.
+func (t *Table) PartitionBatches(ctx *sql.Context, partition sql.Partition) (sql.BatchRowIter, error) {
+	iter, err := t.PartitionRows(ctx, partition)
+	if err != nil {
+		return nil, err
+	}
+	return sql.NewBatchRowIter(iter), nil
+}
+
 // PartitionRows implements the sql.PartitionRows interface.
 func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
 	data := t.sessionTableData(ctx)
@@ -715,19 +726,6 @@ func (i *tableIter) getRow(ctx *sql.Context) (sql.Row, error) {
 	return row, nil
 }
 
-func projectOnRow(columns []int, row sql.Row) sql.Row {
-	if len(columns) < 1 {
-		return row
-	}
-
-	projected := make([]interface{}, len(columns))
-	for i, selected := range columns {
-		projected[i] = row[selected]
-	}
-
-	return projected
-}
-
 func (i *tableIter) getFromIndex(ctx *sql.Context) (sql.Row, error) {
 	data, err := i.indexValues.Next(ctx)
 	if err != nil {
@@ -1115,6 +1113,45 @@ func (t *Table) GetNextAutoIncrementValue(ctx *sql.Context, insertVal interface{
 	return data.autoIncVal, nil
 }
 
+// PersistAutoIncrement implements sql.AutoIncrementTable
+func (t *Table) PersistAutoIncrement(ctx *sql.Context) error {
+	data := t.sessionTableData(ctx)
+	if data.autoColIdx < 0 || t.db == nil {
+		return nil
+	}
+	t.db.persistAutoIncrement(t.name, data.autoIncVal)
+	return nil
+}
+
+// loadAutoIncrement sets the AUTO_INCREMENT value of a table being opened to the value |persisted| for it, or after
+// the largest value in the table if that is larger.
+func (t *Table) loadAutoIncrement(persisted uint64) {
+	data := t.data
+	if data.autoColIdx < 0 {
+		return
+	}
+	if persisted > data.autoIncVal {
+		data.autoIncVal = persisted
+	}
+	autoCol := data.schema.Schema[data.autoColIdx]
+	for _, rows := range data.partitions {
+		for _, row := range rows {
+			if row[data.autoColIdx] == nil {
+				continue
+			}
+			cmp, err := autoCol.Type.Compare(row[data.autoColIdx], data.autoIncVal)
+			if err != nil || cmp < 0 {
+				continue
+			}
+			v, _, err := types.Uint64.Convert(row[data.autoColIdx])
+			if err != nil {
+				continue
+			}
+			data.autoIncVal = v.(uint64) + 1
+		}
+	}
+}
+
 func (t *Table) AddColumn(ctx *sql.Context, column *sql.Column, order *sql.ColumnOrder) error {
 	sess := SessionFromContext(ctx)
 	data := sess.tableData(t)
@@ -1537,6 +1574,16 @@ func (t *FilteredTable) Projections() []string {
 type IndexedTable struct {
 	*Table
 	Lookup sql.IndexLookup
+	limit  uint64
+}
+
+var _ sql.LimitedTable = (*IndexedTable)(nil)
+
+// WithLimit implements sql.LimitedTable
+func (t *IndexedTable) WithLimit(limit uint64) sql.IndexedTable {
+	nt := *t
+	nt.limit = limit
+	return &nt
 }
 
 func (t *IndexedTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLookup) (sql.PartitionIter, error) {
@@ -1620,6 +1667,15 @@ func adjustRangeScanFilterForIndexLookup(filter sql.Expression, index *Index) sq
 	return filter
 }
 
+// PartitionBatches implements the sql.BatchTable interface.
+func (t *IndexedTable) PartitionBatches(ctx *sql.Context, partition sql.Partition) (sql.BatchRowIter, error) {
+	iter, err := t.PartitionRows(ctx, partition)
+	if err != nil {
+		return nil, err
+	}
+	return sql.NewBatchRowIter(iter), nil
+}
+
 // PartitionRows implements the sql.PartitionRows interface.
 func (t *IndexedTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
 	iter, err := t.Table.PartitionRows(ctx, partition)
@@ -1628,11 +1684,8 @@ func (t *IndexedTable) PartitionRows(ctx *sql.Context, partition sql.Partition)
 	}
 
 	// Sorting code below is only for spatial indexes, which use a different partition iterator
-	if _, ok := partition.(indexScanPartition); ok {
-		return iter, nil
-	}
-
-	if t.Lookup.Index != nil {
+	_, isIndexScan := partition.(indexScanPartition)
+	if !isIndexScan && t.Lookup.Index != nil {
 		idx := t.Lookup.Index.(*Index)
 		sf := make(sql.SortFields, len(idx.Exprs))
 		for i, e := range idx.Exprs {
@@ -1662,9 +1715,37 @@ func (t *IndexedTable) PartitionRows(ctx *sql.Context, partition sql.Partition)
 		sort.Stable(sorter)
 	}
 
+	if t.limit > 0 {
+		return &limitedRowIter{child: iter, remaining: t.limit}, nil
+	}
 	return iter, nil
 }
 
+// limitedRowIter returns rows from its child until |remaining| rows have been returned, without reading any further
+// rows from the child.
+type limitedRowIter struct {
+	child     sql.RowIter
+	remaining uint64
+}
+
+var _ sql.RowIter = (*limitedRowIter)(nil)
+
+func (i *limitedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if i.remaining == 0 {
+		return nil, io.EOF
+	}
+	row, err := i.child.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	i.remaining--
+	return row, nil
+}
+
+func (i *limitedRowIter) Close(ctx *sql.Context) error {
+	return i.child.Close(ctx)
+}
+
 func (t *Table) IndexedAccess(lookup sql.IndexLookup) sql.IndexedTable {
 	return &IndexedTable{Table: t, Lookup: lookup}
 }
@@ -1933,13 +2014,6 @@ func (t *Table) createIndex(data *TableData, name string, columns []sql.IndexCol
 		}
 	}
 
-	if constraint == sql.IndexConstraint_Unique {
-		err := data.errIfDuplicateEntryExist(colNames, name)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	return &Index{
 		DB:         t.dbName(),
 		DriverName: "",
@@ -2404,6 +2478,105 @@ func (t *Table) BuildIndex(ctx *sql.Context, indexDef sql.IndexDef) (sql.RowInse
 	return t.getRewriteTableEditor(ctx, data.schema, data.schema), nil
 }
 
+// BulkLoadIndex implements sql.IndexBulkLoadingTable
+func (t *Table) BulkLoadIndex(ctx *sql.Context, indexDef sql.IndexDef) (sql.IndexBulkLoader, error) {
+	sess := SessionFromContext(ctx)
+	data := sess.tableData(t)
+	idx, ok := data.indexes[indexDef.Name]
+	if !ok {
+		return nil, sql.ErrIndexNotFound.New(indexDef.Name)
+	}
+
+	// The loader is given full rows, so we need to find where each of them is stored to write the index entries
+	locations := make(map[uint64][]primaryRowLocation)
+	for _, key := range data.partitionKeys {
+		for i, row := range data.partitions[string(key)] {
+			hash, err := sql.HashOf(row)
+			if err != nil {
+				return nil, err
+			}
+			locations[hash] = append(locations[hash], primaryRowLocation{partition: string(key), idx: i})
+		}
+	}
+
+	return &indexBulkLoader{
+		sess:      sess,
+		data:      data,
+		index:     idx.(*Index),
+		locations: locations,
+	}, nil
+}
+
+// indexBulkLoader writes the entries of a newly created index from the sorted rows given by the engine.
+type indexBulkLoader struct {
+	sess      *Session
+	data      *TableData
+	index     *Index
+	locations map[uint64][]primaryRowLocation
+	storage   []sql.Row
+	discard   bool
+}
+
+var _ sql.IndexBulkLoader = (*indexBulkLoader)(nil)
+
+// LoadBatch implements sql.IndexBulkLoader
+func (l *indexBulkLoader) LoadBatch(ctx *sql.Context, rows []sql.Row) error {
+	for _, row := range rows {
+		loc, err := l.location(row)
+		if err != nil {
+			return err
+		}
+		idxRow, err := l.index.rowToIndexStorage(row, loc.partition, loc.idx)
+		if err != nil {
+			return err
+		}
+		l.storage = append(l.storage, idxRow)
+	}
+	return nil
+}
+
+// location returns the location of |row| in the primary storage. Each location is only returned once, so that
+// identical rows of keyless tables get distinct index entries.
+func (l *indexBulkLoader) location(row sql.Row) (primaryRowLocation, error) {
+	storageRow := l.data.toStorageRow(row)
+	hash, err := sql.HashOf(storageRow)
+	if err != nil {
+		return primaryRowLocation{}, err
+	}
+
+	candidates := l.locations[hash]
+	for i, loc := range candidates {
+		matches, err := l.data.partitions[loc.partition][loc.idx].Equals(storageRow, l.data.schema.PhysicalSchema())
+		if err != nil {
+			return primaryRowLocation{}, err
+		}
+		if matches {
+			l.locations[hash] = append(candidates[:i], candidates[i+1:]...)
+			return loc, nil
+		}
+	}
+	return primaryRowLocation{}, fmt.Errorf("row %s of table %s not found while building index %s",
+		sql.FormatRow(row), l.data.tableName, l.index.Name)
+}
+
+// DiscardChanges implements sql.IndexBulkLoader
+func (l *indexBulkLoader) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	l.discard = true
+	return nil
+}
+
+// Close implements sql.IndexBulkLoader
+func (l *indexBulkLoader) Close(ctx *sql.Context) error {
+	if l.discard {
+		delete(l.data.indexes, l.index.ID())
+		delete(l.data.secondaryIndexStorage, indexName(l.index.ID()))
+	} else {
+		l.data.secondaryIndexStorage[indexName(l.index.ID())] = l.storage
+	}
+	l.sess.putTable(l.data)
+	return nil
+}
+
 // TableRevision is a container for memory tables to run basic smoke tests for versioned queries. It overrides only
 // enough of the Table interface required to pass those tests. Memory tables have a flag to force them to ignore
 // session data and use embedded data, which is required for the versioned table tests to pass.
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/cespare/xxhash/v2"
 
@@ -266,41 +267,6 @@ func (td *TableData) numRows(ctx *sql.Context) (uint64, error) {
 	return count, nil
 }
 
-// throws an error if any two or more rows share the same |cols| values.
-func (td *TableData) errIfDuplicateEntryExist(cols []string, idxName string) error {
-	columnMapping, err := td.columnIndexes(cols)
-	if err != nil {
-		return err
-	}
-	unique := make(map[uint64]struct{})
-	for _, partition := range td.partitions {
-		for _, row := range partition {
-			idxPrefixKey := projectOnRow(columnMapping, row)
-			if hasNulls(idxPrefixKey) {
-				continue
-			}
-			h, err := sql.HashOf(idxPrefixKey)
-			if err != nil {
-				return err
-			}
-			if _, ok := unique[h]; ok {
-				return sql.NewUniqueKeyErr(formatRow(row, columnMapping), false, nil)
-			}
-			unique[h] = struct{}{}
-		}
-	}
-	return nil
-}
-
-func hasNulls(row sql.Row) bool {
-	for _, v := range row {
-		if v == nil {
-			return true
-		}
-	}
-	return false
-}
-
 // getColumnOrdinal returns the index in the schema and column with the name given, if it exists, or -1, nil otherwise.
 func (td *TableData) getColumnOrdinal(col string) (int, *sql.Column) {
 	i := td.schema.IndexOf(col, td.tableName)
@@ -311,19 +277,22 @@ func (td *TableData) getColumnOrdinal(col string) (int, *sql.Column) {
 	return i, td.schema.Schema[i]
 }
 
+// generateCheckName returns the name of an unnamed check constraint added to the table, which is named like MySQL names
+// them: the name of the table followed by "_chk_" and one more than the largest number of the checks of the table that
+// are already named that way.
 func (td *TableData) generateCheckName() string {
-	i := 1
-Top:
-	for {
-		name := fmt.Sprintf("%s_chk_%d", td.tableName, i)
-		for _, check := range td.checks {
-			if check.Name == name {
-				i++
-				continue Top
-			}
+	prefix := strings.ToLower(td.tableName) + "_chk_"
+	last := 0
+	for _, check := range td.checks {
+		name := strings.ToLower(check.Name)
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if n, err := strconv.Atoi(name[len(prefix):]); err == nil && n > last {
+			last = n
 		}
-		return name
 	}
+	return fmt.Sprintf("%s_chk_%d", td.tableName, last+1)
 }
 
 func (td *TableData) indexColsForTableEditor() ([][]int, [][]uint16) {
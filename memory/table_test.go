@@ -19,8 +19,10 @@ import (
 	"io"
 	"testing"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/require"
 
+	sqle "github.com/dolthub/go-mysql-server"
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -250,6 +252,46 @@ func TestTable(t *testing.T) {
 	}
 }
 
+func TestAutoIncrementPersistence(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+	ctx.SetCurrentDatabase("mydb")
+
+	mustQuery := func(ctx *sql.Context, e *sqle.Engine, q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, q)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err, q)
+		return rows
+	}
+
+	e := sqle.NewDefault(pro)
+	mustQuery(ctx, e, "create table t (id int auto_increment primary key, v varchar(10))")
+	mustQuery(ctx, e, "insert into t (v) values ('a'), ('b'), ('c'), ('d'), ('e')")
+	mustQuery(ctx, e, "delete from t where id > 3")
+	tbl, ok, err := db.GetTableInsensitive(ctx, "t")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	t.Run("without a persisted value the sequence resumes after the largest id", func(t *testing.T) {
+		otherDb := memory.NewDatabase("mydb")
+		otherDb.AddTable("t", memory.ReloadTable(ctx, tbl.(*memory.Table), otherDb.BaseDatabase))
+		otherPro := memory.NewDBProvider(otherDb)
+		other := sqle.NewDefault(otherPro)
+		otherCtx := newContext(otherPro)
+		otherCtx.SetCurrentDatabase("mydb")
+		mustQuery(otherCtx, other, "insert into t (v) values ('f'), ('g')")
+		require.Equal(t, []sql.Row{{int32(1)}, {int32(2)}, {int32(3)}, {int32(4)}, {int32(5)}}, mustQuery(otherCtx, other, "select id from t order by id"))
+	})
+
+	t.Run("the persisted value survives a restart", func(t *testing.T) {
+		db.AddTable("t", memory.ReloadTable(ctx, tbl.(*memory.Table), db.BaseDatabase))
+		mustQuery(ctx, e, "insert into t (v) values ('f'), ('g')")
+		require.Equal(t, []sql.Row{{int32(1)}, {int32(2)}, {int32(3)}, {int32(6)}, {int32(7)}}, mustQuery(ctx, e, "select id from t order by id"))
+	})
+}
+
 func TestFiltered(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -378,3 +420,66 @@ func getAllRows(t *testing.T, ctx *sql.Context, table sql.Table) []sql.Row {
 
 	return allRows
 }
+
+func TestIndexedTableWithLimit(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	db.EnablePrimaryKeyIndexes()
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+	ctx.SetCurrentDatabase("mydb")
+
+	e := sqle.NewDefault(pro)
+	for _, q := range []string{
+		"create table t (id int primary key, v varchar(10), index v_idx (v))",
+		"insert into t values (1, 'e'), (2, 'd'), (3, 'c'), (4, 'b'), (5, 'a')",
+	} {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(t, err, q)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err, q)
+	}
+
+	tbl, ok, err := db.GetTableInsensitive(ctx, "t")
+	require.NoError(t, err)
+	require.True(t, ok)
+	indexes, err := tbl.(*memory.Table).GetIndexes(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		index    string
+		typ      sql.Type
+		reverse  bool
+		limit    uint64
+		expected []sql.Row
+	}{
+		{"PRIMARY", types.Int32, false, 2, []sql.Row{{int32(1), "e"}, {int32(2), "d"}}},
+		{"PRIMARY", types.Int32, true, 3, []sql.Row{{int32(5), "a"}, {int32(4), "b"}, {int32(3), "c"}}},
+		{"v_idx", types.MustCreateStringWithDefaults(sqltypes.VarChar, 10), false, 2, []sql.Row{{int32(5), "a"}, {int32(4), "b"}}},
+		{"v_idx", types.MustCreateStringWithDefaults(sqltypes.VarChar, 10), true, 1, []sql.Row{{int32(1), "e"}}},
+		{"PRIMARY", types.Int32, false, 10, []sql.Row{{int32(1), "e"}, {int32(2), "d"}, {int32(3), "c"}, {int32(4), "b"}, {int32(5), "a"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s reverse=%v limit %d", test.index, test.reverse, test.limit), func(t *testing.T) {
+			var idx sql.Index
+			for _, i := range indexes {
+				if i.ID() == test.index {
+					idx = i
+				}
+			}
+			require.NotNil(t, idx)
+
+			lookup := sql.IndexLookup{
+				Index:     idx,
+				Ranges:    sql.RangeCollection{{sql.AllRangeColumnExpr(test.typ)}},
+				IsReverse: test.reverse,
+			}
+			indexed := tbl.(*memory.Table).IndexedAccess(lookup).(sql.LimitedTable).WithLimit(test.limit)
+			pIter, err := indexed.LookupPartitions(ctx, lookup)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, indexed, pIter))
+			require.NoError(t, err)
+			require.Equal(t, test.expected, rows)
+		})
+	}
+}
@@ -84,8 +84,10 @@ func (g *FramerGen) genNewFramer(def frameDef) {
 
 	if def.unit == rang {
 		fmt.Fprintf(g.w, "  var orderBy sql.Expression\n")
+		fmt.Fprintf(g.w, "  var orderByDesc bool\n")
 		fmt.Fprintf(g.w, "  if len(window.OrderBy) > 0 {\n")
 		fmt.Fprintf(g.w, "    orderBy = window.OrderBy.ToExpressions()[0]\n")
+		fmt.Fprintf(g.w, "    orderByDesc = window.OrderBy[0].Order == sql.Descending\n")
 		fmt.Fprintf(g.w, "  }\n")
 	}
 
@@ -96,6 +98,7 @@ func (g *FramerGen) genNewFramer(def frameDef) {
 	case rang:
 		fmt.Fprintf(g.w, "    rangeFramerBase{\n")
 		fmt.Fprintf(g.w, "      orderBy: orderBy,\n")
+		fmt.Fprintf(g.w, "      orderByDesc: orderByDesc,\n")
 	}
 
 	for _, a := range def.Args() {
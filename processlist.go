@@ -151,10 +151,25 @@ func (pl *ProcessList) EndQuery(ctx *sql.Context) {
 		p.Kill()
 		p.Kill = nil
 		p.QueryPid = 0
+		p.State = ""
 		p.Progress = nil
 	}
 }
 
+// UpdateQueryState sets the state of the process with the given pid.
+func (pl *ProcessList) UpdateQueryState(pid uint64, state string) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	id, ok := pl.byQueryPid[pid]
+	if !ok {
+		return
+	}
+	if p, ok := pl.procs[id]; ok {
+		p.State = state
+	}
+}
+
 // UpdateTableProgress updates the progress of the table with the given name for the
 // process with the given pid.
 func (pl *ProcessList) UpdateTableProgress(pid uint64, name string, delta int64) {
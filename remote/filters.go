@@ -0,0 +1,148 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// canPushdown returns whether |e| can be evaluated by the remote server. Only comparisons, boolean logic and IN lists
+// over the columns of |sch| and literals are sent to the remote, and only when the comparisons don't need type
+// conversions whose results could differ between the two servers.
+func canPushdown(e sql.Expression, sch sql.Schema) bool {
+	supported := true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		if cmp, ok := e.(expression.Comparer); ok && !preciseComparison(cmp) {
+			supported = false
+			return false
+		}
+
+		switch e := e.(type) {
+		case *expression.Equals, *expression.NullSafeEquals, *expression.LessThan, *expression.LessThanOrEqual,
+			*expression.GreaterThan, *expression.GreaterThanOrEqual, *expression.And, *expression.Or,
+			*expression.Not, *expression.IsNull, *expression.InTuple, expression.Tuple, *expression.Literal:
+		case *expression.GetField:
+			if sch.IndexOfColName(e.Name()) < 0 {
+				supported = false
+			}
+		default:
+			supported = false
+		}
+		return supported
+	})
+	return supported
+}
+
+// preciseComparison returns whether |cmp| compares its operands the same way on both servers. Strings are compared
+// with the collation of the remote column, which is also the collation of the local column.
+func preciseComparison(cmp expression.Comparer) bool {
+	left, right := cmp.Left().Type(), cmp.Right().Type()
+	if tup, ok := right.(types.TupleType); ok {
+		for _, typ := range tup {
+			if !preciseTypes(left, typ) {
+				return false
+			}
+		}
+		return true
+	}
+	return preciseTypes(left, right)
+}
+
+func preciseTypes(left, right sql.Type) bool {
+	return left.Equals(right) ||
+		(types.IsInteger(left) && types.IsInteger(right)) ||
+		(types.IsText(left) && types.IsText(right))
+}
+
+// filterSQL returns the SQL text of |e|, which must satisfy canPushdown.
+func filterSQL(ctx *sql.Context, e sql.Expression) (string, error) {
+	switch e := e.(type) {
+	case *expression.Equals:
+		return binarySQL(ctx, e.Left(), "=", e.Right())
+	case *expression.NullSafeEquals:
+		return binarySQL(ctx, e.Left(), "<=>", e.Right())
+	case *expression.LessThan:
+		return binarySQL(ctx, e.Left(), "<", e.Right())
+	case *expression.LessThanOrEqual:
+		return binarySQL(ctx, e.Left(), "<=", e.Right())
+	case *expression.GreaterThan:
+		return binarySQL(ctx, e.Left(), ">", e.Right())
+	case *expression.GreaterThanOrEqual:
+		return binarySQL(ctx, e.Left(), ">=", e.Right())
+	case *expression.And:
+		return binarySQL(ctx, e.LeftChild, "AND", e.RightChild)
+	case *expression.Or:
+		return binarySQL(ctx, e.LeftChild, "OR", e.RightChild)
+	case *expression.InTuple:
+		return binarySQL(ctx, e.Left(), "IN", e.Right())
+	case *expression.Not:
+		child, err := filterSQL(ctx, e.Child)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(NOT %s)", child), nil
+	case *expression.IsNull:
+		child, err := filterSQL(ctx, e.Child)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(%s IS NULL)", child), nil
+	case expression.Tuple:
+		elems := make([]string, len(e))
+		for i, elem := range e {
+			s, err := filterSQL(ctx, elem)
+			if err != nil {
+				return "", err
+			}
+			elems[i] = s
+		}
+		return fmt.Sprintf("(%s)", strings.Join(elems, ", ")), nil
+	case *expression.GetField:
+		return quoteIdentifier(e.Name()), nil
+	case *expression.Literal:
+		val, err := e.Type().SQL(ctx, nil, e.Value())
+		if err != nil {
+			return "", err
+		}
+		var buf bytes.Buffer
+		val.EncodeSQL(&buf)
+		return buf.String(), nil
+	default:
+		return "", fmt.Errorf("expression cannot be sent to a remote table: %s", e)
+	}
+}
+
+func binarySQL(ctx *sql.Context, left sql.Expression, op string, right sql.Expression) (string, error) {
+	l, err := filterSQL(ctx, left)
+	if err != nil {
+		return "", err
+	}
+	r, err := filterSQL(ctx, right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", l, op, r), nil
+}
+
+// quoteIdentifier returns |name| quoted with backticks.
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
@@ -0,0 +1,535 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	gosql "database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const autoIncrementExtra = "auto_increment"
+
+// Table is a sql.Table whose rows live in a table of a remote MySQL server. Reads are forwarded to the remote as a
+// SELECT of the projected columns, filtered by the pushed down filters the remote can evaluate and limited by any
+// pushed down row limit. When the connection is
+// writable, inserts, updates and deletes are forwarded one row at a time. Remote writes are not part of the local
+// transaction: each is committed by the remote as soon as it executes.
+type Table struct {
+	name    string
+	conn    Connection
+	db      *gosql.DB
+	schema  sql.Schema
+	comment string
+
+	projection      []string
+	projectedSchema sql.Schema
+	filters         []sql.Expression
+	limit           uint64
+}
+
+var _ sql.Table = (*Table)(nil)
+var _ sql.CommentedTable = (*Table)(nil)
+var _ sql.ProjectedTable = (*Table)(nil)
+var _ sql.PrimaryKeyTable = (*Table)(nil)
+var _ sql.FilteredTable = (*Table)(nil)
+var _ sql.RemoteTable = (*Table)(nil)
+var _ sql.InsertableTable = (*Table)(nil)
+var _ sql.UpdatableTable = (*Table)(nil)
+var _ sql.DeletableTable = (*Table)(nil)
+
+// NewTable returns a table named |name| that forwards to the remote table of |conn|, using a connection pool from
+// |pool|. Its schema is read from the remote's information_schema. When |columns| is non-empty, only the remote
+// columns named are part of the table, in the order given.
+func NewTable(ctx *sql.Context, pool *Pool, name string, conn Connection, columns []string) (*Table, error) {
+	db, err := pool.db(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Table{
+		name: name,
+		conn: conn,
+		db:   db,
+	}
+	remoteSchema, err := t.discoverSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(columns) == 0 {
+		t.schema = remoteSchema
+		return t, nil
+	}
+	t.schema = make(sql.Schema, len(columns))
+	for i, col := range columns {
+		idx := remoteSchema.IndexOfColName(col)
+		if idx < 0 {
+			return nil, ErrRemoteColumnNotFound.New(col, conn.Database, conn.Table)
+		}
+		t.schema[i] = remoteSchema[idx]
+	}
+	return t, nil
+}
+
+// discoverSchema reads the schema of the remote table from the remote's information_schema.
+func (t *Table) discoverSchema(ctx *sql.Context) (sql.Schema, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, t.conn.QueryTimeout)
+	defer cancel()
+
+	rows, err := t.db.QueryContext(queryCtx,
+		"SELECT COLUMN_NAME, COLUMN_TYPE, COLLATION_NAME, IS_NULLABLE, COLUMN_KEY, EXTRA "+
+			"FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION",
+		t.conn.Database, t.conn.Table)
+	if err != nil {
+		return nil, t.mapError(err)
+	}
+	defer rows.Close()
+
+	var sch sql.Schema
+	for rows.Next() {
+		var name, columnType, nullable, key, extra string
+		var collation gosql.NullString
+		if err = rows.Scan(&name, &columnType, &collation, &nullable, &key, &extra); err != nil {
+			return nil, t.mapError(err)
+		}
+		if collation.Valid {
+			columnType = fmt.Sprintf("%s COLLATE %s", columnType, collation.String)
+		}
+		typ, err := planbuilder.ParseColumnTypeString(columnType)
+		if err != nil {
+			return nil, err
+		}
+		col := &sql.Column{
+			Name:       name,
+			Type:       typ,
+			Nullable:   nullable == "YES",
+			PrimaryKey: key == "PRI",
+			Source:     t.name,
+		}
+		// Auto increment values are generated by the remote, so these columns accept NULL locally.
+		if strings.Contains(strings.ToLower(extra), autoIncrementExtra) {
+			col.Nullable = true
+			col.Extra = autoIncrementExtra
+		}
+		sch = append(sch, col)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, t.mapError(err)
+	}
+	if len(sch) == 0 {
+		return nil, ErrRemoteTableNotFound.New(t.conn.Database, t.conn.Table)
+	}
+	return sch, nil
+}
+
+// Name implements the sql.Table interface.
+func (t *Table) Name() string {
+	return t.name
+}
+
+// String implements the sql.Table interface.
+func (t *Table) String() string {
+	return t.name
+}
+
+// Schema implements the sql.Table interface.
+func (t *Table) Schema() sql.Schema {
+	if t.projectedSchema != nil {
+		return t.projectedSchema
+	}
+	return t.schema
+}
+
+// PrimaryKeySchema implements the sql.PrimaryKeyTable interface. It is the schema of every column of the table,
+// regardless of projections.
+func (t *Table) PrimaryKeySchema() sql.PrimaryKeySchema {
+	return sql.NewPrimaryKeySchema(t.schema)
+}
+
+// Collation implements the sql.Table interface.
+func (t *Table) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Comment implements the sql.CommentedTable interface.
+func (t *Table) Comment() string {
+	return t.comment
+}
+
+// Connection returns the connection of the remote table this table forwards to.
+func (t *Table) Connection() Connection {
+	return t.conn
+}
+
+// Partitions implements the sql.Table interface. Remote tables have a single partition.
+func (t *Table) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return sql.PartitionsToPartitionIter(partition{}), nil
+}
+
+// PartitionRows implements the sql.Table interface. Rows are streamed from the remote as they are read, and closing
+// the iterator cancels the remote query, so that a LIMIT that couldn't be pushed down still stops the remote scan.
+func (t *Table) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	query, err := t.selectSQL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, t.conn.QueryTimeout)
+	rows, err := t.db.QueryContext(queryCtx, query)
+	if err != nil {
+		cancel()
+		return nil, t.mapError(err)
+	}
+	return &rowIter{table: t, sch: t.Schema(), rows: rows, cancel: cancel}, nil
+}
+
+// selectSQL returns the query that reads the projected columns of this table, applying its filters and limit.
+func (t *Table) selectSQL(ctx *sql.Context) (string, error) {
+	sch := t.Schema()
+	cols := make([]string, len(sch))
+	for i, col := range sch {
+		cols[i] = quoteIdentifier(col.Name)
+	}
+	if len(cols) == 0 {
+		cols = []string{"1"}
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(cols, ", "), t.qualifiedName())
+	if len(t.filters) > 0 {
+		conds := make([]string, len(t.filters))
+		for i, filter := range t.filters {
+			cond, err := filterSQL(ctx, filter)
+			if err != nil {
+				return "", err
+			}
+			conds[i] = cond
+		}
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	if t.limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", t.limit)
+	}
+	return query, nil
+}
+
+func (t *Table) qualifiedName() string {
+	return quoteIdentifier(t.conn.Database) + "." + quoteIdentifier(t.conn.Table)
+}
+
+func (t *Table) mapError(err error) error {
+	return mapError(t.name, t.conn.QueryTimeout, err)
+}
+
+// WithProjections implements the sql.ProjectedTable interface.
+func (t *Table) WithProjections(cols []string) sql.Table {
+	nt := *t
+	if cols == nil {
+		nt.projection = nil
+		nt.projectedSchema = nil
+		return &nt
+	}
+
+	nt.projection = cols
+	nt.projectedSchema = make(sql.Schema, 0, len(cols))
+	for _, col := range cols {
+		if idx := t.schema.IndexOfColName(col); idx >= 0 {
+			nt.projectedSchema = append(nt.projectedSchema, t.schema[idx])
+		}
+	}
+	return &nt
+}
+
+// Projections implements the sql.ProjectedTable interface.
+func (t *Table) Projections() []string {
+	return t.projection
+}
+
+// Filters implements the sql.FilteredTable interface.
+func (t *Table) Filters() []sql.Expression {
+	return t.filters
+}
+
+// HandledFilters implements the sql.FilteredTable interface. The filters returned are evaluated by the remote server.
+func (t *Table) HandledFilters(filters []sql.Expression) []sql.Expression {
+	var handled []sql.Expression
+	for _, f := range filters {
+		if canPushdown(f, t.schema) {
+			handled = append(handled, f)
+		}
+	}
+	return handled
+}
+
+// WithFilters implements the sql.FilteredTable interface.
+func (t *Table) WithFilters(_ *sql.Context, filters []sql.Expression) sql.Table {
+	nt := *t
+	nt.filters = filters
+	return &nt
+}
+
+// WithLimit implements the sql.RemoteTable interface.
+func (t *Table) WithLimit(limit uint64) sql.Table {
+	nt := *t
+	nt.limit = limit
+	return &nt
+}
+
+// Inserter implements the sql.InsertableTable interface.
+func (t *Table) Inserter(*sql.Context) sql.RowInserter {
+	return &tableEditor{table: t}
+}
+
+// Updater implements the sql.UpdatableTable interface.
+func (t *Table) Updater(*sql.Context) sql.RowUpdater {
+	return &tableEditor{table: t}
+}
+
+// Deleter implements the sql.DeletableTable interface.
+func (t *Table) Deleter(*sql.Context) sql.RowDeleter {
+	return &tableEditor{table: t}
+}
+
+type partition struct{}
+
+var _ sql.Partition = partition{}
+
+// Key implements the sql.Partition interface.
+func (partition) Key() []byte {
+	return nil
+}
+
+// rowIter streams the rows of a remote query, converting them to the types of the table's schema.
+type rowIter struct {
+	table  *Table
+	sch    sql.Schema
+	rows   *gosql.Rows
+	cancel context.CancelFunc
+}
+
+var _ sql.RowIter = (*rowIter)(nil)
+
+// Next implements the sql.RowIter interface.
+func (i *rowIter) Next(*sql.Context) (sql.Row, error) {
+	if !i.rows.Next() {
+		if err := i.rows.Err(); err != nil {
+			return nil, i.table.mapError(err)
+		}
+		return nil, io.EOF
+	}
+
+	if len(i.sch) == 0 {
+		var discard interface{}
+		if err := i.rows.Scan(&discard); err != nil {
+			return nil, i.table.mapError(err)
+		}
+		return sql.Row{}, nil
+	}
+
+	vals := make([]gosql.RawBytes, len(i.sch))
+	dest := make([]interface{}, len(i.sch))
+	for j := range vals {
+		dest[j] = &vals[j]
+	}
+	if err := i.rows.Scan(dest...); err != nil {
+		return nil, i.table.mapError(err)
+	}
+
+	row := make(sql.Row, len(i.sch))
+	for j, val := range vals {
+		if val == nil {
+			continue
+		}
+		var v interface{} = string(val)
+		if types.IsBinaryType(i.sch[j].Type) {
+			v = []byte(val)
+		}
+		converted, _, err := i.sch[j].Type.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		row[j] = converted
+	}
+	return row, nil
+}
+
+// Close implements the sql.RowIter interface.
+func (i *rowIter) Close(*sql.Context) error {
+	// Canceling before closing keeps the driver from reading the rows that are left, which it reports as a canceled
+	// query.
+	i.cancel()
+	if err := i.rows.Close(); err != nil && !errors.Is(err, context.Canceled) {
+		return i.table.mapError(err)
+	}
+	return nil
+}
+
+// tableEditor forwards the rows written to a Table to its remote, one statement per row.
+type tableEditor struct {
+	table *Table
+}
+
+var _ sql.RowInserter = (*tableEditor)(nil)
+var _ sql.RowUpdater = (*tableEditor)(nil)
+var _ sql.RowDeleter = (*tableEditor)(nil)
+
+// StatementBegin implements the sql.EditOpenerCloser interface.
+func (e *tableEditor) StatementBegin(*sql.Context) {}
+
+// DiscardChanges implements the sql.EditOpenerCloser interface. Rows already written to the remote are not reverted.
+func (e *tableEditor) DiscardChanges(*sql.Context, error) error {
+	return nil
+}
+
+// StatementComplete implements the sql.EditOpenerCloser interface.
+func (e *tableEditor) StatementComplete(*sql.Context) error {
+	return nil
+}
+
+// Insert implements the sql.RowInserter interface.
+func (e *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
+	sch := e.table.schema
+	cols := make([]string, 0, len(sch))
+	params := make([]string, 0, len(sch))
+	args := make([]interface{}, 0, len(sch))
+	for i, col := range sch {
+		// A NULL for an auto increment column asks the remote to generate its value.
+		if row[i] == nil && col.Extra == autoIncrementExtra {
+			continue
+		}
+		arg, err := argValue(ctx, col.Type, row[i])
+		if err != nil {
+			return err
+		}
+		cols = append(cols, quoteIdentifier(col.Name))
+		params = append(params, "?")
+		args = append(args, arg)
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", e.table.qualifiedName(), strings.Join(cols, ", "), strings.Join(params, ", "))
+	_, err := e.exec(ctx, query, args...)
+	return err
+}
+
+// Update implements the sql.RowUpdater interface.
+func (e *tableEditor) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	sch := e.table.schema
+	sets := make([]string, len(sch))
+	args := make([]interface{}, 0, len(sch)*2)
+	for i, col := range sch {
+		arg, err := argValue(ctx, col.Type, new[i])
+		if err != nil {
+			return err
+		}
+		sets[i] = quoteIdentifier(col.Name) + " = ?"
+		args = append(args, arg)
+	}
+
+	where, whereArgs, err := e.rowKey(ctx, old)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s LIMIT 1", e.table.qualifiedName(), strings.Join(sets, ", "), where)
+	_, err = e.exec(ctx, query, append(args, whereArgs...)...)
+	return err
+}
+
+// Delete implements the sql.RowDeleter interface.
+func (e *tableEditor) Delete(ctx *sql.Context, row sql.Row) error {
+	where, args, err := e.rowKey(ctx, row)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf("DELETE FROM %s WHERE %s LIMIT 1", e.table.qualifiedName(), where)
+	res, err := e.exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return sql.ErrDeleteRowNotFound.New()
+	}
+	return nil
+}
+
+// Close implements the sql.Closer interface.
+func (e *tableEditor) Close(*sql.Context) error {
+	return nil
+}
+
+// rowKey returns a condition matching |row| in the remote table, using the primary key columns if there are any and
+// every column otherwise.
+func (e *tableEditor) rowKey(ctx *sql.Context, row sql.Row) (string, []interface{}, error) {
+	sch := e.table.schema
+	var conds []string
+	var args []interface{}
+	for _, usePk := range []bool{true, false} {
+		for i, col := range sch {
+			if usePk && !col.PrimaryKey {
+				continue
+			}
+			arg, err := argValue(ctx, col.Type, row[i])
+			if err != nil {
+				return "", nil, err
+			}
+			conds = append(conds, quoteIdentifier(col.Name)+" <=> ?")
+			args = append(args, arg)
+		}
+		if len(conds) > 0 {
+			break
+		}
+	}
+	return strings.Join(conds, " AND "), args, nil
+}
+
+func (e *tableEditor) exec(ctx *sql.Context, query string, args ...interface{}) (gosql.Result, error) {
+	if !e.table.conn.Writable {
+		return nil, ErrReadOnlyRemoteTable.New(e.table.name)
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, e.table.conn.QueryTimeout)
+	defer cancel()
+	res, err := e.table.db.ExecContext(queryCtx, query, args...)
+	if err != nil {
+		return nil, e.table.mapError(err)
+	}
+	return res, nil
+}
+
+// argValue returns |v|, a value of type |typ|, as a query argument for the remote.
+func argValue(ctx *sql.Context, typ sql.Type, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	val, err := typ.SQL(ctx, nil, v)
+	if err != nil {
+		return nil, err
+	}
+	if val.Type() == sqltypes.Null {
+		return nil, nil
+	}
+	if types.IsBinaryType(typ) {
+		return val.ToBytes(), nil
+	}
+	return val.ToString(), nil
+}
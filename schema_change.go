@@ -0,0 +1,290 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// AddSchemaChangeListener registers |listener| to be notified of the DDL statements executed by this engine.
+func (e *Engine) AddSchemaChangeListener(listener sql.SchemaChangeListener) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.schemaChangeListeners = append(e.schemaChangeListeners, listener)
+}
+
+// beforeSchemaChange notifies the registered listeners of the schema changes that the analyzed |node| is about to
+// make, returning the first error a listener returns. The events that listeners were notified of are returned, so
+// that they can be notified again once the changes have been applied.
+func (e *Engine) beforeSchemaChange(ctx *sql.Context, query string, node sql.Node) ([]sql.SchemaChangeListener, []sql.SchemaChangeEvent, error) {
+	e.mu.Lock()
+	listeners := e.schemaChangeListeners
+	e.mu.Unlock()
+	if len(listeners) == 0 {
+		return nil, nil, nil
+	}
+
+	events, err := e.schemaChangeEvents(ctx, query, node)
+	if err != nil || len(events) == 0 {
+		return nil, nil, err
+	}
+	for _, event := range events {
+		for _, listener := range listeners {
+			if err := listener.BeforeSchemaChange(ctx, event); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return listeners, events, nil
+}
+
+// schemaChangeEvents returns the events describing the changes that the analyzed |node| makes to tables, views,
+// triggers and indexes, if it is a DDL statement.
+func (e *Engine) schemaChangeEvents(ctx *sql.Context, query string, node sql.Node) ([]sql.SchemaChangeEvent, error) {
+	if qp, ok := node.(*plan.QueryProcess); ok {
+		node = qp.Child()
+	}
+	if tc, ok := node.(*plan.TransactionCommittingNode); ok {
+		node = tc.Child()
+	}
+
+	switch n := node.(type) {
+	case *plan.CreateTable:
+		db := n.Database()
+		if n.IfNotExists() {
+			if _, exists, err := db.GetTableInsensitive(ctx, n.Name()); err != nil {
+				return nil, err
+			} else if exists {
+				return nil, nil
+			}
+		}
+		newSch := n.PkSchema().Schema
+		if like := n.Like(); like != nil {
+			newSch = like.Schema()
+		}
+		return []sql.SchemaChangeEvent{{
+			Type:      sql.SchemaChangeType_CreateTable,
+			Database:  db.Name(),
+			Table:     n.Name(),
+			NewSchema: newSch,
+			Query:     query,
+		}}, nil
+	case *plan.DropTable:
+		var events []sql.SchemaChangeEvent
+		for _, table := range n.Tables {
+			// tables that don't exist are only given for DROP TABLE IF EXISTS
+			if rt, ok := table.(*plan.ResolvedTable); ok {
+				events = append(events, sql.SchemaChangeEvent{
+					Type:      sql.SchemaChangeType_DropTable,
+					Database:  rt.Database().Name(),
+					Table:     rt.Name(),
+					OldSchema: rt.Schema(),
+					Query:     query,
+				})
+			}
+		}
+		return events, nil
+	case *plan.CreateView:
+		return []sql.SchemaChangeEvent{{
+			Type:      sql.SchemaChangeType_CreateView,
+			Database:  n.Database().Name(),
+			Table:     n.Name,
+			NewSchema: n.Definition.Schema(),
+			Query:     query,
+		}}, nil
+	case *plan.DropView:
+		var events []sql.SchemaChangeEvent
+		for _, child := range n.Children() {
+			if dv, ok := child.(*plan.SingleDropView); ok {
+				events = append(events, sql.SchemaChangeEvent{
+					Type:     sql.SchemaChangeType_DropView,
+					Database: dv.Database().Name(),
+					Table:    dv.ViewName,
+					Query:    query,
+				})
+			}
+		}
+		return events, nil
+	case *plan.CreateTrigger:
+		_, table, _ := tableNodeName(n.Table)
+		return []sql.SchemaChangeEvent{{
+			Type:     sql.SchemaChangeType_CreateTrigger,
+			Database: n.Database().Name(),
+			Table:    table,
+			Name:     n.TriggerName,
+			Query:    query,
+		}}, nil
+	case *plan.DropTrigger:
+		return []sql.SchemaChangeEvent{{
+			Type:     sql.SchemaChangeType_DropTrigger,
+			Database: n.Database().Name(),
+			Name:     n.TriggerName,
+			Query:    query,
+		}}, nil
+	case *plan.CreateIndex:
+		db, table, ok := tableNodeName(n.Table)
+		if !ok {
+			return nil, nil
+		}
+		sch := n.Table.Schema()
+		return []sql.SchemaChangeEvent{{
+			Type:      sql.SchemaChangeType_CreateIndex,
+			Database:  db,
+			Table:     table,
+			Name:      n.Name,
+			OldSchema: sch,
+			NewSchema: sch,
+			Query:     query,
+		}}, nil
+	}
+
+	return e.alterTableEvents(ctx, query, node)
+}
+
+// alterTableEvents returns the event describing the change that the ALTER TABLE statement |node| makes, or nothing if
+// |node| is not one. An ALTER TABLE statement with more than one clause is a block of the nodes for each clause.
+func (e *Engine) alterTableEvents(ctx *sql.Context, query string, node sql.Node) ([]sql.SchemaChangeEvent, error) {
+	clauses := []sql.Node{node}
+	if b, ok := node.(*plan.Block); ok {
+		clauses = b.Children()
+	}
+
+	var dbName, tableName string
+	eventType := sql.SchemaChangeType_AlterTable
+	var indexName string
+	for i, clause := range clauses {
+		db, table, ok := alterTableClauseTarget(clause)
+		if !ok || (i > 0 && (db != dbName || table != tableName)) {
+			return nil, nil
+		}
+		dbName, tableName = db, table
+	}
+	if len(clauses) == 1 {
+		// CREATE INDEX and DROP INDEX are built as the ALTER TABLE clauses that have the same effect
+		if ai, ok := clauses[0].(*plan.AlterIndex); ok {
+			switch ai.Action {
+			case plan.IndexAction_Create:
+				eventType, indexName = sql.SchemaChangeType_CreateIndex, ai.IndexName
+			case plan.IndexAction_Drop:
+				eventType, indexName = sql.SchemaChangeType_DropIndex, ai.IndexName
+			}
+		}
+	}
+
+	table, _, err := e.Analyzer.Catalog.Table(ctx, dbName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	oldSch := table.Schema()
+	newSch, err := analyzer.AlteredSchema(ctx, e.Analyzer, node, oldSch)
+	if err != nil {
+		return nil, err
+	}
+	return []sql.SchemaChangeEvent{{
+		Type:      eventType,
+		Database:  dbName,
+		Table:     tableName,
+		Name:      indexName,
+		OldSchema: oldSch,
+		NewSchema: newSch,
+		Query:     query,
+	}}, nil
+}
+
+// alterTableClauseTarget returns the names of the database and table changed by |n|, and whether |n| is an ALTER TABLE
+// clause.
+func alterTableClauseTarget(n sql.Node) (string, string, bool) {
+	switch n := n.(type) {
+	case *plan.AddColumn:
+		return tableNodeName(n.Table)
+	case *plan.DropColumn:
+		return tableNodeName(n.Table)
+	case *plan.RenameColumn:
+		return tableNodeName(n.Table)
+	case *plan.ModifyColumn:
+		return tableNodeName(n.Table)
+	case *plan.AlterPK:
+		return tableNodeName(n.Table)
+	case *plan.AlterIndex:
+		return tableNodeName(n.Table)
+	case *plan.AlterDefaultSet:
+		return tableNodeName(n.Table)
+	case *plan.AlterDefaultDrop:
+		return tableNodeName(n.Table)
+	case *plan.AlterAutoIncrement:
+		return tableNodeName(n.Table)
+	case *plan.AlterTableCollation:
+		return tableNodeName(n.Table)
+	case *plan.CreateCheck:
+		return tableNodeName(n.Table)
+	case *plan.DropCheck:
+		return tableNodeName(n.Table)
+	case *plan.CreateForeignKey:
+		return n.FkDef.Database, n.FkDef.Table, true
+	case *plan.DropForeignKey:
+		return n.Database(), n.Table, true
+	default:
+		return "", "", false
+	}
+}
+
+// tableNodeName returns the names of the database and table of the table node |n|, and whether |n| is one.
+func tableNodeName(n sql.Node) (string, string, bool) {
+	tn, ok := n.(sql.TableNode)
+	if !ok || tn.Database() == nil {
+		return "", "", false
+	}
+	return tn.Database().Name(), tn.Name(), true
+}
+
+// schemaChangeIter notifies schema change listeners once the statement whose rows it returns has completed without
+// error.
+type schemaChangeIter struct {
+	iter      sql.RowIter
+	listeners []sql.SchemaChangeListener
+	events    []sql.SchemaChangeEvent
+	failed    bool
+}
+
+var _ sql.RowIter = (*schemaChangeIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (i *schemaChangeIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.iter.Next(ctx)
+	if err != nil && err != io.EOF {
+		i.failed = true
+	}
+	return row, err
+}
+
+// Close implements the interface sql.RowIter.
+func (i *schemaChangeIter) Close(ctx *sql.Context) error {
+	if err := i.iter.Close(ctx); err != nil {
+		return err
+	}
+	if i.failed {
+		return nil
+	}
+	for _, event := range i.events {
+		for _, listener := range i.listeners {
+			listener.AfterSchemaChange(ctx, event)
+		}
+	}
+	return nil
+}
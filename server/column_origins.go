@@ -0,0 +1,169 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"strings"
+	"sync"
+
+	querypb "github.com/dolthub/vitess/go/vt/proto/query"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// columnOrigin is where a column of a result set comes from, as reported in the table, org_table and org_name of its
+// column definition. The zero value is a derived expression, which MySQL reports with all of them empty.
+type columnOrigin struct {
+	// database is the database of the table the column is read from.
+	database string
+	// table is the table or table alias the column is read from.
+	table string
+	// orgTable is the name of the table the column is read from, before any alias.
+	orgTable string
+	// orgName is the name of the column in its table, before any alias.
+	orgName string
+}
+
+// resultColumnOrigins returns the origins of the columns of the result schema of the plan given, or nil if they can't
+// be determined from the plan, in which case the schema's names are used as is.
+func resultColumnOrigins(n sql.Node) []columnOrigin {
+	switch n := n.(type) {
+	case *plan.QueryProcess, *plan.Limit, *plan.Offset, *plan.Sort, *plan.TopN, *plan.Distinct, *plan.OrderedDistinct,
+		*plan.Filter, *plan.Having, *plan.Max1Row:
+		return resultColumnOrigins(n.Children()[0])
+	case *plan.Project:
+		return projectionOrigins(n.Projections, n.Child)
+	case *plan.GroupBy:
+		return projectionOrigins(n.SelectedExprs, n.Child)
+	case *plan.Window:
+		return projectionOrigins(n.SelectExprs, n.Child)
+	case *plan.TableAlias:
+		origins := resultColumnOrigins(n.Child)
+		for i := range origins {
+			origins[i].table = n.Name()
+		}
+		return origins
+	case *plan.JoinNode:
+		left, right := resultColumnOrigins(n.Left()), resultColumnOrigins(n.Right())
+		if left == nil || right == nil {
+			return nil
+		}
+		return append(left, right...)
+	case sql.TableNode:
+		var database string
+		if db := n.Database(); db != nil {
+			database = db.Name()
+		}
+		origins := make([]columnOrigin, len(n.Schema()))
+		for i, c := range n.Schema() {
+			origins[i] = columnOrigin{
+				database: database,
+				table:    c.Source,
+				orgTable: n.UnderlyingTable().Name(),
+				orgName:  c.Name,
+			}
+		}
+		return origins
+	default:
+		return nil
+	}
+}
+
+// projectionOrigins returns the origins of the columns of a projection over |child|. Columns that are read directly
+// from |child|, possibly under an alias, keep the origins they have in it; any other expression is derived.
+func projectionOrigins(projections []sql.Expression, child sql.Node) []columnOrigin {
+	childSchema := child.Schema()
+	childOrigins := resultColumnOrigins(child)
+	origins := make([]columnOrigin, len(projections))
+	for i, e := range projections {
+		if alias, ok := e.(*expression.Alias); ok {
+			e = alias.Child
+		}
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			continue
+		}
+		origins[i] = columnOrigin{database: gf.Database(), table: gf.Table(), orgTable: gf.Table(), orgName: gf.Name()}
+		if childOrigins == nil {
+			continue
+		}
+		for j, c := range childSchema {
+			if strings.EqualFold(c.Source, gf.Table()) && strings.EqualFold(c.Name, gf.Name()) {
+				origins[i] = childOrigins[j]
+				break
+			}
+		}
+	}
+	return origins
+}
+
+// withColumnOrigins sets the table and original names of the fields given to the origins given. The fields are left
+// as they are if the origins don't match them.
+func withColumnOrigins(fields []*querypb.Field, origins []columnOrigin) []*querypb.Field {
+	if len(origins) != len(fields) {
+		return fields
+	}
+	for i, origin := range origins {
+		fields[i].Database = origin.database
+		fields[i].Table = origin.table
+		fields[i].OrgTable = origin.orgTable
+		fields[i].OrgName = origin.orgName
+	}
+	return fields
+}
+
+// nodeToFields returns the column definitions of the result schema of the plan given, with the table and original
+// names of each column derived from the plan.
+func nodeToFields(ctx *sql.Context, n sql.Node) []*querypb.Field {
+	return withColumnOrigins(schemaToFields(ctx, n.Schema()), resultColumnOrigins(n))
+}
+
+// preparedOrigins holds the column origins of the prepared statements of each connection, so that the results of
+// COM_STMT_EXECUTE, which are built without the prepared plan, can report the same names as COM_STMT_PREPARE. The zero
+// value is ready to use.
+type preparedOrigins struct {
+	mu      sync.Mutex
+	origins map[uint32]map[string][]columnOrigin
+}
+
+// set records the column origins of the prepared statement |query| of the connection given.
+func (p *preparedOrigins) set(connID uint32, query string, origins []columnOrigin) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.origins == nil {
+		p.origins = make(map[uint32]map[string][]columnOrigin)
+	}
+	if _, ok := p.origins[connID]; !ok {
+		p.origins[connID] = make(map[string][]columnOrigin)
+	}
+	p.origins[connID][query] = origins
+}
+
+// get returns the column origins of the prepared statement |query| of the connection given, or nil if it wasn't
+// prepared.
+func (p *preparedOrigins) get(connID uint32, query string) []columnOrigin {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.origins[connID][query]
+}
+
+// clear removes the column origins of all the prepared statements of the connection given.
+func (p *preparedOrigins) clear(connID uint32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.origins, connID)
+}
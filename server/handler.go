@@ -72,6 +72,7 @@ type Handler struct {
 	maxLoggedQueryLen int
 	encodeLoggedQuery bool
 	sel               ServerEventListener
+	preparedOrigins   preparedOrigins
 }
 
 var _ mysql.Handler = (*Handler)(nil)
@@ -121,7 +122,9 @@ func (h *Handler) ComPrepare(c *mysql.Conn, query string, prepare *mysql.Prepare
 		return nil, nil
 	}
 
-	return schemaToFields(ctx, analyzed.Schema()), nil
+	origins := resultColumnOrigins(analyzed)
+	h.preparedOrigins.set(c.ConnectionID, query, origins)
+	return withColumnOrigins(schemaToFields(ctx, analyzed.Schema()), origins), nil
 }
 
 // These nodes will eventually return an OK result, but their intermediate forms here return a different schema
@@ -155,9 +158,7 @@ func (h *Handler) ComPrepareParsed(c *mysql.Conn, query string, parsed sqlparser
 	// The return result fields should only be directly translated if it doesn't correspond to an OK result.
 	// See comment in ComPrepare
 	if !(nodeReturnsOkResultSchema(analyzed) || types.IsOkResultSchema(analyzed.Schema())) {
-		fields = nil
-	} else {
-		fields = schemaToFields(ctx, analyzed.Schema())
+		fields = nodeToFields(ctx, analyzed)
 	}
 
 	return analyzed, fields, nil
@@ -179,7 +180,7 @@ func (h *Handler) ComBind(c *mysql.Conn, query string, parsedQuery mysql.ParsedQ
 		return nil, nil, err
 	}
 
-	return queryPlan, schemaToFields(ctx, queryPlan.Schema()), nil
+	return queryPlan, nodeToFields(ctx, queryPlan), nil
 }
 
 func (h *Handler) ComExecuteBound(c *mysql.Conn, query string, boundQuery mysql.BoundQuery, callback mysql.ResultSpoolFn) error {
@@ -215,6 +216,7 @@ func (h *Handler) ComResetConnection(c *mysql.Conn) error {
 	// Dispose of the connection's current session
 	h.maybeReleaseAllLocks(c)
 	h.e.CloseSession(c.ConnectionID)
+	h.preparedOrigins.clear(c.ConnectionID)
 
 	// Create a new session and set the current database
 	err := h.sm.NewSession(context.Background(), c)
@@ -244,6 +246,7 @@ func (h *Handler) ConnectionClosed(c *mysql.Conn) {
 
 	defer h.sm.RemoveConn(c)
 	defer h.e.CloseSession(c.ConnectionID)
+	defer h.preparedOrigins.clear(c.ConnectionID)
 
 	h.maybeReleaseAllLocks(c)
 
@@ -318,10 +321,12 @@ func (h *Handler) doQuery(
 	if parsed == nil {
 		_, inPreparedCache := h.e.PreparedDataCache.GetCachedStmt(ctx.Session.ID(), query)
 		if mode == MultiStmtModeOn && !inPreparedCache {
+			parseStart := time.Now()
 			parsed, prequery, remainder, err = planbuilder.ParseOnly(ctx, query, true)
 			if prequery != "" {
 				query = prequery
 			}
+			ctx.TraceHook().OnParse(ctx, query, time.Since(parseStart), err)
 		}
 	}
 
@@ -370,6 +375,15 @@ func (h *Handler) doQuery(
 		return remainder, err
 	}
 
+	// The column origins of the results are taken from the plan, which is only available here for bound plans and for
+	// statements that were prepared on this connection
+	var origins []columnOrigin
+	if analyzedPlan != nil {
+		origins = resultColumnOrigins(analyzedPlan)
+	} else {
+		origins = h.preparedOrigins.get(c.ConnectionID, query)
+	}
+
 	var rowChan chan sql.Row
 
 	rowChan = make(chan sql.Row, 512)
@@ -428,7 +442,7 @@ func (h *Handler) doQuery(
 		defer wg.Done()
 		for {
 			if r == nil {
-				r = &sqltypes.Result{Fields: schemaToFields(ctx, schema)}
+				r = &sqltypes.Result{Fields: withColumnOrigins(schemaToFields(ctx, schema), origins)}
 			}
 
 			if r.RowsAffected == rowsBatch {
@@ -730,24 +744,26 @@ func row2ToSQL(s sql.Schema, row sql.Row2) ([]sqltypes.Value, error) {
 	return o, nil
 }
 
+// notFixedDecimals is the number of decimals of a column without a fixed number of decimals, like a FLOAT or DOUBLE.
+const notFixedDecimals = 31
+
 func schemaToFields(ctx *sql.Context, s sql.Schema) []*querypb.Field {
 	charSetResults := ctx.GetCharacterSetResults()
 	fields := make([]*querypb.Field, len(s))
 	for i, c := range s {
-		charset := uint32(sql.Collation_Default.CharacterSet())
-		if collatedType, ok := c.Type.(sql.TypeWithCollation); ok {
+		// Types without a collation, like numbers and dates, and binary types always use the binary character set and
+		// are flagged as binary, as MySQL does. Non-binary types must respect character_set_results if it is set.
+		var flags querypb.MySqlFlag
+		charset := uint32(sql.CharacterSet_binary)
+		if collatedType, ok := c.Type.(sql.TypeWithCollation); ok && !types.IsBinaryType(c.Type) {
 			charset = uint32(collatedType.Collation().CharacterSet())
+			if charSetResults != sql.CharacterSet_Unspecified {
+				charset = uint32(charSetResults)
+			}
+		} else if !types.IsNumber(c.Type) {
+			flags = flags | querypb.MySqlFlag_BINARY_FLAG
 		}
 
-		// Binary types always use a binary collation, but non-binary types must
-		// respect character_set_results if it is set.
-		if types.IsBinaryType(c.Type) {
-			charset = uint32(sql.Collation_binary)
-		} else if charSetResults != sql.CharacterSet_Unspecified {
-			charset = uint32(charSetResults)
-		}
-
-		var flags querypb.MySqlFlag
 		if !c.Nullable {
 			flags = flags | querypb.MySqlFlag_NOT_NULL_FLAG
 		}
@@ -779,6 +795,9 @@ func schemaToFields(ctx *sql.Context, s sql.Schema) []*querypb.Field {
 		} else if types.IsDatetimeType(c.Type) {
 			dtType := c.Type.(sql.DatetimeType)
 			fields[i].Decimals = uint32(dtType.Precision())
+		} else if types.IsFloat(c.Type) {
+			// Floating point types have no fixed number of decimals, which MySQL reports as 31
+			fields[i].Decimals = notFixedDecimals
 		}
 	}
 
@@ -373,6 +373,24 @@ func (a *Analyzer) LogDiff(prev, next sql.Node) {
 	}
 }
 
+// traceRule records the application of |rule| to the optimizer trace, if it changed the textual representation of
+// the plan.
+func traceRule(t *sql.OptimizerTracer, batch string, rule RuleId, prev, next sql.Node) {
+	before, after := sql.DebugString(prev), sql.DebugString(next)
+	if before == after {
+		return
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:       difflib.SplitLines(before),
+		B:       difflib.SplitLines(after),
+		Context: 1,
+	})
+	if err != nil {
+		diff = after
+	}
+	t.AddRule(batch, rule.String(), diff)
+}
+
 // PushDebugContext pushes the given context string onto the context stack, to use when logging debug messages.
 func (a *Analyzer) PushDebugContext(msg string) {
 	if a != nil && a.Debug {
@@ -408,8 +426,7 @@ func NewProcRuleSelector(sel RuleSelector) RuleSelector {
 			// once after default rules should only be run once
 			AutocommitId,
 			TrackProcessId,
-			parallelizeId,
-			clearWarningsId:
+			parallelizeId:
 			return false
 		}
 		return sel(id)
@@ -31,7 +31,8 @@ func applyHashIn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, s
 		e, same, err := transform.Expr(filter.Expression, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 			if e, ok := expr.(*expression.InTuple); ok &&
 				hasSingleOutput(e.Left()) &&
-				isStatic(e.Right()) {
+				isStatic(e.Right()) &&
+				!convertsInOperands(e) {
 				newe, err := expression.NewHashInTuple(ctx, e.Left(), e.Right())
 				if err != nil {
 					return nil, transform.SameTree, err
@@ -69,6 +70,21 @@ func hasSingleOutput(e sql.Expression) bool {
 	})
 }
 
+// convertsInOperands checks if comparing the left side of an IN expression with any element of its tuple converts
+// them to a different kind of value, which can't be hashed as the type of the left side
+func convertsInOperands(e *expression.InTuple) bool {
+	tup, ok := e.Right().(expression.Tuple)
+	if !ok {
+		return false
+	}
+	for _, el := range tup {
+		if expression.ConvertsOperands(e.Left().Type(), el.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
 // isStatic checks if an expression is static
 func isStatic(e sql.Expression) bool {
 	return !transform.InspectExpr(e, func(expr sql.Expression) bool {
@@ -482,7 +482,7 @@ func TestApplyHashIn(t *testing.T) {
 						"char",
 					),
 					expression.NewTuple(
-						expression.NewLiteral(int64(0), types.Int64),
+						expression.NewLiteral("0", types.LongText),
 					),
 				),
 				child,
@@ -494,6 +494,27 @@ func TestApplyHashIn(t *testing.T) {
 						expression.NewGetField(0, types.Int64, "foo", false),
 						"char",
 					),
+					expression.NewTuple(
+						expression.NewLiteral("0", types.LongText),
+					),
+				),
+				child,
+			),
+		},
+		{
+			name: "skip filter comparing string with number",
+			node: plan.NewFilter(
+				expression.NewInTuple(
+					expression.NewGetField(3, types.MustCreateStringWithDefaults(sqltypes.VarChar, 20), "foo", false),
+					expression.NewTuple(
+						expression.NewLiteral(int64(0), types.Int64),
+					),
+				),
+				child,
+			),
+			expected: plan.NewFilter(
+				expression.NewInTuple(
+					expression.NewGetField(3, types.MustCreateStringWithDefaults(sqltypes.VarChar, 20), "foo", false),
 					expression.NewTuple(
 						expression.NewLiteral(int64(0), types.Int64),
 					),
@@ -367,6 +367,9 @@ func extractJoinColumnExpr(e sql.Expression) (leftCol *joinColExpr, rightCol *jo
 		if leftField == nil || rightField == nil {
 			return nil, nil
 		}
+		if expression.ConvertsOperands(left.Type(), right.Type()) {
+			return nil, nil
+		}
 
 		_, matchnull := e.(*expression.NullSafeEquals)
 
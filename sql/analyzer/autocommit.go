@@ -31,7 +31,63 @@ func addAutocommitNode(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Sc
 		return n, transform.SameTree, nil
 	}
 
-	return plan.NewTransactionCommittingNode(n), transform.NewTree, nil
+	tc := plan.NewTransactionCommittingNode(n)
+	if plan.IsDDLNode(n) {
+		mode, err := ddlTransactionMode(ctx, a, n)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		tc.DDLMode = mode
+	}
+	return tc, transform.NewTree, nil
+}
+
+// ddlTransactionMode returns whether the DDL statement given is applied within the current transaction, which is only
+// the case when every database it touches supports transactional DDL. Statements that create, drop, or alter entire
+// databases always implicitly commit the current transaction.
+func ddlTransactionMode(ctx *sql.Context, a *Analyzer, n sql.Node) (plan.DDLTransactionMode, error) {
+	provider, ok := a.Catalog.DbProvider.(sql.TransactionalDDLProvider)
+	if !ok {
+		return plan.DDLTransactionMode_ImplicitCommit, nil
+	}
+
+	// A Block wraps a set of ALTER TABLE statements, each of which names its own database
+	statements := []sql.Node{n}
+	if block, ok := n.(*plan.Block); ok {
+		statements = block.Children()
+	}
+
+	var dbNames []string
+	for _, stmt := range statements {
+		switch stmt := stmt.(type) {
+		case *plan.CreateDB, *plan.DropDB, *plan.AlterDB:
+			return plan.DDLTransactionMode_ImplicitCommit, nil
+		case sql.Databaser:
+			if db := stmt.Database(); db != nil {
+				dbNames = append(dbNames, db.Name())
+			}
+		}
+	}
+	if len(dbNames) == 0 {
+		dbNames = append(dbNames, ctx.GetCurrentDatabase())
+	}
+
+	var supported, unsupported bool
+	for _, dbName := range dbNames {
+		if provider.SupportsTransactionalDDL(ctx, dbName) {
+			supported = true
+		} else {
+			unsupported = true
+		}
+	}
+	switch {
+	case !unsupported:
+		return plan.DDLTransactionMode_Transactional, nil
+	case !supported:
+		return plan.DDLTransactionMode_ImplicitCommit, nil
+	default:
+		return plan.DDLTransactionMode_None, sql.ErrMixedTransactionalDDL.New()
+	}
 }
 
 func hasShowWarningsNode(n sql.Node) bool {
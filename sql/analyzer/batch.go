@@ -93,6 +93,9 @@ func (b *Batch) evalOnce(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.
 			// We should only do this if the result has changed, but some rules currently misbehave and falsely report nothing
 			// changed
 			a.LogDiff(prev, next)
+			if t := ctx.OptimizerTracer(); t != nil {
+				traceRule(t, b.Desc, rule.Id, prev, next)
+			}
 		}
 		a.PopDebugContext()
 		if err != nil {
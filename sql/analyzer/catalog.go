@@ -33,6 +33,10 @@ type Catalog struct {
 	InfoSchema    sql.Database
 	StatsProvider sql.StatsProvider
 
+	// PerformanceSchema holds the replication status tables of performance_schema. It is resolvable by name but is
+	// not listed by AllDatabases.
+	PerformanceSchema sql.Database
+
 	DbProvider       sql.DatabaseProvider
 	builtInFunctions function.Registry
 
@@ -40,6 +44,16 @@ type Catalog struct {
 	// replication messages (e.g. "start replica").
 	BinlogReplicaController binlogreplication.BinlogReplicaController
 
+	// BinlogPrimaryController holds an optional controller that reports on replicas connected to this
+	// server when it acts as a binlog replication source (e.g. "show replicas").
+	BinlogPrimaryController binlogreplication.BinlogPrimaryController
+
+	// MetadataLocks holds the metadata locks that the sessions of the server hold on tables.
+	MetadataLocks *sql.MetadataLockManager
+
+	// ResourceGroups holds the resource groups of the server, and the groups that sessions are assigned to.
+	ResourceGroups *sql.ResourceGroupRegistry
+
 	mu    sync.RWMutex
 	locks sessionLocks
 }
@@ -53,6 +67,9 @@ var _ sql.FunctionProvider = (*Catalog)(nil)
 var _ sql.TableFunctionProvider = (*Catalog)(nil)
 var _ sql.ExternalStoredProcedureProvider = (*Catalog)(nil)
 var _ binlogreplication.BinlogReplicaCatalog = (*Catalog)(nil)
+var _ binlogreplication.BinlogPrimaryCatalog = (*Catalog)(nil)
+var _ sql.MetadataLockCatalog = (*Catalog)(nil)
+var _ sql.ResourceGroupCatalog = (*Catalog)(nil)
 
 type tableLocks map[string]struct{}
 
@@ -63,12 +80,15 @@ type sessionLocks map[uint32]dbLocks
 // NewCatalog returns a new empty Catalog with the given provider
 func NewCatalog(provider sql.DatabaseProvider) *Catalog {
 	return &Catalog{
-		MySQLDb:          mysql_db.CreateEmptyMySQLDb(),
-		InfoSchema:       information_schema.NewInformationSchemaDatabase(),
-		DbProvider:       provider,
-		builtInFunctions: function.NewRegistry(),
-		StatsProvider:    memory.NewStatsProv(),
-		locks:            make(sessionLocks),
+		MySQLDb:           mysql_db.CreateEmptyMySQLDb(),
+		InfoSchema:        information_schema.NewInformationSchemaDatabase(),
+		PerformanceSchema: information_schema.NewPerformanceSchemaDatabase(),
+		DbProvider:        provider,
+		builtInFunctions:  function.NewRegistry(),
+		StatsProvider:     memory.NewStatsProv(),
+		MetadataLocks:     sql.NewMetadataLockManager(),
+		ResourceGroups:    sql.NewResourceGroupRegistry(),
+		locks:             make(sessionLocks),
 	}
 }
 
@@ -77,6 +97,16 @@ func NewDatabaseProvider(dbs ...sql.Database) sql.DatabaseProvider {
 	return sql.NewDatabaseProvider(dbs...)
 }
 
+// GetMetadataLockManager implements the sql.MetadataLockCatalog interface.
+func (c *Catalog) GetMetadataLockManager() *sql.MetadataLockManager {
+	return c.MetadataLocks
+}
+
+// GetResourceGroupRegistry implements the sql.ResourceGroupCatalog interface.
+func (c *Catalog) GetResourceGroupRegistry() *sql.ResourceGroupRegistry {
+	return c.ResourceGroups
+}
+
 func (c *Catalog) IsBinlogReplicaCatalog() bool {
 	return c.BinlogReplicaController != nil
 }
@@ -85,6 +115,14 @@ func (c *Catalog) GetBinlogReplicaController() binlogreplication.BinlogReplicaCo
 	return c.BinlogReplicaController
 }
 
+func (c *Catalog) IsBinlogPrimaryCatalog() bool {
+	return c.BinlogPrimaryController != nil
+}
+
+func (c *Catalog) GetBinlogPrimaryController() binlogreplication.BinlogPrimaryController {
+	return c.BinlogPrimaryController
+}
+
 func (c *Catalog) WithTableFunctions(fns ...sql.TableFunction) (sql.TableFunctionProvider, error) {
 	if tfp, ok := c.DbProvider.(sql.TableFunctionProvider); !ok {
 		return nil, fmt.Errorf("catalog does not implement sql.TableFunctionProvider")
@@ -156,6 +194,8 @@ func (c *Catalog) HasDatabase(ctx *sql.Context, db string) bool {
 	db = strings.ToLower(db)
 	if db == "information_schema" {
 		return true
+	} else if db == sql.PerformanceSchemaDatabaseName && c.PerformanceSchema != nil {
+		return true
 	} else if c.MySQLDb.Enabled() {
 		return mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.DbProvider).HasDatabase(ctx, db)
 	} else {
@@ -167,6 +207,8 @@ func (c *Catalog) HasDatabase(ctx *sql.Context, db string) bool {
 func (c *Catalog) Database(ctx *sql.Context, db string) (sql.Database, error) {
 	if strings.ToLower(db) == "information_schema" {
 		return c.InfoSchema, nil
+	} else if strings.ToLower(db) == sql.PerformanceSchemaDatabaseName && c.PerformanceSchema != nil {
+		return c.PerformanceSchema, nil
 	} else if c.MySQLDb.Enabled() {
 		return mysql_db.NewPrivilegedDatabaseProvider(c.MySQLDb, c.DbProvider).Database(ctx, db)
 	} else {
@@ -121,6 +121,12 @@ func getCostedIndexScan(ctx *sql.Context, statsProv sql.StatsProvider, rt sql.Ta
 
 	// flatten expression tree for costing
 	c := newIndexCoster(ctx, rt.Name())
+	var chosen string
+	if c.tracer != nil {
+		defer func() {
+			c.traceSelection(rt.Name(), filters, chosen)
+		}()
+	}
 	root, leftover, imprecise := c.flatten(expression.JoinAnd(filters...))
 	if root == nil {
 		return nil, nil, nil, err
@@ -255,6 +261,7 @@ func getCostedIndexScan(ctx *sql.Context, statsProv sql.StatsProvider, rt sql.Ta
 		retFilters = b.leftover
 	}
 
+	chosen = idx.ID()
 	return ret, c.bestStat, retFilters, nil
 }
 
@@ -353,6 +360,7 @@ func newIndexCoster(ctx *sql.Context, underlyingName string) *indexCoster {
 		i:              1,
 		idToExpr:       make(map[indexScanId]sql.Expression),
 		underlyingName: underlyingName,
+		tracer:         ctx.OptimizerTracer(),
 	}
 }
 
@@ -370,6 +378,10 @@ type indexCoster struct {
 	// prefix key of the best indexScan
 	bestPrefix     int
 	underlyingName string
+	// tracer is non-nil when optimizer tracing is enabled
+	tracer *sql.OptimizerTracer
+	// candidates are the indexes costed so far, only recorded when tracing
+	candidates []sql.OptimizerTraceIndexCandidate
 }
 
 // cost tries to build the lowest cardinality index scan for an expression
@@ -410,10 +422,46 @@ func (c *indexCoster) cost(f indexFilter, stat sql.Statistic, idx sql.Index) err
 		panic("unreachable")
 	}
 
+	if c.tracer != nil {
+		var rowCount uint64
+		if newStat != nil {
+			rowCount = newStat.RowCount()
+		}
+		c.candidates = append(c.candidates, sql.OptimizerTraceIndexCandidate{
+			Index:    idx.ID(),
+			RowCount: rowCount,
+			Usable:   filters.Len() > 0,
+		})
+	}
+
 	c.updateBest(newStat, filters, prefix)
 	return nil
 }
 
+// traceSelection records the indexes costed for |table| to the optimizer trace, along with the reason each index
+// that was not |chosen| was rejected.
+func (c *indexCoster) traceSelection(table string, filters []sql.Expression, chosen string) {
+	candidates := make([]sql.OptimizerTraceIndexCandidate, len(c.candidates))
+	for i, cand := range c.candidates {
+		switch {
+		case chosen != "" && strings.EqualFold(cand.Index, chosen):
+			cand.Chosen = true
+		case !cand.Usable:
+			cand.Cause = "no filters match a prefix of the index"
+		case chosen == "":
+			cand.Cause = "index scan is not restrictive enough, table scan preferred"
+		default:
+			cand.Cause = "cost higher than chosen index"
+		}
+		candidates[i] = cand
+	}
+	c.tracer.AddIndexSelection(sql.OptimizerTraceIndexSelection{
+		Table:      table,
+		Filters:    expression.JoinAnd(filters...).String(),
+		Candidates: candidates,
+	})
+}
+
 func (c *indexCoster) updateBest(s sql.Statistic, filters sql.FastIntSet, prefix int) {
 	if s == nil || filters.Len() == 0 {
 		return
@@ -1383,8 +1431,8 @@ func newLeaf(ctx *sql.Context, id indexScanId, e sql.Expression, underlying stri
 		tup := right.(expression.Tuple)
 		var litSet []interface{}
 		for _, lit := range tup {
-			value, err := lit.Eval(ctx, nil)
-			if err != nil {
+			value, ok := indexScanValue(ctx, gf, lit)
+			if !ok {
 				return nil, false
 			}
 			litSet = append(litSet, value)
@@ -1392,14 +1440,33 @@ func newLeaf(ctx *sql.Context, id indexScanId, e sql.Expression, underlying stri
 		return &iScanLeaf{id: id, gf: gf, op: op, setValues: litSet, underlying: underlying}, true
 	}
 
-	value, err := right.Eval(ctx, nil)
-	if err != nil {
+	value, ok := indexScanValue(ctx, gf, right)
+	if !ok {
 		return nil, false
 	}
 
 	return &iScanLeaf{id: id, gf: gf, op: op, litValue: value, underlying: underlying}, true
 }
 
+// indexScanValue evaluates |e|, which |gf| is compared with, for a lookup in an index on |gf|. A comparison that
+// converts the column to another type can't use its index, and one that converts the value can only if the value
+// converts to the column's type without changing the result of the comparison.
+func indexScanValue(ctx *sql.Context, gf *expression.GetField, e sql.Expression) (interface{}, bool) {
+	if expression.ConvertedForComparison(gf.Type(), e.Type()) {
+		return nil, false
+	}
+
+	value, err := e.Eval(ctx, nil)
+	if err != nil {
+		return nil, false
+	}
+
+	if expression.ConvertsOperands(gf.Type(), e.Type()) {
+		return expression.ConvertLosslessly(value, e.Type(), gf.Type())
+	}
+	return value, true
+}
+
 const dummyNotUniqueDistinct = .90
 const dummyNotUniqueNull = .03
 
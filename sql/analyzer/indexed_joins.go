@@ -212,6 +212,18 @@ func replanJoin(ctx *sql.Context, n *plan.JoinNode, a *Analyzer, scope *plan.Sco
 	if a.Verbose && a.Debug {
 		a.Log(m.String())
 	}
+	if t := ctx.OptimizerTracer(); t != nil {
+		best, err := m.BestRootPlan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t.AddJoinOrder(sql.OptimizerTraceJoinOrder{
+			Memo:     m.String(),
+			Cost:     m.Root().Cost,
+			BestPlan: sql.DebugString(best),
+		})
+		return best, nil
+	}
 	return m.BestRootPlan(ctx)
 }
 
@@ -370,14 +382,20 @@ func keyForExpr(targetCol sql.ColumnId, tableId sql.TableId, filters []sql.Expre
 			right = e.Right()
 		default:
 		}
+		var col *expression.GetField
 		if ref, ok := left.(*expression.GetField); ok && ref.Id() == targetCol {
-			key = right
+			key, col = right, ref
 		} else if ref, ok := right.(*expression.GetField); ok && ref.Id() == targetCol {
-			key = left
+			key, col = left, ref
 		} else {
 			continue
 		}
 
+		// the key must be compared with the column as the column's type to be looked up in its index
+		if expression.ConvertsOperands(col.Type(), key.Type()) {
+			continue
+		}
+
 		if sq, ok := key.(*plan.Subquery); ok && !sq.Correlated().Empty() {
 			continue
 		}
@@ -532,8 +550,13 @@ func convertAntiToLeftJoin(m *memo.Memo) error {
 		// project is a new group
 		rightGrp := m.MemoizeProject(nil, anti.Right, projectExpressions)
 
-		// join is a new group
-		joinGrp := m.MemoizeLeftJoin(nil, anti.Left, rightGrp, plan.JoinTypeLeftOuterExcludeNulls, anti.Filter)
+		// join is a new group. The left join for NOT IN excludes the rows whose filter is NULL, which NOT IN doesn't
+		// return, while NOT EXISTS returns them.
+		leftOp := plan.JoinTypeLeftOuter
+		if anti.Op == plan.JoinTypeAntiIncludeNulls {
+			leftOp = plan.JoinTypeLeftOuterExcludeNulls
+		}
+		joinGrp := m.MemoizeLeftJoin(nil, anti.Left, rightGrp, leftOp, anti.Filter)
 
 		// drop null projected columns on right table
 		nullFilters := make([]sql.Expression, len(nullify))
@@ -761,6 +784,10 @@ func addHashJoins(m *memo.Memo) error {
 		for _, f := range join.Filter {
 			switch f := f.(type) {
 			case *expression.Equals:
+				// values that are converted to be compared don't hash to the same keys
+				if expression.ConvertsOperands(f.Left().Type(), f.Right().Type()) {
+					return nil
+				}
 				if satisfiesScalarRefs(f.Left(), join.Left.RelProps.OutputTables()) &&
 					satisfiesScalarRefs(f.Right(), join.Right.RelProps.OutputTables()) {
 					fromExpr = append(fromExpr, f.Right())
@@ -1018,6 +1045,11 @@ func addMergeJoins(m *memo.Memo) error {
 					continue
 				}
 
+				// the sides must be ordered the same way by their indexes
+				if expression.ConvertsOperands(l.Type(), r.Type()) {
+					continue
+				}
+
 				var swap bool
 				if expressionReferencesTable(l, leftTabId) &&
 					expressionReferencesTable(r, rightTabId) {
@@ -0,0 +1,150 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// pushdownLimitToIndexScan pushes a literal Limit, plus any literal Offset beneath it, into a static
+// IndexedTableAccess whose table implements sql.LimitedTable, or into a sql.RemoteTable. This runs after replaceIdxSort and insertTopN, so an
+// ORDER BY that matches the index has already been replaced by the index-ordered scan, and one that doesn't is still
+// a TopN between the Limit and the table, which stops the pushdown. The Limit and Offset nodes are kept: the table
+// limit only bounds how many rows are read from each partition, or from the other server.
+func pushdownLimitToIndexScan(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		l, ok := n.(*plan.Limit)
+		if !ok || l.Percent || l.WithTies || l.CalcFoundRows {
+			return n, transform.SameTree, nil
+		}
+		limit, ok := literalRowCount(l.Limit)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+
+		child := l.Child
+		if o, ok := child.(*plan.Offset); ok {
+			offset, ok := literalRowCount(o.Offset)
+			if !ok || limit+offset < limit {
+				return n, transform.SameTree, nil
+			}
+			newChild, same, err := pushLimitToTable(o.Child, limit+offset)
+			if err != nil || same {
+				return n, transform.SameTree, err
+			}
+			child, err = o.WithChildren(newChild)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+		} else {
+			newChild, same, err := pushLimitToTable(child, limit)
+			if err != nil || same {
+				return n, transform.SameTree, err
+			}
+			child = newChild
+		}
+
+		ret, err := l.WithChildren(child)
+		return ret, transform.NewTree, err
+	})
+}
+
+// pushLimitToTable sets |limit| on the IndexedTableAccess or remote table beneath |n|, looking only through nodes that
+// return exactly one row for each row of their child, and through filters that the remote table evaluates itself.
+func pushLimitToTable(n sql.Node, limit uint64) (sql.Node, transform.TreeIdentity, error) {
+	switch n := n.(type) {
+	case *plan.IndexedTableAccess:
+		if !n.IsStatic() || limit == 0 {
+			return n, transform.SameTree, nil
+		}
+		if _, ok := n.Table.(sql.LimitedTable); !ok {
+			return n, transform.SameTree, nil
+		}
+		if n.Limit() > 0 && n.Limit() <= limit {
+			return n, transform.SameTree, nil
+		}
+		return n.WithLimit(limit), transform.NewTree, nil
+	case *plan.ResolvedTable:
+		remote, ok := n.Table.(sql.RemoteTable)
+		if !ok || limit == 0 {
+			return n, transform.SameTree, nil
+		}
+		ret, err := n.WithTable(remote.WithLimit(limit))
+		return ret, transform.NewTree, err
+	case *plan.Filter:
+		if !filteredByRemoteTable(n) {
+			return n, transform.SameTree, nil
+		}
+		child, same, err := pushLimitToTable(n.Child, limit)
+		if err != nil || same {
+			return n, transform.SameTree, err
+		}
+		ret, err := n.WithChildren(child)
+		return ret, transform.NewTree, err
+	case *plan.Project, *plan.TableAlias:
+		child, same, err := pushLimitToTable(n.Children()[0], limit)
+		if err != nil || same {
+			return n, transform.SameTree, err
+		}
+		ret, err := n.WithChildren(child)
+		return ret, transform.NewTree, err
+	default:
+		return n, transform.SameTree, nil
+	}
+}
+
+// filteredByRemoteTable returns whether every predicate of |f| is one of the filters of the remote table beneath it,
+// so that the rows of the table all pass |f|.
+func filteredByRemoteTable(f *plan.Filter) bool {
+	child := f.Child
+	if ta, ok := child.(*plan.TableAlias); ok {
+		child = ta.Child
+	}
+	rt, ok := child.(*plan.ResolvedTable)
+	if !ok {
+		return false
+	}
+	remote, ok := rt.Table.(sql.RemoteTable)
+	if !ok {
+		return false
+	}
+	remoteFilters := make(map[string]struct{}, len(remote.Filters()))
+	for _, filter := range remote.Filters() {
+		remoteFilters[filter.String()] = struct{}{}
+	}
+	for _, filter := range expression.SplitConjunction(f.Expression) {
+		if _, ok := remoteFilters[filter.String()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// literalRowCount returns the value of a LIMIT or OFFSET expression, if it is a non-negative literal.
+func literalRowCount(e sql.Expression) (uint64, bool) {
+	lit, ok := e.(*expression.Literal)
+	if !ok {
+		return 0, false
+	}
+	val, inRange, err := types.Uint64.Convert(lit.Value())
+	if err != nil || !inRange || val == nil {
+		return 0, false
+	}
+	return val.(uint64), true
+}
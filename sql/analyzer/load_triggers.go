@@ -52,6 +52,9 @@ func loadTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope,
 			for _, trigger := range loadedTriggers {
 				if strings.ToLower(trigger.TriggerName) == lowercasedTriggerName {
 					node.TriggerName = trigger.TriggerName
+					if table, ok := trigger.Table.(sql.Nameable); ok {
+						node.Table = table.Name()
+					}
 				} else if trigger.TriggerOrder != nil &&
 					strings.ToLower(trigger.TriggerOrder.OtherTriggerName) == lowercasedTriggerName {
 					return nil, transform.SameTree, sql.ErrTriggerCannotBeDropped.New(node.TriggerName, trigger.TriggerName)
@@ -74,8 +74,15 @@ func pushFilters(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, s
 	return transform.Node(n, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
 		switch n := node.(type) {
 		case *plan.Filter:
-			switch n.Child.(type) {
-			case *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.ValueDerivedTable:
+			switch child := n.Child.(type) {
+			case *plan.TableAlias, *plan.ResolvedTable:
+				// can't push any lower, but a remote table may filter its own rows
+				newChild, same, err := pushdownFiltersIntoTable(ctx, a, n.Expression, child)
+				if err != nil || same {
+					return n, transform.SameTree, err
+				}
+				return plan.NewFilter(n.Expression, newChild), transform.NewTree, nil
+			case *plan.IndexedTableAccess, *plan.ValueDerivedTable:
 				// can't push any lower
 				return n, transform.SameTree, nil
 			default:
@@ -259,7 +266,11 @@ func pushdownFiltersToAboveTable(
 	switch tableNode.(type) {
 	case *plan.ResolvedTable, *plan.TableAlias, *plan.ValueDerivedTable:
 		if pushedDownFilterExpression != nil {
-			return plan.NewFilter(pushedDownFilterExpression, tableNode), transform.NewTree, nil
+			newTableNode, _, err := pushdownFiltersIntoTable(ctx, a, pushedDownFilterExpression, tableNode)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			return plan.NewFilter(pushedDownFilterExpression, newTableNode), transform.NewTree, nil
 		}
 
 		return tableNode, transform.SameTree, nil
@@ -268,13 +279,41 @@ func pushdownFiltersToAboveTable(
 	}
 }
 
+// pushdownFiltersIntoTable gives the predicates of |filter| that the table of |tableNode| can evaluate to that table,
+// when it is a sql.RemoteTable without filters. The Filter node above the table is kept, so a RemoteTable only needs to
+// return a superset of the rows matching its filters.
+func pushdownFiltersIntoTable(ctx *sql.Context, a *Analyzer, filter sql.Expression, tableNode sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	ft, ok := getTable(tableNode).(sql.RemoteTable)
+	if !ok || len(ft.Filters()) > 0 {
+		return tableNode, transform.SameTree, nil
+	}
+	handled := ft.HandledFilters(expression.SplitConjunction(filter))
+	if len(handled) == 0 {
+		return tableNode, transform.SameTree, nil
+	}
+
+	a.Log("pushed down filters %s into table %q", handled, ft.Name())
+	return transform.Node(tableNode, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		rt, ok := n.(*plan.ResolvedTable)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		newRt, err := rt.WithTable(ft.WithFilters(ctx, handled))
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return newRt, transform.NewTree, nil
+	})
+}
+
 // pushdownFiltersUnderSubqueryAlias takes |filters| applying to the subquery
 // alias a moves them under the subquery alias. Because the subquery alias is
 // Opaque, it behaves a little bit like a FilteredTable, and pushing the
 // filters down below it can help find index usage opportunities later in the
 // analysis phase.
 func pushdownFiltersUnderSubqueryAlias(ctx *sql.Context, a *Analyzer, sa *plan.SubqueryAlias, filters *filterSet) (sql.Node, transform.TreeIdentity, error) {
-	if sa.ScopeMapping == nil {
+	// A NO_MERGE hint keeps the outer query's filters out of the subquery
+	if sa.ScopeMapping == nil || sa.Materialized {
 		return sa, transform.SameTree, nil
 	}
 	handled := filters.availableFiltersForTable(ctx, sa.Name())
@@ -277,6 +277,13 @@ func analyzeSubqueryExpression(ctx *sql.Context, a *Analyzer, n sql.Node, sq *pl
 func analyzeSubqueryAlias(ctx *sql.Context, a *Analyzer, sqa *plan.SubqueryAlias, scope *plan.Scope, sel RuleSelector, finalize bool) (sql.Node, transform.TreeIdentity, error) {
 	subScope := scope.NewScopeFromSubqueryAlias(sqa)
 
+	// The definition of a view that runs as its definer is analyzed, and so has its privileges checked, as the definer
+	if sqa.RunsAsDefiner() {
+		if definer, ok := sql.ParseDefiner(sqa.Definer); ok {
+			defer ctx.RunAs(definer)()
+		}
+	}
+
 	var child sql.Node
 	var same transform.TreeIdentity
 	var err error
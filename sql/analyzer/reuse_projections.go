@@ -0,0 +1,316 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// reuseProjectedExprs rewrites ORDER BY, GROUP BY and HAVING expressions that repeat an aliased select expression to
+// reference the projected column instead, so that the expression is evaluated once per row. When the select
+// expression is only computed after the sort or grouping, a projection computing it is injected below them.
+//
+// Only non-trivial, deterministic expressions with an alias that assigns them a column id are reused. The rewrite
+// never looks through a Distinct node, so the columns a DISTINCT is computed over are never changed.
+func reuseProjectedExprs(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	if !n.Resolved() {
+		return n, transform.SameTree, nil
+	}
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		p, ok := n.(*plan.Project)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		return reuseProjectExprs(p)
+	})
+}
+
+// reuseProjectExprs applies the reuseProjectedExprs rewrite to the Sort and Having nodes directly below |p|, and to
+// the pre-projection or GroupBy beneath them.
+func reuseProjectExprs(p *plan.Project) (sql.Node, transform.TreeIdentity, error) {
+	var chain []sql.Node
+	bottom := p.Child
+	for {
+		switch n := bottom.(type) {
+		case *plan.Sort:
+			chain = append(chain, n)
+			bottom = n.Child
+			continue
+		case *plan.Having:
+			chain = append(chain, n)
+			bottom = n.Child
+			continue
+		}
+		break
+	}
+
+	// aliases the outer projection could read instead of computing
+	var candidates []*expression.Alias
+	if pre, ok := bottom.(*plan.Project); ok {
+		for _, e := range pre.Projections {
+			if alias, ok := e.(*expression.Alias); ok && isReusableAlias(alias) {
+				candidates = append(candidates, alias)
+			}
+		}
+	}
+	childIds := make(map[sql.ColumnId]struct{})
+	for _, id := range columnIdsForNode(p.Child) {
+		childIds[id] = struct{}{}
+	}
+	for _, e := range p.Projections {
+		if alias, ok := e.(*expression.Alias); ok && isReusableAlias(alias) && findReusableAlias(candidates, alias) == nil {
+			if _, ok := childIds[alias.Id()]; ok {
+				candidates = append(candidates, alias)
+			}
+		}
+	}
+
+	// select expressions that are also computed by the sort, having or grouping expressions below |p|
+	var computedBelow []sql.Expression
+	for _, n := range chain {
+		computedBelow = append(computedBelow, n.(sql.Expressioner).Expressions()...)
+	}
+	gb, isGroupBy := bottom.(*plan.GroupBy)
+	if isGroupBy {
+		computedBelow = append(computedBelow, gb.GroupByExprs...)
+	}
+	var injected []*expression.Alias
+	for _, e := range p.Projections {
+		alias, ok := e.(*expression.Alias)
+		if !ok || !isReusableAlias(alias) || findReusableAlias(candidates, alias) != nil {
+			continue
+		}
+		for _, below := range computedBelow {
+			if expressionContainsAlias(below, alias) {
+				injected = append(injected, alias)
+				break
+			}
+		}
+	}
+	candidates = append(candidates, injected...)
+	if len(candidates) == 0 {
+		return p, transform.SameTree, nil
+	}
+
+	var err error
+	if len(injected) > 0 {
+		if isGroupBy {
+			bottom, err = injectGroupByProjection(gb, injected)
+		} else if pre, ok := bottom.(*plan.Project); ok {
+			bottom, err = pre.WithExpressions(append(pre.Projections[:len(pre.Projections):len(pre.Projections)], aliasesToExpressions(injected)...)...)
+		} else {
+			bottom, err = injectProjection(bottom, injected)
+		}
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if bottom == nil {
+			// the columns of the child could not be identified, so the projection cannot be injected
+			return p, transform.SameTree, nil
+		}
+	}
+
+	same := transform.SameTree
+	for i := len(chain) - 1; i >= 0; i-- {
+		n, err := chain[i].WithChildren(bottom)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		ne := n.(sql.Expressioner)
+		exprs, exprsSame := replaceReusableExprs(ne.Expressions(), candidates)
+		if !exprsSame {
+			n, err = ne.WithExpressions(exprs...)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			same = transform.NewTree
+		}
+		bottom = n
+	}
+
+	projections, projectionsSame := replaceReusableExprs(p.Projections, candidates)
+	if same && projectionsSame && len(injected) == 0 {
+		return p, transform.SameTree, nil
+	}
+	return plan.NewProject(projections, bottom), transform.NewTree, nil
+}
+
+// injectProjection returns a projection of all columns of |child| plus |aliases|, or nil if the columns of |child|
+// could not be identified.
+func injectProjection(child sql.Node, aliases []*expression.Alias) (sql.Node, error) {
+	ids := columnIdsForNode(child)
+	sch := child.Schema()
+	if len(ids) != len(sch) {
+		return nil, nil
+	}
+	projections := make([]sql.Expression, 0, len(sch)+len(aliases))
+	for i, col := range sch {
+		if ids[i] == 0 {
+			return nil, nil
+		}
+		projections = append(projections, expression.NewGetFieldWithTable(int(ids[i]), 0, col.Type, col.DatabaseSource, col.Source, col.Name, col.Nullable))
+	}
+	return plan.NewProject(append(projections, aliasesToExpressions(aliases)...), child), nil
+}
+
+// injectGroupByProjection computes |aliases| in a projection below |gb|, and rewrites |gb| to group by and return the
+// projected columns.
+func injectGroupByProjection(gb *plan.GroupBy, aliases []*expression.Alias) (sql.Node, error) {
+	child, err := injectProjection(gb.Child, aliases)
+	if child == nil || err != nil {
+		return nil, err
+	}
+	groupBy, _ := replaceReusableExprs(gb.GroupByExprs, aliases)
+	selected := gb.SelectedExprs[:len(gb.SelectedExprs):len(gb.SelectedExprs)]
+	for _, alias := range aliases {
+		selected = append(selected, aliasGetField(alias))
+	}
+	return plan.NewGroupBy(selected, groupBy, child).WithStrategy(gb.Strategy), nil
+}
+
+// replaceReusableExprs replaces every occurrence of one of |aliases| in |exprs| with a reference to the alias' column.
+func replaceReusableExprs(exprs []sql.Expression, aliases []*expression.Alias) ([]sql.Expression, transform.TreeIdentity) {
+	var ret []sql.Expression
+	for i, e := range exprs {
+		newE, same := replaceReusableExpr(e, aliases)
+		if same {
+			continue
+		}
+		if ret == nil {
+			ret = make([]sql.Expression, len(exprs))
+			copy(ret, exprs)
+		}
+		ret[i] = newE
+	}
+	if ret == nil {
+		return exprs, transform.SameTree
+	}
+	return ret, transform.NewTree
+}
+
+// replaceReusableExpr replaces the outermost occurrences of |aliases| in |e|. Expressions are visited top down, so
+// that an expression that contains a reusable expression is itself reused when possible.
+func replaceReusableExpr(e sql.Expression, aliases []*expression.Alias) (sql.Expression, transform.TreeIdentity) {
+	switch e := e.(type) {
+	case *expression.Alias:
+		match := findReusableAlias(aliases, e)
+		if match == nil {
+			child, same := replaceReusableExpr(e.Child, aliases)
+			if same {
+				return e, transform.SameTree
+			}
+			return expression.NewAlias(e.Name(), child).WithId(e.Id()), transform.NewTree
+		}
+		gf := aliasGetField(match)
+		if match.Id() == e.Id() {
+			return gf, transform.NewTree
+		}
+		// the alias names a different output column, keep it
+		return expression.NewAlias(e.Name(), gf).WithId(e.Id()), transform.NewTree
+	case *expression.GetField, *plan.Subquery:
+		return e, transform.SameTree
+	}
+
+	for _, alias := range aliases {
+		if isReusableExpr(e) && e.String() == alias.Child.String() {
+			return aliasGetField(alias), transform.NewTree
+		}
+	}
+
+	children := e.Children()
+	var newChildren []sql.Expression
+	for i, child := range children {
+		newChild, same := replaceReusableExpr(child, aliases)
+		if same {
+			continue
+		}
+		if newChildren == nil {
+			newChildren = make([]sql.Expression, len(children))
+			copy(newChildren, children)
+		}
+		newChildren[i] = newChild
+	}
+	if newChildren == nil {
+		return e, transform.SameTree
+	}
+	newE, err := e.WithChildren(newChildren...)
+	if err != nil {
+		return e, transform.SameTree
+	}
+	return newE, transform.NewTree
+}
+
+// findReusableAlias returns the alias in |aliases| that |alias| is the same column as, or that computes the same
+// expression as |alias|.
+func findReusableAlias(aliases []*expression.Alias, alias *expression.Alias) *expression.Alias {
+	for _, a := range aliases {
+		if a.Id() != 0 && a.Id() == alias.Id() {
+			return a
+		}
+	}
+	if !isReusableExpr(alias.Child) {
+		return nil
+	}
+	for _, a := range aliases {
+		if a.Child.String() == alias.Child.String() {
+			return a
+		}
+	}
+	return nil
+}
+
+// expressionContainsAlias returns whether |e| computes the expression of |alias|.
+func expressionContainsAlias(e sql.Expression, alias *expression.Alias) bool {
+	_, same := replaceReusableExpr(e, []*expression.Alias{alias})
+	return same == transform.NewTree
+}
+
+// isReusableAlias returns whether the column defined by |alias| can be reused in place of its expression.
+func isReusableAlias(alias *expression.Alias) bool {
+	return alias.Id() != 0 && isReusableExpr(alias.Child)
+}
+
+// isReusableExpr returns whether |e| is worth computing only once per row, and returns the same result every time it
+// is evaluated on the same row.
+func isReusableExpr(e sql.Expression) bool {
+	switch e.(type) {
+	case *expression.GetField, *expression.Literal, *expression.BindVar:
+		return false
+	}
+	return !transform.InspectExpr(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *plan.Subquery, sql.Aggregation, sql.WindowAdaptableExpression:
+			return true
+		case sql.NonDeterministicExpression:
+			return e.IsNonDeterministic()
+		}
+		return false
+	})
+}
+
+func aliasGetField(alias *expression.Alias) *expression.GetField {
+	return expression.NewGetFieldWithTable(int(alias.Id()), 0, alias.Type(), "", "", alias.Name(), alias.IsNullable())
+}
+
+func aliasesToExpressions(aliases []*expression.Alias) []sql.Expression {
+	ret := make([]sql.Expression, len(aliases))
+	for i, alias := range aliases {
+		ret[i] = alias
+	}
+	return ret
+}
@@ -102,15 +102,18 @@ const (
 	matchAgainstId               // matchAgainst
 	pushFiltersId                // pushFilters
 	applyIndexesFromOuterScopeId // applyIndexesFromOuterScope
+	warnIndexConversionsId       // warnIndexConversions
 	pruneTablesId                // pruneTables
 	fixupAuxiliaryExprsId        // fixupAuxiliaryExprs
 	assignExecIndexesId          // assignExecIndexes
 	inlineSubqueryAliasRefsId    // inlineSubqueryAliasRefs
 	eraseProjectionId            // eraseProjection
 	flattenDistinctId            //flattenDistinct
+	reuseProjectedExprsId        // reuseProjectedExprs
 	replaceAggId                 // replaceAgg
 	replaceIdxSortId             // replaceIdxSort
 	insertTopNId                 // insertTopN
+	pushdownLimitToIndexScanId   // pushdownLimitToIndexScan
 	applyHashInId                // applyHashIn
 	resolveInsertRowsId          // resolveInsertRows
 	resolvePreparedInsertId      // resolvePreparedInsert
@@ -100,50 +100,53 @@ func _() {
 	_ = x[matchAgainstId-89]
 	_ = x[pushFiltersId-90]
 	_ = x[applyIndexesFromOuterScopeId-91]
-	_ = x[pruneTablesId-92]
-	_ = x[fixupAuxiliaryExprsId-93]
-	_ = x[assignExecIndexesId-94]
-	_ = x[inlineSubqueryAliasRefsId-95]
-	_ = x[eraseProjectionId-96]
-	_ = x[flattenDistinctId-97]
-	_ = x[replaceAggId-98]
-	_ = x[replaceIdxSortId-99]
-	_ = x[insertTopNId-100]
-	_ = x[applyHashInId-101]
-	_ = x[resolveInsertRowsId-102]
-	_ = x[resolvePreparedInsertId-103]
-	_ = x[applyTriggersId-104]
-	_ = x[applyProceduresId-105]
-	_ = x[assignRoutinesId-106]
-	_ = x[modifyUpdateExprsForJoinId-107]
-	_ = x[applyRowUpdateAccumulatorsId-108]
-	_ = x[wrapWithRollbackId-109]
-	_ = x[applyFKsId-110]
-	_ = x[validateResolvedId-111]
-	_ = x[validateOrderById-112]
-	_ = x[validateGroupById-113]
-	_ = x[validateSchemaSourceId-114]
-	_ = x[validateIndexCreationId-115]
-	_ = x[validateOperandsId-116]
-	_ = x[validateCaseResultTypesId-117]
-	_ = x[validateIntervalUsageId-118]
-	_ = x[validateExplodeUsageId-119]
-	_ = x[validateSubqueryColumnsId-120]
-	_ = x[validateUnionSchemasMatchId-121]
-	_ = x[validateAggregationsId-122]
-	_ = x[validateDeleteFromId-123]
-	_ = x[cacheSubqueryResultsId-124]
-	_ = x[cacheSubqueryAliasesInJoinsId-125]
-	_ = x[backtickDefaulColumnValueNamesId-126]
-	_ = x[AutocommitId-127]
-	_ = x[TrackProcessId-128]
-	_ = x[parallelizeId-129]
-	_ = x[clearWarningsId-130]
+	_ = x[warnIndexConversionsId-92]
+	_ = x[pruneTablesId-93]
+	_ = x[fixupAuxiliaryExprsId-94]
+	_ = x[assignExecIndexesId-95]
+	_ = x[inlineSubqueryAliasRefsId-96]
+	_ = x[eraseProjectionId-97]
+	_ = x[flattenDistinctId-98]
+	_ = x[reuseProjectedExprsId-99]
+	_ = x[replaceAggId-100]
+	_ = x[replaceIdxSortId-101]
+	_ = x[insertTopNId-102]
+	_ = x[pushdownLimitToIndexScanId-103]
+	_ = x[applyHashInId-104]
+	_ = x[resolveInsertRowsId-105]
+	_ = x[resolvePreparedInsertId-106]
+	_ = x[applyTriggersId-107]
+	_ = x[applyProceduresId-108]
+	_ = x[assignRoutinesId-109]
+	_ = x[modifyUpdateExprsForJoinId-110]
+	_ = x[applyRowUpdateAccumulatorsId-111]
+	_ = x[wrapWithRollbackId-112]
+	_ = x[applyFKsId-113]
+	_ = x[validateResolvedId-114]
+	_ = x[validateOrderById-115]
+	_ = x[validateGroupById-116]
+	_ = x[validateSchemaSourceId-117]
+	_ = x[validateIndexCreationId-118]
+	_ = x[validateOperandsId-119]
+	_ = x[validateCaseResultTypesId-120]
+	_ = x[validateIntervalUsageId-121]
+	_ = x[validateExplodeUsageId-122]
+	_ = x[validateSubqueryColumnsId-123]
+	_ = x[validateUnionSchemasMatchId-124]
+	_ = x[validateAggregationsId-125]
+	_ = x[validateDeleteFromId-126]
+	_ = x[cacheSubqueryResultsId-127]
+	_ = x[cacheSubqueryAliasesInJoinsId-128]
+	_ = x[backtickDefaulColumnValueNamesId-129]
+	_ = x[AutocommitId-130]
+	_ = x[TrackProcessId-131]
+	_ = x[parallelizeId-132]
+	_ = x[clearWarningsId-133]
 }
 
-const _RuleId_name = "applyDefaultSelectLimitvalidateOffsetAndLimitvalidateStarExpressionsvalidateCreateTablevalidateAlterTablevalidateExprSemresolveVariablesresolveNamedWindowsresolveSetVariablesresolveViewsliftCtesresolveCtesliftRecursiveCtesresolveDatabasesresolveTablesloadStoredProceduresvalidateDropTablespruneDropTablessetTargetSchemasresolveCreateLikeparseColumnDefaultsresolveDropConstraintvalidateDropConstraintloadCheckConstraintsassignCatalogresolveAnalyzeTablesresolveCreateSelectresolveSubqueriessetViewTargetSchemaresolveUnionsresolveDescribeQuerycheckUniqueTableNamesresolveTableFunctionsresolveDeclarationsresolveColumnDefaultsvalidateColumnDefaultsvalidateCreateTriggervalidateCreateProcedureresolveCreateProcedureloadInfoSchemavalidateReadOnlyDatabasevalidateReadOnlyTransactionvalidateDatabaseSetvalidatePrivilegesreresolveTablessetInsertColumnsvalidateJoinComplexityapplyBinlogReplicaControllerapplyEventSchedulerresolveUsingJoinsresolveOrderbyLiteralsresolveFunctionsflattenTableAliasespushdownSortpushdownGroupbyAliasespushdownSubqueryAliasFiltersqualifyColumnsresolveColumnsvalidateCheckConstraintresolveBarewordSetVariablesreplaceCountStarexpandStarstransposeRightJoinsresolveHavingmergeUnionSchemasflattenAggregationExprsreorderProjectionresolveSubqueryExprsreplaceCrossJoinsmoveJoinCondsToFiltermoveFiltersToJoinCondsimplifyFilterspushNotFiltersoptimizeDistincthoistOutOfScopeFiltersunnestInSubqueriesunnestExistsSubqueriesfinalizeSubqueriesfinalizeUnionsloadTriggersloadEventsprocessTruncateresolveAlterColumnresolveGeneratorsremoveUnnecessaryConvertsstripTableNamesFromColumnDefaultsfoldEmptyJoinsoptimizeJoinsgenerateIndexScansmatchAgainstpushFiltersapplyIndexesFromOuterScopepruneTablesfixupAuxiliaryExprsassignExecIndexesinlineSubqueryAliasRefseraseProjectionflattenDistinctreplaceAggreplaceIdxSortinsertTopNapplyHashInresolveInsertRowsresolvePreparedInsertapplyTriggersapplyProceduresassignRoutinesmodifyUpdateExprsForJoinapplyRowUpdateAccumulatorsrollback triggersapplyFKsvalidateResolvedvalidateOrderByvalidateGroupByvalidateSchemaSourcevalidateIndexCreationvalidateOperandsvalidateCaseResultTypesvalidateIntervalUsagevalidateExplodeUsagevalidateSubqueryColumnsvalidateUnionSchemasMatchvalidateAggregationsvalidateDeleteFromcacheSubqueryResultscacheSubqueryAliasesInJoinsbacktickDefaulColumnValueNamesaddAutocommitNodetrackProcessparallelizeclearWarnings"
+const _RuleId_name = "applyDefaultSelectLimitvalidateOffsetAndLimitvalidateStarExpressionsvalidateCreateTablevalidateAlterTablevalidateExprSemresolveVariablesresolveNamedWindowsresolveSetVariablesresolveViewsliftCtesresolveCtesliftRecursiveCtesresolveDatabasesresolveTablesloadStoredProceduresvalidateDropTablespruneDropTablessetTargetSchemasresolveCreateLikeparseColumnDefaultsresolveDropConstraintvalidateDropConstraintloadCheckConstraintsassignCatalogresolveAnalyzeTablesresolveCreateSelectresolveSubqueriessetViewTargetSchemaresolveUnionsresolveDescribeQuerycheckUniqueTableNamesresolveTableFunctionsresolveDeclarationsresolveColumnDefaultsvalidateColumnDefaultsvalidateCreateTriggervalidateCreateProcedureresolveCreateProcedureloadInfoSchemavalidateReadOnlyDatabasevalidateReadOnlyTransactionvalidateDatabaseSetvalidatePrivilegesreresolveTablessetInsertColumnsvalidateJoinComplexityapplyBinlogReplicaControllerapplyEventSchedulerresolveUsingJoinsresolveOrderbyLiteralsresolveFunctionsflattenTableAliasespushdownSortpushdownGroupbyAliasespushdownSubqueryAliasFiltersqualifyColumnsresolveColumnsvalidateCheckConstraintresolveBarewordSetVariablesreplaceCountStarexpandStarstransposeRightJoinsresolveHavingmergeUnionSchemasflattenAggregationExprsreorderProjectionresolveSubqueryExprsreplaceCrossJoinsmoveJoinCondsToFiltermoveFiltersToJoinCondsimplifyFilterspushNotFiltersoptimizeDistincthoistOutOfScopeFiltersunnestInSubqueriesunnestExistsSubqueriesfinalizeSubqueriesfinalizeUnionsloadTriggersloadEventsprocessTruncateresolveAlterColumnresolveGeneratorsremoveUnnecessaryConvertsstripTableNamesFromColumnDefaultsfoldEmptyJoinsoptimizeJoinsgenerateIndexScansmatchAgainstpushFiltersapplyIndexesFromOuterScopewarnIndexConversionspruneTablesfixupAuxiliaryExprsassignExecIndexesinlineSubqueryAliasRefseraseProjectionflattenDistinctreuseProjectedExprsreplaceAggreplaceIdxSortinsertTopNpushdownLimitToIndexScanapplyHashInresolveInsertRowsresolvePreparedInsertapplyTriggersapplyProceduresassignRoutinesmodifyUpdateExprsForJoinapplyRowUpdateAccumulatorsrollback triggersapplyFKsvalidateResolvedvalidateOrderByvalidateGroupByvalidateSchemaSourcevalidateIndexCreationvalidateOperandsvalidateCaseResultTypesvalidateIntervalUsagevalidateExplodeUsagevalidateSubqueryColumnsvalidateUnionSchemasMatchvalidateAggregationsvalidateDeleteFromcacheSubqueryResultscacheSubqueryAliasesInJoinsbacktickDefaulColumnValueNamesaddAutocommitNodetrackProcessparallelizeclearWarnings"
 
-var _RuleId_index = [...]uint16{0, 23, 45, 68, 87, 105, 120, 136, 155, 174, 186, 194, 205, 222, 238, 251, 271, 289, 304, 320, 337, 356, 377, 399, 419, 432, 452, 471, 488, 507, 520, 540, 561, 582, 601, 622, 644, 665, 688, 710, 724, 748, 775, 794, 812, 827, 843, 865, 893, 912, 929, 951, 967, 986, 998, 1020, 1048, 1062, 1076, 1099, 1126, 1142, 1153, 1172, 1185, 1202, 1225, 1242, 1262, 1279, 1300, 1321, 1336, 1350, 1366, 1388, 1406, 1428, 1446, 1460, 1472, 1482, 1497, 1515, 1532, 1557, 1590, 1604, 1617, 1635, 1647, 1658, 1684, 1695, 1714, 1731, 1754, 1769, 1784, 1794, 1808, 1818, 1829, 1846, 1867, 1880, 1895, 1909, 1933, 1959, 1976, 1984, 2000, 2015, 2030, 2050, 2071, 2087, 2110, 2131, 2151, 2174, 2199, 2219, 2237, 2257, 2284, 2314, 2331, 2343, 2354, 2367}
+var _RuleId_index = [...]uint16{0, 23, 45, 68, 87, 105, 120, 136, 155, 174, 186, 194, 205, 222, 238, 251, 271, 289, 304, 320, 337, 356, 377, 399, 419, 432, 452, 471, 488, 507, 520, 540, 561, 582, 601, 622, 644, 665, 688, 710, 724, 748, 775, 794, 812, 827, 843, 865, 893, 912, 929, 951, 967, 986, 998, 1020, 1048, 1062, 1076, 1099, 1126, 1142, 1153, 1172, 1185, 1202, 1225, 1242, 1262, 1279, 1300, 1321, 1336, 1350, 1366, 1388, 1406, 1428, 1446, 1460, 1472, 1482, 1497, 1515, 1532, 1557, 1590, 1604, 1617, 1635, 1647, 1658, 1684, 1704, 1715, 1734, 1751, 1774, 1789, 1804, 1823, 1833, 1847, 1857, 1881, 1892, 1909, 1930, 1943, 1958, 1972, 1996, 2022, 2039, 2047, 2063, 2078, 2093, 2113, 2134, 2150, 2173, 2194, 2214, 2237, 2262, 2282, 2300, 2320, 2347, 2377, 2394, 2406, 2417, 2430}
 
 func (i RuleId) String() string {
 	if i < 0 || i >= RuleId(len(_RuleId_index)-1) {
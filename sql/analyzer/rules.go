@@ -66,11 +66,14 @@ var OnceAfterDefault = []Rule{
 	{optimizeJoinsId, optimizeJoins},
 	{finalizeSubqueriesId, finalizeSubqueries},
 	{applyIndexesFromOuterScopeId, applyIndexesFromOuterScope},
+	{warnIndexConversionsId, warnIndexConversions},
 	{replaceAggId, replaceAgg},
 	{replaceIdxSortId, replaceIdxSort},
 	{eraseProjectionId, eraseProjection},
 	{flattenDistinctId, flattenDistinct},
+	{reuseProjectedExprsId, reuseProjectedExprs},
 	{insertTopNId, insertTopNNodes},
+	{pushdownLimitToIndexScanId, pushdownLimitToIndexScan},
 	{applyHashInId, applyHashIn},
 	{assignRoutinesId, assignRoutines},
 	{modifyUpdateExprsForJoinId, modifyUpdateExpressionsForJoin},
@@ -107,5 +110,4 @@ var OnceAfterAll = []Rule{
 	{AutocommitId, addAutocommitNode},
 	{TrackProcessId, trackProcess},
 	{parallelizeId, parallelize},
-	{clearWarningsId, clearWarnings},
 }
@@ -194,7 +194,7 @@ func validateStoredProcedure(_ *sql.Context, proc *plan.Procedure) error {
 			err = spUnsupportedErr.New("foreign keys")
 		case *plan.CreateIndex:
 			err = spUnsupportedErr.New("indexes")
-		case *plan.CreateView:
+		case *plan.CreateView, *plan.AlterView:
 			err = spUnsupportedErr.New("views")
 		default:
 			return true
@@ -301,6 +301,13 @@ func applyProcedures(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scop
 			if !ok {
 				return nil, transform.SameTree, sql.ErrProcedureCreateStatementInvalid.New(procedure.CreateStatement)
 			}
+			// The body of a procedure that runs as its definer is analyzed, and so has its privileges checked, as the
+			// definer
+			if cp.Procedure.RunsAsDefiner() {
+				if definer, ok := sql.ParseDefiner(cp.Procedure.Definer); ok {
+					defer ctx.RunAs(definer)()
+				}
+			}
 			analyzedProc, err := analyzeCreateProcedure(ctx, a, cp, scope, sel)
 			if err != nil {
 				return nil, transform.SameTree, err
@@ -22,13 +22,14 @@ import (
 )
 
 // insertTopNNodes replaces Limit(Sort(...)) and Limit(Offset(Sort(...))) with
-// a TopN node.
+// a TopN node. A PERCENT limit or a limit WITH TIES can't know its row count
+// until it has seen the sorted rows, so it isn't replaced.
 func insertTopNNodes(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	var updateCalcFoundRows bool
 	return transform.NodeWithCtx(n, nil, func(tc transform.Context) (sql.Node, transform.TreeIdentity, error) {
 		if o, ok := tc.Node.(*plan.Offset); ok {
 			parentLimit, ok := tc.Parent.(*plan.Limit)
-			if !ok {
+			if !ok || parentLimit.Percent || parentLimit.WithTies {
 				return tc.Node, transform.SameTree, nil
 			}
 			childSort, ok := o.UnaryNode.Child.(*plan.Sort)
@@ -42,7 +43,7 @@ func insertTopNNodes(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scop
 			return node, transform.NewTree, err
 		} else if l, ok := tc.Node.(*plan.Limit); ok {
 			childSort, ok := l.UnaryNode.Child.(*plan.Sort)
-			if !ok {
+			if !ok || l.Percent || l.WithTies {
 				if updateCalcFoundRows {
 					updateCalcFoundRows = false
 					return l.WithCalcFoundRows(false), transform.NewTree, nil
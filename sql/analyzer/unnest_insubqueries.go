@@ -88,7 +88,8 @@ func unnestInSubqueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.S
 				op := plan.JoinTypeSemi
 				if n, ok := e.(*expression.Not); ok {
 					candE = n.Child
-					op = plan.JoinTypeAnti
+					// NOT IN is NULL, rather than true, if the subquery has a NULL for the value
+					op = plan.JoinTypeAntiIncludeNulls
 				}
 
 				var sq *plan.Subquery
@@ -153,6 +153,28 @@ func validateIdentifiers(name string, spec *plan.TableSpec) error {
 }
 
 func resolveAlterColumn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	n, _, same, err := alterTableSchema(ctx, a, n, nil)
+	return n, same, err
+}
+
+// AlteredSchema returns the schema that the ALTER TABLE clauses in the analyzed node |n| leave a table with, starting
+// from its current schema |sch|. The schema is returned unchanged if |n| has no clauses that change columns.
+func AlteredSchema(ctx *sql.Context, a *Analyzer, n sql.Node, sch sql.Schema) (sql.Schema, error) {
+	_, newSch, _, err := alterTableSchema(ctx, a, n, sch)
+	if err != nil {
+		return nil, err
+	}
+	if newSch == nil {
+		return sch, nil
+	}
+	return newSch, nil
+}
+
+// alterTableSchema validates the ALTER TABLE clauses in |n| in order, setting the target schema of each to the schema
+// that the clauses before it leave the table with, and returns the schema after all of them. The table's schema is
+// |initialSch|, or the target schema of the clauses if |initialSch| is nil. A nil schema is returned if |n| has no
+// clauses that change columns.
+func alterTableSchema(ctx *sql.Context, a *Analyzer, n sql.Node, initialSch sql.Schema) (sql.Node, sql.Schema, transform.TreeIdentity, error) {
 	var sch sql.Schema
 	var indexes []string
 	var validator sql.SchemaValidator
@@ -206,16 +228,19 @@ func resolveAlterColumn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.S
 	})
 
 	if err != nil {
-		return nil, transform.SameTree, err
+		return nil, nil, transform.SameTree, err
 	}
 
 	// Skip this validation if we didn't find one or more of the above node types
 	if len(sch) == 0 {
-		return n, transform.SameTree, nil
+		return n, nil, transform.SameTree, nil
+	}
+	if initialSch != nil {
+		sch = initialSch
 	}
 
 	sch = sch.Copy() // Make a copy of the original schema to deal with any references to the original table.
-	initialSch := sch
+	initialSch = sch
 
 	addedColumn := false
 
@@ -316,12 +341,12 @@ func resolveAlterColumn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.S
 	})
 
 	if err != nil {
-		return nil, transform.SameTree, err
+		return nil, nil, transform.SameTree, err
 	}
 
 	if validator != nil {
 		if err := validator.ValidateSchema(sch); err != nil {
-			return nil, transform.SameTree, err
+			return nil, nil, transform.SameTree, err
 		}
 	}
 
@@ -330,11 +355,11 @@ func resolveAlterColumn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.S
 	if addedColumn {
 		err = validateAutoIncrementAdd(ctx, sch, keyedColumns)
 		if err != nil {
-			return nil, false, err
+			return nil, nil, false, err
 		}
 	}
 
-	return n, same, nil
+	return n, sch, same, nil
 }
 
 // updateKeyedColumns updates the keyedColumns map based on the action of the AlterIndex node
@@ -421,6 +446,10 @@ func validateAddColumn(initialSch sql.Schema, schema sql.Schema, ac *plan.AddCol
 		newSch = append(newSch, ac.Column().Copy())
 	}
 
+	if _, err := transform.GeneratedColumnOrder(newSch); err != nil {
+		return nil, err
+	}
+
 	return newSch, nil
 }
 
@@ -456,6 +485,10 @@ func validateModifyColumn(ctx *sql.Context, initialSch sql.Schema, schema sql.Sc
 
 	newSch := replaceInSchema(schema, mc.NewColumn(), nameable.Name())
 
+	if _, err = transform.GeneratedColumnOrder(newSch); err != nil {
+		return nil, err
+	}
+
 	err = validateAutoIncrementModify(newSch, keyedColumns)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,119 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// warnIndexConversions adds a warning for every filter or join condition that compares an indexed column with a value
+// of a type the column has to be converted to, which prevents the comparison from using the index. MySQL reports the
+// same warnings, most visibly after an EXPLAIN.
+func warnIndexConversions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *plan.Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	tables := make(map[sql.TableId]sql.Table)
+	var conds []sql.Expression
+	transform.Inspect(n, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.SubqueryAlias:
+			// subquery aliases are analyzed, and warned about, on their own
+			return false
+		case *plan.TableAlias:
+			if t := getTable(n); t != nil {
+				tables[n.Id()] = t
+			}
+			return false
+		case *plan.ResolvedTable:
+			tables[n.Id()] = n.UnderlyingTable()
+		case *plan.IndexedTableAccess:
+			tables[n.Id()] = n.TableNode.UnderlyingTable()
+			return false
+		case *plan.Filter:
+			conds = append(conds, n.Expression)
+		case *plan.JoinNode:
+			if n.Filter != nil {
+				conds = append(conds, n.Filter)
+			}
+		}
+		return true
+	})
+
+	warned := make(map[string]struct{})
+	warn := func(gf *expression.GetField, other sql.Expression) error {
+		if !expression.ConvertedForComparison(gf.Type(), other.Type()) {
+			return nil
+		}
+		idx, err := leadingIndexForColumn(ctx, tables[gf.TableId()], gf.Name())
+		if err != nil || idx == nil {
+			return err
+		}
+		access := "ref"
+		if isEvaluable(other) {
+			access = "range"
+		}
+		msg := fmt.Sprintf("Cannot use %s access on index '%s' due to type or collation conversion on field '%s'", access, idx.ID(), gf.Name())
+		if _, ok := warned[msg]; !ok {
+			warned[msg] = struct{}{}
+			ctx.Warn(1739, "%s", msg)
+		}
+		return nil
+	}
+
+	var err error
+	for _, cond := range conds {
+		sql.Inspect(cond, func(e sql.Expression) bool {
+			cmp, ok := e.(expression.Comparer)
+			if !ok || err != nil {
+				return err == nil
+			}
+			if gf, ok := cmp.Left().(*expression.GetField); ok {
+				err = warn(gf, cmp.Right())
+			}
+			if gf, ok := cmp.Right().(*expression.GetField); ok && err == nil {
+				err = warn(gf, cmp.Left())
+			}
+			return false
+		})
+		if err != nil {
+			return n, transform.SameTree, err
+		}
+	}
+	return n, transform.SameTree, nil
+}
+
+// leadingIndexForColumn returns an index of |table| whose first expression is the column |name|, or nil if there
+// isn't one.
+func leadingIndexForColumn(ctx *sql.Context, table sql.Table, name string) (sql.Index, error) {
+	iat, ok := table.(sql.IndexAddressableTable)
+	if !ok {
+		return nil, nil
+	}
+	indexes, err := iat.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, idx := range indexes {
+		exprs := idx.Expressions()
+		if len(exprs) > 0 && strings.EqualFold(exprs[0], table.Name()+"."+name) {
+			return idx, nil
+		}
+	}
+	return nil, nil
+}
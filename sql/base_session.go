@@ -44,12 +44,13 @@ type BaseSession struct {
 	idxReg           *IndexRegistry
 	viewReg          *ViewRegistry
 	warnings         []*Warning
-	warncnt          uint16
 	locks            map[string]bool
 	queriedDb        string
 	lastQueryInfo    map[string]any
 	tx               Transaction
 	ignoreAutocommit bool
+	transactionalDDL bool
+	optimizerTraces  []OptimizerTrace
 
 	// When the MySQL database updates any tables related to privileges, it increments its counter. We then update our
 	// privilege set if our counter doesn't equal the database's counter.
@@ -91,6 +92,8 @@ func (s *BaseSession) GetIgnoreAutoCommit() bool {
 }
 
 var _ Session = (*BaseSession)(nil)
+var _ OptimizerTraceSession = (*BaseSession)(nil)
+var _ TransactionalDDLSession = (*BaseSession)(nil)
 
 func (s *BaseSession) SetTransactionDatabase(dbName string) {
 	s.mu.Lock()
@@ -119,10 +122,15 @@ func (s *BaseSession) SetClient(c Client) {
 // GetAllSessionVariables implements the Session interface.
 func (s *BaseSession) GetAllSessionVariables() map[string]interface{} {
 	m := make(map[string]interface{})
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
+	s.addRegisteredSystemVariables()
 	for k, v := range s.systemVars {
+		if val, ok := s.diagnosticsVariable(k); ok {
+			m[k] = val
+			continue
+		}
 		if sysType, ok := v.Var.Type.(SetType); ok {
 			if sv, ok := v.Val.(uint64); ok {
 				if svStr, err := sysType.BitsToString(sv); err == nil {
@@ -209,13 +217,21 @@ func (s *BaseSession) SetUserVariable(ctx *Context, varName string, value interf
 
 // GetSessionVariable implements the Session interface.
 func (s *BaseSession) GetSessionVariable(ctx *Context, sysVarName string) (interface{}, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	sysVarName = strings.ToLower(sysVarName)
+	s.mu.RLock()
 	sysVar, ok := s.systemVars[sysVarName]
 	if !ok {
-		return nil, ErrUnknownSystemVariable.New(sysVarName)
+		s.mu.RUnlock()
+		if !s.addRegisteredSystemVariable(sysVarName) {
+			return nil, ErrUnknownSystemVariable.New(sysVarName)
+		}
+		s.mu.RLock()
+		sysVar = s.systemVars[sysVarName]
+	}
+	defer s.mu.RUnlock()
+
+	if val, ok := s.diagnosticsVariable(sysVarName); ok {
+		return val, nil
 	}
 	// TODO: this is duplicated from within variables.globalSystemVariables, suggesting the need for an interface
 	if sysType, ok := sysVar.Var.Type.(SetType); ok {
@@ -226,6 +242,47 @@ func (s *BaseSession) GetSessionVariable(ctx *Context, sysVarName string) (inter
 	return sysVar.Val, nil
 }
 
+// addRegisteredSystemVariable adds the system variable named |sysVarName| to this session with its current global
+// value, if it was registered after this session was created. It returns false if there is no such variable. The
+// session's mutex must not be held by the caller.
+func (s *BaseSession) addRegisteredSystemVariable(sysVarName string) bool {
+	if SystemVariables == nil {
+		return false
+	}
+	sysVar, val, ok := SystemVariables.GetGlobal(sysVarName)
+	if !ok {
+		return false
+	}
+	// the global value of a set is returned as a string, but sessions store its bits
+	if sysType, ok := sysVar.Type.(SetType); ok {
+		if str, ok := val.(string); ok {
+			if bits, _, err := sysType.Convert(str); err == nil {
+				val = bits
+			}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.systemVars[sysVarName]; !ok {
+		s.systemVars[sysVarName] = SystemVarValue{Var: sysVar, Val: val}
+	}
+	return true
+}
+
+// addRegisteredSystemVariables adds any system variables that were registered after this session was created, using
+// their current global values. The session's mutex must be held by the caller.
+func (s *BaseSession) addRegisteredSystemVariables() {
+	if SystemVariables == nil {
+		return
+	}
+	for name, val := range SystemVariables.NewSessionMap() {
+		if _, ok := s.systemVars[name]; !ok {
+			s.systemVars[name] = val
+		}
+	}
+}
+
 // GetUserVariable implements the Session interface.
 func (s *BaseSession) GetUserVariable(ctx *Context, varName string) (Type, interface{}, error) {
 	return s.userVars.GetUserVariable(ctx, varName)
@@ -321,6 +378,35 @@ func (s *BaseSession) SetConnectionId(id uint32) {
 	return
 }
 
+// AddOptimizerTrace implements the OptimizerTraceSession interface.
+func (s *BaseSession) AddOptimizerTrace(trace OptimizerTrace, retain int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.optimizerTraces = append(s.optimizerTraces, trace)
+	if retain < 0 {
+		retain = 0
+	}
+	if len(s.optimizerTraces) > retain {
+		s.optimizerTraces = s.optimizerTraces[len(s.optimizerTraces)-retain:]
+	}
+}
+
+// OptimizerTraces implements the OptimizerTraceSession interface.
+func (s *BaseSession) OptimizerTraces() []OptimizerTrace {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	traces := make([]OptimizerTrace, len(s.optimizerTraces))
+	copy(traces, s.optimizerTraces)
+	return traces
+}
+
+// ClearOptimizerTraces implements the OptimizerTraceSession interface.
+func (s *BaseSession) ClearOptimizerTraces() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.optimizerTraces = nil
+}
+
 // Warn stores the warning in the session.
 func (s *BaseSession) Warn(warn *Warning) {
 	s.mu.Lock()
@@ -348,14 +434,8 @@ func (s *BaseSession) ClearWarnings() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	cnt := uint16(len(s.warnings))
-	if s.warncnt == cnt {
-		if s.warnings != nil {
-			s.warnings = s.warnings[:0]
-		}
-		s.warncnt = 0
-	} else {
-		s.warncnt = cnt
+	if s.warnings != nil {
+		s.warnings = s.warnings[:0]
 	}
 }
 
@@ -366,6 +446,26 @@ func (s *BaseSession) WarningCount() uint16 {
 	return uint16(len(s.warnings))
 }
 
+// diagnosticsVariable returns the value of the warning_count or error_count system variable, which is the number of
+// session warnings, or of session warnings with the Error level. It returns false for any other variable. The caller
+// must hold the session lock.
+func (s *BaseSession) diagnosticsVariable(sysVarName string) (interface{}, bool) {
+	switch sysVarName {
+	case "warning_count":
+		return int64(len(s.warnings)), true
+	case "error_count":
+		var count int64
+		for _, w := range s.warnings {
+			if w.Level == "Error" {
+				count++
+			}
+		}
+		return count, true
+	default:
+		return nil, false
+	}
+}
+
 // AddLock adds a lock to the set of locks owned by this user which will need to be released if this session terminates
 func (s *BaseSession) AddLock(lockName string) error {
 	s.mu.Lock()
@@ -482,6 +582,21 @@ func (s *BaseSession) SetTransaction(tx Transaction) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.tx = tx
+	s.transactionalDDL = false
+}
+
+// SetTransactionalDDL implements the TransactionalDDLSession interface.
+func (s *BaseSession) SetTransactionalDDL() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.transactionalDDL = true
+}
+
+// HasTransactionalDDL implements the TransactionalDDLSession interface.
+func (s *BaseSession) HasTransactionalDDL() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.transactionalDDL
 }
 
 func (s *BaseSession) GetPrivilegeSet() (PrivilegeSet, uint64) {
@@ -0,0 +1,125 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"io"
+)
+
+// DefaultBatchSize is the number of rows in each batch returned by the BatchRowIter from NewBatchRowIter.
+const DefaultBatchSize = 1024
+
+// RowBatch is a batch of rows stored column by column, so that an expression can be evaluated over a column of the
+// whole batch at once. Columns[i][j] is the value of column i in row j of the batch.
+type RowBatch struct {
+	Columns [][]interface{}
+	Len     int
+}
+
+// NewRowBatch returns an empty RowBatch with |width| columns and room for |size| rows.
+func NewRowBatch(width, size int) *RowBatch {
+	b := &RowBatch{Columns: make([][]interface{}, width)}
+	for i := range b.Columns {
+		b.Columns[i] = make([]interface{}, 0, size)
+	}
+	return b
+}
+
+// Reset empties the batch, keeping its storage so that it can be filled again.
+func (b *RowBatch) Reset() {
+	for i := range b.Columns {
+		b.Columns[i] = b.Columns[i][:0]
+	}
+	b.Len = 0
+}
+
+// Append adds |row| as the last row of the batch.
+func (b *RowBatch) Append(row Row) {
+	for i := range b.Columns {
+		b.Columns[i] = append(b.Columns[i], row[i])
+	}
+	b.Len++
+}
+
+// Row returns a new Row with the values of row |i| of the batch.
+func (b *RowBatch) Row(i int) Row {
+	row := make(Row, len(b.Columns))
+	for j, col := range b.Columns {
+		row[j] = col[i]
+	}
+	return row
+}
+
+// BatchRowIter is an iterator that returns rows a batch at a time.
+type BatchRowIter interface {
+	// NextBatch returns the next batch of rows, which is never empty. It returns io.EOF when there are no more rows.
+	// The returned batch may be reused by the iterator, so it's only valid until the next call to NextBatch.
+	NextBatch(ctx *Context) (*RowBatch, error)
+	Closer
+}
+
+// BatchTable is a Table that can return the rows of its partitions in batches. Filters over a full scan of a
+// BatchTable are evaluated a batch at a time where the filter expression allows it.
+type BatchTable interface {
+	Table
+	// PartitionBatches returns the rows of |partition| in batches. The rows must be the same, and in the same order, as
+	// the rows returned by PartitionRows.
+	PartitionBatches(ctx *Context, partition Partition) (BatchRowIter, error)
+}
+
+// batchRowIter reads the rows of a RowIter into batches.
+type batchRowIter struct {
+	iter  RowIter
+	size  int
+	batch *RowBatch
+	done  bool
+}
+
+var _ BatchRowIter = (*batchRowIter)(nil)
+
+// NewBatchRowIter returns a BatchRowIter that returns the rows of |iter| in batches of up to DefaultBatchSize rows.
+func NewBatchRowIter(iter RowIter) BatchRowIter {
+	return &batchRowIter{iter: iter, size: DefaultBatchSize}
+}
+
+// NextBatch implements the BatchRowIter interface.
+func (i *batchRowIter) NextBatch(ctx *Context) (*RowBatch, error) {
+	if i.batch != nil {
+		i.batch.Reset()
+	}
+	for !i.done && (i.batch == nil || i.batch.Len < i.size) {
+		row, err := i.iter.Next(ctx)
+		if err == io.EOF {
+			i.done = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if i.batch == nil {
+			i.batch = NewRowBatch(len(row), i.size)
+		}
+		i.batch.Append(row)
+	}
+	if i.batch == nil || i.batch.Len == 0 {
+		return nil, io.EOF
+	}
+	return i.batch, nil
+}
+
+// Close implements the BatchRowIter interface.
+func (i *batchRowIter) Close(ctx *Context) error {
+	return i.iter.Close(ctx)
+}
@@ -30,8 +30,10 @@ type BinlogReplicaController interface {
 	// StartReplica tells the binlog replica controller to start up replication processes for the current replication
 	// configuration. An error is returned if replication was unable to be started. Note the error response only signals
 	// whether there was a problem with the initial replication start up. Replication could fail after being started up
-	// successfully with no error response returned.
-	StartReplica(ctx *sql.Context) error
+	// successfully with no error response returned. If |untilOptions| is not empty, it holds the options from a
+	// START REPLICA UNTIL clause (e.g. SOURCE_LOG_FILE and SOURCE_LOG_POS), and the replica controller should stop
+	// replication once the specified position has been reached.
+	StartReplica(ctx *sql.Context, untilOptions []ReplicationOption) error
 
 	// StopReplica tells the binlog replica controller to stop all replication processes. An error is returned if there
 	// were any problems stopping replication. If no replication processes were running, no error is returned.
@@ -92,6 +94,71 @@ type BinlogReplicaCatalog interface {
 	GetBinlogReplicaController() BinlogReplicaController
 }
 
+// BinlogPrimaryController allows callers to report on the state of a server acting as a binlog replication source.
+// Providers built on go-mysql-server may optionally implement this interface and use it when constructing a SQL engine
+// in order to receive callbacks when source-side replication statements (e.g. SHOW REPLICAS) are being handled.
+type BinlogPrimaryController interface {
+	// GetBinlogReplicaStatuses returns the status of each replica currently connected to this server. If no replicas
+	// are connected, an empty slice is returned. If any problems are encountered assembling the replicas' statuses, an
+	// error is returned.
+	GetBinlogReplicaStatuses(ctx *sql.Context) ([]ConnectedReplica, error)
+
+	// ListBinaryLogs returns the binary log files on this server, in the order they were written. If binary logging
+	// is disabled, an empty slice is returned.
+	ListBinaryLogs(ctx *sql.Context) ([]BinaryLogFile, error)
+
+	// ListBinlogEvents returns the events in the binary log file |logName|, starting with the event at position
+	// |startPos|. If |logName| is empty, the events in the first binary log file are returned. If |limit| is not
+	// negative, at most |limit| events are returned.
+	ListBinlogEvents(ctx *sql.Context, logName string, startPos uint64, limit int64) ([]BinlogEvent, error)
+}
+
+// BinlogSourceController allows callers to manage the binary logs of a server acting as a binlog replication source.
+// Providers built on go-mysql-server may optionally implement this interface on their BinlogPrimaryController in order
+// to receive callbacks when statements that modify the binary logs (e.g. RESET BINARY LOGS AND GTIDS) are being
+// handled.
+type BinlogSourceController interface {
+	// ResetBinaryLogs deletes all existing binary log files, clears the binary log index file and the GTID execution
+	// history, and starts a new binary log file. When |toPosition| is not zero, the new binary log file is numbered
+	// |toPosition| instead of 1. If any errors were encountered resetting the binary logs, an error is returned.
+	ResetBinaryLogs(ctx *sql.Context, toPosition uint64) error
+}
+
+// ConnectedReplica stores the status of a single binlog replica connected to this server and is returned by
+// `SHOW REPLICAS`.
+// https://dev.mysql.com/doc/refman/8.0/en/show-replicas.html
+type ConnectedReplica struct {
+	ServerId    uint32
+	Host        string
+	Port        uint16
+	SourceId    uint32
+	ReplicaUuid string
+}
+
+// BinaryLogFile describes a single binary log file and is returned by `SHOW BINARY LOGS`.
+// https://dev.mysql.com/doc/refman/8.0/en/show-binary-logs.html
+type BinaryLogFile struct {
+	Name      string
+	Size      uint64
+	Encrypted bool
+}
+
+// BinlogEvent describes a single event in a binary log file and is returned by `SHOW BINLOG EVENTS`.
+// https://dev.mysql.com/doc/refman/8.0/en/show-binlog-events.html
+type BinlogEvent struct {
+	LogName   string
+	Pos       uint64
+	EventType string
+	ServerId  uint32
+	EndLogPos uint64
+	Info      string
+}
+
+type BinlogPrimaryCatalog interface {
+	IsBinlogPrimaryCatalog() bool
+	GetBinlogPrimaryController() BinlogPrimaryController
+}
+
 const (
 	ReplicaIoNotRunning  = "No"
 	ReplicaIoConnecting  = "Connecting"
@@ -107,6 +174,37 @@ type ReplicationOption struct {
 	Value ReplicationOptionValue
 }
 
+// ReplicationOptionType identifies the type of value held by a ReplicationOption.
+type ReplicationOptionType byte
+
+const (
+	ReplicationOptionTypeUnknown ReplicationOptionType = iota
+	ReplicationOptionTypeString
+	ReplicationOptionTypeInteger
+	ReplicationOptionTypeDecimal
+	ReplicationOptionTypeBoolean
+	ReplicationOptionTypeTableNames
+)
+
+// Typed returns the type of this option's value, so that callers can tell which ReplicationOptionValue
+// implementation it holds without a type switch over both value and pointer receivers.
+func (ro ReplicationOption) Typed() ReplicationOptionType {
+	switch ro.Value.(type) {
+	case StringReplicationOptionValue, *StringReplicationOptionValue:
+		return ReplicationOptionTypeString
+	case IntegerReplicationOptionValue, *IntegerReplicationOptionValue:
+		return ReplicationOptionTypeInteger
+	case DecimalReplicationOptionValue, *DecimalReplicationOptionValue:
+		return ReplicationOptionTypeDecimal
+	case BooleanReplicationOptionValue, *BooleanReplicationOptionValue:
+		return ReplicationOptionTypeBoolean
+	case TableNamesReplicationOptionValue, *TableNamesReplicationOptionValue:
+		return ReplicationOptionTypeTableNames
+	default:
+		return ReplicationOptionTypeUnknown
+	}
+}
+
 // ReplicationOptionValue defines an interface for configuration option values for binlog replication. It holds the
 // values of options for configuring the replication source (i.e. "CHANGE REPLICATION SOURCE TO" options) and for
 // replication filtering (i.g. "SET REPLICATION FILTER" options).
@@ -191,6 +289,51 @@ func (ov IntegerReplicationOptionValue) String() string {
 	return strconv.Itoa(ov.Value)
 }
 
+// DecimalReplicationOptionValue is a ReplicationOptionValue implementation that holds a decimal value, such as the
+// number of seconds in SOURCE_HEARTBEAT_PERIOD.
+type DecimalReplicationOptionValue struct {
+	Value float64
+}
+
+var _ ReplicationOptionValue = (*DecimalReplicationOptionValue)(nil)
+
+func (ov DecimalReplicationOptionValue) GetValue() interface{} {
+	return ov.GetValueAsFloat()
+}
+
+func (ov DecimalReplicationOptionValue) GetValueAsFloat() float64 {
+	return ov.Value
+}
+
+// String implements the Stringer interface and returns a string representation of this option value.
+func (ov DecimalReplicationOptionValue) String() string {
+	return strconv.FormatFloat(ov.Value, 'f', -1, 64)
+}
+
+// BooleanReplicationOptionValue is a ReplicationOptionValue implementation that holds a boolean value, for options
+// such as SOURCE_AUTO_POSITION that are enabled with 1 and disabled with 0.
+type BooleanReplicationOptionValue struct {
+	Value bool
+}
+
+var _ ReplicationOptionValue = (*BooleanReplicationOptionValue)(nil)
+
+func (ov BooleanReplicationOptionValue) GetValue() interface{} {
+	return ov.GetValueAsBool()
+}
+
+func (ov BooleanReplicationOptionValue) GetValueAsBool() bool {
+	return ov.Value
+}
+
+// String implements the Stringer interface and returns a string representation of this option value.
+func (ov BooleanReplicationOptionValue) String() string {
+	if ov.Value {
+		return "1"
+	}
+	return "0"
+}
+
 // NewReplicationOption creates a new ReplicationOption instance, with the specified |name| and |value|.
 func NewReplicationOption(name string, value ReplicationOptionValue) *ReplicationOption {
 	return &ReplicationOption{
@@ -62,6 +62,22 @@ func ResolveCoercibility(leftCollation CollationID, leftCoercibility byte, right
 	}
 }
 
+// ValidateCollationMix returns an error if two strings with the given collations can't be compared or combined. Only
+// explicit collations, given with a COLLATE clause, are checked: MySQL reports an illegal mix of collations when they
+// differ, unless exactly one of them is a Unicode collation, which then wins. All other mixes are left to
+// ResolveCoercibility.
+func ValidateCollationMix(leftCollation CollationID, leftCoercibility byte, rightCollation CollationID, rightCoercibility byte) error {
+	if leftCoercibility != 0 || rightCoercibility != 0 || leftCollation == rightCollation {
+		return nil
+	}
+	leftCharset := leftCollation.CharacterSet()
+	rightCharset := rightCollation.CharacterSet()
+	if leftCharset != rightCharset && (leftCharset.MaxLength() == 1) != (rightCharset.MaxLength() == 1) {
+		return nil
+	}
+	return ErrCollationIllegalMix.New(leftCollation.Name()+",EXPLICIT", rightCollation.Name()+",EXPLICIT")
+}
+
 // GetCoercibility returns the coercibility of the given node or expression.
 func GetCoercibility(ctx *Context, nodeOrExpr interface{}) (collation CollationID, coercibility byte) {
 	if nodeOrExpr == nil {
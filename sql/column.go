@@ -55,6 +55,10 @@ type Column struct {
 	Virtual bool
 	// OnUpdate contains the on update value of the column or nil if it was not explicitly defined.
 	OnUpdate *ColumnDefaultValue
+	// ConvertTimezone is true if the values of this DATETIME column are stored in UTC. Values are converted from the
+	// session time zone to UTC before they are written to the table, and from UTC to the session time zone when they
+	// are read from it. Integrators set this on the schemas of their tables; it has no effect on other types.
+	ConvertTimezone bool
 }
 
 // Check ensures the value is correct for this column.
@@ -86,6 +86,10 @@ type CheckConstraint struct {
 	Name     string
 	Expr     Expression
 	Enforced bool
+	// ViewCheckOption is true for the conditions that rows written through a view are checked against because of a WITH
+	// CHECK OPTION clause, in which case Name is the qualified name of the view. Unlike a check constraint, a check
+	// option is violated by rows that the condition evaluates to NULL for.
+	ViewCheckOption bool
 }
 
 type CheckConstraints []*CheckConstraint
@@ -364,6 +364,9 @@ var SystemVariables SystemVariableRegistry
 type SystemVariableRegistry interface {
 	// AddSystemVariables adds the given system variables to this registry
 	AddSystemVariables(sysVars []SystemVariable)
+	// RegisterSystemVariables adds the given system variables to this registry, returning an error if any of them is
+	// invalid or collides with a variable that is already registered. No variables are added when an error is returned.
+	RegisterSystemVariables(sysVars []SystemVariable) error
 	// AssignValues assigns the given values to the system variables in this registry
 	AssignValues(vals map[string]interface{}) error
 	// NewSessionMap returns a map of system variables values that can be used by a session
@@ -22,6 +22,8 @@ import (
 const (
 	// InformationSchemaDatabaseName is the name of the information schema database.
 	InformationSchemaDatabaseName = "information_schema"
+	// PerformanceSchemaDatabaseName is the name of the performance schema database.
+	PerformanceSchemaDatabaseName = "performance_schema"
 )
 
 // DatabaseProvider is the fundamental interface to integrate with the engine. It provides access to all databases in
@@ -54,6 +56,17 @@ type CollatedDatabaseProvider interface {
 	CreateCollatedDatabase(ctx *Context, name string, collation CollationID) error
 }
 
+// TransactionalDDLProvider is a DatabaseProvider whose databases apply DDL statements within the surrounding
+// transaction, so that schema changes are committed or rolled back along with any data changes. For databases without
+// this capability, DDL statements implicitly commit the current transaction, as in MySQL.
+type TransactionalDDLProvider interface {
+	DatabaseProvider
+
+	// SupportsTransactionalDDL returns whether DDL statements against the database named participate in the
+	// surrounding transaction.
+	SupportsTransactionalDDL(ctx *Context, dbName string) bool
+}
+
 // TableFunctionProvider is an interface that allows custom table functions to be provided. It's usually (but not
 // always) implemented by a DatabaseProvider.
 type TableFunctionProvider interface {
@@ -16,7 +16,10 @@ package sql
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
+	"sync"
+	"time"
 )
 
 type WithDescribeStats interface {
@@ -88,6 +91,94 @@ func (c CountingRowIter) Next(ctx *Context) (Row, error) {
 	return res, err
 }
 
+// ExecStats are the runtime statistics of a plan node, collected while a query is executed by EXPLAIN ANALYZE.
+type ExecStats struct {
+	// Rows is the number of rows returned by the node, over all of its loops.
+	Rows uint64
+	// Loops is the number of times the node was executed.
+	Loops uint64
+	// Elapsed is the time spent building and iterating the node, including the time spent in its children.
+	Elapsed time.Duration
+	// FirstRow is the part of Elapsed spent before the node returned its first row, or ran out of rows, in each loop.
+	FirstRow time.Duration
+}
+
+// String returns the stats as printed by EXPLAIN ANALYZE, like MySQL does: the times to the first row and to all rows
+// in milliseconds, and the number of rows, are averages per loop.
+func (s ExecStats) String() string {
+	var firstRow, allRows, averageRowCount float64
+	if s.Loops > 0 {
+		loops := float64(s.Loops)
+		firstRow = float64(s.FirstRow) / float64(time.Millisecond) / loops
+		allRows = float64(s.Elapsed) / float64(time.Millisecond) / loops
+		averageRowCount = float64(s.Rows) / loops
+	}
+	return fmt.Sprintf("(actual time=%.3f..%.3f rows=%v loops=%v)", firstRow, allRows, averageRowCount, s.Loops)
+}
+
+// ExecStatsCollector records the ExecStats of the nodes of a plan as it is executed. It is safe for concurrent use.
+// Only nodes that are pointers are recorded, since other nodes cannot be told apart from their copies.
+type ExecStatsCollector struct {
+	mu    sync.Mutex
+	stats map[Node]*ExecStats
+}
+
+// NewExecStatsCollector returns a new, empty ExecStatsCollector.
+func NewExecStatsCollector() *ExecStatsCollector {
+	return &ExecStatsCollector{stats: make(map[Node]*ExecStats)}
+}
+
+// Get returns the stats recorded for the node given, and whether the node was executed.
+func (c *ExecStatsCollector) Get(n Node) (ExecStats, bool) {
+	if !isRecordable(n) {
+		return ExecStats{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.stats[n]
+	if !ok {
+		return ExecStats{}, false
+	}
+	return *s, true
+}
+
+// AddLoop records a new execution of the node given, which took |elapsed| to build.
+func (c *ExecStatsCollector) AddLoop(n Node, elapsed time.Duration) {
+	c.add(n, 1, 0, elapsed, 0)
+}
+
+// AddRows records |rows| rows returned by the node given, which took |elapsed| to produce.
+func (c *ExecStatsCollector) AddRows(n Node, rows uint64, elapsed time.Duration) {
+	c.add(n, 0, rows, elapsed, 0)
+}
+
+// AddFirstRow records that a loop of the node given returned its first row, or ran out of rows, |elapsed| after the
+// loop started.
+func (c *ExecStatsCollector) AddFirstRow(n Node, elapsed time.Duration) {
+	c.add(n, 0, 0, 0, elapsed)
+}
+
+func (c *ExecStatsCollector) add(n Node, loops, rows uint64, elapsed, firstRow time.Duration) {
+	if !isRecordable(n) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.stats[n]
+	if !ok {
+		s = &ExecStats{}
+		c.stats[n] = s
+	}
+	s.Loops += loops
+	s.Rows += rows
+	s.Elapsed += elapsed
+	s.FirstRow += firstRow
+}
+
+func isRecordable(n Node) bool {
+	return n != nil && reflect.ValueOf(n).Kind() == reflect.Pointer
+}
+
 type Describable interface {
 	Describe(options DescribeOptions) string
 }
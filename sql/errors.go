@@ -110,6 +110,13 @@ var (
 	// ErrInvalidJSONText is returned when a JSON string cannot be parsed or unmarshalled
 	ErrInvalidJSONText = errors.NewKind("Invalid JSON text: %s")
 
+	// ErrJSONValueNotFound is returned by JSON_VALUE with ERROR ON EMPTY when its path doesn't find a value
+	ErrJSONValueNotFound = errors.NewKind("No value was found by 'json_value' on the specified path.")
+
+	// ErrInvalidJSONValueForCast is returned by JSON_VALUE with ERROR ON ERROR when the value it finds isn't a scalar,
+	// or can't be converted to its RETURNING type
+	ErrInvalidJSONValueForCast = errors.NewKind("Invalid JSON value for CAST to %s from column json_value")
+
 	// ErrDeleteRowNotFound is returned when row being deleted was not found
 	ErrDeleteRowNotFound = errors.NewKind("row was not found when attempting to delete")
 
@@ -128,6 +135,9 @@ var (
 	ErrMisusedAlias = errors.NewKind("column %q does not exist in scope, but there is an alias defined in" +
 		" this projection with that name. Aliases cannot be used in the same projection they're defined in")
 
+	// ErrWrongGroupField is returned when a GROUP BY clause references an alias of an aggregate expression.
+	ErrWrongGroupField = errors.NewKind("can't group on '%s'")
+
 	// ErrInvalidAsOfExpression is returned when an expression for AS OF cannot be used
 	ErrInvalidAsOfExpression = errors.NewKind("expression %s cannot be used in AS OF")
 
@@ -251,6 +261,12 @@ var (
 
 	ErrSystemVariableReinitialized = errors.NewKind(`Variable '%s' was initialized more than 1x`)
 
+	// ErrSystemVariableAlreadyExists is returned when registering a system variable whose name is already in use.
+	ErrSystemVariableAlreadyExists = errors.NewKind(`Variable '%s' already exists`)
+
+	// ErrInvalidSystemVariable is returned when registering a system variable with an invalid definition.
+	ErrInvalidSystemVariable = errors.NewKind(`Invalid definition for system variable '%s': %s`)
+
 	// ErrSystemVariableSessionOnly is returned when attempting to set a SESSION-only variable using SET GLOBAL.
 	ErrSystemVariableSessionOnly = errors.NewKind(`Variable '%s' is a SESSION variable and can't be used with SET GLOBAL`)
 
@@ -296,6 +312,22 @@ var (
 	// ErrCheckConstraintViolated is returned when a CONSTRAINT CHECK is called with a sub-query expression.
 	ErrCheckConstraintViolated = errors.NewKind("Check constraint %q violated")
 
+	// ErrViewCheckOptionFailed is returned when a row written through a view with a WITH CHECK OPTION clause isn't a row
+	// of the view.
+	ErrViewCheckOptionFailed = errors.NewKind("CHECK OPTION failed '%s'")
+
+	// ErrViewCheckOptionNonUpdatable is returned when a view that rows can't be written through is created with a WITH
+	// CHECK OPTION clause.
+	ErrViewCheckOptionNonUpdatable = errors.NewKind("CHECK OPTION on non-updatable view '%s'")
+
+	// ErrColumnNotUpdatable is returned when a column of a view that doesn't select a column of its base table is
+	// written to.
+	ErrColumnNotUpdatable = errors.NewKind("Column '%s' is not updatable")
+
+	// ErrNonUpdatableTable is returned when rows are inserted, updated or deleted through a view that rows can't be
+	// written through, such as a view defined with a UNION, DISTINCT or aggregate functions.
+	ErrNonUpdatableTable = errors.NewKind("The target table %s of the %s is not updatable")
+
 	// ErrCheckConstraintInvalidatedByColumnAlter is returned when an alter column statement would invalidate a check constraint.
 	ErrCheckConstraintInvalidatedByColumnAlter = errors.NewKind("can't alter column %q because it would invalidate check constraint %q")
 
@@ -303,6 +335,10 @@ var (
 	// list with a different number of columns than the schema of the table.
 	ErrColumnCountMismatch = errors.NewKind("In definition of view, derived table or common table expression, SELECT list and column names list have different column counts")
 
+	// ErrValuesRowColumnCountMismatch is returned when the rows of a VALUES table value constructor have different
+	// numbers of columns.
+	ErrValuesRowColumnCountMismatch = errors.NewKind("Column count doesn't match value count at row %d")
+
 	// ErrUuidUnableToParse is returned when a UUID is unable to be parsed.
 	ErrUuidUnableToParse = errors.NewKind("unable to parse '%s' to UUID: %s")
 
@@ -342,6 +378,12 @@ var (
 	// ErrDeclareHandlerDuplicate is returned when a DECLARE ... HANDLER statement has a duplicate in the same block.
 	ErrDeclareHandlerDuplicate = errors.NewKind("duplicate handler declared in the same block")
 
+	// ErrInvalidDiagnosticsItem is returned when GET DIAGNOSTICS reads a condition item as a statement item, or vice versa.
+	ErrInvalidDiagnosticsItem = errors.NewKind("%s is not a %s information item")
+
+	// ErrInvalidConditionNumber is returned when GET DIAGNOSTICS reads a condition that is not in the diagnostics area.
+	ErrInvalidConditionNumber = errors.NewKind("Invalid condition number")
+
 	// ErrDeclareHandlerUndo is returned when a DECLARE ... HANDLER statement has the UNDO action, which is currently unsupported.
 	ErrDeclareHandlerUndo = errors.NewKind("DECLARE ... HANDLER does not support the UNDO action")
 
@@ -379,6 +421,17 @@ var (
 	// ErrAlterTableNotSupported is thrown when the table doesn't support ALTER TABLE statements
 	ErrAlterTableNotSupported = errors.NewKind("table %s cannot be altered")
 
+	// ErrAlterAlgorithmNotSupported is returned when an ALTER TABLE statement requests an ALGORITHM that the operation
+	// can't be performed with
+	ErrAlterAlgorithmNotSupported = errors.NewKind("ALGORITHM=%s is not supported for this operation. Try ALGORITHM=%s.")
+
+	// ErrAlterLockNotSupported is returned when an ALTER TABLE statement requests a LOCK that the operation can't be
+	// performed with
+	ErrAlterLockNotSupported = errors.NewKind("LOCK=%s is not supported. Reason: %s. Try LOCK=%s.")
+
+	// ErrUnsupportedTableEngine is returned when an ALTER TABLE statement converts a table to a different engine
+	ErrUnsupportedTableEngine = errors.NewKind("table %s cannot be converted to engine %s")
+
 	// ErrAlterTableCollationNotSupported is thrown when the table doesn't support ALTER TABLE COLLATE statements
 	ErrAlterTableCollationNotSupported = errors.NewKind("table %s cannot have its collation altered")
 
@@ -539,6 +592,13 @@ var (
 	// function is different from the function arity.
 	ErrInvalidArgumentNumber = errors.NewKind("function '%s' expected %v arguments, %v received")
 
+	// ErrNoMatchingFunctionOverload is returned when none of the overloads of a function accept the arguments given.
+	ErrNoMatchingFunctionOverload = errors.NewKind("function '%s' has no overload accepting arguments (%s), candidates are: %s")
+
+	// ErrAmbiguousFunctionCall is returned when more than one overload of a function matches the arguments given
+	// equally well.
+	ErrAmbiguousFunctionCall = errors.NewKind("call to function '%s' with arguments (%s) is ambiguous, candidates are: %s")
+
 	// ErrDatabaseNotFound is thrown when a database is not found
 	ErrDatabaseNotFound = errors.NewKind("database not found: %s")
 
@@ -564,10 +624,18 @@ var (
 	// ErrReadOnlyTransaction is returned when a write query is executed in a READ ONLY transaction.
 	ErrReadOnlyTransaction = errors.NewKind("cannot execute statement in a READ ONLY transaction")
 
+	// ErrMixedTransactionalDDL is returned when a DDL statement that implicitly commits the current transaction is
+	// executed in a transaction that already contains DDL applied transactionally.
+	ErrMixedTransactionalDDL = errors.NewKind("cannot mix DDL statements on databases with and without transactional DDL support in a single transaction")
+
 	// ErrLockDeadlock is the go-mysql-server equivalent of ER_LOCK_DEADLOCK. Transactions throwing this error
 	// are automatically rolled back. Clients receiving this error must retry the transaction.
 	ErrLockDeadlock = errors.NewKind("serialization failure: %s, try restarting transaction.")
 
+	// ErrLockWaitTimeout is returned when a lock, such as the metadata lock on a table, isn't granted within
+	// @@lock_wait_timeout seconds
+	ErrLockWaitTimeout = errors.NewKind("Lock wait timeout exceeded; try restarting transaction")
+
 	// ErrViewsNotSupported is returned when attempting to access a view on a database that doesn't support them.
 	ErrViewsNotSupported = errors.NewKind("database '%s' doesn't support views")
 
@@ -583,6 +651,16 @@ var (
 	// ErrSessionDoesNotSupportPersistence is thrown when a feature is not already supported
 	ErrSessionDoesNotSupportPersistence = errors.NewKind("session does not support persistence")
 
+	// ErrPersistedVariableNotFound is returned by RESET PERSIST for a system variable that isn't persisted
+	ErrPersistedVariableNotFound = errors.NewKind("Variable %s does not exist in persisted config file")
+
+	// ErrInvalidReplicationOptionValue is returned when a replication option is given a value of the wrong type, which
+	// MySQL reports as a syntax error
+	ErrInvalidReplicationOptionValue = errors.NewKind("You have an error in your SQL syntax; option %s requires %s value, found %s")
+
+	// ErrReplicaHeartbeatOutOfRange is returned when SOURCE_HEARTBEAT_PERIOD is given a value outside the allowed range
+	ErrReplicaHeartbeatOutOfRange = errors.NewKind("The requested value for the heartbeat period is either negative or exceeds the maximum allowed (%d seconds).")
+
 	// ErrInvalidGISData is thrown when a "ST_<spatial_type>FromText" function receives a malformed string
 	ErrInvalidGISData = errors.NewKind("invalid GIS data provided to function %s")
 
@@ -673,6 +751,10 @@ var (
 	// ErrRevokeUserDoesNotExist is returned when a user does not exist when attempting to revoke privileges from them.
 	ErrRevokeUserDoesNotExist = errors.NewKind("There is no such grant defined for user '%s' on host '%s'")
 
+	// ErrRevokeTableGrantDoesNotExist is returned when revoking table privileges from a user that has no privileges
+	// on the table.
+	ErrRevokeTableGrantDoesNotExist = errors.NewKind("There is no such grant defined for user '%s' on host '%s' on table '%s'")
+
 	// ErrGrantRevokeRoleDoesNotExist is returned when a user or role does not exist when attempting to grant or revoke roles.
 	ErrGrantRevokeRoleDoesNotExist = errors.NewKind("Unknown authorization ID %s")
 
@@ -685,8 +767,8 @@ var (
 	// ErrRecursiveCTENotUnion is returned when an INTERSECT or EXCEPT includes a Recursive CTE.
 	ErrRecursiveCTENotUnion = errors.NewKind("Recursive table reference in EXCEPT or INTERSECT operand is not allowed")
 
-	// ErrCteRecursionLimitExceeded is returned when a recursive CTE's execution stack depth exceeds the static limit.
-	ErrCteRecursionLimitExceeded = errors.NewKind("WITH RECURSIVE iteration limit exceeded")
+	// ErrCteRecursionLimitExceeded is returned when a recursive CTE runs more iterations than cte_max_recursion_depth allows.
+	ErrCteRecursionLimitExceeded = errors.NewKind("Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value.")
 
 	// ErrGrantRevokeIllegalPrivilege is returned when a GRANT or REVOKE statement is malformed, or attempts to use privilege incorrectly.
 	ErrGrantRevokeIllegalPrivilege = errors.NewKind("Illegal GRANT/REVOKE command")
@@ -805,6 +887,9 @@ var (
 	// ErrValueOutOfRange is returned when a value is out of range for a type.
 	ErrValueOutOfRange = errors.NewKind("%v out of range for %v")
 
+	// ErrValueOutOfRangeForColumn is returned when a value written to a numeric column is out of the range of its type.
+	ErrValueOutOfRangeForColumn = errors.NewKind("Out of range value for column '%s' at row %d")
+
 	ErrConvertingToSet   = errors.NewKind("value %v is not valid for this set")
 	ErrDuplicateEntrySet = errors.NewKind("duplicate entry: %v")
 	ErrInvalidSetValue   = errors.NewKind("value %v was not found in the set")
@@ -878,6 +963,10 @@ var (
 	// ErrGeneratedColumnWithDefault is returned when a column specifies both a default and a generated value
 	ErrGeneratedColumnWithDefault = errors.NewKind("Incorrect usage of DEFAULT and generated column")
 
+	// ErrGeneratedColumnCycle is returned when a generated column refers to itself, either directly or through other
+	// generated columns
+	ErrGeneratedColumnCycle = errors.NewKind("Generated column %q cannot refer to itself, directly or through other generated columns")
+
 	ErrInvalidOnUpdate = errors.NewKind("Invalid ON UPDATE clause for '%s' column")
 
 	ErrInsertIntoMismatchValueCount = errors.NewKind("number of values does not match number of columns provided")
@@ -885,6 +974,37 @@ var (
 	ErrInvalidTypeForLimit = errors.NewKind("invalid limit. expected %T, found %T")
 
 	ErrColumnSpecifiedTwice = errors.NewKind("column '%v' specified twice")
+
+	// ErrQueryTimeout is returned when a statement runs longer than its MAX_EXECUTION_TIME
+	ErrQueryTimeout = errors.NewKind("Query execution was interrupted, maximum statement execution time exceeded")
+
+	// ErrResourceGroupExists is returned when creating a resource group with the name of an existing one
+	ErrResourceGroupExists = errors.NewKind("Resource Group '%s' exists")
+
+	// ErrResourceGroupNotExists is returned when a resource group named by a statement doesn't exist
+	ErrResourceGroupNotExists = errors.NewKind("Resource Group '%s' does not exist.")
+
+	// ErrInvalidVCPUId is returned when a resource group names a CPU that the server doesn't have
+	ErrInvalidVCPUId = errors.NewKind("Invalid cpu id %d")
+
+	// ErrInvalidVCPURange is returned when a resource group has a CPU range that ends before it starts
+	ErrInvalidVCPURange = errors.NewKind("Invalid VCPU range %s")
+
+	// ErrInvalidThreadPriority is returned when a resource group has a thread priority outside the range of its type
+	ErrInvalidThreadPriority = errors.NewKind("Invalid thread priority value %d for %s resource group %s. Allowed range is [%d, %d].")
+
+	// ErrResourceGroupOperationDisallowed is returned when altering or dropping a default resource group
+	ErrResourceGroupOperationDisallowed = errors.NewKind("%s operation is disallowed on %s")
+
+	// ErrResourceGroupBusy is returned when disabling or dropping a resource group that threads are assigned to,
+	// without FORCE
+	ErrResourceGroupBusy = errors.NewKind("Resource group %s is busy.")
+
+	// ErrResourceGroupDisabled is returned when assigning a thread to a disabled resource group
+	ErrResourceGroupDisabled = errors.NewKind("Resource group %s is disabled.")
+
+	// ErrResourceGroupBindFailed is returned when a thread can't be assigned to a resource group
+	ErrResourceGroupBindFailed = errors.NewKind("Unable to bind resource group %s with thread id (%d).(%s).")
 )
 
 // CastSQLError returns a *mysql.SQLError with the error code and in some cases, also a SQL state, populated for the
@@ -923,12 +1043,28 @@ func CastSQLError(err error) *mysql.SQLError {
 		code = mysql.ERBadNullError
 	case ErrNonAggregatedColumnWithoutGroupBy.Is(err):
 		code = mysql.ERMixOfGroupFuncAndFields
+	case ErrWrongGroupField.Is(err):
+		code = mysql.ERWrongGroupField
 	case ErrPrimaryKeyViolation.Is(err):
 		code = mysql.ERDupEntry
 	case ErrUniqueKeyViolation.Is(err):
 		code = mysql.ERDupEntry
 	case ErrPartitionNotFound.Is(err):
 		code = 1526 // TODO: Needs to be added to vitess
+	case ErrViewCheckOptionFailed.Is(err):
+		code = 1369 // TODO: Needs to be added to vitess
+	case ErrViewCheckOptionNonUpdatable.Is(err):
+		code = 1368 // TODO: Needs to be added to vitess
+	case ErrColumnNotUpdatable.Is(err):
+		code = 1348 // TODO: Needs to be added to vitess
+	case ErrCheckConstraintViolated.Is(err):
+		code = 3819 // TODO: Needs to be added to vitess
+	case ErrNonUpdatableTable.Is(err):
+		code = mysql.ERNonUpdateableTable
+	case ErrInvalidRefInView.Is(err):
+		code = 1356 // TODO: Needs to be added to vitess
+	case ErrCollationIllegalMix.Is(err):
+		code = mysql.ERCantAggregate2Collations
 	case ErrForeignKeyChildViolation.Is(err):
 		code = mysql.ErNoReferencedRow2 // test with mysql returns 1452 vs 1216
 	case ErrForeignKeyParentViolation.Is(err):
@@ -936,7 +1072,13 @@ func CastSQLError(err error) *mysql.SQLError {
 	case ErrDuplicateEntry.Is(err):
 		code = mysql.ERDupEntry
 	case ErrInvalidJSONText.Is(err):
-		code = 3141 // TODO: Needs to be added to vitess
+		code = mysql.ERInvalidJSONTextInParams
+	case ErrInvalidJson.Is(err):
+		code = mysql.ERInvalidJSONText
+	case ErrJSONValueNotFound.Is(err):
+		code = 3966 // TODO: Needs to be added to vitess
+	case ErrInvalidJSONValueForCast.Is(err):
+		code = 3156 // TODO: Needs to be added to vitess
 	case ErrMultiplePrimaryKeysDefined.Is(err):
 		code = mysql.ERMultiplePriKey
 	case ErrWrongAutoKey.Is(err):
@@ -945,16 +1087,73 @@ func CastSQLError(err error) *mysql.SQLError {
 		code = mysql.ERKeyColumnDoesNotExist
 	case ErrCantDropFieldOrKey.Is(err):
 		code = mysql.ERCantDropFieldOrKey
+	case ErrUnknownSystemVariable.Is(err):
+		code = mysql.ERUnknownSystemVariable
+	case ErrPersistedVariableNotFound.Is(err):
+		code = 3615 // TODO: Needs to be added to vitess
+	case ErrCteRecursionLimitExceeded.Is(err):
+		code = 3636 // TODO: Needs to be added to vitess
+	case ErrInvalidConditionNumber.Is(err):
+		code = 1758 // TODO: Needs to be added to vitess
+		sqlState = "35000"
+	case ErrMoreThanOneRow.Is(err):
+		code = mysql.ERTooManyRows
+	case ErrColumnNumberDoesNotMatch.Is(err):
+		code = mysql.ERWrongNumberOfColumnsInSelect
+	case ErrFileExists.Is(err):
+		code = mysql.ERFileExists
+	case ErrSecureFilePriv.Is(err):
+		code = mysql.EROptionPreventsStatement
+	case ErrValuesRowColumnCountMismatch.Is(err):
+		code = mysql.ERWrongValueCountOnRow
+	case ErrRevokeUserDoesNotExist.Is(err):
+		code = mysql.ERNonExistingGrant
+	case ErrRevokeTableGrantDoesNotExist.Is(err):
+		code = mysql.ERNonExistingTableGrant
 	case ErrReadOnlyTransaction.Is(err):
 		code = 1792 // TODO: Needs to be added to vitess
 	case ErrCantDropIndex.Is(err):
 		code = 1553 // TODO: Needs to be added to vitess
 	case ErrInvalidValue.Is(err):
 		code = mysql.ERTruncatedWrongValueForField
+	case ErrValueOutOfRangeForColumn.Is(err):
+		code = mysql.ERWarnDataOutOfRange
+		sqlState = mysql.SSDataOutOfRange
 	case ErrUnknownColumn.Is(err):
 		code = mysql.ERBadFieldError
 	case ErrColumnSpecifiedTwice.Is(err):
 		code = mysql.ERFieldSpecifiedTwice
+	case ErrQueryTimeout.Is(err):
+		code = mysql.ERQueryTimeout
+	case ErrAlterAlgorithmNotSupported.Is(err):
+		code = 1845 // TODO: Needs to be added to vitess
+	case ErrAlterLockNotSupported.Is(err):
+		code = 1846 // TODO: Needs to be added to vitess
+	case ErrInvalidReplicationOptionValue.Is(err):
+		code = mysql.ERParseError
+		sqlState = mysql.SSClientError
+	case ErrReplicaHeartbeatOutOfRange.Is(err):
+		code = 1703 // TODO: Needs to be added to vitess
+	case ErrResourceGroupExists.Is(err):
+		code = 3650 // TODO: Needs to be added to vitess
+	case ErrResourceGroupNotExists.Is(err):
+		code = 3651 // TODO: Needs to be added to vitess
+	case ErrInvalidVCPUId.Is(err):
+		code = 3652 // TODO: Needs to be added to vitess
+	case ErrInvalidVCPURange.Is(err):
+		code = 3653 // TODO: Needs to be added to vitess
+	case ErrInvalidThreadPriority.Is(err):
+		code = 3654 // TODO: Needs to be added to vitess
+	case ErrResourceGroupOperationDisallowed.Is(err):
+		code = 3655 // TODO: Needs to be added to vitess
+	case ErrResourceGroupBusy.Is(err):
+		code = 3656 // TODO: Needs to be added to vitess
+	case ErrResourceGroupDisabled.Is(err):
+		code = 3657 // TODO: Needs to be added to vitess
+	case ErrResourceGroupBindFailed.Is(err):
+		code = 3661 // TODO: Needs to be added to vitess
+	case ErrLockWaitTimeout.Is(err):
+		code = mysql.ERLockWaitTimeout
 	case ErrLockDeadlock.Is(err):
 		// ER_LOCK_DEADLOCK signals that the transaction was rolled back
 		// due to a deadlock between concurrent transactions.
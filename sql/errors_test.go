@@ -17,6 +17,13 @@ func TestSQLErrorCast(t *testing.T) {
 		code int
 	}{
 		{ErrTableNotFound.New("table not found err"), mysql.ERNoSuchTable},
+		{ErrMoreThanOneRow.New(), mysql.ERTooManyRows},
+		{ErrColumnNumberDoesNotMatch.New(), mysql.ERWrongNumberOfColumnsInSelect},
+		{ErrFileExists.New("exists.txt"), mysql.ERFileExists},
+		{ErrCheckConstraintViolated.New("chk1"), 3819},
+		{ErrCollationIllegalMix.New("utf8mb4_bin,EXPLICIT", "utf8mb4_0900_ai_ci,EXPLICIT"), 1267},
+		{ErrNonUpdatableTable.New("v", "INSERT"), mysql.ERNonUpdateableTable},
+		{ErrInvalidRefInView.New("mydb", "v"), 1356},
 		{ErrInvalidType.New("unhandled mysql error"), mysql.ERUnknownError},
 		{fmt.Errorf("generic error"), mysql.ERUnknownError},
 		{nil, mysql.ERUnknownError},
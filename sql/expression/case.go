@@ -58,7 +58,7 @@ func combinedCaseBranchType(left, right sql.Type) sql.Type {
 	if types.IsTextBlob(left) && types.IsTextBlob(right) {
 		return types.LongBlob
 	}
-	if types.IsTime(left) && types.IsTime(right) {
+	if isTemporal(left) && isTemporal(right) {
 		if left == right {
 			return left
 		}
@@ -90,6 +90,39 @@ func combinedCaseBranchType(left, right sql.Type) sql.Type {
 	return types.LongText
 }
 
+// isTemporal returns whether |t| is a DATE, DATETIME, TIMESTAMP or TIME type.
+func isTemporal(t sql.Type) bool {
+	return types.IsTime(t) || types.IsTimespan(t)
+}
+
+// compareAsDouble returns whether the operand and WHEN values of a simple CASE expression must be compared as
+// doubles. MySQL aggregates a single comparison type over the operand and all WHEN values, rather than comparing
+// each pair on its own, so a mix of strings and numbers compares every pair numerically.
+func (c *Case) compareAsDouble() bool {
+	if c.Expr == nil {
+		return false
+	}
+	hasNumber, hasString := false, false
+	for _, e := range append([]sql.Expression{c.Expr}, c.conds()...) {
+		t := e.Type()
+		switch {
+		case types.IsNumber(t):
+			hasNumber = true
+		case types.IsText(t) && !types.IsBinaryType(t):
+			hasString = true
+		}
+	}
+	return hasNumber && hasString
+}
+
+func (c *Case) conds() []sql.Expression {
+	conds := make([]sql.Expression, len(c.Branches))
+	for i, b := range c.Branches {
+		conds[i] = b.Cond
+	}
+	return conds
+}
+
 // Type implements the sql.Expression interface.
 func (c *Case) Type() sql.Type {
 	curr := types.Null
@@ -161,10 +194,13 @@ func (c *Case) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	defer span.End()
 
 	t := c.Type()
+	asDouble := c.compareAsDouble()
 
 	for _, b := range c.Branches {
 		var cond sql.Expression
-		if c.Expr != nil {
+		if asDouble {
+			cond = NewEquals(NewConvert(c.Expr, ConvertToDouble), NewConvert(b.Cond, ConvertToDouble))
+		} else if c.Expr != nil {
 			cond = NewEquals(c.Expr, b.Cond)
 		} else {
 			cond = b.Cond
@@ -243,6 +243,26 @@ func TestCaseType(t *testing.T) {
 			caseExpr(NewLiteral("2020-04-07", types.Date), NewLiteral("2020-04-07T00:00:00Z", types.Timestamp)),
 			types.DatetimeMaxPrecision,
 		},
+		{
+			"date and time becomes datetime",
+			caseExpr(NewLiteral("2020-04-07", types.Date), NewLiteral("10:00:00", types.Time)),
+			types.DatetimeMaxPrecision,
+		},
+		{
+			"time and time stays time",
+			caseExpr(NewLiteral("10:00:00", types.Time), NewLiteral("11:00:00", types.Time)),
+			types.Time,
+		},
+		{
+			"date and text to text",
+			caseExpr(NewLiteral("2020-04-07", types.Date), NewLiteral("Hello, world!", types.LongText)),
+			types.LongText,
+		},
+		{
+			"all null but one",
+			caseExpr(NewLiteral(nil, types.Null), NewLiteral(nil, types.Null), NewLiteral(int32(1), types.Int32), NewLiteral(nil, types.Null)),
+			types.Int32,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -268,3 +288,78 @@ func TestCaseNullBranch(t *testing.T) {
 	require.NoError(err)
 	require.Nil(result)
 }
+
+func TestCaseBranchConversion(t *testing.T) {
+	f := NewCase(
+		nil,
+		[]CaseBranch{
+			{
+				Cond:  NewGetField(0, types.Boolean, "x", false),
+				Value: NewLiteral(int64(1), types.Int64),
+			},
+			{
+				Cond:  NewGetField(1, types.Boolean, "y", false),
+				Value: NewLiteral(nil, types.Null),
+			},
+		},
+		NewLiteral("abc", types.LongText),
+	)
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"number branch converted to text", sql.Row{true, false}, "1"},
+		{"null branch stays null", sql.Row{false, true}, nil},
+		{"else branch", sql.Row{false, false}, "abc"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestCaseSimpleComparisonType(t *testing.T) {
+	f := NewCase(
+		NewGetField(0, types.LongText, "x", false),
+		[]CaseBranch{
+			{Cond: NewLiteral("1", types.LongText), Value: NewLiteral("string", types.LongText)},
+			{Cond: NewLiteral(int64(1), types.Int64), Value: NewLiteral("number", types.LongText)},
+		},
+		NewLiteral("none", types.LongText),
+	)
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"string operand compared numerically to string", sql.Row{"1.0"}, "string"},
+		{"exact match", sql.Row{"1"}, "string"},
+		{"no match", sql.Row{"2"}, "none"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+
+	allStrings := NewCase(
+		NewGetField(0, types.LongText, "x", false),
+		[]CaseBranch{
+			{Cond: NewLiteral("1", types.LongText), Value: NewLiteral("string", types.LongText)},
+		},
+		NewLiteral("none", types.LongText),
+	)
+	result, err := allStrings.Eval(sql.NewEmptyContext(), sql.Row{"1.0"})
+	require.NoError(t, err)
+	require.Equal(t, "none", result)
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Coercion converts the values of its child to a given type, such as the unified type of a UNION column. Unlike
+// Convert, the target is an exact sql.Type rather than a CAST target, so that lengths, precisions and collations of
+// the target type are preserved.
+type Coercion struct {
+	UnaryExpression
+	typ sql.Type
+}
+
+var _ sql.Expression = (*Coercion)(nil)
+var _ sql.CollationCoercible = (*Coercion)(nil)
+
+// NewCoercion creates a new Coercion expression that converts the result of |expr| to |typ|.
+func NewCoercion(expr sql.Expression, typ sql.Type) *Coercion {
+	return &Coercion{
+		UnaryExpression: UnaryExpression{Child: expr},
+		typ:             typ,
+	}
+}
+
+// String implements the interface sql.Expression.
+func (c *Coercion) String() string {
+	return fmt.Sprintf("coerce(%s, %s)", c.Child.String(), c.typ.String())
+}
+
+// DebugString implements the interface sql.Expression.
+func (c *Coercion) DebugString() string {
+	return fmt.Sprintf("coerce(%s, %s)", sql.DebugString(c.Child), c.typ.String())
+}
+
+// Type implements the interface sql.Expression.
+func (c *Coercion) Type() sql.Type {
+	return c.typ
+}
+
+// Eval implements the interface sql.Expression.
+func (c *Coercion) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := c.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+
+	childType := c.Child.Type()
+	if types.IsText(c.typ) && !types.IsText(childType) && childType != types.Null {
+		// use the text representation of the value, so that e.g. dates and enums are formatted as they are displayed
+		sqlVal, err := childType.SQL(ctx, nil, val)
+		if err != nil {
+			return nil, err
+		}
+		val = sqlVal.ToString()
+	}
+
+	ret, _, err := c.typ.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// WithChildren implements the interface sql.Expression.
+func (c *Coercion) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewCoercion(children[0], c.typ), nil
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (c *Coercion) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	if ct, ok := c.typ.(sql.TypeWithCollation); ok {
+		return ct.Collation(), 2
+	}
+	return sql.Collation_binary, 5
+}
@@ -16,6 +16,9 @@ package expression
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 
 	errors "gopkg.in/src-d/go-errors.v1"
@@ -63,6 +66,77 @@ func PreciseComparison(e sql.Expression) bool {
 	return !imprecise
 }
 
+// comparisonFamily is the kind of value that a type is compared as.
+type comparisonFamily byte
+
+const (
+	otherFamily comparisonFamily = iota
+	stringFamily
+	numberFamily
+	temporalFamily
+)
+
+func comparisonFamilyOf(t sql.Type) comparisonFamily {
+	switch {
+	case types.IsTextOnly(t):
+		return stringFamily
+	case types.IsNumber(t):
+		return numberFamily
+	case types.IsTime(t):
+		return temporalFamily
+	default:
+		return otherFamily
+	}
+}
+
+// ConvertedForComparison returns whether values of type |t| are converted to a different kind of value when they're
+// compared with values of type |other|: a string compared with a number or a date is converted to one, and so is a
+// number compared with a date. The converted values are ordered differently than the originals, so a comparison that
+// converts a column can't be satisfied by that column's index.
+func ConvertedForComparison(t, other sql.Type) bool {
+	comparedWith := comparisonFamilyOf(other)
+	switch comparisonFamilyOf(t) {
+	case stringFamily:
+		return comparedWith == numberFamily || comparedWith == temporalFamily
+	case numberFamily:
+		return comparedWith == temporalFamily
+	default:
+		return false
+	}
+}
+
+// ConvertsOperands returns whether comparing values of types |left| and |right| converts either of them to a
+// different kind of value. See ConvertedForComparison.
+func ConvertsOperands(left, right sql.Type) bool {
+	return ConvertedForComparison(left, right) || ConvertedForComparison(right, left)
+}
+
+// ConvertLosslessly converts |val|, of type |from|, to |to|, so that it can be looked up in an index on a column of
+// type |to|. It returns false if |val| can't be converted without changing the result of comparing it with a value of
+// type |to|, like a string with trailing characters that aren't part of a number.
+func ConvertLosslessly(val interface{}, from, to sql.Type) (interface{}, bool) {
+	if val == nil {
+		return nil, true
+	}
+	converted, inRange, err := to.Convert(val)
+	if err != nil || inRange == sql.OutOfRange {
+		return nil, false
+	}
+	// compare the converted value with the original without a session, so that a truncated value doesn't warn
+	cmp := newComparison(NewLiteral(converted, to), NewLiteral(val, from))
+	res, err := cmp.Compare(nil, nil)
+	if err != nil || res != 0 {
+		return nil, false
+	}
+	if s, ok := val.(string); ok && comparisonFamilyOf(to) == numberFamily {
+		// a string that is truncated when it's compared as a number isn't converted losslessly, even if its prefix is
+		if _, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err != nil {
+			return nil, false
+		}
+	}
+	return converted, true
+}
+
 type comparison struct {
 	BinaryExpressionStub
 }
@@ -99,6 +173,12 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 		return 0, ErrNilOperand.New()
 	}
 
+	return c.compareValues(ctx, left, right)
+}
+
+// compareValues compares |left| and |right|, the non-nil values of the comparison's operands.
+func (c *comparison) compareValues(ctx *sql.Context, left, right interface{}) (int, error) {
+	var err error
 	if types.TypesEqual(c.Left().Type(), c.Right().Type()) {
 		return c.Left().Type().Compare(left, right)
 	}
@@ -135,7 +215,7 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 		}
 	}
 	if compareType == nil {
-		left, right, compareType, err = c.castLeftAndRight(left, right)
+		left, right, compareType, err = c.castLeftAndRight(ctx, left, right)
 		if err != nil {
 			return 0, err
 		}
@@ -166,7 +246,11 @@ func (c *comparison) evalLeftAndRight(ctx *sql.Context, row sql.Row) (interface{
 	return left, right, nil
 }
 
-func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, interface{}, sql.Type, error) {
+// castLeftAndRight converts |left| and |right| to the type they're compared as, following MySQL's rules for comparisons
+// between mismatched types, and returns that type. A string that is compared as a number or a date but isn't one is
+// truncated, with a warning, rather than failing the comparison.
+// https://dev.mysql.com/doc/refman/8.0/en/type-conversion.html
+func (c *comparison) castLeftAndRight(ctx *sql.Context, left, right interface{}) (interface{}, interface{}, sql.Type, error) {
 	leftType := c.Left().Type()
 	rightType := c.Right().Type()
 	if types.IsTuple(leftType) && types.IsTuple(rightType) {
@@ -174,7 +258,11 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 	}
 
 	if types.IsTime(leftType) || types.IsTime(rightType) {
-		l, r, err := convertLeftAndRight(left, right, ConvertToDatetime)
+		l, err := convertToDatetimeForComparison(ctx, c.Left(), left)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		r, err := convertToDatetimeForComparison(ctx, c.Right(), right)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -196,7 +284,9 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 	}
 
 	if types.IsNumber(leftType) || types.IsNumber(rightType) {
-		if types.IsDecimal(leftType) || types.IsDecimal(rightType) {
+		// a DECIMAL is compared as a DECIMAL only with another DECIMAL or an integer, and as a DOUBLE otherwise
+		if (types.IsDecimal(leftType) && (types.IsDecimal(rightType) || types.IsInteger(rightType))) ||
+			(types.IsDecimal(rightType) && types.IsInteger(leftType)) {
 			//TODO: We need to set to the actual DECIMAL type
 			l, r, err := convertLeftAndRight(left, right, ConvertToDecimal)
 			if err != nil {
@@ -210,15 +300,6 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 			}
 		}
 
-		if types.IsFloat(leftType) || types.IsFloat(rightType) {
-			l, r, err := convertLeftAndRight(left, right, ConvertToDouble)
-			if err != nil {
-				return nil, nil, nil, err
-			}
-
-			return l, r, types.Float64, nil
-		}
-
 		if types.IsSigned(leftType) && types.IsSigned(rightType) {
 			l, r, err := convertLeftAndRight(left, right, ConvertToSigned)
 			if err != nil {
@@ -237,7 +318,7 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 			return l, r, types.Uint64, nil
 		}
 
-		l, r, err := convertLeftAndRight(left, right, ConvertToDouble)
+		l, r, err := convertLeftAndRight(truncateStringToDouble(ctx, left), truncateStringToDouble(ctx, right), ConvertToDouble)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -253,6 +334,72 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 	return left, right, types.LongText, nil
 }
 
+// numberPrefixRegex matches the longest prefix of a string that is a number, which is the value of the string when
+// it's compared as a number.
+var numberPrefixRegex = regexp.MustCompile(`^\s*[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?`)
+
+// truncateStringToDouble returns the value of |val| as a DOUBLE if it's a string, and |val| as is otherwise. A string
+// that isn't a number is truncated to its longest prefix that is one, or 0 if it has none, with a warning.
+func truncateStringToDouble(ctx *sql.Context, val interface{}) interface{} {
+	s, ok := val.(string)
+	if !ok {
+		return val
+	}
+	if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+		return f
+	}
+	f, _ := strconv.ParseFloat(strings.TrimSpace(numberPrefixRegex.FindString(s)), 64)
+	ctx.Warn(1292, "Truncated incorrect DOUBLE value: '%s'", s)
+	return f
+}
+
+// convertToDatetimeForComparison returns |val|, the value of |e|, as a DATETIME. A number is read as a date in the
+// YYYYMMDD[HHMMSS] or YYMMDD[HHMMSS] format. A string that isn't a date or a datetime is compared as the zero
+// datetime, with a warning.
+func convertToDatetimeForComparison(ctx *sql.Context, e sql.Expression, val interface{}) (interface{}, error) {
+	if types.IsNumber(e.Type()) {
+		if t, ok := numberToDatetimeForComparison(val); ok {
+			return t, nil
+		}
+	}
+	t, err := convertValue(val, ConvertToDatetime, nil, 0, 0)
+	if err == nil {
+		return t, nil
+	}
+	if _, ok := val.(string); !ok {
+		return nil, err
+	}
+	// an invalid date literal is an error, but an invalid date in a column is only a warning
+	if _, ok := e.(*Literal); ok {
+		return nil, err
+	}
+	ctx.Warn(1292, "Incorrect datetime value: '%s'", val)
+	return types.DatetimeMaxPrecision.Zero(), nil
+}
+
+// numberToDatetimeForComparison returns the number |val| as a DATETIME, reading its integer part as a date in the
+// YYYYMMDD[HHMMSS] or YYMMDD[HHMMSS] format. It returns false if the number isn't a date in one of those formats.
+func numberToDatetimeForComparison(val interface{}) (interface{}, bool) {
+	i, err := convertValue(val, ConvertToSigned, nil, 0, 0)
+	if err != nil || i == nil {
+		return nil, false
+	}
+	s := strconv.FormatInt(i.(int64), 10)
+	if len(s) == 6 || len(s) == 12 {
+		// two digit years from 70 to 99 are in the 1900s, and the others in the 2000s
+		if s[:2] >= "70" {
+			s = "19" + s
+		} else {
+			s = "20" + s
+		}
+	}
+	if len(s) != 8 && len(s) != 14 {
+		return nil, false
+	}
+	t, _, err := types.DatetimeMaxPrecision.Convert(s)
+	return t, err == nil
+}
+
 func convertLeftAndRight(left, right interface{}, convertTo string) (interface{}, interface{}, error) {
 	l, err := convertValue(left, convertTo, nil, 0, 0)
 	if err != nil {
@@ -298,6 +445,22 @@ func (e *Equals) CollationCoercibility(ctx *sql.Context) (collation sql.Collatio
 
 // Eval implements the Expression interface.
 func (e *Equals) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if lt, ok := e.Left().Type().(types.TupleType); ok && types.IsTuple(e.Right().Type()) {
+		left, right, err := e.evalLeftAndRight(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if left == nil || right == nil {
+			return nil, nil
+		}
+		l, lok := left.([]interface{})
+		r, rok := right.([]interface{})
+		if !lok || !rok || len(l) != len(r) || len(l) != len(lt) {
+			return nil, sql.ErrInvalidOperandColumns.New(types.NumColumns(e.Left().Type()), types.NumColumns(e.Right().Type()))
+		}
+		return TupleEquals(lt, l, r)
+	}
+
 	result, err := e.Compare(ctx, row)
 	if err != nil {
 		if ErrNilOperand.Is(err) {
@@ -310,6 +473,42 @@ func (e *Equals) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return result == 0, nil
 }
 
+// TupleEquals compares the tuples |l| and |r| element by element, using the element types in |typ|. The result is
+// false if any pair of non-NULL elements differs, otherwise NULL if any element is NULL, and true if every element is
+// equal.
+func TupleEquals(typ types.TupleType, l, r []interface{}) (interface{}, error) {
+	var result interface{} = true
+	for i := range l {
+		if l[i] == nil || r[i] == nil {
+			result = nil
+			continue
+		}
+		lv, _, err := typ[i].Convert(l[i])
+		if err != nil {
+			return nil, err
+		}
+		rv, _, err := typ[i].Convert(r[i])
+		if err != nil {
+			return false, nil
+		}
+		if et, ok := typ[i].(types.TupleType); ok {
+			eq, err := TupleEquals(et, lv.([]interface{}), rv.([]interface{}))
+			if err != nil || eq != true {
+				return eq, err
+			}
+			continue
+		}
+		cmp, err := typ[i].Compare(lv, rv)
+		if err != nil {
+			return nil, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return result, nil
+}
+
 // WithChildren implements the Expression interface.
 func (e *Equals) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -380,7 +579,7 @@ func (e *NullSafeEquals) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 	}
 
 	var compareType sql.Type
-	left, right, compareType, err = e.castLeftAndRight(left, right)
+	left, right, compareType, err = e.castLeftAndRight(ctx, left, right)
 	if err != nil {
 		return 0, err
 	}
@@ -15,7 +15,9 @@
 package expression_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -165,6 +167,34 @@ func TestNullSafeEquals(t *testing.T) {
 	}
 }
 
+func TestEqualsTuples(t *testing.T) {
+	tuple := func(vals ...interface{}) sql.Expression {
+		exprs := make([]sql.Expression, len(vals))
+		for i, v := range vals {
+			exprs[i] = expression.NewLiteral(v, types.Int64)
+		}
+		return expression.NewTuple(exprs...)
+	}
+
+	testCases := []struct {
+		left, right sql.Expression
+		expected    interface{}
+	}{
+		{tuple(int64(1), int64(2)), tuple(int64(1), int64(2)), true},
+		{tuple(int64(1), int64(2)), tuple(int64(1), int64(3)), false},
+		{tuple(int64(1), int64(1)), tuple(int64(1), nil), nil},
+		{tuple(int64(1), nil), tuple(int64(1), nil), nil},
+		{tuple(int64(2), int64(1)), tuple(int64(1), nil), false},
+		{tuple(nil, int64(1)), tuple(int64(2), int64(2)), false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("%s = %s", tt.left, tt.right), func(t *testing.T) {
+			require.Equal(t, tt.expected, eval(t, expression.NewEquals(tt.left, tt.right), nil))
+		})
+	}
+}
+
 func TestLessThan(t *testing.T) {
 	require := require.New(t)
 	for resultType, cmpCase := range comparisonCases {
@@ -269,9 +299,79 @@ func TestInvalidRegexp(t *testing.T) {
 	require.Error(err)
 }
 
+func TestConvertedForComparison(t *testing.T) {
+	tests := []struct {
+		t, other  sql.Type
+		converted bool
+	}{
+		{types.Text, types.Int64, true},
+		{types.Int64, types.Text, false},
+		{types.Text, types.Date, true},
+		{types.Int64, types.Datetime, true},
+		{types.Date, types.Text, false},
+		{types.Text, types.Text, false},
+		{types.Int64, types.Float64, false},
+		{types.InternalDecimalType, types.Float64, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s vs %s", tt.t, tt.other), func(t *testing.T) {
+			require.Equal(t, tt.converted, expression.ConvertedForComparison(tt.t, tt.other))
+		})
+	}
+}
+
+func TestConvertLosslessly(t *testing.T) {
+	tests := []struct {
+		val      interface{}
+		from, to sql.Type
+		expected interface{}
+		ok       bool
+	}{
+		{"10", types.Text, types.Int64, int64(10), true},
+		{"10x", types.Text, types.Int64, nil, false},
+		{"abc", types.Text, types.Int64, nil, false},
+		{"10.5", types.Text, types.Int64, nil, false},
+		{int64(10), types.Int64, types.Int8, int8(10), true},
+		{int64(1000), types.Int64, types.Int8, nil, false},
+		{nil, types.Text, types.Int64, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v as %s", tt.val, tt.to), func(t *testing.T) {
+			val, ok := expression.ConvertLosslessly(tt.val, tt.from, tt.to)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
+
 func eval(t *testing.T, e sql.Expression, row sql.Row) interface{} {
 	t.Helper()
 	v, err := e.Eval(sql.NewEmptyContext(), row)
 	require.NoError(t, err)
 	return v
 }
+
+func TestEqualsDateAndNumber(t *testing.T) {
+	date := expression.NewGetField(0, types.Date, "d", true)
+	tests := []struct {
+		val      interface{}
+		typ      sql.Type
+		expected interface{}
+	}{
+		{int64(20200105), types.Int64, true},
+		{int64(200105), types.Int64, true},
+		{int64(20200105000000), types.Int64, true},
+		{float64(20200105), types.Float64, true},
+		{int64(20200106), types.Int64, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v", tt.val), func(t *testing.T) {
+			eq := expression.NewEquals(date, expression.NewLiteral(tt.val, tt.typ))
+			row := sql.NewRow(time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC))
+			require.Equal(t, tt.expected, eval(t, eq, row))
+		})
+	}
+}
@@ -268,6 +268,9 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	casted, err := convertValue(val, c.castToType, c.Child.Type(), c.typeLength, c.typeScale)
 	if err != nil {
 		if c.castToType == ConvertToJSON {
+			if sql.ErrInvalidJson.Is(err) {
+				return nil, sql.ErrInvalidJSONText.New(val)
+			}
 			return nil, ErrConvertExpression.Wrap(err, c.String(), c.castToType)
 		}
 		ctx.Warn(1292, "Incorrect %s value: %v", c.castToType, val)
@@ -303,6 +306,14 @@ func convertValue(val interface{}, castTo string, originType sql.Type, typeLengt
 		}
 		return truncateConvertedValue(b, typeLength)
 	case ConvertToChar, ConvertToNChar:
+		if js, ok := val.(types.JSONStringer); ok {
+			// JSON values are cast to their serialized form, which keeps the quotes of JSON strings
+			s, err := js.JSONString()
+			if err != nil {
+				return nil, nil
+			}
+			return truncateConvertedValue(s, typeLength)
+		}
 		s, _, err := types.LongText.Convert(val)
 		if err != nil {
 			return nil, nil
@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/cespare/xxhash/v2"
 	"github.com/dolthub/vitess/go/vt/proto/query"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -85,9 +87,7 @@ func (g *GroupConcat) Window() *sql.WindowDefinition {
 
 // NewBuffer creates a new buffer for the aggregation.
 func (g *GroupConcat) NewBuffer() (sql.AggregationBuffer, error) {
-	var rows []sql.Row
-	distinctSet := make(map[string]bool)
-	return &groupConcatBuffer{g, rows, distinctSet}, nil
+	return &groupConcatBuffer{gc: g, distinctSet: make(map[uint64]struct{})}, nil
 }
 
 // NewWindowFunctionAggregation implements sql.WindowAdaptableExpression
@@ -195,62 +195,111 @@ func (g *GroupConcat) WithChildren(children ...sql.Expression) (sql.Expression,
 	return NewGroupConcat(g.distinct, g.sf.FromExpressions(orderByExpr...), g.separator, children[sortFieldMarker:], g.maxLen), nil
 }
 
+// errCutByGroupConcat is ER_CUT_VALUE_GROUP_CONCAT
+const errCutByGroupConcat = 1260
+
 type groupConcatBuffer struct {
-	gc          *GroupConcat
-	rows        []sql.Row
-	distinctSet map[string]bool
+	gc *GroupConcat
+	// rows holds the ordering keys of each accumulated value, followed by the value itself. Only the values that can
+	// appear in the result are kept, so the buffer's size is bounded by group_concat_max_len rather than the group's.
+	rows []sql.Row
+	// distinctSet holds the hashes of the values seen for DISTINCT, including those discarded from rows
+	distinctSet map[uint64]struct{}
+	// size is the length of the values in rows, including the separators between them
+	size int
+	// cut is set once a value has been discarded or shortened, which means the result is longer than
+	// group_concat_max_len
+	cut bool
 }
 
 // Update implements the AggregationBuffer interface.
 func (g *groupConcatBuffer) Update(ctx *sql.Context, originalRow sql.Row) error {
-	evalRow, retType, err := evalExprs(ctx, g.gc.selectExprs, originalRow)
-	if err != nil {
+	vs, ok, err := g.gc.concatRow(ctx, originalRow)
+	if err != nil || !ok {
 		return err
 	}
 
-	g.gc.returnType = retType
-
-	// Skip if this is a null row
-	if evalRow == nil {
-		return nil
+	// Values longer than the result can be are shortened, but remain long enough to be cut
+	clipped := false
+	if len(vs) > g.gc.maxLen {
+		vs = g.gc.clip(vs)
+		clipped = true
 	}
 
-	var v interface{}
-	var vs string
-	if types.IsBlobType(retType) {
-		v, _, err = types.Blob.Convert(evalRow[0])
-		if err != nil {
-			return err
-		}
-		vs = string(v.([]byte))
-		if len(vs) == 0 {
-			return nil
-		}
-	} else {
-		v, _, err = types.LongText.Convert(evalRow[0])
-		if err != nil {
-			return err
-		}
-		if v == nil {
+	// Check if distinct is active if so look at and update our map
+	var hash uint64
+	if g.gc.distinct != "" {
+		hash = xxhash.Sum64String(vs)
+		if _, ok := g.distinctSet[hash]; ok {
 			return nil
 		}
-		vs = v.(string)
 	}
 
-	// Get the current array of rows and the map
-	// Check if distinct is active if so look at and update our map
+	added := len(vs)
+	if len(g.rows) > 0 {
+		added += len(g.gc.separator)
+	}
+	if added == 0 && len(g.rows) > 0 {
+		// an empty value with an empty separator doesn't change the result
+		return nil
+	}
+
+	// Without an ORDER BY, values are concatenated in the order they arrive, so once the result is full nothing that
+	// comes after can appear in it
+	if len(g.gc.sf) == 0 && g.size >= g.gc.maxLen {
+		g.cut = true
+		return nil
+	}
+
+	if ctx.Memory != nil && !ctx.Memory.HasAvailable() {
+		return sql.ErrNoMemoryAvailable.New()
+	}
+
+	row, err := g.gc.orderingRow(ctx, originalRow, vs)
+	if err != nil {
+		return err
+	}
 	if g.gc.distinct != "" {
-		// If this value exists go ahead and return nil
-		if _, ok := g.distinctSet[vs]; ok {
-			return nil
-		} else {
-			g.distinctSet[vs] = true
-		}
+		g.distinctSet[hash] = struct{}{}
 	}
+	g.rows = append(g.rows, row)
+	g.size += added
+	g.cut = g.cut || clipped
+
+	// With an ORDER BY, any value can sort before the ones we have, so we keep accumulating, and periodically discard
+	// the values that sort after the end of the result
+	if len(g.gc.sf) > 0 && g.size-g.gc.maxLen > g.gc.maxLen && g.size > groupConcatMinPruneSize {
+		return g.prune(ctx)
+	}
+
+	return nil
+}
 
-	// Append the current value to the end of the row. We want to preserve the row's original structure for
-	// for sort ordering in the final step.
-	g.rows = append(g.rows, append(originalRow, nil, vs))
+// groupConcatMinPruneSize is the size below which a buffer is never pruned, so that small values of
+// group_concat_max_len don't cause the buffer to be sorted after every few values.
+const groupConcatMinPruneSize = 64 * 1024
+
+// prune sorts the rows of the buffer and discards those that sort after the end of the result.
+func (g *groupConcatBuffer) prune(ctx *sql.Context) error {
+	if err := g.gc.sortRows(ctx, g.rows); err != nil {
+		return err
+	}
+
+	size := 0
+	for i, row := range g.rows {
+		if size >= g.gc.maxLen {
+			for _, dropped := range g.rows[i:] {
+				g.cut = g.cut || len(dropped[len(dropped)-1].(string))+len(g.gc.separator) > 0
+			}
+			g.rows = g.rows[:i:i]
+			break
+		}
+		if i > 0 {
+			size += len(g.gc.separator)
+		}
+		size += len(row[len(row)-1].(string))
+	}
+	g.size = size
 
 	return nil
 }
@@ -258,55 +307,167 @@ func (g *groupConcatBuffer) Update(ctx *sql.Context, originalRow sql.Row) error
 // Eval implements the AggregationBuffer interface.
 // cc: https://dev.mysql.com/doc/refman/8.0/en/aggregate-functions.html#function_group-concat
 func (g *groupConcatBuffer) Eval(ctx *sql.Context) (interface{}, error) {
-	rows := g.rows
-
-	if len(rows) == 0 {
+	if len(g.rows) == 0 {
 		return nil, nil
 	}
+	return g.gc.join(ctx, g.rows, g.cut)
+}
+
+// Dispose implements the Disposable interface.
+func (g *groupConcatBuffer) Dispose() {
+}
+
+// concatRow evaluates the select expressions against |row| and returns their concatenated string value. Returns false
+// if the row should be skipped, which is the case when any of the expressions is NULL.
+func (g *GroupConcat) concatRow(ctx *sql.Context, row sql.Row) (string, bool, error) {
+	evalRow, retType, err := evalExprs(ctx, g.selectExprs, row)
+	if err != nil {
+		return "", false, err
+	}
+
+	g.returnType = retType
 
-	// Execute the order operation if it exists.
-	if g.gc.sf != nil {
-		sorter := &expression.Sorter{
-			SortFields: g.gc.sf,
-			Rows:       rows,
-			Ctx:        ctx,
+	sb := strings.Builder{}
+	for _, val := range evalRow {
+		if val == nil {
+			return "", false, nil
+		}
+		if types.IsBlobType(retType) {
+			v, _, err := types.Blob.Convert(val)
+			if err != nil {
+				return "", false, err
+			}
+			sb.Write(v.([]byte))
+		} else {
+			v, _, err := types.LongText.Convert(val)
+			if err != nil {
+				return "", false, err
+			}
+			sb.WriteString(v.(string))
 		}
+	}
 
-		sort.Stable(sorter)
-		if sorter.LastError != nil {
-			return nil, sorter.LastError
+	vs := sb.String()
+	if types.IsBlobType(retType) && len(vs) == 0 {
+		return "", false, nil
+	}
+	return vs, true, nil
+}
+
+// orderingRow evaluates the ORDER BY expressions against |row| and returns them with |value| appended, so that
+// accumulated values can be sorted without holding on to the rows they came from.
+func (g *GroupConcat) orderingRow(ctx *sql.Context, row sql.Row, value string) (sql.Row, error) {
+	ret := make(sql.Row, len(g.sf)+1)
+	for i, sf := range g.sf {
+		v, err := sf.Column.Eval(ctx, row)
+		if err != nil {
+			return nil, err
 		}
+		ret[i] = v
+	}
+	ret[len(g.sf)] = value
+	return ret, nil
+}
+
+// orderingSortFields returns the ORDER BY sort fields of this GROUP_CONCAT, rewritten to refer to the ordering keys
+// of the rows returned by orderingRow.
+func (g *GroupConcat) orderingSortFields() sql.SortFields {
+	sfs := make(sql.SortFields, len(g.sf))
+	for i, sf := range g.sf {
+		sfs[i] = sql.SortField{
+			Column:       expression.NewGetField(i, sf.Column.Type(), sf.Column.String(), sf.Column.IsNullable()),
+			Order:        sf.Order,
+			NullOrdering: sf.NullOrdering,
+		}
+	}
+	return sfs
+}
+
+// sortRows sorts the ordering rows given by the ORDER BY of this GROUP_CONCAT, keeping the order of equal rows.
+func (g *GroupConcat) sortRows(ctx *sql.Context, rows []sql.Row) error {
+	if len(g.sf) == 0 {
+		return nil
+	}
+
+	sorter := &expression.Sorter{
+		SortFields: g.orderingSortFields(),
+		Rows:       rows,
+		Ctx:        ctx,
+	}
+	sort.Stable(sorter)
+	return sorter.LastError
+}
+
+// join sorts the ordering rows given, and returns their values joined by the separator. The result is truncated to
+// group_concat_max_len, with a warning. |cut| reports that values were already discarded from |rows|, so the result
+// must be reported as cut even if it fits.
+func (g *GroupConcat) join(ctx *sql.Context, rows []sql.Row, cut bool) (string, error) {
+	if err := g.sortRows(ctx, rows); err != nil {
+		return "", err
 	}
 
 	sb := strings.Builder{}
 	for i, row := range rows {
-		lastIdx := len(row) - 1
-		if i == 0 {
-			sb.WriteString(row[lastIdx].(string))
-		} else {
-			sb.WriteString(g.gc.separator)
-			sb.WriteString(row[lastIdx].(string))
+		if i > 0 {
+			sb.WriteString(g.separator)
 		}
+		sb.WriteString(row[len(row)-1].(string))
 
-		// Don't allow the string to cross maxlen
-		if sb.Len() >= g.gc.maxLen {
+		// Don't allow the string to grow much past maxlen
+		if sb.Len() > g.maxLen {
 			break
 		}
 	}
 
 	ret := sb.String()
-
-	// There might be a couple of character differences even if we broke early in the loop
-	if len(ret) > g.gc.maxLen {
-		ret = ret[:g.gc.maxLen]
+	if len(ret) > g.maxLen {
+		ret = g.truncate(ret)
+		cut = true
+	}
+	if cut {
+		g.warnCut(ctx)
 	}
 
-	// Add this to handle any one off errors.
 	return ret, nil
 }
 
-// Dispose implements the Disposable interface.
-func (g *groupConcatBuffer) Dispose() {
+// truncate shortens |s| to group_concat_max_len bytes. Character results are cut at the last whole character that fits,
+// as MySQL does, while binary results are cut at exactly that many bytes.
+func (g *GroupConcat) truncate(s string) string {
+	end := g.maxLen
+	if !types.IsBlobType(g.returnType) {
+		for end > 0 && !utf8.RuneStart(s[end]) {
+			end--
+		}
+	}
+	return s[:end]
+}
+
+// clip shortens a value longer than group_concat_max_len. Character values keep the whole character that crosses the
+// limit, so that they remain valid and truncate cuts the result at the same character as it would the whole value.
+func (g *GroupConcat) clip(s string) string {
+	end := g.maxLen
+	if !types.IsBlobType(g.returnType) {
+		for end < len(s) && !utf8.RuneStart(s[end]) {
+			end++
+		}
+	}
+	return strings.Clone(s[:end])
+}
+
+// warnCut adds the warning MySQL gives when a GROUP_CONCAT result is truncated to group_concat_max_len. MySQL numbers
+// the rows in this warning, which we approximate by the number of results cut so far in this statement.
+func (g *GroupConcat) warnCut(ctx *sql.Context) {
+	if ctx == nil || ctx.Session == nil {
+		return
+	}
+	row := 1
+	for _, w := range ctx.Session.Warnings() {
+		if w.Code == errCutByGroupConcat {
+			row++
+		}
+	}
+	ctx.Warn(errCutByGroupConcat, "Row %d was cut by GROUP_CONCAT()", row)
 }
 
 func evalExprs(ctx *sql.Context, exprs []sql.Expression, row sql.Row) (sql.Row, sql.Type, error) {
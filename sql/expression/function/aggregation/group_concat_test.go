@@ -15,6 +15,8 @@
 package aggregation
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -103,3 +105,164 @@ func TestGroupConcat_ReturnType(t *testing.T) {
 		require.Equal(t, tt.returnType, gc.Type())
 	}
 }
+
+// Validates ordering by multiple columns with mixed directions, keeping the input order of ties
+func TestGroupConcat_OrderByMultipleColumns(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	rows := []sql.Row{
+		{int64(1), "b", "first"},
+		{int64(2), "a", "second"},
+		{int64(1), "a", "third"},
+		{int64(2), "a", "fourth"},
+		{nil, "c", "fifth"},
+	}
+
+	sf := sql.SortFields{
+		{Column: expression.NewGetField(0, types.Int64, "a", true), Order: sql.Descending},
+		{Column: expression.NewGetField(1, types.LongText, "b", true), Order: sql.Ascending},
+	}
+	gc := NewGroupConcat("", sf, "|", []sql.Expression{expression.NewGetField(2, types.LongText, "c", true)}, 1024)
+
+	buf, _ := gc.NewBuffer()
+	for _, row := range rows {
+		require.NoError(t, buf.Update(ctx, row))
+	}
+
+	result, err := buf.Eval(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "second|fourth|third|first|fifth", result)
+}
+
+// Validates that a result cut at group_concat_max_len adds a warning
+func TestGroupConcat_CutWarning(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	gc := NewGroupConcat("", nil, ",", []sql.Expression{expression.NewGetField(0, types.LongText, "s", true)}, 5)
+
+	buf, _ := gc.NewBuffer()
+	require.NoError(t, buf.Update(ctx, sql.Row{"abc"}))
+	result, err := buf.Eval(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "abc", result)
+	require.Len(t, ctx.Warnings(), 0)
+
+	require.NoError(t, buf.Update(ctx, sql.Row{"def"}))
+	result, err = buf.Eval(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "abc,d", result)
+	require.Len(t, ctx.Warnings(), 1)
+	require.Equal(t, 1260, ctx.Warnings()[0].Code)
+}
+
+// Validates that the memory held for a group is bounded by group_concat_max_len rather than by the size of the group
+func TestGroupConcat_BoundedBuffer(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	// 400MB of values in total, against a group_concat_max_len of 4MB
+	const maxLen = 4 * 1024 * 1024
+	value := strings.Repeat("a", 1024*1024)
+
+	sf := sql.SortFields{
+		{Column: expression.NewGetField(0, types.Int64, "i", false), Order: sql.Descending},
+	}
+
+	for _, orderBy := range []sql.SortFields{nil, sf} {
+		gc := NewGroupConcat("", orderBy, ",", []sql.Expression{expression.NewGetField(1, types.LongText, "s", true)}, maxLen)
+		buf, _ := gc.NewBuffer()
+		for i := 0; i < 400; i++ {
+			require.NoError(t, buf.Update(ctx, sql.Row{int64(i), strconv.Itoa(i) + value}))
+			require.LessOrEqual(t, buf.(*groupConcatBuffer).size, 2*maxLen+len(value)+4)
+		}
+
+		result, err := buf.Eval(ctx)
+		require.NoError(t, err)
+		rs := result.(string)
+		require.Equal(t, maxLen, len(rs))
+		if orderBy == nil {
+			require.True(t, strings.HasPrefix(rs, "0a"))
+		} else {
+			require.True(t, strings.HasPrefix(rs, "399a"))
+		}
+	}
+}
+
+// Validates that DISTINCT keeps the first of equal values when values are discarded from the buffer
+func TestGroupConcat_BoundedBufferDistinct(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	const maxLen = 10000
+	value := strings.Repeat("a", 1000)
+	sf := sql.SortFields{
+		{Column: expression.NewGetField(0, types.Int64, "i", false), Order: sql.Ascending},
+	}
+
+	gc := NewGroupConcat("distinct", sf, "", []sql.Expression{expression.NewGetField(1, types.LongText, "s", true)}, maxLen)
+	buf, _ := gc.NewBuffer()
+	for i := 1000; i > 0; i-- {
+		require.NoError(t, buf.Update(ctx, sql.Row{int64(i), strconv.Itoa(i%200) + value}))
+	}
+	require.Less(t, len(buf.(*groupConcatBuffer).rows), 200)
+
+	// the first of each value is the one with the highest i
+	sb := strings.Builder{}
+	for i := 801; i <= 1000; i++ {
+		sb.WriteString(strconv.Itoa(i%200) + value)
+	}
+
+	result, err := buf.Eval(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sb.String()[:maxLen], result)
+}
+
+// Validates that a cut result doesn't end with a partial multibyte character
+func TestGroupConcat_CutMultibyte(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	tests := []struct {
+		maxLen   int
+		expected string
+	}{
+		{3, "é,"},
+		{4, "é,"},
+		{5, "é,é"},
+		{6, "é,é,"},
+	}
+
+	for _, tt := range tests {
+		gc := NewGroupConcat("", nil, ",", []sql.Expression{expression.NewGetField(0, types.LongText, "s", true)}, tt.maxLen)
+		buf, _ := gc.NewBuffer()
+		for i := 0; i < 3; i++ {
+			require.NoError(t, buf.Update(ctx, sql.Row{"é"}))
+		}
+
+		result, err := buf.Eval(ctx)
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, result)
+	}
+}
+
+// Validates that a value longer than group_concat_max_len is cut at the last whole character that fits
+func TestGroupConcat_CutLongMultibyteValue(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	tests := []struct {
+		values   []string
+		expected string
+	}{
+		{[]string{"aaañ"}, "aaa"},
+		{[]string{"aaañ", "b"}, "aaa"},
+		{[]string{"aañ", "b"}, "aañ"},
+	}
+
+	for _, tt := range tests {
+		gc := NewGroupConcat("", nil, ",", []sql.Expression{expression.NewGetField(0, types.LongText, "s", true)}, 4)
+		buf, _ := gc.NewBuffer()
+		for _, v := range tt.values {
+			require.NoError(t, buf.Update(ctx, sql.Row{v}))
+		}
+		result, err := buf.Eval(ctx)
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, result)
+	}
+}
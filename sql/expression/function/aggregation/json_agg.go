@@ -122,7 +122,7 @@ func (j *JSONObjectAgg) Window() *sql.WindowDefinition {
 // NewBuffer implements the Aggregation interface.
 func (j *JSONObjectAgg) NewBuffer() (sql.AggregationBuffer, error) {
 	row := make(map[string]interface{})
-	return &jsonObjectBuffer{row, j}, nil
+	return &jsonObjectBuffer{vals: row, joa: j}, nil
 }
 
 // NewWindowFunctionAggregation implements sql.WindowAdaptableExpression
@@ -138,10 +138,16 @@ func (j *JSONObjectAgg) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 type jsonObjectBuffer struct {
 	vals map[string]interface{}
 	joa  *JSONObjectAgg
+	// limits bounds the size of the result, and records whether it was exceeded
+	limits jsonAggLimits
 }
 
 // Update implements the AggregationBuffer interface.
 func (j *jsonObjectBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	if j.limits.overflowed {
+		return nil
+	}
+
 	key, err := j.joa.key.Eval(ctx, row)
 	if err != nil {
 		return err
@@ -167,13 +173,34 @@ func (j *jsonObjectBuffer) Update(ctx *sql.Context, row sql.Row) error {
 	if err != nil {
 		return nil
 	}
-	j.vals[keyAsString.(string)] = val
+	keyString := keyAsString.(string)
+
+	// a repeated key replaces the earlier value
+	added := jsonValueSize(keyString) + jsonValueSize(val) + 2
+	if prev, ok := j.vals[keyString]; ok {
+		added -= jsonValueSize(keyString) + jsonValueSize(prev) + 2
+	}
+	ok, err := j.limits.grow(ctx, added)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		j.vals = nil
+		return nil
+	}
+
+	j.vals[keyString] = val
 
 	return nil
 }
 
 // Eval implements the AggregationBuffer interface.
 func (j *jsonObjectBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	if j.limits.overflowed {
+		j.limits.warnOverflow(ctx, "json_objectagg")
+		return nil, nil
+	}
+
 	// When no rows are present return NULL
 	if len(j.vals) == 0 {
 		return nil, nil
@@ -185,3 +212,81 @@ func (j *jsonObjectBuffer) Eval(ctx *sql.Context) (interface{}, error) {
 // Dispose implements the Disposable interface.
 func (j *jsonObjectBuffer) Dispose() {
 }
+
+// errAllowedPacketOverflowed is ER_WARN_ALLOWED_PACKET_OVERFLOWED
+const errAllowedPacketOverflowed = 1301
+
+// jsonAggLimits bounds the memory used by a JSON aggregation buffer. MySQL limits the results of JSON_ARRAYAGG and
+// JSON_OBJECTAGG to max_allowed_packet bytes, and returns NULL with a warning for larger results, so a buffer stops
+// accumulating values as soon as its result is known to be larger than that.
+type jsonAggLimits struct {
+	// size is the estimated length of the JSON encoding of the result so far
+	size int
+	// maxSize is max_allowed_packet, read when the first value is added
+	maxSize int
+	// overflowed is set once the result is larger than maxSize
+	overflowed bool
+}
+
+// grow adds |n| bytes to the size of the result, and returns whether it still fits in max_allowed_packet.
+func (l *jsonAggLimits) grow(ctx *sql.Context, n int) (bool, error) {
+	if l.maxSize == 0 {
+		val, err := ctx.GetSessionVariable(ctx, "max_allowed_packet")
+		if err != nil {
+			return false, err
+		}
+		maxSize, _, err := types.Int64.Convert(val)
+		if err != nil {
+			return false, err
+		}
+		l.maxSize = int(maxSize.(int64))
+	}
+
+	l.size += n
+	if l.size > l.maxSize {
+		l.overflowed = true
+		return false, nil
+	}
+
+	if ctx.Memory != nil && !ctx.Memory.HasAvailable() {
+		return false, sql.ErrNoMemoryAvailable.New()
+	}
+	return true, nil
+}
+
+// warnOverflow adds the warning MySQL gives when the result of the function named is larger than max_allowed_packet.
+func (l *jsonAggLimits) warnOverflow(ctx *sql.Context, funcName string) {
+	ctx.Warn(errAllowedPacketOverflowed, "Result of %s() was larger than max_allowed_packet (%d) - truncated", funcName, l.maxSize)
+}
+
+// jsonValueSize estimates the length of the JSON encoding of |v|, which is a value unwrapped from a JSON document or
+// returned by an expression.
+func jsonValueSize(v interface{}) int {
+	switch v := v.(type) {
+	case nil:
+		return len("null")
+	case bool:
+		if v {
+			return len("true")
+		}
+		return len("false")
+	case string:
+		return len(v) + 2
+	case []byte:
+		return len(v) + 2
+	case []interface{}:
+		size := 2
+		for _, e := range v {
+			size += jsonValueSize(e) + 1
+		}
+		return size
+	case map[string]interface{}:
+		size := 2
+		for k, e := range v {
+			size += len(k) + jsonValueSize(e) + 4
+		}
+		return size
+	default:
+		return len(fmt.Sprint(v))
+	}
+}
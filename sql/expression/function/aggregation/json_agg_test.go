@@ -15,6 +15,7 @@
 package aggregation
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -85,3 +86,65 @@ func TestJsonArrayAgg_JSON(t *testing.T) {
 	assert.NoError(err)
 	assert.Equal(types.MustJSON(`[{"key1": "value1", "key2": "value2"}]`), v)
 }
+
+// Validates that JSON_ARRAYAGG stops accumulating once its result is larger than max_allowed_packet
+func TestJsonArrayAgg_MaxAllowedPacket(t *testing.T) {
+	assert := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	// 400MB of values in total, against the default max_allowed_packet of 1GB and a lowered one of 64MB
+	value := strings.Repeat("a", 1024*1024)
+
+	j := NewJsonArray(expression.NewGetField(0, types.LongText, "field", true))
+	b, _ := j.NewBuffer()
+	for i := 0; i < 400; i++ {
+		assert.NoError(b.Update(ctx, sql.NewRow(value)))
+	}
+	assert.Len(b.(*jsonArrayBuffer).vals, 400)
+
+	assert.NoError(ctx.SetSessionVariable(ctx, "max_allowed_packet", int64(64*1024*1024)))
+	b, _ = j.NewBuffer()
+	for i := 0; i < 400; i++ {
+		assert.NoError(b.Update(ctx, sql.NewRow(value)))
+		assert.LessOrEqual(len(b.(*jsonArrayBuffer).vals), 64)
+	}
+
+	v, err := b.Eval(ctx)
+	assert.NoError(err)
+	assert.Nil(v)
+	assert.Len(ctx.Warnings(), 1)
+	assert.Equal(1301, ctx.Warnings()[0].Code)
+}
+
+// Validates that JSON_OBJECTAGG stops accumulating once its result is larger than max_allowed_packet, and that
+// repeated keys don't count towards the size of the result
+func TestJsonObjectAgg_MaxAllowedPacket(t *testing.T) {
+	assert := require.New(t)
+	ctx := sql.NewEmptyContext()
+	assert.NoError(ctx.SetSessionVariable(ctx, "max_allowed_packet", int64(64*1024*1024)))
+
+	value := strings.Repeat("a", 1024*1024)
+	j := NewJSONObjectAgg(
+		expression.NewGetField(0, types.Int64, "key", false),
+		expression.NewGetField(1, types.LongText, "value", true),
+	).(*JSONObjectAgg)
+
+	b, _ := j.NewBuffer()
+	for i := 0; i < 400; i++ {
+		assert.NoError(b.Update(ctx, sql.NewRow(int64(i%10), value)))
+	}
+	v, err := b.Eval(ctx)
+	assert.NoError(err)
+	assert.NotNil(v)
+
+	b, _ = j.NewBuffer()
+	for i := 0; i < 400; i++ {
+		assert.NoError(b.Update(ctx, sql.NewRow(int64(i), value)))
+		assert.LessOrEqual(len(b.(*jsonObjectBuffer).vals), 64)
+	}
+	v, err = b.Eval(ctx)
+	assert.NoError(err)
+	assert.Nil(v)
+	assert.Len(ctx.Warnings(), 1)
+	assert.Equal(1301, ctx.Warnings()[0].Code)
+}
@@ -632,14 +632,20 @@ func (m *minBuffer) Dispose() {
 type jsonArrayBuffer struct {
 	vals []interface{}
 	expr sql.Expression
+	// limits bounds the size of the result, and records whether it was exceeded
+	limits jsonAggLimits
 }
 
 func NewJsonArrayBuffer(child sql.Expression) *jsonArrayBuffer {
-	return &jsonArrayBuffer{nil, child}
+	return &jsonArrayBuffer{expr: child}
 }
 
 // Update implements the AggregationBuffer interface.
 func (j *jsonArrayBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	if j.limits.overflowed {
+		return nil
+	}
+
 	v, err := j.expr.Eval(ctx, row)
 	if err != nil {
 		return err
@@ -650,6 +656,15 @@ func (j *jsonArrayBuffer) Update(ctx *sql.Context, row sql.Row) error {
 		v = js.ToInterface()
 	}
 
+	ok, err := j.limits.grow(ctx, jsonValueSize(v)+1)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		j.vals = nil
+		return nil
+	}
+
 	j.vals = append(j.vals, v)
 
 	return nil
@@ -657,6 +672,10 @@ func (j *jsonArrayBuffer) Update(ctx *sql.Context, row sql.Row) error {
 
 // Eval implements the AggregationBuffer interface.
 func (j *jsonArrayBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	if j.limits.overflowed {
+		j.limits.warnOverflow(ctx, "json_arrayagg")
+		return nil, nil
+	}
 	return types.JSONDocument{Val: j.vals}, nil
 }
 
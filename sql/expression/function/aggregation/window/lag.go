@@ -28,9 +28,10 @@ import (
 type Lag struct {
 	window *sql.WindowDefinition
 	expression.NaryExpression
-	offset int
-	pos    int
-	id     sql.ColumnId
+	offset      int
+	ignoreNulls bool
+	pos         int
+	id          sql.ColumnId
 }
 
 var _ sql.FunctionExpression = (*Lag)(nil)
@@ -64,6 +65,13 @@ func NewLag(e ...sql.Expression) (*Lag, error) {
 	return nil, sql.ErrInvalidArgumentNumber.New("LAG", "1, 2, or 3", len(e))
 }
 
+// WithIgnoreNulls returns a copy of this LAG that skips the rows where the argument is NULL when counting the offset.
+func (l *Lag) WithIgnoreNulls(ignoreNulls bool) *Lag {
+	nl := *l
+	nl.ignoreNulls = ignoreNulls
+	return &nl
+}
+
 // Id implements the Aggregation interface
 func (l *Lag) Id() sql.ColumnId {
 	return l.id
@@ -102,6 +110,9 @@ func (l *Lag) String() string {
 	} else {
 		sb.WriteString(fmt.Sprintf("lag(%s, %d)", l.ChildExpressions[0].String(), l.offset))
 	}
+	if l.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
 	if l.window != nil {
 		sb.WriteString(" ")
 		sb.WriteString(l.window.String())
@@ -116,6 +127,9 @@ func (l *Lag) DebugString() string {
 	} else {
 		sb.WriteString(fmt.Sprintf("lag(%s, %d)", l.ChildExpressions[0].String(), l.offset))
 	}
+	if l.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
 	if l.window != nil {
 		sb.WriteString(" ")
 		sb.WriteString(sql.DebugString(l.window))
@@ -199,5 +213,5 @@ func (l *Lag) NewWindowFunction() (sql.WindowFunction, error) {
 			return nil, err
 		}
 	}
-	return aggregation.NewLag(c, def, l.offset), nil
+	return aggregation.NewLag(c, def, l.offset, l.ignoreNulls), nil
 }
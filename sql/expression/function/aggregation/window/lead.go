@@ -28,9 +28,10 @@ import (
 type Lead struct {
 	window *sql.WindowDefinition
 	expression.NaryExpression
-	offset int
-	pos    int
-	id     sql.ColumnId
+	offset      int
+	ignoreNulls bool
+	pos         int
+	id          sql.ColumnId
 }
 
 var _ sql.FunctionExpression = (*Lead)(nil)
@@ -64,6 +65,13 @@ func NewLead(e ...sql.Expression) (*Lead, error) {
 	return nil, sql.ErrInvalidArgumentNumber.New("LEAD", "1, 2, or 3", len(e))
 }
 
+// WithIgnoreNulls returns a copy of this LEAD that skips the rows where the argument is NULL when counting the offset.
+func (l *Lead) WithIgnoreNulls(ignoreNulls bool) *Lead {
+	nl := *l
+	nl.ignoreNulls = ignoreNulls
+	return &nl
+}
+
 // Id implements sql.IdExpression
 func (l *Lead) Id() sql.ColumnId {
 	return l.id
@@ -102,6 +110,9 @@ func (l *Lead) String() string {
 	} else {
 		sb.WriteString(fmt.Sprintf("lead(%s, %d)", l.ChildExpressions[0].String(), l.offset))
 	}
+	if l.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
 	if l.window != nil {
 		sb.WriteString(" ")
 		sb.WriteString(l.window.String())
@@ -116,6 +127,9 @@ func (l *Lead) DebugString() string {
 	} else {
 		sb.WriteString(fmt.Sprintf("lead(%s, %d)", l.ChildExpressions[0].String(), l.offset))
 	}
+	if l.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
 	if l.window != nil {
 		sb.WriteString(" ")
 		sb.WriteString(sql.DebugString(l.window))
@@ -198,5 +212,5 @@ func (l *Lead) NewWindowFunction() (sql.WindowFunction, error) {
 			return nil, err
 		}
 	}
-	return aggregation.NewLead(c, def, l.offset), nil
+	return aggregation.NewLead(c, def, l.offset, l.ignoreNulls), nil
 }
@@ -0,0 +1,195 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+)
+
+type NthValue struct {
+	window *sql.WindowDefinition
+	expression.UnaryExpression
+	n           int
+	fromLast    bool
+	ignoreNulls bool
+	id          sql.ColumnId
+}
+
+var _ sql.FunctionExpression = (*NthValue)(nil)
+var _ sql.WindowAggregation = (*NthValue)(nil)
+var _ sql.WindowAdaptableExpression = (*NthValue)(nil)
+var _ sql.CollationCoercible = (*NthValue)(nil)
+
+// NewNthValue accepts the arguments [expr] and [n]. The row number is constrained to a positive integer
+// expression.Literal. The FROM LAST and IGNORE NULLS options are set with WithFromLast and WithIgnoreNulls.
+func NewNthValue(e ...sql.Expression) (sql.Expression, error) {
+	if len(e) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("NTH_VALUE", "2", len(e))
+	}
+	n, err := expression.LiteralToInt(e[1])
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, sql.ErrInvalidArgument.New("nth_value")
+	}
+	return &NthValue{UnaryExpression: expression.UnaryExpression{Child: e[0]}, n: n}, nil
+}
+
+// WithFromLast returns a copy of this NTH_VALUE that counts rows from the last row of the frame, instead of the first.
+func (f *NthValue) WithFromLast(fromLast bool) *NthValue {
+	nf := *f
+	nf.fromLast = fromLast
+	return &nf
+}
+
+// WithIgnoreNulls returns a copy of this NTH_VALUE that skips the rows of the frame where the argument is NULL.
+func (f *NthValue) WithIgnoreNulls(ignoreNulls bool) *NthValue {
+	nf := *f
+	nf.ignoreNulls = ignoreNulls
+	return &nf
+}
+
+// Id implements sql.IdExpression
+func (f *NthValue) Id() sql.ColumnId {
+	return f.id
+}
+
+// WithId implements sql.IdExpression
+func (f *NthValue) WithId(id sql.ColumnId) sql.IdExpression {
+	ret := *f
+	ret.id = id
+	return &ret
+}
+
+// Description implements sql.FunctionExpression
+func (f *NthValue) Description() string {
+	return "returns value of argument from N-th row of window frame."
+}
+
+// Window implements sql.WindowExpression
+func (f *NthValue) Window() *sql.WindowDefinition {
+	return f.window
+}
+
+// Resolved implements sql.Expression
+func (f *NthValue) Resolved() bool {
+	return f.Child.Resolved() && windowResolved(f.window)
+}
+
+func (f *NthValue) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("nth_value(%s, %d)", f.Child.String(), f.n))
+	f.writeOptions(&sb)
+	if f.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(f.window.String())
+	}
+	return sb.String()
+}
+
+func (f *NthValue) DebugString() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("nth_value(%s, %d)", sql.DebugString(f.Child), f.n))
+	f.writeOptions(&sb)
+	if f.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(sql.DebugString(f.window))
+	}
+	return sb.String()
+}
+
+// writeOptions writes the options that differ from the defaults, FROM FIRST and RESPECT NULLS.
+func (f *NthValue) writeOptions(sb *strings.Builder) {
+	if f.fromLast {
+		sb.WriteString(" from last")
+	}
+	if f.ignoreNulls {
+		sb.WriteString(" ignore nulls")
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *NthValue) FunctionName() string {
+	return "NTH_VALUE"
+}
+
+// Type implements sql.Expression
+func (f *NthValue) Type() sql.Type {
+	return f.Child.Type()
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (f *NthValue) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, f.Child)
+}
+
+// IsNullable implements sql.Expression
+func (f *NthValue) IsNullable() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (f *NthValue) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, sql.ErrWindowUnsupported.New(f.FunctionName())
+}
+
+// Children implements sql.Expression
+func (f *NthValue) Children() []sql.Expression {
+	if f == nil {
+		return nil
+	}
+	return append(f.window.ToExpressions(), f.Child)
+}
+
+// WithChildren implements sql.Expression
+func (f *NthValue) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) < 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
+	}
+
+	nf := *f
+	window, err := f.window.FromExpressions(children[:len(children)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	nf.Child = children[len(children)-1]
+	nf.window = window
+
+	return &nf, nil
+}
+
+// WithWindow implements sql.WindowAggregation
+func (f *NthValue) WithWindow(window *sql.WindowDefinition) sql.WindowAdaptableExpression {
+	nf := *f
+	nf.window = window
+	return &nf
+}
+
+func (f *NthValue) NewWindowFunction() (sql.WindowFunction, error) {
+	c, err := transform.Clone(f.Child)
+	if err != nil {
+		return nil, err
+	}
+	return aggregation.NewNthValueAgg(c, f.n, f.fromLast, f.ignoreNulls).WithWindow(f.window)
+}
@@ -323,6 +323,10 @@ type rangeFramerBase struct {
 
 	// reference expression for boundary calculation
 	orderBy sql.Expression
+	// orderByDesc is true when the partition is sorted by [orderBy]
+	// in descending order, which reverses the direction of the
+	// PRECEDING and FOLLOWING offsets
+	orderByDesc bool
 
 	// boundary arithmetic on [orderBy] for range start value
 	// is set unless [unboundedPreceding] is true
@@ -345,14 +349,19 @@ type rangeFramerBase struct {
 }
 
 func (f *rangeFramerBase) NewFramer(interval sql.WindowInterval) (sql.WindowFramer, error) {
+	precedingOp, followingOp := ast.MinusStr, ast.PlusStr
+	if f.orderByDesc {
+		precedingOp, followingOp = ast.PlusStr, ast.MinusStr
+	}
+
 	var startInclusion sql.Expression
 	switch {
 	case f.startCurrentRow:
 		startInclusion = f.orderBy
 	case f.startNPreceding != nil:
-		startInclusion = expression.NewArithmetic(f.orderBy, f.startNPreceding, ast.MinusStr)
+		startInclusion = expression.NewArithmetic(f.orderBy, f.startNPreceding, precedingOp)
 	case f.startNFollowing != nil:
-		startInclusion = expression.NewArithmetic(f.orderBy, f.startNFollowing, ast.PlusStr)
+		startInclusion = expression.NewArithmetic(f.orderBy, f.startNFollowing, followingOp)
 	}
 
 	// TODO: how to validate datetime, interval pair when they aren't type comparable
@@ -365,9 +374,9 @@ func (f *rangeFramerBase) NewFramer(interval sql.WindowInterval) (sql.WindowFram
 	case f.endCurrentRow:
 		endInclusion = f.orderBy
 	case f.endNPreceding != nil:
-		endInclusion = expression.NewArithmetic(f.orderBy, f.endNPreceding, ast.MinusStr)
+		endInclusion = expression.NewArithmetic(f.orderBy, f.endNPreceding, precedingOp)
 	case f.endNFollowing != nil:
-		endInclusion = expression.NewArithmetic(f.orderBy, f.endNFollowing, ast.PlusStr)
+		endInclusion = expression.NewArithmetic(f.orderBy, f.endNFollowing, followingOp)
 	}
 
 	// TODO: how to validate datetime, interval pair when they aren't type comparable
@@ -393,6 +402,7 @@ func (f *rangeFramerBase) NewFramer(interval sql.WindowInterval) (sql.WindowFram
 		endNFollowing:      f.endNFollowing,
 		// range specific
 		orderBy:        f.orderBy,
+		orderByDesc:    f.orderByDesc,
 		startInclusion: startInclusion,
 		endInclusion:   endInclusion,
 	}, nil
@@ -413,7 +423,7 @@ func (f *rangeFramerBase) Next(ctx *sql.Context, buf sql.WindowBuffer) (sql.Wind
 		// specified.
 		newStart = f.partitionStart
 	default:
-		newStart, err = findInclusionBoundary(ctx, f.idx, newStart, f.partitionEnd, f.startInclusion, f.orderBy, buf, greaterThanOrEqual)
+		newStart, err = findInclusionBoundary(ctx, f.idx, newStart, f.partitionEnd, f.startInclusion, f.orderBy, f.orderByDesc, buf, greaterThanOrEqual)
 		if err != nil {
 			return sql.WindowInterval{}, err
 		}
@@ -427,7 +437,7 @@ func (f *rangeFramerBase) Next(ctx *sql.Context, buf sql.WindowBuffer) (sql.Wind
 	case newEnd > f.partitionEnd, f.unboundedFollowing, f.endCurrentRow && f.orderBy == nil:
 		newEnd = f.partitionEnd
 	default:
-		newEnd, err = findInclusionBoundary(ctx, f.idx, newEnd, f.partitionEnd, f.endInclusion, f.orderBy, buf, greaterThan)
+		newEnd, err = findInclusionBoundary(ctx, f.idx, newEnd, f.partitionEnd, f.endInclusion, f.orderBy, f.orderByDesc, buf, greaterThan)
 		if err != nil {
 			return sql.WindowInterval{}, err
 		}
@@ -450,8 +460,10 @@ const (
 // findInclusionBoundary searches a sorted [buffer] for the last index satisfying
 // the comparison: [inclusion] [stopCond] [expr]. For example, (x+2) > (x).
 // [expr] is evaluated at the current row, [inclusion] is evaluated on the boundary
-// candidate. This is used as a sliding window algorithm for value ranges.
-func findInclusionBoundary(ctx *sql.Context, pos, searchStart, partitionEnd int, inclusion, expr sql.Expression, buf sql.WindowBuffer, stopCond stopCond) (int, error) {
+// candidate. This is used as a sliding window algorithm for value ranges. When
+// [desc] is true the buffer is sorted in descending order, and the comparison
+// is reversed.
+func findInclusionBoundary(ctx *sql.Context, pos, searchStart, partitionEnd int, inclusion, expr sql.Expression, desc bool, buf sql.WindowBuffer, stopCond stopCond) (int, error) {
 	cur, err := inclusion.Eval(ctx, buf[pos])
 	if err != nil {
 		return 0, err
@@ -473,6 +485,9 @@ func findInclusionBoundary(ctx *sql.Context, pos, searchStart, partitionEnd int,
 		if err != nil {
 			return 0, err
 		}
+		if desc {
+			cmp = -cmp
+		}
 	}
 
 	return i - 1, nil
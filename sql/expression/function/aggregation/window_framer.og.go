@@ -340,12 +340,15 @@ func NewRangeUnboundedPrecedingToNPrecedingFramer(frame sql.WindowFrame, window
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeUnboundedPrecedingToNPrecedingFramer{
 		rangeFramerBase{
 			orderBy:            orderBy,
+			orderByDesc:        orderByDesc,
 			unboundedPreceding: unboundedPreceding,
 			endNPreceding:      endNPreceding,
 		},
@@ -362,12 +365,15 @@ func NewRangeUnboundedPrecedingToCurrentRowFramer(frame sql.WindowFrame, window
 	unboundedPreceding := true
 	endCurrentRow := true
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeUnboundedPrecedingToCurrentRowFramer{
 		rangeFramerBase{
 			orderBy:            orderBy,
+			orderByDesc:        orderByDesc,
 			unboundedPreceding: unboundedPreceding,
 			endCurrentRow:      endCurrentRow,
 		},
@@ -387,12 +393,15 @@ func NewRangeUnboundedPrecedingToNFollowingFramer(frame sql.WindowFrame, window
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeUnboundedPrecedingToNFollowingFramer{
 		rangeFramerBase{
 			orderBy:            orderBy,
+			orderByDesc:        orderByDesc,
 			unboundedPreceding: unboundedPreceding,
 			endNFollowing:      endNFollowing,
 		},
@@ -409,12 +418,15 @@ func NewRangeUnboundedPrecedingToUnboundedFollowingFramer(frame sql.WindowFrame,
 	unboundedPreceding := true
 	unboundedFollowing := true
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeUnboundedPrecedingToUnboundedFollowingFramer{
 		rangeFramerBase{
 			orderBy:            orderBy,
+			orderByDesc:        orderByDesc,
 			unboundedPreceding: unboundedPreceding,
 			unboundedFollowing: unboundedFollowing,
 		},
@@ -434,12 +446,15 @@ func NewRangeNPrecedingToNPrecedingFramer(frame sql.WindowFrame, window *sql.Win
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeNPrecedingToNPrecedingFramer{
 		rangeFramerBase{
 			orderBy:         orderBy,
+			orderByDesc:     orderByDesc,
 			startNPreceding: startNPreceding,
 			endNPreceding:   endNPreceding,
 		},
@@ -459,12 +474,15 @@ func NewRangeNPrecedingToCurrentRowFramer(frame sql.WindowFrame, window *sql.Win
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeNPrecedingToCurrentRowFramer{
 		rangeFramerBase{
 			orderBy:         orderBy,
+			orderByDesc:     orderByDesc,
 			startNPreceding: startNPreceding,
 			endCurrentRow:   endCurrentRow,
 		},
@@ -484,12 +502,15 @@ func NewRangeNPrecedingToNFollowingFramer(frame sql.WindowFrame, window *sql.Win
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeNPrecedingToNFollowingFramer{
 		rangeFramerBase{
 			orderBy:         orderBy,
+			orderByDesc:     orderByDesc,
 			startNPreceding: startNPreceding,
 			endNFollowing:   endNFollowing,
 		},
@@ -509,12 +530,15 @@ func NewRangeNPrecedingToUnboundedFollowingFramer(frame sql.WindowFrame, window
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeNPrecedingToUnboundedFollowingFramer{
 		rangeFramerBase{
 			orderBy:            orderBy,
+			orderByDesc:        orderByDesc,
 			startNPreceding:    startNPreceding,
 			unboundedFollowing: unboundedFollowing,
 		},
@@ -534,12 +558,15 @@ func NewRangeCurrentRowToNPrecedingFramer(frame sql.WindowFrame, window *sql.Win
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeCurrentRowToNPrecedingFramer{
 		rangeFramerBase{
 			orderBy:         orderBy,
+			orderByDesc:     orderByDesc,
 			startCurrentRow: startCurrentRow,
 			endNPreceding:   endNPreceding,
 		},
@@ -556,12 +583,15 @@ func NewRangeCurrentRowToCurrentRowFramer(frame sql.WindowFrame, window *sql.Win
 	startCurrentRow := true
 	endCurrentRow := true
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeCurrentRowToCurrentRowFramer{
 		rangeFramerBase{
 			orderBy:         orderBy,
+			orderByDesc:     orderByDesc,
 			startCurrentRow: startCurrentRow,
 			endCurrentRow:   endCurrentRow,
 		},
@@ -581,12 +611,15 @@ func NewRangeCurrentRowToNFollowingFramer(frame sql.WindowFrame, window *sql.Win
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeCurrentRowToNFollowingFramer{
 		rangeFramerBase{
 			orderBy:         orderBy,
+			orderByDesc:     orderByDesc,
 			startCurrentRow: startCurrentRow,
 			endNFollowing:   endNFollowing,
 		},
@@ -603,12 +636,15 @@ func NewRangeCurrentRowToUnboundedFollowingFramer(frame sql.WindowFrame, window
 	startCurrentRow := true
 	unboundedFollowing := true
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeCurrentRowToUnboundedFollowingFramer{
 		rangeFramerBase{
 			orderBy:            orderBy,
+			orderByDesc:        orderByDesc,
 			startCurrentRow:    startCurrentRow,
 			unboundedFollowing: unboundedFollowing,
 		},
@@ -628,12 +664,15 @@ func NewRangeNFollowingToNPrecedingFramer(frame sql.WindowFrame, window *sql.Win
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeNFollowingToNPrecedingFramer{
 		rangeFramerBase{
 			orderBy:         orderBy,
+			orderByDesc:     orderByDesc,
 			startNFollowing: startNFollowing,
 			endNPreceding:   endNPreceding,
 		},
@@ -653,12 +692,15 @@ func NewRangeNFollowingToCurrentRowFramer(frame sql.WindowFrame, window *sql.Win
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeNFollowingToCurrentRowFramer{
 		rangeFramerBase{
 			orderBy:         orderBy,
+			orderByDesc:     orderByDesc,
 			startNFollowing: startNFollowing,
 			endCurrentRow:   endCurrentRow,
 		},
@@ -678,12 +720,15 @@ func NewRangeNFollowingToNFollowingFramer(frame sql.WindowFrame, window *sql.Win
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeNFollowingToNFollowingFramer{
 		rangeFramerBase{
 			orderBy:         orderBy,
+			orderByDesc:     orderByDesc,
 			startNFollowing: startNFollowing,
 			endNFollowing:   endNFollowing,
 		},
@@ -703,12 +748,15 @@ func NewRangeNFollowingToUnboundedFollowingFramer(frame sql.WindowFrame, window
 		return nil, ErrRangeInvalidOrderBy.New(len(window.OrderBy.ToExpressions()))
 	}
 	var orderBy sql.Expression
+	var orderByDesc bool
 	if len(window.OrderBy) > 0 {
 		orderBy = window.OrderBy.ToExpressions()[0]
+		orderByDesc = window.OrderBy[0].Order == sql.Descending
 	}
 	return &RangeNFollowingToUnboundedFollowingFramer{
 		rangeFramerBase{
 			orderBy:            orderBy,
+			orderByDesc:        orderByDesc,
 			startNFollowing:    startNFollowing,
 			unboundedFollowing: unboundedFollowing,
 		},
@@ -15,9 +15,6 @@
 package aggregation
 
 import (
-	"sort"
-	"strings"
-
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -29,6 +26,7 @@ var _ sql.WindowFunction = (*MinAgg)(nil)
 var _ sql.WindowFunction = (*AvgAgg)(nil)
 var _ sql.WindowFunction = (*LastAgg)(nil)
 var _ sql.WindowFunction = (*FirstAgg)(nil)
+var _ sql.WindowFunction = (*NthValueAgg)(nil)
 var _ sql.WindowFunction = (*CountAgg)(nil)
 var _ sql.WindowFunction = (*GroupConcatAgg)(nil)
 var _ sql.WindowFunction = (*WindowedJSONArrayAgg)(nil)
@@ -719,6 +717,96 @@ func (a *FirstAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer
 	return v
 }
 
+type NthValueAgg struct {
+	expr        sql.Expression
+	n           int
+	fromLast    bool
+	ignoreNulls bool
+	framer      sql.WindowFramer
+}
+
+// NewNthValueAgg returns a window function that evaluates |e| at the |n|th row of the window frame, counting from the
+// last row of the frame if |fromLast| is true, and skipping rows where |e| is NULL if |ignoreNulls| is true.
+func NewNthValueAgg(e sql.Expression, n int, fromLast, ignoreNulls bool) *NthValueAgg {
+	return &NthValueAgg{
+		expr:        e,
+		n:           n,
+		fromLast:    fromLast,
+		ignoreNulls: ignoreNulls,
+	}
+}
+
+func (a *NthValueAgg) WithWindow(w *sql.WindowDefinition) (sql.WindowFunction, error) {
+	na := *a
+	if w != nil && w.Frame != nil {
+		framer, err := w.Frame.NewFramer(w)
+		if err != nil {
+			return nil, err
+		}
+		na.framer = framer
+	}
+	return &na, nil
+}
+
+func (a *NthValueAgg) Dispose() {
+	expression.Dispose(a.expr)
+}
+
+// DefaultFramer returns a NewUnboundedPrecedingToCurrentRowFramer
+func (a *NthValueAgg) DefaultFramer() sql.WindowFramer {
+	if a.framer != nil {
+		return a.framer
+	}
+	return NewUnboundedPrecedingToCurrentRowFramer()
+}
+
+func (a *NthValueAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) error {
+	a.Dispose()
+	return nil
+}
+
+func (a *NthValueAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
+	panic("sliding window interface not implemented yet")
+}
+
+func (a *NthValueAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) interface{} {
+	if interval.End-interval.Start < a.n {
+		return nil
+	}
+	if !a.ignoreNulls {
+		idx := interval.Start + a.n - 1
+		if a.fromLast {
+			idx = interval.End - a.n
+		}
+		v, err := a.expr.Eval(ctx, buffer[idx])
+		if err != nil {
+			return err
+		}
+		return v
+	}
+
+	// count only the rows with a non-NULL value, in the direction given by FROM FIRST or FROM LAST
+	found := 0
+	for i := 0; i < interval.End-interval.Start; i++ {
+		idx := interval.Start + i
+		if a.fromLast {
+			idx = interval.End - 1 - i
+		}
+		v, err := a.expr.Eval(ctx, buffer[idx])
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			continue
+		}
+		found++
+		if found == a.n {
+			return v
+		}
+	}
+	return nil
+}
+
 type CountAgg struct {
 	partitionStart int
 	partitionEnd   int
@@ -729,6 +817,8 @@ type CountAgg struct {
 	prefixSum []float64
 	// orderBy tracks peer group increments
 	orderBy []sql.Expression
+	// orderByDesc is true when the first order by expression is sorted in descending order
+	orderByDesc bool
 	// pos increments every iteration
 	pos int
 	// peerGroup tracks value increments
@@ -764,6 +854,7 @@ func (a *CountAgg) WithWindow(w *sql.WindowDefinition) (sql.WindowFunction, erro
 	}
 	if w.OrderBy != nil {
 		na.orderBy = w.OrderBy.ToExpressions()
+		na.orderByDesc = len(w.OrderBy) > 0 && w.OrderBy[0].Order == sql.Descending
 	}
 	return &na, nil
 }
@@ -785,6 +876,7 @@ func (a *CountAgg) DefaultFramer() sql.WindowFramer {
 	return &RangeUnboundedPrecedingToCurrentRowFramer{
 		rangeFramerBase{
 			orderBy:            a.orderBy[0],
+			orderByDesc:        a.orderByDesc,
 			unboundedPreceding: true,
 			endCurrentRow:      true,
 		},
@@ -893,50 +985,14 @@ func (a *GroupConcatAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterv
 }
 
 func (a *GroupConcatAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
-	rows := a.rows
-
-	if len(rows) == 0 {
+	if len(a.rows) == 0 {
 		return nil
 	}
 
-	// Execute the order operation if it exists.
-	if a.gc.sf != nil {
-		sorter := &expression.Sorter{
-			SortFields: a.gc.sf,
-			Rows:       rows,
-			Ctx:        ctx,
-		}
-
-		sort.Stable(sorter)
-		if sorter.LastError != nil {
-			return nil
-		}
-	}
-
-	sb := strings.Builder{}
-	for i, row := range rows {
-		lastIdx := len(row) - 1
-		if i == 0 {
-			sb.WriteString(row[lastIdx].(string))
-		} else {
-			sb.WriteString(a.gc.separator)
-			sb.WriteString(row[lastIdx].(string))
-		}
-
-		// Don't allow the string to cross maxlen
-		if sb.Len() >= a.gc.maxLen {
-			break
-		}
-	}
-
-	ret := sb.String()
-
-	// There might be a couple of character differences even if we broke early in the loop
-	if len(ret) > a.gc.maxLen {
-		ret = ret[:a.gc.maxLen]
+	ret, err := a.gc.join(ctx, a.rows, false)
+	if err != nil {
+		return nil
 	}
-
-	// Add this to handle any one off errors.
 	return ret
 }
 
@@ -944,49 +1000,27 @@ func (a *GroupConcatAgg) filterToDistinct(ctx *sql.Context, buf sql.WindowBuffer
 	rows := make([]sql.Row, 0)
 	distinct := make(map[string]struct{}, 0)
 	for _, row := range buf {
-		evalRow, retType, err := evalExprs(ctx, a.gc.selectExprs, row)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		a.gc.returnType = retType
-
-		// Skip if this is a null row
-		if evalRow == nil {
-			continue
-		}
-
-		var v interface{}
-		if retType == types.Blob {
-			v, _, err = types.Blob.Convert(evalRow[0])
-		} else {
-			v, _, err = types.LongText.Convert(evalRow[0])
-		}
-
+		vs, ok, err := a.gc.concatRow(ctx, row)
 		if err != nil {
 			return nil, nil, err
 		}
-
-		if v == nil {
+		if !ok {
 			continue
 		}
 
-		vs := v.(string)
-
-		// Get the current array of rows and the map
 		// Check if distinct is active if so look at and update our map
 		if a.gc.distinct != "" {
-			// If this value exists go ahead and return nil
 			if _, ok := distinct[vs]; ok {
 				continue
-			} else {
-				distinct[vs] = struct{}{}
 			}
+			distinct[vs] = struct{}{}
 		}
 
-		// Append the current value to the end of the row. We want to preserve the row's original structure for
-		// for sort ordering in the final step.
-		rows = append(rows, append(row, nil, vs))
+		orderingRow, err := a.gc.orderingRow(ctx, row, vs)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, orderingRow)
 	}
 	return rows, distinct, nil
 }
@@ -1332,12 +1366,15 @@ type Lag struct {
 	leadLagBase
 }
 
-func NewLag(expr, def sql.Expression, offset int) *Lag {
+// NewLag returns a window function that evaluates |expr| at the |offset|th row before the current row, or |def| if
+// there's no such row. If |ignoreNulls| is true, only the rows where |expr| isn't NULL are counted.
+func NewLag(expr, def sql.Expression, offset int, ignoreNulls bool) *Lag {
 	return &Lag{
 		leadLagBase: leadLagBase{
-			expr:   expr,
-			def:    def,
-			offset: offset,
+			expr:        expr,
+			def:         def,
+			offset:      offset,
+			ignoreNulls: ignoreNulls,
 		},
 	}
 }
@@ -1346,21 +1383,25 @@ type Lead struct {
 	leadLagBase
 }
 
-func NewLead(expr, def sql.Expression, offset int) *Lead {
+// NewLead returns a window function that evaluates |expr| at the |offset|th row after the current row, or |def| if
+// there's no such row. If |ignoreNulls| is true, only the rows where |expr| isn't NULL are counted.
+func NewLead(expr, def sql.Expression, offset int, ignoreNulls bool) *Lead {
 	return &Lead{
 		leadLagBase: leadLagBase{
-			expr:   expr,
-			def:    def,
-			offset: -offset,
+			expr:        expr,
+			def:         def,
+			offset:      -offset,
+			ignoreNulls: ignoreNulls,
 		},
 	}
 }
 
 type leadLagBase struct {
-	expr   sql.Expression
-	def    sql.Expression
-	offset int
-	pos    int
+	expr        sql.Expression
+	def         sql.Expression
+	offset      int
+	ignoreNulls bool
+	pos         int
 }
 
 func (a *leadLagBase) WithWindow(w *sql.WindowDefinition) (sql.WindowFunction, error) {
@@ -1391,6 +1432,8 @@ func (a *leadLagBase) Compute(ctx *sql.Context, interval sql.WindowInterval, buf
 	idx := a.pos - a.offset
 	switch {
 	case interval.Start > interval.End:
+	case a.ignoreNulls && a.offset != 0:
+		res, err = a.computeIgnoringNulls(ctx, interval, buffer)
 	case idx >= interval.Start && idx < interval.End:
 		res, err = a.expr.Eval(ctx, buffer[idx])
 	case a.def != nil:
@@ -1402,3 +1445,29 @@ func (a *leadLagBase) Compute(ctx *sql.Context, interval sql.WindowInterval, buf
 	a.pos++
 	return res
 }
+
+// computeIgnoringNulls returns the value of the expression at the row the offset away from the current row, counting
+// only the rows where the value isn't NULL, or the default value if there's no such row in the partition.
+func (a *leadLagBase) computeIgnoringNulls(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) (interface{}, error) {
+	step, remaining := -1, a.offset
+	if a.offset < 0 {
+		step, remaining = 1, -a.offset
+	}
+	for idx := a.pos + step; idx >= interval.Start && idx < interval.End; idx += step {
+		res, err := a.expr.Eval(ctx, buffer[idx])
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			continue
+		}
+		remaining--
+		if remaining == 0 {
+			return res, nil
+		}
+	}
+	if a.def == nil {
+		return nil, nil
+	}
+	return a.def.Eval(ctx, buffer[a.pos])
+}
@@ -137,6 +137,21 @@ func TestGroupedAggFuncs(t *testing.T) {
 			Agg:      NewLastAgg(expression.NewGetField(0, types.LongText, "x", true)),
 			Expected: sql.Row{4, 4, 6},
 		},
+		{
+			Name:     "nth value",
+			Agg:      NewNthValueAgg(expression.NewGetField(0, types.LongText, "x", true), 2, false, false),
+			Expected: sql.Row{nil, nil, 2},
+		},
+		{
+			Name:     "nth value past frame end",
+			Agg:      NewNthValueAgg(expression.NewGetField(0, types.LongText, "x", true), 5, false, false),
+			Expected: sql.Row{nil, nil, 5},
+		},
+		{
+			Name:     "nth value from last ignore nulls",
+			Agg:      NewNthValueAgg(expression.NewGetField(0, types.LongText, "x", true), 2, true, true),
+			Expected: sql.Row{3, 3, 5},
+		},
 		// list aggregations
 		{
 			Name:     "group concat null",
@@ -283,7 +298,7 @@ func TestWindowedAggFuncs(t *testing.T) {
 	}{
 		{
 			Name:     "lag",
-			Agg:      NewLag(expression.NewGetField(1, types.LongText, "x", true), nil, 2),
+			Agg:      NewLag(expression.NewGetField(1, types.LongText, "x", true), nil, 2, false),
 			Expected: sql.Row{nil, nil, 1, 2, nil, nil, 1, 2, nil, nil, 1, 2, 3, 4},
 		},
 		{
@@ -292,6 +307,7 @@ func TestWindowedAggFuncs(t *testing.T) {
 				expression.NewGetField(1, types.LongText, "x", true),
 				expression.NewGetField(1, types.LongText, "x", true),
 				2,
+				false,
 			),
 			Expected: sql.Row{1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 3, 4},
 		},
@@ -301,12 +317,43 @@ func TestWindowedAggFuncs(t *testing.T) {
 				expression.NewGetField(0, types.LongText, "x", true),
 				nil,
 				1,
+				false,
 			),
 			Expected: sql.Row{nil, 1, nil, 3, nil, 1, nil, 3, nil, 1, 2, nil, nil, 5},
 		},
+		{
+			Name: "lag ignore nulls",
+			Agg: NewLag(
+				expression.NewGetField(0, types.LongText, "x", true),
+				nil,
+				1,
+				true,
+			),
+			Expected: sql.Row{nil, 1, 1, 3, nil, 1, 1, 3, nil, 1, 2, 2, 2, 5},
+		},
+		{
+			Name: "lag ignore nulls w/ default",
+			Agg: NewLag(
+				expression.NewGetField(0, types.LongText, "x", true),
+				expression.NewGetField(1, types.LongText, "x", true),
+				2,
+				true,
+			),
+			Expected: sql.Row{1, 2, 3, 1, 1, 2, 3, 1, 1, 2, 1, 1, 1, 2},
+		},
+		{
+			Name: "lead ignore nulls",
+			Agg: NewLead(
+				expression.NewGetField(0, types.LongText, "x", true),
+				nil,
+				1,
+				true,
+			),
+			Expected: sql.Row{3, 3, 4, nil, 3, 3, 4, nil, 2, 5, 5, 5, 6, nil},
+		},
 		{
 			Name:     "lead",
-			Agg:      NewLead(expression.NewGetField(1, types.LongText, "x", true), nil, 2),
+			Agg:      NewLead(expression.NewGetField(1, types.LongText, "x", true), nil, 2, false),
 			Expected: sql.Row{3, 4, nil, nil, 3, 4, nil, nil, 3, 4, 5, 6, nil, nil},
 		},
 		{
@@ -315,6 +362,7 @@ func TestWindowedAggFuncs(t *testing.T) {
 				expression.NewGetField(1, types.LongText, "x", true),
 				expression.NewGetField(1, types.LongText, "x", true),
 				2,
+				false,
 			),
 			Expected: sql.Row{3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 5, 6, 5, 6},
 		},
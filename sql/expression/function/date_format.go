@@ -17,94 +17,93 @@ package function
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/lestrrat-go/strftime"
-
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-func panicIfErr(err error) {
-	if err != nil {
-		panic(err)
+// dateTimeParts are the parts of a value formatted by DATE_FORMAT or TIME_FORMAT.
+type dateTimeParts struct {
+	// t holds the date, minutes, seconds and microseconds of the value. Its date is ignored for a TIME.
+	t time.Time
+	// hour is the hour of the value, which may be greater than 23 for a TIME.
+	hour int
+	// neg is whether the value is a negative TIME.
+	neg bool
+	// isTime is whether the value is a TIME, which has no date part. Specifiers that need a date, like the names and
+	// week numbers, make the result NULL for a TIME, and the numeric date specifiers are zero.
+	isTime bool
+}
+
+func (p dateTimeParts) year() int {
+	if p.isTime {
+		return 0
 	}
+	return p.t.Year()
 }
 
-func monthNum(t time.Time) string {
-	return strconv.FormatInt(int64(t.Month()), 10)
-}
-
-func dayWithSuffix(t time.Time) string {
-	suffix := "th"
-	day := int64(t.Day())
-	if day < 4 || day > 20 {
-		switch day % 10 {
-		case 1:
-			suffix = "st"
-		case 2:
-			suffix = "nd"
-		case 3:
-			suffix = "rd"
-		}
+func (p dateTimeParts) month() int {
+	if p.isTime {
+		return 0
 	}
-
-	return strconv.FormatInt(day, 10) + suffix
-}
-
-func dayOfMonth(t time.Time) string {
-	return strconv.FormatInt(int64(t.Day()), 10)
-}
-
-func microsecondsStr(t time.Time) string {
-	micros := t.Nanosecond() / int(time.Microsecond)
-	return fmt.Sprintf("%06d", micros)
+	return int(p.t.Month())
 }
 
-func minutesStr(t time.Time) string {
-	return fmt.Sprintf("%02d", t.Minute())
+func (p dateTimeParts) day() int {
+	if p.isTime {
+		return 0
+	}
+	return p.t.Day()
 }
 
-func twelveHour(t time.Time) (int, string) {
+// twelveHour returns the hour of the value on a 12-hour clock, and whether it's AM or PM.
+func (p dateTimeParts) twelveHour() (int, string) {
 	ampm := "AM"
-	if t.Hour() >= 12 {
+	if p.hour%24 >= 12 {
 		ampm = "PM"
 	}
-
-	hour := t.Hour() % 12
-	if hour == 0 {
-		hour = 12
-	}
-
-	return hour, ampm
-}
-
-func twelveHourPadded(t time.Time) string {
-	hour, _ := twelveHour(t)
-	return fmt.Sprintf("%02d", hour)
+	return (p.hour%24+11)%12 + 1, ampm
 }
 
-func twelveHourNoPadding(t time.Time) string {
-	hour, _ := twelveHour(t)
-	return fmt.Sprintf("%d", hour)
-}
+// dateFormatSpecifier returns the text of a format specifier for the value given, or false if the value doesn't
+// have the parts the specifier needs, which makes the result NULL.
+type dateFormatSpecifier func(p dateTimeParts, locale *timeLocale) (string, bool)
 
-func twentyFourHourNoPadding(t time.Time) string {
-	return fmt.Sprintf("%d", t.Hour())
+// dateOnly wraps a specifier that needs the date part of the value.
+func dateOnly(fn func(t time.Time, locale *timeLocale) string) dateFormatSpecifier {
+	return func(p dateTimeParts, locale *timeLocale) (string, bool) {
+		if p.isTime {
+			return "", false
+		}
+		return fn(p.t, locale), true
+	}
 }
 
-func fullMonthName(t time.Time) string {
-	return t.Month().String()
+// always wraps a specifier that can be formatted for any value.
+func always(fn func(p dateTimeParts) string) dateFormatSpecifier {
+	return func(p dateTimeParts, _ *timeLocale) (string, bool) {
+		return fn(p), true
+	}
 }
 
-func ampmClockStr(t time.Time) string {
-	hour, ampm := twelveHour(t)
-	return fmt.Sprintf("%02d:%02d:%02d %s", hour, t.Minute(), t.Second(), ampm)
-}
+func dayWithSuffix(t time.Time, _ *timeLocale) string {
+	suffix := "th"
+	day := int64(t.Day())
+	if day < 4 || day > 20 {
+		switch day % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
 
-func secondsStr(t time.Time) string {
-	return fmt.Sprintf("%02d", t.Second())
+	return strconv.FormatInt(day, 10) + suffix
 }
 
 func yearWeek(mode int32, t time.Time) (int32, int32) {
@@ -116,7 +115,7 @@ func yearWeek(mode int32, t time.Time) (int32, int32) {
 	return yr, wk
 }
 
-func weekMode0(t time.Time) string {
+func weekMode0(t time.Time, _ *timeLocale) string {
 	yr, wk := yearWeek(0, t)
 
 	if yr < int32(t.Year()) {
@@ -128,7 +127,7 @@ func weekMode0(t time.Time) string {
 	return fmt.Sprintf("%02d", wk)
 }
 
-func weekMode1(t time.Time) string {
+func weekMode1(t time.Time, _ *timeLocale) string {
 	yr, wk := yearWeek(1, t)
 
 	if yr < int32(t.Year()) {
@@ -140,113 +139,99 @@ func weekMode1(t time.Time) string {
 	return fmt.Sprintf("%02d", wk)
 }
 
-func weekMode2(t time.Time) string {
+func weekMode2(t time.Time, _ *timeLocale) string {
 	_, wk := yearWeek(2, t)
 	return fmt.Sprintf("%02d", wk)
 }
 
-func weekMode3(t time.Time) string {
+func weekMode3(t time.Time, _ *timeLocale) string {
 	_, wk := yearWeek(3, t)
 	return fmt.Sprintf("%02d", wk)
 }
 
-func yearMode0(t time.Time) string {
+func yearMode0(t time.Time, _ *timeLocale) string {
 	yr, _ := yearWeek(0, t)
-	return strconv.FormatInt(int64(yr), 10)
+	return fmt.Sprintf("%04d", yr)
 }
 
-func yearMode1(t time.Time) string {
+func yearMode1(t time.Time, _ *timeLocale) string {
 	yr, _ := yearWeek(1, t)
-	return strconv.FormatInt(int64(yr), 10)
-}
-
-func dayName(t time.Time) string {
-	return t.Weekday().String()
-}
-
-func yearTwoDigit(t time.Time) string {
-	return strconv.FormatInt(int64(t.Year())%100, 10)
-}
-
-type AppendFuncWrapper struct {
-	fn func(time.Time) string
-}
-
-func wrap(fn func(time.Time) string) strftime.Appender {
-	return AppendFuncWrapper{fn}
-}
-
-func (af AppendFuncWrapper) Append(bytes []byte, t time.Time) []byte {
-	s := af.fn(t)
-	return append(bytes, []byte(s)...)
-}
-
-var mysqlDateFormatSpec = strftime.NewSpecificationSet()
-var dateFormatSpecifierToFunc = map[byte]func(time.Time) string{
-	'a': nil,
-	'b': nil,
-	'c': monthNum,
-	'D': dayWithSuffix,
-	'd': nil,
-	'e': dayOfMonth,
-	'f': microsecondsStr,
-	'H': nil,
-	'h': twelveHourPadded,
-	'I': twelveHourPadded,
-	'i': minutesStr,
-	'j': nil,
-	'k': twentyFourHourNoPadding,
-	'l': twelveHourNoPadding,
-	'M': fullMonthName,
-	'm': nil,
-	'p': nil,
-	'r': ampmClockStr,
-	'S': nil,
-	's': secondsStr,
-	'T': nil,
-	'U': weekMode0,
-	'u': weekMode1,
-	'V': weekMode2,
-	'v': weekMode3,
-	'W': dayName,
-	'w': nil,
-	'X': yearMode0,
-	'x': yearMode1,
-	'Y': nil,
-	'y': yearTwoDigit,
-}
-
-func init() {
-	for specifier, fn := range dateFormatSpecifierToFunc {
-		if fn != nil {
-			panicIfErr(mysqlDateFormatSpec.Set(specifier, wrap(fn)))
-		}
+	return fmt.Sprintf("%04d", yr)
+}
+
+// dateFormatSpecifierToFunc holds the format specifiers of DATE_FORMAT and TIME_FORMAT. Any other character following
+// a % is written as is.
+// https://dev.mysql.com/doc/refman/8.0/en/date-and-time-functions.html#function_date-format
+var dateFormatSpecifierToFunc = map[byte]dateFormatSpecifier{
+	'a': dateOnly(func(t time.Time, locale *timeLocale) string { return locale.abbreviatedDayName(t.Weekday()) }),
+	'b': dateOnly(func(t time.Time, locale *timeLocale) string { return locale.abbreviatedMonthName(t.Month()) }),
+	'c': always(func(p dateTimeParts) string { return strconv.Itoa(p.month()) }),
+	'D': dateOnly(dayWithSuffix),
+	'd': always(func(p dateTimeParts) string { return fmt.Sprintf("%02d", p.day()) }),
+	'e': always(func(p dateTimeParts) string { return strconv.Itoa(p.day()) }),
+	'f': always(func(p dateTimeParts) string { return fmt.Sprintf("%06d", p.t.Nanosecond()/int(time.Microsecond)) }),
+	'H': always(func(p dateTimeParts) string { return fmt.Sprintf("%02d", p.hour) }),
+	'h': always(func(p dateTimeParts) string { hour, _ := p.twelveHour(); return fmt.Sprintf("%02d", hour) }),
+	'I': always(func(p dateTimeParts) string { hour, _ := p.twelveHour(); return fmt.Sprintf("%02d", hour) }),
+	'i': always(func(p dateTimeParts) string { return fmt.Sprintf("%02d", p.t.Minute()) }),
+	'j': dateOnly(func(t time.Time, _ *timeLocale) string { return fmt.Sprintf("%03d", t.YearDay()) }),
+	'k': always(func(p dateTimeParts) string { return strconv.Itoa(p.hour) }),
+	'l': always(func(p dateTimeParts) string { hour, _ := p.twelveHour(); return strconv.Itoa(hour) }),
+	'M': dateOnly(func(t time.Time, locale *timeLocale) string { return locale.monthName(t.Month()) }),
+	'm': always(func(p dateTimeParts) string { return fmt.Sprintf("%02d", p.month()) }),
+	'p': always(func(p dateTimeParts) string { _, ampm := p.twelveHour(); return ampm }),
+	'r': always(func(p dateTimeParts) string {
+		hour, ampm := p.twelveHour()
+		return fmt.Sprintf("%02d:%02d:%02d %s", hour, p.t.Minute(), p.t.Second(), ampm)
+	}),
+	'S': always(func(p dateTimeParts) string { return fmt.Sprintf("%02d", p.t.Second()) }),
+	's': always(func(p dateTimeParts) string { return fmt.Sprintf("%02d", p.t.Second()) }),
+	'T': always(func(p dateTimeParts) string {
+		return fmt.Sprintf("%02d:%02d:%02d", p.hour, p.t.Minute(), p.t.Second())
+	}),
+	'U': dateOnly(weekMode0),
+	'u': dateOnly(weekMode1),
+	'V': dateOnly(weekMode2),
+	'v': dateOnly(weekMode3),
+	'W': dateOnly(func(t time.Time, locale *timeLocale) string { return locale.dayName(t.Weekday()) }),
+	'w': dateOnly(func(t time.Time, _ *timeLocale) string { return strconv.Itoa(int(t.Weekday())) }),
+	'X': dateOnly(yearMode0),
+	'x': dateOnly(yearMode1),
+	'Y': always(func(p dateTimeParts) string { return fmt.Sprintf("%04d", p.year()) }),
+	'y': always(func(p dateTimeParts) string { return fmt.Sprintf("%02d", p.year()%100) }),
+}
+
+// formatDateTimeParts formats |p| as specified by |format|, with the month and weekday names of |locale|. Returns
+// false if the result is NULL, because |format| uses a specifier that |p| doesn't have the parts for.
+func formatDateTimeParts(format string, p dateTimeParts, locale *timeLocale) (string, bool) {
+	var sb strings.Builder
+	if p.neg {
+		sb.WriteByte('-')
 	}
-
-	// replace any strftime specifiers that aren't supported
-	fn := func(b byte) {
-		if _, ok := dateFormatSpecifierToFunc[b]; !ok {
-			panicIfErr(mysqlDateFormatSpec.Set(b, wrap(func(time.Time) string {
-				return string(b)
-			})))
+	for i := 0; i < len(format); i++ {
+		// a % that ends the format is written as is
+		if format[i] != '%' || i+1 == len(format) {
+			sb.WriteByte(format[i])
+			continue
 		}
+		i++
+		fn, ok := dateFormatSpecifierToFunc[format[i]]
+		if !ok {
+			sb.WriteByte(format[i])
+			continue
+		}
+		str, ok := fn(p, locale)
+		if !ok {
+			return "", false
+		}
+		sb.WriteString(str)
 	}
-
-	capToLower := byte('a' - 'A')
-	for i := byte('A'); i <= 'Z'; i++ {
-		fn(i)
-		fn(i + capToLower)
-	}
+	return sb.String(), true
 }
 
-func formatDate(format string, t time.Time) (string, error) {
-	formatter, err := strftime.New(format, strftime.WithSpecificationSet(mysqlDateFormatSpec))
-
-	if err != nil {
-		return "", err
-	}
-
-	return formatter.FormatString(t), nil
+// formatDate formats the date and time |t| as DATE_FORMAT does.
+func formatDate(format string, t time.Time, locale *timeLocale) (string, bool) {
+	return formatDateTimeParts(format, dateTimeParts{t: t, hour: t.Hour()}, locale)
 }
 
 // DateFormat function returns a string representation of the date specified in the format specified
@@ -315,7 +300,11 @@ func (f *DateFormat) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, sql.ErrInvalidArgumentDetails.New("DATE_FORMAT", "format must be a string")
 	}
 
-	return formatDate(formatStr, t)
+	res, ok := formatDate(formatStr, t, sessionTimeLocale(ctx))
+	if !ok {
+		return nil, nil
+	}
+	return res, nil
 }
 
 // Type implements the Expression interface.
@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -31,52 +32,70 @@ func TestDateFormatting(t *testing.T) {
 	tests := []struct {
 		formatStr string
 		expected  string
-		expectErr bool
 	}{
-		{"%a", "Mon", false},         // Abbreviated weekday name (Sun to Sat)
-		{"%b", "Feb", false},         // Abbreviated month name (Jan to Dec)
-		{"%c", "2", false},           // Numeric month name (0 to 12)
-		{"%D", "3rd", false},         // Day of the month as a numeric value, followed by suffix (1st, 2nd, 3rd, ...)
-		{"%d", "03", false},          // Day of the month as a numeric value (00 to 31)
-		{"%e", "3", false},           // Day of the month as a numeric value (0 to 31)
-		{"%f", "000007", false},      // Microseconds (000000 to 999999)
-		{"%H", "04", false},          // Hour (00 to 23)
-		{"%h", "04", false},          // Hour (00 to 12)
-		{"%I", "04", false},          // Hour (00 to 12)
-		{"%i", "05", false},          // Minutes (00 to 59)
-		{"%j", "034", false},         // Day of the year (001 to 366)
-		{"%k", "4", false},           // Hour (0 to 23)
-		{"%l", "4", false},           // Hour (1 to 12)
-		{"%M", "February", false},    // Month name in full (January to December)
-		{"%m", "02", false},          // Month name as a numeric value (00 to 12)
-		{"%p", "AM", false},          // AM or PM
-		{"%r", "04:05:06 AM", false}, // Time in 12 hour AM or PM format (hh:mm:ss AM/PM)
-		{"%S", "06", false},          // Seconds (00 to 59)
-		{"%s", "06", false},          // Seconds (00 to 59)
-		{"%T", "04:05:06", false},    // Time in 24 hour format (hh:mm:ss)
-		{"%W", "Monday", false},      // Weekday name in full (Sunday to Saturday)
-		{"%w", "1", false},           // Day of the week where Sunday=0 and Saturday=6
-		{"%Y", "2020", false},        // Year as a numeric, 4-digit value
-		{"%y", "20", false},          // Year as a numeric, 2-digit value
-		{"%U", "05", false},          // Week where Sunday is the first day of the week (00 to 53)
-		{"%u", "06", false},          // Week where Monday is the first day of the week (00 to 53)
-		{"%V", "05", false},          // Week where Sunday is the first day of the week (01 to 53). Used with %X
-		{"%v", "06", false},          // Week where Monday is the first day of the week (01 to 53). Used with %X
-		{"%X", "2020", false},        // Year for the week where Sunday is the first day of the week. Used with %V
-		{"%x", "2020", false},        // Year for the week where Monday is the first day of the week. Used with %V
+		{"%a", "Mon"},         // Abbreviated weekday name (Sun to Sat)
+		{"%b", "Feb"},         // Abbreviated month name (Jan to Dec)
+		{"%c", "2"},           // Numeric month name (0 to 12)
+		{"%D", "3rd"},         // Day of the month as a numeric value, followed by suffix (1st, 2nd, 3rd, ...)
+		{"%d", "03"},          // Day of the month as a numeric value (00 to 31)
+		{"%e", "3"},           // Day of the month as a numeric value (0 to 31)
+		{"%f", "000007"},      // Microseconds (000000 to 999999)
+		{"%H", "04"},          // Hour (00 to 23)
+		{"%h", "04"},          // Hour (00 to 12)
+		{"%I", "04"},          // Hour (00 to 12)
+		{"%i", "05"},          // Minutes (00 to 59)
+		{"%j", "034"},         // Day of the year (001 to 366)
+		{"%k", "4"},           // Hour (0 to 23)
+		{"%l", "4"},           // Hour (1 to 12)
+		{"%M", "February"},    // Month name in full (January to December)
+		{"%m", "02"},          // Month name as a numeric value (00 to 12)
+		{"%p", "AM"},          // AM or PM
+		{"%r", "04:05:06 AM"}, // Time in 12 hour AM or PM format (hh:mm:ss AM/PM)
+		{"%S", "06"},          // Seconds (00 to 59)
+		{"%s", "06"},          // Seconds (00 to 59)
+		{"%T", "04:05:06"},    // Time in 24 hour format (hh:mm:ss)
+		{"%W", "Monday"},      // Weekday name in full (Sunday to Saturday)
+		{"%w", "1"},           // Day of the week where Sunday=0 and Saturday=6
+		{"%Y", "2020"},        // Year as a numeric, 4-digit value
+		{"%y", "20"},          // Year as a numeric, 2-digit value
+		{"%U", "05"},          // Week where Sunday is the first day of the week (00 to 53)
+		{"%u", "06"},          // Week where Monday is the first day of the week (00 to 53)
+		{"%V", "05"},          // Week where Sunday is the first day of the week (01 to 53). Used with %X
+		{"%v", "06"},          // Week where Monday is the first day of the week (01 to 53). Used with %X
+		{"%X", "2020"},        // Year for the week where Sunday is the first day of the week. Used with %V
+		{"%x", "2020"},        // Year for the week where Monday is the first day of the week. Used with %V
+		{"%%", "%"},           // A literal % character
+		{"100%", "100%"},      // A % that ends the format is written as is
+		{"%Y-%m-%d %T.%f", "2020-02-03 04:05:06.000007"},
 	}
 
 	for _, test := range tests {
 		t.Run(dt.String()+test.formatStr, func(t *testing.T) {
-			result, err := formatDate(test.formatStr, dt)
-
-			if test.expectErr {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-				t.Log(result)
-				assert.Equal(t, test.expected, result)
-			}
+			result, ok := formatDate(test.formatStr, dt, englishTimeLocale)
+			require.True(t, ok)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+
+	// expected values are from MySQL 8.0
+	tests2 := []struct {
+		dt        time.Time
+		formatStr string
+		expected  string
+	}{
+		{time.Date(2005, 1, 1, 13, 7, 0, 500000000, time.UTC), "%y %h %I %l %p %r %D %j %f", "05 01 01 1 PM 01:07:00 PM 1st 001 500000"},
+		{time.Date(99, 12, 11, 0, 0, 0, 0, time.UTC), "%Y %y %H %h %k %l %r %D", "0099 99 00 12 0 12 12:00:00 AM 11th"},
+		{time.Date(2021, 3, 12, 12, 59, 59, 999999000, time.UTC), "%D %p %r %T %f", "12th PM 12:59:59 PM 12:59:59 999999"},
+		{time.Date(2021, 3, 22, 23, 0, 0, 0, time.UTC), "%D %W %a %M %b %w %h %p", "22nd Monday Mon March Mar 1 11 PM"},
+		{time.Date(2021, 3, 23, 0, 0, 0, 0, time.UTC), "%D", "23rd"},
+		{time.Date(2021, 3, 31, 0, 0, 0, 0, time.UTC), "%D %j", "31st 090"},
+		{time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC), "%j %U %u %V %v %X %x", "366 52 53 52 53 2020 2020"},
+	}
+	for _, test := range tests2 {
+		t.Run(test.dt.String()+test.formatStr, func(t *testing.T) {
+			result, ok := formatDate(test.formatStr, test.dt, englishTimeLocale)
+			require.True(t, ok)
+			assert.Equal(t, test.expected, result)
 		})
 	}
 }
@@ -86,8 +105,8 @@ func TestUnsupportedSpecifiers(t *testing.T) {
 		if _, ok := dateFormatSpecifierToFunc[b]; !ok {
 			name := fmt.Sprintf("%%%s", string(b))
 			t.Run(name, func(t *testing.T) {
-				result, err := formatDate(name, time.Now())
-				assert.NoError(t, err)
+				result, ok := formatDate(name, time.Now(), englishTimeLocale)
+				assert.True(t, ok)
 				assert.Equal(t, string(b), result)
 			})
 		}
@@ -100,6 +119,40 @@ func TestUnsupportedSpecifiers(t *testing.T) {
 	}
 }
 
+func TestDateFormatLocales(t *testing.T) {
+	dt := time.Date(2020, 3, 1, 4, 5, 6, 0, time.UTC)
+	dateLit := expression.NewLiteral(dt, types.DatetimeMaxPrecision)
+	format := expression.NewLiteral("%W %a %e %M %b %p", types.Text)
+
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{"en_US", "Sunday Sun 1 March Mar AM"},
+		{"", "Sunday Sun 1 March Mar AM"},
+		{"de_DE", "Sonntag So 1 März Mär AM"},
+		{"es_ES", "domingo dom 1 marzo mar AM"},
+		{"fr_FR", "dimanche dim 1 mars mars AM"},
+		{"it_IT", "domenica dom 1 marzo mar AM"},
+		{"nl_NL", "zondag zo 1 maart mrt AM"},
+		{"pt_BR", "domingo dom 1 março mar AM"},
+		{"ru_RU", "Воскресенье Вск 1 Марта Мар AM"},
+		{"sv_SE", "söndag sön 1 mars mar AM"},
+		{"DE_de", "Sonntag So 1 März Mär AM"},
+		{"xx_XX", "Sunday Sun 1 March Mar AM"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.locale, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			require.NoError(t, ctx.SetSessionVariable(ctx, "lc_time_names", test.locale))
+			res, err := NewDateFormat(dateLit, format).Eval(ctx, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
 func TestWeekYearFormatting(t *testing.T) {
 	weekYearStartingMonday := "%x-W%v"
 	weekYearStartingSunday := "%X-W%V"
@@ -139,20 +192,20 @@ func TestWeekYearFormatting(t *testing.T) {
 			dt, err := time.Parse("2006-01-02", test.dateStr)
 			require.NoError(t, err)
 
-			mondayWYStartResult, err := formatDate(weekYearStartingMonday, dt)
-			assert.NoError(t, err)
+			mondayWYStartResult, ok := formatDate(weekYearStartingMonday, dt, englishTimeLocale)
+			assert.True(t, ok)
 			assert.Equal(t, test.expectedWYForMonStart, mondayWYStartResult)
 
-			sundayWYStartResult, err := formatDate(weekYearStartingSunday, dt)
-			assert.NoError(t, err)
+			sundayWYStartResult, ok := formatDate(weekYearStartingSunday, dt, englishTimeLocale)
+			assert.True(t, ok)
 			assert.Equal(t, test.expectedWYForSunStart, sundayWYStartResult)
 
-			mondayWStartResult, err := formatDate(weekStartingMonday, dt)
-			assert.NoError(t, err)
+			mondayWStartResult, ok := formatDate(weekStartingMonday, dt, englishTimeLocale)
+			assert.True(t, ok)
 			assert.Equal(t, test.expectedWForMonStart, mondayWStartResult)
 
-			sundayWStartResult, err := formatDate(weekStartingSunday, dt)
-			assert.NoError(t, err)
+			sundayWStartResult, ok := formatDate(weekStartingSunday, dt, englishTimeLocale)
+			assert.True(t, ok)
 			assert.Equal(t, test.expectedWForSunStart, sundayWStartResult)
 		})
 	}
@@ -130,6 +130,36 @@ func TestJSONMergePatch(t *testing.T) {
 			row: sql.Row{`{"a": 1, "b": 2}`, `{"a": {"one": false, "two": 2.55, "e": 8}}`, `"single value"`},
 			exp: types.MustJSON(`"single value"`),
 		},
+		{
+			f:   f2,
+			row: sql.Row{`{"a": 1, "b": {"c": 2, "d": 3}}`, `{"b": {"c": null}}`},
+			exp: types.MustJSON(`{"a": 1, "b": {"d": 3}}`),
+		},
+		{
+			f:   f2,
+			row: sql.Row{`{"a": {"x": 1}}`, `{"a": {"y": {"z": null}}, "b": null}`},
+			exp: types.MustJSON(`{"a": {"x": 1, "y": {}}}`),
+		},
+		{
+			f:   f2,
+			row: sql.Row{`1`, `{"a": null, "b": 2}`},
+			exp: types.MustJSON(`{"b": 2}`),
+		},
+		{
+			f:   f3,
+			row: sql.Row{`{"a": 1}`, `{"a": null}`, `{"a": [2]}`},
+			exp: types.MustJSON(`{"a": [2]}`),
+		},
+		{
+			f:   f3,
+			row: sql.Row{`{"a": 1}`, nil, `{"b": 2}`},
+			exp: nil,
+		},
+		{
+			f:   f2,
+			row: sql.Row{`{"a": 1}`, `{"a":`},
+			err: true,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -159,10 +159,16 @@ func (j *JSONMergePreserve) WithChildren(children ...sql.Expression) (sql.Expres
 func merge(base, add interface{}, patch bool) interface{} {
 	baseObj, baseOk := base.(map[string]interface{})
 	addObj, addOk := add.(map[string]interface{})
-	if !baseOk || !addOk {
-		if patch {
+	if patch {
+		// RFC 7396: a non-object patch replaces the target, and an object patch applied to a non-object target is
+		// applied to an empty object instead
+		if !addOk {
 			return add
 		}
+		if !baseOk {
+			baseObj = make(map[string]interface{}, len(addObj))
+		}
+	} else if !baseOk || !addOk {
 		return mergeIntoArrays(base, add)
 	}
 
@@ -173,10 +179,11 @@ func merge(base, add interface{}, patch bool) interface{} {
 			continue
 		}
 		baseVal, found := baseObj[key]
-		if !found {
+		if !found && !patch {
 			baseObj[key] = val
 			continue
 		}
+		// when patching, a new member is still merged so that nulls nested in it are removed
 		baseObj[key] = merge(baseVal, val, patch)
 	}
 
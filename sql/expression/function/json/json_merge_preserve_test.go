@@ -146,6 +146,11 @@ func TestJSONMergePreserve(t *testing.T) {
 			row: sql.Row{`{"a": 1, "b": 2}`, `{"a": {"one": false, "two": 2.55, "e": 8}}`, `"single value"`},
 			exp: types.MustJSON(`[{"a": [1, {"e": 8, "one": false, "two": 2.55}], "b": 2}, "single value"]`),
 		},
+		{
+			f:   f2,
+			row: sql.Row{`{"a": 1, "b": {"c": 2, "d": 3}}`, `{"b": {"c": null}}`},
+			exp: types.MustJSON(`{"a": 1, "b": {"c": [2, null], "d": 3}}`),
+		},
 	}
 
 	for _, tt := range testCases {
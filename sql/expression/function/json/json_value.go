@@ -15,11 +15,9 @@
 package json
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 
-	"github.com/dolthub/jsonpath"
 	"github.com/dolthub/vitess/go/sqltypes"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -27,18 +25,38 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-// JsonValue selects data from a json document using a json path and
-// optional type coercion.
+// JsonValue selects a scalar from a json document using a json path, and converts it to the RETURNING type.
 // https://dev.mysql.com/doc/refman/8.0/en/json-search-functions.html#function_json-value
-// usage: JSON_VALUE(json_doc, path, [returning type])
-// TODO: [RETURNING TYPE] should be appended to path option in parser
-// TODO: missing [on empty] and [on error] support
+// usage: JSON_VALUE(json_doc, path [RETURNING type] [on_empty] [on_error])
 type JsonValue struct {
 	JSON sql.Expression
 	Path sql.Expression
 	Typ  sql.Type
+	// OnEmpty is what the function returns when the path doesn't find a value.
+	OnEmpty JsonValueHandler
+	// OnError is what the function returns when the value found isn't a scalar, or can't be converted to Typ.
+	OnError JsonValueHandler
 }
 
+// JsonValueHandler is an ON EMPTY or ON ERROR clause of JSON_VALUE.
+type JsonValueHandler struct {
+	// Action is one of JsonValueNull, JsonValueError or JsonValueDefault.
+	Action string
+	// Default is the literal value of a DEFAULT clause.
+	Default sql.Expression
+	// def is Default converted to the RETURNING type.
+	def interface{}
+}
+
+const (
+	// JsonValueNull makes JSON_VALUE return NULL. It's the action of both clauses when they're omitted.
+	JsonValueNull = "null"
+	// JsonValueError makes JSON_VALUE return an error.
+	JsonValueError = "error"
+	// JsonValueDefault makes JSON_VALUE return the value of the clause.
+	JsonValueDefault = "default"
+)
+
 var _ sql.FunctionExpression = (*JsonValue)(nil)
 var _ sql.CollationCoercible = (*JsonValue)(nil)
 
@@ -46,15 +64,79 @@ var jsonValueDefaultType = types.MustCreateString(sqltypes.VarChar, 512, sql.Col
 
 // NewJsonValue creates a new JsonValue UDF.
 func NewJsonValue(args ...sql.Expression) (sql.Expression, error) {
-	if len(args) < 1 || len(args) > 3 {
-		return nil, sql.ErrInvalidArgumentNumber.New("JSON_VALUE", 2, len(args))
-	} else if len(args) == 1 {
+	switch len(args) {
+	case 1:
 		return &JsonValue{JSON: args[0], Path: expression.NewLiteral("$", types.Text), Typ: jsonValueDefaultType}, nil
-	} else if len(args) == 2 {
+	case 2:
 		return &JsonValue{JSON: args[0], Path: args[1], Typ: jsonValueDefaultType}, nil
-	} else {
-		// third argument is literal zero of the coercion type
+	case 3:
+		// third argument is literal zero of the coercion type, or NULL without a RETURNING clause
+		if types.IsNull(args[2]) {
+			return &JsonValue{JSON: args[0], Path: args[1], Typ: jsonValueDefaultType}, nil
+		}
 		return &JsonValue{JSON: args[0], Path: args[1], Typ: args[2].Type()}, nil
+	default:
+		return nil, sql.ErrInvalidArgumentNumber.New("JSON_VALUE", 2, len(args))
+	}
+}
+
+// NewJsonValueWithClauses creates a JsonValue with the RETURNING type and the ON EMPTY and ON ERROR clauses given. A
+// nil |typ| is the type returned without a RETURNING clause.
+func NewJsonValueWithClauses(js, path sql.Expression, typ sql.Type, onEmpty, onError JsonValueHandler) (*JsonValue, error) {
+	if typ == nil {
+		typ = jsonValueDefaultType
+	}
+	var err error
+	if onEmpty, err = onEmpty.convertDefault(typ); err != nil {
+		return nil, err
+	}
+	if onError, err = onError.convertDefault(typ); err != nil {
+		return nil, err
+	}
+	return &JsonValue{JSON: js, Path: path, Typ: typ, OnEmpty: onEmpty, OnError: onError}, nil
+}
+
+// convertDefault returns the handler with the value of its DEFAULT clause, which must be a literal, converted to |typ|.
+func (h JsonValueHandler) convertDefault(typ sql.Type) (JsonValueHandler, error) {
+	switch h.Action {
+	case "", JsonValueNull, JsonValueError:
+		return h, nil
+	case JsonValueDefault:
+		lit, ok := h.Default.(*expression.Literal)
+		if !ok {
+			return JsonValueHandler{}, sql.ErrInvalidArgumentDetails.New("JSON_VALUE", "DEFAULT value must be a literal")
+		}
+		def, inRange, err := typ.Convert(lit.Value())
+		if err != nil || inRange == sql.OutOfRange {
+			return JsonValueHandler{}, sql.ErrInvalidArgumentDetails.New("JSON_VALUE", fmt.Sprintf("invalid DEFAULT value %v for %s", lit.Value(), typ))
+		}
+		h.def = def
+		return h, nil
+	default:
+		return JsonValueHandler{}, sql.ErrInvalidArgument.New("JSON_VALUE")
+	}
+}
+
+// handle returns the result of the handler given. |err| is the error returned by the ERROR action.
+func (h JsonValueHandler) handle(err error) (interface{}, error) {
+	switch h.Action {
+	case JsonValueError:
+		return nil, err
+	case JsonValueDefault:
+		return h.def, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (h JsonValueHandler) String(clause string) string {
+	switch h.Action {
+	case JsonValueError:
+		return " error on " + clause
+	case JsonValueDefault:
+		return fmt.Sprintf(" default %v on %s", h.def, clause)
+	default:
+		return ""
 	}
 }
 
@@ -95,54 +177,63 @@ func (j *JsonValue) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
-	strData, _, err := types.LongBlob.Convert(js)
+	js, _, err = types.JSON.Convert(js)
 	if err != nil {
 		return nil, fmt.Errorf("invalid data type for JSON data in argument 1 to function json_value; a JSON string or JSON type is required")
 	}
-	if strData == nil {
-		return nil, nil
+	doc, ok := js.(sql.JSONWrapper)
+	if !ok {
+		return nil, fmt.Errorf("expected types.JSONValue, found: %T", js)
 	}
 
-	var jsonData interface{}
-	if err = json.Unmarshal(strData.([]byte), &jsonData); err != nil {
+	path, err := buildPath(ctx, j.Path, row)
+	if err != nil || path == nil {
 		return nil, err
 	}
-
-	path, err := j.Path.Eval(ctx, row)
-	if err != nil {
-		return nil, err
+	if strings.Contains(path.(string), "*") {
+		return nil, ErrPathWildcard
 	}
 
-	res, err := jsonpath.JsonPathLookup(jsonData, path.(string))
-	if err != nil {
+	res, err := types.LookupJSONValue(doc, path.(string))
+	if err != nil && !strings.Contains(err.Error(), "index out of range") {
 		return nil, err
 	}
+	if err != nil || res == nil {
+		return j.OnEmpty.handle(sql.ErrJSONValueNotFound.New())
+	}
 
-	switch r := res.(type) {
+	val := res.ToInterface()
+	switch v := val.(type) {
 	case nil:
 		return nil, nil
 	case []interface{}:
-		if len(r) == 0 {
-			return nil, nil
+		// a member of an array is looked up in each of its elements, which gives an empty array when none of them has it
+		if len(v) == 0 && path.(string) != "$" {
+			return j.OnEmpty.handle(sql.ErrJSONValueNotFound.New())
+		}
+		if !types.IsJSON(j.Typ) {
+			return j.OnError.handle(sql.ErrInvalidJSONValueForCast.New(j.Typ.String()))
 		}
-		res = types.JSONDocument{Val: res}
 	case map[string]interface{}:
-		res = types.JSONDocument{Val: res}
-	}
-
-	if j.Typ != nil {
-		res, _, err = j.Typ.Convert(res)
-		if err != nil {
-			return nil, err
+		// only a JSON value can be an array or an object
+		if !types.IsJSON(j.Typ) {
+			return j.OnError.handle(sql.ErrInvalidJSONValueForCast.New(j.Typ.String()))
 		}
 	}
 
-	return res, nil
+	if types.IsJSON(j.Typ) {
+		return res, nil
+	}
+	converted, inRange, err := j.Typ.Convert(val)
+	if err != nil || inRange == sql.OutOfRange {
+		return j.OnError.handle(sql.ErrInvalidJSONValueForCast.New(j.Typ.String()))
+	}
+	return converted, nil
 }
 
 // IsNullable implements the sql.Expression interface.
 func (j *JsonValue) IsNullable() bool {
-	return j.JSON.IsNullable() || j.Path.IsNullable()
+	return true
 }
 
 // Children implements the sql.Expression interface.
@@ -162,10 +253,14 @@ func (j *JsonValue) WithChildren(children ...sql.Expression) (sql.Expression, er
 }
 
 func (j *JsonValue) String() string {
-	children := j.Children()
-	var parts = make([]string, len(children))
-	for i, c := range children {
-		parts[i] = c.String()
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("json_value(%s, %s", j.JSON, j.Path))
+	if j.Typ != jsonValueDefaultType {
+		sb.WriteString(" returning ")
+		sb.WriteString(j.Typ.String())
 	}
-	return fmt.Sprintf("json_value(%s)", strings.Join(parts, ", "))
+	sb.WriteString(j.OnEmpty.String("empty"))
+	sb.WriteString(j.OnError.String("error"))
+	sb.WriteString(")")
+	return sb.String()
 }
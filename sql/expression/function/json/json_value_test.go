@@ -18,6 +18,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -30,22 +31,48 @@ func TestJsonValue(t *testing.T) {
 	_, err := NewJSONValid()
 	require.True(t, errors.Is(err, sql.ErrInvalidArgumentNumber))
 
+	nullOn := &JsonValueHandler{Action: JsonValueNull}
+	errorOn := &JsonValueHandler{Action: JsonValueError}
+	defaultOn := func(v interface{}, typ sql.Type) *JsonValueHandler {
+		return &JsonValueHandler{Action: JsonValueDefault, Default: expression.NewLiteral(v, typ)}
+	}
+
 	tests := []struct {
-		row  sql.Row
-		typ  sql.Type
-		path string
-		exp  interface{}
+		row     sql.Row
+		typ     sql.Type
+		path    string
+		onEmpty *JsonValueHandler
+		onError *JsonValueHandler
+		exp     interface{}
+		err     *errors.Kind
 	}{
 		{row: sql.Row{`null`}, exp: nil},
 		{row: sql.Row{`1`}, exp: "1"},
-		{row: sql.Row{`[1]`}, exp: `[1]`},
-		{row: sql.Row{`{"a": "fjsadflkd"}`}, exp: `{"a": "fjsadflkd"}`},
-		{row: sql.Row{`[1, false]`}, exp: `[1, false]`},
+		{row: sql.Row{`[1]`}, exp: nil},
+		{row: sql.Row{`{"a": "fjsadflkd"}`}, exp: nil},
+		{row: sql.Row{`[1, false]`}, exp: nil},
 		{row: sql.Row{`[1, false]`}, path: "$[0]", typ: types.Int64, exp: int64(1)},
 		{row: sql.Row{`[1, false]`}, path: "$[0]", typ: types.Uint64, exp: uint64(1)},
 		{row: sql.Row{`[1, false]`}, path: "$[0]", exp: "1"},
 		{row: sql.Row{`[1, {"a": 1}]`}, path: "$[1].a", typ: types.Int64, exp: int64(1)},
 		{row: sql.Row{`[1, {"a": 1}]`}, path: "$[1]", typ: types.JSON, exp: types.MustJSON(`{"a": 1}`)},
+		{row: sql.Row{`{"a": "1.50"}`}, path: "$.a", typ: types.MustCreateDecimalType(10, 2), exp: decimal.RequireFromString("1.50")},
+
+		// ON EMPTY
+		{row: sql.Row{`{"a": 1}`}, path: "$.b", typ: types.Int64, exp: nil},
+		{row: sql.Row{`{"a": 1}`}, path: "$.b", typ: types.Int64, onEmpty: defaultOn("7", types.Text), onError: nullOn, exp: int64(7)},
+		{row: sql.Row{`[1, 2]`}, path: "$[5]", typ: types.Int64, onEmpty: defaultOn(7, types.Int8), onError: nullOn, exp: int64(7)},
+		{row: sql.Row{`{"a": 1}`}, path: "$.b", typ: types.Int64, onEmpty: errorOn, onError: nullOn, err: sql.ErrJSONValueNotFound},
+		{row: sql.Row{`{"a": 1}`}, path: "$.a", typ: types.Int64, onEmpty: errorOn, onError: nullOn, exp: int64(1)},
+
+		// ON ERROR
+		{row: sql.Row{`{"a": "abc"}`}, path: "$.a", typ: types.Int64, exp: nil},
+		{row: sql.Row{`{"a": "abc"}`}, path: "$.a", typ: types.Int64, onEmpty: nullOn, onError: defaultOn(-1, types.Int8), exp: int64(-1)},
+		{row: sql.Row{`{"a": "abc"}`}, path: "$.a", typ: types.Int64, onEmpty: nullOn, onError: errorOn, err: sql.ErrInvalidJSONValueForCast},
+		{row: sql.Row{`{"a": 300}`}, path: "$.a", typ: types.Uint8, onEmpty: nullOn, onError: errorOn, err: sql.ErrInvalidJSONValueForCast},
+		{row: sql.Row{`{"a": [1, 2]}`}, path: "$.a", typ: types.Int64, onEmpty: nullOn, onError: defaultOn(0, types.Int8), exp: int64(0)},
+		{row: sql.Row{`{"a": {"b": 1}}`}, path: "$.a", typ: types.Text, onEmpty: nullOn, onError: errorOn, err: sql.ErrInvalidJSONValueForCast},
+		{row: sql.Row{`{"a": {"b": 1}}`}, path: "$.a", typ: types.JSON, onEmpty: nullOn, onError: errorOn, exp: types.MustJSON(`{"b": 1}`)},
 	}
 
 	for _, tt := range tests {
@@ -60,6 +87,9 @@ func TestJsonValue(t *testing.T) {
 		if tt.typ != nil {
 			args = append(args, tt.typ.String())
 		}
+		if tt.onEmpty != nil {
+			args = append(args, tt.onEmpty.Action, tt.onError.Action)
+		}
 		t.Run(strings.Join(args, ", "), func(t *testing.T) {
 			args := []sql.Expression{expression.NewGetField(0, types.JSON, "", true)}
 			if tt.path != "" {
@@ -68,11 +98,34 @@ func TestJsonValue(t *testing.T) {
 			if tt.typ != nil {
 				args = append(args, expression.NewLiteral(tt.typ.Zero(), tt.typ))
 			}
-			f, _ := NewJsonValue(args...)
 			require := require.New(t)
-			// any error case will result in output of 'false' value
-			result, _ := f.Eval(sql.NewEmptyContext(), tt.row)
+			f, err := NewJsonValue(args...)
+			require.NoError(err)
+			if tt.onEmpty != nil {
+				f, err = NewJsonValueWithClauses(args[0], args[1], tt.typ, *tt.onEmpty, *tt.onError)
+				require.NoError(err)
+			}
+			result, err := f.Eval(sql.NewEmptyContext(), tt.row)
+			if tt.err != nil {
+				require.True(tt.err.Is(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(err)
 			require.Equal(tt.exp, result)
 		})
 	}
 }
+
+func TestJsonValueInvalidDefault(t *testing.T) {
+	onEmpty := JsonValueHandler{Action: JsonValueDefault, Default: expression.NewLiteral("abc", types.Text)}
+	_, err := NewJsonValueWithClauses(expression.NewGetField(0, types.JSON, "", true), expression.NewLiteral("$.a", types.Text), types.Int64, onEmpty, JsonValueHandler{})
+	require.True(t, sql.ErrInvalidArgumentDetails.Is(err))
+
+	_, err = NewJsonValue(
+		expression.NewGetField(0, types.JSON, "", true),
+		expression.NewLiteral("$.a", types.Text),
+		expression.NewLiteral(types.Int64.Zero(), types.Int64),
+		expression.NewLiteral(JsonValueDefault, types.Text),
+	)
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+}
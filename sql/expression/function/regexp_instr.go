@@ -0,0 +1,282 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	regex "github.com/dolthub/go-icu-regex"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// RegexpInstr implements the REGEXP_INSTR function.
+// https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-instr
+type RegexpInstr struct {
+	args []sql.Expression
+
+	re          regex.Regex
+	compileOnce sync.Once
+	compileErr  error
+}
+
+var _ sql.FunctionExpression = (*RegexpInstr)(nil)
+var _ sql.CollationCoercible = (*RegexpInstr)(nil)
+var _ sql.Closer = (*RegexpInstr)(nil)
+
+// NewRegexpInstr creates a new RegexpInstr expression.
+func NewRegexpInstr(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 6 {
+		return nil, sql.ErrInvalidArgumentNumber.New("regexp_instr", "2,3,4,5 or 6", len(args))
+	}
+	return &RegexpInstr{args: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *RegexpInstr) FunctionName() string {
+	return "regexp_instr"
+}
+
+// Description implements sql.FunctionExpression
+func (r *RegexpInstr) Description() string {
+	return "returns the starting index of substring matching regular expression."
+}
+
+// Type implements the sql.Expression interface.
+func (r *RegexpInstr) Type() sql.Type { return types.Int32 }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*RegexpInstr) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the sql.Expression interface.
+func (r *RegexpInstr) IsNullable() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (r *RegexpInstr) Children() []sql.Expression {
+	return r.args
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RegexpInstr) Resolved() bool {
+	for _, arg := range r.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RegexpInstr) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(r.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.args))
+	}
+	return NewRegexpInstr(children...)
+}
+
+func (r *RegexpInstr) String() string {
+	var args []string
+	for _, e := range r.args {
+		args = append(args, e.String())
+	}
+	return fmt.Sprintf("%s(%s)", r.FunctionName(), strings.Join(args, ","))
+}
+
+func (r *RegexpInstr) flags() sql.Expression {
+	if len(r.args) == 6 {
+		return r.args[5]
+	}
+	return nil
+}
+
+// Eval implements the sql.Expression interface.
+func (r *RegexpInstr) Eval(ctx *sql.Context, row sql.Row) (val interface{}, err error) {
+	span, ctx := ctx.Span("function.RegexpInstr")
+	defer span.End()
+
+	text, err := evalRegexpText(ctx, r.args[0], row)
+	if err != nil || text == nil {
+		return nil, err
+	}
+
+	re, cached, err := regexpForRow(ctx, r.args[1], r.args[0], r.flags(), r.FunctionName(), row, &r.compileOnce, &r.re, &r.compileErr)
+	if err != nil || re == nil {
+		return nil, err
+	}
+	if !cached {
+		defer func() {
+			if nErr := re.Close(); err == nil {
+				err = nErr
+			}
+		}()
+	}
+
+	pos, occ, isNull, err := evalRegexpPosAndOccurrence(ctx, r.args, row)
+	if err != nil || isNull {
+		return nil, err
+	}
+
+	returnOption := 0
+	if len(r.args) >= 5 {
+		opt, isNull, err := evalRegexpIntArg(ctx, r.args[4], row)
+		if err != nil || isNull {
+			return nil, err
+		}
+		if opt != 0 && opt != 1 {
+			return nil, sql.ErrInvalidArgument.New(r.FunctionName())
+		}
+		returnOption = opt
+	}
+
+	start, length, ok, err := regexpSearch(ctx, re, *text, pos, occ, r.FunctionName())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return int32(0), nil
+	}
+	if returnOption == 1 {
+		return int32(start + length), nil
+	}
+	return int32(start), nil
+}
+
+// Close implements the sql.Closer interface.
+func (r *RegexpInstr) Close(ctx *sql.Context) error {
+	if r.re != nil {
+		return r.re.Close()
+	}
+	return nil
+}
+
+// regexpForRow returns the regex for the pattern and flags given. When neither references the row, the regex is
+// compiled once and cached in |re| and |compileErr|, as with REGEXP_LIKE. Otherwise, a new regex is compiled for the
+// row, and the returned bool is false to indicate that the caller must close it.
+func regexpForRow(ctx *sql.Context, pattern, text, flags sql.Expression, funcName string, row sql.Row, once *sync.Once, re *regex.Regex, compileErr *error) (regex.Regex, bool, error) {
+	if canBeCached(pattern) && (flags == nil || canBeCached(flags)) {
+		once.Do(func() {
+			*re, *compileErr = compileRegex(ctx, pattern, text, flags, funcName, nil)
+		})
+		return *re, true, *compileErr
+	}
+	r, err := compileRegex(ctx, pattern, text, flags, funcName, row)
+	return r, false, err
+}
+
+// evalRegexpText evaluates the string argument of a regular expression function, returning nil if it is NULL.
+func evalRegexpText(ctx *sql.Context, e sql.Expression, row sql.Row) (*string, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	val, _, err = types.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	s := val.(string)
+	return &s, nil
+}
+
+// evalRegexpIntArg evaluates an integer argument of a regular expression function.
+func evalRegexpIntArg(ctx *sql.Context, e sql.Expression, row sql.Row) (int, bool, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return 0, true, err
+	}
+	val, _, err = types.Int32.Convert(val)
+	if err != nil {
+		return 0, false, err
+	}
+	return int(val.(int32)), false, nil
+}
+
+// evalRegexpPosAndOccurrence evaluates the optional pos and occurrence arguments, which are the third and fourth
+// arguments of both REGEXP_INSTR and REGEXP_SUBSTR. Both default to 1.
+func evalRegexpPosAndOccurrence(ctx *sql.Context, args []sql.Expression, row sql.Row) (pos int, occ int, isNull bool, err error) {
+	pos, occ = 1, 1
+	if len(args) >= 3 {
+		if pos, isNull, err = evalRegexpIntArg(ctx, args[2], row); err != nil || isNull {
+			return 0, 0, isNull, err
+		}
+	}
+	if len(args) >= 4 {
+		if occ, isNull, err = evalRegexpIntArg(ctx, args[3], row); err != nil || isNull {
+			return 0, 0, isNull, err
+		}
+	}
+	return pos, occ, false, nil
+}
+
+// regexpSearch finds the |occurrence|th match of |re| in |text|, starting the search at the character position |pos|.
+// It returns the 1-based character position of the match and the match length in characters.
+func regexpSearch(ctx *sql.Context, re regex.Regex, text string, pos, occurrence int, funcName string) (start int, length int, ok bool, err error) {
+	if pos <= 0 {
+		return 0, 0, false, sql.ErrInvalidArgumentDetails.New(funcName, fmt.Sprintf("%d", pos))
+	}
+	textLen := utf8.RuneCountInString(text)
+	if textLen == 0 {
+		return 0, 0, false, nil
+	}
+	if pos > textLen {
+		return 0, 0, false, errors.NewKind("Index out of bounds for regular expression search.").New()
+	}
+	if occurrence < 1 {
+		occurrence = 1
+	}
+
+	// ICU operates on UTF-16 code units, so the starting position must account for characters outside of the BMP,
+	// which take two code units each.
+	icuPos := 1
+	for i, r := range []rune(text) {
+		if i == pos-1 {
+			break
+		}
+		if r > 0xFFFF {
+			icuPos += 2
+		} else {
+			icuPos++
+		}
+	}
+
+	if err = re.SetMatchString(ctx, text); err != nil {
+		return 0, 0, false, err
+	}
+
+	// The regex library doesn't expose the bounds of a match, so we replace only the match we're looking for with a
+	// character that doesn't appear in the text. Its position in the result is the start of the match, and the change
+	// in length gives the length of the match.
+	sentinel := rune(0xE000)
+	for strings.ContainsRune(text, sentinel) {
+		sentinel++
+	}
+	replaced, err := re.Replace(ctx, string(sentinel), icuPos, occurrence)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	idx := strings.IndexRune(replaced, sentinel)
+	if idx < 0 {
+		return 0, 0, false, nil
+	}
+	start = utf8.RuneCountInString(replaced[:idx]) + 1
+	length = textLen - (utf8.RuneCountInString(replaced) - 1)
+	return start, length, true, nil
+}
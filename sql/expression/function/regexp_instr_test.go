@@ -0,0 +1,95 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestRegexpInstrInvalidArgNumber(t *testing.T) {
+	_, err := NewRegexpInstr(
+		expression.NewGetField(0, types.LongText, "str", true),
+	)
+	require.Error(t, err)
+
+	args := make([]sql.Expression, 7)
+	for i := range args {
+		args[i] = expression.NewGetField(i, types.LongText, "arg", true)
+	}
+	_, err = NewRegexpInstr(args...)
+	require.Error(t, err)
+}
+
+func TestRegexpInstr(t *testing.T) {
+	f, err := NewRegexpInstr(
+		expression.NewGetField(0, types.LongText, "str", true),
+		expression.NewGetField(1, types.LongText, "pattern", true),
+		expression.NewGetField(2, types.Int32, "position", true),
+		expression.NewGetField(3, types.Int32, "occurrence", true),
+		expression.NewGetField(4, types.Int32, "return_option", true),
+		expression.NewGetField(5, types.LongText, "match_type", true),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+		err      bool
+	}{
+		{"nil str", sql.NewRow(nil, "dog", 1, 1, 0, "c"), nil, false},
+		{"nil pattern", sql.NewRow("dog cat dog", nil, 1, 1, 0, "c"), nil, false},
+		{"nil position", sql.NewRow("dog cat dog", "dog", nil, 1, 0, "c"), nil, false},
+		{"empty str", sql.NewRow("", "dog", 1, 1, 0, "c"), int32(0), false},
+		{"empty pattern", sql.NewRow("dog cat dog", "", 1, 1, 0, "c"), nil, true},
+		{"zero position", sql.NewRow("dog cat dog", "dog", 0, 1, 0, "c"), nil, true},
+		{"too large position", sql.NewRow("dog cat dog", "dog", 12, 1, 0, "c"), nil, true},
+		{"invalid return option", sql.NewRow("dog cat dog", "dog", 1, 1, 2, "c"), nil, true},
+		{"first match", sql.NewRow("dog cat dog", "dog", 1, 1, 0, "c"), int32(1), false},
+		{"first match end", sql.NewRow("dog cat dog", "dog", 1, 1, 1, "c"), int32(4), false},
+		{"second occurrence", sql.NewRow("dog cat dog", "dog", 1, 2, 0, "c"), int32(9), false},
+		{"second occurrence end", sql.NewRow("dog cat dog", "dog", 1, 2, 1, "c"), int32(12), false},
+		{"later position", sql.NewRow("dog cat dog", "dog", 2, 1, 0, "c"), int32(9), false},
+		{"no match", sql.NewRow("dog cat dog", "dog", 1, 3, 0, "c"), int32(0), false},
+		{"case sensitive", sql.NewRow("dog cat DOG", "DOG", 1, 1, 0, "c"), int32(9), false},
+		{"case insensitive", sql.NewRow("dog cat DOG", "DOG", 1, 1, 0, "i"), int32(1), false},
+		{"multibyte characters", sql.NewRow("héllo wörld", "w", 1, 1, 0, "c"), int32(7), false},
+		{"surrogate pairs", sql.NewRow("😀😀abc😀", "abc", 1, 1, 0, "c"), int32(3), false},
+		{"surrogate pairs end", sql.NewRow("😀😀abc😀", "abc", 1, 1, 1, "c"), int32(6), false},
+		{"surrogate pairs in match", sql.NewRow("ab😀😀c", "b😀+", 1, 1, 1, "c"), int32(5), false},
+		{"surrogate pairs with position", sql.NewRow("😀a😀abc😀", "a", 3, 1, 0, "c"), int32(4), false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			val, err := f.Eval(ctx, tt.row)
+			if tt.err {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Equal(tt.expected, val)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package function
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -192,8 +193,8 @@ func (r *RegexpReplace) Eval(ctx *sql.Context, row sql.Row) (val interface{}, er
 		return nil, sql.ErrInvalidArgumentDetails.New(r.FunctionName(), fmt.Sprintf("%d", _pos))
 	}
 
-	// Handle out of bounds
-	if _pos > len(_str) {
+	// Handle out of bounds, position is measured in characters rather than bytes
+	if _pos > utf8.RuneCountInString(_str) {
 		return nil, errors.NewKind("Index out of bounds for regular expression search.").New()
 	}
 
@@ -270,6 +270,36 @@ func TestRegexpReplaceWithOccurrence(t *testing.T) {
 			"abc def Xhi",
 			false,
 		},
+		{
+			"multibyte zero occurrence",
+			sql.NewRow("ñaña ñaña", `ñ`, "n", 1, 0),
+			"nana nana",
+			false,
+		},
+		{
+			"multibyte specific occurrence",
+			sql.NewRow("ñaña ñaña", `ñ`, "n", 1, 3),
+			"ñaña naña",
+			false,
+		},
+		{
+			"multibyte position and occurrence",
+			sql.NewRow("ñaña ñaña", `a`, "X", 6, 2),
+			"ñaña ñañX",
+			false,
+		},
+		{
+			"multibyte position at last character",
+			sql.NewRow("ñaña", `a`, "X", 4, 0),
+			"ñañX",
+			false,
+		},
+		{
+			"multibyte position out of range",
+			sql.NewRow("ñaña", `a`, "X", 5, 0),
+			nil,
+			true,
+		},
 	}
 
 	for _, tt := range testCases {
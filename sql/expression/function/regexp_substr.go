@@ -0,0 +1,152 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	regex "github.com/dolthub/go-icu-regex"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// RegexpSubstr implements the REGEXP_SUBSTR function.
+// https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-substr
+type RegexpSubstr struct {
+	args []sql.Expression
+
+	re          regex.Regex
+	compileOnce sync.Once
+	compileErr  error
+}
+
+var _ sql.FunctionExpression = (*RegexpSubstr)(nil)
+var _ sql.CollationCoercible = (*RegexpSubstr)(nil)
+var _ sql.Closer = (*RegexpSubstr)(nil)
+
+// NewRegexpSubstr creates a new RegexpSubstr expression.
+func NewRegexpSubstr(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 5 {
+		return nil, sql.ErrInvalidArgumentNumber.New("regexp_substr", "2,3,4 or 5", len(args))
+	}
+	return &RegexpSubstr{args: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *RegexpSubstr) FunctionName() string {
+	return "regexp_substr"
+}
+
+// Description implements sql.FunctionExpression
+func (r *RegexpSubstr) Description() string {
+	return "returns the substring matching regular expression."
+}
+
+// Type implements the sql.Expression interface.
+func (r *RegexpSubstr) Type() sql.Type { return types.LongText }
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (r *RegexpSubstr) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	leftCollation, leftCoercibility := sql.GetCoercibility(ctx, r.args[0])
+	rightCollation, rightCoercibility := sql.GetCoercibility(ctx, r.args[1])
+	return sql.ResolveCoercibility(leftCollation, leftCoercibility, rightCollation, rightCoercibility)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (r *RegexpSubstr) IsNullable() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (r *RegexpSubstr) Children() []sql.Expression {
+	return r.args
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RegexpSubstr) Resolved() bool {
+	for _, arg := range r.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RegexpSubstr) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(r.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.args))
+	}
+	return NewRegexpSubstr(children...)
+}
+
+func (r *RegexpSubstr) String() string {
+	var args []string
+	for _, e := range r.args {
+		args = append(args, e.String())
+	}
+	return fmt.Sprintf("%s(%s)", r.FunctionName(), strings.Join(args, ","))
+}
+
+func (r *RegexpSubstr) flags() sql.Expression {
+	if len(r.args) == 5 {
+		return r.args[4]
+	}
+	return nil
+}
+
+// Eval implements the sql.Expression interface.
+func (r *RegexpSubstr) Eval(ctx *sql.Context, row sql.Row) (val interface{}, err error) {
+	span, ctx := ctx.Span("function.RegexpSubstr")
+	defer span.End()
+
+	text, err := evalRegexpText(ctx, r.args[0], row)
+	if err != nil || text == nil {
+		return nil, err
+	}
+
+	re, cached, err := regexpForRow(ctx, r.args[1], r.args[0], r.flags(), r.FunctionName(), row, &r.compileOnce, &r.re, &r.compileErr)
+	if err != nil || re == nil {
+		return nil, err
+	}
+	if !cached {
+		defer func() {
+			if nErr := re.Close(); err == nil {
+				err = nErr
+			}
+		}()
+	}
+
+	pos, occ, isNull, err := evalRegexpPosAndOccurrence(ctx, r.args, row)
+	if err != nil || isNull {
+		return nil, err
+	}
+
+	start, length, ok, err := regexpSearch(ctx, re, *text, pos, occ, r.FunctionName())
+	if err != nil || !ok {
+		return nil, err
+	}
+	runes := []rune(*text)
+	return string(runes[start-1 : start-1+length]), nil
+}
+
+// Close implements the sql.Closer interface.
+func (r *RegexpSubstr) Close(ctx *sql.Context) error {
+	if r.re != nil {
+		return r.re.Close()
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestRegexpSubstrInvalidArgNumber(t *testing.T) {
+	_, err := NewRegexpSubstr(
+		expression.NewGetField(0, types.LongText, "str", true),
+	)
+	require.Error(t, err)
+
+	args := make([]sql.Expression, 6)
+	for i := range args {
+		args[i] = expression.NewGetField(i, types.LongText, "arg", true)
+	}
+	_, err = NewRegexpSubstr(args...)
+	require.Error(t, err)
+}
+
+func TestRegexpSubstr(t *testing.T) {
+	f, err := NewRegexpSubstr(
+		expression.NewGetField(0, types.LongText, "str", true),
+		expression.NewGetField(1, types.LongText, "pattern", true),
+		expression.NewGetField(2, types.Int32, "position", true),
+		expression.NewGetField(3, types.Int32, "occurrence", true),
+		expression.NewGetField(4, types.LongText, "match_type", true),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+		err      bool
+	}{
+		{"nil str", sql.NewRow(nil, "[a-z]+", 1, 1, "c"), nil, false},
+		{"nil pattern", sql.NewRow("abc def ghi", nil, 1, 1, "c"), nil, false},
+		{"nil occurrence", sql.NewRow("abc def ghi", "[a-z]+", 1, nil, "c"), nil, false},
+		{"empty str", sql.NewRow("", "[a-z]+", 1, 1, "c"), nil, false},
+		{"empty pattern", sql.NewRow("abc def ghi", "", 1, 1, "c"), nil, true},
+		{"zero position", sql.NewRow("abc def ghi", "[a-z]+", 0, 1, "c"), nil, true},
+		{"too large position", sql.NewRow("abc def ghi", "[a-z]+", 12, 1, "c"), nil, true},
+		{"first match", sql.NewRow("abc def ghi", "[a-z]+", 1, 1, "c"), "abc", false},
+		{"third occurrence", sql.NewRow("abc def ghi", "[a-z]+", 1, 3, "c"), "ghi", false},
+		{"no match", sql.NewRow("abc def ghi", "[a-z]+", 1, 4, "c"), nil, false},
+		{"later position", sql.NewRow("abc def ghi", "[a-z]+", 3, 1, "c"), "c", false},
+		{"case insensitive", sql.NewRow("ABC def", "[a-z]+", 1, 1, "i"), "ABC", false},
+		{"multibyte characters", sql.NewRow("héllo wörld", "w.r", 1, 1, "c"), "wör", false},
+		{"surrogate pairs", sql.NewRow("😀😀abc😀x", "c.", 1, 1, "c"), "c😀", false},
+		{"surrogate pairs with position", sql.NewRow("😀é😀", ".", 2, 1, "c"), "é", false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			val, err := f.Eval(ctx, tt.row)
+			if tt.err {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Equal(tt.expected, val)
+			}
+		})
+	}
+}
@@ -185,8 +185,10 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "quarter", Fn: NewQuarter},
 	sql.Function1{Name: "radians", Fn: NewRadians},
 	sql.FunctionN{Name: "rand", Fn: NewRand},
+	sql.FunctionN{Name: "regexp_instr", Fn: NewRegexpInstr},
 	sql.FunctionN{Name: "regexp_like", Fn: NewRegexpLike},
 	sql.FunctionN{Name: "regexp_replace", Fn: NewRegexpReplace},
+	sql.FunctionN{Name: "regexp_substr", Fn: NewRegexpSubstr},
 	sql.Function2{Name: "repeat", Fn: NewRepeat},
 	sql.Function3{Name: "replace", Fn: NewReplace},
 	sql.Function1{Name: "reverse", Fn: NewReverse},
@@ -199,6 +201,7 @@ var BuiltIns = []sql.Function{
 	sql.Function0{Name: "dense_rank", Fn: window.NewDenseRank},
 	sql.Function1{Name: "first_value", Fn: window.NewFirstValue},
 	sql.Function1{Name: "last_value", Fn: window.NewLastValue},
+	sql.FunctionN{Name: "nth_value", Fn: window.NewNthValue},
 	sql.Function1{Name: "random_bytes", Fn: NewRandomBytes},
 	sql.FunctionN{Name: "rpad", Fn: NewRightPad},
 	sql.Function1{Name: "rtrim", Fn: NewRightTrim},
@@ -229,6 +232,7 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "st_aswkb", Fn: spatial.NewAsWKB},
 	sql.Function1{Name: "st_aswkt", Fn: spatial.NewAsWKT},
 	sql.Function1{Name: "st_astext", Fn: spatial.NewAsWKT},
+	sql.Function2{Name: "st_contains", Fn: spatial.NewContains},
 	sql.FunctionN{Name: "st_distance", Fn: spatial.NewDistance},
 	sql.Function1{Name: "st_dimension", Fn: spatial.NewDimension},
 	sql.Function2{Name: "st_equal", Fn: spatial.NewSTEquals},
@@ -328,11 +332,18 @@ func NewRegistry() Registry {
 	return fr
 }
 
-// Register registers functions, returning an error if it's already registered
+// Register registers functions, returning an error if it's already registered. An sql.OverloadedFunction with the same
+// name as a registered sql.OverloadedFunction adds its overloads to the registered function instead.
 func (r Registry) Register(fn ...sql.Function) error {
 	for _, f := range fn {
-		if _, ok := r[f.FunctionName()]; ok {
-			return ErrFunctionAlreadyRegistered.New(f.FunctionName())
+		if existing, ok := r[f.FunctionName()]; ok {
+			existingOverloads, ok := existing.(sql.OverloadedFunction)
+			newOverloads, ok2 := f.(sql.OverloadedFunction)
+			if !ok || !ok2 {
+				return ErrFunctionAlreadyRegistered.New(f.FunctionName())
+			}
+			r[f.FunctionName()] = existingOverloads.WithOverloads(newOverloads.Overloads...)
+			continue
 		}
 		r[f.FunctionName()] = f
 	}
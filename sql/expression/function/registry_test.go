@@ -0,0 +1,49 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestRegistryRegisterOverloads(t *testing.T) {
+	require := require.New(t)
+	r := NewRegistry()
+
+	fn := func(args ...sql.Expression) (sql.Expression, error) { return args[0], nil }
+	require.NoError(r.Register(sql.NewOverloadedFunction("f",
+		sql.FunctionOverload{ArgTypes: []sql.Type{types.Int64}, Fn: fn},
+	)))
+	require.NoError(r.Register(sql.NewOverloadedFunction("f",
+		sql.FunctionOverload{ArgTypes: []sql.Type{types.LongText}, Fn: fn},
+	)))
+
+	f, err := r.Function(sql.NewEmptyContext(), "f")
+	require.NoError(err)
+	require.Len(f.(sql.OverloadedFunction).Overloads, 2)
+
+	// overloads can't be added to functions that aren't overloaded, and vice versa
+	err = r.Register(sql.NewOverloadedFunction("abs",
+		sql.FunctionOverload{ArgTypes: []sql.Type{types.LongText}, Fn: fn},
+	))
+	require.True(ErrFunctionAlreadyRegistered.Is(err))
+	err = r.Register(sql.FunctionN{Name: "f", Fn: fn})
+	require.True(ErrFunctionAlreadyRegistered.Is(err))
+}
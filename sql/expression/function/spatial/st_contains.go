@@ -0,0 +1,93 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Contains is a function that returns true if left spatially contains right
+type Contains struct {
+	expression.BinaryExpressionStub
+}
+
+var _ sql.FunctionExpression = (*Contains)(nil)
+var _ sql.CollationCoercible = (*Contains)(nil)
+
+// NewContains creates a new Contains expression.
+func NewContains(g1, g2 sql.Expression) sql.Expression {
+	return &Contains{
+		expression.BinaryExpressionStub{
+			LeftChild:  g1,
+			RightChild: g2,
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (c *Contains) FunctionName() string {
+	return "st_contains"
+}
+
+// Description implements sql.FunctionExpression
+func (c *Contains) Description() string {
+	return "returns 1 or 0 to indicate whether g1 completely contains g2. This tests the opposite relationship as st_within()."
+}
+
+// Type implements the sql.Expression interface.
+func (c *Contains) Type() sql.Type {
+	return types.Boolean
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*Contains) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+func (c *Contains) String() string {
+	return fmt.Sprintf("%s(%s,%s)", c.FunctionName(), c.LeftChild, c.RightChild)
+}
+
+// WithChildren implements the Expression interface.
+func (c *Contains) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 2)
+	}
+	return NewContains(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (c *Contains) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	geom1, err := c.LeftChild.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	geom2, err := c.RightChild.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	g1, g2, err := validateGeomComp(geom1, geom2, c.FunctionName())
+	if err != nil {
+		return nil, err
+	}
+	if g1 == nil || g2 == nil {
+		return nil, nil
+	}
+	return isWithin(g2, g1), nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatial
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestContains(t *testing.T) {
+	smallSquare := types.Polygon{Lines: []types.LineString{squareWithHole.Lines[1]}}
+	// a square between the outer ring and the hole of squareWithHole
+	sideSquare := types.Polygon{Lines: []types.LineString{{Points: []types.Point{{X: 2, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: 4}}}}}
+	farSquare := types.Polygon{Lines: []types.LineString{{Points: []types.Point{{X: 100, Y: 100}, {X: 101, Y: 100}, {X: 101, Y: 99}, {X: 100, Y: 100}}}}}
+	// a triangle sharing a side with square
+	corner := types.Polygon{Lines: []types.LineString{{Points: []types.Point{{X: 0, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 0}, {X: 0, Y: 4}}}}}
+
+	tests := []struct {
+		name     string
+		g1       types.GeometryValue
+		g2       types.GeometryValue
+		expected interface{}
+	}{
+		{
+			name:     "polygon contains point in interior",
+			g1:       square,
+			g2:       types.Point{X: 1, Y: 1},
+			expected: true,
+		},
+		{
+			name:     "polygon does not contain point on boundary",
+			g1:       square,
+			g2:       types.Point{X: 4, Y: 0},
+			expected: false,
+		},
+		{
+			name:     "polygon does not contain point on vertex",
+			g1:       square,
+			g2:       types.Point{X: 4, Y: 4},
+			expected: false,
+		},
+		{
+			name:     "polygon does not contain point in hole",
+			g1:       squareWithHole,
+			g2:       types.Point{},
+			expected: false,
+		},
+		{
+			name:     "polygon does not contain point on boundary of hole",
+			g1:       squareWithHole,
+			g2:       types.Point{X: 2, Y: 0},
+			expected: false,
+		},
+		{
+			name:     "polygon contains polygon fully inside it",
+			g1:       square,
+			g2:       smallSquare,
+			expected: true,
+		},
+		{
+			name:     "polygon does not contain polygon containing it",
+			g1:       smallSquare,
+			g2:       square,
+			expected: false,
+		},
+		{
+			name:     "polygon contains itself",
+			g1:       square,
+			g2:       square,
+			expected: true,
+		},
+		{
+			name:     "polygon contains polygon sharing part of its boundary",
+			g1:       square,
+			g2:       corner,
+			expected: true,
+		},
+		{
+			name:     "polygon contains polygon touching its boundary at vertices",
+			g1:       square,
+			g2:       diamond,
+			expected: true,
+		},
+		{
+			name:     "polygon does not contain polygon overlapping its hole",
+			g1:       squareWithHole,
+			g2:       smallSquare,
+			expected: false,
+		},
+		{
+			name:     "polygon does not contain polygon around its hole",
+			g1:       squareWithHole,
+			g2:       diamond,
+			expected: false,
+		},
+		{
+			name:     "polygon with hole contains polygon outside of the hole",
+			g1:       squareWithHole,
+			g2:       sideSquare,
+			expected: true,
+		},
+		{
+			name:     "polygon contains polygon with a hole",
+			g1:       square,
+			g2:       squareWithHole,
+			expected: true,
+		},
+		{
+			name:     "polygon contains linestring crossing its interior",
+			g1:       square,
+			g2:       types.LineString{Points: []types.Point{{X: -4, Y: -4}, {X: 4, Y: 4}}},
+			expected: true,
+		},
+		{
+			name:     "polygon does not contain its boundary",
+			g1:       square,
+			g2:       square.Lines[0],
+			expected: false,
+		},
+		{
+			name:     "polygon does not contain linestring crossing its hole",
+			g1:       squareWithHole,
+			g2:       types.LineString{Points: []types.Point{{X: -3, Y: 0}, {X: 3, Y: 0}}},
+			expected: false,
+		},
+		{
+			name:     "multipolygon contains polygon in one of its polygons",
+			g1:       types.MultiPolygon{Polygons: []types.Polygon{farSquare, square}},
+			g2:       smallSquare,
+			expected: true,
+		},
+		{
+			name:     "point does not contain polygon",
+			g1:       types.Point{},
+			g2:       emptyPolygon,
+			expected: false,
+		},
+		{
+			name:     "polygon contains empty geometry collection returns null",
+			g1:       square,
+			g2:       types.GeomColl{},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			f := NewContains(expression.NewLiteral(tt.g1, types.GeometryType{}), expression.NewLiteral(tt.g2, types.GeometryType{}))
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+
+			// contains is the opposite relationship of within
+			f = NewWithin(expression.NewLiteral(tt.g2, types.GeometryType{}), expression.NewLiteral(tt.g1, types.GeometryType{}))
+			v, err = f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+
+	t.Run("different SRIDs", func(t *testing.T) {
+		require := require.New(t)
+		p := types.Point{SRID: types.GeoSpatialSRID, X: 1, Y: 1}
+		f := NewContains(expression.NewLiteral(square, types.PolygonType{}), expression.NewLiteral(p, types.PointType{}))
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.True(sql.ErrDiffSRIDs.Is(err))
+	})
+
+	t.Run("null geometry returns null", func(t *testing.T) {
+		require := require.New(t)
+		f := NewContains(expression.NewLiteral(nil, types.Null), expression.NewLiteral(square, types.PolygonType{}))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Nil(v)
+	})
+}
@@ -26,7 +26,9 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-// Distance is a function that returns the shortest distance between two geometries
+// Distance is a function that returns the shortest distance between two geometries. The distance between geometries
+// in SRID 0 is Cartesian, and between geometries in SRID 4326 it's measured in metres along the WGS 84 ellipsoid, or in
+// the unit of measure given as the optional third argument.
 type Distance struct {
 	expression.NaryExpression
 }
@@ -37,6 +39,9 @@ var _ sql.CollationCoercible = (*Distance)(nil)
 // ErrNoUnits is thrown when the specified SRID does not have units
 var ErrNoUnits = errors.NewKind("the geometry passed to function st_distance is in SRID %v, which doesn't specify a length unit. Can't convert to '%v'.")
 
+// ErrUnitNotFound is thrown when the unit given isn't a unit of measure in information_schema.ST_UNITS_OF_MEASURE
+var ErrUnitNotFound = errors.NewKind("There's no unit of measure named '%s'.")
+
 // NewDistance creates a new Distance expression.
 func NewDistance(args ...sql.Expression) (sql.Expression, error) {
 	if len(args) != 2 && len(args) != 3 {
@@ -78,66 +83,247 @@ func (d *Distance) WithChildren(children ...sql.Expression) (sql.Expression, err
 	return NewDistance(children...)
 }
 
-// flattenGeometry recursively "flattens" the geometry value into a map of its points
-func flattenGeometry(g types.GeometryValue, points map[types.Point]bool) {
+// segment is a line segment of a geometry. A point is a segment whose ends are the same point.
+type segment struct {
+	a, b types.Point
+}
+
+// geometrySegments appends the points and line segments that make up the geometry value given to |segs|.
+func geometrySegments(g types.GeometryValue, segs []segment) []segment {
 	switch g := g.(type) {
 	case types.Point:
-		points[g] = true
+		segs = append(segs, segment{g, g})
 	case types.LineString:
-		for _, p := range g.Points {
-			flattenGeometry(p, points)
+		if len(g.Points) == 1 {
+			segs = append(segs, segment{g.Points[0], g.Points[0]})
+		}
+		for i := 1; i < len(g.Points); i++ {
+			segs = append(segs, segment{g.Points[i-1], g.Points[i]})
 		}
 	case types.Polygon:
 		for _, l := range g.Lines {
-			flattenGeometry(l, points)
+			segs = geometrySegments(l, segs)
 		}
 	case types.MultiPoint:
 		for _, p := range g.Points {
-			flattenGeometry(p, points)
+			segs = geometrySegments(p, segs)
 		}
 	case types.MultiLineString:
 		for _, l := range g.Lines {
-			flattenGeometry(l, points)
+			segs = geometrySegments(l, segs)
 		}
 	case types.MultiPolygon:
 		for _, p := range g.Polygons {
-			flattenGeometry(p, points)
+			segs = geometrySegments(p, segs)
 		}
 	case types.GeomColl:
 		for _, gg := range g.Geoms {
-			flattenGeometry(gg, points)
+			segs = geometrySegments(gg, segs)
 		}
 	}
+	return segs
 }
 
 // calcPointDist calculates the distance between two points
-// Small Optimization: don't do square root
 func calcPointDist(a, b types.Point) float64 {
 	dx := b.X - a.X
 	dy := b.Y - a.Y
 	return math.Sqrt(dx*dx + dy*dy)
 }
 
-// calcDist finds the minimum distance from a Point in g1 to a Point g2
-func calcDist(g1, g2 types.GeometryValue) interface{} {
-	points1, points2 := map[types.Point]bool{}, map[types.Point]bool{}
-	flattenGeometry(g1, points1)
-	flattenGeometry(g2, points2)
+// calcPointSegmentDist calculates the distance between the point p and the closest point of the segment ab
+func calcPointSegmentDist(p, a, b types.Point) float64 {
+	dx := b.X - a.X
+	dy := b.Y - a.Y
+	if dx == 0 && dy == 0 {
+		return calcPointDist(p, a)
+	}
+	// t is the position of the projection of p on the line through ab, where a is 0 and b is 1
+	t := ((p.X-a.X)*dx + (p.Y-a.Y)*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+	return calcPointDist(p, types.Point{X: a.X + t*dx, Y: a.Y + t*dy})
+}
 
-	if len(points1) == 0 || len(points2) == 0 {
+// calcSegmentDist calculates the shortest distance between the two segments given, using |pointSegmentDist| for the
+// distance between a point and a segment. The segments must not intersect, so that one of them is closest to the other
+// at one of its ends.
+func calcSegmentDist(s1, s2 segment, pointSegmentDist func(p, a, b types.Point) float64) float64 {
+	return math.Min(
+		math.Min(pointSegmentDist(s1.a, s2.a, s2.b), pointSegmentDist(s1.b, s2.a, s2.b)),
+		math.Min(pointSegmentDist(s2.a, s1.a, s1.b), pointSegmentDist(s2.b, s1.a, s1.b)),
+	)
+}
+
+// calcDist finds the minimum distance between g1 and g2, using |pointSegmentDist| for the distance between a point and
+// a segment. The distance is 0 if the geometries intersect, and nil if either of them is empty.
+func calcDist(g1, g2 types.GeometryValue, pointSegmentDist func(p, a, b types.Point) float64) interface{} {
+	segs1, segs2 := geometrySegments(g1, nil), geometrySegments(g2, nil)
+	if len(segs1) == 0 || len(segs2) == 0 {
 		return nil
 	}
 
+	if isIntersects(g1, g2) {
+		return 0.0
+	}
+
 	minDist := math.MaxFloat64
-	for a := range points1 {
-		for b := range points2 {
-			minDist = math.Min(minDist, calcPointDist(a, b))
+	for _, a := range segs1 {
+		for _, b := range segs2 {
+			minDist = math.Min(minDist, calcSegmentDist(a, b, pointSegmentDist))
 		}
 	}
 
 	return minDist
 }
 
+// The semi-major axis and flattening of the WGS 84 ellipsoid, which SRID 4326 is defined on.
+const (
+	wgs84SemiMajorAxis = 6378137.0
+	wgs84Flattening    = 1 / 298.257223563
+)
+
+// calcGeographicPointDist calculates the distance in metres between two points in SRID 4326 along the WGS 84
+// ellipsoid, using Vincenty's inverse formula. The X and Y of the points are their latitude and longitude in degrees.
+// Reference: https://en.wikipedia.org/wiki/Vincenty%27s_formulae
+func calcGeographicPointDist(p1, p2 types.Point) float64 {
+	const a = wgs84SemiMajorAxis
+	const f = wgs84Flattening
+	const b = a * (1 - f)
+
+	L := (p2.Y - p1.Y) * math.Pi / 180
+	U1 := math.Atan((1 - f) * math.Tan(p1.X*math.Pi/180))
+	U2 := math.Atan((1 - f) * math.Tan(p2.X*math.Pi/180))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	lambda := L
+	var sinSigma, cosSigma, sigma, cosSqAlpha, cos2SigmaM float64
+	converged := false
+	for i := 0; i < 200; i++ {
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma = math.Sqrt((cosU2*sinLambda)*(cosU2*sinLambda) +
+			(cosU1*sinU2-sinU1*cosU2*cosLambda)*(cosU1*sinU2-sinU1*cosU2*cosLambda))
+		if sinSigma == 0 {
+			// the points are the same
+			return 0
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		if cosSqAlpha != 0 {
+			// both points are on the equator otherwise
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+		prevLambda := lambda
+		lambda = L + (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-prevLambda) < 1e-12 {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		// the formula doesn't converge for nearly antipodal points, which are about half the ellipsoid's
+		// circumference apart
+		return vectorAngle(toUnitVector(p1), toUnitVector(p2)) * a
+	}
+
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+	return b * A * (sigma - deltaSigma)
+}
+
+// unitVector is a point on the unit sphere.
+type unitVector [3]float64
+
+// toUnitVector returns the point on the unit sphere at the latitude and longitude of the point in SRID 4326 given.
+func toUnitVector(p types.Point) unitVector {
+	sinLat, cosLat := math.Sincos(p.X * math.Pi / 180)
+	sinLon, cosLon := math.Sincos(p.Y * math.Pi / 180)
+	return unitVector{cosLat * cosLon, cosLat * sinLon, sinLat}
+}
+
+// toGeographicPoint returns the point in SRID 4326 at the latitude and longitude of the vector given.
+func toGeographicPoint(v unitVector) types.Point {
+	return types.Point{
+		SRID: types.GeoSpatialSRID,
+		X:    math.Atan2(v[2], math.Hypot(v[0], v[1])) * 180 / math.Pi,
+		Y:    math.Atan2(v[1], v[0]) * 180 / math.Pi,
+	}
+}
+
+func (v unitVector) dot(u unitVector) float64 {
+	return v[0]*u[0] + v[1]*u[1] + v[2]*u[2]
+}
+
+func (v unitVector) cross(u unitVector) unitVector {
+	return unitVector{v[1]*u[2] - v[2]*u[1], v[2]*u[0] - v[0]*u[2], v[0]*u[1] - v[1]*u[0]}
+}
+
+func (v unitVector) norm() float64 {
+	return math.Sqrt(v.dot(v))
+}
+
+// vectorAngle returns the angle in radians between two points on the unit sphere.
+func vectorAngle(v, u unitVector) float64 {
+	return math.Atan2(v.cross(u).norm(), v.dot(u))
+}
+
+// calcGeographicPointSegmentDist calculates the distance in metres between the point p and the closest point of the
+// segment ab, all of them in SRID 4326. The closest point is found on the great circle arc from a to b, and its
+// distance to p is then measured along the WGS 84 ellipsoid.
+func calcGeographicPointSegmentDist(p, a, b types.Point) float64 {
+	if a == b {
+		return calcGeographicPointDist(p, a)
+	}
+	va, vb, vp := toUnitVector(a), toUnitVector(b), toUnitVector(p)
+	n := va.cross(vb)
+	nNorm := n.norm()
+	if nNorm == 0 {
+		// a and b are the same or antipodal, so the arc between them isn't defined
+		return math.Min(calcGeographicPointDist(p, a), calcGeographicPointDist(p, b))
+	}
+	for i := range n {
+		n[i] /= nNorm
+	}
+
+	// project p onto the plane of the great circle through a and b
+	d := vp.dot(n)
+	proj := unitVector{vp[0] - d*n[0], vp[1] - d*n[1], vp[2] - d*n[2]}
+	projNorm := proj.norm()
+	if projNorm != 0 {
+		for i := range proj {
+			proj[i] /= projNorm
+		}
+		// the projection is the closest point if it lies on the arc, between a and b
+		if va.cross(proj).dot(n) >= 0 && proj.cross(vb).dot(n) >= 0 {
+			return calcGeographicPointDist(p, toGeographicPoint(proj))
+		}
+	}
+	return math.Min(calcGeographicPointDist(p, a), calcGeographicPointDist(p, b))
+}
+
+// validateGeographicPoints checks that the latitude and longitude of every point of the geometry given, which is in
+// SRID 4326, are within range.
+func validateGeographicPoints(g types.GeometryValue, funcName string) error {
+	for _, s := range geometrySegments(g, nil) {
+		for _, p := range []types.Point{s.a, s.b} {
+			if p.X < -90 || p.X > 90 {
+				return ErrLatitudeOutOfRange.New(p.X, funcName)
+			}
+			if p.Y < -180 || p.Y > 180 {
+				return ErrLongitudeOutOfRange.New(p.Y, funcName)
+			}
+		}
+	}
+	return nil
+}
+
 // Eval implements the sql.Expression interface.
 func (d *Distance) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	g1, err := d.ChildExpressions[0].Eval(ctx, row)
@@ -170,18 +356,47 @@ func (d *Distance) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, sql.ErrDiffSRIDs.New(d.FunctionName(), srid1, srid2)
 	}
 
-	if srid1 != types.CartesianSRID {
+	if srid1 != types.CartesianSRID && srid1 != types.GeoSpatialSRID {
 		return nil, sql.ErrUnsupportedSRID.New(srid1)
 	}
 
-	dist := calcDist(geom1, geom2)
-
+	// the distance is in metres, unless another unit is given
+	conversionFactor := 1.0
 	if len(d.ChildExpressions) == 3 {
+		u, err := d.ChildExpressions[2].Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if u == nil {
+			return nil, nil
+		}
+		unitName, _, err := types.LongText.Convert(u)
+		if err != nil {
+			return nil, err
+		}
 		if srid1 == types.CartesianSRID {
-			return nil, ErrNoUnits.New(srid1)
+			return nil, ErrNoUnits.New(srid1, unitName)
+		}
+		unit, ok := types.LookupUnitOfMeasure(unitName.(string))
+		if !ok {
+			return nil, ErrUnitNotFound.New(unitName)
 		}
-		// TODO: check valid unit arguments
+		conversionFactor = unit.ConversionFactor
 	}
 
-	return dist, nil
+	if srid1 == types.CartesianSRID {
+		return calcDist(geom1, geom2, calcPointSegmentDist), nil
+	}
+
+	if err = validateGeographicPoints(geom1, d.FunctionName()); err != nil {
+		return nil, err
+	}
+	if err = validateGeographicPoints(geom2, d.FunctionName()); err != nil {
+		return nil, err
+	}
+	dist := calcDist(geom1, geom2, calcGeographicPointSegmentDist)
+	if dist == nil {
+		return nil, nil
+	}
+	return dist.(float64) / conversionFactor, nil
 }
@@ -65,15 +65,103 @@ func TestDistance(t *testing.T) {
 		require.Equal(0.0, v)
 	})
 
-	t.Run("non-cartesian SRIDs error", func(t *testing.T) {
+	t.Run("point distance from line", func(t *testing.T) {
+		require := require.New(t)
+		p := types.Point{X: 1, Y: 1}
+		l := types.LineString{Points: []types.Point{{X: 0, Y: 0}, {X: 2, Y: 0}}}
+		f, err := NewDistance(expression.NewLiteral(p, types.PointType{}), expression.NewLiteral(l, types.LineStringType{}))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(1.0, v)
+	})
+
+	t.Run("line distance from polygon", func(t *testing.T) {
+		require := require.New(t)
+		l := types.LineString{Points: []types.Point{{X: 3, Y: -1}, {X: 3, Y: 3}}}
+		poly := types.Polygon{Lines: []types.LineString{{Points: []types.Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}, {X: 0, Y: 0}}}}}
+		f, err := NewDistance(expression.NewLiteral(l, types.LineStringType{}), expression.NewLiteral(poly, types.PolygonType{}))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(2.0, v)
+	})
+
+	t.Run("point within polygon", func(t *testing.T) {
+		require := require.New(t)
+		p := types.Point{X: 0.5, Y: 0.5}
+		poly := types.Polygon{Lines: []types.LineString{{Points: []types.Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}, {X: 0, Y: 0}}}}}
+		f, err := NewDistance(expression.NewLiteral(p, types.PointType{}), expression.NewLiteral(poly, types.PolygonType{}))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(0.0, v)
+	})
+
+	t.Run("geographic point distance", func(t *testing.T) {
 		require := require.New(t)
 		p1 := types.Point{SRID: types.GeoSpatialSRID, X: 0, Y: 0}
-		p2 := types.Point{SRID: types.GeoSpatialSRID, X: 0, Y: 0}
+		p2 := types.Point{SRID: types.GeoSpatialSRID, X: 0, Y: 1}
+		f, err := NewDistance(expression.NewLiteral(p1, types.PointType{}), expression.NewLiteral(p2, types.PointType{}))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.InDelta(111319.49079327357, v, 1e-6)
+	})
+
+	t.Run("geographic point distance between cities", func(t *testing.T) {
+		require := require.New(t)
+		// Paris and New York, as latitude and longitude
+		p1 := types.Point{SRID: types.GeoSpatialSRID, X: 48.8566, Y: 2.3522}
+		p2 := types.Point{SRID: types.GeoSpatialSRID, X: 40.7128, Y: -74.006}
+		f, err := NewDistance(expression.NewLiteral(p1, types.PointType{}), expression.NewLiteral(p2, types.PointType{}), expression.NewLiteral("kilometre", types.LongText))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.InDelta(5852.0, v, 1.0)
+	})
+
+	t.Run("geographic point distance from line", func(t *testing.T) {
+		require := require.New(t)
+		p := types.Point{SRID: types.GeoSpatialSRID, X: 1, Y: 1}
+		l := types.LineString{SRID: types.GeoSpatialSRID, Points: []types.Point{
+			{SRID: types.GeoSpatialSRID, X: 0, Y: 0},
+			{SRID: types.GeoSpatialSRID, X: 0, Y: 2},
+		}}
+		f, err := NewDistance(expression.NewLiteral(p, types.PointType{}), expression.NewLiteral(l, types.LineStringType{}))
+		require.NoError(err)
+
+		// the closest point of the line is on the equator, one degree of latitude away
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.InDelta(110574.0, v, 1.0)
+	})
+
+	t.Run("geographic unit not found", func(t *testing.T) {
+		require := require.New(t)
+		p1 := types.Point{SRID: types.GeoSpatialSRID, X: 0, Y: 0}
+		p2 := types.Point{SRID: types.GeoSpatialSRID, X: 0, Y: 1}
+		f, err := NewDistance(expression.NewLiteral(p1, types.PointType{}), expression.NewLiteral(p2, types.PointType{}), expression.NewLiteral("parsec", types.LongText))
+		require.NoError(err)
+
+		_, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.True(ErrUnitNotFound.Is(err))
+	})
+
+	t.Run("geographic latitude out of range", func(t *testing.T) {
+		require := require.New(t)
+		p1 := types.Point{SRID: types.GeoSpatialSRID, X: 91, Y: 0}
+		p2 := types.Point{SRID: types.GeoSpatialSRID, X: 0, Y: 1}
 		f, err := NewDistance(expression.NewLiteral(p1, types.PointType{}), expression.NewLiteral(p2, types.PointType{}))
 		require.NoError(err)
 
 		_, err = f.Eval(sql.NewEmptyContext(), nil)
-		require.Error(err)
+		require.True(ErrLatitudeOutOfRange.Is(err))
 	})
 
 	t.Run("different SRIDs error", func(t *testing.T) {
@@ -87,15 +175,15 @@ func TestDistance(t *testing.T) {
 		require.Error(err)
 	})
 
-	t.Run("geospatial SRID unsupported", func(t *testing.T) {
+	t.Run("other SRIDs unsupported", func(t *testing.T) {
 		require := require.New(t)
-		p1 := types.Point{SRID: types.GeoSpatialSRID, X: 0, Y: 0}
-		p2 := types.Point{SRID: types.GeoSpatialSRID, X: 0, Y: 0}
+		p1 := types.Point{SRID: 3857, X: 0, Y: 0}
+		p2 := types.Point{SRID: 3857, X: 0, Y: 0}
 		f, err := NewDistance(expression.NewLiteral(p1, types.PointType{}), expression.NewLiteral(p2, types.PointType{}))
 		require.NoError(err)
 
 		_, err = f.Eval(sql.NewEmptyContext(), nil)
-		require.Error(err)
+		require.True(sql.ErrUnsupportedSRID.Is(err))
 	})
 
 	t.Run("cartesian has no units", func(t *testing.T) {
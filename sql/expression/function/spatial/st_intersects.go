@@ -260,6 +260,14 @@ func isPolyIntersects(p types.Polygon, g types.GeometryValue) bool {
 				}
 			}
 		}
+		// the polygons can cross each other with no vertex of either inside the other
+		for _, l1 := range p.Lines {
+			for _, l2 := range g.Lines {
+				if isLineIntersectLine(l1, l2) {
+					return true
+				}
+			}
+		}
 	case types.MultiPoint:
 		for _, point := range g.Points {
 			if isPolyIntersects(p, point) {
@@ -523,6 +523,18 @@ func TestPolygonIntersectsPolygon(t *testing.T) {
 		require.Equal(true, v)
 	})
 
+	t.Run("polygons crossing without a vertex inside each other intersect", func(t *testing.T) {
+		require := require.New(t)
+		// a wide and a tall rectangle forming a cross
+		wide := types.Polygon{Lines: []types.LineString{{Points: []types.Point{{X: -4, Y: 1}, {X: 4, Y: 1}, {X: 4, Y: -1}, {X: -4, Y: -1}, {X: -4, Y: 1}}}}}
+		tall := types.Polygon{Lines: []types.LineString{{Points: []types.Point{{X: -1, Y: 4}, {X: 1, Y: 4}, {X: 1, Y: -4}, {X: -1, Y: -4}, {X: -1, Y: 4}}}}}
+
+		f := NewIntersects(expression.NewLiteral(wide, types.PolygonType{}), expression.NewLiteral(tall, types.PolygonType{}))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(true, v)
+	})
+
 	t.Run("polygon does not intersect polygon", func(t *testing.T) {
 		require := require.New(t)
 
@@ -17,6 +17,7 @@ package spatial
 import (
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -172,10 +173,11 @@ func isPointWithin(p types.Point, g types.GeometryValue) bool {
 			}
 		}
 	case types.MultiLineString:
-		// Point is considered within MultiLineString if it is within at least one LineString
+		// Point is considered within MultiLineString if it is on at least one LineString
 		// Edge Case: If point is a terminal point for an odd number of lines,
 		//            then it's not within the entire MultiLineString.
-		//            This is the case regardless of how many other LineStrings the point is in
+		//            This is the case regardless of how many other LineStrings the point is in.
+		//            A terminal point of an even number of lines is within it.
 		isOddTerminalPoint := false
 		for _, l := range g.Lines {
 			if isTerminalPoint(p, l) {
@@ -187,7 +189,7 @@ func isPointWithin(p types.Point, g types.GeometryValue) bool {
 		}
 
 		for _, l := range g.Lines {
-			if isPointWithin(p, l) {
+			if isPointIntersectLine(p, l) {
 				return true
 			}
 		}
@@ -209,19 +211,237 @@ func isPointWithin(p types.Point, g types.GeometryValue) bool {
 	return false
 }
 
+// isWithin returns whether g1 is spatially within g2, which is when no point of g1 is in the exterior of g2, and some
+// point of the interior of g1 is in the interior of g2.
 func isWithin(g1, g2 types.GeometryValue) bool {
+	covered, interior := withinParts(g1, g2)
+	return covered && interior
+}
+
+// withinParts returns whether every point of g1 intersects g2, and whether some point of the interior of g1 is in the
+// interior of g2. Each Geometry of a collection g1 is considered on its own. A LineString or Polygon whose points are
+// all the same is considered as that Point, though never within a Geometry of a lower dimension.
+func withinParts(g1, g2 types.GeometryValue) (covered, interior bool) {
 	switch g1 := g1.(type) {
 	case types.Point:
-		return isPointWithin(g1, g2)
+		return isPointIntersects(g1, g2), isPointWithin(g1, g2)
 	case types.LineString:
+		if dimension(g2) < 1 || len(g1.Points) == 0 {
+			return false, false
+		}
+		if p, ok := degeneratePoint(g1.Points); ok {
+			return withinParts(p, g2)
+		}
+		return lineWithinParts(g1, g2)
 	case types.Polygon:
+		if dimension(g2) < 2 || len(g1.Lines) == 0 {
+			return false, false
+		}
+		var points []types.Point
+		for _, l := range g1.Lines {
+			points = append(points, l.Points...)
+		}
+		if p, ok := degeneratePoint(points); ok {
+			return withinParts(p, g2)
+		}
+		return polyWithinParts(g1, g2)
 	case types.MultiPoint:
+		geoms := make([]types.GeometryValue, len(g1.Points))
+		for i, p := range g1.Points {
+			geoms[i] = p
+		}
+		return geomsWithinParts(geoms, g2)
 	case types.MultiLineString:
+		geoms := make([]types.GeometryValue, len(g1.Lines))
+		for i, l := range g1.Lines {
+			geoms[i] = l
+		}
+		return geomsWithinParts(geoms, g2)
 	case types.MultiPolygon:
+		geoms := make([]types.GeometryValue, len(g1.Polygons))
+		for i, p := range g1.Polygons {
+			geoms[i] = p
+		}
+		return geomsWithinParts(geoms, g2)
 	case types.GeomColl:
-		// TODO (james): implement these
+		return geomsWithinParts(g1.Geoms, g2)
 	}
-	return false
+	return false, false
+}
+
+// geomsWithinParts returns the withinParts of the Geometries of a collection as a whole.
+func geomsWithinParts(geoms []types.GeometryValue, g types.GeometryValue) (covered, interior bool) {
+	if len(geoms) == 0 {
+		return false, false
+	}
+	for _, gg := range geoms {
+		c, i := withinParts(gg, g)
+		if !c {
+			return false, false
+		}
+		interior = interior || i
+	}
+	return true, interior
+}
+
+// lineWithinParts returns the withinParts of the LineString l. Each line segment of l is split where it meets the
+// boundary of g, and each piece is within g if its end points and midpoint are.
+func lineWithinParts(l types.LineString, g types.GeometryValue) (covered, interior bool) {
+	segs := geometrySegments(g, nil)
+	for i := 1; i < len(l.Points); i++ {
+		points := splitSegment(l.Points[i-1], l.Points[i], segs)
+		for j, p := range points {
+			if !isPointIntersects(p, g) {
+				return false, false
+			}
+			if j == 0 {
+				continue
+			}
+			mid := midpoint(points[j-1], p)
+			if !isPointIntersects(mid, g) {
+				return false, false
+			}
+			interior = interior || isPointWithin(mid, g)
+		}
+	}
+	return true, interior
+}
+
+// polyWithinParts returns the withinParts of the Polygon p, which is within g if its rings are covered by g, and no
+// boundary of g, such as a ring of a hole, passes through its interior. The interior of p is then either all inside g
+// or all outside of it, as it is when p is a hole of g, so it's enough to check one point of it.
+func polyWithinParts(p types.Polygon, g types.GeometryValue) (covered, interior bool) {
+	for _, l := range p.Lines {
+		if c, _ := lineWithinParts(l, g); !c {
+			return false, false
+		}
+	}
+	polySegs := geometrySegments(p, nil)
+	for _, s := range geometrySegments(g, nil) {
+		points := splitSegment(s.a, s.b, polySegs)
+		for j := 1; j < len(points); j++ {
+			if isPointWithin(midpoint(points[j-1], points[j]), p) {
+				return false, false
+			}
+		}
+	}
+	if point, ok := polyInteriorPoint(p); !ok || !isPointWithin(point, g) {
+		return false, false
+	}
+	return true, true
+}
+
+// polyInteriorPoint returns a Point in the interior of the Polygon p. A horizontal line is drawn between the heights of
+// two vertices, so that it passes through no vertex, and the Point is halfway between the first two edges it crosses.
+func polyInteriorPoint(p types.Polygon) (types.Point, bool) {
+	var ys []float64
+	for _, l := range p.Lines {
+		for _, point := range l.Points {
+			ys = append(ys, point.Y)
+		}
+	}
+	if len(ys) == 0 {
+		return types.Point{}, false
+	}
+	sort.Float64s(ys)
+	i := sort.Search(len(ys), func(i int) bool { return ys[i] > ys[0] })
+	if i == len(ys) {
+		return types.Point{}, false
+	}
+	y := (ys[0] + ys[i]) / 2
+
+	var xs []float64
+	for _, l := range p.Lines {
+		for j := 1; j < len(l.Points); j++ {
+			a, b := l.Points[j-1], l.Points[j]
+			if (a.Y < y) != (b.Y < y) {
+				xs = append(xs, a.X+(y-a.Y)*(b.X-a.X)/(b.Y-a.Y))
+			}
+		}
+	}
+	if len(xs) < 2 {
+		return types.Point{}, false
+	}
+	sort.Float64s(xs)
+	return types.Point{X: (xs[0] + xs[1]) / 2, Y: y}, true
+}
+
+// splitSegment returns the end points of the pieces of the line segment ab, which is split at every point where it
+// meets one of |segs|, in order from a to b.
+func splitSegment(a, b types.Point, segs []segment) []types.Point {
+	ts := []float64{0, 1}
+	dx, dy := b.X-a.X, b.Y-a.Y
+	for _, s := range segs {
+		c, d := s.a, s.b
+		if !linesIntersect(a, b, c, d) {
+			continue
+		}
+		denom := dx*(d.Y-c.Y) - dy*(d.X-c.X)
+		if denom == 0 {
+			// collinear segments are split where the other one starts and ends
+			length := dx*dx + dy*dy
+			if length == 0 {
+				continue
+			}
+			ts = append(ts, ((c.X-a.X)*dx+(c.Y-a.Y)*dy)/length, ((d.X-a.X)*dx+(d.Y-a.Y)*dy)/length)
+			continue
+		}
+		ts = append(ts, ((c.X-a.X)*(d.Y-c.Y)-(c.Y-a.Y)*(d.X-c.X))/denom)
+	}
+	sort.Float64s(ts)
+
+	points := []types.Point{a}
+	last := 0.0
+	for _, t := range ts {
+		if t <= last || t >= 1 {
+			continue
+		}
+		points = append(points, types.Point{X: a.X + t*dx, Y: a.Y + t*dy})
+		last = t
+	}
+	if dx != 0 || dy != 0 {
+		points = append(points, b)
+	}
+	return points
+}
+
+func midpoint(a, b types.Point) types.Point {
+	return types.Point{X: (a.X + b.X) / 2, Y: (a.Y + b.Y) / 2}
+}
+
+// degeneratePoint returns the Point that all of |points| are equal to, if they are.
+func degeneratePoint(points []types.Point) (types.Point, bool) {
+	if len(points) == 0 {
+		return types.Point{}, false
+	}
+	for _, p := range points[1:] {
+		if !isPointEqual(p, points[0]) {
+			return types.Point{}, false
+		}
+	}
+	return points[0], true
+}
+
+// dimension returns the highest dimension of the Geometries that make up g, which is 0 for Points, 1 for LineStrings
+// and 2 for Polygons, or -1 if there are none.
+func dimension(g types.GeometryValue) int {
+	switch g := g.(type) {
+	case types.Point, types.MultiPoint:
+		return 0
+	case types.LineString, types.MultiLineString:
+		return 1
+	case types.Polygon, types.MultiPolygon:
+		return 2
+	case types.GeomColl:
+		dim := -1
+		for _, gg := range g.Geoms {
+			if d := dimension(gg); d > dim {
+				dim = d
+			}
+		}
+		return dim
+	}
+	return -1
 }
 
 // Eval implements the sql.Expression interface.
@@ -242,21 +462,5 @@ func (w *Within) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	// TODO (james): remove this switch block when the other comparisons are implemented
-	switch geom1.(type) {
-	case types.LineString:
-		return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New("LineString", w.FunctionName())
-	case types.Polygon:
-		return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New("Polygon", w.FunctionName())
-	case types.MultiPoint:
-		return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New("MultiPoint", w.FunctionName())
-	case types.MultiLineString:
-		return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New("MultiLineString", w.FunctionName())
-	case types.MultiPolygon:
-		return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New("MultiPolygon", w.FunctionName())
-	case types.GeomColl:
-		return nil, sql.ErrUnsupportedGISTypeForSpatialFunc.New("GeomColl", w.FunctionName())
-	}
-
 	return isWithin(g1, g2), nil
 }
@@ -514,8 +514,6 @@ func TestPointWithinGeometryCollection(t *testing.T) {
 }
 
 func TestWithin(t *testing.T) {
-	t.Skip("these are all the cases that haven't been implemented yet")
-
 	// LineString vs Point
 	t.Run("linestring never within point", func(t *testing.T) {
 		require := require.New(t)
@@ -746,7 +744,7 @@ func TestWithin(t *testing.T) {
 		b := types.Point{X: 2, Y: 2}
 		c := types.Point{X: 2, Y: -2}
 		d := types.Point{X: -2, Y: -2}
-		l := types.LineString{Points: []types.Point{a, b, c, d, a}}
+		l := types.LineString{Points: []types.Point{a, b, d, c, a}}
 		p := types.Polygon{Lines: []types.LineString{l}}
 
 		w := types.Point{X: -1, Y: 0}
@@ -1169,7 +1167,7 @@ func TestWithin(t *testing.T) {
 		require.Equal(true, v)
 	})
 
-	t.Run("multipoint some within linestring", func(t *testing.T) {
+	t.Run("multipoint with terminal points and interior point within linestring", func(t *testing.T) {
 		require := require.New(t)
 		a := types.Point{}
 		b := types.Point{X: 2, Y: 2}
@@ -1180,7 +1178,7 @@ func TestWithin(t *testing.T) {
 		f := NewWithin(expression.NewLiteral(mp, types.MultiPointType{}), expression.NewLiteral(ab, types.LineStringType{}))
 		v, err := f.Eval(sql.NewEmptyContext(), nil)
 		require.NoError(err)
-		require.Equal(false, v)
+		require.Equal(true, v)
 	})
 
 	t.Run("multipoint terminal points not within linestring", func(t *testing.T) {
@@ -234,12 +234,7 @@ func (h *Hex) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return "0", nil
 
 	case time.Time:
-		s, err := formatDate("%Y-%m-%d %H:%i:%s", val)
-
-		if err != nil {
-			return nil, err
-		}
-
+		s, _ := formatDate("%Y-%m-%d %H:%i:%s", val, englishTimeLocale)
 		s += fractionOfSecString(val)
 
 		return hexForString(s), nil
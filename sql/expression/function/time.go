@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"gopkg.in/src-d/go-errors.v1"
 
 	gmstime "github.com/dolthub/go-mysql-server/internal/time"
@@ -948,10 +949,14 @@ func (n *Now) Description() string {
 
 // Type implements the sql.Expression interface.
 func (n *Now) Type() sql.Type {
-	// TODO: precision
 	if n.prec == nil {
 		return types.Datetime
 	}
+	if lit, ok := n.prec.(*expression.Literal); ok {
+		if fsp, ok := types.CoalesceInt(lit.Value()); ok && fsp >= 0 && fsp <= 6 {
+			return types.MustCreateDatetimeType(sqltypes.Datetime, fsp)
+		}
+	}
 	return types.DatetimeMaxPrecision
 }
 
@@ -18,57 +18,22 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/lestrrat-go/strftime"
-
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-var mysqlTimeFormatSpec = strftime.NewSpecificationSet()
-var timeFormatSpecifierToFunc = map[byte]func(time.Time) string{
-	'f': microsecondsStr,
-	'H': nil,
-	'h': twelveHourPadded,
-	'I': twelveHourPadded,
-	'i': minutesStr,
-	'p': nil,
-	'r': ampmClockStr,
-	'S': nil,
-	's': secondsStr,
-	'T': nil,
-}
-
-func init() {
-	for specifier, fn := range timeFormatSpecifierToFunc {
-		if fn != nil {
-			panicIfErr(mysqlTimeFormatSpec.Set(specifier, wrap(fn)))
-		}
-	}
-
-	// replace any strftime specifiers that aren't supported
-	fn := func(b byte) {
-		if _, ok := timeFormatSpecifierToFunc[b]; !ok {
-			panicIfErr(mysqlTimeFormatSpec.Set(b, wrap(func(time.Time) string {
-				return string(b)
-			})))
-		}
-	}
-
-	capToLower := byte('a' - 'A')
-	for i := byte('A'); i <= 'Z'; i++ {
-		fn(i)
-		fn(i + capToLower)
+// formatTime formats the TIME |d| as TIME_FORMAT does. The date specifiers of the format are zero, or make the result
+// NULL if they are names or week numbers.
+func formatTime(format string, d time.Duration) (string, bool) {
+	p := dateTimeParts{isTime: true}
+	if d < 0 {
+		p.neg = true
+		d = -d
 	}
-}
-
-func formatTime(format string, t time.Time) (string, error) {
-	formatter, err := strftime.New(format, strftime.WithSpecificationSet(mysqlTimeFormatSpec))
-	if err != nil {
-		return "", err
-	}
-
-	return formatter.FormatString(t), nil
+	p.hour = int(d / time.Hour)
+	p.t = time.Date(0, time.January, 1, 0, 0, 0, int(d%time.Hour), time.UTC)
+	return formatDateTimeParts(format, p, englishTimeLocale)
 }
 
 // TimeFormat function returns a string representation of the date specified in the format specified
@@ -133,10 +98,11 @@ func (f *TimeFormat) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, sql.ErrInvalidArgumentDetails.New("time_format", "format must be a string")
 	}
 
-	return formatTime(
-		formatStr,
-		time.Date(1980, time.January, 1, int(d.Hours())%24, int(d.Minutes())%60, int(d.Seconds())%60, int(d.Nanoseconds())%1e9, time.UTC),
-	)
+	res, ok := formatTime(formatStr, d)
+	if !ok {
+		return nil, nil
+	}
+	return res, nil
 }
 
 // Type implements the Expression interface.
@@ -19,44 +19,68 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 func TestTimeFormatting(t *testing.T) {
-	dt := time.Date(2020, 2, 3, 4, 5, 6, 7000, time.UTC)
+	d := 4*time.Hour + 5*time.Minute + 6*time.Second + 7*time.Microsecond
 	tests := []struct {
 		formatStr string
 		expected  string
-		expectErr bool
 	}{
-		{"%f", "000007", false},               // Microseconds (000000 to 999999)
-		{"%h %p--%f", "04 AM--000007", false}, // Microseconds (000000 to 999999)
-		{"%H", "04", false},                   // Hour (00 to 23)
-		{"%h", "04", false},                   // Hour (00 to 12)
-		{"%I", "04", false},                   // Hour (00 to 12)
-		{"%i", "05", false},                   // Minutes (00 to 59)
-		{"%p", "AM", false},                   // AM or PM
-		{"%r", "04:05:06 AM", false},          // Time in 12 hour AM or PM format (hh:mm:ss AM/PM)
-		{"%S", "06", false},                   // Seconds (00 to 59)
-		{"%s", "06", false},                   // Seconds (00 to 59)
-		{"%T", "04:05:06", false},             // Time in 24 hour format (hh:mm:ss)
-		{"%U", "U", false},                    // Assert that unsupported (date) verbs are ignored
-		{"%z", "z", false},                    // Assert that unsupported (unknown) verbs are ignored
+		{"%f", "000007"},               // Microseconds (000000 to 999999)
+		{"%h %p--%f", "04 AM--000007"}, // Microseconds (000000 to 999999)
+		{"%H", "04"},                   // Hour (00 to 23)
+		{"%h", "04"},                   // Hour (00 to 12)
+		{"%I", "04"},                   // Hour (00 to 12)
+		{"%i", "05"},                   // Minutes (00 to 59)
+		{"%k", "4"},                    // Hour (0 to 23)
+		{"%l", "4"},                    // Hour (1 to 12)
+		{"%p", "AM"},                   // AM or PM
+		{"%r", "04:05:06 AM"},          // Time in 12 hour AM or PM format (hh:mm:ss AM/PM)
+		{"%S", "06"},                   // Seconds (00 to 59)
+		{"%s", "06"},                   // Seconds (00 to 59)
+		{"%T", "04:05:06"},             // Time in 24 hour format (hh:mm:ss)
+		{"%Y-%m-%d %c %e %y", "0000-00-00 0 0 00"}, // A TIME has a zero date
+		{"%z", "z"}, // Assert that unknown verbs are written as is
 	}
 
 	for _, test := range tests {
-		t.Run(dt.String()+test.formatStr, func(t *testing.T) {
-			result, err := formatTime(test.formatStr, dt)
+		t.Run(test.formatStr, func(t *testing.T) {
+			result, ok := formatTime(test.formatStr, d)
+			require.True(t, ok)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+
+	// specifiers for the names, week numbers and days of a date make the result NULL
+	for _, formatStr := range []string{"%a", "%b", "%D", "%j", "%M", "%U", "%u", "%V", "%v", "%W", "%w", "%X", "%x"} {
+		t.Run(formatStr, func(t *testing.T) {
+			_, ok := formatTime("%H "+formatStr, d)
+			require.False(t, ok)
+		})
+	}
 
-			if test.expectErr {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-				t.Log(result)
-				assert.Equal(t, test.expected, result)
-			}
+	// hours of a TIME may be greater than 23, and the result of a negative TIME starts with a minus sign
+	tests2 := []struct {
+		d         time.Duration
+		formatStr string
+		expected  string
+	}{
+		{100*time.Hour + 2*time.Minute, "%H %k %h %I %l %p", "100 100 04 04 4 AM"},
+		{100*time.Hour + 2*time.Minute, "%T|%r", "100:02:00|04:02:00 AM"},
+		{838*time.Hour + 59*time.Minute + 59*time.Second, "%H:%i:%s", "838:59:59"},
+		{-(time.Hour + 2*time.Minute + 3*time.Second), "%H:%i:%s", "-01:02:03"},
+		{-(13*time.Hour + 500*time.Millisecond), "%l %p %f", "-1 PM 500000"},
+	}
+	for _, test := range tests2 {
+		t.Run(test.d.String()+test.formatStr, func(t *testing.T) {
+			result, ok := formatTime(test.formatStr, test.d)
+			require.True(t, ok)
+			assert.Equal(t, test.expected, result)
 		})
 	}
 }
@@ -0,0 +1,131 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// timeLocale holds the month and weekday names of a locale, as used for the lc_time_names system variable.
+// https://dev.mysql.com/doc/refman/8.0/en/locale-support.html
+type timeLocale struct {
+	// monthNames and abbreviatedMonthNames are indexed by time.Month - 1
+	monthNames            [12]string
+	abbreviatedMonthNames [12]string
+	// dayNames and abbreviatedDayNames are indexed by time.Weekday, so they start with Sunday
+	dayNames            [7]string
+	abbreviatedDayNames [7]string
+}
+
+var englishTimeLocale = &timeLocale{
+	monthNames:            [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	abbreviatedMonthNames: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	dayNames:              [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	abbreviatedDayNames:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+}
+
+// timeLocales are the supported values of lc_time_names, keyed by their lower case name.
+var timeLocales = map[string]*timeLocale{
+	"en_us": englishTimeLocale,
+	"en_gb": englishTimeLocale,
+	"en_au": englishTimeLocale,
+	"en_ca": englishTimeLocale,
+	"en_ie": englishTimeLocale,
+	"en_nz": englishTimeLocale,
+	"de_de": {
+		monthNames:            [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		abbreviatedMonthNames: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		dayNames:              [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		abbreviatedDayNames:   [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	},
+	"es_es": {
+		monthNames:            [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		abbreviatedMonthNames: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sep", "oct", "nov", "dic"},
+		dayNames:              [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		abbreviatedDayNames:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	},
+	"fr_fr": {
+		monthNames:            [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		abbreviatedMonthNames: [12]string{"janv", "févr", "mars", "avr", "mai", "juin", "juil", "août", "sept", "oct", "nov", "déc"},
+		dayNames:              [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		abbreviatedDayNames:   [7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+	},
+	"it_it": {
+		monthNames:            [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		abbreviatedMonthNames: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		dayNames:              [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		abbreviatedDayNames:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+	},
+	"nl_nl": {
+		monthNames:            [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		abbreviatedMonthNames: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		dayNames:              [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		abbreviatedDayNames:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+	},
+	"pt_br": {
+		monthNames:            [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		abbreviatedMonthNames: [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		dayNames:              [7]string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"},
+		abbreviatedDayNames:   [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+	},
+	"ru_ru": {
+		monthNames:            [12]string{"Января", "Февраля", "Марта", "Апреля", "Мая", "Июня", "Июля", "Августа", "Сентября", "Октября", "Ноября", "Декабря"},
+		abbreviatedMonthNames: [12]string{"Янв", "Фев", "Мар", "Апр", "Май", "Июн", "Июл", "Авг", "Сен", "Окт", "Ноя", "Дек"},
+		dayNames:              [7]string{"Воскресенье", "Понедельник", "Вторник", "Среда", "Четверг", "Пятница", "Суббота"},
+		abbreviatedDayNames:   [7]string{"Вск", "Пнд", "Втр", "Срд", "Чтв", "Птн", "Сбт"},
+	},
+	"sv_se": {
+		monthNames:            [12]string{"januari", "februari", "mars", "april", "maj", "juni", "juli", "augusti", "september", "oktober", "november", "december"},
+		abbreviatedMonthNames: [12]string{"jan", "feb", "mar", "apr", "maj", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		dayNames:              [7]string{"söndag", "måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag"},
+		abbreviatedDayNames:   [7]string{"sön", "mån", "tis", "ons", "tor", "fre", "lör"},
+	},
+}
+
+// sessionTimeLocale returns the locale named by the lc_time_names system variable of the session, or the English
+// locale if it names a locale that isn't supported.
+func sessionTimeLocale(ctx *sql.Context) *timeLocale {
+	if ctx == nil || ctx.Session == nil {
+		return englishTimeLocale
+	}
+	val, err := ctx.GetSessionVariable(ctx, "lc_time_names")
+	if err != nil {
+		return englishTimeLocale
+	}
+	name, _ := val.(string)
+	if locale, ok := timeLocales[strings.ToLower(name)]; ok {
+		return locale
+	}
+	return englishTimeLocale
+}
+
+func (l *timeLocale) monthName(m time.Month) string {
+	return l.monthNames[m-1]
+}
+
+func (l *timeLocale) abbreviatedMonthName(m time.Month) string {
+	return l.abbreviatedMonthNames[m-1]
+}
+
+func (l *timeLocale) dayName(d time.Weekday) string {
+	return l.dayNames[d]
+}
+
+func (l *timeLocale) abbreviatedDayName(d time.Weekday) string {
+	return l.abbreviatedDayNames[d]
+}
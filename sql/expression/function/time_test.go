@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -688,6 +689,20 @@ func TestNow(t *testing.T) {
 	}
 }
 
+func TestNowType(t *testing.T) {
+	f, err := NewNow()
+	require.NoError(t, err)
+	require.Equal(t, types.Datetime, f.Type())
+
+	f, err = NewNow(expression.NewLiteral(int8(3), types.Int8))
+	require.NoError(t, err)
+	require.Equal(t, types.MustCreateDatetimeType(sqltypes.Datetime, 3), f.Type())
+
+	f, err = NewNow(expression.NewGetField(0, types.Int8, "foo", false))
+	require.NoError(t, err)
+	require.Equal(t, types.DatetimeMaxPrecision, f.Type())
+}
+
 // TestSysdate tests the SYSDATE() function, which should generally behave identically to NOW(), but unlike NOW(),
 // SYSDATE() should always return the exact current time, and not the cached query start time. That behavior is
 // tested in the enginetests, instead of these unit tests.
@@ -104,7 +104,15 @@ func (in *InTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 			var cmp int
 			elType := el.Type()
-			if types.IsDecimal(elType) || types.IsFloat(elType) {
+			if ConvertsOperands(in.Left().Type(), elType) {
+				// the element is compared with the left operand as it would be with =, converting both to a number
+				// or a date
+				c := newComparison(in.Left(), el)
+				cmp, err = c.compareValues(ctx, originalLeft, originalRight)
+				if err != nil {
+					return nil, err
+				}
+			} else if types.IsDecimal(elType) || types.IsFloat(elType) {
 				rtyp := el.Type().Promote()
 				left, err := convertOrTruncate(ctx, left, rtyp)
 				if err != nil {
@@ -181,6 +181,26 @@ func TestInTuple(t *testing.T) {
 			err:    types.ErrConvertingToTime,
 			row:    nil,
 			result: false,
+		},
+		{
+			name: "string on left side; numbers on right",
+			left: expression.NewLiteral("5.0", types.Text),
+			right: expression.NewTuple(
+				expression.NewLiteral(int64(4), types.Int64),
+				expression.NewLiteral(int64(5), types.Int64),
+			),
+			row:    nil,
+			result: true,
+		},
+		{
+			name: "number on left side; number prefixed strings on right",
+			left: expression.NewLiteral(int64(6), types.Int64),
+			right: expression.NewTuple(
+				expression.NewLiteral("5", types.Text),
+				expression.NewLiteral("6abc", types.Text),
+			),
+			row:    nil,
+			result: true,
 		}}
 
 	for _, tt := range testCases {
@@ -72,7 +72,11 @@ func (s *SetField) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 	if val != nil {
-		convertedVal, _, err := getField.fieldType.Convert(val)
+		convertedVal, inRange, err := getField.fieldType.Convert(val)
+		if types.IsOutOfRangeConversion(getField.fieldType, inRange, err) {
+			// callers decide whether to clamp the value or fail, depending on the SQL mode
+			return nil, sql.NewWrappedTypeConversionError(val, getField.fieldIndex, sql.ErrValueOutOfRange.New(val, getField.fieldType))
+		}
 		if err != nil {
 			// Fill in error with information
 			if types.ErrLengthBeyondLimit.Is(err) {
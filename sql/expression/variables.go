@@ -147,7 +147,8 @@ func NewUserVarWithType(name string, t sql.Type) *UserVar {
 // Children implements the sql.Expression interface.
 func (v *UserVar) Children() []sql.Expression { return nil }
 
-// Eval implements the sql.Expression interface.
+// Eval implements the sql.Expression interface. An unset user variable evaluates to NULL, regardless of the type
+// the variable had when this expression was built.
 func (v *UserVar) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
 	_, val, err := ctx.GetUserVariable(ctx, v.Name)
 	if err != nil {
@@ -0,0 +1,56 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestUnsetUserVar(t *testing.T) {
+	// An unset user variable is NULL, even when the variable was typed when the expression was built
+	unsetVars := []*expression.UserVar{
+		expression.NewUserVar("unset_var"),
+		expression.NewUserVarWithType("unset_var", types.Int64),
+	}
+
+	for _, unsetVar := range unsetVars {
+		t.Run(unsetVar.Type().String(), func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+
+			val, err := unsetVar.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Nil(t, val)
+
+			val, err = expression.NewPlus(unsetVar, expression.NewLiteral(int8(1), types.Int8)).Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Nil(t, val)
+
+			val, err = function.NewIfNull(unsetVar, expression.NewLiteral(int8(42), types.Int8)).Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, int8(42), val)
+
+			val, err = expression.NewIsNull(unsetVar).Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, true, val)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+)
+
+// FunctionOverload is a single signature of an OverloadedFunction.
+type FunctionOverload struct {
+	// ArgTypes are the types of the arguments accepted by this overload. A nil entry accepts an argument of any type.
+	// An argument matches a type if it has the same type, or failing that, a type of the same class (numeric, string,
+	// temporal, etc.).
+	ArgTypes []Type
+	// Variadic is true when the last entry of ArgTypes may be repeated zero or more times.
+	Variadic bool
+	// Fn creates an instance of this overload for the arguments given.
+	Fn CreateFuncNArgs
+}
+
+// OverloadedFunction is a function with multiple signatures, which may differ in the number of arguments they
+// accept, their types, or both. Calls are resolved to the signature that most closely matches the types of the
+// arguments. Registering an OverloadedFunction with the same name as an existing OverloadedFunction adds its
+// signatures to the existing function.
+type OverloadedFunction struct {
+	Name      string
+	Overloads []FunctionOverload
+}
+
+var _ Function = OverloadedFunction{}
+
+// NewOverloadedFunction returns a new OverloadedFunction with the signatures given.
+func NewOverloadedFunction(name string, overloads ...FunctionOverload) OverloadedFunction {
+	return OverloadedFunction{
+		Name:      name,
+		Overloads: overloads,
+	}
+}
+
+// WithOverloads returns a copy of this function with the signatures given added to it.
+func (fn OverloadedFunction) WithOverloads(overloads ...FunctionOverload) OverloadedFunction {
+	nf := fn
+	nf.Overloads = make([]FunctionOverload, 0, len(fn.Overloads)+len(overloads))
+	nf.Overloads = append(nf.Overloads, fn.Overloads...)
+	nf.Overloads = append(nf.Overloads, overloads...)
+	return nf
+}
+
+// FunctionName implements the Function interface.
+func (fn OverloadedFunction) FunctionName() string { return fn.Name }
+
+func (OverloadedFunction) isFunction() {}
+
+// NewInstance implements the Function interface. It returns an instance of the overload that most closely matches the
+// types of |args|, or an error if no overload matches or more than one matches equally well.
+func (fn OverloadedFunction) NewInstance(args []Expression) (Expression, error) {
+	best := -1
+	bestCost := -1
+	ambiguous := false
+	for i, overload := range fn.Overloads {
+		cost, ok := overload.matchCost(args)
+		if !ok {
+			continue
+		}
+		switch {
+		case best < 0 || cost < bestCost:
+			best, bestCost, ambiguous = i, cost, false
+		case cost == bestCost:
+			ambiguous = true
+		}
+	}
+
+	if best < 0 {
+		return nil, ErrNoMatchingFunctionOverload.New(fn.Name, argTypesString(args), fn.signatures())
+	}
+	if ambiguous {
+		return nil, ErrAmbiguousFunctionCall.New(fn.Name, argTypesString(args), fn.signatures())
+	}
+	return fn.Overloads[best].Fn(args...)
+}
+
+func (fn OverloadedFunction) signatures() string {
+	sigs := make([]string, len(fn.Overloads))
+	for i, overload := range fn.Overloads {
+		sigs[i] = fn.Name + "(" + overload.String() + ")"
+	}
+	return strings.Join(sigs, ", ")
+}
+
+// String returns the argument types of this overload, e.g. "bigint, longtext...".
+func (o FunctionOverload) String() string {
+	args := make([]string, len(o.ArgTypes))
+	for i, t := range o.ArgTypes {
+		if t == nil {
+			args[i] = "any"
+		} else {
+			args[i] = t.String()
+		}
+		if o.Variadic && i == len(o.ArgTypes)-1 {
+			args[i] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// Costs of matching an argument to a parameter of an overload. Lower is better.
+const (
+	overloadCostExact = iota
+	overloadCostSameClass
+	overloadCostAny
+	// overloadCostVariadic is added once to variadic overloads, so that a fixed-arity overload is preferred when both
+	// match equally well otherwise.
+	overloadCostVariadic
+)
+
+// matchCost returns the cost of calling this overload with |args|, and false if the overload doesn't accept them.
+func (o FunctionOverload) matchCost(args []Expression) (int, bool) {
+	if o.Variadic {
+		if len(o.ArgTypes) == 0 || len(args) < len(o.ArgTypes)-1 {
+			return 0, false
+		}
+	} else if len(args) != len(o.ArgTypes) {
+		return 0, false
+	}
+
+	cost := 0
+	if o.Variadic {
+		cost += overloadCostVariadic
+	}
+	for i, arg := range args {
+		var t Type
+		if i < len(o.ArgTypes) {
+			t = o.ArgTypes[i]
+		} else {
+			t = o.ArgTypes[len(o.ArgTypes)-1]
+		}
+		argCost, ok := argMatchCost(t, arg.Type())
+		if !ok {
+			return 0, false
+		}
+		cost += argCost
+	}
+	return cost, true
+}
+
+func argMatchCost(param, arg Type) (int, bool) {
+	switch {
+	case param == nil || arg == nil || arg.Type() == sqltypes.Null:
+		return overloadCostAny, true
+	case param.Equals(arg):
+		return overloadCostExact, true
+	case typeClass(param) == typeClass(arg):
+		return overloadCostSameClass, true
+	default:
+		return 0, false
+	}
+}
+
+type overloadTypeClass byte
+
+const (
+	overloadClassOther overloadTypeClass = iota
+	overloadClassNumber
+	overloadClassString
+	overloadClassTemporal
+	overloadClassJSON
+	overloadClassGeometry
+)
+
+func typeClass(t Type) overloadTypeClass {
+	qt := t.Type()
+	switch {
+	case sqltypes.IsIntegral(qt), sqltypes.IsFloat(qt), qt == sqltypes.Decimal, qt == sqltypes.Bit:
+		return overloadClassNumber
+	case sqltypes.IsQuoted(qt) && !isTemporalQueryType(qt) && qt != sqltypes.TypeJSON:
+		return overloadClassString
+	case isTemporalQueryType(qt):
+		return overloadClassTemporal
+	case qt == sqltypes.TypeJSON:
+		return overloadClassJSON
+	case qt == sqltypes.Geometry:
+		return overloadClassGeometry
+	default:
+		return overloadClassOther
+	}
+}
+
+func isTemporalQueryType(qt query.Type) bool {
+	switch qt {
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp, sqltypes.Time, sqltypes.Year:
+		return true
+	default:
+		return false
+	}
+}
+
+func argTypesString(args []Expression) string {
+	types := make([]string, len(args))
+	for i, arg := range args {
+		types[i] = arg.Type().String()
+	}
+	return strings.Join(types, ", ")
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func overloadNamed(name string) sql.CreateFuncNArgs {
+	return func(args ...sql.Expression) (sql.Expression, error) {
+		return expression.NewLiteral(fmt.Sprintf("%s/%d", name, len(args)), types.LongText), nil
+	}
+}
+
+func TestOverloadedFunction(t *testing.T) {
+	fn := sql.NewOverloadedFunction("f",
+		sql.FunctionOverload{ArgTypes: []sql.Type{types.Int64}, Fn: overloadNamed("int")},
+		sql.FunctionOverload{ArgTypes: []sql.Type{types.Float64}, Fn: overloadNamed("double")},
+		sql.FunctionOverload{ArgTypes: []sql.Type{types.LongText}, Fn: overloadNamed("text")},
+		sql.FunctionOverload{ArgTypes: []sql.Type{types.Int64, types.LongText}, Variadic: true, Fn: overloadNamed("variadic")},
+		sql.FunctionOverload{ArgTypes: []sql.Type{types.Int64, types.LongText}, Fn: overloadNamed("pair")},
+	)
+
+	intArg := expression.NewLiteral(int64(1), types.Int64)
+	int8Arg := expression.NewLiteral(int8(1), types.Int8)
+	floatArg := expression.NewLiteral(1.5, types.Float64)
+	textArg := expression.NewLiteral("a", types.LongText)
+	varcharArg := expression.NewLiteral("a", types.Text)
+	dateArg := expression.NewLiteral("2020-01-01", types.Date)
+
+	testCases := []struct {
+		name     string
+		args     []sql.Expression
+		expected string
+	}{
+		{name: "exact integer", args: []sql.Expression{intArg}, expected: "int/1"},
+		{name: "exact double", args: []sql.Expression{floatArg}, expected: "double/1"},
+		{name: "exact text", args: []sql.Expression{textArg}, expected: "text/1"},
+		{name: "text of a different type", args: []sql.Expression{varcharArg}, expected: "text/1"},
+		{name: "variadic without repeated arguments", args: []sql.Expression{intArg}, expected: "int/1"},
+		{name: "fixed arity preferred over variadic", args: []sql.Expression{intArg, textArg}, expected: "pair/2"},
+		{name: "variadic", args: []sql.Expression{intArg, textArg, varcharArg}, expected: "variadic/3"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := fn.NewInstance(tt.args)
+			require.NoError(t, err)
+			val, err := e.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+
+	// a tinyint is equally close to the bigint and double overloads
+	_, err := fn.NewInstance([]sql.Expression{int8Arg})
+	require.True(t, sql.ErrAmbiguousFunctionCall.Is(err), "unexpected error %v", err)
+
+	_, err = fn.NewInstance([]sql.Expression{dateArg})
+	require.True(t, sql.ErrNoMatchingFunctionOverload.Is(err), "unexpected error %v", err)
+
+	_, err = fn.NewInstance(nil)
+	require.True(t, sql.ErrNoMatchingFunctionOverload.Is(err), "unexpected error %v", err)
+	require.Contains(t, err.Error(), "f(bigint, longtext...)")
+}
+
+func TestOverloadedFunctionWithOverloads(t *testing.T) {
+	fn := sql.NewOverloadedFunction("f",
+		sql.FunctionOverload{ArgTypes: []sql.Type{types.Int64}, Fn: overloadNamed("int")},
+	)
+	extended := fn.WithOverloads(sql.FunctionOverload{ArgTypes: []sql.Type{nil, nil}, Fn: overloadNamed("any")})
+	require.Len(t, fn.Overloads, 1)
+	require.Len(t, extended.Overloads, 2)
+
+	e, err := extended.NewInstance([]sql.Expression{
+		expression.NewLiteral("2020-01-01", types.Date),
+		expression.NewLiteral(nil, types.Null),
+	})
+	require.NoError(t, err)
+	val, err := e.Eval(sql.NewEmptyContext(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "any/2", val)
+}
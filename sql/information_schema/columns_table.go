@@ -28,6 +28,8 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
 	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -212,7 +214,7 @@ func columnsRowIter(ctx *sql.Context, catalog sql.Catalog, allColsWithDefaultVal
 		}
 		rows = append(rows, rs...)
 
-		rs, err = getRowsFromViews(ctx, db)
+		rs, err = getRowsFromViews(ctx, catalog, db, privSet, globalPrivSetMap)
 		if err != nil {
 			return nil, err
 		}
@@ -258,8 +260,13 @@ func getRowFromColumn(ctx *sql.Context, curOrdPos int, col *sql.Column, dbName,
 
 	extra := col.Extra
 	// If extra is not defined, fill it here.
-	if extra == "" && !col.Default.IsLiteral() {
-		extra = "DEFAULT_GENERATED"
+	if extra == "" {
+		if !col.Default.IsLiteral() {
+			extra = "DEFAULT_GENERATED"
+		}
+		if col.OnUpdate != nil {
+			extra = strings.TrimSpace(fmt.Sprintf("%s on update %v", extra, getColumnOnUpdate(col.OnUpdate)))
+		}
 	}
 
 	var curColPrivStr []string
@@ -340,42 +347,33 @@ func getRowsFromTable(ctx *sql.Context, db sql.Database, t sql.Table, privSetDb
 	return rows, nil
 }
 
-// getRowsFromViews returns array or rows for columns for all views for given database.
-func getRowsFromViews(ctx *sql.Context, db sql.Database) ([]sql.Row, error) {
+// getRowsFromViews returns array of rows for the columns of all views for given database. The columns of a view are
+// those of its definition, so a view defined with a UNION is named and typed by its first SELECT and the type
+// unification of the UNION. Views that no longer resolve, such as those referencing a dropped column, have no rows.
+func getRowsFromViews(ctx *sql.Context, catalog sql.Catalog, db sql.Database, privSet sql.PrivilegeSet, privSetMap map[string]struct{}) ([]sql.Row, error) {
 	var rows []sql.Row
-	// TODO: View Definition is lacking information to properly fill out these table
-	// TODO: Should somehow get reference to table(s) view is referencing
-	// TODO: Each column that view references should also show up as unique entries as well
 	views, err := viewsInDatabase(ctx, db)
 	if err != nil {
 		return nil, err
 	}
 
+	privSetDb := privSet.Database(db.Name())
+	curPrivSetMap := getCurrentPrivSetMapForColumn(privSetDb.ToSlice(), privSetMap)
 	for _, view := range views {
-		rows = append(rows, sql.Row{
-			"def",     // table_catalog
-			db.Name(), // table_schema
-			view.Name, // table_name
-			"",        // column_name
-			uint32(0), // ordinal_position
-			nil,       // column_default
-			"",        // is_nullable
-			nil,       // data_type
-			nil,       // character_maximum_length
-			nil,       // character_octet_length
-			nil,       // numeric_precision
-			nil,       // numeric_scale
-			nil,       // datetime_precision
-			"",        // character_set_name
-			"",        // collation_name
-			"",        // column_type
-			"",        // column_key
-			"",        // extra
-			"select",  // privileges
-			"",        // column_comment
-			"",        // generation_expression
-			nil,       // srs_id
-		})
+		parsedView, err := planbuilder.ParseWithOptions(ctx, catalog, view.CreateViewStatement, sql.NewSqlModeFromString(view.SqlMode).ParserOptions())
+		if err != nil {
+			continue
+		}
+		viewPlan, ok := parsedView.(*plan.CreateView)
+		if !ok {
+			continue
+		}
+
+		privSetTbl := privSetDb.Table(view.Name)
+		viewPrivSetMap := getCurrentPrivSetMapForColumn(privSetTbl.ToSlice(), curPrivSetMap)
+		for i, col := range viewPlan.Definition.Schema() {
+			rows = append(rows, getRowFromColumn(ctx, i, col, db.Name(), view.Name, "", privSetTbl, viewPrivSetMap))
+		}
 	}
 
 	return rows, nil
@@ -465,6 +463,16 @@ func getIndexKeyInfo(ctx *sql.Context, t sql.Table) (map[string]string, bool, er
 	return columnKeyMap, hasPK, nil
 }
 
+// getColumnOnUpdate returns the ON UPDATE expression of a column as shown in the extra column. Unlike column defaults,
+// ON UPDATE expressions are not resolved for this table, so the expression is formatted from its string.
+func getColumnOnUpdate(cd *sql.ColumnDefaultValue) string {
+	onUpdate := cd.String()
+	if strings.HasPrefix(onUpdate, "(") && strings.HasSuffix(onUpdate, ")") {
+		onUpdate = strings.TrimSuffix(strings.TrimPrefix(onUpdate, "("), ")")
+	}
+	return onUpdate
+}
+
 // getColumnDefault returns the column default value for given sql.ColumnDefaultValue
 func getColumnDefault(ctx *sql.Context, cd *sql.ColumnDefaultValue) interface{} {
 	if cd == nil {
@@ -14,63 +14,6 @@
 
 package information_schema
 
-type UnitOfMeasure struct {
-	Name             string
-	Type             string
-	ConversionFactor float64
-	Description      string
-}
-
-var unitsOfMeasureArray = [47]UnitOfMeasure{
-	{"British chain (Sears 1922 truncated)", "LINEAR", 20.116756, ""},
-	{"British foot (Sears 1922 truncated)", "LINEAR", 0.30479933333333337, ""},
-	{"chain", "LINEAR", 20.1168, ""},
-	{"yard", "LINEAR", 0.9144, ""},
-	{"British foot (1936)", "LINEAR", 0.3048007491, ""},
-	{"British link (Sears 1922 truncated)", "LINEAR", 0.20116756, ""},
-	{"Gold Coast foot", "LINEAR", 0.3047997101815088, ""},
-	{"Indian yard (1937)", "LINEAR", 0.91439523, ""},
-	{"link", "LINEAR", 0.201168, ""},
-	{"Indian yard", "LINEAR", 0.9143985307444408, ""},
-	{"Indian yard (1975)", "LINEAR", 0.9143985, ""},
-	{"Indian yard (1962)", "LINEAR", 0.9143988, ""},
-	{"Indian foot (1975)", "LINEAR", 0.3047995, ""},
-	{"Indian foot (1937)", "LINEAR", 0.30479841, ""},
-	{"Indian foot", "LINEAR", 0.30479951024814694, ""},
-	{"Indian foot (1962)", "LINEAR", 0.3047996, ""},
-	{"British foot (1865)", "LINEAR", 0.30480083333333335, ""},
-	{"British link (Benoit 1895 B)", "LINEAR", 0.2011678249437587, ""},
-	{"Statute mile", "LINEAR", 1609.344, ""},
-	{"British chain (Benoit 1895 B)", "LINEAR", 20.116782494375872, ""},
-	{"British foot (Benoit 1895 B)", "LINEAR", 0.30479973476327077, ""},
-	{"British link (Benoit 1895 A)", "LINEAR", 0.201167824, ""},
-	{"British yard (Sears 1922 truncated)", "LINEAR", 0.914398, ""},
-	{"British yard (Benoit 1895 B)", "LINEAR", 0.9143992042898124, ""},
-	{"foot", "LINEAR", 0.3048, ""},
-	{"British foot (Benoit 1895 A)", "LINEAR", 0.3047997333333333, ""},
-	{"British yard (Benoit 1895 A)", "LINEAR", 0.9143992, ""},
-	{"British chain (Sears 1922)", "LINEAR", 20.116765121552632, ""},
-	{"Clarke's link", "LINEAR", 0.201166195164, ""},
-	{"Clarke's chain", "LINEAR", 20.1166195164, ""},
-	{"British chain (Benoit 1895 A)", "LINEAR", 20.1167824, ""},
-	{"Clarke's yard", "LINEAR", 0.9143917962, ""},
-	{"US survey foot", "LINEAR", 0.30480060960121924, ""},
-	{"kilometre", "LINEAR", 1000, ""},
-	{"centimetre", "LINEAR", 0.01, ""},
-	{"British yard (Sears 1922)", "LINEAR", 0.9143984146160288, ""},
-	{"US survey mile", "LINEAR", 1609.3472186944375, ""},
-	{"metre", "LINEAR", 1, ""},
-	{"US survey link", "LINEAR", 0.2011684023368047, ""},
-	{"nautical mile", "LINEAR", 1852, ""},
-	{"British foot (Sears 1922)", "LINEAR", 0.3047994715386762, ""},
-	{"British link (Sears 1922)", "LINEAR", 0.2011676512155263, ""},
-	{"fathom", "LINEAR", 1.8288, ""},
-	{"US survey chain", "LINEAR", 20.11684023368047, ""},
-	{"German legal metre", "LINEAR", 1.0000135965, ""},
-	{"Clarke's foot", "LINEAR", 0.3047972654, ""},
-	{"millimetre", "LINEAR", 0.001, ""},
-}
-
 type Keyword struct {
 	Word     string
 	Reserved int32
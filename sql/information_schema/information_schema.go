@@ -30,6 +30,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -503,7 +504,7 @@ var resourceGroupsSchema = Schema{
 	{Name: "RESOURCE_GROUP_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ResourceGroupsTableName},
 	{Name: "RESOURCE_GROUP_TYPE", Type: types.MustCreateEnumType([]string{"SYSTEM", "USER"}, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ResourceGroupsTableName},
 	{Name: "RESOURCE_GROUP_ENABLE", Type: types.MustCreateBitType(1), Default: nil, Nullable: false, Source: ResourceGroupsTableName},
-	{Name: "VPCUS_IDS", Type: types.Blob, Default: nil, Nullable: true, Source: ResourceGroupsTableName},
+	{Name: "VCPU_IDS", Type: types.Blob, Default: nil, Nullable: true, Source: ResourceGroupsTableName},
 	{Name: "THREAD_PRIORITY", Type: types.Int32, Default: nil, Nullable: false, Source: ResourceGroupsTableName},
 }
 
@@ -771,8 +772,8 @@ var viewRoutineUsageSchema = Schema{
 	{Name: "TABLE_SCHEMA", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ViewRoutineUsageTableName},
 	{Name: "TABLE_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ViewRoutineUsageTableName},
 	{Name: "SPECIFIC_CATALOG", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ViewRoutineUsageTableName},
-	{Name: "SPECIFIC_CATALOG", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ViewRoutineUsageTableName},
-	{Name: "SPECIFIC_TABLE", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ViewRoutineUsageTableName},
+	{Name: "SPECIFIC_SCHEMA", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ViewRoutineUsageTableName},
+	{Name: "SPECIFIC_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ViewRoutineUsageTableName},
 }
 
 var viewTableUsageSchema = Schema{
@@ -1138,6 +1139,25 @@ func keywordsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// optimizerTraceRowIter implements the sql.RowIter for the information_schema.OPTIMIZER_TRACE table.
+func optimizerTraceRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	traces, err := VisibleOptimizerTraces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var rows = make([]Row, len(traces))
+	for i, trace := range traces {
+		missingBytes := int32(trace.MissingBytesBeyondMaxMemSize)
+		rows[i] = Row{
+			trace.Query,  // query
+			trace.Trace,  // trace
+			missingBytes, // missing_bytes_beyond_max_mem_size
+			uint64(0),    // insufficient_privileges
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
+
 // processListRowIter implements the sql.RowIter for the information_schema.PROCESSLIST table.
 func processListRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	processes := ctx.ProcessList.Processes()
@@ -1148,7 +1168,9 @@ func processListRowIter(ctx *Context, c Catalog) (RowIter, error) {
 		for name, progress := range proc.Progress {
 			status = append(status, fmt.Sprintf("%s(%s)", name, progress))
 		}
-		if len(status) == 0 && proc.Command == ProcessCommandQuery {
+		if proc.State != "" {
+			status = []string{proc.State}
+		} else if len(status) == 0 && proc.Command == ProcessCommandQuery {
 			status = []string{"running"}
 		}
 		sort.Strings(status)
@@ -1264,6 +1286,30 @@ func referentialConstraintsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// resourceGroupsRowIter implements the sql.RowIter for the information_schema.RESOURCE_GROUPS table.
+func resourceGroupsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	rc, ok := c.(ResourceGroupCatalog)
+	if !ok || rc.GetResourceGroupRegistry() == nil {
+		return RowsToRowIter(), nil
+	}
+
+	var rows []Row
+	for _, g := range rc.GetResourceGroupRegistry().Groups() {
+		enabled := uint64(0)
+		if g.Enabled {
+			enabled = 1
+		}
+		rows = append(rows, Row{
+			g.Name,                            // resource_group_name
+			g.Type.String(),                   // resource_group_type
+			enabled,                           // resource_group_enable
+			ResourceGroupVCPUsString(g.VCPUs), // vcpu_ids
+			int32(g.ThreadPriority),           // thread_priority
+		})
+	}
+	return RowsToRowIter(rows...), nil
+}
+
 // schemaPrivilegesRowIter implements the sql.RowIter for the information_schema.SCHEMA_PRIVILEGES table.
 func schemaPrivilegesRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	var rows []Row
@@ -1439,7 +1485,7 @@ func stSpatialReferenceSystemsRowIter(ctx *Context, cat Catalog) (RowIter, error
 // stUnitsOfMeasureRowIter implements the sql.RowIter for the information_schema.ST_UNITS_OF_MEASURE table.
 func stUnitsOfMeasureRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 	var rows []Row
-	for _, spRef := range unitsOfMeasureArray {
+	for _, spRef := range types.UnitsOfMeasure {
 		rows = append(rows, Row{
 			spRef.Name,             // unit_name
 			spRef.Type,             // unit_type
@@ -1475,6 +1521,14 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 					return nil, iErr
 				}
 
+				var tableStats []Statistic
+				if len(indexes) > 0 {
+					tableStats, err = c.GetTableStats(ctx, db.Name(), tbl.Name())
+					if err != nil {
+						return nil, err
+					}
+				}
+
 				for _, index := range indexes {
 					var (
 						nonUnique    int
@@ -1494,6 +1548,8 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 					// setting `VISIBLE` is not supported, so defaulting it to "YES"
 					isVisible = "YES"
 
+					cardinalities := IndexCardinalities(tableStats, index)
+
 					// Create a Row for each column this index refers too.
 					i := 0
 					for j, expr := range index.Expressions() {
@@ -1503,7 +1559,7 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 							var (
 								collation   string
 								nullable    string
-								cardinality int64
+								cardinality interface{}
 								subPart     interface{}
 							)
 
@@ -1513,7 +1569,9 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 							// collation is "A" for ASC ; "D" for DESC ; "NULL" for not sorted
 							collation = "A"
 
-							// TODO : cardinality is an estimate of the number of unique values in the index.
+							// cardinality is an estimate of the number of unique values in the index prefix ending
+							// with this column, which is NULL for tables without statistics
+							cardinality = cardinalities[j]
 
 							if j < len(index.PrefixLengths()) {
 								subPart = int64(index.PrefixLengths()[j])
@@ -2199,21 +2257,19 @@ func viewsRowIter(ctx *Context, catalog Catalog) (RowIter, error) {
 			viewDef := view.TextDefinition
 			definer := removeBackticks(viewPlan.Definer)
 
-			// TODO: WITH CHECK OPTION is not supported yet.
 			checkOpt := viewPlan.CheckOpt
 			if checkOpt == "" {
 				checkOpt = "NONE"
 			}
 
 			isUpdatable := "YES"
-			// TODO: this function call should be done at CREATE VIEW time, not here
-			if !plan.GetIsUpdatableFromCreateView(viewPlan) {
+			if !viewPlan.IsUpdatable {
 				isUpdatable = "NO"
 			}
 
 			securityType := viewPlan.Security
 			if securityType == "" {
-				securityType = "DEFINER"
+				securityType = plan.ViewSecurityDefiner
 			}
 
 			rows = append(rows, Row{
@@ -2234,6 +2290,99 @@ func viewsRowIter(ctx *Context, catalog Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// viewTableUsageRowIter implements the sql.RowIter for the information_schema.VIEW_TABLE_USAGE table.
+func viewTableUsageRowIter(ctx *Context, catalog Catalog) (RowIter, error) {
+	var rows []Row
+	privSet, _ := ctx.GetPrivilegeSet()
+	if privSet == nil {
+		return RowsToRowIter(rows...), nil
+	}
+	hasGlobalShowViewPriv := privSet.Has(PrivilegeType_ShowView)
+	for _, db := range catalog.AllDatabases(ctx) {
+		dbName := db.Name()
+		privDbSet := privSet.Database(dbName)
+		hasDbShowViewPriv := privDbSet.Has(PrivilegeType_ShowView)
+
+		views, err := viewsInDatabase(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, view := range views {
+			privTblSet := privDbSet.Table(view.Name)
+			if !hasGlobalShowViewPriv && !hasDbShowViewPriv && !privTblSet.Has(PrivilegeType_ShowView) {
+				continue
+			}
+			parsedView, err := planbuilder.ParseWithOptions(ctx, catalog, view.CreateViewStatement, NewSqlModeFromString(view.SqlMode).ParserOptions())
+			if err != nil {
+				return nil, err
+			}
+			viewPlan, ok := parsedView.(*plan.CreateView)
+			if !ok {
+				return nil, fmt.Errorf("expected create view statement, found: %s", view.CreateViewStatement)
+			}
+
+			for _, table := range viewTableReferences(viewPlan.Child) {
+				rows = append(rows, Row{
+					"def",     // view_catalog
+					dbName,    // view_schema
+					view.Name, // view_name
+					"def",     // table_catalog
+					table[0],  // table_schema
+					table[1],  // table_name
+				})
+			}
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// viewTableReferences returns the database and name of each table or view selected from by the view definition |def|,
+// in the order they first appear. The tables of the views it selects from, and common table expressions, are not
+// included.
+func viewTableReferences(def Node) [][2]string {
+	var tables [][2]string
+	seen := make(map[[2]string]struct{})
+	add := func(db, name string) {
+		table := [2]string{db, name}
+		if _, ok := seen[table]; !ok {
+			seen[table] = struct{}{}
+			tables = append(tables, table)
+		}
+	}
+
+	var inspect func(n Node)
+	inspect = func(n Node) {
+		transform.Inspect(n, func(n Node) bool {
+			switch n := n.(type) {
+			case *plan.SubqueryAlias:
+				if n.ViewName != "" {
+					add(n.ViewDatabase, n.ViewName)
+					return false
+				}
+			case *plan.ResolvedTable:
+				if n.Database() != nil {
+					add(n.Database().Name(), n.Name())
+				}
+			}
+			if ne, ok := n.(Expressioner); ok {
+				for _, e := range ne.Expressions() {
+					transform.InspectExpr(e, func(e Expression) bool {
+						if sq, ok := e.(*plan.Subquery); ok {
+							inspect(sq.Query)
+						}
+						return false
+					})
+				}
+			}
+			return true
+		})
+	}
+	inspect(def)
+	return tables
+}
+
 // emptyRowIter implements the sql.RowIter for empty table.
 func emptyRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(), nil
@@ -2327,7 +2476,7 @@ func NewInformationSchemaDatabase() Database {
 			OptimizerTraceTableName: &informationSchemaTable{
 				name:   OptimizerTraceTableName,
 				schema: optimizerTraceSchema,
-				reader: emptyRowIter,
+				reader: optimizerTraceRowIter,
 			},
 			ParametersTableName: &routineTable{
 				name:    ParametersTableName,
@@ -2362,7 +2511,7 @@ func NewInformationSchemaDatabase() Database {
 			ResourceGroupsTableName: &informationSchemaTable{
 				name:   ResourceGroupsTableName,
 				schema: resourceGroupsSchema,
-				reader: emptyRowIter,
+				reader: resourceGroupsRowIter,
 			},
 			RoleColumnGrantsTableName: &informationSchemaTable{
 				name:   RoleColumnGrantsTableName,
@@ -2472,7 +2621,7 @@ func NewInformationSchemaDatabase() Database {
 			ViewTableUsageTableName: &informationSchemaTable{
 				name:   ViewTableUsageTableName,
 				schema: viewTableUsageSchema,
-				reader: emptyRowIter,
+				reader: viewTableUsageRowIter,
 			},
 			ViewsTableName: &informationSchemaTable{
 				name:   ViewsTableName,
@@ -2647,7 +2796,7 @@ func (db *informationSchemaDatabase) Name() string { return db.name }
 
 func (db *informationSchemaDatabase) GetTableInsensitive(ctx *Context, tblName string) (Table, bool, error) {
 	// The columns table has dynamic information that can't be cached across queries
-	if strings.ToLower(tblName) == ColumnsTableName {
+	if db.name == InformationSchemaDatabaseName && strings.ToLower(tblName) == ColumnsTableName {
 		return &ColumnsTable{}, true, nil
 	}
 
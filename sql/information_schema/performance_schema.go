@@ -0,0 +1,384 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package information_schema
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	. "github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+const (
+	// ReplicationConnectionStatusTableName is the name of the REPLICATION_CONNECTION_STATUS table.
+	ReplicationConnectionStatusTableName = "replication_connection_status"
+	// ReplicationApplierStatusTableName is the name of the REPLICATION_APPLIER_STATUS table.
+	ReplicationApplierStatusTableName = "replication_applier_status"
+	// ReplicationApplierStatusByWorkerTableName is the name of the REPLICATION_APPLIER_STATUS_BY_WORKER table.
+	ReplicationApplierStatusByWorkerTableName = "replication_applier_status_by_worker"
+	// MetadataLocksTableName is the name of the METADATA_LOCKS table.
+	MetadataLocksTableName = "metadata_locks"
+	// ThreadsTableName is the name of the THREADS table.
+	ThreadsTableName = "threads"
+	// GlobalVariablesTableName is the name of the GLOBAL_VARIABLES table.
+	GlobalVariablesTableName = "global_variables"
+	// SessionVariablesTableName is the name of the SESSION_VARIABLES table.
+	SessionVariablesTableName = "session_variables"
+)
+
+var replicationConnectionStatusSchema = Schema{
+	{Name: "CHANNEL_NAME", Type: types.MustCreateString(sqltypes.Char, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ReplicationConnectionStatusTableName},
+	{Name: "GROUP_NAME", Type: types.MustCreateString(sqltypes.Char, 36, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ReplicationConnectionStatusTableName},
+	{Name: "SOURCE_UUID", Type: types.MustCreateString(sqltypes.Char, 36, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ReplicationConnectionStatusTableName},
+	{Name: "THREAD_ID", Type: types.Uint64, Default: nil, Nullable: true, Source: ReplicationConnectionStatusTableName},
+	{Name: "SERVICE_STATE", Type: types.MustCreateEnumType([]string{"ON", "OFF", "CONNECTING"}, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ReplicationConnectionStatusTableName},
+	{Name: "COUNT_RECEIVED_HEARTBEATS", Type: types.Uint64, Default: nil, Nullable: false, Source: ReplicationConnectionStatusTableName},
+	{Name: "LAST_HEARTBEAT_TIMESTAMP", Type: types.TimestampMaxPrecision, Default: nil, Nullable: true, Source: ReplicationConnectionStatusTableName},
+	{Name: "RECEIVED_TRANSACTION_SET", Type: types.LongText, Default: nil, Nullable: false, Source: ReplicationConnectionStatusTableName},
+	{Name: "LAST_ERROR_NUMBER", Type: types.Int32, Default: nil, Nullable: false, Source: ReplicationConnectionStatusTableName},
+	{Name: "LAST_ERROR_MESSAGE", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ReplicationConnectionStatusTableName},
+	{Name: "LAST_ERROR_TIMESTAMP", Type: types.TimestampMaxPrecision, Default: nil, Nullable: true, Source: ReplicationConnectionStatusTableName},
+}
+
+var replicationApplierStatusSchema = Schema{
+	{Name: "CHANNEL_NAME", Type: types.MustCreateString(sqltypes.Char, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ReplicationApplierStatusTableName},
+	{Name: "SERVICE_STATE", Type: types.MustCreateEnumType([]string{"ON", "OFF"}, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ReplicationApplierStatusTableName},
+	{Name: "REMAINING_DELAY", Type: types.Uint32, Default: nil, Nullable: true, Source: ReplicationApplierStatusTableName},
+	{Name: "COUNT_TRANSACTIONS_RETRIES", Type: types.Uint64, Default: nil, Nullable: false, Source: ReplicationApplierStatusTableName},
+}
+
+var replicationApplierStatusByWorkerSchema = Schema{
+	{Name: "CHANNEL_NAME", Type: types.MustCreateString(sqltypes.Char, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ReplicationApplierStatusByWorkerTableName},
+	{Name: "WORKER_ID", Type: types.Uint64, Default: nil, Nullable: false, Source: ReplicationApplierStatusByWorkerTableName},
+	{Name: "THREAD_ID", Type: types.Uint64, Default: nil, Nullable: true, Source: ReplicationApplierStatusByWorkerTableName},
+	{Name: "SERVICE_STATE", Type: types.MustCreateEnumType([]string{"ON", "OFF"}, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ReplicationApplierStatusByWorkerTableName},
+	{Name: "LAST_ERROR_NUMBER", Type: types.Int32, Default: nil, Nullable: false, Source: ReplicationApplierStatusByWorkerTableName},
+	{Name: "LAST_ERROR_MESSAGE", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ReplicationApplierStatusByWorkerTableName},
+	{Name: "LAST_ERROR_TIMESTAMP", Type: types.TimestampMaxPrecision, Default: nil, Nullable: true, Source: ReplicationApplierStatusByWorkerTableName},
+	{Name: "LAST_APPLIED_TRANSACTION", Type: types.LongText, Default: nil, Nullable: true, Source: ReplicationApplierStatusByWorkerTableName},
+	{Name: "APPLYING_TRANSACTION", Type: types.LongText, Default: nil, Nullable: true, Source: ReplicationApplierStatusByWorkerTableName},
+}
+
+var metadataLocksSchema = Schema{
+	{Name: "OBJECT_TYPE", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: MetadataLocksTableName},
+	{Name: "OBJECT_SCHEMA", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: MetadataLocksTableName},
+	{Name: "OBJECT_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: MetadataLocksTableName},
+	{Name: "COLUMN_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: MetadataLocksTableName},
+	{Name: "OBJECT_INSTANCE_BEGIN", Type: types.Uint64, Default: nil, Nullable: false, Source: MetadataLocksTableName},
+	{Name: "LOCK_TYPE", Type: types.MustCreateString(sqltypes.VarChar, 32, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: MetadataLocksTableName},
+	{Name: "LOCK_DURATION", Type: types.MustCreateString(sqltypes.VarChar, 32, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: MetadataLocksTableName},
+	{Name: "LOCK_STATUS", Type: types.MustCreateString(sqltypes.VarChar, 32, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: MetadataLocksTableName},
+	{Name: "SOURCE", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: MetadataLocksTableName},
+	{Name: "OWNER_THREAD_ID", Type: types.Uint64, Default: nil, Nullable: true, Source: MetadataLocksTableName},
+	{Name: "OWNER_EVENT_ID", Type: types.Uint64, Default: nil, Nullable: true, Source: MetadataLocksTableName},
+}
+
+var threadsSchema = Schema{
+	{Name: "THREAD_ID", Type: types.Uint64, Default: nil, Nullable: false, Source: ThreadsTableName},
+	{Name: "NAME", Type: types.MustCreateString(sqltypes.VarChar, 128, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ThreadsTableName},
+	{Name: "TYPE", Type: types.MustCreateString(sqltypes.VarChar, 10, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ThreadsTableName},
+	{Name: "PROCESSLIST_ID", Type: types.Uint64, Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "PROCESSLIST_USER", Type: types.MustCreateString(sqltypes.VarChar, 32, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "PROCESSLIST_HOST", Type: types.MustCreateString(sqltypes.VarChar, 255, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "PROCESSLIST_DB", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "PROCESSLIST_COMMAND", Type: types.MustCreateString(sqltypes.VarChar, 16, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "PROCESSLIST_TIME", Type: types.Int64, Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "PROCESSLIST_STATE", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "PROCESSLIST_INFO", Type: types.LongText, Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "PARENT_THREAD_ID", Type: types.Uint64, Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "ROLE", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "INSTRUMENTED", Type: types.MustCreateEnumType([]string{"YES", "NO"}, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ThreadsTableName},
+	{Name: "HISTORY", Type: types.MustCreateEnumType([]string{"YES", "NO"}, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ThreadsTableName},
+	{Name: "CONNECTION_TYPE", Type: types.MustCreateString(sqltypes.VarChar, 16, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "THREAD_OS_ID", Type: types.Uint64, Default: nil, Nullable: true, Source: ThreadsTableName},
+	{Name: "RESOURCE_GROUP", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: ThreadsTableName},
+}
+
+var globalVariablesSchema = Schema{
+	{Name: "VARIABLE_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: GlobalVariablesTableName},
+	{Name: "VARIABLE_VALUE", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: GlobalVariablesTableName},
+}
+
+var sessionVariablesSchema = Schema{
+	{Name: "VARIABLE_NAME", Type: types.MustCreateString(sqltypes.VarChar, 64, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: SessionVariablesTableName},
+	{Name: "VARIABLE_VALUE", Type: types.MustCreateString(sqltypes.VarChar, 1024, Collation_Information_Schema_Default), Default: nil, Nullable: true, Source: SessionVariablesTableName},
+}
+
+// performanceSchemaTable is an informationSchemaTable that belongs to the performance_schema database.
+type performanceSchemaTable struct {
+	*informationSchemaTable
+}
+
+// Database implements the sql.Databaseable interface.
+func (t *performanceSchemaTable) Database() string {
+	return PerformanceSchemaDatabaseName
+}
+
+func (t *performanceSchemaTable) AssignCatalog(cat Catalog) Table {
+	t.catalog = cat
+	return t
+}
+
+// NewPerformanceSchemaDatabase creates a new PERFORMANCE_SCHEMA Database. Only the replication status tables, which are
+// populated from the BinlogReplicaController of the catalog, and the metadata locks, threads, and system variables
+// tables are provided.
+func NewPerformanceSchemaDatabase() Database {
+	return &informationSchemaDatabase{
+		name: PerformanceSchemaDatabaseName,
+		tables: map[string]Table{
+			ReplicationConnectionStatusTableName: &performanceSchemaTable{&informationSchemaTable{
+				name:   ReplicationConnectionStatusTableName,
+				schema: replicationConnectionStatusSchema,
+				reader: replicationConnectionStatusRowIter,
+			}},
+			ReplicationApplierStatusTableName: &performanceSchemaTable{&informationSchemaTable{
+				name:   ReplicationApplierStatusTableName,
+				schema: replicationApplierStatusSchema,
+				reader: replicationApplierStatusRowIter,
+			}},
+			ReplicationApplierStatusByWorkerTableName: &performanceSchemaTable{&informationSchemaTable{
+				name:   ReplicationApplierStatusByWorkerTableName,
+				schema: replicationApplierStatusByWorkerSchema,
+				reader: replicationApplierStatusByWorkerRowIter,
+			}},
+			MetadataLocksTableName: &performanceSchemaTable{&informationSchemaTable{
+				name:   MetadataLocksTableName,
+				schema: metadataLocksSchema,
+				reader: metadataLocksRowIter,
+			}},
+			ThreadsTableName: &performanceSchemaTable{&informationSchemaTable{
+				name:   ThreadsTableName,
+				schema: threadsSchema,
+				reader: threadsRowIter,
+			}},
+			GlobalVariablesTableName: &performanceSchemaTable{&informationSchemaTable{
+				name:   GlobalVariablesTableName,
+				schema: globalVariablesSchema,
+				reader: globalVariablesRowIter,
+			}},
+			SessionVariablesTableName: &performanceSchemaTable{&informationSchemaTable{
+				name:   SessionVariablesTableName,
+				schema: sessionVariablesSchema,
+				reader: sessionVariablesRowIter,
+			}},
+		},
+	}
+}
+
+// replicaStatus returns the status of the catalog's binlog replica, or nil if the catalog has no replica controller
+// or no replication processes are running.
+func replicaStatus(ctx *Context, c Catalog) (*binlogreplication.ReplicaStatus, error) {
+	rc, ok := c.(binlogreplication.BinlogReplicaCatalog)
+	if !ok || !rc.IsBinlogReplicaCatalog() {
+		return nil, nil
+	}
+	return rc.GetBinlogReplicaController().GetReplicaStatus(ctx)
+}
+
+// replicationConnectionStatusRowIter implements the sql.RowIter for the performance_schema.REPLICATION_CONNECTION_STATUS table.
+func replicationConnectionStatusRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	status, err := replicaStatus(ctx, c)
+	if err != nil || status == nil {
+		return RowsToRowIter(), err
+	}
+
+	var serviceState string
+	switch status.ReplicaIoRunning {
+	case binlogreplication.ReplicaIoRunning:
+		serviceState = "ON"
+	case binlogreplication.ReplicaIoConnecting:
+		serviceState = "CONNECTING"
+	default:
+		serviceState = "OFF"
+	}
+
+	return RowsToRowIter(Row{
+		"",                            // CHANNEL_NAME
+		"",                            // GROUP_NAME
+		status.SourceServerUuid,       // SOURCE_UUID
+		nil,                           // THREAD_ID
+		serviceState,                  // SERVICE_STATE
+		uint64(0),                     // COUNT_RECEIVED_HEARTBEATS
+		nil,                           // LAST_HEARTBEAT_TIMESTAMP
+		status.RetrievedGtidSet,       // RECEIVED_TRANSACTION_SET
+		int32(status.LastIoErrNumber), // LAST_ERROR_NUMBER
+		status.LastIoError,            // LAST_ERROR_MESSAGE
+		errorTimestamp(status.LastIoErrorTimestamp), // LAST_ERROR_TIMESTAMP
+	}), nil
+}
+
+// replicationApplierStatusRowIter implements the sql.RowIter for the performance_schema.REPLICATION_APPLIER_STATUS table.
+func replicationApplierStatusRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	status, err := replicaStatus(ctx, c)
+	if err != nil || status == nil {
+		return RowsToRowIter(), err
+	}
+
+	return RowsToRowIter(Row{
+		"",                          // CHANNEL_NAME
+		applierServiceState(status), // SERVICE_STATE
+		nil,                         // REMAINING_DELAY
+		uint64(0),                   // COUNT_TRANSACTIONS_RETRIES
+	}), nil
+}
+
+// replicationApplierStatusByWorkerRowIter implements the sql.RowIter for the
+// performance_schema.REPLICATION_APPLIER_STATUS_BY_WORKER table. The applier is single threaded, so there is a single
+// worker.
+func replicationApplierStatusByWorkerRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	status, err := replicaStatus(ctx, c)
+	if err != nil || status == nil {
+		return RowsToRowIter(), err
+	}
+
+	return RowsToRowIter(Row{
+		"",                             // CHANNEL_NAME
+		uint64(0),                      // WORKER_ID
+		nil,                            // THREAD_ID
+		applierServiceState(status),    // SERVICE_STATE
+		int32(status.LastSqlErrNumber), // LAST_ERROR_NUMBER
+		status.LastSqlError,            // LAST_ERROR_MESSAGE
+		errorTimestamp(status.LastSqlErrorTimestamp), // LAST_ERROR_TIMESTAMP
+		nil, // LAST_APPLIED_TRANSACTION
+		nil, // APPLYING_TRANSACTION
+	}), nil
+}
+
+// metadataLocksRowIter implements the sql.RowIter for the performance_schema.METADATA_LOCKS table. Connection IDs
+// are reported as thread IDs.
+func metadataLocksRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	mc, ok := c.(MetadataLockCatalog)
+	if !ok {
+		return RowsToRowIter(), nil
+	}
+
+	var rows []Row
+	for _, lock := range mc.GetMetadataLockManager().Locks() {
+		status := "PENDING"
+		if lock.Granted {
+			status = "GRANTED"
+		}
+		rows = append(rows, Row{
+			"TABLE",                   // object_type
+			lock.Schema,               // object_schema
+			lock.Table,                // object_name
+			nil,                       // column_name
+			uint64(0),                 // object_instance_begin
+			lock.Type.String(),        // lock_type
+			"TRANSACTION",             // lock_duration
+			status,                    // lock_status
+			nil,                       // source
+			uint64(lock.ConnectionID), // owner_thread_id
+			nil,                       // owner_event_id
+		})
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+// threadsRowIter implements the sql.RowIter for the performance_schema.THREADS table. There is a thread for each
+// connection in the process list, and its connection ID is reported as its thread ID.
+func threadsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	var registry *ResourceGroupRegistry
+	if rc, ok := c.(ResourceGroupCatalog); ok {
+		registry = rc.GetResourceGroupRegistry()
+	}
+
+	processes := ctx.ProcessList.Processes()
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].Connection < processes[j].Connection
+	})
+	rows := make([]Row, len(processes))
+	for i, proc := range processes {
+		var db, state, info, group interface{}
+		if proc.Database != "" {
+			db = proc.Database
+		}
+		if proc.State != "" {
+			state = proc.State
+		}
+		if proc.Command == ProcessCommandQuery {
+			info = proc.Query
+		}
+		if registry != nil {
+			group = registry.ThreadGroup(proc.Connection)
+		}
+		rows[i] = Row{
+			uint64(proc.Connection),     // thread_id
+			"thread/sql/one_connection", // name
+			"FOREGROUND",                // type
+			uint64(proc.Connection),     // processlist_id
+			proc.User,                   // processlist_user
+			proc.Host,                   // processlist_host
+			db,                          // processlist_db
+			string(proc.Command),        // processlist_command
+			int64(proc.Seconds()),       // processlist_time
+			state,                       // processlist_state
+			info,                        // processlist_info
+			nil,                         // parent_thread_id
+			nil,                         // role
+			"YES",                       // instrumented
+			"YES",                       // history
+			nil,                         // connection_type
+			nil,                         // thread_os_id
+			group,                       // resource_group
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+// globalVariablesRowIter implements the sql.RowIter for the performance_schema.GLOBAL_VARIABLES table.
+func globalVariablesRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	return systemVariableRows(SystemVariables.GetAllGlobalVariables()), nil
+}
+
+// sessionVariablesRowIter implements the sql.RowIter for the performance_schema.SESSION_VARIABLES table.
+func sessionVariablesRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	return systemVariableRows(ctx.GetAllSessionVariables()), nil
+}
+
+// systemVariableRows returns the given system variable values as rows sorted by variable name.
+func systemVariableRows(vars map[string]interface{}) RowIter {
+	rows := make([]Row, 0, len(vars))
+	for name, val := range vars {
+		if val != nil {
+			val = fmt.Sprint(val)
+		}
+		rows = append(rows, Row{name, val})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0].(string) < rows[j][0].(string)
+	})
+	return RowsToRowIter(rows...)
+}
+
+func applierServiceState(status *binlogreplication.ReplicaStatus) string {
+	if status.ReplicaSqlRunning == binlogreplication.ReplicaSqlRunning {
+		return "ON"
+	}
+	return "OFF"
+}
+
+// errorTimestamp returns |t| as a row value, or nil if no error has been recorded.
+func errorTimestamp(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
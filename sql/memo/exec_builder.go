@@ -39,7 +39,9 @@ func (b *ExecBuilder) buildCrossJoin(j *CrossJoin, children ...sql.Node) (sql.No
 
 func (b *ExecBuilder) buildLeftJoin(j *LeftJoin, children ...sql.Node) (sql.Node, error) {
 	filters := b.buildFilterConjunction(j.Filter...)
-	return plan.NewLeftOuterJoin(children[0], children[1], filters), nil
+	// The join type also carries whether the join excludes rows whose filter is NULL, for anti joins converted to
+	// left joins
+	return plan.NewJoin(children[0], children[1], j.Op, filters), nil
 }
 
 func (b *ExecBuilder) buildFullOuterJoin(j *FullOuterJoin, children ...sql.Node) (sql.Node, error) {
@@ -140,7 +140,7 @@ func (e *ExprGroup) fixTableScanPath() bool {
 				if c.fixTableScanPath() {
 					// found path, update best
 					e.Best = n
-					n.SetDistinct(NoDistinctOp)
+					n.SetDistinct(e.tableScanPathDistinct())
 					e.Done = true
 					return true
 				}
@@ -154,7 +154,7 @@ func (e *ExprGroup) fixTableScanPath() bool {
 			continue
 		}
 		// is a source, not an indexScan
-		n.SetDistinct(NoDistinctOp)
+		n.SetDistinct(e.tableScanPathDistinct())
 		e.Best = n
 		e.HintOk = true
 		e.Done = true
@@ -163,6 +163,17 @@ func (e *ExprGroup) fixTableScanPath() bool {
 	return false
 }
 
+// tableScanPathDistinct returns the distinct operator for a replacement
+// |best| plan. A group that must be deduplicated, like the materialized
+// right side of a semi join, keeps a hash distinct unless its output
+// columns are already a strict key.
+func (e *ExprGroup) tableScanPathDistinct() distinctOp {
+	if e.RelProps.Distinct.IsHash() && !e.RelProps.FuncDeps().ColsAreStrictKey(e.RelProps.OutputCols()) {
+		return HashDistinctOp
+	}
+	return NoDistinctOp
+}
+
 func (e *ExprGroup) String() string {
 	b := strings.Builder{}
 	n := e.First
@@ -20,11 +20,17 @@ func _() {
 	_ = x[HintTypeLeftOuterLookupJoin-9]
 	_ = x[HintTypeNoIndexConditionPushDown-10]
 	_ = x[HintTypeLeftDeep-11]
+	_ = x[HintTypeSemiJoinStrategy-12]
+	_ = x[HintTypeNoSemiJoinStrategy-13]
+	_ = x[HintTypeMaxExecutionTime-14]
+	_ = x[HintTypeSetVar-15]
+	_ = x[HintTypeNoMerge-16]
+	_ = x[HintTypeResourceGroup-17]
 }
 
-const _HintType_name = "JOIN_ORDERJOIN_FIXED_ORDERMERGE_JOINLOOKUP_JOINHASH_JOINSEMI_JOINANTI_JOININNER_JOINLEFT_OUTER_LOOKUP_JOINNO_ICPLEFT_DEEP"
+const _HintType_name = "JOIN_ORDERJOIN_FIXED_ORDERMERGE_JOINLOOKUP_JOINHASH_JOINSEMI_JOINANTI_JOININNER_JOINLEFT_OUTER_LOOKUP_JOINNO_ICPLEFT_DEEPSEMIJOINNO_SEMIJOINMAX_EXECUTION_TIMESET_VARNO_MERGERESOURCE_GROUP"
 
-var _HintType_index = [...]uint8{0, 0, 10, 26, 36, 47, 56, 65, 74, 84, 106, 112, 121}
+var _HintType_index = [...]uint8{0, 0, 10, 26, 36, 47, 56, 65, 74, 84, 106, 112, 121, 129, 140, 158, 165, 173, 187}
 
 func (i HintType) String() string {
 	if i >= HintType(len(_HintType_index)-1) {
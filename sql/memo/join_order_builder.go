@@ -198,6 +198,11 @@ func (j *joinOrderBuilder) populateSubgraph(n sql.Node) (vertexSet, edgeSet, *Ex
 	case *plan.Having:
 		return j.buildFilter(n.Child, n.Cond)
 	case *plan.Limit:
+		if n.Percent || n.WithTies {
+			// the relational properties can only represent a limit of a fixed number of rows
+			err := fmt.Errorf("%w: %T", ErrUnsupportedReorderNode, n)
+			j.m.HandleErr(err)
+		}
 		_, _, group = j.populateSubgraph(n.Child)
 		group.RelProps.Limit = n.Limit
 	case *plan.Project:
@@ -795,7 +800,7 @@ func (j *joinOrderBuilder) constructJoin(
 		rel = &LeftJoin{b}
 	case plan.JoinTypeSemi:
 		rel = &SemiJoin{b}
-	case plan.JoinTypeAnti:
+	case plan.JoinTypeAnti, plan.JoinTypeAntiIncludeNulls:
 		rel = &AntiJoin{b}
 	case plan.JoinTypeLateralInner, plan.JoinTypeLateralCross,
 		plan.JoinTypeLateralRight, plan.JoinTypeLateralLeft:
@@ -1412,7 +1417,7 @@ func getOpIdx(e *edge) int {
 		return 1
 	case plan.JoinTypeSemi:
 		return 2
-	case plan.JoinTypeAnti:
+	case plan.JoinTypeAnti, plan.JoinTypeAntiIncludeNulls:
 		return 3
 	case plan.JoinTypeLeftOuter:
 		return 4
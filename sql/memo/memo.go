@@ -492,10 +492,32 @@ func (m *Memo) ApplyHint(hint Hint) {
 		m.WithJoinOp(hint.Typ, hint.Args[0], hint.Args[1])
 	case HintTypeLeftDeep:
 		m.hints.leftDeep = true
+	case HintTypeSemiJoinStrategy, HintTypeNoSemiJoinStrategy:
+		m.WithSemiJoinStrategy(hint)
 	default:
 	}
 }
 
+// WithSemiJoinStrategy narrows the strategies allowed for semi joins.
+// SEMIJOIN hints intersect, and NO_SEMIJOIN hints subtract from, the
+// current set of strategies. Hints without strategies have no effect;
+// subqueries are always unnested into semi joins before join planning.
+func (m *Memo) WithSemiJoinStrategy(hint Hint) {
+	strategies, ok := parseSemiJoinStrategies(hint.Args)
+	if !ok || strategies == 0 {
+		return
+	}
+	if m.hints.semiJoin == nil {
+		m.hints.semiJoin = newSemiJoinHint(m.root, semiJoinStrategyAll)
+	}
+	switch hint.Typ {
+	case HintTypeSemiJoinStrategy:
+		m.hints.semiJoin.allowed &= strategies
+	case HintTypeNoSemiJoinStrategy:
+		m.hints.semiJoin.allowed &^= strategies
+	}
+}
+
 func (m *Memo) WithJoinOrder(tables []string) {
 	// order maps groupId -> table dependencies
 	order := make(map[sql.TableId]uint64)
@@ -262,7 +262,9 @@ func (p *relProps) populateFds() {
 				if l, ok := f.Left().(*expression.GetField); ok {
 					switch r := f.Right().(type) {
 					case *expression.GetField:
-						equiv = append(equiv, [2]sql.ColumnId{l.Id(), r.Id()})
+						if !expression.ConvertsOperands(l.Type(), r.Type()) {
+							equiv = append(equiv, [2]sql.ColumnId{l.Id(), r.Id()})
+						}
 					case *expression.Literal:
 						constant.Add(l.Id())
 						if r.Value() != nil {
@@ -273,7 +275,9 @@ func (p *relProps) populateFds() {
 				if r, ok := f.Right().(*expression.GetField); ok {
 					switch l := f.Left().(type) {
 					case *expression.GetField:
-						equiv = append(equiv, [2]sql.ColumnId{l.Id(), r.Id()})
+						if !expression.ConvertsOperands(l.Type(), r.Type()) {
+							equiv = append(equiv, [2]sql.ColumnId{l.Id(), r.Id()})
+						}
 					case *expression.Literal:
 						constant.Add(r.Id())
 						if l.Value() != nil {
@@ -579,7 +583,7 @@ func getEquivs(filters []sql.Expression) [][2]sql.ColumnId {
 			l, _ = f.Left().(*expression.GetField)
 			r, _ = f.Right().(*expression.GetField)
 		}
-		if l != nil && r != nil {
+		if l != nil && r != nil && !expression.ConvertsOperands(l.Type(), r.Type()) {
 			ret = append(ret, [2]sql.ColumnId{l.Id(), r.Id()})
 		}
 	}
@@ -17,6 +17,7 @@ package memo
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -41,6 +42,12 @@ const (
 	HintTypeLeftOuterLookupJoin                      // LEFT_OUTER_LOOKUP_JOIN
 	HintTypeNoIndexConditionPushDown                 // NO_ICP
 	HintTypeLeftDeep                                 // LEFT_DEEP
+	HintTypeSemiJoinStrategy                         // SEMIJOIN
+	HintTypeNoSemiJoinStrategy                       // NO_SEMIJOIN
+	HintTypeMaxExecutionTime                         // MAX_EXECUTION_TIME
+	HintTypeSetVar                                   // SET_VAR
+	HintTypeNoMerge                                  // NO_MERGE
+	HintTypeResourceGroup                            // RESOURCE_GROUP
 )
 
 type Hint struct {
@@ -81,6 +88,18 @@ func newHint(joinTyp string, args []string) Hint {
 		typ = HintTypeNoIndexConditionPushDown
 	case "left_deep":
 		typ = HintTypeLeftDeep
+	case "semijoin":
+		typ = HintTypeSemiJoinStrategy
+	case "no_semijoin":
+		typ = HintTypeNoSemiJoinStrategy
+	case "max_execution_time":
+		typ = HintTypeMaxExecutionTime
+	case "set_var":
+		typ = HintTypeSetVar
+	case "no_merge":
+		typ = HintTypeNoMerge
+	case "resource_group":
+		typ = HintTypeResourceGroup
 	default:
 		typ = HintTypeUnknown
 	}
@@ -111,6 +130,22 @@ func (h Hint) valid() bool {
 		return len(h.Args) == 0
 	case HintTypeLeftDeep:
 		return len(h.Args) == 0
+	case HintTypeSemiJoinStrategy, HintTypeNoSemiJoinStrategy:
+		_, ok := parseSemiJoinStrategies(h.Args)
+		return ok
+	case HintTypeMaxExecutionTime:
+		if len(h.Args) != 1 {
+			return false
+		}
+		_, err := strconv.ParseUint(h.Args[0], 10, 32)
+		return err == nil
+	case HintTypeSetVar:
+		return len(h.Args) == 2 && h.Args[0] != "" && h.Args[1] != ""
+	case HintTypeNoMerge:
+		// NO_MERGE accepts any number of table names, and without any applies to every derived table
+		return true
+	case HintTypeResourceGroup:
+		return len(h.Args) == 1
 	case HintTypeUnknown:
 		return false
 	default:
@@ -118,32 +153,39 @@ func (h Hint) valid() bool {
 	return true
 }
 
-var hintRegex = regexp.MustCompile("([a-z_]+)(\\(([^\\(]+)\\))?")
+var hintRegex = regexp.MustCompile("(?i)([a-z_]+)(\\(([^\\(]+)\\))?")
 var argsRegex = regexp.MustCompile("\\s*([^\\(,\\s]+)\\s*[,\\s*]?")
 
 func ExtractJoinHint(n *plan.JoinNode) []Hint {
 	if n.Comment() != "" {
-		return parseJoinHints(n.Comment())
+		return ParseHints(n.Comment())
 	}
 	return nil
 }
 
+// ParseHints returns the valid hints in an optimizer hint comment,
+// which starts with /*+. Hint names and arguments are lowercased,
+// except for the value of a SET_VAR hint, whose arguments are the
+// variable name and the text of its value.
 // TODO: this is pretty nasty. Should be done in the parser instead.
-func parseJoinHints(comment string) []Hint {
+func ParseHints(comment string) []Hint {
 	if !strings.HasPrefix(comment, "/*+") {
 		return nil
 	}
 	var hints []Hint
-	comments := hintRegex.FindAllStringSubmatch(strings.ToLower(comment), -1)
+	comments := hintRegex.FindAllStringSubmatch(comment, -1)
 	for _, c := range comments {
+		name := strings.ToLower(c[1])
 		var args []string
-		if c[3] != "" {
-			argsParsed := argsRegex.FindAllStringSubmatch(c[3], -1)
+		if name == "set_var" {
+			args = parseSetVarArgs(c[3])
+		} else if c[3] != "" {
+			argsParsed := argsRegex.FindAllStringSubmatch(strings.ToLower(c[3]), -1)
 			for _, arg := range argsParsed {
 				args = append(args, arg[1])
 			}
 		}
-		hint := newHint(c[1], args)
+		hint := newHint(name, args)
 		if hint.valid() {
 			hints = append(hints, hint)
 		}
@@ -151,6 +193,16 @@ func parseJoinHints(comment string) []Hint {
 	return hints
 }
 
+// parseSetVarArgs splits the arguments of a SET_VAR(name=value) hint
+// into the lowercased variable name and the text of the value.
+func parseSetVarArgs(args string) []string {
+	name, value, ok := strings.Cut(args, "=")
+	if !ok {
+		return nil
+	}
+	return []string{strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(value)}
+}
+
 // joinOrderHint encodes a groups relational dependencies in a bitset
 // by mapping group ids into join_order ordinals. Remapping source
 // relations from group -> join_order ordinal makes it easy to perform
@@ -367,16 +419,114 @@ func (o joinOpHint) typeMatches(n RelExpr) bool {
 	return true
 }
 
+// semiJoinStrategy is a bitset of the execution strategies
+// named by SEMIJOIN and NO_SEMIJOIN hints.
+type semiJoinStrategy uint8
+
+const (
+	semiJoinStrategyFirstMatch semiJoinStrategy = 1 << iota
+	semiJoinStrategyLooseScan
+	semiJoinStrategyDuplicateWeedout
+	semiJoinStrategyMaterialization
+
+	semiJoinStrategyAll = semiJoinStrategyFirstMatch | semiJoinStrategyLooseScan | semiJoinStrategyDuplicateWeedout | semiJoinStrategyMaterialization
+)
+
+// parseSemiJoinStrategies converts SEMIJOIN hint arguments into a
+// strategy set. Query block names (@qb) are ignored.
+func parseSemiJoinStrategies(args []string) (semiJoinStrategy, bool) {
+	var s semiJoinStrategy
+	for _, arg := range args {
+		switch arg {
+		case "firstmatch":
+			s |= semiJoinStrategyFirstMatch
+		case "loosescan":
+			s |= semiJoinStrategyLooseScan
+		case "duplicateweedout":
+			s |= semiJoinStrategyDuplicateWeedout
+		case "materialization":
+			s |= semiJoinStrategyMaterialization
+		default:
+			if !strings.HasPrefix(arg, "@") {
+				return 0, false
+			}
+		}
+	}
+	return s, true
+}
+
+// semiJoinHint restricts the physical plans chosen for semi join
+// groups to the subset implementing an allowed strategy.
+//
+//   - FIRSTMATCH: nested loop, lookup and merge semi joins that stop
+//     probing the right side after the first match.
+//   - MATERIALIZATION: hash semi joins, and inner joins against a
+//     deduplicated, materialized right side.
+//   - LOOSESCAN: lookups into the left side driven by a deduplicated
+//     scan of the right side.
+//
+// DUPLICATEWEEDOUT has no implementation, and is only satisfied by
+// falling back to the lowest cost plan.
+type semiJoinHint struct {
+	allowed semiJoinStrategy
+	groups  map[GroupId]struct{}
+}
+
+func newSemiJoinHint(root *ExprGroup, allowed semiJoinStrategy) *semiJoinHint {
+	h := &semiJoinHint{
+		allowed: allowed,
+		groups:  make(map[GroupId]struct{}),
+	}
+	DfsRel(root, func(e RelExpr) error {
+		if _, ok := e.(*SemiJoin); ok {
+			h.groups[e.Group().Id] = struct{}{}
+		}
+		return nil
+	})
+	return h
+}
+
+// satisfiedBy returns whether a RelExpr either belongs to a
+// non-semi join group, or implements an allowed strategy.
+func (h semiJoinHint) satisfiedBy(n RelExpr) bool {
+	if _, ok := h.groups[n.Group().Id]; !ok {
+		return true
+	}
+	return h.allowed&semiJoinStrategyOf(n) != 0
+}
+
+// semiJoinStrategyOf returns the strategy implemented by an
+// expression in a semi join group.
+func semiJoinStrategyOf(n RelExpr) semiJoinStrategy {
+	switch n := n.(type) {
+	case JoinRel:
+		switch n.JoinPrivate().Op {
+		case plan.JoinTypeSemi, plan.JoinTypeSemiLookup, plan.JoinTypeSemiMerge:
+			return semiJoinStrategyFirstMatch
+		case plan.JoinTypeSemiHash:
+			return semiJoinStrategyMaterialization
+		case plan.JoinTypeLookup:
+			// see addRightSemiJoins
+			return semiJoinStrategyLooseScan
+		}
+	case *Project:
+		// see convertSemiToInnerJoin
+		return semiJoinStrategyMaterialization
+	}
+	return 0
+}
+
 // joinHints wraps a collection of join hints. The memo
 // interfaces with this object during costing.
 type joinHints struct {
 	ops      []joinOpHint
 	order    *joinOrderHint
 	leftDeep bool
+	semiJoin *semiJoinHint
 }
 
 func (h joinHints) isEmpty() bool {
-	return len(h.ops) == 0 && h.order == nil && !h.leftDeep
+	return len(h.ops) == 0 && h.order == nil && !h.leftDeep && h.semiJoin == nil
 }
 
 // satisfiedBy returns whether a RelExpr satisfies every join hint. This
@@ -395,6 +545,10 @@ func (h joinHints) satisfiedBy(n RelExpr) bool {
 		}
 	}
 
+	if h.semiJoin != nil && !h.semiJoin.satisfiedBy(n) {
+		return false
+	}
+
 	if h.ops == nil {
 		return true
 	}
@@ -95,6 +95,57 @@ func TestHintParsing(t *testing.T) {
 			comment: "/*+ anti_join(a,b) */",
 			hints:   []Hint{{Typ: HintTypeAntiJoin, Args: []string{"a", "b"}}},
 		},
+		{
+			comment: "/*+ SEMIJOIN(FIRSTMATCH) */",
+			hints:   []Hint{{Typ: HintTypeSemiJoinStrategy, Args: []string{"firstmatch"}}},
+		},
+		{
+			comment: "/*+ semijoin(@subq1 firstmatch, materialization) */",
+			hints:   []Hint{{Typ: HintTypeSemiJoinStrategy, Args: []string{"@subq1", "firstmatch", "materialization"}}},
+		},
+		{
+			comment: "/*+ NO_SEMIJOIN(LOOSESCAN,DUPLICATEWEEDOUT) */",
+			hints:   []Hint{{Typ: HintTypeNoSemiJoinStrategy, Args: []string{"loosescan", "duplicateweedout"}}},
+		},
+		{
+			comment: "/*+ SEMIJOIN(FIRSTMATCH, NESTED_LOOP) */",
+			hints:   []Hint{},
+		},
+		{
+			comment: "/*+ MAX_EXECUTION_TIME(1000) */",
+			hints:   []Hint{{Typ: HintTypeMaxExecutionTime, Args: []string{"1000"}}},
+		},
+		{
+			comment: "/*+ max_execution_time( 0 ) */",
+			hints:   []Hint{{Typ: HintTypeMaxExecutionTime, Args: []string{"0"}}},
+		},
+		{
+			comment: "/*+ MAX_EXECUTION_TIME(-1) MAX_EXECUTION_TIME(1.5) MAX_EXECUTION_TIME(5000000000) MAX_EXECUTION_TIME(1, 2) */",
+			hints:   []Hint{},
+		},
+		{
+			comment: "/*+ SET_VAR(sort_buffer_size = 16M) SET_VAR(SQL_MODE='ANSI_QUOTES') */",
+			hints: []Hint{
+				{Typ: HintTypeSetVar, Args: []string{"sort_buffer_size", "16M"}},
+				{Typ: HintTypeSetVar, Args: []string{"sql_mode", "'ANSI_QUOTES'"}},
+			},
+		},
+		{
+			comment: "/*+ NO_MERGE NO_MERGE(dt1, `Dt2`) no_merge(@qb1 v) */",
+			hints: []Hint{
+				{Typ: HintTypeNoMerge},
+				{Typ: HintTypeNoMerge, Args: []string{"dt1", "`dt2`"}},
+				{Typ: HintTypeNoMerge, Args: []string{"@qb1", "v"}},
+			},
+		},
+		{
+			comment: "/*+ RESOURCE_GROUP(Batch) RESOURCE_GROUP RESOURCE_GROUP(a, b) */",
+			hints:   []Hint{{Typ: HintTypeResourceGroup, Args: []string{"batch"}}},
+		},
+		{
+			comment: "/*+ SET_VAR(sort_buffer_size) SET_VAR(=1) SET_VAR(a=) SET_VAR */",
+			hints:   []Hint{},
+		},
 		{
 			comment: "/*+ hash_join(a,b) merge_join(b,c) lookup_join(a,d) */",
 			hints: []Hint{
@@ -132,7 +183,7 @@ func TestHintParsing(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.comment, func(t *testing.T) {
-			res := parseJoinHints(tt.comment)
+			res := ParseHints(tt.comment)
 			require.ElementsMatch(t, tt.hints, res)
 		})
 	}
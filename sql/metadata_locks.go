@@ -0,0 +1,400 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MetadataLockWaitState is the state of a process that is waiting for a metadata lock, as shown in the process list.
+const MetadataLockWaitState = "Waiting for table metadata lock"
+
+// MetadataLockType is the type of a metadata lock on a table.
+type MetadataLockType byte
+
+const (
+	// MetadataLockShared is taken by statements that read or write the rows of a table. Any number of sessions may
+	// hold a shared lock on the same table.
+	MetadataLockShared MetadataLockType = iota
+	// MetadataLockExclusive is taken by DDL statements that change a table. It conflicts with every lock held by
+	// another session.
+	MetadataLockExclusive
+)
+
+// String returns the name of the lock type, as listed in performance_schema.metadata_locks.
+func (t MetadataLockType) String() string {
+	if t == MetadataLockExclusive {
+		return "EXCLUSIVE"
+	}
+	return "SHARED"
+}
+
+// MetadataLockRequest names a table that a statement needs a metadata lock on.
+type MetadataLockRequest struct {
+	Schema string
+	Table  string
+	Type   MetadataLockType
+}
+
+// MetadataLock is a metadata lock that is held, or waited for, by a session.
+type MetadataLock struct {
+	Schema       string
+	Table        string
+	Type         MetadataLockType
+	Granted      bool
+	ConnectionID uint32
+}
+
+// MetadataLockCatalog is implemented by catalogs that track the metadata locks of the sessions of the server.
+type MetadataLockCatalog interface {
+	GetMetadataLockManager() *MetadataLockManager
+}
+
+type metadataLockKey struct {
+	schema string
+	table  string
+}
+
+type metadataLockEntry struct {
+	schema  string
+	table   string
+	holders map[Session]MetadataLockType
+}
+
+type metadataLockWait struct {
+	key metadataLockKey
+	typ MetadataLockType
+}
+
+// MetadataLockManager grants the metadata locks that keep DDL statements from changing tables that are in use by the
+// transactions of other sessions. Locks are held until the session releases them, which the engine does when the
+// session's transaction ends. All methods may be called on a nil manager, which grants every lock.
+type MetadataLockManager struct {
+	mu      sync.Mutex
+	entries map[metadataLockKey]*metadataLockEntry
+	waiting map[Session]metadataLockWait
+	// changed is closed and replaced whenever locks are released, to wake up the sessions waiting for them
+	changed chan struct{}
+}
+
+// NewMetadataLockManager returns a new MetadataLockManager with no locks.
+func NewMetadataLockManager() *MetadataLockManager {
+	return &MetadataLockManager{
+		entries: make(map[metadataLockKey]*metadataLockEntry),
+		waiting: make(map[Session]metadataLockWait),
+		changed: make(chan struct{}),
+	}
+}
+
+// Acquire takes the locks in |requests| for the session of |ctx|, waiting up to |timeout| for each of the locks held
+// by other sessions to be released. Locks are acquired in a fixed order, and a request that would wait on a session
+// that is itself waiting on this one fails immediately with ErrLockDeadlock. On failure, none of the locks requested
+// are held, apart from those that the session already held before.
+func (m *MetadataLockManager) Acquire(ctx *Context, requests []MetadataLockRequest, timeout time.Duration) error {
+	if m == nil || len(requests) == 0 {
+		return nil
+	}
+
+	// an exclusive request for a table supersedes a shared one
+	merged := make(map[metadataLockKey]MetadataLockRequest, len(requests))
+	keys := make([]metadataLockKey, 0, len(requests))
+	for _, req := range requests {
+		key := metadataLockKey{schema: strings.ToLower(req.Schema), table: strings.ToLower(req.Table)}
+		prev, ok := merged[key]
+		if !ok {
+			keys = append(keys, key)
+		}
+		if !ok || req.Type > prev.Type {
+			merged[key] = req
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].schema != keys[j].schema {
+			return keys[i].schema < keys[j].schema
+		}
+		return keys[i].table < keys[j].table
+	})
+
+	type undo struct {
+		key  metadataLockKey
+		prev MetadataLockType
+		held bool
+	}
+	var acquired []undo
+	deadline := time.Now().Add(timeout)
+	for _, key := range keys {
+		prev, held, err := m.acquire(ctx, key, merged[key], deadline)
+		if err != nil {
+			m.mu.Lock()
+			for _, u := range acquired {
+				m.restore(ctx.Session, u.key, u.prev, u.held)
+			}
+			m.broadcast()
+			m.mu.Unlock()
+			return err
+		}
+		acquired = append(acquired, undo{key: key, prev: prev, held: held})
+	}
+	return nil
+}
+
+// acquire takes a single lock, and returns the type of the lock that the session held on the table before, if any.
+func (m *MetadataLockManager) acquire(ctx *Context, key metadataLockKey, req MetadataLockRequest, deadline time.Time) (MetadataLockType, bool, error) {
+	sess := ctx.Session
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	setState := func(state string) {
+		if pl, ok := ctx.ProcessList.(QueryStateProcessList); ok {
+			pl.UpdateQueryState(ctx.Pid(), state)
+		}
+	}
+	waited := false
+	defer func() {
+		delete(m.waiting, sess)
+		if waited {
+			setState("")
+			if req.Type == MetadataLockExclusive {
+				// shared requests queued behind this one may now be granted
+				m.broadcast()
+			}
+		}
+	}()
+
+	for {
+		entry, ok := m.entries[key]
+		if !ok {
+			entry = &metadataLockEntry{schema: req.Schema, table: req.Table, holders: make(map[Session]MetadataLockType)}
+			m.entries[key] = entry
+		}
+		if !m.conflicts(key, entry, sess, req.Type) {
+			prev, held := entry.holders[sess]
+			if !held || req.Type > prev {
+				entry.holders[sess] = req.Type
+			}
+			return prev, held, nil
+		}
+		if m.deadlocked(sess, key, req.Type) {
+			m.dropIfUnused(key)
+			return 0, false, ErrLockDeadlock.New("deadlock found when trying to get metadata lock")
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			m.dropIfUnused(key)
+			return 0, false, ErrLockWaitTimeout.New()
+		}
+		m.waiting[sess] = metadataLockWait{key: key, typ: req.Type}
+		if !waited {
+			waited = true
+			setState(MetadataLockWaitState)
+		}
+
+		changed := m.changed
+		timer := time.NewTimer(remaining)
+		m.mu.Unlock()
+		select {
+		case <-changed:
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+		timer.Stop()
+		m.mu.Lock()
+
+		if err := ctx.Err(); err != nil {
+			m.dropIfUnused(key)
+			return 0, false, err
+		}
+	}
+}
+
+// conflicts returns whether a lock of type |typ| on |entry| for |sess| conflicts with the locks of other sessions. A
+// new shared request also waits behind an exclusive request of another session on the same table, so that a steady
+// stream of readers can't starve DDL statements.
+func (m *MetadataLockManager) conflicts(key metadataLockKey, entry *metadataLockEntry, sess Session, typ MetadataLockType) bool {
+	for holder, held := range entry.holders {
+		if holder != sess && (typ == MetadataLockExclusive || held == MetadataLockExclusive) {
+			return true
+		}
+	}
+	if _, held := entry.holders[sess]; typ == MetadataLockExclusive || held {
+		return false
+	}
+	return len(m.exclusiveWaiters(key, sess)) > 0
+}
+
+// exclusiveWaiters returns the sessions other than |sess| that are waiting for an exclusive lock on |key|.
+func (m *MetadataLockManager) exclusiveWaiters(key metadataLockKey, sess Session) []Session {
+	var waiters []Session
+	for waiter, wait := range m.waiting {
+		if waiter != sess && wait.key == key && wait.typ == MetadataLockExclusive {
+			waiters = append(waiters, waiter)
+		}
+	}
+	return waiters
+}
+
+// deadlocked returns whether waiting for a lock of type |typ| on |key| would make |sess| wait, directly or through
+// other waiting sessions, on itself.
+func (m *MetadataLockManager) deadlocked(sess Session, key metadataLockKey, typ MetadataLockType) bool {
+	visited := make(map[Session]struct{})
+	var waitsOn func(key metadataLockKey, typ MetadataLockType, waiter Session) bool
+	waitsOn = func(key metadataLockKey, typ MetadataLockType, waiter Session) bool {
+		entry, ok := m.entries[key]
+		if !ok {
+			return false
+		}
+		var blockers []Session
+		for holder, held := range entry.holders {
+			if holder != waiter && (typ == MetadataLockExclusive || held == MetadataLockExclusive) {
+				blockers = append(blockers, holder)
+			}
+		}
+		if _, held := entry.holders[waiter]; typ != MetadataLockExclusive && !held {
+			blockers = append(blockers, m.exclusiveWaiters(key, waiter)...)
+		}
+		for _, blocker := range blockers {
+			if blocker == sess {
+				return true
+			}
+			if _, ok := visited[blocker]; ok {
+				continue
+			}
+			visited[blocker] = struct{}{}
+			if wait, ok := m.waiting[blocker]; ok && waitsOn(wait.key, wait.typ, blocker) {
+				return true
+			}
+		}
+		return false
+	}
+	return waitsOn(key, typ, sess)
+}
+
+// restore sets the lock of |sess| on |key| back to what it was before a failed Acquire.
+func (m *MetadataLockManager) restore(sess Session, key metadataLockKey, prev MetadataLockType, held bool) {
+	entry, ok := m.entries[key]
+	if !ok {
+		return
+	}
+	if held {
+		entry.holders[sess] = prev
+	} else {
+		delete(entry.holders, sess)
+	}
+	m.dropIfUnused(key)
+}
+
+// dropIfUnused removes the entry for |key| if no session holds a lock on it.
+func (m *MetadataLockManager) dropIfUnused(key metadataLockKey) {
+	if entry, ok := m.entries[key]; ok && len(entry.holders) == 0 {
+		delete(m.entries, key)
+	}
+}
+
+// broadcast wakes up the sessions waiting for locks.
+func (m *MetadataLockManager) broadcast() {
+	close(m.changed)
+	m.changed = make(chan struct{})
+}
+
+// ReleaseAll releases every metadata lock held by |sess|.
+func (m *MetadataLockManager) ReleaseAll(sess Session) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.release(func(holder Session) bool { return holder == sess })
+}
+
+// ReleaseConnection releases every metadata lock held by the sessions of the connection with the ID given.
+func (m *MetadataLockManager) ReleaseConnection(connID uint32) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.release(func(holder Session) bool { return holder.ID() == connID })
+}
+
+func (m *MetadataLockManager) release(matches func(Session) bool) {
+	released := false
+	for key, entry := range m.entries {
+		for holder := range entry.holders {
+			if matches(holder) {
+				delete(entry.holders, holder)
+				released = true
+			}
+		}
+		m.dropIfUnused(key)
+	}
+	if released {
+		m.broadcast()
+	}
+}
+
+// Locks returns the metadata locks that are held, followed by those that are waited for, ordered by table.
+func (m *MetadataLockManager) Locks() []MetadataLock {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var locks []MetadataLock
+	for _, entry := range m.entries {
+		for holder, typ := range entry.holders {
+			locks = append(locks, MetadataLock{
+				Schema:       entry.schema,
+				Table:        entry.table,
+				Type:         typ,
+				Granted:      true,
+				ConnectionID: holder.ID(),
+			})
+		}
+	}
+	for waiter, wait := range m.waiting {
+		entry, ok := m.entries[wait.key]
+		if !ok {
+			continue
+		}
+		locks = append(locks, MetadataLock{
+			Schema:       entry.schema,
+			Table:        entry.table,
+			Type:         wait.typ,
+			ConnectionID: waiter.ID(),
+		})
+	}
+
+	sort.Slice(locks, func(i, j int) bool {
+		if locks[i].Granted != locks[j].Granted {
+			return locks[i].Granted
+		}
+		if locks[i].Schema != locks[j].Schema {
+			return locks[i].Schema < locks[j].Schema
+		}
+		if locks[i].Table != locks[j].Table {
+			return locks[i].Table < locks[j].Table
+		}
+		if locks[i].ConnectionID != locks[j].ConnectionID {
+			return locks[i].ConnectionID < locks[j].ConnectionID
+		}
+		return locks[i].Type < locks[j].Type
+	})
+	return locks
+}
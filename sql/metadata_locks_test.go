@@ -0,0 +1,136 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newMetadataLockContext(id uint32) *Context {
+	sess := NewBaseSessionWithClientServer("address", Client{Address: "localhost", User: "root"}, id)
+	return NewContext(context.Background(), WithSession(sess))
+}
+
+func TestMetadataLockManager(t *testing.T) {
+	m := NewMetadataLockManager()
+	a := newMetadataLockContext(1)
+	b := newMetadataLockContext(2)
+
+	shared := []MetadataLockRequest{{Schema: "db", Table: "t", Type: MetadataLockShared}}
+	exclusive := []MetadataLockRequest{{Schema: "db", Table: "T", Type: MetadataLockExclusive}}
+
+	require.NoError(t, m.Acquire(a, shared, time.Second))
+	require.NoError(t, m.Acquire(b, shared, time.Second))
+	// a session can upgrade its own lock only when no other session holds one
+	err := m.Acquire(a, exclusive, 10*time.Millisecond)
+	require.True(t, ErrLockWaitTimeout.Is(err))
+	require.Equal(t, []MetadataLock{
+		{Schema: "db", Table: "t", Type: MetadataLockShared, Granted: true, ConnectionID: 1},
+		{Schema: "db", Table: "t", Type: MetadataLockShared, Granted: true, ConnectionID: 2},
+	}, m.Locks())
+
+	m.ReleaseAll(b.Session)
+	require.NoError(t, m.Acquire(a, exclusive, time.Second))
+	require.Equal(t, []MetadataLock{
+		{Schema: "db", Table: "t", Type: MetadataLockExclusive, Granted: true, ConnectionID: 1},
+	}, m.Locks())
+
+	// a waiting session is granted its lock as soon as the holder releases it
+	done := make(chan error)
+	go func() {
+		done <- m.Acquire(b, shared, time.Minute)
+	}()
+	require.Eventually(t, func() bool { return len(m.Locks()) == 2 }, time.Second, time.Millisecond)
+	require.Equal(t, MetadataLock{Schema: "db", Table: "t", Type: MetadataLockShared, ConnectionID: 2}, m.Locks()[1])
+	m.ReleaseConnection(1)
+	require.NoError(t, <-done)
+	require.Equal(t, []MetadataLock{
+		{Schema: "db", Table: "t", Type: MetadataLockShared, Granted: true, ConnectionID: 2},
+	}, m.Locks())
+	m.ReleaseAll(b.Session)
+	require.Empty(t, m.Locks())
+}
+
+func TestMetadataLockDeadlock(t *testing.T) {
+	m := NewMetadataLockManager()
+	a := newMetadataLockContext(1)
+	b := newMetadataLockContext(2)
+
+	require.NoError(t, m.Acquire(a, []MetadataLockRequest{{Schema: "db", Table: "t1", Type: MetadataLockShared}}, time.Second))
+	require.NoError(t, m.Acquire(b, []MetadataLockRequest{{Schema: "db", Table: "t2", Type: MetadataLockShared}}, time.Second))
+
+	done := make(chan error)
+	go func() {
+		done <- m.Acquire(b, []MetadataLockRequest{{Schema: "db", Table: "t1", Type: MetadataLockExclusive}}, time.Minute)
+	}()
+	require.Eventually(t, func() bool { return len(m.Locks()) == 3 }, time.Second, time.Millisecond)
+
+	// a would wait on b, which is waiting on a
+	err := m.Acquire(a, []MetadataLockRequest{{Schema: "db", Table: "t2", Type: MetadataLockExclusive}}, time.Minute)
+	require.True(t, ErrLockDeadlock.Is(err))
+
+	m.ReleaseAll(a.Session)
+	require.NoError(t, <-done)
+	require.Equal(t, []MetadataLock{
+		{Schema: "db", Table: "t1", Type: MetadataLockExclusive, Granted: true, ConnectionID: 2},
+		{Schema: "db", Table: "t2", Type: MetadataLockShared, Granted: true, ConnectionID: 2},
+	}, m.Locks())
+}
+
+func TestMetadataLockExclusiveWaiterBlocksShared(t *testing.T) {
+	m := NewMetadataLockManager()
+	a := newMetadataLockContext(1)
+	b := newMetadataLockContext(2)
+	c := newMetadataLockContext(3)
+
+	shared := []MetadataLockRequest{{Schema: "db", Table: "t", Type: MetadataLockShared}}
+	exclusive := []MetadataLockRequest{{Schema: "db", Table: "t", Type: MetadataLockExclusive}}
+
+	require.NoError(t, m.Acquire(a, shared, time.Second))
+	done := make(chan error)
+	go func() {
+		done <- m.Acquire(b, exclusive, time.Minute)
+	}()
+	require.Eventually(t, func() bool { return len(m.Locks()) == 2 }, time.Second, time.Millisecond)
+
+	// a new shared request waits behind the exclusive one, but a session that already holds a lock is granted it
+	err := m.Acquire(c, shared, 10*time.Millisecond)
+	require.True(t, ErrLockWaitTimeout.Is(err))
+	require.NoError(t, m.Acquire(a, shared, time.Second))
+
+	m.ReleaseAll(a.Session)
+	require.NoError(t, <-done)
+	require.Equal(t, []MetadataLock{
+		{Schema: "db", Table: "t", Type: MetadataLockExclusive, Granted: true, ConnectionID: 2},
+	}, m.Locks())
+	m.ReleaseAll(b.Session)
+
+	// once the exclusive request gives up, the shared requests queued behind it are granted
+	require.NoError(t, m.Acquire(a, shared, time.Second))
+	go func() {
+		done <- m.Acquire(b, exclusive, 50*time.Millisecond)
+	}()
+	require.Eventually(t, func() bool { return len(m.Locks()) == 2 }, time.Second, time.Millisecond)
+	require.NoError(t, m.Acquire(c, shared, time.Minute))
+	require.True(t, ErrLockWaitTimeout.Is(<-done))
+	require.Equal(t, []MetadataLock{
+		{Schema: "db", Table: "t", Type: MetadataLockShared, Granted: true, ConnectionID: 1},
+		{Schema: "db", Table: "t", Type: MetadataLockShared, Granted: true, ConnectionID: 3},
+	}, m.Locks())
+}
@@ -666,6 +666,9 @@ func (db *MySQLDb) UserHasPrivileges(ctx *sql.Context, operations ...sql.Privile
 				//TODO: Handle partial revokes
 				continue
 			}
+			if operation.IsGlobal {
+				return false
+			}
 			database := operation.Database
 			if database == "" {
 				database = ctx.GetCurrentDatabase()
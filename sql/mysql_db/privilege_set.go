@@ -149,10 +149,7 @@ func (ps PrivilegeSet) RemoveDatabase(dbName string, privileges ...sql.Privilege
 			delete(dbSet.privs, priv)
 		}
 	}
-
-	if len(dbSet.privs) == 0 {
-		delete(ps.databases, strings.ToLower(dbName))
-	}
+	ps.pruneDatabase(dbName)
 }
 
 // RemoveTable removes the given table privilege(s).
@@ -164,6 +161,7 @@ func (ps PrivilegeSet) RemoveTable(dbName string, tblName string, privileges ...
 			delete(tblSet.privs, priv)
 		}
 	}
+	ps.pruneTable(dbName, tblName)
 }
 
 // RemoveColumn removes the given column privilege(s).
@@ -186,6 +184,7 @@ func (ps PrivilegeSet) RemoveRoutine(dbName string, procName string, isProc bool
 	if len(procSet.privs) == 0 {
 		delete(ps.getUseableDb(dbName).routines, routineKey{name: procName, isProc: isProc})
 	}
+	ps.pruneDatabase(dbName)
 }
 
 // Has returns whether the given global static privilege(s) exists.
@@ -292,19 +291,23 @@ func (ps *PrivilegeSet) ClearGlobal() {
 	ps.globalDynamic = make(map[string]bool)
 }
 
-// ClearDatabase removes all privileges for the given database.
+// ClearDatabase removes all database-level privileges for the given database. Privileges on the tables and routines
+// of the database are not affected, as privileges at each level are granted and revoked independently.
 func (ps PrivilegeSet) ClearDatabase(dbName string) {
-	lowerDbName := strings.ToLower(dbName)
-	dbSet, ok := ps.databases[lowerDbName]
+	dbSet, ok := ps.databases[strings.ToLower(dbName)]
 	if ok {
 		dbSet.clear()
-		delete(ps.databases, lowerDbName)
+		ps.pruneDatabase(dbName)
 	}
 }
 
 // ClearTable removes all privileges for the given table.
 func (ps PrivilegeSet) ClearTable(dbName string, tblName string) {
-	ps.getUseableDb(dbName).getUseableTbl(tblName).clear()
+	tblSet, ok := ps.Database(dbName).(PrivilegeSetDatabase).tables[strings.ToLower(tblName)]
+	if ok {
+		tblSet.clear()
+		ps.pruneTable(dbName, tblName)
+	}
 }
 
 // ClearColumn removes all privileges for the given column.
@@ -383,6 +386,29 @@ func (ps PrivilegeSet) ToSliceDynamic(withGrantOption bool) []string {
 	return privs
 }
 
+// pruneDatabase removes the given database if it no longer has privileges at any level, so that it is
+// indistinguishable from a database that was never granted any privileges.
+func (ps PrivilegeSet) pruneDatabase(dbName string) {
+	lowerDbName := strings.ToLower(dbName)
+	if dbSet, ok := ps.databases[lowerDbName]; ok && !dbSet.HasPrivileges() {
+		delete(ps.databases, lowerDbName)
+	}
+}
+
+// pruneTable removes the given table if it no longer has privileges, along with its database if the database is then
+// empty as well.
+func (ps PrivilegeSet) pruneTable(dbName string, tblName string) {
+	dbSet, ok := ps.databases[strings.ToLower(dbName)]
+	if !ok {
+		return
+	}
+	lowerTblName := strings.ToLower(tblName)
+	if tblSet, ok := dbSet.tables[lowerTblName]; ok && !tblSet.HasPrivileges() {
+		delete(dbSet.tables, lowerTblName)
+	}
+	ps.pruneDatabase(dbName)
+}
+
 // getUseableDb is used internally to either retrieve an existing database, or create a new one that is returned.
 func (ps PrivilegeSet) getUseableDb(dbName string) PrivilegeSetDatabase {
 	lowerDbName := strings.ToLower(dbName)
@@ -50,13 +50,14 @@ func TestClears(t *testing.T) {
 	require.False(t, privSet.getUseableDb("db1").Has(sql.PrivilegeType_Insert))
 	require.False(t, privSet.getUseableDb("db1").HasPrivileges())
 
-	// Verify that clearing a DB deletes all the tables and routines hanging off of it.
+	// Verify that clearing a DB only removes database-level privileges, and not the tables and routines hanging off of it.
 	privSet = buildStdTestPrivs()
 	privSet.ClearDatabase("db1")
-	require.False(t, privSet.getUseableDb("db1").getUseableRoutine("rtn", true).HasPrivileges())
-	require.False(t, privSet.getUseableDb("db1").getUseableTbl("tbl").getUseableCol("col").HasPrivileges())
-	require.False(t, privSet.getUseableDb("db1").getUseableTbl("tbl").HasPrivileges())
-	require.False(t, privSet.getUseableDb("db1").HasPrivileges())
+	require.Zero(t, privSet.Database("db1").Count())
+	require.True(t, privSet.getUseableDb("db1").getUseableRoutine("rtn", true).HasPrivileges())
+	require.True(t, privSet.getUseableDb("db1").getUseableTbl("tbl").getUseableCol("col").HasPrivileges())
+	require.True(t, privSet.getUseableDb("db1").getUseableTbl("tbl").HasPrivileges())
+	require.True(t, privSet.getUseableDb("db1").HasPrivileges())
 
 	// Spot check that db2 wasn't affected.
 	require.True(t, privSet.getUseableDb("db2").getUseableRoutine("rtn", true).Has(sql.PrivilegeType_Execute))
@@ -115,6 +116,24 @@ func TestRemoves(t *testing.T) {
 	privSet.RemoveDatabase("db1", sql.PrivilegeType_Insert)
 	require.False(t, privSet.getUseableDb("db1").Has(sql.PrivilegeType_Insert))
 	require.False(t, privSet.getUseableDb("db1").HasPrivileges())
+
+	// Verify that removing the last database privilege doesn't affect the tables and routines of the database.
+	privSet = buildStdTestPrivs()
+	privSet.RemoveDatabase("db1", sql.PrivilegeType_Select, sql.PrivilegeType_Insert)
+	require.Zero(t, privSet.Database("db1").Count())
+	require.True(t, privSet.Database("db1").Table("tbl").Has(sql.PrivilegeType_Update, sql.PrivilegeType_Delete))
+	require.True(t, privSet.Database("db1").Routine("rtn", true).Has(sql.PrivilegeType_Execute))
+
+	// Verify that tables and databases without any remaining privileges are removed entirely.
+	privSet = buildStdTestPrivs()
+	privSet.RemoveDatabase("db1", sql.PrivilegeType_Select, sql.PrivilegeType_Insert)
+	privSet.RemoveRoutine("db1", "rtn", true, sql.PrivilegeType_Execute)
+	privSet.RemoveColumn("db1", "tbl", "col", sql.PrivilegeType_Create, sql.PrivilegeType_Drop)
+	require.Len(t, privSet.Database("db1").GetTables(), 1)
+	privSet.RemoveTable("db1", "tbl", sql.PrivilegeType_Update, sql.PrivilegeType_Delete)
+	require.Empty(t, privSet.Database("db1").GetTables())
+	require.Len(t, privSet.GetDatabases(), 1)
+	require.NotContains(t, privSet.databases, "db1")
 }
 
 func TestUnions(t *testing.T) {
@@ -0,0 +1,259 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	optimizerTraceSysVar           = "optimizer_trace"
+	optimizerTraceLimitSysVar      = "optimizer_trace_limit"
+	optimizerTraceOffsetSysVar     = "optimizer_trace_offset"
+	optimizerTraceMaxMemSizeSysVar = "optimizer_trace_max_mem_size"
+)
+
+// OptimizerTrace is a completed trace for a single statement, as exposed by the
+// information_schema.optimizer_trace table.
+type OptimizerTrace struct {
+	// Query is the text of the traced statement
+	Query string
+	// Trace is the JSON document describing the analyzer's decisions
+	Trace string
+	// MissingBytesBeyondMaxMemSize is the number of bytes of the trace truncated by optimizer_trace_max_mem_size
+	MissingBytesBeyondMaxMemSize int
+}
+
+// OptimizerTraceSession is a Session that retains optimizer traces for the statements it executes.
+type OptimizerTraceSession interface {
+	Session
+	// AddOptimizerTrace stores the trace given, keeping only as many traces as |retain|.
+	AddOptimizerTrace(trace OptimizerTrace, retain int)
+	// OptimizerTraces returns the traces stored for this session, oldest first.
+	OptimizerTraces() []OptimizerTrace
+	// ClearOptimizerTraces discards all traces stored for this session.
+	ClearOptimizerTraces()
+}
+
+// OptimizerTraceRule records a single analyzer rule that changed the plan.
+type OptimizerTraceRule struct {
+	Batch string `json:"batch"`
+	Rule  string `json:"rule"`
+	// Diff is a unified diff between the plan before and after the rule was applied
+	Diff string `json:"diff"`
+}
+
+// OptimizerTraceIndexCandidate is an index considered for an index scan.
+type OptimizerTraceIndexCandidate struct {
+	Index string `json:"index"`
+	// RowCount is the estimated number of rows returned by a scan of the index
+	RowCount uint64 `json:"rows"`
+	Usable   bool   `json:"usable"`
+	Chosen   bool   `json:"chosen"`
+	Cause    string `json:"cause,omitempty"`
+}
+
+// OptimizerTraceIndexSelection records the indexes considered for a filtered table scan.
+type OptimizerTraceIndexSelection struct {
+	Table      string                         `json:"table"`
+	Filters    string                         `json:"filters"`
+	Candidates []OptimizerTraceIndexCandidate `json:"considered_indexes"`
+}
+
+// OptimizerTraceJoinOrder summarizes join planning for a single join tree.
+type OptimizerTraceJoinOrder struct {
+	// Memo describes the expression groups and the alternative plans enumerated for each
+	Memo string `json:"memo"`
+	// Cost is the estimated cost of the chosen plan
+	Cost float64 `json:"cost"`
+	// BestPlan is the chosen plan
+	BestPlan string `json:"best_plan"`
+}
+
+// OptimizerTraceStep is a single entry in an optimizer trace. Exactly one field is set.
+type OptimizerTraceStep struct {
+	Rule           *OptimizerTraceRule           `json:"rule_application,omitempty"`
+	IndexSelection *OptimizerTraceIndexSelection `json:"index_selection,omitempty"`
+	JoinOrder      *OptimizerTraceJoinOrder      `json:"join_optimization,omitempty"`
+}
+
+// OptimizerTracer collects the decisions made while analyzing a single statement. A tracer is only attached to a
+// Context when the session has enabled tracing with optimizer_trace, so callers can skip the work of describing a
+// decision when Context.OptimizerTracer returns nil.
+type OptimizerTracer struct {
+	query   string
+	oneLine bool
+	steps   []OptimizerTraceStep
+}
+
+// NewOptimizerTracer returns a new tracer for the query given.
+func NewOptimizerTracer(query string, oneLine bool) *OptimizerTracer {
+	return &OptimizerTracer{query: query, oneLine: oneLine}
+}
+
+// AddRule records an analyzer rule that changed the plan.
+func (t *OptimizerTracer) AddRule(batch, rule, diff string) {
+	t.steps = append(t.steps, OptimizerTraceStep{Rule: &OptimizerTraceRule{Batch: batch, Rule: rule, Diff: diff}})
+}
+
+// AddIndexSelection records the indexes considered for a filtered table scan.
+func (t *OptimizerTracer) AddIndexSelection(sel OptimizerTraceIndexSelection) {
+	t.steps = append(t.steps, OptimizerTraceStep{IndexSelection: &sel})
+}
+
+// AddJoinOrder records the result of join planning.
+func (t *OptimizerTracer) AddJoinOrder(join OptimizerTraceJoinOrder) {
+	t.steps = append(t.steps, OptimizerTraceStep{JoinOrder: &join})
+}
+
+// Finish renders the trace as JSON, truncated to |maxMemSize| bytes.
+func (t *OptimizerTracer) Finish(maxMemSize int) (OptimizerTrace, error) {
+	doc := struct {
+		Steps []OptimizerTraceStep `json:"steps"`
+	}{Steps: t.steps}
+	if doc.Steps == nil {
+		doc.Steps = []OptimizerTraceStep{}
+	}
+
+	var b []byte
+	var err error
+	if t.oneLine {
+		b, err = json.Marshal(doc)
+	} else {
+		b, err = json.MarshalIndent(doc, "", "  ")
+	}
+	if err != nil {
+		return OptimizerTrace{}, err
+	}
+
+	trace := OptimizerTrace{Query: t.query, Trace: string(b)}
+	if maxMemSize >= 0 && len(b) > maxMemSize {
+		trace.Trace = string(b[:maxMemSize])
+		trace.MissingBytesBeyondMaxMemSize = len(b) - maxMemSize
+	}
+	return trace, nil
+}
+
+// NewOptimizerTracerForSession returns a new tracer for |query| if the session has enabled optimizer tracing, and nil
+// otherwise.
+func NewOptimizerTracerForSession(ctx *Context, query string) *OptimizerTracer {
+	if _, ok := ctx.Session.(OptimizerTraceSession); !ok {
+		return nil
+	}
+	val, err := ctx.GetSessionVariable(ctx, optimizerTraceSysVar)
+	if err != nil {
+		return nil
+	}
+	s, ok := val.(string)
+	if !ok || s == "" {
+		return nil
+	}
+	opts := parseOptimizerTraceOptions(s)
+	if !opts["enabled"] {
+		return nil
+	}
+	return NewOptimizerTracer(query, opts["one_line"])
+}
+
+// parseOptimizerTraceOptions parses the flags in an optimizer_trace value, e.g. "enabled=on,one_line=off".
+func parseOptimizerTraceOptions(s string) map[string]bool {
+	opts := make(map[string]bool)
+	for _, opt := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(opt, "=")
+		if !ok {
+			continue
+		}
+		opts[strings.ToLower(strings.TrimSpace(k))] = strings.EqualFold(strings.TrimSpace(v), "on")
+	}
+	return opts
+}
+
+// FinishOptimizerTrace renders the trace given and stores it in the session, honoring the optimizer_trace_offset,
+// optimizer_trace_limit, and optimizer_trace_max_mem_size session variables.
+func FinishOptimizerTrace(ctx *Context, t *OptimizerTracer) error {
+	sess, ok := ctx.Session.(OptimizerTraceSession)
+	if !ok || t == nil {
+		return nil
+	}
+	offset, limit, maxMemSize, err := optimizerTraceBounds(ctx)
+	if err != nil {
+		return err
+	}
+	trace, err := t.Finish(maxMemSize)
+	if err != nil {
+		return err
+	}
+
+	// A negative offset refers to the most recent traces, so only that many need to be kept. Otherwise, only the
+	// first offset+limit traces can ever be displayed, and later traces are discarded.
+	retain := -offset
+	if offset >= 0 {
+		retain = offset + limit
+		if len(sess.OptimizerTraces()) >= retain {
+			return nil
+		}
+	}
+	sess.AddOptimizerTrace(trace, retain)
+	return nil
+}
+
+// VisibleOptimizerTraces returns the session's traces that are visible according to optimizer_trace_offset and
+// optimizer_trace_limit.
+func VisibleOptimizerTraces(ctx *Context) ([]OptimizerTrace, error) {
+	sess, ok := ctx.Session.(OptimizerTraceSession)
+	if !ok {
+		return nil, nil
+	}
+	offset, limit, _, err := optimizerTraceBounds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	traces := sess.OptimizerTraces()
+	start := offset
+	if offset < 0 {
+		start = len(traces) + offset
+	}
+	if start < 0 {
+		start = 0
+	}
+	if start >= len(traces) || limit <= 0 {
+		return nil, nil
+	}
+	end := start + limit
+	if end > len(traces) {
+		end = len(traces)
+	}
+	return traces[start:end], nil
+}
+
+func optimizerTraceBounds(ctx *Context) (offset, limit, maxMemSize int, err error) {
+	vals := make([]int, 3)
+	for i, name := range []string{optimizerTraceOffsetSysVar, optimizerTraceLimitSysVar, optimizerTraceMaxMemSizeSysVar} {
+		val, err := ctx.GetSessionVariable(ctx, name)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		switch v := val.(type) {
+		case int64:
+			vals[i] = int(v)
+		case uint64:
+			vals[i] = int(v)
+		case int:
+			vals[i] = v
+		}
+	}
+	return vals[0], vals[1], vals[2], nil
+}
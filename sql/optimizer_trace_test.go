@@ -0,0 +1,72 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimizerTracer(t *testing.T) {
+	require := require.New(t)
+
+	tracer := NewOptimizerTracer("select * from xy where y = 1", true)
+	tracer.AddRule("default-rules", "pruneTables", "-a\n+b\n")
+	tracer.AddIndexSelection(OptimizerTraceIndexSelection{
+		Table:   "xy",
+		Filters: "(xy.y = 1)",
+		Candidates: []OptimizerTraceIndexCandidate{
+			{Index: "PRIMARY", RowCount: 3, Cause: "no filters match a prefix of the index"},
+			{Index: "y_idx", RowCount: 1, Usable: true, Chosen: true},
+		},
+	})
+	tracer.AddJoinOrder(OptimizerTraceJoinOrder{Memo: "memo", Cost: 1.5, BestPlan: "plan"})
+
+	trace, err := tracer.Finish(-1)
+	require.NoError(err)
+	require.Equal("select * from xy where y = 1", trace.Query)
+	require.Equal(0, trace.MissingBytesBeyondMaxMemSize)
+	require.NotContains(trace.Trace, "\n")
+
+	var doc struct {
+		Steps []OptimizerTraceStep `json:"steps"`
+	}
+	require.NoError(json.Unmarshal([]byte(trace.Trace), &doc))
+	require.Len(doc.Steps, 3)
+	require.Equal(&OptimizerTraceRule{Batch: "default-rules", Rule: "pruneTables", Diff: "-a\n+b\n"}, doc.Steps[0].Rule)
+	require.Nil(doc.Steps[0].IndexSelection)
+	require.Equal("xy", doc.Steps[1].IndexSelection.Table)
+	require.Len(doc.Steps[1].IndexSelection.Candidates, 2)
+	require.True(doc.Steps[1].IndexSelection.Candidates[1].Chosen)
+	require.Equal("plan", doc.Steps[2].JoinOrder.BestPlan)
+
+	truncated, err := tracer.Finish(10)
+	require.NoError(err)
+	require.Equal(trace.Trace[:10], truncated.Trace)
+	require.Equal(len(trace.Trace)-10, truncated.MissingBytesBeyondMaxMemSize)
+}
+
+func TestOptimizerTracerEmpty(t *testing.T) {
+	trace, err := NewOptimizerTracer("select 1", false).Finish(-1)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"steps": []}`, trace.Trace)
+}
+
+func TestParseOptimizerTraceOptions(t *testing.T) {
+	opts := parseOptimizerTraceOptions("enabled=on, ONE_LINE = off,bogus")
+	require.Equal(t, map[string]bool{"enabled": true, "one_line": false}, opts)
+}
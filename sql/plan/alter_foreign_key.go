@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -126,6 +127,13 @@ func ResolveForeignKey(ctx *sql.Context, tbl sql.ForeignKeyTable, refTbl sql.For
 	if fkDef.IsResolved {
 		return fmt.Errorf("cannot resolve foreign key `%s` as it has already been resolved", fkDef.Name)
 	}
+	if shouldAdd && fkDef.Name == "" {
+		name, err := generateForeignKeyName(ctx, tbl, fkDef.Table)
+		if err != nil {
+			return err
+		}
+		fkDef.Name = name
+	}
 	if len(fkDef.Columns) == 0 {
 		return sql.ErrForeignKeyMissingColumns.New()
 	}
@@ -298,6 +306,28 @@ func ResolveForeignKey(ctx *sql.Context, tbl sql.ForeignKeyTable, refTbl sql.For
 	}
 }
 
+// generateForeignKeyName returns the name of an unnamed foreign key added to |tbl|, which is named like MySQL names them:
+// the name of the table followed by "_ibfk_" and one more than the largest number of the foreign keys of |tbl| that are
+// already named that way.
+func generateForeignKeyName(ctx *sql.Context, tbl sql.ForeignKeyTable, tableName string) (string, error) {
+	fks, err := tbl.GetDeclaredForeignKeys(ctx)
+	if err != nil {
+		return "", err
+	}
+	prefix := strings.ToLower(tableName) + "_ibfk_"
+	last := 0
+	for _, fk := range fks {
+		name := strings.ToLower(fk.Name)
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if n, err := strconv.Atoi(name[len(prefix):]); err == nil && n > last {
+			last = n
+		}
+	}
+	return fmt.Sprintf("%s_ibfk_%d", tableName, last+1), nil
+}
+
 type DropForeignKey struct {
 	// In the cases where we have multiple ALTER statements, we need to resolve the table at execution time rather than
 	// during analysis. Otherwise, you could add a foreign key in the preceding alter and we may have analyzed to a
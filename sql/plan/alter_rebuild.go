@@ -0,0 +1,136 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// RebuildTable rebuilds the storage of a table without changing its schema, for ALTER TABLE ... FORCE and for
+// ALTER TABLE ... ENGINE with the table's current engine.
+type RebuildTable struct {
+	ddlNode
+	Table     sql.Node
+	Algorithm sql.AlterAlgorithm
+	Lock      sql.AlterLock
+	checks    sql.CheckConstraints
+}
+
+var _ sql.Node = (*RebuildTable)(nil)
+var _ sql.Databaser = (*RebuildTable)(nil)
+var _ sql.Expressioner = (*RebuildTable)(nil)
+var _ sql.CheckConstraintNode = (*RebuildTable)(nil)
+var _ sql.CollationCoercible = (*RebuildTable)(nil)
+
+func NewRebuildTable(database sql.Database, table sql.Node, algorithm sql.AlterAlgorithm, lock sql.AlterLock) *RebuildTable {
+	return &RebuildTable{
+		ddlNode:   ddlNode{Db: database},
+		Table:     table,
+		Algorithm: algorithm,
+		Lock:      lock,
+	}
+}
+
+// WithChildren implements the Node interface.
+func (r *RebuildTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 1)
+	}
+	nr := *r
+	nr.Table = children[0]
+	return &nr, nil
+}
+
+// Children implements the sql.Node interface.
+func (r *RebuildTable) Children() []sql.Node {
+	return []sql.Node{r.Table}
+}
+
+// Resolved implements the sql.Node interface.
+func (r *RebuildTable) Resolved() bool {
+	return r.ddlNode.Resolved() && r.Table.Resolved() && expression.ExpressionsResolved(r.checks.ToExpressions()...)
+}
+
+func (r *RebuildTable) IsReadOnly() bool {
+	return false
+}
+
+// Checks returns the check constraints that every row of the table is validated against while it's rebuilt.
+func (r *RebuildTable) Checks() sql.CheckConstraints {
+	return r.checks
+}
+
+// WithChecks implements the sql.CheckConstraintNode interface.
+func (r *RebuildTable) WithChecks(checks sql.CheckConstraints) sql.Node {
+	nr := *r
+	nr.checks = checks
+	return &nr
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (r *RebuildTable) Expressions() []sql.Expression {
+	return r.checks.ToExpressions()
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (r *RebuildTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(r.checks) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(exprs), len(r.checks))
+	}
+
+	var err error
+	nr := *r
+	nr.checks, err = r.checks.FromExpressions(exprs)
+	if err != nil {
+		return nil, err
+	}
+	return &nr, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (r *RebuildTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	subject := sql.PrivilegeCheckSubject{
+		Database: r.Database().Name(),
+		Table:    getTableName(r.Table),
+	}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Alter))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (r *RebuildTable) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+func (r *RebuildTable) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+func (r *RebuildTable) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("RebuildTable(algorithm=%s, lock=%s)", r.Algorithm, r.Lock)
+	_ = pr.WriteChildren(fmt.Sprintf("Table(%s)", r.Table.String()))
+	return pr.String()
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (r *RebuildTable) WithDatabase(db sql.Database) (sql.Node, error) {
+	nr := *r
+	nr.Db = db
+	return &nr, nil
+}
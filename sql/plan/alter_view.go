@@ -0,0 +1,70 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// AlterView is a node representing the ALTER VIEW statement, which replaces the definition of an existing view. Unlike
+// CREATE OR REPLACE VIEW, it fails if the view doesn't exist. The new definition is given by the embedded CreateView,
+// whose CreateViewString is the CREATE VIEW statement that the view is stored with.
+// https://dev.mysql.com/doc/refman/8.0/en/alter-view.html
+type AlterView struct {
+	*CreateView
+}
+
+var _ sql.Node = (*AlterView)(nil)
+var _ sql.Databaser = (*AlterView)(nil)
+var _ sql.CollationCoercible = (*AlterView)(nil)
+
+// NewAlterView creates an AlterView node that replaces the view defined by |definition|.
+func NewAlterView(definition *CreateView) *AlterView {
+	return &AlterView{CreateView: definition}
+}
+
+// String implements the fmt.Stringer interface.
+func (av *AlterView) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("AlterView(%s)", av.Name)
+	return pr.String()
+}
+
+// WithChildren implements the Node interface.
+func (av *AlterView) WithChildren(children ...sql.Node) (sql.Node, error) {
+	cv, err := av.CreateView.WithChildren(children...)
+	if err != nil {
+		return nil, err
+	}
+	return &AlterView{CreateView: cv.(*CreateView)}, nil
+}
+
+// WithDatabase implements the Databaser interface.
+func (av *AlterView) WithDatabase(database sql.Database) (sql.Node, error) {
+	cv, err := av.CreateView.WithDatabase(database)
+	if err != nil {
+		return nil, err
+	}
+	return &AlterView{CreateView: cv.(*CreateView)}, nil
+}
+
+// CheckPrivileges implements the interface sql.Node. Altering a view requires the privileges to create it and to drop
+// it.
+func (av *AlterView) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	subject := sql.PrivilegeCheckSubject{Database: av.database.Name()}
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(subject, sql.PrivilegeType_CreateView, sql.PrivilegeType_Drop)) &&
+		av.Child.CheckPrivileges(ctx, opChecker)
+}
@@ -15,6 +15,8 @@
 package plan
 
 import (
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -36,9 +38,35 @@ type CreateView struct {
 	Algorithm        string
 	Definer          string
 	Security         string
-	CheckOpt         string
+	// CheckOpt is the check option of the view, either ViewCheckOptionCascaded or ViewCheckOptionLocal, or empty if it
+	// has none.
+	CheckOpt string
+	// IsUpdatable is whether rows can be written through the view, as reported by information_schema.views.
+	IsUpdatable bool
 }
 
+const (
+	// ViewCheckOptionCascaded is the check option of views created WITH CASCADED CHECK OPTION, which checks that the
+	// rows written through the view satisfy the conditions of the view and of every view it's defined on.
+	ViewCheckOptionCascaded = "CASCADED"
+	// ViewCheckOptionLocal is the check option of views created WITH LOCAL CHECK OPTION, which checks that the rows
+	// written through the view satisfy the conditions of the view, and of the views it's defined on that have a check
+	// option of their own.
+	ViewCheckOptionLocal = "LOCAL"
+
+	// ViewSecurityDefiner is the SQL SECURITY of views that select from their tables with the privileges of their
+	// definer. It's the default.
+	ViewSecurityDefiner = "DEFINER"
+	// ViewSecurityInvoker is the SQL SECURITY of views that select from their tables with the privileges of the user
+	// selecting from the view.
+	ViewSecurityInvoker = "INVOKER"
+)
+
+// DynamicPrivilege_SetUserId is the dynamic privilege required to create views and stored routines with a definer
+// other than the current user.
+// https://dev.mysql.com/doc/refman/8.0/en/privileges-provided.html#priv_set-user-id
+const DynamicPrivilege_SetUserId = "set_user_id"
+
 var _ sql.Node = (*CreateView)(nil)
 var _ sql.CollationCoercible = (*CreateView)(nil)
 
@@ -110,7 +138,24 @@ func (cv *CreateView) CheckPrivileges(ctx *sql.Context, opChecker sql.Privileged
 	subject := sql.PrivilegeCheckSubject{Database: cv.database.Name()}
 	return opChecker.UserHasPrivileges(ctx,
 		sql.NewPrivilegedOperation(subject, sql.PrivilegeType_CreateView)) &&
-		cv.Child.CheckPrivileges(ctx, opChecker)
+		cv.Child.CheckPrivileges(ctx, opChecker) &&
+		checkDefinerPrivileges(ctx, opChecker, cv.Definer)
+}
+
+// checkDefinerPrivileges returns whether the current user may create a view or a stored routine that runs as
+// |definer|, which requires the SET_USER_ID or SUPER privilege unless the definer is the current user.
+func checkDefinerPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker, definer string) bool {
+	if definer == "" {
+		return true
+	}
+	if account, ok := sql.ParseDefiner(definer); ok {
+		client := ctx.Session.Client()
+		if account.User == client.User && strings.EqualFold(account.Address, client.Address) {
+			return true
+		}
+	}
+	return opChecker.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(DynamicPrivilege_SetUserId)) ||
+		opChecker.UserHasPrivileges(ctx, sql.NewGlobalPrivilegedOperation(sql.PrivilegeType_Super))
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -138,52 +183,54 @@ func (cv *CreateView) WithDatabase(database sql.Database) (sql.Node, error) {
 // GetIsUpdatableFromCreateView returns whether the view is updatable or not.
 // https://dev.mysql.com/doc/refman/8.0/en/view-updatability.html
 func GetIsUpdatableFromCreateView(cv *CreateView) bool {
-	isUpdatable := true
-	node := cv.Child
-
 	if cv.Algorithm == "TEMPTABLE" {
 		return false
 	}
 
-	transform.InspectExpressionsWithNode(node, func(n sql.Node, e sql.Expression) bool {
-		switch e.(type) {
-		case sql.Aggregation, sql.WindowAggregation, *Subquery:
-			isUpdatable = false
-			return false
-		}
-
-		switch nn := n.(type) {
-		case *Distinct, *GroupBy, *Having, *SetOp:
+	isUpdatable := true
+	transform.Inspect(cv.Child, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *Distinct, *GroupBy, *Having, *SetOp, *Window:
 			isUpdatable = false
-			return false
 		case *Project:
-			// Refers only to literal values (in this case, there is no underlying table to update)
+			// Refers only to literal values (in this case, there is no underlying table to update), or has a subquery
+			// in the select list
 			allLiteral := true
-			transform.InspectExpressions(nn, func(ne sql.Expression) bool {
-				switch ne.(type) {
-				case *expression.Literal:
-
-				default:
+			for _, e := range n.Projections {
+				if _, ok := e.(*expression.Literal); !ok {
 					allLiteral = false
-					return false
 				}
-				return true
-			})
-
+				if transform.InspectExpr(e, func(e sql.Expression) bool {
+					_, ok := e.(*Subquery)
+					return ok
+				}) {
+					isUpdatable = false
+				}
+			}
 			if allLiteral {
 				isUpdatable = false
 			}
-
-			return false
+		}
+		if ne, ok := n.(sql.Expressioner); ok && isUpdatable {
+			for _, e := range ne.Expressions() {
+				if transform.InspectExpr(e, func(e sql.Expression) bool {
+					switch e.(type) {
+					case sql.Aggregation, sql.WindowAggregation:
+						return true
+					}
+					return false
+				}) {
+					isUpdatable = false
+				}
+			}
 		}
 
 		// TODO: these are missing checks for isUpdatable = false in these conditions.
 		//  we do not differentiate 'view' from 'table' in FROM clause
 		// If the view is a join view, all components of the view must be updatable
-		// Reference to nonupdatable view in the FROM clause
 		// Multiple references to any column of a base table
 
-		return true
+		return isUpdatable
 	})
 
 	return isUpdatable
@@ -114,7 +114,8 @@ func (c *CreateProcedure) CheckPrivileges(ctx *sql.Context, opChecker sql.Privil
 		Database: c.Db.Name(),
 	}
 
-	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_CreateRoutine))
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_CreateRoutine)) &&
+		checkDefinerPrivileges(ctx, opChecker, c.Definer)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
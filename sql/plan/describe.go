@@ -15,6 +15,9 @@
 package plan
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
@@ -163,3 +166,39 @@ func (d *DescribeQuery) WithQuery(child sql.Node) sql.Node {
 	res.Child = child
 	return &res
 }
+
+// DescribeAnalyze returns the EXPLAIN ANALYZE tree of |n|, which annotates each node with the runtime statistics
+// recorded for it in |stats|.
+func DescribeAnalyze(n sql.Node, stats *sql.ExecStatsCollector) string {
+	var sb strings.Builder
+	describeAnalyze(&sb, n, stats, 0)
+	return sb.String()
+}
+
+func describeAnalyze(sb *strings.Builder, n sql.Node, stats *sql.ExecStatsCollector, depth int) {
+	if qp, ok := n.(*QueryProcess); ok {
+		describeAnalyze(sb, qp.Child(), stats, depth)
+		return
+	}
+
+	actual := "(never executed)"
+	if s, ok := stats.Get(n); ok {
+		actual = s.String()
+	}
+	fmt.Fprintf(sb, "%s-> %s %s\n", strings.Repeat("    ", depth), describeAnalyzeLabel(n), actual)
+	for _, child := range n.Children() {
+		describeAnalyze(sb, child, stats, depth+1)
+	}
+}
+
+// describeAnalyzeLabel returns the first line of the description of |n|, which names the node without its children.
+func describeAnalyzeLabel(n sql.Node) string {
+	if rt, ok := n.(*ResolvedTable); ok {
+		return fmt.Sprintf("TableScan on %s", rt.Name())
+	}
+	label := sql.Describe(n, sql.DescribeOptions{})
+	if i := strings.IndexByte(label, '\n'); i >= 0 {
+		label = label[:i]
+	}
+	return strings.TrimSpace(label)
+}
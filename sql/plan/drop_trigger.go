@@ -27,6 +27,8 @@ type DropTrigger struct {
 	Db          sql.Database
 	IfExists    bool
 	TriggerName string
+	// Table is the name of the table of the trigger, which is set by the analyzer when it loads the triggers of Db.
+	Table string
 }
 
 var _ sql.Databaser = (*DropTrigger)(nil)
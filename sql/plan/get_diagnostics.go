@@ -0,0 +1,172 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DiagnosticsItemName is the name of a piece of information that GET DIAGNOSTICS reads from the diagnostics area.
+type DiagnosticsItemName string
+
+const (
+	// Statement information items
+	DiagnosticsItemName_Number   DiagnosticsItemName = "number"
+	DiagnosticsItemName_RowCount DiagnosticsItemName = "row_count"
+
+	// Condition information items
+	DiagnosticsItemName_MysqlErrno       DiagnosticsItemName = "mysql_errno"
+	DiagnosticsItemName_MessageText      DiagnosticsItemName = "message_text"
+	DiagnosticsItemName_ReturnedSqlState DiagnosticsItemName = "returned_sqlstate"
+)
+
+// IsConditionItem returns whether the item describes a single condition, rather than the statement.
+func (n DiagnosticsItemName) IsConditionItem() bool {
+	switch n {
+	case DiagnosticsItemName_MysqlErrno, DiagnosticsItemName_MessageText, DiagnosticsItemName_ReturnedSqlState:
+		return true
+	}
+	return false
+}
+
+// DiagnosticsItem assigns a piece of information from the diagnostics area to a user variable or a procedure
+// variable.
+type DiagnosticsItem struct {
+	Target sql.Expression
+	Name   DiagnosticsItemName
+}
+
+// GetDiagnostics is the GET DIAGNOSTICS statement, which reads either statement information or the information of a
+// single condition from the diagnostics area of the session.
+type GetDiagnostics struct {
+	// ConditionNumber is the condition to read condition information items from, or nil for statement information.
+	ConditionNumber sql.Expression
+	Items           []DiagnosticsItem
+}
+
+var _ sql.Node = (*GetDiagnostics)(nil)
+var _ sql.Expressioner = (*GetDiagnostics)(nil)
+var _ sql.CollationCoercible = (*GetDiagnostics)(nil)
+
+// NewGetDiagnostics returns a new *GetDiagnostics node. A nil |conditionNumber| reads statement information items,
+// otherwise the items are read from the condition with that number.
+func NewGetDiagnostics(conditionNumber sql.Expression, items []DiagnosticsItem) (*GetDiagnostics, error) {
+	for _, item := range items {
+		if isCondition := conditionNumber != nil; item.Name.IsConditionItem() != isCondition {
+			kind := "statement"
+			if isCondition {
+				kind = "condition"
+			}
+			return nil, sql.ErrInvalidDiagnosticsItem.New(strings.ToUpper(string(item.Name)), kind)
+		}
+	}
+	return &GetDiagnostics{
+		ConditionNumber: conditionNumber,
+		Items:           items,
+	}, nil
+}
+
+// Resolved implements the interface sql.Node.
+func (g *GetDiagnostics) Resolved() bool {
+	for _, e := range g.Expressions() {
+		if !e.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsReadOnly implements the interface sql.Node.
+func (g *GetDiagnostics) IsReadOnly() bool {
+	return true
+}
+
+// String implements the interface sql.Node.
+func (g *GetDiagnostics) String() string {
+	return g.format(func(e sql.Expression) string { return e.String() })
+}
+
+// DebugString implements the interface sql.DebugStringer.
+func (g *GetDiagnostics) DebugString() string {
+	return g.format(func(e sql.Expression) string { return sql.DebugString(e) })
+}
+
+func (g *GetDiagnostics) format(exprString func(sql.Expression) string) string {
+	items := make([]string, len(g.Items))
+	for i, item := range g.Items {
+		items[i] = fmt.Sprintf("%s = %s", exprString(item.Target), strings.ToUpper(string(item.Name)))
+	}
+	if g.ConditionNumber != nil {
+		return fmt.Sprintf("GET DIAGNOSTICS CONDITION %s %s", exprString(g.ConditionNumber), strings.Join(items, ", "))
+	}
+	return fmt.Sprintf("GET DIAGNOSTICS %s", strings.Join(items, ", "))
+}
+
+// Schema implements the interface sql.Node.
+func (g *GetDiagnostics) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the interface sql.Node.
+func (g *GetDiagnostics) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (g *GetDiagnostics) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(g, children...)
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (g *GetDiagnostics) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*GetDiagnostics) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// Expressions implements the interface sql.Expressioner. The condition number, if any, comes first, followed by the
+// targets of the items.
+func (g *GetDiagnostics) Expressions() []sql.Expression {
+	var exprs []sql.Expression
+	if g.ConditionNumber != nil {
+		exprs = append(exprs, g.ConditionNumber)
+	}
+	for _, item := range g.Items {
+		exprs = append(exprs, item.Target)
+	}
+	return exprs
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (g *GetDiagnostics) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(g.Expressions()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(exprs), len(g.Expressions()))
+	}
+	ng := *g
+	if ng.ConditionNumber != nil {
+		ng.ConditionNumber, exprs = exprs[0], exprs[1:]
+	}
+	ng.Items = make([]DiagnosticsItem, len(g.Items))
+	for i, item := range g.Items {
+		ng.Items[i] = DiagnosticsItem{Target: exprs[i], Name: item.Name}
+	}
+	return &ng, nil
+}
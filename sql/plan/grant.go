@@ -99,7 +99,7 @@ func (n *Grant) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperat
 
 	if n.PrivilegeLevel.Database == "*" && n.PrivilegeLevel.TableRoutine == "*" {
 		if n.Privileges[0].Type == PrivilegeType_All {
-			return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(sql.PrivilegeCheckSubject{},
+			return opChecker.UserHasPrivileges(ctx, sql.NewGlobalPrivilegedOperation(
 				sql.PrivilegeType_Select,
 				sql.PrivilegeType_Insert,
 				sql.PrivilegeType_Update,
@@ -133,7 +133,7 @@ func (n *Grant) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperat
 				sql.PrivilegeType_GrantOption,
 			))
 		}
-		return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(sql.PrivilegeCheckSubject{},
+		return opChecker.UserHasPrivileges(ctx, sql.NewGlobalPrivilegedOperation(
 			convertToSqlPrivilegeType(true, n.Privileges...)...))
 	} else if n.PrivilegeLevel.Database != "*" && n.PrivilegeLevel.TableRoutine == "*" {
 		database := n.PrivilegeLevel.Database
@@ -217,7 +217,8 @@ func (p *Privilege) String() string {
 func (p *Privilege) IsValidDynamic() bool {
 	if p.Type == PrivilegeType_Dynamic {
 		switch p.Dynamic {
-		case DynamicPrivilege_ReplicationSlaveAdmin, DynamicPrivilege_CloneAdmin:
+		case DynamicPrivilege_ReplicationSlaveAdmin, DynamicPrivilege_CloneAdmin, DynamicPrivilege_BinlogAdmin,
+			DynamicPrivilege_ResourceGroupAdmin, DynamicPrivilege_ResourceGroupUser, DynamicPrivilege_SetUserId:
 			return true
 		}
 	}
@@ -32,6 +32,32 @@ type GroupBy struct {
 	UnaryNode
 	SelectedExprs []sql.Expression
 	GroupByExprs  []sql.Expression
+	// Strategy is the way rows are grouped, as requested by a SQL_SMALL_RESULT or SQL_BIG_RESULT select modifier.
+	Strategy GroupByStrategy
+}
+
+// GroupByStrategy is the way a GroupBy node with grouping expressions groups its rows.
+type GroupByStrategy byte
+
+const (
+	// GroupByStrategyDefault groups rows in a hash table, and isn't shown in the plan.
+	GroupByStrategyDefault GroupByStrategy = iota
+	// GroupByStrategyHash groups rows in a hash table, as requested by SQL_SMALL_RESULT.
+	GroupByStrategyHash
+	// GroupByStrategySort sorts rows by the grouping expressions and aggregates each group as it's read, as requested
+	// by SQL_BIG_RESULT.
+	GroupByStrategySort
+)
+
+func (s GroupByStrategy) String() string {
+	switch s {
+	case GroupByStrategyHash:
+		return "hash"
+	case GroupByStrategySort:
+		return "sort"
+	default:
+		return ""
+	}
 }
 
 var _ sql.Expressioner = (*GroupBy)(nil)
@@ -101,7 +127,14 @@ func (g *GroupBy) WithChildren(children ...sql.Node) (sql.Node, error) {
 		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 1)
 	}
 
-	return NewGroupBy(g.SelectedExprs, g.GroupByExprs, children[0]), nil
+	return NewGroupBy(g.SelectedExprs, g.GroupByExprs, children[0]).WithStrategy(g.Strategy), nil
+}
+
+// WithStrategy returns a copy of this node that groups rows with |strategy|.
+func (g *GroupBy) WithStrategy(strategy GroupByStrategy) *GroupBy {
+	ng := *g
+	ng.Strategy = strategy
+	return &ng
 }
 
 // CheckPrivileges implements the interface sql.Node.
@@ -127,7 +160,7 @@ func (g *GroupBy) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 	grouping := make([]sql.Expression, len(g.GroupByExprs))
 	copy(grouping, exprs[len(g.SelectedExprs):])
 
-	return NewGroupBy(agg, grouping, g.Child), nil
+	return NewGroupBy(agg, grouping, g.Child).WithStrategy(g.Strategy), nil
 }
 
 func (g *GroupBy) String() string {
@@ -144,11 +177,14 @@ func (g *GroupBy) String() string {
 		grouping[i] = g.String()
 	}
 
-	_ = pr.WriteChildren(
+	children := []string{
 		fmt.Sprintf("SelectedExprs(%s)", strings.Join(selectedExprs, ", ")),
 		fmt.Sprintf("Grouping(%s)", strings.Join(grouping, ", ")),
-		g.Child.String(),
-	)
+	}
+	if g.Strategy != GroupByStrategyDefault {
+		children = append(children, fmt.Sprintf("Strategy(%s)", g.Strategy))
+	}
+	_ = pr.WriteChildren(append(children, g.Child.String())...)
 	return pr.String()
 }
 
@@ -166,11 +202,14 @@ func (g *GroupBy) DebugString() string {
 		grouping[i] = sql.DebugString(g)
 	}
 
-	_ = pr.WriteChildren(
+	children := []string{
 		fmt.Sprintf("select: %s", strings.Join(selectedExprs, ", ")),
 		fmt.Sprintf("group: %s", strings.Join(grouping, ", ")),
-		sql.DebugString(g.Child),
-	)
+	}
+	if g.Strategy != GroupByStrategyDefault {
+		children = append(children, fmt.Sprintf("strategy: %s", g.Strategy))
+	}
+	_ = pr.WriteChildren(append(children, sql.DebugString(g.Child))...)
 	return pr.String()
 }
 
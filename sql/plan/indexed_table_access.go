@@ -42,6 +42,7 @@ type IndexedTableAccess struct {
 	Typ       itaType
 	id        sql.TableId
 	cols      sql.ColSet
+	limit     uint64
 }
 
 var _ sql.Table = (*IndexedTableAccess)(nil)
@@ -194,6 +195,19 @@ func (i *IndexedTableAccess) Columns() sql.ColSet {
 	return i.cols
 }
 
+// Limit returns the maximum number of rows to read from each partition of the table, or 0 if there is no limit.
+func (i *IndexedTableAccess) Limit() uint64 {
+	return i.limit
+}
+
+// WithLimit returns a copy of this node that reads at most |limit| rows from each partition of the table. The
+// limit is only applied when the table implements sql.LimitedTable.
+func (i *IndexedTableAccess) WithLimit(limit uint64) *IndexedTableAccess {
+	ret := *i
+	ret.limit = limit
+	return &ret
+}
+
 func (i *IndexedTableAccess) IsStatic() bool {
 	return !i.lookup.IsEmpty()
 }
@@ -344,6 +358,10 @@ func (i *IndexedTableAccess) String() string {
 		children = append(children, fmt.Sprintf("reverse: %v", i.lookup.IsReverse))
 	}
 
+	if i.limit > 0 {
+		children = append(children, fmt.Sprintf("limit: %d", i.limit))
+	}
+
 	pr.WriteChildren(children...)
 	return pr.String()
 }
@@ -364,6 +382,9 @@ func (i *IndexedTableAccess) DebugString() string {
 		if i.lookup.IsReverse {
 			children = append(children, fmt.Sprintf("reverse: %v", i.lookup.IsReverse))
 		}
+		if i.limit > 0 {
+			children = append(children, fmt.Sprintf("limit: %d", i.limit))
+		}
 	} else {
 		var filters []string
 		for _, e := range i.lb.keyExprs {
@@ -96,6 +96,11 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			return false, nil
 		}
 
+		// A tuple with a NULL element never compares equal to anything, so it can't be looked up by its hash
+		if tupleHasNull(nLeft) {
+			return in.evalNullableTuple(ctx, row, right, nLeft)
+		}
+
 		key, err := sql.HashOf(sql.NewRow(nLeft))
 		if err != nil {
 			return nil, err
@@ -103,10 +108,15 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 		val, notFoundErr := values.Get(key)
 		if notFoundErr != nil {
-			if _, nilValNotFoundErr := values.Get(nilKey); nilValNotFoundErr == nil {
-				return nil, nil
+			// With no match, the result is NULL if the subquery returned a value that might have matched: NULL for a
+			// single column, or a tuple that contains a NULL
+			if _, nilValNotFoundErr := values.Get(nilKey); nilValNotFoundErr != nil {
+				return false, nil
 			}
-			return false, nil
+			if _, ok := nLeft.([]interface{}); ok {
+				return in.evalNullableTuple(ctx, row, right, nLeft)
+			}
+			return nil, nil
 		}
 
 		val, _, err = typ.Convert(val)
@@ -126,6 +136,54 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 }
 
+// evalNullableTuple compares the tuple |left| with every row of the subquery |right| using three-valued logic, for
+// when either side contains a NULL: the result is true if some row is equal to |left|, NULL if some row is equal
+// except for NULL elements, and false otherwise.
+func (in *InSubquery) evalNullableTuple(ctx *sql.Context, row sql.Row, right *Subquery, left interface{}) (interface{}, error) {
+	typ, ok := right.Type().(types.TupleType)
+	if !ok {
+		return nil, nil
+	}
+	rows, err := right.EvalMultiple(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	var result interface{} = false
+	l := left.([]interface{})
+	for _, r := range rows {
+		r, ok := r.([]interface{})
+		if !ok || len(r) != len(l) {
+			return nil, sql.ErrInvalidOperandColumns.New(len(l), len(typ))
+		}
+		eq, err := expression.TupleEquals(typ, l, r)
+		if err != nil {
+			return nil, err
+		}
+		if eq == true {
+			return true, nil
+		}
+		if eq == nil {
+			result = nil
+		}
+	}
+	return result, nil
+}
+
+// tupleHasNull returns whether |v| is a tuple with a NULL element.
+func tupleHasNull(v interface{}) bool {
+	vals, ok := v.([]interface{})
+	if !ok {
+		return false
+	}
+	for _, v := range vals {
+		if v == nil {
+			return true
+		}
+	}
+	return false
+}
+
 // WithChildren implements the Expression interface.
 func (in *InSubquery) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
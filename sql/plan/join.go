@@ -62,6 +62,10 @@ const (
 	JoinTypeLateralInner // LateralInnerJoin
 	JoinTypeLateralLeft  // LateralLeftJoin
 	JoinTypeLateralRight // LateralLeftJoin
+
+	// JoinTypeAntiIncludeNulls is an anti join for NOT IN, which treats a NULL condition as a match, unlike the anti
+	// join for NOT EXISTS
+	JoinTypeAntiIncludeNulls // AntiJoinIncludingNulls
 )
 
 func (i JoinType) IsLeftOuter() bool {
@@ -77,7 +81,7 @@ func (i JoinType) IsLeftOuter() bool {
 // that row is excluded from the result table.
 func (i JoinType) IsExcludeNulls() bool {
 	switch i {
-	case JoinTypeAnti, JoinTypeAntiHash, JoinTypeAntiLookup, JoinTypeAntiMerge, JoinTypeLeftOuterExcludeNulls, JoinTypeLeftOuterHashExcludeNulls:
+	case JoinTypeAntiIncludeNulls, JoinTypeAntiHash, JoinTypeAntiLookup, JoinTypeAntiMerge, JoinTypeLeftOuterExcludeNulls, JoinTypeLeftOuterHashExcludeNulls:
 		return true
 	default:
 		return false
@@ -157,7 +161,7 @@ func (i JoinType) IsSemi() bool {
 
 func (i JoinType) IsAnti() bool {
 	switch i {
-	case JoinTypeAnti, JoinTypeAntiLookup, JoinTypeAntiMerge, JoinTypeAntiHash:
+	case JoinTypeAnti, JoinTypeAntiIncludeNulls, JoinTypeAntiLookup, JoinTypeAntiMerge, JoinTypeAntiHash:
 		return true
 	default:
 		return false
@@ -167,6 +171,7 @@ func (i JoinType) IsAnti() bool {
 func (i JoinType) IsPartial() bool {
 	return i == JoinTypeSemi ||
 		i == JoinTypeAnti ||
+		i == JoinTypeAntiIncludeNulls ||
 		i == JoinTypeSemiHash ||
 		i == JoinTypeAntiHash ||
 		i == JoinTypeAntiLookup ||
@@ -219,7 +224,7 @@ func (i JoinType) AsHash() JoinType {
 		return JoinTypeLeftOuterHashExcludeNulls
 	case JoinTypeSemi:
 		return JoinTypeSemiHash
-	case JoinTypeAnti:
+	case JoinTypeAnti, JoinTypeAntiIncludeNulls:
 		return JoinTypeAntiHash
 	case JoinTypeCross:
 		return JoinTypeCrossHash
@@ -247,7 +252,7 @@ func (i JoinType) AsMerge() JoinType {
 		return JoinTypeLeftOuterMerge
 	case JoinTypeSemi:
 		return JoinTypeSemiMerge
-	case JoinTypeAnti:
+	case JoinTypeAnti, JoinTypeAntiIncludeNulls:
 		return JoinTypeAntiMerge
 	default:
 		return i
@@ -262,7 +267,7 @@ func (i JoinType) AsLookup() JoinType {
 		return JoinTypeLeftOuterLookup
 	case JoinTypeSemi:
 		return JoinTypeSemiLookup
-	case JoinTypeAnti:
+	case JoinTypeAnti, JoinTypeAntiIncludeNulls:
 		return JoinTypeAntiLookup
 	default:
 		return i
@@ -528,6 +533,12 @@ func NewAntiJoin(left, right sql.Node, cond sql.Expression) *JoinNode {
 	return NewJoin(left, right, JoinTypeAnti, cond)
 }
 
+// NewAntiJoinIncludingNulls creates an anti join that excludes the rows of |left| for which |cond| is NULL for some
+// row of |right|, as NOT IN does.
+func NewAntiJoinIncludingNulls(left, right sql.Node, cond sql.Expression) *JoinNode {
+	return NewJoin(left, right, JoinTypeAntiIncludeNulls, cond)
+}
+
 func NewSemiJoin(left, right sql.Node, cond sql.Expression) *JoinNode {
 	return NewJoin(left, right, JoinTypeSemi, cond)
 }
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // Limit is a node that only allows up to N rows to be retrieved.
@@ -23,6 +24,12 @@ type Limit struct {
 	UnaryNode
 	Limit         sql.Expression
 	CalcFoundRows bool
+	// Percent is whether Limit is a percentage of the rows of the child, which must all be read to count them
+	Percent bool
+	// WithTies is whether the rows following the last row within the limit are also returned while they're equal to it
+	// on SortFields
+	WithTies   bool
+	SortFields sql.SortFields
 }
 
 var _ sql.Node = (*Limit)(nil)
@@ -38,22 +45,25 @@ func NewLimit(size sql.Expression, child sql.Node) *Limit {
 
 // Expressions implements sql.Expressioner
 func (l *Limit) Expressions() []sql.Expression {
-	return []sql.Expression{l.Limit}
+	return append([]sql.Expression{l.Limit}, l.SortFields.ToExpressions()...)
 }
 
 // WithExpressions implements sql.Expressioner
 func (l Limit) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
-	if len(exprs) != 1 {
-		return nil, sql.ErrInvalidChildrenNumber.New(l, len(exprs), 1)
+	if len(exprs) != 1+len(l.SortFields) {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(exprs), 1+len(l.SortFields))
 	}
 	nl := &l
 	nl.Limit = exprs[0]
+	if len(l.SortFields) > 0 {
+		nl.SortFields = l.SortFields.FromExpressions(exprs[1:]...)
+	}
 	return nl, nil
 }
 
 // Resolved implements the Resolvable interface.
 func (l *Limit) Resolved() bool {
-	return l.UnaryNode.Child.Resolved() && l.Limit.Resolved()
+	return l.UnaryNode.Child.Resolved() && l.Limit.Resolved() && expression.ExpressionsResolved(l.SortFields.ToExpressions()...)
 }
 
 func (l Limit) WithCalcFoundRows(v bool) *Limit {
@@ -88,14 +98,24 @@ func (l *Limit) CollationCoercibility(ctx *sql.Context) (collation sql.Collation
 
 func (l Limit) String() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("Limit(%s)", l.Limit)
+	_ = pr.WriteNode("Limit(%s)", l.limitString(l.Limit.String()))
 	_ = pr.WriteChildren(l.Child.String())
 	return pr.String()
 }
 
 func (l Limit) DebugString() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("Limit(%s)", l.Limit)
+	_ = pr.WriteNode("Limit(%s)", l.limitString(l.Limit.String()))
 	_ = pr.WriteChildren(sql.DebugString(l.Child))
 	return pr.String()
 }
+
+func (l Limit) limitString(limit string) string {
+	if l.Percent {
+		limit += " PERCENT"
+	}
+	if l.WithTies {
+		limit += " WITH TIES"
+	}
+	return limit
+}
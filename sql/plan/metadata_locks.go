@@ -0,0 +1,122 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// MetadataLockRequests returns the metadata locks that the analyzed |node| needs to execute: a shared lock on every
+// table it reads or writes, and an exclusive lock on every table it changes the definition of. Tables of the
+// information_schema and performance_schema databases are never locked.
+func MetadataLockRequests(node sql.Node) []sql.MetadataLockRequest {
+	var requests []sql.MetadataLockRequest
+	add := func(db sql.Database, table string, typ sql.MetadataLockType) {
+		if db == nil {
+			return
+		}
+		addMetadataLockRequest(&requests, db.Name(), table, typ)
+	}
+	addTable := func(n sql.Node, typ sql.MetadataLockType) {
+		if tn, ok := n.(sql.TableNode); ok {
+			add(tn.Database(), tn.Name(), typ)
+		}
+	}
+
+	var inspect func(node sql.Node)
+	inspect = func(node sql.Node) {
+		if node == nil {
+			return
+		}
+		transform.Inspect(node, func(n sql.Node) bool {
+			switch n := n.(type) {
+			case sql.TableNode:
+				addTable(n, sql.MetadataLockShared)
+			case *SubqueryAlias:
+				// a view is locked by name, as well as the tables it selects from
+				addMetadataLockRequest(&requests, n.ViewDatabase, n.ViewName, sql.MetadataLockShared)
+			case *InsertInto:
+				// the source of an insert is not one of its children
+				inspect(n.Source)
+			case *CreateTable:
+				add(n.Database(), n.Name(), sql.MetadataLockExclusive)
+			case *DropTable:
+				for _, table := range n.Tables {
+					addTable(table, sql.MetadataLockExclusive)
+				}
+			case *RenameTable:
+				if db := n.Database(); db != nil {
+					for i := range n.OldNames {
+						addMetadataLockRequest(&requests, db.Name(), n.OldNames[i], sql.MetadataLockExclusive)
+						addMetadataLockRequest(&requests, db.Name(), n.NewNames[i], sql.MetadataLockExclusive)
+					}
+				}
+			case *CreateForeignKey:
+				addMetadataLockRequest(&requests, n.FkDef.Database, n.FkDef.Table, sql.MetadataLockExclusive)
+				addMetadataLockRequest(&requests, n.FkDef.ParentDatabase, n.FkDef.ParentTable, sql.MetadataLockShared)
+			case *DropForeignKey:
+				addMetadataLockRequest(&requests, n.Database(), n.Table, sql.MetadataLockExclusive)
+			case *DropTrigger:
+				add(n.Db, n.Table, sql.MetadataLockExclusive)
+			case *CreateView:
+				add(n.Database(), n.Name, sql.MetadataLockExclusive)
+			case *AlterView:
+				add(n.Database(), n.Name, sql.MetadataLockExclusive)
+			case *SingleDropView:
+				add(n.Database(), n.ViewName, sql.MetadataLockExclusive)
+			}
+
+			// every other statement that changes the definition of a table, such as an ALTER TABLE, has the table as one of
+			// its children. The child of a CREATE TABLE is the table or query it's created from, which is only read.
+			if _, ok := n.(*CreateTable); !ok && IsDDLNode(n) {
+				for _, child := range n.Children() {
+					addTable(child, sql.MetadataLockExclusive)
+				}
+			}
+
+			if ne, ok := n.(sql.Expressioner); ok {
+				for _, e := range ne.Expressions() {
+					if e == nil {
+						continue
+					}
+					transform.InspectExpr(e, func(e sql.Expression) bool {
+						if sq, ok := e.(*Subquery); ok {
+							inspect(sq.Query)
+						}
+						return false
+					})
+				}
+			}
+			return true
+		})
+	}
+	inspect(node)
+	return requests
+}
+
+// addMetadataLockRequest appends a request for a lock on |table| in |db| to |requests|, unless the table is not
+// lockable.
+func addMetadataLockRequest(requests *[]sql.MetadataLockRequest, db, table string, typ sql.MetadataLockType) {
+	if db == "" || table == "" {
+		return
+	}
+	if strings.EqualFold(db, sql.InformationSchemaDatabaseName) || strings.EqualFold(db, sql.PerformanceSchemaDatabaseName) {
+		return
+	}
+	*requests = append(*requests, sql.MetadataLockRequest{Schema: db, Table: table, Type: typ})
+}
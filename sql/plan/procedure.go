@@ -237,6 +237,12 @@ func (p *Procedure) IsExternal() bool {
 	return false
 }
 
+// RunsAsDefiner returns whether the stored procedure runs with the privileges of its definer, which is the default for
+// procedures that name a definer.
+func (p *Procedure) RunsAsDefiner() bool {
+	return p.Definer != "" && p.SecurityContext == ProcedureSecurityContext_Definer
+}
+
 // String returns the original SQL representation.
 func (pst ProcedureSecurityContext) String() string {
 	switch pst {
@@ -232,6 +232,16 @@ func (t *ProcessTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.Row
 	return NewTrackedRowIter(nil, iter, onNext, onDone), nil
 }
 
+// BatchTable returns this table as a sql.BatchTable that notifies the process manager in the same way as
+// PartitionRows, if the wrapped table is a sql.BatchTable.
+func (t *ProcessTable) BatchTable() (sql.BatchTable, bool) {
+	bt, ok := t.Table.(sql.BatchTable)
+	if !ok {
+		return nil, false
+	}
+	return &processBatchTable{ProcessTable: t, batchTable: bt}, true
+}
+
 func (t *ProcessTable) DebugString() string {
 	tp := sql.NewTreePrinter()
 	_ = tp.WriteNode("ProcessTable")
@@ -394,6 +404,58 @@ func (i *trackedRowIter) updateSessionVars(ctx *sql.Context) {
 	}
 }
 
+// processBatchTable is a ProcessTable over a sql.BatchTable.
+type processBatchTable struct {
+	*ProcessTable
+	batchTable sql.BatchTable
+}
+
+var _ sql.BatchTable = (*processBatchTable)(nil)
+
+// PartitionBatches implements the sql.BatchTable interface.
+func (t *processBatchTable) PartitionBatches(ctx *sql.Context, p sql.Partition) (sql.BatchRowIter, error) {
+	iter, err := t.batchTable.PartitionBatches(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	onDone, onNext := t.notifyFuncsForPartition(p)
+
+	return &trackedBatchIter{iter: iter, tracker: NewTrackedRowIter(nil, nil, onNext, onDone)}, nil
+}
+
+// trackedBatchIter is the sql.BatchRowIter version of trackedRowIter.
+type trackedBatchIter struct {
+	iter    sql.BatchRowIter
+	tracker *trackedRowIter
+}
+
+func (i *trackedBatchIter) NextBatch(ctx *sql.Context) (*sql.RowBatch, error) {
+	batch, err := i.iter.NextBatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i.tracker.numRows += int64(batch.Len)
+
+	if i.tracker.onNext != nil {
+		for j := 0; j < batch.Len; j++ {
+			i.tracker.onNext()
+		}
+	}
+
+	return batch, nil
+}
+
+func (i *trackedBatchIter) Close(ctx *sql.Context) error {
+	err := i.iter.Close(ctx)
+
+	i.tracker.updateSessionVars(ctx)
+
+	i.tracker.done()
+	return err
+}
+
 type trackedPartitionIndexKeyValueIter struct {
 	sql.PartitionIndexKeyValueIter
 	OnPartitionDone  NamedNotifyFunc
@@ -477,13 +539,14 @@ func IsDDLNode(node sql.Node) bool {
 		*AddColumn, *ModifyColumn, *DropColumn,
 		*CreateDB, *DropDB, *AlterDB,
 		*RenameTable, *RenameColumn,
-		*CreateView, *DropView,
+		*CreateView, *AlterView, *DropView,
 		*CreateIndex, *AlterIndex, *DropIndex,
 		*CreateProcedure, *DropProcedure,
 		*CreateEvent, *DropEvent,
 		*CreateForeignKey, *DropForeignKey,
 		*CreateCheck, *DropCheck,
-		*CreateTrigger, *DropTrigger, *AlterPK,
+		*CreateTrigger, *DropTrigger, *AlterPK, *RebuildTable,
+		*AlterDefaultSet, *AlterDefaultDrop, *AlterAutoIncrement, *AlterTableCollation,
 		*Block: // Block as a top level node wraps a set of ALTER TABLE statements
 		return true
 	default:
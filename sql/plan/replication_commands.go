@@ -28,10 +28,22 @@ import (
 // replication controller to dispatch the command to.
 var ErrNoReplicationController = errors.NewKind("no replication controller available")
 
+// ErrNoPrimaryController is returned when source-side replication commands are executed without a configured
+// primary controller to dispatch the command to.
+var ErrNoPrimaryController = errors.NewKind("no binlog primary controller available")
+
+// ErrNoSourceController is returned when statements that modify the binary logs are executed without a configured
+// source controller to dispatch the command to.
+var ErrNoSourceController = errors.NewKind("no binlog source controller available")
+
 // DynamicPrivilege_ReplicationSlaveAdmin is the dynamic privilege required to execute replication commands.
 // https://dev.mysql.com/doc/refman/8.0/en/privileges-provided.html#priv_replication-slave-admin
 const DynamicPrivilege_ReplicationSlaveAdmin = "replication_slave_admin"
 
+// DynamicPrivilege_BinlogAdmin is the dynamic privilege required to manage the binary logs.
+// https://dev.mysql.com/doc/refman/8.0/en/privileges-provided.html#priv_binlog-admin
+const DynamicPrivilege_BinlogAdmin = "binlog_admin"
+
 // BinlogReplicaControllerCommand represents a SQL statement that requires a BinlogReplicaController
 // (e.g. Start Replica, Show Replica Status).
 type BinlogReplicaControllerCommand interface {
@@ -42,6 +54,16 @@ type BinlogReplicaControllerCommand interface {
 	WithBinlogReplicaController(controller binlogreplication.BinlogReplicaController) sql.Node
 }
 
+// BinlogPrimaryControllerCommand represents a SQL statement that requires a BinlogPrimaryController
+// (e.g. Show Replicas).
+type BinlogPrimaryControllerCommand interface {
+	sql.Node
+
+	// WithBinlogPrimaryController returns a new instance of this BinlogPrimaryControllerCommand, with the binlog
+	// primary controller configured.
+	WithBinlogPrimaryController(controller binlogreplication.BinlogPrimaryController) sql.Node
+}
+
 // ChangeReplicationSource is the plan node for the "CHANGE REPLICATION SOURCE TO" statement.
 // https://dev.mysql.com/doc/refman/8.0/en/change-replication-source-to.html
 type ChangeReplicationSource struct {
@@ -74,16 +96,14 @@ func (c *ChangeReplicationSource) IsReadOnly() bool {
 	return false
 }
 
+// String implements the sql.Node interface. String option values are quoted so that the result can be parsed.
 func (c *ChangeReplicationSource) String() string {
-	sb := strings.Builder{}
-	sb.WriteString("CHANGE REPLICATION SOURCE TO ")
-	for i, option := range c.Options {
-		if i > 0 {
-			sb.WriteString(", ")
-		}
-		sb.WriteString(fmt.Sprintf("%s = %s", option.Name, option.Value))
-	}
-	return sb.String()
+	return "CHANGE REPLICATION SOURCE TO " + replicationOptionsString(c.Options)
+}
+
+// DebugString implements the sql.DebugStringer interface.
+func (c *ChangeReplicationSource) DebugString() string {
+	return c.String()
 }
 
 func (c *ChangeReplicationSource) Schema() sql.Schema {
@@ -145,19 +165,14 @@ func (c *ChangeReplicationFilter) IsReadOnly() bool {
 	return false
 }
 
+// String implements the sql.Node interface. Table names are quoted so that the result can be parsed.
 func (c *ChangeReplicationFilter) String() string {
-	sb := strings.Builder{}
-	sb.WriteString("CHANGE REPLICATION FILTER ")
-	for i, option := range c.Options {
-		if i > 0 {
-			sb.WriteString(", ")
-		}
-		sb.WriteString(option.Name)
-		sb.WriteString(" = ")
-		// TODO: Fix this to use better typing
-		sb.WriteString(fmt.Sprintf("%s", option.Value))
-	}
-	return sb.String()
+	return "CHANGE REPLICATION FILTER " + replicationOptionsString(c.Options)
+}
+
+// DebugString implements the sql.DebugStringer interface.
+func (c *ChangeReplicationFilter) DebugString() string {
+	return c.String()
 }
 
 func (c *ChangeReplicationFilter) Schema() sql.Schema {
@@ -191,14 +206,19 @@ func (*ChangeReplicationFilter) CollationCoercibility(ctx *sql.Context) (collati
 // https://dev.mysql.com/doc/refman/8.0/en/start-replica.html
 type StartReplica struct {
 	ReplicaController binlogreplication.BinlogReplicaController
+	// UntilOptions holds the options from an UNTIL clause (e.g. SOURCE_LOG_FILE and SOURCE_LOG_POS) that specify
+	// when replication should stop, or is empty if replication should run until explicitly stopped.
+	UntilOptions []binlogreplication.ReplicationOption
 }
 
 var _ sql.Node = (*StartReplica)(nil)
 var _ sql.CollationCoercible = (*StartReplica)(nil)
 var _ BinlogReplicaControllerCommand = (*StartReplica)(nil)
 
-func NewStartReplica() *StartReplica {
-	return &StartReplica{}
+func NewStartReplica(untilOptions []binlogreplication.ReplicationOption) *StartReplica {
+	return &StartReplica{
+		UntilOptions: untilOptions,
+	}
 }
 
 // WithBinlogReplicaController implements the BinlogReplicaControllerCommand interface.
@@ -217,7 +237,25 @@ func (s *StartReplica) IsReadOnly() bool {
 }
 
 func (s *StartReplica) String() string {
-	return "START REPLICA"
+	sb := strings.Builder{}
+	sb.WriteString("START REPLICA")
+	for i, option := range s.UntilOptions {
+		if i == 0 {
+			sb.WriteString(" UNTIL ")
+		} else {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%s = %s", option.Name, option.Value))
+	}
+	return sb.String()
+}
+
+// DebugString implements the sql.DebugStringer interface.
+func (s *StartReplica) DebugString() string {
+	if len(s.UntilOptions) == 0 {
+		return "START REPLICA"
+	}
+	return "START REPLICA UNTIL " + replicationOptionsString(s.UntilOptions)
 }
 
 func (s *StartReplica) Schema() sql.Schema {
@@ -280,6 +318,11 @@ func (s *StopReplica) String() string {
 	return "STOP REPLICA"
 }
 
+// DebugString implements the sql.DebugStringer interface.
+func (s *StopReplica) DebugString() string {
+	return s.String()
+}
+
 func (s *StopReplica) Schema() sql.Schema {
 	return nil
 }
@@ -348,6 +391,11 @@ func (r *ResetReplica) String() string {
 	return sb.String()
 }
 
+// DebugString implements the sql.DebugStringer interface.
+func (r *ResetReplica) DebugString() string {
+	return r.String()
+}
+
 func (r *ResetReplica) Schema() sql.Schema {
 	return nil
 }
@@ -373,3 +421,123 @@ func (r *ResetReplica) CheckPrivileges(ctx *sql.Context, opChecker sql.Privilege
 func (*ResetReplica) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
 	return sql.Collation_binary, 7
 }
+
+// ResetBinaryLogs is a plan node for the "RESET BINARY LOGS AND GTIDS" statement, and its legacy form "RESET MASTER".
+// https://dev.mysql.com/doc/refman/8.0/en/reset-master.html
+type ResetBinaryLogs struct {
+	SourceController binlogreplication.BinlogSourceController
+	// ToPosition is the number of the first binary log file after the reset, or zero to start from the first file.
+	ToPosition uint64
+}
+
+var _ sql.Node = (*ResetBinaryLogs)(nil)
+var _ sql.CollationCoercible = (*ResetBinaryLogs)(nil)
+
+func NewResetBinaryLogs(toPosition uint64) *ResetBinaryLogs {
+	return &ResetBinaryLogs{
+		ToPosition: toPosition,
+	}
+}
+
+// WithBinlogSourceController returns a new instance of this node, with the binlog source controller configured.
+func (r *ResetBinaryLogs) WithBinlogSourceController(controller binlogreplication.BinlogSourceController) sql.Node {
+	nc := *r
+	nc.SourceController = controller
+	return &nc
+}
+
+func (r *ResetBinaryLogs) Resolved() bool {
+	return true
+}
+
+func (r *ResetBinaryLogs) IsReadOnly() bool {
+	return false
+}
+
+func (r *ResetBinaryLogs) String() string {
+	sb := strings.Builder{}
+	sb.WriteString("RESET BINARY LOGS AND GTIDS")
+	if r.ToPosition != 0 {
+		sb.WriteString(fmt.Sprintf(" TO %d", r.ToPosition))
+	}
+	return sb.String()
+}
+
+// DebugString implements the sql.DebugStringer interface.
+func (r *ResetBinaryLogs) DebugString() string {
+	return r.String()
+}
+
+func (r *ResetBinaryLogs) Schema() sql.Schema {
+	return nil
+}
+
+func (r *ResetBinaryLogs) Children() []sql.Node {
+	return nil
+}
+
+func (r *ResetBinaryLogs) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 0)
+	}
+
+	newNode := *r
+	return &newNode, nil
+}
+
+func (r *ResetBinaryLogs) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(DynamicPrivilege_BinlogAdmin)) ||
+		opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(sql.PrivilegeCheckSubject{}, sql.PrivilegeType_Super))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*ResetBinaryLogs) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// replicationOptionsString returns the options given as a comma-separated list of assignments, with each value
+// quoted as it would be in a statement.
+func replicationOptionsString(options []binlogreplication.ReplicationOption) string {
+	sb := strings.Builder{}
+	for i, option := range options {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(option.Name)
+		sb.WriteString(" = ")
+		sb.WriteString(quotedReplicationOptionValue(option.Value))
+	}
+	return sb.String()
+}
+
+func quotedReplicationOptionValue(value binlogreplication.ReplicationOptionValue) string {
+	switch v := value.(type) {
+	case binlogreplication.StringReplicationOptionValue:
+		return quoteReplicationString(v.Value)
+	case *binlogreplication.StringReplicationOptionValue:
+		return quoteReplicationString(v.Value)
+	case binlogreplication.TableNamesReplicationOptionValue:
+		return quoteReplicationTableNames(v.Value)
+	case *binlogreplication.TableNamesReplicationOptionValue:
+		return quoteReplicationTableNames(v.Value)
+	default:
+		return value.String()
+	}
+}
+
+func quoteReplicationString(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "'", "\\'")
+	return "'" + s + "'"
+}
+
+func quoteReplicationTableNames(tables []sql.UnresolvedTable) string {
+	names := make([]string, len(tables))
+	for i, urt := range tables {
+		names[i] = sql.QuoteIdentifier(urt.Name())
+		if urt.Database().Name() != "" {
+			names[i] = sql.QuoteIdentifier(urt.Database().Name()) + "." + names[i]
+		}
+	}
+	return "(" + strings.Join(names, ", ") + ")"
+}
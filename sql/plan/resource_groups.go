@@ -0,0 +1,281 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ErrNoResourceGroupRegistry is returned when resource group statements are executed against a catalog that doesn't
+// keep resource groups.
+var ErrNoResourceGroupRegistry = errors.NewKind("resource groups are not supported")
+
+// DynamicPrivilege_ResourceGroupAdmin is the dynamic privilege required to manage resource groups.
+// https://dev.mysql.com/doc/refman/8.0/en/privileges-provided.html#priv_resource-group-admin
+const DynamicPrivilege_ResourceGroupAdmin = "resource_group_admin"
+
+// DynamicPrivilege_ResourceGroupUser is the dynamic privilege required to assign threads to resource groups.
+// https://dev.mysql.com/doc/refman/8.0/en/privileges-provided.html#priv_resource-group-user
+const DynamicPrivilege_ResourceGroupUser = "resource_group_user"
+
+// CreateResourceGroup is the plan node for the "CREATE RESOURCE GROUP" statement.
+// https://dev.mysql.com/doc/refman/8.0/en/create-resource-group.html
+type CreateResourceGroup struct {
+	Registry *sql.ResourceGroupRegistry
+	Group    sql.ResourceGroup
+}
+
+var _ sql.Node = (*CreateResourceGroup)(nil)
+var _ sql.CollationCoercible = (*CreateResourceGroup)(nil)
+
+func NewCreateResourceGroup(group sql.ResourceGroup) *CreateResourceGroup {
+	return &CreateResourceGroup{Group: group}
+}
+
+func (c *CreateResourceGroup) Resolved() bool {
+	return true
+}
+
+func (c *CreateResourceGroup) IsReadOnly() bool {
+	return false
+}
+
+func (c *CreateResourceGroup) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("CREATE RESOURCE GROUP %s TYPE = %s", c.Group.Name, c.Group.Type))
+	if len(c.Group.VCPUs) > 0 {
+		sb.WriteString(" VCPU = " + sql.ResourceGroupVCPUsString(c.Group.VCPUs))
+	}
+	sb.WriteString(fmt.Sprintf(" THREAD_PRIORITY = %d", c.Group.ThreadPriority))
+	if c.Group.Enabled {
+		sb.WriteString(" ENABLE")
+	} else {
+		sb.WriteString(" DISABLE")
+	}
+	return sb.String()
+}
+
+func (c *CreateResourceGroup) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+func (c *CreateResourceGroup) Children() []sql.Node {
+	return nil
+}
+
+func (c *CreateResourceGroup) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 0)
+	}
+	return c, nil
+}
+
+func (c *CreateResourceGroup) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(DynamicPrivilege_ResourceGroupAdmin))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*CreateResourceGroup) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// AlterResourceGroup is the plan node for the "ALTER RESOURCE GROUP" statement.
+// https://dev.mysql.com/doc/refman/8.0/en/alter-resource-group.html
+type AlterResourceGroup struct {
+	Registry *sql.ResourceGroupRegistry
+	Name     string
+	Changes  sql.ResourceGroupChanges
+}
+
+var _ sql.Node = (*AlterResourceGroup)(nil)
+var _ sql.CollationCoercible = (*AlterResourceGroup)(nil)
+
+func NewAlterResourceGroup(name string, changes sql.ResourceGroupChanges) *AlterResourceGroup {
+	return &AlterResourceGroup{Name: name, Changes: changes}
+}
+
+func (a *AlterResourceGroup) Resolved() bool {
+	return true
+}
+
+func (a *AlterResourceGroup) IsReadOnly() bool {
+	return false
+}
+
+func (a *AlterResourceGroup) String() string {
+	var sb strings.Builder
+	sb.WriteString("ALTER RESOURCE GROUP " + a.Name)
+	if a.Changes.VCPUs != nil {
+		sb.WriteString(" VCPU = " + sql.ResourceGroupVCPUsString(a.Changes.VCPUs))
+	}
+	if a.Changes.ThreadPriority != nil {
+		sb.WriteString(fmt.Sprintf(" THREAD_PRIORITY = %d", *a.Changes.ThreadPriority))
+	}
+	if a.Changes.Enabled != nil {
+		if *a.Changes.Enabled {
+			sb.WriteString(" ENABLE")
+		} else {
+			sb.WriteString(" DISABLE")
+		}
+	}
+	if a.Changes.Force {
+		sb.WriteString(" FORCE")
+	}
+	return sb.String()
+}
+
+func (a *AlterResourceGroup) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+func (a *AlterResourceGroup) Children() []sql.Node {
+	return nil
+}
+
+func (a *AlterResourceGroup) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 0)
+	}
+	return a, nil
+}
+
+func (a *AlterResourceGroup) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(DynamicPrivilege_ResourceGroupAdmin))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*AlterResourceGroup) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// DropResourceGroup is the plan node for the "DROP RESOURCE GROUP" statement.
+// https://dev.mysql.com/doc/refman/8.0/en/drop-resource-group.html
+type DropResourceGroup struct {
+	Registry *sql.ResourceGroupRegistry
+	Name     string
+	Force    bool
+}
+
+var _ sql.Node = (*DropResourceGroup)(nil)
+var _ sql.CollationCoercible = (*DropResourceGroup)(nil)
+
+func NewDropResourceGroup(name string, force bool) *DropResourceGroup {
+	return &DropResourceGroup{Name: name, Force: force}
+}
+
+func (d *DropResourceGroup) Resolved() bool {
+	return true
+}
+
+func (d *DropResourceGroup) IsReadOnly() bool {
+	return false
+}
+
+func (d *DropResourceGroup) String() string {
+	if d.Force {
+		return fmt.Sprintf("DROP RESOURCE GROUP %s FORCE", d.Name)
+	}
+	return fmt.Sprintf("DROP RESOURCE GROUP %s", d.Name)
+}
+
+func (d *DropResourceGroup) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+func (d *DropResourceGroup) Children() []sql.Node {
+	return nil
+}
+
+func (d *DropResourceGroup) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 0)
+	}
+	return d, nil
+}
+
+func (d *DropResourceGroup) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(DynamicPrivilege_ResourceGroupAdmin))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*DropResourceGroup) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// SetResourceGroup is the plan node for the "SET RESOURCE GROUP" statement.
+// https://dev.mysql.com/doc/refman/8.0/en/set-resource-group.html
+type SetResourceGroup struct {
+	Registry *sql.ResourceGroupRegistry
+	Name     string
+	// ThreadIDs are the threads to assign to the group, or empty for the thread of the current session.
+	ThreadIDs []uint32
+}
+
+var _ sql.Node = (*SetResourceGroup)(nil)
+var _ sql.CollationCoercible = (*SetResourceGroup)(nil)
+
+func NewSetResourceGroup(name string, threadIDs []uint32) *SetResourceGroup {
+	return &SetResourceGroup{Name: name, ThreadIDs: threadIDs}
+}
+
+func (s *SetResourceGroup) Resolved() bool {
+	return true
+}
+
+func (s *SetResourceGroup) IsReadOnly() bool {
+	return true
+}
+
+func (s *SetResourceGroup) String() string {
+	if len(s.ThreadIDs) == 0 {
+		return fmt.Sprintf("SET RESOURCE GROUP %s", s.Name)
+	}
+	ids := make([]string, len(s.ThreadIDs))
+	for i, id := range s.ThreadIDs {
+		ids[i] = fmt.Sprintf("%d", id)
+	}
+	return fmt.Sprintf("SET RESOURCE GROUP %s FOR %s", s.Name, strings.Join(ids, ", "))
+}
+
+func (s *SetResourceGroup) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+func (s *SetResourceGroup) Children() []sql.Node {
+	return nil
+}
+
+func (s *SetResourceGroup) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+	return s, nil
+}
+
+func (s *SetResourceGroup) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(DynamicPrivilege_ResourceGroupAdmin)) ||
+		opChecker.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(DynamicPrivilege_ResourceGroupUser))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*SetResourceGroup) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
@@ -0,0 +1,183 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ShowBinaryLogs is the plan node for the "SHOW BINARY LOGS" statement.
+// https://dev.mysql.com/doc/refman/8.0/en/show-binary-logs.html
+type ShowBinaryLogs struct {
+	PrimaryController binlogreplication.BinlogPrimaryController
+}
+
+var _ sql.Node = (*ShowBinaryLogs)(nil)
+var _ sql.CollationCoercible = (*ShowBinaryLogs)(nil)
+var _ BinlogPrimaryControllerCommand = (*ShowBinaryLogs)(nil)
+
+func NewShowBinaryLogs() *ShowBinaryLogs {
+	return &ShowBinaryLogs{}
+}
+
+// WithBinlogPrimaryController implements the BinlogPrimaryControllerCommand interface.
+func (s *ShowBinaryLogs) WithBinlogPrimaryController(controller binlogreplication.BinlogPrimaryController) sql.Node {
+	nc := *s
+	nc.PrimaryController = controller
+	return &nc
+}
+
+func (s *ShowBinaryLogs) Resolved() bool {
+	return true
+}
+
+func (s *ShowBinaryLogs) String() string {
+	return "SHOW BINARY LOGS"
+}
+
+func (s *ShowBinaryLogs) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: "Log_name", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 255), Default: nil, Nullable: false},
+		{Name: "File_size", Type: types.Uint64, Default: nil, Nullable: false},
+		{Name: "Encrypted", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 3), Default: nil, Nullable: false},
+	}
+}
+
+func (s *ShowBinaryLogs) Children() []sql.Node {
+	return nil
+}
+
+func (s *ShowBinaryLogs) IsReadOnly() bool {
+	return true
+}
+
+func (s *ShowBinaryLogs) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+
+	newNode := *s
+	return &newNode, nil
+}
+
+func (s *ShowBinaryLogs) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(sql.PrivilegeCheckSubject{}, sql.PrivilegeType_ReplicationClient))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*ShowBinaryLogs) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// ShowBinlogEvents is the plan node for the "SHOW BINLOG EVENTS" statement.
+// https://dev.mysql.com/doc/refman/8.0/en/show-binlog-events.html
+type ShowBinlogEvents struct {
+	PrimaryController binlogreplication.BinlogPrimaryController
+	// LogName is the binary log file to show events from. If empty, the first binary log file is used.
+	LogName string
+	// Pos is the position of the first event to show.
+	Pos uint64
+	// Offset is the number of events to skip.
+	Offset int64
+	// Limit is the maximum number of events to show, or -1 to show all events.
+	Limit int64
+}
+
+var _ sql.Node = (*ShowBinlogEvents)(nil)
+var _ sql.CollationCoercible = (*ShowBinlogEvents)(nil)
+var _ BinlogPrimaryControllerCommand = (*ShowBinlogEvents)(nil)
+
+func NewShowBinlogEvents(logName string, pos uint64, offset, limit int64) *ShowBinlogEvents {
+	return &ShowBinlogEvents{
+		LogName: logName,
+		Pos:     pos,
+		Offset:  offset,
+		Limit:   limit,
+	}
+}
+
+// WithBinlogPrimaryController implements the BinlogPrimaryControllerCommand interface.
+func (s *ShowBinlogEvents) WithBinlogPrimaryController(controller binlogreplication.BinlogPrimaryController) sql.Node {
+	nc := *s
+	nc.PrimaryController = controller
+	return &nc
+}
+
+func (s *ShowBinlogEvents) Resolved() bool {
+	return true
+}
+
+func (s *ShowBinlogEvents) String() string {
+	sb := strings.Builder{}
+	sb.WriteString("SHOW BINLOG EVENTS")
+	if s.LogName != "" {
+		sb.WriteString(fmt.Sprintf(" IN '%s'", s.LogName))
+	}
+	if s.Pos != 0 {
+		sb.WriteString(fmt.Sprintf(" FROM %d", s.Pos))
+	}
+	if s.Limit >= 0 {
+		if s.Offset != 0 {
+			sb.WriteString(fmt.Sprintf(" LIMIT %d, %d", s.Offset, s.Limit))
+		} else {
+			sb.WriteString(fmt.Sprintf(" LIMIT %d", s.Limit))
+		}
+	}
+	return sb.String()
+}
+
+func (s *ShowBinlogEvents) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: "Log_name", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 255), Default: nil, Nullable: false},
+		{Name: "Pos", Type: types.Uint64, Default: nil, Nullable: false},
+		{Name: "Event_type", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: false},
+		{Name: "Server_id", Type: types.Uint32, Default: nil, Nullable: false},
+		{Name: "End_log_pos", Type: types.Uint64, Default: nil, Nullable: false},
+		{Name: "Info", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 65535), Default: nil, Nullable: false},
+	}
+}
+
+func (s *ShowBinlogEvents) Children() []sql.Node {
+	return nil
+}
+
+func (s *ShowBinlogEvents) IsReadOnly() bool {
+	return true
+}
+
+func (s *ShowBinlogEvents) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+
+	newNode := *s
+	return &newNode, nil
+}
+
+func (s *ShowBinlogEvents) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(sql.PrivilegeCheckSubject{}, sql.PrivilegeType_ReplicationSlave))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*ShowBinlogEvents) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
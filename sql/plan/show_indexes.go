@@ -25,6 +25,8 @@ import (
 type ShowIndexes struct {
 	UnaryNode
 	IndexesToShow []sql.Index
+	// Stats provides the statistics used to estimate the cardinality of each index
+	Stats sql.StatsProvider
 }
 
 // NewShowIndexes creates a new ShowIndexes node. The node must represent a table.
@@ -46,6 +48,7 @@ func (n *ShowIndexes) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return &ShowIndexes{
 		UnaryNode:     UnaryNode{children[0]},
 		IndexesToShow: n.IndexesToShow,
+		Stats:         n.Stats,
 	}, nil
 }
 
@@ -78,7 +81,7 @@ func (n *ShowIndexes) Schema() sql.Schema {
 		&sql.Column{Name: "Seq_in_index", Type: types.Int32},
 		&sql.Column{Name: "Column_name", Type: types.LongText, Nullable: true},
 		&sql.Column{Name: "Collation", Type: types.LongText, Nullable: true},
-		&sql.Column{Name: "Cardinality", Type: types.Int64},
+		&sql.Column{Name: "Cardinality", Type: types.Int64, Nullable: true},
 		&sql.Column{Name: "Sub_part", Type: types.Int64, Nullable: true},
 		&sql.Column{Name: "Packed", Type: types.LongText, Nullable: true},
 		&sql.Column{Name: "Null", Type: types.LongText},
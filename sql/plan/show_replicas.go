@@ -0,0 +1,88 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+)
+
+// ShowReplicas is the plan node for the "SHOW REPLICAS" statement.
+// https://dev.mysql.com/doc/refman/8.0/en/show-replicas.html
+type ShowReplicas struct {
+	PrimaryController binlogreplication.BinlogPrimaryController
+}
+
+var _ sql.Node = (*ShowReplicas)(nil)
+var _ sql.CollationCoercible = (*ShowReplicas)(nil)
+var _ BinlogPrimaryControllerCommand = (*ShowReplicas)(nil)
+
+func NewShowReplicas() *ShowReplicas {
+	return &ShowReplicas{}
+}
+
+// WithBinlogPrimaryController implements the BinlogPrimaryControllerCommand interface.
+func (s *ShowReplicas) WithBinlogPrimaryController(controller binlogreplication.BinlogPrimaryController) sql.Node {
+	nc := *s
+	nc.PrimaryController = controller
+	return &nc
+}
+
+func (s *ShowReplicas) Resolved() bool {
+	return true
+}
+
+func (s *ShowReplicas) String() string {
+	return "SHOW REPLICAS"
+}
+
+func (s *ShowReplicas) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: "Server_Id", Type: types.Uint32, Default: nil, Nullable: false},
+		{Name: "Host", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 255), Default: nil, Nullable: false},
+		{Name: "Port", Type: types.Uint16, Default: nil, Nullable: false},
+		{Name: "Source_Id", Type: types.Uint32, Default: nil, Nullable: false},
+		{Name: "Replica_UUID", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: false},
+	}
+}
+
+func (s *ShowReplicas) Children() []sql.Node {
+	return nil
+}
+
+func (s *ShowReplicas) IsReadOnly() bool {
+	return true
+}
+
+func (s *ShowReplicas) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+
+	newNode := *s
+	return &newNode, nil
+}
+
+func (s *ShowReplicas) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(sql.PrivilegeCheckSubject{}, sql.PrivilegeType_ReplicationSlave))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*ShowReplicas) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
@@ -480,6 +480,14 @@ func putAllRows(cache sql.KeyValueCache, vals []interface{}) error {
 		if err != nil {
 			return err
 		}
+		// Tuples with a NULL element are also stored under the NULL key, so InSubquery can tell whether any value
+		// might compare as NULL without scanning the results
+		if tupleHasNull(val) {
+			err = cache.Put(nilKey, val)
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
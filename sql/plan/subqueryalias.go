@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -33,9 +34,24 @@ type SubqueryAlias struct {
 	Volatile             bool
 	CacheableCTESource   bool
 	IsLateral            bool
-	ScopeMapping         map[sql.ColumnId]sql.Expression
-	id                   sql.TableId
-	cols                 sql.ColSet
+	// Materialized is true when a NO_MERGE optimizer hint names this derived table or view, so its result is
+	// computed on its own rather than merged with the outer query.
+	Materialized bool
+	// CheckOption is the check option of the view defined by this node, either ViewCheckOptionCascaded or
+	// ViewCheckOptionLocal, or empty if the view has none or this node doesn't define a view.
+	CheckOption string
+	// Definer is the account that defined the view defined by this node, and SecurityType is its SQL SECURITY, either
+	// ViewSecurityDefiner or ViewSecurityInvoker. A view that runs as its definer checks the privileges of the definer
+	// on the tables it selects from, rather than those of the user selecting from the view.
+	Definer      string
+	SecurityType string
+	// ViewDatabase and ViewName name the view defined by this node, whose own privileges are checked for the user
+	// selecting from it before the view runs as its definer.
+	ViewDatabase string
+	ViewName     string
+	ScopeMapping map[sql.ColumnId]sql.Expression
+	id           sql.TableId
+	cols         sql.ColSet
 }
 
 var _ sql.Node = (*SubqueryAlias)(nil)
@@ -122,9 +138,24 @@ func (sq *SubqueryAlias) WithChildren(children ...sql.Node) (sql.Node, error) {
 
 // CheckPrivileges implements the interface sql.Node.
 func (sq *SubqueryAlias) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	if sq.RunsAsDefiner() {
+		subject := sql.PrivilegeCheckSubject{Database: sq.ViewDatabase, Table: sq.ViewName}
+		if !opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select)) {
+			return false
+		}
+		if definer, ok := sql.ParseDefiner(sq.Definer); ok {
+			defer ctx.RunAs(definer)()
+		}
+	}
 	return sq.Child.CheckPrivileges(ctx, opChecker)
 }
 
+// RunsAsDefiner returns whether this node defines a view that runs with the privileges of its definer, which is the
+// default for views.
+func (sq *SubqueryAlias) RunsAsDefiner() bool {
+	return sq.Definer != "" && !strings.EqualFold(sq.SecurityType, ViewSecurityInvoker)
+}
+
 // CollationCoercibility implements the interface sql.CollationCoercible.
 func (sq *SubqueryAlias) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
 	return sql.GetCoercibility(ctx, sq.Child)
@@ -152,6 +183,12 @@ func (sq *SubqueryAlias) WithVolatile(v bool) *SubqueryAlias {
 	return &ret
 }
 
+func (sq *SubqueryAlias) WithMaterialized(m bool) *SubqueryAlias {
+	ret := *sq
+	ret.Materialized = m
+	return &ret
+}
+
 func (sq *SubqueryAlias) WithScopeMapping(cols map[sql.ColumnId]sql.Expression) *SubqueryAlias {
 	ret := *sq
 	ret.ScopeMapping = cols
@@ -166,12 +203,15 @@ func (sq *SubqueryAlias) Opaque() bool {
 func (sq *SubqueryAlias) String() string {
 	pr := sql.NewTreePrinter()
 	_ = pr.WriteNode("SubqueryAlias")
-	children := make([]string, 5)
-	children[0] = fmt.Sprintf("name: %s", sq.name)
-	children[1] = fmt.Sprintf("outerVisibility: %t", sq.OuterScopeVisibility)
-	children[2] = fmt.Sprintf("isLateral: %t", sq.IsLateral)
-	children[3] = fmt.Sprintf("cacheable: %t", sq.CanCacheResults())
-	children[4] = sq.Child.String()
+	children := make([]string, 0, 6)
+	children = append(children, fmt.Sprintf("name: %s", sq.name))
+	children = append(children, fmt.Sprintf("outerVisibility: %t", sq.OuterScopeVisibility))
+	children = append(children, fmt.Sprintf("isLateral: %t", sq.IsLateral))
+	children = append(children, fmt.Sprintf("cacheable: %t", sq.CanCacheResults()))
+	if sq.Materialized {
+		children = append(children, "materialized: true")
+	}
+	children = append(children, sq.Child.String())
 	_ = pr.WriteChildren(children...)
 	return pr.String()
 }
@@ -179,14 +219,17 @@ func (sq *SubqueryAlias) String() string {
 func (sq *SubqueryAlias) DebugString() string {
 	pr := sql.NewTreePrinter()
 	_ = pr.WriteNode("SubqueryAlias")
-	children := make([]string, 7)
-	children[0] = fmt.Sprintf("name: %s", sq.name)
-	children[1] = fmt.Sprintf("outerVisibility: %t", sq.OuterScopeVisibility)
-	children[2] = fmt.Sprintf("isLateral: %t", sq.IsLateral)
-	children[3] = fmt.Sprintf("cacheable: %t", sq.CanCacheResults())
-	children[4] = fmt.Sprintf("colSet: %s", sq.Columns())
-	children[5] = fmt.Sprintf("tableId: %d", sq.Id())
-	children[6] = sql.DebugString(sq.Child)
+	children := make([]string, 0, 8)
+	children = append(children, fmt.Sprintf("name: %s", sq.name))
+	children = append(children, fmt.Sprintf("outerVisibility: %t", sq.OuterScopeVisibility))
+	children = append(children, fmt.Sprintf("isLateral: %t", sq.IsLateral))
+	children = append(children, fmt.Sprintf("cacheable: %t", sq.CanCacheResults()))
+	if sq.Materialized {
+		children = append(children, "materialized: true")
+	}
+	children = append(children, fmt.Sprintf("colSet: %s", sq.Columns()))
+	children = append(children, fmt.Sprintf("tableId: %d", sq.Id()))
+	children = append(children, sql.DebugString(sq.Child))
 	_ = pr.WriteChildren(children...)
 	return pr.String()
 }
@@ -38,8 +38,24 @@ func init() {
 // the transaction.
 type TransactionCommittingNode struct {
 	UnaryNode
+	// DDLMode describes how the wrapped statement, if it is a DDL statement, interacts with the current transaction
+	DDLMode DDLTransactionMode
 }
 
+// DDLTransactionMode describes how a DDL statement interacts with the current transaction.
+type DDLTransactionMode byte
+
+const (
+	// DDLTransactionMode_None is used for statements that are not DDL
+	DDLTransactionMode_None DDLTransactionMode = iota
+	// DDLTransactionMode_ImplicitCommit is used for DDL statements that implicitly commit the current transaction
+	// before they execute, and are committed as soon as they complete, as in MySQL
+	DDLTransactionMode_ImplicitCommit
+	// DDLTransactionMode_Transactional is used for DDL statements on databases provided by a
+	// sql.TransactionalDDLProvider, which participate in the current transaction like any other write
+	DDLTransactionMode_Transactional
+)
+
 var _ sql.Node = (*TransactionCommittingNode)(nil)
 var _ sql.CollationCoercible = (*TransactionCommittingNode)(nil)
 
@@ -152,7 +152,8 @@ func (v ValueDerivedTable) WithColumNames(columns []string) *ValueDerivedTable {
 	return &v
 }
 
-// getSchema returns schema created with most permissive types by examining all rows.
+// getSchema returns a schema whose column types can hold the values of every row, by generalizing the types of each
+// column across all rows.
 func getSchema(rows [][]sql.Expression) sql.Schema {
 	s := make(sql.Schema, len(rows[0]))
 
@@ -168,7 +169,7 @@ func getSchema(rows [][]sql.Expression) sql.Schema {
 
 				s[i] = &sql.Column{Name: name, Type: val.Type(), Nullable: val.IsNullable()}
 			} else {
-				s[i].Type = getMostPermissiveType(s[i], val)
+				s[i].Type = types.GeneralizeTypes(s[i].Type, val.Type())
 				if !s[i].Nullable {
 					s[i].Nullable = val.IsNullable()
 				}
@@ -179,53 +180,3 @@ func getSchema(rows [][]sql.Expression) sql.Schema {
 
 	return s
 }
-
-// getMostPermissiveType returns the most permissive type given the current type and the expression type.
-// The ordering is "other types < uint < int < decimal (float should be interpreted as decimal) < string"
-func getMostPermissiveType(s *sql.Column, e sql.Expression) sql.Type {
-	if types.IsText(s.Type) {
-		return s.Type
-	} else if types.IsText(e.Type()) {
-		return e.Type()
-	}
-
-	if st, ok := s.Type.(sql.DecimalType); ok {
-		et, eok := e.Type().(sql.DecimalType)
-		if !eok {
-			return s.Type
-		}
-		// if both are decimal types, get the bigger decimaltype
-		frac := st.Scale()
-		whole := st.Precision() - frac
-		if ep := et.Precision() - et.Scale(); ep > whole {
-			whole = ep
-		}
-		if et.Scale() > frac {
-			frac = et.Scale()
-		}
-		return types.MustCreateDecimalType(whole+frac, frac)
-	} else if types.IsDecimal(e.Type()) {
-		return e.Type()
-	}
-
-	// TODO: float type should be interpreted as decimal type
-	if types.IsFloat(s.Type) {
-		return s.Type
-	} else if types.IsFloat(e.Type()) {
-		return types.Float64
-	}
-
-	if types.IsSigned(s.Type) {
-		return s.Type
-	} else if types.IsSigned(e.Type()) {
-		return types.Int64
-	}
-
-	if types.IsUnsigned(s.Type) {
-		return s.Type
-	} else if types.IsUnsigned(e.Type()) {
-		return types.Uint64
-	}
-
-	return s.Type
-}
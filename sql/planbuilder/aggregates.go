@@ -16,6 +16,7 @@ package planbuilder
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation/window"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -79,6 +81,22 @@ func (g *groupBy) getAggRef(name string) sql.Expression {
 	return ret.scalarGf()
 }
 
+// refsAggregate returns whether |e| references the result of one of the aggregations.
+func (g *groupBy) refsAggregate(e sql.Expression) bool {
+	return transform.InspectExpr(e, func(e sql.Expression) bool {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return false
+		}
+		for _, agg := range g.aggs {
+			if agg.id == columnId(gf.Id()) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
 type aggregateInfo struct {
 	ast.Expr
 }
@@ -111,6 +129,9 @@ func (b *Builder) buildGroupingCols(fromScope, projScope *scope, groupby ast.Gro
 			col, ok = fromScope.resolveColumn(dbName, tblName, colName, true, false)
 			if !ok {
 				col, ok = projScope.resolveColumn(dbName, tblName, colName, true, true)
+				if ok && col.scalar != nil && g.refsAggregate(col.scalar) {
+					b.handleErr(sql.ErrWrongGroupField.New(e.Name.String()))
+				}
 			}
 
 			if !ok {
@@ -140,7 +161,10 @@ func (b *Builder) buildGroupingCols(fromScope, projScope *scope, groupby ast.Gro
 				col = projScope.cols[intIdx-1]
 			}
 		default:
-			expr := b.buildScalar(fromScope, e)
+			expr := b.buildScalarWithAliases(fromScope, projScope, e)
+			if g.refsAggregate(expr) {
+				b.handleErr(sql.ErrWrongGroupField.New(ast.String(e)))
+			}
 			col = scopeColumn{
 				col:      expr.String(),
 				typ:      nil,
@@ -164,7 +188,7 @@ func (b *Builder) buildGroupingCols(fromScope, projScope *scope, groupby ast.Gro
 	return groupings
 }
 
-func (b *Builder) buildAggregation(fromScope, projScope *scope, groupingCols []sql.Expression) *scope {
+func (b *Builder) buildAggregation(fromScope, projScope *scope, groupingCols []sql.Expression, strategy plan.GroupByStrategy) *scope {
 	// GROUP_BY consists of:
 	// - input arguments projection
 	// - grouping cols projection
@@ -225,6 +249,9 @@ func (b *Builder) buildAggregation(fromScope, projScope *scope, groupingCols []s
 		}
 	}
 	gb := plan.NewGroupBy(selectExprs, groupingCols, fromScope.node)
+	if len(groupingCols) > 0 {
+		gb = gb.WithStrategy(strategy)
+	}
 	outScope.node = gb
 
 	if len(aliases) > 0 {
@@ -444,6 +471,9 @@ func (b *Builder) buildGroupConcat(inScope *scope, e *ast.GroupConcatExpr) sql.E
 		b.handleErr(err)
 	}
 	groupConcatMaxLen := gcml.(uint64)
+	if groupConcatMaxLen > math.MaxInt {
+		groupConcatMaxLen = math.MaxInt
+	}
 
 	// todo store ref to aggregate
 	agg := aggregation.NewGroupConcat(e.Distinct, sortFields, separatorS, args, int(groupConcatMaxLen))
@@ -466,7 +496,7 @@ func isWindowFunc(name string) bool {
 	case "first", "last", "count", "sum", "any_value",
 		"avg", "max", "min", "count_distinct", "json_arrayagg",
 		"row_number", "percent_rank", "lead", "lag",
-		"first_value", "last_value",
+		"first_value", "last_value", "nth_value",
 		"rank", "dense_rank":
 		return true
 	default:
@@ -500,15 +530,24 @@ func (b *Builder) buildWindowFunc(inScope *scope, name string, e *ast.FuncExpr,
 		}
 
 		newInst, err := f.NewInstance(args)
+		if err != nil {
+			b.handleErr(err)
+		}
 		var ok bool
 		win, ok = newInst.(sql.WindowAdaptableExpression)
 		if !ok {
 			err := fmt.Errorf("function is not a window adaptable exprssion: %s", f.FunctionName())
 			b.handleErr(err)
 		}
-		if err != nil {
-			b.handleErr(err)
-		}
+	}
+
+	switch w := win.(type) {
+	case *window.Lag:
+		win = w.WithIgnoreNulls(e.IgnoreNulls)
+	case *window.Lead:
+		win = w.WithIgnoreNulls(e.IgnoreNulls)
+	case *window.NthValue:
+		win = w.WithFromLast(e.FromLast).WithIgnoreNulls(e.IgnoreNulls)
 	}
 
 	def := b.buildWindowDef(inScope, over)
@@ -748,6 +787,7 @@ func (b *Builder) analyzeHaving(fromScope, projScope *scope, having *ast.Where)
 	ast.Walk(func(node ast.SQLNode) (bool, error) {
 		switch n := node.(type) {
 		case *ast.Subquery:
+			b.analyzeHavingSubquery(fromScope, n)
 			return false, nil
 		case *ast.FuncExpr:
 			name := n.Name.Lowered()
@@ -781,6 +821,70 @@ func (b *Builder) analyzeHaving(fromScope, projScope *scope, having *ast.Where)
 	}, having.Expr)
 }
 
+// analyzeHavingSubquery registers the outer references of a subquery in a
+// HAVING clause. Columns of |fromScope| are added to the extra columns of the
+// aggregation, and aggregates whose arguments only reference tables of
+// |fromScope| are computed by the outer GROUP BY, so that the subquery can
+// reference their results when it is built.
+func (b *Builder) analyzeHavingSubquery(fromScope *scope, sq *ast.Subquery) {
+	innerTables := make(map[string]bool)
+	ast.Walk(func(node ast.SQLNode) (bool, error) {
+		if t, ok := node.(*ast.AliasedTableExpr); ok {
+			if !t.As.IsEmpty() {
+				innerTables[strings.ToLower(t.As.String())] = true
+			} else if tn, ok := t.Expr.(ast.TableName); ok {
+				innerTables[strings.ToLower(tn.Name.String())] = true
+			}
+		}
+		return true, nil
+	}, sq.Select)
+
+	// resolveOuter returns the |fromScope| column that |n| references, if
+	// it is qualified by a table that the subquery does not mask.
+	// Unqualified names could belong to the subquery's own tables.
+	resolveOuter := func(n *ast.ColName) (scopeColumn, bool) {
+		tblName := strings.ToLower(n.Qualifier.Name.String())
+		if tblName == "" || innerTables[tblName] {
+			return scopeColumn{}, false
+		}
+		dbName := strings.ToLower(n.Qualifier.Qualifier.String())
+		colName := strings.ToLower(n.Name.String())
+		return fromScope.resolveColumn(dbName, tblName, colName, false, true)
+	}
+
+	ast.Walk(func(node ast.SQLNode) (bool, error) {
+		switch n := node.(type) {
+		case *ast.FuncExpr:
+			name := n.Name.Lowered()
+			if !isAggregateFunc(name) || n.Over != nil {
+				break
+			}
+			outer, hasCols := true, false
+			ast.Walk(func(node ast.SQLNode) (bool, error) {
+				switch n := node.(type) {
+				case *ast.ColName:
+					_, ok := resolveOuter(n)
+					outer, hasCols = outer && ok, true
+				case *ast.StarExpr, *ast.Subquery:
+					outer = false
+				}
+				return outer, nil
+			}, n.Exprs)
+			if outer && hasCols {
+				_ = b.buildAggregateFunc(fromScope, name, n)
+				return false, nil
+			}
+		case *ast.ColName:
+			c, ok := resolveOuter(n)
+			if ok {
+				c.scalar = expression.NewGetFieldWithTable(int(c.id), 0, c.typ, c.db, c.table, c.col, c.nullable)
+				fromScope.addExtraColumn(c)
+			}
+		}
+		return true, nil
+	}, sq.Select)
+}
+
 func (b *Builder) buildInnerProj(fromScope, projScope *scope) *scope {
 	outScope := fromScope
 	var proj []sql.Expression
@@ -16,6 +16,7 @@ package planbuilder
 
 import (
 	"fmt"
+	"strings"
 
 	ast "github.com/dolthub/vitess/go/vt/sqlparser"
 
@@ -39,28 +40,163 @@ func (b *Builder) buildChangeReplicationSource(inScope *scope, n *ast.ChangeRepl
 	return outScope
 }
 
+func (b *Builder) buildStartReplica(inScope *scope, untilOptions []*ast.ReplicationOption) (outScope *scope) {
+	outScope = inScope.push()
+	var convertedOptions []binlogreplication.ReplicationOption
+	for _, option := range untilOptions {
+		convertedOption := b.buildReplicationOption(inScope, option)
+		convertedOptions = append(convertedOptions, *convertedOption)
+	}
+	startRep := plan.NewStartReplica(convertedOptions)
+	if binCat, ok := b.cat.(binlogreplication.BinlogReplicaCatalog); ok && binCat.IsBinlogReplicaCatalog() {
+		startRep.ReplicaController = binCat.GetBinlogReplicaController()
+	}
+	outScope.node = startRep
+	return outScope
+}
+
+// replicationOptionKind is the type of value that a replication option takes.
+type replicationOptionKind byte
+
+const (
+	replicationOptionString replicationOptionKind = iota
+	replicationOptionInteger
+	replicationOptionDecimal
+	replicationOptionBoolean
+	replicationOptionTableNames
+)
+
+func (k replicationOptionKind) String() string {
+	switch k {
+	case replicationOptionString:
+		return "a string"
+	case replicationOptionInteger:
+		return "an integer"
+	case replicationOptionDecimal:
+		return "a numeric"
+	case replicationOptionBoolean:
+		return "a 0 or 1"
+	default:
+		return "a table name list"
+	}
+}
+
+// maxReplicaHeartbeatPeriod is the largest number of seconds that SOURCE_HEARTBEAT_PERIOD accepts.
+const maxReplicaHeartbeatPeriod = 4294967
+
+// replicationOptionKinds holds the type of value for each option of CHANGE REPLICATION SOURCE TO, CHANGE REPLICATION
+// FILTER and START REPLICA UNTIL.
+// https://dev.mysql.com/doc/refman/8.0/en/change-replication-source-to.html
+var replicationOptionKinds = map[string]replicationOptionKind{
+	"SOURCE_BIND":                     replicationOptionString,
+	"SOURCE_HOST":                     replicationOptionString,
+	"SOURCE_USER":                     replicationOptionString,
+	"SOURCE_PASSWORD":                 replicationOptionString,
+	"SOURCE_PORT":                     replicationOptionInteger,
+	"SOURCE_CONNECT_RETRY":            replicationOptionInteger,
+	"SOURCE_RETRY_COUNT":              replicationOptionInteger,
+	"SOURCE_DELAY":                    replicationOptionInteger,
+	"SOURCE_HEARTBEAT_PERIOD":         replicationOptionDecimal,
+	"SOURCE_LOG_FILE":                 replicationOptionString,
+	"SOURCE_LOG_POS":                  replicationOptionInteger,
+	"SOURCE_AUTO_POSITION":            replicationOptionBoolean,
+	"RELAY_LOG_FILE":                  replicationOptionString,
+	"RELAY_LOG_POS":                   replicationOptionInteger,
+	"SOURCE_COMPRESSION_ALGORITHMS":   replicationOptionString,
+	"SOURCE_ZSTD_COMPRESSION_LEVEL":   replicationOptionInteger,
+	"SOURCE_SSL":                      replicationOptionBoolean,
+	"SOURCE_SSL_CA":                   replicationOptionString,
+	"SOURCE_SSL_CAPATH":               replicationOptionString,
+	"SOURCE_SSL_CERT":                 replicationOptionString,
+	"SOURCE_SSL_CRL":                  replicationOptionString,
+	"SOURCE_SSL_CRLPATH":              replicationOptionString,
+	"SOURCE_SSL_KEY":                  replicationOptionString,
+	"SOURCE_SSL_CIPHER":               replicationOptionString,
+	"SOURCE_SSL_VERIFY_SERVER_CERT":   replicationOptionBoolean,
+	"SOURCE_TLS_VERSION":              replicationOptionString,
+	"SOURCE_TLS_CIPHERSUITES":         replicationOptionString,
+	"SOURCE_PUBLIC_KEY_PATH":          replicationOptionString,
+	"GET_SOURCE_PUBLIC_KEY":           replicationOptionBoolean,
+	"SOURCE_CONNECTION_AUTO_FAILOVER": replicationOptionBoolean,
+	"NETWORK_NAMESPACE":               replicationOptionString,
+	"MASTER_LOG_FILE":                 replicationOptionString,
+	"MASTER_LOG_POS":                  replicationOptionInteger,
+	"SQL_BEFORE_GTIDS":                replicationOptionString,
+	"SQL_AFTER_GTIDS":                 replicationOptionString,
+	"REPLICATE_DO_TABLE":              replicationOptionTableNames,
+	"REPLICATE_IGNORE_TABLE":          replicationOptionTableNames,
+}
+
+// buildReplicationOption converts |option| into a typed replication option, and returns an error if its value isn't
+// of the type that the option takes.
 func (b *Builder) buildReplicationOption(inScope *scope, option *ast.ReplicationOption) *binlogreplication.ReplicationOption {
 	if option.Value == nil {
 		err := fmt.Errorf("nil replication option specified for option %q", option.Name)
 		b.handleErr(err)
 	}
+	name := strings.ToUpper(option.Name)
+	kind, ok := replicationOptionKinds[name]
+	if !ok {
+		err := fmt.Errorf("unsupported replication option %q", option.Name)
+		b.handleErr(err)
+	}
+
+	var value binlogreplication.ReplicationOptionValue
 	switch vv := option.Value.(type) {
 	case string:
-		return binlogreplication.NewReplicationOption(option.Name, binlogreplication.StringReplicationOptionValue{Value: vv})
+		if kind == replicationOptionString {
+			value = binlogreplication.StringReplicationOptionValue{Value: vv}
+		}
 	case int:
-		return binlogreplication.NewReplicationOption(option.Name, binlogreplication.IntegerReplicationOptionValue{Value: vv})
+		switch kind {
+		case replicationOptionInteger:
+			value = binlogreplication.IntegerReplicationOptionValue{Value: vv}
+		case replicationOptionDecimal:
+			value = binlogreplication.DecimalReplicationOptionValue{Value: float64(vv)}
+		case replicationOptionBoolean:
+			if vv == 0 || vv == 1 {
+				value = binlogreplication.BooleanReplicationOptionValue{Value: vv == 1}
+			}
+		}
+	case float64:
+		if kind == replicationOptionDecimal {
+			value = binlogreplication.DecimalReplicationOptionValue{Value: vv}
+		}
 	case ast.TableNames:
-		urts := make([]sql.UnresolvedTable, len(vv))
-		for i, tableName := range vv {
-			// downstream logic expects these to specifically be unresolved tables
-			urts[i] = plan.NewUnresolvedTable(tableName.Name.String(), tableName.Qualifier.String())
+		if kind == replicationOptionTableNames {
+			urts := make([]sql.UnresolvedTable, len(vv))
+			for i, tableName := range vv {
+				// downstream logic expects these to specifically be unresolved tables
+				urts[i] = plan.NewUnresolvedTable(tableName.Name.String(), tableName.Qualifier.String())
+			}
+			value = binlogreplication.TableNamesReplicationOptionValue{Value: urts}
 		}
-		return binlogreplication.NewReplicationOption(option.Name, binlogreplication.TableNamesReplicationOptionValue{Value: urts})
 	default:
 		err := fmt.Errorf("unsupported option value type '%T' specified for option %q", option.Value, option.Name)
 		b.handleErr(err)
 	}
-	return nil
+	if value == nil {
+		b.handleErr(sql.ErrInvalidReplicationOptionValue.New(name, kind, replicationOptionValueString(option.Value)))
+	}
+
+	if name == "SOURCE_HEARTBEAT_PERIOD" {
+		if period := value.(binlogreplication.DecimalReplicationOptionValue).Value; period < 0 || period > maxReplicaHeartbeatPeriod {
+			b.handleErr(sql.ErrReplicaHeartbeatOutOfRange.New(maxReplicaHeartbeatPeriod))
+		}
+	}
+	return binlogreplication.NewReplicationOption(name, value)
+}
+
+// replicationOptionValueString returns |v| as it's written in a statement, for error messages.
+func replicationOptionValueString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return "'" + v + "'"
+	case ast.TableNames:
+		return "(" + ast.String(v) + ")"
+	default:
+		return fmt.Sprint(v)
+	}
 }
 
 func (b *Builder) buildChangeReplicationFilter(inScope *scope, n *ast.ChangeReplicationFilter) (outScope *scope) {
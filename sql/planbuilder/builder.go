@@ -17,6 +17,7 @@ package planbuilder
 import (
 	"strings"
 	"sync"
+	"time"
 
 	querypb "github.com/dolthub/vitess/go/vt/proto/query"
 	ast "github.com/dolthub/vitess/go/vt/sqlparser"
@@ -47,6 +48,15 @@ type Builder struct {
 	bindCtx         *BindvarContext
 	insertActive    bool
 	nesting         int
+	// maxExecutionTime is the timeout requested by a MAX_EXECUTION_TIME hint
+	maxExecutionTime time.Duration
+	// setVarHints are the system variable overrides requested by SET_VAR hints
+	setVarHints []SetVarHint
+	// resourceGroupHint is the resource group named by a RESOURCE_GROUP hint
+	resourceGroupHint string
+	// setUserVarTypes are the types of the user variables assigned by the SET statement being built, which the later
+	// assignments of the statement refer to the variables with, rather than the types of their current values
+	setUserVarTypes map[string]sql.Type
 }
 
 // BindvarContext holds bind variable replacement literals.
@@ -168,6 +178,10 @@ func (b *Builder) Reset() {
 	b.triggerCtx = nil
 	b.viewCtx = nil
 	b.nesting = 0
+	b.maxExecutionTime = 0
+	b.setVarHints = nil
+	b.resourceGroupHint = ""
+	b.setUserVarTypes = nil
 }
 
 type parseErr struct {
@@ -180,12 +194,20 @@ func (b *Builder) handleErr(err error) {
 
 func (b *Builder) build(inScope *scope, stmt ast.Statement, query string) (outScope *scope) {
 	if inScope == nil {
+		// SET_VAR and RESOURCE_GROUP hints are only honored on the top-level statement
+		if b.nesting <= 1 {
+			b.buildSetVarHints(stmt)
+			b.buildResourceGroupHint(stmt)
+		}
 		inScope = b.newScope()
 	}
 	switch n := stmt.(type) {
 	default:
 		b.handleErr(sql.ErrUnsupportedSyntax.New(ast.String(n)))
 	case ast.SelectStatement:
+		if sel, ok := n.(*ast.Select); ok && b.nesting <= 1 {
+			b.buildMaxExecutionTimeHint(sel.Comments)
+		}
 		outScope = b.buildSelectStmt(inScope, n)
 		if into := n.GetInto(); into != nil {
 			b.buildInto(outScope, into)
@@ -233,6 +255,12 @@ func (b *Builder) build(inScope *scope, stmt ast.Statement, query string) (outSc
 		return b.buildLoad(inScope, n)
 	case *ast.Set:
 		return b.buildSet(inScope, n)
+	case *ast.ResetPersist:
+		return b.buildResetPersist(inScope, n)
+	case *ast.ResourceGroup:
+		return b.buildResourceGroupDDL(inScope, n)
+	case *ast.SetResourceGroup:
+		return b.buildSetResourceGroup(inScope, n)
 	case *ast.Use:
 		return b.buildUse(inScope, n)
 	case *ast.Begin:
@@ -263,12 +291,16 @@ func (b *Builder) build(inScope *scope, stmt ast.Statement, query string) (outSc
 	case *ast.ChangeReplicationFilter:
 		return b.buildChangeReplicationFilter(inScope, n)
 	case *ast.StartReplica:
+		return b.buildStartReplica(inScope, n.UntilOptions)
+	case *ast.ResetBinaryLogs:
 		outScope = inScope.push()
-		startRep := plan.NewStartReplica()
-		if binCat, ok := b.cat.(binlogreplication.BinlogReplicaCatalog); ok && binCat.IsBinlogReplicaCatalog() {
-			startRep.ReplicaController = binCat.GetBinlogReplicaController()
+		resetLogs := plan.NewResetBinaryLogs(n.To)
+		if binCat, ok := b.cat.(binlogreplication.BinlogPrimaryCatalog); ok && binCat.IsBinlogPrimaryCatalog() {
+			if controller, ok := binCat.GetBinlogPrimaryController().(binlogreplication.BinlogSourceController); ok {
+				resetLogs.SourceController = controller
+			}
 		}
-		outScope.node = startRep
+		outScope.node = resetLogs
 	case *ast.StopReplica:
 		outScope = inScope.push()
 		stopRep := plan.NewStopReplica()
@@ -383,6 +415,12 @@ func (b *Builder) buildVirtualTableScan(db string, tab sql.Table) *plan.VirtualC
 		}
 	}
 
+	// Virtual columns may refer to other virtual columns, which the table doesn't store values for
+	projections, err := transform.InlineVirtualColumns(schema, projections)
+	if err != nil {
+		b.handleErr(err)
+	}
+
 	// Unlike other kinds of nodes, the projection on this table wrapper is invisible to the analyzer, so we need to
 	// get the column indexes correct here, they won't be fixed later like other kinds of expressions.
 	for i, p := range projections {
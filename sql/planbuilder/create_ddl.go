@@ -25,6 +25,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -436,10 +437,69 @@ func (b *Builder) buildAlterEvent(inScope *scope, query string, c *ast.DDL) (out
 	return
 }
 
+// replacedViewDefiner returns the definer given explicitly when the view named was created, or the empty string if
+// the view doesn't exist or was created without one.
+func (b *Builder) replacedViewDefiner(db sql.Database, name string) string {
+	viewDb, ok := db.(sql.ViewDatabase)
+	if !ok {
+		return ""
+	}
+	existing, ok, err := viewDb.GetViewDefinition(b.ctx, name)
+	if err != nil || !ok {
+		return ""
+	}
+	stmt, err := ast.ParseWithOptions(existing.CreateViewStatement, sql.NewSqlModeFromString(existing.SqlMode).ParserOptions())
+	if err != nil {
+		return ""
+	}
+	if ddl, ok := stmt.(*ast.DDL); ok && ddl.ViewSpec != nil {
+		return ddl.ViewSpec.Definer
+	}
+	return ""
+}
+
+// hasOuterJoin returns whether |n| joins tables with an outer join, whose inner side rows can't be written through a
+// view with a check option.
+func hasOuterJoin(n sql.Node) bool {
+	return transform.InspectUp(n, func(n sql.Node) bool {
+		j, ok := n.(*plan.JoinNode)
+		return ok && (j.Op.IsLeftOuter() || j.Op.IsRightOuter() || j.Op.IsFullOuter())
+	})
+}
+
+// viewCheckOption returns the plan's check option for the check option of |spec|, or the empty string if it has none.
+func viewCheckOption(spec *ast.ViewSpec) string {
+	switch spec.CheckOption {
+	case ast.ViewCheckOptionCascaded:
+		return plan.ViewCheckOptionCascaded
+	case ast.ViewCheckOptionLocal:
+		return plan.ViewCheckOptionLocal
+	default:
+		return ""
+	}
+}
+
+// alterViewCreateQuery returns the CREATE VIEW statement that the ALTER VIEW statement |query| is equivalent to, which
+// is the statement with ALTER replaced by CREATE. An altered view is stored with this statement.
+func alterViewCreateQuery(query string) string {
+	tkn := ast.NewStringTokenizer(query)
+	for typ, _ := tkn.Scan(); typ == ast.COMMENT; typ, _ = tkn.Scan() {
+	}
+	// the tokenizer has read one character past the ALTER keyword
+	return "create" + query[tkn.Position-1:]
+}
+
+// buildCreateView builds the CREATE VIEW or ALTER VIEW statement |c|. A view that replaces another, with CREATE OR
+// REPLACE or with ALTER VIEW, keeps the definer of the view it replaces unless the statement gives one.
 func (b *Builder) buildCreateView(inScope *scope, query string, c *ast.DDL) (outScope *scope) {
 	outScope = inScope.push()
 
 	selectStr := query[c.SubStatementPositionStart:c.SubStatementPositionEnd]
+	alter := c.Action == ast.AlterStr
+	if alter {
+		query = alterViewCreateQuery(query)
+	}
+	checkOpt := viewCheckOption(c.ViewSpec)
 	stmt, _, err := ast.ParseOneWithOptions(selectStr, b.parserOpts)
 	if err != nil {
 		b.handleErr(err)
@@ -452,7 +512,7 @@ func (b *Builder) buildCreateView(inScope *scope, query string, c *ast.DDL) (out
 	queryScope := b.buildSelectStmt(inScope, selectStatement)
 
 	queryAlias := plan.NewSubqueryAlias(c.ViewSpec.ViewName.Name.String(), selectStr, queryScope.node)
-	definer := getCurrentUserForDefiner(b.ctx, c.ViewSpec.Definer)
+	queryAlias.CheckOption = checkOpt
 
 	if len(c.ViewSpec.Columns) > 0 {
 		if len(c.ViewSpec.Columns) != len(queryScope.cols) {
@@ -467,6 +527,29 @@ func (b *Builder) buildCreateView(inScope *scope, query string, c *ast.DDL) (out
 		dbName = b.ctx.GetCurrentDatabase()
 	}
 	db := b.resolveDb(dbName)
-	outScope.node = plan.NewCreateView(db, c.ViewSpec.ViewName.Name.String(), queryAlias, c.OrReplace, query, c.ViewSpec.Algorithm, definer, c.ViewSpec.Security)
+
+	// A replaced view keeps its definer, unless the statement gives one. The definer is added to the stored statement,
+	// which is where it's read from.
+	definer := c.ViewSpec.Definer
+	if (c.OrReplace || alter) && definer == "" {
+		if replacedDefiner := b.replacedViewDefiner(db, c.ViewSpec.ViewName.Name.String()); replacedDefiner != "" {
+			if loc := createViewPrefixRegex.FindStringIndex(query); loc != nil {
+				query = query[:loc[1]] + "DEFINER = " + replacedDefiner + " " + query[loc[1]:]
+				definer = replacedDefiner
+			}
+		}
+	}
+	definer = getCurrentUserForDefiner(b.ctx, definer)
+
+	createView := plan.NewCreateView(db, c.ViewSpec.ViewName.Name.String(), queryAlias, c.OrReplace, query, c.ViewSpec.Algorithm, definer, c.ViewSpec.Security)
+	createView.CheckOpt = checkOpt
+	createView.IsUpdatable = plan.GetIsUpdatableFromCreateView(createView)
+	if checkOpt != "" && (!createView.IsUpdatable || hasOuterJoin(queryAlias.Child)) {
+		b.handleErr(sql.ErrViewCheckOptionNonUpdatable.New(fmt.Sprintf("%s.%s", dbName, queryAlias.Name())))
+	}
+	outScope.node = createView
+	if alter {
+		outScope.node = plan.NewAlterView(createView)
+	}
 	return outScope
 }
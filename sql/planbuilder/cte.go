@@ -16,12 +16,15 @@ package planbuilder
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	ast "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 func (b *Builder) buildWith(inScope *scope, with *ast.With) (outScope *scope) {
@@ -153,7 +156,8 @@ func (b *Builder) buildRecursiveCte(inScope *scope, union *ast.SetOp, name strin
 			c.scalar = nil
 			c.table = name
 			toId := cteScope.newColumn(c)
-			scopeMapping[sql.ColumnId(toId)] = c.scalarGf()
+			// filters pushed into the subquery alias read the output columns of the recursive CTE
+			scopeMapping[sql.ColumnId(toId)] = expression.NewGetFieldWithTable(int(toId), int(tableId), recSch[i].Type, "", name, recSch[i].Name, recSch[i].Nullable)
 			cols.Add(sql.ColumnId(toId))
 		}
 		b.renameSource(cteScope, name, columns)
@@ -166,12 +170,36 @@ func (b *Builder) buildRecursiveCte(inScope *scope, union *ast.SetOp, name strin
 	rightInScope.addCte(name, cteScope)
 	rightScope := b.buildSelectStmt(rightInScope, r)
 
+	// rows of the recursive part take the types of the non-recursive part, so that duplicates are detected and the
+	// working table is read with consistent types across iterations
+	rSch := rightScope.node.Schema()
+	if len(rSch) != len(recSch) {
+		b.handleErr(ErrUnionSchemasDifferentLength.New(len(recSch), len(rSch)))
+	}
+	colTypes := make([]sql.Type, len(recSch))
+	for i, c := range recSch {
+		colTypes[i] = c.Type
+		if c.Type == types.Null || reflect.DeepEqual(rSch[i].Type.Promote(), c.Type) {
+			// values of the same type family are already consistent, and a NULL column has no type to coerce to
+			colTypes[i] = rSch[i].Type
+		}
+	}
+	if projections, hasdiff := setOpInputProjections(rightScope.node, colTypes); hasdiff {
+		rightScope.node = plan.NewProject(projections, rightScope.node)
+	}
+
 	// all is not distinct
 	distinct := true
 	switch union.Type {
 	case ast.UnionAllStr, ast.IntersectAllStr, ast.ExceptAllStr:
 		distinct = false
 	}
+	if union.Limit != nil && union.Limit.WithTies {
+		b.handleErr(errWithTiesWithSetOp.New())
+	}
+	if union.Limit != nil && union.Limit.Percent {
+		b.handleErr(errPercentWithSetOp.New())
+	}
 	limit := b.buildLimit(inScope, union.Limit)
 
 	orderByScope := b.analyzeOrderBy(cteScope, leftScope, union.OrderBy)
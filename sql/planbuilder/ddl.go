@@ -27,6 +27,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -61,6 +62,10 @@ func (b *Builder) buildAlterTable(inScope *scope, query string, c *ast.AlterTabl
 		b.multiDDL = false
 	}()
 
+	if isAlterTableRebuild(c) {
+		return b.buildAlterTableRebuild(inScope, c)
+	}
+
 	statements := make([]sql.Node, 0, len(c.Statements))
 	for i := 0; i < len(c.Statements); i++ {
 		scopes := b.buildAlterTableClause(inScope, c.Statements[i])
@@ -136,7 +141,9 @@ func (b *Builder) buildDDL(inScope *scope, query string, c *ast.DDL) (outScope *
 		}
 		return b.buildDropTable(inScope, c)
 	case ast.AlterStr:
-		if c.EventSpec != nil {
+		if c.ViewSpec != nil {
+			return b.buildCreateView(inScope, query, c)
+		} else if c.EventSpec != nil {
 			return b.buildAlterEvent(inScope, query, c)
 		} else if !c.User.IsEmpty() {
 			return b.buildAlterUser(inScope, query, c)
@@ -509,6 +516,11 @@ func (b *Builder) buildAlterTableClause(inScope *scope, ddl *ast.DDL) []*scope {
 			outScopes = append(outScopes, b.buildAlterCollationSpec(tableScope, ddl, rt))
 		}
 
+		if ddl.AlterOptionSpec != nil {
+			// the other clauses of the statement rebuild the table already
+			b.validateAlterTableEngine(tableName, ddl.AlterOptionSpec.Engine)
+		}
+
 		for _, s := range outScopes {
 			if ts, ok := s.node.(sql.SchemaTarget); ok {
 				s.node = b.modifySchemaTarget(s, ts, rt)
@@ -848,6 +860,56 @@ func (b *Builder) gatherIndexColumns(cols []*ast.IndexColumn) []sql.IndexColumn
 	return out
 }
 
+// isAlterTableRebuild returns whether every clause of |c| is an option that doesn't change the table's definition, such
+// as FORCE or ENGINE, so that the statement only rebuilds the table.
+func isAlterTableRebuild(c *ast.AlterTable) bool {
+	for _, ddl := range c.Statements {
+		if ddl.AlterOptionSpec == nil {
+			return false
+		}
+	}
+	return len(c.Statements) > 0
+}
+
+// buildAlterTableRebuild builds ALTER TABLE ... FORCE and ALTER TABLE ... ENGINE, which rebuild the table.
+func (b *Builder) buildAlterTableRebuild(inScope *scope, c *ast.AlterTable) (outScope *scope) {
+	dbName := c.Table.Qualifier.String()
+	tableName := c.Table.Name.String()
+	tableScope, ok := b.buildResolvedTable(inScope, dbName, tableName, nil)
+	if !ok {
+		b.handleErr(sql.ErrTableNotFound.New(tableName))
+	}
+	rt, ok := tableScope.node.(*plan.ResolvedTable)
+	if !ok {
+		b.handleErr(fmt.Errorf("expected resolved table: %s", tableName))
+	}
+
+	algorithm, lock := sql.AlterAlgorithmDefault, sql.AlterLockDefault
+	for _, ddl := range c.Statements {
+		opt := ddl.AlterOptionSpec
+		b.validateAlterTableEngine(tableName, opt.Engine)
+		if opt.Algorithm != "" {
+			algorithm = sql.AlterAlgorithm(strings.ToUpper(opt.Algorithm))
+		}
+		if opt.Lock != "" {
+			lock = sql.AlterLock(strings.ToUpper(opt.Lock))
+		}
+	}
+
+	rebuild := plan.NewRebuildTable(rt.SqlDatabase, rt, algorithm, lock)
+	outScope = inScope.push()
+	outScope.node = rebuild.WithChecks(b.loadChecksFromTable(tableScope, rt.Table))
+	return outScope
+}
+
+// validateAlterTableEngine reports an error for an ENGINE option of ALTER TABLE that names an engine other than InnoDB.
+// Every table reports InnoDB as its engine, so naming any other engine would convert the table, which isn't supported.
+func (b *Builder) validateAlterTableEngine(tableName, engine string) {
+	if engine != "" && !strings.EqualFold(engine, "InnoDB") {
+		b.handleErr(sql.ErrUnsupportedTableEngine.New(tableName, engine))
+	}
+}
+
 func (b *Builder) buildAlterAutoIncrement(inScope *scope, ddl *ast.DDL, table *plan.ResolvedTable) (outScope *scope) {
 	outScope = inScope
 	val, ok := ddl.AutoIncSpec.Value.(*ast.SQLVal)
@@ -1089,31 +1151,27 @@ func (b *Builder) tableSpecToSchema(inScope, outScope *scope, db sql.Database, t
 			tableCollation = cdb.GetCollation(b.ctx)
 		}
 		if len(tableSpec.Options) > 0 {
-			charsetSubmatches := tableCharsetOptionRegex.FindStringSubmatch(tableSpec.Options)
-			collationSubmatches := tableCollationOptionRegex.FindStringSubmatch(tableSpec.Options)
-			commentSubmatches := tableCommentOptionRegex.FindStringSubmatch(tableSpec.Options)
-			if len(charsetSubmatches) == 5 && len(collationSubmatches) == 5 {
+			opts := parseTableOptions(tableSpec.Options)
+			if opts.CharacterSet != "" && opts.Collation != "" {
 				var err error
-				tableCollation, err = sql.ParseCollation(&charsetSubmatches[4], &collationSubmatches[4], false)
+				tableCollation, err = sql.ParseCollation(&opts.CharacterSet, &opts.Collation, false)
 				if err != nil {
 					b.handleErr(err)
 				}
-			} else if len(charsetSubmatches) == 5 {
-				charset, err := sql.ParseCharacterSet(charsetSubmatches[4])
+			} else if opts.CharacterSet != "" {
+				charset, err := sql.ParseCharacterSet(opts.CharacterSet)
 				if err != nil {
 					b.handleErr(err)
 				}
 				tableCollation = charset.DefaultCollation()
-			} else if len(collationSubmatches) == 5 {
+			} else if opts.Collation != "" {
 				var err error
-				tableCollation, err = sql.ParseCollation(nil, &collationSubmatches[4], false)
+				tableCollation, err = sql.ParseCollation(nil, &opts.Collation, false)
 				if err != nil {
 					b.handleErr(err)
 				}
 			}
-			if len(commentSubmatches) == 5 {
-				tableComment = commentSubmatches[4]
-			}
+			tableComment = opts.Comment
 		}
 	}
 
@@ -1177,6 +1235,9 @@ func (b *Builder) tableSpecToSchema(inScope, outScope *scope, db sql.Database, t
 			schema[i].Virtual = virtual
 		}
 	}
+	if _, err := transform.GeneratedColumnOrder(schema); err != nil {
+		b.handleErr(err)
+	}
 
 	for i, onUpdateExpr := range updates {
 		schema[i].OnUpdate = b.convertDefaultExpression(outScope, onUpdateExpr, schema[i].Type, schema[i].Nullable)
@@ -38,6 +38,19 @@ func (b *Builder) buildInsert(inScope *scope, i *ast.Insert) (outScope *scope) {
 	if !ok {
 		b.handleErr(sql.ErrTableNotFound.New(tableName))
 	}
+	// rows inserted into an updatable view are inserted into its base table
+	var view *updatableView
+	if sq, ok := destScope.node.(*plan.SubqueryAlias); ok {
+		viewDb := dbName
+		if viewDb == "" {
+			viewDb = b.ctx.GetCurrentDatabase()
+		}
+		view, ok = newUpdatableView(sq, viewDb, false)
+		if !ok {
+			b.handleErr(sql.ErrNonUpdatableTable.New(tableName, "INSERT"))
+		}
+		destScope, _ = b.buildResolvedTable(inScope, view.db, view.table, nil)
+	}
 	var db sql.Database
 	var rt *plan.ResolvedTable
 	switch n := destScope.node.(type) {
@@ -62,6 +75,20 @@ func (b *Builder) buildInsert(inScope *scope, i *ast.Insert) (outScope *scope) {
 	var columns []string
 	{
 		columns = columnsToStrings(i.Columns)
+		if view != nil {
+			if len(columns) == 0 {
+				columns = view.columns
+			}
+			baseColumns := make([]string, len(columns))
+			for i, col := range columns {
+				baseCol, err := view.baseColumn(col)
+				if err != nil {
+					b.handleErr(err)
+				}
+				baseColumns[i] = baseCol
+			}
+			columns = baseColumns
+		}
 		// If no column names were specified in the query, go ahead and fill
 		// them all in now that the destination is resolved.
 		// TODO: setting the plan field directly is not great
@@ -114,6 +141,9 @@ func (b *Builder) buildInsert(inScope *scope, i *ast.Insert) (outScope *scope) {
 	outScope.node = ins
 	if rt != nil {
 		checks := b.loadChecksFromTable(destScope, rt.Table)
+		if view != nil {
+			checks = append(checks, b.resolveViewChecks(destScope, rt.Name(), view)...)
+		}
 		outScope.node = ins.WithChecks(checks)
 	}
 
@@ -294,11 +324,6 @@ func (b *Builder) assignmentExprsToExpressions(inScope *scope, e ast.AssignmentE
 	if len(tableSch) > 0 {
 		tabId := inScope.tables[strings.ToLower(tableSch[0].Source)]
 		for i, col := range tableSch {
-			if col.Generated != nil {
-				colGf := expression.NewGetFieldWithTable(i+1, int(tabId), col.Type, col.DatabaseSource, col.Source, col.Name, col.Nullable)
-				generated := b.resolveColumnDefaultExpression(inScope, col, col.Generated)
-				updateExprs = append(updateExprs, expression.NewSetField(colGf, assignColumnIndexes(generated, tableSch)))
-			}
 			if col.OnUpdate != nil {
 				// don't add if column is already being updated
 				if !isColumnUpdated(col, updateExprs) {
@@ -308,6 +333,17 @@ func (b *Builder) assignmentExprsToExpressions(inScope *scope, e ast.AssignmentE
 				}
 			}
 		}
+		// generated columns are updated last, in the order of their references to each other
+		generatedOrder, err := transform.GeneratedColumnOrder(tableSch)
+		if err != nil {
+			b.handleErr(err)
+		}
+		for _, i := range generatedOrder {
+			col := tableSch[i]
+			colGf := expression.NewGetFieldWithTable(i+1, int(tabId), col.Type, col.DatabaseSource, col.Source, col.Name, col.Nullable)
+			generated := b.resolveColumnDefaultExpression(inScope, col, col.Generated)
+			updateExprs = append(updateExprs, expression.NewSetField(colGf, assignColumnIndexes(generated, tableSch)))
+		}
 	}
 
 	return updateExprs
@@ -392,6 +428,9 @@ func (b *Builder) buildOnDupLeft(inScope *scope, e ast.Expr) sql.Expression {
 
 func (b *Builder) buildDelete(inScope *scope, d *ast.Delete) (outScope *scope) {
 	outScope = b.buildFrom(inScope, d.TableExprs)
+	if len(d.Targets) == 0 {
+		b.checkDeletable(outScope.node)
+	}
 	b.buildWhere(outScope, d.Where)
 	orderByScope := b.analyzeOrderBy(outScope, outScope, d.OrderBy)
 	b.buildOrderBy(outScope, orderByScope)
@@ -433,6 +472,7 @@ func (b *Builder) buildDelete(inScope *scope, d *ast.Delete) (outScope *scope) {
 				}
 				target = tableScope.node
 			}
+			b.checkDeletable(target)
 			targets[i] = target
 		}
 	}
@@ -443,12 +483,32 @@ func (b *Builder) buildDelete(inScope *scope, d *ast.Delete) (outScope *scope) {
 }
 
 func (b *Builder) buildUpdate(inScope *scope, u *ast.Update) (outScope *scope) {
-	outScope = b.buildFrom(inScope, u.TableExprs)
+	// rows updated through an updatable view are updated in its base table
+	tableExprs, view, viewAlias := b.updatableViewTableExprs(inScope, u.TableExprs)
+	outScope = b.buildFrom(inScope, tableExprs)
+	var viewCond sql.Expression
+	var viewChecks []*sql.CheckConstraint
+	if view != nil {
+		if len(view.conditions) > 0 {
+			viewCond = expression.JoinAnd(b.resolveViewConditions(outScope, viewAlias, view.conditions)...)
+		}
+		viewChecks = b.resolveViewChecks(outScope, viewAlias, view)
+		// the base table columns that the view doesn't select can't be referenced
+		outScope.restrictToColumns(view.columns)
+	}
 
 	// default expressions only resolve to target table
 	updateExprs := b.assignmentExprsToExpressions(outScope, u.Exprs)
 
 	b.buildWhere(outScope, u.Where)
+	if viewCond != nil {
+		// only the rows of the view are updated
+		if where, ok := outScope.node.(*plan.Filter); ok && u.Where != nil {
+			outScope.node = plan.NewFilter(expression.NewAnd(viewCond, where.Expression), where.Child)
+		} else {
+			outScope.node = plan.NewFilter(viewCond, outScope.node)
+		}
+	}
 
 	orderByScope := b.analyzeOrderBy(outScope, b.newScope(), u.OrderBy)
 
@@ -516,6 +576,7 @@ func (b *Builder) buildUpdate(inScope *scope, u *ast.Update) (outScope *scope) {
 			}
 			return true
 		})
+		checks = append(checks, viewChecks...)
 	}
 	outScope.node = update.WithChecks(checks)
 	return
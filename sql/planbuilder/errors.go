@@ -23,19 +23,23 @@ import (
 var (
 	errInvalidDescribeFormat = errors.NewKind("invalid format %q for DESCRIBE, supported formats: %s")
 
+	errInvalidAnalyzeFormat = errors.NewKind("invalid format %q for EXPLAIN ANALYZE, supported formats: tree")
+
 	errInvalidSortOrder = errors.NewKind("invalid sort order: %s")
 
+	errWithTiesWithoutOrderBy = errors.NewKind("WITH TIES requires an ORDER BY clause")
+
+	errWithTiesWithDistinct = errors.NewKind("WITH TIES is not supported with DISTINCT")
+
+	errWithTiesWithSetOp = errors.NewKind("WITH TIES is not supported with UNION, INTERSECT, or EXCEPT")
+
+	errPercentWithSetOp = errors.NewKind("PERCENT is not supported with UNION, INTERSECT, or EXCEPT")
+
 	ErrPrimaryKeyOnNullField = errors.NewKind("All parts of PRIMARY KEY must be NOT NULL")
 
-	// TODO: We parse table options in Vitess, but we return them to GMS as a single string, so GMS has to reparse
-	//       table options using these regexes. It would be cleaner for Vitess to parse them into structures so that
-	//       GMS could just pull out the data it needs, without having to regex it out from a single string. Because
-	//       of how the original quotes are lost and single quotes are always used, we also currently lose some info
-	//       about the original statement – notably, we can't parse comments that contain single quotes because Vitess
-	//       strips the original double quotes and sends the comment string to GMS wrapped in single quotes.
-	tableCharsetOptionRegex   = regexp.MustCompile(`(?i)(DEFAULT)?\s+CHARACTER\s+SET((\s*=?\s*)|\s+)([A-Za-z0-9_]+)`)
-	tableCollationOptionRegex = regexp.MustCompile(`(?i)(DEFAULT)?\s+COLLATE((\s*=?\s*)|\s+)([A-Za-z0-9_]+)`)
-	tableCommentOptionRegex   = regexp.MustCompile(`(?i)\s+COMMENT((\s*=?\s*)|\s+)('([^']+)')`)
+	// createViewPrefixRegex matches the start of a CREATE [OR REPLACE] VIEW statement, up to where its DEFINER clause
+	// would be.
+	createViewPrefixRegex = regexp.MustCompile(`(?i)^\s*CREATE\s+(OR\s+REPLACE\s+)?(ALGORITHM\s*=\s*\w+\s+)?`)
 
 	// ErrUnionSchemasDifferentLength is returned when the two sides of a
 	// UNION do not have the same number of columns in their schemas.
@@ -31,6 +31,15 @@ func (b *Builder) buildExplain(inScope *scope, n *sqlparser.Explain) (outScope *
 		Analyze: n.Analyze,
 	}
 
+	if n.Analyze {
+		// EXPLAIN ANALYZE executes the query, and its output is only available as a tree
+		if format := strings.ToLower(n.ExplainFormat); format != "" && format != sqlparser.TreeStr {
+			b.handleErr(errInvalidAnalyzeFormat.New(n.ExplainFormat))
+		}
+		outScope.node = plan.NewDescribeQuery(describeOptions, childScope.node)
+		return outScope
+	}
+
 	formatFlags := strings.Split(n.ExplainFormat, "_")
 	for _, flag := range formatFlags {
 		switch strings.ToLower(flag) {
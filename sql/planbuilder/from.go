@@ -70,6 +70,15 @@ func (b *Builder) isLateral(te ast.TableExpr) bool {
 	}
 }
 
+func (b *Builder) isOuterJoin(te *ast.JoinTableExpr) bool {
+	switch strings.ToLower(te.Join) {
+	case ast.LeftJoinStr, ast.RightJoinStr, ast.FullOuterJoinStr:
+		return true
+	default:
+		return false
+	}
+}
+
 func (b *Builder) isUsingJoin(te *ast.JoinTableExpr) bool {
 	return te.Condition.Using != nil ||
 		strings.EqualFold(te.Join, ast.NaturalJoinStr) ||
@@ -97,8 +106,8 @@ func (b *Builder) buildJoin(inScope *scope, te *ast.JoinTableExpr) (outScope *sc
 	outScope.appendColumnsFromScope(leftScope)
 	outScope.appendColumnsFromScope(rightScope)
 
-	// cross join
-	if (te.Condition.On == nil || te.Condition.On == ast.BoolVal(true)) && te.Condition.Using == nil {
+	// cross join; outer joins still have to return the unmatched rows of their outer side
+	if (te.Condition.On == nil || te.Condition.On == ast.BoolVal(true)) && te.Condition.Using == nil && !b.isOuterJoin(te) {
 		if rast, ok := te.RightExpr.(*ast.AliasedTableExpr); ok && rast.Lateral {
 			var err error
 			outScope.node, err = b.f.buildJoin(leftScope.node, rightScope.node, plan.JoinTypeLateralCross, expression.NewLiteral(true, types.Boolean))
@@ -322,16 +331,16 @@ func (b *Builder) buildDataSource(inScope *scope, te ast.TableExpr) (outScope *s
 			scopeMapping := make(map[sql.ColumnId]sql.Expression)
 			var colSet sql.ColSet
 			for i, c := range fromScope.cols {
-				col := c.col
+				col, originalCol := c.col, c.originalCol
 				if len(renameCols) > 0 {
-					col = renameCols[i]
+					col, originalCol = strings.ToLower(renameCols[i]), renameCols[i]
 				}
 				toId := outScope.newColumn(scopeColumn{
 					tableId:     tabId,
 					db:          c.db,
 					table:       alias,
 					col:         col,
-					originalCol: c.originalCol,
+					originalCol: originalCol,
 					id:          0,
 					typ:         c.typ,
 					nullable:    c.nullable,
@@ -348,6 +357,9 @@ func (b *Builder) buildDataSource(inScope *scope, te ast.TableExpr) (outScope *s
 			}
 			exprTuples := make([][]sql.Expression, len(e.Rows))
 			for i, vt := range e.Rows {
+				if len(vt) != len(e.Rows[0]) {
+					b.handleErr(sql.ErrValuesRowColumnCountMismatch.New(i + 1))
+				}
 				exprs := make([]sql.Expression, len(vt))
 				exprTuples[i] = exprs
 				for j, e := range vt {
@@ -782,6 +794,15 @@ func (b *Builder) resolveView(name string, database sql.Database, asOf interface
 				b.ViewCtx().DbName = outerDb
 			}()
 			b.parserOpts = sql.NewSqlModeFromString(viewDef.SqlMode).ParserOptions()
+			// The tables of a view that runs as its definer are resolved with the privileges of the definer
+			if stmt, _, err := ast.ParseOneWithOptions(viewDef.CreateViewStatement, b.parserOpts); err == nil {
+				if ddl, ok := stmt.(*ast.DDL); ok && ddl.ViewSpec != nil && ddl.ViewSpec.Definer != "" &&
+					!strings.EqualFold(ddl.ViewSpec.Security, plan.ViewSecurityInvoker) {
+					if definer, ok := sql.ParseDefiner(ddl.ViewSpec.Definer); ok {
+						defer b.ctx.RunAs(definer)()
+					}
+				}
+			}
 			node, _, _, err := b.Parse(viewDef.CreateViewStatement, false)
 			if err != nil {
 				// TODO: Need to account for non-existing functions or
@@ -796,12 +817,19 @@ func (b *Builder) resolveView(name string, database sql.Database, asOf interface
 			if !ok {
 				err = fmt.Errorf("expected create view statement, found: %T", node)
 			}
+			var definition *plan.SubqueryAlias
 			switch n := create.Child.(type) {
 			case *plan.SubqueryAlias:
-				view = n.AsView(viewDef.CreateViewStatement)
+				definition = n
 			default:
-				view = plan.NewSubqueryAlias(name, viewDef.TextDefinition, n).AsView(viewDef.CreateViewStatement)
+				definition = plan.NewSubqueryAlias(name, viewDef.TextDefinition, n)
 			}
+			// The view is selected from with the privileges of its definer, unless it has SQL SECURITY INVOKER
+			definition.Definer = create.Definer
+			definition.SecurityType = create.Security
+			definition.ViewDatabase = database.Name()
+			definition.ViewName = name
+			view = definition.AsView(viewDef.CreateViewStatement)
 
 		}
 	}
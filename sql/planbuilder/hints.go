@@ -0,0 +1,187 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/memo"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+var setVarHintIntRegex = regexp.MustCompile(`^(?i)(-?\d+)([kmg]?)$`)
+
+// SetVarHint is a system variable override requested by a SET_VAR(name=value) optimizer hint. The variable takes the
+// value given for the duration of the statement only.
+type SetVarHint struct {
+	Name  string
+	Value interface{}
+}
+
+// isOptimizerHint returns whether |comment| is an optimizer hint comment, of the form /*+ ... */
+func isOptimizerHint(comment []byte) bool {
+	return strings.HasPrefix(string(comment), "/*+")
+}
+
+// buildMaxExecutionTimeHint records the statement timeout specified by a MAX_EXECUTION_TIME(N) optimizer
+// hint, where N is in milliseconds. Only the first valid hint is honored and a timeout of zero disables the
+// limit, matching MySQL.
+func (b *Builder) buildMaxExecutionTimeHint(comments ast.Comments) {
+	for _, c := range comments {
+		for _, hint := range memo.ParseHints(string(c)) {
+			if hint.Typ != memo.HintTypeMaxExecutionTime {
+				continue
+			}
+			// the hint is only valid if its argument is a 32-bit integer
+			ms, _ := strconv.ParseUint(hint.Args[0], 10, 32)
+			b.maxExecutionTime = time.Duration(ms) * time.Millisecond
+			return
+		}
+	}
+}
+
+// MaxExecutionTime returns the statement timeout requested by a MAX_EXECUTION_TIME optimizer hint on the
+// top-level statement most recently built, or zero if there is none.
+func (b *Builder) MaxExecutionTime() time.Duration {
+	return b.maxExecutionTime
+}
+
+// buildSetVarHints records the system variable overrides requested by SET_VAR optimizer hints on |stmt|, in the
+// order they are given.
+func (b *Builder) buildSetVarHints(stmt ast.Statement) {
+	for _, c := range statementComments(stmt) {
+		for _, hint := range memo.ParseHints(string(c)) {
+			if hint.Typ != memo.HintTypeSetVar {
+				continue
+			}
+			b.setVarHints = append(b.setVarHints, SetVarHint{
+				Name:  hint.Args[0],
+				Value: setVarHintValue(hint.Args[1]),
+			})
+		}
+	}
+}
+
+// statementComments returns the comments of |stmt|, which hold its optimizer hints, if it's a statement that accepts
+// them.
+func statementComments(stmt ast.Statement) ast.Comments {
+	switch n := stmt.(type) {
+	case *ast.Select:
+		return n.Comments
+	case *ast.Insert:
+		return n.Comments
+	case *ast.Update:
+		return n.Comments
+	case *ast.Delete:
+		return n.Comments
+	default:
+		return nil
+	}
+}
+
+// setVarHintValue converts the text of a SET_VAR hint value to a string or integer. Integers may use a K, M, or G
+// suffix, as in MySQL.
+func setVarHintValue(s string) interface{} {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	match := setVarHintIntRegex.FindStringSubmatch(s)
+	if match == nil {
+		return s
+	}
+	i, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return s
+	}
+	switch strings.ToLower(match[2]) {
+	case "k":
+		i <<= 10
+	case "m":
+		i <<= 20
+	case "g":
+		i <<= 30
+	}
+	return i
+}
+
+// SetVarHints returns the system variable overrides requested by SET_VAR optimizer hints on the top-level statement
+// most recently built.
+func (b *Builder) SetVarHints() []SetVarHint {
+	return b.setVarHints
+}
+
+// buildResourceGroupHint records the resource group named by a RESOURCE_GROUP(name) optimizer hint on |stmt|, which
+// the thread of the session runs in for the statement only. Only the first hint is honored.
+func (b *Builder) buildResourceGroupHint(stmt ast.Statement) {
+	for _, c := range statementComments(stmt) {
+		for _, hint := range memo.ParseHints(string(c)) {
+			if hint.Typ == memo.HintTypeResourceGroup {
+				b.resourceGroupHint = strings.Trim(hint.Args[0], "`")
+				return
+			}
+		}
+	}
+}
+
+// ResourceGroupHint returns the resource group named by a RESOURCE_GROUP optimizer hint on the top-level statement
+// most recently built, or an empty string if there is none.
+func (b *Builder) ResourceGroupHint() string {
+	return b.resourceGroupHint
+}
+
+// buildNoMergeHints marks the derived tables and views in |fromScope| named by NO_MERGE optimizer hints as materialized,
+// which keeps the analyzer from merging them with the outer query. A NO_MERGE hint without table names applies to every
+// derived table and view in the query block. Query block names are not supported, and are ignored.
+func (b *Builder) buildNoMergeHints(fromScope *scope, comments ast.Comments) {
+	var all bool
+	tables := make(map[string]bool)
+	for _, c := range comments {
+		for _, hint := range memo.ParseHints(string(c)) {
+			if hint.Typ != memo.HintTypeNoMerge {
+				continue
+			}
+			if len(hint.Args) == 0 {
+				all = true
+			}
+			for _, arg := range hint.Args {
+				if !strings.HasPrefix(arg, "@") {
+					tables[strings.Trim(arg, "`")] = true
+				}
+			}
+		}
+	}
+	if !all && len(tables) == 0 {
+		return
+	}
+
+	node, _, err := transform.Node(fromScope.node, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		sq, ok := n.(*plan.SubqueryAlias)
+		if !ok || !(all || tables[strings.ToLower(sq.Name())]) {
+			return n, transform.SameTree, nil
+		}
+		return sq.WithMaterialized(true), transform.NewTree, nil
+	})
+	if err != nil {
+		b.handleErr(err)
+	}
+	fromScope.node = node
+}
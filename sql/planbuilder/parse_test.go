@@ -2854,3 +2854,88 @@ func TestPlanBuilderErr(t *testing.T) {
 		})
 	}
 }
+
+func TestReplicationDebugStringRoundTrip(t *testing.T) {
+	tests := []struct {
+		Query    string
+		Expected string
+	}{
+		{
+			Query:    "CHANGE REPLICATION SOURCE TO SOURCE_HOST='local\\'host', SOURCE_USER = 'root', SOURCE_PORT = 3307",
+			Expected: "CHANGE REPLICATION SOURCE TO SOURCE_HOST = 'local\\'host', SOURCE_USER = 'root', SOURCE_PORT = 3307",
+		},
+		{
+			Query:    "change replication source to source_connect_retry = 10, source_heartbeat_period = 0.5",
+			Expected: "CHANGE REPLICATION SOURCE TO SOURCE_CONNECT_RETRY = 10, SOURCE_HEARTBEAT_PERIOD = 0.5",
+		},
+		{
+			Query:    "change replication source to source_auto_position = 1, get_source_public_key = 0",
+			Expected: "CHANGE REPLICATION SOURCE TO SOURCE_AUTO_POSITION = 1, GET_SOURCE_PUBLIC_KEY = 0",
+		},
+		{
+			Query:    "CHANGE REPLICATION FILTER REPLICATE_IGNORE_TABLE=(db01.t1, `db 02`.`t``2`), REPLICATE_DO_TABLE=(t3)",
+			Expected: "CHANGE REPLICATION FILTER REPLICATE_IGNORE_TABLE = (`db01`.`t1`, `db 02`.`t``2`), REPLICATE_DO_TABLE = (`t3`)",
+		},
+		{
+			Query:    "START REPLICA",
+			Expected: "START REPLICA",
+		},
+		{
+			Query:    "STOP REPLICA",
+			Expected: "STOP REPLICA",
+		},
+		{
+			Query:    "RESET REPLICA ALL",
+			Expected: "RESET REPLICA ALL",
+		},
+		{
+			Query:    "RESET MASTER",
+			Expected: "RESET BINARY LOGS AND GTIDS",
+		},
+		{
+			Query:    "reset binary logs and gtids",
+			Expected: "RESET BINARY LOGS AND GTIDS",
+		},
+		{
+			Query:    "RESET MASTER TO 5",
+			Expected: "RESET BINARY LOGS AND GTIDS TO 5",
+		},
+		{
+			Query:    "reset binary logs and gtids to 5",
+			Expected: "RESET BINARY LOGS AND GTIDS TO 5",
+		},
+	}
+
+	db := memory.NewDatabase("mydb")
+	cat := newTestCatalog(db)
+	pro := memory.NewDBProvider(db)
+	sess := memory.NewSession(sql.NewBaseSession(), pro)
+
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+	ctx.SetCurrentDatabase("mydb")
+	b := New(ctx, cat)
+
+	build := func(t *testing.T, query string) sql.Node {
+		stmt, err := sqlparser.Parse(query)
+		require.NoError(t, err)
+		defer b.Reset()
+		node, err := b.BindOnly(stmt, query)
+		require.NoError(t, err)
+		return node
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Query, func(t *testing.T) {
+			node := build(t, tt.Query)
+			require.Equal(t, tt.Expected, sql.DebugString(node))
+			require.Equal(t, tt.Expected, node.String())
+
+			// the debug string can be parsed back into the same plan
+			reparsed := build(t, sql.DebugString(node))
+			require.Equal(t, tt.Expected, sql.DebugString(reparsed))
+		})
+	}
+
+	// the legacy RESET MASTER syntax builds the same plan as RESET BINARY LOGS AND GTIDS
+	require.Equal(t, build(t, "RESET BINARY LOGS AND GTIDS TO 5"), build(t, "RESET MASTER TO 5"))
+}
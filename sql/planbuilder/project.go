@@ -163,6 +163,43 @@ func (b *Builder) analyzeSelectList(inScope, outScope *scope, selectExprs ast.Se
 	inScope.parent = tempScope.parent
 }
 
+// buildScalarWithAliases builds |e| in |fromScope|, resolving column names that aren't found in |fromScope| to the
+// aliases in |projScope|. MySQL resolves names in GROUP BY, HAVING and ORDER BY expressions against the FROM clause
+// first, and then against the select list. References to aliases are replaced with the aliased expressions.
+func (b *Builder) buildScalarWithAliases(fromScope, projScope *scope, e ast.Expr) sql.Expression {
+	if projScope == fromScope {
+		// the clause is over the output of a set operation, where the aliases are the columns
+		return b.buildScalar(fromScope, e)
+	}
+	aliasScope := fromScope.replace()
+	aliases := make(map[columnId]*expression.Alias)
+	for _, c := range projScope.cols {
+		if a, ok := c.scalar.(*expression.Alias); ok && !a.Unreferencable() {
+			aliasScope.addColumn(c)
+			aliases[c.id] = a
+		}
+	}
+	if len(aliases) == 0 {
+		return b.buildScalar(fromScope, e)
+	}
+
+	// aliases are only searched after the FROM columns, so they sit between the FROM scope and its parent
+	fromScope.parent = aliasScope
+	defer func() {
+		fromScope.parent = aliasScope.parent
+	}()
+	expr := b.buildScalar(fromScope, e)
+	expr, _, _ = transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if gf, ok := e.(*expression.GetField); ok {
+			if a, ok := aliases[columnId(gf.Id())]; ok && strings.EqualFold(gf.Name(), a.Name()) {
+				return a.Child, transform.NewTree, nil
+			}
+		}
+		return e, transform.SameTree, nil
+	})
+	return expr
+}
+
 // selectExprToExpression binds dependencies in a scalar expression in a SELECT clause.
 // We differentiate inScope from localScope in cases where we want to differentiate
 // leading aliases in the same SELECT clause from inner-scope columns of the same name.
@@ -0,0 +1,86 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func (b *Builder) buildResourceGroupDDL(inScope *scope, n *ast.ResourceGroup) (outScope *scope) {
+	outScope = inScope.push()
+	registry := b.resourceGroupRegistry()
+	switch n.Action {
+	case ast.CreateStr:
+		groupType := sql.ResourceGroupType_User
+		if n.Type == ast.ResourceGroupTypeSystem {
+			groupType = sql.ResourceGroupType_System
+		}
+		group := sql.ResourceGroup{Name: n.Name, Type: groupType, Enabled: true, VCPUs: resourceGroupVCPUs(n.VCPUs)}
+		if n.ThreadPriority != nil {
+			group.ThreadPriority = *n.ThreadPriority
+		}
+		if n.Enabled != nil {
+			group.Enabled = *n.Enabled
+		}
+		create := plan.NewCreateResourceGroup(group)
+		create.Registry = registry
+		outScope.node = create
+	case ast.AlterStr:
+		alter := plan.NewAlterResourceGroup(n.Name, sql.ResourceGroupChanges{
+			VCPUs:          resourceGroupVCPUs(n.VCPUs),
+			ThreadPriority: n.ThreadPriority,
+			Enabled:        n.Enabled,
+			Force:          n.Force,
+		})
+		alter.Registry = registry
+		outScope.node = alter
+	default:
+		drop := plan.NewDropResourceGroup(n.Name, n.Force)
+		drop.Registry = registry
+		outScope.node = drop
+	}
+	return outScope
+}
+
+// resourceGroupVCPUs returns the CPU ranges of a VCPU clause, or nil if there is none.
+func resourceGroupVCPUs(vcpus []ast.ResourceGroupVCPURange) []sql.ResourceGroupVCPURange {
+	if vcpus == nil {
+		return nil
+	}
+	ranges := make([]sql.ResourceGroupVCPURange, len(vcpus))
+	for i, r := range vcpus {
+		ranges[i] = sql.ResourceGroupVCPURange{Start: r.Start, End: r.End}
+	}
+	return ranges
+}
+
+func (b *Builder) buildSetResourceGroup(inScope *scope, n *ast.SetResourceGroup) (outScope *scope) {
+	outScope = inScope.push()
+	set := plan.NewSetResourceGroup(n.Name, n.ThreadIDs)
+	set.Registry = b.resourceGroupRegistry()
+	outScope.node = set
+	return outScope
+}
+
+// resourceGroupRegistry returns the resource group registry of the catalog, or nil if it doesn't keep resource groups.
+func (b *Builder) resourceGroupRegistry() *sql.ResourceGroupRegistry {
+	if rgCat, ok := b.cat.(sql.ResourceGroupCatalog); ok {
+		return rgCat.GetResourceGroupRegistry()
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	ast "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -149,7 +150,7 @@ func (b *Builder) buildScalar(inScope *scope, e ast.Expr) (ex sql.Expression) {
 		}
 
 		if name == "json_value" {
-			if len(args) == 3 {
+			if len(args) >= 3 && !types.IsNull(args[2]) {
 				args[2] = b.getJsonValueTypeLiteral(args[2])
 			}
 		}
@@ -369,6 +370,8 @@ func (b *Builder) buildScalar(inScope *scope, e ast.Expr) (ex sql.Expression) {
 		return function.NewExtract(unit, expr)
 	case *ast.MatchExpr:
 		return b.buildMatchAgainst(inScope, v)
+	case *ast.JSONValueExpr:
+		return b.buildJsonValue(inScope, v)
 	default:
 		b.handleErr(sql.ErrUnsupportedSyntax.New(ast.String(e)))
 	}
@@ -388,33 +391,99 @@ func (b *Builder) getJsonValueTypeLiteral(e sql.Expression) sql.Expression {
 		err := fmt.Errorf("invalid json_value coercion type: %s; %s", typLit.Value(), err.Error())
 		b.handleErr(err)
 	}
+	typ := b.jsonValueType(&ast.ConvertType{Type: convStr.(string)})
+	return expression.NewLiteral(typ.Zero(), typ)
+}
+
+// buildJsonValue builds a JSON_VALUE with a RETURNING, ON EMPTY or ON ERROR clause.
+func (b *Builder) buildJsonValue(inScope *scope, e *ast.JSONValueExpr) sql.Expression {
 	var typ sql.Type
-	switch strings.ToLower(convStr.(string)) {
-	case "float":
+	if e.Returning != nil {
+		typ = b.jsonValueType(e.Returning)
+	}
+	ret, err := json.NewJsonValueWithClauses(
+		b.buildScalar(inScope, e.JSON),
+		b.buildScalar(inScope, e.Path),
+		typ,
+		b.buildJsonValueHandler(inScope, e.OnEmpty),
+		b.buildJsonValueHandler(inScope, e.OnError),
+	)
+	if err != nil {
+		b.handleErr(err)
+	}
+	return ret
+}
+
+// buildJsonValueHandler builds the ON EMPTY or ON ERROR clause of a JSON_VALUE. An omitted clause returns NULL.
+func (b *Builder) buildJsonValueHandler(inScope *scope, h *ast.JSONValueHandler) json.JsonValueHandler {
+	if h == nil {
+		return json.JsonValueHandler{Action: json.JsonValueNull}
+	}
+	ret := json.JsonValueHandler{Action: h.Action}
+	if h.Default != nil {
+		ret.Default = b.buildScalar(inScope, h.Default)
+	}
+	return ret
+}
+
+// jsonValueType returns the type of the RETURNING clause of a JSON_VALUE.
+func (b *Builder) jsonValueType(ct *ast.ConvertType) sql.Type {
+	var err error
+	length, scale := int64(-1), int64(0)
+	if ct.Length != nil {
+		// TODO move to vitess
+		length, err = strconv.ParseInt(ct.Length.String(), 10, 64)
+		if err != nil {
+			b.handleErr(err)
+		}
+	}
+	if ct.Scale != nil {
+		scale, err = strconv.ParseInt(ct.Scale.String(), 10, 64)
+		if err != nil {
+			b.handleErr(err)
+		}
+	}
+
+	var typ sql.Type
+	switch name := strings.ToLower(ct.Type); {
+	case name == "float" && length < 0:
 		typ = types.Float32
-	case "double", "decimal":
+	case (name == "double" || name == "real") && length < 0:
 		typ = types.Float64
-	case "signed":
+	case name == "decimal":
+		if length < 0 {
+			length = 10
+		}
+		// the value returned has the scale of the RETURNING type, like a column of that type
+		typ, err = types.CreateColumnDecimalType(uint8(length), uint8(scale))
+	case name == "signed" && length < 0:
 		typ = types.Int64
-	case "unsigned":
+	case name == "unsigned" && length < 0:
 		typ = types.Uint64
-	case "char":
+	case name == "char" && length < 0:
 		typ = types.Text
-	case "json":
+	case name == "char":
+		typ, err = types.CreateString(sqltypes.VarChar, length, sql.Collation_Default)
+	case name == "json" && length < 0:
 		typ = types.JSON
-	case "time":
+	case name == "time" && length < 0:
 		typ = types.Time
-	case "datetime":
-		typ = types.Datetime
-	case "date":
+	case name == "datetime":
+		if length < 0 {
+			length = 0
+		}
+		typ, err = types.CreateDatetimeType(sqltypes.Datetime, int(length))
+	case name == "date" && length < 0:
 		typ = types.Date
-	case "year":
+	case name == "year" && length < 0:
 		typ = types.Year
 	default:
-		err := fmt.Errorf("invalid type for json_value: %s", convStr)
+		err = fmt.Errorf("invalid type for json_value: %s", ct.Type)
+	}
+	if err != nil {
 		b.handleErr(err)
 	}
-	return expression.NewLiteral(typ.Zero(), typ)
+	return typ
 }
 
 func (b *Builder) buildUnaryScalar(inScope *scope, e *ast.UnaryExpr) sql.Expression {
@@ -446,7 +515,9 @@ func (b *Builder) buildUnaryScalar(inScope *scope, e *ast.UnaryExpr) sql.Express
 
 			// Due to how vitess orders expressions, COLLATE is a child rather than a parent, so we need to handle it in a special way
 			collation := charSet.DefaultCollation()
+			explicitCollation := false
 			if collateExpr, ok := e.Expr.(*ast.CollateExpr); ok {
+				explicitCollation = true
 				// We extract the expression out of CollateExpr as we're only concerned about the collation string
 				e.Expr = collateExpr.Expr
 				// TODO: rename this from Charset to Collation
@@ -475,14 +546,14 @@ func (b *Builder) buildUnaryScalar(inScope *scope, e *ast.UnaryExpr) sql.Express
 					err := sql.ErrCharSetInvalidString.New(charSet.Name(), strLiteral)
 					b.handleErr(err)
 				}
-				return expression.NewLiteral(encodings.BytesToString(decodedLiteral), types.CreateLongText(collation))
+				return introducedLiteral(expression.NewLiteral(encodings.BytesToString(decodedLiteral), types.CreateLongText(collation)), explicitCollation)
 			} else if byteLiteral, ok := literal.([]byte); ok {
 				decodedLiteral, ok := charSet.Encoder().Decode(byteLiteral)
 				if !ok {
 					err := sql.ErrCharSetInvalidString.New(charSet.Name(), strLiteral)
 					b.handleErr(err)
 				}
-				return expression.NewLiteral(decodedLiteral, types.CreateLongText(collation))
+				return introducedLiteral(expression.NewLiteral(decodedLiteral, types.CreateLongText(collation)), explicitCollation)
 			} else {
 				// Should not be possible
 				err := fmt.Errorf("expression literal returned type `%s` but literal value had type `%T`",
@@ -496,6 +567,15 @@ func (b *Builder) buildUnaryScalar(inScope *scope, e *ast.UnaryExpr) sql.Express
 	return nil
 }
 
+// introducedLiteral returns the string literal built for a character set introducer. A COLLATE clause following the
+// literal makes its collation explicit, as it does for any other expression.
+func introducedLiteral(lit *expression.Literal, explicitCollation bool) sql.Expression {
+	if explicitCollation {
+		return expression.NewCollatedExpression(lit, lit.Type().(sql.TypeWithCollation).Collation())
+	}
+	return lit
+}
+
 func (b *Builder) buildBinaryScalar(inScope *scope, be *ast.BinaryExpr) sql.Expression {
 	expr, err := b.binaryExprToExpression(inScope, be)
 	if err != nil {
@@ -504,6 +584,18 @@ func (b *Builder) buildBinaryScalar(inScope *scope, be *ast.BinaryExpr) sql.Expr
 	return expr
 }
 
+// validateCollationMix reports an error if |left| and |right| are strings whose collations can't be compared.
+func (b *Builder) validateCollationMix(left, right sql.Expression) {
+	if !types.IsText(left.Type()) || !types.IsText(right.Type()) {
+		return
+	}
+	leftCollation, leftCoercibility := sql.GetCoercibility(b.ctx, left)
+	rightCollation, rightCoercibility := sql.GetCoercibility(b.ctx, right)
+	if err := sql.ValidateCollationMix(leftCollation, leftCoercibility, rightCollation, rightCoercibility); err != nil {
+		b.handleErr(err)
+	}
+}
+
 func (b *Builder) buildComparison(inScope *scope, c *ast.ComparisonExpr) sql.Expression {
 	left := b.buildScalar(inScope, c.Left)
 	right := b.buildScalar(inScope, c.Right)
@@ -513,6 +605,11 @@ func (b *Builder) buildComparison(inScope *scope, c *ast.ComparisonExpr) sql.Exp
 		escape = b.buildScalar(inScope, c.Escape)
 	}
 
+	switch strings.ToLower(c.Operator) {
+	case ast.EqualStr, ast.LessThanStr, ast.LessEqualStr, ast.GreaterThanStr, ast.GreaterEqualStr, ast.NullSafeEqualStr, ast.NotEqualStr:
+		b.validateCollationMix(left, right)
+	}
+
 	switch strings.ToLower(c.Operator) {
 	case ast.RegexpStr:
 		return expression.NewRegexp(left, right)
@@ -812,7 +909,7 @@ func (b *Builder) ConvertVal(v *ast.SQLVal) sql.Expression {
 		return expression.NewBindVar(name)
 	case ast.BitVal:
 		if len(v.Val) == 0 {
-			return expression.NewLiteral(0, types.Uint64)
+			return expression.NewLiteral(uint64(0), types.Uint64)
 		}
 
 		res, err := strconv.ParseUint(string(v.Val), 2, 64)
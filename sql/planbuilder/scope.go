@@ -320,10 +320,25 @@ func (s *scope) setColAlias(cols []string) {
 	for i := range s.cols {
 		name := strings.ToLower(cols[i])
 		s.cols[i].col = name
+		s.cols[i].originalCol = cols[i]
 		s.exprs[s.cols[i].String()] = ids[i]
 	}
 }
 
+// restrictToColumns removes the columns that aren't named by |cols| from this scope, so that they can't be referenced.
+func (s *scope) restrictToColumns(cols []string) {
+	var kept []scopeColumn
+	for _, c := range s.cols {
+		for _, col := range cols {
+			if strings.EqualFold(c.col, col) {
+				kept = append(kept, c)
+				break
+			}
+		}
+	}
+	s.cols = kept
+}
+
 // push creates a new scope referencing the current scope as a
 // parent. Variables in the new scope will have name visibility
 // into this scope.
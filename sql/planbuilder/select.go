@@ -42,12 +42,31 @@ func (b *Builder) buildSelectStmt(inScope *scope, s ast.SelectStatement) (outSco
 		return b.buildSetOp(inScope, s)
 	case *ast.ParenSelect:
 		return b.buildSelectStmt(inScope, s.Select)
+	case *ast.ValuesStatement:
+		return b.buildSelectStmt(inScope, valuesStatementAsSelect(s))
 	default:
 		b.handleErr(fmt.Errorf("unknown select statement %T", s))
 	}
 	return
 }
 
+// valuesStatementAsSelect rewrites a VALUES statement used as a query into
+// the equivalent SELECT * over a derived table of its rows. The trailing
+// clauses of the statement move to the SELECT.
+func valuesStatementAsSelect(v *ast.ValuesStatement) *ast.Select {
+	return &ast.Select{
+		With:        v.With,
+		SelectExprs: ast.SelectExprs{&ast.StarExpr{}},
+		From: ast.TableExprs{&ast.AliasedTableExpr{
+			Expr: &ast.ValuesStatement{Rows: v.Rows},
+			As:   ast.NewTableIdent("values"),
+		}},
+		OrderBy: v.OrderBy,
+		Limit:   v.Limit,
+		Lock:    v.Lock,
+	}
+}
+
 func (b *Builder) buildSelect(inScope *scope, s *ast.Select) (outScope *scope) {
 	// General order of binding:
 	// 1) Get definitions in FROM.
@@ -60,6 +79,7 @@ func (b *Builder) buildSelect(inScope *scope, s *ast.Select) (outScope *scope) {
 	//    projections from (4).
 	// 6) Finish with final target projections.
 	fromScope := b.buildFrom(inScope, s.From)
+	b.buildNoMergeHints(fromScope, s.Comments)
 	if cn, ok := fromScope.node.(sql.CommentedNode); ok && len(s.Comments) > 0 {
 		fromScope.node = cn.WithComment(string(s.Comments[0]))
 	}
@@ -87,7 +107,7 @@ func (b *Builder) buildSelect(inScope *scope, s *ast.Select) (outScope *scope) {
 	// so we can build the FROM clause
 	if b.needsAggregation(fromScope, s) {
 		groupingCols := b.buildGroupingCols(fromScope, projScope, s.GroupBy, s.SelectExprs)
-		outScope = b.buildAggregation(fromScope, projScope, groupingCols)
+		outScope = b.buildAggregation(fromScope, projScope, groupingCols, resultSizeStrategy(s.QueryOpts))
 	} else if fromScope.windowFuncs != nil {
 		outScope = b.buildWindow(fromScope, projScope)
 	} else {
@@ -103,29 +123,62 @@ func (b *Builder) buildSelect(inScope *scope, s *ast.Select) (outScope *scope) {
 
 	b.buildOrderBy(outScope, orderByScope)
 
+	// A limit WITH TIES compares rows on the ORDER BY expressions, which the projection may not keep, so its OFFSET
+	// and LIMIT are built before the projection.
+	withTies := s.Limit != nil && s.Limit.WithTies
+	if withTies {
+		if s.QueryOpts.Distinct {
+			b.handleErr(errWithTiesWithDistinct.New())
+		}
+		b.buildOffsetAndLimit(outScope, s)
+	}
+
 	// Last level projection restricts outputs to target projections.
 	b.buildProjection(outScope, projScope)
 	outScope = projScope
 
 	b.buildDistinct(outScope, s.QueryOpts.Distinct)
+	if s.QueryOpts.Distinct && len(s.OrderBy) == 0 && resultSizeStrategy(s.QueryOpts) == plan.GroupByStrategySort {
+		b.buildSortedDistinct(outScope)
+	}
 
 	// OFFSET and LIMIT are last
-	offset := b.buildOffset(outScope, s.Limit)
+	if !withTies {
+		b.buildOffsetAndLimit(outScope, s)
+	}
+
+	return
+}
+
+// buildOffsetAndLimit creates the plan.Offset and plan.Limit nodes for the LIMIT clause of a query, if it has one.
+// A limit WITH TIES must be built directly over the plan.Sort for the query's ORDER BY clause.
+func (b *Builder) buildOffsetAndLimit(inScope *scope, s *ast.Select) {
+	var sortFields sql.SortFields
+	if s.Limit != nil && s.Limit.WithTies {
+		sort, ok := inScope.node.(*plan.Sort)
+		if !ok {
+			b.handleErr(errWithTiesWithoutOrderBy.New())
+		}
+		sortFields = sort.SortFields
+	}
+	offset := b.buildOffset(inScope, s.Limit)
 	if offset != nil {
-		outScope.node = plan.NewOffset(offset, outScope.node)
+		inScope.node = plan.NewOffset(offset, inScope.node)
 	}
-	limit := b.buildLimit(outScope, s.Limit)
+	limit := b.buildLimit(inScope, s.Limit)
 	if limit != nil {
-		l := plan.NewLimit(limit, outScope.node)
+		l := plan.NewLimit(limit, inScope.node)
 		l.CalcFoundRows = s.QueryOpts.SQLCalcFoundRows
-		outScope.node = l
+		l.Percent = s.Limit.Percent
+		l.WithTies = s.Limit.WithTies
+		l.SortFields = sortFields
+		inScope.node = l
 	}
-
-	return
 }
 
 func (b *Builder) buildLimit(inScope *scope, limit *ast.Limit) sql.Expression {
-	if limit != nil {
+	// an OFFSET clause without a FETCH clause has no row count
+	if limit != nil && limit.Rowcount != nil {
 		return b.buildLimitVal(inScope, limit.Rowcount)
 	}
 	return nil
@@ -200,6 +253,35 @@ func (b *Builder) buildDistinct(inScope *scope, distinct bool) {
 	}
 }
 
+// resultSizeStrategy returns the GroupBy strategy requested by a SQL_SMALL_RESULT or SQL_BIG_RESULT select modifier.
+// SQL_SMALL_RESULT prefers grouping rows in a hash table, and SQL_BIG_RESULT prefers sorting rows to group them. If
+// both are given, SQL_BIG_RESULT wins.
+func resultSizeStrategy(opts ast.QueryOpts) plan.GroupByStrategy {
+	switch {
+	case opts.SQLBigResult:
+		return plan.GroupByStrategySort
+	case opts.SQLSmallResult:
+		return plan.GroupByStrategyHash
+	default:
+		return plan.GroupByStrategyDefault
+	}
+}
+
+// buildSortedDistinct replaces the plan.Distinct node built for a query with SQL_BIG_RESULT with a plan.OrderedDistinct
+// over its rows sorted by every column, which doesn't keep the distinct rows in memory. The rows of a query with an
+// ORDER BY clause can't be sorted again, and are left to plan.Distinct.
+func (b *Builder) buildSortedDistinct(inScope *scope) {
+	d, ok := inScope.node.(*plan.Distinct)
+	if !ok {
+		return
+	}
+	sortFields := make(sql.SortFields, len(inScope.cols))
+	for i, c := range inScope.cols {
+		sortFields[i] = sql.SortField{Column: c.scalarGf(), Order: sql.Ascending}
+	}
+	inScope.node = plan.NewOrderedDistinct(plan.NewSort(sortFields, d.Child))
+}
+
 func (b *Builder) currentDb() sql.Database {
 	if b.currentDatabase == nil {
 		if b.ctx.GetCurrentDatabase() == "" {
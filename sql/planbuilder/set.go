@@ -52,6 +52,17 @@ func (b *Builder) buildSet(inScope *scope, n *ast.Set) (outScope *scope) {
 	return outScope
 }
 
+// buildResetPersist builds RESET PERSIST as a Set of the system variable with the ResetPersist scope, which removes
+// it from the persisted variables. The value it's set to is whether IF EXISTS was given.
+func (b *Builder) buildResetPersist(inScope *scope, n *ast.ResetPersist) (outScope *scope) {
+	sysVar := expression.NewSystemVar(strings.ToLower(n.Name), sql.SystemVariableScope_ResetPersist, "")
+	outScope = inScope.push()
+	outScope.node = plan.NewSet([]sql.Expression{
+		expression.NewSetField(sysVar, expression.NewLiteral(n.IfExists, types.Boolean)),
+	})
+	return outScope
+}
+
 func getSetVarExprsFromSetNamesExpr(expr *ast.SetVarExpr) []*ast.SetVarExpr {
 	return []*ast.SetVarExpr{
 		{
@@ -88,6 +99,11 @@ func getSetVarExprsFromSetCharsetExpr(expr *ast.SetVarExpr, csd []byte) []*ast.S
 }
 
 func (b *Builder) setExprsToExpressions(inScope *scope, e ast.SetVarExprs) []sql.Expression {
+	// the assignments are made from left to right, so each one sees the user variables assigned before it
+	b.setUserVarTypes = make(map[string]sql.Type)
+	defer func() {
+		b.setUserVarTypes = nil
+	}()
 	res := make([]sql.Expression, len(e))
 	for i, setExpr := range e {
 		if expr, ok := setExpr.Expr.(*ast.SQLVal); ok && strings.ToLower(setExpr.Name.String()) == "transaction" &&
@@ -157,6 +173,9 @@ func (b *Builder) setExprsToExpressions(inScope *scope, e ast.SetVarExprs) []sql
 		}
 
 		res[i] = expression.NewSetField(setVar, innerExpr)
+		if uv, ok := setVar.(*expression.UserVar); ok {
+			b.setUserVarTypes[strings.ToLower(uv.Name)] = innerExpr.Type()
+		}
 	}
 	return res
 }
@@ -207,6 +226,9 @@ func (b *Builder) buildSysVar(colName *ast.ColName, scopeHint ast.SetScope) (sql
 			return expression.NewSystemVar(varName, sql.SystemVariableScope_Session, specifiedScope), scope, true
 		}
 	case ast.SetScope_User:
+		if t, ok := b.setUserVarTypes[strings.ToLower(varName)]; ok {
+			return expression.NewUserVarWithType(varName, t), scope, true
+		}
 		t, _, err := b.ctx.GetUserVariable(b.ctx, varName)
 		if err != nil {
 			b.handleErr(err)
@@ -343,7 +365,12 @@ func (b *Builder) simplifySetExpr(name *ast.ColName, varScope ast.SetScope, val
 			}
 			err = sql.ErrUnknownSystemVariable.New(varName)
 		case ast.SetScope_Persist, ast.SetScope_PersistOnly:
-			err = fmt.Errorf("%wsetting default for '%s'", sql.ErrUnsupportedFeature.New(), varScope)
+			// persisting the default restores it when the server restarts, rather than the current global value
+			sysVar, _, ok := sql.SystemVariables.GetGlobal(varName)
+			if ok {
+				return expression.NewLiteral(sysVar.Default, types.ApproximateTypeFromValue(sysVar.Default)), true
+			}
+			err = sql.ErrUnknownSystemVariable.New(varName)
 		case ast.SetScope_User:
 			err = sql.ErrUserVariableNoDefault.New(varName)
 		default: // shouldn't happen
@@ -24,6 +24,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 func hasRecursiveCte(node sql.Node) bool {
@@ -70,6 +71,12 @@ func (b *Builder) buildSetOp(inScope *scope, u *ast.SetOp) (outScope *scope) {
 		distinct = false
 	}
 
+	if u.Limit != nil && u.Limit.WithTies {
+		b.handleErr(errWithTiesWithSetOp.New())
+	}
+	if u.Limit != nil && u.Limit.Percent {
+		b.handleErr(errPercentWithSetOp.New())
+	}
 	limit := b.buildLimit(inScope, u.Limit)
 	offset := b.buildOffset(inScope, u.Limit)
 
@@ -79,6 +86,16 @@ func (b *Builder) buildSetOp(inScope *scope, u *ast.SetOp) (outScope *scope) {
 		}
 	}
 
+	// the columns of a set operation have the unified type of all of its inputs
+	colTypes := b.setOpColumnTypes(leftScope.node, rightScope.node)
+	colTypesById := make(map[sql.ColumnId]sql.Type)
+	for i := range leftScope.cols {
+		if i < len(colTypes) {
+			leftScope.cols[i].typ = colTypes[i]
+			colTypesById[sql.ColumnId(leftScope.cols[i].id)] = colTypes[i]
+		}
+	}
+
 	// mysql errors for order by right projection
 	orderByScope := b.analyzeOrderBy(leftScope, leftScope, u.OrderBy)
 
@@ -98,7 +115,17 @@ func (b *Builder) buildSetOp(inScope *scope, u *ast.SetOp) (outScope *scope) {
 		scalar, _, _ = transform.Expr(scalar, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 			switch e := e.(type) {
 			case *expression.Alias:
-				return expression.NewGetField(int(c.id), e.Type(), e.Name(), e.IsNullable()), transform.NewTree, nil
+				typ := e.Type()
+				if c.typ != nil {
+					typ = c.typ
+				}
+				return expression.NewGetField(int(c.id), typ, e.Name(), e.IsNullable()), transform.NewTree, nil
+			case *expression.GetField:
+				typ, ok := colTypesById[e.Id()]
+				if !ok || reflect.DeepEqual(typ, e.Type()) {
+					return e, transform.SameTree, nil
+				}
+				return expression.NewGetFieldWithTable(int(e.Id()), int(e.TableId()), typ, e.Database(), e.Table(), e.Name(), e.IsNullable()), transform.NewTree, nil
 			default:
 				return e, transform.SameTree, nil
 			}
@@ -144,45 +171,36 @@ func (b *Builder) buildSetOp(inScope *scope, u *ast.SetOp) (outScope *scope) {
 	tabId := b.tabId
 	ret := plan.NewSetOp(setOpType, leftScope.node, rightScope.node, distinct, limit, offset, sortFields).WithId(tabId).WithColumns(cols)
 	outScope = leftScope
-	outScope.node = b.mergeSetOpSchemas(ret.(*plan.SetOp))
+	outScope.node = b.mergeSetOpSchemas(ret.(*plan.SetOp), colTypes)
 	return
 }
 
-func (b *Builder) mergeSetOpSchemas(u *plan.SetOp) sql.Node {
-	ls, rs := u.Left().Schema(), u.Right().Schema()
+// setOpColumnTypes returns the types of the columns of a set operation over |left| and |right|. Each column has the
+// type that holds the values of the column from either side, following MySQL's rules for aggregating types.
+func (b *Builder) setOpColumnTypes(left, right sql.Node) []sql.Type {
+	ls, rs := left.Schema(), right.Schema()
 	if len(ls) != len(rs) {
 		err := ErrUnionSchemasDifferentLength.New(len(ls), len(rs))
 		b.handleErr(err)
 	}
-
-	leftIds := colIdsForRel(u.Left())
-	rightIds := colIdsForRel(u.Right())
-
-	les, res := make([]sql.Expression, len(ls)), make([]sql.Expression, len(rs))
-	hasdiff := false
-	var err error
+	colTypes := make([]sql.Type, len(ls))
 	for i := range ls {
-		// todo: proj col ids should align with input column ids
-		les[i] = expression.NewGetFieldWithTable(int(leftIds[i]), 0, ls[i].Type, ls[i].DatabaseSource, ls[i].Source, ls[i].Name, ls[i].Nullable)
-		res[i] = expression.NewGetFieldWithTable(int(rightIds[i]), 0, rs[i].Type, rs[i].DatabaseSource, rs[i].Source, rs[i].Name, rs[i].Nullable)
 		if reflect.DeepEqual(ls[i].Type, rs[i].Type) {
-			continue
+			colTypes[i] = ls[i].Type
+		} else {
+			colTypes[i] = types.GeneralizeTypes(ls[i].Type, rs[i].Type)
 		}
-		hasdiff = true
-
-		// try to get optimal type to convert both into
-		convertTo := expression.GetConvertToType(ls[i].Type, rs[i].Type)
-
-		// TODO: Principled type coercion...
-		les[i], err = b.f.buildConvert(les[i], convertTo, 0, 0)
-		res[i], err = b.f.buildConvert(res[i], convertTo, 0, 0)
-
-		// Preserve schema names across the conversion.
-		les[i] = expression.NewAlias(ls[i].Name, les[i])
-		res[i] = expression.NewAlias(rs[i].Name, res[i])
 	}
+	return colTypes
+}
+
+// mergeSetOpSchemas coerces the columns of both inputs of |u| that do not already have the column types |colTypes|.
+func (b *Builder) mergeSetOpSchemas(u *plan.SetOp, colTypes []sql.Type) sql.Node {
+	les, leftDiff := setOpInputProjections(u.Left(), colTypes)
+	res, rightDiff := setOpInputProjections(u.Right(), colTypes)
 	var ret sql.Node = u
-	if hasdiff {
+	if leftDiff || rightDiff {
+		var err error
 		ret, err = u.WithChildren(
 			plan.NewProject(les, u.Left()),
 			plan.NewProject(res, u.Right()),
@@ -194,6 +212,24 @@ func (b *Builder) mergeSetOpSchemas(u *plan.SetOp) sql.Node {
 	return ret
 }
 
+// setOpInputProjections returns projections of the columns of |n| coerced to |colTypes|, and whether any column
+// needed to be coerced.
+func setOpInputProjections(n sql.Node, colTypes []sql.Type) ([]sql.Expression, bool) {
+	sch := n.Schema()
+	ids := colIdsForRel(n)
+	projections := make([]sql.Expression, len(sch))
+	hasdiff := false
+	for i, col := range sch {
+		// todo: proj col ids should align with input column ids
+		projections[i] = expression.NewGetFieldWithTable(int(ids[i]), 0, col.Type, col.DatabaseSource, col.Source, col.Name, col.Nullable)
+		if !reflect.DeepEqual(col.Type, colTypes[i]) {
+			hasdiff = true
+			projections[i] = expression.NewAlias(col.Name, expression.NewCoercion(projections[i], colTypes[i]))
+		}
+	}
+	return projections, hasdiff
+}
+
 // colIdsForRel returns the padded column set returned by a node,
 // with 0's filled in for non-aliasable columns
 func colIdsForRel(n sql.Node) []sql.ColumnId {
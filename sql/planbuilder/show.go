@@ -68,6 +68,8 @@ func (b *Builder) buildShow(inScope *scope, s *ast.Show) (outScope *scope) {
 		return b.buildShowAllColumns(inScope, s)
 	case ast.KeywordString(ast.WARNINGS):
 		return b.buildShowWarnings(inScope, s)
+	case ast.KeywordString(ast.ERRORS):
+		return b.buildShowErrors(inScope, s)
 	case ast.KeywordString(ast.COLLATION):
 		return b.buildShowCollation(inScope, s)
 	case ast.KeywordString(ast.CHARSET):
@@ -85,6 +87,22 @@ func (b *Builder) buildShow(inScope *scope, s *ast.Show) (outScope *scope) {
 			showRep.ReplicaController = binCat.GetBinlogReplicaController()
 		}
 		outScope.node = showRep
+	case "replicas":
+		outScope = inScope.push()
+		showReplicas := plan.NewShowReplicas()
+		if binCat, ok := b.cat.(binlogreplication.BinlogPrimaryCatalog); ok && binCat.IsBinlogPrimaryCatalog() {
+			showReplicas.PrimaryController = binCat.GetBinlogPrimaryController()
+		}
+		outScope.node = showReplicas
+	case "binary logs", "master logs":
+		outScope = inScope.push()
+		showLogs := plan.NewShowBinaryLogs()
+		if binCat, ok := b.cat.(binlogreplication.BinlogPrimaryCatalog); ok && binCat.IsBinlogPrimaryCatalog() {
+			showLogs.PrimaryController = binCat.GetBinlogPrimaryController()
+		}
+		outScope.node = showLogs
+	case "binlog events":
+		return b.buildShowBinlogEvents(inScope, s)
 	default:
 		unsupportedShow := fmt.Sprintf("SHOW %s", s.Type)
 		b.handleErr(sql.ErrUnsupportedFeature.New(unsupportedShow))
@@ -92,6 +110,29 @@ func (b *Builder) buildShow(inScope *scope, s *ast.Show) (outScope *scope) {
 	return
 }
 
+func (b *Builder) buildShowBinlogEvents(inScope *scope, s *ast.Show) (outScope *scope) {
+	outScope = inScope.push()
+	offset, limit := int64(0), int64(-1)
+	if s.Limit != nil {
+		limit = b.getInt64Value(inScope, s.Limit.Rowcount, "LIMIT with non-integer literal")
+		if s.Limit.Offset != nil {
+			offset = b.getInt64Value(inScope, s.Limit.Offset, "LIMIT with non-integer literal")
+		}
+	}
+
+	var logName string
+	var pos uint64
+	if s.BinlogEventsOpt != nil {
+		logName, pos = s.BinlogEventsOpt.LogName, s.BinlogEventsOpt.Pos
+	}
+	showEvents := plan.NewShowBinlogEvents(logName, pos, offset, limit)
+	if binCat, ok := b.cat.(binlogreplication.BinlogPrimaryCatalog); ok && binCat.IsBinlogPrimaryCatalog() {
+		showEvents.PrimaryController = binCat.GetBinlogPrimaryController()
+	}
+	outScope.node = showEvents
+	return outScope
+}
+
 func (b *Builder) buildShowTable(inScope *scope, s *ast.Show, showType string) (outScope *scope) {
 	outScope = inScope.push()
 	var asOf *ast.AsOf
@@ -102,6 +143,9 @@ func (b *Builder) buildShowTable(inScope *scope, s *ast.Show, showType string) (
 	}
 
 	db := s.Database
+	if db == "" && s.ShowTablesOpt != nil {
+		db = s.ShowTablesOpt.DbName
+	}
 	if db == "" {
 		db = s.Table.Qualifier.String()
 	}
@@ -462,6 +506,7 @@ func (b *Builder) buildShowIndex(inScope *scope, s *ast.Show) (outScope *scope)
 	switch n := tableScope.node.(type) {
 	case *plan.ResolvedTable:
 		showIdx.IndexesToShow = b.getInfoSchemaIndexes(n)
+		showIdx.Stats = b.cat
 	case *plan.SubqueryAlias:
 		// views don't have keys
 		showIdx.Child = plan.NewResolvedDualTable()
@@ -476,7 +521,11 @@ func (b *Builder) buildShowIndex(inScope *scope, s *ast.Show) (outScope *scope)
 func (b *Builder) getInfoSchemaIndexes(rt *plan.ResolvedTable) []sql.Index {
 	it, ok := rt.Table.(sql.IndexAddressableTable)
 	if !ok {
-		return nil
+		// tables with virtual columns are wrapped
+		it, ok = rt.UnderlyingTable().(sql.IndexAddressableTable)
+		if !ok {
+			return nil
+		}
 	}
 
 	indexes, err := it.GetIndexes(b.ctx)
@@ -687,6 +736,9 @@ func (b *Builder) buildShowAllColumns(inScope *scope, s *ast.Show) (outScope *sc
 	}
 
 	db := s.Database
+	if db == "" && s.ShowTablesOpt != nil {
+		db = s.ShowTablesOpt.DbName
+	}
 	if db == "" {
 		db = s.Table.Qualifier.String()
 	}
@@ -753,11 +805,10 @@ func (b *Builder) buildShowAllColumns(inScope *scope, s *ast.Show) (outScope *sc
 }
 
 func (b *Builder) buildShowWarnings(inScope *scope, s *ast.Show) (outScope *scope) {
-	outScope = inScope.push()
 	if s.CountStar {
-		unsupportedShow := "SHOW COUNT(*) WARNINGS"
-		b.handleErr(sql.ErrUnsupportedFeature.New(unsupportedShow))
+		return b.buildShowDiagnosticsCount(inScope, "warning_count")
 	}
+	outScope = inScope.push()
 	var node sql.Node
 	node = plan.ShowWarnings(b.ctx.Session.Warnings())
 	if s.Limit != nil {
@@ -773,6 +824,35 @@ func (b *Builder) buildShowWarnings(inScope *scope, s *ast.Show) (outScope *scop
 	return
 }
 
+// buildShowErrors builds SHOW COUNT(*) ERRORS. SHOW ERRORS itself isn't supported.
+func (b *Builder) buildShowErrors(inScope *scope, s *ast.Show) (outScope *scope) {
+	if !s.CountStar {
+		b.handleErr(sql.ErrUnsupportedFeature.New("SHOW ERRORS"))
+	}
+	return b.buildShowDiagnosticsCount(inScope, "error_count")
+}
+
+// buildShowDiagnosticsCount builds SHOW COUNT(*) WARNINGS or SHOW COUNT(*) ERRORS, which are equivalent to selecting the
+// warning_count or error_count system variable, named by |sysVarName|.
+// https://dev.mysql.com/doc/refman/8.0/en/show-warnings.html
+func (b *Builder) buildShowDiagnosticsCount(inScope *scope, sysVarName string) (outScope *scope) {
+	outScope = inScope.push()
+	node, _, _, err := b.Parse("select @@session."+sysVarName, false)
+	if err != nil {
+		b.handleErr(err)
+	}
+
+	for _, c := range node.Schema() {
+		outScope.newColumn(scopeColumn{
+			col:      strings.ToLower(c.Name),
+			typ:      c.Type,
+			nullable: c.Nullable,
+		})
+	}
+	outScope.node = node
+	return
+}
+
 func (b *Builder) buildShowCollation(inScope *scope, s *ast.Show) (outScope *scope) {
 	outScope = inScope.push()
 	// show collation statements are functionally identical to selecting from the collations table in
@@ -0,0 +1,189 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"strings"
+)
+
+// TableOption holds the CREATE TABLE options that are used when building a table's schema. Options that the engine
+// doesn't use, such as ENGINE or ROW_FORMAT, are accepted and dropped.
+type TableOption struct {
+	CharacterSet string
+	Collation    string
+	Comment      string
+}
+
+// tableOptionKeywords are the words that can start a table option in the options string returned by Vitess.
+var tableOptionKeywords = map[string]struct{}{
+	"AUTOEXTEND_SIZE":            {},
+	"AUTO_INCREMENT":             {},
+	"AVG_ROW_LENGTH":             {},
+	"CHARACTER":                  {},
+	"CHECKSUM":                   {},
+	"COLLATE":                    {},
+	"COMMENT":                    {},
+	"COMPRESSION":                {},
+	"CONNECTION":                 {},
+	"DATA":                       {},
+	"DEFAULT":                    {},
+	"DELAY_KEY_WRITE":            {},
+	"ENCRYPTION":                 {},
+	"ENGINE":                     {},
+	"ENGINE_ATTRIBUTE":           {},
+	"INDEX":                      {},
+	"INSERT_METHOD":              {},
+	"KEY_BLOCK_SIZE":             {},
+	"MAX_ROWS":                   {},
+	"MIN_ROWS":                   {},
+	"PACK_KEYS":                  {},
+	"PASSWORD":                   {},
+	"ROW_FORMAT":                 {},
+	"SECONDARY_ENGINE":           {},
+	"SECONDARY_ENGINE_ATTRIBUTE": {},
+	"START":                      {},
+	"STATS_AUTO_RECALC":          {},
+	"STATS_PERSISTENT":           {},
+	"STATS_SAMPLE_PAGES":         {},
+	"TABLESPACE":                 {},
+	"UNION":                      {},
+}
+
+// parseTableOptions reads the character set, collation and comment out of the table options of a parsed TableSpec.
+// Vitess returns the options as a single string, with each string value unescaped and wrapped in single quotes,
+// regardless of how it was quoted in the original statement. A quoted value therefore ends at the first single quote
+// that is followed by the end of the options or by another table option, which lets comments contain quotes of
+// either kind. The one comment this can't read back is one with a single quote followed by the name of a table option,
+// such as 'a' ENGINE b', which is cut short at that quote.
+func parseTableOptions(options string) TableOption {
+	var opts TableOption
+	p := tableOptionParser{s: options}
+	for {
+		word := strings.ToUpper(p.word())
+		if word == "" {
+			// the value of an option we don't use, or punctuation such as the parentheses of UNION
+			if p.atQuote() {
+				p.quoted()
+			} else if !p.skip() {
+				return opts
+			}
+			continue
+		}
+		switch word {
+		case "CHARACTER":
+			if strings.ToUpper(p.word()) == "SET" {
+				opts.CharacterSet = p.value()
+			}
+		case "COLLATE":
+			opts.Collation = p.value()
+		case "COMMENT":
+			opts.Comment = p.value()
+		}
+	}
+}
+
+// tableOptionParser walks through the options string of a TableSpec.
+type tableOptionParser struct {
+	s   string
+	pos int
+}
+
+// skipSeparators moves past any spaces and commas between words.
+func (p *tableOptionParser) skipSeparators() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == ',') {
+		p.pos++
+	}
+}
+
+// skip moves past a single character that can't start a word or a quoted value, returning false at the end of the
+// options.
+func (p *tableOptionParser) skip() bool {
+	if p.pos >= len(p.s) {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+// word returns the next bare word, or the empty string if the next value is quoted or there are no more words.
+func (p *tableOptionParser) word() string {
+	p.skipSeparators()
+	start := p.pos
+	for p.pos < len(p.s) && isTableOptionWordChar(p.s[p.pos]) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// value returns the next quoted value with its quotes removed, or the next bare word.
+func (p *tableOptionParser) value() string {
+	if p.atQuote() {
+		return p.quoted()
+	}
+	return p.word()
+}
+
+// atQuote moves past any separators and returns whether the next value is quoted.
+func (p *tableOptionParser) atQuote() bool {
+	p.skipSeparators()
+	return p.pos < len(p.s) && p.s[p.pos] == '\''
+}
+
+// quoted returns the quoted value that starts at the current position, with its quotes removed.
+func (p *tableOptionParser) quoted() string {
+	start := p.pos + 1
+	end := -1
+	for i := start; i < len(p.s); i++ {
+		if p.s[i] == '\'' && endsTableOptionValue(p.s[i+1:]) {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		// an unterminated value runs to the end of the options
+		p.pos = len(p.s)
+		return p.s[start:]
+	}
+	p.pos = end + 1
+	return p.s[start:end]
+}
+
+// endsTableOptionValue returns whether |rest|, the remainder of the options after a single quote, shows that the
+// quote closes a value: either nothing follows it, or the next table option does.
+func endsTableOptionValue(rest string) bool {
+	trimmed := strings.TrimLeft(rest, " ")
+	if trimmed == "" {
+		return true
+	}
+	if trimmed[0] == ',' {
+		trimmed = strings.TrimLeft(trimmed[1:], " ")
+	} else if len(trimmed) == len(rest) {
+		// the next option must be separated from this one
+		return false
+	}
+	i := 0
+	for i < len(trimmed) && isTableOptionWordChar(trimmed[i]) {
+		i++
+	}
+	if i < len(trimmed) && trimmed[i] != ' ' && trimmed[i] != '\'' {
+		return false
+	}
+	_, ok := tableOptionKeywords[strings.ToUpper(trimmed[:i])]
+	return ok
+}
+
+func isTableOptionWordChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
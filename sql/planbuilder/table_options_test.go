@@ -0,0 +1,76 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"testing"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTableOptions(t *testing.T) {
+	tests := []struct {
+		options  string
+		expected TableOption
+	}{
+		{
+			options:  `ENGINE=InnoDB`,
+			expected: TableOption{},
+		},
+		{
+			options:  `COMMENT 'plain comment'`,
+			expected: TableOption{Comment: "plain comment"},
+		},
+		{
+			options:  `COMMENT="it's got a single quote"`,
+			expected: TableOption{Comment: "it's got a single quote"},
+		},
+		{
+			options:  `COMMENT 'it''s escaped'`,
+			expected: TableOption{Comment: "it's escaped"},
+		},
+		{
+			options:  `COMMENT 'say "hello"'`,
+			expected: TableOption{Comment: `say "hello"`},
+		},
+		{
+			options:  `COMMENT "'quoted' words" ENGINE=InnoDB`,
+			expected: TableOption{Comment: "'quoted' words"},
+		},
+		{
+			options:  `DEFAULT CHARSET=latin1 COLLATE latin1_german1_ci COMMENT='a, b'`,
+			expected: TableOption{CharacterSet: "latin1", Collation: "latin1_german1_ci", Comment: "a, b"},
+		},
+		{
+			options:  `COMMENT "don't", CHARACTER SET utf8mb4, ROW_FORMAT=DYNAMIC`,
+			expected: TableOption{CharacterSet: "utf8mb4", Comment: "don't"},
+		},
+		{
+			options:  `collate=utf8mb4_0900_bin comment ''`,
+			expected: TableOption{Collation: "utf8mb4_0900_bin"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.options, func(t *testing.T) {
+			stmt, err := ast.Parse("create table t (i int) " + test.options)
+			require.NoError(t, err)
+			ddl, ok := stmt.(*ast.DDL)
+			require.True(t, ok)
+			require.Equal(t, test.expected, parseTableOptions(ddl.TableSpec.Options))
+		})
+	}
+}
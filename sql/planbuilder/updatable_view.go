@@ -0,0 +1,289 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planbuilder
+
+import (
+	"fmt"
+	"strings"
+
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// updatableView is a view that rows can be inserted and updated through, which selects columns from a single table,
+// or from a single view that is itself updatable, with an optional WHERE clause. The rows written through the view
+// are written to its base table.
+type updatableView struct {
+	name string
+	// db and table are the names of the base table.
+	db    string
+	table string
+	// columns are the names of the columns of the view.
+	columns []string
+	// baseColumns are the names of the base table columns selected by each column of the view, or empty for the columns
+	// that don't select a column.
+	baseColumns []string
+	// conditions are the conditions that rows of the base table satisfy to be rows of the view, with columns named by
+	// the base table columns they refer to.
+	conditions []sql.Expression
+	// checks are the conditions that the rows written through the view are checked against, because of the WITH CHECK
+	// OPTION clauses of the view and the views it's defined on.
+	checks []*sql.CheckConstraint
+}
+
+// newUpdatableView returns the updatableView for |view|, which is defined in the database named |db|, or false if rows
+// can't be written through it. The conditions of |view| are checked if it has a check option or if |cascaded| is true,
+// which is the case for the views that a view with a CASCADED check option is defined on.
+func newUpdatableView(view *plan.SubqueryAlias, db string, cascaded bool) (*updatableView, bool) {
+	cascaded = cascaded || view.CheckOption == plan.ViewCheckOptionCascaded
+	checked := cascaded || view.CheckOption == plan.ViewCheckOptionLocal
+
+	var projections []sql.Expression
+	var filters []sql.Expression
+	node := view.Child
+	for done := false; !done; {
+		switch n := node.(type) {
+		case *plan.Project:
+			if projections != nil {
+				return nil, false
+			}
+			projections = n.Projections
+			node = n.Child
+		case *plan.Filter:
+			filters = append(filters, n.Expression)
+			node = n.Child
+		case *plan.Sort:
+			node = n.Child
+		case *plan.TableAlias:
+			node = n.Child
+		default:
+			done = true
+		}
+	}
+
+	ret := &updatableView{name: view.Name()}
+	// childColumns are the base table columns selected by the columns of |node|
+	var childColumns []string
+	switch n := node.(type) {
+	case *plan.ResolvedTable:
+		ret.db, ret.table = n.SqlDatabase.Name(), n.Name()
+		for _, col := range n.Schema() {
+			childColumns = append(childColumns, col.Name)
+		}
+	case *plan.SubqueryAlias:
+		inner, ok := newUpdatableView(n, db, cascaded)
+		if !ok {
+			return nil, false
+		}
+		ret.db, ret.table = inner.db, inner.table
+		ret.conditions = inner.conditions
+		ret.checks = inner.checks
+		childColumns = inner.baseColumns
+	default:
+		return nil, false
+	}
+	childSch := node.Schema()
+	baseColumn := func(name string) string {
+		for i, col := range childSch {
+			if strings.EqualFold(col.Name, name) {
+				return childColumns[i]
+			}
+		}
+		return ""
+	}
+
+	for _, filter := range filters {
+		ok := true
+		cond, _, _ := transform.Expr(filter, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			switch e := e.(type) {
+			case *expression.GetField:
+				name := baseColumn(e.Name())
+				if name == "" {
+					ok = false
+					return e, transform.SameTree, nil
+				}
+				return expression.NewGetField(0, e.Type(), name, e.IsNullable()), transform.NewTree, nil
+			case *plan.Subquery:
+				ok = false
+			}
+			return e, transform.SameTree, nil
+		})
+		if !ok {
+			return nil, false
+		}
+		ret.conditions = append(ret.conditions, cond)
+		if checked {
+			ret.checks = append(ret.checks, &sql.CheckConstraint{
+				Name:            fmt.Sprintf("%s.%s", db, view.Name()),
+				Expr:            cond,
+				Enforced:        true,
+				ViewCheckOption: true,
+			})
+		}
+	}
+
+	for _, col := range view.Schema() {
+		ret.columns = append(ret.columns, col.Name)
+	}
+	if projections == nil {
+		ret.baseColumns = childColumns
+		return ret, true
+	}
+	for _, p := range projections {
+		if a, ok := p.(*expression.Alias); ok {
+			p = a.Child
+		}
+		var name string
+		if gf, ok := p.(*expression.GetField); ok {
+			name = baseColumn(gf.Name())
+		}
+		ret.baseColumns = append(ret.baseColumns, name)
+	}
+	return ret, true
+}
+
+// baseColumn returns the name of the base table column selected by the view column named |name|. It is an error for
+// the view to not have the column, or for the column to not select a base table column.
+func (v *updatableView) baseColumn(name string) (string, error) {
+	for i, col := range v.columns {
+		if strings.EqualFold(col, name) {
+			if v.baseColumns[i] == "" {
+				return "", sql.ErrColumnNotUpdatable.New(col)
+			}
+			return v.baseColumns[i], nil
+		}
+	}
+	return "", sql.ErrUnknownColumn.New(name, v.name)
+}
+
+// selectsBaseColumns returns whether each column of the view selects the base table column with the same name.
+func (v *updatableView) selectsBaseColumns() bool {
+	for i, col := range v.columns {
+		if !strings.EqualFold(col, v.baseColumns[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveViewConditions returns |conds| with their columns resolved to the columns of the base table in |inScope|,
+// where the base table is named |table|.
+func (b *Builder) resolveViewConditions(inScope *scope, table string, conds []sql.Expression) []sql.Expression {
+	ret := make([]sql.Expression, len(conds))
+	for i, cond := range conds {
+		ret[i], _, _ = transform.Expr(cond, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			gf, ok := e.(*expression.GetField)
+			if !ok {
+				return e, transform.SameTree, nil
+			}
+			c, ok := inScope.resolveColumn("", strings.ToLower(table), strings.ToLower(gf.Name()), false, false)
+			if !ok {
+				b.handleErr(sql.ErrColumnNotFound.New(gf.Name()))
+			}
+			return c.scalarGf(), transform.NewTree, nil
+		})
+	}
+	return ret
+}
+
+// resolveViewChecks returns the checks of |view| with their columns resolved to the columns of the base table in
+// |inScope|, where the base table is named |table|.
+func (b *Builder) resolveViewChecks(inScope *scope, table string, view *updatableView) []*sql.CheckConstraint {
+	ret := make([]*sql.CheckConstraint, len(view.checks))
+	for i, check := range view.checks {
+		resolved := *check
+		resolved.Expr = b.resolveViewConditions(inScope, table, []sql.Expression{check.Expr})[0]
+		ret[i] = &resolved
+	}
+	return ret
+}
+
+// updatableViewTableExprs returns |exprs| with the view they name replaced by its base table, if |exprs| name a single
+// updatable view whose columns have the names of the base table columns they select. The base table is given the name
+// of the view, or the view's alias, which is also returned.
+func (b *Builder) updatableViewTableExprs(inScope *scope, exprs ast.TableExprs) (ast.TableExprs, *updatableView, string) {
+	if len(exprs) != 1 {
+		return exprs, nil, ""
+	}
+	ate, ok := exprs[0].(*ast.AliasedTableExpr)
+	if !ok {
+		return exprs, nil, ""
+	}
+	tn, ok := ate.Expr.(ast.TableName)
+	if !ok {
+		return exprs, nil, ""
+	}
+	dbName := tn.Qualifier.String()
+	if dbName == "" {
+		dbName = b.ctx.GetCurrentDatabase()
+	}
+	// tables aren't built here, so that they're only assigned ids once
+	if !b.isView(dbName, tn.Name.String()) {
+		return exprs, nil, ""
+	}
+	tableScope, ok := b.buildResolvedTable(inScope, dbName, tn.Name.String(), ate.AsOf)
+	if !ok {
+		return exprs, nil, ""
+	}
+	sq, ok := tableScope.node.(*plan.SubqueryAlias)
+	if !ok {
+		return exprs, nil, ""
+	}
+	view, ok := newUpdatableView(sq, dbName, false)
+	if !ok {
+		b.handleErr(sql.ErrNonUpdatableTable.New(tn.Name.String(), "UPDATE"))
+	}
+	if !view.selectsBaseColumns() {
+		return exprs, nil, ""
+	}
+
+	base := *ate
+	base.Expr = ast.TableName{Name: ast.NewTableIdent(view.table), Qualifier: ast.NewTableIdent(view.db)}
+	if base.As.IsEmpty() {
+		base.As = tn.Name
+	}
+	return ast.TableExprs{&base}, view, base.As.String()
+}
+
+// checkDeletable errors if |n|, a table that rows are deleted from, is a view or derived table that rows can't be
+// deleted through.
+func (b *Builder) checkDeletable(n sql.Node) {
+	sq, ok := n.(*plan.SubqueryAlias)
+	if !ok {
+		return
+	}
+	if _, ok := newUpdatableView(sq, b.ctx.GetCurrentDatabase(), false); !ok {
+		b.handleErr(sql.ErrNonUpdatableTable.New(sq.Name(), "DELETE"))
+	}
+}
+
+// isView returns whether |name| names a view in the database named |dbName|.
+func (b *Builder) isView(dbName, name string) bool {
+	database, err := b.cat.Database(b.ctx, dbName)
+	if err != nil {
+		return false
+	}
+	if vdb, ok := database.(sql.ViewDatabase); ok {
+		if _, ok, err := vdb.GetViewDefinition(b.ctx, name); err == nil && ok {
+			return true
+		}
+	}
+	_, ok := b.ctx.GetViewRegistry().View(database.Name(), name)
+	return ok
+}
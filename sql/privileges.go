@@ -21,6 +21,7 @@ type PrivilegedOperation struct {
 	Column            string
 	Routine           string
 	IsProcedure       bool // true if the routine is a procedure, false if it's a function
+	IsGlobal          bool // true if only global privileges may satisfy the operation
 	StaticPrivileges  []PrivilegeType
 	DynamicPrivileges []string
 }
@@ -47,6 +48,15 @@ func NewPrivilegedOperation(subject PrivilegeCheckSubject, privs ...PrivilegeTyp
 	}
 }
 
+// NewGlobalPrivilegedOperation returns a new PrivilegedOperation that is only satisfied by global privileges. Unlike an
+// operation with an empty subject, the privileges of the current database are not considered.
+func NewGlobalPrivilegedOperation(privs ...PrivilegeType) PrivilegedOperation {
+	return PrivilegedOperation{
+		IsGlobal:         true,
+		StaticPrivileges: privs,
+	}
+}
+
 // NewDynamicPrivilegedOperation returns a new PrivilegedOperation for the specified dynamic privileges. Dynamic
 // privileges may only be applied globally, so you cannot specify a database, table, or column.
 func NewDynamicPrivilegedOperation(privs ...string) PrivilegedOperation {
@@ -68,6 +68,15 @@ type ProcessList interface {
 	RemovePartitionProgress(pid uint64, tableName, partitionName string)
 }
 
+// QueryStateProcessList is implemented by process lists that can show the state of a running query.
+type QueryStateProcessList interface {
+	ProcessList
+
+	// UpdateQueryState sets the state of the process with the given pid, such as the lock it's waiting for. An empty
+	// state clears it.
+	UpdateQueryState(pid uint64, state string)
+}
+
 type ProcessCommand string
 
 const (
@@ -92,6 +101,8 @@ type Process struct {
 
 	QueryPid uint64
 	Query    string
+	// State describes what the query is waiting for, if anything
+	State    string
 	Progress map[string]TableProgress
 	Kill     context.CancelFunc
 }
@@ -0,0 +1,445 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultUserResourceGroup is the resource group that user threads belong to unless they're assigned to another.
+	DefaultUserResourceGroup = "USR_default"
+	// DefaultSystemResourceGroup is the resource group of the background threads of the server.
+	DefaultSystemResourceGroup = "SYS_default"
+)
+
+// ResourceGroupType is the type of a resource group, which determines the threads that can be assigned to it and the
+// thread priorities that it may have.
+type ResourceGroupType byte
+
+const (
+	ResourceGroupType_User ResourceGroupType = iota
+	ResourceGroupType_System
+)
+
+// String returns the name of the type, as listed in information_schema.resource_groups.
+func (t ResourceGroupType) String() string {
+	if t == ResourceGroupType_System {
+		return "SYSTEM"
+	}
+	return "USER"
+}
+
+// priorityRange returns the lowest and highest thread priorities that a group of this type may have. Lower values are
+// higher priorities, as for the nice values of Linux threads.
+func (t ResourceGroupType) priorityRange() (int, int) {
+	if t == ResourceGroupType_System {
+		return -20, 0
+	}
+	return 0, 19
+}
+
+// ResourceGroupVCPURange is an inclusive range of virtual CPU ids.
+type ResourceGroupVCPURange struct {
+	Start uint
+	End   uint
+}
+
+// String returns the range in the form it's written in a VCPU clause.
+func (r ResourceGroupVCPURange) String() string {
+	if r.Start == r.End {
+		return fmt.Sprintf("%d", r.Start)
+	}
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// ResourceGroupVCPUsString returns |vcpus| in the form they're written in a VCPU clause.
+func ResourceGroupVCPUsString(vcpus []ResourceGroupVCPURange) string {
+	strs := make([]string, len(vcpus))
+	for i, r := range vcpus {
+		strs[i] = r.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// ResourceGroup is the definition of a resource group: the CPUs that its threads may run on, and their priority.
+// https://dev.mysql.com/doc/refman/8.0/en/resource-groups.html
+type ResourceGroup struct {
+	Name           string
+	Type           ResourceGroupType
+	Enabled        bool
+	VCPUs          []ResourceGroupVCPURange
+	ThreadPriority int
+}
+
+// ResourceGroupChanges are the attributes of a resource group given by ALTER RESOURCE GROUP. Attributes that weren't
+// given are nil.
+type ResourceGroupChanges struct {
+	VCPUs          []ResourceGroupVCPURange
+	ThreadPriority *int
+	Enabled        *bool
+	// Force moves the threads of a group being disabled to the default group, rather than failing.
+	Force bool
+}
+
+// ResourceGroupScheduler is implemented by integrators that apply the CPU and priority constraints of resource groups
+// to the work done for sessions, for example by limiting the goroutines that run their queries. Without a scheduler,
+// resource groups only record which group each thread is assigned to.
+type ResourceGroupScheduler interface {
+	// AssignResourceGroup is called when the thread of the connection given is assigned to |group|, either for the
+	// rest of the session by SET RESOURCE GROUP, or for a single statement by a RESOURCE_GROUP optimizer hint. When a
+	// statement assigned by a hint completes, it's called again with the group of the session. It's also called for
+	// every thread of a group whose attributes are changed by ALTER RESOURCE GROUP. An error fails the statement that
+	// made the assignment.
+	AssignResourceGroup(ctx *Context, connID uint32, group ResourceGroup) error
+}
+
+// ResourceGroupCatalog is implemented by catalogs that keep the resource groups of the server.
+type ResourceGroupCatalog interface {
+	GetResourceGroupRegistry() *ResourceGroupRegistry
+}
+
+// ResourceGroupRegistry holds the resource groups of the server, and the groups that threads are assigned to. Threads
+// that aren't assigned to a group belong to DefaultUserResourceGroup. The default groups can't be changed or dropped.
+type ResourceGroupRegistry struct {
+	// Scheduler is an optional hook that's told about every assignment of a thread to a resource group.
+	Scheduler ResourceGroupScheduler
+
+	mu     sync.Mutex
+	groups map[string]*ResourceGroup
+	// sessions holds the lower case name of the group of each connection assigned by SET RESOURCE GROUP
+	sessions map[uint32]string
+	// statements holds the lower case name of the group of each connection running a statement with a
+	// RESOURCE_GROUP hint
+	statements map[uint32]string
+}
+
+// NewResourceGroupRegistry returns a new ResourceGroupRegistry with only the default resource groups.
+func NewResourceGroupRegistry() *ResourceGroupRegistry {
+	allVCPUs := []ResourceGroupVCPURange{{Start: 0, End: uint(runtime.NumCPU() - 1)}}
+	r := &ResourceGroupRegistry{
+		groups:     make(map[string]*ResourceGroup),
+		sessions:   make(map[uint32]string),
+		statements: make(map[uint32]string),
+	}
+	for _, g := range []ResourceGroup{
+		{Name: DefaultUserResourceGroup, Type: ResourceGroupType_User, Enabled: true, VCPUs: allVCPUs},
+		{Name: DefaultSystemResourceGroup, Type: ResourceGroupType_System, Enabled: true, VCPUs: allVCPUs},
+	} {
+		g := g
+		r.groups[strings.ToLower(g.Name)] = &g
+	}
+	return r
+}
+
+// Groups returns every resource group, ordered by name.
+func (r *ResourceGroupRegistry) Groups() []ResourceGroup {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	groups := make([]ResourceGroup, 0, len(r.groups))
+	for _, g := range r.groups {
+		groups = append(groups, *g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return strings.ToLower(groups[i].Name) < strings.ToLower(groups[j].Name)
+	})
+	return groups
+}
+
+// Group returns the resource group with the name given, ignoring case.
+func (r *ResourceGroupRegistry) Group(name string) (ResourceGroup, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	g, ok := r.groups[strings.ToLower(name)]
+	if !ok {
+		return ResourceGroup{}, false
+	}
+	return *g, true
+}
+
+// ThreadGroup returns the name of the resource group that the thread of the connection given is running in.
+func (r *ResourceGroupRegistry) ThreadGroup(connID uint32) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.threadGroup(connID).Name
+}
+
+func (r *ResourceGroupRegistry) threadGroup(connID uint32) *ResourceGroup {
+	if name, ok := r.statements[connID]; ok {
+		return r.groups[name]
+	}
+	return r.sessionGroup(connID)
+}
+
+func (r *ResourceGroupRegistry) sessionGroup(connID uint32) *ResourceGroup {
+	if name, ok := r.sessions[connID]; ok {
+		return r.groups[name]
+	}
+	return r.groups[strings.ToLower(DefaultUserResourceGroup)]
+}
+
+// Create adds |group| to the registry. When no VCPUs are given, the group may use every CPU.
+func (r *ResourceGroupRegistry) Create(group ResourceGroup) error {
+	if len(group.VCPUs) == 0 {
+		group.VCPUs = []ResourceGroupVCPURange{{Start: 0, End: uint(runtime.NumCPU() - 1)}}
+	}
+	if err := validateResourceGroup(group); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := strings.ToLower(group.Name)
+	if _, ok := r.groups[key]; ok {
+		return ErrResourceGroupExists.New(group.Name)
+	}
+	group.VCPUs = append([]ResourceGroupVCPURange(nil), group.VCPUs...)
+	r.groups[key] = &group
+	return nil
+}
+
+// Alter applies |changes| to the resource group named. The threads of the group are reassigned to it, so that the
+// scheduler sees its new attributes, or are moved to the default group if it's disabled with FORCE.
+func (r *ResourceGroupRegistry) Alter(ctx *Context, name string, changes ResourceGroupChanges) error {
+	r.mu.Lock()
+	key := strings.ToLower(name)
+	g, ok := r.groups[key]
+	if !ok {
+		r.mu.Unlock()
+		return ErrResourceGroupNotExists.New(name)
+	}
+	if isDefaultResourceGroup(key) {
+		r.mu.Unlock()
+		return ErrResourceGroupOperationDisallowed.New("Alter", g.Name)
+	}
+
+	altered := *g
+	if changes.VCPUs != nil {
+		altered.VCPUs = append([]ResourceGroupVCPURange(nil), changes.VCPUs...)
+	}
+	if changes.ThreadPriority != nil {
+		altered.ThreadPriority = *changes.ThreadPriority
+	}
+	if changes.Enabled != nil {
+		altered.Enabled = *changes.Enabled
+	}
+	if err := validateResourceGroup(altered); err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	threads := r.threadsOf(key)
+	if !altered.Enabled && len(threads) > 0 && !changes.Force {
+		r.mu.Unlock()
+		return ErrResourceGroupBusy.New(g.Name)
+	}
+
+	*g = altered
+	if !altered.Enabled {
+		r.unassign(key)
+	}
+	assignments := r.assignments(threads)
+	r.mu.Unlock()
+	return r.schedule(ctx, assignments)
+}
+
+// Drop removes the resource group named. A group that threads are assigned to can only be dropped with |force|,
+// which moves them to the default group.
+func (r *ResourceGroupRegistry) Drop(ctx *Context, name string, force bool) error {
+	r.mu.Lock()
+	key := strings.ToLower(name)
+	g, ok := r.groups[key]
+	if !ok {
+		r.mu.Unlock()
+		return ErrResourceGroupNotExists.New(name)
+	}
+	if isDefaultResourceGroup(key) {
+		r.mu.Unlock()
+		return ErrResourceGroupOperationDisallowed.New("Drop", g.Name)
+	}
+	threads := r.threadsOf(key)
+	if len(threads) > 0 && !force {
+		r.mu.Unlock()
+		return ErrResourceGroupBusy.New(g.Name)
+	}
+
+	r.unassign(key)
+	delete(r.groups, key)
+	assignments := r.assignments(threads)
+	r.mu.Unlock()
+	return r.schedule(ctx, assignments)
+}
+
+// AssignSessions assigns the threads of the connections given to the resource group named, for the rest of their
+// sessions.
+func (r *ResourceGroupRegistry) AssignSessions(ctx *Context, name string, connIDs []uint32) error {
+	r.mu.Lock()
+	key := strings.ToLower(name)
+	if err := r.checkAssignable(key, name, connIDs); err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	for _, id := range connIDs {
+		if key == strings.ToLower(DefaultUserResourceGroup) {
+			delete(r.sessions, id)
+		} else {
+			r.sessions[id] = key
+		}
+	}
+	assignments := r.assignments(connIDs)
+	r.mu.Unlock()
+	return r.schedule(ctx, assignments)
+}
+
+// AssignStatement assigns the thread of the session of |ctx| to the resource group named for a single statement. It
+// returns a function that moves the thread back to the group of its session once the statement completes.
+func (r *ResourceGroupRegistry) AssignStatement(ctx *Context, name string) (func(*Context) error, error) {
+	connID := ctx.Session.ID()
+	r.mu.Lock()
+	key := strings.ToLower(name)
+	if err := r.checkAssignable(key, name, []uint32{connID}); err != nil {
+		r.mu.Unlock()
+		return nil, err
+	}
+	r.statements[connID] = key
+	assignments := r.assignments([]uint32{connID})
+	r.mu.Unlock()
+
+	restore := func(ctx *Context) error {
+		r.mu.Lock()
+		if r.statements[connID] != key {
+			r.mu.Unlock()
+			return nil
+		}
+		delete(r.statements, connID)
+		assignments := r.assignments([]uint32{connID})
+		r.mu.Unlock()
+		return r.schedule(ctx, assignments)
+	}
+	if err := r.schedule(ctx, assignments); err != nil {
+		restore(ctx)
+		return nil, err
+	}
+	return restore, nil
+}
+
+// ReleaseConnection forgets the resource group assignments of the connection given, once it's closed.
+func (r *ResourceGroupRegistry) ReleaseConnection(connID uint32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, connID)
+	delete(r.statements, connID)
+}
+
+// checkAssignable returns an error if the user threads of |connIDs| can't be assigned to the group with the lower case
+// name |key|.
+func (r *ResourceGroupRegistry) checkAssignable(key, name string, connIDs []uint32) error {
+	g, ok := r.groups[key]
+	if !ok {
+		return ErrResourceGroupNotExists.New(name)
+	}
+	if !g.Enabled {
+		return ErrResourceGroupDisabled.New(g.Name)
+	}
+	if g.Type == ResourceGroupType_System && len(connIDs) > 0 {
+		return ErrResourceGroupBindFailed.New(g.Name, connIDs[0], "System resource group can't be bound with a session thread")
+	}
+	return nil
+}
+
+// threadsOf returns the connections whose threads are assigned to the group with the lower case name |key|, in order.
+func (r *ResourceGroupRegistry) threadsOf(key string) []uint32 {
+	seen := make(map[uint32]bool)
+	var threads []uint32
+	for _, m := range []map[uint32]string{r.sessions, r.statements} {
+		for id, name := range m {
+			if name == key && !seen[id] {
+				seen[id] = true
+				threads = append(threads, id)
+			}
+		}
+	}
+	sort.Slice(threads, func(i, j int) bool { return threads[i] < threads[j] })
+	return threads
+}
+
+// unassign moves every thread assigned to the group with the lower case name |key| to the default group.
+func (r *ResourceGroupRegistry) unassign(key string) {
+	for _, m := range []map[uint32]string{r.sessions, r.statements} {
+		for id, name := range m {
+			if name == key {
+				delete(m, id)
+			}
+		}
+	}
+}
+
+type resourceGroupAssignment struct {
+	connID uint32
+	group  ResourceGroup
+}
+
+// assignments returns the groups that the threads of |connIDs| are running in, to be passed to the scheduler once the
+// registry is unlocked.
+func (r *ResourceGroupRegistry) assignments(connIDs []uint32) []resourceGroupAssignment {
+	if r.Scheduler == nil {
+		return nil
+	}
+	assignments := make([]resourceGroupAssignment, len(connIDs))
+	for i, id := range connIDs {
+		g := *r.threadGroup(id)
+		g.VCPUs = append([]ResourceGroupVCPURange(nil), g.VCPUs...)
+		assignments[i] = resourceGroupAssignment{connID: id, group: g}
+	}
+	return assignments
+}
+
+// schedule passes |assignments| to the scheduler, if there is one.
+func (r *ResourceGroupRegistry) schedule(ctx *Context, assignments []resourceGroupAssignment) error {
+	if r.Scheduler == nil {
+		return nil
+	}
+	for _, a := range assignments {
+		if err := r.Scheduler.AssignResourceGroup(ctx, a.connID, a.group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isDefaultResourceGroup(key string) bool {
+	return key == strings.ToLower(DefaultUserResourceGroup) || key == strings.ToLower(DefaultSystemResourceGroup)
+}
+
+// validateResourceGroup returns an error if the VCPUs or the thread priority of |group| are invalid.
+func validateResourceGroup(group ResourceGroup) error {
+	numCPU := uint(runtime.NumCPU())
+	for _, r := range group.VCPUs {
+		if r.Start > r.End {
+			return ErrInvalidVCPURange.New(r.String())
+		}
+		if r.End >= numCPU {
+			return ErrInvalidVCPUId.New(r.End)
+		}
+	}
+	lo, hi := group.Type.priorityRange()
+	if group.ThreadPriority < lo || group.ThreadPriority > hi {
+		return ErrInvalidThreadPriority.New(group.ThreadPriority, strings.ToLower(group.Type.String()), group.Name, lo, hi)
+	}
+	return nil
+}
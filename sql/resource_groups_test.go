@@ -0,0 +1,111 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type resourceGroupAssignmentRecord struct {
+	connID uint32
+	group  ResourceGroup
+}
+
+type recordingResourceGroupScheduler struct {
+	assignments []resourceGroupAssignmentRecord
+}
+
+func (s *recordingResourceGroupScheduler) AssignResourceGroup(ctx *Context, connID uint32, group ResourceGroup) error {
+	s.assignments = append(s.assignments, resourceGroupAssignmentRecord{connID: connID, group: group})
+	return nil
+}
+
+func TestResourceGroupRegistry(t *testing.T) {
+	r := NewResourceGroupRegistry()
+	scheduler := &recordingResourceGroupScheduler{}
+	r.Scheduler = scheduler
+	sess := NewBaseSessionWithClientServer("address", Client{Address: "localhost", User: "root"}, 1)
+	ctx := NewContext(context.Background(), WithSession(sess))
+
+	var names []string
+	for _, g := range r.Groups() {
+		names = append(names, g.Name)
+	}
+	require.Equal(t, []string{DefaultSystemResourceGroup, DefaultUserResourceGroup}, names)
+	require.Equal(t, DefaultUserResourceGroup, r.ThreadGroup(1))
+
+	batch := ResourceGroup{
+		Name:           "Batch",
+		Type:           ResourceGroupType_User,
+		Enabled:        true,
+		VCPUs:          []ResourceGroupVCPURange{{Start: 0, End: 0}},
+		ThreadPriority: 10,
+	}
+	require.NoError(t, r.Create(batch))
+	require.True(t, ErrResourceGroupExists.Is(r.Create(ResourceGroup{Name: "BATCH"})))
+	err := r.Create(ResourceGroup{Name: "bad", Type: ResourceGroupType_User, ThreadPriority: -1})
+	require.True(t, ErrInvalidThreadPriority.Is(err))
+	err = r.Create(ResourceGroup{Name: "bad", VCPUs: []ResourceGroupVCPURange{{Start: 1, End: 0}}})
+	require.True(t, ErrInvalidVCPURange.Is(err))
+	g, ok := r.Group("batch")
+	require.True(t, ok)
+	require.Equal(t, batch, g)
+
+	// assigning a session passes the attributes of its group to the scheduler
+	require.NoError(t, r.AssignSessions(ctx, "batch", []uint32{1, 2}))
+	require.Equal(t, "Batch", r.ThreadGroup(1))
+	require.Equal(t, "Batch", r.ThreadGroup(2))
+	require.Equal(t, []resourceGroupAssignmentRecord{{connID: 1, group: batch}, {connID: 2, group: batch}}, scheduler.assignments)
+	scheduler.assignments = nil
+
+	// a statement runs in the group of its hint, and then returns to the group of its session
+	restore, err := r.AssignStatement(ctx, DefaultUserResourceGroup)
+	require.NoError(t, err)
+	require.Equal(t, DefaultUserResourceGroup, r.ThreadGroup(1))
+	require.NoError(t, restore(ctx))
+	require.Equal(t, "Batch", r.ThreadGroup(1))
+	require.Len(t, scheduler.assignments, 2)
+	require.Equal(t, DefaultUserResourceGroup, scheduler.assignments[0].group.Name)
+	require.Equal(t, batch, scheduler.assignments[1].group)
+	scheduler.assignments = nil
+
+	_, err = r.AssignStatement(ctx, DefaultSystemResourceGroup)
+	require.True(t, ErrResourceGroupBindFailed.Is(err))
+	require.True(t, ErrResourceGroupNotExists.Is(r.AssignSessions(ctx, "missing", []uint32{1})))
+
+	// altering a group reassigns its threads with the new attributes
+	priority := 5
+	require.NoError(t, r.Alter(ctx, "batch", ResourceGroupChanges{ThreadPriority: &priority}))
+	require.Len(t, scheduler.assignments, 2)
+	require.Equal(t, 5, scheduler.assignments[0].group.ThreadPriority)
+	scheduler.assignments = nil
+
+	disabled := false
+	require.True(t, ErrResourceGroupBusy.Is(r.Alter(ctx, "batch", ResourceGroupChanges{Enabled: &disabled})))
+	require.NoError(t, r.Alter(ctx, "batch", ResourceGroupChanges{Enabled: &disabled, Force: true}))
+	require.Equal(t, DefaultUserResourceGroup, r.ThreadGroup(1))
+	require.Equal(t, DefaultUserResourceGroup, scheduler.assignments[0].group.Name)
+	require.True(t, ErrResourceGroupDisabled.Is(r.AssignSessions(ctx, "batch", []uint32{1})))
+
+	require.True(t, ErrResourceGroupOperationDisallowed.Is(r.Drop(ctx, DefaultUserResourceGroup, true)))
+	require.True(t, ErrResourceGroupOperationDisallowed.Is(r.Alter(ctx, DefaultSystemResourceGroup, ResourceGroupChanges{ThreadPriority: &priority})))
+	require.NoError(t, r.Drop(ctx, "batch", false))
+	_, ok = r.Group("batch")
+	require.False(t, ok)
+	require.True(t, ErrResourceGroupNotExists.Is(r.Drop(ctx, "batch", false)))
+}
@@ -238,6 +238,95 @@ func (i *groupByGroupingIter) Dispose() {
 	}
 }
 
+// groupBySortedIter groups the rows of a child iterator that's sorted by the grouping expressions. Each group is
+// aggregated as its rows are read, and returned when the first row of the next group is read, so only one group is
+// kept in memory.
+type groupBySortedIter struct {
+	selectedExprs []sql.Expression
+	groupByExprs  []sql.Expression
+	child         sql.RowIter
+	buf           []sql.AggregationBuffer
+	key           uint64
+	done          bool
+}
+
+func newGroupBySortedIter(selectedExprs, groupByExprs []sql.Expression, child sql.RowIter) *groupBySortedIter {
+	return &groupBySortedIter{
+		selectedExprs: selectedExprs,
+		groupByExprs:  groupByExprs,
+		child:         child,
+	}
+}
+
+func (i *groupBySortedIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for !i.done {
+		row, err := i.child.Next(ctx)
+		if err == io.EOF {
+			i.done = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := groupingKey(ctx, i.groupByExprs, row)
+		if err != nil {
+			return nil, err
+		}
+
+		var result sql.Row
+		if i.buf != nil && key != i.key {
+			result, err = evalBuffers(ctx, i.buf)
+			if err != nil {
+				return nil, err
+			}
+			i.Dispose()
+			i.buf = nil
+		}
+
+		if i.buf == nil {
+			i.buf = make([]sql.AggregationBuffer, len(i.selectedExprs))
+			for j, a := range i.selectedExprs {
+				i.buf[j], err = newAggregationBuffer(a)
+				if err != nil {
+					return nil, err
+				}
+			}
+			i.key = key
+		}
+
+		if err := updateBuffers(ctx, i.buf, row); err != nil {
+			return nil, err
+		}
+		if result != nil {
+			return result, nil
+		}
+	}
+
+	if i.buf == nil {
+		return nil, io.EOF
+	}
+	row, err := evalBuffers(ctx, i.buf)
+	if err != nil {
+		return nil, err
+	}
+	i.Dispose()
+	i.buf = nil
+	return row, nil
+}
+
+func (i *groupBySortedIter) Close(ctx *sql.Context) error {
+	i.Dispose()
+	i.buf = nil
+	return i.child.Close(ctx)
+}
+
+func (i *groupBySortedIter) Dispose() {
+	for _, b := range i.buf {
+		b.Dispose()
+	}
+}
+
 func groupingKey(
 	ctx *sql.Context,
 	exprs []sql.Expression,
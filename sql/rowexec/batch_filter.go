@@ -0,0 +1,431 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// The value of a filter condition for a single row of a batch.
+const (
+	batchFalse byte = iota
+	batchTrue
+	batchNull
+)
+
+// batchPredicate evaluates a filter condition for every row of |batch|, writing the value for each row to |out|. It
+// returns false, leaving |out| undefined, when the batch holds a value the predicate wasn't compiled for, in which
+// case the condition must be evaluated one row at a time instead.
+type batchPredicate func(batch *sql.RowBatch, out []byte) bool
+
+// batchNumber is a type that numeric comparisons are evaluated in.
+type batchNumber interface {
+	int64 | uint64 | float64
+}
+
+// buildBatchFilter returns an iterator that evaluates the condition of |n| a batch of rows at a time, when the child
+// of |n| is a scan of a sql.BatchTable and the condition can be compiled into a batchPredicate. It returns false
+// otherwise, and the filter must be built as a FilterIter.
+func (b *BaseBuilder) buildBatchFilter(ctx *sql.Context, n *plan.Filter) (sql.RowIter, bool, error) {
+	if ctx.ExecStats() != nil {
+		// EXPLAIN ANALYZE reports the rows of the table scan, which a batch filter doesn't produce
+		return nil, false, nil
+	}
+	child := n.Child
+	if ta, ok := child.(*plan.TableAlias); ok {
+		child = ta.Child
+	}
+	rt, ok := child.(*plan.ResolvedTable)
+	if !ok {
+		return nil, false, nil
+	}
+	if _, ok := plan.FindVirtualColumnTable(rt.Table); ok {
+		return nil, false, nil
+	}
+	if len(timezoneColumns(rt.Schema())) > 0 {
+		return nil, false, nil
+	}
+
+	var table sql.BatchTable
+	switch t := rt.Table.(type) {
+	case *plan.ProcessTable:
+		table, ok = t.BatchTable()
+	case sql.BatchTable:
+		table = t
+	}
+	if table == nil {
+		return nil, false, nil
+	}
+
+	pred, ok := compileBatchPredicate(n.Expression)
+	if !ok {
+		return nil, false, nil
+	}
+
+	partitions, err := rt.Table.Partitions(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &batchFilterIter{
+		cond:    n.Expression,
+		pred:    pred,
+		batches: sql.NewTableBatchIter(table, partitions),
+	}, true, nil
+}
+
+// batchFilterIter is the batch version of plan.FilterIter. It evaluates its condition over each batch of rows with a
+// batchPredicate and returns the matching rows one at a time, so the nodes above it are unaware of the batches.
+// Batches that the predicate can't handle are filtered one row at a time.
+type batchFilterIter struct {
+	cond    sql.Expression
+	pred    batchPredicate
+	batches sql.BatchRowIter
+	batch   *sql.RowBatch
+	truth   []byte
+	rowWise bool
+	pos     int
+}
+
+var _ sql.RowIter = (*batchFilterIter)(nil)
+
+// Next implements the sql.RowIter interface.
+func (i *batchFilterIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if i.batch == nil || i.pos >= i.batch.Len {
+			if err := i.nextBatch(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		pos := i.pos
+		i.pos++
+
+		if i.rowWise {
+			row := i.batch.Row(pos)
+			res, err := sql.EvaluateCondition(ctx, i.cond, row)
+			if err != nil {
+				return nil, err
+			}
+			if sql.IsTrue(res) {
+				return row, nil
+			}
+		} else if i.truth[pos] == batchTrue {
+			return i.batch.Row(pos), nil
+		}
+	}
+}
+
+// nextBatch reads the next batch and evaluates the predicate over it.
+func (i *batchFilterIter) nextBatch(ctx *sql.Context) error {
+	batch, err := i.batches.NextBatch(ctx)
+	if err != nil {
+		return err
+	}
+	i.batch = batch
+	i.pos = 0
+	if cap(i.truth) < batch.Len {
+		i.truth = make([]byte, batch.Len)
+	}
+	i.truth = i.truth[:batch.Len]
+	i.rowWise = !i.pred(batch, i.truth)
+	return nil
+}
+
+// Close implements the sql.RowIter interface.
+func (i *batchFilterIter) Close(ctx *sql.Context) error {
+	return i.batches.Close(ctx)
+}
+
+// compileBatchPredicate compiles |e| into a batchPredicate with the same result for every row as evaluating |e|
+// against the row. Only AND, OR, NOT, IS NULL and comparisons between integer and floating point columns and literals
+// are supported, and false is returned for any other expression.
+func compileBatchPredicate(e sql.Expression) (batchPredicate, bool) {
+	switch e := e.(type) {
+	case *expression.And:
+		return compileBatchLogic(e.LeftChild, e.RightChild, andTruth)
+	case *expression.Or:
+		return compileBatchLogic(e.LeftChild, e.RightChild, orTruth)
+	case *expression.Not:
+		child, ok := compileBatchPredicate(e.Child)
+		if !ok {
+			return nil, false
+		}
+		return func(batch *sql.RowBatch, out []byte) bool {
+			if !child(batch, out) {
+				return false
+			}
+			for i, t := range out {
+				switch t {
+				case batchTrue:
+					out[i] = batchFalse
+				case batchFalse:
+					out[i] = batchTrue
+				}
+			}
+			return true
+		}, true
+	case *expression.IsNull:
+		gf, ok := e.Child.(*expression.GetField)
+		if !ok {
+			return nil, false
+		}
+		col := gf.Index()
+		return func(batch *sql.RowBatch, out []byte) bool {
+			if col >= len(batch.Columns) {
+				return false
+			}
+			for i, v := range batch.Columns[col][:batch.Len] {
+				out[i] = batchFalse
+				if v == nil {
+					out[i] = batchTrue
+				}
+			}
+			return true
+		}, true
+	case *expression.Equals:
+		return compileBatchComparison(e, func(c int) bool { return c == 0 })
+	case *expression.GreaterThan:
+		return compileBatchComparison(e, func(c int) bool { return c == 1 })
+	case *expression.GreaterThanOrEqual:
+		return compileBatchComparison(e, func(c int) bool { return c > -1 })
+	case *expression.LessThan:
+		return compileBatchComparison(e, func(c int) bool { return c == -1 })
+	case *expression.LessThanOrEqual:
+		return compileBatchComparison(e, func(c int) bool { return c < 1 })
+	}
+	return nil, false
+}
+
+// andTruth is the value of AND for the values of its operands.
+func andTruth(l, r byte) byte {
+	switch {
+	case l == batchFalse || r == batchFalse:
+		return batchFalse
+	case l == batchNull || r == batchNull:
+		return batchNull
+	}
+	return batchTrue
+}
+
+// orTruth is the value of OR for the values of its operands.
+func orTruth(l, r byte) byte {
+	switch {
+	case l == batchTrue || r == batchTrue:
+		return batchTrue
+	case l == batchNull || r == batchNull:
+		return batchNull
+	}
+	return batchFalse
+}
+
+// compileBatchLogic compiles a binary logical operator, whose value is |combine| of the values of |left| and |right|.
+func compileBatchLogic(left, right sql.Expression, combine func(l, r byte) byte) (batchPredicate, bool) {
+	l, ok := compileBatchPredicate(left)
+	if !ok {
+		return nil, false
+	}
+	r, ok := compileBatchPredicate(right)
+	if !ok {
+		return nil, false
+	}
+	var rightOut []byte
+	return func(batch *sql.RowBatch, out []byte) bool {
+		if cap(rightOut) < len(out) {
+			rightOut = make([]byte, len(out))
+		}
+		rightOut = rightOut[:len(out)]
+		if !l(batch, out) || !r(batch, rightOut) {
+			return false
+		}
+		for i := range out {
+			out[i] = combine(out[i], rightOut[i])
+		}
+		return true
+	}, true
+}
+
+// compileBatchComparison compiles the comparison |cmp|, which is true when |op| is true for the result of comparing
+// its operands. The operands are compared in the same type that the comparison uses when it's evaluated for a row.
+func compileBatchComparison(cmp expression.Comparer, op func(c int) bool) (batchPredicate, bool) {
+	lt, rt := cmp.Left().Type(), cmp.Right().Type()
+	if !isBatchNumber(lt) || !isBatchNumber(rt) {
+		return nil, false
+	}
+
+	// Mirrors the numeric cases of the comparison's Compare: operands of the same type are compared in that type,
+	// and otherwise in a type that both are converted to.
+	var signed, unsigned bool
+	if types.TypesEqual(lt, rt) {
+		signed, unsigned = types.IsSigned(lt), types.IsUnsigned(lt)
+	} else if !types.IsFloat(lt) && !types.IsFloat(rt) {
+		signed = types.IsSigned(lt) && types.IsSigned(rt)
+		unsigned = types.IsUnsigned(lt) && types.IsUnsigned(rt)
+	}
+
+	switch {
+	case signed:
+		return compileTypedComparison(cmp, batchInt64Value, types.Int64, op)
+	case unsigned:
+		return compileTypedComparison(cmp, batchUint64Value, types.Uint64, op)
+	default:
+		return compileTypedComparison(cmp, batchFloat64Value, types.Float64, op)
+	}
+}
+
+// isBatchNumber returns whether values of |t| can be compared in a batch kernel.
+func isBatchNumber(t sql.Type) bool {
+	return types.IsInteger(t) || types.IsFloat(t)
+}
+
+// batchOperand is an operand of a comparison compiled for batches: either a column of the batch, or a constant.
+type batchOperand[T batchNumber] struct {
+	col   int
+	value T
+}
+
+// compileBatchOperand compiles |e| as an operand of a comparison in the type |typ|, whose values are converted from
+// the values of |e| with |convert|.
+func compileBatchOperand[T batchNumber](e sql.Expression, convert func(interface{}) (T, bool), typ sql.Type) (batchOperand[T], bool) {
+	switch e := e.(type) {
+	case *expression.GetField:
+		return batchOperand[T]{col: e.Index()}, true
+	case *expression.Literal:
+		v, _, err := typ.Convert(e.Value())
+		if err != nil || v == nil {
+			return batchOperand[T]{}, false
+		}
+		value, ok := convert(v)
+		return batchOperand[T]{col: -1, value: value}, ok
+	}
+	return batchOperand[T]{}, false
+}
+
+// compileTypedComparison compiles |cmp| as a comparison of values converted to |typ| with |convert|.
+func compileTypedComparison[T batchNumber](cmp expression.Comparer, convert func(interface{}) (T, bool), typ sql.Type, op func(c int) bool) (batchPredicate, bool) {
+	left, ok := compileBatchOperand(cmp.Left(), convert, typ)
+	if !ok {
+		return nil, false
+	}
+	right, ok := compileBatchOperand(cmp.Right(), convert, typ)
+	if !ok {
+		return nil, false
+	}
+
+	// The result of each comparison is precomputed for every three-way comparison result
+	var truth [3]byte
+	for c := -1; c <= 1; c++ {
+		truth[c+1] = batchFalse
+		if op(c) {
+			truth[c+1] = batchTrue
+		}
+	}
+
+	return func(batch *sql.RowBatch, out []byte) bool {
+		if left.col >= len(batch.Columns) || right.col >= len(batch.Columns) {
+			return false
+		}
+		for i := 0; i < batch.Len; i++ {
+			l, r := left.value, right.value
+			if left.col >= 0 {
+				v := batch.Columns[left.col][i]
+				if v == nil {
+					out[i] = batchNull
+					continue
+				}
+				if l, ok = convert(v); !ok {
+					return false
+				}
+			}
+			if right.col >= 0 {
+				v := batch.Columns[right.col][i]
+				if v == nil {
+					out[i] = batchNull
+					continue
+				}
+				if r, ok = convert(v); !ok {
+					return false
+				}
+			}
+			out[i] = truth[compareBatchNumbers(l, r)+1]
+		}
+		return true
+	}, true
+}
+
+// compareBatchNumbers compares |l| and |r| in the same way as types.NumberTypeImpl_.Compare.
+func compareBatchNumbers[T batchNumber](l, r T) int {
+	if l == r {
+		return 0
+	}
+	if l < r {
+		return -1
+	}
+	return +1
+}
+
+// batchInt64Value converts |v| to an int64, or returns false if it's not a signed integer.
+func batchInt64Value(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// batchUint64Value converts |v| to a uint64, or returns false if it's not an unsigned integer.
+func batchUint64Value(v interface{}) (uint64, bool) {
+	switch v := v.(type) {
+	case uint8:
+		return uint64(v), true
+	case uint16:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	case uint:
+		return uint64(v), true
+	}
+	return 0, false
+}
+
+// batchFloat64Value converts |v| to a float64, or returns false if it's not an integer or a floating point number.
+func batchFloat64Value(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	}
+	if i, ok := batchInt64Value(v); ok {
+		return float64(i), true
+	}
+	if u, ok := batchUint64Value(v); ok {
+		return float64(u), true
+	}
+	return 0, false
+}
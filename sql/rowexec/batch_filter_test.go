@@ -0,0 +1,225 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var batchFilterSchema = sql.Schema{
+	{Name: "i8", Type: types.Int8, Nullable: true},
+	{Name: "i32", Type: types.Int32, Nullable: true},
+	{Name: "i64", Type: types.Int64, Nullable: true},
+	{Name: "u32", Type: types.Uint32, Nullable: true},
+	{Name: "u64", Type: types.Uint64, Nullable: true},
+	{Name: "f32", Type: types.Float32, Nullable: true},
+	{Name: "f64", Type: types.Float64, Nullable: true},
+	{Name: "s", Type: types.Text, Nullable: true},
+}
+
+func batchFilterField(name string) *expression.GetField {
+	idx := batchFilterSchema.IndexOfColName(name)
+	return expression.NewGetField(idx, batchFilterSchema[idx].Type, name, true)
+}
+
+// batchFilterRow returns a row of random values for batchFilterSchema, with NULLs and extreme values.
+func batchFilterRow(r *rand.Rand) sql.Row {
+	row := sql.Row{
+		int8(r.Intn(21) - 10),
+		int32(r.Intn(2001) - 1000),
+		r.Int63n(2001) - 1000,
+		uint32(r.Intn(2001)),
+		uint64(r.Intn(2001)),
+		float32(r.Intn(2001)-1000) / 4,
+		float64(r.Intn(2001)-1000) / 4,
+		fmt.Sprint(r.Intn(100)),
+	}
+	for i := range row {
+		switch r.Intn(20) {
+		case 0:
+			row[i] = nil
+		case 1:
+			switch v := row[i].(type) {
+			case int64:
+				row[i] = v * math.MaxInt32 * 4
+			case uint64:
+				row[i] = uint64(math.MaxUint64) - v
+			case float64:
+				row[i] = math.NaN()
+			}
+		}
+	}
+	return row
+}
+
+func TestBatchFilter(t *testing.T) {
+	db := memory.NewDatabase("test")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+
+	table := memory.NewTable(db.BaseDatabase, "test", sql.NewPrimaryKeySchema(batchFilterSchema), nil)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 3*sql.DefaultBatchSize+17; i++ {
+		require.NoError(t, table.Insert(ctx, batchFilterRow(r)))
+	}
+
+	tests := []struct {
+		cond     sql.Expression
+		compiled bool
+	}{
+		{
+			cond:     expression.NewGreaterThan(batchFilterField("i32"), expression.NewLiteral(int8(10), types.Int8)),
+			compiled: true,
+		},
+		{
+			cond: expression.NewAnd(
+				expression.NewGreaterThan(batchFilterField("i32"), expression.NewLiteral(int8(10), types.Int8)),
+				expression.NewLessThan(batchFilterField("i64"), expression.NewLiteral(int8(100), types.Int8)),
+			),
+			compiled: true,
+		},
+		{
+			cond: expression.NewOr(
+				expression.NewLessThanOrEqual(batchFilterField("i8"), expression.NewLiteral(int8(-5), types.Int8)),
+				expression.NewNot(expression.NewEquals(batchFilterField("u32"), expression.NewLiteral(int16(500), types.Int16))),
+			),
+			compiled: true,
+		},
+		{
+			cond:     expression.NewGreaterThanOrEqual(batchFilterField("u64"), expression.NewLiteral(uint64(1000), types.Uint64)),
+			compiled: true,
+		},
+		{
+			cond:     expression.NewLessThan(batchFilterField("u64"), batchFilterField("i64")),
+			compiled: true,
+		},
+		{
+			cond:     expression.NewEquals(batchFilterField("f32"), batchFilterField("f64")),
+			compiled: true,
+		},
+		{
+			cond:     expression.NewGreaterThan(batchFilterField("f64"), expression.NewLiteral(int32(-100), types.Int32)),
+			compiled: true,
+		},
+		{
+			cond:     expression.NewLessThan(batchFilterField("i32"), batchFilterField("u32")),
+			compiled: true,
+		},
+		{
+			cond: expression.NewOr(
+				expression.NewIsNull(batchFilterField("f64")),
+				expression.NewNot(expression.NewIsNull(batchFilterField("i8"))),
+			),
+			compiled: true,
+		},
+		{
+			cond:     expression.NewEquals(batchFilterField("s"), expression.NewLiteral("42", types.LongText)),
+			compiled: false,
+		},
+		{
+			cond: expression.NewAnd(
+				expression.NewGreaterThan(batchFilterField("i32"), expression.NewLiteral(int8(10), types.Int8)),
+				expression.NewLessThan(batchFilterField("f64"), expression.NewLiteral("2.5", types.LongText)),
+			),
+			compiled: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cond.String(), func(t *testing.T) {
+			_, ok := compileBatchPredicate(tt.cond)
+			require.Equal(t, tt.compiled, ok)
+
+			partitions, err := table.Partitions(ctx)
+			require.NoError(t, err)
+			expected, err := sql.RowIterToRows(ctx, plan.NewFilterIter(tt.cond, sql.NewTableRowIter(ctx, table, partitions)))
+			require.NoError(t, err)
+
+			iter, err := DefaultBuilder.Build(ctx, plan.NewFilter(tt.cond, plan.NewResolvedTable(table, nil, nil)), nil)
+			require.NoError(t, err)
+			actual, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+
+			require.NotEmpty(t, expected)
+			require.Equal(t, fmt.Sprint(expected), fmt.Sprint(actual))
+		})
+	}
+}
+
+func BenchmarkBatchFilter(b *testing.B) {
+	const numRows = 10_000_000
+
+	db := memory.NewDatabase("test")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+
+	table := memory.NewTable(db.BaseDatabase, "test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int64},
+		{Name: "b", Type: types.Int64},
+	}), nil)
+	inserter := table.Inserter(ctx)
+	for i := 0; i < numRows; i++ {
+		require.NoError(b, inserter.Insert(ctx, sql.NewRow(int64(i%1000), int64(i%150))))
+	}
+	require.NoError(b, inserter.Close(ctx))
+
+	cond := expression.NewAnd(
+		expression.NewGreaterThan(
+			expression.NewGetField(0, types.Int64, "a", false),
+			expression.NewLiteral(int8(10), types.Int8)),
+		expression.NewLessThan(
+			expression.NewGetField(1, types.Int64, "b", false),
+			expression.NewLiteral(int8(100), types.Int8)),
+	)
+
+	count := func(b *testing.B, iter sql.RowIter) {
+		for {
+			_, err := iter.Next(ctx)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(b, err)
+		}
+		require.NoError(b, iter.Close(ctx))
+	}
+
+	b.Run("row", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			partitions, err := table.Partitions(ctx)
+			require.NoError(b, err)
+			count(b, plan.NewFilterIter(cond, sql.NewTableRowIter(ctx, table, partitions)))
+		}
+	})
+
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			iter, err := DefaultBuilder.Build(ctx, plan.NewFilter(cond, plan.NewResolvedTable(table, nil, nil)), nil)
+			require.NoError(b, err)
+			count(b, iter)
+		}
+	})
+}
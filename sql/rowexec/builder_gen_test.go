@@ -32,6 +32,7 @@ func TestGenBuilder(t *testing.T) {
 		"dummyNode":                 "plan.dummyNode",
 		"UnresolvedTableFunction":   "*plan.UnresolvedTableFunction",
 		"AlterAutoIncrement":        "*plan.AlterAutoIncrement",
+		"RebuildTable":              "*plan.RebuildTable",
 		"CreateCheck":               "*plan.CreateCheck",
 		"DropCheck":                 "*plan.DropCheck",
 		"DropConstraint":            "*plan.DropConstraint",
@@ -60,6 +61,7 @@ func TestGenBuilder(t *testing.T) {
 		"CreateRole":                "*plan.CreateRole",
 		"CreateUser":                "*plan.CreateUser",
 		"CreateView":                "*plan.CreateView",
+		"AlterView":                 "*plan.AlterView",
 		"CreateDB":                  "*plan.CreateDB",
 		"DropDB":                    "*plan.DropDB",
 		"AlterDB":                   "*plan.AlterDB",
@@ -104,6 +106,7 @@ func TestGenBuilder(t *testing.T) {
 		"InsertInto":                "*plan.InsertInto",
 		"InsertDestination":         "*plan.InsertDestination",
 		"Into":                      "*plan.Into",
+		"GetDiagnostics":            "*plan.GetDiagnostics",
 		"Iterate":                   "*plan.Iterate",
 		"JoinNode":                  "*plan.JoinNode",
 		"JSONTable":                 "plan.JSONTable",
@@ -151,6 +154,13 @@ func TestGenBuilder(t *testing.T) {
 		"ShowIndexes":               "*plan.ShowIndexes",
 		"ShowPrivileges":            "*plan.ShowPrivileges",
 		"ShowReplicaStatus":         "*plan.ShowReplicaStatus",
+		"ShowReplicas":              "*plan.ShowReplicas",
+		"ShowBinaryLogs":            "*plan.ShowBinaryLogs",
+		"ShowBinlogEvents":          "*plan.ShowBinlogEvents",
+		"CreateResourceGroup":       "*plan.CreateResourceGroup",
+		"AlterResourceGroup":        "*plan.AlterResourceGroup",
+		"DropResourceGroup":         "*plan.DropResourceGroup",
+		"SetResourceGroup":          "*plan.SetResourceGroup",
 		"ShowStatus":                "*plan.ShowStatus",
 		"ShowTriggers":              "*plan.ShowTriggers",
 		"ShowColumns":               "*plan.ShowColumns",
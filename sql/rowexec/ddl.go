@@ -42,6 +42,15 @@ func (b *BaseBuilder) buildAlterAutoIncrement(ctx *sql.Context, n *plan.AlterAut
 	return sql.RowsToRowIter(), nil
 }
 
+func (b *BaseBuilder) buildRebuildTable(ctx *sql.Context, n *plan.RebuildTable, row sql.Row) (sql.RowIter, error) {
+	copied, err := b.executeRebuildTable(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(copied))), nil
+}
+
 func (b *BaseBuilder) buildDropTrigger(ctx *sql.Context, n *plan.DropTrigger, row sql.Row) (sql.RowIter, error) {
 	triggerDb, ok := n.Db.(sql.TriggerDatabase)
 	if !ok {
@@ -149,19 +158,6 @@ func (b *BaseBuilder) buildDropConstraint(ctx *sql.Context, n *plan.DropConstrai
 
 func (b *BaseBuilder) buildCreateView(ctx *sql.Context, n *plan.CreateView, row sql.Row) (sql.RowIter, error) {
 	registry := ctx.GetViewRegistry()
-	if n.IsReplace {
-		if dropper, ok := n.Database().(sql.ViewDatabase); ok {
-			err := dropper.DropView(ctx, n.Name)
-			if err != nil && !sql.ErrViewDoesNotExist.Is(err) {
-				return sql.RowsToRowIter(), err
-			}
-		} else {
-			err := registry.Delete(n.Database().Name(), n.Name)
-			if err != nil && !sql.ErrViewDoesNotExist.Is(err) {
-				return sql.RowsToRowIter(), err
-			}
-		}
-	}
 	names, err := n.Database().GetTableNames(ctx)
 	if err != nil {
 		return nil, err
@@ -172,16 +168,54 @@ func (b *BaseBuilder) buildCreateView(ctx *sql.Context, n *plan.CreateView, row
 		}
 	}
 
-	// TODO: isUpdatable should be defined at CREATE VIEW time
-	// isUpdatable := GetIsUpdatableFromCreateView(cv)
 	creator, ok := n.Database().(sql.ViewDatabase)
-	if ok {
+	if ok && n.IsReplace {
+		return rowIterWithOkResultWithZeroRowsAffected(), replaceView(ctx, creator, n)
+	} else if ok {
 		return rowIterWithOkResultWithZeroRowsAffected(), creator.CreateView(ctx, n.Name, n.Definition.TextDefinition, n.CreateViewString)
+	} else if n.IsReplace {
+		return rowIterWithOkResultWithZeroRowsAffected(), registry.Replace(n.Database().Name(), n.View())
 	} else {
 		return rowIterWithOkResultWithZeroRowsAffected(), registry.Register(n.Database().Name(), n.View())
 	}
 }
 
+func (b *BaseBuilder) buildAlterView(ctx *sql.Context, n *plan.AlterView, row sql.Row) (sql.RowIter, error) {
+	db, ok := n.Database().(sql.ViewDatabase)
+	if !ok {
+		return rowIterWithOkResultWithZeroRowsAffected(), ctx.GetViewRegistry().Alter(n.Database().Name(), n.View())
+	}
+	_, exists, err := db.GetViewDefinition(ctx, n.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, sql.ErrViewDoesNotExist.New(n.Database().Name(), n.Name)
+	}
+	return rowIterWithOkResultWithZeroRowsAffected(), replaceView(ctx, db, n.CreateView)
+}
+
+// replaceView creates the view of |n| in |db|, replacing the existing view with its name. If the new view can't be
+// created, the existing view is restored.
+func replaceView(ctx *sql.Context, db sql.ViewDatabase, n *plan.CreateView) error {
+	existing, exists, err := db.GetViewDefinition(ctx, n.Name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if err := db.DropView(ctx, n.Name); err != nil && !sql.ErrViewDoesNotExist.Is(err) {
+			return err
+		}
+	}
+	err = db.CreateView(ctx, n.Name, n.Definition.TextDefinition, n.CreateViewString)
+	if err != nil && exists {
+		if rerr := db.CreateView(ctx, existing.Name, existing.TextDefinition, existing.CreateViewStatement); rerr != nil {
+			return rerr
+		}
+	}
+	return err
+}
+
 func (b *BaseBuilder) buildCreateCheck(ctx *sql.Context, n *plan.CreateCheck, row sql.Row) (sql.RowIter, error) {
 	err := b.executeCreateCheck(ctx, n)
 	if err != nil {
@@ -1148,3 +1182,59 @@ func (b *BaseBuilder) buildCreateForeignKey(ctx *sql.Context, n *plan.CreateFore
 func rowIterWithOkResultWithZeroRowsAffected() sql.RowIter {
 	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0)))
 }
+
+func (b *BaseBuilder) buildCreateResourceGroup(ctx *sql.Context, n *plan.CreateResourceGroup, row sql.Row) (sql.RowIter, error) {
+	if n.Registry == nil {
+		return nil, plan.ErrNoResourceGroupRegistry.New()
+	}
+	if err := n.Registry.Create(n.Group); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+func (b *BaseBuilder) buildAlterResourceGroup(ctx *sql.Context, n *plan.AlterResourceGroup, row sql.Row) (sql.RowIter, error) {
+	if n.Registry == nil {
+		return nil, plan.ErrNoResourceGroupRegistry.New()
+	}
+	if err := n.Registry.Alter(ctx, n.Name, n.Changes); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+func (b *BaseBuilder) buildDropResourceGroup(ctx *sql.Context, n *plan.DropResourceGroup, row sql.Row) (sql.RowIter, error) {
+	if n.Registry == nil {
+		return nil, plan.ErrNoResourceGroupRegistry.New()
+	}
+	if err := n.Registry.Drop(ctx, n.Name, n.Force); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+// buildSetResourceGroup assigns the threads named to the resource group, or the thread of the current session if none
+// are named. Threads are identified by their connection ids.
+func (b *BaseBuilder) buildSetResourceGroup(ctx *sql.Context, n *plan.SetResourceGroup, row sql.Row) (sql.RowIter, error) {
+	if n.Registry == nil {
+		return nil, plan.ErrNoResourceGroupRegistry.New()
+	}
+	threadIDs := n.ThreadIDs
+	if len(threadIDs) == 0 {
+		threadIDs = []uint32{ctx.Session.ID()}
+	} else {
+		connections := make(map[uint32]bool)
+		for _, p := range ctx.ProcessList.Processes() {
+			connections[p.Connection] = true
+		}
+		for _, id := range threadIDs {
+			if !connections[id] {
+				return nil, sql.ErrResourceGroupBindFailed.New(n.Name, id, fmt.Sprintf("Invalid thread id (%d)", id))
+			}
+		}
+	}
+	if err := n.Registry.AssignSessions(ctx, n.Name, threadIDs); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
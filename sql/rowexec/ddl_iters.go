@@ -1280,6 +1280,15 @@ func (i *addColumnIter) UpdateRowsWithDefaults(ctx *sql.Context, table sql.Table
 			return err
 		}
 
+		r, err = rowToUTC(ctx, schema, r)
+		if err != nil {
+			return err
+		}
+		updatedRow, err = rowToUTC(ctx, schema, updatedRow)
+		if err != nil {
+			return err
+		}
+
 		err = updater.Update(ctx, r, updatedRow)
 		if err != nil {
 			return err
@@ -1907,6 +1916,9 @@ func (b *BaseBuilder) executeAlterIndex(ctx *sql.Context, n *plan.AlterIndex) er
 			}
 
 			if shouldRebuild || indexCreateRequiresBuild(n) {
+				if iblt, ok := canBulkLoadIndex(ibt, indexDef); ok {
+					return bulkLoadIndex(ctx, n, iblt, indexDef)
+				}
 				return buildIndex(ctx, n, ibt, indexDef)
 			}
 		}
@@ -2045,7 +2057,10 @@ func buildIndex(ctx *sql.Context, n *plan.AlterIndex, ibt sql.IndexBuildingTable
 	isVirtual := ibt.Schema().HasVirtualColumns()
 	var projections []sql.Expression
 	if isVirtual {
-		projections = virtualTableProjections(n.TargetSchema(), ibt.Name())
+		projections, err = virtualTableProjections(n.TargetSchema(), ibt.Name())
+		if err != nil {
+			return err
+		}
 	}
 
 	for {
@@ -2084,7 +2099,7 @@ func buildIndex(ctx *sql.Context, n *plan.AlterIndex, ibt sql.IndexBuildingTable
 // virtualTableProjections returns the projections for a virtual table with the schema and name provided.
 // Typically virtual tables have their projections applied by the analyzer and row executor process, but this is
 // equivalent when we need it at runtime.
-func virtualTableProjections(schema sql.Schema, tableName string) []sql.Expression {
+func virtualTableProjections(schema sql.Schema, tableName string) ([]sql.Expression, error) {
 	projections := make([]sql.Expression, len(schema))
 	for i, c := range schema {
 		if !c.Virtual {
@@ -2095,11 +2110,15 @@ func virtualTableProjections(schema sql.Schema, tableName string) []sql.Expressi
 		}
 	}
 
+	projections, err := transform.InlineVirtualColumns(schema, projections)
+	if err != nil {
+		return nil, err
+	}
 	for i, p := range projections {
 		projections[i] = assignColumnIndexes(p, schema)
 	}
 
-	return projections
+	return projections, nil
 }
 
 // assignColumnIndexes fixes the column indexes in the expression to match the schema given
@@ -2207,6 +2226,142 @@ func (b *BaseBuilder) executeAlterAutoInc(ctx *sql.Context, n *plan.AlterAutoInc
 	return setter.Close(ctx)
 }
 
+// executeRebuildTable rebuilds the table given and returns the number of rows rewritten. Tables that implement
+// sql.RebuildableTable rebuild themselves with the algorithm and lock they negotiate. Other tables are rebuilt by
+// copying every row through a rewrite, which validates each row against the table's constraints and regenerates its
+// indexes.
+func (b *BaseBuilder) executeRebuildTable(ctx *sql.Context, n *plan.RebuildTable) (int, error) {
+	table, err := getTableFromDatabase(ctx, n.Database(), n.Table)
+	if err != nil {
+		return 0, err
+	}
+
+	if rbt, ok := table.(sql.RebuildableTable); ok {
+		algorithm, lock, err := rbt.NegotiateRebuild(ctx, n.Algorithm, n.Lock)
+		if err != nil {
+			return 0, err
+		}
+		return rbt.RebuildTable(ctx, algorithm, lock)
+	}
+
+	// Copying the table requires writes to be blocked while the copy is made
+	switch n.Algorithm {
+	case sql.AlterAlgorithmInstant, sql.AlterAlgorithmInplace:
+		return 0, sql.ErrAlterAlgorithmNotSupported.New(n.Algorithm, sql.AlterAlgorithmCopy)
+	}
+	if n.Lock == sql.AlterLockNone {
+		return 0, sql.ErrAlterLockNotSupported.New(n.Lock, "COPY algorithm requires a lock", sql.AlterLockShared)
+	}
+
+	rwt, ok := table.(sql.RewritableTable)
+	if !ok {
+		// There's no storage that the engine can rebuild for other tables
+		return 0, nil
+	}
+
+	// The rewrite restarts the AUTO_INCREMENT sequence from the copied rows, so the next value is restored afterwards
+	var nextAutoInc uint64
+	if autoTbl, ok := table.(sql.AutoIncrementTable); ok && autoTbl.Schema().HasAutoIncrement() {
+		nextAutoInc, err = autoTbl.PeekNextAutoIncrementValue(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	sch := sql.SchemaToPrimaryKeySchema(rwt, rwt.Schema())
+	inserter, err := rwt.RewriteInserter(ctx, sch, sch, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	// Rows are read through the table node so that the progress of the copy is tracked in the process list
+	rowIter, err := b.buildNodeExec(ctx, n.Table, nil)
+	if err != nil {
+		_ = inserter.DiscardChanges(ctx, err)
+		_ = inserter.Close(ctx)
+		return 0, err
+	}
+
+	copied := 0
+	for {
+		row, err := rowIter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err == nil {
+			err = rebuildRow(ctx, n.Checks(), sch.Schema, inserter, row)
+		}
+		if err != nil {
+			_ = rowIter.Close(ctx)
+			_ = inserter.DiscardChanges(ctx, err)
+			_ = inserter.Close(ctx)
+			return 0, err
+		}
+		copied++
+	}
+
+	if err = rowIter.Close(ctx); err != nil {
+		_ = inserter.DiscardChanges(ctx, err)
+		_ = inserter.Close(ctx)
+		return 0, err
+	}
+	if err = inserter.Close(ctx); err != nil {
+		return 0, err
+	}
+
+	if nextAutoInc > 0 {
+		rebuilt, err := getTableFromDatabase(ctx, n.Database(), n.Table)
+		if err != nil {
+			return 0, err
+		}
+		if autoTbl, ok := rebuilt.(sql.AutoIncrementTable); ok {
+			setter := autoTbl.AutoIncrementSetter(ctx)
+			if err = setter.SetAutoIncrementValue(ctx, nextAutoInc); err != nil {
+				return 0, err
+			}
+			if err = setter.Close(ctx); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if hasFullText(ctx, rwt) {
+		if err = rebuildFullText(ctx, rwt.Name(), n.Database()); err != nil {
+			return 0, err
+		}
+	}
+	return copied, nil
+}
+
+// rebuildRow validates |row| against |checks| and writes it to |inserter| as it is stored for |sch|, unless the
+// statement has been killed.
+func rebuildRow(ctx *sql.Context, checks sql.CheckConstraints, sch sql.Schema, inserter sql.RowInserter, row sql.Row) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	for _, check := range checks {
+		if !check.Enforced {
+			continue
+		}
+		res, err := sql.EvaluateCondition(ctx, check.Expr, row)
+		if err != nil {
+			return err
+		}
+		if sql.IsFalse(res) {
+			return sql.ErrCheckConstraintViolated.New(check.Name)
+		}
+	}
+
+	row, err := rowToUTC(ctx, sch, row)
+	if err != nil {
+		return err
+	}
+	return inserter.Insert(ctx, row)
+}
+
 // hasFullText returns whether the given table has any Full-Text indexes.
 func hasFullText(ctx *sql.Context, tbl sql.Table) bool {
 	hasFT := false
@@ -92,6 +92,10 @@ func (d *deleteIter) Next(ctx *sql.Context) (sql.Row, error) {
 		if schemaLength < rowLength {
 			subSlice = row[(rowLength - fullSchemaLength + deleter.schemaStart):(rowLength - fullSchemaLength + deleter.schemaEnd)]
 		}
+		subSlice, err = rowToUTC(ctx, d.schema[deleter.schemaStart:deleter.schemaEnd], subSlice)
+		if err != nil {
+			return nil, err
+		}
 		err = deleter.deleter.Delete(ctx, subSlice)
 		if err != nil {
 			return nil, err
@@ -579,6 +579,8 @@ type updateSourceIter struct {
 	updateExprs []sql.Expression
 	tableSchema sql.Schema
 	ignore      bool
+	// rowNumber is the 1-based number of the row being updated, used in error messages
+	rowNumber int
 }
 
 func (u *updateSourceIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -587,7 +589,8 @@ func (u *updateSourceIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, err
 	}
 
-	newRow, err := applyUpdateExpressionsWithIgnore(ctx, u.updateExprs, u.tableSchema, oldRow, u.ignore)
+	u.rowNumber++
+	newRow, err := applyUpdateExpressionsWithIgnore(ctx, u.updateExprs, u.tableSchema, oldRow, u.rowNumber, u.ignore)
 	if err != nil {
 		return nil, err
 	}
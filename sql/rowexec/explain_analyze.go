@@ -0,0 +1,93 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"io"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// buildNodeExecWithStats builds the iterator for |n|, recording the time spent building it and every row it returns
+// in |stats|, for EXPLAIN ANALYZE.
+func (b *BaseBuilder) buildNodeExecWithStats(ctx *sql.Context, stats *sql.ExecStatsCollector, n sql.Node, row sql.Row) (sql.RowIter, error) {
+	start := time.Now()
+	iter, err := b.buildNodeExecNoAnalyze(ctx, n, row)
+	elapsed := time.Since(start)
+	stats.AddLoop(n, elapsed)
+	if err != nil {
+		return nil, err
+	}
+	return &execStatsIter{iter: iter, node: n, stats: stats, elapsed: elapsed}, nil
+}
+
+// drainWithStats executes |n| discarding its rows, so that the stats of the context given are populated.
+func (b *BaseBuilder) drainWithStats(ctx *sql.Context, n sql.Node, row sql.Row) (err error) {
+	iter, err := b.Build(ctx, n, row)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := iter.Close(ctx); err == nil {
+			err = cerr
+		}
+	}()
+	for {
+		_, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// execStatsIter records the rows returned by the iterator of a node, and the time spent producing them.
+type execStatsIter struct {
+	iter  sql.RowIter
+	node  sql.Node
+	stats *sql.ExecStatsCollector
+	// elapsed is the time spent building and iterating the node until its first row, after which it's not updated.
+	elapsed  time.Duration
+	firstRow bool
+}
+
+var _ sql.RowIter = (*execStatsIter)(nil)
+
+func (i *execStatsIter) Next(ctx *sql.Context) (sql.Row, error) {
+	start := time.Now()
+	row, err := i.iter.Next(ctx)
+	elapsed := time.Since(start)
+	var rows uint64
+	if err == nil {
+		rows = 1
+	}
+	i.stats.AddRows(i.node, rows, elapsed)
+	if !i.firstRow {
+		i.firstRow = true
+		i.elapsed += elapsed
+		i.stats.AddFirstRow(i.node, i.elapsed)
+	}
+	return row, err
+}
+
+func (i *execStatsIter) Close(ctx *sql.Context) error {
+	start := time.Now()
+	err := i.iter.Close(ctx)
+	i.stats.AddRows(i.node, 0, time.Since(start))
+	return err
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestGetDiagnostics(t *testing.T) {
+	require := require.New(t)
+
+	ctx := sql.NewEmptyContext()
+	ctx.Warn(1265, "Data truncated for column 'c' at row 1")
+	addHandledCondition(ctx, sql.ErrUniqueKeyViolation.New())
+
+	getDiagnostics := func(conditionNumber sql.Expression, items ...plan.DiagnosticsItem) error {
+		n, err := plan.NewGetDiagnostics(conditionNumber, items)
+		if err != nil {
+			return err
+		}
+		_, err = DefaultBuilder.Build(ctx, n, nil)
+		return err
+	}
+	requireVar := func(name string, expected interface{}) {
+		_, val, err := ctx.GetUserVariable(ctx, name)
+		require.NoError(err)
+		require.Equal(expected, val)
+	}
+
+	require.NoError(getDiagnostics(nil,
+		plan.DiagnosticsItem{Target: expression.NewUserVar("number"), Name: plan.DiagnosticsItemName_Number},
+	))
+	requireVar("number", int64(2))
+
+	require.NoError(getDiagnostics(expression.NewLiteral(int8(2), types.Int8),
+		plan.DiagnosticsItem{Target: expression.NewUserVar("errno"), Name: plan.DiagnosticsItemName_MysqlErrno},
+		plan.DiagnosticsItem{Target: expression.NewUserVar("msg"), Name: plan.DiagnosticsItemName_MessageText},
+		plan.DiagnosticsItem{Target: expression.NewUserVar("state"), Name: plan.DiagnosticsItemName_ReturnedSqlState},
+	))
+	requireVar("errno", int64(1062))
+	requireVar("msg", sql.ErrUniqueKeyViolation.New().Error())
+	requireVar("state", "HY000")
+
+	require.NoError(getDiagnostics(expression.NewLiteral(int8(1), types.Int8),
+		plan.DiagnosticsItem{Target: expression.NewUserVar("errno"), Name: plan.DiagnosticsItemName_MysqlErrno},
+		plan.DiagnosticsItem{Target: expression.NewUserVar("state"), Name: plan.DiagnosticsItemName_ReturnedSqlState},
+	))
+	requireVar("errno", int64(1265))
+	requireVar("state", "01000")
+
+	err := getDiagnostics(expression.NewLiteral(int8(3), types.Int8),
+		plan.DiagnosticsItem{Target: expression.NewUserVar("errno"), Name: plan.DiagnosticsItemName_MysqlErrno},
+	)
+	require.True(sql.ErrInvalidConditionNumber.Is(err))
+
+	err = getDiagnostics(nil,
+		plan.DiagnosticsItem{Target: expression.NewUserVar("errno"), Name: plan.DiagnosticsItemName_MysqlErrno},
+	)
+	require.True(sql.ErrInvalidDiagnosticsItem.Is(err))
+}
@@ -305,6 +305,55 @@ func BenchmarkGroupBy(b *testing.B) {
 	b.Run("grouping", bench(node, expected))
 }
 
+// BenchmarkGroupByHighCardinality compares the default hash grouping with the sort-based grouping used for
+// SQL_BIG_RESULT, over a table where nearly every row is its own group.
+func BenchmarkGroupByHighCardinality(b *testing.B) {
+	const numRows = 1_000_000
+
+	db := memory.NewDatabase("test")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+
+	table := memory.NewTable(db.BaseDatabase, "test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int64},
+		{Name: "b", Type: types.Int64},
+	}), nil)
+	inserter := table.Inserter(ctx)
+	for i := 0; i < numRows; i++ {
+		// scatter the keys so that the input isn't already sorted by them
+		require.NoError(b, inserter.Insert(ctx, sql.NewRow(int64((i*7919)%(numRows/2)), int64(i))))
+	}
+	require.NoError(b, inserter.Close(ctx))
+
+	node := plan.NewGroupBy(
+		[]sql.Expression{
+			expression.NewGetField(0, types.Int64, "a", false),
+			aggregation.NewMax(
+				expression.NewGetField(1, types.Int64, "b", false),
+			),
+		},
+		[]sql.Expression{
+			expression.NewGetField(0, types.Int64, "a", false),
+		},
+		plan.NewResolvedTable(table, nil, nil),
+	)
+
+	bench := func(node sql.Node) func(*testing.B) {
+		return func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				iter, err := DefaultBuilder.Build(ctx, node, nil)
+				require.NoError(b, err)
+				rows, err := sql.RowIterToRows(ctx, iter)
+				require.NoError(b, err)
+				require.Len(b, rows, numRows/2)
+			}
+		}
+	}
+
+	b.Run("hash", bench(node))
+	b.Run("sort", bench(node.WithStrategy(plan.GroupByStrategySort)))
+}
+
 func benchmarkTable(t testing.TB) sql.Table {
 	t.Helper()
 	require := require.New(t)
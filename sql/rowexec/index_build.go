@@ -0,0 +1,563 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// indexBuildBatchSize is the number of rows given to an IndexBulkLoader at a time. Workers also check the memory
+// budget and the context every time they have read this many rows.
+const indexBuildBatchSize = 1024
+
+func init() {
+	// Spilled runs are gob encoded, which requires the concrete types of row values to be registered. Runs with values
+	// of any other type are kept in memory.
+	gob.Register(time.Time{})
+	gob.Register(decimal.Decimal{})
+	gob.Register(types.Timespan(0))
+	gob.Register(types.JSONDocument{})
+	gob.Register(types.JsonObject{})
+	gob.Register(types.JsonArray{})
+	gob.Register(types.Point{})
+	gob.Register(types.LineString{})
+	gob.Register(types.Polygon{})
+	gob.Register(types.MultiPoint{})
+	gob.Register(types.MultiLineString{})
+	gob.Register(types.MultiPolygon{})
+	gob.Register(types.GeomColl{})
+}
+
+// canBulkLoadIndex returns whether the index given can be built by bulkLoadIndex. Spatial indexes aren't ordered by
+// the values of their columns, so they're always built a row at a time.
+func canBulkLoadIndex(ibt sql.IndexBuildingTable, indexDef sql.IndexDef) (sql.IndexBulkLoadingTable, bool) {
+	if indexDef.Constraint == sql.IndexConstraint_Spatial {
+		return nil, false
+	}
+	iblt, ok := ibt.(sql.IndexBulkLoadingTable)
+	return iblt, ok
+}
+
+// bulkLoadIndex builds a new index on a table that supports bulk loading. The table's partitions are read concurrently
+// by up to innodb_ddl_threads workers, each of which sorts the index entries of the rows it reads into runs. The runs
+// are then merged in index order, checking unique indexes for duplicate keys, and given to the loader in batches.
+func bulkLoadIndex(ctx *sql.Context, n *plan.AlterIndex, iblt sql.IndexBulkLoadingTable, indexDef sql.IndexDef) error {
+	keys, err := newIndexKeyBuilder(n.TargetSchema(), indexDef)
+	if err != nil {
+		return err
+	}
+
+	// Our table scan needs to include projections for virtual columns if there are any
+	var projections []sql.Expression
+	if iblt.Schema().HasVirtualColumns() {
+		projections, err = virtualTableProjections(n.TargetSchema(), iblt.Name())
+		if err != nil {
+			return err
+		}
+	}
+
+	loader, err := iblt.BulkLoadIndex(ctx, indexDef)
+	if err != nil {
+		return err
+	}
+
+	runs, err := sortIndexRuns(ctx, iblt, keys, projections)
+	defer func() {
+		for _, run := range runs {
+			run.dispose()
+		}
+	}()
+	if err == nil {
+		err = mergeIndexRuns(ctx, runs, keys, indexDef.Constraint == sql.IndexConstraint_Unique, loader)
+	}
+	if err != nil {
+		_ = loader.DiscardChanges(ctx, err)
+		_ = loader.Close(ctx)
+		return err
+	}
+	return loader.Close(ctx)
+}
+
+// indexBuildThreads returns the number of workers that read the table when building an index.
+func indexBuildThreads(ctx *sql.Context) int {
+	val, err := ctx.GetSessionVariable(ctx, "innodb_ddl_threads")
+	if err != nil {
+		return 1
+	}
+	threads, ok := val.(int64)
+	if !ok || threads < 1 {
+		return 1
+	}
+	return int(threads)
+}
+
+// sortIndexRuns reads every row of |table| and returns them as sorted runs of index entries.
+func sortIndexRuns(ctx *sql.Context, table sql.Table, keys *indexKeyBuilder, projections []sql.Expression) ([]*indexBuildRun, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	var runs []*indexBuildRun
+	addRun := func(run *indexBuildRun) {
+		mu.Lock()
+		defer mu.Unlock()
+		runs = append(runs, run)
+	}
+
+	partitionsCh := make(chan sql.Partition)
+	eg, egCtx := ctx.NewErrgroup()
+	eg.Go(func() error {
+		defer close(partitionsCh)
+		return iterPartitions(egCtx, partitions, partitionsCh)
+	})
+	for i := 0; i < indexBuildThreads(ctx); i++ {
+		eg.Go(func() error {
+			return sortPartitionRows(egCtx, table, keys, projections, partitionsCh, addRun)
+		})
+	}
+
+	err = eg.Wait()
+	return runs, err
+}
+
+// sortPartitionRows is a worker of sortIndexRuns. It reads the partitions received on |partitions| and collects the
+// index entries of their rows into a run, which is sorted and passed to |addRun| once the partitions are exhausted. If
+// the memory budget runs out, the run is spilled to disk early and the worker starts a new one.
+func sortPartitionRows(
+	ctx *sql.Context,
+	table sql.Table,
+	keys *indexKeyBuilder,
+	projections []sql.Expression,
+	partitions <-chan sql.Partition,
+	addRun func(*indexBuildRun),
+) (rerr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			rerr = fmt.Errorf("panic in sortPartitionRows: %v", r)
+		}
+	}()
+
+	var entries []indexBuildEntry
+	for {
+		select {
+		case p, ok := <-partitions:
+			if !ok {
+				if len(entries) == 0 {
+					return nil
+				}
+				run, err := newIndexBuildRun(keys, entries, false)
+				if err != nil {
+					return err
+				}
+				addRun(run)
+				return nil
+			}
+
+			iter, err := table.PartitionRows(ctx, p)
+			if err != nil {
+				return err
+			}
+			entries, err = readPartitionEntries(ctx, iter, keys, projections, entries, addRun)
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// readPartitionEntries appends the index entries of the rows of |iter| to |entries|, spilling them into a new run
+// whenever the memory budget runs out.
+func readPartitionEntries(
+	ctx *sql.Context,
+	iter sql.RowIter,
+	keys *indexKeyBuilder,
+	projections []sql.Expression,
+	entries []indexBuildEntry,
+	addRun func(*indexBuildRun),
+) (_ []indexBuildEntry, rerr error) {
+	defer func() {
+		cerr := iter.Close(ctx)
+		if rerr == nil {
+			rerr = cerr
+		}
+	}()
+
+	for count := 1; ; count++ {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return entries, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if projections != nil {
+			row, err = ProjectRow(ctx, projections, row)
+			if err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, indexBuildEntry{key: keys.key(row), row: row})
+
+		if count%indexBuildBatchSize == 0 {
+			if err = ctx.Err(); err != nil {
+				return nil, err
+			}
+			if ctx.Memory != nil && !ctx.Memory.HasAvailable() {
+				run, err := newIndexBuildRun(keys, entries, true)
+				if err != nil {
+					return nil, err
+				}
+				addRun(run)
+				entries = nil
+			}
+		}
+	}
+}
+
+// mergeIndexRuns merges the sorted runs given and loads the result into |loader|. For unique indexes, an error is
+// returned for the first pair of rows with the same non-NULL key.
+func mergeIndexRuns(ctx *sql.Context, runs []*indexBuildRun, keys *indexKeyBuilder, unique bool, loader sql.IndexBulkLoader) error {
+	h := &indexRunHeap{keys: keys}
+	for _, run := range runs {
+		cursor, err := run.cursor()
+		if err != nil {
+			return err
+		}
+		ok, err := cursor.next(keys)
+		if err != nil {
+			return err
+		}
+		if ok {
+			h.cursors = append(h.cursors, cursor)
+		}
+	}
+	heap.Init(h)
+	if h.err != nil {
+		return h.err
+	}
+
+	var prev indexBuildEntry
+	batch := make([]sql.Row, 0, indexBuildBatchSize)
+	for h.Len() > 0 {
+		cursor := h.cursors[0]
+		entry := cursor.current
+		if unique && prev.row != nil {
+			duplicate, err := keys.isDuplicate(prev.key, entry.key)
+			if err != nil {
+				return err
+			}
+			if duplicate {
+				return sql.NewUniqueKeyErr(sql.FormatRow(entry.key), false, prev.row)
+			}
+		}
+		prev = entry
+
+		batch = append(batch, entry.row)
+		if len(batch) == indexBuildBatchSize {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := loader.LoadBatch(ctx, batch); err != nil {
+				return err
+			}
+			batch = make([]sql.Row, 0, indexBuildBatchSize)
+		}
+
+		ok, err := cursor.next(keys)
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+		if h.err != nil {
+			return h.err
+		}
+	}
+
+	if len(batch) > 0 {
+		return loader.LoadBatch(ctx, batch)
+	}
+	return nil
+}
+
+// indexBuildEntry is a row of the table being indexed, along with its key in the new index.
+type indexBuildEntry struct {
+	key sql.Row
+	row sql.Row
+}
+
+// indexKeyBuilder computes the keys of a new index, which are the values of its columns truncated to their prefix
+// lengths, and compares them.
+type indexKeyBuilder struct {
+	ordinals []int
+	prefixes []int64
+	types    []sql.Type
+}
+
+func newIndexKeyBuilder(schema sql.Schema, indexDef sql.IndexDef) (*indexKeyBuilder, error) {
+	b := &indexKeyBuilder{
+		ordinals: make([]int, len(indexDef.Columns)),
+		prefixes: make([]int64, len(indexDef.Columns)),
+		types:    make([]sql.Type, len(indexDef.Columns)),
+	}
+	for i, col := range indexDef.Columns {
+		idx := schema.IndexOfColName(col.Name)
+		if idx < 0 {
+			return nil, sql.ErrKeyColumnDoesNotExist.New(col.Name)
+		}
+		b.ordinals[i] = idx
+		b.prefixes[i] = col.Length
+		b.types[i] = schema[idx].Type
+	}
+	return b, nil
+}
+
+// key returns the key of |row| in the index.
+func (b *indexKeyBuilder) key(row sql.Row) sql.Row {
+	key := make(sql.Row, len(b.ordinals))
+	for i, ordinal := range b.ordinals {
+		key[i] = indexPrefix(row[ordinal], b.prefixes[i])
+	}
+	return key
+}
+
+// indexPrefix returns the first |length| characters of a string, or bytes of a byte slice. Other values, and values
+// of indexed columns without a prefix length, are returned as they are.
+func indexPrefix(val interface{}, length int64) interface{} {
+	if length <= 0 {
+		return val
+	}
+	switch v := val.(type) {
+	case string:
+		if int64(utf8.RuneCountInString(v)) <= length {
+			return v
+		}
+		var chars int64
+		for i := range v {
+			if chars == length {
+				return v[:i]
+			}
+			chars++
+		}
+		return v
+	case []byte:
+		if int64(len(v)) > length {
+			return v[:length]
+		}
+		return v
+	default:
+		return val
+	}
+}
+
+// compare compares two keys of the index. NULL values sort before all others.
+func (b *indexKeyBuilder) compare(left, right sql.Row) (int, error) {
+	for i, typ := range b.types {
+		l, r := left[i], right[i]
+		if l == nil || r == nil {
+			if l == nil && r == nil {
+				continue
+			} else if l == nil {
+				return -1, nil
+			}
+			return 1, nil
+		}
+
+		cmp, err := typ.Compare(l, r)
+		if err != nil {
+			return 0, err
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	return 0, nil
+}
+
+// isDuplicate returns whether two keys violate a unique index. Keys with a NULL value never do.
+func (b *indexKeyBuilder) isDuplicate(left, right sql.Row) (bool, error) {
+	for i := range left {
+		if left[i] == nil || right[i] == nil {
+			return false, nil
+		}
+	}
+	cmp, err := b.compare(left, right)
+	return cmp == 0, err
+}
+
+// indexBuildRun is a sorted run of index entries. A run is kept in memory unless it was spilled to a temporary file
+// because the memory budget ran out.
+type indexBuildRun struct {
+	entries []indexBuildEntry
+	file    *os.File
+}
+
+// newIndexBuildRun sorts |entries| into a run. If |spill| is true, the run is written to a temporary file so that its
+// memory can be reclaimed. Runs with values that can't be encoded are kept in memory.
+func newIndexBuildRun(keys *indexKeyBuilder, entries []indexBuildEntry, spill bool) (*indexBuildRun, error) {
+	var err error
+	sort.SliceStable(entries, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		var cmp int
+		cmp, err = keys.compare(entries[i].key, entries[j].key)
+		return cmp < 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	run := &indexBuildRun{entries: entries}
+	if spill {
+		if file, err := spillIndexEntries(entries); err == nil {
+			run = &indexBuildRun{file: file}
+		}
+	}
+	return run, nil
+}
+
+// spillIndexEntries writes the rows of |entries| to a new temporary file and returns it.
+func spillIndexEntries(entries []indexBuildEntry) (*os.File, error) {
+	file, err := os.CreateTemp("", "gms-index-build-*")
+	if err != nil {
+		return nil, err
+	}
+
+	w := bufio.NewWriter(file)
+	enc := gob.NewEncoder(w)
+	for _, entry := range entries {
+		if err = enc.Encode(entry.row); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return nil, err
+	}
+	return file, nil
+}
+
+// cursor returns a cursor positioned before the first entry of the run.
+func (r *indexBuildRun) cursor() (*indexRunCursor, error) {
+	if r.file == nil {
+		return &indexRunCursor{entries: r.entries, pos: -1}, nil
+	}
+	if _, err := r.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return &indexRunCursor{dec: gob.NewDecoder(bufio.NewReader(r.file))}, nil
+}
+
+// dispose releases the resources of the run, removing its temporary file if it has one.
+func (r *indexBuildRun) dispose() {
+	r.entries = nil
+	if r.file != nil {
+		_ = r.file.Close()
+		_ = os.Remove(r.file.Name())
+		r.file = nil
+	}
+}
+
+// indexRunCursor iterates over the entries of an indexBuildRun in order.
+type indexRunCursor struct {
+	entries []indexBuildEntry
+	pos     int
+	dec     *gob.Decoder
+	current indexBuildEntry
+}
+
+// next advances the cursor to the next entry of the run, returning false when the run is exhausted.
+func (c *indexRunCursor) next(keys *indexKeyBuilder) (bool, error) {
+	if c.dec == nil {
+		c.pos++
+		if c.pos >= len(c.entries) {
+			return false, nil
+		}
+		c.current = c.entries[c.pos]
+		return true, nil
+	}
+
+	var row sql.Row
+	if err := c.dec.Decode(&row); err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	c.current = indexBuildEntry{key: keys.key(row), row: row}
+	return true, nil
+}
+
+// indexRunHeap is a min-heap of run cursors, ordered by the keys of their current entries. Since heap.Interface can't
+// return errors, the first error comparing keys is kept in |err|.
+type indexRunHeap struct {
+	keys    *indexKeyBuilder
+	cursors []*indexRunCursor
+	err     error
+}
+
+var _ heap.Interface = (*indexRunHeap)(nil)
+
+func (h *indexRunHeap) Len() int {
+	return len(h.cursors)
+}
+
+func (h *indexRunHeap) Less(i, j int) bool {
+	cmp, err := h.keys.compare(h.cursors[i].current.key, h.cursors[j].current.key)
+	if err != nil && h.err == nil {
+		h.err = err
+	}
+	return cmp < 0
+}
+
+func (h *indexRunHeap) Swap(i, j int) {
+	h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i]
+}
+
+func (h *indexRunHeap) Push(x interface{}) {
+	h.cursors = append(h.cursors, x.(*indexRunCursor))
+}
+
+func (h *indexRunHeap) Pop() interface{} {
+	last := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return last
+}
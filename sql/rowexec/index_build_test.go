@@ -0,0 +1,129 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// recordingBulkLoader is an IndexBulkLoader that keeps the rows it's given.
+type recordingBulkLoader struct {
+	rows [][]sql.Row
+}
+
+func (l *recordingBulkLoader) LoadBatch(ctx *sql.Context, rows []sql.Row) error {
+	l.rows = append(l.rows, rows)
+	return nil
+}
+
+func (l *recordingBulkLoader) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	return nil
+}
+
+func (l *recordingBulkLoader) Close(ctx *sql.Context) error {
+	return nil
+}
+
+func TestMergeIndexRuns(t *testing.T) {
+	schema := sql.Schema{
+		{Name: "pk", Type: types.Int64},
+		{Name: "v", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 20), Nullable: true},
+		{Name: "d", Type: types.MustCreateDecimalType(10, 2), Nullable: true},
+		{Name: "t", Type: types.Datetime, Nullable: true},
+	}
+	def := sql.IndexDef{
+		Name:       "v_idx",
+		Columns:    []sql.IndexColumn{{Name: "v", Length: 2}},
+		Constraint: sql.IndexConstraint_Unique,
+	}
+	keys, err := newIndexKeyBuilder(schema, def)
+	require.NoError(t, err)
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	row := func(pk int64, v interface{}) sql.Row {
+		return sql.Row{pk, v, decimal.New(pk, -2), ts}
+	}
+	entries := func(rows ...sql.Row) []indexBuildEntry {
+		var entries []indexBuildEntry
+		for _, r := range rows {
+			entries = append(entries, indexBuildEntry{key: keys.key(r), row: r})
+		}
+		return entries
+	}
+	newRuns := func(t *testing.T, runs ...[]indexBuildEntry) []*indexBuildRun {
+		var built []*indexBuildRun
+		for i, e := range runs {
+			// Spill every other run to check that spilled rows come back unchanged
+			run, err := newIndexBuildRun(keys, e, i%2 == 0)
+			require.NoError(t, err)
+			require.Equal(t, i%2 == 0, run.file != nil)
+			built = append(built, run)
+		}
+		t.Cleanup(func() {
+			for _, run := range built {
+				run.dispose()
+			}
+		})
+		return built
+	}
+	ctx := sql.NewEmptyContext()
+
+	t.Run("rows are merged in index order", func(t *testing.T) {
+		runs := newRuns(t,
+			entries(row(1, "dd"), row(2, nil), row(3, "bb")),
+			entries(row(4, "cc"), row(5, nil)),
+			entries(row(6, "aa"), row(7, "ee")),
+		)
+		loader := &recordingBulkLoader{}
+		require.NoError(t, mergeIndexRuns(ctx, runs, keys, true, loader))
+		require.Len(t, loader.rows, 1)
+
+		var pks []int64
+		for _, r := range loader.rows[0] {
+			pks = append(pks, r[0].(int64))
+		}
+		require.ElementsMatch(t, []int64{2, 5}, pks[:2])
+		require.Equal(t, []int64{6, 3, 4, 1, 7}, pks[2:])
+		require.Equal(t, row(1, "dd"), loader.rows[0][5])
+	})
+
+	t.Run("duplicate prefixes in different runs", func(t *testing.T) {
+		runs := newRuns(t,
+			entries(row(1, "abc"), row(2, "xyz")),
+			entries(row(3, "abd"), row(4, "klm")),
+		)
+		err := mergeIndexRuns(ctx, runs, keys, true, &recordingBulkLoader{})
+		require.True(t, sql.ErrUniqueKeyViolation.Is(err))
+		require.Contains(t, err.Error(), "[ab]")
+	})
+
+	t.Run("duplicates in non-unique indexes", func(t *testing.T) {
+		runs := newRuns(t,
+			entries(row(1, "abc"), row(2, "xyz")),
+			entries(row(3, "abd"), row(4, "klm")),
+		)
+		loader := &recordingBulkLoader{}
+		require.NoError(t, mergeIndexRuns(ctx, runs, keys, false, loader))
+		require.Len(t, loader.rows[0], 4)
+	})
+}
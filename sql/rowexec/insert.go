@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/vt/proto/query"
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -37,13 +38,15 @@ type insertIter struct {
 	rowSource           sql.RowIter
 	lastInsertIdUpdated bool
 	hasAutoAutoIncValue bool
-	ctx                 *sql.Context
-	insertExprs         []sql.Expression
-	updateExprs         []sql.Expression
-	checks              sql.CheckConstraints
-	tableNode           sql.Node
-	closed              bool
-	ignore              bool
+	// rowNumber is the 1-based number of the row being inserted, used in error messages
+	rowNumber   int
+	ctx         *sql.Context
+	insertExprs []sql.Expression
+	updateExprs []sql.Expression
+	checks      sql.CheckConstraints
+	tableNode   sql.Node
+	closed      bool
+	ignore      bool
 }
 
 func getInsertExpressions(values sql.Node) []sql.Expression {
@@ -68,6 +71,7 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 	if err != nil {
 		return nil, i.ignoreOrClose(ctx, row, err)
 	}
+	i.rowNumber++
 
 	// Prune the row down to the size of the schema. It can be larger in the case of running with an outer scope, in which
 	// case the additional scope variables are prepended to the row.
@@ -92,6 +96,14 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 	for idx, col := range i.schema {
 		if row[idx] != nil {
 			converted, inRange, cErr := col.Type.Convert(row[idx])
+			if types.IsOutOfRangeConversion(col.Type, inRange, cErr) {
+				converted, cErr = outOfRangeValue(ctx, col.Name, col.Type, row[idx], i.rowNumber, i.ignore)
+				if cErr != nil {
+					return nil, sql.NewWrappedInsertError(origRow, cErr)
+				}
+				row[idx] = converted
+				continue
+			}
 			if cErr == nil && !inRange {
 				cErr = sql.ErrValueOutOfRange.New(row[idx], col.Type)
 			}
@@ -138,8 +150,12 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 		}
 		// May have multiple duplicate pk & unique errors due to multiple indexes
 		//TODO: how does this interact with triggers?
+		storedRow, err := rowToUTC(ctx, i.schema, row)
+		if err != nil {
+			return nil, i.ignoreOrClose(ctx, row, err)
+		}
 		for {
-			if err := i.replacer.Insert(ctx, row); err != nil {
+			if err := i.replacer.Insert(ctx, storedRow); err != nil {
 				if !sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) {
 					i.rowSource.Close(ctx)
 					i.rowSource = nil
@@ -152,15 +168,25 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 					i.rowSource = nil
 					return nil, sql.NewWrappedInsertError(row, err)
 				}
+				existing, err := rowFromUTC(ctx, i.schema, ue.Existing)
+				if err != nil {
+					i.rowSource.Close(ctx)
+					i.rowSource = nil
+					return nil, sql.NewWrappedInsertError(row, err)
+				}
 				// the row had to be deleted, write the values into the toReturn row
-				copy(toReturn, ue.Existing)
+				copy(toReturn, existing)
 			} else {
 				break
 			}
 		}
 		return toReturn, nil
 	} else {
-		if err := i.inserter.Insert(ctx, row); err != nil {
+		storedRow, err := rowToUTC(ctx, i.schema, row)
+		if err != nil {
+			return nil, i.ignoreOrClose(ctx, row, err)
+		}
+		if err := i.inserter.Insert(ctx, storedRow); err != nil {
 			if (!sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) && !sql.ErrDuplicateEntry.Is(err)) || len(i.updateExprs) == 0 {
 				return nil, i.ignoreOrClose(ctx, row, err)
 			}
@@ -175,14 +201,18 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 	return row, nil
 }
 
-func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, oldRow, newRow sql.Row) (returnRow sql.Row, returnErr error) {
-	var err error
+func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, storedRow, newRow sql.Row) (returnRow sql.Row, returnErr error) {
+	// the existing row is as it is stored in the table, and must be given back to the updater that way
+	oldRow, err := rowFromUTC(ctx, i.schema, storedRow)
+	if err != nil {
+		return nil, i.ignoreOrClose(ctx, newRow, err)
+	}
 	updateAcc := append(oldRow, newRow...)
 	var evalRow sql.Row
 	for _, updateExpr := range i.updateExprs {
 		// this SET <val> indexes into LHS, but the <expr> can
 		// reference the new row on RHS
-		val, err := updateExpr.Eval(i.ctx, updateAcc)
+		val, err := evalSetField(i.ctx, updateExpr, updateAcc, i.rowNumber, i.ignore)
 		if err != nil {
 			if i.ignore {
 				idx, ok := getFieldIndexFromUpdateExpr(updateExpr)
@@ -207,7 +237,11 @@ func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, oldRow, newRow
 		return nil, i.ignoreOrClose(ctx, newRow, err)
 	}
 
-	err = i.updater.Update(ctx, oldRow, evalRow)
+	storedEvalRow, err := rowToUTC(ctx, i.schema, evalRow)
+	if err != nil {
+		return nil, i.ignoreOrClose(ctx, newRow, err)
+	}
+	err = i.updater.Update(ctx, storedRow, storedEvalRow)
 	if err != nil {
 		return nil, i.ignoreOrClose(ctx, newRow, err)
 	}
@@ -340,6 +374,30 @@ func convertDataAndWarn(ctx *sql.Context, tableSchema sql.Schema, row sql.Row, c
 	return row
 }
 
+// outOfRangeValue handles the value |v| being outside the range of the numeric column |colName| of type |typ|. In
+// strict SQL mode the statement fails, unless it is an IGNORE statement. Otherwise, the value is clamped to the range of
+// the type and a warning is added.
+// cc. https://dev.mysql.com/doc/refman/8.0/en/out-of-range-and-overflow.html
+func outOfRangeValue(ctx *sql.Context, colName string, typ sql.Type, v interface{}, rowNumber int, ignore bool) (interface{}, error) {
+	err := sql.ErrValueOutOfRangeForColumn.New(colName, rowNumber)
+	if !ignore && sql.LoadSqlMode(ctx).Strict() {
+		return nil, err
+	}
+
+	clamped, cErr := types.ClampToRange(typ, v)
+	if cErr != nil {
+		return nil, cErr
+	}
+	if ctx != nil && ctx.Session != nil {
+		ctx.Session.Warn(&sql.Warning{
+			Level:   "Warning",
+			Code:    mysql.ERWarnDataOutOfRange,
+			Message: err.Error(),
+		})
+	}
+	return clamped, nil
+}
+
 func warnOnIgnorableError(ctx *sql.Context, row sql.Row, err error) error {
 	// Check that this error is a part of the list of Ignorable Errors and create the relevant warning
 	for _, ie := range plan.IgnorableErrors {
@@ -380,14 +438,28 @@ func (i *insertIter) evaluateChecks(ctx *sql.Context, row sql.Row) error {
 			return err
 		}
 
-		if sql.IsFalse(res) {
-			return sql.ErrCheckConstraintViolated.New(check.Name)
+		if err := checkViolation(check, res); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// checkViolation returns the error for a row that |check| evaluated to |res| for, or nil if the row satisfies |check|.
+func checkViolation(check *sql.CheckConstraint, res interface{}) error {
+	if check.ViewCheckOption {
+		if !sql.IsTrue(res) {
+			return sql.ErrViewCheckOptionFailed.New(check.Name)
+		}
+		return nil
+	}
+	if sql.IsFalse(res) {
+		return sql.ErrCheckConstraintViolated.New(check.Name)
+	}
+	return nil
+}
+
 func (i *insertIter) validateNullability(ctx *sql.Context, dstSchema sql.Schema, row sql.Row) error {
 	for count, col := range dstSchema {
 		if !col.Nullable && row[count] == nil {
@@ -65,7 +65,7 @@ func TestInsertIgnoreConversions(t *testing.T) {
 			name:      "inserting a negative into an unsigned int results in 0",
 			colType:   types.Uint64,
 			value:     int64(-1),
-			expected:  uint64(1<<64 - 1),
+			expected:  uint64(0),
 			valueType: types.Uint64,
 			err:       true,
 		},
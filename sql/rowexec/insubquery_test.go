@@ -231,3 +231,112 @@ func TestNotInSubquery(t *testing.T) {
 		})
 	}
 }
+
+func TestNotInSubqueryWithNulls(t *testing.T) {
+	db := memory.NewDatabase("foo")
+	pro := memory.NewDBProvider(db)
+	ctx := newContext(pro)
+
+	single := memory.NewTable(db.BaseDatabase, "single", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "t", Source: "single", Type: types.Text, Nullable: true},
+	}), nil)
+	require.NoError(t, single.Insert(ctx, sql.Row{"one"}))
+	require.NoError(t, single.Insert(ctx, sql.Row{nil}))
+
+	pairs := memory.NewTable(db.BaseDatabase, "pairs", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "pairs", Type: types.Int64, Nullable: true},
+		{Name: "b", Source: "pairs", Type: types.Int64, Nullable: true},
+	}), nil)
+	require.NoError(t, pairs.Insert(ctx, sql.Row{int64(1), nil}))
+	require.NoError(t, pairs.Insert(ctx, sql.Row{int64(3), int64(3)}))
+
+	singleQuery := plan.NewProject([]sql.Expression{
+		expression.NewGetField(1, types.Text, "t", true),
+	}, plan.NewResolvedTable(single, nil, nil))
+	pairsQuery := plan.NewProject([]sql.Expression{
+		expression.NewGetField(2, types.Int64, "a", true),
+		expression.NewGetField(3, types.Int64, "b", true),
+	}, plan.NewResolvedTable(pairs, nil, nil))
+	pair := expression.NewTuple(
+		expression.NewGetField(0, types.Int64, "x", true),
+		expression.NewGetField(1, types.Int64, "y", true),
+	)
+
+	testCases := []struct {
+		name   string
+		left   sql.Expression
+		right  sql.Node
+		row    sql.Row
+		result interface{}
+	}{
+		{
+			"left is in right",
+			expression.NewGetField(0, types.Text, "foo", true),
+			singleQuery,
+			sql.NewRow("one"),
+			false,
+		},
+		{
+			"left is not in right, right has a null",
+			expression.NewGetField(0, types.Text, "foo", true),
+			singleQuery,
+			sql.NewRow("four"),
+			nil,
+		},
+		{
+			"left is nil",
+			expression.NewGetField(0, types.Text, "foo", true),
+			singleQuery,
+			sql.NewRow(nil),
+			nil,
+		},
+		{
+			"tuple is in right",
+			pair,
+			pairsQuery,
+			sql.NewRow(int64(3), int64(3)),
+			false,
+		},
+		{
+			"tuple only differs from right in a null element",
+			pair,
+			pairsQuery,
+			sql.NewRow(int64(1), int64(1)),
+			nil,
+		},
+		{
+			"tuple differs from every row in right",
+			pair,
+			pairsQuery,
+			sql.NewRow(int64(2), int64(2)),
+			true,
+		},
+		{
+			"tuple with a null element",
+			pair,
+			pairsQuery,
+			sql.NewRow(int64(1), nil),
+			nil,
+		},
+		{
+			"tuple with a null element differs from every row in right",
+			pair,
+			pairsQuery,
+			sql.NewRow(int64(2), nil),
+			true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			result, err := plan.NewNotInSubquery(
+				tt.left,
+				plan.NewSubquery(tt.right, "").WithExecBuilder(DefaultBuilder),
+			).Eval(ctx, tt.row)
+			require.NoError(err)
+			require.Equal(tt.result, result)
+		})
+	}
+}
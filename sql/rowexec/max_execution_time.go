@@ -0,0 +1,60 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// maxExecutionTimeIter runs its child iterator under a context with a statement deadline, as requested by the
+// MAX_EXECUTION_TIME optimizer hint. The deadline is enforced by cancelling the context, so any iterator or
+// expression that respects context cancellation will stop once the deadline passes.
+type maxExecutionTimeIter struct {
+	iter sql.RowIter
+	// ctx is the context carrying the statement deadline, which every row is read under
+	ctx    *sql.Context
+	cancel context.CancelFunc
+}
+
+var _ sql.RowIter = (*maxExecutionTimeIter)(nil)
+
+// AddMaxExecutionTime returns a new iterator that evaluates |iter| under |ctx|, which is expected to carry the
+// statement deadline. |cancel| releases the resources for |ctx| and is called when the iterator is closed.
+func AddMaxExecutionTime(ctx *sql.Context, cancel context.CancelFunc, iter sql.RowIter) sql.RowIter {
+	return &maxExecutionTimeIter{
+		iter:   iter,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Next implements the interface sql.RowIter.
+func (i *maxExecutionTimeIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.iter.Next(i.ctx)
+	if err != nil && err != io.EOF && errors.Is(i.ctx.Err(), context.DeadlineExceeded) {
+		return nil, sql.ErrQueryTimeout.New()
+	}
+	return row, err
+}
+
+// Close implements the interface sql.RowIter.
+func (i *maxExecutionTimeIter) Close(ctx *sql.Context) error {
+	defer i.cancel()
+	return i.iter.Close(ctx)
+}
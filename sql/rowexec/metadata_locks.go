@@ -0,0 +1,51 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// metadataLockIter releases the metadata locks of the session once the statement has finished executing, if that
+// statement ended the session's transaction.
+type metadataLockIter struct {
+	iter  sql.RowIter
+	locks *sql.MetadataLockManager
+}
+
+var _ sql.RowIter = (*metadataLockIter)(nil)
+
+// AddMetadataLockRelease returns a new iterator that releases the metadata locks held by the session in |locks| when
+// |iter| is closed and the session is no longer in a transaction.
+func AddMetadataLockRelease(iter sql.RowIter, locks *sql.MetadataLockManager) sql.RowIter {
+	return &metadataLockIter{
+		iter:  iter,
+		locks: locks,
+	}
+}
+
+// Next implements the interface sql.RowIter.
+func (i *metadataLockIter) Next(ctx *sql.Context) (sql.Row, error) {
+	return i.iter.Next(ctx)
+}
+
+// Close implements the interface sql.RowIter.
+func (i *metadataLockIter) Close(ctx *sql.Context) error {
+	err := i.iter.Close(ctx)
+	if ctx.GetTransaction() == nil {
+		i.locks.ReleaseAll(ctx.Session)
+	}
+	return err
+}
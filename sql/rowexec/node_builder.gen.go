@@ -24,6 +24,9 @@ import (
 )
 
 func (b *BaseBuilder) buildNodeExec(ctx *sql.Context, n sql.Node, row sql.Row) (sql.RowIter, error) {
+	if stats := ctx.ExecStats(); stats != nil {
+		return b.buildNodeExecWithStats(ctx, stats, n, row)
+	}
 	iter, err := b.buildNodeExecNoAnalyze(ctx, n, row)
 	if err != nil {
 		return nil, err
@@ -58,6 +61,12 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildQueryProcess(ctx, n, row)
 	case *plan.ShowReplicaStatus:
 		return b.buildShowReplicaStatus(ctx, n, row)
+	case *plan.ShowReplicas:
+		return b.buildShowReplicas(ctx, n, row)
+	case *plan.ShowBinaryLogs:
+		return b.buildShowBinaryLogs(ctx, n, row)
+	case *plan.ShowBinlogEvents:
+		return b.buildShowBinlogEvents(ctx, n, row)
 	case *plan.UpdateSource:
 		return b.buildUpdateSource(ctx, n, row)
 	case plan.ElseCaseError:
@@ -146,6 +155,8 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildExternalProcedure(ctx, n, row)
 	case *plan.Into:
 		return b.buildInto(ctx, n, row)
+	case *plan.GetDiagnostics:
+		return b.buildGetDiagnostics(ctx, n, row)
 	case *plan.LockTables:
 		return b.buildLockTables(ctx, n, row)
 	case *plan.Truncate:
@@ -174,6 +185,16 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildDropIndex(ctx, n, row)
 	case *plan.ResetReplica:
 		return b.buildResetReplica(ctx, n, row)
+	case *plan.ResetBinaryLogs:
+		return b.buildResetBinaryLogs(ctx, n, row)
+	case *plan.CreateResourceGroup:
+		return b.buildCreateResourceGroup(ctx, n, row)
+	case *plan.AlterResourceGroup:
+		return b.buildAlterResourceGroup(ctx, n, row)
+	case *plan.DropResourceGroup:
+		return b.buildDropResourceGroup(ctx, n, row)
+	case *plan.SetResourceGroup:
+		return b.buildSetResourceGroup(ctx, n, row)
 	case *plan.ShowCreateTrigger:
 		return b.buildShowCreateTrigger(ctx, n, row)
 	case *plan.TableCopier:
@@ -282,6 +303,8 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildValueDerivedTable(ctx, n, row)
 	case *plan.CreateView:
 		return b.buildCreateView(ctx, n, row)
+	case *plan.AlterView:
+		return b.buildAlterView(ctx, n, row)
 	case *plan.InsertInto:
 		return b.buildInsertInto(ctx, n, row)
 	case *plan.TopN:
@@ -358,6 +381,8 @@ func (b *BaseBuilder) buildNodeExecNoAnalyze(ctx *sql.Context, n sql.Node, row s
 		return b.buildStartReplica(ctx, n, row)
 	case *plan.AlterAutoIncrement:
 		return b.buildAlterAutoIncrement(ctx, n, row)
+	case *plan.RebuildTable:
+		return b.buildRebuildTable(ctx, n, row)
 	case *plan.DropForeignKey:
 		return b.buildDropForeignKey(ctx, n, row)
 	case *plan.DropTable:
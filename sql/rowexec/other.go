@@ -174,7 +174,11 @@ func (b *BaseBuilder) buildExchange(ctx *sql.Context, n *plan.Exchange, row sql.
 }
 
 func (b *BaseBuilder) buildExchangePartition(ctx *sql.Context, n *plan.ExchangePartition, row sql.Row) (sql.RowIter, error) {
-	return n.Table.PartitionRows(ctx, n.Partition)
+	iter, err := n.Table.PartitionRows(ctx, n.Partition)
+	if err != nil {
+		return nil, err
+	}
+	return newTimezoneIter(ctx, n.Table.Schema(), iter)
 }
 
 func (b *BaseBuilder) buildEmptyTable(ctx *sql.Context, n *plan.EmptyTable, row sql.Row) (sql.RowIter, error) {
@@ -241,6 +241,17 @@ func (b *BaseBuilder) buildRevoke(ctx *sql.Context, n *plan.Revoke, row sql.Row)
 			if n.ObjectType != plan.ObjectType_Any {
 				return nil, sql.ErrGrantRevokeIllegalPrivilege.New()
 			}
+			// Privileges are only revoked at the named level, so the user must have been granted privileges on the
+			// database itself, regardless of their global or table privileges. There is nothing to remove from such a
+			// user, so handling their privileges first only reports privileges that are illegal at this level.
+			for _, user := range users {
+				if user.PrivilegeSet.Database(database).Count() == 0 {
+					if err := n.HandleDatabasePrivileges(user, database); err != nil {
+						return nil, err
+					}
+					return nil, sql.ErrRevokeUserDoesNotExist.New(user.User, user.Host)
+				}
+			}
 			for _, user := range users {
 				if err := n.HandleDatabasePrivileges(user, database); err != nil {
 					return nil, err
@@ -261,6 +272,14 @@ func (b *BaseBuilder) buildRevoke(ctx *sql.Context, n *plan.Revoke, row sql.Row)
 				}
 			} else {
 				// Table Privileges
+				for _, user := range users {
+					if !user.PrivilegeSet.Database(database).Table(n.PrivilegeLevel.TableRoutine).HasPrivileges() {
+						if err := n.HandleTablePrivileges(user, database, n.PrivilegeLevel.TableRoutine); err != nil {
+							return nil, err
+						}
+						return nil, sql.ErrRevokeTableGrantDoesNotExist.New(user.User, user.Host, n.PrivilegeLevel.TableRoutine)
+					}
+				}
 				for _, user := range users {
 					if err := n.HandleTablePrivileges(user, database, n.PrivilegeLevel.TableRoutine); err != nil {
 						return nil, err
@@ -350,6 +369,9 @@ func (b *BaseBuilder) buildGrant(ctx *sql.Context, n *plan.Grant, row sql.Row) (
 					if err := n.HandleRoutinePrivileges(user, database, n.PrivilegeLevel.TableRoutine, true); err != nil {
 						return nil, err
 					}
+					if n.WithGrantOption {
+						user.PrivilegeSet.AddRoutine(database, n.PrivilegeLevel.TableRoutine, true, sql.PrivilegeType_GrantOption)
+					}
 				}
 			} else if n.ObjectType == plan.ObjectType_Function {
 				// TODO: We currently model function permissions, but don't have a common place to enforce them, so punting
@@ -20,9 +20,12 @@ import (
 	"io"
 	"strings"
 
+	"github.com/dolthub/vitess/go/mysql"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 func (b *BaseBuilder) buildCaseStatement(ctx *sql.Context, n *plan.CaseStatement, row sql.Row) (sql.RowIter, error) {
@@ -156,6 +159,7 @@ func (b *BaseBuilder) buildBeginEndBlock(ctx *sql.Context, n *plan.BeginEndBlock
 				}()
 				n.Pref.InnermostScope = scope
 				handlerRefVal := scope.Handlers[i]
+				addHandledCondition(ctx, err)
 
 				handlerRowIter, err := b.buildNodeExec(ctx, handlerRefVal.Stmt, nil)
 				if err != nil {
@@ -225,13 +229,23 @@ func (b *BaseBuilder) buildCall(ctx *sql.Context, n *plan.Call, row sql.Row) (sq
 	n.Pref.PushScope()
 	defer n.Pref.PopScope(ctx)
 
+	// A procedure with SQL SECURITY DEFINER runs as its definer until its iterator is closed
+	restore := func() {}
+	if n.Procedure.RunsAsDefiner() {
+		if definer, ok := sql.ParseDefiner(n.Procedure.Definer); ok {
+			restore = ctx.RunAs(definer)
+		}
+	}
+
 	innerIter, err := b.buildNodeExec(ctx, n.Procedure, row)
 	if err != nil {
+		restore()
 		return nil, err
 	}
 	return &callIter{
 		call:      n,
 		innerIter: innerIter,
+		restore:   restore,
 	}, nil
 }
 
@@ -391,3 +405,77 @@ func (b *BaseBuilder) buildIterate(ctx *sql.Context, n *plan.Iterate, row sql.Ro
 func (b *BaseBuilder) buildWhile(ctx *sql.Context, n *plan.While, row sql.Row) (sql.RowIter, error) {
 	return b.buildLoop(ctx, n.Loop, row)
 }
+
+func (b *BaseBuilder) buildGetDiagnostics(ctx *sql.Context, n *plan.GetDiagnostics, row sql.Row) (sql.RowIter, error) {
+	// the session's warnings are ordered from the most recent, while conditions are numbered in the order they were
+	// raised
+	warnings := ctx.Session.Warnings()
+	var condition *sql.Warning
+	if n.ConditionNumber != nil {
+		val, err := n.ConditionNumber.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		num, _, err := types.Int64.Convert(val)
+		if err != nil || num == nil || num.(int64) < 1 || num.(int64) > int64(len(warnings)) {
+			return nil, sql.ErrInvalidConditionNumber.New()
+		}
+		condition = warnings[int64(len(warnings))-num.(int64)]
+	}
+
+	for _, item := range n.Items {
+		var val interface{}
+		var typ sql.Type
+		switch item.Name {
+		case plan.DiagnosticsItemName_Number:
+			val, typ = int64(len(warnings)), types.Int64
+		case plan.DiagnosticsItemName_RowCount:
+			val, typ = ctx.GetLastQueryInfoInt(sql.RowCount), types.Int64
+		case plan.DiagnosticsItemName_MysqlErrno:
+			val, typ = int64(condition.Code), types.Int64
+		case plan.DiagnosticsItemName_MessageText:
+			val, typ = condition.Message, types.LongText
+		case plan.DiagnosticsItemName_ReturnedSqlState:
+			val, typ = conditionSqlState(condition), types.LongText
+		default:
+			return nil, fmt.Errorf("unsupported diagnostics item: %s", item.Name)
+		}
+		if err := assignVariable(ctx, item.Target, val, typ); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// conditionSqlState returns the SQLSTATE of a condition in the diagnostics area, defaulting to the general SQLSTATE of
+// its level when it was not recorded.
+func conditionSqlState(condition *sql.Warning) string {
+	if condition.SqlState != "" {
+		return condition.SqlState
+	}
+	if condition.Level == "Error" {
+		return mysql.SSUnknownSQLState
+	}
+	return "01000"
+}
+
+// addHandledCondition records |err|, which is about to be handled by a stored procedure handler, in the diagnostics
+// area of the session, so that the handler can inspect it.
+func addHandledCondition(ctx *sql.Context, err error) {
+	if errors.Is(err, expression.FetchEOF) {
+		ctx.Session.Warn(&sql.Warning{
+			Level:    "Error",
+			Code:     1329, // TODO: Needs to be added to vitess
+			Message:  "No data - zero rows fetched, selected, or processed",
+			SqlState: "02000",
+		})
+		return
+	}
+	sqlErr := sql.CastSQLError(err)
+	ctx.Session.Warn(&sql.Warning{
+		Level:    "Error",
+		Code:     sqlErr.Num,
+		Message:  sqlErr.Message,
+		SqlState: sqlErr.State,
+	})
+}
@@ -103,6 +103,8 @@ func (b *beginEndIter) Close(ctx *sql.Context) error {
 type callIter struct {
 	call      *plan.Call
 	innerIter sql.RowIter
+	// restore restores the client that called the procedure, if the procedure runs as its definer.
+	restore func()
 }
 
 // Next implements the sql.RowIter interface.
@@ -112,6 +114,9 @@ func (iter *callIter) Next(ctx *sql.Context) (sql.Row, error) {
 
 // Close implements the sql.RowIter interface.
 func (iter *callIter) Close(ctx *sql.Context) error {
+	if iter.restore != nil {
+		defer iter.restore()
+	}
 	err := iter.innerIter.Close(ctx)
 	if err != nil {
 		return err
@@ -38,14 +38,19 @@ import (
 
 func (b *BaseBuilder) buildTopN(ctx *sql.Context, n *plan.TopN, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.TopN")
-	i, err := b.buildNodeExec(ctx, n.Child, row)
+	limit, err := getInt64Value(ctx, n.Limit)
 	if err != nil {
 		span.End()
 		return nil, err
 	}
+	if limit == 0 && !n.CalcFoundRows {
+		span.End()
+		return plan.EmptyIter, nil
+	}
 
-	limit, err := getInt64Value(ctx, n.Limit)
+	i, err := b.buildNodeExec(ctx, n.Child, row)
 	if err != nil {
+		span.End()
 		return nil, err
 	}
 	return sql.NewSpanIter(span, newTopRowsIter(n.Fields, limit, n.CalcFoundRows, i, len(n.Child.Schema()))), nil
@@ -411,6 +416,12 @@ func (b *BaseBuilder) buildGroupBy(ctx *sql.Context, n *plan.GroupBy, row sql.Ro
 	var iter sql.RowIter
 	if len(n.GroupByExprs) == 0 {
 		iter = newGroupByIter(n.SelectedExprs, i)
+	} else if n.Strategy == plan.GroupByStrategySort {
+		sortFields := make(sql.SortFields, len(n.GroupByExprs))
+		for j, e := range n.GroupByExprs {
+			sortFields[j] = sql.SortField{Column: e, Order: sql.Ascending}
+		}
+		iter = newGroupBySortedIter(n.SelectedExprs, n.GroupByExprs, newSortIter(sortFields, i))
 	} else {
 		iter = newGroupByGroupingIter(ctx, n.SelectedExprs, n.GroupByExprs, i)
 	}
@@ -421,6 +432,13 @@ func (b *BaseBuilder) buildGroupBy(ctx *sql.Context, n *plan.GroupBy, row sql.Ro
 func (b *BaseBuilder) buildFilter(ctx *sql.Context, n *plan.Filter, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.Filter")
 
+	if i, ok, err := b.buildBatchFilter(ctx, n); err != nil {
+		span.End()
+		return nil, err
+	} else if ok {
+		return sql.NewSpanIter(span, i), nil
+	}
+
 	i, err := b.buildNodeExec(ctx, n.Child, row)
 	if err != nil {
 		span.End()
@@ -439,6 +457,14 @@ func (b *BaseBuilder) buildDeclareHandler(ctx *sql.Context, n *plan.DeclareHandl
 }
 
 func (b *BaseBuilder) buildRecursiveCte(ctx *sql.Context, n *plan.RecursiveCte, row sql.Row) (sql.RowIter, error) {
+	val, err := ctx.GetSessionVariable(ctx, "cte_max_recursion_depth")
+	if err != nil {
+		return nil, err
+	}
+	maxDepth, _, err := types.Int64.Convert(val)
+	if err != nil {
+		return nil, err
+	}
 	var iter sql.RowIter = &recursiveCteIter{
 		init:        n.Left(),
 		rec:         n.Right(),
@@ -446,6 +472,7 @@ func (b *BaseBuilder) buildRecursiveCte(ctx *sql.Context, n *plan.RecursiveCte,
 		working:     n.Working,
 		temp:        make([]sql.Row, 0),
 		deduplicate: n.Union().Distinct,
+		maxDepth:    maxDepth.(int64),
 		b:           b,
 	}
 	if n.Union().Limit != nil && len(n.Union().SortFields) > 0 {
@@ -474,6 +501,21 @@ func (b *BaseBuilder) buildLimit(ctx *sql.Context, n *plan.Limit, row sql.Row) (
 		span.End()
 		return nil, err
 	}
+	if limit == 0 && !n.CalcFoundRows {
+		// LIMIT 0 is used to get the schema of a result cheaply, which is known without executing the child
+		span.End()
+		return plan.EmptyIter, nil
+	}
+
+	// the rows skipped by an OFFSET count towards the total that a PERCENT limit is a fraction of
+	var offset int64
+	if o, ok := n.Child.(*plan.Offset); ok && n.Percent {
+		offset, err = getInt64Value(ctx, o.Offset)
+		if err != nil {
+			span.End()
+			return nil, err
+		}
+	}
 
 	childIter, err := b.buildNodeExec(ctx, n.Child, row)
 	if err != nil {
@@ -484,6 +526,10 @@ func (b *BaseBuilder) buildLimit(ctx *sql.Context, n *plan.Limit, row sql.Row) (
 		calcFoundRows: n.CalcFoundRows,
 		limit:         limit,
 		childIter:     childIter,
+		percent:       n.Percent,
+		offset:        offset,
+		withTies:      n.WithTies,
+		sortFields:    n.SortFields,
 	}), nil
 }
 
@@ -579,6 +625,10 @@ func (b *BaseBuilder) buildInto(ctx *sql.Context, n *plan.Into, row sql.Row) (sq
 	span, ctx := ctx.Span("plan.Into")
 	defer span.End()
 
+	if len(n.IntoVars) > 0 && len(n.Child.Schema()) != len(n.IntoVars) {
+		return nil, sql.ErrColumnNumberDoesNotMatch.New()
+	}
+
 	rowIter, err := b.buildNodeExec(ctx, n.Child, row)
 	if err != nil {
 		return nil, err
@@ -664,37 +714,39 @@ func (b *BaseBuilder) buildInto(ctx *sql.Context, n *plan.Into, row sql.Row) (sq
 	}
 
 	if rowNum == 0 {
-		// a warning with error code 1329 occurs (No data), and make no change to variables
+		// like MySQL, warn that there was no data and make no change to variables
+		ctx.Warn(1329, "No data - zero rows fetched, selected, or processed")
 		return sql.RowsToRowIter(sql.Row{}), nil
 	}
-	if len(rows[0]) != len(n.IntoVars) {
-		return nil, sql.ErrColumnNumberDoesNotMatch.New()
-	}
 
 	var rowValues = make([]interface{}, len(rows[0]))
 	copy(rowValues, rows[0])
 
 	for j, v := range n.IntoVars {
-		switch variable := v.(type) {
-		case *expression.UserVar:
-			varType := types.ApproximateTypeFromValue(rowValues[j])
-			err = ctx.SetUserVariable(ctx, variable.Name, rowValues[j], varType)
-			if err != nil {
-				return nil, err
-			}
-		case *expression.ProcedureParam:
-			err = variable.Set(rowValues[j], types.ApproximateTypeFromValue(rowValues[j]))
-			if err != nil {
-				return nil, err
-			}
-		default:
-			return nil, fmt.Errorf("unsupported type for into: %T", variable)
+		if err = assignVariable(ctx, v, rowValues[j], n.Child.Schema()[j].Type); err != nil {
+			return nil, err
 		}
 	}
 
 	return sql.RowsToRowIter(sql.Row{}), nil
 }
 
+// assignVariable sets the user variable or procedure variable |v| to |val|, a value of type |typ|.
+func assignVariable(ctx *sql.Context, v sql.Expression, val interface{}, typ sql.Type) error {
+	switch variable := v.(type) {
+	case *expression.UserVar:
+		val, varType, err := types.ConvertToUserVariable(ctx, val, typ)
+		if err != nil {
+			return err
+		}
+		return ctx.SetUserVariable(ctx, variable.Name, val, varType)
+	case *expression.ProcedureParam:
+		return variable.Set(val, types.ApproximateTypeFromValue(val))
+	default:
+		return fmt.Errorf("unsupported type for into: %T", variable)
+	}
+}
+
 func (b *BaseBuilder) buildExternalProcedure(ctx *sql.Context, n *plan.ExternalProcedure, row sql.Row) (sql.RowIter, error) {
 	// The function's structure has been verified by the analyzer, so no need to double-check any of it here
 	funcVal := reflect.ValueOf(n.Function)
@@ -780,14 +832,23 @@ func (b *BaseBuilder) buildIndexedTableAccess(ctx *sql.Context, n *plan.IndexedT
 	if err != nil {
 		return nil, err
 	}
+	lookup, err = lookupToUTC(ctx, n.Table, lookup)
+	if err != nil {
+		return nil, err
+	}
+
+	table := n.Table
+	if lt, ok := table.(sql.LimitedTable); ok && n.Limit() > 0 {
+		table = lt.WithLimit(n.Limit())
+	}
 
-	partIter, err := n.Table.LookupPartitions(ctx, lookup)
+	partIter, err := table.LookupPartitions(ctx, lookup)
 	if err != nil {
 		return nil, err
 	}
 
 	var tableIter sql.RowIter
-	tableIter = sql.NewTableRowIter(ctx, n.Table, partIter)
+	tableIter = sql.NewTableRowIter(ctx, table, partIter)
 
 	if vct, ok := plan.FindVirtualColumnTable(n.Table); ok {
 		tableIter, err = b.buildVirtualColumnTable(ctx, vct, tableIter, row)
@@ -796,11 +857,28 @@ func (b *BaseBuilder) buildIndexedTableAccess(ctx *sql.Context, n *plan.IndexedT
 		}
 	}
 
+	tableIter, err = newTimezoneIter(ctx, n.Schema(), tableIter)
+	if err != nil {
+		return nil, err
+	}
+
 	return sql.NewSpanIter(span, tableIter), nil
 }
 
 func (b *BaseBuilder) buildSetOp(ctx *sql.Context, s *plan.SetOp, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.SetOp")
+	if s.Limit != nil {
+		limit, err := getInt64Value(ctx, s.Limit)
+		if err != nil {
+			span.End()
+			return nil, err
+		}
+		if limit == 0 {
+			span.End()
+			return plan.EmptyIter, nil
+		}
+	}
+
 	var iter sql.RowIter
 	var err error
 	iter, err = b.buildNodeExec(ctx, s.Left(), row)
@@ -929,6 +1007,11 @@ func (b *BaseBuilder) buildResolvedTable(ctx *sql.Context, n *plan.ResolvedTable
 		}
 	}
 
+	iter, err = newTimezoneIter(ctx, n.Schema(), iter)
+	if err != nil {
+		return nil, err
+	}
+
 	return sql.NewSpanIter(span, iter), nil
 }
 
@@ -28,6 +28,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -384,6 +385,9 @@ func (c *jsonTableCol) Next(obj interface{}, pass bool, ord int) (sql.Row, error
 			return nil, fmt.Errorf("missing value for JSON_TABLE column '%s'", c.opts.name)
 		}
 		val = c.opts.defEmpVal
+	} else if types.IsJSON(c.opts.typ) {
+		// the value found is already JSON, so a JSON string isn't parsed as JSON text again
+		val = types.JSONDocument{Val: val}
 	}
 
 	val, _, err = c.opts.typ.Convert(val)
@@ -547,7 +551,7 @@ func ProjectRow(
 		f = normalizeNegativeZeros(f)
 		fields = append(fields, f)
 	}
-	for _, index := range secondPass {
+	for _, index := range orderDefaultsByReferences(projections, secondPass) {
 		field, err := projections[index].Eval(ctx, fields)
 		if err != nil {
 			return nil, err
@@ -558,6 +562,59 @@ func ProjectRow(
 	return sql.NewRow(fields...), nil
 }
 
+// orderDefaultsByReferences returns the indexes of the default value projections given, ordered so that every default
+// value is evaluated after the other default values it refers to, such as when a generated column refers to a
+// generated column defined after it. Defaults that refer to each other in a cycle are evaluated in their original order.
+func orderDefaultsByReferences(projections []sql.Expression, defaults []int) []int {
+	if len(defaults) < 2 {
+		return defaults
+	}
+	pending := make(map[int]bool, len(defaults))
+	for _, i := range defaults {
+		pending[i] = true
+	}
+	references := make(map[int][]int, len(defaults))
+	for _, i := range defaults {
+		transform.InspectExpr(projections[i], func(e sql.Expression) bool {
+			if gf, ok := e.(*expression.GetField); ok && gf.Index() != i && pending[gf.Index()] {
+				references[i] = append(references[i], gf.Index())
+			}
+			return false
+		})
+	}
+
+	order := make([]int, 0, len(defaults))
+	for len(order) < len(defaults) {
+		progress := false
+		for _, i := range defaults {
+			if !pending[i] {
+				continue
+			}
+			ready := true
+			for _, ref := range references[i] {
+				if pending[ref] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				order = append(order, i)
+				pending[i] = false
+				progress = true
+			}
+		}
+		if !progress {
+			for _, i := range defaults {
+				if pending[i] {
+					order = append(order, i)
+					pending[i] = false
+				}
+			}
+		}
+	}
+	return order
+}
+
 func defaultValFromProjectExpr(e sql.Expression) (*sql.ColumnDefaultValue, bool) {
 	if defaultVal, ok := e.(*expression.Wrapper); ok {
 		e = defaultVal.Unwrap()
@@ -615,7 +672,10 @@ func setUserVar(ctx *sql.Context, userVar *expression.UserVar, right sql.Express
 	if err != nil {
 		return err
 	}
-	typ := types.ApproximateTypeFromValue(val)
+	val, typ, err := types.ConvertToUserVariable(ctx, val, right.Type())
+	if err != nil {
+		return err
+	}
 
 	err = ctx.SetUserVariable(ctx, userVar.Name, val, typ)
 	if err != nil {
@@ -624,6 +684,16 @@ func setUserVar(ctx *sql.Context, userVar *expression.UserVar, right sql.Express
 	return nil
 }
 
+// persistableSystemVariables returns the sql.PersistableSystemVariables of the session in |ctx|, or an error if the
+// integrator doesn't support persisted system variables.
+func persistableSystemVariables(ctx *sql.Context) (sql.PersistableSystemVariables, error) {
+	persisted, ok := ctx.Session.(sql.PersistableSystemVariables)
+	if !ok {
+		return nil, sql.ErrSessionDoesNotSupportPersistence.New()
+	}
+	return persisted, nil
+}
+
 func setSystemVar(ctx *sql.Context, sysVar *expression.SystemVar, right sql.Expression, row sql.Row) error {
 	val, err := right.Eval(ctx, row)
 	if err != nil {
@@ -641,37 +711,57 @@ func setSystemVar(ctx *sql.Context, sysVar *expression.SystemVar, right sql.Expr
 			return err
 		}
 	case sql.SystemVariableScope_Persist:
-		persistSess, ok := ctx.Session.(sql.PersistableSession)
-		if !ok {
-			return sql.ErrSessionDoesNotSupportPersistence.New()
-		}
-		err = persistSess.PersistGlobal(sysVar.Name, val)
+		persisted, err := persistableSystemVariables(ctx)
 		if err != nil {
 			return err
 		}
+		// Setting the global value first validates the new value before it's persisted
 		err = sql.SystemVariables.SetGlobal(sysVar.Name, val)
 		if err != nil {
 			return err
 		}
+		err = persisted.PersistGlobal(sysVar.Name, val)
+		if err != nil {
+			return err
+		}
 	case sql.SystemVariableScope_PersistOnly:
-		persistSess, ok := ctx.Session.(sql.PersistableSession)
-		if !ok {
-			return sql.ErrSessionDoesNotSupportPersistence.New()
+		persisted, err := persistableSystemVariables(ctx)
+		if err != nil {
+			return err
+		}
+		if _, _, ok := sql.SystemVariables.GetGlobal(sysVar.Name); !ok {
+			return sql.ErrUnknownSystemVariable.New(sysVar.Name)
 		}
-		err = persistSess.PersistGlobal(sysVar.Name, val)
+		err = persisted.PersistGlobal(sysVar.Name, val)
 		if err != nil {
 			return err
 		}
 	case sql.SystemVariableScope_ResetPersist:
-		// TODO: add parser support for RESET PERSIST
-		persistSess, ok := ctx.Session.(sql.PersistableSession)
-		if !ok {
-			return sql.ErrSessionDoesNotSupportPersistence.New()
+		// For RESET PERSIST, |val| is whether IF EXISTS was given
+		persisted, err := persistableSystemVariables(ctx)
+		if err != nil {
+			return err
 		}
 		if sysVar.Name == "" {
-			err = persistSess.RemoveAllPersistedGlobals()
+			err = persisted.RemoveAllPersistedGlobals()
+			if err != nil {
+				return err
+			}
+			break
 		}
-		err = persistSess.RemovePersistedGlobal(sysVar.Name)
+		current, err := persisted.GetPersistedValue(sysVar.Name)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			err = sql.ErrPersistedVariableNotFound.New(sysVar.Name)
+			if val != true {
+				return err
+			}
+			ctx.Warn(3615, "%s", err.Error())
+			break
+		}
+		err = persisted.RemovePersistedGlobal(sysVar.Name)
 		if err != nil {
 			return err
 		}
@@ -714,7 +804,7 @@ func applyUpdateExpressions(ctx *sql.Context, updateExprs []sql.Expression, row
 	var ok bool
 	prev := row
 	for _, updateExpr := range updateExprs {
-		val, err := updateExpr.Eval(ctx, prev)
+		val, err := evalSetField(ctx, updateExpr, prev, 1, false)
 		if err != nil {
 			return nil, err
 		}
@@ -771,8 +861,6 @@ func (d *declareHandlerIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
-const cteRecursionLimit = 10001
-
 // recursiveCteIter exhaustively executes a recursive
 // relation [rec] populated by an [init] base case.
 // Refer to RecursiveCte for more details.
@@ -785,6 +873,8 @@ type recursiveCteIter struct {
 	working *plan.RecursiveTable
 	// true if UNION, false if UNION ALL
 	deduplicate bool
+	// maximum number of recursive iterations, from cte_max_recursion_depth
+	maxDepth int64
 	// parent iter initialization state
 	row sql.Row
 
@@ -864,8 +954,8 @@ func (r *recursiveCteIter) resetIter(ctx *sql.Context) error {
 		return io.EOF
 	}
 	r.cycle++
-	if r.cycle > cteRecursionLimit {
-		return sql.ErrCteRecursionLimitExceeded.New()
+	if int64(r.cycle) > r.maxDepth {
+		return sql.ErrCteRecursionLimitExceeded.New(r.cycle)
 	}
 
 	if r.working != nil {
@@ -899,15 +989,47 @@ type limitIter struct {
 	currentPos    int64
 	childIter     sql.RowIter
 	limit         int64
+	// percent is whether limit is a percentage of the rows of the child and the rows skipped by offset. The rows of
+	// the child are buffered in rows to count them before the first row is returned.
+	percent  bool
+	offset   int64
+	rows     []sql.Row
+	buffered bool
+	// withTies is whether the rows after the limit are returned while they're equal to lastRow on sortFields
+	withTies   bool
+	sortFields sql.SortFields
+	lastRow    sql.Row
 }
 
 func (li *limitIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if li.percent && !li.buffered {
+		if err := li.bufferRows(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if li.currentPos >= li.limit {
+		if li.withTies && li.lastRow != nil {
+			row, err := li.nextChildRow(ctx)
+			if err != nil {
+				return nil, err
+			}
+			li.currentPos++
+			tie, err := li.isTie(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			if tie {
+				return row, nil
+			}
+			li.lastRow = nil
+		}
+
 		// If we were asked to calc all found rows, then when we are past the limit we iterate over the rest of the
 		// result set to count it
 		if li.calcFoundRows {
 			for {
-				_, err := li.childIter.Next(ctx)
+				_, err := li.nextChildRow(ctx)
 				if err != nil {
 					return nil, err
 				}
@@ -918,16 +1040,63 @@ func (li *limitIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, io.EOF
 	}
 
-	childRow, err := li.childIter.Next(ctx)
+	childRow, err := li.nextChildRow(ctx)
 	if err != nil {
 		return nil, err
 	}
 	li.currentPos++
+	if li.withTies {
+		li.lastRow = childRow
+	}
 
 	return childRow, nil
 }
 
+// bufferRows reads all the rows of the child to compute the number of rows returned by a PERCENT limit, which is
+// rounded up.
+func (li *limitIter) bufferRows(ctx *sql.Context) error {
+	for {
+		row, err := li.childIter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		li.rows = append(li.rows, row)
+	}
+	li.buffered = true
+
+	total := int64(len(li.rows)) + li.offset
+	li.limit = (total*li.limit + 99) / 100
+	return nil
+}
+
+func (li *limitIter) nextChildRow(ctx *sql.Context) (sql.Row, error) {
+	if !li.buffered {
+		return li.childIter.Next(ctx)
+	}
+	if len(li.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := li.rows[0]
+	li.rows = li.rows[1:]
+	return row, nil
+}
+
+// isTie returns whether |row| sorts equal to the last row returned.
+func (li *limitIter) isTie(ctx *sql.Context, row sql.Row) (bool, error) {
+	sorter := &expression.Sorter{
+		SortFields: li.sortFields,
+		Rows:       []sql.Row{li.lastRow, row},
+		Ctx:        ctx,
+	}
+	tie := !sorter.Less(0, 1) && !sorter.Less(1, 0)
+	return tie, sorter.LastError
+}
+
 func (li *limitIter) Close(ctx *sql.Context) error {
+	li.rows = nil
 	err := li.childIter.Close(ctx)
 	if err != nil {
 		return err
@@ -1114,7 +1283,10 @@ func (ii *intersectIter) Next(ctx *sql.Context) (sql.Row, error) {
 		ii.cache = make(map[uint64]int)
 		for {
 			res, err := ii.rIter.Next(ctx)
-			if err != nil && err != io.EOF {
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
 				return nil, err
 			}
 
@@ -1122,14 +1294,7 @@ func (ii *intersectIter) Next(ctx *sql.Context) (sql.Row, error) {
 			if herr != nil {
 				return nil, herr
 			}
-			if _, ok := ii.cache[hash]; !ok {
-				ii.cache[hash] = 0
-			}
 			ii.cache[hash]++
-
-			if err == io.EOF {
-				break
-			}
 		}
 		ii.cached = true
 	}
@@ -1181,7 +1346,10 @@ func (ei *exceptIter) Next(ctx *sql.Context) (sql.Row, error) {
 		ei.cache = make(map[uint64]int)
 		for {
 			res, err := ei.rIter.Next(ctx)
-			if err != nil && err != io.EOF {
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
 				return nil, err
 			}
 
@@ -1189,14 +1357,7 @@ func (ei *exceptIter) Next(ctx *sql.Context) (sql.Row, error) {
 			if herr != nil {
 				return nil, herr
 			}
-			if _, ok := ei.cache[hash]; !ok {
-				ei.cache[hash] = 0
-			}
 			ei.cache[hash]++
-
-			if err == io.EOF {
-				break
-			}
 		}
 		ei.cached = true
 	}
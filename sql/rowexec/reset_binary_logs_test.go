@@ -0,0 +1,59 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+type testSourceController struct {
+	resets     int
+	toPosition uint64
+}
+
+var _ binlogreplication.BinlogSourceController = (*testSourceController)(nil)
+
+func (c *testSourceController) ResetBinaryLogs(_ *sql.Context, toPosition uint64) error {
+	c.resets++
+	c.toPosition = toPosition
+	return nil
+}
+
+func TestResetBinaryLogs(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	n := plan.NewResetBinaryLogs(0)
+	require.Equal("RESET BINARY LOGS AND GTIDS", n.String())
+	_, err := DefaultBuilder.Build(ctx, n, nil)
+	require.True(plan.ErrNoSourceController.Is(err))
+
+	controller := &testSourceController{}
+	n = plan.NewResetBinaryLogs(5)
+	require.Equal("RESET BINARY LOGS AND GTIDS TO 5", n.String())
+	iter, err := DefaultBuilder.Build(ctx, n.WithBinlogSourceController(controller), nil)
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Empty(rows)
+	require.Equal(1, controller.resets)
+	require.Equal(uint64(5), controller.toPosition)
+}
@@ -18,7 +18,6 @@ import (
 	"context"
 	"testing"
 
-	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/src-d/go-errors.v1"
@@ -48,7 +47,7 @@ func TestSet(t *testing.T) {
 
 	typ, v, err := ctx.GetUserVariable(ctx, "foo")
 	require.NoError(err)
-	require.Equal(types.MustCreateStringWithDefaults(sqltypes.VarChar, 3), typ)
+	require.Equal(types.LongText, typ)
 	require.Equal("bar", v)
 
 	typ, v, err = ctx.GetUserVariable(ctx, "baz")
@@ -113,3 +112,27 @@ func TestPersistedSessionSetIterator(t *testing.T) {
 		})
 	}
 }
+
+func TestSetWithoutPersistence(t *testing.T) {
+	for _, scope := range []sql.SystemVariableScope{
+		sql.SystemVariableScope_Persist,
+		sql.SystemVariableScope_PersistOnly,
+		sql.SystemVariableScope_ResetPersist,
+	} {
+		t.Run(scope.String(), func(t *testing.T) {
+			variables.InitSystemVariables()
+			ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+			s := plan.NewSet(
+				[]sql.Expression{
+					expression.NewSetField(expression.NewSystemVar("max_connections", scope, ""), expression.NewLiteral(int64(10), types.Int64)),
+				},
+			)
+
+			_, err := DefaultBuilder.Build(ctx, s, nil)
+			require.True(t, sql.ErrSessionDoesNotSupportPersistence.Is(err))
+
+			_, val, _ := sql.SystemVariables.GetGlobal("max_connections")
+			assert.Equal(t, int64(151), val)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// statementHintIter restores the session state changed by the SET_VAR and RESOURCE_GROUP optimizer hints of a
+// statement once it has finished executing.
+type statementHintIter struct {
+	iter    sql.RowIter
+	restore func(ctx *sql.Context) error
+}
+
+var _ sql.RowIter = (*statementHintIter)(nil)
+
+// AddSetVarHintRestore returns a new iterator that calls |restore| when |iter| is closed, to restore the system
+// variables overridden by SET_VAR hints for the statement being executed.
+func AddSetVarHintRestore(iter sql.RowIter, restore func(ctx *sql.Context) error) sql.RowIter {
+	return &statementHintIter{
+		iter:    iter,
+		restore: restore,
+	}
+}
+
+// AddResourceGroupHintRestore returns a new iterator that calls |restore| when |iter| is closed, to move the thread of
+// the session back from the resource group named by a RESOURCE_GROUP hint for the statement being executed.
+func AddResourceGroupHintRestore(iter sql.RowIter, restore func(ctx *sql.Context) error) sql.RowIter {
+	return &statementHintIter{
+		iter:    iter,
+		restore: restore,
+	}
+}
+
+// Next implements the interface sql.RowIter.
+func (i *statementHintIter) Next(ctx *sql.Context) (sql.Row, error) {
+	return i.iter.Next(ctx)
+}
+
+// Close implements the interface sql.RowIter.
+func (i *statementHintIter) Close(ctx *sql.Context) error {
+	err := i.iter.Close(ctx)
+	if restoreErr := i.restore(ctx); err == nil {
+		err = restoreErr
+	}
+	return err
+}
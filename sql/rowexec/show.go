@@ -18,7 +18,6 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"io"
 	"sort"
 	"strings"
 
@@ -53,29 +52,22 @@ func (b *BaseBuilder) buildShowCharset(ctx *sql.Context, n *plan.ShowCharset, ro
 }
 
 func (b *BaseBuilder) buildDescribeQuery(ctx *sql.Context, n *plan.DescribeQuery, row sql.Row) (sql.RowIter, error) {
+	var formatString string
 	if n.Format.Analyze {
-		if !n.IsReadOnly() {
+		if !n.Child.IsReadOnly() {
 			return nil, errors.New("cannot analyze statement that could have side effects")
 		}
 		// Iterate over the child until its exhausted, in order to populate the stats.
-		childIter, err := b.Build(ctx, n.Child, row)
-		if err != nil {
+		stats := sql.NewExecStatsCollector()
+		if err := b.drainWithStats(ctx.WithExecStats(stats), n.Child, row); err != nil {
 			return nil, err
 		}
-		for {
-			_, err := childIter.Next(ctx)
-			if err == io.EOF {
-				break
-			}
-			if err != nil {
-				return nil, err
-			}
-		}
+		formatString = plan.DescribeAnalyze(n.Child, stats)
+	} else {
+		formatString = sql.Describe(n.Child, n.Format)
 	}
 
 	var rows []sql.Row
-	formatString := sql.Describe(n.Child, n.Format)
-
 	for _, l := range strings.Split(formatString, "\n") {
 		if strings.TrimSpace(l) != "" {
 			rows = append(rows, sql.NewRow(l))
@@ -119,7 +111,9 @@ func (b *BaseBuilder) buildShowProcessList(ctx *sql.Context, n *plan.ShowProcess
 			status = append(status, printer.String())
 		}
 
-		if len(status) == 0 && proc.Command == sql.ProcessCommandQuery {
+		if proc.State != "" {
+			status = []string{proc.State}
+		} else if len(status) == 0 && proc.Command == sql.ProcessCommandQuery {
 			status = []string{"running"}
 		}
 
@@ -498,8 +492,13 @@ func (b *BaseBuilder) buildShowColumns(ctx *sql.Context, n *plan.ShowColumns, ro
 
 		extra := col.Extra
 		// If extra is not defined, fill it here.
-		if extra == "" && !col.Default.IsLiteral() {
-			extra = "DEFAULT_GENERATED"
+		if extra == "" {
+			if !col.Default.IsLiteral() {
+				extra = "DEFAULT_GENERATED"
+			}
+			if col.OnUpdate != nil {
+				extra = strings.TrimSpace(fmt.Sprintf("%s on update %s", extra, col.OnUpdate.String()))
+			}
 		}
 
 		if n.Full {
@@ -644,34 +643,69 @@ func (b *BaseBuilder) buildShowGrants(ctx *sql.Context, n *plan.ShowGrants, row
 	}
 
 	//TODO: implement USING, perhaps by creating a new context with the chosen roles set as the active roles
+	// Grants are listed in the same order as MySQL: global static privileges, global dynamic privileges, then the
+	// database, table, and routine privileges of every database, and finally the granted roles.
 	var rows []sql.Row
 	userStr := user.UserHostToString("`")
 	privStr := generatePrivStrings("*", "*", userStr, user.PrivilegeSet.ToSlice())
 	rows = append(rows, sql.Row{privStr})
 
-	for _, db := range user.PrivilegeSet.GetDatabases() {
+	sb := strings.Builder{}
+	for i, dynamicPrivWithWgo := range user.PrivilegeSet.ToSliceDynamic(true) {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(dynamicPrivWithWgo)
+	}
+	if sb.Len() > 0 {
+		rows = append(rows, sql.Row{fmt.Sprintf("GRANT %s ON *.* TO %s WITH GRANT OPTION", sb.String(), userStr)})
+	}
+	sb.Reset()
+	for i, dynamicPrivWithoutWgo := range user.PrivilegeSet.ToSliceDynamic(false) {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(dynamicPrivWithoutWgo)
+	}
+	if sb.Len() > 0 {
+		rows = append(rows, sql.Row{fmt.Sprintf("GRANT %s ON *.* TO %s", sb.String(), userStr)})
+	}
+
+	dbs := user.PrivilegeSet.GetDatabases()
+	for _, db := range dbs {
 		dbStr := fmt.Sprintf("`%s`", db.Name())
 		if privStr = generatePrivStrings(dbStr, "*", userStr, db.ToSlice()); len(privStr) != 0 {
 			rows = append(rows, sql.Row{privStr})
 		}
+	}
 
+	for _, db := range dbs {
+		dbStr := fmt.Sprintf("`%s`", db.Name())
 		for _, tbl := range db.GetTables() {
 			tblStr := fmt.Sprintf("`%s`", tbl.Name())
-			privStr = generatePrivStrings(dbStr, tblStr, userStr, tbl.ToSlice())
-			rows = append(rows, sql.Row{privStr})
-		}
-
-		for _, routine := range db.GetRoutines() {
-			quotedRoutine := fmt.Sprintf("`%s`", routine.RoutineName())
-			privStr = generateRoutinePrivStrings(dbStr, quotedRoutine, routine.RoutineType(), userStr, routine.ToSlice())
-			rows = append(rows, sql.Row{privStr})
+			if privStr = generatePrivStrings(dbStr, tblStr, userStr, tbl.ToSlice()); len(privStr) != 0 {
+				rows = append(rows, sql.Row{privStr})
+			}
 		}
-
 		// TODO: display column privileges
 	}
 
-	sb := strings.Builder{}
+	// Procedures are listed before functions
+	for _, routineType := range []string{"PROCEDURE", "FUNCTION"} {
+		for _, db := range dbs {
+			dbStr := fmt.Sprintf("`%s`", db.Name())
+			for _, routine := range db.GetRoutines() {
+				if routine.RoutineType() != routineType {
+					continue
+				}
+				quotedRoutine := fmt.Sprintf("`%s`", routine.RoutineName())
+				privStr = generateRoutinePrivStrings(dbStr, quotedRoutine, routine.RoutineType(), userStr, routine.ToSlice())
+				rows = append(rows, sql.Row{privStr})
+			}
+		}
+	}
 
+	sb.Reset()
 	roleEdges := reader.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{
 		ToHost: user.Host,
 		ToUser: user.User,
@@ -683,28 +717,7 @@ func (b *BaseBuilder) buildShowGrants(ctx *sql.Context, n *plan.ShowGrants, row
 		sb.WriteString(roleEdge.FromString("`"))
 	}
 	if sb.Len() > 0 {
-		rows = append(rows, sql.Row{fmt.Sprintf("GRANT %s TO %s", sb.String(), user.UserHostToString("`"))})
-	}
-
-	sb.Reset()
-	for i, dynamicPrivWithWgo := range user.PrivilegeSet.ToSliceDynamic(true) {
-		if i > 0 {
-			sb.WriteString(", ")
-		}
-		sb.WriteString(dynamicPrivWithWgo)
-	}
-	if sb.Len() > 0 {
-		rows = append(rows, sql.Row{fmt.Sprintf("GRANT %s ON *.* TO %s WITH GRANT OPTION", sb.String(), user.UserHostToString("`"))})
-	}
-	sb.Reset()
-	for i, dynamicPrivWithoutWgo := range user.PrivilegeSet.ToSliceDynamic(false) {
-		if i > 0 {
-			sb.WriteString(", ")
-		}
-		sb.WriteString(dynamicPrivWithoutWgo)
-	}
-	if sb.Len() > 0 {
-		rows = append(rows, sql.Row{fmt.Sprintf("GRANT %s ON *.* TO %s", sb.String(), user.UserHostToString("`"))})
+		rows = append(rows, sql.Row{fmt.Sprintf("GRANT %s TO %s", sb.String(), userStr)})
 	}
 	return sql.RowsToRowIter(rows...), nil
 }
@@ -715,9 +728,19 @@ func (b *BaseBuilder) buildShowIndexes(ctx *sql.Context, n *plan.ShowIndexes, ro
 		panic(fmt.Sprintf("unexpected type %T", n.Child))
 	}
 
+	var stats []sql.Statistic
+	if n.Stats != nil && len(n.IndexesToShow) > 0 {
+		var err error
+		stats, err = n.Stats.GetTableStats(ctx, table.SqlDatabase.Name(), table.Name())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &showIndexesIter{
 		table: table,
 		idxs:  newIndexesToShow(n.IndexesToShow),
+		stats: stats,
 	}, nil
 }
 
@@ -732,6 +755,89 @@ func (b *BaseBuilder) buildShowCreateTable(ctx *sql.Context, n *plan.ShowCreateT
 	}, nil
 }
 
+func (b *BaseBuilder) buildShowReplicas(ctx *sql.Context, n *plan.ShowReplicas, row sql.Row) (sql.RowIter, error) {
+	if n.PrimaryController == nil {
+		return nil, plan.ErrNoPrimaryController.New()
+	}
+
+	replicas, err := n.PrimaryController.GetBinlogReplicaStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]sql.Row, len(replicas))
+	for i, replica := range replicas {
+		rows[i] = sql.Row{
+			replica.ServerId,
+			replica.Host,
+			replica.Port,
+			replica.SourceId,
+			replica.ReplicaUuid,
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func (b *BaseBuilder) buildShowBinaryLogs(ctx *sql.Context, n *plan.ShowBinaryLogs, row sql.Row) (sql.RowIter, error) {
+	// MySQL returns an empty result when binary logging is disabled
+	if n.PrimaryController == nil {
+		return sql.RowsToRowIter(), nil
+	}
+
+	logs, err := n.PrimaryController.ListBinaryLogs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]sql.Row, len(logs))
+	for i, log := range logs {
+		encrypted := "No"
+		if log.Encrypted {
+			encrypted = "Yes"
+		}
+		rows[i] = sql.Row{
+			log.Name,
+			log.Size,
+			encrypted,
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func (b *BaseBuilder) buildShowBinlogEvents(ctx *sql.Context, n *plan.ShowBinlogEvents, row sql.Row) (sql.RowIter, error) {
+	// MySQL returns an empty result when binary logging is disabled
+	if n.PrimaryController == nil || n.Limit == 0 {
+		return sql.RowsToRowIter(), nil
+	}
+
+	// The controller only limits the number of events, so any offset is applied here
+	limit := n.Limit
+	if limit > 0 {
+		limit += n.Offset
+	}
+	events, err := n.PrimaryController.ListBinlogEvents(ctx, n.LogName, n.Pos, limit)
+	if err != nil {
+		return nil, err
+	}
+	if n.Offset >= int64(len(events)) {
+		return sql.RowsToRowIter(), nil
+	}
+	events = events[n.Offset:]
+
+	rows := make([]sql.Row, len(events))
+	for i, event := range events {
+		rows[i] = sql.Row{
+			event.LogName,
+			event.Pos,
+			event.EventType,
+			event.ServerId,
+			event.EndLogPos,
+			event.Info,
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
 func (b *BaseBuilder) buildShowReplicaStatus(ctx *sql.Context, n *plan.ShowReplicaStatus, row sql.Row) (sql.RowIter, error) {
 	if n.ReplicaController == nil {
 		return sql.RowsToRowIter(), nil
@@ -807,6 +913,7 @@ func (b *BaseBuilder) buildShowReplicaStatus(ctx *sql.Context, n *plan.ShowRepli
 		status.ExecutedGtidSet,   // Executed_Gtid_Set
 		status.AutoPosition,      // Auto_Position
 		nil,                      // Replicate_Rewrite_DB
+		"",                       // Channel_Name
 	}
 
 	return sql.RowsToRowIter(row), nil
@@ -0,0 +1,110 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestShowBinaryLogs(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	// without a controller, binary logging is disabled and there is nothing to show
+	iter, err := DefaultBuilder.Build(ctx, plan.NewShowBinaryLogs(), nil)
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Empty(rows)
+
+	controller := &testPrimaryController{logs: []binlogreplication.BinaryLogFile{
+		{Name: "binlog.000001", Size: 1024},
+		{Name: "binlog.000002", Size: 157, Encrypted: true},
+	}}
+	iter, err = DefaultBuilder.Build(ctx, plan.NewShowBinaryLogs().WithBinlogPrimaryController(controller), nil)
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"binlog.000001", uint64(1024), "No"},
+		{"binlog.000002", uint64(157), "Yes"},
+	}, rows)
+}
+
+func TestShowBinlogEvents(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	iter, err := DefaultBuilder.Build(ctx, plan.NewShowBinlogEvents("", 0, 0, -1), nil)
+	require.NoError(t, err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+
+	controller := &testPrimaryController{
+		logs: []binlogreplication.BinaryLogFile{
+			{Name: "binlog.000001", Size: 1024},
+			{Name: "binlog.000002", Size: 157},
+		},
+		events: []binlogreplication.BinlogEvent{
+			{LogName: "binlog.000001", Pos: 4, EventType: "Format_desc", ServerId: 1, EndLogPos: 126, Info: "Server ver: 8.0.33, Binlog ver: 4"},
+			{LogName: "binlog.000001", Pos: 126, EventType: "Previous_gtids", ServerId: 1, EndLogPos: 157, Info: ""},
+			{LogName: "binlog.000001", Pos: 157, EventType: "Rotate", ServerId: 1, EndLogPos: 204, Info: "binlog.000002;pos=4"},
+			{LogName: "binlog.000002", Pos: 4, EventType: "Format_desc", ServerId: 1, EndLogPos: 126, Info: "Server ver: 8.0.33, Binlog ver: 4"},
+		},
+	}
+	event := func(i int) sql.Row {
+		e := controller.events[i]
+		return sql.Row{e.LogName, e.Pos, e.EventType, e.ServerId, e.EndLogPos, e.Info}
+	}
+
+	testCases := []struct {
+		name     string
+		node     *plan.ShowBinlogEvents
+		expected []sql.Row
+	}{
+		{"first log", plan.NewShowBinlogEvents("", 0, 0, -1), []sql.Row{event(0), event(1), event(2)}},
+		{"named log", plan.NewShowBinlogEvents("binlog.000002", 0, 0, -1), []sql.Row{event(3)}},
+		{"from position", plan.NewShowBinlogEvents("binlog.000001", 126, 0, -1), []sql.Row{event(1), event(2)}},
+		{"limit", plan.NewShowBinlogEvents("", 0, 0, 2), []sql.Row{event(0), event(1)}},
+		{"limit with offset", plan.NewShowBinlogEvents("", 0, 1, 1), []sql.Row{event(1)}},
+		{"offset past end", plan.NewShowBinlogEvents("", 0, 5, 1), []sql.Row{}},
+		{"zero limit", plan.NewShowBinlogEvents("", 0, 0, 0), []sql.Row{}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			iter, err := DefaultBuilder.Build(ctx, tt.node.WithBinlogPrimaryController(controller), nil)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+			if len(tt.expected) == 0 {
+				require.Empty(t, rows)
+			} else {
+				require.Equal(t, tt.expected, rows)
+			}
+		})
+	}
+}
+
+func TestShowBinlogEventsString(t *testing.T) {
+	require.Equal(t, "SHOW BINLOG EVENTS", plan.NewShowBinlogEvents("", 0, 0, -1).String())
+	require.Equal(t, "SHOW BINLOG EVENTS IN 'binlog.000001' FROM 4 LIMIT 1, 2", plan.NewShowBinlogEvents("binlog.000001", 4, 1, 2).String())
+}
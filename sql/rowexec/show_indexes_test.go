@@ -122,7 +122,7 @@ func TestShowIndexes(t *testing.T) {
 					i+1,
 					columnName,
 					nil,
-					int64(0),
+					nil,
 					nil,
 					nil,
 					nullable,
@@ -103,27 +103,23 @@ func tableToStatusRow(table string, numRows uint64, dataLength uint64, collation
 	)
 }
 
-// generatePrivStrings creates a formatted GRANT <privilege_list> on <global/database/table> to <user@host> string
+// generatePrivStrings creates a formatted GRANT <privilege_list> on <global/database/table> to <user@host> string.
+// GRANT OPTION is held separately at each level, so it is rendered as WITH GRANT OPTION even when it is the only
+// privilege at the level.
 func generatePrivStrings(db, tbl, user string, privs []sql.PrivilegeType) string {
-	sb := strings.Builder{}
+	privStrs := make([]string, 0, len(privs))
 	withGrantOption := ""
-	for i, priv := range privs {
-		privStr := priv.String()
-		if privStr == sql.PrivilegeType_GrantOption.String() {
-			if len(privs) > 1 {
-				withGrantOption = " WITH GRANT OPTION"
-			}
+	for _, priv := range privs {
+		if priv == sql.PrivilegeType_GrantOption {
+			withGrantOption = " WITH GRANT OPTION"
 		} else {
-			if i > 0 {
-				sb.WriteString(", ")
-			}
-			sb.WriteString(privStr)
+			privStrs = append(privStrs, priv.String())
 		}
 	}
 	// handle special case for empty global and database privileges
-	privStr := sb.String()
+	privStr := strings.Join(privStrs, ", ")
 	if len(privStr) == 0 {
-		if db == "*" {
+		if db == "*" || len(withGrantOption) > 0 {
 			privStr = "USAGE"
 		} else {
 			return ""
@@ -205,6 +201,7 @@ func (i *indexesToShow) next() (*idxToShow, error) {
 type showIndexesIter struct {
 	table *plan.ResolvedTable
 	idxs  *indexesToShow
+	stats []sql.Statistic
 }
 
 func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -241,6 +238,9 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 		nonUnique = 1
 	}
 
+	// cardinality is NULL for tables without statistics
+	cardinality := sql.IndexCardinalities(i.stats, show.index)[show.exPosition]
+
 	return sql.NewRow(
 		show.index.Table(),     // "Table" string
 		nonUnique,              // "Non_unique" int32, Values [0, 1]
@@ -248,7 +248,7 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 		show.exPosition+1,      // "Seq_in_index" int32
 		columnName,             // "Column_name" string
 		nil,                    // "Collation" string, Values [A, D, NULL]
-		int64(0),               // "Cardinality" int64 (not calculated)
+		cardinality,            // "Cardinality" int64
 		nil,                    // "Sub_part" int64
 		nil,                    // "Packed" string
 		nullable,               // "Null" string, Values [YES, '']
@@ -376,6 +376,21 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 				if err != nil {
 					return "", err
 				}
+				// enum and set defaults are stored as their index and bit set, but are shown as their string values
+				switch t := col.Type.(type) {
+				case sql.EnumType:
+					if idx, ok := v.(uint16); ok {
+						if str, ok := t.At(int(idx)); ok {
+							v = str
+						}
+					}
+				case sql.SetType:
+					if bits, ok := v.(uint64); ok {
+						if str, err := t.BitsToString(bits); err == nil {
+							v = str
+						}
+					}
+				}
 				colDefaultStr = fmt.Sprintf("'%v'", v)
 			}
 		}
@@ -463,11 +478,15 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 }
 
 func produceCreateViewStatement(view *plan.SubqueryAlias) string {
-	return fmt.Sprintf(
+	stmt := fmt.Sprintf(
 		"CREATE VIEW `%s` AS %s",
 		view.Name(),
 		view.TextDefinition,
 	)
+	if view.CheckOption != "" {
+		stmt += fmt.Sprintf(" WITH %s CHECK OPTION", view.CheckOption)
+	}
+	return stmt
 }
 
 func (i *showCreateTablesIter) Close(*sql.Context) error {
@@ -0,0 +1,88 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+type testPrimaryController struct {
+	replicas []binlogreplication.ConnectedReplica
+	logs     []binlogreplication.BinaryLogFile
+	events   []binlogreplication.BinlogEvent
+}
+
+var _ binlogreplication.BinlogPrimaryController = (*testPrimaryController)(nil)
+
+func (c *testPrimaryController) GetBinlogReplicaStatuses(_ *sql.Context) ([]binlogreplication.ConnectedReplica, error) {
+	return c.replicas, nil
+}
+
+func (c *testPrimaryController) ListBinaryLogs(_ *sql.Context) ([]binlogreplication.BinaryLogFile, error) {
+	return c.logs, nil
+}
+
+func (c *testPrimaryController) ListBinlogEvents(_ *sql.Context, logName string, startPos uint64, limit int64) ([]binlogreplication.BinlogEvent, error) {
+	if logName == "" && len(c.logs) > 0 {
+		logName = c.logs[0].Name
+	}
+	var events []binlogreplication.BinlogEvent
+	for _, event := range c.events {
+		if event.LogName != logName || event.Pos < startPos {
+			continue
+		}
+		if limit >= 0 && int64(len(events)) >= limit {
+			break
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func TestShowReplicas(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	_, err := DefaultBuilder.Build(ctx, plan.NewShowReplicas(), nil)
+	require.Error(err)
+	require.True(plan.ErrNoPrimaryController.Is(err))
+
+	controller := &testPrimaryController{replicas: []binlogreplication.ConnectedReplica{
+		{ServerId: 2, Host: "replica1", Port: 3306, SourceId: 1, ReplicaUuid: "3e11fa47-71ca-11e1-9e33-c80aa9429562"},
+		{ServerId: 3, Host: "replica2", Port: 3307, SourceId: 1, ReplicaUuid: "3e11fa47-71ca-11e1-9e33-c80aa9429563"},
+	}}
+	n := plan.NewShowReplicas().WithBinlogPrimaryController(controller)
+	iter, err := DefaultBuilder.Build(ctx, n, nil)
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{uint32(2), "replica1", uint16(3306), uint32(1), "3e11fa47-71ca-11e1-9e33-c80aa9429562"},
+		{uint32(3), "replica2", uint16(3307), uint32(1), "3e11fa47-71ca-11e1-9e33-c80aa9429563"},
+	}, rows)
+
+	controller.replicas = nil
+	iter, err = DefaultBuilder.Build(ctx, n, nil)
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Empty(rows)
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+type testReplicaController struct {
+	started      bool
+	untilOptions []binlogreplication.ReplicationOption
+}
+
+var _ binlogreplication.BinlogReplicaController = (*testReplicaController)(nil)
+
+func (c *testReplicaController) StartReplica(_ *sql.Context, untilOptions []binlogreplication.ReplicationOption) error {
+	c.started = true
+	c.untilOptions = untilOptions
+	return nil
+}
+
+func (c *testReplicaController) StopReplica(_ *sql.Context) error {
+	c.started = false
+	return nil
+}
+
+func (c *testReplicaController) SetReplicationSourceOptions(_ *sql.Context, _ []binlogreplication.ReplicationOption) error {
+	return nil
+}
+
+func (c *testReplicaController) SetReplicationFilterOptions(_ *sql.Context, _ []binlogreplication.ReplicationOption) error {
+	return nil
+}
+
+func (c *testReplicaController) GetReplicaStatus(_ *sql.Context) (*binlogreplication.ReplicaStatus, error) {
+	return nil, nil
+}
+
+func (c *testReplicaController) ResetReplica(_ *sql.Context, _ bool) error {
+	return nil
+}
+
+func TestStartReplicaUntil(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	untilOptions := []binlogreplication.ReplicationOption{
+		*binlogreplication.NewReplicationOption("SOURCE_LOG_FILE", binlogreplication.StringReplicationOptionValue{Value: "binlog.000002"}),
+		*binlogreplication.NewReplicationOption("SOURCE_LOG_POS", binlogreplication.IntegerReplicationOptionValue{Value: 1234}),
+	}
+
+	n := plan.NewStartReplica(nil)
+	require.Equal("START REPLICA", n.String())
+	n = plan.NewStartReplica(untilOptions)
+	require.Equal("START REPLICA UNTIL SOURCE_LOG_FILE = binlog.000002, SOURCE_LOG_POS = 1234", n.String())
+
+	_, err := DefaultBuilder.Build(ctx, n, nil)
+	require.True(plan.ErrNoReplicationController.Is(err))
+
+	controller := &testReplicaController{}
+	iter, err := DefaultBuilder.Build(ctx, n.WithBinlogReplicaController(controller), nil)
+	require.NoError(err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.True(controller.started)
+	require.Equal(untilOptions, controller.untilOptions)
+}
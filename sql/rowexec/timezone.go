@@ -0,0 +1,189 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+)
+
+// utcTimeZone is the time zone that the values of sql.Column.ConvertTimezone columns are stored in.
+const utcTimeZone = "+00:00"
+
+// timezoneColumns returns the indexes of the columns in |sch| that are stored in UTC, or nil if there are none.
+func timezoneColumns(sch sql.Schema) []int {
+	var cols []int
+	for i, col := range sch {
+		if !col.ConvertTimezone {
+			continue
+		}
+		if _, ok := col.Type.(sql.DatetimeType); ok {
+			cols = append(cols, i)
+		}
+	}
+	return cols
+}
+
+// convertRowTimezone returns a copy of |row| with the values of |cols| converted from the time zone |fromTz| to
+// |toTz|. The row itself is returned when there is nothing to convert.
+func convertRowTimezone(sch sql.Schema, cols []int, row sql.Row, fromTz, toTz string) (sql.Row, error) {
+	if len(cols) == 0 || row == nil || fromTz == toTz {
+		return row, nil
+	}
+	converted := row.Copy()
+	for _, i := range cols {
+		if i >= len(converted) || converted[i] == nil {
+			continue
+		}
+		v, _, err := sch[i].Type.(sql.DatetimeType).ConvertTimezone(converted[i], fromTz, toTz)
+		if err != nil {
+			return nil, err
+		}
+		converted[i] = v
+	}
+	return converted, nil
+}
+
+// rowToUTC converts the values of the UTC columns of |sch| in |row| from the session time zone to UTC, ready to be
+// written to the table.
+func rowToUTC(ctx *sql.Context, sch sql.Schema, row sql.Row) (sql.Row, error) {
+	cols := timezoneColumns(sch)
+	if len(cols) == 0 {
+		return row, nil
+	}
+	tz, err := function.SessionTimeZone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertRowTimezone(sch, cols, row, tz, utcTimeZone)
+}
+
+// rowFromUTC converts the values of the UTC columns of |sch| in |row|, as read from the table, to the session time
+// zone.
+func rowFromUTC(ctx *sql.Context, sch sql.Schema, row sql.Row) (sql.Row, error) {
+	cols := timezoneColumns(sch)
+	if len(cols) == 0 {
+		return row, nil
+	}
+	tz, err := function.SessionTimeZone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertRowTimezone(sch, cols, row, utcTimeZone, tz)
+}
+
+// timezoneIter converts the values of the UTC columns of the rows read from a table to the session time zone.
+type timezoneIter struct {
+	childIter sql.RowIter
+	sch       sql.Schema
+	cols      []int
+	tz        string
+}
+
+var _ sql.RowIter = (*timezoneIter)(nil)
+
+// newTimezoneIter wraps |iter|, which returns rows of the table schema |sch|, so that the values of its UTC columns
+// are returned in the session time zone. |iter| is returned as is if the schema has no such columns.
+func newTimezoneIter(ctx *sql.Context, sch sql.Schema, iter sql.RowIter) (sql.RowIter, error) {
+	cols := timezoneColumns(sch)
+	if len(cols) == 0 {
+		return iter, nil
+	}
+	tz, err := function.SessionTimeZone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &timezoneIter{childIter: iter, sch: sch, cols: cols, tz: tz}, nil
+}
+
+func (i *timezoneIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.childIter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertRowTimezone(i.sch, i.cols, row, utcTimeZone, i.tz)
+}
+
+func (i *timezoneIter) Close(ctx *sql.Context) error {
+	return i.childIter.Close(ctx)
+}
+
+// lookupToUTC converts the bounds of |lookup| on UTC columns of |table| from the session time zone to UTC, so that
+// they match the values stored in the index.
+func lookupToUTC(ctx *sql.Context, table sql.Table, lookup sql.IndexLookup) (sql.IndexLookup, error) {
+	// the index may be on columns that are not projected
+	sch := table.Schema()
+	if pkt, ok := table.(sql.PrimaryKeyTable); ok {
+		sch = pkt.PrimaryKeySchema().Schema
+	}
+	if lookup.Index == nil || len(timezoneColumns(sch)) == 0 {
+		return lookup, nil
+	}
+	var types []sql.DatetimeType
+	var found bool
+	for i, expr := range lookup.Index.Expressions() {
+		types = append(types, nil)
+		idx := sch.IndexOfColName(expr[strings.LastIndex(expr, ".")+1:])
+		if idx < 0 || !sch[idx].ConvertTimezone {
+			continue
+		}
+		if typ, ok := sch[idx].Type.(sql.DatetimeType); ok {
+			types[i] = typ
+			found = true
+		}
+	}
+	if !found {
+		return lookup, nil
+	}
+
+	tz, err := function.SessionTimeZone(ctx)
+	if err != nil {
+		return sql.IndexLookup{}, err
+	}
+	newRanges := make(sql.RangeCollection, len(lookup.Ranges))
+	for i, rang := range lookup.Ranges {
+		newRange := make(sql.Range, len(rang))
+		for j, expr := range rang {
+			if j < len(types) && types[j] != nil {
+				if expr.LowerBound, err = rangeCutToUTC(expr.LowerBound, types[j], tz); err != nil {
+					return sql.IndexLookup{}, err
+				}
+				if expr.UpperBound, err = rangeCutToUTC(expr.UpperBound, types[j], tz); err != nil {
+					return sql.IndexLookup{}, err
+				}
+			}
+			newRange[j] = expr
+		}
+		newRanges[i] = newRange
+	}
+	lookup.Ranges = newRanges
+	return lookup, nil
+}
+
+// rangeCutToUTC converts the key of |cut|, if it has one, from the time zone |tz| to UTC.
+func rangeCutToUTC(cut sql.RangeCut, typ sql.DatetimeType, tz string) (sql.RangeCut, error) {
+	switch c := cut.(type) {
+	case sql.Above:
+		key, _, err := typ.ConvertTimezone(c.Key, tz, utcTimeZone)
+		return sql.Above{Key: key}, err
+	case sql.Below:
+		key, _, err := typ.ConvertTimezone(c.Key, tz, utcTimeZone)
+		return sql.Below{Key: key}, err
+	default:
+		return cut, nil
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowexec
+
+import (
+	"io"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// traceHookIter notifies a sql.TraceHook of each row a query returns, and of the end of its execution.
+type traceHookIter struct {
+	iter  sql.RowIter
+	hook  sql.TraceHook
+	query string
+	start time.Time
+	rows  int64
+	err   error
+}
+
+var _ sql.RowIter = (*traceHookIter)(nil)
+
+// AddTraceHook returns a new iterator that notifies |hook| of each row returned by |iter|, the iterator for |query|,
+// and of the end of the execution of |query| when it is closed. |start| is the time the execution began.
+func AddTraceHook(iter sql.RowIter, hook sql.TraceHook, query string, start time.Time) sql.RowIter {
+	return &traceHookIter{
+		iter:  iter,
+		hook:  hook,
+		query: query,
+		start: start,
+	}
+}
+
+// Next implements the interface sql.RowIter.
+func (i *traceHookIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.iter.Next(ctx)
+	if err != nil {
+		if err != io.EOF && i.err == nil {
+			i.err = err
+		}
+		return nil, err
+	}
+	i.rows++
+	i.hook.OnRowRead(ctx, i.query, row)
+	return row, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (i *traceHookIter) Close(ctx *sql.Context) error {
+	err := i.iter.Close(ctx)
+	execErr := i.err
+	if execErr == nil {
+		execErr = err
+	}
+	i.hook.OnExecute(ctx, i.query, i.rows, time.Since(i.start), execErr)
+	return err
+}
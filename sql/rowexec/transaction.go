@@ -118,7 +118,7 @@ func (b *BaseBuilder) buildStartReplica(ctx *sql.Context, n *plan.StartReplica,
 		return nil, plan.ErrNoReplicationController.New()
 	}
 
-	err := n.ReplicaController.StartReplica(ctx)
+	err := n.ReplicaController.StartReplica(ctx, n.UntilOptions)
 	return sql.RowsToRowIter(), err
 }
 
@@ -182,6 +182,15 @@ func (b *BaseBuilder) buildResetReplica(ctx *sql.Context, n *plan.ResetReplica,
 	return sql.RowsToRowIter(), err
 }
 
+func (b *BaseBuilder) buildResetBinaryLogs(ctx *sql.Context, n *plan.ResetBinaryLogs, row sql.Row) (sql.RowIter, error) {
+	if n.SourceController == nil {
+		return nil, plan.ErrNoSourceController.New()
+	}
+
+	err := n.SourceController.ResetBinaryLogs(ctx, n.ToPosition)
+	return sql.RowsToRowIter(), err
+}
+
 func (b *BaseBuilder) buildRollback(ctx *sql.Context, n *plan.Rollback, row sql.Row) (sql.RowIter, error) {
 	ts, ok := ctx.Session.(sql.TransactionSession)
 	if !ok {
@@ -302,9 +311,62 @@ func (b *BaseBuilder) buildUse(ctx *sql.Context, n *plan.Use, row sql.Row) (sql.
 }
 
 func (b *BaseBuilder) buildTransactionCommittingNode(ctx *sql.Context, n *plan.TransactionCommittingNode, row sql.Row) (sql.RowIter, error) {
+	implicitCommit := false
+	switch n.DDLMode {
+	case plan.DDLTransactionMode_ImplicitCommit:
+		var err error
+		implicitCommit, err = implicitlyCommitTransaction(ctx)
+		if err != nil {
+			return nil, err
+		}
+	case plan.DDLTransactionMode_Transactional:
+		if ds, ok := ctx.Session.(sql.TransactionalDDLSession); ok && ctx.GetTransaction() != nil {
+			ds.SetTransactionalDDL()
+		}
+	}
+
 	iter, err := b.Build(ctx, n.Child(), row)
 	if err != nil {
 		return nil, err
 	}
-	return transactionCommittingIter{childIter: iter}, nil
+	return transactionCommittingIter{childIter: iter, implicitCommit: implicitCommit}, nil
+}
+
+// implicitlyCommitTransaction commits the session's transaction before a DDL statement that doesn't support
+// transactional DDL is executed, as MySQL does, and starts a new transaction for the statement. Returns whether the
+// transaction was committed, in which case the statement must be committed as soon as it completes, regardless of
+// @@autocommit.
+func implicitlyCommitTransaction(ctx *sql.Context) (bool, error) {
+	tx := ctx.GetTransaction()
+	if tx == nil {
+		return false, nil
+	}
+	autocommit, err := plan.IsSessionAutocommit(ctx)
+	if err != nil {
+		return false, err
+	}
+	if autocommit && !ctx.GetIgnoreAutoCommit() {
+		// this statement's transaction will be committed when it completes anyway
+		return false, nil
+	}
+	ts, ok := ctx.Session.(sql.TransactionSession)
+	if !ok {
+		return false, nil
+	}
+	if ds, ok := ctx.Session.(sql.TransactionalDDLSession); ok && ds.HasTransactionalDDL() {
+		return false, sql.ErrMixedTransactionalDDL.New()
+	}
+
+	ctx.GetLogger().Tracef("implicitly committing transaction %s", tx)
+	if err := ts.CommitTransaction(ctx, tx); err != nil {
+		return false, err
+	}
+	ctx.SetIgnoreAutoCommit(false)
+
+	newTx, err := ts.StartTransaction(ctx, sql.ReadWrite)
+	if err != nil {
+		return false, err
+	}
+	ctx.SetTransaction(newTx)
+	return true, nil
 }
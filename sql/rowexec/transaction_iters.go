@@ -72,6 +72,9 @@ func getLockableTable(table sql.Table) (sql.Lockable, error) {
 type transactionCommittingIter struct {
 	childIter           sql.RowIter
 	transactionDatabase string
+	// implicitCommit is set for DDL statements that implicitly committed an open transaction, and must be committed
+	// as soon as they complete
+	implicitCommit bool
 }
 
 func (t transactionCommittingIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -96,7 +99,7 @@ func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 		return err
 	}
 
-	commitTransaction := ((tx != nil) && !ctx.GetIgnoreAutoCommit()) && autocommit
+	commitTransaction := ((tx != nil) && !ctx.GetIgnoreAutoCommit()) && (autocommit || t.implicitCommit)
 	if commitTransaction {
 		ts, ok := ctx.Session.(sql.TransactionSession)
 		if !ok {
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -51,8 +52,8 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 					return nil, err
 				}
 
-				if sql.IsFalse(res) {
-					return nil, u.ignoreOrError(ctx, newRow, sql.ErrCheckConstraintViolated.New(check.Name))
+				if err := checkViolation(check, res); err != nil {
+					return nil, u.ignoreOrError(ctx, newRow, err)
 				}
 			}
 
@@ -61,7 +62,15 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 				return nil, u.ignoreOrError(ctx, newRow, err)
 			}
 
-			err = u.updater.Update(ctx, oldRow, newRow)
+			storedOldRow, err := rowToUTC(ctx, u.schema, oldRow)
+			if err != nil {
+				return nil, err
+			}
+			storedNewRow, err := rowToUTC(ctx, u.schema, newRow)
+			if err != nil {
+				return nil, u.ignoreOrError(ctx, newRow, err)
+			}
+			err = u.updater.Update(ctx, storedOldRow, storedNewRow)
 			if err != nil {
 				return nil, u.ignoreOrError(ctx, newRow, err)
 			}
@@ -75,7 +84,7 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 
 // Applies the update expressions given to the row given, returning the new resultant row. In the case that ignore is
 // provided and there is a type conversion error, this function sets the value to the zero value as per the MySQL standard.
-func applyUpdateExpressionsWithIgnore(ctx *sql.Context, updateExprs []sql.Expression, tableSchema sql.Schema, row sql.Row, ignore bool) (sql.Row, error) {
+func applyUpdateExpressionsWithIgnore(ctx *sql.Context, updateExprs []sql.Expression, tableSchema sql.Schema, row sql.Row, rowNumber int, ignore bool) (sql.Row, error) {
 	var secondPass []int
 
 	for i, updateExpr := range updateExprs {
@@ -87,7 +96,7 @@ func applyUpdateExpressionsWithIgnore(ctx *sql.Context, updateExprs []sql.Expres
 			continue
 		}
 
-		val, err := updateExpr.Eval(ctx, row)
+		val, err := evalSetField(ctx, updateExpr, row, rowNumber, ignore)
 		if err != nil {
 			var wtce sql.WrappedTypeConversionError
 			isTypeConversionError := errors.As(err, &wtce)
@@ -107,7 +116,7 @@ func applyUpdateExpressionsWithIgnore(ctx *sql.Context, updateExprs []sql.Expres
 	}
 
 	for _, index := range secondPass {
-		val, err := updateExprs[index].Eval(ctx, row)
+		val, err := evalSetField(ctx, updateExprs[index], row, rowNumber, ignore)
 		if err != nil {
 			return nil, err
 		}
@@ -122,6 +131,32 @@ func applyUpdateExpressionsWithIgnore(ctx *sql.Context, updateExprs []sql.Expres
 	return row, nil
 }
 
+// evalSetField evaluates the *expression.SetField |expr| against |row|. A value outside the range of the numeric column
+// being set is handled by outOfRangeValue.
+func evalSetField(ctx *sql.Context, expr sql.Expression, row sql.Row, rowNumber int, ignore bool) (interface{}, error) {
+	val, err := expr.Eval(ctx, row)
+	var wtce sql.WrappedTypeConversionError
+	if err == nil || !errors.As(err, &wtce) || !sql.ErrValueOutOfRange.Is(wtce.Err) {
+		return val, err
+	}
+
+	setField, ok := expr.(*expression.SetField)
+	if !ok {
+		return nil, err
+	}
+	getField, ok := setField.LeftChild.(*expression.GetField)
+	if !ok {
+		return nil, err
+	}
+	clamped, err := outOfRangeValue(ctx, getField.Name(), getField.Type(), wtce.OffendingVal, rowNumber, ignore)
+	if err != nil {
+		return nil, err
+	}
+	updatedRow := row.Copy()
+	updatedRow[wtce.OffendingIdx] = clamped
+	return updatedRow, nil
+}
+
 func (u *updateIter) validateNullability(ctx *sql.Context, row sql.Row, schema sql.Schema) error {
 	for idx := 0; idx < len(row); idx++ {
 		col := schema[idx]
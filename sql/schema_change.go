@@ -0,0 +1,64 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// SchemaChangeType is the kind of DDL statement described by a SchemaChangeEvent.
+type SchemaChangeType string
+
+const (
+	SchemaChangeType_CreateTable   SchemaChangeType = "CREATE TABLE"
+	SchemaChangeType_AlterTable    SchemaChangeType = "ALTER TABLE"
+	SchemaChangeType_DropTable     SchemaChangeType = "DROP TABLE"
+	SchemaChangeType_CreateView    SchemaChangeType = "CREATE VIEW"
+	SchemaChangeType_DropView      SchemaChangeType = "DROP VIEW"
+	SchemaChangeType_CreateTrigger SchemaChangeType = "CREATE TRIGGER"
+	SchemaChangeType_DropTrigger   SchemaChangeType = "DROP TRIGGER"
+	SchemaChangeType_CreateIndex   SchemaChangeType = "CREATE INDEX"
+	SchemaChangeType_DropIndex     SchemaChangeType = "DROP INDEX"
+)
+
+// SchemaChangeEvent describes a change that a DDL statement makes to a table, view, trigger or index. An ALTER TABLE
+// statement with several clauses is described by a single event with the schema the table has after all of them.
+type SchemaChangeEvent struct {
+	// Type is the kind of statement making the change.
+	Type SchemaChangeType
+	// Database is the name of the database of the changed object.
+	Database string
+	// Table is the name of the changed table or view, or of the table that the changed trigger or index belongs to. It
+	// is empty for DROP TRIGGER.
+	Table string
+	// Name is the name of the changed trigger or index, and is empty for tables and views.
+	Name string
+	// OldSchema is the schema of the table before the change, or of the table that the changed index belongs to. It is
+	// nil for CREATE TABLE, views and triggers.
+	OldSchema Schema
+	// NewSchema is the schema of the table or view after the change, or of the table that the changed index belongs
+	// to. It is nil for DROP TABLE, DROP VIEW and triggers.
+	NewSchema Schema
+	// Query is the text of the statement making the change.
+	Query string
+}
+
+// SchemaChangeListener is notified of the changes that DDL statements make to tables, views, triggers and indexes.
+// Listeners are registered with the engine, and are only notified of statements that have been analyzed
+// successfully.
+type SchemaChangeListener interface {
+	// BeforeSchemaChange is called before the change described by |event| is applied. Returning an error aborts the
+	// statement with that error.
+	BeforeSchemaChange(ctx *Context, event SchemaChangeEvent) error
+	// AfterSchemaChange is called once the statement making the change described by |event| has been executed
+	// successfully and, if it commits the transaction, committed.
+	AfterSchemaChange(ctx *Context, event SchemaChangeEvent)
+}
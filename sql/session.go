@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -153,19 +154,43 @@ type Session interface {
 	ValidateSession(ctx *Context) error
 }
 
-// PersistableSession supports serializing/deserializing global system variables/
-type PersistableSession interface {
-	Session
+// PersistableSystemVariables stores the global system variables set with SET PERSIST and SET PERSIST_ONLY, so that
+// they can be restored when the server restarts, and removes them for RESET PERSIST. Integrators that support
+// persisted system variables implement it on the sessions returned by their session builder.
+type PersistableSystemVariables interface {
 	// PersistGlobal writes to the persisted global system variables file
 	PersistGlobal(sysVarName string, value interface{}) error
 	// RemovePersistedGlobal deletes a variable from the persisted globals file
 	RemovePersistedGlobal(sysVarName string) error
 	// RemoveAllPersistedGlobals clears the contents of the persisted globals file
 	RemoveAllPersistedGlobals() error
-	// GetPersistedValue returns persisted value for a global system variable
+	// GetPersistedValue returns persisted value for a global system variable, or nil if it isn't persisted
 	GetPersistedValue(k string) (interface{}, error)
 }
 
+// PersistableSession supports serializing/deserializing global system variables/
+type PersistableSession interface {
+	Session
+	PersistableSystemVariables
+}
+
+// LoadPersistedSystemVariables assigns the global system variables that were persisted with SET PERSIST or SET
+// PERSIST_ONLY. The engine doesn't load persisted variables on its own, so integrators that support them must call
+// this once at startup, after the system variables are initialized and before any session is created.
+func LoadPersistedSystemVariables(persisted PersistableSystemVariables) error {
+	vals := make(map[string]interface{})
+	for name := range SystemVariables.GetAllGlobalVariables() {
+		val, err := persisted.GetPersistedValue(name)
+		if err != nil {
+			return err
+		}
+		if val != nil {
+			vals[name] = val
+		}
+	}
+	return SystemVariables.AssignValues(vals)
+}
+
 // TransactionSession can BEGIN, ROLLBACK and COMMIT transactions, as well as create SAVEPOINTS and restore to them.
 // Transactions can span multiple databases, and integrators must do their own error handling to prevent this if they
 // cannot support multiple databases in a single transaction. Such integrators can use Session.GetTransactionDatabase
@@ -187,6 +212,16 @@ type TransactionSession interface {
 	ReleaseSavepoint(ctx *Context, transaction Transaction, name string) error
 }
 
+// TransactionalDDLSession is a Session that tracks whether DDL statements have been applied within its current
+// transaction, for databases provided by a TransactionalDDLProvider.
+type TransactionalDDLSession interface {
+	Session
+	// SetTransactionalDDL records that a DDL statement has been applied within the current transaction.
+	SetTransactionalDDL()
+	// HasTransactionalDDL returns whether a DDL statement has been applied within the current transaction.
+	HasTransactionalDDL() bool
+}
+
 type (
 	// TypedValue is a value along with its type.
 	TypedValue struct {
@@ -194,11 +229,14 @@ type (
 		Value interface{}
 	}
 
-	// Warning stands for mySQL warning record.
+	// Warning stands for mySQL warning record. Warnings, notes and the errors caught by stored procedure handlers make
+	// up the diagnostics area of a session.
 	Warning struct {
 		Level   string
 		Message string
 		Code    int
+		// SqlState is the SQLSTATE of the condition, if known.
+		SqlState string
 	}
 )
 
@@ -225,6 +263,9 @@ type Context struct {
 	tracer      trace.Tracer
 	rootSpan    trace.Span
 	Version     AnalyzerVersion
+	optTracer   *OptimizerTracer
+	traceHook   TraceHook
+	execStats   *ExecStatsCollector
 }
 
 // ContextOption is a function to configure the context.
@@ -278,6 +319,13 @@ func WithProcessList(p ProcessList) ContextOption {
 	}
 }
 
+// WithTraceHook sets the hook notified of each stage of the execution of the queries run with the Context.
+func WithTraceHook(h TraceHook) ContextOption {
+	return func(ctx *Context) {
+		ctx.traceHook = h
+	}
+}
+
 // WithServices sets the services for the Context
 func WithServices(services Services) ContextOption {
 	return func(ctx *Context) {
@@ -327,6 +375,7 @@ func NewContext(
 		Session:   nil,
 		queryTime: ctxNowFunc(),
 		tracer:    NoopTracer,
+		traceHook: NoopTraceHook,
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -439,6 +488,54 @@ func (c *Context) WithContext(ctx context.Context) *Context {
 	return &nc
 }
 
+// WithOptimizerTracer returns a new context that records analyzer decisions to the tracer given.
+func (c *Context) WithOptimizerTracer(t *OptimizerTracer) *Context {
+	if c == nil {
+		return nil
+	}
+
+	nc := *c
+	nc.optTracer = t
+	return &nc
+}
+
+// OptimizerTracer returns the optimizer tracer for this context, or nil if optimizer tracing is disabled.
+func (c *Context) OptimizerTracer() *OptimizerTracer {
+	if c == nil {
+		return nil
+	}
+	return c.optTracer
+}
+
+// WithExecStats returns a new context that records the runtime statistics of the nodes it executes to the
+// collector given.
+func (c *Context) WithExecStats(s *ExecStatsCollector) *Context {
+	if c == nil {
+		return nil
+	}
+
+	nc := *c
+	nc.execStats = s
+	return &nc
+}
+
+// ExecStats returns the collector of runtime statistics for this context, or nil if they are not being collected.
+func (c *Context) ExecStats() *ExecStatsCollector {
+	if c == nil {
+		return nil
+	}
+	return c.execStats
+}
+
+// TraceHook returns the hook notified of each stage of the execution of the queries run with this context, which is
+// NoopTraceHook if none has been set.
+func (c *Context) TraceHook() TraceHook {
+	if c == nil || c.traceHook == nil {
+		return NoopTraceHook
+	}
+	return c.traceHook
+}
+
 // RootSpan returns the root span, if any.
 func (c *Context) RootSpan() trace.Span {
 	if c == nil {
@@ -518,6 +615,37 @@ func (c *Context) NewCtxWithClient(client Client) *Context {
 	return &nc
 }
 
+// RunAs sets the client of the session to |client|, whose privileges are checked for the statements run afterwards,
+// and returns a function that restores the previous client. Views and stored procedures defined with SQL SECURITY
+// DEFINER run as their definer this way.
+func (c *Context) RunAs(client Client) (restore func()) {
+	prev := c.Session.Client()
+	client.Capabilities = prev.Capabilities
+	c.Session.SetClient(client)
+	c.Session.SetPrivilegeSet(nil, 0)
+	return func() {
+		c.Session.SetClient(prev)
+		c.Session.SetPrivilegeSet(nil, 0)
+	}
+}
+
+// ParseDefiner returns the client for |definer|, the account that a view or a stored routine is defined by, given as
+// user@host with each part optionally quoted. It returns false if |definer| isn't an account.
+func ParseDefiner(definer string) (Client, bool) {
+	i := strings.LastIndex(definer, "@")
+	if i <= 0 {
+		return Client{}, false
+	}
+	unquote := func(s string) string {
+		s = strings.TrimSpace(s)
+		if len(s) >= 2 && (s[0] == '`' || s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+			return s[1 : len(s)-1]
+		}
+		return s
+	}
+	return Client{User: unquote(definer[:i]), Address: unquote(definer[i+1:])}, true
+}
+
 // Services are handles to optional or plugin functionality that can be
 // used by the SQL implementation in certain situations. An integrator can set
 // methods on Services for a given *Context and different parts of go-mysql-server
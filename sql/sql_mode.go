@@ -70,6 +70,12 @@ func (s *SqlMode) AnsiQuotes() bool {
 	return s.ModeEnabled("ansi_quotes") || s.ModeEnabled("ansi")
 }
 
+// Strict returns true if strict SQL mode is enabled, through either of the STRICT_TRANS_TABLES or STRICT_ALL_TABLES
+// modes, or the TRADITIONAL compound mode that includes them.
+func (s *SqlMode) Strict() bool {
+	return s.ModeEnabled("strict_trans_tables") || s.ModeEnabled("strict_all_tables") || s.ModeEnabled("traditional")
+}
+
 // ModeEnabled returns true if |mode| was explicitly specified in the SQL_MODE string that was used to
 // create this SqlMode instance. Note this function does not support expanding compound modes into the
 // individual modes they contain (e.g. if "ANSI" is the SQL_MODE string, then this function will not
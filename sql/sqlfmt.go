@@ -84,7 +84,7 @@ func GenerateCreateTableColumnDefinition(col *Column, colDefault, onUpdate strin
 	}
 
 	if col.Comment != "" {
-		stmt = fmt.Sprintf("%s COMMENT '%s'", stmt, col.Comment)
+		stmt = fmt.Sprintf("%s COMMENT '%s'", stmt, strings.ReplaceAll(col.Comment, "'", "''"))
 	}
 	return stmt
 }
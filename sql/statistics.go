@@ -66,6 +66,10 @@ type Statistic interface {
 	MutableStatistic
 	RowCount() uint64
 	DistinctCount() uint64
+	// PrefixDistinctCounts returns the number of distinct values of each prefix of the statistic's columns: the first
+	// element counts the distinct values of the first column, the second of the first two columns, and so on. It is
+	// empty if the counts were not collected.
+	PrefixDistinctCounts() []uint64
 	NullCount() uint64
 	AvgSize() uint64
 	CreatedAt() time.Time
@@ -84,6 +88,7 @@ type MutableStatistic interface {
 	WithFuncDeps(*FuncDepSet) Statistic
 	WithHistogram(Histogram) (Statistic, error)
 	WithDistinctCount(uint64) Statistic
+	WithPrefixDistinctCounts([]uint64) Statistic
 	WithRowCount(uint64) Statistic
 	WithNullCount(uint64) Statistic
 	WithAvgSize(uint64) Statistic
@@ -133,6 +138,29 @@ func (q StatQualifier) Index() string {
 	return q.Idx
 }
 
+// IndexCardinalities returns the cardinality of each column of |idx| as reported by SHOW INDEX and
+// information_schema.statistics, which is the estimated number of distinct values of the index prefix ending with that
+// column. Elements are nil for prefixes that |stats| has no estimate for.
+func IndexCardinalities(stats []Statistic, idx Index) []interface{} {
+	ret := make([]interface{}, len(idx.Expressions()))
+	for _, stat := range stats {
+		qual := stat.Qualifier()
+		if !strings.EqualFold(qual.Table(), idx.Table()) || !strings.EqualFold(qual.Index(), idx.ID()) {
+			continue
+		}
+		// a statistic's distinct count covers all of its columns, which may only be a prefix of the index
+		if n := len(stat.Columns()); n > 0 && n <= len(ret) {
+			ret[n-1] = int64(stat.DistinctCount())
+		}
+		for i, cnt := range stat.PrefixDistinctCounts() {
+			if i < len(ret) && ret[i] == nil {
+				ret[i] = int64(cnt)
+			}
+		}
+	}
+	return ret
+}
+
 // Histogram is a collection of non-overlapping buckets that
 // estimate the costing statistics for an index prefix.
 // Note that a non-unique key can cross bucket boundaries.
@@ -48,6 +48,7 @@ func NewStatistic(rowCount, distinctCount, nullCount, avgSize uint64, createdAt
 type Statistic struct {
 	RowCnt      uint64            `json:"row_count"`
 	DistinctCnt uint64            `json:"distinct_count"`
+	PrefixCnts  []uint64          `json:"prefix_distinct_counts,omitempty"`
 	NullCnt     uint64            `json:"null_count"`
 	AvgRowSize  uint64            `json:"avg_size"`
 	Created     time.Time         `json:"created_at"`
@@ -108,6 +109,10 @@ func (s *Statistic) DistinctCount() uint64 {
 	return s.DistinctCnt
 }
 
+func (s *Statistic) PrefixDistinctCounts() []uint64 {
+	return s.PrefixCnts
+}
+
 func (s *Statistic) NullCount() uint64 {
 	return s.NullCnt
 }
@@ -146,6 +151,12 @@ func (s *Statistic) WithDistinctCount(i uint64) sql.Statistic {
 	return &ret
 }
 
+func (s *Statistic) WithPrefixDistinctCounts(cnts []uint64) sql.Statistic {
+	ret := *s
+	ret.PrefixCnts = cnts
+	return &ret
+}
+
 func (s *Statistic) WithRowCount(i uint64) sql.Statistic {
 	ret := *s
 	ret.RowCnt = i
@@ -89,3 +89,65 @@ func (i *TableRowIter) Close(ctx *Context) error {
 	}
 	return i.partitions.Close(ctx)
 }
+
+// TableBatchIter is an iterator over the batches of rows in the partitions of a BatchTable.
+type TableBatchIter struct {
+	table      BatchTable
+	partitions PartitionIter
+	batches    BatchRowIter
+}
+
+var _ BatchRowIter = (*TableBatchIter)(nil)
+
+// NewTableBatchIter returns a new iterator over the batches of rows in the partitions of the table given.
+func NewTableBatchIter(table BatchTable, partitions PartitionIter) *TableBatchIter {
+	return &TableBatchIter{table: table, partitions: partitions}
+}
+
+// NextBatch implements the BatchRowIter interface.
+func (i *TableBatchIter) NextBatch(ctx *Context) (*RowBatch, error) {
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		if i.batches == nil {
+			partition, err := i.partitions.Next(ctx)
+			if err != nil {
+				if err == io.EOF {
+					if e := i.partitions.Close(ctx); e != nil {
+						return nil, e
+					}
+				}
+				return nil, err
+			}
+
+			i.batches, err = i.table.PartitionBatches(ctx, partition)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		batch, err := i.batches.NextBatch(ctx)
+		if err != io.EOF {
+			return batch, err
+		}
+
+		err = i.batches.Close(ctx)
+		i.batches = nil
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Close implements the BatchRowIter interface.
+func (i *TableBatchIter) Close(ctx *Context) error {
+	if i.batches != nil {
+		if err := i.batches.Close(ctx); err != nil {
+			_ = i.partitions.Close(ctx)
+			return err
+		}
+	}
+	return i.partitions.Close(ctx)
+}
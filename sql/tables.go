@@ -85,6 +85,16 @@ type FilteredTable interface {
 	WithFilters(ctx *Context, filters []Expression) Table
 }
 
+// RemoteTable is a FilteredTable whose rows are read from another server, such as the tables of the remote package.
+// The analyzer gives it the filters it can evaluate and the row limit of a query that reads it alone, so that the other
+// server sends fewer rows. The Filter and Limit nodes above the table are kept, so that it only needs to return a
+// superset of the rows matching its filters. Other FilteredTables are not given filters by the analyzer.
+type RemoteTable interface {
+	FilteredTable
+	// WithLimit returns a version of this table whose RowIter returns at most |limit| rows.
+	WithLimit(limit uint64) Table
+}
+
 // CommentedTable is a table that has a comment on it.
 type CommentedTable interface {
 	Table
@@ -140,6 +150,14 @@ type IndexedTable interface {
 	LookupPartitions(*Context, IndexLookup) (PartitionIter, error)
 }
 
+// LimitedTable is an IndexedTable that can stop reading rows early. The analyzer uses it to push a LIMIT (plus any
+// OFFSET) into an index-ordered scan, so that only the rows needed to satisfy the limit are fetched.
+type LimitedTable interface {
+	IndexedTable
+	// WithLimit returns a version of this table whose partitions each return at most |limit| rows.
+	WithLimit(limit uint64) IndexedTable
+}
+
 // IndexAlterableTable represents a table that supports index modification operations.
 type IndexAlterableTable interface {
 	Table
@@ -167,6 +185,28 @@ type IndexBuildingTable interface {
 	BuildIndex(ctx *Context, indexDef IndexDef) (RowInserter, error)
 }
 
+// IndexBulkLoadingTable is an optional extension to IndexBuildingTable for tables that can populate a newly created
+// index from batches of rows that are already sorted in the index's order. When a table implements this interface, the
+// engine uses BulkLoadIndex rather than BuildIndex for the indexes that ShouldBuildIndex asks it to build.
+type IndexBulkLoadingTable interface {
+	IndexBuildingTable
+	// BulkLoadIndex returns an IndexBulkLoader that populates the newly created index given by the definition.
+	BulkLoadIndex(ctx *Context, indexDef IndexDef) (IndexBulkLoader, error)
+}
+
+// IndexBulkLoader populates a newly created index from batches of rows sorted in the index's order.
+type IndexBulkLoader interface {
+	// LoadBatch adds the rows given to the index. Each row is a full row of the table, and the rows of each batch come
+	// after the rows of the previous batch in the index's order. The engine checks unique indexes before loading them,
+	// so a unique index is never given two rows with the same non-NULL key.
+	LoadBatch(ctx *Context, rows []Row) error
+	// DiscardChanges is called before Close when the index can't be built, such as when a unique index has duplicate
+	// keys. Close should then leave the table as it was before the index was created.
+	DiscardChanges(ctx *Context, errorEncountered error) error
+	// Close finalizes the index, which should then be fully populated and available for further use in the session.
+	Closer
+}
+
 // ForeignKeyTable is a table that declares foreign key constraints, and can be referenced by other tables' foreign
 // key constraints.
 type ForeignKeyTable interface {
@@ -323,6 +363,10 @@ type AutoIncrementTable interface {
 	GetNextAutoIncrementValue(ctx *Context, insertVal interface{}) (uint64, error)
 	// AutoIncrementSetter returns an AutoIncrementSetter.
 	AutoIncrementSetter(*Context) AutoIncrementSetter
+	// PersistAutoIncrement saves the current AUTO_INCREMENT value to the integrator's storage, so that the sequence
+	// resumes from it when the table is opened again, e.g. after a restart, rather than from the largest value in the
+	// table. It is called when changes to the table are committed.
+	PersistAutoIncrement(ctx *Context) error
 }
 
 // AutoIncrementSetter provides support for altering a table's
@@ -393,6 +437,44 @@ type RewritableTable interface {
 	RewriteInserter(ctx *Context, oldSchema, newSchema PrimaryKeySchema, oldColumn, newColumn *Column, idxCols []IndexColumn) (RowInserter, error)
 }
 
+// AlterAlgorithm is the algorithm requested by the ALGORITHM clause of an ALTER TABLE statement.
+type AlterAlgorithm string
+
+const (
+	AlterAlgorithmDefault AlterAlgorithm = "DEFAULT"
+	AlterAlgorithmInstant AlterAlgorithm = "INSTANT"
+	AlterAlgorithmInplace AlterAlgorithm = "INPLACE"
+	AlterAlgorithmCopy    AlterAlgorithm = "COPY"
+)
+
+// AlterLock is the level of concurrent access requested by the LOCK clause of an ALTER TABLE statement.
+type AlterLock string
+
+const (
+	AlterLockDefault   AlterLock = "DEFAULT"
+	AlterLockNone      AlterLock = "NONE"
+	AlterLockShared    AlterLock = "SHARED"
+	AlterLockExclusive AlterLock = "EXCLUSIVE"
+)
+
+// RebuildableTable is an extension to Table for integrators that can rewrite or compact the storage of a table without
+// changing its schema, as requested by ALTER TABLE ... FORCE and by ALTER TABLE ... ENGINE with the table's current
+// engine. Tables that don't implement this interface are rebuilt by copying every row through the RewriteInserter of
+// RewritableTable, which also validates the table's constraints and regenerates its indexes.
+type RebuildableTable interface {
+	Table
+
+	// NegotiateRebuild returns the algorithm and lock that RebuildTable will use, given the ones requested by the
+	// statement. Either request may be DEFAULT, in which case the table chooses. An error should be returned when a
+	// request can't be satisfied, which fails the statement before anything is rebuilt.
+	NegotiateRebuild(ctx *Context, algorithm AlterAlgorithm, lock AlterLock) (AlterAlgorithm, AlterLock, error)
+
+	// RebuildTable rebuilds the storage of the table with the algorithm and lock returned by NegotiateRebuild, and
+	// returns the number of rows rewritten. Implementations should stop and return the context's error when it's
+	// canceled, which happens when the statement is killed.
+	RebuildTable(ctx *Context, algorithm AlterAlgorithm, lock AlterLock) (int, error)
+}
+
 // AlterableTable should be implemented by tables that can receive
 // ALTER TABLE statements to modify their schemas.
 type AlterableTable interface {
@@ -0,0 +1,106 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// TraceHook is notified of each stage of the execution of the queries run with a Context, allowing profilers to be
+// plugged into the engine without depending on OpenTelemetry. A TraceHook is set on a Context with WithTraceHook, and
+// may be called concurrently for the queries of different sessions.
+type TraceHook interface {
+	// OnParse is called once |query| has been parsed, with the time parsing took and the error it failed with, if
+	// any. It is not called for queries given to the engine already parsed.
+	OnParse(ctx *Context, query string, duration time.Duration, err error)
+	// OnAnalyze is called once the plan for |query| has been built and analyzed, with the analyzed plan, the time
+	// building and analysis took, and the error they failed with, if any. The plan is nil if it could not be built.
+	OnAnalyze(ctx *Context, query string, node Node, duration time.Duration, err error)
+	// OnRowRead is called for each row that |query| returns.
+	OnRowRead(ctx *Context, query string, row Row)
+	// OnExecute is called once the execution of |query| has finished and its row iterator has been closed, with the
+	// number of rows it returned, the time since its execution began, and the error it failed with, if any.
+	OnExecute(ctx *Context, query string, rows int64, duration time.Duration, err error)
+}
+
+// NoopTraceHook is the TraceHook of contexts that don't have one set, and does nothing.
+var NoopTraceHook TraceHook = noopTraceHook{}
+
+type noopTraceHook struct{}
+
+var _ TraceHook = noopTraceHook{}
+
+// OnParse implements the TraceHook interface.
+func (noopTraceHook) OnParse(*Context, string, time.Duration, error) {}
+
+// OnAnalyze implements the TraceHook interface.
+func (noopTraceHook) OnAnalyze(*Context, string, Node, time.Duration, error) {}
+
+// OnRowRead implements the TraceHook interface.
+func (noopTraceHook) OnRowRead(*Context, string, Row) {}
+
+// OnExecute implements the TraceHook interface.
+func (noopTraceHook) OnExecute(*Context, string, int64, time.Duration, error) {}
+
+// LoggingTraceHook is a TraceHook that writes a line describing each stage of the execution of a query to a writer.
+// Rows are not logged individually; their number is given by the line for the end of the execution.
+type LoggingTraceHook struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+var _ TraceHook = (*LoggingTraceHook)(nil)
+
+// NewLoggingTraceHook returns a new LoggingTraceHook that writes to |w|.
+func NewLoggingTraceHook(w io.Writer) *LoggingTraceHook {
+	return &LoggingTraceHook{w: w}
+}
+
+// OnParse implements the TraceHook interface.
+func (h *LoggingTraceHook) OnParse(ctx *Context, query string, duration time.Duration, err error) {
+	h.log(ctx, "parse", query, duration, err, "")
+}
+
+// OnAnalyze implements the TraceHook interface.
+func (h *LoggingTraceHook) OnAnalyze(ctx *Context, query string, _ Node, duration time.Duration, err error) {
+	h.log(ctx, "analyze", query, duration, err, "")
+}
+
+// OnRowRead implements the TraceHook interface.
+func (h *LoggingTraceHook) OnRowRead(*Context, string, Row) {}
+
+// OnExecute implements the TraceHook interface.
+func (h *LoggingTraceHook) OnExecute(ctx *Context, query string, rows int64, duration time.Duration, err error) {
+	h.log(ctx, "execute", query, duration, err, fmt.Sprintf(" rows=%d", rows))
+}
+
+func (h *LoggingTraceHook) log(ctx *Context, stage, query string, duration time.Duration, err error, extra string) {
+	var connID uint32
+	if ctx != nil && ctx.Session != nil {
+		connID = ctx.Session.ID()
+	}
+	line := fmt.Sprintf("[conn %d] %s duration=%s%s", connID, stage, duration, extra)
+	if err != nil {
+		line += fmt.Sprintf(" error=%q", err.Error())
+	}
+	line += fmt.Sprintf(" query=%q\n", query)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, _ = io.WriteString(h.w, line)
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextTraceHook(t *testing.T) {
+	ctx := NewEmptyContext()
+	require.Equal(t, NoopTraceHook, ctx.TraceHook())
+
+	var buf bytes.Buffer
+	hook := NewLoggingTraceHook(&buf)
+	ctx = NewContext(context.Background(), WithTraceHook(hook))
+	require.Equal(t, hook, ctx.TraceHook())
+	require.Equal(t, hook, ctx.WithContext(context.Background()).TraceHook())
+}
+
+func TestLoggingTraceHook(t *testing.T) {
+	var buf bytes.Buffer
+	hook := NewLoggingTraceHook(&buf)
+	sess := NewBaseSessionWithClientServer("", Client{}, 7)
+	ctx := NewContext(context.Background(), WithSession(sess), WithTraceHook(hook))
+
+	hook.OnParse(ctx, "select 1", time.Millisecond, nil)
+	hook.OnAnalyze(ctx, "select 1", nil, 2*time.Millisecond, nil)
+	hook.OnRowRead(ctx, "select 1", Row{1})
+	hook.OnExecute(ctx, "select 1", 1, 3*time.Millisecond, nil)
+	hook.OnExecute(ctx, "select x", 0, time.Second, errors.New("column \"x\" could not be found"))
+
+	require.Equal(t, `[conn 7] parse duration=1ms query="select 1"
+[conn 7] analyze duration=2ms query="select 1"
+[conn 7] execute duration=3ms rows=1 query="select 1"
+[conn 7] execute duration=1s rows=0 error="column \"x\" could not be found" query="select x"
+`, buf.String())
+}
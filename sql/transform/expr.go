@@ -260,3 +260,86 @@ func WrappedColumnDefaults(schema sql.Schema) []sql.Expression {
 	}
 	return defs
 }
+
+// GeneratedColumnOrder returns the indexes of the generated columns in the schema given, ordered so that every
+// generated column comes after the generated columns its expression refers to. Returns an error if a generated column
+// refers to itself, either directly or through other generated columns.
+func GeneratedColumnOrder(schema sql.Schema) ([]int, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(schema))
+	order := make([]int, 0, len(schema))
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visited:
+			return nil
+		case visiting:
+			return sql.ErrGeneratedColumnCycle.New(schema[i].Name)
+		}
+		state[i] = visiting
+		var err error
+		InspectExpr(schema[i].Generated, func(e sql.Expression) bool {
+			gf, ok := e.(*expression.GetField)
+			if !ok {
+				return false
+			}
+			idx := schema.IndexOfColName(gf.Name())
+			if idx < 0 || schema[idx].Generated == nil {
+				return false
+			}
+			err = visit(idx)
+			return err != nil
+		})
+		if err != nil {
+			return err
+		}
+		state[i] = visited
+		order = append(order, i)
+		return nil
+	}
+	for i, col := range schema {
+		if col.Generated == nil {
+			continue
+		}
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// InlineVirtualColumns returns the projections given for the schema given, with every reference to a virtual column
+// replaced by that column's projection, so that the projections only refer to the stored columns of the table. The
+// projection of a virtual column must be the expression that generates it.
+func InlineVirtualColumns(schema sql.Schema, projections []sql.Expression) ([]sql.Expression, error) {
+	order, err := GeneratedColumnOrder(schema)
+	if err != nil {
+		return nil, err
+	}
+	inlined := make([]sql.Expression, len(projections))
+	copy(inlined, projections)
+	for _, i := range order {
+		if !schema[i].Virtual {
+			continue
+		}
+		inlined[i], _, err = Expr(inlined[i], func(e sql.Expression) (sql.Expression, TreeIdentity, error) {
+			gf, ok := e.(*expression.GetField)
+			if !ok {
+				return e, SameTree, nil
+			}
+			idx := schema.IndexOfColName(gf.Name())
+			if idx < 0 || idx == i || !schema[idx].Virtual {
+				return e, SameTree, nil
+			}
+			return inlined[idx], NewTree, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return inlined, nil
+}
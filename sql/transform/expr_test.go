@@ -0,0 +1,63 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestGeneratedColumnOrder(t *testing.T) {
+	gf := func(idx int, name string) sql.Expression {
+		return expression.NewGetField(idx, types.Int64, name, true)
+	}
+	generated := func(e sql.Expression) *sql.ColumnDefaultValue {
+		return &sql.ColumnDefaultValue{Expr: e, OutType: types.Int64, ReturnNil: true}
+	}
+
+	// c refers to b, which is defined after it, and d refers to both
+	sch := sql.Schema{
+		{Name: "a", Type: types.Int64},
+		{Name: "c", Type: types.Int64, Generated: generated(expression.NewPlus(gf(2, "b"), gf(2, "b"))), Virtual: true},
+		{Name: "b", Type: types.Int64, Generated: generated(expression.NewPlus(gf(0, "a"), gf(0, "a"))), Virtual: true},
+		{Name: "d", Type: types.Int64, Generated: generated(expression.NewPlus(gf(1, "c"), gf(2, "b")))},
+	}
+	order, err := GeneratedColumnOrder(sch)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 1, 3}, order)
+
+	projections := []sql.Expression{gf(0, "a"), sch[1].Generated, sch[2].Generated, gf(3, "d")}
+	inlined, err := InlineVirtualColumns(sch, projections)
+	require.NoError(t, err)
+	require.Equal(t, projections[2], inlined[2])
+	require.Equal(t, projections[3], inlined[3])
+	require.False(t, InspectExpr(inlined[1], func(e sql.Expression) bool {
+		gf, ok := e.(*expression.GetField)
+		return ok && gf.Name() != "a"
+	}))
+
+	sch[2].Generated = generated(gf(3, "d"))
+	_, err = GeneratedColumnOrder(sch)
+	require.True(t, sql.ErrGeneratedColumnCycle.Is(err))
+
+	sch[2].Generated = generated(gf(2, "b"))
+	_, err = GeneratedColumnOrder(sch)
+	require.True(t, sql.ErrGeneratedColumnCycle.Is(err))
+}
@@ -144,6 +144,9 @@ type StringType interface {
 type DatetimeType interface {
 	Type
 	ConvertWithoutRangeCheck(v interface{}) (time.Time, error)
+	// ConvertTimezone converts |v| to this type, and then from the time zone |fromTz| to the time zone |toTz|. Time
+	// zones may be names, such as "UTC", or MySQL offsets, such as "+01:00".
+	ConvertTimezone(v interface{}, fromTz, toTz string) (interface{}, ConvertInRange, error)
 	MaximumTime() time.Time
 	MinimumTime() time.Time
 	Precision() int
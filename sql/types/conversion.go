@@ -118,6 +118,63 @@ func ApproximateTypeFromValue(val interface{}) sql.Type {
 	}
 }
 
+// ConvertToUserVariable converts |val|, of the type |typ|, to one of the types that a user variable can hold, and
+// returns the converted value along with its type. As in MySQL, integers become BIGINT, floating point numbers
+// become DOUBLE, decimals keep their type, binary values become LONGBLOB, and all other values become LONGTEXT in
+// the collation of their type, or of the connection if their type has none. BIT values are stored as unsigned
+// integers, and JSON values as their text in utf8mb4_bin.
+func ConvertToUserVariable(ctx *sql.Context, val interface{}, typ sql.Type) (interface{}, sql.Type, error) {
+	if val == nil {
+		return nil, Null, nil
+	}
+	if typ == nil || typ == Null {
+		typ = ApproximateTypeFromValue(val)
+	}
+
+	var target sql.Type
+	switch {
+	case IsUnsigned(typ), IsBit(typ):
+		target = Uint64
+	case IsInteger(typ), IsYear(typ):
+		target = Int64
+	case IsDecimal(typ):
+		target = typ
+	case IsFloat(typ):
+		target = Float64
+	case IsJSON(typ):
+		return userVariableString(ctx, val, typ, CreateLongText(sql.Collation_utf8mb4_bin))
+	case IsEnum(typ), IsSet(typ):
+		return userVariableString(ctx, val, typ, CreateLongText(typ.(sql.TypeWithCollation).Collation()))
+	case IsGeometry(typ):
+		target = LongBlob
+	case IsText(typ):
+		if collation := typ.(sql.StringType).Collation(); collation == sql.Collation_binary {
+			target = LongBlob
+		} else {
+			target = CreateLongText(collation)
+		}
+	default:
+		// temporal values are stored as their text, like any other value without a numeric or string type
+		return userVariableString(ctx, val, typ, CreateLongText(ctx.GetCollation()))
+	}
+
+	converted, _, err := target.Convert(val)
+	if err != nil {
+		return nil, nil, err
+	}
+	return converted, target, nil
+}
+
+// userVariableString returns the text of |val|, of the type |typ|, to be stored in a user variable of the type
+// |target|.
+func userVariableString(ctx *sql.Context, val interface{}, typ sql.Type, target sql.Type) (interface{}, sql.Type, error) {
+	sqlVal, err := typ.SQL(ctx, nil, val)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sqlVal.ToString(), target, nil
+}
+
 // IsBinary returns whether the type represents binary data.
 func IsBinary(sqlType query.Type) bool {
 	switch sqlType {
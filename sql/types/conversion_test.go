@@ -17,10 +17,13 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -158,3 +161,38 @@ func TestColumnCharTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertToUserVariable(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	dt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		val          interface{}
+		typ          sql.Type
+		expected     interface{}
+		expectedType sql.Type
+	}{
+		{nil, Int32, nil, Null},
+		{nil, nil, nil, Null},
+		{int8(3), Int8, int64(3), Int64},
+		{uint16(3), Uint16, uint64(3), Uint64},
+		{int16(2024), Year, int64(2024), Int64},
+		{uint64(5), MustCreateBitType(8), uint64(5), Uint64},
+		{float32(1.5), Float32, float64(1.5), Float64},
+		{decimal.RequireFromString("12.345"), MustCreateDecimalType(10, 3), decimal.RequireFromString("12.345"), MustCreateDecimalType(10, 3)},
+		{"abc", MustCreateStringWithDefaults(sqltypes.VarChar, 10), "abc", CreateLongText(sql.Collation_Default)},
+		{"abc", MustCreateString(sqltypes.VarChar, 10, sql.Collation_utf8mb4_0900_ai_ci), "abc", CreateLongText(sql.Collation_utf8mb4_0900_ai_ci)},
+		{[]byte("abc"), MustCreateBinary(sqltypes.VarBinary, 10), []byte("abc"), LongBlob},
+		{MustJSON(`{"a": 1}`), JSON, `{"a": 1}`, CreateLongText(sql.Collation_utf8mb4_bin)},
+		{dt, Datetime, "2024-01-02 03:04:05", CreateLongText(ctx.GetCollation())},
+		{"7", nil, "7", CreateLongText(sql.Collation_Default)},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.val, test.typ), func(t *testing.T) {
+			val, typ, err := ConvertToUserVariable(ctx, test.val, test.typ)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, val)
+			assert.Equal(t, test.expectedType, typ)
+		})
+	}
+}
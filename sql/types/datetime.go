@@ -25,6 +25,7 @@ import (
 	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
+	gmstime "github.com/dolthub/go-mysql-server/internal/time"
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
@@ -38,6 +39,9 @@ var (
 
 	ErrConvertingToTimeOutOfRange = errors.NewKind("value %q is outside of %v range")
 
+	// ErrInvalidTimeZone is thrown when a time zone is neither a known time zone name nor a valid offset
+	ErrInvalidTimeZone = errors.NewKind("Unknown or incorrect time zone: '%s'")
+
 	// datetimeTypeMaxDatetime is the maximum representable Datetime/Date value.
 	datetimeTypeMaxDatetime = time.Date(9999, 12, 31, 23, 59, 59, 999999000, time.UTC)
 
@@ -175,6 +179,28 @@ func (t datetimeType) Convert(v interface{}) (interface{}, sql.ConvertInRange, e
 	return res, sql.InRange, nil
 }
 
+// ConvertTimezone implements the DatetimeType interface. The value is converted as by Convert, and then from the time
+// zone |fromTz| to the time zone |toTz|. Zero dates are left as they are.
+func (t datetimeType) ConvertTimezone(v interface{}, fromTz, toTz string) (interface{}, sql.ConvertInRange, error) {
+	res, inRange, err := t.Convert(v)
+	if err != nil || res == nil {
+		return res, inRange, err
+	}
+	tm := res.(time.Time)
+	if tm.Equal(zeroTime) || fromTz == toTz {
+		return tm, sql.InRange, nil
+	}
+
+	converted, ok := gmstime.ConvertTimeZone(tm, fromTz, toTz)
+	if !ok {
+		if _, err := gmstime.ConvertTimeToLocation(tm, fromTz); err != nil {
+			return nil, sql.OutOfRange, ErrInvalidTimeZone.New(fromTz)
+		}
+		return nil, sql.OutOfRange, ErrInvalidTimeZone.New(toTz)
+	}
+	return t.Convert(converted)
+}
+
 // precisionConversion is a conversion ratio to divide time.Second by to truncate the appropriate amount for the
 // precision of a type with time info
 var precisionConversion = [7]int{
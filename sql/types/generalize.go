@@ -0,0 +1,276 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// GeneralizeTypes returns the type that values of both |l| and |r| can be stored in without losing data, following
+// MySQL's rules for aggregating the types of a result column, such as the columns of a UNION. Numbers are promoted to
+// the smallest numeric type that holds both ranges, temporal types to the most precise temporal type, and every other
+// combination becomes a string type long enough for the text representation of either type.
+func GeneralizeTypes(l, r sql.Type) sql.Type {
+	if l == Null {
+		return r
+	}
+	if r == Null {
+		return l
+	}
+	if l.Equals(r) && !IsTime(l) {
+		return l
+	}
+
+	switch {
+	case IsBit(l) && IsBit(r):
+		return MustCreateBitType(maxUint8(l.(BitType).NumberOfBits(), r.(BitType).NumberOfBits()))
+	case isIntegerLike(l) && isIntegerLike(r):
+		return generalizeIntegers(asInteger(l), asInteger(r))
+	case isNumberLike(l) && isNumberLike(r):
+		if IsFloat(l) || IsFloat(r) {
+			return generalizeFloats(l, r)
+		}
+		return generalizeDecimals(l, r)
+	case isTemporal(l) && isTemporal(r):
+		return generalizeTemporal(l, r)
+	case IsJSON(l) && IsJSON(r):
+		return JSON
+	case IsGeometry(l) && IsGeometry(r):
+		return GeometryType{}
+	}
+	return generalizeStrings(l, r)
+}
+
+// isIntegerLike returns whether |t| is aggregated as an integer type.
+func isIntegerLike(t sql.Type) bool {
+	return IsInteger(t) || IsYear(t) || IsBit(t)
+}
+
+// isNumberLike returns whether |t| is aggregated as a numeric type.
+func isNumberLike(t sql.Type) bool {
+	return isIntegerLike(t) || IsFloat(t) || IsDecimal(t)
+}
+
+// isTemporal returns whether |t| is aggregated as a date or time type.
+func isTemporal(t sql.Type) bool {
+	return IsTime(t) || IsTimespan(t)
+}
+
+// asInteger returns the integer type that values of |t| are aggregated as.
+func asInteger(t sql.Type) sql.Type {
+	switch {
+	case IsYear(t):
+		return Int16
+	case IsBit(t):
+		return Uint64
+	}
+	return t
+}
+
+// integerRank orders integer base types by the width of their range.
+var integerRank = map[query.Type]int{
+	sqltypes.Int8:   1,
+	sqltypes.Uint8:  1,
+	sqltypes.Int16:  2,
+	sqltypes.Uint16: 2,
+	sqltypes.Int24:  3,
+	sqltypes.Uint24: 3,
+	sqltypes.Int32:  4,
+	sqltypes.Uint32: 4,
+	sqltypes.Int64:  5,
+	sqltypes.Uint64: 5,
+}
+
+// signedIntegerFor maps unsigned integer base types to the smallest signed integer type that holds their range.
+var signedIntegerFor = map[query.Type]sql.Type{
+	sqltypes.Uint8:  Int16,
+	sqltypes.Uint16: Int32,
+	sqltypes.Uint24: Int32,
+	sqltypes.Uint32: Int64,
+}
+
+// integerDigits is the number of decimal digits needed for the range of each integer base type.
+var integerDigits = map[query.Type]uint8{
+	sqltypes.Int8:   3,
+	sqltypes.Uint8:  3,
+	sqltypes.Int16:  5,
+	sqltypes.Uint16: 5,
+	sqltypes.Int24:  7,
+	sqltypes.Uint24: 8,
+	sqltypes.Int32:  10,
+	sqltypes.Uint32: 10,
+	sqltypes.Int64:  19,
+	sqltypes.Uint64: 20,
+}
+
+func generalizeIntegers(l, r sql.Type) sql.Type {
+	if IsUnsigned(l) != IsUnsigned(r) {
+		signed, unsigned := l, r
+		if IsUnsigned(l) {
+			signed, unsigned = r, l
+		}
+		promoted, ok := signedIntegerFor[unsigned.Type()]
+		if !ok {
+			// no signed integer holds every BIGINT UNSIGNED value
+			return MustCreateColumnDecimalType(integerDigits[sqltypes.Uint64], 0)
+		}
+		l, r = signed, promoted
+	}
+	if integerRank[r.Type()] > integerRank[l.Type()] {
+		return r
+	}
+	return l
+}
+
+func generalizeFloats(l, r sql.Type) sql.Type {
+	for _, t := range []sql.Type{l, r} {
+		if t.Type() == sqltypes.Float64 || IsDecimal(t) {
+			return Float64
+		}
+		if isIntegerLike(t) && integerRank[asInteger(t).Type()] > integerRank[sqltypes.Int16] {
+			return Float64
+		}
+	}
+	return Float32
+}
+
+// decimalDigits returns the number of integer and fractional digits needed for the values of the numeric type |t|.
+func decimalDigits(t sql.Type) (uint8, uint8) {
+	if dt, ok := t.(sql.DecimalType); ok {
+		return dt.Precision() - dt.Scale(), dt.Scale()
+	}
+	return integerDigits[asInteger(t).Type()], 0
+}
+
+func generalizeDecimals(l, r sql.Type) sql.Type {
+	lInt, lScale := decimalDigits(l)
+	rInt, rScale := decimalDigits(r)
+	intDigits, scale := maxUint8(lInt, rInt), maxUint8(lScale, rScale)
+	if scale > DecimalTypeMaxScale {
+		scale = DecimalTypeMaxScale
+	}
+	if intDigits+scale > DecimalTypeMaxPrecision {
+		intDigits = DecimalTypeMaxPrecision - scale
+	}
+	return MustCreateColumnDecimalType(intDigits+scale, scale)
+}
+
+// temporalPrecision returns the fractional seconds precision of the temporal type |t|.
+func temporalPrecision(t sql.Type) int {
+	if dt, ok := t.(sql.DatetimeType); ok {
+		return dt.Precision()
+	}
+	return 0
+}
+
+func generalizeTemporal(l, r sql.Type) sql.Type {
+	if IsTimespan(l) && IsTimespan(r) {
+		return Time
+	}
+	precision := temporalPrecision(l)
+	if rp := temporalPrecision(r); rp > precision {
+		precision = rp
+	}
+	switch {
+	case IsDateType(l) && IsDateType(r):
+		return Date
+	case IsTimestampType(l) && IsTimestampType(r):
+		return MustCreateDatetimeType(sqltypes.Timestamp, precision)
+	}
+	return MustCreateDatetimeType(sqltypes.Datetime, precision)
+}
+
+// textLength returns the maximum number of characters in a text representation of a value of |t|.
+func textLength(t sql.Type) int64 {
+	switch t := t.(type) {
+	case sql.StringType:
+		return t.MaxCharacterLength()
+	case sql.EnumType:
+		return int64(t.MaxTextResponseByteLength(nil)) / t.Collation().CharacterSet().MaxLength()
+	case sql.SetType:
+		return int64(t.MaxTextResponseByteLength(nil)) / t.Collation().CharacterSet().MaxLength()
+	case sql.DatetimeType:
+		if t.Type() == sqltypes.Date {
+			return int64(len(sql.DateLayout))
+		}
+		length := int64(len(sql.DateLayout) + len(" 00:00:00"))
+		if t.Precision() > 0 {
+			length += int64(t.Precision()) + 1
+		}
+		return length
+	}
+	if IsJSON(t) || IsGeometry(t) {
+		return LongTextBlobMax
+	}
+	return int64(t.MaxTextResponseByteLength(nil))
+}
+
+// isBinaryString returns whether the text representation of |t| is a binary string.
+func isBinaryString(t sql.Type) bool {
+	return (IsBinaryType(t) && !IsJSON(t)) || IsGeometry(t) || IsBit(t)
+}
+
+// typeCollation returns the collation of |t|, and whether |t| has one.
+func typeCollation(t sql.Type) (sql.CollationID, bool) {
+	if ct, ok := t.(sql.TypeWithCollation); ok {
+		return ct.Collation(), true
+	}
+	return sql.Collation_binary, false
+}
+
+func generalizeStrings(l, r sql.Type) sql.Type {
+	length := textLength(l)
+	if rl := textLength(r); rl > length {
+		length = rl
+	}
+	// JSON is aggregated as text, while BIT and spatial values are binary strings
+	isBinary := isBinaryString(l) || isBinaryString(r)
+	isBlob := IsTextBlob(l) || IsTextBlob(r) || IsJSON(l) || IsJSON(r) || IsGeometry(l) || IsGeometry(r)
+
+	collation := sql.Collation_binary
+	if !isBinary {
+		collation = sql.Collation_Default
+		if lc, ok := typeCollation(l); ok && lc != sql.Collation_binary {
+			collation = lc
+		} else if rc, ok := typeCollation(r); ok && rc != sql.Collation_binary {
+			collation = rc
+		}
+	}
+
+	byteLength := length * collation.CharacterSet().MaxLength()
+	if isBlob || byteLength > varcharVarbinaryMax {
+		if byteLength > LongTextBlobMax {
+			byteLength = LongTextBlobMax
+		}
+		if isBinary {
+			return MustCreateBinary(sqltypes.Blob, byteLength)
+		}
+		return MustCreateString(sqltypes.Text, byteLength, collation)
+	}
+	if isBinary {
+		return MustCreateBinary(sqltypes.VarBinary, length)
+	}
+	return MustCreateString(sqltypes.VarChar, length, collation)
+}
+
+func maxUint8(a, b uint8) uint8 {
+	if a > b {
+		return a
+	}
+	return b
+}
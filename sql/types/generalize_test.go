@@ -0,0 +1,73 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestGeneralizeTypes(t *testing.T) {
+	tests := []struct {
+		l        sql.Type
+		r        sql.Type
+		expected sql.Type
+	}{
+		{Null, Int32, Int32},
+		{Int32, Null, Int32},
+		{Int8, Int64, Int64},
+		{Int24, Int16, Int24},
+		{Uint8, Uint32, Uint32},
+		{Uint8, Int8, Int16},
+		{Int8, Uint16, Int32},
+		{Uint32, Int32, Int64},
+		{Uint64, Int8, MustCreateColumnDecimalType(20, 0)},
+		{Year, Int8, Int16},
+		{Float32, Int8, Float32},
+		{Float32, Int32, Float64},
+		{Float32, Float64, Float64},
+		{MustCreateDecimalType(5, 2), Float32, Float64},
+		{MustCreateDecimalType(5, 2), MustCreateDecimalType(10, 4), MustCreateColumnDecimalType(10, 4)},
+		{MustCreateDecimalType(5, 4), MustCreateDecimalType(10, 0), MustCreateColumnDecimalType(14, 4)},
+		{Int32, MustCreateDecimalType(5, 2), MustCreateColumnDecimalType(12, 2)},
+		{Date, Date, Date},
+		{Date, Datetime, Datetime},
+		{Timestamp, MustCreateDatetimeType(sqltypes.Timestamp, 3), MustCreateDatetimeType(sqltypes.Timestamp, 3)},
+		{Timestamp, MustCreateDatetimeType(sqltypes.Datetime, 6), DatetimeMaxPrecision},
+		{Time, Date, Datetime},
+		{Time, Time, Time},
+		{JSON, JSON, JSON},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), MustCreateStringWithDefaults(sqltypes.VarChar, 20), MustCreateStringWithDefaults(sqltypes.VarChar, 20)},
+		{MustCreateStringWithDefaults(sqltypes.Char, 30), MustCreateStringWithDefaults(sqltypes.VarChar, 20), MustCreateStringWithDefaults(sqltypes.VarChar, 30)},
+		{Int32, MustCreateStringWithDefaults(sqltypes.VarChar, 5), MustCreateStringWithDefaults(sqltypes.VarChar, 11)},
+		{Date, Int8, MustCreateStringWithDefaults(sqltypes.VarChar, 10)},
+		{MustCreateDatetimeType(sqltypes.Datetime, 3), Float64, MustCreateStringWithDefaults(sqltypes.VarChar, 23)},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 20), Text, Text},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 20), JSON, LongText},
+		{MustCreateBinary(sqltypes.VarBinary, 10), MustCreateStringWithDefaults(sqltypes.VarChar, 20), MustCreateBinary(sqltypes.VarBinary, 20)},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 16383), MustCreateStringWithDefaults(sqltypes.VarChar, 16384), MediumText},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %s", test.l, test.r), func(t *testing.T) {
+			assert.Equal(t, test.expected, GeneralizeTypes(test.l, test.r))
+		})
+	}
+}
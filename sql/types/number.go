@@ -1516,3 +1516,99 @@ func CoalesceInt(val interface{}) (int, bool) {
 		return 0, false
 	}
 }
+
+// IsOutOfRangeConversion returns whether the result of converting a value to the numeric type |t|, given by |inRange|
+// and |err|, means that the value is outside the range of the type.
+func IsOutOfRangeConversion(t sql.Type, inRange sql.ConvertInRange, err error) bool {
+	switch t.(type) {
+	case NumberTypeImpl_:
+		return err == nil && inRange == sql.OutOfRange
+	case DecimalType_:
+		return ErrConvertToDecimalLimit.Is(err)
+	default:
+		return false
+	}
+}
+
+// ClampToRange returns the value of the numeric type |t| that is closest to |v|, a value outside the range of the type:
+// the minimum value of the type if |v| is negative, and the maximum value otherwise.
+func ClampToRange(t sql.Type, v interface{}) (interface{}, error) {
+	d, _, err := InternalDecimalType.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+	negative := d.(decimal.Decimal).Sign() < 0
+
+	switch t.Type() {
+	case sqltypes.Int8:
+		if negative {
+			return int8(math.MinInt8), nil
+		}
+		return int8(math.MaxInt8), nil
+	case sqltypes.Uint8:
+		if negative {
+			return uint8(0), nil
+		}
+		return uint8(math.MaxUint8), nil
+	case sqltypes.Int16:
+		if negative {
+			return int16(math.MinInt16), nil
+		}
+		return int16(math.MaxInt16), nil
+	case sqltypes.Uint16:
+		if negative {
+			return uint16(0), nil
+		}
+		return uint16(math.MaxUint16), nil
+	case sqltypes.Int24:
+		if negative {
+			return int32(-1 << 23), nil
+		}
+		return int32(1<<23 - 1), nil
+	case sqltypes.Uint24:
+		if negative {
+			return uint32(0), nil
+		}
+		return uint32(1<<24 - 1), nil
+	case sqltypes.Int32:
+		if negative {
+			return int32(math.MinInt32), nil
+		}
+		return int32(math.MaxInt32), nil
+	case sqltypes.Uint32:
+		if negative {
+			return uint32(0), nil
+		}
+		return uint32(math.MaxUint32), nil
+	case sqltypes.Int64:
+		if negative {
+			return int64(math.MinInt64), nil
+		}
+		return int64(math.MaxInt64), nil
+	case sqltypes.Uint64:
+		if negative {
+			return uint64(0), nil
+		}
+		return uint64(math.MaxUint64), nil
+	case sqltypes.Float32:
+		if negative {
+			return float32(-math.MaxFloat32), nil
+		}
+		return float32(math.MaxFloat32), nil
+	case sqltypes.Float64:
+		if negative {
+			return -math.MaxFloat64, nil
+		}
+		return math.MaxFloat64, nil
+	case sqltypes.Decimal:
+		dt := t.(sql.DecimalType)
+		// the largest value has every digit of the precision set to 9
+		max := decimal.New(1, int32(dt.Precision()-dt.Scale())).Sub(decimal.New(1, -int32(dt.Scale())))
+		if negative {
+			return max.Neg(), nil
+		}
+		return max, nil
+	default:
+		return nil, sql.ErrInvalidType.New(t.String())
+	}
+}
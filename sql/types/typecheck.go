@@ -105,6 +105,9 @@ func IsSigned(t sql.Type) bool {
 	if _, ok := t.(SystemBoolType); ok {
 		return true
 	}
+	if svt, ok := t.(sql.SystemVariableType); ok {
+		t = svt.UnderlyingType()
+	}
 	return t == Int8 || t == Int16 || t == Int24 || t == Int32 || t == Int64 || t == Boolean
 }
 
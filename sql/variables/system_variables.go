@@ -62,6 +62,49 @@ func (sv *globalSystemVariables) AddSystemVariables(sysVars []sql.SystemVariable
 	}
 }
 
+// RegisterSystemVariables adds the given system variables to the collection. Unlike AddSystemVariables, an error is
+// returned if a name is already used by an existing variable, or by another variable in the given slice, or if a
+// variable's definition is invalid. Either all of the variables are added, or none of them are. Variables registered
+// after a session has started are visible to that session as well.
+func (sv *globalSystemVariables) RegisterSystemVariables(sysVars []sql.SystemVariable) error {
+	sv.mutex.Lock()
+	defer sv.mutex.Unlock()
+	newVars := make(map[string]sql.SystemVariable, len(sysVars))
+	for _, sysVar := range sysVars {
+		lowerName := strings.ToLower(sysVar.Name)
+		if lowerName == "" {
+			return sql.ErrInvalidSystemVariable.New(sysVar.Name, "name must not be empty")
+		}
+		if _, ok := systemVars[lowerName]; ok {
+			return sql.ErrSystemVariableAlreadyExists.New(lowerName)
+		}
+		if _, ok := newVars[lowerName]; ok {
+			return sql.ErrSystemVariableAlreadyExists.New(lowerName)
+		}
+		switch sysVar.Scope {
+		case sql.SystemVariableScope_Global, sql.SystemVariableScope_Session, sql.SystemVariableScope_Both:
+		default:
+			return sql.ErrInvalidSystemVariable.New(lowerName, "scope must be GLOBAL, SESSION, or both")
+		}
+		if sysVar.Type == nil {
+			return sql.ErrInvalidSystemVariable.New(lowerName, "type must not be nil")
+		}
+		if _, _, err := sysVar.Type.Convert(sysVar.Default); err != nil {
+			return sql.ErrInvalidSystemVariable.New(lowerName, err.Error())
+		}
+		sysVar.Name = lowerName
+		newVars[lowerName] = sysVar
+	}
+	for name, sysVar := range newVars {
+		systemVars[name] = sysVar
+		sv.sysVarVals[name] = sql.SystemVarValue{
+			Var: sysVar,
+			Val: sysVar.Default,
+		}
+	}
+	return nil
+}
+
 // AssignValues sets all of the values in the given map to their respective variables. If a variable cannot be found, or
 // the value is invalid, then an error is returned. If the values contain any custom system variables, then make sure
 // that they've been added using AddSystemVariables first.
@@ -774,6 +817,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("eq_range_index_dive_limit", 0, 4294967295, false),
 		Default:           int64(200),
 	},
+	"error_count": {
+		Name:              "error_count",
+		Scope:             sql.SystemVariableScope_Session,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemIntType("error_count", 0, 65535, false),
+		Default:           int64(0),
+	},
 	"event_scheduler": {
 		Name:              "event_scheduler",
 		Scope:             sql.SystemVariableScope_Global,
@@ -1030,15 +1081,25 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("inmemory_joins"),
 		Default:           int8(0),
 	},
-	// Row locking is currently not supported. This variable is provided for 3p tools, and we always return the
-	// Lowest value allowed by MySQL, which is 1. If you attempt to set this value to anything other than 1, errors ensue.
+	// The number of goroutines that read and sort the rows of a table when the engine builds a new index on it.
+	"innodb_ddl_threads": {
+		Name:              "innodb_ddl_threads",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemIntType("innodb_ddl_threads", 1, 64, false),
+		Default:           int64(4),
+	},
+	// The number of seconds a transaction waits for a row lock. Row locking is currently not supported, so this only
+	// has MySQL's scope, range and default for 3p tools that set it. Statements wait for the metadata locks of tables
+	// held by other sessions for up to @@lock_wait_timeout seconds instead.
 	"innodb_lock_wait_timeout": {
 		Name:              "innodb_lock_wait_timeout",
-		Scope:             sql.SystemVariableScope_Global,
+		Scope:             sql.SystemVariableScope_Both,
 		Dynamic:           true,
 		SetVarHintApplies: false,
-		Type:              types.NewSystemIntType("innodb_lock_wait_timeout", 1, 1, false),
-		Default:           int64(1),
+		Type:              types.NewSystemIntType("innodb_lock_wait_timeout", 1, 1073741824, false),
+		Default:           int64(50),
 	},
 	"innodb_stats_auto_recalc": {
 		Name:              "innodb_stats_auto_recalc",
@@ -1182,7 +1243,7 @@ var systemVars = map[string]sql.SystemVariable{
 		Dynamic:           true,
 		SetVarHintApplies: false,
 		Type:              types.NewSystemStringType("lc_time_names"),
-		Default:           "",
+		Default:           "en_US",
 	},
 	"license": {
 		Name:              "license",
@@ -2889,6 +2950,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("wait_timeout", 1, 31536000, false),
 		Default:           int64(28800),
 	},
+	"warning_count": {
+		Name:              "warning_count",
+		Scope:             sql.SystemVariableScope_Session,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemIntType("warning_count", 0, 65535, false),
+		Default:           int64(0),
+	},
 	"windowing_use_high_precision": {
 		Name:              "windowing_use_high_precision",
 		Scope:             sql.SystemVariableScope_Both,
@@ -138,3 +138,72 @@ func TestInitReadonlySessionVariable(t *testing.T) {
 	require.Error(err)
 	require.True(sql.ErrSystemVariableReinitialized.Is(err))
 }
+
+func TestRegisterSystemVariables(t *testing.T) {
+	require := require.New(t)
+	InitSystemVariables()
+	t.Cleanup(func() {
+		delete(systemVars, "custom_session_var")
+		delete(systemVars, "custom_global_var")
+		InitSystemVariables()
+	})
+	ctx := sql.NewEmptyContext()
+	sess := sql.NewBaseSessionWithClientServer("foo", sql.Client{Address: "baz", User: "bar"}, 1)
+
+	sessionVar := sql.SystemVariable{
+		Name:    "Custom_Session_Var",
+		Scope:   sql.SystemVariableScope_Session,
+		Dynamic: true,
+		Type:    types.NewSystemIntType("custom_session_var", 0, 100, false),
+		Default: int64(10),
+	}
+	globalVar := sql.SystemVariable{
+		Name:    "custom_global_var",
+		Scope:   sql.SystemVariableScope_Global,
+		Dynamic: false,
+		Type:    types.NewSystemStringType("custom_global_var"),
+		Default: "abc",
+	}
+
+	// invalid definitions and collisions are rejected without registering anything
+	err := sql.SystemVariables.RegisterSystemVariables([]sql.SystemVariable{sessionVar, {Name: "MAX_CONNECTIONS", Scope: sql.SystemVariableScope_Global, Type: types.NewSystemStringType("max_connections")}})
+	require.True(sql.ErrSystemVariableAlreadyExists.Is(err))
+	err = sql.SystemVariables.RegisterSystemVariables([]sql.SystemVariable{sessionVar, sessionVar})
+	require.True(sql.ErrSystemVariableAlreadyExists.Is(err))
+	err = sql.SystemVariables.RegisterSystemVariables([]sql.SystemVariable{{Name: "custom_untyped_var", Scope: sql.SystemVariableScope_Both}})
+	require.True(sql.ErrInvalidSystemVariable.Is(err))
+	err = sql.SystemVariables.RegisterSystemVariables([]sql.SystemVariable{{Name: "custom_persist_var", Scope: sql.SystemVariableScope_Persist, Type: types.NewSystemStringType("custom_persist_var")}})
+	require.True(sql.ErrInvalidSystemVariable.Is(err))
+	err = sql.SystemVariables.RegisterSystemVariables([]sql.SystemVariable{{Name: "custom_bad_default", Scope: sql.SystemVariableScope_Both, Type: types.NewSystemIntType("custom_bad_default", 0, 100, false), Default: int64(1000)}})
+	require.True(sql.ErrInvalidSystemVariable.Is(err))
+	_, _, ok := sql.SystemVariables.GetGlobal("custom_session_var")
+	require.False(ok)
+
+	require.NoError(sql.SystemVariables.RegisterSystemVariables([]sql.SystemVariable{sessionVar, globalVar}))
+	err = sql.SystemVariables.RegisterSystemVariables([]sql.SystemVariable{globalVar})
+	require.True(sql.ErrSystemVariableAlreadyExists.Is(err))
+
+	sysVar, val, ok := sql.SystemVariables.GetGlobal("CUSTOM_SESSION_VAR")
+	require.True(ok)
+	require.Equal("custom_session_var", sysVar.Name)
+	require.Equal(int64(10), val)
+	_, val, ok = sql.SystemVariables.GetGlobal("custom_global_var")
+	require.True(ok)
+	require.Equal("abc", val)
+	require.True(sql.ErrSystemVariableReadOnly.Is(sql.SystemVariables.SetGlobal("custom_global_var", "def")))
+
+	// variables registered after a session was created are visible to it
+	val, err = sess.GetSessionVariable(ctx, "custom_session_var")
+	require.NoError(err)
+	require.Equal(int64(10), val)
+	require.NoError(sess.SetSessionVariable(ctx, "custom_session_var", 20))
+	val, err = sess.GetSessionVariable(ctx, "custom_session_var")
+	require.NoError(err)
+	require.Equal(int64(20), val)
+	require.Equal("abc", sess.GetAllSessionVariables()["custom_global_var"])
+
+	// registered variables survive the registry being reinitialized, just like those added with AddSystemVariables
+	InitSystemVariables()
+	_, _, ok = sql.SystemVariables.GetGlobal("custom_global_var")
+	require.True(ok)
+}
@@ -101,6 +101,32 @@ func (r *ViewRegistry) Register(database string, view *View) error {
 	return nil
 }
 
+// Replace adds the view specified by the pair {database, view.Name()}, replacing the existing view with that key if
+// there is one.
+func (r *ViewRegistry) Replace(database string, view *View) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.views[NewViewKey(database, view.Name())] = view
+	return nil
+}
+
+// Alter replaces the view specified by the pair {database, view.Name()}, returning an error if there is no element
+// with that key.
+func (r *ViewRegistry) Alter(database string, view *View) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key := NewViewKey(database, view.Name())
+
+	if _, ok := r.views[key]; !ok {
+		return ErrViewDoesNotExist.New(database, view.Name())
+	}
+
+	r.views[key] = view
+	return nil
+}
+
 // Delete deletes the view specified by the pair {databaseName, viewName},
 // returning an error if it does not exist.
 func (r *ViewRegistry) Delete(databaseName, viewName string) error {
@@ -118,7 +144,8 @@ func (r *ViewRegistry) Delete(databaseName, viewName string) error {
 }
 
 // View returns a pointer to the view specified by the pair {databaseName,
-// viewName}, returning an error if it does not exist.
+// viewName}, returning false if it does not exist. Both names are matched
+// case-insensitively.
 func (r *ViewRegistry) View(databaseName, viewName string) (*View, bool) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
@@ -130,11 +157,12 @@ func (r *ViewRegistry) View(databaseName, viewName string) (*View, bool) {
 }
 
 // ViewsInDatabase returns an array of all the views registered under the
-// specified database.
+// specified database. The database name is matched case-insensitively.
 func (r *ViewRegistry) ViewsInDatabase(databaseName string) (views []*View) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
+	databaseName = strings.ToLower(databaseName)
 	for key, value := range r.views {
 		if key.dbName == databaseName {
 			views = append(views, value)
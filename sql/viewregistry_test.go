@@ -16,6 +16,7 @@ package sql
 
 import (
 	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -140,6 +141,40 @@ func TestViewsInDatabase(t *testing.T) {
 	}
 }
 
+// Tests that views are found regardless of the casing used to register and look them up.
+func TestViewNamesAreCaseInsensitive(t *testing.T) {
+	require := require.New(t)
+
+	registry := NewViewRegistry()
+	view := NewView("MyView", nil, "", "")
+	require.NoError(registry.Register("MyDb", view))
+
+	for _, name := range []string{"MyView", "myview", "MYVIEW", "mYvIeW"} {
+		actualView, ok := registry.View("mydb", name)
+		require.True(ok, name)
+		require.Equal(view, actualView)
+		require.Equal("MyView", actualView.Name())
+		require.True(registry.Exists("MYDB", name), name)
+	}
+
+	require.Equal([]*View{view}, registry.ViewsInDatabase("mydb"))
+	require.Equal([]*View{view}, registry.ViewsInDatabase("MYDB"))
+
+	err := registry.Register("mydb", NewView("myview", nil, "", ""))
+	require.True(ErrExistingView.Is(err))
+
+	replacement := NewView("MYVIEW", nil, "", "")
+	require.NoError(registry.Replace("myDB", replacement))
+	actualView, ok := registry.View("MyDb", "MyView")
+	require.True(ok)
+	require.Equal(replacement, actualView)
+	require.Equal(1, len(registry.views))
+
+	require.NoError(registry.Delete("MYDB", "myView"))
+	require.False(registry.Exists("MyDb", "MyView"))
+	require.Empty(registry.ViewsInDatabase("MyDb"))
+}
+
 var viewKeys = []ViewKey{
 	{
 		"db1",
@@ -178,3 +213,92 @@ func TestExistsOnNonExistingView(t *testing.T) {
 
 	require.False(registry.Exists("non", "existing"))
 }
+
+// Tests that replacing an existing view succeeds and replaces its definition.
+func TestReplaceExistingView(t *testing.T) {
+	require := require.New(t)
+
+	registry := newRegistry(require)
+
+	newView := NewView(viewName, nil, "select 2", "create view myview as select 2")
+	err := registry.Replace(dbName, newView)
+	require.NoError(err)
+	require.Equal(1, len(registry.views))
+
+	actualView, ok := registry.View(dbName, viewName)
+	require.True(ok)
+	require.Equal(newView, actualView)
+}
+
+// Tests that replacing a non-existing view registers it.
+func TestReplaceNonExistingView(t *testing.T) {
+	require := require.New(t)
+
+	registry := NewViewRegistry()
+
+	err := registry.Replace(dbName, testView)
+	require.NoError(err)
+	require.Equal(1, len(registry.views))
+
+	actualView, ok := registry.View(dbName, viewName)
+	require.True(ok)
+	require.Equal(testView, actualView)
+}
+
+// Tests that concurrent replacements of the same view leave exactly one of them registered.
+func TestReplaceConcurrently(t *testing.T) {
+	require := require.New(t)
+
+	registry := newRegistry(require)
+
+	const numReplacements = 50
+	views := make([]*View, numReplacements)
+	for i := range views {
+		views[i] = NewView(viewName, nil, "select "+strconv.Itoa(i), "")
+	}
+
+	var wg sync.WaitGroup
+	for _, view := range views {
+		wg.Add(1)
+		go func(view *View) {
+			defer wg.Done()
+			require.NoError(registry.Replace(dbName, view))
+			_, ok := registry.View(dbName, viewName)
+			require.True(ok)
+		}(view)
+	}
+	wg.Wait()
+
+	require.Equal(1, len(registry.views))
+	actualView, ok := registry.View(dbName, viewName)
+	require.True(ok)
+	require.Contains(views, actualView)
+}
+
+// Tests that altering an existing view succeeds and replaces its definition.
+func TestAlterExistingView(t *testing.T) {
+	require := require.New(t)
+
+	registry := newRegistry(require)
+
+	newView := NewView(viewName, nil, "select 2", "create view myview as select 2")
+	err := registry.Alter(dbName, newView)
+	require.NoError(err)
+	require.Equal(1, len(registry.views))
+
+	actualView, ok := registry.View(dbName, viewName)
+	require.True(ok)
+	require.Equal(newView, actualView)
+}
+
+// Tests that altering a non-existing view fails without registering it.
+func TestAlterNonExistingView(t *testing.T) {
+	require := require.New(t)
+
+	registry := NewViewRegistry()
+
+	err := registry.Alter(dbName, testView)
+	require.Error(err)
+	require.True(ErrViewDoesNotExist.Is(err))
+	require.Equal(0, len(registry.views))
+}
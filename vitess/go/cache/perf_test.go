@@ -0,0 +1,40 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"testing"
+)
+
+type MyValue []byte
+
+func (mv MyValue) Size() int {
+	return cap(mv)
+}
+
+func BenchmarkGet(b *testing.B) {
+	cache := NewLRUCache(64 * 1024 * 1024)
+	value := make(MyValue, 1000)
+	cache.Set("stuff", value)
+	for i := 0; i < b.N; i++ {
+		val, ok := cache.Get("stuff")
+		if !ok {
+			panic("error")
+		}
+		_ = val
+	}
+}
@@ -0,0 +1,22637 @@
+// Code generated by goyacc -o sql.go sql.y. DO NOT EDIT.
+
+//line sql.y:18
+package sqlparser
+
+import __yyfmt__ "fmt"
+
+//line sql.y:18
+
+import "fmt"
+import "strconv"
+import "strings"
+
+//import "runtime/debug"
+
+func setParseTree(yylex interface{}, stmt Statement) {
+	yylex.(*Tokenizer).ParseTree = stmt
+}
+
+func setAllowComments(yylex interface{}, allow bool) {
+	yylex.(*Tokenizer).AllowComments = allow
+}
+
+func incNesting(yylex interface{}) bool {
+	yylex.(*Tokenizer).nesting++
+	if yylex.(*Tokenizer).nesting == 200 {
+		return true
+	}
+	return false
+}
+
+func decNesting(yylex interface{}) {
+	yylex.(*Tokenizer).nesting--
+}
+
+func statementSeen(yylex interface{}) {
+	if yylex.(*Tokenizer).stopAfterFirstStmt {
+		yylex.(*Tokenizer).stopped = true
+	}
+}
+
+func yyPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).Position
+}
+
+func yyOldPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).OldPosition
+}
+
+func yySpecialCommentMode(yylex interface{}) bool {
+	tkn := yylex.(*Tokenizer)
+	return tkn.specialComment != nil
+}
+
+//line sql.y:66
+type yySymType struct {
+	yys                      int
+	empty                    struct{}
+	statement                Statement
+	selStmt                  SelectStatement
+	ddl                      *DDL
+	ddls                     []*DDL
+	ins                      *Insert
+	byt                      byte
+	bytes                    []byte
+	bytes2                   [][]byte
+	str                      string
+	int                      int
+	strs                     []string
+	selectExprs              SelectExprs
+	selectExpr               SelectExpr
+	columns                  Columns
+	statements               Statements
+	partitions               Partitions
+	variables                Variables
+	into                     *Into
+	colName                  *ColName
+	tableExprs               TableExprs
+	tableExpr                TableExpr
+	subquery                 *Subquery
+	simpleTableExpr          SimpleTableExpr
+	joinCondition            JoinCondition
+	triggerName              TriggerName
+	tableName                TableName
+	tableNames               TableNames
+	procedureName            ProcedureName
+	indexHints               *IndexHints
+	asOf                     *AsOf
+	expr                     Expr
+	exprs                    Exprs
+	boolVal                  BoolVal
+	boolean                  bool
+	sqlVal                   *SQLVal
+	colTuple                 ColTuple
+	values                   Values
+	valTuple                 ValTuple
+	whens                    []*When
+	when                     *When
+	orderBy                  OrderBy
+	order                    *Order
+	limit                    *Limit
+	assignExprs              AssignmentExprs
+	assignExpr               *AssignmentExpr
+	setVarExprs              SetVarExprs
+	setVarExpr               *SetVarExpr
+	setScope                 SetScope
+	colIdent                 ColIdent
+	colIdents                []ColIdent
+	tableIdent               TableIdent
+	convertType              *ConvertType
+	jsonValueExpr            *JSONValueExpr
+	jsonValueHandler         *JSONValueHandler
+	aliasedTableName         *AliasedTableExpr
+	TableSpec                *TableSpec
+	columnType               ColumnType
+	queryOpts                QueryOpts
+	JSONTableSpec            *JSONTableSpec
+	JSONTableColDef          *JSONTableColDef
+	JSONTableColOpts         JSONTableColOpts
+	columnOrder              *ColumnOrder
+	triggerOrder             *TriggerOrder
+	colKeyOpt                ColumnKeyOption
+	optVal                   Expr
+	LengthScaleOption        LengthScaleOption
+	columnDefinition         *ColumnDefinition
+	indexDefinition          *IndexDefinition
+	indexInfo                *IndexInfo
+	indexOption              *IndexOption
+	indexOptions             []*IndexOption
+	flushOption              *FlushOption
+	replicationOption        *ReplicationOption
+	replicationOptions       []*ReplicationOption
+	indexColumn              *IndexColumn
+	indexColumns             []*IndexColumn
+	constraintDefinition     *ConstraintDefinition
+	constraintInfo           ConstraintInfo
+	ReferenceAction          ReferenceAction
+	partDefs                 []*PartitionDefinition
+	partDef                  *PartitionDefinition
+	partSpec                 *PartitionSpec
+	viewSpec                 *ViewSpec
+	showFilter               *ShowFilter
+	frame                    *Frame
+	frameExtent              *FrameExtent
+	frameBound               *FrameBound
+	caseStatementCases       []CaseStatementCase
+	caseStatementCase        CaseStatementCase
+	ifStatementConditions    []IfStatementCondition
+	ifStatementCondition     IfStatementCondition
+	signalInfo               SignalInfo
+	signalInfos              []SignalInfo
+	signalConditionItemName  SignalConditionItemName
+	declareHandlerAction     DeclareHandlerAction
+	declareHandlerCondition  DeclareHandlerCondition
+	declareHandlerConditions []DeclareHandlerCondition
+	procedureParam           ProcedureParam
+	procedureParams          []ProcedureParam
+	characteristic           Characteristic
+	characteristics          []Characteristic
+	charsetCollate           *CharsetAndCollate
+	charsetCollates          []*CharsetAndCollate
+	Fields                   *Fields
+	Lines                    *Lines
+	EnclosedBy               *EnclosedBy
+	tableAndLockType         *TableAndLockType
+	tableAndLockTypes        TableAndLockTypes
+	lockType                 LockType
+	accountName              AccountName
+	accountNames             []AccountName
+	accountRenames           []AccountRename
+	authentication           *Authentication
+	accountWithAuth          AccountWithAuth
+	accountsWithAuth         []AccountWithAuth
+	tlsOptionItem            TLSOptionItem
+	tlsOptionItems           []TLSOptionItem
+	accountLimitItem         AccountLimitItem
+	accountLimitItems        []AccountLimitItem
+	passLockItem             PassLockItem
+	passLockItems            []PassLockItem
+	grantPrivilege           Privilege
+	grantPrivileges          []Privilege
+	grantObjectType          GrantObjectType
+	privilegeLevel           PrivilegeLevel
+	grantAssumption          *GrantUserAssumption
+	with                     *With
+	window                   Window
+	over                     *Over
+	windowDef                *WindowDef
+	eventName                EventName
+	eventScheduleSpec        *EventScheduleSpec
+	eventScheduleTimeSpec    *EventScheduleTimeSpec
+	eventStatus              EventStatus
+	eventOnCompletion        EventOnCompletion
+	intervalExprs            []IntervalExpr
+	separator                Separator
+	srsAttr                  *SrsAttribute
+	resourceGroup            *ResourceGroup
+	vcpuRange                ResourceGroupVCPURange
+	vcpuRanges               []ResourceGroupVCPURange
+	intPtr                   *int
+	boolPtr                  *bool
+	uint64                   uint64
+	uint32s                  []uint32
+}
+
+const LEX_ERROR = 57346
+const FOR_SYSTEM_TIME = 57347
+const OFFSET_ROWS = 57348
+const FOR_VERSION = 57349
+const EXCEPT = 57350
+const UNION = 57351
+const INTERSECT = 57352
+const SELECT = 57353
+const STREAM = 57354
+const INSERT = 57355
+const UPDATE = 57356
+const DELETE = 57357
+const FROM = 57358
+const WHERE = 57359
+const GROUP = 57360
+const HAVING = 57361
+const ORDER = 57362
+const BY = 57363
+const LIMIT = 57364
+const OFFSET = 57365
+const FOR = 57366
+const CALL = 57367
+const ALL = 57368
+const DISTINCT = 57369
+const AS = 57370
+const EXISTS = 57371
+const ASC = 57372
+const DESC = 57373
+const DUPLICATE = 57374
+const DEFAULT = 57375
+const SET = 57376
+const LOCK = 57377
+const UNLOCK = 57378
+const KEYS = 57379
+const OF = 57380
+const OUTFILE = 57381
+const DUMPFILE = 57382
+const DATA = 57383
+const LOAD = 57384
+const LINES = 57385
+const TERMINATED = 57386
+const ESCAPED = 57387
+const ENCLOSED = 57388
+const OPTIONALLY = 57389
+const STARTING = 57390
+const UNIQUE = 57391
+const KEY = 57392
+const SYSTEM_TIME = 57393
+const CONTAINED = 57394
+const VERSION = 57395
+const VERSIONS = 57396
+const VALUES = 57397
+const LAST_INSERT_ID = 57398
+const SQL_CALC_FOUND_ROWS = 57399
+const NEXT = 57400
+const VALUE = 57401
+const SHARE = 57402
+const MODE = 57403
+const SQL_NO_CACHE = 57404
+const SQL_CACHE = 57405
+const JOIN = 57406
+const STRAIGHT_JOIN = 57407
+const LEFT = 57408
+const RIGHT = 57409
+const INNER = 57410
+const OUTER = 57411
+const CROSS = 57412
+const NATURAL = 57413
+const USE = 57414
+const FORCE = 57415
+const ON = 57416
+const USING = 57417
+const ID = 57418
+const HEX = 57419
+const STRING = 57420
+const INTEGRAL = 57421
+const FLOAT = 57422
+const HEXNUM = 57423
+const VALUE_ARG = 57424
+const LIST_ARG = 57425
+const COMMENT = 57426
+const COMMENT_KEYWORD = 57427
+const BIT_LITERAL = 57428
+const NCHAR_STRING = 57429
+const NULL = 57430
+const TRUE = 57431
+const FALSE = 57432
+const OFF = 57433
+const INTO = 57434
+const LIMIT_WITHOUT_TIES = 57435
+const WITH = 57436
+const OR = 57437
+const XOR = 57438
+const AND = 57439
+const NOT = 57440
+const BETWEEN = 57441
+const CASE = 57442
+const WHEN = 57443
+const THEN = 57444
+const ELSE = 57445
+const ELSEIF = 57446
+const END = 57447
+const LE = 57448
+const GE = 57449
+const NE = 57450
+const NULL_SAFE_EQUAL = 57451
+const IS = 57452
+const LIKE = 57453
+const REGEXP = 57454
+const IN = 57455
+const ASSIGNMENT_OP = 57456
+const UNBOUNDED = 57457
+const PARTITION = 57458
+const RANGE = 57459
+const ROWS = 57460
+const GROUPS = 57461
+const PRECEDING = 57462
+const FOLLOWING = 57463
+const SHIFT_LEFT = 57464
+const SHIFT_RIGHT = 57465
+const DIV = 57466
+const MOD = 57467
+const UNARY = 57468
+const COLLATE = 57469
+const BINARY = 57470
+const UNDERSCORE_ARMSCII8 = 57471
+const UNDERSCORE_ASCII = 57472
+const UNDERSCORE_BIG5 = 57473
+const UNDERSCORE_BINARY = 57474
+const UNDERSCORE_CP1250 = 57475
+const UNDERSCORE_CP1251 = 57476
+const UNDERSCORE_CP1256 = 57477
+const UNDERSCORE_CP1257 = 57478
+const UNDERSCORE_CP850 = 57479
+const UNDERSCORE_CP852 = 57480
+const UNDERSCORE_CP866 = 57481
+const UNDERSCORE_CP932 = 57482
+const UNDERSCORE_DEC8 = 57483
+const UNDERSCORE_EUCJPMS = 57484
+const UNDERSCORE_EUCKR = 57485
+const UNDERSCORE_GB18030 = 57486
+const UNDERSCORE_GB2312 = 57487
+const UNDERSCORE_GBK = 57488
+const UNDERSCORE_GEOSTD8 = 57489
+const UNDERSCORE_GREEK = 57490
+const UNDERSCORE_HEBREW = 57491
+const UNDERSCORE_HP8 = 57492
+const UNDERSCORE_KEYBCS2 = 57493
+const UNDERSCORE_KOI8R = 57494
+const UNDERSCORE_KOI8U = 57495
+const UNDERSCORE_LATIN1 = 57496
+const UNDERSCORE_LATIN2 = 57497
+const UNDERSCORE_LATIN5 = 57498
+const UNDERSCORE_LATIN7 = 57499
+const UNDERSCORE_MACCE = 57500
+const UNDERSCORE_MACROMAN = 57501
+const UNDERSCORE_SJIS = 57502
+const UNDERSCORE_SWE7 = 57503
+const UNDERSCORE_TIS620 = 57504
+const UNDERSCORE_UCS2 = 57505
+const UNDERSCORE_UJIS = 57506
+const UNDERSCORE_UTF16 = 57507
+const UNDERSCORE_UTF16LE = 57508
+const UNDERSCORE_UTF32 = 57509
+const UNDERSCORE_UTF8 = 57510
+const UNDERSCORE_UTF8MB3 = 57511
+const UNDERSCORE_UTF8MB4 = 57512
+const INTERVAL = 57513
+const JSON_EXTRACT_OP = 57514
+const JSON_UNQUOTE_EXTRACT_OP = 57515
+const CREATE = 57516
+const ALTER = 57517
+const DROP = 57518
+const RENAME = 57519
+const ANALYZE = 57520
+const ADD = 57521
+const MODIFY = 57522
+const CHANGE = 57523
+const SCHEMA = 57524
+const TABLE = 57525
+const INDEX = 57526
+const INDEXES = 57527
+const VIEW = 57528
+const TO = 57529
+const IGNORE = 57530
+const IF = 57531
+const PRIMARY = 57532
+const COLUMN = 57533
+const SPATIAL = 57534
+const FULLTEXT = 57535
+const KEY_BLOCK_SIZE = 57536
+const CHECK = 57537
+const ACTION = 57538
+const CASCADE = 57539
+const CONSTRAINT = 57540
+const FOREIGN = 57541
+const NO = 57542
+const REFERENCES = 57543
+const RESTRICT = 57544
+const FIRST = 57545
+const AFTER = 57546
+const LAST = 57547
+const SHOW = 57548
+const DESCRIBE = 57549
+const EXPLAIN = 57550
+const DATE = 57551
+const ESCAPE = 57552
+const REPAIR = 57553
+const OPTIMIZE = 57554
+const TRUNCATE = 57555
+const FORMAT = 57556
+const EXTENDED = 57557
+const MAXVALUE = 57558
+const REORGANIZE = 57559
+const LESS = 57560
+const THAN = 57561
+const PROCEDURE = 57562
+const TRIGGER = 57563
+const TRIGGERS = 57564
+const FUNCTION = 57565
+const STATUS = 57566
+const VARIABLES = 57567
+const WARNINGS = 57568
+const ERRORS = 57569
+const KILL = 57570
+const CONNECTION = 57571
+const SEQUENCE = 57572
+const ENABLE = 57573
+const DISABLE = 57574
+const EACH = 57575
+const ROW = 57576
+const BEFORE = 57577
+const FOLLOWS = 57578
+const PRECEDES = 57579
+const DEFINER = 57580
+const INVOKER = 57581
+const INOUT = 57582
+const OUT = 57583
+const DETERMINISTIC = 57584
+const CONTAINS = 57585
+const READS = 57586
+const MODIFIES = 57587
+const SQL = 57588
+const SECURITY = 57589
+const TEMPORARY = 57590
+const ALGORITHM = 57591
+const MERGE = 57592
+const TEMPTABLE = 57593
+const UNDEFINED = 57594
+const EVENT = 57595
+const EVENTS = 57596
+const SCHEDULE = 57597
+const EVERY = 57598
+const STARTS = 57599
+const ENDS = 57600
+const COMPLETION = 57601
+const PRESERVE = 57602
+const CLASS_ORIGIN = 57603
+const SUBCLASS_ORIGIN = 57604
+const MESSAGE_TEXT = 57605
+const MYSQL_ERRNO = 57606
+const CONSTRAINT_CATALOG = 57607
+const CONSTRAINT_SCHEMA = 57608
+const CONSTRAINT_NAME = 57609
+const CATALOG_NAME = 57610
+const SCHEMA_NAME = 57611
+const TABLE_NAME = 57612
+const COLUMN_NAME = 57613
+const CURSOR_NAME = 57614
+const SIGNAL = 57615
+const RESIGNAL = 57616
+const SQLSTATE = 57617
+const DECLARE = 57618
+const CONDITION = 57619
+const CURSOR = 57620
+const CONTINUE = 57621
+const EXIT = 57622
+const UNDO = 57623
+const HANDLER = 57624
+const FOUND = 57625
+const SQLWARNING = 57626
+const SQLEXCEPTION = 57627
+const FETCH = 57628
+const OPEN = 57629
+const CLOSE = 57630
+const LOOP = 57631
+const LEAVE = 57632
+const ITERATE = 57633
+const REPEAT = 57634
+const UNTIL = 57635
+const WHILE = 57636
+const DO = 57637
+const RETURN = 57638
+const USER = 57639
+const IDENTIFIED = 57640
+const ROLE = 57641
+const REUSE = 57642
+const GRANT = 57643
+const GRANTS = 57644
+const REVOKE = 57645
+const NONE = 57646
+const ATTRIBUTE = 57647
+const RANDOM = 57648
+const PASSWORD = 57649
+const INITIAL = 57650
+const AUTHENTICATION = 57651
+const SSL = 57652
+const X509 = 57653
+const CIPHER = 57654
+const ISSUER = 57655
+const SUBJECT = 57656
+const ACCOUNT = 57657
+const EXPIRE = 57658
+const NEVER = 57659
+const OPTION = 57660
+const OPTIONAL = 57661
+const ADMIN = 57662
+const PRIVILEGES = 57663
+const MAX_QUERIES_PER_HOUR = 57664
+const MAX_UPDATES_PER_HOUR = 57665
+const MAX_CONNECTIONS_PER_HOUR = 57666
+const MAX_USER_CONNECTIONS = 57667
+const FLUSH = 57668
+const FAILED_LOGIN_ATTEMPTS = 57669
+const PASSWORD_LOCK_TIME = 57670
+const REQUIRE = 57671
+const PROXY = 57672
+const ROUTINE = 57673
+const TABLESPACE = 57674
+const CLIENT = 57675
+const SLAVE = 57676
+const EXECUTE = 57677
+const FILE = 57678
+const RELOAD = 57679
+const REPLICATION = 57680
+const SHUTDOWN = 57681
+const SUPER = 57682
+const USAGE = 57683
+const LOGS = 57684
+const ENGINE = 57685
+const ERROR = 57686
+const GENERAL = 57687
+const HOSTS = 57688
+const OPTIMIZER_COSTS = 57689
+const RELAY = 57690
+const SLOW = 57691
+const USER_RESOURCES = 57692
+const NO_WRITE_TO_BINLOG = 57693
+const CHANNEL = 57694
+const APPLICATION_PASSWORD_ADMIN = 57695
+const AUDIT_ABORT_EXEMPT = 57696
+const AUDIT_ADMIN = 57697
+const AUTHENTICATION_POLICY_ADMIN = 57698
+const BACKUP_ADMIN = 57699
+const BINLOG_ADMIN = 57700
+const BINLOG_ENCRYPTION_ADMIN = 57701
+const CLONE_ADMIN = 57702
+const CONNECTION_ADMIN = 57703
+const ENCRYPTION_KEY_ADMIN = 57704
+const FIREWALL_ADMIN = 57705
+const FIREWALL_EXEMPT = 57706
+const FIREWALL_USER = 57707
+const FLUSH_OPTIMIZER_COSTS = 57708
+const FLUSH_STATUS = 57709
+const FLUSH_TABLES = 57710
+const FLUSH_USER_RESOURCES = 57711
+const GROUP_REPLICATION_ADMIN = 57712
+const GROUP_REPLICATION_STREAM = 57713
+const INNODB_REDO_LOG_ARCHIVE = 57714
+const INNODB_REDO_LOG_ENABLE = 57715
+const NDB_STORED_USER = 57716
+const PASSWORDLESS_USER_ADMIN = 57717
+const PERSIST_RO_VARIABLES_ADMIN = 57718
+const REPLICATION_APPLIER = 57719
+const REPLICATION_SLAVE_ADMIN = 57720
+const RESOURCE_GROUP_ADMIN = 57721
+const RESOURCE_GROUP_USER = 57722
+const ROLE_ADMIN = 57723
+const SENSITIVE_VARIABLES_OBSERVER = 57724
+const SESSION_VARIABLES_ADMIN = 57725
+const SET_USER_ID = 57726
+const SHOW_ROUTINE = 57727
+const SKIP_QUERY_REWRITE = 57728
+const SYSTEM_VARIABLES_ADMIN = 57729
+const TABLE_ENCRYPTION_ADMIN = 57730
+const TP_CONNECTION_ADMIN = 57731
+const VERSION_TOKEN_ADMIN = 57732
+const XA_RECOVER_ADMIN = 57733
+const REPLICA = 57734
+const SOURCE = 57735
+const STOP = 57736
+const RESET = 57737
+const FILTER = 57738
+const SOURCE_HOST = 57739
+const SOURCE_USER = 57740
+const SOURCE_PASSWORD = 57741
+const SOURCE_PORT = 57742
+const SOURCE_CONNECT_RETRY = 57743
+const SOURCE_RETRY_COUNT = 57744
+const REPLICATE_DO_TABLE = 57745
+const REPLICATE_IGNORE_TABLE = 57746
+const BEGIN = 57747
+const START = 57748
+const TRANSACTION = 57749
+const COMMIT = 57750
+const ROLLBACK = 57751
+const SAVEPOINT = 57752
+const WORK = 57753
+const RELEASE = 57754
+const CHAIN = 57755
+const BIT = 57756
+const TINYINT = 57757
+const SMALLINT = 57758
+const MEDIUMINT = 57759
+const INT = 57760
+const INTEGER = 57761
+const BIGINT = 57762
+const INTNUM = 57763
+const SERIAL = 57764
+const INT1 = 57765
+const INT2 = 57766
+const INT3 = 57767
+const INT4 = 57768
+const INT8 = 57769
+const REAL = 57770
+const DOUBLE = 57771
+const FLOAT_TYPE = 57772
+const DECIMAL = 57773
+const NUMERIC = 57774
+const DEC = 57775
+const FIXED = 57776
+const PRECISION = 57777
+const TIME = 57778
+const TIMESTAMP = 57779
+const DATETIME = 57780
+const CHAR = 57781
+const VARCHAR = 57782
+const BOOL = 57783
+const CHARACTER = 57784
+const VARBINARY = 57785
+const NCHAR = 57786
+const NVARCHAR = 57787
+const NATIONAL = 57788
+const VARYING = 57789
+const VARCHARACTER = 57790
+const TEXT = 57791
+const TINYTEXT = 57792
+const MEDIUMTEXT = 57793
+const LONGTEXT = 57794
+const LONG = 57795
+const BLOB = 57796
+const TINYBLOB = 57797
+const MEDIUMBLOB = 57798
+const LONGBLOB = 57799
+const JSON = 57800
+const ENUM = 57801
+const GEOMETRY = 57802
+const POINT = 57803
+const LINESTRING = 57804
+const POLYGON = 57805
+const GEOMETRYCOLLECTION = 57806
+const MULTIPOINT = 57807
+const MULTILINESTRING = 57808
+const MULTIPOLYGON = 57809
+const LOCAL = 57810
+const LOW_PRIORITY = 57811
+const SKIP = 57812
+const LOCKED = 57813
+const NULLX = 57814
+const AUTO_INCREMENT = 57815
+const APPROXNUM = 57816
+const SIGNED = 57817
+const UNSIGNED = 57818
+const ZEROFILL = 57819
+const SRID = 57820
+const COLLATION = 57821
+const DATABASES = 57822
+const SCHEMAS = 57823
+const TABLES = 57824
+const FULL = 57825
+const PROCESSLIST = 57826
+const COLUMNS = 57827
+const FIELDS = 57828
+const ENGINES = 57829
+const PLUGINS = 57830
+const NAMES = 57831
+const CHARSET = 57832
+const GLOBAL = 57833
+const SESSION = 57834
+const ISOLATION = 57835
+const LEVEL = 57836
+const READ = 57837
+const WRITE = 57838
+const ONLY = 57839
+const REPEATABLE = 57840
+const COMMITTED = 57841
+const UNCOMMITTED = 57842
+const SERIALIZABLE = 57843
+const ENCRYPTION = 57844
+const CURRENT_TIMESTAMP = 57845
+const NOW = 57846
+const DATABASE = 57847
+const CURRENT_DATE = 57848
+const CURRENT_USER = 57849
+const CURRENT_TIME = 57850
+const LOCALTIME = 57851
+const LOCALTIMESTAMP = 57852
+const UTC_DATE = 57853
+const UTC_TIME = 57854
+const UTC_TIMESTAMP = 57855
+const REPLACE = 57856
+const CONVERT = 57857
+const CAST = 57858
+const POSITION = 57859
+const SUBSTR = 57860
+const SUBSTRING = 57861
+const TRIM = 57862
+const LEADING = 57863
+const TRAILING = 57864
+const BOTH = 57865
+const GROUP_CONCAT = 57866
+const SEPARATOR = 57867
+const TIMESTAMPADD = 57868
+const TIMESTAMPDIFF = 57869
+const EXTRACT = 57870
+const OVER = 57871
+const WINDOW = 57872
+const GROUPING = 57873
+const CURRENT = 57874
+const AVG = 57875
+const BIT_AND = 57876
+const BIT_OR = 57877
+const BIT_XOR = 57878
+const COUNT = 57879
+const JSON_ARRAYAGG = 57880
+const JSON_OBJECTAGG = 57881
+const MAX = 57882
+const MIN = 57883
+const STDDEV_POP = 57884
+const STDDEV = 57885
+const STD = 57886
+const STDDEV_SAMP = 57887
+const SUM = 57888
+const VAR_POP = 57889
+const VARIANCE = 57890
+const VAR_SAMP = 57891
+const CUME_DIST = 57892
+const DENSE_RANK = 57893
+const FIRST_VALUE = 57894
+const LAG = 57895
+const LAST_VALUE = 57896
+const LEAD = 57897
+const NTH_VALUE = 57898
+const NTILE = 57899
+const ROW_NUMBER = 57900
+const PERCENT_RANK = 57901
+const RANK = 57902
+const DUAL = 57903
+const JSON_TABLE = 57904
+const JSON_VALUE = 57905
+const PATH = 57906
+const AVG_ROW_LENGTH = 57907
+const CHECKSUM = 57908
+const TABLE_CHECKSUM = 57909
+const COMPRESSION = 57910
+const DIRECTORY = 57911
+const DELAY_KEY_WRITE = 57912
+const ENGINE_ATTRIBUTE = 57913
+const INSERT_METHOD = 57914
+const MAX_ROWS = 57915
+const MIN_ROWS = 57916
+const PACK_KEYS = 57917
+const ROW_FORMAT = 57918
+const SECONDARY_ENGINE = 57919
+const SECONDARY_ENGINE_ATTRIBUTE = 57920
+const STATS_AUTO_RECALC = 57921
+const STATS_PERSISTENT = 57922
+const STATS_SAMPLE_PAGES = 57923
+const STORAGE = 57924
+const DISK = 57925
+const MEMORY = 57926
+const DYNAMIC = 57927
+const COMPRESSED = 57928
+const REDUNDANT = 57929
+const COMPACT = 57930
+const LIST = 57931
+const HASH = 57932
+const PARTITIONS = 57933
+const SUBPARTITION = 57934
+const SUBPARTITIONS = 57935
+const PREPARE = 57936
+const DEALLOCATE = 57937
+const MATCH = 57938
+const AGAINST = 57939
+const BOOLEAN = 57940
+const LANGUAGE = 57941
+const QUERY = 57942
+const EXPANSION = 57943
+const MICROSECOND = 57944
+const SECOND = 57945
+const MINUTE = 57946
+const HOUR = 57947
+const DAY = 57948
+const WEEK = 57949
+const MONTH = 57950
+const QUARTER = 57951
+const YEAR = 57952
+const SECOND_MICROSECOND = 57953
+const MINUTE_MICROSECOND = 57954
+const MINUTE_SECOND = 57955
+const HOUR_MICROSECOND = 57956
+const HOUR_SECOND = 57957
+const HOUR_MINUTE = 57958
+const DAY_MICROSECOND = 57959
+const DAY_SECOND = 57960
+const DAY_MINUTE = 57961
+const DAY_HOUR = 57962
+const YEAR_MONTH = 57963
+const NAME = 57964
+const SYSTEM = 57965
+const ACCESSIBLE = 57966
+const ASENSITIVE = 57967
+const CUBE = 57968
+const DELAYED = 57969
+const DISTINCTROW = 57970
+const EMPTY = 57971
+const FLOAT4 = 57972
+const FLOAT8 = 57973
+const GET = 57974
+const HIGH_PRIORITY = 57975
+const INSENSITIVE = 57976
+const IO_AFTER_GTIDS = 57977
+const IO_BEFORE_GTIDS = 57978
+const LINEAR = 57979
+const MASTER_BIND = 57980
+const MASTER_SSL_VERIFY_SERVER_CERT = 57981
+const MIDDLEINT = 57982
+const PURGE = 57983
+const READ_WRITE = 57984
+const RLIKE = 57985
+const SENSITIVE = 57986
+const SPECIFIC = 57987
+const SQL_BIG_RESULT = 57988
+const SQL_SMALL_RESULT = 57989
+const UNUSED = 57990
+const DESCRIPTION = 57991
+const LATERAL = 57992
+const MEMBER = 57993
+const RECURSIVE = 57994
+const BUCKETS = 57995
+const CLONE = 57996
+const COMPONENT = 57997
+const DEFINITION = 57998
+const ENFORCED = 57999
+const NOT_ENFORCED = 58000
+const EXCLUDE = 58001
+const GEOMCOLLECTION = 58002
+const GET_MASTER_PUBLIC_KEY = 58003
+const HISTOGRAM = 58004
+const HISTORY = 58005
+const INACTIVE = 58006
+const INVISIBLE = 58007
+const MASTER_COMPRESSION_ALGORITHMS = 58008
+const MASTER_PUBLIC_KEY_PATH = 58009
+const MASTER_TLS_CIPHERSUITES = 58010
+const MASTER_ZSTD_COMPRESSION_LEVEL = 58011
+const NESTED = 58012
+const NETWORK_NAMESPACE = 58013
+const NOWAIT = 58014
+const NULLS = 58015
+const OJ = 58016
+const OLD = 58017
+const ORDINALITY = 58018
+const ORGANIZATION = 58019
+const OTHERS = 58020
+const PERCENT = 58021
+const PERSIST = 58022
+const PERSIST_ONLY = 58023
+const PRIVILEGE_CHECKS_USER = 58024
+const PROCESS = 58025
+const REFERENCE = 58026
+const REQUIRE_ROW_FORMAT = 58027
+const RESOURCE = 58028
+const RESPECT = 58029
+const RESTART = 58030
+const RETAIN = 58031
+const RETURNING = 58032
+const SECONDARY = 58033
+const SECONDARY_LOAD = 58034
+const SECONDARY_UNLOAD = 58035
+const THREAD_PRIORITY = 58036
+const TIES = 58037
+const VCPU = 58038
+const VISIBLE = 58039
+const INFILE = 58040
+const ACTIVE = 58041
+const AGGREGATE = 58042
+const ANY = 58043
+const ARRAY = 58044
+const ASCII = 58045
+const AT = 58046
+const AUTOEXTEND_SIZE = 58047
+const GENERATED = 58048
+const ALWAYS = 58049
+const STORED = 58050
+const VIRTUAL = 58051
+const NVAR = 58052
+const PASSWORD_LOCK = 58053
+
+var yyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
+	"LEX_ERROR",
+	"FOR_SYSTEM_TIME",
+	"OFFSET_ROWS",
+	"FOR_VERSION",
+	"EXCEPT",
+	"UNION",
+	"INTERSECT",
+	"SELECT",
+	"STREAM",
+	"INSERT",
+	"UPDATE",
+	"DELETE",
+	"FROM",
+	"WHERE",
+	"GROUP",
+	"HAVING",
+	"ORDER",
+	"BY",
+	"LIMIT",
+	"OFFSET",
+	"FOR",
+	"CALL",
+	"ALL",
+	"DISTINCT",
+	"AS",
+	"EXISTS",
+	"ASC",
+	"DESC",
+	"DUPLICATE",
+	"DEFAULT",
+	"SET",
+	"LOCK",
+	"UNLOCK",
+	"KEYS",
+	"OF",
+	"OUTFILE",
+	"DUMPFILE",
+	"DATA",
+	"LOAD",
+	"LINES",
+	"TERMINATED",
+	"ESCAPED",
+	"ENCLOSED",
+	"OPTIONALLY",
+	"STARTING",
+	"UNIQUE",
+	"KEY",
+	"SYSTEM_TIME",
+	"CONTAINED",
+	"VERSION",
+	"VERSIONS",
+	"VALUES",
+	"LAST_INSERT_ID",
+	"SQL_CALC_FOUND_ROWS",
+	"NEXT",
+	"VALUE",
+	"SHARE",
+	"MODE",
+	"SQL_NO_CACHE",
+	"SQL_CACHE",
+	"JOIN",
+	"STRAIGHT_JOIN",
+	"LEFT",
+	"RIGHT",
+	"INNER",
+	"OUTER",
+	"CROSS",
+	"NATURAL",
+	"USE",
+	"FORCE",
+	"ON",
+	"USING",
+	"'('",
+	"','",
+	"')'",
+	"'@'",
+	"':'",
+	"ID",
+	"HEX",
+	"STRING",
+	"INTEGRAL",
+	"FLOAT",
+	"HEXNUM",
+	"VALUE_ARG",
+	"LIST_ARG",
+	"COMMENT",
+	"COMMENT_KEYWORD",
+	"BIT_LITERAL",
+	"NCHAR_STRING",
+	"NULL",
+	"TRUE",
+	"FALSE",
+	"OFF",
+	"INTO",
+	"LIMIT_WITHOUT_TIES",
+	"WITH",
+	"OR",
+	"XOR",
+	"AND",
+	"NOT",
+	"'!'",
+	"BETWEEN",
+	"CASE",
+	"WHEN",
+	"THEN",
+	"ELSE",
+	"ELSEIF",
+	"END",
+	"'='",
+	"'<'",
+	"'>'",
+	"LE",
+	"GE",
+	"NE",
+	"NULL_SAFE_EQUAL",
+	"IS",
+	"LIKE",
+	"REGEXP",
+	"IN",
+	"ASSIGNMENT_OP",
+	"UNBOUNDED",
+	"PARTITION",
+	"RANGE",
+	"ROWS",
+	"GROUPS",
+	"PRECEDING",
+	"FOLLOWING",
+	"'|'",
+	"'&'",
+	"SHIFT_LEFT",
+	"SHIFT_RIGHT",
+	"'+'",
+	"'-'",
+	"'*'",
+	"'/'",
+	"DIV",
+	"'%'",
+	"MOD",
+	"'^'",
+	"'~'",
+	"UNARY",
+	"COLLATE",
+	"BINARY",
+	"UNDERSCORE_ARMSCII8",
+	"UNDERSCORE_ASCII",
+	"UNDERSCORE_BIG5",
+	"UNDERSCORE_BINARY",
+	"UNDERSCORE_CP1250",
+	"UNDERSCORE_CP1251",
+	"UNDERSCORE_CP1256",
+	"UNDERSCORE_CP1257",
+	"UNDERSCORE_CP850",
+	"UNDERSCORE_CP852",
+	"UNDERSCORE_CP866",
+	"UNDERSCORE_CP932",
+	"UNDERSCORE_DEC8",
+	"UNDERSCORE_EUCJPMS",
+	"UNDERSCORE_EUCKR",
+	"UNDERSCORE_GB18030",
+	"UNDERSCORE_GB2312",
+	"UNDERSCORE_GBK",
+	"UNDERSCORE_GEOSTD8",
+	"UNDERSCORE_GREEK",
+	"UNDERSCORE_HEBREW",
+	"UNDERSCORE_HP8",
+	"UNDERSCORE_KEYBCS2",
+	"UNDERSCORE_KOI8R",
+	"UNDERSCORE_KOI8U",
+	"UNDERSCORE_LATIN1",
+	"UNDERSCORE_LATIN2",
+	"UNDERSCORE_LATIN5",
+	"UNDERSCORE_LATIN7",
+	"UNDERSCORE_MACCE",
+	"UNDERSCORE_MACROMAN",
+	"UNDERSCORE_SJIS",
+	"UNDERSCORE_SWE7",
+	"UNDERSCORE_TIS620",
+	"UNDERSCORE_UCS2",
+	"UNDERSCORE_UJIS",
+	"UNDERSCORE_UTF16",
+	"UNDERSCORE_UTF16LE",
+	"UNDERSCORE_UTF32",
+	"UNDERSCORE_UTF8",
+	"UNDERSCORE_UTF8MB3",
+	"UNDERSCORE_UTF8MB4",
+	"INTERVAL",
+	"'.'",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
+	"CREATE",
+	"ALTER",
+	"DROP",
+	"RENAME",
+	"ANALYZE",
+	"ADD",
+	"MODIFY",
+	"CHANGE",
+	"SCHEMA",
+	"TABLE",
+	"INDEX",
+	"INDEXES",
+	"VIEW",
+	"TO",
+	"IGNORE",
+	"IF",
+	"PRIMARY",
+	"COLUMN",
+	"SPATIAL",
+	"FULLTEXT",
+	"KEY_BLOCK_SIZE",
+	"CHECK",
+	"ACTION",
+	"CASCADE",
+	"CONSTRAINT",
+	"FOREIGN",
+	"NO",
+	"REFERENCES",
+	"RESTRICT",
+	"FIRST",
+	"AFTER",
+	"LAST",
+	"SHOW",
+	"DESCRIBE",
+	"EXPLAIN",
+	"DATE",
+	"ESCAPE",
+	"REPAIR",
+	"OPTIMIZE",
+	"TRUNCATE",
+	"FORMAT",
+	"EXTENDED",
+	"MAXVALUE",
+	"REORGANIZE",
+	"LESS",
+	"THAN",
+	"PROCEDURE",
+	"TRIGGER",
+	"TRIGGERS",
+	"FUNCTION",
+	"STATUS",
+	"VARIABLES",
+	"WARNINGS",
+	"ERRORS",
+	"KILL",
+	"CONNECTION",
+	"SEQUENCE",
+	"ENABLE",
+	"DISABLE",
+	"EACH",
+	"ROW",
+	"BEFORE",
+	"FOLLOWS",
+	"PRECEDES",
+	"DEFINER",
+	"INVOKER",
+	"INOUT",
+	"OUT",
+	"DETERMINISTIC",
+	"CONTAINS",
+	"READS",
+	"MODIFIES",
+	"SQL",
+	"SECURITY",
+	"TEMPORARY",
+	"ALGORITHM",
+	"MERGE",
+	"TEMPTABLE",
+	"UNDEFINED",
+	"EVENT",
+	"EVENTS",
+	"SCHEDULE",
+	"EVERY",
+	"STARTS",
+	"ENDS",
+	"COMPLETION",
+	"PRESERVE",
+	"CLASS_ORIGIN",
+	"SUBCLASS_ORIGIN",
+	"MESSAGE_TEXT",
+	"MYSQL_ERRNO",
+	"CONSTRAINT_CATALOG",
+	"CONSTRAINT_SCHEMA",
+	"CONSTRAINT_NAME",
+	"CATALOG_NAME",
+	"SCHEMA_NAME",
+	"TABLE_NAME",
+	"COLUMN_NAME",
+	"CURSOR_NAME",
+	"SIGNAL",
+	"RESIGNAL",
+	"SQLSTATE",
+	"DECLARE",
+	"CONDITION",
+	"CURSOR",
+	"CONTINUE",
+	"EXIT",
+	"UNDO",
+	"HANDLER",
+	"FOUND",
+	"SQLWARNING",
+	"SQLEXCEPTION",
+	"FETCH",
+	"OPEN",
+	"CLOSE",
+	"LOOP",
+	"LEAVE",
+	"ITERATE",
+	"REPEAT",
+	"UNTIL",
+	"WHILE",
+	"DO",
+	"RETURN",
+	"USER",
+	"IDENTIFIED",
+	"ROLE",
+	"REUSE",
+	"GRANT",
+	"GRANTS",
+	"REVOKE",
+	"NONE",
+	"ATTRIBUTE",
+	"RANDOM",
+	"PASSWORD",
+	"INITIAL",
+	"AUTHENTICATION",
+	"SSL",
+	"X509",
+	"CIPHER",
+	"ISSUER",
+	"SUBJECT",
+	"ACCOUNT",
+	"EXPIRE",
+	"NEVER",
+	"OPTION",
+	"OPTIONAL",
+	"ADMIN",
+	"PRIVILEGES",
+	"MAX_QUERIES_PER_HOUR",
+	"MAX_UPDATES_PER_HOUR",
+	"MAX_CONNECTIONS_PER_HOUR",
+	"MAX_USER_CONNECTIONS",
+	"FLUSH",
+	"FAILED_LOGIN_ATTEMPTS",
+	"PASSWORD_LOCK_TIME",
+	"REQUIRE",
+	"PROXY",
+	"ROUTINE",
+	"TABLESPACE",
+	"CLIENT",
+	"SLAVE",
+	"EXECUTE",
+	"FILE",
+	"RELOAD",
+	"REPLICATION",
+	"SHUTDOWN",
+	"SUPER",
+	"USAGE",
+	"LOGS",
+	"ENGINE",
+	"ERROR",
+	"GENERAL",
+	"HOSTS",
+	"OPTIMIZER_COSTS",
+	"RELAY",
+	"SLOW",
+	"USER_RESOURCES",
+	"NO_WRITE_TO_BINLOG",
+	"CHANNEL",
+	"APPLICATION_PASSWORD_ADMIN",
+	"AUDIT_ABORT_EXEMPT",
+	"AUDIT_ADMIN",
+	"AUTHENTICATION_POLICY_ADMIN",
+	"BACKUP_ADMIN",
+	"BINLOG_ADMIN",
+	"BINLOG_ENCRYPTION_ADMIN",
+	"CLONE_ADMIN",
+	"CONNECTION_ADMIN",
+	"ENCRYPTION_KEY_ADMIN",
+	"FIREWALL_ADMIN",
+	"FIREWALL_EXEMPT",
+	"FIREWALL_USER",
+	"FLUSH_OPTIMIZER_COSTS",
+	"FLUSH_STATUS",
+	"FLUSH_TABLES",
+	"FLUSH_USER_RESOURCES",
+	"GROUP_REPLICATION_ADMIN",
+	"GROUP_REPLICATION_STREAM",
+	"INNODB_REDO_LOG_ARCHIVE",
+	"INNODB_REDO_LOG_ENABLE",
+	"NDB_STORED_USER",
+	"PASSWORDLESS_USER_ADMIN",
+	"PERSIST_RO_VARIABLES_ADMIN",
+	"REPLICATION_APPLIER",
+	"REPLICATION_SLAVE_ADMIN",
+	"RESOURCE_GROUP_ADMIN",
+	"RESOURCE_GROUP_USER",
+	"ROLE_ADMIN",
+	"SENSITIVE_VARIABLES_OBSERVER",
+	"SESSION_VARIABLES_ADMIN",
+	"SET_USER_ID",
+	"SHOW_ROUTINE",
+	"SKIP_QUERY_REWRITE",
+	"SYSTEM_VARIABLES_ADMIN",
+	"TABLE_ENCRYPTION_ADMIN",
+	"TP_CONNECTION_ADMIN",
+	"VERSION_TOKEN_ADMIN",
+	"XA_RECOVER_ADMIN",
+	"REPLICA",
+	"SOURCE",
+	"STOP",
+	"RESET",
+	"FILTER",
+	"SOURCE_HOST",
+	"SOURCE_USER",
+	"SOURCE_PASSWORD",
+	"SOURCE_PORT",
+	"SOURCE_CONNECT_RETRY",
+	"SOURCE_RETRY_COUNT",
+	"REPLICATE_DO_TABLE",
+	"REPLICATE_IGNORE_TABLE",
+	"BEGIN",
+	"START",
+	"TRANSACTION",
+	"COMMIT",
+	"ROLLBACK",
+	"SAVEPOINT",
+	"WORK",
+	"RELEASE",
+	"CHAIN",
+	"BIT",
+	"TINYINT",
+	"SMALLINT",
+	"MEDIUMINT",
+	"INT",
+	"INTEGER",
+	"BIGINT",
+	"INTNUM",
+	"SERIAL",
+	"INT1",
+	"INT2",
+	"INT3",
+	"INT4",
+	"INT8",
+	"REAL",
+	"DOUBLE",
+	"FLOAT_TYPE",
+	"DECIMAL",
+	"NUMERIC",
+	"DEC",
+	"FIXED",
+	"PRECISION",
+	"TIME",
+	"TIMESTAMP",
+	"DATETIME",
+	"CHAR",
+	"VARCHAR",
+	"BOOL",
+	"CHARACTER",
+	"VARBINARY",
+	"NCHAR",
+	"NVARCHAR",
+	"NATIONAL",
+	"VARYING",
+	"VARCHARACTER",
+	"TEXT",
+	"TINYTEXT",
+	"MEDIUMTEXT",
+	"LONGTEXT",
+	"LONG",
+	"BLOB",
+	"TINYBLOB",
+	"MEDIUMBLOB",
+	"LONGBLOB",
+	"JSON",
+	"ENUM",
+	"GEOMETRY",
+	"POINT",
+	"LINESTRING",
+	"POLYGON",
+	"GEOMETRYCOLLECTION",
+	"MULTIPOINT",
+	"MULTILINESTRING",
+	"MULTIPOLYGON",
+	"LOCAL",
+	"LOW_PRIORITY",
+	"SKIP",
+	"LOCKED",
+	"NULLX",
+	"AUTO_INCREMENT",
+	"APPROXNUM",
+	"SIGNED",
+	"UNSIGNED",
+	"ZEROFILL",
+	"SRID",
+	"COLLATION",
+	"DATABASES",
+	"SCHEMAS",
+	"TABLES",
+	"FULL",
+	"PROCESSLIST",
+	"COLUMNS",
+	"FIELDS",
+	"ENGINES",
+	"PLUGINS",
+	"NAMES",
+	"CHARSET",
+	"GLOBAL",
+	"SESSION",
+	"ISOLATION",
+	"LEVEL",
+	"READ",
+	"WRITE",
+	"ONLY",
+	"REPEATABLE",
+	"COMMITTED",
+	"UNCOMMITTED",
+	"SERIALIZABLE",
+	"ENCRYPTION",
+	"CURRENT_TIMESTAMP",
+	"NOW",
+	"DATABASE",
+	"CURRENT_DATE",
+	"CURRENT_USER",
+	"CURRENT_TIME",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"UTC_DATE",
+	"UTC_TIME",
+	"UTC_TIMESTAMP",
+	"REPLACE",
+	"CONVERT",
+	"CAST",
+	"POSITION",
+	"SUBSTR",
+	"SUBSTRING",
+	"TRIM",
+	"LEADING",
+	"TRAILING",
+	"BOTH",
+	"GROUP_CONCAT",
+	"SEPARATOR",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
+	"EXTRACT",
+	"OVER",
+	"WINDOW",
+	"GROUPING",
+	"CURRENT",
+	"AVG",
+	"BIT_AND",
+	"BIT_OR",
+	"BIT_XOR",
+	"COUNT",
+	"JSON_ARRAYAGG",
+	"JSON_OBJECTAGG",
+	"MAX",
+	"MIN",
+	"STDDEV_POP",
+	"STDDEV",
+	"STD",
+	"STDDEV_SAMP",
+	"SUM",
+	"VAR_POP",
+	"VARIANCE",
+	"VAR_SAMP",
+	"CUME_DIST",
+	"DENSE_RANK",
+	"FIRST_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"NTH_VALUE",
+	"NTILE",
+	"ROW_NUMBER",
+	"PERCENT_RANK",
+	"RANK",
+	"DUAL",
+	"JSON_TABLE",
+	"JSON_VALUE",
+	"PATH",
+	"AVG_ROW_LENGTH",
+	"CHECKSUM",
+	"TABLE_CHECKSUM",
+	"COMPRESSION",
+	"DIRECTORY",
+	"DELAY_KEY_WRITE",
+	"ENGINE_ATTRIBUTE",
+	"INSERT_METHOD",
+	"MAX_ROWS",
+	"MIN_ROWS",
+	"PACK_KEYS",
+	"ROW_FORMAT",
+	"SECONDARY_ENGINE",
+	"SECONDARY_ENGINE_ATTRIBUTE",
+	"STATS_AUTO_RECALC",
+	"STATS_PERSISTENT",
+	"STATS_SAMPLE_PAGES",
+	"STORAGE",
+	"DISK",
+	"MEMORY",
+	"DYNAMIC",
+	"COMPRESSED",
+	"REDUNDANT",
+	"COMPACT",
+	"LIST",
+	"HASH",
+	"PARTITIONS",
+	"SUBPARTITION",
+	"SUBPARTITIONS",
+	"PREPARE",
+	"DEALLOCATE",
+	"MATCH",
+	"AGAINST",
+	"BOOLEAN",
+	"LANGUAGE",
+	"QUERY",
+	"EXPANSION",
+	"MICROSECOND",
+	"SECOND",
+	"MINUTE",
+	"HOUR",
+	"DAY",
+	"WEEK",
+	"MONTH",
+	"QUARTER",
+	"YEAR",
+	"SECOND_MICROSECOND",
+	"MINUTE_MICROSECOND",
+	"MINUTE_SECOND",
+	"HOUR_MICROSECOND",
+	"HOUR_SECOND",
+	"HOUR_MINUTE",
+	"DAY_MICROSECOND",
+	"DAY_SECOND",
+	"DAY_MINUTE",
+	"DAY_HOUR",
+	"YEAR_MONTH",
+	"NAME",
+	"SYSTEM",
+	"ACCESSIBLE",
+	"ASENSITIVE",
+	"CUBE",
+	"DELAYED",
+	"DISTINCTROW",
+	"EMPTY",
+	"FLOAT4",
+	"FLOAT8",
+	"GET",
+	"HIGH_PRIORITY",
+	"INSENSITIVE",
+	"IO_AFTER_GTIDS",
+	"IO_BEFORE_GTIDS",
+	"LINEAR",
+	"MASTER_BIND",
+	"MASTER_SSL_VERIFY_SERVER_CERT",
+	"MIDDLEINT",
+	"PURGE",
+	"READ_WRITE",
+	"RLIKE",
+	"SENSITIVE",
+	"SPECIFIC",
+	"SQL_BIG_RESULT",
+	"SQL_SMALL_RESULT",
+	"UNUSED",
+	"DESCRIPTION",
+	"LATERAL",
+	"MEMBER",
+	"RECURSIVE",
+	"BUCKETS",
+	"CLONE",
+	"COMPONENT",
+	"DEFINITION",
+	"ENFORCED",
+	"NOT_ENFORCED",
+	"EXCLUDE",
+	"GEOMCOLLECTION",
+	"GET_MASTER_PUBLIC_KEY",
+	"HISTOGRAM",
+	"HISTORY",
+	"INACTIVE",
+	"INVISIBLE",
+	"MASTER_COMPRESSION_ALGORITHMS",
+	"MASTER_PUBLIC_KEY_PATH",
+	"MASTER_TLS_CIPHERSUITES",
+	"MASTER_ZSTD_COMPRESSION_LEVEL",
+	"NESTED",
+	"NETWORK_NAMESPACE",
+	"NOWAIT",
+	"NULLS",
+	"OJ",
+	"OLD",
+	"ORDINALITY",
+	"ORGANIZATION",
+	"OTHERS",
+	"PERCENT",
+	"PERSIST",
+	"PERSIST_ONLY",
+	"PRIVILEGE_CHECKS_USER",
+	"PROCESS",
+	"REFERENCE",
+	"REQUIRE_ROW_FORMAT",
+	"RESOURCE",
+	"RESPECT",
+	"RESTART",
+	"RETAIN",
+	"RETURNING",
+	"SECONDARY",
+	"SECONDARY_LOAD",
+	"SECONDARY_UNLOAD",
+	"THREAD_PRIORITY",
+	"TIES",
+	"VCPU",
+	"VISIBLE",
+	"INFILE",
+	"ACTIVE",
+	"AGGREGATE",
+	"ANY",
+	"ARRAY",
+	"ASCII",
+	"AT",
+	"AUTOEXTEND_SIZE",
+	"GENERATED",
+	"ALWAYS",
+	"STORED",
+	"VIRTUAL",
+	"NVAR",
+	"PASSWORD_LOCK",
+	"';'",
+}
+
+var yyStatenames = [...]string{}
+
+const yyEofCode = 1
+const yyErrCode = 2
+const yyInitialStackSize = 16
+
+//line yacctab:1
+var yyExca = [...]int16{
+	-1, 0,
+	1, 40,
+	731, 40,
+	-2, 75,
+	-1, 1,
+	1, -1,
+	-2, 0,
+	-1, 46,
+	202, 1757,
+	203, 1776,
+	-2, 329,
+	-1, 60,
+	243, 1115,
+	244, 1115,
+	-2, 1104,
+	-1, 83,
+	202, 1763,
+	-2, 329,
+	-1, 89,
+	8, 53,
+	9, 53,
+	10, 53,
+	-2, 46,
+	-1, 90,
+	8, 56,
+	9, 56,
+	-2, 47,
+	-1, 517,
+	1, 2446,
+	5, 2446,
+	7, 2446,
+	28, 2446,
+	190, 2446,
+	731, 2446,
+	-2, 1149,
+	-1, 530,
+	190, 1786,
+	-2, 1780,
+	-1, 531,
+	190, 1787,
+	-2, 1781,
+	-1, 617,
+	312, 726,
+	-2, 718,
+	-1, 625,
+	1, 724,
+	731, 724,
+	-2, 1759,
+	-1, 632,
+	1, 673,
+	731, 673,
+	-2, 671,
+	-1, 648,
+	1, 1251,
+	6, 1251,
+	8, 1251,
+	9, 1251,
+	10, 1251,
+	17, 1251,
+	18, 1251,
+	19, 1251,
+	20, 1251,
+	22, 1251,
+	24, 1251,
+	34, 1251,
+	35, 1251,
+	64, 1251,
+	65, 1251,
+	66, 1251,
+	67, 1251,
+	68, 1251,
+	70, 1251,
+	71, 1251,
+	74, 1251,
+	75, 1251,
+	77, 1251,
+	78, 1251,
+	97, 1251,
+	99, 1251,
+	305, 1251,
+	502, 1251,
+	549, 1251,
+	731, 1251,
+	-2, 1307,
+	-1, 653,
+	1, 1358,
+	6, 1358,
+	8, 1358,
+	9, 1358,
+	10, 1358,
+	17, 1358,
+	18, 1358,
+	19, 1358,
+	20, 1358,
+	22, 1358,
+	24, 1358,
+	34, 1358,
+	35, 1358,
+	64, 1358,
+	65, 1358,
+	66, 1358,
+	67, 1358,
+	68, 1358,
+	70, 1358,
+	71, 1358,
+	74, 1358,
+	75, 1358,
+	77, 1358,
+	78, 1358,
+	97, 1358,
+	99, 1358,
+	305, 1358,
+	502, 1358,
+	549, 1358,
+	731, 1358,
+	-2, 1307,
+	-1, 685,
+	190, 2152,
+	-2, 1372,
+	-1, 716,
+	190, 2260,
+	-2, 1649,
+	-1, 717,
+	190, 2339,
+	-2, 1374,
+	-1, 718,
+	190, 2172,
+	-2, 1375,
+	-1, 788,
+	190, 2123,
+	-2, 1618,
+	-1, 791,
+	190, 2138,
+	-2, 1532,
+	-1, 792,
+	190, 2141,
+	-2, 1532,
+	-1, 793,
+	190, 2349,
+	-2, 1532,
+	-1, 795,
+	190, 2139,
+	-2, 1532,
+	-1, 796,
+	190, 2350,
+	-2, 1532,
+	-1, 797,
+	190, 2351,
+	-2, 1532,
+	-1, 855,
+	190, 2140,
+	-2, 1532,
+	-1, 938,
+	190, 2240,
+	-2, 1532,
+	-1, 939,
+	190, 2241,
+	-2, 1532,
+	-1, 1049,
+	112, 2459,
+	123, 2459,
+	190, 2459,
+	-2, 1740,
+	-1, 1050,
+	112, 2582,
+	123, 2582,
+	190, 2582,
+	-2, 1741,
+	-1, 1055,
+	112, 2484,
+	123, 2484,
+	190, 2484,
+	-2, 1742,
+	-1, 1056,
+	112, 2532,
+	123, 2532,
+	190, 2532,
+	-2, 1743,
+	-1, 1057,
+	112, 2533,
+	123, 2533,
+	190, 2533,
+	-2, 1744,
+	-1, 1058,
+	112, 2390,
+	123, 2390,
+	190, 2390,
+	-2, 1749,
+	-1, 1060,
+	112, 2509,
+	123, 2509,
+	190, 2509,
+	-2, 1751,
+	-1, 1234,
+	431, 1128,
+	-2, 1132,
+	-1, 1236,
+	431, 1128,
+	-2, 1132,
+	-1, 1357,
+	1, 673,
+	731, 673,
+	-2, 671,
+	-1, 1359,
+	1, 674,
+	731, 674,
+	-2, 671,
+	-1, 1387,
+	1, 1252,
+	6, 1252,
+	8, 1252,
+	9, 1252,
+	10, 1252,
+	17, 1252,
+	18, 1252,
+	19, 1252,
+	20, 1252,
+	22, 1252,
+	24, 1252,
+	34, 1252,
+	35, 1252,
+	64, 1252,
+	65, 1252,
+	66, 1252,
+	67, 1252,
+	68, 1252,
+	70, 1252,
+	71, 1252,
+	74, 1252,
+	75, 1252,
+	77, 1252,
+	78, 1252,
+	97, 1252,
+	99, 1252,
+	305, 1252,
+	502, 1252,
+	549, 1252,
+	731, 1252,
+	-2, 1307,
+	-1, 1397,
+	1, 1358,
+	6, 1358,
+	8, 1358,
+	9, 1358,
+	10, 1358,
+	17, 1358,
+	18, 1358,
+	19, 1358,
+	20, 1358,
+	22, 1358,
+	24, 1358,
+	34, 1358,
+	35, 1358,
+	64, 1358,
+	65, 1358,
+	66, 1358,
+	67, 1358,
+	68, 1358,
+	70, 1358,
+	71, 1358,
+	74, 1358,
+	75, 1358,
+	77, 1358,
+	78, 1358,
+	97, 1358,
+	99, 1358,
+	305, 1358,
+	502, 1358,
+	549, 1358,
+	731, 1358,
+	-2, 1307,
+	-1, 1699,
+	1, 673,
+	731, 673,
+	-2, 671,
+	-1, 1701,
+	1, 673,
+	731, 673,
+	-2, 671,
+	-1, 2271,
+	190, 1790,
+	-2, 1630,
+	-1, 2273,
+	190, 2663,
+	-2, 1632,
+	-1, 2274,
+	190, 2664,
+	-2, 1633,
+	-1, 2275,
+	190, 1789,
+	-2, 1785,
+	-1, 2419,
+	75, 93,
+	77, 93,
+	-2, 97,
+	-1, 2438,
+	190, 2264,
+	-2, 1745,
+	-1, 2624,
+	49, 931,
+	209, 934,
+	211, 931,
+	212, 931,
+	-2, 1003,
+	-1, 2667,
+	8, 54,
+	9, 54,
+	10, 54,
+	-2, 1404,
+	-1, 2684,
+	1, 1295,
+	6, 1295,
+	8, 1295,
+	9, 1295,
+	10, 1295,
+	17, 1295,
+	18, 1295,
+	19, 1295,
+	20, 1295,
+	22, 1295,
+	24, 1295,
+	34, 1295,
+	35, 1295,
+	64, 1295,
+	65, 1295,
+	66, 1295,
+	67, 1295,
+	68, 1295,
+	70, 1295,
+	71, 1295,
+	74, 1295,
+	75, 1295,
+	77, 1295,
+	78, 1295,
+	97, 1295,
+	99, 1295,
+	305, 1295,
+	502, 1295,
+	549, 1295,
+	731, 1295,
+	-2, 1307,
+	-1, 3067,
+	1, 1358,
+	6, 1358,
+	8, 1358,
+	9, 1358,
+	10, 1358,
+	17, 1358,
+	18, 1358,
+	19, 1358,
+	20, 1358,
+	22, 1358,
+	24, 1358,
+	34, 1358,
+	35, 1358,
+	64, 1358,
+	65, 1358,
+	66, 1358,
+	67, 1358,
+	68, 1358,
+	70, 1358,
+	71, 1358,
+	74, 1358,
+	75, 1358,
+	77, 1358,
+	78, 1358,
+	97, 1358,
+	99, 1358,
+	305, 1358,
+	502, 1358,
+	549, 1358,
+	731, 1358,
+	-2, 1307,
+	-1, 3392,
+	209, 935,
+	-2, 933,
+	-1, 3526,
+	77, 2036,
+	78, 2036,
+	190, 2036,
+	-2, 1155,
+	-1, 3754,
+	8, 54,
+	9, 54,
+	10, 54,
+	-2, 1704,
+	-1, 3806,
+	99, 114,
+	-2, 113,
+	-1, 3859,
+	99, 114,
+	-2, 113,
+	-1, 3894,
+	46, 1801,
+	-2, 1799,
+	-1, 3949,
+	99, 114,
+	-2, 113,
+	-1, 4088,
+	202, 1757,
+	203, 1776,
+	-2, 329,
+	-1, 4105,
+	202, 1763,
+	-2, 329,
+	-1, 4163,
+	8, 54,
+	9, 54,
+	10, 54,
+	-2, 1707,
+	-1, 4190,
+	301, 420,
+	-2, 1856,
+	-1, 4191,
+	301, 421,
+	-2, 1897,
+	-1, 4192,
+	301, 422,
+	-2, 2073,
+	-1, 4412,
+	107, 406,
+	109, 406,
+	111, 406,
+	-2, 75,
+	-1, 4492,
+	109, 413,
+	110, 413,
+	111, 413,
+	-2, 75,
+}
+
+const yyPrivate = 57344
+
+const yyLast = 75688
+
+var yyAct = [...]int16{
+	729, 97, 4465, 4416, 4370, 4404, 2885, 4197, 1430, 4403,
+	1165, 542, 4046, 3, 2435, 3312, 4372, 4049, 7, 4048,
+	6, 4047, 5, 3888, 4291, 4080, 27, 4050, 8, 4155,
+	3890, 4292, 1390, 641, 2884, 4184, 3460, 3614, 2362, 4196,
+	3350, 2363, 3154, 1620, 705, 4183, 1396, 123, 4008, 119,
+	3883, 3985, 3715, 4038, 2663, 3941, 3785, 4040, 3541, 3185,
+	3250, 3901, 687, 3984, 3894, 2300, 3889, 4153, 3708, 1456,
+	653, 728, 3519, 3430, 1522, 3892, 2508, 3851, 3520, 691,
+	3179, 100, 3055, 670, 521, 524, 2182, 569, 569, 614,
+	3685, 3363, 650, 3726, 637, 2461, 3377, 2527, 97, 3327,
+	3005, 3643, 2647, 2954, 3637, 3620, 3251, 2868, 3556, 3557,
+	667, 3705, 3186, 97, 4039, 3516, 2739, 1736, 1738, 1136,
+	2246, 2874, 1391, 2452, 3385, 2613, 151, 3694, 3436, 2940,
+	666, 635, 794, 631, 2668, 3165, 2738, 519, 1553, 2174,
+	1363, 3239, 467, 129, 2619, 1196, 3157, 1552, 2492, 2623,
+	1393, 1184, 2806, 3348, 696, 690, 2967, 3116, 1247, 1389,
+	2830, 2239, 2564, 2222, 678, 2236, 1741, 2139, 2588, 2224,
+	1395, 1434, 2175, 2875, 1709, 2135, 2896, 2448, 1158, 2094,
+	1221, 2488, 2033, 2921, 1248, 1125, 1339, 1431, 1602, 3018,
+	1054, 1051, 1597, 2352, 2277, 1443, 2465, 673, 1140, 2654,
+	648, 1371, 2467, 3664, 2138, 2099, 1281, 1162, 1259, 3236,
+	1601, 1358, 1127, 1047, 644, 1048, 2421, 1464, 2230, 657,
+	669, 1180, 2314, 1375, 1735, 84, 645, 1362, 640, 1361,
+	1360, 545, 544, 1258, 1240, 2067, 527, 2068, 1702, 2032,
+	1145, 1151, 676, 4492, 4486, 4473, 4457, 4443, 4412, 4410,
+	4385, 4382, 4381, 4380, 4365, 1164, 4363, 4276, 1132, 4272,
+	633, 4267, 99, 3943, 3942, 2892, 3266, 3438, 2092, 2615,
+	2899, 3728, 3462, 3094, 2699, 3006, 2512, 694, 1155, 624,
+	133, 2742, 2241, 2235, 2238, 4137, 2904, 2903, 3339, 3511,
+	3159, 3510, 1128, 2548, 2547, 3309, 3310, 3545, 4436, 93,
+	1416, 4481, 4399, 4397, 3486, 3826, 4435, 89, 4398, 3344,
+	2932, 2900, 1712, 3542, 3786, 3342, 665, 96, 4211, 3621,
+	4210, 3824, 3340, 4402, 639, 2685, 4151, 2906, 4348, 3788,
+	2882, 120, 3623, 537, 3827, 4015, 4150, 2234, 1124, 4014,
+	2883, 2740, 3145, 3341, 623, 477, 4247, 143, 139, 140,
+	636, 141, 3923, 116, 3253, 3774, 1448, 1449, 3768, 2238,
+	1448, 1449, 3592, 1147, 3218, 1153, 1154, 1167, 3217, 654,
+	3781, 3782, 4136, 3999, 4229, 3965, 3897, 2911, 2782, 3502,
+	1144, 2581, 2437, 2886, 516, 145, 144, 1453, 1446, 2167,
+	2587, 1453, 1455, 1454, 2095, 1450, 1455, 1454, 3198, 1450,
+	131, 2431, 108, 106, 107, 3199, 3200, 1335, 2240, 2432,
+	2433, 135, 464, 3577, 3464, 4159, 4231, 3096, 148, 1234,
+	4159, 2376, 2374, 2373, 2372, 2375, 2371, 2370, 2369, 2148,
+	2149, 2383, 122, 2382, 2381, 4138, 2380, 2379, 2378, 2377,
+	3392, 4154, 126, 134, 3164, 684, 2902, 2821, 3626, 2905,
+	2820, 1139, 3787, 2822, 1135, 4156, 1603, 2895, 1604, 634,
+	4156, 3458, 146, 98, 147, 2364, 2376, 2374, 2373, 2372,
+	2375, 2371, 2370, 2369, 2365, 2366, 2383, 2367, 2382, 2381,
+	2368, 2380, 2379, 2378, 2377, 1219, 1220, 2897, 131, 3733,
+	3624, 3625, 3627, 3628, 3629, 3289, 1040, 539, 132, 135,
+	1228, 3290, 3291, 1217, 1179, 1218, 1219, 1220, 2116, 1170,
+	1171, 1172, 1173, 1174, 1175, 1176, 1177, 97, 2865, 97,
+	4160, 98, 1311, 536, 3209, 4160, 143, 139, 140, 1241,
+	141, 667, 2098, 1183, 1242, 2898, 1244, 535, 1243, 2908,
+	2463, 2464, 2837, 2195, 1687, 1381, 1382, 124, 3727, 125,
+	2845, 1246, 1319, 1200, 1201, 3117, 3090, 1204, 2096, 2097,
+	2693, 628, 2586, 4175, 145, 144, 1278, 2472, 2474, 2482,
+	2473, 3484, 636, 1139, 1377, 1380, 1381, 1382, 1378, 3044,
+	1379, 1384, 2469, 4435, 1229, 1231, 2831, 4398, 4396, 3485,
+	2469, 1202, 1203, 2489, 3482, 2571, 2570, 148, 2469, 2842,
+	2073, 2469, 1688, 511, 1237, 534, 1282, 2469, 619, 622,
+	3230, 2741, 710, 709, 712, 713, 714, 715, 514, 2136,
+	2137, 711, 131, 2309, 1205, 1332, 620, 69, 1333, 621,
+	1206, 161, 3004, 135, 2832, 3345, 44, 3119, 97, 1194,
+	4480, 146, 1372, 147, 4295, 4436, 4434, 4433, 4399, 2742,
+	1388, 1392, 4011, 2158, 2841, 1193, 1410, 1411, 97, 2157,
+	97, 97, 2156, 667, 2155, 1426, 97, 632, 3825, 2141,
+	142, 2154, 2153, 1338, 1191, 3881, 616, 1139, 629, 1315,
+	1316, 634, 1294, 1232, 617, 2947, 1498, 1388, 1285, 4110,
+	1501, 3644, 3645, 3646, 3647, 1385, 4174, 2988, 2897, 619,
+	1326, 4360, 3845, 1327, 4294, 2165, 1688, 2582, 4308, 2740,
+	4307, 4250, 2846, 3855, 4269, 3437, 3334, 4270, 3959, 4271,
+	1514, 3362, 2852, 2993, 1517, 1518, 1519, 1520, 1521, 2901,
+	1525, 2600, 1292, 1308, 2894, 161, 1425, 4112, 3674, 3969,
+	3967, 3655, 667, 1192, 2601, 2128, 2898, 3843, 3638, 4215,
+	3337, 1437, 1437, 2166, 2949, 121, 3641, 2844, 2528, 149,
+	1377, 1380, 1381, 1382, 1378, 2168, 1379, 1384, 3639, 3640,
+	2655, 2656, 3653, 1528, 1529, 1530, 1531, 1532, 1533, 1534,
+	1535, 1536, 1537, 1538, 1539, 1540, 1541, 1542, 1543, 3158,
+	1546, 1547, 1549, 1549, 1549, 3543, 1554, 1554, 1554, 1557,
+	1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566, 1567,
+	1568, 1569, 1570, 1571, 1572, 1573, 1574, 1575, 1576, 1577,
+	1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585, 1586, 3789,
+	4268, 3463, 1190, 3579, 3095, 2856, 3790, 1294, 1402, 3821,
+	3208, 4223, 515, 3545, 1438, 3426, 1318, 3622, 630, 142,
+	1387, 138, 2912, 2702, 2098, 4209, 1554, 3122, 3123, 3121,
+	2864, 3879, 133, 1368, 3127, 2951, 3120, 3118, 538, 2897,
+	4489, 2849, 3125, 4000, 3961, 4459, 1199, 1461, 1462, 1460,
+	2096, 2097, 4157, 2237, 136, 4013, 3124, 4157, 3207, 632,
+	1334, 3772, 4488, 3428, 4458, 3043, 1463, 625, 1294, 3325,
+	4455, 4378, 1245, 3126, 3128, 3328, 3329, 3330, 3331, 3332,
+	674, 159, 4420, 120, 3773, 160, 4264, 2898, 162, 163,
+	4367, 2881, 109, 3792, 164, 672, 4135, 654, 654, 525,
+	1548, 1550, 1551, 1236, 2858, 3435, 128, 3712, 149, 1554,
+	1554, 671, 4262, 4263, 1555, 1556, 3058, 3057, 2074, 3770,
+	3057, 522, 1238, 4130, 1286, 3791, 1293, 2737, 2237, 1418,
+	1418, 1419, 2893, 1369, 1499, 1413, 1328, 1413, 1413, 4176,
+	2838, 2491, 2917, 1413, 4293, 1412, 526, 1417, 1417, 2741,
+	2915, 1383, 3966, 1427, 3578, 3580, 3581, 3582, 2471, 3842,
+	2476, 2468, 3336, 1341, 4111, 1452, 1451, 2477, 137, 1452,
+	1451, 2834, 1366, 1587, 2142, 2143, 2144, 2145, 2146, 2147,
+	3991, 3322, 1383, 1152, 122, 159, 98, 1697, 2836, 160,
+	1367, 3960, 162, 163, 126, 134, 4359, 1301, 164, 3817,
+	2848, 1461, 1462, 1460, 3818, 1468, 1707, 3816, 3129, 3815,
+	3814, 1590, 3813, 1216, 3328, 3329, 3330, 3331, 3332, 3812,
+	1463, 1152, 3289, 1040, 131, 3280, 3281, 3283, 3290, 3291,
+	3282, 3284, 3285, 131, 3238, 3246, 3248, 3247, 3954, 3955,
+	131, 3240, 98, 3822, 135, 3286, 3287, 3288, 2241, 1304,
+	132, 135, 4241, 1718, 1719, 1717, 1588, 1589, 2518, 1299,
+	2950, 4383, 4044, 2835, 2839, 2840, 2843, 664, 2847, 2850,
+	2851, 2853, 2854, 2855, 2857, 2859, 2860, 2861, 2862, 2863,
+	1343, 3434, 2522, 2523, 1596, 4179, 4180, 2229, 3950, 2517,
+	1215, 4376, 3431, 3432, 4371, 3374, 3971, 1054, 1212, 124,
+	523, 125, 1054, 3374, 3372, 3427, 1149, 1148, 1300, 1211,
+	1296, 1506, 1507, 4374, 1509, 1510, 1511, 1512, 1513, 1261,
+	1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271,
+	1272, 1210, 1152, 2100, 523, 569, 1711, 2975, 2976, 1150,
+	1213, 1214, 1297, 1298, 1682, 1683, 1684, 1685, 1686, 4335,
+	4286, 520, 3514, 1441, 3515, 3686, 3687, 569, 1737, 523,
+	4036, 2069, 1698, 1139, 1696, 1594, 2025, 2458, 1383, 1612,
+	2102, 4482, 4252, 2101, 2240, 4469, 1617, 1353, 3931, 4251,
+	3568, 3024, 1139, 3569, 1695, 3570, 3419, 3254, 133, 3420,
+	3391, 3421, 1139, 4495, 674, 1386, 2458, 1398, 1400, 2529,
+	4490, 4474, 3036, 2833, 4446, 1146, 1168, 4116, 1596, 1606,
+	636, 1730, 3696, 1290, 1607, 4273, 97, 3698, 3433, 664,
+	3360, 41, 1340, 3241, 2460, 1690, 2987, 2983, 1241, 2958,
+	2957, 2595, 2133, 1242, 1723, 1244, 1721, 1243, 1743, 1398,
+	1400, 1710, 2043, 2044, 2045, 2046, 2047, 1716, 2048, 2460,
+	1295, 1302, 1303, 1305, 1306, 1307, 1239, 1309, 1310, 2511,
+	1312, 1313, 1314, 1356, 1317, 95, 1320, 1321, 1322, 1323,
+	1324, 569, 1591, 1592, 1349, 117, 1143, 3242, 2209, 2208,
+	1437, 2207, 2460, 1142, 2460, 3355, 98, 2226, 1365, 1726,
+	1156, 2023, 462, 3056, 1745, 1348, 1344, 1345, 1346, 1347,
+	1350, 1351, 1352, 1354, 523, 2542, 2126, 121, 3701, 668,
+	2541, 2037, 3457, 4373, 4375, 2061, 1613, 1440, 2754, 95,
+	2089, 1429, 2039, 2530, 1503, 1504, 2049, 1614, 2050, 2051,
+	2052, 1692, 1399, 98, 3195, 2985, 2984, 2056, 2027, 2031,
+	98, 2827, 1289, 1502, 650, 650, 650, 650, 2065, 1500,
+	3390, 134, 2716, 2686, 1693, 2652, 1705, 4467, 2546, 97,
+	4468, 1706, 4466, 1392, 4128, 2203, 2053, 1713, 2055, 2519,
+	1715, 667, 2064, 2176, 1399, 667, 2426, 2459, 2249, 2109,
+	3035, 1734, 1733, 1505, 3032, 1616, 2751, 1516, 41, 2197,
+	3695, 1515, 680, 1469, 1505, 2202, 1276, 2160, 2034, 1181,
+	2204, 97, 2459, 2087, 2198, 3964, 2169, 2437, 3031, 3024,
+	2041, 2042, 91, 3028, 2206, 1479, 3027, 3030, 1489, 2132,
+	1478, 1477, 1487, 1488, 1480, 1481, 1482, 1483, 1484, 1485,
+	1486, 1479, 3182, 1505, 1489, 2459, 3183, 2459, 3982, 1407,
+	1489, 1408, 2071, 2070, 3024, 1508, 1233, 1502, 1139, 2816,
+	2075, 3025, 2458, 98, 2170, 3604, 2171, 2080, 2081, 3270,
+	3357, 2083, 1401, 2104, 2063, 3682, 91, 3989, 2185, 3702,
+	2270, 3679, 2024, 4242, 4243, 3986, 668, 2086, 117, 3748,
+	2301, 1407, 2302, 1408, 2107, 2179, 4239, 4240, 1409, 41,
+	2807, 2105, 2108, 1503, 1504, 112, 1525, 1546, 650, 2257,
+	3054, 2305, 2127, 2307, 1401, 2130, 1398, 1400, 667, 4278,
+	2029, 2029, 2029, 2029, 2278, 3829, 2317, 2319, 2565, 3380,
+	3605, 3381, 95, 3680, 3271, 2131, 2275, 3013, 2202, 3014,
+	1409, 1222, 3010, 95, 3011, 2683, 2308, 2310, 2205, 2161,
+	2823, 2680, 2824, 115, 2312, 2303, 4018, 4017, 1398, 1400,
+	2559, 41, 531, 1613, 98, 3830, 1463, 2164, 2677, 2162,
+	2163, 1503, 1504, 650, 1614, 1208, 2233, 2384, 2385, 2172,
+	2183, 2184, 2036, 2186, 3178, 4032, 2609, 85, 2196, 91,
+	2607, 1198, 1745, 1460, 3382, 2436, 2606, 114, 2338, 2341,
+	3147, 2256, 3015, 4279, 2085, 3182, 2354, 3012, 1224, 3183,
+	1463, 1394, 4449, 4417, 4448, 2825, 2269, 665, 158, 1252,
+	465, 476, 1160, 1159, 158, 2353, 98, 2442, 4472, 158,
+	644, 654, 654, 654, 654, 4445, 1368, 2247, 2248, 611,
+	611, 2284, 2417, 4361, 2419, 4312, 2254, 1223, 158, 668,
+	654, 1399, 2060, 2353, 158, 2767, 2282, 2283, 2281, 1054,
+	2955, 1700, 4302, 2266, 1462, 1460, 2560, 3654, 3648, 2356,
+	2745, 4185, 2744, 4311, 2329, 4310, 1372, 158, 1138, 91,
+	2150, 1209, 1463, 2275, 1482, 1483, 1484, 1485, 1486, 1479,
+	91, 1235, 1489, 1399, 1197, 1461, 1462, 1460, 4301, 158,
+	611, 4041, 4117, 1138, 3324, 2387, 2545, 1461, 1462, 1460,
+	2698, 1226, 465, 158, 1463, 2538, 2392, 2255, 2394, 3839,
+	2320, 2321, 2322, 2323, 2324, 2420, 1463, 4185, 3364, 4258,
+	2279, 4257, 2418, 2219, 1461, 1462, 1460, 2231, 4330, 1745,
+	3707, 2981, 1132, 2221, 4483, 4478, 2350, 1461, 1462, 1460,
+	2231, 3264, 91, 1463, 41, 4476, 2545, 3709, 2498, 2499,
+	2500, 2501, 2502, 2443, 2531, 1457, 1463, 2952, 69, 2220,
+	4304, 2534, 2535, 2457, 88, 4214, 2449, 44, 2594, 2543,
+	1461, 1462, 1460, 2243, 4342, 654, 2244, 2503, 2504, 2505,
+	4171, 4426, 2424, 2428, 98, 2427, 2434, 3840, 95, 1463,
+	1468, 2713, 2714, 2715, 4484, 2521, 2280, 2494, 2495, 2496,
+	2497, 2470, 2446, 2475, 2478, 2479, 2480, 2481, 2444, 98,
+	2536, 2537, 2218, 2251, 4097, 2485, 2486, 2487, 1461, 1462,
+	1460, 4114, 3910, 2262, 2264, 2265, 4314, 2228, 2490, 1461,
+	1462, 1460, 85, 2263, 4341, 2953, 4185, 1463, 2252, 4090,
+	654, 2253, 4414, 4417, 4413, 3909, 4107, 4339, 1463, 4033,
+	2507, 3246, 3248, 3247, 1478, 1477, 1487, 1488, 1480, 1481,
+	1482, 1483, 1484, 1485, 1486, 1479, 3924, 2510, 1489, 1480,
+	1481, 1482, 1483, 1484, 1485, 1486, 1479, 3858, 3378, 1489,
+	3856, 2513, 2639, 2515, 3000, 3001, 3002, 2276, 3837, 4108,
+	2285, 2286, 2287, 2288, 2289, 2290, 2291, 2292, 2293, 2294,
+	2295, 2296, 2297, 2298, 2299, 3836, 1364, 4338, 2644, 1478,
+	1477, 1487, 1488, 1480, 1481, 1482, 1483, 1484, 1485, 1486,
+	1479, 2746, 3835, 1489, 3834, 2927, 4088, 4105, 4089, 53,
+	56, 1461, 1462, 1460, 3962, 91, 1461, 1462, 1460, 4261,
+	4109, 4091, 3828, 3766, 3149, 1429, 2641, 1461, 1462, 1460,
+	1463, 2414, 2336, 3662, 3612, 1463, 2344, 4300, 60, 87,
+	86, 1429, 3611, 1444, 3314, 55, 1463, 2361, 1461, 1462,
+	1460, 1470, 1478, 1477, 1487, 1488, 1480, 1481, 1482, 1483,
+	1484, 1485, 1486, 1479, 3080, 3963, 1489, 1463, 3267, 3197,
+	3583, 2966, 3585, 1461, 1462, 1460, 2110, 2926, 2924, 2113,
+	2114, 2115, 3584, 2117, 2118, 3589, 2909, 2119, 3412, 102,
+	3413, 2120, 1463, 2151, 2121, 158, 1284, 1523, 2122, 2123,
+	3414, 2124, 2125, 1283, 2215, 67, 68, 1256, 4093, 3587,
+	465, 4299, 4296, 1549, 2217, 4232, 2078, 4228, 4102, 4094,
+	4095, 4096, 4100, 4101, 4098, 4212, 4099, 3077, 4103, 2211,
+	4145, 1255, 104, 76, 110, 77, 3246, 3248, 3247, 2213,
+	2216, 2077, 4139, 4035, 4034, 3958, 2549, 2631, 2625, 2626,
+	3957, 2624, 2627, 2628, 3937, 3880, 1545, 3844, 82, 4494,
+	3246, 3248, 3247, 3811, 3780, 2212, 3779, 58, 158, 3744,
+	3661, 1724, 3660, 3659, 3658, 2562, 3651, 4493, 3650, 2585,
+	4030, 710, 709, 712, 713, 714, 715, 3649, 3610, 2645,
+	711, 3607, 2309, 2214, 3586, 1041, 1042, 1043, 2577, 3575,
+	3567, 3565, 3561, 2633, 2632, 3560, 3559, 1141, 3415, 3371,
+	3296, 1372, 3089, 3088, 1282, 3086, 2758, 3016, 2210, 2922,
+	2826, 2643, 2583, 2554, 1388, 1478, 1477, 1487, 1488, 1480,
+	1481, 1482, 1483, 1484, 1485, 1486, 1479, 4104, 4092, 1489,
+	64, 65, 71, 2553, 72, 2082, 3424, 1364, 4477, 2561,
+	650, 2567, 2557, 4460, 4454, 4387, 4379, 4274, 2672, 2673,
+	2674, 4255, 4254, 4202, 2563, 710, 709, 712, 713, 714,
+	715, 158, 4201, 4195, 711, 4194, 2309, 3968, 1429, 3861,
+	1426, 4198, 2665, 158, 3495, 3693, 3508, 3407, 3338, 3263,
+	2671, 2970, 2969, 2610, 3425, 1357, 2572, 2556, 2555, 2533,
+	3074, 158, 1487, 1488, 1480, 1481, 1482, 1483, 1484, 1485,
+	1486, 1479, 2304, 465, 1489, 2642, 2079, 2072, 2646, 1732,
+	1731, 2717, 1701, 2592, 1699, 1279, 533, 3996, 1429, 1429,
+	2270, 1478, 1477, 1487, 1488, 1480, 1481, 1482, 1483, 1484,
+	1485, 1486, 1479, 2576, 1325, 1489, 2599, 3441, 4351, 2602,
+	2584, 2688, 2748, 3860, 2685, 1429, 3441, 1429, 3804, 2591,
+	2634, 3595, 4284, 3803, 1437, 1437, 4124, 1429, 3595, 4218,
+	3595, 4119, 2666, 3595, 3946, 2711, 2712, 3441, 3945, 3441,
+	3940, 3876, 1429, 3441, 3849, 2422, 2275, 3517, 2604, 2360,
+	3532, 1478, 1477, 1487, 1488, 1480, 1481, 1482, 1483, 1484,
+	1485, 1486, 1479, 2278, 2422, 1489, 3068, 1429, 2651, 1429,
+	3441, 3719, 2616, 2614, 2025, 3677, 2025, 3676, 3595, 3594,
+	3441, 3440, 3307, 3306, 2649, 2670, 57, 59, 3303, 3304,
+	3303, 3302, 2685, 1429, 2968, 2659, 2597, 2596, 2325, 2579,
+	2325, 1429, 1745, 3316, 2423, 2630, 2425, 2199, 1429, 1429,
+	1619, 1618, 3299, 3298, 2701, 2968, 2695, 2696, 3297, 2811,
+	101, 2025, 1330, 2423, 2235, 2025, 2443, 1329, 541, 1290,
+	1288, 2809, 650, 4428, 3532, 650, 1477, 1487, 1488, 1480,
+	1481, 1482, 1483, 1484, 1485, 1486, 1479, 2700, 2526, 1489,
+	1287, 2703, 3752, 1288, 2199, 2325, 4146, 4010, 2722, 2545,
+	3681, 654, 1478, 1477, 1487, 1488, 1480, 1481, 1482, 1483,
+	1484, 1485, 1486, 1479, 2199, 3532, 1489, 2685, 2569, 3441,
+	3539, 2199, 3317, 1290, 3305, 3087, 2718, 654, 2726, 3017,
+	2608, 2430, 2525, 2775, 2774, 2685, 2605, 2808, 2637, 2638,
+	2084, 2550, 3723, 2544, 2640, 2245, 613, 1370, 2129, 2635,
+	2636, 2093, 2025, 1722, 1720, 1599, 1424, 98, 1054, 4249,
+	2664, 4120, 3980, 1743, 3864, 3613, 2040, 3603, 3600, 2466,
+	2493, 2469, 2573, 3062, 2998, 2997, 2781, 2783, 2489, 2866,
+	2655, 2656, 2509, 2789, 2790, 2791, 2792, 1294, 2520, 3666,
+	3722, 2484, 2059, 2483, 1691, 2766, 1478, 1477, 1487, 1488,
+	1480, 1481, 1482, 1483, 1484, 1485, 1486, 1479, 1275, 2279,
+	1489, 2566, 1188, 1187, 2877, 2879, 4464, 4463, 4440, 1745,
+	4438, 1690, 2810, 4432, 4431, 4405, 4400, 569, 2974, 4394,
+	4392, 2812, 2931, 4344, 2813, 4343, 3903, 3714, 3710, 3517,
+	3315, 3130, 2963, 1523, 1478, 1477, 1487, 1488, 1480, 1481,
+	1482, 1483, 1484, 1485, 1486, 1479, 2962, 2945, 1489, 2928,
+	2658, 2648, 2103, 1728, 1331, 2959, 1291, 3678, 2814, 2818,
+	1606, 2539, 2194, 510, 2191, 2662, 158, 2193, 2873, 2192,
+	2876, 2661, 2828, 2189, 2660, 2965, 1138, 2188, 2190, 2187,
+	4237, 4149, 3091, 1710, 3756, 2694, 4221, 2708, 3183, 1437,
+	4204, 2707, 3915, 3692, 3598, 3401, 2675, 2676, 3400, 3295,
+	2678, 2679, 3294, 2960, 2681, 2682, 3293, 2880, 2923, 2872,
+	3256, 2910, 4002, 2925, 2913, 2914, 2916, 2918, 3075, 2919,
+	2920, 3078, 2176, 3060, 3081, 4005, 4144, 4143, 512, 513,
+	3895, 3893, 4205, 2618, 3953, 3952, 3848, 532, 2575, 654,
+	2948, 2574, 654, 2076, 3042, 3064, 3831, 3832, 1432, 2972,
+	3041, 650, 3551, 3070, 3071, 3072, 3410, 2270, 4421, 1433,
+	3066, 3269, 3210, 2617, 1615, 1138, 158, 1273, 2719, 2720,
+	2721, 3082, 1257, 2964, 1254, 1253, 611, 611, 1195, 3874,
+	1138, 2977, 611, 2971, 1523, 3873, 1364, 3104, 3750, 1138,
+	158, 2247, 2248, 158, 3656, 3343, 2514, 1727, 611, 611,
+	2986, 3657, 1386, 4147, 158, 2982, 4113, 3852, 465, 465,
+	465, 465, 3602, 2275, 680, 3098, 2999, 1437, 2258, 2259,
+	2260, 2996, 3313, 3155, 158, 158, 158, 158, 158, 3008,
+	158, 2759, 2760, 2761, 2179, 2930, 2173, 3003, 3019, 3029,
+	3034, 3022, 3021, 2315, 2316, 158, 158, 3180, 3184, 2078,
+	611, 3133, 650, 2077, 3135, 3026, 158, 3037, 3038, 667,
+	2989, 3040, 1422, 1423, 3059, 1250, 1337, 1251, 2995, 1745,
+	1420, 1421, 1414, 1415, 4318, 4317, 4316, 2706, 3808, 3181,
+	2817, 2612, 3069, 1523, 1230, 2705, 3146, 1227, 1249, 2598,
+	2697, 2333, 2334, 3085, 4234, 3083, 4233, 4141, 3104, 1138,
+	4024, 3092, 4006, 3970, 3097, 3914, 3099, 3731, 3202, 3093,
+	3101, 675, 611, 611, 611, 101, 3102, 1138, 3730, 3466,
+	1600, 3105, 3106, 1355, 1166, 1157, 2968, 4442, 4441, 3187,
+	2934, 2935, 2936, 3265, 4275, 3367, 3163, 2994, 2992, 2991,
+	3277, 2776, 3132, 2755, 3134, 2029, 2752, 2710, 611, 2603,
+	2552, 2054, 1458, 611, 611, 1186, 1185, 4441, 4442, 4020,
+	3156, 3292, 3160, 2232, 1138, 658, 662, 661, 4333, 1439,
+	2441, 4063, 63, 103, 3161, 4065, 22, 66, 1138, 158,
+	4064, 21, 4066, 23, 3276, 4067, 24, 618, 158, 611,
+	4203, 3245, 3189, 158, 1, 1138, 158, 158, 158, 158,
+	569, 3333, 3272, 4061, 17, 3475, 3268, 3191, 158, 3193,
+	3194, 3192, 4060, 16, 3237, 158, 4059, 15, 2736, 158,
+	3258, 3259, 3260, 4134, 3261, 3318, 652, 2503, 654, 2505,
+	2504, 3255, 3201, 3257, 4062, 18, 4058, 14, 47, 3311,
+	3107, 2593, 3721, 4052, 10, 2111, 1428, 4087, 38, 2506,
+	568, 2973, 4085, 36, 4084, 35, 4083, 31, 4082, 30,
+	2978, 2979, 4081, 29, 3379, 4078, 26, 3636, 3346, 1478,
+	1477, 1487, 1488, 1480, 1481, 1482, 1483, 1484, 1485, 1486,
+	1479, 158, 3635, 1489, 4077, 25, 4057, 13, 465, 3335,
+	3418, 4054, 12, 3642, 3274, 3275, 1478, 1477, 1487, 1488,
+	1480, 1481, 1482, 1483, 1484, 1485, 1486, 1479, 1596, 1596,
+	1489, 4053, 11, 4051, 9, 3323, 3347, 3326, 2946, 4129,
+	3990, 3652, 1708, 1138, 3820, 1138, 1163, 2524, 1138, 654,
+	1280, 4142, 4001, 4003, 3619, 3618, 1138, 2939, 1138, 1138,
+	2938, 1274, 2580, 3439, 3404, 2091, 3020, 158, 3144, 3023,
+	158, 2540, 2629, 2611, 3196, 3373, 3456, 3351, 3300, 3376,
+	3301, 39, 2551, 2035, 3461, 2140, 2134, 3388, 1342, 2450,
+	3353, 4246, 3922, 3354, 3767, 3544, 3540, 2829, 3245, 3576,
+	2445, 1126, 111, 2558, 1207, 1690, 3500, 487, 2447, 3369,
+	3370, 2890, 4004, 1277, 2889, 2907, 2462, 1359, 2634, 2888,
+	2887, 3422, 3406, 4115, 3389, 2891, 1624, 3383, 1622, 3108,
+	1623, 1621, 3467, 1626, 158, 158, 158, 1625, 492, 1608,
+	3522, 97, 4189, 1138, 3507, 1459, 721, 130, 3131, 3416,
+	3417, 3033, 626, 667, 627, 3136, 118, 3137, 3138, 127,
+	3139, 3140, 1138, 494, 3141, 1497, 2704, 2819, 3356, 1052,
+	1053, 2176, 3361, 3523, 1044, 2689, 3365, 3366, 3442, 3368,
+	3150, 3151, 3152, 1478, 1477, 1487, 1488, 1480, 1481, 1482,
+	1483, 1484, 1485, 1486, 1479, 2242, 4016, 1489, 3518, 3166,
+	3167, 3168, 3169, 3170, 3171, 3172, 3173, 3174, 3175, 3176,
+	3593, 3521, 1402, 3476, 3896, 4007, 4178, 1442, 3187, 3898,
+	3729, 3465, 2765, 1544, 2351, 693, 663, 3747, 3900, 2261,
+	3480, 708, 3549, 3537, 707, 706, 703, 704, 4158, 2250,
+	3177, 1549, 1549, 1549, 1554, 1554, 1554, 1557, 1558, 1559,
+	1554, 1554, 1554, 3509, 1471, 3162, 158, 3512, 3308, 1336,
+	3245, 682, 1406, 158, 158, 611, 611, 611, 3245, 3513,
+	1405, 158, 1404, 1403, 3477, 3478, 1397, 3479, 647, 3530,
+	3525, 3189, 3481, 2179, 3483, 2415, 2980, 1376, 1374, 3615,
+	1373, 3566, 3533, 3534, 3535, 3536, 3606, 1729, 1595, 3505,
+	2657, 2653, 3531, 92, 646, 651, 43, 2709, 1225, 3538,
+	1447, 3501, 3908, 3554, 3555, 105, 660, 659, 677, 3262,
+	28, 3564, 20, 3631, 3632, 3633, 19, 1182, 2622, 3574,
+	1161, 45, 52, 3387, 51, 3588, 3590, 50, 3599, 48,
+	49, 3394, 3396, 3398, 2933, 2516, 3617, 3403, 4188, 3461,
+	3667, 3669, 3387, 1478, 1477, 1487, 1488, 1480, 1481, 1482,
+	1483, 1484, 1485, 1486, 1479, 4369, 1260, 1489, 4386, 4415,
+	37, 34, 33, 3546, 3547, 3548, 32, 3683, 3616, 1548,
+	1550, 1551, 4079, 4073, 4072, 3609, 3670, 4075, 4074, 4071,
+	3688, 3689, 1555, 1556, 3571, 3572, 3573, 1587, 1588, 1589,
+	4076, 4070, 4069, 4068, 4086, 3591, 4056, 3630, 3671, 3596,
+	3597, 3634, 4055, 3673, 4353, 4352, 4, 94, 90, 3725,
+	40, 113, 1123, 2, 0, 3690, 0, 0, 0, 0,
+	3663, 3665, 0, 0, 0, 0, 0, 3704, 0, 0,
+	0, 0, 3717, 0, 0, 0, 0, 0, 3104, 0,
+	0, 0, 0, 0, 0, 0, 0, 3699, 0, 3668,
+	3716, 3718, 0, 0, 0, 3245, 0, 0, 0, 3684,
+	3504, 3691, 2503, 3461, 0, 3732, 3255, 0, 0, 3447,
+	3448, 3449, 3450, 3451, 3452, 3453, 3454, 3455, 3706, 650,
+	0, 3697, 3700, 0, 0, 0, 0, 0, 0, 3713,
+	0, 0, 0, 3769, 3771, 0, 0, 0, 0, 0,
+	0, 0, 3711, 3469, 0, 3470, 3471, 3472, 3473, 3474,
+	0, 0, 0, 1444, 1478, 1477, 1487, 1488, 1480, 1481,
+	1482, 1483, 1484, 1485, 1486, 1479, 0, 0, 1489, 0,
+	0, 0, 0, 0, 0, 3672, 0, 0, 3497, 3498,
+	3499, 0, 3675, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 3734, 3800, 0, 3755, 0, 0, 158, 0,
+	0, 0, 3187, 3759, 0, 97, 3775, 0, 0, 3778,
+	0, 3765, 0, 0, 0, 0, 0, 667, 0, 0,
+	0, 0, 0, 0, 3751, 0, 0, 158, 0, 3245,
+	0, 3798, 0, 2732, 3801, 3760, 0, 3806, 0, 3749,
+	0, 644, 0, 3807, 0, 0, 0, 0, 0, 0,
+	0, 3777, 0, 0, 0, 0, 0, 0, 1138, 0,
+	2223, 2768, 0, 0, 3805, 3189, 158, 0, 158, 0,
+	3245, 0, 1138, 0, 97, 0, 0, 1138, 3809, 0,
+	0, 0, 0, 0, 0, 0, 667, 0, 0, 0,
+	0, 0, 3793, 3706, 0, 3783, 3796, 3797, 3878, 3784,
+	1138, 3601, 0, 1138, 0, 0, 3859, 3794, 3795, 0,
+	0, 0, 0, 3608, 0, 3810, 0, 0, 0, 2200,
+	2201, 0, 0, 0, 0, 0, 0, 3819, 0, 3387,
+	0, 3823, 0, 0, 3833, 0, 0, 0, 3838, 0,
+	0, 3854, 3841, 3522, 0, 0, 3522, 0, 3920, 0,
+	3884, 0, 3387, 3850, 3853, 3847, 3846, 0, 0, 3875,
+	667, 3926, 0, 3928, 3929, 3930, 0, 0, 3857, 0,
+	0, 158, 158, 0, 3862, 3863, 1138, 0, 0, 158,
+	3919, 3872, 0, 0, 0, 0, 3882, 0, 0, 3865,
+	3866, 0, 0, 0, 0, 0, 654, 0, 97, 0,
+	0, 0, 0, 0, 1138, 0, 0, 0, 0, 0,
+	667, 0, 3947, 0, 3521, 0, 0, 3521, 3917, 3913,
+	0, 3916, 0, 0, 0, 0, 0, 0, 3918, 0,
+	3949, 3934, 3932, 3921, 0, 0, 0, 3951, 0, 0,
+	0, 0, 0, 0, 0, 0, 3245, 0, 3245, 0,
+	0, 0, 2326, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2332, 3245, 0, 3976, 3948, 0, 0, 3925,
+	0, 3927, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 3956, 0, 3987, 3935, 0, 0, 0,
+	0, 0, 0, 0, 2390, 2391, 0, 0, 0, 0,
+	0, 2397, 2398, 2399, 2400, 0, 0, 0, 0, 3522,
+	0, 97, 0, 4023, 0, 3978, 3973, 0, 0, 3977,
+	2413, 3975, 0, 667, 3997, 0, 0, 0, 3735, 3736,
+	3737, 3738, 3979, 0, 0, 0, 3742, 0, 0, 0,
+	3745, 3746, 97, 4022, 0, 0, 3988, 0, 0, 4045,
+	0, 0, 0, 3245, 0, 4042, 0, 4026, 0, 4028,
+	3974, 4031, 0, 3061, 0, 0, 3063, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3983, 0, 97, 4019,
+	3521, 4021, 4029, 0, 0, 4126, 0, 0, 0, 158,
+	0, 0, 3494, 0, 158, 0, 0, 158, 158, 158,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 4043,
+	0, 0, 0, 0, 0, 4106, 0, 0, 0, 680,
+	0, 0, 0, 0, 2871, 4118, 3717, 0, 0, 2871,
+	2871, 4121, 0, 0, 4131, 0, 0, 0, 0, 3868,
+	0, 0, 3870, 0, 3716, 0, 0, 4127, 0, 0,
+	0, 4181, 0, 4133, 0, 0, 4140, 0, 0, 0,
+	0, 0, 4148, 0, 0, 0, 0, 0, 0, 0,
+	4162, 0, 0, 4165, 0, 3148, 4161, 4164, 0, 3493,
+	0, 0, 1138, 4173, 158, 0, 3934, 97, 0, 97,
+	3187, 4177, 1138, 1138, 0, 97, 0, 4172, 611, 1478,
+	1477, 1487, 1488, 1480, 1481, 1482, 1483, 1484, 1485, 1486,
+	1479, 0, 0, 1489, 4193, 0, 0, 158, 611, 1138,
+	0, 0, 0, 465, 0, 0, 0, 0, 4199, 4230,
+	0, 0, 4222, 0, 4208, 0, 0, 0, 3155, 611,
+	0, 0, 0, 635, 0, 631, 0, 0, 0, 0,
+	0, 0, 0, 3189, 0, 0, 0, 3885, 3886, 3887,
+	4213, 0, 4248, 4216, 1138, 3717, 4220, 0, 611, 4227,
+	1138, 4217, 4226, 4225, 4238, 0, 611, 0, 4219, 0,
+	4236, 4224, 0, 3716, 4253, 4235, 1478, 1477, 1487, 1488,
+	1480, 1481, 1482, 1483, 1484, 1485, 1486, 1479, 0, 0,
+	1489, 0, 0, 0, 0, 0, 0, 0, 0, 4259,
+	0, 0, 0, 4244, 0, 4277, 569, 4283, 4256, 0,
+	0, 0, 4298, 4265, 1138, 1138, 0, 0, 0, 4287,
+	0, 0, 0, 0, 0, 0, 3944, 1138, 0, 0,
+	0, 0, 0, 0, 4297, 1138, 1138, 1138, 0, 97,
+	0, 4290, 97, 0, 0, 0, 4288, 0, 97, 97,
+	97, 97, 4285, 97, 97, 4322, 4289, 97, 97, 0,
+	4322, 0, 0, 4303, 4322, 4332, 3352, 158, 97, 4305,
+	4306, 4309, 4259, 158, 3972, 4336, 0, 4320, 4313, 1138,
+	3461, 4315, 4319, 0, 0, 0, 0, 0, 4324, 4325,
+	4326, 0, 97, 4329, 0, 97, 0, 4340, 97, 3981,
+	3492, 0, 4347, 4337, 0, 0, 2436, 4358, 0, 4357,
+	667, 4356, 4349, 4366, 4377, 4355, 4390, 4354, 4334, 0,
+	0, 0, 0, 0, 569, 4389, 0, 0, 4388, 0,
+	4368, 4362, 4391, 0, 4364, 0, 0, 3408, 4345, 0,
+	97, 0, 0, 0, 97, 1138, 97, 0, 4406, 4409,
+	97, 4322, 0, 4322, 0, 0, 0, 4418, 0, 0,
+	0, 97, 97, 97, 97, 0, 97, 0, 4322, 4322,
+	4322, 0, 0, 4322, 158, 0, 0, 0, 0, 4407,
+	0, 0, 4393, 2568, 0, 4395, 0, 0, 0, 4437,
+	1138, 97, 0, 97, 4439, 97, 0, 0, 4322, 4450,
+	4322, 0, 0, 4425, 4452, 0, 0, 1478, 1477, 1487,
+	1488, 1480, 1481, 1482, 1483, 1484, 1485, 1486, 1479, 0,
+	0, 1489, 0, 0, 97, 4470, 0, 3244, 0, 465,
+	97, 4322, 0, 0, 4447, 2871, 2871, 2871, 97, 2871,
+	0, 0, 0, 0, 0, 4322, 0, 3503, 0, 0,
+	0, 0, 0, 0, 97, 0, 0, 97, 158, 0,
+	0, 4322, 0, 0, 0, 0, 0, 97, 0, 4475,
+	0, 0, 0, 97, 4322, 0, 0, 0, 0, 0,
+	4322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 158, 0, 0, 2650, 0, 4487, 0, 0, 0,
+	0, 0, 0, 0, 0, 1138, 1138, 1138, 0, 0,
+	0, 0, 611, 0, 0, 0, 0, 3143, 0, 0,
+	158, 611, 2667, 0, 0, 1138, 1138, 0, 0, 4206,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2865, 2684, 0, 0, 0, 611, 0, 1138, 0,
+	611, 0, 0, 0, 611, 611, 0, 611, 0, 0,
+	158, 158, 0, 0, 0, 2837, 0, 3142, 0, 0,
+	0, 0, 0, 2845, 0, 0, 0, 0, 0, 0,
+	0, 465, 2871, 0, 0, 0, 0, 0, 0, 465,
+	465, 465, 1138, 0, 0, 465, 158, 1138, 0, 0,
+	465, 0, 0, 1138, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 3244, 0, 0, 1138, 0, 0,
+	0, 0, 2842, 0, 2724, 1138, 2725, 0, 0, 0,
+	0, 1138, 1478, 1477, 1487, 1488, 1480, 1481, 1482, 1483,
+	1484, 1485, 1486, 1479, 0, 158, 1489, 2733, 2734, 2735,
+	1138, 2743, 0, 0, 0, 2747, 0, 2750, 0, 0,
+	2753, 0, 0, 2756, 2757, 0, 0, 0, 2762, 2763,
+	0, 0, 0, 0, 2769, 2770, 2771, 2841, 0, 2772,
+	0, 2773, 1478, 1477, 1487, 1488, 1480, 1481, 1482, 1483,
+	1484, 1485, 1486, 1479, 0, 0, 1489, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2777, 2778, 2779, 2780,
+	0, 0, 2784, 2785, 2786, 2787, 2788, 0, 0, 0,
+	0, 2793, 2794, 2795, 2796, 2797, 2798, 2799, 2800, 2801,
+	2802, 2803, 2804, 0, 2805, 2846, 0, 0, 0, 0,
+	0, 0, 0, 1138, 0, 2852, 0, 1138, 0, 2687,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1473, 0, 1476, 0, 0, 0, 0, 0, 0, 1490,
+	1491, 1492, 1493, 1494, 1495, 1496, 3244, 1474, 1475, 1472,
+	2844, 0, 0, 0, 3244, 0, 0, 0, 1478, 1477,
+	1487, 1488, 1480, 1481, 1482, 1483, 1484, 1485, 1486, 1479,
+	0, 0, 1489, 1478, 1477, 1487, 1488, 1480, 1481, 1482,
+	1483, 1484, 1485, 1486, 1479, 0, 4429, 1489, 0, 0,
+	1523, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	3079, 3761, 3762, 3763, 3764, 0, 0, 0, 0, 0,
+	0, 2620, 2621, 0, 0, 0, 0, 4453, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2856, 1478,
+	1477, 1487, 1488, 1480, 1481, 1482, 1483, 1484, 1485, 1486,
+	1479, 3076, 0, 1489, 0, 0, 0, 0, 158, 0,
+	1138, 0, 0, 2864, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2849, 0, 0, 0, 0, 0,
+	1478, 1477, 1487, 1488, 1480, 1481, 1482, 1483, 1484, 1485,
+	1486, 1479, 1138, 0, 1489, 0, 0, 0, 158, 0,
+	0, 0, 2749, 611, 0, 0, 0, 0, 0, 3073,
+	611, 1478, 1477, 1487, 1488, 1480, 1481, 1482, 1483, 1484,
+	1485, 1486, 1479, 0, 0, 1489, 0, 465, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2858, 1478, 1477,
+	1487, 1488, 1480, 1481, 1482, 1483, 1484, 1485, 1486, 1479,
+	465, 0, 1489, 0, 0, 0, 2723, 0, 0, 0,
+	0, 3244, 0, 0, 0, 0, 0, 0, 3067, 0,
+	0, 0, 0, 2838, 0, 0, 1445, 0, 0, 0,
+	0, 0, 0, 0, 1138, 1478, 1477, 1487, 1488, 1480,
+	1481, 1482, 1483, 1484, 1485, 1486, 1479, 0, 0, 1489,
+	1138, 0, 0, 0, 2834, 0, 0, 0, 3899, 3902,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2836, 0, 0, 0, 0, 3109, 3110, 3111, 3112,
+	3113, 3114, 152, 2848, 0, 0, 3115, 0, 509, 0,
+	0, 0, 0, 152, 0, 0, 529, 1478, 1477, 1487,
+	1488, 1480, 1481, 1482, 1483, 1484, 1485, 1486, 1479, 0,
+	0, 1489, 642, 0, 0, 0, 0, 0, 152, 0,
+	158, 158, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 681, 0, 0, 0, 0, 0, 1062, 0, 0,
+	0, 152, 0, 0, 0, 3244, 2835, 2839, 2840, 2843,
+	0, 2847, 2850, 2851, 2853, 2854, 2855, 2857, 2859, 2860,
+	2861, 2862, 2863, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 152, 0, 0,
+	0, 0, 2306, 0, 0, 0, 3244, 0, 465, 0,
+	0, 2871, 2871, 1138, 0, 0, 1138, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 465, 0, 0, 2327,
+	2328, 2330, 2331, 0, 0, 0, 2335, 0, 2337, 2340,
+	2343, 0, 2348, 2349, 0, 0, 0, 0, 2359, 0,
+	0, 0, 0, 0, 0, 0, 0, 2956, 0, 0,
+	0, 2386, 1523, 2388, 2389, 0, 0, 0, 2393, 0,
+	2395, 2396, 0, 0, 0, 0, 2401, 2402, 2403, 2404,
+	2405, 2406, 2407, 2408, 2409, 2410, 2411, 2412, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2871, 2871, 2833, 465, 0, 0,
+	465, 1138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1138, 3007,
+	0, 3009, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 3039, 0, 0, 0, 3902, 3045,
+	3046, 3047, 3048, 3049, 3050, 0, 3051, 3052, 3053, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 3244, 0, 3244, 0, 505, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 3244,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	479, 0, 0, 0, 0, 0, 0, 0, 4182, 4186,
+	3444, 3445, 3446, 0, 0, 0, 0, 4200, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 611, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 3468, 0, 465, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 3244,
+	0, 0, 0, 0, 0, 478, 0, 0, 0, 0,
+	0, 0, 3487, 3488, 3489, 3490, 3491, 0, 0, 152,
+	1138, 3496, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 3506, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 4260, 0, 0, 0, 0, 0, 1138,
+	0, 1138, 483, 1138, 0, 0, 0, 0, 0, 0,
+	3524, 493, 503, 504, 0, 0, 0, 3203, 3204, 3205,
+	3206, 0, 0, 3211, 3212, 3213, 3214, 3215, 3216, 0,
+	0, 3219, 3220, 3221, 3222, 3223, 3224, 3225, 3226, 3227,
+	3228, 3229, 152, 3231, 3232, 3233, 3234, 3235, 489, 3249,
+	495, 491, 0, 465, 500, 501, 0, 0, 0, 1138,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1138,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 4327,
+	0, 561, 502, 555, 566, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 692, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 556, 0, 0, 0, 158,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	497, 0, 0, 0, 0, 0, 4384, 0, 0, 0,
+	0, 0, 0, 0, 0, 642, 0, 0, 0, 498,
+	153, 0, 468, 0, 0, 0, 0, 152, 0, 0,
+	0, 153, 480, 0, 0, 0, 1138, 0, 0, 0,
+	0, 0, 0, 0, 0, 642, 0, 0, 1062, 1062,
+	643, 0, 0, 0, 0, 0, 153, 0, 0, 0,
+	3375, 0, 0, 465, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1063, 0, 0, 0, 153,
+	1130, 0, 0, 0, 0, 0, 0, 0, 0, 1138,
+	490, 0, 0, 0, 0, 4451, 0, 0, 3411, 0,
+	0, 153, 4456, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 468, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 719, 547, 546, 549, 0, 0, 0, 0,
+	481, 0, 0, 554, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 611, 0,
+	558, 0, 0, 0, 0, 562, 0, 0, 0, 0,
+	158, 0, 0, 1138, 0, 0, 0, 496, 484, 485,
+	565, 508, 0, 0, 0, 486, 488, 0, 482, 507,
+	506, 1138, 1138, 0, 0, 0, 0, 3739, 3740, 3741,
+	0, 3743, 528, 0, 0, 0, 0, 0, 0, 1138,
+	0, 0, 550, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 3753, 3754, 0, 3757, 0, 0, 0, 0,
+	3758, 0, 0, 0, 0, 499, 0, 0, 0, 0,
+	0, 1138, 0, 1061, 0, 0, 0, 0, 1129, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	553, 3550, 0, 3552, 3553, 0, 0, 0, 0, 0,
+	0, 3562, 3563, 1169, 0, 0, 611, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 551, 552, 559, 2106, 563, 564,
+	567, 3799, 0, 158, 0, 0, 0, 0, 3802, 0,
+	0, 0, 570, 571, 572, 573, 574, 575, 576, 577,
+	578, 579, 580, 581, 582, 583, 584, 585, 586, 587,
+	588, 589, 590, 591, 592, 593, 594, 595, 596, 597,
+	598, 599, 600, 601, 602, 603, 604, 605, 606, 607,
+	608, 2727, 2728, 2729, 2730, 2731, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2764, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 3877, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1598, 0, 0, 0, 1062, 0, 0, 153, 0, 1062,
+	0, 0, 0, 0, 0, 3904, 3905, 3906, 3907, 0,
+	0, 0, 468, 0, 0, 3911, 3912, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 3703, 0, 0, 41, 42, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 69, 0, 3933, 0, 0, 0, 88, 0, 0,
+	44, 73, 74, 0, 0, 0, 0, 0, 70, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 95, 0, 0, 0, 0, 0, 0, 0, 0,
+	152, 0, 0, 0, 0, 1704, 529, 0, 61, 0,
+	0, 0, 98, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 152, 0, 0, 152, 0, 0,
+	1704, 529, 0, 0, 1740, 85, 0, 0, 1742, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 152, 152,
+	152, 152, 152, 0, 152, 3992, 3993, 3994, 3995, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2057,
+	2058, 0, 0, 643, 0, 3776, 0, 1062, 4012, 0,
+	2066, 0, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 722, 0, 4025, 0, 4027, 0, 0,
+	0, 0, 0, 643, 0, 0, 1063, 1063, 0, 0,
+	0, 0, 557, 0, 4037, 468, 0, 0, 0, 46,
+	83, 54, 53, 56, 0, 0, 78, 0, 91, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 155,
+	0, 60, 87, 86, 4123, 0, 0, 0, 55, 0,
+	155, 0, 0, 0, 0, 4132, 0, 0, 0, 0,
+	0, 0, 0, 75, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 155, 4152, 0, 0, 0,
+	0, 0, 4163, 0, 0, 0, 4166, 0, 4167, 4168,
+	4169, 4170, 0, 152, 0, 0, 0, 0, 155, 1134,
+	0, 0, 152, 0, 1740, 0, 0, 642, 67, 68,
+	152, 152, 152, 152, 0, 0, 0, 0, 0, 0,
+	155, 0, 642, 0, 0, 0, 0, 0, 0, 152,
+	0, 0, 0, 642, 155, 0, 76, 0, 77, 0,
+	0, 0, 0, 0, 0, 0, 0, 2227, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 82, 0, 0, 1061, 1061, 0, 0, 0, 0,
+	58, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 681, 0, 152, 0, 0, 0, 0,
+	0, 3936, 0, 3938, 3939, 0, 1742, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4245, 0, 0, 0, 0, 80,
+	81, 0, 0, 0, 0, 0, 0, 0, 2227, 0,
+	62, 79, 0, 64, 65, 71, 0, 72, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 642, 0, 0, 152, 2227, 2227, 2227, 2227, 0,
+	0, 0, 2227, 0, 2227, 2227, 2227, 0, 2227, 2227,
+	0, 0, 0, 1062, 2227, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2227, 2227, 2227,
+	2227, 0, 0, 2227, 2227, 2227, 2227, 2227, 0, 0,
+	0, 0, 2227, 2227, 2227, 2227, 2227, 2227, 2227, 2227,
+	2227, 2227, 2227, 2227, 0, 0, 0, 0, 152, 152,
+	152, 0, 0, 0, 0, 0, 1062, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1742, 0, 0, 0, 4346, 0, 0,
+	0, 0, 0, 0, 0, 4350, 0, 0, 153, 0,
+	0, 0, 1063, 0, 0, 0, 0, 1063, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 4122, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 4401, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 57,
+	59, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1740, 0, 0, 155, 0, 0, 0,
+	152, 0, 0, 0, 0, 0, 0, 152, 152, 0,
+	1689, 0, 0, 0, 0, 152, 0, 0, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 4461,
+	4462, 0, 153, 2038, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	468, 468, 468, 468, 0, 0, 0, 0, 0, 155,
+	0, 0, 0, 0, 0, 0, 153, 153, 153, 153,
+	153, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	1061, 0, 0, 0, 0, 1061, 1609, 0, 0, 0,
+	0, 0, 0, 0, 0, 1063, 0, 730, 731, 732,
+	733, 734, 735, 736, 737, 738, 739, 740, 741, 742,
+	743, 744, 745, 746, 747, 748, 749, 750, 751, 752,
+	753, 754, 755, 756, 757, 758, 759, 760, 761, 762,
+	763, 764, 765, 766, 767, 768, 769, 770, 771, 0,
+	0, 0, 0, 0, 720, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 155, 1694, 0, 0, 0, 0,
+	0, 1703, 528, 0, 0, 0, 0, 0, 0, 0,
+	1714, 0, 0, 0, 0, 0, 0, 0, 0, 1725,
+	154, 0, 466, 0, 0, 0, 1703, 528, 0, 0,
+	1739, 154, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	153, 0, 0, 0, 0, 643, 154, 2177, 153, 153,
+	153, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	643, 0, 0, 0, 0, 0, 0, 153, 0, 154,
+	1131, 643, 0, 1061, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 154, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 466, 154, 0, 0, 0, 0,
+	0, 0, 152, 0, 0, 0, 0, 0, 0, 2090,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 2112, 0, 0,
+	468, 152, 0, 0, 2272, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	152, 0, 642, 0, 2152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2159, 643,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	1739, 0, 0, 0, 0, 2181, 0, 0, 0, 0,
+	0, 2355, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 153, 153, 0,
+	0, 0, 0, 0, 1063, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 642, 152, 0, 0, 0,
+	0, 2272, 0, 642, 1130, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2181, 0, 0, 0, 0, 0, 0, 0,
+	0, 1062, 1062, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1742, 0, 0, 0,
+	0, 0, 0, 2181, 0, 2181, 0, 0, 2311, 0,
+	0, 0, 0, 0, 0, 0, 2313, 0, 2181, 2181,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 155, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 153, 1061,
+	0, 0, 0, 0, 0, 153, 153, 0, 0, 0,
+	0, 2227, 0, 153, 0, 0, 1646, 2227, 2227, 2227,
+	2227, 2227, 0, 0, 0, 0, 0, 154, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 466, 0, 0, 0, 0, 2227, 0, 0,
+	0, 0, 1061, 2429, 0, 0, 0, 0, 0, 4266,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2181,
+	0, 0, 1129, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 155, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	154, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 155, 0, 152, 155, 0, 0, 0, 152, 0,
+	0, 152, 2815, 1742, 0, 1062, 0, 0, 1633, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 155, 155, 155, 155, 155,
+	0, 155, 0, 0, 0, 0, 0, 0, 0, 1739,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 152, 0,
+	1647, 0, 0, 0, 0, 154, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 152, 0, 0, 0, 466, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1062, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 155,
+	153, 0, 0, 0, 0, 0, 0, 155, 155, 155,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 155, 0, 0, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 153, 0,
+	643, 0, 2227, 1742, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1062, 0, 0, 0, 0, 0,
+	0, 152, 155, 0, 0, 0, 0, 152, 681, 0,
+	0, 0, 0, 0, 2227, 1660, 1663, 1664, 1665, 1666,
+	1667, 1668, 0, 1669, 1670, 1671, 1672, 1673, 1674, 1675,
+	1676, 1677, 1678, 1679, 1680, 1681, 0, 1648, 1649, 1650,
+	1627, 1631, 1661, 1628, 1634, 1630, 1632, 1629, 0, 0,
+	1635, 1636, 1637, 1638, 1639, 1640, 1641, 1642, 1643, 1644,
+	1645, 1652, 1653, 1654, 1655, 1656, 1657, 1658, 1659, 0,
+	0, 155, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 643, 153, 0, 0, 0, 0, 0,
+	0, 643, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1062, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1063,
+	1063, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2272, 155, 155, 155, 2578, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2590, 0, 0, 0, 0, 2590, 0, 0,
+	0, 0, 0, 1134, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 41, 0, 0, 0, 0, 0,
+	2590, 0, 0, 2590, 0, 0, 0, 0, 69, 0,
+	0, 0, 152, 0, 88, 0, 0, 44, 0, 1662,
+	0, 0, 0, 0, 0, 0, 0, 0, 154, 0,
+	0, 1651, 0, 0, 0, 0, 0, 0, 95, 0,
+	0, 0, 0, 0, 0, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	0, 0, 0, 0, 4097, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 152, 0, 2669, 155, 0, 0,
+	0, 0, 85, 0, 155, 155, 0, 0, 0, 4090,
+	0, 0, 155, 0, 4491, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2692, 0, 0, 1061, 1061, 0,
+	0, 0, 0, 0, 152, 152, 0, 0, 0, 0,
+	0, 153, 2181, 0, 0, 0, 153, 0, 154, 153,
+	0, 0, 0, 1063, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	152, 0, 154, 0, 0, 154, 2869, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1744, 0, 0, 0,
+	466, 466, 466, 466, 0, 0, 4088, 4105, 4089, 53,
+	56, 0, 0, 0, 0, 91, 154, 154, 154, 154,
+	154, 4091, 154, 0, 0, 0, 0, 0, 0, 642,
+	0, 0, 0, 0, 0, 0, 0, 0, 60, 87,
+	86, 0, 0, 0, 0, 55, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 153,
+	0, 0, 0, 0, 0, 468, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1063, 67, 68, 0, 4093, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 4102, 4094,
+	4095, 4096, 4100, 4101, 4098, 1062, 4099, 0, 4103, 0,
+	0, 1061, 0, 76, 0, 77, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 58, 0, 0,
+	0, 154, 0, 0, 0, 0, 0, 0, 0, 0,
+	154, 0, 0, 0, 0, 0, 2177, 2178, 154, 154,
+	154, 154, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 154, 0, 0,
+	0, 0, 2929, 0, 0, 0, 0, 0, 0, 155,
+	0, 2272, 2937, 2941, 0, 0, 0, 0, 0, 0,
+	0, 0, 1063, 0, 0, 0, 0, 4104, 4092, 153,
+	64, 65, 71, 0, 72, 153, 0, 0, 155, 2961,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1061, 154, 0, 0, 0, 0, 0, 0,
+	466, 0, 0, 0, 2271, 0, 0, 155, 0, 0,
+	0, 0, 0, 0, 2590, 0, 0, 0, 0, 0,
+	2990, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 154, 0, 3188, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 2181, 2181, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 3065, 0, 0,
+	0, 0, 0, 0, 0, 3065, 3065, 3065, 0, 0,
+	1740, 0, 0, 0, 0, 0, 0, 0, 0, 2181,
+	0, 0, 0, 155, 0, 0, 0, 0, 0, 0,
+	1061, 468, 0, 0, 0, 0, 154, 154, 154, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2181,
+	0, 0, 0, 0, 0, 0, 57, 59, 0, 0,
+	153, 2271, 0, 0, 1131, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 642, 152, 0, 0, 0, 1062,
+	0, 0, 0, 0, 0, 3153, 0, 0, 0, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1061, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 154, 0,
+	2181, 0, 153, 153, 0, 154, 154, 0, 0, 0,
+	0, 0, 0, 154, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 468, 0, 0, 0, 0, 0, 0,
+	2869, 468, 468, 468, 0, 0, 0, 468, 153, 0,
+	0, 0, 468, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	155, 0, 0, 0, 0, 155, 0, 643, 155, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 41, 0, 0, 0, 3319, 3320, 3321, 0, 0,
+	0, 0, 0, 0, 0, 69, 0, 0, 0, 3998,
+	0, 88, 0, 0, 44, 3065, 3065, 0, 0, 1646,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 95, 0, 0, 3359, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 155, 98, 0, 0, 0,
+	0, 4097, 0, 3188, 0, 2177, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	0, 0, 3399, 0, 0, 0, 4090, 3405, 155, 0,
+	0, 4485, 0, 3409, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 3429, 0, 0,
+	0, 0, 0, 0, 0, 3065, 0, 0, 0, 0,
+	0, 3443, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1633, 0, 0, 0, 0, 0, 0, 0, 0,
+	3459, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 4088, 4105, 4089, 53, 56, 0, 0,
+	0, 0, 91, 0, 0, 0, 0, 0, 4091, 0,
+	154, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	153, 0, 0, 0, 0, 60, 87, 86, 0, 0,
+	0, 0, 55, 1647, 0, 0, 0, 0, 0, 154,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1061, 0, 2181, 0, 0, 0, 2669, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 155, 0,
+	0, 0, 0, 0, 155, 0, 0, 0, 154, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 468,
+	0, 0, 67, 68, 0, 4093, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 4102, 4094, 4095, 4096, 4100,
+	4101, 4098, 468, 4099, 0, 4103, 0, 0, 0, 0,
+	76, 0, 77, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1062, 0, 0,
+	0, 0, 0, 0, 0, 82, 0, 0, 0, 0,
+	0, 0, 0, 152, 58, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 155, 0, 0, 0, 0,
+	0, 0, 0, 0, 154, 0, 0, 0, 0, 0,
+	2941, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 3065, 0, 4104, 4092, 0, 64, 65, 71,
+	0, 72, 643, 153, 2271, 0, 0, 3188, 1660, 1663,
+	1664, 1665, 1666, 1667, 1668, 0, 1669, 1670, 1671, 1672,
+	1673, 1674, 1675, 1676, 1677, 1678, 1679, 1680, 1681, 155,
+	1648, 1649, 1650, 1627, 1631, 1661, 1628, 1634, 1630, 1632,
+	1629, 0, 0, 1635, 1636, 1637, 1638, 1639, 1640, 1641,
+	1642, 1643, 1644, 1645, 1652, 1653, 1654, 1655, 1656, 1657,
+	1658, 1659, 155, 0, 0, 0, 0, 0, 0, 0,
+	468, 0, 0, 0, 0, 0, 1739, 0, 0, 0,
+	0, 0, 0, 0, 3720, 0, 0, 0, 468, 0,
+	0, 155, 0, 0, 0, 0, 0, 0, 0, 0,
+	2181, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 642, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 155, 155, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 69, 0, 0, 0, 0, 0, 88,
+	0, 0, 44, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 155, 0, 468,
+	0, 154, 468, 95, 0, 1061, 154, 0, 0, 154,
+	0, 1744, 0, 57, 59, 0, 0, 0, 0, 0,
+	0, 0, 1662, 0, 98, 0, 0, 0, 0, 4097,
+	0, 0, 0, 0, 1651, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 0, 0, 0, 4090, 0, 0, 0, 0, 4479,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 3065, 0, 0, 3065, 642, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 154, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 154,
+	0, 0, 0, 0, 0, 466, 0, 0, 41, 0,
+	0, 4088, 4105, 4089, 53, 56, 0, 0, 0, 0,
+	91, 0, 69, 0, 0, 0, 4091, 0, 88, 0,
+	0, 44, 0, 0, 0, 0, 0, 0, 0, 468,
+	0, 3871, 0, 60, 87, 86, 0, 0, 0, 0,
+	55, 0, 95, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2181, 0,
+	0, 0, 0, 98, 0, 0, 0, 0, 4097, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
+	0, 0, 1646, 4090, 0, 0, 2178, 0, 4471, 0,
+	67, 68, 0, 4093, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 4102, 4094, 4095, 4096, 4100, 4101, 4098,
+	0, 4099, 0, 4103, 0, 0, 0, 0, 76, 0,
+	77, 2271, 0, 0, 0, 0, 0, 0, 0, 155,
+	0, 0, 0, 0, 0, 468, 0, 0, 0, 154,
+	0, 0, 0, 82, 0, 154, 0, 0, 0, 0,
+	0, 0, 58, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 155,
+	4088, 4105, 4089, 53, 56, 3188, 0, 0, 0, 91,
+	0, 0, 0, 0, 0, 4091, 0, 0, 0, 0,
+	0, 153, 0, 0, 1633, 0, 0, 0, 0, 0,
+	0, 0, 60, 87, 86, 0, 0, 0, 0, 55,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	4009, 0, 4104, 4092, 0, 64, 65, 71, 0, 72,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 154, 0, 0, 3065,
+	0, 3065, 0, 3065, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 67,
+	68, 0, 4093, 0, 0, 468, 1647, 0, 0, 0,
+	0, 0, 4102, 4094, 4095, 4096, 4100, 4101, 4098, 0,
+	4099, 0, 4103, 0, 0, 0, 0, 76, 0, 77,
+	0, 466, 0, 0, 0, 0, 0, 0, 0, 4125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2181,
+	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	154, 58, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 155, 1061, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 154, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 643, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 154, 0, 0, 0, 0, 0, 0, 0,
+	0, 4104, 4092, 0, 64, 65, 71, 0, 72, 0,
+	0, 57, 59, 0, 0, 0, 2181, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 154, 154, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 466, 0, 0, 0, 0, 0, 0,
+	0, 466, 466, 466, 0, 0, 0, 466, 154, 4009,
+	0, 0, 466, 0, 0, 0, 0, 0, 0, 0,
+	0, 1660, 1663, 1664, 1665, 1666, 1667, 1668, 0, 1669,
+	1670, 1671, 1672, 1673, 1674, 1675, 1676, 1677, 1678, 1679,
+	1680, 1681, 0, 1648, 1649, 1650, 1627, 1631, 1661, 1628,
+	1634, 1630, 1632, 1629, 0, 643, 1635, 1636, 1637, 1638,
+	1639, 1640, 1641, 1642, 1643, 1644, 1645, 1652, 1653, 1654,
+	1655, 1656, 1657, 1658, 1659, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2181, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3065, 3065, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2181,
+	57, 59, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2178, 0, 0, 0, 0,
+	0, 2181, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 425, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 340, 1662, 0, 0, 0, 1465,
+	0, 0, 0, 249, 0, 0, 0, 1651, 0, 0,
+	0, 248, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 167, 453, 185, 0, 300, 0, 471,
+	451, 404, 322, 474, 473, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1466, 0, 1467, 0, 0, 0, 0, 0,
+	0, 166, 0, 0, 0, 0, 0, 472, 0, 0,
+	0, 1461, 1462, 1460, 0, 0, 0, 0, 0, 0,
+	154, 0, 253, 0, 0, 0, 0, 0, 0, 0,
+	1463, 0, 0, 0, 0, 445, 0, 0, 0, 0,
+	359, 272, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	154, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 466,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	323, 0, 466, 0, 0, 289, 186, 0, 0, 0,
+	155, 0, 0, 0, 297, 0, 190, 0, 0, 0,
+	336, 0, 0, 172, 193, 299, 0, 0, 0, 236,
+	0, 373, 0, 444, 470, 267, 0, 372, 301, 437,
+	0, 0, 443, 0, 419, 452, 457, 261, 0, 226,
+	401, 251, 245, 0, 0, 0, 273, 358, 240, 293,
+	0, 0, 0, 232, 0, 0, 0, 400, 434, 195,
+	317, 435, 456, 161, 262, 392, 263, 418, 254, 227,
+	361, 214, 426, 318, 328, 229, 231, 230, 208, 393,
+	433, 220, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 274, 0, 0, 0, 344, 217, 0,
+	0, 0, 0, 447, 0, 247, 0, 449, 174, 387,
+	386, 0, 281, 154, 176, 165, 368, 177, 290, 199,
+	0, 460, 213, 295, 427, 469, 266, 335, 0, 345,
+	192, 363, 313, 315, 312, 316, 271, 170, 178, 0,
+	365, 389, 432, 215, 407, 168, 171, 180, 378, 181,
+	182, 0, 307, 256, 260, 275, 286, 0, 371, 408,
+	450, 0, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	466, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 466, 0,
+	0, 0, 375, 409, 423, 380, 269, 411, 415, 412,
+	413, 410, 414, 376, 377, 202, 417, 442, 221, 388,
+	391, 459, 0, 209, 204, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 0, 440, 441, 237, 0,
+	0, 205, 0, 0, 332, 339, 331, 0, 0, 436,
+	0, 0, 0, 0, 0, 0, 0, 0, 296, 259,
+	277, 356, 303, 357, 278, 326, 325, 327, 305, 466,
+	406, 306, 466, 200, 0, 405, 0, 0, 416, 218,
+	0, 0, 431, 173, 364, 219, 268, 257, 355, 330,
+	211, 280, 403, 294, 302, 0, 0, 343, 374, 225,
+	446, 402, 252, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 183, 184, 169, 0,
+	0, 0, 233, 159, 0, 0, 0, 160, 0, 0,
+	162, 163, 0, 0, 0, 0, 164, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 351, 201, 212, 430, 224, 244,
+	242, 258, 291, 314, 320, 349, 390, 396, 397, 420,
+	421, 422, 424, 246, 0, 250, 223, 369, 222, 304,
+	283, 350, 428, 429, 360, 239, 0, 194, 206, 298,
+	367, 265, 319, 394, 321, 287, 238, 458, 324, 366,
+	461, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 329, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 466,
+	0, 0, 0, 0, 0, 0, 0, 0, 448, 243,
+	0, 0, 0, 207, 216, 228, 241, 255, 0, 264,
+	276, 279, 284, 285, 288, 292, 308, 309, 310, 311,
+	333, 334, 337, 338, 341, 342, 346, 347, 348, 352,
+	353, 354, 362, 179, 370, 379, 381, 382, 383, 384,
+	385, 395, 398, 399, 438, 439, 454, 455, 0, 191,
+	0, 0, 197, 0, 198, 0, 0, 196, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 466, 0, 0, 0, 0,
+	0, 879, 1019, 0, 0, 425, 781, 1023, 866, 889,
+	1032, 895, 897, 962, 841, 937, 340, 886, 842, 0,
+	0, 833, 686, 834, 867, 249, 685, 995, 940, 1021,
+	923, 955, 965, 248, 235, 930, 929, 1010, 878, 877,
+	960, 1006, 1020, 0, 0, 167, 453, 185, 789, 300,
+	0, 154, 451, 404, 322, 0, 0, 921, 0, 772,
+	773, 906, 964, 853, 951, 1025, 887, 956, 1026, 98,
+	0, 0, 0, 0, 530, 710, 709, 712, 713, 714,
+	715, 0, 0, 166, 711, 697, 716, 717, 718, 0,
+	916, 0, 1035, 961, 1037, 832, 683, 701, 837, 788,
+	4185, 1011, 874, 875, 253, 0, 0, 0, 0, 0,
+	0, 0, 919, 936, 980, 903, 0, 445, 967, 976,
+	990, 896, 359, 272, 0, 0, 0, 0, 698, 699,
+	0, 0, 0, 0, 803, 466, 700, 0, 847, 695,
+	730, 731, 732, 733, 734, 735, 736, 737, 738, 739,
+	740, 741, 742, 743, 744, 745, 746, 747, 748, 749,
+	750, 751, 752, 753, 754, 755, 756, 757, 758, 759,
+	760, 761, 762, 763, 764, 765, 766, 767, 768, 769,
+	770, 771, 702, 0, 0, 0, 852, 830, 872, 982,
+	831, 829, 323, 844, 776, 1009, 904, 289, 186, 1015,
+	902, 801, 970, 848, 999, 890, 297, 846, 190, 843,
+	849, 888, 336, 979, 985, 786, 193, 299, 996, 868,
+	881, 236, 0, 373, 957, 444, 689, 267, 943, 372,
+	301, 437, 971, 1017, 443, 891, 419, 452, 457, 261,
+	924, 226, 401, 251, 245, 873, 989, 836, 273, 358,
+	240, 293, 907, 963, 869, 232, 974, 950, 1001, 400,
+	434, 195, 317, 435, 456, 161, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 997, 984, 1003, 864, 850,
+	856, 851, 880, 1018, 282, 274, 1004, 1002, 882, 344,
+	217, 934, 927, 920, 790, 447, 1033, 247, 986, 449,
+	174, 387, 386, 894, 281, 987, 176, 165, 368, 177,
+	290, 199, 1005, 460, 213, 295, 427, 688, 266, 335,
+	959, 345, 192, 363, 313, 315, 312, 316, 271, 170,
+	178, 983, 365, 389, 432, 215, 407, 168, 171, 180,
+	378, 181, 182, 1024, 307, 256, 260, 275, 286, 958,
+	371, 408, 450, 952, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 981, 209, 204, 1013, 998, 945,
+	909, 915, 838, 0, 203, 910, 911, 912, 913, 914,
+	977, 871, 883, 863, 953, 862, 270, 969, 440, 441,
+	237, 779, 1028, 205, 845, 1027, 332, 339, 331, 1030,
+	1029, 436, 1014, 946, 933, 931, 839, 1012, 944, 932,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 935, 406, 306, 0, 200, 0, 405, 1022, 1039,
+	416, 218, 857, 991, 431, 173, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 973, 1036, 343,
+	374, 225, 446, 402, 252, 855, 1040, 802, 791, 792,
+	795, 938, 939, 793, 796, 797, 804, 777, 778, 780,
+	782, 783, 784, 926, 1016, 840, 787, 994, 798, 799,
+	800, 966, 1034, 775, 233, 723, 816, 817, 818, 724,
+	819, 820, 725, 726, 821, 822, 823, 824, 727, 825,
+	826, 827, 805, 806, 807, 808, 809, 810, 811, 812,
+	815, 813, 814, 0, 922, 774, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 785, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 992, 949, 0, 899, 901, 900, 859,
+	861, 860, 858, 1038, 329, 1008, 828, 835, 854, 865,
+	870, 876, 884, 885, 893, 898, 908, 917, 918, 928,
+	941, 942, 948, 972, 975, 988, 993, 1000, 0, 0,
+	448, 243, 925, 947, 978, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 179, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	905, 191, 0, 0, 197, 0, 198, 0, 892, 196,
+	1007, 1031, 954, 968, 879, 1019, 0, 0, 425, 781,
+	1023, 866, 889, 1032, 895, 897, 962, 841, 937, 340,
+	886, 842, 0, 0, 833, 686, 834, 867, 249, 685,
+	995, 940, 1021, 923, 955, 965, 248, 235, 930, 929,
+	1010, 878, 877, 960, 1006, 1020, 0, 0, 167, 453,
+	185, 789, 300, 0, 0, 451, 404, 322, 0, 0,
+	921, 0, 772, 773, 906, 964, 853, 951, 1025, 887,
+	956, 1026, 98, 0, 1429, 0, 0, 530, 710, 709,
+	712, 713, 714, 715, 0, 0, 166, 711, 697, 716,
+	717, 718, 0, 916, 0, 1035, 961, 1037, 832, 683,
+	701, 837, 788, 0, 1011, 874, 875, 253, 0, 0,
+	0, 0, 0, 0, 0, 919, 936, 980, 903, 0,
+	445, 967, 976, 990, 896, 359, 272, 0, 0, 0,
+	0, 698, 699, 0, 0, 0, 0, 803, 0, 700,
+	0, 847, 695, 730, 731, 732, 733, 734, 735, 736,
+	737, 738, 739, 740, 741, 742, 743, 744, 745, 746,
+	747, 748, 749, 750, 751, 752, 753, 754, 755, 756,
+	757, 758, 759, 760, 761, 762, 763, 764, 765, 766,
+	767, 768, 769, 770, 771, 702, 0, 0, 0, 852,
+	830, 872, 982, 831, 829, 323, 844, 776, 1009, 904,
+	289, 186, 1015, 902, 801, 970, 848, 999, 890, 297,
+	846, 190, 843, 849, 888, 336, 979, 985, 786, 193,
+	299, 996, 868, 881, 236, 0, 373, 957, 444, 689,
+	267, 943, 372, 301, 437, 971, 1017, 443, 891, 419,
+	452, 457, 261, 924, 226, 401, 251, 245, 873, 989,
+	836, 273, 358, 240, 293, 907, 963, 869, 232, 974,
+	950, 1001, 400, 434, 195, 317, 435, 456, 161, 262,
+	392, 263, 418, 254, 227, 361, 214, 426, 318, 328,
+	229, 231, 230, 208, 393, 433, 220, 234, 997, 984,
+	1003, 864, 850, 856, 851, 880, 1018, 282, 274, 1004,
+	1002, 882, 344, 217, 934, 927, 920, 790, 447, 1033,
+	247, 986, 449, 174, 387, 386, 894, 281, 987, 176,
+	165, 368, 177, 290, 199, 1005, 460, 213, 295, 427,
+	688, 266, 335, 959, 345, 192, 363, 313, 315, 312,
+	316, 271, 170, 178, 983, 365, 389, 432, 215, 407,
+	168, 171, 180, 378, 181, 182, 1024, 307, 256, 260,
+	275, 286, 958, 371, 408, 450, 952, 210, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 375, 409, 423,
+	380, 269, 411, 415, 412, 413, 410, 414, 376, 377,
+	202, 417, 442, 221, 388, 391, 459, 981, 209, 204,
+	1013, 998, 945, 909, 915, 838, 0, 203, 910, 911,
+	912, 913, 914, 977, 871, 883, 863, 953, 862, 270,
+	969, 440, 441, 237, 779, 1028, 205, 845, 1027, 332,
+	339, 331, 1030, 1029, 436, 1014, 946, 933, 931, 839,
+	1012, 944, 932, 296, 259, 277, 356, 303, 357, 278,
+	326, 325, 327, 305, 935, 406, 306, 0, 200, 0,
+	405, 1022, 1039, 416, 218, 857, 991, 431, 173, 364,
+	219, 268, 257, 355, 330, 211, 280, 403, 294, 302,
+	973, 1036, 343, 374, 225, 446, 402, 252, 855, 1040,
+	802, 791, 792, 795, 938, 939, 793, 796, 797, 804,
+	777, 778, 780, 782, 783, 784, 926, 1016, 840, 787,
+	994, 798, 799, 800, 966, 1034, 775, 233, 723, 816,
+	817, 818, 724, 819, 820, 725, 726, 821, 822, 823,
+	824, 727, 825, 826, 827, 805, 806, 807, 808, 809,
+	810, 811, 812, 815, 813, 814, 0, 922, 774, 351,
+	201, 212, 430, 224, 244, 242, 258, 291, 314, 320,
+	349, 390, 396, 397, 420, 421, 422, 424, 246, 0,
+	250, 223, 369, 222, 304, 283, 350, 428, 429, 360,
+	239, 785, 194, 206, 298, 367, 265, 319, 394, 321,
+	287, 238, 458, 324, 366, 461, 992, 949, 0, 899,
+	901, 900, 859, 861, 860, 858, 1038, 329, 1008, 828,
+	835, 854, 865, 870, 876, 884, 885, 893, 898, 908,
+	917, 918, 928, 941, 942, 948, 972, 975, 988, 993,
+	1000, 0, 0, 448, 243, 925, 947, 978, 207, 216,
+	228, 241, 255, 0, 264, 276, 279, 284, 285, 288,
+	292, 308, 309, 310, 311, 333, 334, 337, 338, 341,
+	342, 346, 347, 348, 352, 353, 354, 362, 179, 370,
+	379, 381, 382, 383, 384, 385, 395, 398, 399, 438,
+	439, 454, 455, 905, 191, 0, 0, 197, 0, 198,
+	0, 892, 196, 1007, 1031, 954, 968, 879, 1019, 0,
+	0, 425, 781, 1023, 866, 889, 1032, 895, 897, 962,
+	841, 937, 340, 886, 842, 0, 0, 833, 686, 834,
+	867, 249, 685, 995, 940, 1021, 923, 955, 965, 248,
+	235, 930, 929, 1010, 878, 877, 960, 1006, 1020, 0,
+	0, 167, 453, 185, 789, 300, 0, 0, 451, 404,
+	322, 0, 0, 921, 0, 772, 773, 906, 964, 853,
+	951, 1025, 887, 956, 1026, 98, 0, 0, 0, 0,
+	530, 710, 709, 712, 713, 714, 715, 0, 0, 166,
+	711, 697, 716, 717, 718, 0, 916, 0, 1035, 961,
+	1037, 832, 683, 701, 837, 788, 0, 1011, 874, 875,
+	253, 0, 0, 0, 0, 0, 0, 0, 919, 936,
+	980, 903, 0, 445, 967, 976, 990, 896, 359, 272,
+	0, 0, 0, 0, 698, 699, 2225, 0, 0, 0,
+	803, 0, 700, 0, 847, 695, 730, 731, 732, 733,
+	734, 735, 736, 737, 738, 739, 740, 741, 742, 743,
+	744, 745, 746, 747, 748, 749, 750, 751, 752, 753,
+	754, 755, 756, 757, 758, 759, 760, 761, 762, 763,
+	764, 765, 766, 767, 768, 769, 770, 771, 702, 0,
+	0, 0, 852, 830, 872, 982, 831, 829, 323, 844,
+	776, 1009, 904, 289, 186, 1015, 902, 801, 970, 848,
+	999, 890, 297, 846, 190, 843, 849, 888, 336, 979,
+	985, 786, 193, 299, 996, 868, 881, 236, 0, 373,
+	957, 444, 689, 267, 943, 372, 301, 437, 971, 1017,
+	443, 891, 419, 452, 457, 261, 924, 226, 401, 251,
+	245, 873, 989, 836, 273, 358, 240, 293, 907, 963,
+	869, 232, 974, 950, 1001, 400, 434, 195, 317, 435,
+	456, 161, 262, 392, 263, 418, 254, 227, 361, 214,
+	426, 318, 328, 229, 231, 230, 208, 393, 433, 220,
+	234, 997, 984, 1003, 864, 850, 856, 851, 880, 1018,
+	282, 274, 1004, 1002, 882, 344, 217, 934, 927, 920,
+	790, 447, 1033, 247, 986, 449, 174, 387, 386, 894,
+	281, 987, 176, 165, 368, 177, 290, 199, 1005, 460,
+	213, 295, 427, 688, 266, 335, 959, 345, 192, 363,
+	313, 315, 312, 316, 271, 170, 178, 983, 365, 389,
+	432, 215, 407, 168, 171, 180, 378, 181, 182, 1024,
+	307, 256, 260, 275, 286, 958, 371, 408, 450, 952,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	375, 409, 423, 380, 269, 411, 415, 412, 413, 410,
+	414, 376, 377, 202, 417, 442, 221, 388, 391, 459,
+	981, 209, 204, 1013, 998, 945, 909, 915, 838, 0,
+	203, 910, 911, 912, 913, 914, 977, 871, 883, 863,
+	953, 862, 270, 969, 440, 441, 237, 779, 1028, 205,
+	845, 1027, 332, 339, 331, 1030, 1029, 436, 1014, 946,
+	933, 931, 839, 1012, 944, 932, 296, 259, 277, 356,
+	303, 357, 278, 326, 325, 327, 305, 935, 406, 306,
+	0, 200, 0, 405, 1022, 1039, 416, 218, 857, 991,
+	431, 173, 364, 219, 268, 257, 355, 330, 211, 280,
+	403, 294, 302, 973, 1036, 343, 374, 225, 446, 402,
+	252, 855, 1040, 802, 791, 792, 795, 938, 939, 793,
+	796, 797, 804, 777, 778, 780, 782, 783, 784, 926,
+	1016, 840, 787, 994, 798, 799, 800, 966, 1034, 775,
+	233, 723, 816, 817, 818, 724, 819, 820, 725, 726,
+	821, 822, 823, 824, 727, 825, 826, 827, 805, 806,
+	807, 808, 809, 810, 811, 812, 815, 813, 814, 0,
+	922, 774, 351, 201, 212, 430, 224, 244, 242, 258,
+	291, 314, 320, 349, 390, 396, 397, 420, 421, 422,
+	424, 246, 0, 250, 223, 369, 222, 304, 283, 350,
+	428, 429, 360, 239, 785, 194, 206, 298, 367, 265,
+	319, 394, 321, 287, 238, 458, 324, 366, 461, 992,
+	949, 0, 899, 901, 900, 859, 861, 860, 858, 1038,
+	329, 1008, 828, 835, 854, 865, 870, 876, 884, 885,
+	893, 898, 908, 917, 918, 928, 941, 942, 948, 972,
+	975, 988, 993, 1000, 0, 0, 448, 243, 925, 947,
+	978, 207, 216, 228, 241, 255, 0, 264, 276, 279,
+	284, 285, 288, 292, 308, 309, 310, 311, 333, 334,
+	337, 338, 341, 342, 346, 347, 348, 352, 353, 354,
+	362, 179, 370, 379, 381, 382, 383, 384, 385, 395,
+	398, 399, 438, 439, 454, 455, 905, 191, 0, 0,
+	197, 0, 198, 0, 892, 196, 1007, 1031, 954, 968,
+	879, 1019, 0, 0, 425, 781, 1023, 866, 889, 1032,
+	895, 897, 962, 841, 937, 340, 886, 842, 0, 0,
+	833, 686, 834, 867, 249, 685, 995, 940, 1021, 923,
+	955, 965, 248, 235, 930, 929, 1010, 878, 877, 960,
+	1006, 1020, 0, 0, 167, 453, 185, 789, 300, 0,
+	0, 451, 404, 322, 0, 0, 921, 0, 772, 773,
+	906, 964, 853, 951, 1025, 887, 956, 1026, 98, 0,
+	0, 0, 0, 530, 710, 709, 712, 713, 714, 715,
+	0, 0, 166, 711, 697, 716, 717, 718, 0, 916,
+	0, 1035, 961, 1037, 832, 683, 701, 837, 788, 0,
+	1011, 874, 875, 253, 0, 0, 0, 0, 0, 0,
+	0, 919, 936, 980, 903, 0, 445, 967, 976, 990,
+	896, 359, 272, 0, 0, 0, 0, 698, 699, 679,
+	0, 0, 0, 803, 0, 700, 0, 847, 695, 730,
+	731, 732, 733, 734, 735, 736, 737, 738, 739, 740,
+	741, 742, 743, 744, 745, 746, 747, 748, 749, 750,
+	751, 752, 753, 754, 755, 756, 757, 758, 759, 760,
+	761, 762, 763, 764, 765, 766, 767, 768, 769, 770,
+	771, 702, 0, 0, 0, 852, 830, 872, 982, 831,
+	829, 323, 844, 776, 1009, 904, 289, 186, 1015, 902,
+	801, 970, 848, 999, 890, 297, 846, 190, 843, 849,
+	888, 336, 979, 985, 786, 193, 299, 996, 868, 881,
+	236, 0, 373, 957, 444, 689, 267, 943, 372, 301,
+	437, 971, 1017, 443, 891, 419, 452, 457, 261, 924,
+	226, 401, 251, 245, 873, 989, 836, 273, 358, 240,
+	293, 907, 963, 869, 232, 974, 950, 1001, 400, 434,
+	195, 317, 435, 456, 161, 262, 392, 263, 418, 254,
+	227, 361, 214, 426, 318, 328, 229, 231, 230, 208,
+	393, 433, 220, 234, 997, 984, 1003, 864, 850, 856,
+	851, 880, 1018, 282, 274, 1004, 1002, 882, 344, 217,
+	934, 927, 920, 790, 447, 1033, 247, 986, 449, 174,
+	387, 386, 894, 281, 987, 176, 165, 368, 177, 290,
+	199, 1005, 460, 213, 295, 427, 688, 266, 335, 959,
+	345, 192, 363, 313, 315, 312, 316, 271, 170, 178,
+	983, 365, 389, 432, 215, 407, 168, 171, 180, 378,
+	181, 182, 1024, 307, 256, 260, 275, 286, 958, 371,
+	408, 450, 952, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 375, 409, 423, 380, 269, 411, 415,
+	412, 413, 410, 414, 376, 377, 202, 417, 442, 221,
+	388, 391, 459, 981, 209, 204, 1013, 998, 945, 909,
+	915, 838, 0, 203, 910, 911, 912, 913, 914, 977,
+	871, 883, 863, 953, 862, 270, 969, 440, 441, 237,
+	779, 1028, 205, 845, 1027, 332, 339, 331, 1030, 1029,
+	436, 1014, 946, 933, 931, 839, 1012, 944, 932, 296,
+	259, 277, 356, 303, 357, 278, 326, 325, 327, 305,
+	935, 406, 306, 0, 200, 0, 405, 1022, 1039, 416,
+	218, 857, 991, 431, 173, 364, 219, 268, 257, 355,
+	330, 211, 280, 403, 294, 302, 973, 1036, 343, 374,
+	225, 446, 402, 252, 855, 1040, 802, 791, 792, 795,
+	938, 939, 793, 796, 797, 804, 777, 778, 780, 782,
+	783, 784, 926, 1016, 840, 787, 994, 798, 799, 800,
+	966, 1034, 775, 233, 723, 816, 817, 818, 724, 819,
+	820, 725, 726, 821, 822, 823, 824, 727, 825, 826,
+	827, 805, 806, 807, 808, 809, 810, 811, 812, 815,
+	813, 814, 0, 922, 774, 351, 201, 212, 430, 224,
+	244, 242, 258, 291, 314, 320, 349, 390, 396, 397,
+	420, 421, 422, 424, 246, 0, 250, 223, 369, 222,
+	304, 283, 350, 428, 429, 360, 239, 785, 194, 206,
+	298, 367, 265, 319, 394, 321, 287, 238, 458, 324,
+	366, 461, 992, 949, 0, 899, 901, 900, 859, 861,
+	860, 858, 1038, 329, 1008, 828, 835, 854, 865, 870,
+	876, 884, 885, 893, 898, 908, 917, 918, 928, 941,
+	942, 948, 972, 975, 988, 993, 1000, 0, 0, 448,
+	243, 925, 947, 978, 207, 216, 228, 241, 255, 0,
+	264, 276, 279, 284, 285, 288, 292, 308, 309, 310,
+	311, 333, 334, 337, 338, 341, 342, 346, 347, 348,
+	352, 353, 354, 362, 179, 370, 379, 381, 382, 383,
+	384, 385, 395, 398, 399, 438, 439, 454, 455, 905,
+	191, 0, 0, 197, 0, 198, 0, 892, 196, 1007,
+	1031, 954, 968, 879, 1019, 0, 0, 425, 781, 1023,
+	866, 889, 1032, 895, 897, 962, 841, 937, 340, 886,
+	842, 0, 0, 833, 686, 834, 867, 249, 685, 995,
+	940, 1021, 923, 955, 965, 248, 235, 930, 929, 1010,
+	878, 877, 960, 1006, 1020, 0, 0, 167, 453, 185,
+	789, 300, 0, 0, 451, 404, 322, 0, 0, 921,
+	0, 772, 773, 906, 964, 853, 951, 1025, 887, 2438,
+	1026, 98, 0, 0, 0, 0, 530, 710, 2440, 712,
+	713, 714, 715, 0, 0, 166, 711, 697, 716, 717,
+	718, 2439, 916, 0, 1035, 961, 1037, 832, 683, 701,
+	837, 788, 0, 1011, 874, 875, 253, 0, 0, 0,
+	0, 0, 0, 0, 919, 936, 980, 903, 0, 445,
+	967, 976, 990, 896, 359, 272, 0, 0, 0, 0,
+	698, 699, 0, 0, 0, 0, 803, 0, 700, 0,
+	847, 695, 730, 731, 732, 733, 734, 735, 736, 737,
+	738, 739, 740, 741, 742, 743, 744, 745, 746, 747,
+	748, 749, 750, 751, 752, 753, 754, 755, 756, 757,
+	758, 759, 760, 761, 762, 763, 764, 765, 766, 767,
+	768, 769, 770, 771, 702, 0, 0, 0, 852, 830,
+	872, 982, 831, 829, 323, 844, 776, 1009, 904, 289,
+	186, 1015, 902, 801, 970, 848, 999, 890, 297, 846,
+	190, 843, 849, 888, 336, 979, 985, 786, 193, 299,
+	996, 868, 881, 236, 0, 373, 957, 444, 689, 267,
+	943, 372, 301, 437, 971, 1017, 443, 891, 419, 452,
+	457, 261, 924, 226, 401, 251, 245, 873, 989, 836,
+	273, 358, 240, 293, 907, 963, 869, 232, 974, 950,
+	1001, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 997, 984, 1003,
+	864, 850, 856, 851, 880, 1018, 282, 274, 1004, 1002,
+	882, 344, 217, 934, 927, 920, 790, 447, 1033, 247,
+	986, 449, 174, 387, 386, 894, 281, 987, 176, 165,
+	368, 177, 290, 199, 1005, 460, 213, 295, 427, 688,
+	266, 335, 959, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 983, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 1024, 307, 256, 260, 275,
+	286, 958, 371, 408, 450, 952, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 981, 209, 204, 1013,
+	998, 945, 909, 915, 838, 0, 203, 910, 911, 912,
+	913, 914, 977, 871, 883, 863, 953, 862, 270, 969,
+	440, 441, 237, 779, 1028, 205, 845, 1027, 332, 339,
+	331, 1030, 1029, 436, 1014, 946, 933, 931, 839, 1012,
+	944, 932, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 935, 406, 306, 0, 200, 0, 405,
+	1022, 1039, 416, 218, 857, 991, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 973,
+	1036, 343, 374, 225, 446, 402, 252, 855, 1040, 802,
+	791, 792, 795, 938, 939, 793, 796, 797, 804, 777,
+	778, 780, 782, 783, 784, 926, 1016, 840, 787, 994,
+	798, 799, 800, 966, 1034, 775, 233, 723, 816, 817,
+	818, 724, 819, 820, 725, 726, 821, 822, 823, 824,
+	727, 825, 826, 827, 805, 806, 807, 808, 809, 810,
+	811, 812, 815, 813, 814, 0, 922, 774, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	785, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 992, 949, 0, 899, 901,
+	900, 859, 861, 860, 858, 1038, 329, 1008, 828, 835,
+	854, 865, 870, 876, 884, 885, 893, 898, 908, 917,
+	918, 928, 941, 942, 948, 972, 975, 988, 993, 1000,
+	0, 0, 448, 243, 925, 947, 978, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 905, 191, 0, 0, 197, 0, 198, 0,
+	892, 196, 1007, 1031, 954, 968, 879, 1019, 0, 0,
+	425, 781, 1023, 866, 889, 1032, 895, 897, 962, 841,
+	937, 340, 886, 842, 0, 0, 833, 686, 834, 867,
+	249, 685, 995, 940, 1021, 923, 955, 965, 248, 235,
+	930, 929, 1010, 878, 877, 960, 1006, 1020, 0, 0,
+	167, 453, 185, 789, 300, 0, 0, 451, 404, 322,
+	0, 0, 921, 0, 772, 773, 906, 964, 853, 951,
+	1025, 887, 956, 1026, 98, 0, 0, 0, 0, 530,
+	710, 2342, 712, 713, 714, 715, 0, 0, 166, 711,
+	697, 716, 717, 718, 0, 916, 0, 1035, 961, 1037,
+	832, 683, 701, 837, 788, 0, 1011, 874, 875, 253,
+	0, 0, 0, 0, 0, 0, 0, 919, 936, 980,
+	903, 0, 445, 967, 976, 990, 896, 359, 272, 0,
+	0, 0, 0, 698, 699, 2225, 0, 0, 0, 803,
+	0, 700, 0, 847, 695, 730, 731, 732, 733, 734,
+	735, 736, 737, 738, 739, 740, 741, 742, 743, 744,
+	745, 746, 747, 748, 749, 750, 751, 752, 753, 754,
+	755, 756, 757, 758, 759, 760, 761, 762, 763, 764,
+	765, 766, 767, 768, 769, 770, 771, 702, 0, 0,
+	0, 852, 830, 872, 982, 831, 829, 323, 844, 776,
+	1009, 904, 289, 186, 1015, 902, 801, 970, 848, 999,
+	890, 297, 846, 190, 843, 849, 888, 336, 979, 985,
+	786, 193, 299, 996, 868, 881, 236, 0, 373, 957,
+	444, 689, 267, 943, 372, 301, 437, 971, 1017, 443,
+	891, 419, 452, 457, 261, 924, 226, 401, 251, 245,
+	873, 989, 836, 273, 358, 240, 293, 907, 963, 869,
+	232, 974, 950, 1001, 400, 434, 195, 317, 435, 456,
+	161, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	997, 984, 1003, 864, 850, 856, 851, 880, 1018, 282,
+	274, 1004, 1002, 882, 344, 217, 934, 927, 920, 790,
+	447, 1033, 247, 986, 449, 174, 387, 386, 894, 281,
+	987, 176, 165, 368, 177, 290, 199, 1005, 460, 213,
+	295, 427, 688, 266, 335, 959, 345, 192, 363, 313,
+	315, 312, 316, 271, 170, 178, 983, 365, 389, 432,
+	215, 407, 168, 171, 180, 378, 181, 182, 1024, 307,
+	256, 260, 275, 286, 958, 371, 408, 450, 952, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 981,
+	209, 204, 1013, 998, 945, 909, 915, 838, 0, 203,
+	910, 911, 912, 913, 914, 977, 871, 883, 863, 953,
+	862, 270, 969, 440, 441, 237, 779, 1028, 205, 845,
+	1027, 332, 339, 331, 1030, 1029, 436, 1014, 946, 933,
+	931, 839, 1012, 944, 932, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 935, 406, 306, 0,
+	200, 0, 405, 1022, 1039, 416, 218, 857, 991, 431,
+	173, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 973, 1036, 343, 374, 225, 446, 402, 252,
+	855, 1040, 802, 791, 792, 795, 938, 939, 793, 796,
+	797, 804, 777, 778, 780, 782, 783, 784, 926, 1016,
+	840, 787, 994, 798, 799, 800, 966, 1034, 775, 233,
+	723, 816, 817, 818, 724, 819, 820, 725, 726, 821,
+	822, 823, 824, 727, 825, 826, 827, 805, 806, 807,
+	808, 809, 810, 811, 812, 815, 813, 814, 0, 922,
+	774, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 785, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 992, 949,
+	0, 899, 901, 900, 859, 861, 860, 858, 1038, 329,
+	1008, 828, 835, 854, 865, 870, 876, 884, 885, 893,
+	898, 908, 917, 918, 928, 941, 942, 948, 972, 975,
+	988, 993, 1000, 0, 0, 448, 243, 925, 947, 978,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	179, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 905, 191, 0, 0, 197,
+	0, 198, 0, 892, 196, 1007, 1031, 954, 968, 879,
+	1019, 0, 0, 425, 781, 1023, 866, 889, 1032, 895,
+	897, 962, 841, 937, 340, 886, 842, 0, 0, 833,
+	686, 834, 867, 249, 685, 995, 940, 1021, 923, 955,
+	965, 248, 235, 930, 929, 1010, 878, 877, 960, 1006,
+	1020, 0, 0, 167, 453, 185, 789, 300, 0, 0,
+	451, 404, 322, 0, 0, 921, 0, 772, 773, 906,
+	964, 853, 951, 1025, 887, 956, 1026, 98, 0, 0,
+	0, 0, 530, 710, 2339, 712, 713, 714, 715, 0,
+	0, 166, 711, 697, 716, 717, 718, 0, 916, 0,
+	1035, 961, 1037, 832, 683, 701, 837, 788, 0, 1011,
+	874, 875, 253, 0, 0, 0, 0, 0, 0, 0,
+	919, 936, 980, 903, 0, 445, 967, 976, 990, 896,
+	359, 272, 0, 0, 0, 0, 698, 699, 2225, 0,
+	0, 0, 803, 0, 700, 0, 847, 695, 730, 731,
+	732, 733, 734, 735, 736, 737, 738, 739, 740, 741,
+	742, 743, 744, 745, 746, 747, 748, 749, 750, 751,
+	752, 753, 754, 755, 756, 757, 758, 759, 760, 761,
+	762, 763, 764, 765, 766, 767, 768, 769, 770, 771,
+	702, 0, 0, 0, 852, 830, 872, 982, 831, 829,
+	323, 844, 776, 1009, 904, 289, 186, 1015, 902, 801,
+	970, 848, 999, 890, 297, 846, 190, 843, 849, 888,
+	336, 979, 985, 786, 193, 299, 996, 868, 881, 236,
+	0, 373, 957, 444, 689, 267, 943, 372, 301, 437,
+	971, 1017, 443, 891, 419, 452, 457, 261, 924, 226,
+	401, 251, 245, 873, 989, 836, 273, 358, 240, 293,
+	907, 963, 869, 232, 974, 950, 1001, 400, 434, 195,
+	317, 435, 456, 161, 262, 392, 263, 418, 254, 227,
+	361, 214, 426, 318, 328, 229, 231, 230, 208, 393,
+	433, 220, 234, 997, 984, 1003, 864, 850, 856, 851,
+	880, 1018, 282, 274, 1004, 1002, 882, 344, 217, 934,
+	927, 920, 790, 447, 1033, 247, 986, 449, 174, 387,
+	386, 894, 281, 987, 176, 165, 368, 177, 290, 199,
+	1005, 460, 213, 295, 427, 688, 266, 335, 959, 345,
+	192, 363, 313, 315, 312, 316, 271, 170, 178, 983,
+	365, 389, 432, 215, 407, 168, 171, 180, 378, 181,
+	182, 1024, 307, 256, 260, 275, 286, 958, 371, 408,
+	450, 952, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 375, 409, 423, 380, 269, 411, 415, 412,
+	413, 410, 414, 376, 377, 202, 417, 442, 221, 388,
+	391, 459, 981, 209, 204, 1013, 998, 945, 909, 915,
+	838, 0, 203, 910, 911, 912, 913, 914, 977, 871,
+	883, 863, 953, 862, 270, 969, 440, 441, 237, 779,
+	1028, 205, 845, 1027, 332, 339, 331, 1030, 1029, 436,
+	1014, 946, 933, 931, 839, 1012, 944, 932, 296, 259,
+	277, 356, 303, 357, 278, 326, 325, 327, 305, 935,
+	406, 306, 0, 200, 0, 405, 1022, 1039, 416, 218,
+	857, 991, 431, 173, 364, 219, 268, 257, 355, 330,
+	211, 280, 403, 294, 302, 973, 1036, 343, 374, 225,
+	446, 402, 252, 855, 1040, 802, 791, 792, 795, 938,
+	939, 793, 796, 797, 804, 777, 778, 780, 782, 783,
+	784, 926, 1016, 840, 787, 994, 798, 799, 800, 966,
+	1034, 775, 233, 723, 816, 817, 818, 724, 819, 820,
+	725, 726, 821, 822, 823, 824, 727, 825, 826, 827,
+	805, 806, 807, 808, 809, 810, 811, 812, 815, 813,
+	814, 0, 922, 774, 351, 201, 212, 430, 224, 244,
+	242, 258, 291, 314, 320, 349, 390, 396, 397, 420,
+	421, 422, 424, 246, 0, 250, 223, 369, 222, 304,
+	283, 350, 428, 429, 360, 239, 785, 194, 206, 298,
+	367, 265, 319, 394, 321, 287, 238, 458, 324, 366,
+	461, 992, 949, 0, 899, 901, 900, 859, 861, 860,
+	858, 1038, 329, 1008, 828, 835, 854, 865, 870, 876,
+	884, 885, 893, 898, 908, 917, 918, 928, 941, 942,
+	948, 972, 975, 988, 993, 1000, 0, 0, 448, 243,
+	925, 947, 978, 207, 216, 228, 241, 255, 0, 264,
+	276, 279, 284, 285, 288, 292, 308, 309, 310, 311,
+	333, 334, 337, 338, 341, 342, 346, 347, 348, 352,
+	353, 354, 362, 179, 370, 379, 381, 382, 383, 384,
+	385, 395, 398, 399, 438, 439, 454, 455, 905, 191,
+	0, 0, 197, 0, 198, 0, 892, 196, 1007, 1031,
+	954, 968, 879, 1019, 0, 41, 425, 781, 1023, 866,
+	889, 1032, 895, 897, 962, 841, 937, 340, 886, 842,
+	0, 0, 833, 686, 834, 867, 249, 685, 995, 940,
+	1021, 923, 955, 965, 248, 235, 930, 929, 1010, 878,
+	877, 960, 1006, 1020, 0, 0, 167, 453, 185, 1526,
+	300, 0, 0, 451, 404, 322, 0, 0, 921, 0,
+	772, 773, 906, 964, 853, 951, 1025, 887, 956, 1026,
+	98, 0, 0, 0, 0, 530, 710, 709, 712, 713,
+	714, 715, 0, 0, 166, 711, 697, 716, 717, 718,
+	0, 916, 0, 1524, 961, 1037, 832, 683, 701, 837,
+	788, 0, 1011, 874, 875, 253, 0, 0, 0, 0,
+	0, 0, 0, 919, 936, 980, 903, 0, 445, 967,
+	976, 990, 896, 359, 272, 0, 0, 0, 0, 698,
+	699, 0, 0, 0, 0, 803, 0, 700, 0, 847,
+	695, 730, 731, 732, 733, 734, 735, 736, 737, 738,
+	739, 740, 741, 742, 743, 744, 745, 746, 747, 748,
+	749, 750, 751, 752, 753, 754, 755, 756, 757, 758,
+	759, 760, 761, 762, 763, 764, 765, 766, 767, 768,
+	769, 770, 771, 702, 0, 0, 0, 852, 830, 872,
+	982, 831, 829, 323, 844, 776, 1527, 904, 289, 186,
+	1015, 902, 801, 970, 848, 999, 890, 297, 846, 190,
+	843, 849, 888, 336, 979, 985, 786, 193, 299, 996,
+	868, 881, 236, 0, 373, 957, 444, 689, 267, 943,
+	372, 301, 437, 971, 1017, 443, 891, 419, 452, 457,
+	261, 924, 226, 401, 251, 245, 873, 989, 836, 273,
+	358, 240, 293, 907, 963, 869, 232, 974, 950, 1001,
+	400, 434, 195, 317, 435, 456, 161, 262, 392, 263,
+	418, 254, 227, 361, 214, 426, 318, 328, 229, 231,
+	230, 208, 393, 433, 220, 234, 997, 984, 1003, 864,
+	850, 856, 851, 880, 1018, 282, 274, 1004, 1002, 882,
+	344, 217, 934, 927, 920, 790, 447, 1033, 247, 986,
+	449, 174, 387, 386, 894, 281, 987, 176, 165, 368,
+	177, 290, 199, 1005, 460, 213, 295, 427, 688, 266,
+	335, 959, 345, 192, 363, 313, 315, 312, 316, 271,
+	170, 178, 983, 365, 389, 432, 215, 407, 168, 171,
+	180, 378, 181, 182, 1024, 307, 256, 260, 275, 286,
+	958, 371, 408, 450, 952, 210, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 375, 409, 423, 380, 269,
+	411, 415, 412, 413, 410, 414, 376, 377, 202, 417,
+	442, 221, 388, 391, 459, 981, 209, 204, 1013, 998,
+	945, 909, 915, 838, 0, 203, 910, 911, 912, 913,
+	914, 977, 871, 883, 863, 953, 862, 270, 969, 440,
+	441, 237, 779, 1028, 205, 845, 1027, 332, 339, 331,
+	1030, 1029, 436, 1014, 946, 933, 931, 839, 1012, 944,
+	932, 296, 259, 277, 356, 303, 357, 278, 326, 325,
+	327, 305, 935, 406, 306, 0, 200, 0, 405, 1022,
+	1039, 416, 218, 857, 991, 431, 173, 364, 219, 268,
+	257, 355, 330, 211, 280, 403, 294, 302, 973, 1036,
+	343, 374, 225, 446, 402, 252, 855, 1040, 802, 791,
+	792, 795, 938, 939, 793, 796, 797, 804, 777, 778,
+	780, 782, 783, 784, 926, 1016, 840, 787, 994, 798,
+	799, 800, 966, 1034, 775, 233, 723, 816, 817, 818,
+	724, 819, 820, 725, 726, 821, 822, 823, 824, 727,
+	825, 826, 827, 805, 806, 807, 808, 809, 810, 811,
+	812, 815, 813, 814, 0, 922, 774, 351, 201, 212,
+	430, 224, 244, 242, 258, 291, 314, 320, 349, 390,
+	396, 397, 420, 421, 422, 424, 246, 0, 250, 223,
+	369, 222, 304, 283, 350, 428, 429, 360, 239, 785,
+	194, 206, 298, 367, 265, 319, 394, 321, 287, 238,
+	458, 324, 366, 461, 992, 949, 0, 899, 901, 900,
+	859, 861, 860, 858, 1038, 329, 1008, 828, 835, 854,
+	865, 870, 876, 884, 885, 893, 898, 908, 917, 918,
+	928, 941, 942, 948, 972, 975, 988, 993, 1000, 0,
+	0, 448, 243, 925, 947, 978, 207, 216, 228, 241,
+	255, 0, 264, 276, 279, 284, 285, 288, 292, 308,
+	309, 310, 311, 333, 334, 337, 338, 341, 342, 346,
+	347, 348, 352, 353, 354, 362, 179, 370, 379, 381,
+	382, 383, 384, 385, 395, 398, 399, 438, 439, 454,
+	455, 905, 191, 0, 0, 197, 0, 198, 0, 892,
+	196, 1007, 1031, 954, 968, 879, 1019, 0, 0, 425,
+	781, 1023, 866, 889, 1032, 895, 897, 962, 841, 937,
+	340, 886, 842, 0, 0, 833, 686, 834, 867, 249,
+	685, 995, 940, 1021, 923, 955, 965, 248, 235, 930,
+	929, 1010, 878, 877, 960, 1006, 1020, 0, 0, 167,
+	453, 185, 789, 300, 0, 0, 451, 404, 322, 0,
+	0, 921, 0, 772, 773, 906, 964, 853, 951, 1025,
+	887, 956, 1026, 98, 0, 2088, 0, 0, 530, 710,
+	709, 712, 713, 714, 715, 0, 0, 166, 711, 697,
+	716, 717, 718, 0, 916, 0, 1035, 961, 1037, 832,
+	683, 701, 837, 788, 0, 1011, 874, 875, 253, 0,
+	0, 0, 0, 0, 0, 0, 919, 936, 980, 903,
+	0, 445, 967, 976, 990, 896, 359, 272, 0, 0,
+	0, 0, 698, 699, 0, 0, 0, 0, 803, 0,
+	700, 0, 847, 695, 730, 731, 732, 733, 734, 735,
+	736, 737, 738, 739, 740, 741, 742, 743, 744, 745,
+	746, 747, 748, 749, 750, 751, 752, 753, 754, 755,
+	756, 757, 758, 759, 760, 761, 762, 763, 764, 765,
+	766, 767, 768, 769, 770, 771, 702, 0, 0, 0,
+	852, 830, 872, 982, 831, 829, 323, 844, 776, 1009,
+	904, 289, 186, 1015, 902, 801, 970, 848, 999, 890,
+	297, 846, 190, 843, 849, 888, 336, 979, 985, 786,
+	193, 299, 996, 868, 881, 236, 0, 373, 957, 444,
+	689, 267, 943, 372, 301, 437, 971, 1017, 443, 891,
+	419, 452, 457, 261, 924, 226, 401, 251, 245, 873,
+	989, 836, 273, 358, 240, 293, 907, 963, 869, 232,
+	974, 950, 1001, 400, 434, 195, 317, 435, 456, 161,
+	262, 392, 263, 418, 254, 227, 361, 214, 426, 318,
+	328, 229, 231, 230, 208, 393, 433, 220, 234, 997,
+	984, 1003, 864, 850, 856, 851, 880, 1018, 282, 274,
+	1004, 1002, 882, 344, 217, 934, 927, 920, 790, 447,
+	1033, 247, 986, 449, 174, 387, 386, 894, 281, 987,
+	176, 165, 368, 177, 290, 199, 1005, 460, 213, 295,
+	427, 688, 266, 335, 959, 345, 192, 363, 313, 315,
+	312, 316, 271, 170, 178, 983, 365, 389, 432, 215,
+	407, 168, 171, 180, 378, 181, 182, 1024, 307, 256,
+	260, 275, 286, 958, 371, 408, 450, 952, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 375, 409,
+	423, 380, 269, 411, 415, 412, 413, 410, 414, 376,
+	377, 202, 417, 442, 221, 388, 391, 459, 981, 209,
+	204, 1013, 998, 945, 909, 915, 838, 0, 203, 910,
+	911, 912, 913, 914, 977, 871, 883, 863, 953, 862,
+	270, 969, 440, 441, 237, 779, 1028, 205, 845, 1027,
+	332, 339, 331, 1030, 1029, 436, 1014, 946, 933, 931,
+	839, 1012, 944, 932, 296, 259, 277, 356, 303, 357,
+	278, 326, 325, 327, 305, 935, 406, 306, 0, 200,
+	0, 405, 1022, 1039, 416, 218, 857, 991, 431, 173,
+	364, 219, 268, 257, 355, 330, 211, 280, 403, 294,
+	302, 973, 1036, 343, 374, 225, 446, 402, 252, 855,
+	1040, 802, 791, 792, 795, 938, 939, 793, 796, 797,
+	804, 777, 778, 780, 782, 783, 784, 926, 1016, 840,
+	787, 994, 798, 799, 800, 966, 1034, 775, 233, 723,
+	816, 817, 818, 724, 819, 820, 725, 726, 821, 822,
+	823, 824, 727, 825, 826, 827, 805, 806, 807, 808,
+	809, 810, 811, 812, 815, 813, 814, 0, 922, 774,
+	351, 201, 212, 430, 224, 244, 242, 258, 291, 314,
+	320, 349, 390, 396, 397, 420, 421, 422, 424, 246,
+	0, 250, 223, 369, 222, 304, 283, 350, 428, 429,
+	360, 239, 785, 194, 206, 298, 367, 265, 319, 394,
+	321, 287, 238, 458, 324, 366, 461, 992, 949, 0,
+	899, 901, 900, 859, 861, 860, 858, 1038, 329, 1008,
+	828, 835, 854, 865, 870, 876, 884, 885, 893, 898,
+	908, 917, 918, 928, 941, 942, 948, 972, 975, 988,
+	993, 1000, 0, 0, 448, 243, 925, 947, 978, 207,
+	216, 228, 241, 255, 0, 264, 276, 279, 284, 285,
+	288, 292, 308, 309, 310, 311, 333, 334, 337, 338,
+	341, 342, 346, 347, 348, 352, 353, 354, 362, 179,
+	370, 379, 381, 382, 383, 384, 385, 395, 398, 399,
+	438, 439, 454, 455, 905, 191, 0, 0, 197, 0,
+	198, 0, 892, 196, 1007, 1031, 954, 968, 879, 1019,
+	0, 0, 425, 781, 1023, 866, 889, 1032, 895, 897,
+	962, 841, 937, 340, 886, 842, 0, 0, 833, 686,
+	834, 867, 249, 685, 995, 940, 1021, 923, 955, 965,
+	248, 235, 930, 929, 1010, 878, 877, 960, 1006, 1020,
+	0, 0, 167, 453, 185, 789, 300, 0, 0, 451,
+	404, 322, 0, 0, 921, 0, 772, 773, 906, 964,
+	853, 951, 1025, 887, 956, 1026, 98, 0, 0, 0,
+	0, 530, 710, 709, 712, 713, 714, 715, 0, 0,
+	166, 711, 697, 716, 717, 718, 0, 916, 0, 1035,
+	961, 1037, 832, 683, 701, 837, 788, 0, 1011, 874,
+	875, 253, 0, 0, 0, 0, 0, 0, 0, 919,
+	936, 980, 903, 0, 445, 967, 976, 990, 896, 359,
+	272, 0, 0, 0, 0, 698, 699, 0, 0, 0,
+	0, 803, 0, 700, 0, 847, 695, 730, 731, 732,
+	733, 734, 735, 736, 737, 738, 739, 740, 741, 742,
+	743, 744, 745, 746, 747, 748, 749, 750, 751, 752,
+	753, 754, 755, 756, 757, 758, 759, 760, 761, 762,
+	763, 764, 765, 766, 767, 768, 769, 770, 771, 702,
+	0, 0, 0, 852, 830, 872, 982, 831, 829, 323,
+	844, 776, 1009, 904, 289, 186, 1015, 902, 801, 970,
+	848, 999, 890, 297, 846, 190, 843, 849, 888, 336,
+	979, 985, 786, 193, 299, 996, 868, 881, 236, 0,
+	373, 957, 444, 689, 267, 943, 372, 301, 437, 971,
+	1017, 443, 891, 419, 452, 457, 261, 924, 226, 401,
+	251, 245, 873, 989, 836, 273, 358, 240, 293, 907,
+	963, 869, 232, 974, 950, 1001, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 997, 984, 1003, 864, 850, 856, 851, 880,
+	1018, 282, 274, 1004, 1002, 882, 344, 217, 934, 927,
+	920, 790, 447, 1033, 247, 986, 449, 174, 387, 386,
+	894, 281, 987, 176, 165, 368, 177, 290, 199, 1005,
+	460, 213, 295, 427, 688, 266, 335, 959, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 983, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	1024, 307, 256, 260, 275, 286, 958, 371, 408, 450,
+	952, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 981, 209, 204, 1013, 998, 945, 909, 915, 838,
+	0, 203, 910, 911, 912, 913, 914, 977, 871, 883,
+	863, 953, 862, 270, 969, 440, 441, 237, 779, 1028,
+	205, 845, 1027, 332, 339, 331, 1030, 1029, 436, 1014,
+	946, 933, 931, 839, 1012, 944, 932, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 935, 406,
+	306, 0, 200, 0, 405, 1022, 1039, 416, 218, 857,
+	991, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 973, 1036, 343, 374, 225, 446,
+	402, 252, 855, 1040, 802, 791, 792, 795, 938, 939,
+	793, 796, 797, 804, 777, 778, 780, 782, 783, 784,
+	926, 1016, 840, 787, 994, 798, 799, 800, 966, 1034,
+	775, 233, 723, 816, 817, 818, 724, 819, 820, 725,
+	726, 821, 822, 823, 824, 727, 825, 826, 827, 805,
+	806, 807, 808, 809, 810, 811, 812, 815, 813, 814,
+	0, 922, 774, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 785, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	992, 949, 0, 899, 901, 900, 859, 861, 860, 858,
+	1038, 329, 1008, 828, 835, 854, 865, 870, 876, 884,
+	885, 893, 898, 908, 917, 918, 928, 941, 942, 948,
+	972, 975, 988, 993, 1000, 0, 0, 448, 243, 925,
+	947, 978, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 905, 191, 0,
+	0, 197, 0, 198, 0, 892, 196, 1007, 1031, 954,
+	968, 879, 1019, 0, 0, 425, 781, 1023, 866, 889,
+	1032, 895, 897, 962, 841, 937, 340, 886, 842, 0,
+	0, 833, 1081, 834, 867, 249, 1079, 995, 940, 1021,
+	923, 955, 965, 248, 235, 930, 929, 1010, 878, 877,
+	960, 1006, 1020, 0, 0, 167, 453, 185, 789, 300,
+	0, 0, 451, 404, 322, 0, 0, 921, 0, 772,
+	773, 906, 964, 853, 951, 1025, 887, 956, 1026, 98,
+	0, 0, 0, 0, 530, 710, 709, 712, 713, 714,
+	715, 0, 0, 166, 711, 697, 716, 717, 718, 0,
+	916, 0, 1035, 961, 1037, 832, 1098, 701, 837, 788,
+	0, 1011, 874, 875, 253, 0, 0, 0, 0, 0,
+	0, 0, 919, 936, 980, 903, 0, 445, 967, 976,
+	990, 896, 359, 272, 0, 0, 0, 0, 698, 699,
+	0, 0, 0, 0, 803, 0, 700, 0, 847, 695,
+	730, 731, 732, 733, 734, 735, 736, 737, 738, 739,
+	740, 741, 742, 743, 744, 745, 746, 747, 748, 749,
+	750, 751, 752, 753, 754, 755, 756, 757, 758, 759,
+	760, 761, 762, 763, 764, 765, 766, 767, 768, 769,
+	770, 771, 702, 0, 0, 0, 852, 830, 872, 982,
+	831, 829, 323, 844, 776, 1009, 904, 289, 186, 1015,
+	902, 801, 970, 848, 999, 890, 297, 846, 190, 843,
+	849, 888, 336, 979, 985, 786, 193, 299, 996, 868,
+	881, 236, 0, 373, 957, 444, 689, 267, 943, 372,
+	301, 437, 971, 1017, 443, 891, 419, 452, 457, 261,
+	924, 226, 401, 251, 245, 873, 989, 836, 273, 358,
+	240, 293, 907, 963, 869, 232, 974, 950, 1001, 400,
+	434, 195, 317, 435, 456, 161, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 997, 984, 1003, 864, 850,
+	856, 851, 880, 1018, 282, 274, 1004, 1002, 882, 344,
+	217, 934, 927, 920, 790, 447, 1033, 247, 986, 449,
+	174, 387, 386, 894, 281, 987, 176, 165, 368, 177,
+	290, 199, 1005, 460, 213, 295, 427, 688, 266, 335,
+	959, 345, 192, 363, 313, 315, 312, 316, 271, 170,
+	178, 983, 365, 389, 432, 215, 407, 168, 171, 180,
+	378, 181, 182, 1024, 307, 256, 260, 275, 286, 958,
+	371, 408, 450, 952, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 981, 209, 204, 1013, 998, 945,
+	909, 915, 838, 0, 203, 910, 911, 912, 913, 914,
+	977, 871, 883, 863, 953, 862, 270, 969, 440, 441,
+	237, 779, 1028, 205, 845, 1027, 332, 339, 331, 1030,
+	1029, 436, 1014, 946, 933, 931, 839, 1012, 944, 932,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 935, 406, 306, 0, 200, 0, 405, 1022, 1039,
+	416, 218, 857, 991, 431, 173, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 973, 1036, 343,
+	374, 225, 446, 402, 252, 855, 1040, 802, 791, 792,
+	795, 938, 939, 793, 796, 797, 804, 777, 778, 780,
+	782, 783, 784, 926, 1016, 840, 787, 994, 798, 799,
+	800, 966, 1034, 775, 233, 723, 816, 817, 818, 724,
+	819, 820, 725, 726, 821, 822, 823, 824, 727, 825,
+	826, 827, 805, 806, 807, 808, 809, 810, 811, 812,
+	815, 813, 814, 0, 922, 774, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 785, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 992, 949, 0, 899, 901, 900, 859,
+	861, 860, 858, 1038, 329, 1008, 828, 835, 854, 865,
+	870, 876, 884, 885, 893, 898, 908, 917, 918, 928,
+	941, 942, 948, 972, 975, 988, 993, 1000, 0, 0,
+	448, 243, 925, 947, 978, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 179, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	905, 191, 0, 0, 197, 0, 198, 0, 892, 196,
+	1007, 1031, 954, 968, 879, 1019, 0, 0, 425, 781,
+	1023, 866, 889, 1032, 895, 897, 962, 841, 937, 340,
+	886, 842, 0, 0, 833, 1081, 834, 867, 249, 1079,
+	995, 940, 1021, 923, 955, 965, 248, 235, 930, 929,
+	1010, 878, 877, 960, 1006, 1020, 0, 0, 167, 453,
+	185, 789, 300, 0, 0, 451, 404, 322, 0, 0,
+	921, 0, 772, 773, 906, 964, 853, 951, 1025, 887,
+	956, 1026, 98, 0, 0, 0, 0, 530, 710, 709,
+	712, 713, 714, 715, 0, 0, 166, 711, 697, 716,
+	717, 718, 0, 916, 0, 1035, 961, 1037, 832, 1098,
+	701, 837, 788, 0, 1011, 874, 875, 253, 0, 0,
+	0, 0, 0, 0, 0, 919, 936, 980, 903, 0,
+	445, 967, 976, 990, 896, 359, 272, 0, 0, 0,
+	0, 698, 699, 0, 0, 0, 0, 803, 0, 700,
+	0, 847, 695, 730, 731, 732, 733, 734, 735, 736,
+	737, 738, 739, 740, 741, 742, 743, 744, 745, 746,
+	747, 748, 749, 750, 751, 752, 753, 754, 755, 756,
+	757, 758, 759, 760, 761, 762, 763, 764, 765, 766,
+	767, 768, 769, 770, 771, 702, 0, 0, 0, 852,
+	830, 872, 982, 831, 829, 323, 844, 776, 1009, 904,
+	289, 186, 1015, 902, 801, 970, 848, 999, 890, 297,
+	846, 190, 843, 849, 888, 336, 979, 985, 786, 193,
+	299, 996, 868, 881, 236, 0, 373, 957, 444, 689,
+	267, 4430, 372, 301, 437, 971, 1017, 443, 891, 419,
+	452, 457, 261, 924, 226, 401, 251, 245, 873, 989,
+	836, 273, 358, 240, 293, 907, 963, 869, 232, 974,
+	950, 1001, 400, 434, 195, 317, 435, 456, 161, 262,
+	392, 263, 418, 254, 227, 361, 214, 426, 318, 328,
+	229, 231, 230, 208, 393, 433, 220, 234, 997, 984,
+	1003, 864, 850, 856, 851, 880, 1018, 282, 274, 1004,
+	1002, 882, 344, 217, 934, 927, 920, 790, 447, 1033,
+	247, 986, 449, 174, 387, 386, 894, 281, 987, 176,
+	165, 368, 177, 290, 199, 1005, 460, 213, 295, 427,
+	688, 266, 335, 959, 345, 192, 363, 313, 315, 312,
+	316, 271, 170, 178, 983, 365, 389, 432, 215, 407,
+	168, 171, 180, 378, 181, 182, 1024, 307, 256, 260,
+	275, 286, 958, 371, 408, 450, 952, 210, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 375, 409, 423,
+	380, 269, 411, 415, 412, 413, 410, 414, 376, 377,
+	202, 417, 442, 221, 388, 391, 459, 981, 209, 204,
+	1013, 998, 945, 909, 915, 838, 0, 203, 910, 911,
+	912, 913, 914, 977, 871, 883, 863, 953, 862, 270,
+	969, 440, 441, 237, 779, 1028, 205, 845, 1027, 332,
+	339, 331, 1030, 1029, 436, 1014, 946, 933, 931, 839,
+	1012, 944, 932, 296, 259, 277, 356, 303, 357, 278,
+	326, 325, 327, 305, 935, 406, 306, 0, 200, 0,
+	405, 1022, 1039, 416, 218, 857, 991, 431, 173, 364,
+	219, 268, 257, 355, 330, 211, 280, 403, 294, 302,
+	973, 1036, 343, 374, 225, 446, 402, 252, 855, 1040,
+	802, 791, 792, 795, 938, 939, 793, 796, 797, 804,
+	777, 778, 780, 782, 783, 784, 926, 1016, 840, 787,
+	994, 798, 799, 800, 966, 1034, 775, 233, 723, 816,
+	817, 818, 724, 819, 820, 725, 726, 821, 822, 823,
+	824, 727, 825, 826, 827, 805, 806, 807, 808, 809,
+	810, 811, 812, 815, 813, 814, 0, 922, 774, 351,
+	201, 212, 430, 224, 244, 242, 258, 291, 314, 320,
+	349, 390, 396, 397, 420, 421, 422, 424, 246, 0,
+	250, 223, 369, 222, 304, 283, 350, 428, 429, 360,
+	239, 785, 194, 206, 298, 367, 265, 319, 394, 321,
+	287, 238, 458, 324, 366, 461, 992, 949, 0, 899,
+	901, 900, 859, 861, 860, 858, 1038, 329, 1008, 828,
+	835, 854, 865, 870, 876, 884, 885, 893, 898, 908,
+	917, 918, 928, 941, 942, 948, 972, 975, 988, 993,
+	1000, 0, 0, 448, 243, 925, 947, 978, 207, 216,
+	228, 241, 255, 0, 264, 276, 279, 284, 285, 288,
+	292, 308, 309, 310, 311, 333, 334, 337, 338, 341,
+	342, 346, 347, 348, 352, 353, 354, 362, 179, 370,
+	379, 381, 382, 383, 384, 385, 395, 398, 399, 438,
+	439, 454, 455, 905, 191, 0, 0, 197, 0, 198,
+	0, 892, 196, 1007, 1031, 954, 968, 879, 1019, 0,
+	0, 425, 781, 1023, 866, 889, 1032, 895, 897, 962,
+	841, 937, 340, 886, 842, 0, 0, 833, 1081, 834,
+	867, 249, 1079, 995, 940, 1021, 923, 955, 965, 248,
+	235, 930, 929, 1010, 878, 877, 960, 1006, 1020, 0,
+	0, 167, 453, 185, 789, 300, 0, 0, 451, 404,
+	322, 0, 0, 921, 0, 772, 773, 906, 964, 853,
+	951, 1025, 887, 956, 1026, 98, 0, 0, 0, 0,
+	530, 710, 709, 712, 713, 714, 715, 0, 0, 166,
+	711, 697, 716, 717, 718, 0, 916, 0, 1035, 961,
+	1037, 832, 1098, 701, 837, 788, 0, 1011, 874, 875,
+	253, 0, 0, 0, 0, 0, 0, 0, 919, 936,
+	980, 903, 0, 445, 967, 976, 990, 896, 359, 272,
+	0, 0, 0, 0, 698, 699, 0, 0, 0, 0,
+	803, 0, 700, 0, 847, 695, 730, 731, 732, 733,
+	734, 735, 736, 737, 738, 739, 740, 741, 742, 743,
+	744, 745, 746, 747, 748, 749, 750, 751, 752, 753,
+	754, 755, 756, 757, 758, 759, 760, 761, 762, 763,
+	764, 765, 766, 767, 768, 769, 770, 771, 702, 0,
+	0, 0, 852, 830, 872, 982, 831, 829, 323, 844,
+	776, 1009, 904, 289, 186, 1015, 902, 801, 970, 848,
+	999, 890, 297, 846, 190, 843, 849, 888, 336, 979,
+	985, 786, 193, 299, 996, 868, 881, 236, 0, 373,
+	957, 444, 689, 267, 943, 372, 301, 437, 971, 1017,
+	443, 891, 419, 452, 457, 261, 924, 226, 401, 251,
+	245, 873, 989, 836, 273, 358, 240, 293, 907, 963,
+	869, 232, 974, 950, 1001, 400, 434, 195, 317, 435,
+	456, 161, 262, 392, 263, 418, 254, 227, 361, 214,
+	426, 318, 328, 229, 231, 230, 208, 393, 433, 220,
+	234, 997, 984, 1003, 864, 850, 856, 851, 880, 1018,
+	282, 274, 1004, 1002, 882, 344, 217, 934, 927, 920,
+	790, 447, 1033, 247, 986, 449, 174, 387, 386, 894,
+	281, 987, 176, 165, 368, 177, 290, 199, 1005, 460,
+	213, 295, 427, 688, 266, 335, 959, 345, 192, 363,
+	313, 315, 312, 316, 271, 170, 178, 983, 365, 389,
+	432, 215, 407, 168, 171, 180, 378, 181, 182, 1024,
+	307, 256, 260, 275, 286, 958, 371, 408, 450, 952,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	375, 409, 423, 380, 269, 411, 415, 412, 413, 410,
+	414, 376, 377, 202, 417, 442, 221, 388, 391, 459,
+	981, 209, 204, 1013, 998, 945, 909, 915, 838, 0,
+	203, 910, 911, 912, 913, 914, 977, 871, 883, 863,
+	953, 862, 270, 969, 440, 441, 237, 779, 1028, 205,
+	845, 1027, 332, 339, 331, 1030, 1029, 436, 1014, 946,
+	933, 931, 839, 1012, 944, 932, 296, 259, 277, 356,
+	303, 357, 278, 326, 325, 327, 305, 935, 406, 306,
+	0, 200, 0, 405, 1022, 1039, 416, 218, 857, 991,
+	431, 173, 364, 219, 268, 257, 355, 330, 211, 280,
+	403, 294, 302, 973, 1036, 343, 374, 225, 446, 402,
+	252, 855, 1040, 802, 791, 792, 795, 938, 939, 793,
+	796, 797, 804, 777, 778, 780, 782, 783, 784, 2345,
+	2346, 2347, 787, 994, 798, 799, 800, 966, 1034, 775,
+	233, 723, 816, 817, 818, 724, 819, 820, 725, 726,
+	821, 822, 823, 824, 727, 825, 826, 827, 805, 806,
+	807, 808, 809, 810, 811, 812, 815, 813, 814, 0,
+	922, 774, 351, 201, 212, 430, 224, 244, 242, 258,
+	291, 314, 320, 349, 390, 396, 397, 420, 421, 422,
+	424, 246, 0, 250, 223, 369, 222, 304, 283, 350,
+	428, 429, 360, 239, 785, 194, 206, 298, 367, 265,
+	319, 394, 321, 287, 238, 458, 324, 366, 461, 992,
+	949, 0, 899, 901, 900, 859, 861, 860, 858, 1038,
+	329, 1008, 828, 835, 854, 865, 870, 876, 884, 885,
+	893, 898, 908, 917, 918, 928, 941, 942, 948, 972,
+	975, 988, 993, 1000, 0, 0, 448, 243, 925, 947,
+	978, 207, 216, 228, 241, 255, 0, 264, 276, 279,
+	284, 285, 288, 292, 308, 309, 310, 311, 333, 334,
+	337, 338, 341, 342, 346, 347, 348, 352, 353, 354,
+	362, 179, 370, 379, 381, 382, 383, 384, 385, 395,
+	398, 399, 438, 439, 454, 455, 905, 191, 0, 0,
+	197, 0, 198, 0, 892, 196, 1007, 1031, 954, 968,
+	1815, 1994, 0, 3526, 425, 1849, 1998, 1798, 1828, 2015,
+	1834, 1837, 1918, 1764, 1887, 340, 1825, 1765, 1748, 1803,
+	1752, 1816, 1753, 1800, 249, 1796, 1959, 1890, 1996, 1869,
+	1911, 1921, 248, 235, 1879, 1878, 1984, 1814, 1813, 1916,
+	1973, 1995, 1868, 0, 167, 453, 185, 3529, 300, 1970,
+	471, 451, 404, 322, 474, 473, 1864, 1979, 1885, 1948,
+	1847, 1920, 1780, 1903, 2000, 1826, 1912, 2001, 98, 0,
+	1429, 0, 0, 1137, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 1908, 1992, 1819, 472, 1859,
+	0, 3527, 1917, 2020, 1751, 1904, 0, 1756, 1767, 2014,
+	1985, 1810, 1811, 253, 0, 0, 0, 0, 0, 0,
+	0, 1862, 1886, 1938, 1844, 0, 445, 1923, 1933, 1951,
+	1836, 359, 272, 0, 0, 0, 0, 0, 0, 0,
+	0, 1805, 0, 1901, 0, 0, 0, 1772, 1758, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1858, 0, 0, 0, 1779, 1749, 1807, 1940, 1750,
+	1747, 323, 1768, 1953, 3528, 1845, 289, 186, 1989, 1843,
+	1842, 1927, 1773, 1963, 1829, 297, 1771, 190, 1766, 1774,
+	1827, 336, 1937, 1945, 172, 193, 299, 1960, 1801, 1818,
+	236, 2180, 373, 1913, 444, 470, 267, 1894, 372, 301,
+	437, 1928, 1991, 443, 1830, 419, 452, 457, 261, 1870,
+	226, 401, 251, 245, 1809, 1950, 1755, 273, 358, 240,
+	293, 1848, 1919, 1802, 232, 1931, 1902, 1965, 400, 434,
+	195, 317, 435, 456, 161, 262, 392, 263, 418, 254,
+	227, 361, 214, 426, 318, 328, 229, 231, 230, 208,
+	393, 433, 220, 234, 1961, 1944, 1967, 1795, 1775, 1786,
+	1776, 1817, 1993, 282, 274, 1968, 1966, 1820, 344, 217,
+	1883, 1876, 1863, 1941, 447, 2016, 247, 1946, 449, 174,
+	387, 386, 1833, 281, 1947, 176, 165, 368, 177, 290,
+	199, 1972, 460, 213, 295, 427, 469, 266, 335, 1915,
+	345, 192, 363, 313, 315, 312, 316, 271, 170, 178,
+	1943, 365, 389, 432, 215, 407, 168, 171, 180, 378,
+	181, 182, 1999, 307, 256, 260, 275, 286, 1914, 371,
+	408, 450, 1905, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 375, 409, 423, 380, 269, 411, 415,
+	412, 413, 410, 414, 376, 377, 202, 417, 442, 221,
+	388, 391, 459, 1939, 209, 204, 1987, 1962, 1896, 1851,
+	1857, 1757, 0, 203, 1852, 1853, 1854, 1855, 1856, 1935,
+	1806, 1822, 1794, 1909, 1793, 270, 1926, 440, 441, 237,
+	1769, 2007, 205, 1770, 2006, 332, 339, 331, 2010, 2008,
+	436, 1988, 1897, 1882, 1880, 1762, 1986, 1895, 1881, 296,
+	259, 277, 356, 303, 357, 278, 326, 325, 327, 305,
+	1884, 406, 306, 0, 200, 0, 405, 1997, 2022, 416,
+	218, 1788, 1954, 431, 173, 364, 219, 268, 257, 355,
+	330, 211, 280, 403, 294, 302, 1930, 2019, 343, 374,
+	225, 446, 402, 252, 1784, 0, 1787, 1782, 1785, 1783,
+	1888, 1889, 2002, 2003, 2004, 1942, 1777, 0, 0, 1980,
+	1981, 0, 1875, 1990, 1763, 0, 1958, 183, 184, 169,
+	1922, 2017, 1835, 233, 159, 1759, 1760, 1761, 160, 1865,
+	1866, 162, 163, 1976, 1975, 1974, 1977, 164, 2011, 2009,
+	2012, 1778, 1799, 1821, 1871, 1872, 1874, 1906, 1907, 1952,
+	1925, 1934, 1808, 1867, 175, 351, 201, 212, 430, 224,
+	244, 242, 258, 291, 314, 320, 349, 390, 396, 397,
+	420, 421, 422, 424, 246, 0, 250, 223, 369, 222,
+	304, 283, 350, 428, 429, 360, 239, 1893, 194, 206,
+	298, 367, 265, 319, 394, 321, 287, 238, 458, 324,
+	366, 461, 1955, 1900, 0, 1839, 1841, 1840, 1790, 1792,
+	1791, 1789, 2021, 329, 1982, 1746, 1754, 1781, 1797, 1804,
+	1812, 1823, 1824, 1832, 1838, 1850, 1860, 1861, 1877, 1891,
+	1892, 1899, 1929, 1932, 1949, 1957, 1964, 1969, 1971, 448,
+	243, 1873, 1898, 1936, 207, 216, 228, 241, 255, 0,
+	264, 276, 279, 284, 285, 288, 292, 308, 309, 310,
+	311, 333, 334, 337, 338, 341, 342, 346, 347, 348,
+	352, 353, 354, 362, 179, 370, 379, 381, 382, 383,
+	384, 385, 395, 398, 399, 438, 439, 454, 455, 1846,
+	191, 0, 0, 197, 0, 198, 0, 1831, 196, 1978,
+	2013, 1910, 1924, 1815, 1994, 0, 1956, 425, 1849, 1998,
+	1798, 1828, 2015, 1834, 1837, 1918, 1764, 1887, 340, 1825,
+	1765, 1748, 1803, 1752, 1816, 1753, 1800, 249, 1796, 1959,
+	1890, 1996, 1869, 1911, 1921, 248, 235, 1879, 1878, 1984,
+	1814, 1813, 1916, 1973, 1995, 1868, 0, 167, 453, 185,
+	2005, 300, 1970, 471, 451, 404, 322, 474, 473, 1864,
+	1979, 1885, 1948, 1847, 1920, 1780, 1903, 2000, 1826, 1912,
+	2001, 0, 0, 0, 0, 0, 530, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 1908, 1992,
+	1819, 472, 1859, 0, 2018, 1917, 2020, 1751, 1904, 0,
+	1756, 1767, 2014, 1985, 1810, 1811, 253, 0, 0, 0,
+	0, 0, 0, 0, 1862, 1886, 1938, 1844, 0, 445,
+	1923, 1933, 1951, 1836, 359, 272, 0, 0, 0, 0,
+	0, 0, 3084, 0, 1805, 0, 1901, 0, 0, 0,
+	1772, 1758, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1858, 0, 0, 0, 1779, 1749,
+	1807, 1940, 1750, 1747, 323, 1768, 1953, 1983, 1845, 289,
+	186, 1989, 1843, 1842, 1927, 1773, 1963, 1829, 297, 1771,
+	190, 1766, 1774, 1827, 336, 1937, 1945, 172, 193, 299,
+	1960, 1801, 1818, 236, 0, 373, 1913, 444, 2274, 267,
+	1894, 372, 301, 437, 1928, 1991, 443, 1830, 419, 452,
+	457, 261, 1870, 226, 401, 251, 245, 1809, 1950, 1755,
+	273, 358, 240, 293, 1848, 1919, 1802, 232, 1931, 1902,
+	1965, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 1961, 1944, 1967,
+	1795, 1775, 1786, 1776, 1817, 1993, 282, 274, 1968, 1966,
+	1820, 344, 217, 1883, 1876, 1863, 1941, 447, 2016, 247,
+	1946, 449, 174, 387, 386, 1833, 281, 1947, 176, 165,
+	368, 177, 290, 199, 1972, 460, 213, 295, 427, 2273,
+	266, 335, 1915, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 1943, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 1999, 307, 256, 260, 275,
+	286, 1914, 371, 408, 450, 1905, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 1939, 209, 204, 1987,
+	1962, 1896, 1851, 1857, 1757, 0, 203, 1852, 1853, 1854,
+	1855, 1856, 1935, 1806, 1822, 1794, 1909, 1793, 270, 1926,
+	440, 441, 237, 1769, 2007, 205, 1770, 2006, 332, 339,
+	331, 2010, 2008, 436, 1988, 1897, 1882, 1880, 1762, 1986,
+	1895, 1881, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 1884, 406, 306, 0, 200, 0, 405,
+	1997, 2022, 416, 218, 1788, 1954, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 1930,
+	2019, 343, 374, 225, 446, 402, 252, 1784, 0, 1787,
+	1782, 1785, 1783, 1888, 1889, 2002, 2003, 2004, 1942, 1777,
+	0, 0, 1980, 1981, 0, 1875, 1990, 1763, 0, 1958,
+	183, 184, 169, 1922, 2017, 1835, 233, 159, 1759, 1760,
+	1761, 160, 1865, 1866, 162, 163, 1976, 1975, 1974, 1977,
+	164, 2011, 2009, 2012, 1778, 1799, 1821, 1871, 1872, 1874,
+	1906, 1907, 1952, 1925, 1934, 1808, 1867, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	1893, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 1955, 1900, 0, 1839, 1841,
+	1840, 1790, 1792, 1791, 1789, 2021, 329, 1982, 1746, 1754,
+	1781, 1797, 1804, 1812, 1823, 1824, 1832, 1838, 1850, 1860,
+	1861, 1877, 1891, 1892, 1899, 1929, 1932, 1949, 1957, 1964,
+	1969, 1971, 448, 243, 1873, 1898, 1936, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 1846, 191, 0, 0, 197, 0, 198, 0,
+	1831, 196, 1978, 2013, 1910, 1924, 1815, 1994, 0, 1956,
+	425, 1849, 1998, 1798, 1828, 2015, 1834, 1837, 1918, 1764,
+	1887, 340, 1825, 1765, 1748, 1803, 1752, 1816, 1753, 1800,
+	249, 1796, 1959, 1890, 1996, 1869, 1911, 1921, 248, 235,
+	1879, 1878, 1984, 1814, 1813, 1916, 1973, 1995, 1868, 0,
+	167, 453, 185, 2005, 300, 1970, 471, 451, 404, 322,
+	474, 473, 1864, 1979, 1885, 1948, 1847, 1920, 1780, 1903,
+	2000, 1826, 1912, 2001, 0, 0, 0, 0, 0, 1137,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 1908, 1992, 1819, 472, 1859, 0, 2018, 1917, 2020,
+	1751, 1904, 0, 1756, 1767, 2014, 1985, 1810, 1811, 253,
+	0, 0, 0, 0, 0, 0, 0, 1862, 1886, 1938,
+	1844, 0, 445, 1923, 1933, 1951, 1836, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 1805, 0, 1901,
+	0, 0, 0, 1772, 1758, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1858, 0, 0,
+	0, 1779, 1749, 1807, 1940, 1750, 1747, 323, 1768, 1953,
+	1983, 1845, 289, 186, 1989, 1843, 1842, 1927, 1773, 1963,
+	1829, 297, 1771, 190, 1766, 1774, 1827, 336, 1937, 1945,
+	172, 193, 299, 1960, 1801, 1818, 236, 2180, 373, 1913,
+	444, 470, 267, 1894, 372, 301, 437, 1928, 1991, 443,
+	1830, 419, 452, 457, 261, 1870, 226, 401, 251, 245,
+	1809, 1950, 1755, 273, 358, 240, 293, 1848, 1919, 1802,
+	232, 1931, 1902, 1965, 400, 434, 195, 317, 435, 456,
+	161, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	1961, 1944, 1967, 1795, 1775, 1786, 1776, 1817, 1993, 282,
+	274, 1968, 1966, 1820, 344, 217, 1883, 1876, 1863, 1941,
+	447, 2016, 247, 1946, 449, 174, 387, 386, 1833, 281,
+	1947, 176, 165, 368, 177, 290, 199, 1972, 460, 213,
+	295, 427, 469, 266, 335, 1915, 345, 192, 363, 313,
+	315, 312, 316, 271, 170, 178, 1943, 365, 389, 432,
+	215, 407, 168, 171, 180, 378, 181, 182, 1999, 307,
+	256, 260, 275, 286, 1914, 371, 408, 450, 1905, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 1939,
+	209, 204, 1987, 1962, 1896, 1851, 1857, 1757, 0, 203,
+	1852, 1853, 1854, 1855, 1856, 1935, 1806, 1822, 1794, 1909,
+	1793, 270, 1926, 440, 441, 237, 1769, 2007, 205, 1770,
+	2006, 332, 339, 331, 2010, 2008, 436, 1988, 1897, 1882,
+	1880, 1762, 1986, 1895, 1881, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 1884, 406, 306, 0,
+	200, 0, 405, 1997, 2022, 416, 218, 1788, 1954, 431,
+	173, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 1930, 2019, 343, 374, 225, 446, 402, 252,
+	1784, 0, 1787, 1782, 1785, 1783, 1888, 1889, 2002, 2003,
+	2004, 1942, 1777, 0, 0, 1980, 1981, 0, 1875, 1990,
+	1763, 0, 1958, 183, 184, 169, 1922, 2017, 1835, 233,
+	159, 1759, 1760, 1761, 160, 1865, 1866, 162, 163, 1976,
+	1975, 1974, 1977, 164, 2011, 2009, 2012, 1778, 1799, 1821,
+	1871, 1872, 1874, 1906, 1907, 1952, 1925, 1934, 1808, 1867,
+	175, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 1893, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 1955, 1900,
+	0, 1839, 1841, 1840, 1790, 1792, 1791, 1789, 2021, 329,
+	1982, 1746, 1754, 1781, 1797, 1804, 1812, 1823, 1824, 1832,
+	1838, 1850, 1860, 1861, 1877, 1891, 1892, 1899, 1929, 1932,
+	1949, 1957, 1964, 1969, 1971, 448, 243, 1873, 1898, 1936,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	179, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 1846, 191, 0, 0, 197,
+	0, 198, 0, 1831, 196, 1978, 2013, 1910, 1924, 1815,
+	1994, 0, 1956, 425, 1849, 1998, 1798, 1828, 2015, 1834,
+	1837, 1918, 1764, 1887, 340, 1825, 1765, 1748, 1803, 1752,
+	1816, 1753, 1800, 249, 1796, 1959, 1890, 1996, 1869, 1911,
+	1921, 248, 235, 1879, 1878, 1984, 1814, 1813, 1916, 1973,
+	1995, 1868, 0, 167, 453, 185, 2005, 300, 1970, 471,
+	451, 404, 322, 474, 473, 1864, 1979, 1885, 1948, 1847,
+	1920, 1780, 1903, 2000, 1826, 1912, 2001, 0, 0, 0,
+	0, 0, 530, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 0, 1908, 1992, 1819, 472, 1859, 0,
+	2018, 1917, 2020, 1751, 1904, 0, 1756, 1767, 2014, 1985,
+	1810, 1811, 253, 0, 0, 0, 0, 0, 0, 0,
+	1862, 1886, 1938, 1844, 0, 445, 1923, 1933, 1951, 1836,
+	359, 272, 0, 0, 0, 0, 0, 0, 2268, 0,
+	1805, 0, 1901, 0, 0, 0, 1772, 1758, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1858, 0, 0, 0, 1779, 1749, 1807, 1940, 1750, 1747,
+	323, 1768, 1953, 1983, 1845, 289, 186, 1989, 1843, 1842,
+	1927, 1773, 1963, 1829, 297, 1771, 190, 1766, 1774, 1827,
+	336, 1937, 1945, 172, 193, 299, 1960, 1801, 1818, 236,
+	0, 373, 1913, 444, 2274, 267, 1894, 372, 301, 437,
+	1928, 1991, 443, 1830, 419, 452, 457, 261, 1870, 226,
+	401, 251, 245, 1809, 1950, 1755, 273, 358, 240, 293,
+	1848, 1919, 1802, 232, 1931, 1902, 1965, 400, 434, 195,
+	317, 435, 456, 161, 262, 392, 263, 418, 254, 227,
+	361, 214, 426, 318, 328, 229, 231, 230, 208, 393,
+	433, 220, 234, 1961, 1944, 1967, 1795, 1775, 1786, 1776,
+	1817, 1993, 282, 274, 1968, 1966, 1820, 344, 217, 1883,
+	1876, 1863, 1941, 447, 2016, 247, 1946, 449, 174, 387,
+	386, 1833, 281, 1947, 176, 165, 368, 177, 290, 199,
+	1972, 460, 213, 295, 427, 2273, 266, 335, 1915, 345,
+	192, 363, 313, 315, 312, 316, 271, 170, 178, 1943,
+	365, 389, 432, 215, 407, 168, 171, 180, 378, 181,
+	182, 1999, 307, 256, 260, 275, 286, 1914, 371, 408,
+	450, 1905, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 375, 409, 423, 380, 269, 411, 415, 412,
+	413, 410, 414, 376, 377, 202, 417, 442, 221, 388,
+	391, 459, 1939, 209, 204, 1987, 1962, 1896, 1851, 1857,
+	1757, 0, 203, 1852, 1853, 1854, 1855, 1856, 1935, 1806,
+	1822, 1794, 1909, 1793, 270, 1926, 440, 441, 237, 1769,
+	2007, 205, 1770, 2006, 332, 339, 331, 2010, 2008, 436,
+	1988, 1897, 1882, 1880, 1762, 1986, 1895, 1881, 296, 259,
+	277, 356, 303, 357, 278, 326, 325, 327, 305, 1884,
+	406, 306, 0, 200, 0, 405, 1997, 2022, 416, 218,
+	1788, 1954, 431, 173, 364, 219, 268, 257, 355, 330,
+	211, 280, 403, 294, 302, 1930, 2019, 343, 374, 225,
+	446, 402, 252, 1784, 0, 1787, 1782, 1785, 1783, 1888,
+	1889, 2002, 2003, 2004, 1942, 1777, 0, 0, 1980, 1981,
+	0, 1875, 1990, 1763, 0, 1958, 183, 184, 169, 1922,
+	2017, 1835, 233, 159, 1759, 1760, 1761, 160, 1865, 1866,
+	162, 163, 1976, 1975, 1974, 1977, 164, 2011, 2009, 2012,
+	1778, 1799, 1821, 1871, 1872, 1874, 1906, 1907, 1952, 1925,
+	1934, 1808, 1867, 175, 351, 201, 212, 430, 224, 244,
+	242, 258, 291, 314, 320, 349, 390, 396, 397, 420,
+	421, 422, 424, 246, 0, 250, 223, 369, 222, 304,
+	283, 350, 428, 429, 360, 239, 1893, 194, 206, 298,
+	367, 265, 319, 394, 321, 287, 238, 458, 324, 366,
+	461, 1955, 1900, 0, 1839, 1841, 1840, 1790, 1792, 1791,
+	1789, 2021, 329, 1982, 1746, 1754, 1781, 1797, 1804, 1812,
+	1823, 1824, 1832, 1838, 1850, 1860, 1861, 1877, 1891, 1892,
+	1899, 1929, 1932, 1949, 1957, 1964, 1969, 1971, 448, 243,
+	1873, 1898, 1936, 207, 216, 228, 241, 255, 0, 264,
+	276, 279, 284, 285, 288, 292, 308, 309, 310, 311,
+	333, 334, 337, 338, 341, 342, 346, 347, 348, 352,
+	353, 354, 362, 179, 370, 379, 381, 382, 383, 384,
+	385, 395, 398, 399, 438, 439, 454, 455, 1846, 191,
+	0, 0, 197, 0, 198, 0, 1831, 196, 1978, 2013,
+	1910, 1924, 1815, 1994, 0, 1956, 425, 1849, 1998, 1798,
+	1828, 2015, 1834, 1837, 1918, 1764, 1887, 340, 1825, 1765,
+	1748, 1803, 1752, 1816, 1753, 1800, 249, 1796, 1959, 1890,
+	1996, 1869, 1911, 1921, 248, 235, 1879, 1878, 1984, 1814,
+	1813, 1916, 1973, 1995, 1868, 0, 167, 453, 185, 2005,
+	300, 1970, 471, 451, 404, 322, 474, 473, 1864, 1979,
+	1885, 1948, 1847, 1920, 1780, 1903, 2000, 1826, 1912, 2001,
+	0, 0, 0, 0, 0, 530, 0, 0, 0, 0,
+	0, 0, 0, 0, 166, 0, 0, 1908, 1992, 1819,
+	472, 1859, 0, 2018, 1917, 2020, 1751, 1904, 0, 1756,
+	1767, 2014, 1985, 1810, 1811, 253, 0, 0, 0, 0,
+	0, 0, 0, 1862, 1886, 1938, 1844, 0, 445, 1923,
+	1933, 1951, 1836, 359, 272, 0, 0, 0, 0, 0,
+	0, 0, 0, 1805, 0, 1901, 0, 0, 0, 1772,
+	1758, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1858, 0, 0, 0, 1779, 1749, 1807,
+	1940, 1750, 1747, 323, 1768, 1953, 1983, 1845, 289, 186,
+	1989, 1843, 1842, 1927, 1773, 1963, 1829, 297, 1771, 190,
+	1766, 1774, 1827, 336, 1937, 1945, 172, 193, 299, 1960,
+	1801, 1818, 236, 0, 373, 1913, 444, 2274, 267, 1894,
+	372, 301, 437, 1928, 1991, 443, 1830, 419, 452, 457,
+	261, 1870, 226, 401, 251, 245, 1809, 1950, 1755, 273,
+	358, 240, 293, 1848, 1919, 1802, 232, 1931, 1902, 1965,
+	400, 434, 195, 317, 435, 456, 161, 262, 392, 263,
+	418, 254, 227, 361, 214, 426, 318, 328, 229, 231,
+	230, 208, 393, 433, 220, 234, 1961, 1944, 1967, 1795,
+	1775, 1786, 1776, 1817, 1993, 282, 274, 1968, 1966, 1820,
+	344, 217, 1883, 1876, 1863, 1941, 447, 2016, 247, 1946,
+	449, 174, 387, 386, 1833, 281, 1947, 176, 165, 368,
+	177, 290, 199, 1972, 460, 213, 295, 427, 2273, 266,
+	335, 1915, 345, 192, 363, 313, 315, 312, 316, 271,
+	170, 178, 1943, 365, 389, 432, 215, 407, 168, 171,
+	180, 378, 181, 182, 1999, 307, 256, 260, 275, 286,
+	1914, 371, 408, 450, 1905, 210, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 375, 409, 423, 380, 269,
+	411, 415, 412, 413, 410, 414, 376, 377, 202, 417,
+	442, 221, 388, 391, 459, 1939, 209, 204, 1987, 1962,
+	1896, 1851, 1857, 1757, 0, 203, 1852, 1853, 1854, 1855,
+	1856, 1935, 1806, 1822, 1794, 1909, 1793, 270, 1926, 440,
+	441, 237, 1769, 2007, 205, 1770, 2006, 332, 339, 331,
+	2010, 2008, 436, 1988, 1897, 1882, 1880, 1762, 1986, 1895,
+	1881, 296, 259, 277, 356, 303, 357, 278, 326, 325,
+	327, 305, 1884, 406, 306, 0, 200, 0, 405, 1997,
+	2022, 416, 218, 1788, 1954, 431, 173, 364, 219, 268,
+	257, 355, 330, 211, 280, 403, 294, 302, 1930, 2019,
+	343, 374, 225, 446, 402, 252, 1784, 0, 1787, 1782,
+	1785, 1783, 1888, 1889, 2002, 2003, 2004, 1942, 1777, 0,
+	0, 1980, 1981, 0, 1875, 1990, 1763, 0, 1958, 183,
+	184, 169, 1922, 2017, 1835, 233, 159, 1759, 1760, 1761,
+	160, 1865, 1866, 162, 163, 1976, 1975, 1974, 1977, 164,
+	2011, 2009, 2012, 1778, 1799, 1821, 1871, 1872, 1874, 1906,
+	1907, 1952, 1925, 1934, 1808, 1867, 175, 351, 201, 212,
+	430, 224, 244, 242, 258, 291, 314, 320, 349, 390,
+	396, 397, 420, 421, 422, 424, 246, 0, 250, 223,
+	369, 222, 304, 283, 350, 428, 429, 360, 239, 1893,
+	194, 206, 298, 367, 265, 319, 394, 321, 287, 238,
+	458, 324, 366, 461, 1955, 1900, 0, 1839, 1841, 1840,
+	1790, 1792, 1791, 1789, 2021, 329, 1982, 1746, 1754, 1781,
+	1797, 1804, 1812, 1823, 1824, 1832, 1838, 1850, 1860, 1861,
+	1877, 1891, 1892, 1899, 1929, 1932, 1949, 1957, 1964, 1969,
+	1971, 448, 243, 1873, 1898, 1936, 207, 216, 228, 241,
+	255, 0, 264, 276, 279, 284, 285, 288, 292, 308,
+	309, 310, 311, 333, 334, 337, 338, 341, 342, 346,
+	347, 348, 352, 353, 354, 362, 179, 370, 379, 381,
+	382, 383, 384, 385, 395, 398, 399, 438, 439, 454,
+	455, 1846, 191, 0, 0, 197, 0, 198, 0, 1831,
+	196, 1978, 2013, 1910, 1924, 1815, 1994, 0, 1956, 425,
+	1849, 1998, 1798, 1828, 2015, 1834, 1837, 1918, 1764, 1887,
+	340, 1825, 1765, 1748, 1803, 1752, 1816, 1753, 1800, 249,
+	1796, 1959, 1890, 1996, 1869, 1911, 1921, 248, 235, 1879,
+	1878, 1984, 1814, 1813, 1916, 1973, 1995, 1868, 0, 167,
+	453, 185, 2005, 300, 1970, 471, 451, 404, 322, 474,
+	473, 1864, 1979, 1885, 1948, 1847, 1920, 1780, 1903, 2000,
+	1826, 1912, 2001, 0, 0, 0, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 0,
+	1908, 1992, 1819, 472, 1859, 0, 2018, 1917, 2020, 1751,
+	1904, 0, 1756, 1767, 2014, 1985, 1810, 1811, 253, 0,
+	0, 0, 0, 0, 0, 0, 1862, 1886, 1938, 1844,
+	0, 445, 1923, 1933, 1951, 1836, 359, 272, 0, 0,
+	0, 0, 0, 0, 2816, 0, 1805, 0, 1901, 0,
+	0, 0, 1772, 1758, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1858, 0, 0, 0,
+	1779, 1749, 1807, 1940, 1750, 1747, 323, 1768, 1953, 1983,
+	1845, 289, 186, 1989, 1843, 1842, 1927, 1773, 1963, 1829,
+	297, 1771, 190, 1766, 1774, 1827, 336, 1937, 1945, 172,
+	193, 299, 1960, 1801, 1818, 236, 0, 373, 1913, 444,
+	470, 267, 1894, 372, 301, 437, 1928, 1991, 443, 1830,
+	419, 452, 457, 261, 1870, 226, 401, 251, 245, 1809,
+	1950, 1755, 273, 358, 240, 293, 1848, 1919, 1802, 232,
+	1931, 1902, 1965, 400, 434, 195, 317, 435, 456, 0,
+	262, 392, 263, 418, 254, 227, 361, 214, 426, 318,
+	328, 229, 231, 230, 208, 393, 433, 220, 234, 1961,
+	1944, 1967, 1795, 1775, 1786, 1776, 1817, 1993, 282, 274,
+	1968, 1966, 1820, 344, 217, 1883, 1876, 1863, 1941, 447,
+	2016, 247, 1946, 449, 174, 387, 386, 1833, 281, 1947,
+	176, 165, 368, 177, 290, 199, 1972, 460, 213, 295,
+	427, 469, 266, 335, 1915, 345, 192, 363, 313, 315,
+	312, 316, 271, 170, 178, 1943, 365, 389, 432, 215,
+	407, 168, 171, 180, 378, 181, 182, 1999, 307, 256,
+	260, 275, 286, 1914, 371, 408, 450, 1905, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 375, 409,
+	423, 380, 269, 411, 415, 412, 413, 410, 414, 376,
+	377, 202, 417, 442, 221, 388, 391, 459, 1939, 209,
+	204, 1987, 1962, 1896, 1851, 1857, 1757, 0, 203, 1852,
+	1853, 1854, 1855, 1856, 1935, 1806, 1822, 1794, 1909, 1793,
+	270, 1926, 440, 441, 237, 1769, 2007, 205, 1770, 2006,
+	332, 339, 331, 2010, 2008, 436, 1988, 1897, 1882, 1880,
+	1762, 1986, 1895, 1881, 296, 259, 277, 356, 303, 357,
+	278, 326, 325, 327, 305, 1884, 406, 306, 0, 200,
+	0, 405, 1997, 2022, 416, 218, 1788, 1954, 431, 173,
+	364, 219, 268, 257, 355, 330, 211, 280, 403, 294,
+	302, 1930, 2019, 343, 374, 225, 446, 402, 252, 1784,
+	0, 1787, 1782, 1785, 1783, 1888, 1889, 2002, 2003, 2004,
+	1942, 1777, 0, 0, 1980, 1981, 0, 1875, 1990, 1763,
+	0, 1958, 183, 184, 169, 1922, 2017, 1835, 233, 0,
+	1759, 1760, 1761, 0, 1865, 1866, 0, 0, 1976, 1975,
+	1974, 1977, 0, 2011, 2009, 2012, 1778, 1799, 1821, 1871,
+	1872, 1874, 1906, 1907, 1952, 1925, 1934, 1808, 1867, 175,
+	351, 201, 212, 430, 224, 244, 242, 258, 291, 314,
+	320, 349, 390, 396, 397, 420, 421, 422, 424, 246,
+	0, 250, 223, 369, 222, 304, 283, 350, 428, 429,
+	360, 239, 1893, 194, 206, 298, 367, 265, 319, 394,
+	321, 287, 238, 458, 324, 366, 461, 1955, 1900, 0,
+	1839, 1841, 1840, 1790, 1792, 1791, 1789, 2021, 329, 1982,
+	1746, 1754, 1781, 1797, 1804, 1812, 1823, 1824, 1832, 1838,
+	1850, 1860, 1861, 1877, 1891, 1892, 1899, 1929, 1932, 1949,
+	1957, 1964, 1969, 1971, 448, 243, 1873, 1898, 1936, 207,
+	216, 228, 241, 255, 0, 264, 276, 279, 284, 285,
+	288, 292, 308, 309, 310, 311, 333, 334, 337, 338,
+	341, 342, 346, 347, 348, 352, 353, 354, 362, 179,
+	370, 379, 381, 382, 383, 384, 385, 395, 398, 399,
+	438, 439, 454, 455, 1846, 191, 0, 0, 197, 0,
+	198, 0, 1831, 196, 1978, 2013, 1910, 1924, 1815, 1994,
+	0, 1956, 425, 1849, 1998, 1798, 1828, 2015, 1834, 1837,
+	1918, 1764, 1887, 340, 1825, 1765, 1748, 1803, 1752, 1816,
+	1753, 1800, 249, 1796, 1959, 1890, 1996, 1869, 1911, 1921,
+	248, 235, 1879, 1878, 1984, 1814, 1813, 1916, 1973, 1995,
+	1868, 0, 167, 453, 185, 2005, 300, 1970, 471, 451,
+	404, 322, 474, 473, 1864, 1979, 1885, 1948, 1847, 1920,
+	1780, 1903, 2000, 1826, 1912, 2001, 0, 0, 0, 0,
+	0, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 1908, 1992, 1819, 472, 1859, 0, 2018,
+	1917, 2020, 1751, 1904, 0, 1756, 1767, 2014, 1985, 1810,
+	1811, 253, 0, 0, 0, 0, 0, 0, 0, 1862,
+	1886, 1938, 1844, 0, 445, 1923, 1933, 1951, 1836, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 1805,
+	0, 1901, 0, 0, 0, 1772, 1758, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1858,
+	0, 0, 0, 1779, 1749, 1807, 1940, 1750, 1747, 323,
+	1768, 1953, 1983, 1845, 289, 186, 1989, 1843, 1842, 1927,
+	1773, 1963, 1829, 297, 1771, 190, 1766, 1774, 1827, 336,
+	1937, 1945, 172, 193, 299, 1960, 1801, 1818, 236, 0,
+	373, 1913, 444, 470, 267, 1894, 372, 301, 437, 1928,
+	1991, 443, 1830, 419, 452, 457, 261, 1870, 226, 401,
+	251, 245, 1809, 1950, 1755, 273, 358, 240, 293, 1848,
+	1919, 1802, 232, 1931, 1902, 1965, 400, 434, 195, 317,
+	435, 456, 0, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 1961, 1944, 1967, 1795, 1775, 1786, 1776, 1817,
+	1993, 282, 274, 1968, 1966, 1820, 344, 217, 1883, 1876,
+	1863, 1941, 447, 2016, 247, 1946, 449, 174, 387, 386,
+	1833, 281, 1947, 176, 165, 368, 177, 290, 199, 1972,
+	460, 213, 295, 427, 469, 266, 335, 1915, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 1943, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	1999, 307, 256, 260, 275, 286, 1914, 371, 408, 450,
+	1905, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 1939, 209, 204, 1987, 1962, 1896, 1851, 1857, 1757,
+	0, 203, 1852, 1853, 1854, 1855, 1856, 1935, 1806, 1822,
+	1794, 1909, 1793, 270, 1926, 440, 441, 237, 1769, 2007,
+	205, 1770, 2006, 332, 339, 331, 2010, 2008, 436, 1988,
+	1897, 1882, 1880, 1762, 1986, 1895, 1881, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 1884, 406,
+	306, 0, 200, 0, 405, 1997, 2022, 416, 218, 1788,
+	1954, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 1930, 2019, 343, 374, 225, 446,
+	402, 252, 1784, 0, 1787, 1782, 1785, 1783, 1888, 1889,
+	2002, 2003, 2004, 1942, 1777, 0, 0, 1980, 1981, 0,
+	1875, 1990, 1763, 0, 1958, 183, 184, 169, 1922, 2017,
+	1835, 233, 0, 1759, 1760, 1761, 0, 1865, 1866, 0,
+	0, 1976, 1975, 1974, 1977, 0, 2011, 2009, 2012, 1778,
+	1799, 1821, 1871, 1872, 1874, 1906, 1907, 1952, 1925, 1934,
+	1808, 1867, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 1893, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	1955, 1900, 0, 1839, 1841, 1840, 1790, 1792, 1791, 1789,
+	2021, 329, 1982, 1746, 1754, 1781, 1797, 1804, 1812, 1823,
+	1824, 1832, 1838, 1850, 1860, 1861, 1877, 1891, 1892, 1899,
+	1929, 1932, 1949, 1957, 1964, 1969, 1971, 448, 243, 1873,
+	1898, 1936, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 1846, 191, 0,
+	0, 197, 0, 198, 0, 1831, 196, 1978, 2013, 1910,
+	1924, 879, 1019, 0, 0, 425, 1086, 1023, 866, 889,
+	1032, 895, 897, 962, 841, 937, 340, 886, 842, 0,
+	0, 833, 1081, 834, 867, 249, 1079, 995, 940, 1021,
+	923, 955, 965, 248, 235, 930, 929, 1010, 878, 877,
+	960, 1006, 1020, 0, 0, 167, 453, 185, 1119, 300,
+	0, 471, 451, 404, 322, 474, 473, 921, 0, 1093,
+	1106, 906, 964, 853, 951, 1025, 887, 956, 1026, 0,
+	0, 0, 0, 0, 530, 0, 0, 0, 0, 0,
+	0, 0, 0, 166, 0, 0, 1101, 1115, 1082, 472,
+	916, 0, 1035, 961, 1037, 832, 1098, 0, 837, 1070,
+	0, 1011, 874, 875, 253, 0, 0, 0, 0, 0,
+	0, 0, 919, 936, 980, 903, 0, 445, 967, 976,
+	990, 896, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1097, 0, 0, 0, 847, 1066,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1087, 0, 0, 0, 852, 830, 872, 982,
+	831, 829, 323, 844, 1108, 1009, 904, 289, 186, 1015,
+	902, 1085, 970, 848, 999, 890, 297, 846, 190, 843,
+	849, 888, 336, 979, 985, 172, 193, 299, 996, 868,
+	881, 236, 3190, 373, 957, 444, 2358, 267, 943, 372,
+	301, 437, 971, 1017, 443, 891, 419, 452, 457, 261,
+	924, 226, 401, 251, 245, 873, 989, 836, 273, 358,
+	240, 293, 907, 963, 869, 232, 974, 950, 1001, 400,
+	434, 195, 317, 435, 456, 161, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 997, 984, 1003, 864, 850,
+	856, 851, 880, 1018, 282, 274, 1004, 1002, 882, 344,
+	217, 934, 927, 920, 1104, 447, 1033, 247, 986, 449,
+	174, 387, 386, 894, 281, 987, 176, 165, 368, 177,
+	290, 199, 1005, 460, 213, 295, 427, 2357, 266, 335,
+	959, 345, 192, 363, 313, 315, 312, 316, 271, 170,
+	178, 983, 365, 389, 432, 215, 407, 168, 171, 180,
+	378, 181, 182, 1024, 307, 256, 260, 275, 286, 958,
+	371, 408, 450, 952, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 981, 209, 204, 1013, 998, 945,
+	909, 915, 838, 0, 203, 910, 911, 912, 913, 914,
+	977, 871, 883, 863, 953, 862, 270, 969, 440, 441,
+	237, 1071, 1028, 205, 845, 1027, 332, 339, 331, 1030,
+	1029, 436, 1014, 946, 933, 931, 839, 1012, 944, 932,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 935, 406, 306, 0, 200, 0, 405, 1022, 1039,
+	416, 218, 857, 991, 431, 173, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 973, 1036, 343,
+	374, 225, 446, 402, 252, 1076, 0, 1078, 1074, 1077,
+	1075, 1094, 1095, 1116, 1117, 1118, 1105, 1072, 187, 188,
+	1113, 1114, 189, 926, 1016, 840, 0, 994, 183, 184,
+	169, 966, 1034, 1084, 233, 159, 1067, 1068, 1069, 160,
+	1088, 1089, 162, 163, 1111, 1110, 1109, 1112, 164, 1121,
+	1120, 1122, 1073, 1080, 1083, 1090, 1091, 1092, 1099, 1100,
+	1107, 1102, 1103, 0, 922, 175, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 1096, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 992, 949, 0, 899, 901, 900, 859,
+	861, 860, 858, 1038, 329, 1008, 828, 835, 854, 865,
+	870, 876, 884, 885, 893, 898, 908, 917, 918, 928,
+	941, 942, 948, 972, 975, 988, 993, 1000, 0, 0,
+	448, 243, 925, 947, 978, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 179, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	905, 191, 0, 0, 197, 0, 198, 0, 892, 196,
+	1007, 1031, 954, 968, 879, 1019, 0, 0, 425, 1086,
+	1023, 866, 889, 1032, 895, 897, 962, 841, 937, 340,
+	886, 842, 0, 0, 833, 1081, 834, 867, 249, 1079,
+	995, 940, 1021, 923, 955, 965, 248, 235, 930, 929,
+	1010, 878, 877, 960, 1006, 1020, 0, 0, 167, 453,
+	185, 1119, 300, 0, 471, 451, 404, 322, 474, 473,
+	921, 0, 1093, 1106, 906, 964, 853, 951, 1025, 887,
+	956, 1026, 0, 0, 0, 0, 0, 530, 0, 0,
+	0, 0, 0, 0, 0, 0, 166, 0, 0, 1101,
+	1115, 1082, 472, 916, 0, 1035, 961, 1037, 832, 1098,
+	0, 837, 1070, 0, 1011, 874, 875, 253, 0, 0,
+	0, 0, 0, 0, 0, 919, 936, 980, 903, 0,
+	445, 967, 976, 990, 896, 359, 272, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1097, 0, 0,
+	0, 847, 1066, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1087, 0, 0, 0, 852,
+	830, 872, 982, 831, 829, 323, 844, 1108, 1009, 904,
+	289, 186, 1015, 902, 1085, 970, 848, 999, 890, 297,
+	846, 190, 843, 849, 888, 336, 979, 985, 172, 193,
+	299, 996, 868, 881, 236, 0, 373, 957, 444, 2358,
+	267, 943, 372, 301, 437, 971, 1017, 443, 891, 419,
+	452, 457, 261, 924, 226, 401, 251, 245, 873, 989,
+	836, 273, 358, 240, 293, 907, 963, 869, 232, 974,
+	950, 1001, 400, 434, 195, 317, 435, 456, 161, 262,
+	392, 263, 418, 254, 227, 361, 214, 426, 318, 328,
+	229, 231, 230, 208, 393, 433, 220, 234, 997, 984,
+	1003, 864, 850, 856, 851, 880, 1018, 282, 274, 1004,
+	1002, 882, 344, 217, 934, 927, 920, 1104, 447, 1033,
+	247, 986, 449, 174, 387, 386, 894, 281, 987, 176,
+	165, 368, 177, 290, 199, 1005, 460, 213, 295, 427,
+	2357, 266, 335, 959, 345, 192, 363, 313, 315, 312,
+	316, 271, 170, 178, 983, 365, 389, 432, 215, 407,
+	168, 171, 180, 378, 181, 182, 1024, 307, 256, 260,
+	275, 286, 958, 371, 408, 450, 952, 210, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 375, 409, 423,
+	380, 269, 411, 415, 412, 413, 410, 414, 376, 377,
+	202, 417, 442, 221, 388, 391, 459, 981, 209, 204,
+	1013, 998, 945, 909, 915, 838, 0, 203, 910, 911,
+	912, 913, 914, 977, 871, 883, 863, 953, 862, 270,
+	969, 440, 441, 237, 1071, 1028, 205, 845, 1027, 332,
+	339, 331, 1030, 1029, 436, 1014, 946, 933, 931, 839,
+	1012, 944, 932, 296, 259, 277, 356, 303, 357, 278,
+	326, 325, 327, 305, 935, 406, 306, 0, 200, 0,
+	405, 1022, 1039, 416, 218, 857, 991, 431, 173, 364,
+	219, 268, 257, 355, 330, 211, 280, 403, 294, 302,
+	973, 1036, 343, 374, 225, 446, 402, 252, 1076, 0,
+	1078, 1074, 1077, 1075, 1094, 1095, 1116, 1117, 1118, 1105,
+	1072, 187, 188, 1113, 1114, 189, 926, 1016, 840, 0,
+	994, 183, 184, 169, 966, 1034, 1084, 233, 159, 1067,
+	1068, 1069, 160, 1088, 1089, 162, 163, 1111, 1110, 1109,
+	1112, 164, 1121, 1120, 1122, 1073, 1080, 1083, 1090, 1091,
+	1092, 1099, 1100, 1107, 1102, 1103, 0, 922, 175, 351,
+	201, 212, 430, 224, 244, 242, 258, 291, 314, 320,
+	349, 390, 396, 397, 420, 421, 422, 424, 246, 0,
+	250, 223, 369, 222, 304, 283, 350, 428, 429, 360,
+	239, 1096, 194, 206, 298, 367, 265, 319, 394, 321,
+	287, 238, 458, 324, 366, 461, 992, 949, 0, 899,
+	901, 900, 859, 861, 860, 858, 1038, 329, 1008, 828,
+	835, 854, 865, 870, 876, 884, 885, 893, 898, 908,
+	917, 918, 928, 941, 942, 948, 972, 975, 988, 993,
+	1000, 0, 0, 448, 243, 925, 947, 978, 207, 216,
+	228, 241, 255, 0, 264, 276, 279, 284, 285, 288,
+	292, 308, 309, 310, 311, 333, 334, 337, 338, 341,
+	342, 346, 347, 348, 352, 353, 354, 362, 179, 370,
+	379, 381, 382, 383, 384, 385, 395, 398, 399, 438,
+	439, 454, 455, 905, 191, 0, 0, 197, 0, 198,
+	0, 892, 196, 1007, 1031, 954, 968, 879, 1019, 0,
+	0, 425, 1086, 1023, 866, 889, 1032, 895, 897, 962,
+	841, 937, 340, 886, 842, 0, 0, 833, 1081, 834,
+	867, 249, 1079, 995, 940, 1021, 923, 955, 965, 248,
+	235, 930, 929, 1010, 878, 877, 960, 1006, 1020, 0,
+	0, 167, 453, 185, 1119, 300, 0, 0, 451, 404,
+	322, 0, 0, 921, 0, 1093, 1106, 906, 964, 853,
+	951, 1025, 887, 956, 1026, 0, 0, 0, 0, 0,
+	530, 0, 0, 1435, 0, 0, 1436, 0, 0, 166,
+	0, 0, 1101, 1115, 1082, 0, 916, 0, 1035, 961,
+	1037, 832, 1098, 0, 837, 1070, 0, 1011, 874, 875,
+	253, 0, 0, 0, 0, 0, 0, 0, 919, 936,
+	980, 903, 0, 445, 967, 976, 990, 896, 359, 272,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1097, 0, 0, 0, 847, 1066, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1087, 0,
+	0, 0, 852, 830, 872, 982, 831, 829, 323, 844,
+	1108, 1009, 904, 289, 186, 1015, 902, 1085, 970, 848,
+	999, 890, 297, 846, 190, 843, 849, 888, 336, 979,
+	985, 172, 193, 299, 996, 868, 881, 236, 0, 373,
+	957, 444, 1065, 267, 943, 372, 301, 437, 971, 1017,
+	443, 891, 419, 452, 457, 261, 924, 226, 401, 251,
+	245, 873, 989, 836, 273, 358, 240, 293, 907, 963,
+	869, 232, 974, 950, 1001, 400, 434, 195, 317, 435,
+	456, 161, 262, 392, 263, 418, 254, 227, 361, 214,
+	426, 318, 328, 229, 231, 230, 208, 393, 433, 220,
+	234, 997, 984, 1003, 864, 850, 856, 851, 880, 1018,
+	282, 274, 1004, 1002, 882, 344, 217, 934, 927, 920,
+	1104, 447, 1033, 247, 986, 449, 174, 387, 386, 894,
+	281, 987, 176, 165, 368, 177, 290, 199, 1005, 460,
+	213, 295, 427, 1064, 266, 335, 959, 345, 192, 363,
+	313, 315, 312, 316, 271, 170, 178, 983, 365, 389,
+	432, 215, 407, 168, 171, 180, 378, 181, 182, 1024,
+	307, 256, 260, 275, 286, 958, 371, 408, 450, 952,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	375, 409, 423, 380, 269, 411, 415, 412, 413, 410,
+	414, 376, 377, 202, 417, 442, 221, 388, 391, 459,
+	981, 209, 204, 1013, 998, 945, 909, 915, 838, 0,
+	203, 910, 911, 912, 913, 914, 977, 871, 883, 863,
+	953, 862, 270, 969, 440, 441, 237, 1071, 1028, 205,
+	845, 1027, 332, 339, 331, 1030, 1029, 436, 1014, 946,
+	933, 931, 839, 1012, 944, 932, 296, 259, 277, 356,
+	303, 357, 278, 326, 325, 327, 305, 935, 406, 306,
+	0, 200, 0, 405, 1022, 1039, 416, 218, 857, 991,
+	431, 173, 364, 219, 268, 257, 355, 330, 211, 280,
+	403, 294, 302, 973, 1036, 343, 374, 225, 446, 402,
+	252, 1076, 0, 1078, 1074, 1077, 1075, 1094, 1095, 1116,
+	1117, 1118, 1105, 1072, 187, 188, 1113, 1114, 189, 926,
+	1016, 840, 0, 994, 183, 184, 169, 966, 1034, 1084,
+	233, 159, 1067, 1068, 1069, 160, 1088, 1089, 162, 163,
+	1111, 1110, 1109, 1112, 164, 1121, 1120, 1122, 1073, 1080,
+	1083, 1090, 1091, 1092, 1099, 1100, 1107, 1102, 1103, 0,
+	922, 175, 351, 201, 212, 430, 224, 244, 242, 258,
+	291, 314, 320, 349, 390, 396, 397, 420, 421, 422,
+	424, 246, 0, 250, 223, 369, 222, 304, 283, 350,
+	428, 429, 360, 239, 1096, 194, 206, 298, 367, 265,
+	319, 394, 321, 287, 238, 458, 324, 366, 461, 992,
+	949, 0, 899, 901, 900, 859, 861, 860, 858, 1038,
+	329, 1008, 828, 835, 854, 865, 870, 876, 884, 885,
+	893, 898, 908, 917, 918, 928, 941, 942, 948, 972,
+	975, 988, 993, 1000, 0, 0, 448, 243, 925, 947,
+	978, 207, 216, 228, 241, 255, 0, 264, 276, 279,
+	284, 285, 288, 292, 308, 309, 310, 311, 333, 334,
+	337, 338, 341, 342, 346, 347, 348, 352, 353, 354,
+	362, 179, 370, 379, 381, 382, 383, 384, 385, 395,
+	398, 399, 438, 439, 454, 455, 905, 191, 0, 0,
+	197, 0, 198, 0, 892, 196, 1007, 1031, 954, 968,
+	879, 1019, 0, 0, 425, 1086, 1023, 866, 889, 1032,
+	895, 897, 962, 841, 937, 340, 886, 842, 0, 0,
+	833, 1081, 834, 867, 249, 1079, 995, 940, 1021, 923,
+	955, 965, 248, 235, 930, 929, 1010, 878, 877, 960,
+	1006, 1020, 0, 0, 167, 453, 185, 1119, 300, 0,
+	0, 451, 404, 322, 0, 0, 921, 0, 1093, 1106,
+	906, 964, 853, 951, 1025, 887, 956, 1026, 0, 0,
+	0, 0, 0, 530, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 1101, 1115, 1082, 0, 916,
+	0, 1035, 961, 1037, 832, 1098, 0, 837, 1070, 0,
+	1011, 874, 875, 253, 0, 0, 0, 0, 0, 0,
+	0, 919, 936, 980, 903, 0, 445, 967, 976, 990,
+	896, 359, 272, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1097, 0, 0, 0, 847, 1066, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1087, 0, 0, 0, 852, 830, 872, 982, 831,
+	829, 323, 844, 1108, 1009, 904, 289, 186, 1015, 902,
+	1085, 970, 848, 999, 890, 297, 846, 190, 843, 849,
+	888, 336, 979, 985, 172, 193, 299, 996, 868, 881,
+	236, 0, 373, 957, 444, 1065, 267, 943, 372, 301,
+	437, 971, 1017, 443, 891, 419, 452, 457, 261, 924,
+	226, 401, 251, 245, 873, 989, 836, 273, 358, 240,
+	293, 907, 963, 869, 232, 974, 950, 1001, 400, 434,
+	195, 317, 435, 456, 161, 262, 392, 263, 418, 254,
+	227, 361, 214, 426, 318, 328, 229, 231, 230, 208,
+	393, 433, 220, 234, 997, 984, 1003, 864, 850, 856,
+	851, 880, 1018, 282, 274, 1004, 1002, 882, 344, 217,
+	934, 927, 920, 1104, 447, 1033, 247, 986, 449, 174,
+	387, 386, 894, 281, 987, 176, 165, 368, 177, 290,
+	199, 1005, 460, 213, 295, 427, 1064, 266, 335, 959,
+	345, 192, 363, 313, 315, 312, 316, 271, 170, 178,
+	983, 365, 389, 432, 215, 407, 168, 171, 180, 378,
+	181, 182, 1024, 307, 256, 260, 275, 286, 958, 371,
+	408, 450, 952, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 375, 409, 423, 380, 269, 411, 415,
+	412, 413, 410, 414, 376, 377, 202, 417, 442, 221,
+	388, 391, 459, 981, 209, 204, 1013, 998, 945, 909,
+	915, 838, 0, 203, 910, 911, 912, 913, 914, 977,
+	871, 883, 863, 953, 862, 270, 969, 440, 441, 237,
+	1071, 1028, 205, 845, 1027, 332, 339, 331, 1030, 1029,
+	436, 1014, 946, 933, 931, 839, 1012, 944, 932, 296,
+	259, 277, 356, 303, 357, 278, 326, 325, 327, 305,
+	935, 406, 306, 0, 200, 0, 405, 1022, 1039, 416,
+	218, 857, 991, 431, 173, 364, 219, 268, 257, 355,
+	330, 211, 280, 403, 294, 302, 973, 1036, 343, 374,
+	225, 446, 402, 252, 1076, 0, 1078, 1074, 1077, 1075,
+	1094, 1095, 1116, 1117, 1118, 1105, 1072, 187, 188, 1113,
+	1114, 189, 926, 1016, 840, 0, 994, 183, 184, 169,
+	966, 1034, 1084, 233, 159, 1067, 1068, 1069, 160, 1088,
+	1089, 162, 163, 1111, 1110, 1109, 1112, 164, 1121, 1120,
+	1122, 1073, 1080, 1083, 1090, 1091, 1092, 1099, 1100, 1107,
+	1102, 1103, 0, 922, 175, 351, 201, 212, 430, 224,
+	244, 242, 258, 291, 314, 320, 349, 390, 396, 397,
+	420, 421, 422, 424, 246, 0, 250, 223, 369, 222,
+	304, 283, 350, 428, 429, 360, 239, 1096, 194, 206,
+	298, 367, 265, 319, 394, 321, 287, 238, 458, 324,
+	366, 461, 992, 949, 0, 899, 901, 900, 859, 861,
+	860, 858, 1038, 329, 1008, 828, 835, 854, 865, 870,
+	876, 884, 885, 893, 898, 908, 917, 918, 928, 941,
+	942, 948, 972, 975, 988, 993, 1000, 0, 0, 448,
+	243, 925, 947, 978, 207, 216, 228, 241, 255, 0,
+	264, 276, 279, 284, 285, 288, 292, 308, 309, 310,
+	311, 333, 334, 337, 338, 341, 342, 346, 347, 348,
+	352, 353, 354, 362, 179, 370, 379, 381, 382, 383,
+	384, 385, 395, 398, 399, 438, 439, 454, 455, 905,
+	191, 0, 0, 197, 0, 198, 0, 892, 196, 1007,
+	1031, 954, 968, 879, 1019, 0, 0, 425, 1086, 1023,
+	866, 889, 1032, 895, 897, 962, 841, 937, 340, 886,
+	842, 0, 0, 833, 1081, 834, 867, 249, 1079, 995,
+	940, 1021, 923, 955, 965, 248, 235, 930, 929, 1010,
+	878, 877, 960, 1006, 1020, 0, 0, 167, 453, 185,
+	1119, 300, 0, 0, 451, 404, 322, 0, 0, 921,
+	0, 1093, 1106, 906, 964, 853, 951, 1025, 887, 956,
+	1026, 0, 0, 0, 0, 0, 530, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 1101, 1115,
+	1082, 0, 916, 0, 1035, 961, 1037, 832, 1098, 0,
+	837, 1070, 0, 1011, 874, 875, 253, 0, 0, 0,
+	0, 0, 0, 0, 919, 936, 980, 903, 0, 445,
+	967, 976, 990, 896, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1097, 0, 0, 0,
+	847, 1066, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1087, 0, 0, 0, 852, 830,
+	872, 982, 831, 829, 323, 844, 1108, 1009, 904, 289,
+	186, 1015, 902, 1085, 970, 848, 999, 890, 297, 846,
+	190, 843, 849, 888, 336, 979, 985, 172, 193, 299,
+	996, 868, 881, 236, 0, 373, 957, 444, 1065, 267,
+	943, 372, 301, 437, 971, 1017, 443, 891, 419, 452,
+	457, 261, 924, 226, 401, 251, 245, 873, 989, 836,
+	273, 358, 240, 293, 907, 963, 869, 232, 974, 950,
+	1001, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 997, 984, 1003,
+	864, 850, 856, 851, 880, 1018, 282, 274, 1004, 1002,
+	882, 344, 217, 934, 927, 920, 1104, 447, 1033, 247,
+	986, 449, 174, 387, 386, 894, 281, 987, 176, 165,
+	368, 177, 290, 199, 1005, 460, 213, 295, 427, 1064,
+	266, 335, 959, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 983, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 1024, 307, 256, 260, 275,
+	286, 958, 371, 408, 450, 952, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 981, 209, 204, 1013,
+	998, 945, 909, 915, 838, 0, 203, 910, 911, 912,
+	913, 914, 977, 871, 883, 863, 953, 862, 270, 969,
+	440, 441, 237, 1071, 1028, 205, 1059, 1027, 332, 339,
+	331, 1030, 1029, 436, 1014, 946, 933, 931, 839, 1012,
+	944, 932, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 1055, 935, 406, 306, 0, 200, 0, 405,
+	1022, 1039, 416, 218, 857, 991, 431, 173, 364, 219,
+	268, 257, 355, 1060, 1058, 1049, 1050, 294, 302, 973,
+	1036, 343, 374, 225, 446, 402, 252, 1076, 0, 1078,
+	1074, 1077, 1075, 1094, 1095, 1116, 1117, 1118, 1105, 1072,
+	187, 188, 1113, 1114, 189, 926, 1016, 840, 0, 994,
+	183, 184, 169, 966, 1034, 1084, 233, 159, 1067, 1068,
+	1069, 160, 1088, 1089, 162, 163, 1111, 1110, 1109, 1112,
+	164, 1121, 1120, 1122, 1073, 1080, 1083, 1090, 1091, 1092,
+	1099, 1100, 1107, 1102, 1103, 0, 922, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	1096, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 992, 949, 0, 899, 901,
+	900, 859, 861, 860, 858, 1038, 329, 1008, 828, 835,
+	854, 865, 870, 876, 884, 885, 893, 898, 908, 917,
+	918, 928, 941, 942, 948, 972, 975, 988, 993, 1000,
+	0, 0, 448, 243, 925, 947, 978, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 1056, 1057, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 905, 191, 0, 0, 197, 0, 198, 0,
+	892, 196, 1007, 1031, 954, 968, 879, 1019, 0, 0,
+	425, 1086, 1023, 866, 889, 1032, 895, 897, 962, 841,
+	937, 340, 886, 842, 0, 0, 833, 1081, 834, 867,
+	249, 1079, 995, 940, 1021, 923, 955, 965, 248, 235,
+	930, 929, 1010, 878, 877, 960, 1006, 1020, 0, 0,
+	167, 453, 185, 1119, 300, 0, 0, 451, 404, 322,
+	0, 0, 921, 0, 1093, 1106, 906, 964, 853, 951,
+	1025, 887, 956, 1026, 0, 0, 0, 0, 0, 530,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 1101, 1115, 1082, 0, 916, 0, 1035, 961, 1037,
+	832, 1098, 0, 837, 1070, 0, 1011, 874, 875, 253,
+	0, 0, 0, 0, 0, 0, 0, 919, 936, 980,
+	903, 0, 445, 967, 976, 990, 896, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1097,
+	0, 0, 0, 847, 1066, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1087, 0, 0,
+	0, 852, 830, 872, 982, 831, 829, 323, 844, 1108,
+	1009, 904, 289, 186, 1015, 902, 1085, 970, 848, 999,
+	890, 297, 846, 190, 843, 849, 888, 336, 979, 985,
+	172, 193, 299, 996, 868, 881, 236, 0, 373, 957,
+	444, 1065, 267, 943, 372, 301, 437, 971, 1017, 443,
+	891, 419, 452, 457, 261, 924, 226, 401, 251, 245,
+	873, 989, 836, 273, 358, 240, 293, 907, 963, 869,
+	232, 974, 950, 1001, 400, 434, 195, 317, 435, 456,
+	161, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	997, 984, 1003, 864, 850, 856, 851, 880, 1018, 282,
+	274, 1004, 1002, 882, 344, 217, 934, 927, 920, 1104,
+	447, 1033, 247, 986, 449, 174, 387, 386, 894, 281,
+	987, 176, 165, 368, 177, 290, 199, 1005, 460, 213,
+	295, 427, 1064, 266, 335, 959, 345, 192, 363, 313,
+	315, 312, 316, 271, 170, 178, 983, 365, 389, 432,
+	215, 407, 168, 171, 180, 378, 181, 182, 1024, 307,
+	256, 260, 275, 286, 958, 371, 408, 450, 952, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 1605, 221, 388, 391, 459, 981,
+	209, 204, 1013, 998, 945, 909, 915, 838, 0, 203,
+	910, 911, 912, 913, 914, 977, 871, 883, 863, 953,
+	862, 270, 969, 440, 441, 237, 1071, 1028, 205, 845,
+	1027, 332, 339, 331, 1030, 1029, 436, 1014, 946, 933,
+	931, 839, 1012, 944, 932, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 935, 406, 306, 0,
+	200, 0, 405, 1022, 1039, 416, 218, 857, 991, 431,
+	173, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 973, 1036, 343, 374, 225, 446, 402, 252,
+	1076, 0, 1078, 1074, 1077, 1075, 1094, 1095, 1116, 1117,
+	1118, 1105, 1072, 187, 188, 1113, 1114, 189, 926, 1016,
+	840, 0, 994, 183, 184, 169, 966, 1034, 1084, 233,
+	159, 1067, 1068, 1069, 160, 1088, 1089, 162, 163, 1111,
+	1110, 1109, 1112, 164, 1121, 1120, 1122, 1073, 1080, 1083,
+	1090, 1091, 1092, 1099, 1100, 1107, 1102, 1103, 0, 922,
+	175, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 1096, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 992, 949,
+	0, 899, 901, 900, 859, 861, 860, 858, 1038, 329,
+	1008, 828, 835, 854, 865, 870, 876, 884, 885, 893,
+	898, 908, 917, 918, 928, 941, 942, 948, 972, 975,
+	988, 993, 1000, 0, 0, 448, 243, 925, 947, 978,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	179, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 905, 191, 0, 0, 197,
+	0, 198, 0, 892, 196, 1007, 1031, 954, 968, 879,
+	1019, 0, 0, 425, 1086, 1023, 866, 889, 1032, 895,
+	897, 962, 841, 937, 340, 886, 842, 0, 0, 833,
+	1081, 834, 867, 249, 1079, 995, 940, 1021, 923, 955,
+	965, 248, 235, 930, 929, 1010, 878, 877, 960, 1006,
+	1020, 0, 0, 167, 453, 185, 1119, 300, 0, 0,
+	451, 404, 322, 0, 0, 921, 0, 1093, 1106, 906,
+	964, 853, 951, 1025, 887, 956, 1026, 0, 0, 0,
+	0, 0, 530, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 0, 1101, 1115, 1082, 0, 916, 0,
+	1035, 961, 1037, 832, 1098, 0, 837, 1070, 0, 1011,
+	874, 875, 253, 0, 0, 0, 0, 0, 0, 0,
+	919, 936, 980, 903, 0, 445, 967, 976, 990, 896,
+	359, 272, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1097, 0, 0, 0, 847, 1066, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1087, 0, 0, 0, 852, 830, 872, 982, 831, 829,
+	323, 844, 1108, 1009, 904, 289, 186, 1015, 902, 1085,
+	970, 848, 999, 890, 297, 846, 190, 843, 849, 888,
+	336, 979, 985, 172, 193, 299, 996, 868, 881, 236,
+	0, 373, 957, 444, 1065, 267, 943, 372, 301, 437,
+	971, 1017, 443, 891, 419, 452, 457, 261, 924, 226,
+	401, 251, 245, 873, 989, 836, 273, 358, 240, 293,
+	907, 963, 869, 232, 974, 950, 1001, 400, 434, 195,
+	317, 435, 456, 161, 262, 392, 263, 418, 254, 227,
+	361, 214, 426, 318, 328, 229, 231, 230, 208, 393,
+	433, 220, 234, 997, 984, 1003, 864, 850, 856, 851,
+	880, 1018, 282, 274, 1004, 1002, 882, 344, 217, 934,
+	927, 920, 1104, 447, 1033, 247, 986, 449, 174, 387,
+	386, 894, 281, 987, 176, 165, 368, 177, 290, 199,
+	1005, 460, 213, 295, 427, 1064, 266, 335, 959, 345,
+	192, 363, 313, 315, 312, 316, 271, 170, 178, 983,
+	365, 389, 432, 215, 407, 168, 171, 180, 378, 181,
+	182, 1024, 307, 256, 260, 275, 286, 958, 371, 408,
+	450, 952, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 375, 409, 423, 380, 269, 411, 415, 412,
+	413, 410, 414, 376, 377, 202, 417, 1046, 221, 388,
+	391, 459, 981, 209, 204, 1013, 998, 945, 909, 915,
+	838, 0, 203, 910, 911, 912, 913, 914, 977, 871,
+	883, 863, 953, 862, 270, 969, 440, 441, 237, 1071,
+	1028, 205, 1059, 1027, 332, 339, 331, 1030, 1029, 436,
+	1014, 946, 933, 931, 839, 1012, 944, 932, 296, 259,
+	277, 356, 303, 357, 278, 326, 325, 327, 1055, 935,
+	406, 306, 0, 200, 0, 405, 1022, 1039, 416, 218,
+	857, 991, 431, 173, 364, 219, 268, 257, 355, 1060,
+	1058, 1049, 1050, 294, 302, 973, 1036, 343, 374, 225,
+	446, 402, 252, 1076, 0, 1078, 1074, 1077, 1075, 1094,
+	1095, 1116, 1117, 1118, 1105, 1072, 187, 188, 1113, 1114,
+	189, 926, 1016, 840, 0, 994, 183, 184, 169, 966,
+	1034, 1084, 233, 159, 1067, 1068, 1069, 160, 1088, 1089,
+	162, 163, 1111, 1110, 1109, 1112, 164, 1121, 1120, 1122,
+	1073, 1080, 1083, 1090, 1091, 1092, 1099, 1100, 1107, 1102,
+	1103, 0, 922, 175, 351, 201, 212, 430, 224, 244,
+	242, 258, 291, 314, 320, 349, 390, 396, 397, 420,
+	421, 422, 424, 246, 0, 250, 223, 369, 222, 304,
+	283, 350, 428, 429, 360, 239, 1096, 194, 206, 298,
+	367, 265, 319, 394, 321, 287, 238, 458, 324, 366,
+	461, 992, 949, 0, 899, 901, 900, 859, 861, 860,
+	858, 1038, 329, 1008, 828, 835, 854, 865, 870, 876,
+	884, 885, 893, 898, 908, 917, 918, 928, 941, 942,
+	948, 972, 975, 988, 993, 1000, 0, 0, 448, 243,
+	925, 947, 978, 207, 216, 228, 241, 255, 0, 264,
+	276, 279, 284, 285, 288, 292, 308, 309, 310, 311,
+	333, 334, 337, 338, 341, 342, 346, 347, 348, 352,
+	1056, 1057, 362, 179, 370, 379, 1045, 382, 383, 384,
+	385, 395, 398, 399, 438, 439, 454, 455, 905, 191,
+	0, 0, 197, 0, 198, 0, 892, 196, 1007, 1031,
+	954, 968, 1815, 1994, 0, 1956, 425, 1849, 1998, 1798,
+	1828, 2015, 1834, 1837, 1918, 1764, 1887, 340, 1825, 1765,
+	1748, 1803, 1752, 1816, 1753, 1800, 249, 1796, 1959, 1890,
+	1996, 1869, 1911, 1921, 248, 235, 1879, 1878, 1984, 1814,
+	1813, 1916, 1973, 1995, 1868, 0, 0, 453, 0, 2005,
+	300, 1970, 0, 451, 404, 322, 0, 0, 1864, 1979,
+	1885, 1948, 1847, 1920, 1780, 1903, 2000, 1826, 1912, 2001,
+	0, 0, 0, 0, 0, 3243, 0, 3238, 3246, 3248,
+	3247, 0, 0, 0, 3240, 0, 0, 1908, 1992, 1819,
+	0, 1859, 0, 2018, 1917, 2020, 1751, 1904, 0, 1756,
+	1767, 2014, 1985, 1810, 1811, 253, 0, 0, 0, 0,
+	0, 0, 0, 1862, 1886, 1938, 1844, 0, 445, 1923,
+	1933, 1951, 1836, 359, 272, 0, 0, 0, 0, 0,
+	0, 0, 0, 1805, 0, 1901, 0, 0, 0, 1772,
+	1758, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1858, 0, 0, 0, 1779, 1749, 1807,
+	1940, 1750, 1747, 323, 1768, 1953, 1983, 1845, 289, 0,
+	1989, 1843, 1842, 1927, 1773, 1963, 1829, 297, 1771, 190,
+	1766, 1774, 1827, 336, 1937, 1945, 0, 193, 299, 1960,
+	1801, 1818, 236, 0, 373, 1913, 444, 0, 267, 1894,
+	372, 301, 437, 1928, 1991, 443, 1830, 419, 452, 457,
+	261, 1870, 226, 401, 251, 245, 1809, 1950, 1755, 273,
+	358, 240, 293, 1848, 1919, 1802, 232, 1931, 1902, 1965,
+	400, 434, 195, 317, 435, 456, 3241, 262, 392, 263,
+	418, 254, 227, 361, 214, 426, 318, 328, 229, 231,
+	230, 208, 393, 433, 220, 234, 1961, 1944, 1967, 1795,
+	1775, 1786, 1776, 1817, 1993, 282, 274, 1968, 1966, 1820,
+	344, 217, 1883, 1876, 1863, 1941, 447, 2016, 247, 1946,
+	449, 0, 387, 386, 1833, 281, 1947, 0, 0, 368,
+	3242, 290, 199, 1972, 460, 213, 295, 427, 0, 266,
+	335, 1915, 345, 192, 363, 313, 315, 312, 316, 271,
+	0, 0, 1943, 365, 389, 432, 215, 407, 0, 0,
+	0, 378, 0, 0, 1999, 307, 256, 260, 275, 286,
+	1914, 371, 408, 450, 1905, 210, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 375, 409, 423, 380, 269,
+	411, 415, 412, 413, 410, 414, 376, 377, 202, 417,
+	442, 221, 388, 391, 459, 1939, 209, 204, 1987, 1962,
+	1896, 1851, 1857, 1757, 0, 203, 1852, 1853, 1854, 1855,
+	1856, 1935, 1806, 1822, 1794, 1909, 1793, 270, 1926, 440,
+	441, 237, 1769, 2007, 205, 1770, 2006, 332, 339, 331,
+	2010, 2008, 436, 1988, 1897, 1882, 1880, 1762, 1986, 1895,
+	1881, 296, 259, 277, 356, 303, 357, 278, 326, 325,
+	327, 305, 1884, 406, 306, 0, 200, 0, 405, 1997,
+	2022, 416, 218, 1788, 1954, 431, 0, 364, 219, 268,
+	257, 355, 330, 211, 280, 403, 294, 302, 1930, 2019,
+	343, 374, 225, 446, 402, 252, 1784, 0, 1787, 1782,
+	1785, 1783, 1888, 1889, 2002, 2003, 2004, 1942, 1777, 0,
+	0, 1980, 1981, 0, 1875, 1990, 1763, 0, 1958, 0,
+	0, 0, 1922, 2017, 1835, 233, 0, 1759, 1760, 1761,
+	0, 1865, 1866, 0, 0, 1976, 1975, 1974, 1977, 0,
+	2011, 2009, 2012, 1778, 1799, 1821, 1871, 1872, 1874, 1906,
+	1907, 1952, 1925, 1934, 1808, 1867, 0, 351, 201, 212,
+	430, 224, 244, 242, 258, 291, 314, 320, 349, 390,
+	396, 397, 420, 421, 422, 424, 246, 0, 250, 223,
+	369, 222, 304, 283, 350, 428, 429, 360, 239, 1893,
+	194, 206, 298, 367, 265, 319, 394, 321, 287, 238,
+	458, 324, 366, 461, 1955, 1900, 0, 1839, 1841, 1840,
+	1790, 1792, 1791, 1789, 2021, 329, 1982, 1746, 1754, 1781,
+	1797, 1804, 1812, 1823, 1824, 1832, 1838, 1850, 1860, 1861,
+	1877, 1891, 1892, 1899, 1929, 1932, 1949, 1957, 1964, 1969,
+	1971, 448, 243, 1873, 1898, 1936, 207, 216, 228, 241,
+	255, 0, 264, 276, 279, 284, 285, 288, 292, 308,
+	309, 310, 311, 333, 334, 337, 338, 341, 342, 346,
+	347, 348, 352, 353, 354, 362, 0, 370, 379, 381,
+	382, 383, 384, 385, 395, 398, 399, 438, 439, 454,
+	455, 1846, 191, 0, 0, 197, 0, 198, 0, 1831,
+	196, 1978, 2013, 1910, 1924, 1815, 1994, 0, 1956, 425,
+	1849, 1998, 1798, 1828, 2015, 1834, 1837, 1918, 1764, 1887,
+	340, 1825, 1765, 1748, 1803, 1752, 1816, 1753, 1800, 249,
+	1796, 1959, 1890, 1996, 1869, 1911, 1921, 248, 235, 1879,
+	1878, 1984, 1814, 1813, 1916, 1973, 1995, 1868, 0, 0,
+	453, 0, 2005, 300, 1970, 0, 451, 404, 322, 0,
+	0, 1864, 1979, 1885, 1948, 1847, 1920, 1780, 1903, 2000,
+	1826, 1912, 2001, 0, 0, 0, 0, 0, 3243, 0,
+	3558, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1908, 1992, 1819, 0, 1859, 0, 2018, 1917, 2020, 1751,
+	1904, 0, 1756, 1767, 2014, 1985, 1810, 1811, 253, 0,
+	0, 0, 0, 0, 0, 0, 1862, 1886, 1938, 1844,
+	0, 445, 1923, 1933, 1951, 1836, 359, 272, 0, 0,
+	0, 0, 0, 0, 0, 0, 1805, 0, 1901, 0,
+	0, 0, 1772, 1758, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1858, 0, 0, 0,
+	1779, 1749, 1807, 1940, 1750, 1747, 323, 1768, 1953, 1983,
+	1845, 289, 0, 1989, 1843, 1842, 1927, 1773, 1963, 1829,
+	297, 1771, 190, 1766, 1774, 1827, 336, 1937, 1945, 0,
+	193, 299, 1960, 1801, 1818, 236, 0, 373, 1913, 444,
+	0, 267, 1894, 372, 301, 437, 1928, 1991, 443, 1830,
+	419, 452, 457, 261, 1870, 226, 401, 251, 245, 1809,
+	1950, 1755, 273, 358, 240, 293, 1848, 1919, 1802, 232,
+	1931, 1902, 1965, 400, 434, 195, 317, 435, 456, 0,
+	262, 392, 263, 418, 254, 227, 361, 214, 426, 318,
+	328, 229, 231, 230, 208, 393, 433, 220, 234, 1961,
+	1944, 1967, 1795, 1775, 1786, 1776, 1817, 1993, 282, 274,
+	1968, 1966, 1820, 344, 217, 1883, 1876, 1863, 1941, 447,
+	2016, 247, 1946, 449, 0, 387, 386, 1833, 281, 1947,
+	0, 0, 368, 0, 290, 199, 1972, 460, 213, 295,
+	427, 0, 266, 335, 1915, 345, 192, 363, 313, 315,
+	312, 316, 271, 0, 0, 1943, 365, 389, 432, 215,
+	407, 0, 0, 0, 378, 0, 0, 1999, 307, 256,
+	260, 275, 286, 1914, 371, 408, 450, 1905, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 375, 409,
+	423, 380, 269, 411, 415, 412, 413, 410, 414, 376,
+	377, 202, 417, 442, 221, 388, 391, 459, 1939, 209,
+	204, 1987, 1962, 1896, 1851, 1857, 1757, 0, 203, 1852,
+	1853, 1854, 1855, 1856, 1935, 1806, 1822, 1794, 1909, 1793,
+	270, 1926, 440, 441, 237, 1769, 2007, 205, 1770, 2006,
+	332, 339, 331, 2010, 2008, 436, 1988, 1897, 1882, 1880,
+	1762, 1986, 1895, 1881, 296, 259, 277, 356, 303, 357,
+	278, 326, 325, 327, 305, 1884, 406, 306, 0, 200,
+	0, 405, 1997, 2022, 416, 218, 1788, 1954, 431, 0,
+	364, 219, 268, 257, 355, 330, 211, 280, 403, 294,
+	302, 1930, 2019, 343, 374, 225, 446, 402, 252, 1784,
+	0, 1787, 1782, 1785, 1783, 1888, 1889, 2002, 2003, 2004,
+	1942, 1777, 0, 0, 1980, 1981, 0, 1875, 1990, 1763,
+	0, 1958, 0, 0, 0, 1922, 2017, 1835, 233, 0,
+	1759, 1760, 1761, 0, 1865, 1866, 0, 0, 1976, 1975,
+	1974, 1977, 0, 2011, 2009, 2012, 1778, 1799, 1821, 1871,
+	1872, 1874, 1906, 1907, 1952, 1925, 1934, 1808, 1867, 0,
+	351, 201, 212, 430, 224, 244, 242, 258, 291, 314,
+	320, 349, 390, 396, 397, 420, 421, 422, 424, 246,
+	0, 250, 223, 369, 222, 304, 283, 350, 428, 429,
+	360, 239, 1893, 194, 206, 298, 367, 265, 319, 394,
+	321, 287, 238, 458, 324, 366, 461, 1955, 1900, 0,
+	1839, 1841, 1840, 1790, 1792, 1791, 1789, 2021, 329, 1982,
+	1746, 1754, 1781, 1797, 1804, 1812, 1823, 1824, 1832, 1838,
+	1850, 1860, 1861, 1877, 1891, 1892, 1899, 1929, 1932, 1949,
+	1957, 1964, 1969, 1971, 448, 243, 1873, 1898, 1936, 207,
+	216, 228, 241, 255, 0, 264, 276, 279, 284, 285,
+	288, 292, 308, 309, 310, 311, 333, 334, 337, 338,
+	341, 342, 346, 347, 348, 352, 353, 354, 362, 0,
+	370, 379, 381, 382, 383, 384, 385, 395, 398, 399,
+	438, 439, 454, 455, 1846, 191, 0, 0, 197, 0,
+	198, 0, 1831, 196, 1978, 2013, 1910, 1924, 1815, 1994,
+	0, 1956, 425, 1849, 1998, 1798, 1828, 2015, 1834, 1837,
+	1918, 1764, 1887, 340, 1825, 1765, 1748, 1803, 1752, 1816,
+	1753, 1800, 249, 1796, 1959, 1890, 1996, 1869, 1911, 1921,
+	248, 235, 1879, 1878, 1984, 1814, 1813, 1916, 1973, 1995,
+	1868, 0, 0, 453, 0, 2005, 300, 1970, 0, 451,
+	404, 322, 0, 0, 1864, 1979, 1885, 1948, 1847, 1920,
+	1780, 1903, 2000, 1826, 1912, 2001, 0, 0, 0, 0,
+	0, 1137, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1908, 1992, 1819, 0, 1859, 0, 2018,
+	1917, 2020, 1751, 1904, 0, 1756, 1767, 2014, 1985, 1810,
+	1811, 253, 0, 0, 0, 0, 0, 0, 0, 1862,
+	1886, 1938, 1844, 0, 445, 1923, 1933, 1951, 1836, 359,
+	272, 0, 0, 0, 0, 0, 0, 3724, 0, 1805,
+	0, 1901, 0, 0, 0, 1772, 1758, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1858,
+	0, 0, 0, 1779, 1749, 1807, 1940, 1750, 1747, 323,
+	1768, 1953, 1983, 1845, 289, 0, 1989, 1843, 1842, 1927,
+	1773, 1963, 1829, 297, 1771, 190, 1766, 1774, 1827, 336,
+	1937, 1945, 0, 193, 299, 1960, 1801, 1818, 236, 0,
+	373, 1913, 444, 0, 267, 1894, 372, 301, 437, 1928,
+	1991, 443, 1830, 419, 452, 457, 261, 1870, 226, 401,
+	251, 245, 1809, 1950, 1755, 273, 358, 240, 293, 1848,
+	1919, 1802, 232, 1931, 1902, 1965, 400, 434, 195, 317,
+	435, 456, 0, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 1961, 1944, 1967, 1795, 1775, 1786, 1776, 1817,
+	1993, 282, 274, 1968, 1966, 1820, 344, 217, 1883, 1876,
+	1863, 1941, 447, 2016, 247, 1946, 449, 0, 387, 386,
+	1833, 281, 1947, 0, 0, 368, 0, 290, 199, 1972,
+	460, 213, 295, 427, 0, 266, 335, 1915, 345, 192,
+	363, 313, 315, 312, 316, 271, 0, 0, 1943, 365,
+	389, 432, 215, 407, 0, 0, 0, 378, 0, 0,
+	1999, 307, 256, 260, 275, 286, 1914, 371, 408, 450,
+	1905, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 1939, 209, 204, 1987, 1962, 1896, 1851, 1857, 1757,
+	0, 203, 1852, 1853, 1854, 1855, 1856, 1935, 1806, 1822,
+	1794, 1909, 1793, 270, 1926, 440, 441, 237, 1769, 2007,
+	205, 1770, 2006, 332, 339, 331, 2010, 2008, 436, 1988,
+	1897, 1882, 1880, 1762, 1986, 1895, 1881, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 1884, 406,
+	306, 0, 200, 0, 405, 1997, 2022, 416, 218, 1788,
+	1954, 431, 0, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 1930, 2019, 343, 374, 225, 446,
+	402, 252, 1784, 0, 1787, 1782, 1785, 1783, 1888, 1889,
+	2002, 2003, 2004, 1942, 1777, 0, 0, 1980, 1981, 0,
+	1875, 1990, 1763, 0, 1958, 0, 0, 0, 1922, 2017,
+	1835, 233, 0, 1759, 1760, 1761, 0, 1865, 1866, 0,
+	0, 1976, 1975, 1974, 1977, 0, 2011, 2009, 2012, 1778,
+	1799, 1821, 1871, 1872, 1874, 1906, 1907, 1952, 1925, 1934,
+	1808, 1867, 0, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 1893, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	1955, 1900, 0, 1839, 1841, 1840, 1790, 1792, 1791, 1789,
+	2021, 329, 1982, 1746, 1754, 1781, 1797, 1804, 1812, 1823,
+	1824, 1832, 1838, 1850, 1860, 1861, 1877, 1891, 1892, 1899,
+	1929, 1932, 1949, 1957, 1964, 1969, 1971, 448, 243, 1873,
+	1898, 1936, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 0, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 1846, 191, 0,
+	0, 197, 0, 198, 0, 1831, 196, 1978, 2013, 1910,
+	1924, 1815, 1994, 0, 1956, 425, 1849, 1998, 1798, 1828,
+	2015, 1834, 1837, 1918, 1764, 1887, 340, 1825, 1765, 1748,
+	1803, 1752, 1816, 1753, 1800, 249, 1796, 1959, 1890, 1996,
+	1869, 1911, 1921, 248, 235, 1879, 1878, 1984, 1814, 1813,
+	1916, 1973, 1995, 1868, 0, 0, 453, 0, 2005, 300,
+	1970, 0, 451, 404, 322, 0, 0, 1864, 1979, 1885,
+	1948, 1847, 1920, 1780, 1903, 2000, 1826, 1912, 2001, 0,
+	0, 0, 0, 0, 3243, 0, 3423, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1908, 1992, 1819, 0,
+	1859, 0, 2018, 1917, 2020, 1751, 1904, 0, 1756, 1767,
+	2014, 1985, 1810, 1811, 253, 0, 0, 0, 0, 0,
+	0, 0, 1862, 1886, 1938, 1844, 0, 445, 1923, 1933,
+	1951, 1836, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 1805, 0, 1901, 0, 0, 0, 1772, 1758,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1858, 0, 0, 0, 1779, 1749, 1807, 1940,
+	1750, 1747, 323, 1768, 1953, 1983, 1845, 289, 0, 1989,
+	1843, 1842, 1927, 1773, 1963, 1829, 297, 1771, 190, 1766,
+	1774, 1827, 336, 1937, 1945, 0, 193, 299, 1960, 1801,
+	1818, 236, 0, 373, 1913, 444, 0, 267, 1894, 372,
+	301, 437, 1928, 1991, 443, 1830, 419, 452, 457, 261,
+	1870, 226, 401, 251, 245, 1809, 1950, 1755, 273, 358,
+	240, 293, 1848, 1919, 1802, 232, 1931, 1902, 1965, 400,
+	434, 195, 317, 435, 456, 0, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 1961, 1944, 1967, 1795, 1775,
+	1786, 1776, 1817, 1993, 282, 274, 1968, 1966, 1820, 344,
+	217, 1883, 1876, 1863, 1941, 447, 2016, 247, 1946, 449,
+	0, 387, 386, 1833, 281, 1947, 0, 0, 368, 0,
+	290, 199, 1972, 460, 213, 295, 427, 0, 266, 335,
+	1915, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 1943, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 1999, 307, 256, 260, 275, 286, 1914,
+	371, 408, 450, 1905, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 1939, 209, 204, 1987, 1962, 1896,
+	1851, 1857, 1757, 0, 203, 1852, 1853, 1854, 1855, 1856,
+	1935, 1806, 1822, 1794, 1909, 1793, 270, 1926, 440, 441,
+	237, 1769, 2007, 205, 1770, 2006, 332, 339, 331, 2010,
+	2008, 436, 1988, 1897, 1882, 1880, 1762, 1986, 1895, 1881,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 1884, 406, 306, 0, 200, 0, 405, 1997, 2022,
+	416, 218, 1788, 1954, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 1930, 2019, 343,
+	374, 225, 446, 402, 252, 1784, 0, 1787, 1782, 1785,
+	1783, 1888, 1889, 2002, 2003, 2004, 1942, 1777, 0, 0,
+	1980, 1981, 0, 1875, 1990, 1763, 0, 1958, 0, 0,
+	0, 1922, 2017, 1835, 233, 0, 1759, 1760, 1761, 0,
+	1865, 1866, 0, 0, 1976, 1975, 1974, 1977, 0, 2011,
+	2009, 2012, 1778, 1799, 1821, 1871, 1872, 1874, 1906, 1907,
+	1952, 1925, 1934, 1808, 1867, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 1893, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 1955, 1900, 0, 1839, 1841, 1840, 1790,
+	1792, 1791, 1789, 2021, 329, 1982, 1746, 1754, 1781, 1797,
+	1804, 1812, 1823, 1824, 1832, 1838, 1850, 1860, 1861, 1877,
+	1891, 1892, 1899, 1929, 1932, 1949, 1957, 1964, 1969, 1971,
+	448, 243, 1873, 1898, 1936, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	1846, 191, 0, 0, 197, 0, 198, 0, 1831, 196,
+	1978, 2013, 1910, 1924, 1815, 1994, 0, 1956, 425, 1849,
+	1998, 1798, 1828, 2015, 1834, 1837, 1918, 1764, 1887, 340,
+	1825, 1765, 1748, 1803, 1752, 1816, 1753, 1800, 249, 1796,
+	1959, 1890, 1996, 1869, 1911, 1921, 248, 235, 1879, 1878,
+	1984, 1814, 1813, 1916, 1973, 1995, 1868, 0, 0, 453,
+	0, 2005, 300, 1970, 0, 451, 404, 322, 0, 0,
+	1864, 1979, 1885, 1948, 1847, 1920, 1780, 1903, 2000, 1826,
+	1912, 2001, 0, 0, 0, 0, 0, 1137, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1908,
+	1992, 1819, 0, 1859, 0, 2018, 1917, 2020, 1751, 1904,
+	0, 1756, 1767, 2014, 1985, 1810, 1811, 253, 0, 0,
+	0, 0, 0, 0, 0, 1862, 1886, 1938, 1844, 0,
+	445, 1923, 1933, 1951, 1836, 359, 272, 0, 0, 0,
+	0, 0, 0, 3103, 0, 1805, 0, 1901, 0, 0,
+	0, 1772, 1758, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1858, 0, 0, 0, 1779,
+	1749, 1807, 1940, 1750, 1747, 323, 1768, 1953, 1983, 1845,
+	289, 0, 1989, 1843, 1842, 1927, 1773, 1963, 1829, 297,
+	1771, 190, 1766, 1774, 1827, 336, 1937, 1945, 0, 193,
+	299, 1960, 1801, 1818, 236, 0, 373, 1913, 444, 0,
+	267, 1894, 372, 301, 437, 1928, 1991, 443, 1830, 419,
+	452, 457, 261, 1870, 226, 401, 251, 245, 1809, 1950,
+	1755, 273, 358, 240, 293, 1848, 1919, 1802, 232, 1931,
+	1902, 1965, 400, 434, 195, 317, 435, 456, 0, 262,
+	392, 263, 418, 254, 227, 361, 214, 426, 318, 328,
+	229, 231, 230, 208, 393, 433, 220, 234, 1961, 1944,
+	1967, 1795, 1775, 1786, 1776, 1817, 1993, 282, 274, 1968,
+	1966, 1820, 344, 217, 1883, 1876, 1863, 1941, 447, 2016,
+	247, 1946, 449, 0, 387, 386, 1833, 281, 1947, 0,
+	0, 368, 0, 290, 199, 1972, 460, 213, 295, 427,
+	0, 266, 335, 1915, 345, 192, 363, 313, 315, 312,
+	316, 271, 0, 0, 1943, 365, 389, 432, 215, 407,
+	0, 0, 0, 378, 0, 0, 1999, 307, 256, 260,
+	275, 286, 1914, 371, 408, 450, 1905, 210, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 375, 409, 423,
+	380, 269, 411, 415, 412, 413, 410, 414, 376, 377,
+	202, 417, 442, 221, 388, 391, 459, 1939, 209, 204,
+	1987, 1962, 1896, 1851, 1857, 1757, 0, 203, 1852, 1853,
+	1854, 1855, 1856, 1935, 1806, 1822, 1794, 1909, 1793, 270,
+	1926, 440, 441, 237, 1769, 2007, 205, 1770, 2006, 332,
+	339, 331, 2010, 2008, 436, 1988, 1897, 1882, 1880, 1762,
+	1986, 1895, 1881, 296, 259, 277, 356, 303, 357, 278,
+	326, 325, 327, 305, 1884, 406, 306, 0, 200, 0,
+	405, 1997, 2022, 416, 218, 1788, 1954, 431, 0, 364,
+	219, 268, 257, 355, 330, 211, 280, 403, 294, 302,
+	1930, 2019, 343, 374, 225, 446, 402, 252, 1784, 0,
+	1787, 1782, 1785, 1783, 1888, 1889, 2002, 2003, 2004, 1942,
+	1777, 0, 0, 1980, 1981, 0, 1875, 1990, 1763, 0,
+	1958, 0, 0, 0, 1922, 2017, 1835, 233, 0, 1759,
+	1760, 1761, 0, 1865, 1866, 0, 0, 1976, 1975, 1974,
+	1977, 0, 2011, 2009, 2012, 1778, 1799, 1821, 1871, 1872,
+	1874, 1906, 1907, 1952, 1925, 1934, 1808, 1867, 0, 351,
+	201, 212, 430, 224, 244, 242, 258, 291, 314, 320,
+	349, 390, 396, 397, 420, 421, 422, 424, 246, 0,
+	250, 223, 369, 222, 304, 283, 350, 428, 429, 360,
+	239, 1893, 194, 206, 298, 367, 265, 319, 394, 321,
+	287, 238, 458, 324, 366, 461, 1955, 1900, 0, 1839,
+	1841, 1840, 1790, 1792, 1791, 1789, 2021, 329, 1982, 1746,
+	1754, 1781, 1797, 1804, 1812, 1823, 1824, 1832, 1838, 1850,
+	1860, 1861, 1877, 1891, 1892, 1899, 1929, 1932, 1949, 1957,
+	1964, 1969, 1971, 448, 243, 1873, 1898, 1936, 207, 216,
+	228, 241, 255, 0, 264, 276, 279, 284, 285, 288,
+	292, 308, 309, 310, 311, 333, 334, 337, 338, 341,
+	342, 346, 347, 348, 352, 353, 354, 362, 0, 370,
+	379, 381, 382, 383, 384, 385, 395, 398, 399, 438,
+	439, 454, 455, 1846, 191, 0, 0, 197, 0, 198,
+	0, 1831, 196, 1978, 2013, 1910, 1924, 1815, 1994, 0,
+	1956, 425, 1849, 1998, 1798, 1828, 2015, 1834, 1837, 1918,
+	1764, 1887, 340, 1825, 1765, 1748, 1803, 1752, 1816, 1753,
+	1800, 249, 1796, 1959, 1890, 1996, 1869, 1911, 1921, 248,
+	235, 1879, 1878, 1984, 1814, 1813, 1916, 1973, 1995, 1868,
+	0, 0, 453, 0, 2005, 300, 1970, 0, 451, 404,
+	322, 0, 0, 1864, 1979, 1885, 1948, 1847, 1920, 1780,
+	1903, 2000, 1826, 1912, 2001, 0, 0, 0, 0, 0,
+	1137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1908, 1992, 1819, 0, 1859, 0, 2018, 1917,
+	2020, 1751, 1904, 0, 1756, 1767, 2014, 1985, 1810, 1811,
+	253, 0, 0, 0, 0, 0, 0, 0, 1862, 1886,
+	1938, 1844, 0, 445, 1923, 1933, 1951, 1836, 359, 272,
+	0, 0, 0, 0, 0, 0, 0, 0, 1805, 0,
+	1901, 0, 0, 0, 1772, 1758, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1858, 0,
+	0, 0, 1779, 1749, 1807, 1940, 1750, 1747, 323, 1768,
+	1953, 1983, 1845, 289, 0, 1989, 1843, 1842, 1927, 1773,
+	1963, 1829, 297, 1771, 190, 1766, 1774, 1827, 336, 1937,
+	1945, 0, 193, 299, 1960, 1801, 1818, 236, 0, 373,
+	1913, 444, 0, 267, 1894, 372, 301, 437, 1928, 1991,
+	443, 1830, 419, 452, 457, 261, 1870, 226, 401, 251,
+	245, 1809, 1950, 1755, 273, 358, 240, 293, 1848, 1919,
+	1802, 232, 1931, 1902, 1965, 400, 434, 195, 317, 435,
+	456, 0, 262, 392, 263, 418, 254, 227, 361, 214,
+	426, 318, 328, 229, 231, 230, 208, 393, 433, 220,
+	234, 1961, 1944, 1967, 1795, 1775, 1786, 1776, 1817, 1993,
+	282, 274, 1968, 1966, 1820, 344, 217, 1883, 1876, 1863,
+	1941, 447, 2016, 247, 1946, 449, 0, 387, 386, 1833,
+	281, 1947, 0, 0, 368, 0, 290, 199, 1972, 460,
+	213, 295, 427, 0, 266, 335, 1915, 345, 192, 363,
+	313, 315, 312, 316, 271, 0, 0, 1943, 365, 389,
+	432, 215, 407, 0, 0, 0, 378, 0, 0, 1999,
+	307, 256, 260, 275, 286, 1914, 371, 408, 450, 1905,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	375, 409, 423, 380, 269, 411, 415, 412, 413, 410,
+	414, 376, 377, 202, 417, 442, 221, 388, 391, 459,
+	1939, 209, 204, 1987, 1962, 1896, 1851, 1857, 1757, 0,
+	203, 1852, 1853, 1854, 1855, 1856, 1935, 1806, 1822, 1794,
+	1909, 1793, 270, 1926, 440, 441, 237, 1769, 2007, 205,
+	1770, 2006, 332, 339, 331, 2010, 2008, 436, 1988, 1897,
+	1882, 1880, 1762, 1986, 1895, 1881, 296, 259, 277, 356,
+	303, 357, 278, 326, 325, 327, 305, 1884, 406, 306,
+	0, 200, 0, 405, 1997, 2022, 416, 218, 1788, 1954,
+	431, 2318, 364, 219, 268, 257, 355, 330, 211, 280,
+	403, 294, 302, 1930, 2019, 343, 374, 225, 446, 402,
+	252, 1784, 0, 1787, 1782, 1785, 1783, 1888, 1889, 2002,
+	2003, 2004, 1942, 1777, 0, 0, 1980, 1981, 0, 1875,
+	1990, 1763, 0, 1958, 0, 0, 0, 1922, 2017, 1835,
+	233, 0, 1759, 1760, 1761, 0, 1865, 1866, 0, 0,
+	1976, 1975, 1974, 1977, 0, 2011, 2009, 2012, 1778, 1799,
+	1821, 1871, 1872, 1874, 1906, 1907, 1952, 1925, 1934, 1808,
+	1867, 0, 351, 201, 212, 430, 224, 244, 242, 258,
+	291, 314, 320, 349, 390, 396, 397, 420, 421, 422,
+	424, 246, 0, 250, 223, 369, 222, 304, 283, 350,
+	428, 429, 360, 239, 1893, 194, 206, 298, 367, 265,
+	319, 394, 321, 287, 238, 458, 324, 366, 461, 1955,
+	1900, 0, 1839, 1841, 1840, 1790, 1792, 1791, 1789, 2021,
+	329, 1982, 1746, 1754, 1781, 1797, 1804, 1812, 1823, 1824,
+	1832, 1838, 1850, 1860, 1861, 1877, 1891, 1892, 1899, 1929,
+	1932, 1949, 1957, 1964, 1969, 1971, 448, 243, 1873, 1898,
+	1936, 207, 216, 228, 241, 255, 0, 264, 276, 279,
+	284, 285, 288, 292, 308, 309, 310, 311, 333, 334,
+	337, 338, 341, 342, 346, 347, 348, 352, 353, 354,
+	362, 0, 370, 379, 381, 382, 383, 384, 385, 395,
+	398, 399, 438, 439, 454, 455, 1846, 191, 0, 0,
+	197, 0, 198, 0, 1831, 196, 1978, 2013, 1910, 1924,
+	1815, 1994, 0, 1956, 425, 1849, 1998, 1798, 1828, 2015,
+	1834, 1837, 1918, 1764, 1887, 340, 1825, 1765, 1748, 1803,
+	1752, 1816, 1753, 1800, 249, 1796, 1959, 1890, 1996, 1869,
+	1911, 1921, 248, 235, 1879, 1878, 1984, 1814, 1813, 1916,
+	1973, 1995, 1868, 0, 0, 453, 0, 2005, 300, 1970,
+	0, 451, 404, 322, 0, 0, 1864, 1979, 1885, 1948,
+	1847, 1920, 1780, 1903, 2000, 1826, 1912, 2001, 0, 0,
+	0, 0, 0, 1137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1908, 1992, 1819, 0, 1859,
+	0, 2018, 1917, 2020, 1751, 1904, 0, 1756, 1767, 2014,
+	1985, 1810, 1811, 253, 0, 0, 0, 0, 0, 0,
+	0, 1862, 1886, 1938, 1844, 0, 445, 1923, 1933, 1951,
+	1836, 359, 272, 0, 0, 0, 0, 0, 0, 0,
+	0, 1805, 0, 1901, 0, 0, 0, 1772, 1758, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1858, 0, 0, 0, 1779, 1749, 1807, 1940, 1750,
+	1747, 323, 1768, 1953, 1983, 1845, 289, 0, 1989, 1843,
+	1842, 1927, 1773, 1963, 1829, 297, 1771, 190, 1766, 1774,
+	1827, 336, 1937, 1945, 0, 193, 299, 1960, 1801, 1818,
+	236, 0, 373, 1913, 444, 0, 267, 1894, 372, 301,
+	437, 1928, 1991, 443, 1830, 419, 452, 457, 261, 1870,
+	226, 401, 251, 245, 1809, 1950, 1755, 273, 358, 240,
+	293, 1848, 1919, 1802, 232, 1931, 1902, 1965, 400, 434,
+	195, 317, 435, 456, 0, 262, 392, 263, 418, 254,
+	227, 361, 214, 426, 318, 328, 229, 231, 230, 208,
+	393, 433, 220, 234, 1961, 1944, 1967, 1795, 1775, 1786,
+	1776, 1817, 1993, 282, 274, 1968, 1966, 1820, 344, 217,
+	1883, 1876, 1863, 1941, 447, 2016, 247, 1946, 449, 0,
+	387, 386, 1833, 281, 1947, 0, 0, 368, 0, 290,
+	199, 1972, 460, 213, 295, 427, 0, 266, 335, 1915,
+	345, 192, 363, 313, 315, 312, 316, 271, 0, 0,
+	1943, 365, 389, 432, 215, 407, 0, 0, 0, 378,
+	0, 0, 1999, 307, 256, 260, 275, 286, 1914, 371,
+	408, 450, 1905, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 375, 409, 423, 380, 269, 411, 415,
+	412, 413, 410, 414, 376, 377, 202, 417, 442, 221,
+	388, 391, 459, 1939, 209, 204, 1987, 1962, 1896, 1851,
+	1857, 1757, 0, 203, 1852, 1853, 1854, 1855, 1856, 1935,
+	1806, 1822, 1794, 1909, 1793, 270, 1926, 440, 441, 237,
+	1769, 2007, 205, 1770, 2006, 332, 339, 331, 2010, 2008,
+	436, 1988, 1897, 1882, 1880, 1762, 1986, 1895, 1881, 296,
+	259, 277, 356, 303, 357, 278, 326, 325, 327, 305,
+	1884, 406, 306, 0, 200, 0, 405, 1997, 2022, 416,
+	218, 1788, 1954, 431, 0, 364, 219, 268, 257, 355,
+	330, 211, 280, 403, 294, 302, 1930, 2019, 343, 374,
+	225, 446, 402, 252, 1784, 0, 1787, 1782, 1785, 1783,
+	1888, 1889, 2002, 2003, 2004, 1942, 1777, 0, 0, 1980,
+	1981, 0, 1875, 1990, 1763, 0, 1958, 0, 0, 0,
+	1922, 2017, 1835, 233, 0, 1759, 1760, 1761, 0, 1865,
+	1866, 0, 0, 1976, 1975, 1974, 1977, 0, 2011, 2009,
+	2012, 1778, 1799, 1821, 1871, 1872, 1874, 1906, 1907, 1952,
+	1925, 1934, 1808, 1867, 0, 351, 201, 212, 430, 224,
+	244, 242, 258, 291, 314, 320, 349, 390, 396, 397,
+	420, 421, 422, 424, 246, 0, 250, 223, 369, 222,
+	304, 283, 350, 428, 429, 360, 239, 1893, 194, 206,
+	298, 367, 265, 319, 394, 321, 287, 238, 458, 324,
+	366, 461, 1955, 1900, 0, 1839, 1841, 1840, 1790, 1792,
+	1791, 1789, 2021, 329, 1982, 1746, 1754, 1781, 1797, 1804,
+	1812, 1823, 1824, 1832, 1838, 1850, 1860, 1861, 1877, 1891,
+	1892, 1899, 1929, 1932, 1949, 1957, 1964, 1969, 1971, 448,
+	243, 1873, 1898, 1936, 207, 216, 228, 241, 255, 0,
+	264, 276, 279, 284, 285, 288, 292, 308, 309, 310,
+	311, 3891, 334, 337, 338, 341, 342, 346, 347, 348,
+	352, 353, 354, 362, 0, 370, 379, 381, 382, 383,
+	384, 385, 395, 398, 399, 438, 439, 454, 455, 1846,
+	191, 0, 0, 197, 0, 198, 0, 1831, 196, 1978,
+	2013, 1910, 1924, 1815, 1994, 0, 1956, 425, 1849, 1998,
+	1798, 1828, 2015, 1834, 1837, 1918, 1764, 1887, 340, 1825,
+	1765, 1748, 1803, 1752, 1816, 1753, 1800, 249, 1796, 1959,
+	1890, 1996, 1869, 1911, 1921, 248, 235, 1879, 1878, 1984,
+	1814, 1813, 1916, 1973, 1995, 1868, 0, 0, 453, 0,
+	2005, 300, 1970, 0, 451, 404, 322, 0, 0, 1864,
+	1979, 1885, 1948, 1847, 1920, 1780, 1903, 2000, 1826, 1912,
+	2001, 0, 0, 0, 0, 0, 1137, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1908, 1992,
+	1819, 0, 1859, 0, 2018, 1917, 2020, 1751, 1904, 0,
+	1756, 1767, 2014, 1985, 1810, 1811, 253, 0, 0, 0,
+	0, 0, 0, 0, 1862, 1886, 1938, 1844, 0, 445,
+	1923, 1933, 1951, 1836, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 1805, 0, 1901, 0, 0, 0,
+	1772, 1758, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1858, 0, 0, 0, 1779, 1749,
+	1807, 1940, 1750, 1747, 323, 1768, 1953, 1983, 1845, 289,
+	0, 1989, 1843, 1842, 1927, 1773, 1963, 1829, 297, 1771,
+	190, 1766, 1774, 1827, 336, 1937, 1945, 0, 193, 299,
+	1960, 1801, 1818, 236, 0, 373, 1913, 444, 0, 267,
+	1894, 372, 301, 437, 1928, 1991, 443, 1830, 419, 452,
+	457, 261, 1870, 226, 401, 251, 245, 1809, 1950, 1755,
+	273, 358, 240, 293, 1848, 1919, 1802, 232, 1931, 1902,
+	1965, 400, 434, 195, 317, 435, 456, 0, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 1961, 1944, 1967,
+	1795, 1775, 1786, 1776, 1817, 1993, 282, 274, 1968, 1966,
+	1820, 344, 217, 1883, 1876, 1863, 1941, 447, 2016, 247,
+	1946, 449, 0, 387, 386, 1833, 281, 1947, 0, 0,
+	368, 0, 290, 199, 1972, 460, 213, 295, 427, 0,
+	266, 335, 1915, 345, 192, 363, 313, 315, 312, 316,
+	271, 0, 0, 1943, 365, 389, 432, 215, 407, 0,
+	0, 0, 378, 0, 0, 1999, 307, 256, 260, 275,
+	286, 1914, 371, 408, 450, 1905, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 1939, 209, 204, 1987,
+	1962, 1896, 1851, 1857, 1757, 0, 203, 1852, 1853, 1854,
+	1855, 1856, 1935, 1806, 1822, 1794, 1909, 1793, 270, 1926,
+	440, 441, 237, 1769, 2007, 205, 1770, 2006, 332, 339,
+	331, 2010, 2008, 436, 1988, 1897, 1882, 1880, 1762, 1986,
+	1895, 1881, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 1884, 406, 306, 0, 200, 0, 405,
+	1997, 2022, 416, 218, 1788, 1954, 431, 0, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 1930,
+	2019, 343, 374, 225, 446, 402, 252, 1784, 0, 1787,
+	1782, 1785, 1783, 1888, 1889, 2002, 2003, 2004, 1942, 1777,
+	0, 0, 1980, 1981, 0, 1875, 1990, 1763, 0, 1958,
+	0, 0, 0, 1922, 2017, 1835, 233, 0, 1759, 1760,
+	1761, 0, 1865, 1866, 0, 0, 1976, 1975, 1974, 1977,
+	0, 2011, 2009, 2012, 1778, 1799, 1821, 1871, 1872, 1874,
+	1906, 1907, 1952, 1925, 1934, 1808, 1867, 0, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	1893, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 1955, 1900, 0, 1839, 1841,
+	1840, 1790, 1792, 1791, 1789, 2021, 329, 1982, 1746, 1754,
+	1781, 1797, 1804, 1812, 1823, 1824, 1832, 1838, 1850, 1860,
+	1861, 1877, 1891, 1892, 1899, 1929, 1932, 1949, 1957, 1964,
+	1969, 1971, 448, 243, 1873, 1898, 1936, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 0, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 1846, 191, 0, 0, 197, 0, 198, 0,
+	1831, 196, 1978, 2013, 1910, 1924, 1815, 1994, 0, 1956,
+	425, 1849, 1998, 1798, 1828, 2015, 1834, 1837, 1918, 1764,
+	1887, 340, 1825, 1765, 1748, 1803, 1752, 1816, 1753, 1800,
+	249, 1796, 1959, 1890, 1996, 1869, 1911, 1921, 248, 235,
+	1879, 1878, 1984, 1814, 1813, 1916, 1973, 1995, 1868, 0,
+	0, 453, 0, 2005, 300, 1970, 0, 451, 404, 322,
+	0, 0, 1864, 1979, 1885, 1948, 1847, 1920, 1780, 1903,
+	2000, 1826, 1912, 2001, 0, 0, 0, 0, 0, 4187,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1908, 1992, 1819, 0, 1859, 0, 2018, 1917, 2020,
+	1751, 1904, 0, 1756, 1767, 2014, 1985, 1810, 1811, 253,
+	0, 0, 0, 0, 0, 0, 0, 1862, 1886, 1938,
+	1844, 0, 445, 1923, 1933, 1951, 1836, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 1805, 0, 1901,
+	0, 0, 0, 1772, 1758, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1858, 0, 0,
+	0, 1779, 1749, 1807, 1940, 1750, 1747, 323, 1768, 1953,
+	1983, 1845, 289, 0, 1989, 1843, 1842, 1927, 1773, 1963,
+	1829, 297, 1771, 190, 1766, 1774, 1827, 336, 1937, 1945,
+	0, 193, 299, 1960, 1801, 1818, 236, 0, 373, 1913,
+	444, 0, 267, 1894, 372, 301, 437, 1928, 1991, 443,
+	1830, 419, 452, 457, 261, 1870, 226, 401, 251, 245,
+	1809, 1950, 1755, 273, 358, 240, 293, 1848, 1919, 1802,
+	232, 1931, 1902, 1965, 400, 434, 195, 317, 435, 456,
+	0, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	1961, 1944, 1967, 1795, 1775, 1786, 4190, 4191, 4192, 282,
+	274, 1968, 1966, 1820, 344, 217, 1883, 1876, 1863, 1941,
+	447, 2016, 247, 1946, 449, 0, 387, 386, 1833, 281,
+	1947, 0, 0, 368, 0, 290, 199, 1972, 460, 213,
+	295, 427, 0, 266, 335, 1915, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 1943, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 1999, 307,
+	256, 260, 275, 286, 1914, 371, 408, 450, 1905, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 1939,
+	209, 204, 1987, 1962, 1896, 1851, 1857, 1757, 0, 203,
+	1852, 1853, 1854, 1855, 1856, 1935, 1806, 1822, 1794, 1909,
+	1793, 270, 1926, 440, 441, 237, 1769, 2007, 205, 1770,
+	2006, 332, 339, 331, 2010, 2008, 436, 1988, 1897, 1882,
+	1880, 1762, 1986, 1895, 1881, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 1884, 406, 306, 0,
+	200, 0, 405, 1997, 2022, 416, 218, 1788, 1954, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 1930, 2019, 343, 374, 225, 446, 402, 252,
+	1784, 0, 1787, 1782, 1785, 1783, 1888, 1889, 2002, 2003,
+	2004, 1942, 1777, 0, 0, 1980, 1981, 0, 1875, 1990,
+	1763, 0, 1958, 0, 0, 0, 1922, 2017, 1835, 233,
+	0, 1759, 1760, 1761, 0, 1865, 1866, 0, 0, 1976,
+	1975, 1974, 1977, 0, 2011, 2009, 2012, 1778, 1799, 1821,
+	1871, 1872, 1874, 1906, 1907, 1952, 1925, 1934, 1808, 1867,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 1893, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 1955, 1900,
+	0, 1839, 1841, 1840, 1790, 1792, 1791, 1789, 2021, 329,
+	1982, 1746, 1754, 1781, 1797, 1804, 1812, 1823, 1824, 1832,
+	1838, 1850, 1860, 1861, 1877, 1891, 1892, 1899, 1929, 1932,
+	1949, 1957, 1964, 1969, 1971, 448, 243, 1873, 1898, 1936,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 1846, 191, 0, 0, 197,
+	0, 198, 0, 1831, 196, 1978, 2013, 1910, 1924, 1815,
+	1994, 0, 1956, 425, 1849, 1998, 1798, 1828, 2015, 1834,
+	1837, 1918, 1764, 1887, 340, 1825, 1765, 1748, 1803, 1752,
+	1816, 1753, 1800, 249, 1796, 1959, 1890, 1996, 1869, 1911,
+	1921, 248, 235, 1879, 1878, 1984, 1814, 1813, 1916, 1973,
+	1995, 1868, 0, 0, 453, 0, 2005, 300, 1970, 0,
+	451, 404, 322, 0, 0, 1864, 1979, 1885, 1948, 1847,
+	1920, 1780, 1903, 2000, 1826, 1912, 2001, 0, 0, 0,
+	0, 0, 3243, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1908, 1992, 1819, 0, 1859, 0,
+	2018, 1917, 2020, 1751, 1904, 0, 1756, 1767, 2014, 1985,
+	1810, 1811, 253, 0, 0, 0, 0, 0, 0, 0,
+	1862, 1886, 1938, 1844, 0, 445, 1923, 1933, 1951, 1836,
+	359, 272, 0, 0, 0, 0, 0, 0, 0, 0,
+	1805, 0, 1901, 0, 0, 0, 1772, 1758, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1858, 0, 0, 0, 1779, 1749, 1807, 1940, 1750, 1747,
+	323, 1768, 1953, 1983, 1845, 289, 0, 1989, 1843, 1842,
+	1927, 1773, 1963, 1829, 297, 1771, 190, 1766, 1774, 1827,
+	336, 1937, 1945, 0, 193, 299, 1960, 1801, 1818, 236,
+	0, 373, 1913, 444, 0, 267, 1894, 372, 301, 437,
+	1928, 1991, 443, 1830, 419, 452, 457, 261, 1870, 226,
+	401, 251, 245, 1809, 1950, 1755, 273, 358, 240, 293,
+	1848, 1919, 1802, 232, 1931, 1902, 1965, 400, 434, 195,
+	317, 435, 456, 0, 262, 392, 263, 418, 254, 227,
+	361, 214, 426, 318, 328, 229, 231, 230, 208, 393,
+	433, 220, 234, 1961, 1944, 1967, 1795, 1775, 1786, 1776,
+	1817, 1993, 282, 274, 1968, 1966, 1820, 344, 217, 1883,
+	1876, 1863, 1941, 447, 2016, 247, 1946, 449, 0, 387,
+	386, 1833, 281, 1947, 0, 0, 368, 0, 290, 199,
+	1972, 460, 213, 295, 427, 0, 266, 335, 1915, 345,
+	192, 363, 313, 315, 312, 316, 271, 0, 0, 1943,
+	365, 389, 432, 215, 407, 0, 0, 0, 378, 0,
+	0, 1999, 307, 256, 260, 275, 286, 1914, 371, 408,
+	450, 1905, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 375, 409, 423, 380, 269, 411, 415, 412,
+	413, 410, 414, 376, 377, 202, 417, 442, 221, 388,
+	391, 459, 1939, 209, 204, 1987, 1962, 1896, 1851, 1857,
+	1757, 0, 203, 1852, 1853, 1854, 1855, 1856, 1935, 1806,
+	1822, 1794, 1909, 1793, 270, 1926, 440, 441, 237, 1769,
+	2007, 205, 1770, 2006, 332, 339, 331, 2010, 2008, 436,
+	1988, 1897, 1882, 1880, 1762, 1986, 1895, 1881, 296, 259,
+	277, 356, 303, 357, 278, 326, 325, 327, 305, 1884,
+	406, 306, 0, 200, 0, 405, 1997, 2022, 416, 218,
+	1788, 1954, 431, 0, 364, 219, 268, 257, 355, 330,
+	211, 280, 403, 294, 302, 1930, 2019, 343, 374, 225,
+	446, 402, 252, 1784, 0, 1787, 1782, 1785, 1783, 1888,
+	1889, 2002, 2003, 2004, 1942, 1777, 0, 0, 1980, 1981,
+	0, 1875, 1990, 1763, 0, 1958, 0, 0, 0, 1922,
+	2017, 1835, 233, 0, 1759, 1760, 1761, 0, 1865, 1866,
+	0, 0, 1976, 1975, 1974, 1977, 0, 2011, 2009, 2012,
+	1778, 1799, 1821, 1871, 1872, 1874, 1906, 1907, 1952, 1925,
+	1934, 1808, 1867, 0, 351, 201, 212, 430, 224, 244,
+	242, 258, 291, 314, 320, 349, 390, 396, 397, 420,
+	421, 422, 424, 246, 0, 250, 223, 369, 222, 304,
+	283, 350, 428, 429, 360, 239, 1893, 194, 206, 298,
+	367, 265, 319, 394, 321, 287, 238, 458, 324, 366,
+	461, 1955, 1900, 0, 1839, 1841, 1840, 1790, 1792, 1791,
+	1789, 2021, 329, 1982, 1746, 1754, 1781, 1797, 1804, 1812,
+	1823, 1824, 1832, 1838, 1850, 1860, 1861, 1877, 1891, 1892,
+	1899, 1929, 1932, 1949, 1957, 1964, 1969, 1971, 448, 243,
+	1873, 1898, 1936, 207, 216, 228, 241, 255, 0, 264,
+	276, 279, 284, 285, 288, 292, 308, 309, 310, 311,
+	333, 334, 337, 338, 341, 342, 346, 347, 348, 352,
+	353, 354, 362, 0, 370, 379, 381, 382, 383, 384,
+	385, 395, 398, 399, 438, 439, 454, 455, 1846, 191,
+	0, 0, 197, 0, 198, 0, 1831, 196, 1978, 2013,
+	1910, 1924, 561, 425, 555, 566, 548, 0, 0, 0,
+	0, 0, 0, 0, 340, 0, 0, 612, 0, 0,
+	0, 0, 0, 249, 0, 0, 556, 0, 0, 0,
+	0, 248, 235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 453, 0, 0, 300, 0, 0,
+	451, 404, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	0, 0, 610, 0, 609, 710, 709, 712, 713, 714,
+	715, 0, 0, 0, 711, 0, 2309, 3278, 3279, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 253, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 445, 0, 0, 0, 0,
+	359, 272, 0, 0, 0, 0, 0, 0, 0, 3273,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	730, 731, 732, 733, 734, 735, 736, 737, 738, 739,
+	740, 741, 742, 743, 744, 745, 746, 747, 748, 749,
+	750, 751, 752, 753, 754, 755, 756, 757, 758, 759,
+	760, 761, 762, 763, 764, 765, 766, 767, 768, 769,
+	770, 771, 0, 0, 547, 546, 549, 0, 0, 0,
+	323, 0, 0, 0, 554, 289, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 0, 190, 0, 0, 0,
+	336, 558, 0, 0, 193, 299, 562, 0, 0, 236,
+	0, 373, 0, 444, 0, 267, 0, 372, 301, 437,
+	0, 565, 443, 0, 419, 452, 457, 261, 0, 226,
+	401, 251, 245, 0, 0, 0, 273, 358, 240, 293,
+	0, 0, 0, 232, 0, 0, 0, 400, 434, 195,
+	317, 435, 456, 550, 262, 392, 263, 418, 254, 227,
+	361, 214, 426, 318, 328, 229, 231, 230, 208, 393,
+	433, 220, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 274, 0, 0, 0, 344, 217, 0,
+	0, 0, 0, 447, 0, 247, 0, 449, 0, 387,
+	386, 553, 281, 0, 0, 0, 368, 0, 290, 199,
+	0, 460, 213, 295, 427, 0, 266, 335, 0, 345,
+	192, 363, 313, 315, 312, 316, 271, 0, 0, 0,
+	615, 389, 432, 215, 407, 551, 552, 559, 560, 563,
+	564, 567, 307, 256, 260, 275, 286, 0, 371, 408,
+	450, 0, 210, 570, 571, 572, 573, 574, 575, 576,
+	577, 578, 579, 580, 581, 582, 583, 584, 585, 586,
+	587, 588, 589, 590, 591, 592, 593, 594, 595, 596,
+	597, 598, 599, 600, 601, 602, 603, 604, 605, 606,
+	607, 608, 375, 409, 423, 380, 269, 411, 415, 412,
+	413, 410, 414, 376, 377, 202, 417, 442, 221, 388,
+	391, 459, 0, 209, 204, 0, 0, 0, 0, 0,
+	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 0, 440, 441, 237, 0,
+	0, 205, 0, 0, 332, 339, 331, 0, 0, 436,
+	0, 0, 0, 0, 0, 0, 0, 0, 296, 259,
+	277, 356, 303, 357, 278, 326, 325, 327, 305, 0,
+	406, 306, 0, 200, 0, 405, 0, 0, 416, 218,
+	0, 0, 431, 0, 364, 219, 268, 257, 355, 330,
+	211, 280, 403, 294, 302, 0, 0, 343, 374, 225,
+	446, 402, 252, 0, 0, 3289, 1040, 0, 3280, 3281,
+	3283, 3290, 3291, 3282, 3284, 3285, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 3286, 3287,
+	3288, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 351, 201, 212, 430, 224, 244,
+	242, 258, 291, 314, 320, 349, 390, 396, 397, 420,
+	421, 422, 424, 246, 0, 250, 223, 369, 222, 304,
+	283, 350, 428, 429, 360, 239, 0, 194, 206, 298,
+	367, 265, 319, 394, 321, 287, 238, 458, 324, 366,
+	461, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 329, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 448, 243,
+	0, 0, 0, 207, 216, 228, 241, 255, 0, 264,
+	276, 279, 284, 285, 288, 292, 308, 309, 310, 311,
+	333, 334, 337, 338, 341, 342, 346, 347, 348, 352,
+	353, 354, 362, 557, 370, 379, 381, 382, 383, 384,
+	385, 395, 398, 399, 438, 439, 454, 455, 0, 191,
+	0, 0, 197, 0, 198, 0, 0, 196, 561, 425,
+	555, 566, 548, 0, 0, 0, 0, 0, 0, 0,
+	340, 0, 0, 540, 0, 0, 0, 0, 0, 249,
+	0, 0, 556, 0, 0, 0, 0, 248, 235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	453, 0, 0, 300, 0, 0, 451, 404, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 610, 0,
+	609, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 253, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 445, 0, 0, 0, 0, 359, 272, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	547, 546, 549, 0, 0, 0, 323, 0, 0, 0,
+	554, 289, 0, 0, 0, 0, 0, 0, 0, 0,
+	297, 0, 190, 0, 0, 0, 336, 558, 0, 0,
+	193, 299, 562, 0, 0, 236, 0, 373, 0, 444,
+	0, 267, 0, 372, 301, 437, 0, 565, 443, 0,
+	419, 452, 457, 261, 0, 226, 401, 251, 245, 0,
+	0, 0, 273, 358, 240, 293, 0, 0, 0, 232,
+	0, 0, 0, 400, 434, 195, 317, 435, 456, 550,
+	262, 392, 263, 418, 254, 227, 361, 214, 426, 318,
+	328, 229, 231, 230, 208, 393, 433, 220, 234, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 274,
+	0, 0, 0, 344, 217, 0, 0, 0, 0, 447,
+	0, 247, 0, 449, 0, 387, 386, 553, 281, 0,
+	0, 0, 368, 0, 290, 199, 0, 460, 213, 295,
+	427, 0, 266, 335, 0, 345, 192, 363, 313, 315,
+	312, 316, 271, 0, 0, 0, 543, 389, 432, 215,
+	407, 551, 552, 559, 560, 563, 564, 567, 307, 256,
+	260, 275, 286, 0, 371, 408, 450, 0, 210, 570,
+	571, 572, 573, 574, 575, 576, 577, 578, 579, 580,
+	581, 582, 583, 584, 585, 586, 587, 588, 589, 590,
+	591, 592, 593, 594, 595, 596, 597, 598, 599, 600,
+	601, 602, 603, 604, 605, 606, 607, 608, 375, 409,
+	423, 380, 269, 411, 415, 412, 413, 410, 414, 376,
+	377, 202, 417, 442, 221, 388, 391, 459, 0, 209,
+	204, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 0, 440, 441, 237, 0, 0, 205, 0, 0,
+	332, 339, 331, 0, 0, 436, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 259, 277, 356, 303, 357,
+	278, 326, 325, 327, 305, 0, 406, 306, 0, 200,
+	0, 405, 0, 0, 416, 218, 0, 0, 431, 0,
+	364, 219, 268, 257, 355, 330, 211, 280, 403, 294,
+	302, 0, 0, 343, 374, 225, 446, 402, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	351, 201, 212, 430, 224, 244, 242, 258, 291, 314,
+	320, 349, 390, 396, 397, 420, 421, 422, 424, 246,
+	0, 250, 223, 369, 222, 304, 283, 350, 428, 429,
+	360, 239, 0, 194, 206, 298, 367, 265, 319, 394,
+	321, 287, 238, 458, 324, 366, 461, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 448, 243, 0, 0, 0, 207,
+	216, 228, 241, 255, 0, 264, 276, 279, 284, 285,
+	288, 292, 308, 309, 310, 311, 333, 334, 337, 338,
+	341, 342, 346, 347, 348, 352, 353, 354, 362, 557,
+	370, 379, 381, 382, 383, 384, 385, 395, 398, 399,
+	438, 439, 454, 455, 425, 191, 0, 0, 197, 0,
+	198, 0, 0, 196, 0, 340, 0, 0, 0, 0,
+	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
+	0, 0, 248, 235, 0, 0, 0, 0, 0, 0,
+	0, 2456, 2460, 0, 167, 453, 185, 0, 300, 0,
+	471, 451, 404, 322, 474, 473, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1137, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 0, 0, 0, 472, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 253, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 445, 0, 0, 0,
+	0, 359, 272, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 323, 0, 0, 0, 2459, 289, 186, 0, 0,
+	0, 2453, 0, 2454, 2455, 297, 1139, 190, 0, 2451,
+	2458, 336, 0, 0, 172, 193, 299, 0, 0, 0,
+	236, 1133, 373, 0, 444, 470, 267, 0, 372, 301,
+	437, 0, 0, 443, 0, 419, 452, 457, 261, 0,
+	226, 401, 251, 245, 0, 0, 0, 273, 358, 240,
+	293, 0, 0, 0, 232, 0, 0, 0, 400, 434,
+	195, 317, 435, 456, 161, 262, 392, 263, 418, 254,
+	227, 361, 214, 426, 318, 328, 229, 231, 230, 208,
+	393, 433, 220, 234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 274, 0, 0, 0, 344, 217,
+	0, 0, 0, 0, 447, 0, 247, 0, 449, 174,
+	387, 386, 0, 281, 0, 176, 165, 368, 177, 290,
+	199, 0, 460, 213, 295, 427, 469, 266, 335, 0,
+	345, 192, 363, 313, 315, 312, 316, 271, 170, 178,
+	0, 365, 389, 432, 215, 407, 168, 171, 180, 378,
+	181, 182, 0, 307, 256, 260, 275, 286, 0, 371,
+	408, 450, 0, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 375, 409, 423, 380, 269, 411, 415,
+	412, 413, 410, 414, 376, 377, 202, 417, 442, 221,
+	388, 391, 459, 0, 209, 204, 0, 0, 0, 0,
+	0, 0, 0, 203, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 440, 441, 237,
+	0, 0, 205, 0, 0, 332, 339, 331, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 0, 296,
+	259, 277, 356, 303, 357, 278, 326, 325, 327, 305,
+	0, 406, 306, 0, 200, 0, 405, 0, 0, 416,
+	218, 0, 0, 431, 173, 364, 219, 268, 257, 355,
+	330, 211, 280, 403, 294, 302, 0, 0, 343, 374,
+	225, 446, 402, 252, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 187, 188, 0,
+	0, 189, 0, 0, 0, 0, 0, 183, 184, 169,
+	0, 0, 0, 233, 159, 0, 0, 0, 160, 0,
+	0, 162, 163, 0, 0, 0, 0, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 175, 351, 201, 212, 430, 224,
+	244, 242, 258, 291, 314, 320, 349, 390, 396, 397,
+	420, 421, 422, 424, 246, 0, 250, 223, 369, 222,
+	304, 283, 350, 428, 429, 360, 239, 0, 194, 206,
+	298, 367, 265, 319, 394, 321, 287, 238, 458, 324,
+	366, 461, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 329, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 448,
+	243, 0, 0, 0, 207, 216, 228, 241, 255, 0,
+	264, 276, 279, 284, 285, 288, 292, 308, 309, 310,
+	311, 333, 334, 337, 338, 341, 342, 346, 347, 348,
+	352, 353, 354, 362, 179, 370, 379, 381, 382, 383,
+	384, 385, 395, 398, 399, 438, 439, 454, 455, 425,
+	191, 0, 0, 197, 0, 198, 0, 0, 196, 0,
+	340, 0, 0, 0, 0, 0, 0, 0, 0, 249,
+	0, 0, 0, 0, 0, 0, 0, 248, 235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 167,
+	453, 185, 0, 300, 0, 471, 451, 404, 322, 474,
+	473, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1137, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 0,
+	0, 0, 0, 472, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 253, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 445, 0, 0, 0, 0, 359, 272, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 323, 0, 0, 0,
+	0, 289, 186, 0, 0, 0, 0, 0, 0, 0,
+	297, 1139, 190, 0, 1135, 0, 336, 0, 0, 172,
+	193, 299, 0, 0, 0, 236, 1133, 373, 0, 444,
+	470, 267, 0, 372, 301, 437, 0, 0, 443, 0,
+	419, 452, 457, 261, 0, 226, 401, 251, 245, 0,
+	0, 0, 273, 358, 240, 293, 0, 0, 0, 232,
+	0, 0, 0, 400, 434, 195, 317, 435, 456, 161,
+	262, 392, 263, 418, 254, 227, 361, 214, 426, 318,
+	328, 229, 231, 230, 208, 393, 433, 220, 234, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 274,
+	0, 0, 0, 344, 217, 0, 0, 0, 0, 447,
+	0, 247, 0, 449, 174, 387, 386, 0, 281, 0,
+	176, 165, 368, 177, 290, 199, 0, 460, 213, 295,
+	427, 469, 266, 335, 0, 345, 192, 363, 313, 315,
+	312, 316, 271, 170, 178, 0, 365, 389, 432, 215,
+	407, 168, 171, 180, 378, 181, 182, 0, 307, 256,
+	260, 275, 286, 0, 371, 408, 450, 0, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 375, 409,
+	423, 380, 269, 411, 415, 412, 413, 410, 414, 376,
+	377, 202, 417, 442, 221, 388, 391, 459, 0, 209,
+	204, 0, 0, 0, 0, 0, 0, 0, 203, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 0, 440, 441, 237, 0, 0, 205, 0, 0,
+	332, 339, 331, 0, 0, 436, 0, 0, 0, 0,
+	0, 0, 0, 0, 296, 259, 277, 356, 303, 357,
+	278, 326, 325, 327, 305, 0, 406, 306, 0, 200,
+	0, 405, 0, 0, 416, 218, 0, 0, 431, 173,
+	364, 219, 268, 257, 355, 330, 211, 280, 403, 294,
+	302, 0, 0, 343, 374, 225, 446, 402, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 188, 0, 0, 189, 0, 0, 0,
+	0, 0, 183, 184, 169, 0, 0, 0, 233, 159,
+	0, 0, 0, 160, 0, 0, 162, 163, 0, 0,
+	0, 0, 164, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	351, 201, 212, 430, 224, 244, 242, 258, 291, 314,
+	320, 349, 390, 396, 397, 420, 421, 422, 424, 246,
+	0, 250, 223, 369, 222, 304, 283, 350, 428, 429,
+	360, 239, 0, 194, 206, 298, 367, 265, 319, 394,
+	321, 287, 238, 458, 324, 366, 461, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 448, 243, 0, 0, 0, 207,
+	216, 228, 241, 255, 0, 264, 276, 279, 284, 285,
+	288, 292, 308, 309, 310, 311, 333, 334, 337, 338,
+	341, 342, 346, 347, 348, 352, 353, 354, 362, 179,
+	370, 379, 381, 382, 383, 384, 385, 395, 398, 399,
+	438, 439, 454, 455, 0, 191, 41, 425, 197, 0,
+	198, 0, 0, 196, 0, 0, 0, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	95, 300, 0, 0, 451, 404, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 0, 0, 0, 0, 655, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 0, 0, 0, 668, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 323, 0, 0, 91, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 0, 373, 0, 444, 0, 267,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 156,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 188, 0, 0, 189, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 656, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 649, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 0, 451,
+	404, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
+	0, 655, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 0, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 156, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 187, 188, 0, 0, 189,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	3100, 656, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 649,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 425, 191, 0,
+	0, 197, 0, 198, 0, 0, 196, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	0, 300, 0, 471, 451, 404, 322, 474, 473, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1466, 0, 1467, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 472, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 323, 0, 0, 0, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 2267, 373, 0, 444, 470, 267,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 469,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 0, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 0, 451,
+	404, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
+	0, 655, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 0, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 156, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 187, 188, 0, 0, 189,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 656, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 649,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 425, 191, 0,
+	0, 197, 0, 198, 0, 0, 196, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	0, 300, 0, 471, 451, 404, 322, 474, 473, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3384, 0, 0, 0, 0, 3386, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 472, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 323, 0, 0, 0, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 0, 373, 0, 444, 470, 267,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 469,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 0, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 471, 451,
+	404, 322, 474, 473, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2028, 0, 2030, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 472, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 470, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 469, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 0,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 425, 191, 0,
+	0, 197, 0, 198, 0, 0, 196, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	0, 300, 0, 471, 451, 404, 322, 474, 473, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2028, 0, 2026, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 472, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 323, 0, 0, 0, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 0, 373, 0, 444, 470, 267,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 469,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 0, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 471, 451,
+	404, 322, 474, 473, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3252, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 472, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 470, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 469, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 0,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 425, 191, 0,
+	0, 197, 0, 198, 0, 0, 196, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	0, 300, 0, 471, 451, 404, 322, 474, 473, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3869, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 472, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 323, 0, 0, 0, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 0, 373, 0, 444, 470, 267,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 469,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 0, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 471, 451,
+	404, 322, 474, 473, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3867, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 472, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 470, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 469, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 0,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 425, 191, 0,
+	0, 197, 0, 198, 0, 0, 196, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	0, 300, 0, 471, 451, 404, 322, 474, 473, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3386, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 472, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 323, 0, 0, 0, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 0, 373, 0, 444, 470, 267,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 469,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 0, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 471, 451,
+	404, 322, 474, 473, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3402, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 472, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 470, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 469, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 0,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 425, 191, 0,
+	0, 197, 0, 198, 0, 0, 196, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	0, 300, 0, 471, 451, 404, 322, 474, 473, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3397, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 472, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 323, 0, 0, 0, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 0, 373, 0, 444, 470, 267,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 469,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 0, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 471, 451,
+	404, 322, 474, 473, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3395, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 472, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 470, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 469, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 0,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 425, 191, 0,
+	0, 197, 0, 198, 0, 0, 196, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	0, 300, 0, 471, 451, 404, 322, 474, 473, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3393, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 472, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 323, 0, 0, 0, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 0, 373, 0, 444, 470, 267,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 469,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 0, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 471, 451,
+	404, 322, 474, 473, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2028, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 472, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 470, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 469, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 0,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 425, 191, 0,
+	0, 197, 0, 198, 0, 0, 196, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	0, 300, 0, 471, 451, 404, 322, 474, 473, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1189, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 472, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 323, 0, 0, 0, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 0, 373, 0, 444, 470, 267,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 469,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 0, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 471, 451,
+	404, 322, 474, 473, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1178, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 472, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 470, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 469, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 0,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 425, 191, 0,
+	0, 197, 0, 198, 0, 0, 196, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	0, 300, 0, 0, 451, 404, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 518, 0, 323, 0, 0, 0, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 0, 373, 0, 444, 520, 517,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 156,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 188, 0, 0, 189, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 0, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 471, 451,
+	404, 322, 474, 473, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 472, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 470, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 469, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 0,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 425, 191, 0,
+	0, 197, 0, 198, 0, 0, 196, 0, 340, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 248, 235, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 167, 453, 185,
+	0, 300, 0, 0, 451, 404, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 359, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 323, 0, 0, 0, 0, 289,
+	186, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	190, 0, 0, 0, 336, 0, 0, 172, 193, 299,
+	0, 0, 0, 236, 0, 373, 0, 444, 2532, 267,
+	0, 372, 301, 437, 0, 0, 443, 0, 419, 452,
+	457, 261, 0, 226, 401, 251, 245, 0, 0, 0,
+	273, 358, 240, 293, 0, 0, 0, 232, 0, 0,
+	0, 400, 434, 195, 317, 435, 456, 161, 262, 392,
+	263, 418, 254, 227, 361, 214, 426, 318, 328, 229,
+	231, 230, 208, 393, 433, 220, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 274, 0, 0,
+	0, 344, 217, 0, 0, 0, 0, 447, 0, 247,
+	0, 449, 174, 387, 386, 0, 281, 0, 176, 165,
+	368, 177, 290, 199, 0, 460, 213, 295, 427, 156,
+	266, 335, 0, 345, 192, 363, 313, 315, 312, 316,
+	271, 170, 178, 0, 365, 389, 432, 215, 407, 168,
+	171, 180, 378, 181, 182, 0, 307, 256, 260, 275,
+	286, 0, 371, 408, 450, 0, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 375, 409, 423, 380,
+	269, 411, 415, 412, 413, 410, 414, 376, 377, 202,
+	417, 442, 221, 388, 391, 459, 0, 209, 204, 0,
+	0, 0, 0, 0, 0, 0, 203, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	440, 441, 237, 0, 0, 205, 0, 0, 332, 339,
+	331, 0, 0, 436, 0, 0, 0, 0, 0, 0,
+	0, 0, 296, 259, 277, 356, 303, 357, 278, 326,
+	325, 327, 305, 0, 406, 306, 0, 200, 0, 405,
+	0, 0, 416, 218, 0, 0, 431, 173, 364, 219,
+	268, 257, 355, 330, 211, 280, 403, 294, 302, 0,
+	0, 343, 374, 225, 446, 402, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 188, 0, 0, 189, 0, 0, 0, 0, 0,
+	183, 184, 169, 0, 0, 0, 233, 159, 0, 0,
+	0, 160, 0, 0, 162, 163, 0, 0, 0, 0,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 351, 201,
+	212, 430, 224, 244, 242, 258, 291, 314, 320, 349,
+	390, 396, 397, 420, 421, 422, 424, 246, 0, 250,
+	223, 369, 222, 304, 283, 350, 428, 429, 360, 239,
+	0, 194, 206, 298, 367, 265, 319, 394, 321, 287,
+	238, 458, 324, 366, 461, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 448, 243, 0, 0, 0, 207, 216, 228,
+	241, 255, 0, 264, 276, 279, 284, 285, 288, 292,
+	308, 309, 310, 311, 333, 334, 337, 338, 341, 342,
+	346, 347, 348, 352, 353, 354, 362, 179, 370, 379,
+	381, 382, 383, 384, 385, 395, 398, 399, 438, 439,
+	454, 455, 425, 191, 0, 0, 197, 0, 198, 0,
+	0, 196, 0, 340, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	248, 235, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 453, 185, 0, 300, 0, 0, 451,
+	404, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 0, 2416, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 0, 0, 0, 0, 359,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 0, 0, 0, 289, 186, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 190, 0, 0, 0, 336,
+	0, 0, 172, 193, 299, 0, 0, 0, 236, 0,
+	373, 0, 444, 0, 267, 0, 372, 301, 437, 0,
+	0, 443, 0, 419, 452, 457, 261, 0, 226, 401,
+	251, 245, 0, 0, 0, 273, 358, 240, 293, 0,
+	0, 0, 232, 0, 0, 0, 400, 434, 195, 317,
+	435, 456, 161, 262, 392, 263, 418, 254, 227, 361,
+	214, 426, 318, 328, 229, 231, 230, 208, 393, 433,
+	220, 234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 274, 0, 0, 0, 344, 217, 0, 0,
+	0, 0, 447, 0, 247, 0, 449, 174, 387, 386,
+	0, 281, 0, 176, 165, 368, 177, 290, 199, 0,
+	460, 213, 295, 427, 156, 266, 335, 0, 345, 192,
+	363, 313, 315, 312, 316, 271, 170, 178, 0, 365,
+	389, 432, 215, 407, 168, 171, 180, 378, 181, 182,
+	0, 307, 256, 260, 275, 286, 0, 371, 408, 450,
+	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 375, 409, 423, 380, 269, 411, 415, 412, 413,
+	410, 414, 376, 377, 202, 417, 442, 221, 388, 391,
+	459, 0, 209, 204, 0, 0, 0, 0, 0, 0,
+	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 440, 441, 237, 0, 0,
+	205, 0, 0, 332, 339, 331, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 296, 259, 277,
+	356, 303, 357, 278, 326, 325, 327, 305, 0, 406,
+	306, 0, 200, 0, 405, 0, 0, 416, 218, 0,
+	0, 431, 173, 364, 219, 268, 257, 355, 330, 211,
+	280, 403, 294, 302, 0, 0, 343, 374, 225, 446,
+	402, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 187, 188, 0, 0, 189,
+	0, 0, 0, 0, 0, 183, 184, 169, 0, 0,
+	0, 233, 159, 0, 0, 0, 160, 0, 0, 162,
+	163, 0, 0, 0, 0, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 351, 201, 212, 430, 224, 244, 242,
+	258, 291, 314, 320, 349, 390, 396, 397, 420, 421,
+	422, 424, 246, 0, 250, 223, 369, 222, 304, 283,
+	350, 428, 429, 360, 239, 0, 194, 206, 298, 367,
+	265, 319, 394, 321, 287, 238, 458, 324, 366, 461,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 243, 0,
+	0, 0, 207, 216, 228, 241, 255, 0, 264, 276,
+	279, 284, 285, 288, 292, 308, 309, 310, 311, 333,
+	334, 337, 338, 341, 342, 346, 347, 348, 352, 353,
+	354, 362, 179, 370, 379, 381, 382, 383, 384, 385,
+	395, 398, 399, 438, 439, 454, 455, 0, 191, 0,
+	425, 197, 0, 198, 1593, 0, 196, 0, 0, 0,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	167, 453, 185, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 186, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	172, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	161, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 174, 387, 386, 0, 281,
+	0, 176, 165, 368, 177, 290, 199, 0, 460, 213,
+	295, 427, 156, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 170, 178, 0, 365, 389, 432,
+	215, 407, 168, 171, 180, 378, 181, 182, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	173, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 188, 0, 0, 189, 0, 0,
+	0, 0, 0, 183, 184, 169, 0, 0, 0, 233,
+	159, 0, 0, 0, 160, 0, 0, 162, 163, 0,
+	0, 0, 0, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	179, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 167, 453, 185, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 166, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 150, 0, 289, 186, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 172, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 161, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	174, 387, 386, 0, 281, 0, 176, 165, 368, 177,
+	290, 199, 0, 460, 213, 295, 427, 156, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 170,
+	178, 0, 365, 389, 432, 215, 407, 168, 171, 180,
+	378, 181, 182, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 173, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 187, 188,
+	0, 0, 189, 0, 0, 0, 0, 0, 183, 184,
+	169, 0, 0, 0, 233, 159, 0, 0, 0, 160,
+	0, 0, 162, 163, 0, 0, 0, 0, 164, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 179, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	167, 453, 185, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 186, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	172, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	161, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 174, 387, 386, 0, 281,
+	0, 176, 165, 368, 177, 290, 199, 0, 460, 213,
+	295, 427, 156, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 170, 178, 0, 365, 389, 432,
+	215, 407, 168, 171, 180, 378, 181, 182, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	173, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 188, 0, 0, 189, 0, 0,
+	0, 0, 0, 183, 184, 169, 0, 0, 0, 233,
+	159, 0, 0, 0, 160, 0, 0, 162, 163, 0,
+	0, 0, 0, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	179, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 1478, 1477, 1487, 1488, 1480, 1481,
+	1482, 1483, 1484, 1485, 1486, 1479, 0, 0, 1489, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 0, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 453, 0, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1137,
+	710, 709, 712, 713, 714, 715, 0, 0, 0, 711,
+	0, 2309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	0, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	0, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 0, 387, 386, 0, 281,
+	0, 0, 0, 368, 0, 290, 199, 0, 460, 213,
+	295, 427, 0, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 0, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 161, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 159, 0, 0, 0, 160,
+	0, 0, 162, 163, 0, 0, 0, 0, 164, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 638, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 453, 0, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2867,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 1139, 190, 0, 0, 0, 336, 0, 0,
+	0, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 2870, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	161, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 0, 387, 386, 0, 281,
+	0, 0, 0, 368, 0, 290, 199, 0, 460, 213,
+	295, 427, 0, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 0, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	159, 0, 0, 0, 160, 0, 0, 162, 163, 0,
+	0, 0, 0, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 161, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 159, 0, 0, 0, 160,
+	0, 0, 162, 163, 0, 0, 0, 0, 164, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 4282, 0, 0, 0, 0, 0,
+	249, 4280, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 453, 0, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 610,
+	0, 609, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	0, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	0, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 0, 387, 386, 0, 281,
+	0, 4281, 0, 368, 0, 290, 199, 0, 460, 213,
+	295, 427, 0, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 0, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2942, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 2943, 2944, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 0, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 2460, 0,
+	0, 453, 0, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2878,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 2459, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	0, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	0, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 0, 387, 386, 0, 281,
+	0, 0, 0, 368, 0, 290, 199, 0, 460, 213,
+	295, 427, 0, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 0, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 610, 0, 609, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 0, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 2062,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 1611, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 453, 0, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1137,
+	0, 1610, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	0, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	0, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 0, 387, 386, 0, 281,
+	0, 0, 0, 368, 0, 290, 199, 0, 460, 213,
+	295, 427, 0, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 0, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 610, 0, 609, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 0, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 453, 0, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1137,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 3358, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	0, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	0, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 0, 387, 386, 0, 281,
+	0, 0, 0, 368, 0, 290, 199, 0, 460, 213,
+	295, 427, 0, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 0, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 3349,
+	0, 0, 0, 0, 1137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 0, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 453, 0, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 98, 0, 0, 0, 0, 1137,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	0, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	0, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 0, 387, 386, 0, 281,
+	0, 0, 0, 368, 0, 290, 199, 0, 460, 213,
+	295, 427, 0, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 0, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	2589, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 0, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 2691, 2690, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 453, 0, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1137,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	0, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	0, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 0, 387, 386, 0, 281,
+	0, 0, 0, 368, 0, 290, 199, 0, 460, 213,
+	295, 427, 0, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 0, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 0, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 453, 0, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2878,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	0, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	0, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 0, 387, 386, 0, 281,
+	0, 0, 0, 368, 0, 290, 199, 0, 460, 213,
+	295, 427, 0, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 0, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 530, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 0, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	425, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 340, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 248, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 453, 0, 0, 300, 0, 0, 451, 404, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 445, 0, 0, 0, 0, 359, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 323, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 190, 0, 0, 0, 336, 0, 0,
+	0, 193, 299, 0, 0, 0, 236, 0, 373, 0,
+	444, 0, 267, 0, 372, 301, 437, 0, 0, 443,
+	0, 419, 452, 457, 261, 0, 226, 401, 251, 245,
+	0, 0, 0, 273, 358, 240, 293, 0, 0, 0,
+	232, 0, 0, 0, 400, 434, 195, 317, 435, 456,
+	0, 262, 392, 263, 418, 254, 227, 361, 214, 426,
+	318, 328, 229, 231, 230, 208, 393, 433, 220, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	274, 0, 0, 0, 344, 217, 0, 0, 0, 0,
+	447, 0, 247, 0, 449, 0, 387, 386, 0, 281,
+	0, 0, 0, 368, 0, 290, 199, 0, 460, 213,
+	295, 427, 0, 266, 335, 0, 345, 192, 363, 313,
+	315, 312, 316, 271, 0, 0, 0, 365, 389, 432,
+	215, 407, 0, 0, 0, 378, 0, 0, 0, 307,
+	256, 260, 275, 286, 0, 371, 408, 450, 0, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
+	409, 423, 380, 269, 411, 415, 412, 413, 410, 414,
+	376, 377, 202, 417, 442, 221, 388, 391, 459, 0,
+	209, 204, 0, 0, 0, 0, 0, 0, 0, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 440, 441, 237, 0, 0, 205, 0,
+	0, 332, 339, 331, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 296, 259, 277, 356, 303,
+	357, 278, 326, 325, 327, 305, 0, 406, 306, 0,
+	200, 0, 405, 0, 0, 416, 218, 0, 0, 431,
+	0, 364, 219, 268, 257, 355, 330, 211, 280, 403,
+	294, 302, 0, 0, 343, 374, 225, 446, 402, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 201, 212, 430, 224, 244, 242, 258, 291,
+	314, 320, 349, 390, 396, 397, 420, 421, 422, 424,
+	246, 0, 250, 223, 369, 222, 304, 283, 350, 428,
+	429, 360, 239, 0, 194, 206, 298, 367, 265, 319,
+	394, 321, 287, 238, 458, 324, 366, 461, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 448, 243, 0, 0, 0,
+	207, 216, 228, 241, 255, 0, 264, 276, 279, 284,
+	285, 288, 292, 308, 309, 310, 311, 333, 334, 337,
+	338, 341, 342, 346, 347, 348, 352, 353, 354, 362,
+	0, 370, 379, 381, 382, 383, 384, 385, 395, 398,
+	399, 438, 439, 454, 455, 425, 191, 0, 0, 197,
+	0, 198, 0, 0, 196, 0, 340, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 0, 0, 248, 235, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 453, 0, 0, 300,
+	0, 0, 451, 404, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 475, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 359, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 323, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 190, 0,
+	0, 0, 336, 0, 0, 0, 193, 299, 0, 0,
+	0, 236, 0, 373, 0, 444, 0, 267, 0, 372,
+	301, 437, 0, 0, 443, 0, 419, 452, 457, 261,
+	0, 226, 401, 251, 245, 0, 0, 0, 273, 358,
+	240, 293, 0, 0, 0, 232, 0, 0, 0, 400,
+	434, 195, 317, 435, 456, 0, 262, 392, 263, 418,
+	254, 227, 361, 214, 426, 318, 328, 229, 231, 230,
+	208, 393, 433, 220, 234, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 274, 0, 0, 0, 344,
+	217, 0, 0, 0, 0, 447, 0, 247, 0, 449,
+	0, 387, 386, 0, 281, 0, 0, 0, 368, 0,
+	290, 199, 0, 460, 213, 295, 427, 0, 266, 335,
+	0, 345, 192, 363, 313, 315, 312, 316, 271, 0,
+	0, 0, 365, 389, 432, 215, 407, 0, 0, 0,
+	378, 0, 0, 0, 307, 256, 260, 275, 286, 0,
+	371, 408, 450, 0, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 375, 409, 423, 380, 269, 411,
+	415, 412, 413, 410, 414, 376, 377, 202, 417, 442,
+	221, 388, 391, 459, 0, 209, 204, 0, 0, 0,
+	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 440, 441,
+	237, 0, 0, 205, 0, 0, 332, 339, 331, 0,
+	0, 436, 0, 0, 0, 0, 0, 0, 0, 0,
+	296, 259, 277, 356, 303, 357, 278, 326, 325, 327,
+	305, 0, 406, 306, 0, 200, 0, 405, 0, 0,
+	416, 218, 0, 0, 431, 0, 364, 219, 268, 257,
+	355, 330, 211, 280, 403, 294, 302, 0, 0, 343,
+	374, 225, 446, 402, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 351, 201, 212, 430,
+	224, 244, 242, 258, 291, 314, 320, 349, 390, 396,
+	397, 420, 421, 422, 424, 246, 0, 250, 223, 369,
+	222, 304, 283, 350, 428, 429, 360, 239, 0, 194,
+	206, 298, 367, 265, 319, 394, 321, 287, 238, 458,
+	324, 366, 461, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 243, 0, 0, 0, 207, 216, 228, 241, 255,
+	0, 264, 276, 279, 284, 285, 288, 292, 308, 309,
+	310, 311, 333, 334, 337, 338, 341, 342, 346, 347,
+	348, 352, 353, 354, 362, 0, 370, 379, 381, 382,
+	383, 384, 385, 395, 398, 399, 438, 439, 454, 455,
+	41, 191, 0, 0, 197, 0, 198, 0, 0, 196,
+	0, 0, 0, 0, 69, 0, 0, 0, 0, 0,
+	88, 0, 0, 44, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 41, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 0, 69, 0,
+	4097, 0, 0, 0, 88, 0, 0, 44, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
+	0, 0, 0, 0, 0, 4090, 0, 0, 95, 0,
+	4444, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	0, 0, 0, 0, 4097, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 0, 0, 0, 0, 4090,
+	0, 0, 0, 0, 4427, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 4088, 4105, 4089, 53, 56, 0, 0, 0,
+	0, 91, 0, 0, 0, 0, 0, 4091, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 60, 87, 86, 0, 0, 0,
+	0, 55, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 4088, 4105, 4089, 53,
+	56, 0, 0, 0, 0, 91, 0, 0, 0, 0,
+	0, 4091, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 60, 87,
+	86, 0, 0, 0, 0, 55, 0, 0, 0, 0,
+	0, 67, 68, 0, 4093, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4102, 4094, 4095, 4096, 4100, 4101,
+	4098, 0, 4099, 0, 4103, 0, 0, 0, 0, 76,
+	0, 77, 0, 0, 0, 0, 0, 41, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 69, 0, 0, 82, 67, 68, 88, 4093, 0,
+	44, 0, 0, 58, 0, 0, 0, 0, 4102, 4094,
+	4095, 4096, 4100, 4101, 4098, 0, 4099, 0, 4103, 0,
+	0, 95, 0, 76, 0, 77, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 4097, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 58, 0, 0,
+	0, 0, 0, 0, 0, 85, 0, 0, 0, 0,
+	0, 0, 4090, 4104, 4092, 0, 64, 65, 71, 0,
+	72, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 4104, 4092, 0,
+	64, 65, 71, 0, 72, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 41, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 69, 4088,
+	4105, 4089, 53, 56, 88, 0, 0, 44, 91, 0,
+	0, 0, 0, 0, 4091, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 95, 0,
+	0, 60, 87, 86, 0, 0, 0, 0, 55, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	0, 0, 0, 0, 4097, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 0, 0, 0, 0, 4090,
+	0, 0, 0, 0, 4423, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 67, 68,
+	0, 4093, 57, 59, 0, 0, 0, 0, 0, 0,
+	0, 4102, 4094, 4095, 4096, 4100, 4101, 4098, 4424, 4099,
+	0, 4103, 0, 0, 0, 0, 76, 0, 77, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 82, 0, 0, 0, 0, 57, 59, 0, 41,
+	58, 0, 0, 0, 0, 0, 4088, 4105, 4089, 53,
+	56, 0, 0, 69, 0, 91, 0, 0, 0, 88,
+	0, 4091, 44, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 60, 87,
+	86, 0, 0, 95, 0, 55, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 98, 0, 0, 0, 0, 4097,
+	4104, 4092, 0, 64, 65, 71, 0, 72, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 85, 0, 0,
+	0, 0, 0, 0, 4090, 0, 0, 0, 0, 4422,
+	0, 0, 0, 0, 0, 67, 68, 0, 4093, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 4102, 4094,
+	4095, 4096, 4100, 4101, 4098, 0, 4099, 0, 4103, 0,
+	0, 0, 0, 76, 0, 77, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 41, 58, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	69, 4088, 4105, 4089, 53, 56, 88, 0, 0, 44,
+	91, 0, 0, 0, 0, 0, 4091, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	95, 0, 0, 60, 87, 86, 0, 0, 0, 0,
+	55, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 4419, 0, 0, 0, 4097, 4104, 4092, 0,
+	64, 65, 71, 0, 72, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 0, 0, 0, 0, 57,
+	59, 4090, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	67, 68, 0, 4093, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 4102, 4094, 4095, 4096, 4100, 4101, 4098,
+	0, 4099, 0, 4103, 0, 0, 0, 0, 76, 0,
+	77, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
+	0, 41, 58, 0, 0, 0, 0, 0, 4088, 4105,
+	4089, 53, 56, 0, 0, 69, 0, 91, 0, 0,
+	0, 88, 0, 4091, 44, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	60, 87, 86, 0, 0, 95, 0, 55, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
+	0, 4097, 4104, 4092, 0, 64, 65, 71, 0, 72,
+	0, 0, 0, 0, 0, 0, 57, 59, 0, 85,
+	0, 0, 0, 0, 0, 0, 4090, 0, 0, 0,
+	0, 4411, 0, 0, 0, 0, 0, 67, 68, 0,
+	4093, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	4102, 4094, 4095, 4096, 4100, 4101, 4098, 0, 4099, 0,
+	4103, 0, 0, 0, 0, 76, 0, 77, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	82, 0, 0, 0, 0, 0, 0, 0, 41, 58,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 69, 4088, 4105, 4089, 53, 56, 88, 0,
+	0, 44, 91, 0, 0, 0, 0, 0, 4091, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 95, 0, 0, 60, 87, 86, 0, 0,
+	0, 0, 55, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 0, 0, 0, 4097, 4104,
+	4092, 0, 64, 65, 71, 0, 72, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
+	0, 57, 59, 4090, 0, 0, 0, 0, 4408, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 67, 68, 0, 4093, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 4102, 4094, 4095, 4096, 4100,
+	4101, 4098, 0, 4099, 0, 4103, 0, 0, 0, 0,
+	76, 0, 77, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 82, 0, 0, 0, 0,
+	0, 0, 0, 41, 58, 0, 0, 0, 0, 0,
+	4088, 4105, 4089, 53, 56, 0, 0, 69, 0, 91,
+	0, 0, 0, 88, 0, 4091, 44, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 60, 87, 86, 0, 0, 95, 0, 55,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 0, 4097, 4104, 4092, 0, 64, 65, 71,
+	0, 72, 0, 0, 0, 0, 0, 0, 57, 59,
+	0, 85, 0, 0, 0, 0, 0, 0, 4090, 0,
+	0, 0, 0, 4331, 0, 0, 0, 0, 0, 67,
+	68, 0, 4093, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 4102, 4094, 4095, 4096, 4100, 4101, 4098, 0,
+	4099, 0, 4103, 0, 0, 0, 0, 76, 0, 77,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	41, 58, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 69, 4088, 4105, 4089, 53, 56,
+	88, 0, 0, 44, 91, 0, 0, 0, 0, 0,
+	4091, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 95, 0, 0, 60, 87, 86,
+	0, 0, 0, 0, 55, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
+	4097, 4104, 4092, 0, 64, 65, 71, 0, 72, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
+	0, 0, 0, 57, 59, 4090, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 67, 68, 0, 4093, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 4102, 4094, 4095,
+	4096, 4100, 4101, 4098, 0, 4099, 0, 4103, 0, 0,
+	0, 0, 76, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
+	0, 0, 0, 0, 0, 41, 58, 0, 0, 0,
+	0, 0, 4088, 4105, 4089, 53, 56, 0, 0, 69,
+	0, 91, 0, 0, 0, 88, 0, 4091, 44, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 60, 87, 86, 0, 0, 95,
+	0, 55, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 0, 4097, 4104, 4092, 0, 64,
+	65, 71, 0, 72, 0, 0, 0, 0, 0, 0,
+	57, 59, 0, 85, 0, 0, 0, 0, 0, 0,
+	4090, 0, 0, 0, 0, 4323, 0, 0, 0, 0,
+	0, 67, 68, 0, 4093, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 4102, 4094, 4095, 4096, 4100, 4101,
+	4098, 4328, 4099, 0, 4103, 0, 0, 0, 0, 76,
+	0, 77, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
+	0, 0, 41, 58, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 69, 4088, 4105, 4089,
+	53, 56, 88, 0, 0, 44, 91, 0, 0, 0,
+	0, 0, 4091, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 95, 0, 0, 60,
+	87, 86, 0, 0, 0, 0, 55, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
+	0, 0, 4097, 4104, 4092, 0, 64, 65, 71, 0,
+	72, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	85, 0, 0, 0, 0, 57, 59, 4090, 0, 0,
+	0, 0, 4321, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 67, 68, 0, 4093,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 4102,
+	4094, 4095, 4096, 4100, 4101, 4098, 0, 4099, 0, 4103,
+	0, 0, 0, 0, 76, 0, 77, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
+	0, 0, 0, 0, 0, 0, 0, 41, 58, 0,
+	0, 0, 0, 0, 4088, 4105, 4089, 53, 56, 0,
+	0, 69, 0, 91, 0, 0, 0, 88, 0, 4091,
+	44, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 60, 87, 86, 0,
+	0, 95, 0, 55, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 4097, 4104, 4092,
+	0, 64, 65, 71, 0, 72, 0, 0, 0, 0,
+	0, 0, 57, 59, 0, 85, 0, 0, 0, 0,
+	0, 0, 4090, 0, 0, 0, 0, 4207, 0, 0,
+	0, 0, 0, 67, 68, 0, 4093, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 4102, 4094, 4095, 4096,
+	4100, 4101, 4098, 0, 4099, 0, 4103, 0, 0, 0,
+	0, 76, 0, 77, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
+	0, 0, 0, 0, 41, 58, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 69, 4088,
+	4105, 4089, 53, 56, 88, 0, 0, 44, 91, 0,
+	0, 0, 0, 0, 4091, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 95, 0,
+	0, 60, 87, 86, 0, 0, 0, 0, 55, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	0, 0, 0, 0, 4097, 4104, 4092, 0, 64, 65,
+	71, 0, 72, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 85, 0, 0, 0, 0, 57, 59, 4090,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 67, 68,
+	0, 4093, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 4102, 4094, 4095, 4096, 4100, 4101, 4098, 0, 4099,
+	0, 4103, 0, 0, 0, 0, 76, 0, 77, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
+	58, 0, 0, 0, 0, 0, 4088, 4105, 4089, 53,
+	56, 0, 0, 0, 0, 91, 0, 0, 0, 0,
+	0, 4091, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 60, 87,
+	86, 0, 0, 0, 0, 55, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	4104, 4092, 0, 64, 65, 71, 0, 72, 0, 0,
+	0, 0, 0, 0, 57, 59, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 67, 68, 0, 4093, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 4102, 4094,
+	4095, 4096, 4100, 4101, 4098, 0, 4099, 0, 4103, 0,
+	0, 0, 0, 76, 0, 77, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 58, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 4104, 4092, 0,
+	64, 65, 71, 0, 72, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 57,
+	59, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 57, 59,
+}
+
+var yyPact = [...]int32{
+	6096, -1000, -469, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2855, -1000, -1000, 389, -1000, 1497, 231, -1000, -1000, -1000,
+	-1000, -1000, -1000, 682, 146, 56663, 1120, 53840, 72173, -268,
+	5319, 71468, 173, -1000, 173, 412, 53135, 870, 895, 70763,
+	2656, 2195, 176, 36, 22, 249, 38327, 37611, 319, 273,
+	218, 198, 191, 143, -1000, 59483, -1000, -1000, -1000, -1000,
+	-1000, 42560, -1000, 2915, 2918, 844, 2915, 1240, -1000, -1000,
+	919, 2850, -1000, -1000, 13032, -1000, -1000, -1000, -1000, -1000,
+	29661, -1000, 1294, -1000, 57368, 39737, 2855, -1000, 1110, 1101,
+	-153, 1027, 1027, 897, 1027, 1027, -425, 1118, 2867, -1000,
+	797, 1531, -1000, -1000, -1000, 1530, 57368, 65123, 2866, 1028,
+	69353, 1028, 1028, 1028, 1028, 1028, 1028, 1028, 1028, 52430,
+	57368, -1000, 1239, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 57368, 2900, 2899, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 2488, 2487, 51725, 313, 382,
+	274, 2704, 1594, 352, 1594, -1000, -1000, 1579, -1000, 918,
+	896, 885, 927, 877, 2, 1545, 1611, 2823, -1000, 2431,
+	2822, 2822, 1545, -1000, -1000, -1000, -1000, -1000, -1000, 1338,
+	-1000, -1000, 1609, 1609, 523, 1080, 1508, 948, 1240, 2820,
+	1527, 2701, 2700, 1998, 2698, 869, 2693, 2482, -1000, 1236,
+	-1000, -1000, 79, -1000, 2194, 57368, -1000, -1000, 1969, 1962,
+	614, 2366, 1166, 2542, -1000, -1000, 606, 822, 2461, 761,
+	2461, 2461, 2461, 396, 2461, 2461, 21, 2461, 2461, 2461,
+	327, 2461, 347, 2461, 2461, 2461, 2461, 2461, -1000, 2215,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 626, 2343, 2396, 2540, 213, -1000, 578, -107,
+	-1000, -1000, 2800, 312, 1046, 1028, 964, -1000, -1000, -1000,
+	2865, 1088, 2164, 1116, 1028, 748, -1000, 1294, 60893, 2420,
+	-1000, 2431, -1000, -1000, 510, -1000, -1000, -1000, 2734, 2431,
+	40445, -1000, -1000, 1506, -1000, 2431, 2431, 1294, 2806, 1294,
+	1294, 2804, 2796, 2429, 2431, 1294, 2201, -1000, 59483, 2684,
+	-1000, 26769, 26769, -1000, 1125, 17370, 330, 1696, 2896, -1000,
+	10091, 1233, -1000, 17370, 4677, 2431, 2431, -1000, 1189, 1287,
+	-1000, 1163, 1223, -1000, -1000, 18093, 18093, 1330, 18093, 18093,
+	18093, 18093, 18093, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2431,
+	1231, 1227, -1000, 2431, 2431, 2431, 2431, 2431, -1000, 15924,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431,
+	2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431, 17370, 2431,
+	2431, 2431, 2431, 2431, -1000, 2431, 2431, 2431, 2431, 2431,
+	2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431,
+	2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431,
+	2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 2431, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2431, 2431,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2431, 1033, 1033, 55958, 2428, 2862, -56, 28938, -1000, -1000,
+	-1000, -1000, 27492, 64418, 1471, -1000, -1000, -1000, -1000, 2690,
+	-1000, -1000, 1225, 1223, 1189, 1183, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2855, -1000, 2323, -1000, -1000, 9498,
+	9498, 9498, 9498, 9498, 9498, 463, -1000, -1000, -1000, 2468,
+	919, -1000, 69353, 57368, 806, 2193, 1578, 2191, 70763, 70763,
+	1027, -1000, 770, 65123, 65123, -330, 1027, 69353, 786, 65123,
+	814, 2427, 1060, 2426, 1058, 2042, 69353, 57368, 2728, 2539,
+	57368, 2189, 2188, 70763, 70763, 65123, 65123, 70763, -1000, -1000,
+	-1000, 24600, -1000, 1307, 2425, 44675, 43970, 51020, 51020, -1000,
+	-1000, -1000, -1000, 1480, -1000, 1594, -1000, 6690, 17370, 1027,
+	1027, 57368, 57368, 57368, 57368, 57368, -1000, 57368, -1000, -1000,
+	1594, -1000, 1594, 1594, 1594, 1545, 2895, 1545, -1000, -1000,
+	-1000, 1594, 71468, 71468, 17370, -1000, 18093, 63713, 1357, -1000,
+	26769, -1000, 1594, 71468, 972, 972, 972, -1000, 2186, 519,
+	-1000, -1000, -1000, -1000, -1000, 1508, -1000, -1000, -1000, 2665,
+	2785, 2781, 2185, 869, 869, -1000, 2112, 869, 2413, -1000,
+	1512, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 869, 2820, 16647, 69353, -449, -1000, -1000,
+	2424, -1000, 366, -1000, -1000, 961, 2538, 961, 5580, 65123,
+	65123, 65123, -1000, 2461, 69353, -1000, 2461, 2461, 2461, 7,
+	2461, 2461, -1000, -1000, 2461, -1000, -1000, -1000, 2461, -1000,
+	-1000, 2461, -1000, -1000, -1000, 2461, 2461, -1000, 2461, 2461,
+	-1000, -1000, -1000, -1000, -1000, 65123, 961, 425, 2421, 961,
+	65123, 65123, 1056, 197, 588, -86, -1000, -1000, 1598, -1000,
+	1959, 69353, -1000, 311, 310, 303, 301, -1000, -1000, -1000,
+	-1000, 298, 292, -1000, -1000, 69353, 57368, 2713, -1000, 2713,
+	-1000, -1000, -1000, 244, -1000, 57368, 65123, 70763, -1000, 2420,
+	60893, 2768, 21708, 42560, 42560, 42560, 42560, -1000, 2575, 2573,
+	-1000, 2569, 2560, 2558, 479, 60893, -1000, 2734, 1240, 2320,
+	2201, 510, 40445, -1000, 2431, -1000, 60893, 1197, 1108, 1106,
+	1105, 2665, -1000, -1000, -1000, -1000, -1000, 2063, 2038, 1737,
+	12309, 18093, -1000, -1000, -1000, -1000, -1000, 2915, 2915, -1000,
+	-1000, -1000, -1000, -1000, 864, -1000, 17370, -1000, -1000, -1000,
+	1294, 1673, 2909, 1474, 260, -1000, -1000, -1000, 951, 1719,
+	-1000, -1000, 2418, -1000, 1627, 1218, 1784, 1660, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 13032, -1000, 57368, -1000,
+	17370, 17370, 17370, 1760, -1000, 41855, -1000, -1000, -1000, 22431,
+	1467, 18093, 1738, 1556, 18093, 18093, 18093, 18093, 18093, 18093,
+	18093, 18093, 18093, 18093, 18093, 18093, 18093, 18093, 18093, 1429,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2181, -1000,
+	35445, 12309, 35445, 2133, 2133, 69353, 1325, 1325, 1429, 1325,
+	1325, 1325, 1325, 58073, 2777, 33999, 35445, 2777, 2777, 2777,
+	2777, 2777, 2313, 1898, 59483, 15924, 996, 42560, 12309, 12309,
+	12309, 12309, 2201, 17370, 17370, 12309, 18093, 12309, 15201, 14478,
+	19539, 12309, 12309, 2777, 1538, 1898, 26046, 12309, -1000, 2201,
+	-1000, -1000, -1000, -1000, 1913, -1000, -1000, -156, -156, -156,
+	12309, 12309, 12309, 12309, 2201, 2201, 12309, 12309, 12309, 12309,
+	12309, 2201, 2201, 2201, 2201, 12309, 12309, 12309, 12309, 12309,
+	12309, 12309, 12309, 12309, 12309, 12309, 12309, -1000, -1000, -1000,
+	1897, 55250, 42560, 57368, 2338, 2319, -1000, -1000, 1216, 28215,
+	69353, 2404, -1000, -112, -106, -56, -1000, -1000, 1292, -1000,
+	-1000, -1000, 13755, -1000, -1000, -1000, 23154, 919, -1000, 39032,
+	-1000, 46, -1000, -1000, -1000, 2443, -1000, 525, 522, 109,
+	531, 2445, 2445, 2445, 2445, -1000, -1000, -1000, -1000, 110,
+	-1000, -1000, -1000, -1000, -1000, 2467, 2465, -1000, 2443, 2443,
+	2443, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 2452, 517, 2444, 2444, 2444,
+	2444, 2444, -1000, -1000, -1000, -1000, -1000, 998, 998, 998,
+	-1000, 17370, 2684, 334, 2457, 2431, 1084, -427, 786, 2713,
+	2727, 2713, 865, -1000, 1209, 2462, 70763, 855, 2405, -1000,
+	441, 2342, 1145, 54545, 2168, -1000, -1000, -1000, -1000, -1000,
+	57368, 57368, 65123, 65123, 65123, 2548, 1119, -1000, 57368, 2416,
+	-1000, -1000, -1000, -1000, -1000, 2372, -1000, 2342, -1000, -1000,
+	1198, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -387, -388, 57368, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2414, -1000, 2414, 2894, 2110, -1000, -1000, 2090,
+	1898, 2167, 2166, 2820, -1000, -1000, -1000, -1000, 1564, -1000,
+	-1000, -1000, -1000, 1594, 57368, 2820, -1000, -1000, -1000, 1898,
+	1319, 2486, 2431, 2201, 2391, -1000, -1000, -1000, 165, -1000,
+	163, -1000, -1000, -1000, 2165, -1000, 18093, 2663, 2660, -1000,
+	2413, 2413, -1000, 2413, 869, 58778, 2413, -1000, -1000, 2311,
+	-1000, 174, 2089, 57368, -1000, 60893, 75, -1000, -125, 67943,
+	-1000, -1000, -1000, 961, 67943, -1000, 327, 1699, -1000, 1055,
+	-1000, 2309, -1000, -1000, -1000, -1000, 2461, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 67943, 394, 424,
+	67943, 2372, 2893, 588, 2409, -1000, 1504, 1498, 2403, -1000,
+	1494, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2162, -1000, -1000, -1000, -1000, -1000, -1000, 2817, -1000, -446,
+	2431, -1000, -1000, -1000, -1000, 2689, 1471, 1471, 1471, 1903,
+	441, 2537, -1000, 2431, 2281, -1000, 1195, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2456, 2536, 696, -1000, -1000, -1000, -1000,
+	2570, -1000, 2567, 2561, -1000, -1000, -1000, 2434, 60893, 42560,
+	-1000, -1000, 2201, 69353, 1155, -1000, 60893, 2431, 2431, 2431,
+	18093, 18093, 1466, -1000, 18093, 18093, 1449, -1000, 18093, 18093,
+	1443, -1000, 2201, 2408, -1000, -1000, 1898, 1193, 4692, 2431,
+	-1000, 68648, 71, 2585, 26769, 26769, 2827, 1631, -440, 605,
+	-1000, -1000, 155, -1000, -1000, 17370, -1000, -1000, -1000, 23154,
+	2821, -1000, 2596, 2592, 2891, -1000, -1000, -1000, 1467, 1583,
+	1511, -1000, -1000, 1728, -1000, -1000, -1000, -1000, -1000, 1192,
+	2431, -1000, -1000, -1000, -1000, -1000, 4956, -1000, -1000, -1000,
+	-1000, 1738, 18093, 18093, 18093, 1319, 4956, 4894, 2119, 2294,
+	1325, 1567, 1567, 1303, 1303, 1303, 1303, 1303, 1764, 1764,
+	-1000, -1000, -1000, -1000, 2201, -1000, 2305, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 12309, -1000, -1000, -1000, -1000, -1000,
+	12309, 12309, 12309, 12309, 12309, 17370, -1000, 2305, 2305, 2201,
+	248, 2305, -1000, 1615, 1923, 2227, 4820, 2305, 1400, 2890,
+	2305, 1332, 2887, 2305, 2150, 18093, 18093, 18093, 2305, 2305,
+	12309, 1566, -1000, 17370, 2201, 1273, 2201, 1189, 1183, 2305,
+	-1000, 2201, 2407, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2406, 2885, 2305, 2201, 2305, 2305,
+	-170, -170, 2201, 2305, 2201, 2305, 2305, -170, -170, -170,
+	-170, 2305, 2305, 2305, 2305, 2305, 2305, 2305, 2305, 2305,
+	2305, 2305, 2305, -1000, 2201, 1395, 57368, -1000, 2387, 1395,
+	2334, 42560, -1000, -1000, 42560, 71468, 23877, -1000, 27492, 2816,
+	-56, -67, -1000, -1000, 2404, -1000, -1000, 1489, -1000, -1000,
+	2087, 1898, -1000, 1181, 2684, 509, -1000, -1000, -1000, -1000,
+	2453, 60188, -1000, 2619, 1262, 1262, 63008, 70058, 2617, -1000,
+	-1000, 237, 43, -1000, -1000, -1000, 1952, -1000, 2445, 293,
+	-1000, 2445, 514, 506, 2445, -1000, 2445, 2445, -1000, -1000,
+	-1000, -1000, -1000, 2086, 2086, -1000, -1000, -1000, -1000, 1944,
+	-1000, 2452, -1000, 1943, -1000, -1000, -1000, -1000, 237, 237,
+	237, 237, 237, -1000, -1000, -1000, 1877, -1000, 2535, 69353,
+	2767, 57368, -332, -1000, -1000, -1000, 2877, -1000, -1000, 69353,
+	62303, 2533, -1000, -1000, 337, 65123, 436, 763, 1766, -1000,
+	1577, -1000, -1000, 1471, 1054, -1000, 1053, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 57368, 65123, 69353, 2532, 2518, -1000,
+	51020, 2822, 1937, -1000, -1000, -1000, -1000, -1000, 2869, 2161,
+	2160, -1000, 1545, 1594, -1000, 18093, 65123, -1000, 922, 26769,
+	-1000, -1000, -1000, 4956, 18093, 18093, -1000, -1000, -1000, -1000,
+	1664, -1000, -1000, -1000, -1000, 44, -1000, -1000, 1051, 1176,
+	1175, 67943, 1050, -1000, 358, 65123, -1000, 69353, -1000, 2883,
+	2882, 386, 2881, 65123, 2403, 197, 2449, 2448, 588, 1831,
+	1046, -1000, 261, -1000, -438, 1471, 2761, 1471, 1481, -1000,
+	1476, 2084, 2402, -1000, 1264, 1229, 1204, 1001, 1001, -1000,
+	1471, 1001, 2673, 2667, 434, 1471, 1471, 1471, 1471, 1471,
+	1471, -1000, 1471, 1471, 1471, 1405, -1000, 1127, 672, -1000,
+	-1000, 21708, 35445, -1000, -1000, 17370, 2447, -1000, 17370, -1000,
+	-1000, -1000, -1000, -1000, 69353, 2434, 510, -1000, 2279, -1000,
+	-1000, 1155, 69353, 69353, 69353, 4847, 2044, 2431, 4789, 1871,
+	2431, 4748, 1808, 2431, 2734, 12309, 20985, 2082, -1000, 2398,
+	2080, 2079, -1000, 66, 2581, 185, -1000, 26769, -441, -1000,
+	-1000, 318, 951, -1000, 57368, -1000, -1000, -1000, -1000, 2869,
+	41150, 13032, -1000, -1000, -1000, -1000, 33276, 12309, -1000, 1319,
+	4956, 2898, -1000, 18093, -1000, -1000, 2201, 2305, 2305, 2305,
+	2305, 2305, 1898, -1000, -1000, -1000, 2201, 409, -1000, 2517,
+	-1000, -1000, 18093, -1000, 409, 1429, 409, -1000, 1429, 18093,
+	-1000, 18093, 18093, -1000, 18093, 18093, -1000, -1000, 18093, 4571,
+	4521, 3092, -274, -1000, 2390, 1509, -1000, 17370, 1866, -1000,
+	-1000, -1000, -1000, -1000, 18093, 18093, 18093, -1000, -1000, -1000,
+	-1000, -1000, 67238, -1000, -170, 83, -170, 83, 2880, -1000,
+	-1000, -1000, -1000, -170, -170, -170, -170, -170, -170, -170,
+	-170, -170, -170, -170, -170, -1000, 1570, 2431, -1000, 25323,
+	2869, 42560, 2367, 2367, -1000, 1174, -1000, 1935, -1000, -1000,
+	-116, -113, -1000, -1000, -1000, -1000, -1000, 35445, -1000, -1000,
+	-1000, 4552, 2847, 1471, 1471, 1471, 1471, 379, 2688, 1471,
+	1471, 1471, 1471, 1471, 1471, -220, -224, 1471, 1471, 1471,
+	1471, 1471, 1471, 1471, 1471, 1471, 1471, 1471, 184, 1471,
+	1471, 1471, 1471, 1471, 30384, 1471, 45380, 1008, 2621, 979,
+	998, -1000, 70058, 70058, 70058, -1000, 70058, -1000, -1000, -1000,
+	2158, -1000, -1000, 1678, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2431, -460, 1934, 57368, -1000, 2687, 1408, 37613,
+	2907, -1000, 2616, -1000, 2612, 2609, 2077, -1000, -1000, 2330,
+	-1000, 2325, 2324, -1000, -1000, 2445, -1000, 2445, -1000, -1000,
+	-1000, 2303, -1000, 2301, 2397, -1000, 2295, -381, 57368, -1000,
+	-1000, 2754, 1910, 2516, -1000, -1000, -1000, -1000, 2315, 2395,
+	-1000, 9498, 69353, 69353, 69353, 737, 1625, 576, -1000, 65123,
+	388, 441, 667, 2157, -353, 2726, -7, 57368, 65123, -1000,
+	-1000, -1000, 66533, 66533, -1000, -1000, -1000, -1000, 17370, -1000,
+	-1000, 2820, -1000, 4956, 2342, -1000, -1000, -1000, 4956, 4956,
+	1395, 1113, -1000, 65123, 1353, 65828, 1044, 65123, 384, 1649,
+	-1000, 65123, 65123, 2879, 65123, 2372, -1000, 57368, 57368, -1000,
+	-1000, -1000, -1000, -1000, 2076, 883, 1471, 1824, -1000, 1468,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1903, 43265, 70058,
+	1169, -1000, -1000, 1011, -1000, 359, 50315, 49610, 48905, 69353,
+	2608, 2605, 48200, 57368, 69353, -1000, -1000, 47495, 2156, 17370,
+	69353, -1000, -1000, 2682, 1471, 1957, 2075, 1797, 1797, 2431,
+	997, 32553, 2163, 812, 69353, 872, 1042, 832, -8, -1000,
+	-1000, 1898, 69353, 1898, 2293, -1000, -1000, 1464, 69353, -1000,
+	2229, 2229, 2229, 18093, 18093, 18093, 18093, 18093, 18093, 18093,
+	18093, 18093, 60893, -1000, -1000, 1152, -43, 69353, -1000, 26,
+	-1000, -1000, -1000, -1000, -1000, -1000, -442, 315, -1000, 2861,
+	-1000, 2394, -1000, -1000, -1000, 2201, -1000, 18093, 4956, -1000,
+	-170, -170, -170, -170, -170, -1000, 616, 2443, 2443, -1000,
+	2443, 2444, -1000, -1000, -1000, 2443, 156, 2443, 133, -1000,
+	-59, 4956, 2201, 2201, 2201, 2201, 2321, 4296, 4065, 3978,
+	2210, 2321, 18093, 18093, 18093, 2431, -165, -1000, 1898, 17370,
+	3433, 3262, 2321, -1000, -1000, 2155, -1000, -170, -401, -403,
+	-1000, -170, 83, 960, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 2515, 25323, 2431,
+	20262, -1000, 844, -1000, 69353, 2388, -1000, 1471, 1234, 1471,
+	1471, 2855, 2367, -1000, -1000, 1342, 2393, -1000, -1000, -1000,
+	-1000, -1000, 187, 981, 981, 981, 1429, 1471, 2678, 1471,
+	1471, 1797, 1797, 31107, 2073, 2072, 2069, 1471, 1471, 1797,
+	2068, 36891, 2067, 991, 981, 981, 981, 1797, 2066, 380,
+	-1000, 1939, 2061, 2026, 2002, 981, -1000, -239, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2431,
+	2291, -1000, 2443, 2443, 2604, -1000, 1262, -1000, -1000, -1000,
+	-1000, -1000, 2442, -1000, -1000, 18093, 2744, -1000, 2441, 1404,
+	-1000, -1000, -1000, 2133, 2058, -1000, -1000, 18093, -1000, -1000,
+	2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431, 2431,
+	2431, 2431, -27, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2055, -1000, 1908, -1000, 1900, -1000, -1000,
+	-1000, 2439, -1000, -1000, -1000, 57368, 229, 62303, -1000, 9498,
+	9498, 9498, -8, 422, 350, -1000, 1586, -1000, -1000, -1000,
+	2054, 2045, 2043, 2342, 455, 1585, 415, -1000, 2733, 2041,
+	2040, 2039, 2037, 1899, -446, -446, -1000, -1000, 2464, 69353,
+	2392, -1000, 1898, 1594, 26, 57368, 1649, -1000, -1000, -1000,
+	65123, 1649, -1000, -1000, 418, 2372, 2372, 65123, 2372, 2289,
+	2287, -1000, 2544, -1000, -1000, 1417, 2373, -1000, 1359, -1000,
+	-1000, -1000, -1000, -1000, 47495, 963, 9498, 9498, 2457, 1262,
+	-1000, 2603, 979, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2154, -1000, 1214, 1214, -1000, 1041, 963, 47495, 1898, 1304,
+	1471, 1429, -1000, -1000, -1000, -1000, -1000, -1000, 36891, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1663,
+	1687, -1000, 2514, 70763, -1000, 658, 2513, 530, 2133, 2283,
+	-1000, 69353, -1000, -1000, -1000, -1000, -1000, 4956, 4956, 2925,
+	4956, 4956, 2463, 4956, 4956, 2415, -1000, 31830, 2431, -1000,
+	-19, -1000, -1000, -1000, -443, 2859, 2846, -1000, -1000, 4956,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 408, -1000,
+	-1000, -1000, -1000, -1000, -1000, 616, -1000, -1000, -1000, -1000,
+	-1000, -1000, 18093, 18093, 18093, 18093, -1000, 2321, 2321, 2321,
+	18093, 2201, 2036, 1898, 18093, 18093, -1000, 1384, -1000, -1000,
+	-1000, -1000, -1000, -170, -1000, -1000, -1000, 2716, 2263, 2365,
+	-1000, -1000, 11586, 2201, 2589, 2281, -1000, 59483, 42560, 844,
+	2279, 2855, 25323, 17370, 17370, 17370, 17370, 2822, -1000, 1889,
+	-252, -1000, 445, 387, 305, -1000, -1000, -1000, -1000, -1000,
+	1429, 1471, 31107, 1429, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2033, 2031, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -232, 36891, 239, 45380, 2721, 2721, 70058, 70058,
+	69353, 2321, 2431, 69353, -1000, -1000, -1000, -1000, 2321, -1000,
+	-1000, 2235, 2230, 45380, 1240, -1000, -353, 2814, -1000, 229,
+	-1000, 2030, 784, 781, -1000, 775, 774, 772, 764, 768,
+	-1000, -1000, -1000, -1000, 2513, 749, 422, -1000, -14, 1888,
+	1461, 2671, 350, -1000, 1870, 1868, 1851, 1834, 715, -1000,
+	-1000, -1000, -1000, 1718, 441, -1000, 664, 2024, -1000, -1000,
+	385, -1000, -1000, -1000, -438, -1000, 2655, 2256, -1000, -19,
+	-1000, 2739, 1649, 2739, 376, 2372, -1000, -1000, -1000, -1000,
+	1826, 1824, 1823, 1240, 2225, -1000, -1000, 2148, -1000, -1000,
+	2438, 70058, 70058, -1000, 46790, -1000, -1000, 46085, 69353, -1000,
+	963, 2712, 2706, 1429, -1000, 2254, -1000, 2431, 547, 2022,
+	322, -1000, -1000, 1127, 669, -1000, -1000, -1000, -201, -1000,
+	-1000, 18093, 18093, 18093, -1000, 34722, 2648, 2646, -1000, -173,
+	17370, 17370, -1000, -1000, 2512, 2321, 2321, 2321, 2321, -1000,
+	-1000, -1000, 1753, -1000, -1000, 2321, 2321, 2855, 2844, -1000,
+	2602, -1000, 2431, -1000, -1000, 2431, -1000, 1407, -1000, 2822,
+	-1000, 1898, 1898, 1898, 1898, -1000, -1000, -259, 1812, 36891,
+	2431, 36891, 2431, 2431, 940, -1000, 1429, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2254, 239, -1000, 36891, 1471, 2021, 1471,
+	1471, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2252, -464,
+	18093, 2250, -1000, -1000, -1000, 2246, -1000, 1240, 866, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2654, 2653, 811, 872,
+	-1000, 2017, 2012, -1000, 685, 1931, 1246, -176, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 657,
+	2146, -1000, 413, -1000, -1000, 2842, -353, 875, 18093, -1000,
+	2648, -1000, 65123, 2739, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 237, 237, 45380, 2457, 2436, -1000, -1000, -1000,
+	-1000, -1000, -1000, 18093, -1000, 1323, 36891, -1000, 1380, -1000,
+	-1000, -1000, 872, 1362, 734, 2321, 2321, 2321, 2200, -1000,
+	8705, 290, -1000, 2624, 2638, 2841, -1000, 69353, -1000, 1898,
+	2370, -1000, 1898, 289, -1000, -1000, -1000, -1000, 2201, 268,
+	-284, -1000, -1000, 1450, 17370, 2905, -1000, 2365, 2431, -1000,
+	1240, -1000, 2431, 2839, -1000, 2201, 69353, 2201, 69353, 2049,
+	69353, 1493, -1000, -1000, -1000, -1000, 1795, -1000, 2011, 2010,
+	970, -1000, -1000, -1000, 2321, -1000, 239, -1000, 1622, -1000,
+	839, 75073, -1000, -1000, -1000, -1000, 1687, -1000, -1000, -1000,
+	-1000, 1792, -1000, -1000, 1886, 656, 411, -1000, 2738, -1000,
+	1767, -1000, 4956, 1030, 1623, -1000, -1000, 1622, 2243, 2435,
+	45380, 4956, 1471, -1000, 2239, -1000, 69353, 75073, 1687, 1205,
+	676, 530, -1000, -1000, -1000, 2201, 34722, 343, -410, -69,
+	2009, 2646, 2836, 2642, 2640, -1000, 1997, 2369, -1000, 2735,
+	17370, -1000, -1000, 2580, -282, -294, 2201, 336, 336, 2368,
+	25323, 2365, 2201, 1380, -359, -1000, 2229, -1000, 2229, 2201,
+	2201, 2229, 1726, -1000, -1000, -1000, 57368, -464, -1000, 239,
+	-1000, 482, -1000, 1622, 860, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 813, 325,
+	10863, 17370, 182, 36168, 2144, 2142, 75073, 2151, 75073, 17370,
+	2141, 2132, 2634, 18093, 74906, 365, 541, -305, -1000, -307,
+	-1000, -1000, -1000, 1992, -1000, 2434, 1711, 431, -1000, 239,
+	45380, 2241, 1429, -1000, 1380, 2591, -1000, 527, 2133, -1000,
+	530, -1000, 2734, -1000, -1000, 1984, -209, -1000, 2431, -88,
+	-1000, 1982, -1000, 2835, 2833, -1000, 69353, 2431, -1000, -1000,
+	2579, -1000, -1000, -1000, 331, -1000, 1387, 829, 1374, -1000,
+	2133, -1000, 2347, -1000, 2239, -266, -1000, -1000, -1000, -1000,
+	-1000, 2431, 2433, -1000, 374, 995, 988, -1000, -1000, 2131,
+	2130, -1000, 1759, 1640, -1000, 17370, 1861, 646, 615, 7302,
+	-1000, -1000, -1000, -1000, -1000, -1000, -470, -1000, 406, -472,
+	931, -1000, -1000, 2126, -1000, 2878, 4956, -1000, -474, -1000,
+	-1000, -1000, -1000, -1000, 1496, 61598, -1000, 2234, 239, -1000,
+	-1000, 943, -1000, -1000, -201, -1000, 1362, 60893, 611, 1979,
+	34722, 2431, -1000, 1978, 1904, -1000, -1000, 1619, 1580, -1000,
+	-1000, -1000, -1000, -1000, -156, -1000, -1000, 1706, 69353, 69353,
+	-1000, 373, 371, -1000, -1000, -1000, 1584, 1559, 75073, -1000,
+	1748, 75073, 2812, 2811, 2810, 26, 35445, 74731, 74564, 75073,
+	75073, 17370, 74389, 75073, 1661, -1000, 74222, 75073, -1000, -1000,
+	-1000, -1000, 2920, 2342, 239, -1000, 941, 75073, -1000, 1362,
+	-1000, 1844, 1771, 2511, 2509, 2133, -1000, 2200, 34722, -1000,
+	-1000, -291, 331, -1000, -1000, 2229, 2220, -1000, -1000, 602,
+	1557, 75073, -1000, -475, 75073, -477, 836, 1240, 1040, 1292,
+	-1000, 593, -1000, 2125, -478, -479, -480, 777, 17370, -481,
+	2124, -1000, -1000, 65123, -1000, 2431, -1000, -1000, 2506, 2133,
+	-1000, 2505, 2133, -60, -61, 2502, -1000, 2200, -297, -1000,
+	-1000, 2501, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 74047,
+	59483, -1000, -482, 73880, -483, 1763, -1000, -1000, -1000, 73705,
+	-1000, -1000, -1000, -1000, 610, -1000, -1000, 2685, -1000, -1000,
+	73538, 73363, 73196, 75073, 1700, 72933, 2346, -1000, -1000, 2342,
+	18816, -1000, 285, 2500, -340, 2499, 284, 283, -1000, -1000,
+	-65, -1000, -1000, 2496, 2494, 2873, -1000, -484, -1000, -1000,
+	72879, 1549, 75073, 1026, 75073, 1523, -1000, 17370, -1000, 1040,
+	-1000, 18093, 2123, 592, 17370, -485, 583, 562, 2122, 2321,
+	2201, 282, -342, 2493, 2492, -1000, -1000, -1000, 2904, -1000,
+	2902, 1171, 1171, 9427, 1542, -1000, -1000, -486, 1023, 75073,
+	-1000, 1667, -1000, 4956, -1000, 2117, 1654, 9248, -1000, -1000,
+	-1000, -1000, -1000, 277, -347, -1000, -1000, -1000, 986, 1731,
+	-1000, -1000, -1000, 8700, -1000, -487, 75073, -1000, 581, 557,
+	-1000, -1000, -1000, -1000, -1000, 1022, 7883, -488, 2046, 2028,
+	-1000, 1015, 75073, -1000, -1000, -1000,
+}
+
+var yyPgo = [...]int16{
+	0, 3463, 3462, 3461, 12, 3460, 225, 317, 307, 32,
+	3458, 299, 3457, 300, 3456, 21, 19, 17, 27, 3455,
+	3073, 3071, 3051, 3046, 25, 3454, 7, 3452, 3446, 3044,
+	3444, 3443, 3025, 3442, 3441, 3440, 3429, 3428, 3427, 3424,
+	3423, 3422, 3022, 3018, 3016, 3416, 3412, 3411, 3014, 3012,
+	3007, 3410, 39, 45, 35, 3409, 3, 3408, 208, 233,
+	3406, 4, 3405, 3388, 16, 3385, 3384, 3003, 3380, 3379,
+	3377, 3374, 3372, 3371, 3370, 149, 3368, 2996, 3367, 2994,
+	3366, 2976, 2972, 2963, 2945, 2942, 234, 3362, 2940, 2935,
+	2931, 3360, 2623, 237, 419, 235, 3359, 2039, 3358, 222,
+	3357, 219, 3356, 3355, 3352, 384, 3351, 162, 3350, 3670,
+	163, 164, 169, 1317, 3348, 61, 159, 324, 3347, 8,
+	3346, 122, 3345, 226, 3344, 228, 3343, 200, 277, 199,
+	3341, 3340, 151, 3338, 3337, 3330, 223, 3328, 3327, 238,
+	70, 3326, 3325, 192, 3318, 150, 3316, 184, 3313, 3312,
+	3310, 3302, 236, 118, 82, 170, 156, 3301, 155, 3299,
+	3298, 3295, 146, 3294, 3280, 62, 445, 3279, 158, 171,
+	3278, 52, 38, 41, 3277, 44, 3276, 3275, 147, 3274,
+	3271, 132, 3269, 194, 74, 3268, 3267, 72, 3266, 71,
+	78, 3265, 79, 3264, 193, 3263, 3262, 3261, 3260, 3259,
+	81, 3257, 90, 3256, 195, 135, 444, 3255, 3254, 48,
+	42, 3236, 67, 29, 15, 69, 37, 120, 83, 220,
+	197, 165, 3235, 187, 172, 139, 201, 40, 153, 54,
+	152, 134, 3215, 239, 182, 218, 115, 59, 3214, 210,
+	3210, 112, 215, 191, 188, 213, 3209, 3207, 3206, 260,
+	3205, 180, 548, 3203, 145, 367, 240, 3199, 242, 143,
+	3196, 3194, 3192, 3191, 127, 46, 189, 3187, 47, 241,
+	49, 86, 3186, 1582, 5604, 6263, 6884, 142, 354, 5772,
+	30, 217, 3185, 76, 3184, 3182, 3179, 5016, 166, 33,
+	65, 154, 3178, 157, 43, 95, 3177, 3173, 3171, 3170,
+	3168, 3166, 202, 196, 3165, 3163, 68, 3160, 3159, 36,
+	34, 14, 6, 108, 140, 230, 229, 227, 3157, 211,
+	55, 3156, 3155, 181, 148, 3154, 3153, 3152, 3151, 183,
+	124, 212, 3148, 177, 176, 173, 3147, 121, 3144, 3143,
+	107, 2693, 144, 3142, 3141, 3140, 160, 209, 3139, 3137,
+	3136, 58, 3135, 3134, 3132, 3131, 3129, 106, 60, 56,
+	114, 53, 3128, 204, 3126, 3125, 57, 3123, 3122, 186,
+	3121, 125, 203, 3119, 96, 100, 3115, 3114, 167, 175,
+	3113, 123, 119, 63, 51, 3112, 2, 9, 5, 3111,
+	3109, 3106, 3105, 3102, 3101, 3100, 3097, 129, 3095, 3094,
+	105, 93, 75, 3093, 64, 3092, 3091, 3090, 206, 179,
+	117, 231, 224, 11, 3087, 3086, 10, 3084, 174, 3082,
+	97, 3081, 128, 3080, 3079, 73, 102, 50, 99, 3078,
+	3077, 101, 3075, 3053, 104, 3042, 3027, 232, 2418, 3010,
+	732, 3005, 205, 168, 77, 91, 3001, 109, 111, 2998,
+	103, 2986, 23, 66, 2983, 24, 31, 2978, 2965, 136,
+	116, 141, 2954, 0, 2339, 216, 2950, 2947, 138, 2937,
+	2933, 161, 2929, 80,
+}
+
+var yyR1 = [...]int16{
+	0, 462, 462, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 91, 4, 4, 9, 14, 6, 6, 10, 10,
+	10, 11, 11, 7, 7, 12, 12, 13, 13, 5,
+	5, 119, 119, 8, 8, 8, 118, 118, 118, 235,
+	235, 235, 235, 232, 232, 120, 120, 117, 117, 125,
+	15, 15, 103, 103, 16, 17, 17, 17, 17, 465,
+	465, 134, 134, 132, 132, 133, 133, 230, 230, 18,
+	18, 18, 18, 18, 239, 239, 244, 244, 244, 247,
+	247, 247, 247, 214, 215, 216, 20, 20, 20, 20,
+	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
+	20, 20, 20, 449, 449, 449, 450, 450, 450, 450,
+	450, 414, 414, 429, 429, 429, 430, 430, 428, 428,
+	428, 428, 428, 432, 432, 433, 433, 431, 431, 431,
+	431, 435, 435, 436, 436, 434, 434, 434, 434, 434,
+	434, 434, 434, 434, 434, 434, 434, 434, 434, 417,
+	417, 417, 48, 48, 48, 48, 48, 49, 49, 49,
+	49, 49, 49, 437, 437, 437, 437, 437, 437, 437,
+	437, 437, 437, 437, 437, 437, 437, 437, 437, 437,
+	437, 437, 437, 437, 437, 437, 437, 437, 437, 437,
+	437, 437, 437, 437, 437, 437, 438, 438, 439, 439,
+	439, 439, 439, 439, 439, 439, 439, 439, 439, 439,
+	439, 439, 439, 439, 439, 439, 439, 439, 439, 439,
+	439, 439, 439, 439, 439, 439, 439, 439, 439, 439,
+	439, 439, 439, 439, 439, 439, 439, 440, 440, 441,
+	441, 442, 442, 442, 442, 443, 443, 443, 443, 443,
+	444, 444, 444, 444, 444, 444, 444, 445, 445, 446,
+	446, 3, 2, 395, 395, 396, 396, 397, 397, 397,
+	397, 398, 398, 399, 399, 400, 400, 400, 400, 400,
+	400, 400, 400, 400, 400, 30, 30, 30, 30, 30,
+	30, 366, 366, 366, 270, 270, 267, 267, 267, 268,
+	268, 269, 269, 269, 416, 416, 416, 410, 410, 410,
+	412, 412, 411, 411, 411, 413, 413, 418, 418, 418,
+	418, 418, 420, 420, 420, 420, 420, 420, 421, 421,
+	421, 419, 419, 153, 153, 422, 422, 427, 427, 423,
+	423, 424, 424, 426, 426, 426, 425, 425, 425, 425,
+	306, 306, 171, 171, 65, 65, 66, 66, 66, 203,
+	203, 203, 19, 19, 19, 19, 19, 19, 25, 25,
+	27, 27, 27, 27, 53, 53, 54, 28, 28, 28,
+	28, 55, 55, 56, 31, 31, 31, 31, 31, 31,
+	63, 63, 63, 62, 62, 61, 61, 61, 61, 61,
+	61, 33, 34, 35, 466, 466, 466, 57, 57, 36,
+	36, 36, 39, 39, 39, 40, 40, 40, 37, 38,
+	41, 29, 29, 29, 29, 64, 64, 59, 59, 58,
+	58, 60, 60, 60, 60, 60, 60, 60, 60, 60,
+	60, 60, 60, 32, 32, 32, 32, 32, 32, 24,
+	394, 394, 394, 52, 52, 26, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 73, 73, 343,
+	344, 344, 344, 344, 344, 344, 330, 330, 331, 331,
+	331, 331, 331, 331, 320, 320, 320, 295, 295, 295,
+	295, 295, 295, 295, 295, 295, 295, 295, 295, 295,
+	295, 295, 295, 295, 294, 294, 294, 294, 298, 298,
+	296, 296, 296, 296, 296, 296, 296, 296, 296, 296,
+	296, 296, 296, 296, 296, 297, 297, 297, 297, 297,
+	297, 297, 297, 299, 299, 299, 299, 299, 300, 300,
+	300, 300, 300, 300, 300, 300, 300, 300, 300, 300,
+	300, 300, 300, 300, 300, 300, 300, 300, 300, 300,
+	300, 300, 300, 300, 300, 300, 300, 301, 301, 301,
+	301, 301, 301, 301, 301, 329, 329, 303, 303, 302,
+	302, 302, 323, 323, 324, 324, 324, 321, 321, 321,
+	322, 322, 307, 307, 307, 307, 307, 307, 308, 325,
+	309, 309, 310, 310, 310, 310, 311, 311, 312, 312,
+	312, 314, 314, 319, 319, 318, 318, 318, 318, 318,
+	318, 315, 315, 315, 315, 315, 315, 316, 316, 316,
+	317, 328, 328, 328, 328, 328, 304, 50, 362, 362,
+	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
+	380, 380, 261, 261, 262, 262, 51, 51, 51, 51,
+	51, 51, 51, 51, 51, 51, 467, 467, 369, 369,
+	367, 367, 368, 368, 159, 159, 363, 363, 378, 378,
+	378, 365, 365, 365, 365, 365, 365, 365, 364, 364,
+	379, 379, 370, 370, 370, 370, 370, 370, 371, 372,
+	372, 373, 373, 374, 374, 375, 375, 375, 376, 376,
+	376, 377, 377, 332, 332, 361, 361, 360, 360, 359,
+	359, 359, 359, 359, 359, 359, 341, 341, 342, 342,
+	356, 356, 356, 356, 356, 356, 356, 356, 336, 336,
+	336, 335, 335, 337, 337, 340, 340, 340, 358, 358,
+	357, 357, 333, 333, 333, 381, 381, 381, 381, 381,
+	96, 96, 334, 334, 334, 334, 334, 382, 338, 338,
+	339, 339, 339, 387, 388, 386, 386, 386, 386, 386,
+	160, 160, 160, 345, 345, 345, 346, 346, 346, 346,
+	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
+	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
+	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
+	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
+	313, 313, 347, 347, 347, 347, 347, 461, 461, 461,
+	348, 348, 348, 348, 348, 348, 448, 448, 447, 447,
+	447, 349, 349, 349, 350, 350, 350, 350, 350, 351,
+	351, 351, 351, 352, 352, 353, 353, 354, 354, 355,
+	355, 391, 391, 391, 390, 390, 67, 67, 67, 67,
+	67, 72, 72, 69, 69, 68, 76, 76, 75, 75,
+	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
+	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
+	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
+	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
+	75, 75, 75, 75, 75, 75, 75, 75, 75, 71,
+	202, 202, 202, 266, 266, 385, 383, 383, 384, 384,
+	70, 70, 70, 70, 154, 154, 21, 21, 74, 74,
+	415, 415, 22, 22, 22, 22, 22, 22, 22, 22,
+	22, 22, 389, 389, 389, 23, 23, 77, 78, 78,
+	78, 278, 278, 278, 278, 81, 81, 81, 81, 233,
+	233, 234, 234, 82, 82, 82, 82, 83, 83, 83,
+	83, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 114, 253, 253, 252, 252, 251, 251,
+	251, 254, 254, 254, 254, 292, 292, 292, 80, 80,
+	80, 92, 92, 87, 87, 90, 90, 90, 93, 93,
+	94, 94, 95, 95, 88, 89, 42, 43, 43, 43,
+	43, 44, 85, 85, 85, 86, 86, 86, 86, 105,
+	105, 469, 469, 469, 84, 470, 97, 98, 98, 100,
+	100, 100, 101, 101, 101, 102, 102, 102, 258, 258,
+	258, 258, 258, 258, 258, 258, 258, 99, 99, 99,
+	108, 108, 112, 112, 112, 112, 111, 111, 111, 111,
+	205, 205, 210, 284, 284, 186, 186, 206, 206, 211,
+	211, 211, 212, 212, 213, 213, 213, 213, 213, 208,
+	208, 207, 207, 209, 172, 172, 172, 172, 172, 172,
+	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
+	173, 173, 173, 173, 173, 170, 170, 282, 282, 282,
+	282, 281, 281, 281, 116, 116, 121, 121, 123, 123,
+	123, 123, 123, 123, 123, 123, 126, 188, 188, 144,
+	144, 145, 145, 145, 145, 146, 146, 146, 146, 146,
+	148, 148, 148, 149, 149, 149, 149, 149, 149, 150,
+	151, 151, 147, 147, 147, 168, 168, 228, 228, 229,
+	229, 227, 227, 231, 231, 122, 122, 124, 124, 124,
+	124, 129, 129, 130, 130, 131, 131, 265, 265, 289,
+	289, 135, 135, 135, 137, 136, 136, 136, 136, 136,
+	136, 138, 138, 451, 452, 452, 453, 453, 453, 453,
+	454, 454, 454, 454, 454, 454, 454, 454, 454, 454,
+	455, 455, 456, 456, 139, 139, 141, 142, 142, 140,
+	140, 140, 140, 140, 140, 152, 152, 143, 155, 155,
+	155, 155, 156, 156, 113, 113, 113, 113, 113, 113,
+	113, 113, 250, 250, 158, 158, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 182, 182, 182, 182,
+	182, 182, 163, 163, 163, 163, 163, 163, 163, 107,
+	107, 183, 183, 183, 128, 127, 110, 110, 109, 109,
+	184, 184, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
+	176, 176, 180, 180, 180, 180, 180, 180, 180, 180,
+	180, 180, 180, 180, 180, 180, 180, 180, 180, 161,
+	161, 161, 162, 162, 162, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 174, 174, 174, 174,
+	174, 174, 174, 174, 174, 174, 174, 174, 174, 174,
+	174, 174, 174, 174, 174, 174, 174, 174, 174, 174,
+	174, 174, 174, 174, 174, 174, 174, 175, 175, 175,
+	175, 175, 175, 175, 175, 175, 175, 181, 181, 181,
+	181, 181, 468, 468, 178, 178, 177, 177, 177, 177,
+	177, 177, 104, 104, 104, 104, 104, 290, 290, 290,
+	291, 291, 291, 291, 291, 291, 291, 291, 291, 291,
+	291, 291, 291, 291, 291, 291, 291, 291, 291, 291,
+	291, 291, 291, 291, 291, 291, 291, 291, 291, 291,
+	291, 291, 291, 291, 291, 291, 291, 291, 291, 291,
+	291, 291, 293, 293, 293, 293, 293, 293, 293, 293,
+	293, 293, 293, 293, 293, 293, 293, 293, 457, 457,
+	458, 458, 459, 459, 459, 460, 460, 460, 195, 195,
+	106, 106, 193, 193, 194, 196, 196, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 165, 165, 165, 165, 165, 165, 165, 165,
+	167, 167, 167, 197, 197, 185, 185, 115, 198, 198,
+	199, 200, 200, 201, 201, 204, 217, 217, 217, 218,
+	218, 218, 218, 219, 219, 219, 219, 219, 219, 219,
+	220, 220, 220, 220, 221, 221, 221, 222, 222, 222,
+	472, 472, 471, 471, 169, 169, 169, 223, 223, 223,
+	223, 164, 164, 164, 164, 164, 164, 164, 473, 473,
+	226, 226, 225, 225, 224, 224, 224, 224, 224, 224,
+	236, 236, 187, 187, 190, 190, 189, 191, 237, 237,
+	241, 241, 241, 241, 238, 238, 242, 242, 242, 242,
+	245, 245, 246, 246, 246, 243, 243, 243, 243, 240,
+	240, 240, 286, 286, 286, 248, 248, 257, 257, 255,
+	255, 256, 256, 249, 249, 305, 305, 305, 264, 264,
+	263, 263, 263, 259, 259, 259, 260, 260, 283, 283,
+	279, 279, 285, 285, 280, 280, 287, 287, 288, 288,
+	288, 288, 392, 392, 393, 393, 393, 326, 326, 403,
+	403, 327, 327, 404, 404, 406, 406, 401, 401, 402,
+	402, 405, 405, 45, 407, 407, 408, 408, 409, 409,
+	409, 409, 46, 47, 47, 47, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
+	272, 272, 272, 272, 272, 272, 272, 272, 272, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
+	276, 276, 276, 277, 277, 277, 277, 277, 277, 274,
+	274, 274, 274, 274, 274, 275, 275, 275, 463, 464,
+}
+
+var yyR2 = [...]int8{
+	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 12, 5, 7, 1, 5, 1, 1, 1, 3,
+	3, 3, 3, 1, 3, 1, 1, 1, 1, 1,
+	3, 2, 1, 10, 2, 1, 0, 2, 2, 0,
+	2, 3, 6, 1, 3, 0, 2, 1, 3, 4,
+	8, 9, 1, 1, 10, 9, 8, 7, 7, 1,
+	1, 1, 3, 1, 3, 1, 3, 0, 4, 3,
+	5, 7, 4, 5, 1, 3, 3, 2, 2, 2,
+	2, 2, 1, 0, 0, 0, 2, 3, 2, 3,
+	11, 10, 11, 11, 12, 5, 5, 16, 12, 9,
+	4, 15, 1, 6, 9, 8, 0, 3, 3, 6,
+	3, 0, 3, 0, 2, 2, 1, 3, 1, 1,
+	2, 2, 2, 0, 2, 1, 2, 2, 2, 2,
+	2, 0, 1, 1, 2, 3, 3, 5, 3, 3,
+	4, 5, 4, 4, 2, 2, 2, 2, 2, 0,
+	2, 2, 9, 10, 9, 5, 7, 7, 7, 7,
+	8, 4, 6, 2, 3, 2, 3, 3, 3, 4,
+	3, 3, 2, 2, 3, 2, 2, 2, 3, 2,
+	2, 3, 2, 2, 2, 3, 3, 2, 3, 3,
+	2, 2, 2, 2, 2, 1, 1, 3, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 3, 1,
+	3, 0, 1, 1, 1, 1, 3, 1, 3, 3,
+	0, 2, 5, 5, 5, 7, 5, 0, 3, 0,
+	3, 4, 4, 0, 1, 1, 3, 2, 3, 3,
+	3, 0, 1, 1, 2, 2, 2, 2, 1, 2,
+	2, 3, 3, 3, 3, 2, 4, 5, 4, 6,
+	7, 3, 4, 4, 2, 3, 3, 3, 3, 0,
+	3, 0, 3, 3, 1, 1, 1, 3, 2, 1,
+	1, 3, 3, 2, 1, 1, 3, 1, 2, 5,
+	4, 6, 4, 3, 3, 6, 5, 5, 4, 3,
+	5, 1, 3, 1, 3, 3, 5, 0, 5, 0,
+	3, 0, 3, 0, 3, 4, 0, 1, 1, 3,
+	0, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 2, 1, 1, 1, 1, 1, 1, 4, 2,
+	5, 8, 4, 7, 1, 2, 5, 7, 10, 8,
+	11, 1, 2, 5, 5, 5, 5, 6, 5, 7,
+	1, 1, 1, 1, 3, 1, 1, 1, 2, 1,
+	1, 2, 2, 5, 0, 1, 2, 1, 3, 5,
+	7, 8, 7, 9, 10, 7, 9, 10, 2, 2,
+	2, 2, 4, 2, 4, 2, 3, 1, 3, 3,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 4, 3, 2, 4, 4,
+	0, 2, 3, 1, 3, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 5, 5, 5,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 0, 1, 1, 0, 2, 2,
+	3, 2, 2, 2, 2, 2, 2, 2, 2, 6,
+	8, 3, 6, 2, 3, 1, 1, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 3, 2, 2,
+	2, 2, 2, 1, 2, 2, 2, 1, 2, 2,
+	3, 3, 2, 3, 3, 2, 3, 3, 2, 3,
+	4, 4, 2, 2, 1, 1, 1, 1, 1, 2,
+	1, 1, 1, 1, 1, 4, 4, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 0, 3, 0,
+	3, 3, 0, 5, 0, 3, 5, 0, 1, 1,
+	0, 1, 2, 3, 3, 2, 2, 4, 3, 1,
+	0, 1, 3, 3, 2, 2, 0, 1, 2, 2,
+	2, 0, 1, 0, 1, 1, 1, 1, 2, 2,
+	2, 5, 5, 5, 4, 4, 4, 4, 4, 4,
+	4, 2, 1, 2, 1, 2, 2, 3, 2, 2,
+	2, 2, 1, 1, 1, 1, 3, 2, 1, 1,
+	0, 3, 1, 1, 0, 1, 5, 4, 2, 4,
+	2, 3, 6, 3, 2, 4, 1, 1, 0, 2,
+	0, 2, 0, 2, 0, 1, 1, 3, 3, 3,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	5, 5, 8, 8, 5, 8, 4, 5, 3, 0,
+	3, 1, 3, 1, 3, 0, 3, 4, 0, 1,
+	1, 1, 3, 5, 4, 0, 1, 1, 2, 2,
+	3, 2, 3, 3, 1, 1, 0, 1, 1, 1,
+	3, 5, 3, 3, 5, 3, 2, 2, 1, 1,
+	1, 1, 1, 0, 1, 0, 1, 1, 1, 3,
+	3, 3, 3, 3, 1, 11, 12, 12, 13, 13,
+	0, 1, 3, 3, 3, 2, 1, 5, 1, 1,
+	0, 2, 2, 3, 3, 1, 1, 2, 2, 2,
+	0, 1, 1, 0, 2, 3, 3, 3, 3, 3,
+	4, 4, 5, 3, 3, 3, 4, 3, 3, 3,
+	4, 4, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 2, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 2, 2, 4, 4, 5,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
+	1, 0, 5, 8, 1, 4, 5, 4, 5, 5,
+	5, 5, 8, 0, 1, 0, 2, 0, 4, 0,
+	2, 0, 1, 2, 0, 2, 1, 1, 1, 1,
+	1, 10, 11, 4, 3, 5, 1, 3, 5, 4,
+	3, 3, 5, 5, 5, 5, 3, 8, 10, 3,
+	3, 3, 3, 3, 5, 4, 5, 1, 2, 2,
+	4, 3, 6, 5, 3, 9, 2, 2, 5, 8,
+	4, 3, 3, 3, 3, 3, 3, 5, 3, 3,
+	3, 1, 3, 3, 3, 3, 3, 3, 3, 5,
+	0, 1, 2, 0, 1, 7, 1, 3, 8, 8,
+	8, 11, 12, 15, 0, 3, 3, 3, 3, 5,
+	3, 5, 5, 5, 4, 4, 4, 4, 4, 4,
+	4, 4, 0, 1, 1, 3, 2, 3, 8, 5,
+	1, 1, 1, 1, 1, 4, 4, 4, 4, 1,
+	3, 1, 1, 2, 2, 4, 4, 3, 3, 3,
+	3, 3, 3, 2, 3, 6, 4, 3, 5, 5,
+	5, 4, 4, 4, 4, 3, 2, 2, 6, 2,
+	4, 3, 4, 4, 5, 8, 6, 3, 4, 4,
+	2, 4, 3, 2, 4, 5, 6, 2, 6, 6,
+	3, 3, 4, 3, 0, 1, 1, 1, 0, 2,
+	2, 0, 2, 3, 2, 0, 1, 1, 2, 4,
+	1, 0, 1, 2, 1, 2, 4, 4, 0, 1,
+	0, 3, 0, 2, 4, 4, 2, 3, 4, 4,
+	5, 3, 3, 4, 3, 1, 1, 1, 1, 0,
+	3, 1, 1, 1, 3, 0, 2, 0, 2, 1,
+	2, 2, 1, 2, 2, 1, 2, 2, 0, 2,
+	2, 2, 2, 2, 2, 2, 2, 0, 1, 1,
+	3, 5, 1, 1, 3, 5, 1, 2, 3, 5,
+	2, 2, 6, 0, 1, 0, 3, 0, 1, 0,
+	2, 2, 4, 1, 2, 2, 2, 2, 2, 0,
+	2, 1, 3, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 0, 1, 2,
+	2, 1, 1, 1, 1, 3, 1, 1, 1, 4,
+	5, 1, 2, 3, 1, 1, 2, 2, 4, 2,
+	6, 1, 3, 2, 4, 1, 1, 1, 1, 1,
+	5, 5, 8, 5, 5, 8, 5, 5, 8, 2,
+	2, 2, 3, 4, 4, 0, 1, 3, 1, 0,
+	3, 1, 3, 1, 3, 4, 6, 4, 4, 4,
+	3, 2, 4, 0, 1, 0, 2, 0, 1, 1,
+	1, 1, 2, 2, 1, 2, 3, 2, 3, 3,
+	2, 2, 2, 12, 1, 3, 3, 3, 6, 7,
+	2, 3, 3, 4, 4, 5, 5, 8, 8, 3,
+	3, 4, 3, 4, 1, 3, 3, 2, 1, 1,
+	3, 1, 1, 1, 1, 1, 3, 3, 0, 5,
+	5, 5, 0, 2, 1, 3, 3, 3, 2, 3,
+	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
+	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 1, 1, 1, 3, 1, 0, 1, 1, 3,
+	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 2, 2, 1, 3, 2,
+	2, 2, 2, 3, 1, 1, 1, 1, 1, 1,
+	5, 6, 6, 6, 5, 5, 5, 6, 5, 5,
+	6, 5, 5, 5, 5, 6, 5, 5, 5, 0,
+	2, 2, 0, 2, 2, 4, 4, 5, 6, 5,
+	6, 7, 4, 4, 4, 4, 4, 4, 4, 4,
+	5, 4, 3, 6, 6, 4, 6, 6, 6, 4,
+	8, 8, 8, 8, 4, 6, 7, 7, 7, 9,
+	4, 7, 5, 4, 4, 4, 4, 2, 2, 2,
+	1, 2, 2, 2, 8, 8, 6, 3, 4, 2,
+	2, 2, 0, 2, 1, 3, 4, 4, 4, 4,
+	4, 4, 0, 3, 4, 7, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 3, 3, 1, 2, 2, 1, 1,
+	1, 2, 1, 2, 2, 1, 2, 1, 3, 1,
+	0, 1, 3, 3, 6, 1, 1, 2, 0, 1,
+	0, 2, 1, 2, 4, 0, 2, 1, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	1, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 0, 3, 1, 3, 1, 0, 2,
+	1, 0, 3, 1, 3, 2, 0, 1, 1, 0,
+	2, 4, 4, 1, 3, 5, 5, 3, 4, 1,
+	5, 6, 6, 7, 1, 2, 3, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 2, 4,
+	4, 2, 4, 1, 3, 5, 4, 6, 1, 1,
+	0, 3, 1, 3, 3, 1, 1, 1, 1, 1,
+	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
+	3, 3, 3, 3, 1, 3, 1, 2, 2, 3,
+	1, 1, 1, 1, 1, 3, 3, 4, 3, 1,
+	2, 1, 1, 1, 1, 1, 1, 0, 1, 0,
+	2, 0, 3, 0, 1, 0, 3, 3, 1, 1,
+	0, 1, 1, 1, 1, 1, 0, 1, 0, 2,
+	1, 1, 1, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 0, 2, 0, 1, 1, 0, 1, 0,
+	4, 0, 1, 0, 3, 0, 3, 0, 4, 0,
+	3, 0, 3, 3, 1, 3, 2, 4, 1, 2,
+	1, 2, 2, 2, 3, 3, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+}
+
+var yyChk = [...]int16{
+	-1000, -462, -1, -4, -14, -15, -16, -17, -18, -20,
+	-67, -21, -22, -23, -77, -81, -82, -83, -79, -80,
+	-87, -88, -89, -85, -84, -29, -32, -24, -91, -42,
+	-43, -44, -45, -46, -47, -48, -49, -51, -50, -370,
+	-5, 11, 12, -120, 34, -73, 193, -449, -69, -68,
+	-70, -71, -72, 196, 195, 232, 197, 613, 354, 614,
+	225, 72, 424, -90, 427, 428, -469, 292, 293, 25,
+	42, 429, 431, 35, 36, 247, 320, 322, 200, 425,
+	413, 414, 345, 194, -6, 99, 227, 226, 31, -8,
+	-10, 202, -126, -11, -12, 55, -7, -463, 76, 731,
+	-200, 20, -97, -470, -97, -103, 14, 15, 13, 533,
+	-97, -343, 28, -3, 120, 76, -8, 11, -260, -270,
+	100, 524, 201, -268, 316, 318, 211, -257, 705, -259,
+	-267, 257, 267, 49, 212, 268, 202, 316, 705, 202,
+	203, 205, 524, 201, 240, 239, 316, 318, 272, 613,
+	202, -140, -287, -274, -276, -275, 334, 81, -273, 552,
+	556, 272, 559, 560, 565, 324, 90, 52, 354, 547,
+	346, 355, 222, 502, 317, 582, 323, 326, 347, 702,
+	356, 358, 359, 545, 546, 54, 205, 535, 536, 539,
+	215, 718, 339, 223, 616, 268, 726, 721, 723, 328,
+	492, 584, 424, 441, 433, 460, 617, 672, 287, 432,
+	371, 509, 585, 331, 280, 352, 673, 307, 498, 504,
+	290, 427, 607, 605, 587, 518, 248, 278, 674, 284,
+	286, 285, 262, 551, 291, 41, 228, 457, 625, 614,
+	257, 675, 589, 668, 588, 251, 602, 314, 40, 32,
+	604, 250, 521, 111, 277, 676, 362, 506, 590, 478,
+	363, 246, 273, 275, 678, 620, 335, 234, 505, 415,
+	453, 345, 130, 255, 302, 364, 679, 479, 483, 680,
+	510, 321, 301, 609, 681, 682, 365, 624, 683, 204,
+	327, 591, 684, 258, 512, 332, 477, 213, 618, 224,
+	56, 237, 513, 481, 608, 487, 490, 361, 685, 686,
+	687, 688, 343, 341, 592, 342, 344, 269, 282, 621,
+	593, 623, 61, 199, 627, 485, 484, 486, 283, 641,
+	508, 465, 463, 689, 690, 336, 219, 691, 692, 464,
+	23, 693, 694, 516, 306, 338, 695, 696, 697, 594,
+	610, 583, 698, 699, 700, 507, 480, 482, 256, 129,
+	613, 279, 701, 340, 503, 349, 628, 619, 325, 606,
+	703, 367, 236, 230, 517, 411, 422, 423, 357, 704,
+	414, 705, 706, 707, 708, 709, 319, 318, 428, 350,
+	595, 429, 274, 288, 622, 710, 596, 597, 711, 712,
+	266, 249, 520, 511, 60, 494, 489, 353, 368, 412,
+	420, 416, 418, 419, 421, 417, 497, 425, 276, 243,
+	598, 599, 600, 413, 601, 12, 281, 333, 611, 612,
+	586, 501, 351, 289, 267, 270, 468, 238, 713, 714,
+	455, 456, 426, 241, 232, 124, 519, 312, 667, 316,
+	369, 59, 244, 53, 715, 716, 271, 245, 626, 430,
+	330, 629, 202, 81, -278, -273, -276, -277, -274, 334,
+	233, 58, 96, 63, 62, 81, -273, 613, 146, 81,
+	353, 461, 509, 193, 499, 500, 506, -336, 507, 239,
+	411, 242, -292, 202, -253, 241, 498, 321, 340, 556,
+	245, 246, 273, 203, 204, 37, 511, 510, 502, -287,
+	-92, 430, -92, -92, 206, 430, -105, 234, 197, -140,
+	233, -64, 81, 294, -64, 34, 81, -152, -279, -287,
+	81, -273, 41, 81, 429, 501, 501, 84, 619, 248,
+	26, -438, -413, 349, -437, -411, 194, 193, 15, 195,
+	272, 354, 355, 320, 203, 13, 35, 702, 220, 356,
+	357, 11, 225, 358, 359, 240, 14, 360, -439, -416,
+	372, 373, 374, 375, 376, 377, 378, 379, 380, 381,
+	382, 383, 384, 385, 386, 387, 388, 389, 390, 391,
+	392, 393, 394, 395, 396, 397, 398, 399, 400, 401,
+	402, 403, 404, 405, 406, 407, 408, 409, 410, 83,
+	81, -273, 26, -438, -413, 349, 357, 411, -467, 426,
+	353, 411, 411, 146, 81, 699, -262, -261, 370, 487,
+	705, -270, 524, -249, 316, -268, 207, -119, 671, -117,
+	-125, -289, -287, -274, -121, -123, -124, -144, -127, 669,
+	-463, -122, -451, -140, -128, 81, 581, -101, 10, -100,
+	-102, 9, 8, -188, 253, -101, -9, -4, 99, -219,
+	-218, 22, 6, -220, 305, 21, -258, -98, -111, 137,
+	-113, -287, -157, 103, -166, 33, 29, -165, 334, 233,
+	-158, -192, -274, -191, -128, 146, -291, 92, 135, 136,
+	143, 104, 189, -176, -174, -175, -177, -179, -180, 83,
+	82, 91, 84, 85, 86, 87, 93, 94, 95, -279,
+	-276, -272, -275, 552, 556, 559, 560, 565, -189, -463,
+	147, 148, 149, 150, 151, 152, 153, 154, 155, 156,
+	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
+	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 181, 182, 183, 184, 185, 186,
+	187, 188, 66, 67, 582, 550, 201, 534, 535, 458,
+	536, 13, 537, 538, 539, 615, 222, 543, 106, 55,
+	311, 525, 526, 530, -181, 527, 531, 532, 545, 546,
+	547, 208, 524, 141, 533, 569, 570, 571, 572, 573,
+	574, 575, 576, 578, 579, 577, 553, 554, 555, 557,
+	558, 561, 562, 563, 564, 566, 567, 568, 643, 198,
+	194, 197, 102, 28, 30, 644, 254, 105, 439, 473,
+	542, 21, 25, 216, 200, 461, 214, 145, 210, 217,
+	296, 298, 193, 70, 645, 522, 297, 499, 639, 636,
+	638, 637, 452, 450, 295, 646, 15, 31, 226, 261,
+	647, 448, 195, 252, 109, 110, 648, 46, 45, 8,
+	299, 227, 305, 449, 649, 650, 24, 73, 218, 16,
+	212, 242, 725, 651, 320, 18, 128, 19, 652, 633,
+	635, 634, 207, 122, 203, 717, 68, 259, 653, 437,
+	442, 443, 444, 445, 446, 438, 97, 654, 655, 119,
+	310, 64, 581, 37, 247, 669, 540, 309, 656, 43,
+	42, 472, 476, 471, 308, 488, 120, 22, 528, 529,
+	35, 657, 658, 235, 475, 436, 470, 670, 659, 631,
+	264, 71, 370, 451, 729, 38, 74, 231, 366, 337,
+	47, 100, 20, 260, 69, 39, 548, 125, 730, 454,
+	209, 239, 660, 514, 263, 661, 126, 447, 671, 220,
+	121, 431, 196, 348, 293, 221, 315, 322, 662, 253,
+	127, 500, 630, 663, 544, 34, 225, 292, 435, 211,
+	664, 265, 304, 294, 303, 329, 48, 727, 642, 202,
+	44, 108, 474, 434, 469, 206, 541, 240, 300, 9,
+	49, 36, 495, 14, 360, 72, 75, 462, 459, 467,
+	466, 728, 17, 313, 549, 99, 515, 101, 640, 496,
+	523, -97, -97, -97, -238, 705, 426, -245, -242, 510,
+	511, -243, -246, -240, -192, 487, 699, 700, 509, 461,
+	508, -279, -287, -274, 334, 233, 146, 553, 554, 555,
+	106, 458, 534, 569, 525, 527, 522, 526, 524, 33,
+	570, 29, 95, 571, 550, 208, 13, 189, 557, 558,
+	572, 573, 574, 66, 528, 529, 615, 141, 103, 575,
+	576, 93, 578, 579, 311, 533, 67, 577, 201, 563,
+	562, 561, 564, 537, 538, 94, 530, 531, 532, 55,
+	567, 566, 568, -2, -6, -140, -344, -331, -334, -279,
+	-274, -276, -277, 229, -275, 217, -382, 81, -273, 214,
+	-200, -97, 203, 205, 533, -256, 208, -256, 240, 239,
+	272, -269, 265, -256, -256, 703, 202, 18, -268, 112,
+	112, -74, -140, -415, -410, -416, 18, -255, 208, -279,
+	-255, -255, -255, -255, -255, -255, -255, -255, 81, -278,
+	-140, 190, -78, -140, -132, 16, 16, 75, 75, 81,
+	-278, 361, 361, 273, 365, 34, -254, 120, 17, 524,
+	201, 202, 239, 240, 205, 272, -254, -338, 16, 122,
+	243, 243, 243, 243, 244, 243, -252, 501, 503, 504,
+	505, -251, 16, 122, 17, -114, 120, 24, -463, -218,
+	22, -218, -251, 138, -94, 102, -94, 81, 429, 206,
+	-86, -4, -17, -15, -16, -105, -9, -168, -147, 28,
+	5, 7, 112, 34, 34, 83, 59, 34, -59, -58,
+	-60, 280, 281, 282, 283, 284, 285, 286, 287, 288,
+	289, 290, 291, 34, -394, 76, 190, -326, 487, 81,
+	-407, -408, -140, 84, 84, 74, 340, 74, 77, 206,
+	77, 74, -440, 350, 76, -440, 318, 350, 351, 267,
+	316, 205, -440, -440, 318, -440, -440, -440, 337, -440,
+	-440, 501, -440, -440, -440, 352, 353, -440, 499, 205,
+	-440, -440, -440, -440, -440, 79, 74, 77, 340, 74,
+	16, 74, 412, 415, 312, 514, -159, 26, 361, -369,
+	206, -255, -362, 146, 362, 363, 364, 365, 361, 340,
+	366, 367, 368, 243, 369, 18, 205, 81, -319, -318,
+	-315, -316, -317, -314, 33, 202, -255, 272, -6, -117,
+	77, -226, -463, -135, -137, -136, -138, 64, 68, 70,
+	65, 66, 67, 502, 71, -265, 28, -127, -463, -116,
+	-9, -121, -463, -145, 125, -155, -265, -146, 72, 207,
+	73, 28, -147, -148, -149, -150, -151, 5, 7, 54,
+	-463, -463, -7, -8, 26, 27, -13, -7, -11, -13,
+	26, 27, 26, 27, 77, -189, -463, -7, -464, 78,
+	-119, -223, 24, 35, -169, 84, 87, -192, -169, -472,
+	222, 58, -201, -204, -113, -287, 58, -108, 26, 27,
+	65, 666, 665, 57, 63, 62, -215, 89, 16, -282,
+	102, 100, 101, 119, -281, 28, 81, 83, -278, 190,
+	-113, -163, 122, 103, 120, 121, 105, 132, 131, 142,
+	135, 136, 137, 138, 139, 140, 141, 133, 134, 145,
+	112, 113, 114, 115, 116, 117, 118, -250, -463, -128,
+	190, -463, 190, 191, 192, 190, -166, -166, 145, -166,
+	-166, -166, -166, -166, -463, 190, 190, -463, -463, -463,
+	-463, -463, -184, -113, 99, -463, 55, 202, -463, -463,
+	-463, -463, -463, -463, -463, -463, -463, -463, -463, -463,
+	-463, -463, -463, -463, -195, -113, -463, -463, -468, -463,
+	-468, -468, -178, -468, -463, -178, -178, -463, -463, -463,
+	-463, -463, -463, -463, -463, -463, -463, -463, -463, -463,
+	-463, -463, -463, -463, -463, -463, -463, -463, -463, -463,
+	-463, -463, -463, -463, -463, -463, -463, -178, -178, -178,
+	-463, -249, -249, 16, -132, -133, -140, -143, -287, 77,
+	18, -239, -244, 512, 514, 426, -243, -243, -286, -279,
+	83, 33, -342, 112, 123, 34, 190, -200, 78, 77,
+	-294, -298, -300, -299, -301, -296, -297, 458, 461, 465,
+	463, 459, 464, 146, 462, 468, 469, 470, 471, 472,
+	473, 474, 475, 476, 477, 478, 34, 228, 455, 456,
+	457, 629, 479, 480, 481, 482, 483, 484, 485, 486,
+	433, 460, 617, 434, 435, 436, 437, 438, 439, 441,
+	442, 443, 444, 445, 446, 447, 448, 449, 450, 451,
+	452, 453, -294, -294, -294, -294, -294, 81, 243, -274,
+	-382, 76, -219, -258, -279, -140, -270, 211, -268, 81,
+	103, 81, -139, -279, -287, -152, -256, 266, -419, -418,
+	-410, -413, 642, -256, -279, -269, -410, 271, 269, 270,
+	77, 206, 77, 206, 79, -279, -132, 29, 74, -134,
+	-140, 81, 81, -139, -152, -412, -410, -413, -153, -279,
+	-287, -288, -287, -271, -276, -277, 643, 198, 26, 194,
+	197, 102, 28, 30, 644, 254, 105, 439, 146, 553,
+	554, 555, 473, 542, 21, 25, 216, 106, 200, 458,
+	461, 214, 145, 210, 217, 296, 298, 534, 569, 193,
+	70, 645, 525, 527, 522, 526, 297, 524, 499, 639,
+	636, 638, 637, 452, 450, 295, 33, 646, 15, 570,
+	31, 226, 261, 27, 647, 139, 448, 195, 580, 252,
+	109, 110, 648, 46, 45, 8, 29, 299, 227, 95,
+	305, 571, 449, 649, 650, 24, 73, 218, 16, 212,
+	242, 725, 651, 320, 18, 550, 128, 19, 652, 633,
+	635, 634, 208, 207, 122, 203, 717, 68, 259, 13,
+	653, 437, 442, 443, 444, 445, 446, 438, 189, 97,
+	654, 655, 119, 310, 64, 557, 558, 581, 50, 37,
+	247, 572, 573, 669, 574, 540, 309, 656, 43, 42,
+	472, 476, 471, 308, 488, 66, 120, 22, 528, 529,
+	35, 657, 658, 615, 235, 475, 436, 470, 670, 659,
+	631, 141, 264, 71, 103, 370, 575, 576, 93, 451,
+	729, 38, 74, 231, 366, 337, 47, 100, 20, 260,
+	69, 39, 548, 125, 730, 578, 454, 209, 239, 660,
+	514, 263, 661, 126, 579, 447, 671, 220, 121, 431,
+	196, 311, 533, 348, 293, 221, 315, 322, 67, 662,
+	253, 127, 577, 201, 500, 630, 11, 663, 544, 34,
+	225, 292, 435, 211, 664, 265, 304, 294, 303, 665,
+	57, 666, 329, 48, 563, 562, 561, 564, 727, 65,
+	537, 538, 642, 202, 44, 108, 474, 434, 469, 206,
+	541, 240, 94, 300, 9, 49, 36, 495, 14, 360,
+	72, 75, 530, 531, 532, 55, 462, 459, 467, 567,
+	466, 566, 568, 728, 107, 17, 313, 549, 99, 515,
+	101, 640, 496, 14, 195, 77, 83, -234, 81, -278,
+	83, -234, -233, -234, -233, -367, 122, -254, 83, -291,
+	-113, -256, -256, -140, -140, -140, -140, -140, -140, -254,
+	-254, -254, -254, -251, 16, -251, -254, -287, -287, -113,
+	-166, -410, 526, 137, -169, -254, -287, -95, -93, 219,
+	-93, -95, 81, 81, 429, -86, 38, 28, 28, 81,
+	-59, -59, 83, -59, 77, 112, -59, -168, 78, -184,
+	-279, -392, 717, 77, -409, 28, 514, 515, 488, -442,
+	202, 242, 239, 74, -442, -437, 357, -412, -411, -410,
+	-440, -441, -279, -440, -440, -440, 501, -440, -440, -440,
+	-440, -440, -440, -440, -440, -440, -416, -442, 320, 77,
+	-442, -412, -410, 206, -364, -379, 422, 423, -363, -378,
+	-365, 81, 416, 417, 418, 419, 420, 421, 515, 516,
+	102, 84, -279, 361, 361, 361, 361, 361, 361, -279,
+	-140, -319, -316, -315, -317, 461, 509, 145, 521, -140,
+	-410, -153, -125, 28, -225, -224, -280, -274, -276, -277,
+	229, -279, -271, -123, -123, -121, -123, 64, 64, 64,
+	69, 64, 69, 69, 64, 64, -136, -289, -265, 77,
+	-464, -464, -9, -463, -289, -155, -265, 203, 203, 203,
+	105, 16, 52, 26, 105, 16, 52, 26, 105, 16,
+	52, 26, -110, -109, -112, 137, -113, -287, -166, 253,
+	-235, 97, 14, 122, 77, 23, -221, 698, 99, -471,
+	253, 127, -222, 84, 87, 77, -217, 30, 31, 190,
+	-167, 59, 84, 87, -235, 77, -111, -140, -113, -113,
+	-113, -182, 93, 103, 94, 95, -281, 229, 137, -288,
+	-280, -276, -274, 334, 233, -271, -166, -183, -189, -128,
+	88, 122, 120, 121, 105, -166, -166, -166, -166, -166,
+	-166, -166, -166, -166, -166, -166, -166, -166, -166, -166,
+	-290, 81, 83, 146, 81, -280, -109, -280, -165, 93,
+	-165, -279, -290, -279, -99, 26, 27, -280, 502, -280,
+	-99, -99, -99, -99, -99, 77, -464, -109, -109, -110,
+	-109, -109, -464, -113, -113, -109, -166, -109, -192, 83,
+	-109, -192, 83, -109, -166, 540, 541, 542, -109, -109,
+	-99, -193, -194, 107, -192, -274, -277, 334, 233, -109,
+	-464, 84, -172, -173, 621, 630, 631, 633, 636, 629,
+	628, 627, 625, 624, 623, 626, 622, 640, 639, 638,
+	637, 635, 634, 632, -172, -172, -109, -110, -109, -109,
+	-464, -464, -112, -109, -112, -109, -109, -464, -464, -464,
+	-464, -109, -109, -109, -109, -109, -109, -109, -109, -109,
+	-109, -109, -109, -464, 84, -142, 97, -140, -116, -140,
+	-132, -465, 16, 75, -465, 77, 190, -242, -245, -279,
+	77, 513, 515, 516, -239, -311, -312, 145, 74, 96,
+	83, -113, -280, -288, -219, -345, -331, -332, -333, -334,
+	-356, 217, -381, 209, 211, 212, 49, -335, 218, 203,
+	50, -295, -321, 494, 495, -303, 76, -302, 466, 76,
+	-302, 466, 458, 461, 459, -302, 459, 466, -302, -302,
+	-302, -302, 459, 76, 76, -303, -303, -303, -323, 76,
+	-323, 454, -324, 76, -324, -324, -324, -324, -295, -295,
+	-295, -295, -295, -382, -382, -382, -113, -223, -283, 75,
+	-226, 205, 703, -319, 29, -319, -65, 254, 223, 190,
+	76, -153, 257, 258, -414, 77, 33, -420, 317, 84,
+	208, -140, 233, 81, -140, -140, -410, -410, -416, 73,
+	-389, 221, 216, -140, 77, 77, 190, 681, 681, -140,
+	77, -368, 16, 83, 83, 81, 81, -168, -339, 16,
+	122, -254, -140, -168, -107, 229, 75, -468, -464, 77,
+	431, 432, 81, -166, 38, 38, -58, -165, -279, 78,
+	-393, 207, 533, 83, -408, -289, 487, 515, -443, 137,
+	-279, -442, -443, -446, 99, 206, 78, 77, -440, -443,
+	337, 320, -443, 16, -363, 77, 112, 112, 77, 112,
+	81, -380, 24, -371, -372, 715, -226, 34, -341, -342,
+	-341, -341, -76, -75, 198, 195, 196, 199, 200, -385,
+	492, 194, 251, 250, -314, 596, 597, 585, 586, 9,
+	591, 73, 362, 268, 35, 236, -420, -426, 74, -127,
+	-464, 77, 190, -130, -129, 74, 75, -131, 74, -129,
+	64, 64, 64, -229, 76, -289, -121, -464, -231, -279,
+	-155, -289, -463, -463, -463, -166, -166, 122, -166, -166,
+	122, -166, -166, 122, -464, 77, 190, 77, -189, -232,
+	40, 39, -279, 489, 60, -169, -169, 23, 99, 714,
+	-220, -471, 698, -204, -248, 24, 16, 55, 55, -118,
+	16, -215, -215, 93, 94, 95, 190, -463, -183, -166,
+	-166, -166, -107, 102, -464, -464, -110, -109, -109, -109,
+	-109, -109, -113, -464, -464, -464, -457, 709, -459, -460,
+	93, 363, 33, -464, 77, 75, 28, -464, 75, 122,
+	-464, 16, 16, -464, 16, 16, -464, -464, 16, -166,
+	-166, -166, -464, -464, -109, -196, -194, 109, -113, -464,
+	-464, -464, -464, -464, 77, 77, 16, -464, -464, -464,
+	-464, -205, 548, -205, -464, -464, -464, -464, -464, -205,
+	-205, -205, -205, -464, -464, -464, -464, -464, -464, -464,
+	-464, -464, -464, -464, -464, -464, -230, 125, -140, 34,
+	-230, 75, -116, -116, -143, -287, 137, 24, -244, -247,
+	517, 514, 520, 81, 83, 146, 83, 190, -223, -349,
+	-346, 77, 125, 724, 492, 584, 509, 33, 461, 585,
+	586, 145, 90, 587, 248, 41, 203, 589, 521, 362,
+	590, 591, 213, 592, 593, 594, 326, 595, 425, 596,
+	597, 598, 599, 600, 351, 9, 76, 81, -340, -274,
+	243, -273, 50, -335, -337, -335, -335, -340, 81, -340,
+	50, 684, 93, 103, -310, -312, 146, -307, -308, -325,
+	-328, -304, 28, 725, 497, 220, -334, 461, 509, 33,
+	74, 492, 209, 50, 49, 212, 90, -322, 496, 84,
+	-302, 84, 559, -302, -302, 466, -302, 466, -302, -302,
+	-302, -329, 83, -329, 84, -323, 84, 78, 74, -279,
+	28, -140, 642, -66, 13, 14, 15, -279, -395, -396,
+	-397, -279, 122, 259, 260, 74, -429, 348, -418, 318,
+	327, 102, 21, 99, -450, 103, -341, 206, 206, -140,
+	-410, -279, 74, 74, -234, -218, 84, -156, 17, 81,
+	81, -251, -254, -166, -413, 245, 246, -169, -166, -166,
+	-141, 97, -409, 206, 190, 190, -443, 206, 339, -412,
+	-279, 16, 16, 337, 16, -412, -379, 76, 76, -378,
+	83, 84, 85, -369, 371, -375, 713, -341, 28, -341,
+	81, 83, 146, 81, 83, 146, 83, 77, -266, -335,
+	-391, -333, -334, -390, 210, 217, -266, 217, 214, -335,
+	218, 209, 210, -263, -335, 206, 28, -266, -266, -341,
+	-266, 37, 37, 461, 145, -341, -341, -341, -341, -341,
+	-341, -341, -341, -341, 125, -154, 196, 278, 274, -224,
+	-280, -113, 76, -113, -227, -279, -229, -464, 77, -155,
+	-227, -227, -227, 102, 206, -463, 102, 206, -463, 102,
+	206, -463, -265, -112, 137, -288, 83, 77, 83, 83,
+	490, 61, -221, -169, 714, 516, 99, -471, -140, -156,
+	580, -116, -111, 137, -280, -110, -107, 102, -166, -464,
+	-464, -464, -464, -464, -464, -464, -293, 146, 458, 228,
+	457, 450, 448, 449, 477, 463, 494, 455, 495, 629,
+	74, -166, -293, -290, -293, -290, -166, -166, -166, -166,
+	-166, -166, 16, 16, 16, 616, -200, 111, -113, 108,
+	-166, -166, -166, -279, -210, -463, -205, -162, 706, 207,
+	-205, -162, -161, 16, -206, -205, -206, -206, -206, -206,
+	-206, -206, -206, -206, -206, -206, -206, -164, 34, -473,
+	-463, -9, 55, 59, -463, -237, -241, -192, -274, -277,
+	229, -156, -116, -156, -156, 190, -377, 84, 514, 518,
+	519, -346, 21, -341, -341, -341, -341, 509, 461, 145,
+	34, -341, -341, -341, -341, -341, -341, 588, 588, -341,
+	-341, -341, -341, -341, -341, -341, -341, -341, -341, -341,
+	426, -341, -341, -341, -341, -341, -347, -447, 83, -461,
+	90, 272, 326, 81, -273, -271, 84, 86, 85, -341,
+	-358, -357, 81, -278, 209, -381, 49, -381, -340, -340,
+	-340, -340, -96, 81, 93, -463, 726, 84, -140, 34,
+	81, 146, -165, 136, -291, -158, -175, -463, 94, 95,
+	525, 526, 530, 527, 531, 532, 545, 546, 547, 522,
+	528, 529, 14, 50, 50, 50, 83, 78, 78, 78,
+	-302, -302, 78, 77, 78, 77, 78, 77, -160, 676,
+	677, -140, -214, 28, 84, 74, 78, 77, -294, -279,
+	-279, -279, 274, -432, 99, 323, -430, -428, 329, 330,
+	331, 332, 333, -413, 328, -420, 325, 83, 81, 641,
+	675, 696, 668, 29, 316, 642, -140, -410, -228, 76,
+	-227, -228, -113, -168, -230, 202, -412, 137, 137, -279,
+	206, -412, 337, -445, 99, -412, -412, 16, -412, -132,
+	-132, 83, 251, -376, 250, -341, -373, -374, 84, -214,
+	81, 83, 146, -75, 76, -330, 81, -278, -340, -259,
+	211, 209, 81, 81, -278, 81, -278, 81, -278, -279,
+	50, 50, 81, -278, -140, -279, -330, 81, -113, -279,
+	34, -341, 81, 83, 93, 83, -461, -461, -463, 219,
+	222, 224, -447, 83, 33, 81, 33, 323, 81, -279,
+	-425, 250, 251, 206, 279, 103, -422, 723, 275, -227,
+	78, 77, -145, -279, -464, -464, -464, -166, -166, -166,
+	-166, -166, -166, -166, -166, -166, -289, 190, 504, -279,
+	-309, -310, 714, 516, 99, -197, 18, -215, -464, -166,
+	-206, -206, -206, -206, -206, -458, -459, -303, -303, -303,
+	-324, -303, 438, -303, 438, 648, 363, -464, -464, -464,
+	-464, -464, 24, 24, 24, 24, -464, -166, -166, -166,
+	-463, -106, 544, -113, 77, 77, -464, -284, 81, -205,
+	692, 692, -205, -162, 222, 224, -236, 74, -237, -187,
+	-190, -189, -463, -9, -464, -225, 11, 99, 202, 55,
+	-231, -156, 77, -342, -342, -342, -342, -200, -156, 77,
+	-350, -351, 126, 608, -352, 656, -347, -347, -347, -290,
+	-341, 34, -341, -341, -461, -461, -313, -447, 83, 83,
+	83, 83, -341, -341, -461, 83, -447, 83, 219, 222,
+	224, -347, -347, -347, -461, 83, -348, 33, 604, 453,
+	605, 606, 607, 81, 93, 83, 83, 33, -461, 33,
+	-461, -347, 601, -463, 78, 77, -303, -303, 50, -337,
+	76, -166, 28, 76, 81, 146, -165, 83, -166, -181,
+	83, 84, 84, 76, -216, -214, -450, -140, -398, -399,
+	-400, 90, 618, 103, 261, 262, 219, 263, 264, 265,
+	-397, -294, -294, -294, -422, -435, -436, -434, 326, 346,
+	347, 334, -433, -431, 341, 342, 343, 344, 102, 83,
+	83, 83, -421, 317, 102, 326, 21, 28, 83, 83,
+	83, 83, 84, -371, -372, -371, 75, -227, -254, -309,
+	-140, -445, -412, -445, 320, -412, 78, 78, 73, 84,
+	136, 77, 136, -216, -330, -202, 222, 223, -294, -294,
+	-283, -337, 50, 81, -264, 206, 28, -264, 206, -202,
+	-330, 34, 195, -341, -290, -448, -447, 97, -306, 90,
+	74, -153, 279, -426, 74, -171, -165, -175, -165, 78,
+	-279, 77, 77, 77, 137, -463, -401, -252, 714, -198,
+	19, 21, -309, 81, -460, -166, -166, -166, -166, -464,
+	-464, -464, -166, -464, 83, -166, -166, -186, 125, -205,
+	32, -236, 77, -464, -464, -473, 55, -464, -464, -200,
+	-241, -113, -113, -113, -113, -218, 84, -353, 610, -463,
+	504, -463, 504, 609, 50, -290, -341, -313, -290, 83,
+	83, 602, 603, -448, -360, -359, 75, 213, 90, 590,
+	597, 716, 684, -357, -217, -217, -340, -340, -227, -464,
+	-463, -227, -464, 78, 78, -358, -9, -216, 24, -215,
+	-400, 83, 265, 261, 265, 265, 265, 265, 266, -426,
+	-417, 90, 324, -434, 335, 682, 319, 348, 84, 84,
+	124, 35, 36, -431, 84, 84, 84, 84, -428, 21,
+	99, -420, 325, 83, 83, 317, -450, -375, 41, 78,
+	-401, -444, 28, -445, -444, 337, 84, -374, 84, -9,
+	78, 81, -295, -295, 76, -340, -340, 81, -278, 81,
+	-278, -279, -202, 33, 33, -290, 77, -464, -463, 314,
+	83, 353, -154, -427, -173, -166, -166, -166, -452, -453,
+	-280, 689, -402, 43, -404, 44, -208, 549, -199, -113,
+	-185, -115, -113, 74, -464, -464, -464, -464, -104, 122,
+	99, -464, -464, -200, 21, 50, -190, -187, -473, -9,
+	-463, -218, -354, 611, 84, -447, -463, -447, -463, -463,
+	-463, 268, -290, -464, -359, -447, -341, 83, -341, -341,
+	78, -320, 728, 727, -166, 78, 78, -214, -215, -9,
+	252, -216, 41, 41, 257, 258, -425, 83, 83, 33,
+	336, 189, 33, 84, 189, 551, 325, 83, 81, 326,
+	21, 251, -166, -402, -410, -444, -214, -215, -358, -283,
+	76, -166, 145, -447, -383, -384, 125, -214, -425, 135,
+	-423, 276, -464, -464, -464, -464, 77, -294, 24, 83,
+	583, -405, 48, -403, -327, 47, 21, -207, -209, -279,
+	77, 363, -464, 617, 71, 619, -211, 127, 126, -184,
+	14, -187, -9, -463, 21, -464, -227, -464, -227, -165,
+	81, -227, 112, 84, 83, 83, 220, -464, -361, -360,
+	-366, 99, -214, -215, 253, -26, -4, -15, -16, -17,
+	-18, -20, -67, -21, -22, -27, -28, -23, -77, -81,
+	-82, -83, -79, -90, -88, -89, -85, -84, -31, -33,
+	-34, -36, -39, -40, -37, -38, -35, -29, -32, -41,
+	-24, -42, -43, -44, -48, -49, -30, -50, 193, 195,
+	106, 208, 425, 295, 306, 307, 308, 81, 311, 313,
+	309, 310, 305, 315, 424, 194, -306, 84, 33, 84,
+	33, 338, 326, 28, 84, -305, 207, 99, -366, 78,
+	76, -358, -341, -464, 77, -279, -26, -306, 189, -424,
+	277, -171, -464, -453, -454, 583, 29, 695, 504, 83,
+	-404, 21, -406, 45, 46, 83, 77, 28, -115, 61,
+	618, 620, -464, -212, 105, -213, 124, 551, -170, 84,
+	189, -212, -237, -464, -383, -351, -464, -464, -464, -464,
+	-464, 84, -140, -320, 214, 81, 487, -366, -203, 255,
+	256, -214, -113, -53, -54, 107, -113, 81, -63, -285,
+	298, 299, 300, -280, 81, 81, -52, -26, 80, -52,
+	-113, 81, 81, -466, 16, 58, -166, 111, -52, 314,
+	625, 625, 83, -229, 84, 318, -361, -358, 78, -290,
+	-384, 55, -214, 314, -165, -171, -427, -265, 83, 583,
+	-463, 504, 83, 21, 21, -209, -210, 61, -213, 129,
+	130, 253, 129, 130, -165, -464, -355, 612, -463, 76,
+	337, 214, 214, -214, 81, 81, -53, 111, 109, -54,
+	-113, 108, 296, 297, 301, -294, 77, 731, 424, 308,
+	311, 313, 731, 314, 81, 16, 731, -214, 43, 127,
+	33, 323, 26, -413, 78, -361, 237, -214, -173, -427,
+	-289, -455, -456, 363, 93, 33, 83, -452, -463, 83,
+	83, 99, 102, -172, 84, -227, -227, 337, 337, -216,
+	111, 109, 106, -52, 108, -52, 24, 24, 24, -309,
+	-280, 111, -26, 111, -52, -52, -52, -113, 312, -52,
+	97, 111, -26, 8, -361, 238, -26, -456, 93, 33,
+	-455, 93, 33, 74, 74, -165, -464, -452, 619, -213,
+	-464, 78, -19, -25, -18, -24, -15, -16, -17, 424,
+	99, 106, -52, 731, -52, 731, -64, 84, -9, -62,
+	-61, 84, -64, 303, 103, 304, 81, -311, 308, 81,
+	731, 731, 731, 314, -113, 731, -57, 81, -214, -413,
+	-463, -214, 74, -165, 74, -165, 648, 363, 648, 363,
+	74, -464, 620, -387, -388, 74, -214, -52, 111, -119,
+	731, 111, 731, 111, 109, -55, -56, 110, -26, 77,
+	302, 33, 111, 111, 312, -52, 111, 111, 77, -166,
+	235, 74, 74, 363, 363, 648, 363, -388, 74, -387,
+	74, 15, 14, 731, 111, 106, 208, -52, 111, 109,
+	-56, -113, -61, -166, 81, 308, -113, 731, 311, 313,
+	81, -464, -464, 74, 74, -386, 221, 216, 219, 34,
+	-386, 111, 106, 731, 208, -52, 108, 81, 111, 111,
+	363, 648, 215, 33, 93, 111, 731, -52, 311, 313,
+	208, 111, 731, 81, 81, 208,
+}
+
+var yyDef = [...]int16{
+	-2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
+	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	20, 21, 22, 23, 24, 25, 26, 27, 28, 29,
+	30, 31, 32, 33, 34, 35, 36, 37, 38, 39,
+	1661, 1155, 1155, 0, 1155, 0, -2, 132, 936, 937,
+	938, 939, 940, 0, 0, 0, 0, 0, 0, 0,
+	-2, 1120, 1121, 1124, 1121, 1121, 1149, 0, 473, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 714, -2, 59, 0, 1151, 1152, 1153, -2,
+	-2, 0, 65, 48, 0, 0, 55, 0, 2678, 2,
+	1669, 0, 1168, 1157, 0, 1155, 1155, 1155, 82, 83,
+	0, 116, 0, 118, 0, 0, 1661, 1155, 0, 0,
+	0, 1761, 1761, 331, 1761, 1761, 1775, 0, 0, 1777,
+	329, 0, 1758, 1773, 1774, 0, 0, 0, 0, 1759,
+	0, 1759, 1759, 1759, 1759, 1759, 1759, 1759, 1759, 0,
+	0, 1036, 1349, 1351, 1352, 1353, 1354, 1786, 1787, 2669,
+	2670, 2671, 2672, 2673, 2674, 2641, 2642, 2643, 2644, 2645,
+	2646, 2647, 2648, 2649, 2650, 2651, 2652, 2653, 2654, 2655,
+	2656, 2657, 2658, 2659, 2660, 2661, 2662, 2675, 2676, 2677,
+	2369, 2370, 2371, 2372, 2373, 2374, 2375, 2376, 2377, 2378,
+	2379, 2380, 2381, 2382, 2383, 2384, 2385, 2386, 2387, 2388,
+	2389, 2390, 2391, 2392, 2393, 2394, 2395, 2396, 2397, 2398,
+	2399, 2400, 2401, 2402, 2403, 2404, 2405, 2406, 2407, 2408,
+	2409, 2410, 2411, 2412, 2413, 2414, 2415, 2416, 2417, 2418,
+	2419, 2420, 2421, 2422, 2423, 2424, 2425, 2426, 2427, 2428,
+	2429, 2430, 2431, 2432, 2433, 2434, 2435, 2436, 2437, 2438,
+	2439, 2440, 2441, 2442, 2443, 2444, 2445, 2446, 2447, 2448,
+	2449, 2450, 2451, 2452, 2453, 2454, 2455, 2456, 2457, 2458,
+	2459, 2460, 2461, 2462, 2463, 2464, 2465, 2466, 2467, 2468,
+	2469, 2470, 2471, 2472, 2473, 2474, 2475, 2476, 2477, 2478,
+	2479, 2480, 2481, 2482, 2483, 2484, 2485, 2486, 2487, 2488,
+	2489, 2490, 2491, 2492, 2493, 2494, 2495, 2496, 2497, 2498,
+	2499, 2500, 2501, 2502, 2503, 2504, 2505, 2506, 2507, 2508,
+	2509, 2510, 2511, 2512, 2513, 2514, 2515, 2516, 2517, 2518,
+	2519, 2520, 2521, 2522, 2523, 2524, 2525, 2526, 2527, 2528,
+	2529, 2530, 2531, 2532, 2533, 2534, 2535, 2536, 2537, 2538,
+	2539, 2540, 2541, 2542, 2543, 2544, 2545, 2546, 2547, 2548,
+	2549, 2550, 2551, 2552, 2553, 2554, 2555, 2556, 2557, 2558,
+	2559, 2560, 2561, 2562, 2563, 2564, 2565, 2566, 2567, 2568,
+	2569, 2570, 2571, 2572, 2573, 2574, 2575, 2576, 2577, 2578,
+	2579, 2580, 2581, 2582, 2583, 2584, 2585, 2586, 2587, 2588,
+	2589, 2590, 2591, 2592, 2593, 2594, 2595, 2596, 2597, 2598,
+	2599, 2600, 2601, 2602, 2603, 2604, 2605, 2606, 2607, 2608,
+	2609, 2610, 2611, 2612, 2613, 2614, 2615, 2616, 2617, 2618,
+	2619, 2620, 2621, 2622, 2623, 2624, 2625, 2626, 2627, 2628,
+	2629, 2630, 2631, 2632, 2633, 2634, 2635, 2636, 2637, 2638,
+	2639, 2640, 0, 0, 0, 1041, 1042, 1043, 1044, 2663,
+	2664, 2665, 2666, 2667, 2668, 1053, 1054, 0, 0, 1063,
+	0, 0, 1111, 0, 1111, 1076, 1077, 0, 1079, 0,
+	0, 0, 0, 0, 0, 1108, 1090, 1093, 1097, 0,
+	1669, 1669, 1108, 798, 799, 800, 1116, 1117, 1105, 1118,
+	1123, 1122, 1130, 1130, 0, 1122, 75, -2, 0, 1285,
+	0, 451, 453, 0, 474, 0, 477, 480, 1355, 0,
+	-2, -2, 1797, 1136, 0, 0, 1822, 1823, 0, 0,
+	0, 0, 0, 2544, 226, 345, 267, 267, 267, 267,
+	267, 267, 267, 0, 267, 267, 0, 267, 267, 267,
+	2557, 267, 0, 267, 267, 267, 267, 267, 225, 344,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 239, 240, 241, 242, 243, 244, 245, 246, 247,
+	248, 249, 250, 251, 252, 253, 254, 255, 256, 257,
+	258, 259, 260, 261, 262, 263, 264, 265, 266, 334,
+	335, 336, 0, 0, 0, 2544, 0, -2, 0, 1125,
+	727, 720, 734, 0, 728, -2, 0, 715, 712, 713,
+	0, 0, -2, 0, 1759, 331, 1764, 76, 0, 62,
+	77, 1710, 1309, 1310, 64, 1246, 1247, 1248, -2, 0,
+	0, 1254, 1255, -2, 1405, 1786, 0, 0, 1162, 0,
+	0, 1159, 1165, 1256, 0, 0, 0, 44, 0, 1697,
+	1673, 0, 0, 1679, 0, 0, 114, 1156, 0, 1186,
+	1237, 0, 1364, 2256, 1370, -2, 2169, 1412, 1413, 1414,
+	1415, 1416, 1417, 1418, 1419, 2114, 0, 1437, 0, 0,
+	0, 0, 2211, 1444, 1445, 1446, 1447, 1448, 1449, 1642,
+	1643, 1644, 1645, 1646, 1647, 1648, -2, -2, -2, 1627,
+	1628, 0, 1640, 2669, 2670, 2672, 2673, 2674, 1727, 0,
+	1550, 1551, 1552, 1553, 1554, 1555, 1556, 1557, 1558, 1559,
+	1560, 1561, 1562, 1563, 1564, 1565, 1566, 1567, 1568, 1569,
+	1570, 1571, 1572, 1573, 1574, 1575, 1576, 1577, 1578, 1579,
+	1580, 1581, 1582, 1583, 1584, 1585, 1586, 1587, 1588, 1589,
+	1590, 1591, 2237, 2300, 2651, 2188, 2305, 2133, 2675, 2125,
+	2676, 2202, 2327, 2328, 2677, 2245, 2648, 0, -2, 2352,
+	2293, -2, -2, -2, 1520, -2, -2, -2, 2659, 2660,
+	2645, 2195, 2143, 2253, 2294, 2134, 2155, 2174, 2223, 2224,
+	2226, 2258, 2259, 2277, 2286, 2304, 2115, 2116, 2117, 2218,
+	2219, 2324, 2323, 2322, 2325, 2358, 2356, 2359, 2103, 2104,
+	2105, 2106, 2107, 2108, 2109, 2110, 2111, 2112, 2113, 2118,
+	2119, 2120, 2121, 2122, 2124, 2126, 2127, 2128, 2129, 2130,
+	2131, 2132, 2135, 2136, 2137, -2, 2142, 2144, 2145, 2146,
+	2147, 2148, 2149, 2150, 2151, 2153, 2154, 2156, 2157, 2158,
+	2159, 2160, 2161, 2162, 2163, 2164, 2165, 2166, 2167, 2168,
+	2170, 2171, 2173, 2175, 2176, 2177, 2178, 2179, 2180, 2181,
+	2182, 2183, 2184, 2185, 2186, 2187, 2189, 2190, 2191, 2192,
+	2193, 2194, 2196, 2197, 2198, 2199, 2200, 2201, 2203, 2204,
+	2205, 2206, 2207, 2208, 2209, 2210, 2212, 2213, 2214, 2215,
+	2216, 2217, 2220, 2221, 2222, 2225, 2227, 2228, 2229, 2230,
+	2231, 2232, 2233, 2234, 2235, 2236, 2238, 2239, -2, -2,
+	2242, 2243, 2244, 2246, 2247, 2248, 2249, 2250, 2251, 2252,
+	2254, 2255, 2257, 2261, 2262, 2263, 2264, 2265, 2266, 2267,
+	2268, 2269, 2270, 2271, 2272, 2273, 2274, 2275, 2276, 2278,
+	2279, 2280, 2281, 2282, 2283, 2284, 2285, 2287, 2288, 2289,
+	2290, 2291, 2292, 2295, 2296, 2297, 2298, 2299, 2301, 2302,
+	2303, 2306, 2307, 2308, 2309, 2310, 2311, 2312, 2313, 2314,
+	2315, 2316, 2317, 2318, 2319, 2320, 2321, 2326, 2329, 2330,
+	2331, 2332, 2333, 2334, 2335, 2336, 2337, 2338, 2340, 2341,
+	2342, 2343, 2344, 2345, 2346, 2347, 2348, 2353, 2354, 2355,
+	2357, 2360, 2361, 2362, 2363, 2364, 2365, 2366, 2367, 2368,
+	0, 1763, 1763, 0, 99, 2560, 2621, 0, 1734, -2,
+	-2, 1736, 0, 0, 0, -2, -2, -2, -2, 2126,
+	-2, 1627, 0, 0, 0, 0, 2114, 2115, 2116, 2117,
+	2123, 2125, 2133, 2134, 2138, 2139, 2140, 2141, 2143, 2152,
+	2155, 2169, 2172, 2174, 2188, 2195, 2202, 2211, 2218, 2219,
+	2223, 2224, 2226, 2237, 2240, 2241, 2245, 2253, 2256, 2258,
+	2259, 2260, 2277, 2286, 2293, 2294, 2300, 2304, 2305, 2322,
+	2323, 2324, 2325, 2327, 2328, 2339, 2349, 2350, 2351, 2352,
+	2356, 2358, 2359, 117, 1661, 119, 0, 530, 531, 0,
+	0, 0, 0, 0, 0, 0, 826, 1780, 1781, 0,
+	1669, 1168, 0, 0, 329, 0, 0, 0, 0, 0,
+	1761, 324, 0, 0, 0, 0, 1761, 0, 331, 0,
+	0, 1016, 0, 1017, 0, 339, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1059, 1060,
+	1035, 0, 1037, 93, 1040, 0, 0, 0, 0, 1057,
+	1058, 1061, 1062, 730, 1064, 1111, 1067, 0, 0, 1761,
+	1761, 0, 0, 0, 0, 0, 1075, 0, 828, 829,
+	1111, 1081, 1111, 1111, 1111, 1108, 0, 1108, 1087, 1106,
+	1107, 1111, 0, 0, 0, 1092, 0, 0, 0, 1100,
+	0, 1101, 1111, 0, -2, 1128, -2, 1137, 0, 0,
+	1142, 1145, 1146, 1147, 1148, 75, 1144, 1154, 1286, 0,
+	0, 0, 0, 0, 0, 455, 0, 0, 476, 457,
+	0, 461, 462, 463, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 0, 1285, 0, 0, 1792, 1798, 1141,
+	1813, 1814, 0, 1824, 1825, 271, 0, 271, 0, 0,
+	0, 0, 193, 267, 0, 195, 267, 267, 267, 0,
+	267, 267, 202, 203, 267, 205, 206, 207, 267, 209,
+	210, 267, 212, 213, 214, 267, 267, 217, 267, 267,
+	220, 221, 222, 223, 224, 343, 271, 0, 0, 271,
+	0, 0, 0, 0, 0, 0, 721, 735, 0, 723,
+	0, 0, 697, 0, 0, 0, 0, 702, 703, 704,
+	705, 0, 0, 708, 709, 0, 0, -2, 944, -2,
+	675, 676, 677, 0, 672, 0, 0, 0, 60, 61,
+	0, 0, 0, 0, 0, 0, 0, 1311, 0, 0,
+	1314, 0, 0, 0, 0, 0, 1308, -2, 0, 0,
+	0, 1244, 0, 1259, 0, 1261, 0, -2, 0, 0,
+	0, 1308, 1265, 1266, 1267, 1268, 1269, 0, 0, 0,
+	1406, 0, 52, 53, 1163, 1164, 49, 57, 58, 50,
+	1160, 1161, 1166, 1167, 0, 1257, 0, 51, 54, 2679,
+	0, 69, 0, 0, 1670, 1694, 1695, 1696, 0, 1687,
+	1690, 1691, 1662, 1663, 1666, 0, 0, 69, 1169, 1170,
+	1171, 1172, 1173, 1174, 1175, 1176, 0, 1158, 0, 1187,
+	0, 0, 0, 0, 1238, 0, 1241, 1242, 1243, 0,
+	1368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1392, 1393, 1394, 1395, 1396, 1397, 1398, 1371, 0, 1385,
+	0, 0, 0, 0, 0, 0, 1435, 1436, 0, 1439,
+	1440, 1441, 1442, 0, 1177, 0, 0, 1177, 1177, 1177,
+	1177, 1177, 0, 1410, 2364, 0, 2352, 2330, 0, 0,
+	1406, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1177, 0, 1619, 0, 0, 1517, 0,
+	1518, 1519, 1521, 1534, 0, 1522, 1523, 0, 0, 0,
+	0, 1406, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1529, 1530, 1531,
+	0, 0, 0, 0, 0, 0, 93, 95, 1349, 0,
+	0, 102, 104, 0, 0, 2621, 1737, 1738, 666, 1752,
+	1753, 1754, 0, 788, 789, 1750, 0, 1669, 843, 0,
+	547, 647, 565, 566, 567, 637, 569, 639, 639, 0,
+	639, 639, 639, 639, 639, 614, 615, 616, 617, 618,
+	620, 621, 622, 623, 624, 0, 0, 593, 637, 637,
+	637, 597, 627, 628, 629, 630, 631, 632, 633, 634,
+	570, 571, 572, 573, 574, 575, 576, 577, 578, 579,
+	580, 581, 582, 583, 584, 642, 642, 644, 644, 644,
+	644, 644, 547, 547, 547, 547, 547, 0, 0, 0,
+	825, 0, 1697, 114, 1778, 1710, 0, 0, 331, -2,
+	0, -2, 0, 1344, 0, 0, 0, 0, 141, 361,
+	347, 130, 0, 0, 0, 325, 330, 326, 327, 328,
+	0, 0, 0, 0, 338, 756, 1032, 1760, 0, 1024,
+	91, 1025, 1026, 1027, 1028, 1029, 340, 1030, 1031, 363,
+	0, 1350, 1788, 1789, 1790, 1791, 1826, 1827, 1828, 1829,
+	1830, 1831, 1832, 1833, 1834, 1835, 1836, 1837, 1838, 1839,
+	1840, 1841, 1842, 1843, 1844, 1845, 1846, 1847, 1848, 1849,
+	1850, 1851, 1852, 1853, 1854, 1855, 1856, 1857, 1858, 1859,
+	1860, 1861, 1862, 1863, 1864, 1865, 1866, 1867, 1868, 1869,
+	1870, 1871, 1872, 1873, 1874, 1875, 1876, 1877, 1878, 1879,
+	1880, 1881, 1882, 1883, 1884, 1885, 1886, 1887, 1888, 1889,
+	1890, 1891, 1892, 1893, 1894, 1895, 1896, 1897, 1898, 1899,
+	1900, 1901, 1902, 1903, 1904, 1905, 1906, 1907, 1908, 1909,
+	1910, 1911, 1912, 1913, 1914, 1915, 1916, 1917, 1918, 1919,
+	1920, 1921, 1922, 1923, 1924, 1925, 1926, 1927, 1928, 1929,
+	1930, 1931, 1932, 1933, 1934, 1935, 1936, 1937, 1938, 1939,
+	1940, 1941, 1942, 1943, 1944, 1945, 1946, 1947, 1948, 1949,
+	1950, 1951, 1952, 1953, 1954, 1955, 1956, 1957, 1958, 1959,
+	1960, 1961, 1962, 1963, 1964, 1965, 1966, 1967, 1968, 1969,
+	1970, 1971, 1972, 1973, 1974, 1975, 1976, 1977, 1978, 1979,
+	1980, 1981, 1982, 1983, 1984, 1985, 1986, 1987, 1988, 1989,
+	1990, 1991, 1992, 1993, 1994, 1995, 1996, 1997, 1998, 1999,
+	2000, 2001, 2002, 2003, 2004, 2005, 2006, 2007, 2008, 2009,
+	2010, 2011, 2012, 2013, 2014, 2015, 2016, 2017, 2018, 2019,
+	2020, 2021, 2022, 2023, 2024, 2025, 2026, 2027, 2028, 2029,
+	2030, 2031, 2032, 2033, 2034, 2035, 2036, 2037, 2038, 2039,
+	2040, 2041, 2042, 2043, 2044, 2045, 2046, 2047, 2048, 2049,
+	2050, 2051, 2052, 2053, 2054, 2055, 2056, 2057, 2058, 2059,
+	2060, 2061, 2062, 2063, 2064, 2065, 2066, 2067, 2068, 2069,
+	2070, 2071, 2072, 2073, 2074, 2075, 2076, 2077, 2078, 2079,
+	2080, 2081, 2082, 2083, 2084, 2085, 2086, 2087, 2088, 2089,
+	2090, 2091, 2092, 2093, 2094, 2095, 2096, 2097, 2098, 2099,
+	2100, 2101, 2102, 0, 0, 0, 1045, 1047, 1051, 1052,
+	1046, 1048, 1055, 1049, 1056, 732, 0, 1066, 1112, 0,
+	1114, 0, 0, 1285, 1071, 1072, 1073, 1074, 830, 1080,
+	1082, 1083, 1089, 1111, 0, 1285, 1088, 1109, 1110, 1091,
+	1399, 1094, 1532, 0, 1670, 1102, 1119, 1134, 0, 1129,
+	0, 1135, 1139, 1138, 0, 1143, 0, 0, 0, 1150,
+	452, 454, 456, 475, 0, 0, 478, 479, 481, 0,
+	1356, 1794, 0, 0, 1816, 0, 1818, 1820, 0, 0,
+	272, 273, 274, 271, 0, 227, 0, 289, 346, 0,
+	194, 0, 269, 196, 197, 198, 267, 200, 201, 204,
+	208, 211, 215, 216, 218, 219, 342, 0, 0, 0,
+	0, 191, 0, 0, 717, 748, 0, 0, 719, 736,
+	0, 741, 742, 743, 744, 745, 746, 747, 1126, 1127,
+	0, 729, 725, 698, 699, 700, 701, 710, 707, 759,
+	1710, 943, 678, 679, 680, 0, 786, 786, 786, 671,
+	0, 373, 78, 0, 0, 1712, 1715, 1716, 1717, 1718,
+	1719, 1784, 1785, 1303, 1305, 0, 1300, 1312, 1313, 1315,
+	0, 1317, 0, 0, 1320, 1321, 1322, 1289, 0, 0,
+	1253, 1404, 0, 0, 1358, 1263, 0, 0, 0, 0,
+	0, 0, 0, 1279, 0, 0, 0, 1280, 0, 0,
+	0, 1281, 0, 1407, 1408, 1182, 1183, 0, 0, 0,
+	42, 0, 1698, 0, 0, 0, 1674, 1684, 0, 1677,
+	1692, 1693, 0, 1688, 1689, 0, 1665, 1667, 1668, 0,
+	0, 1650, 0, 0, 66, 114, 114, 45, 1365, 1366,
+	1367, 1369, 1386, 0, 1388, 1390, 1239, 1240, 1188, 0,
+	1629, -2, 1631, -2, -2, -2, 1376, 1377, 1401, 1402,
+	1403, 0, 0, 0, 0, 1399, 1381, 0, 1420, 1421,
+	1422, 1423, 1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431,
+	1434, 1547, 1548, 1549, 0, 1638, 0, 1639, 1432, 1649,
+	1433, 1634, 1438, 1443, 1406, 1178, 1179, 1636, 1637, 1635,
+	0, 0, 0, 0, 0, 0, 1726, 0, 0, 0,
+	1407, 0, 1492, 0, 0, 0, 0, 0, 1416, 1642,
+	0, 1416, 1642, 0, 0, 2227, 2337, 2119, 0, 0,
+	0, 1625, 1622, 0, 0, 0, 0, 2663, 2664, 0,
+	1533, 0, 0, 1214, 1215, 1216, 1217, 1218, 1219, 1220,
+	1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
+	1231, 1232, 1233, 1234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1527, 0, 97, 0, 1348, 0, -2,
+	0, 0, 89, 90, 0, 0, 0, 1735, 0, 100,
+	0, 0, 107, 108, 103, 1739, 667, 0, -2, 1746,
+	1642, 1748, 1629, 0, 1697, 911, 532, 533, 534, 535,
+	0, 805, 814, 0, 0, 803, 805, 805, 0, 801,
+	802, 538, 650, 648, 649, 568, 0, 598, 639, 0,
+	599, 639, 639, 639, 639, 602, 639, 639, 605, 608,
+	612, 613, 619, 0, 0, 594, 595, 596, 585, 0,
+	586, 642, 588, 0, 589, 590, 591, 592, 539, 540,
+	541, 542, 543, 822, 823, 824, 0, 291, 0, 0,
+	0, 0, 0, 125, 1762, 126, 0, 384, 385, 0,
+	293, 0, 332, 333, 143, 0, 0, 348, 0, 136,
+	0, 527, 528, 786, 0, 1018, 0, 1020, 337, 757,
+	1022, 1033, 1034, 1023, 0, 0, 0, 0, 0, 94,
+	0, 1669, 0, 731, 1113, 1068, 1069, 1070, 1362, 0,
+	0, 1084, 1108, 1111, 1103, 0, 0, 1095, 0, 0,
+	1133, 1131, 1140, 1282, 0, 0, 458, 459, 460, 482,
+	0, 1795, 1796, 1793, 1815, 0, 1819, 1821, 0, 275,
+	277, 0, 0, 185, 0, 0, 268, 0, 199, 0,
+	0, 0, 0, 0, 716, 0, 0, 0, 0, 0,
+	728, 706, 0, 754, 765, 786, 0, 786, 0, 787,
+	0, 0, 945, 946, -2, 1003, 1770, 1003, 1003, 967,
+	786, 1003, 0, 0, 0, 786, 786, 786, 786, 786,
+	786, 991, 786, 786, 786, 0, 999, 1014, 0, 79,
+	1711, 0, 0, 1297, 1304, 0, 0, 1298, 0, 1299,
+	1316, 1318, 1319, 1249, 0, 1289, 1245, -2, 0, 1293,
+	1262, 1358, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, -2, 0, 0, 0, 1258, 70,
+	2427, 0, 73, 0, 0, 1671, 1672, 0, 0, 1685,
+	1678, 0, 0, 1664, 0, 1755, 1756, 1651, 1652, 1362,
+	0, 0, 1180, 1387, 1389, 1391, 0, 1406, 1378, 1399,
+	1382, 0, 1379, 0, 1373, 1488, 0, 0, 0, 0,
+	0, 0, 1411, 1486, 1487, 1489, 0, 0, 1609, 0,
+	1615, 1616, 0, 1491, 0, 0, 0, 1495, 0, 0,
+	1499, 0, 0, 1540, 0, 0, 1541, 1504, 0, 0,
+	0, 0, 0, 1510, 1661, 0, 1623, 0, 0, 1513,
+	1514, 1515, 1516, 1535, 0, 0, 0, 1536, 1537, 1538,
+	1539, 1475, 0, 1476, 0, 1472, 0, 1472, 1469, 1482,
+	1483, 1484, 1485, 1197, 1197, 1197, 1197, 1197, 1197, 1197,
+	1197, 1197, 1197, 1197, 1197, 1528, 0, 0, 1347, 0,
+	1362, 0, 1362, 1362, 96, 0, 1357, 0, 105, 106,
+	0, 0, 112, 668, 669, 670, 1747, 0, 292, 529,
+	844, 0, 0, 786, 786, 786, 786, 0, 0, 786,
+	786, 786, 786, 786, 786, 0, 0, 786, 786, 786,
+	786, 786, 786, 786, 786, 786, 786, 786, 0, 786,
+	786, 786, 786, 786, 0, 786, 0, 806, 0, 0,
+	2598, 807, 805, 805, 805, 804, 805, 796, 806, 797,
+	820, 548, 549, 0, 551, 552, 553, 554, 555, 556,
+	557, 558, 0, 0, 0, 0, 563, 0, 0, 0,
+	0, 659, 0, 692, 694, 0, 0, 564, 651, 0,
+	606, 0, 0, 607, 600, 639, 601, 639, 609, 603,
+	604, 0, 635, 0, 0, 587, 0, 840, 0, 1779,
+	113, 0, 0, 0, 386, 387, 388, 1345, 0, 294,
+	295, 0, 0, 0, 0, 0, 153, 0, 362, 0,
+	0, 0, 0, 0, 133, 0, 0, 0, 0, 92,
+	341, 364, 0, 0, 1050, 1065, 733, 1078, 0, 831,
+	832, 1285, 1086, 1400, 1096, 1098, 1099, 1671, 1283, 1284,
+	97, 0, 1817, 0, 0, 0, 0, 0, 0, 287,
+	270, 0, 0, 0, 0, 192, 749, 0, 0, 737,
+	738, 739, 740, 722, 0, 768, 786, 0, 113, 0,
+	684, 685, 686, 687, 688, 689, 690, 671, 0, 805,
+	0, 968, 969, 0, 1004, 932, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1771, 1772, 0, 0, 0,
+	0, 976, 977, 0, 786, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 376, 0, 0, 0, 1713,
+	1714, 1301, 0, 1306, 0, 1291, 1250, -2, 0, 1264,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1409, 1184, 0, 0, 0, 71, 660,
+	1699, 1700, 1675, 1676, 1686, 1680, 0, 0, 43, 1653,
+	67, 68, 114, 1189, 1641, 0, 1380, 0, 1383, 1450,
+	1197, 1197, 1197, 1197, 1197, 1490, 1610, 637, 637, 1595,
+	637, 644, 1598, 1599, 1600, 637, 1602, 637, 1605, 1607,
+	0, 1617, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1620, 1512, 1626, 0,
+	0, 0, 0, 1190, 1191, 1193, 1477, 0, 0, 0,
+	1479, 0, 1472, 0, 1454, 1198, 1455, 1456, 1458, 1459,
+	1461, 1462, 1463, 1464, 1466, 1467, 1468, 1720, 0, 0,
+	0, 1703, 1708, 1709, 0, 1362, 1728, 0, 0, 0,
+	0, 1661, 1362, 87, 88, 0, 101, 771, 109, 110,
+	111, 845, 923, 0, 0, 0, 0, 786, 0, 786,
+	786, 0, 0, 0, 0, 0, 0, 786, 786, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	875, 0, 0, 0, 0, 0, 885, 886, 892, 893,
+	894, 895, 896, 908, 909, 910, 897, 898, 899, 0,
+	0, 808, 637, 637, 0, 812, 803, 813, 790, 792,
+	793, 795, 0, 821, 550, 0, 0, 561, 0, 0,
+	664, 665, 652, 0, 0, 655, 656, 0, 1374, 1375,
+	1532, 1532, 1532, 1532, 1532, 1532, 0, 0, 0, 1532,
+	1532, 1532, 0, 691, 693, 695, 696, 638, 640, 641,
+	610, 611, 625, 0, 626, 0, 645, 0, 827, 841,
+	842, 0, 115, 113, 136, 0, 301, 0, 297, 0,
+	0, 0, 0, 161, 0, 144, 145, 146, 148, 149,
+	0, 0, 0, 142, 0, 350, 0, 353, 354, 0,
+	0, 0, 0, 0, 759, 759, 1019, 1021, 0, 0,
+	1288, 1039, 1363, 1111, 660, 0, 287, 276, 278, 279,
+	0, 287, 290, 186, 0, 187, 189, 0, 188, 0,
+	0, 711, 770, 758, 769, 0, 760, 761, 763, 115,
+	681, 682, 683, 947, 0, 1000, 0, 0, 1778, 803,
+	1775, 0, -2, 950, 951, 959, 960, 961, 962, 963,
+	0, 974, 0, 0, 956, 0, 1000, 0, 971, 0,
+	786, 0, 981, 982, 983, 984, 985, 986, 0, 988,
+	989, 990, 992, 993, 994, 995, 996, 997, 998, 0,
+	380, 377, 378, 0, 374, 0, 373, 0, 0, 0,
+	1290, 0, 1260, 1294, 1359, 1360, 1361, 1270, 1271, 0,
+	1273, 1274, 0, 1276, 1277, 0, 1296, 0, 0, 74,
+	1807, 661, 1681, 1682, 0, 1658, 0, 1181, 1451, 1384,
+	1453, 1457, 1452, 1460, 1465, 1608, 1611, 1592, 660, 1596,
+	1597, 1601, 1603, 1604, 1606, 1612, 1613, 1493, 1497, 1494,
+	1496, 1498, 0, 0, 0, 0, 1505, 0, 0, 0,
+	0, 0, 0, 1624, 0, 0, 1526, 1195, 1194, 1478,
+	1473, 1474, 1480, 0, 1470, 1471, 80, 0, 1720, 1701,
+	1722, 1724, 0, 0, 0, 0, -2, 2098, 2063, 2085,
+	0, 1661, 0, 0, 0, 0, 0, 1669, 86, 0,
+	925, 914, 0, 0, 0, 924, 846, 847, 848, 849,
+	0, 786, 0, 0, 853, 854, 855, 890, 891, 857,
+	858, 859, 0, 0, 862, 863, 864, 865, 866, 867,
+	868, 869, 870, 871, 872, 873, 874, 900, 901, 902,
+	903, 904, 905, 876, 877, 878, 879, 880, 881, 882,
+	883, 884, 0, 0, 774, 0, 1666, 1666, 805, 805,
+	0, 0, 0, 0, 662, 663, 653, 654, 0, 658,
+	636, 0, 0, 0, 0, 115, 135, 0, 114, 302,
+	303, 0, 0, 0, 308, 0, 0, 0, 0, 0,
+	296, 298, 299, 300, 373, 179, 162, 163, 0, 0,
+	0, 0, 154, 155, 0, 0, 0, 0, 0, 150,
+	151, 152, 349, 0, 0, 352, 0, 0, 137, 138,
+	0, 140, 136, 752, 765, 753, 0, 0, 1085, 1807,
+	1346, 280, 287, 280, 0, 190, 750, 751, 755, 766,
+	0, 0, 0, 0, 0, 949, 1001, 0, 547, 547,
+	0, 805, 805, 970, 0, 1768, 1769, 0, 0, 965,
+	1000, 0, 0, 0, 980, 0, 906, 0, 1010, 0,
+	0, 1015, 375, 1014, 0, 367, 382, 383, 0, 1302,
+	1292, 0, 0, 0, 1185, 0, 1809, 1803, 1683, 1209,
+	0, 0, 1593, 1594, 0, 0, 0, 0, 0, 1506,
+	1507, 1508, 1542, 1511, 1621, 0, 0, 1661, 0, 1481,
+	0, 81, 0, 1725, -2, 0, 1708, 0, 98, 1669,
+	1729, 1730, 1731, 1732, 1733, 85, 772, 927, 0, 0,
+	0, 0, 0, 0, 0, 850, 0, 856, 851, 860,
+	861, 887, 888, 0, 773, 777, 0, 786, 0, 786,
+	786, 784, 785, 809, 810, 811, 791, 794, 0, 544,
+	0, 0, 657, 643, 646, 0, -2, 0, 0, 115,
+	304, 305, 306, 307, 309, 310, 0, 0, 0, 376,
+	129, 0, 0, 164, 0, 0, 0, 0, 174, 175,
+	176, 177, 178, 156, 157, 158, 159, 160, 147, 0,
+	0, 351, 0, 356, 357, 0, 134, 768, 0, 1287,
+	1809, 182, 0, 280, 184, 288, 767, 762, 764, -2,
+	948, 1002, 536, 537, 0, 1778, 0, 952, 954, 953,
+	955, 964, 966, 0, 973, 978, 0, 987, 0, 113,
+	381, 379, 376, 365, 369, 0, 0, 0, 0, 1324,
+	0, 2512, 72, 1811, -2, 0, 63, 0, 1659, 1660,
+	1654, 1655, 1657, 0, 1500, 1502, 1501, 1503, 0, 0,
+	0, 1524, 1525, 1199, 0, 0, 1723, 1702, 0, 1706,
+	0, 84, 912, 0, 926, 0, 0, 0, 0, 0,
+	0, 0, 852, 889, 778, 779, 0, 781, 0, 0,
+	0, 559, 545, 546, 0, 562, 775, 121, 0, -2,
+	0, 75, 311, 312, 313, 314, 380, 180, 181, 165,
+	166, 0, 168, 169, 0, 0, 0, 359, 0, 355,
+	0, 770, 1038, 1765, 281, 183, 941, 0, 0, 0,
+	0, 972, 786, 907, 0, 1006, 0, 75, 380, 0,
+	371, 0, 1272, 1275, 1278, 0, 0, 0, 0, 0,
+	0, 1803, 0, 1805, 0, 1802, 0, 1210, 1211, 0,
+	0, 1614, 1509, 0, 0, 0, 0, 0, 0, 1196,
+	0, 1705, 0, 0, 923, 915, 0, 917, 0, 0,
+	0, 0, 0, 780, 782, 783, 0, 544, 120, 776,
+	122, 0, 123, 0, 389, 113, 485, 486, 487, 488,
+	489, 490, 491, 492, 493, 494, 495, 496, 497, 498,
+	499, 500, 501, 502, 503, 504, 505, 506, 507, 508,
+	509, 510, 511, 512, 513, 514, 515, 516, 517, 518,
+	519, 520, 521, 522, 523, 524, 525, 526, -2, 0,
+	0, 0, 0, 0, 0, 0, 75, 0, 75, 0,
+	0, 0, 434, 0, 75, -2, 0, 0, 170, 0,
+	172, 173, 358, 0, 139, 1289, 0, 0, 942, 775,
+	0, 0, 0, 1005, 0, 0, 113, 1011, 0, 366,
+	0, 367, 1307, 1325, 1326, 0, 0, 1327, 0, 0,
+	1810, 0, 1808, 0, 0, 1804, 0, 0, 1656, 1543,
+	0, 1546, 1192, 1200, 0, 1203, 0, 0, 0, 1235,
+	0, 1201, 1721, -2, 0, 929, 916, 918, 919, 920,
+	921, 0, 0, 560, 0, 0, 0, 124, 113, 0,
+	0, 128, 0, 0, 404, 0, 0, 1780, 0, 0,
+	-2, -2, -2, 1782, 431, 432, 0, 483, 0, 0,
+	0, 448, 449, 0, 435, 0, 450, 315, 0, 113,
+	167, 171, 360, 41, 0, 0, 957, 0, 775, 979,
+	1007, 0, 1012, 113, 0, 367, 370, 0, 1330, 0,
+	0, 0, 1812, 0, 0, 1212, 1213, 1544, 0, 1204,
+	1205, 1206, 1207, 1208, 0, 913, 928, 0, 0, 0,
+	321, 0, 0, 115, 390, 391, 0, 0, 75, 405,
+	0, 75, 0, 0, 0, 660, 0, 75, 75, 75,
+	75, 0, 75, 75, 0, 436, 75, 75, 1766, 1767,
+	282, 283, 284, 286, 775, 975, 0, 75, 368, 372,
+	1323, 1331, 1332, 0, 0, 0, 1339, 0, 0, 1806,
+	1800, 0, 0, 1236, 930, 0, 0, 322, 323, 75,
+	0, 75, 402, 0, 75, 0, 0, 0, 0, 666,
+	1783, 0, 484, 316, 0, 0, 0, 0, 0, 0,
+	0, 318, 113, 0, 958, 0, 113, 1333, 0, 0,
+	1334, 0, 0, 0, 0, 0, 1328, 0, 0, 1202,
+	922, 815, 113, 392, 393, 394, 395, 396, 397, 75,
+	0, 400, 0, 75, 0, 75, 414, 415, 416, 75,
+	423, 425, 426, 427, 0, 429, 430, 418, 439, 317,
+	75, 75, 75, 75, 0, 75, 433, 437, 131, 285,
+	0, 1013, 0, 0, 0, 0, 1335, 1336, 1340, 1342,
+	0, 1329, 1545, 816, 817, 0, 127, 0, 399, 76,
+	75, 0, -2, 0, 75, 0, 411, 0, 417, 0,
+	428, 0, 319, 0, 0, 0, 0, 0, 0, 0,
+	2246, 0, 0, 0, 0, 1341, 1343, 818, 0, 819,
+	0, 0, 0, 75, 0, 403, 407, 0, 0, 75,
+	412, 0, 424, 419, 320, 440, 0, 75, 442, 445,
+	438, 1008, 1009, 0, 0, 833, 835, 836, 0, 0,
+	834, 398, 401, 75, 409, 0, 75, 441, 0, 0,
+	1337, 1338, 837, 838, 839, 0, 75, 0, 443, 446,
+	408, 0, -2, 444, 447, 410,
+}
+
+var yyTok1 = [...]int16{
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 104, 3, 3, 3, 140, 132, 3,
+	76, 78, 137, 135, 77, 136, 190, 138, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 80, 731,
+	113, 112, 114, 3, 79, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 142, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 131, 3, 143,
+}
+
+var yyTok2 = [...]int16{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
+	72, 73, 74, 75, 81, 82, 83, 84, 85, 86,
+	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 105, 106, 107,
+	108, 109, 110, 111, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	133, 134, 139, 141, 144, 145, 146, 147, 148, 149,
+	150, 151, 152, 153, 154, 155, 156, 157, 158, 159,
+	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 239, 240,
+	241, 242, 243, 244, 245, 246, 247, 248, 249, 250,
+	251, 252, 253, 254, 255, 256, 257, 258, 259, 260,
+	261, 262, 263, 264, 265, 266, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276,
+}
+
+var yyTok3 = [...]uint16{
+	57600, 277, 57601, 278, 57602, 279, 57603, 280, 57604, 281,
+	57605, 282, 57606, 283, 57607, 284, 57608, 285, 57609, 286,
+	57610, 287, 57611, 288, 57612, 289, 57613, 290, 57614, 291,
+	57615, 292, 57616, 293, 57617, 294, 57618, 295, 57619, 296,
+	57620, 297, 57621, 298, 57622, 299, 57623, 300, 57624, 301,
+	57625, 302, 57626, 303, 57627, 304, 57628, 305, 57629, 306,
+	57630, 307, 57631, 308, 57632, 309, 57633, 310, 57634, 311,
+	57635, 312, 57636, 313, 57637, 314, 57638, 315, 57639, 316,
+	57640, 317, 57641, 318, 57642, 319, 57643, 320, 57644, 321,
+	57645, 322, 57646, 323, 57647, 324, 57648, 325, 57649, 326,
+	57650, 327, 57651, 328, 57652, 329, 57653, 330, 57654, 331,
+	57655, 332, 57656, 333, 57657, 334, 57658, 335, 57659, 336,
+	57660, 337, 57661, 338, 57662, 339, 57663, 340, 57664, 341,
+	57665, 342, 57666, 343, 57667, 344, 57668, 345, 57669, 346,
+	57670, 347, 57671, 348, 57672, 349, 57673, 350, 57674, 351,
+	57675, 352, 57676, 353, 57677, 354, 57678, 355, 57679, 356,
+	57680, 357, 57681, 358, 57682, 359, 57683, 360, 57684, 361,
+	57685, 362, 57686, 363, 57687, 364, 57688, 365, 57689, 366,
+	57690, 367, 57691, 368, 57692, 369, 57693, 370, 57694, 371,
+	57695, 372, 57696, 373, 57697, 374, 57698, 375, 57699, 376,
+	57700, 377, 57701, 378, 57702, 379, 57703, 380, 57704, 381,
+	57705, 382, 57706, 383, 57707, 384, 57708, 385, 57709, 386,
+	57710, 387, 57711, 388, 57712, 389, 57713, 390, 57714, 391,
+	57715, 392, 57716, 393, 57717, 394, 57718, 395, 57719, 396,
+	57720, 397, 57721, 398, 57722, 399, 57723, 400, 57724, 401,
+	57725, 402, 57726, 403, 57727, 404, 57728, 405, 57729, 406,
+	57730, 407, 57731, 408, 57732, 409, 57733, 410, 57734, 411,
+	57735, 412, 57736, 413, 57737, 414, 57738, 415, 57739, 416,
+	57740, 417, 57741, 418, 57742, 419, 57743, 420, 57744, 421,
+	57745, 422, 57746, 423, 57747, 424, 57748, 425, 57749, 426,
+	57750, 427, 57751, 428, 57752, 429, 57753, 430, 57754, 431,
+	57755, 432, 57756, 433, 57757, 434, 57758, 435, 57759, 436,
+	57760, 437, 57761, 438, 57762, 439, 57763, 440, 57764, 441,
+	57765, 442, 57766, 443, 57767, 444, 57768, 445, 57769, 446,
+	57770, 447, 57771, 448, 57772, 449, 57773, 450, 57774, 451,
+	57775, 452, 57776, 453, 57777, 454, 57778, 455, 57779, 456,
+	57780, 457, 57781, 458, 57782, 459, 57783, 460, 57784, 461,
+	57785, 462, 57786, 463, 57787, 464, 57788, 465, 57789, 466,
+	57790, 467, 57791, 468, 57792, 469, 57793, 470, 57794, 471,
+	57795, 472, 57796, 473, 57797, 474, 57798, 475, 57799, 476,
+	57800, 477, 57801, 478, 57802, 479, 57803, 480, 57804, 481,
+	57805, 482, 57806, 483, 57807, 484, 57808, 485, 57809, 486,
+	57810, 487, 57811, 488, 57812, 489, 57813, 490, 57814, 491,
+	57815, 492, 57816, 493, 57817, 494, 57818, 495, 57819, 496,
+	57820, 497, 57821, 498, 57822, 499, 57823, 500, 57824, 501,
+	57825, 502, 57826, 503, 57827, 504, 57828, 505, 57829, 506,
+	57830, 507, 57831, 508, 57832, 509, 57833, 510, 57834, 511,
+	57835, 512, 57836, 513, 57837, 514, 57838, 515, 57839, 516,
+	57840, 517, 57841, 518, 57842, 519, 57843, 520, 57844, 521,
+	57845, 522, 57846, 523, 57847, 524, 57848, 525, 57849, 526,
+	57850, 527, 57851, 528, 57852, 529, 57853, 530, 57854, 531,
+	57855, 532, 57856, 533, 57857, 534, 57858, 535, 57859, 536,
+	57860, 537, 57861, 538, 57862, 539, 57863, 540, 57864, 541,
+	57865, 542, 57866, 543, 57867, 544, 57868, 545, 57869, 546,
+	57870, 547, 57871, 548, 57872, 549, 57873, 550, 57874, 551,
+	57875, 552, 57876, 553, 57877, 554, 57878, 555, 57879, 556,
+	57880, 557, 57881, 558, 57882, 559, 57883, 560, 57884, 561,
+	57885, 562, 57886, 563, 57887, 564, 57888, 565, 57889, 566,
+	57890, 567, 57891, 568, 57892, 569, 57893, 570, 57894, 571,
+	57895, 572, 57896, 573, 57897, 574, 57898, 575, 57899, 576,
+	57900, 577, 57901, 578, 57902, 579, 57903, 580, 57904, 581,
+	57905, 582, 57906, 583, 57907, 584, 57908, 585, 57909, 586,
+	57910, 587, 57911, 588, 57912, 589, 57913, 590, 57914, 591,
+	57915, 592, 57916, 593, 57917, 594, 57918, 595, 57919, 596,
+	57920, 597, 57921, 598, 57922, 599, 57923, 600, 57924, 601,
+	57925, 602, 57926, 603, 57927, 604, 57928, 605, 57929, 606,
+	57930, 607, 57931, 608, 57932, 609, 57933, 610, 57934, 611,
+	57935, 612, 57936, 613, 57937, 614, 57938, 615, 57939, 616,
+	57940, 617, 57941, 618, 57942, 619, 57943, 620, 57944, 621,
+	57945, 622, 57946, 623, 57947, 624, 57948, 625, 57949, 626,
+	57950, 627, 57951, 628, 57952, 629, 57953, 630, 57954, 631,
+	57955, 632, 57956, 633, 57957, 634, 57958, 635, 57959, 636,
+	57960, 637, 57961, 638, 57962, 639, 57963, 640, 57964, 641,
+	57965, 642, 57966, 643, 57967, 644, 57968, 645, 57969, 646,
+	57970, 647, 57971, 648, 57972, 649, 57973, 650, 57974, 651,
+	57975, 652, 57976, 653, 57977, 654, 57978, 655, 57979, 656,
+	57980, 657, 57981, 658, 57982, 659, 57983, 660, 57984, 661,
+	57985, 662, 57986, 663, 57987, 664, 57988, 665, 57989, 666,
+	57990, 667, 57991, 668, 57992, 669, 57993, 670, 57994, 671,
+	57995, 672, 57996, 673, 57997, 674, 57998, 675, 57999, 676,
+	58000, 677, 58001, 678, 58002, 679, 58003, 680, 58004, 681,
+	58005, 682, 58006, 683, 58007, 684, 58008, 685, 58009, 686,
+	58010, 687, 58011, 688, 58012, 689, 58013, 690, 58014, 691,
+	58015, 692, 58016, 693, 58017, 694, 58018, 695, 58019, 696,
+	58020, 697, 58021, 698, 58022, 699, 58023, 700, 58024, 701,
+	58025, 702, 58026, 703, 58027, 704, 58028, 705, 58029, 706,
+	58030, 707, 58031, 708, 58032, 709, 58033, 710, 58034, 711,
+	58035, 712, 58036, 713, 58037, 714, 58038, 715, 58039, 716,
+	58040, 717, 58041, 718, 58042, 719, 58043, 720, 58044, 721,
+	58045, 722, 58046, 723, 58047, 724, 58048, 725, 58049, 726,
+	58050, 727, 58051, 728, 58052, 729, 58053, 730, 0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := int(yyPact[state])
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := int(yyExca[i])
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = int(yyTok1[0])
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = int(yyTok1[char])
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = int(yyTok2[char-yyPrivate])
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = int(yyTok3[i+0])
+		if token == char {
+			token = int(yyTok3[i+1])
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = int(yyTok2[1]) /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = int(yyPact[yystate])
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = int(yyDef[yystate])
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = int(yyExca[xi+0])
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = int(yyExca[xi+1])
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= int(yyR2[yyn])
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = int(yyAct[yyg])
+	} else {
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 1:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:657
+		{
+			setParseTree(yylex, yyDollar[1].statement)
+		}
+	case 2:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:661
+		{
+			setParseTree(yylex, yyDollar[1].statement)
+			statementSeen(yylex)
+		}
+	case 3:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:668
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 40:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:708
+		{
+			setParseTree(yylex, nil)
+		}
+	case 41:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:714
+		{
+			yyVAL.statement = &Load{Local: yyDollar[3].boolVal, Infile: yyDollar[4].str, IgnoreOrReplace: yyDollar[5].str, Table: yyDollar[6].tableName, Partition: yyDollar[7].partitions, Charset: yyDollar[8].str, Fields: yyDollar[9].Fields, Lines: yyDollar[10].Lines, IgnoreNum: yyDollar[11].sqlVal, Columns: yyDollar[12].columns}
+		}
+	case 42:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:720
+		{
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			if err := yyDollar[1].selStmt.SetInto(yyDollar[5].into); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 43:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:731
+		{
+			yyVAL.selStmt = &Select{
+				Comments:    Comments(yyDollar[2].bytes2),
+				QueryOpts:   yyDollar[3].queryOpts,
+				SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}},
+				From:        TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}},
+			}
+		}
+	case 44:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:742
+		{
+			if yyDollar[1].selStmt.GetInto() != nil {
+				yylex.Error(fmt.Errorf("INTO clause is not allowed").Error())
+				return 1
+			}
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 45:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:752
+		{
+			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
+		}
+	case 46:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:760
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 47:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:764
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 48:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:771
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 49:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:775
+		{
+			yyVAL.selStmt = &SetOp{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 50:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:779
+		{
+			yyVAL.selStmt = &SetOp{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 51:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:788
+		{
+			yyVAL.selStmt = &SetOp{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 52:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:792
+		{
+			yyVAL.selStmt = &SetOp{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 53:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:799
+		{
+			if yyDollar[1].selStmt.GetInto() != nil {
+				yylex.Error(fmt.Errorf("INTO clause is not allowed").Error())
+				return 1
+			}
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 54:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:807
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 55:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:815
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 56:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:819
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 57:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:827
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 58:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:831
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:837
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 60:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:841
+		{
+			yyDollar[3].selStmt.SetWith(yyDollar[2].with)
+			yyVAL.selStmt = yyDollar[3].selStmt
+		}
+	case 61:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:848
+		{
+			yyVAL.with = &With{Ctes: yyDollar[2].tableExprs, Recursive: true}
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:851
+		{
+			yyVAL.with = &With{Ctes: yyDollar[1].tableExprs, Recursive: false}
+		}
+	case 63:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:857
+		{
+			yyVAL.selStmt = &Select{
+				Comments:    Comments(yyDollar[2].bytes2),
+				QueryOpts:   yyDollar[3].queryOpts,
+				SelectExprs: yyDollar[4].selectExprs,
+				Into:        yyDollar[5].into,
+				From:        yyDollar[6].tableExprs,
+				Where:       NewWhere(WhereStr, yyDollar[7].expr),
+				GroupBy:     GroupBy(yyDollar[8].exprs),
+				Having:      NewWhere(HavingStr, yyDollar[9].expr),
+				Window:      yyDollar[10].window,
+			}
+		}
+	case 64:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:871
+		{
+			yyVAL.selStmt = &Select{SelectExprs: SelectExprs{&StarExpr{}}, From: TableExprs{yyDollar[2].tableExpr}}
+		}
+	case 65:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:875
+		{
+			yyVAL.selStmt = yyDollar[1].simpleTableExpr.(*ValuesStatement)
+		}
+	case 66:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:880
+		{
+			yyVAL.tableExprs = nil
+		}
+	case 67:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:884
+		{
+			yyVAL.tableExprs = nil
+		}
+	case 68:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:888
+		{
+			yyVAL.tableExprs = yyDollar[2].tableExprs
+		}
+	case 69:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:897
+		{
+			yyVAL.into = nil
+		}
+	case 70:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:901
+		{
+			yyVAL.into = &Into{Variables: yyDollar[2].variables}
+		}
+	case 71:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:905
+		{
+			yyVAL.into = &Into{Dumpfile: string(yyDollar[3].bytes)}
+		}
+	case 72:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:909
+		{
+			yyVAL.into = &Into{Outfile: string(yyDollar[3].bytes), Charset: yyDollar[4].str, Fields: yyDollar[5].Fields, Lines: yyDollar[6].Lines}
+		}
+	case 73:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:915
+		{
+			yyVAL.variables = Variables{yyDollar[1].colIdent}
+		}
+	case 74:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:919
+		{
+			yyVAL.variables = append(yyVAL.variables, yyDollar[3].colIdent)
+		}
+	case 75:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:924
+		{
+			yyVAL.with = nil
+		}
+	case 76:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:928
+		{
+			yyVAL.with = yyDollar[2].with
+		}
+	case 77:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:934
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 78:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:938
+		{
+			yyVAL.tableExprs = append(yyDollar[1].tableExprs, yyDollar[3].tableExpr)
+		}
+	case 79:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:944
+		{
+			yyVAL.tableExpr = &CommonTableExpr{&AliasedTableExpr{Expr: yyDollar[4].simpleTableExpr, As: yyDollar[1].tableIdent}, yyDollar[2].columns}
+		}
+	case 80:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:950
+		{
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[7].ins
+			ins.Action = yyDollar[2].str
+			ins.Comments = yyDollar[3].bytes2
+			ins.Ignore = yyDollar[4].str
+			ins.Table = yyDollar[5].tableName
+			ins.Partitions = yyDollar[6].partitions
+			ins.OnDup = OnDup(yyDollar[8].assignExprs)
+			ins.With = yyDollar[1].with
+			yyVAL.statement = ins
+		}
+	case 81:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:963
+		{
+			cols := make(Columns, 0, len(yyDollar[8].assignExprs))
+			vals := make(ValTuple, 0, len(yyDollar[9].assignExprs))
+			for _, updateList := range yyDollar[8].assignExprs {
+				cols = append(cols, updateList.Name.Name)
+				vals = append(vals, updateList.Expr)
+			}
+			yyVAL.statement = &Insert{Action: yyDollar[2].str, Comments: Comments(yyDollar[3].bytes2), Ignore: yyDollar[4].str, Table: yyDollar[5].tableName, Partitions: yyDollar[6].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[9].assignExprs), With: yyDollar[1].with}
+		}
+	case 82:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:975
+		{
+			yyVAL.str = InsertStr
+		}
+	case 83:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:979
+		{
+			yyVAL.str = ReplaceStr
+		}
+	case 84:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:985
+		{
+			yyVAL.statement = &Update{Comments: Comments(yyDollar[3].bytes2), Ignore: yyDollar[4].str, TableExprs: yyDollar[5].tableExprs, Exprs: yyDollar[7].assignExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), OrderBy: yyDollar[9].orderBy, Limit: yyDollar[10].limit, With: yyDollar[1].with}
+		}
+	case 85:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:991
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[3].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitions, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit, With: yyDollar[1].with}
+		}
+	case 86:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:995
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[3].bytes2), Targets: yyDollar[5].tableNames, TableExprs: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), With: yyDollar[1].with}
+		}
+	case 87:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:999
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[3].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), With: yyDollar[1].with}
+		}
+	case 88:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1003
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[3].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), With: yyDollar[1].with}
+		}
+	case 89:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1008
+		{
+		}
+	case 90:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1009
+		{
+		}
+	case 91:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1013
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
+		}
+	case 92:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1017
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
+		}
+	case 93:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1023
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 94:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1027
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 95:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1033
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 96:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1037
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 97:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1042
+		{
+			yyVAL.partitions = nil
+		}
+	case 98:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1046
+		{
+			yyVAL.partitions = yyDollar[3].partitions
+		}
+	case 99:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1052
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setVarExprs}
+		}
+	case 100:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1056
+		{
+			yyVAL.statement = &SetResourceGroup{Name: yyDollar[5].colIdent.String()}
+		}
+	case 101:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1060
+		{
+			yyVAL.statement = &SetResourceGroup{Name: yyDollar[5].colIdent.String(), ThreadIDs: yyDollar[7].uint32s}
+		}
+	case 102:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1064
+		{
+			for i := 0; i < len(yyDollar[4].setVarExprs); i++ {
+				yyDollar[4].setVarExprs[i].Scope = SetScope_None
+			}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setVarExprs}
+		}
+	case 103:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1071
+		{
+			for i := 0; i < len(yyDollar[5].setVarExprs); i++ {
+				yyDollar[5].setVarExprs[i].Scope = yyDollar[3].setScope
+			}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[5].setVarExprs}
+		}
+	case 104:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1080
+		{
+			yyVAL.setVarExprs = SetVarExprs{yyDollar[1].setVarExpr}
+		}
+	case 105:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1084
+		{
+			yyVAL.setVarExprs = append(yyVAL.setVarExprs, yyDollar[3].setVarExpr)
+		}
+	case 106:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1090
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
+		}
+	case 107:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1094
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
+		}
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1098
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
+		}
+	case 109:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1104
+		{
+			yyVAL.str = IsolationLevelRepeatableRead
+		}
+	case 110:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1108
+		{
+			yyVAL.str = IsolationLevelReadCommitted
+		}
+	case 111:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1112
+		{
+			yyVAL.str = IsolationLevelReadUncommitted
+		}
+	case 112:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1116
+		{
+			yyVAL.str = IsolationLevelSerializable
+		}
+	case 113:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1121
+		{
+			yyVAL.int = yyPosition(yylex)
+		}
+	case 114:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1126
+		{
+			yyVAL.int = yyOldPosition(yylex)
+		}
+	case 115:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1131
+		{
+			yyVAL.boolean = yySpecialCommentMode(yylex)
+		}
+	case 116:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1137
+		{
+			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
+			if len(yyDollar[1].ddl.TableSpec.Constraints) > 0 {
+				yyDollar[1].ddl.ConstraintAction = AddStr
+			}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 117:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1146
+		{
+			yyDollar[1].ddl.OptSelect = &OptSelect{Select: yyDollar[3].selStmt}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 118:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1155
+		{
+			yyDollar[1].ddl.OptSelect = &OptSelect{Select: yyDollar[2].selStmt}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 119:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1160
+		{
+			yyDollar[1].ddl.OptLike = &OptLike{LikeTable: yyDollar[3].tableName}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 120:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:1165
+		{
+			// For consistency, we always return AlterTable for any ALTER TABLE-equivalent statements
+			ddl := &DDL{Action: AlterStr, Table: yyDollar[7].tableName, IndexSpec: &IndexSpec{Action: CreateStr, ToName: yyDollar[4].colIdent, Using: yyDollar[5].colIdent, Type: yyDollar[2].str, Columns: yyDollar[9].indexColumns, Options: yyDollar[11].indexOptions}}
+			yyVAL.statement = &AlterTable{Table: yyDollar[7].tableName, Statements: []*DDL{ddl}}
+		}
+	case 121:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:1171
+		{
+			yyDollar[2].viewSpec.ViewName = yyDollar[4].tableName.ToViewName()
+			yyDollar[2].viewSpec.ViewExpr = yyDollar[9].selStmt
+			yyDollar[2].viewSpec.Columns = yyDollar[5].columns
+			yyVAL.statement = &DDL{Action: CreateStr, ViewSpec: yyDollar[2].viewSpec, SpecialCommentMode: yyDollar[8].boolean, SubStatementPositionStart: yyDollar[7].int, SubStatementPositionEnd: yyDollar[10].int - 1}
+		}
+	case 122:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:1178
+		{
+			yyDollar[2].viewSpec.ViewName = yyDollar[4].tableName.ToViewName()
+			yyDollar[2].viewSpec.ViewExpr = yyDollar[9].selStmt
+			yyDollar[2].viewSpec.Columns = yyDollar[5].columns
+			yyDollar[2].viewSpec.CheckOption = yyDollar[11].str
+			yyVAL.statement = &DDL{Action: CreateStr, ViewSpec: yyDollar[2].viewSpec, SpecialCommentMode: yyDollar[8].boolean, SubStatementPositionStart: yyDollar[7].int, SubStatementPositionEnd: yyDollar[10].int - 1}
+		}
+	case 123:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:1186
+		{
+			yyDollar[4].viewSpec.ViewName = yyDollar[6].tableName.ToViewName()
+			yyDollar[4].viewSpec.ViewExpr = yyDollar[10].selStmt
+			yyVAL.statement = &DDL{Action: CreateStr, ViewSpec: yyDollar[4].viewSpec, SpecialCommentMode: yyDollar[9].boolean, SubStatementPositionStart: yyDollar[8].int, SubStatementPositionEnd: yyDollar[11].int - 1, OrReplace: true}
+		}
+	case 124:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:1192
+		{
+			yyDollar[4].viewSpec.ViewName = yyDollar[6].tableName.ToViewName()
+			yyDollar[4].viewSpec.ViewExpr = yyDollar[10].selStmt
+			yyDollar[4].viewSpec.CheckOption = yyDollar[12].str
+			yyVAL.statement = &DDL{Action: CreateStr, ViewSpec: yyDollar[4].viewSpec, SpecialCommentMode: yyDollar[9].boolean, SubStatementPositionStart: yyDollar[8].int, SubStatementPositionEnd: yyDollar[11].int - 1, OrReplace: true}
+		}
+	case 125:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1199
+		{
+			var ne bool
+			if yyDollar[3].byt != 0 {
+				ne = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), IfNotExists: ne, CharsetCollate: yyDollar[5].charsetCollates}
+		}
+	case 126:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1207
+		{
+			var ne bool
+			if yyDollar[3].byt != 0 {
+				ne = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), IfNotExists: ne, CharsetCollate: yyDollar[5].charsetCollates}
+		}
+	case 127:
+		yyDollar = yyS[yypt-16 : yypt+1]
+//line sql.y:1215
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[8].tableName, TriggerSpec: &TriggerSpec{TrigName: yyDollar[4].triggerName, Definer: yyDollar[2].str, Time: yyDollar[5].str, Event: yyDollar[6].str, Order: yyDollar[12].triggerOrder, Body: yyDollar[15].statement}, SpecialCommentMode: yyDollar[14].boolean, SubStatementPositionStart: yyDollar[13].int, SubStatementPositionEnd: yyDollar[16].int - 1}
+		}
+	case 128:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:1219
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, ProcedureSpec: &ProcedureSpec{ProcName: yyDollar[4].procedureName, Definer: yyDollar[2].str, Params: yyDollar[6].procedureParams, Characteristics: yyDollar[8].characteristics, Body: yyDollar[11].statement}, SpecialCommentMode: yyDollar[10].boolean, SubStatementPositionStart: yyDollar[9].int, SubStatementPositionEnd: yyDollar[12].int - 1}
+		}
+	case 129:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1223
+		{
+			var notExists bool
+			if yyDollar[3].byt != 0 {
+				notExists = true
+			}
+			tlsOptions, err := NewTLSOptions(yyDollar[6].tlsOptionItems)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			accountLimits, err := NewAccountLimits(yyDollar[7].accountLimitItems)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			passwordOptions, locked := NewPasswordOptionsWithLock(yyDollar[8].passLockItems)
+			yyVAL.statement = &CreateUser{IfNotExists: notExists, Users: yyDollar[4].accountsWithAuth, DefaultRoles: yyDollar[5].accountNames, TLSOptions: tlsOptions, AccountLimits: accountLimits, PasswordOptions: passwordOptions, Locked: locked, Attribute: yyDollar[9].str}
+		}
+	case 130:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1242
+		{
+			var notExists bool
+			if yyDollar[3].byt != 0 {
+				notExists = true
+			}
+			yyVAL.statement = &CreateRole{IfNotExists: notExists, Roles: yyDollar[4].accountNames}
+		}
+	case 131:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:1250
+		{
+			var notExists bool
+			if yyDollar[4].byt != 0 {
+				notExists = true
+			}
+			yyVAL.statement = &DDL{Action: CreateStr, EventSpec: &EventSpec{EventName: yyDollar[5].eventName, Definer: yyDollar[2].str, IfNotExists: notExists, OnSchedule: yyDollar[8].eventScheduleSpec, OnCompletionPreserve: yyDollar[9].eventOnCompletion, Status: yyDollar[10].eventStatus, Comment: yyDollar[11].sqlVal, Body: yyDollar[14].statement}, SubStatementPositionStart: yyDollar[13].int, SubStatementPositionEnd: yyDollar[15].int - 1}
+		}
+	case 132:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1258
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 133:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1264
+		{
+			yyVAL.statement = &CreateSpatialRefSys{SRID: NewIntVal(yyDollar[5].bytes), SrsAttr: yyDollar[6].srsAttr}
+		}
+	case 134:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1268
+		{
+			yyVAL.statement = &CreateSpatialRefSys{SRID: NewIntVal(yyDollar[8].bytes), IfNotExists: true, SrsAttr: yyDollar[9].srsAttr}
+		}
+	case 135:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1272
+		{
+			yyVAL.statement = &CreateSpatialRefSys{SRID: NewIntVal(yyDollar[7].bytes), OrReplace: true, SrsAttr: yyDollar[8].srsAttr}
+		}
+	case 136:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1277
+		{
+			yyVAL.srsAttr = &SrsAttribute{}
+		}
+	case 137:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1281
+		{
+			if yyDollar[1].srsAttr.Name != "" {
+				yylex.Error("multiple definitions of attribute name")
+				return 1
+			}
+			yyDollar[1].srsAttr.Name = string(yyDollar[3].bytes)
+			yyVAL.srsAttr = yyDollar[1].srsAttr
+		}
+	case 138:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1290
+		{
+			if yyDollar[1].srsAttr.Definition != "" {
+				yylex.Error("multiple definitions of attribute definition")
+				return 1
+			}
+			yyDollar[1].srsAttr.Definition = string(yyDollar[3].bytes)
+			yyVAL.srsAttr = yyDollar[1].srsAttr
+		}
+	case 139:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1299
+		{
+			if yyDollar[1].srsAttr.Organization != "" {
+				yylex.Error("multiple definitions of attribute organization")
+				return 1
+			}
+			yyDollar[1].srsAttr.Organization = string(yyDollar[3].bytes)
+			yyDollar[1].srsAttr.OrgID = NewIntVal(yyDollar[6].bytes)
+			yyVAL.srsAttr = yyDollar[1].srsAttr
+		}
+	case 140:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1309
+		{
+			if yyDollar[1].srsAttr.Description != "" {
+				yylex.Error("multiple definitions of attribute description")
+				return 1
+			}
+			yyDollar[1].srsAttr.Description = string(yyDollar[3].bytes)
+			yyVAL.srsAttr = yyDollar[1].srsAttr
+		}
+	case 141:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1319
+		{
+			yyVAL.accountNames = nil
+		}
+	case 142:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1323
+		{
+			yyVAL.accountNames = yyDollar[3].accountNames
+		}
+	case 143:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1328
+		{
+			yyVAL.tlsOptionItems = nil
+		}
+	case 144:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1332
+		{
+			yyVAL.tlsOptionItems = nil
+		}
+	case 145:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1336
+		{
+			yyVAL.tlsOptionItems = yyDollar[2].tlsOptionItems
+		}
+	case 146:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1342
+		{
+			yyVAL.tlsOptionItems = []TLSOptionItem{yyDollar[1].tlsOptionItem}
+		}
+	case 147:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1346
+		{
+			yyVAL.tlsOptionItems = append(yyDollar[1].tlsOptionItems, yyDollar[3].tlsOptionItem)
+		}
+	case 148:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1352
+		{
+			yyVAL.tlsOptionItem = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_SSL, ItemData: ""}
+		}
+	case 149:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1356
+		{
+			yyVAL.tlsOptionItem = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_X509, ItemData: ""}
+		}
+	case 150:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1360
+		{
+			yyVAL.tlsOptionItem = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_Cipher, ItemData: string(yyDollar[2].bytes)}
+		}
+	case 151:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1364
+		{
+			yyVAL.tlsOptionItem = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_Issuer, ItemData: string(yyDollar[2].bytes)}
+		}
+	case 152:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1368
+		{
+			yyVAL.tlsOptionItem = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_Subject, ItemData: string(yyDollar[2].bytes)}
+		}
+	case 153:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1373
+		{
+			yyVAL.accountLimitItems = nil
+		}
+	case 154:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1377
+		{
+			yyVAL.accountLimitItems = yyDollar[2].accountLimitItems
+		}
+	case 155:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1383
+		{
+			yyVAL.accountLimitItems = []AccountLimitItem{yyDollar[1].accountLimitItem}
+		}
+	case 156:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1387
+		{
+			yyVAL.accountLimitItems = append(yyDollar[1].accountLimitItems, yyDollar[2].accountLimitItem)
+		}
+	case 157:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1393
+		{
+			yyVAL.accountLimitItem = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Queries_PH, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 158:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1397
+		{
+			yyVAL.accountLimitItem = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Updates_PH, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 159:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1401
+		{
+			yyVAL.accountLimitItem = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Connections_PH, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 160:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1405
+		{
+			yyVAL.accountLimitItem = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Connections, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 161:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1410
+		{
+			yyVAL.passLockItems = nil
+		}
+	case 162:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1414
+		{
+			yyVAL.passLockItems = yyDollar[1].passLockItems
+		}
+	case 163:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1420
+		{
+			yyVAL.passLockItems = []PassLockItem{yyDollar[1].passLockItem}
+		}
+	case 164:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1424
+		{
+			yyVAL.passLockItems = append(yyDollar[1].passLockItems, yyDollar[2].passLockItem)
+		}
+	case 165:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1430
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassExpireDefault, Value: nil}
+		}
+	case 166:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1434
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassExpireNever, Value: nil}
+		}
+	case 167:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1438
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassExpireInterval, Value: NewIntVal(yyDollar[4].bytes)}
+		}
+	case 168:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1442
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassHistory, Value: nil}
+		}
+	case 169:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1446
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassHistory, Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 170:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1450
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassReuseInterval, Value: nil}
+		}
+	case 171:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1454
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassReuseInterval, Value: NewIntVal(yyDollar[4].bytes)}
+		}
+	case 172:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1458
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassReqCurrentDefault, Value: nil}
+		}
+	case 173:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1462
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassReqCurrentOptional, Value: nil}
+		}
+	case 174:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1466
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassFailedLogins, Value: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 175:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1470
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassLockTime, Value: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 176:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1474
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassLockTime, Value: nil}
+		}
+	case 177:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1478
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_AccountLock, Value: nil}
+		}
+	case 178:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1482
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_AccountUnlock, Value: nil}
+		}
+	case 179:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1487
+		{
+			yyVAL.str = ""
+		}
+	case 180:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1491
+		{
+			comment := string(yyDollar[2].bytes)
+			yyVAL.str = `{"comment": "` + escapeDoubleQuotes(comment) + `"}`
+		}
+	case 181:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1496
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 182:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1502
+		{
+			allPriv := []Privilege{Privilege{Type: PrivilegeType_All, Columns: nil}}
+			yyVAL.statement = &GrantPrivilege{Privileges: allPriv, ObjectType: yyDollar[4].grantObjectType, PrivilegeLevel: yyDollar[5].privilegeLevel, To: yyDollar[7].accountNames, WithGrantOption: yyDollar[8].boolean, As: yyDollar[9].grantAssumption}
+		}
+	case 183:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:1507
+		{
+			allPriv := []Privilege{Privilege{Type: PrivilegeType_All, Columns: nil}}
+			yyVAL.statement = &GrantPrivilege{Privileges: allPriv, ObjectType: yyDollar[5].grantObjectType, PrivilegeLevel: yyDollar[6].privilegeLevel, To: yyDollar[8].accountNames, WithGrantOption: yyDollar[9].boolean, As: yyDollar[10].grantAssumption}
+		}
+	case 184:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1512
+		{
+			yyVAL.statement = &GrantPrivilege{Privileges: yyDollar[2].grantPrivileges, ObjectType: yyDollar[4].grantObjectType, PrivilegeLevel: yyDollar[5].privilegeLevel, To: yyDollar[7].accountNames, WithGrantOption: yyDollar[8].boolean, As: yyDollar[9].grantAssumption}
+		}
+	case 185:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1516
+		{
+			yyVAL.statement = &GrantRole{Roles: yyDollar[2].accountNames, To: yyDollar[4].accountNames, WithAdminOption: yyDollar[5].boolean}
+		}
+	case 186:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1520
+		{
+			yyVAL.statement = &GrantProxy{On: yyDollar[4].accountName, To: yyDollar[6].accountNames, WithGrantOption: yyDollar[7].boolean}
+		}
+	case 187:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1526
+		{
+			allPriv := []Privilege{Privilege{Type: PrivilegeType_All, Columns: nil}}
+			yyVAL.statement = &RevokePrivilege{Privileges: allPriv, ObjectType: yyDollar[4].grantObjectType, PrivilegeLevel: yyDollar[5].privilegeLevel, From: yyDollar[7].accountNames}
+		}
+	case 188:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1531
+		{
+			yyVAL.statement = &RevokePrivilege{Privileges: yyDollar[2].grantPrivileges, ObjectType: yyDollar[4].grantObjectType, PrivilegeLevel: yyDollar[5].privilegeLevel, From: yyDollar[7].accountNames}
+		}
+	case 189:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1535
+		{
+			yyVAL.statement = &RevokeAllPrivileges{From: yyDollar[7].accountNames}
+		}
+	case 190:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1539
+		{
+			yyVAL.statement = &RevokeAllPrivileges{From: yyDollar[8].accountNames}
+		}
+	case 191:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1543
+		{
+			yyVAL.statement = &RevokeRole{Roles: yyDollar[2].accountNames, From: yyDollar[4].accountNames}
+		}
+	case 192:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1547
+		{
+			yyVAL.statement = &RevokeProxy{On: yyDollar[4].accountName, From: yyDollar[6].accountNames}
+		}
+	case 193:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1553
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Alter, Columns: yyDollar[2].strs}
+		}
+	case 194:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1557
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_AlterRoutine, Columns: yyDollar[3].strs}
+		}
+	case 195:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1561
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Create, Columns: yyDollar[2].strs}
+		}
+	case 196:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1565
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_CreateRole, Columns: yyDollar[3].strs}
+		}
+	case 197:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1569
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_CreateRoutine, Columns: yyDollar[3].strs}
+		}
+	case 198:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1573
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_CreateTablespace, Columns: yyDollar[3].strs}
+		}
+	case 199:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1577
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_CreateTemporaryTables, Columns: yyDollar[4].strs}
+		}
+	case 200:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1581
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_CreateUser, Columns: yyDollar[3].strs}
+		}
+	case 201:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1585
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_CreateView, Columns: yyDollar[3].strs}
+		}
+	case 202:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1589
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Delete, Columns: yyDollar[2].strs}
+		}
+	case 203:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1593
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Drop, Columns: yyDollar[2].strs}
+		}
+	case 204:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1597
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_DropRole, Columns: yyDollar[3].strs}
+		}
+	case 205:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1601
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Event, Columns: yyDollar[2].strs}
+		}
+	case 206:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1605
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Execute, Columns: yyDollar[2].strs}
+		}
+	case 207:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1609
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_File, Columns: yyDollar[2].strs}
+		}
+	case 208:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1613
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_GrantOption, Columns: yyDollar[3].strs}
+		}
+	case 209:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1617
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Index, Columns: yyDollar[2].strs}
+		}
+	case 210:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1621
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Insert, Columns: yyDollar[2].strs}
+		}
+	case 211:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1625
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_LockTables, Columns: yyDollar[3].strs}
+		}
+	case 212:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1629
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Process, Columns: yyDollar[2].strs}
+		}
+	case 213:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1633
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_References, Columns: yyDollar[2].strs}
+		}
+	case 214:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1637
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Reload, Columns: yyDollar[2].strs}
+		}
+	case 215:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1641
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_ReplicationClient, Columns: yyDollar[3].strs}
+		}
+	case 216:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1645
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_ReplicationSlave, Columns: yyDollar[3].strs}
+		}
+	case 217:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1649
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Select, Columns: yyDollar[2].strs}
+		}
+	case 218:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1653
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_ShowDatabases, Columns: yyDollar[3].strs}
+		}
+	case 219:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1657
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_ShowView, Columns: yyDollar[3].strs}
+		}
+	case 220:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1661
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Shutdown, Columns: yyDollar[2].strs}
+		}
+	case 221:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1665
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Super, Columns: yyDollar[2].strs}
+		}
+	case 222:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1669
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Trigger, Columns: yyDollar[2].strs}
+		}
+	case 223:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1673
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Update, Columns: yyDollar[2].strs}
+		}
+	case 224:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1677
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Usage, Columns: yyDollar[2].strs}
+		}
+	case 225:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1681
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Dynamic, DynamicName: strings.ToLower(string(yyDollar[1].bytes))}
+		}
+	case 226:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1687
+		{
+			yyVAL.grantPrivileges = []Privilege{yyDollar[1].grantPrivilege}
+		}
+	case 227:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1691
+		{
+			yyVAL.grantPrivileges = append(yyDollar[1].grantPrivileges, yyDollar[3].grantPrivilege)
+		}
+	case 267:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1737
+		{
+			yyVAL.strs = nil
+		}
+	case 268:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1741
+		{
+			yyVAL.strs = yyDollar[2].strs
+		}
+	case 269:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1747
+		{
+			yyVAL.strs = []string{yyDollar[1].colIdent.String()}
+		}
+	case 270:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1751
+		{
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].colIdent.String())
+		}
+	case 271:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1756
+		{
+			yyVAL.grantObjectType = GrantObjectType_Any
+		}
+	case 272:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1760
+		{
+			yyVAL.grantObjectType = GrantObjectType_Table
+		}
+	case 273:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1764
+		{
+			yyVAL.grantObjectType = GrantObjectType_Function
+		}
+	case 274:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1768
+		{
+			yyVAL.grantObjectType = GrantObjectType_Procedure
+		}
+	case 275:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1774
+		{
+			yyVAL.privilegeLevel = PrivilegeLevel{Database: "", TableRoutine: "*"}
+		}
+	case 276:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1778
+		{
+			yyVAL.privilegeLevel = PrivilegeLevel{Database: "*", TableRoutine: "*"}
+		}
+	case 277:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1782
+		{
+			yyVAL.privilegeLevel = PrivilegeLevel{Database: "", TableRoutine: yyDollar[1].colIdent.String()}
+		}
+	case 278:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1786
+		{
+			yyVAL.privilegeLevel = PrivilegeLevel{Database: yyDollar[1].colIdent.String(), TableRoutine: "*"}
+		}
+	case 279:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1790
+		{
+			yyVAL.privilegeLevel = PrivilegeLevel{Database: yyDollar[1].colIdent.String(), TableRoutine: yyDollar[3].colIdent.String()}
+		}
+	case 280:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1795
+		{
+			yyVAL.grantAssumption = nil
+		}
+	case 281:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1799
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_Default, User: yyDollar[2].accountName, Roles: nil}
+		}
+	case 282:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1803
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_Default, User: yyDollar[2].accountName, Roles: nil}
+		}
+	case 283:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1807
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_None, User: yyDollar[2].accountName, Roles: nil}
+		}
+	case 284:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1811
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_All, User: yyDollar[2].accountName, Roles: nil}
+		}
+	case 285:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1815
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_AllExcept, User: yyDollar[2].accountName, Roles: yyDollar[7].accountNames}
+		}
+	case 286:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1819
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_Roles, User: yyDollar[2].accountName, Roles: yyDollar[5].accountNames}
+		}
+	case 287:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1824
+		{
+			yyVAL.boolean = false
+		}
+	case 288:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1828
+		{
+			yyVAL.boolean = true
+		}
+	case 289:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1833
+		{
+			yyVAL.boolean = false
+		}
+	case 290:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1837
+		{
+			yyVAL.boolean = true
+		}
+	case 291:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1844
+		{
+			if yyDollar[1].selStmt.GetInto() != nil {
+				yylex.Error(fmt.Errorf("INTO clause is not allowed").Error())
+				return 1
+			}
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 292:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1857
+		{
+			if yyDollar[1].selStmt.GetInto() != nil {
+				yylex.Error(fmt.Errorf("INTO clause is not allowed").Error())
+				return 1
+			}
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 293:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1869
+		{
+			yyVAL.procedureParams = nil
+		}
+	case 294:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1873
+		{
+			yyVAL.procedureParams = yyDollar[1].procedureParams
+		}
+	case 295:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1879
+		{
+			yyVAL.procedureParams = []ProcedureParam{yyDollar[1].procedureParam}
+		}
+	case 296:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1883
+		{
+			yyVAL.procedureParams = append(yyVAL.procedureParams, yyDollar[3].procedureParam)
+		}
+	case 297:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1889
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_In, Name: yyDollar[1].colIdent.String(), Type: yyDollar[2].columnType}
+		}
+	case 298:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1893
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_In, Name: yyDollar[2].colIdent.String(), Type: yyDollar[3].columnType}
+		}
+	case 299:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1897
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_Inout, Name: yyDollar[2].colIdent.String(), Type: yyDollar[3].columnType}
+		}
+	case 300:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1901
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_Out, Name: yyDollar[2].colIdent.String(), Type: yyDollar[3].columnType}
+		}
+	case 301:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1906
+		{
+			yyVAL.characteristics = nil
+		}
+	case 302:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1910
+		{
+			yyVAL.characteristics = yyDollar[1].characteristics
+		}
+	case 303:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1916
+		{
+			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
+		}
+	case 304:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1920
+		{
+			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[2].characteristic)
+		}
+	case 305:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1926
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_Comment, Comment: string(yyDollar[2].bytes)}
+		}
+	case 306:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1930
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_LanguageSql}
+		}
+	case 307:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1934
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_NotDeterministic}
+		}
+	case 308:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1938
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_Deterministic}
+		}
+	case 309:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1942
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ContainsSql}
+		}
+	case 310:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1946
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_NoSql}
+		}
+	case 311:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1950
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ReadsSqlData}
+		}
+	case 312:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1954
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ModifiesSqlData}
+		}
+	case 313:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1958
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_SqlSecurityDefiner}
+		}
+	case 314:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1962
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_SqlSecurityInvoker}
+		}
+	case 315:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1968
+		{
+			yyVAL.statement = &BeginEndBlock{Label: ""}
+		}
+	case 316:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1972
+		{
+			yyVAL.statement = &BeginEndBlock{Label: string(yyDollar[1].bytes)}
+		}
+	case 317:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1976
+		{
+			label := string(yyDollar[1].bytes)
+			if label != string(yyDollar[5].bytes) {
+				yylex.Error("End-label " + string(yyDollar[5].bytes) + " without match")
+				return 1
+			}
+			yyVAL.statement = &BeginEndBlock{Label: label}
+		}
+	case 318:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1985
+		{
+			yyVAL.statement = &BeginEndBlock{Label: "", Statements: yyDollar[2].statements}
+		}
+	case 319:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1989
+		{
+			yyVAL.statement = &BeginEndBlock{Label: string(yyDollar[1].bytes), Statements: yyDollar[4].statements}
+		}
+	case 320:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1993
+		{
+			label := string(yyDollar[1].bytes)
+			if label != string(yyDollar[7].bytes) {
+				yylex.Error("End-label " + string(yyDollar[7].bytes) + " without match")
+				return 1
+			}
+			yyVAL.statement = &BeginEndBlock{Label: label, Statements: yyDollar[4].statements}
+		}
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2004
+		{
+			yyVAL.str = ViewCheckOptionCascaded
+		}
+	case 322:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2008
+		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), ViewCheckOptionCascaded) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.str = ViewCheckOptionCascaded
+		}
+	case 323:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2016
+		{
+			yyVAL.str = ViewCheckOptionLocal
+		}
+	case 324:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2022
+		{
+			yyVAL.viewSpec = &ViewSpec{Algorithm: "", Definer: yyDollar[1].str, Security: yyDollar[2].str}
+		}
+	case 325:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2026
+		{
+			yyVAL.viewSpec = &ViewSpec{Algorithm: yyDollar[1].str, Definer: yyDollar[2].str, Security: yyDollar[3].str}
+		}
+	case 326:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2032
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 327:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2036
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 328:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2040
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 329:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2045
+		{
+			yyVAL.str = ""
+		}
+	case 330:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2049
+		{
+			yyVAL.str = yyDollar[3].accountName.String()
+		}
+	case 331:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2054
+		{
+			yyVAL.str = ""
+		}
+	case 332:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2058
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 333:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2062
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 334:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2068
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 335:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2072
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 336:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2076
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 337:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2082
+		{
+			anyHost := false
+			if yyDollar[3].str == "%" {
+				anyHost = true
+			}
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: yyDollar[3].str, AnyHost: anyHost}
+		}
+	case 338:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2090
+		{
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: "", AnyHost: false}
+		}
+	case 339:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2094
+		{
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: "", AnyHost: true}
+		}
+	case 340:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2100
+		{
+			yyVAL.accountNames = []AccountName{yyDollar[1].accountName}
+		}
+	case 341:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2104
+		{
+			yyVAL.accountNames = append(yyDollar[1].accountNames, yyDollar[3].accountName)
+		}
+	case 342:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2110
+		{
+			if len(yyDollar[1].str) == 0 {
+				yylex.Error("the anonymous user is not a valid role name")
+				return 1
+			}
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: yyDollar[3].str, AnyHost: false}
+		}
+	case 343:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2118
+		{
+			if len(yyDollar[1].str) == 0 {
+				yylex.Error("the anonymous user is not a valid role name")
+				return 1
+			}
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: "", AnyHost: false}
+		}
+	case 344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2126
+		{
+			if len(yyDollar[1].str) == 0 {
+				yylex.Error("the anonymous user is not a valid role name")
+				return 1
+			}
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: "", AnyHost: true}
+		}
+	case 345:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2136
+		{
+			yyVAL.accountNames = []AccountName{yyDollar[1].accountName}
+		}
+	case 346:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2140
+		{
+			yyVAL.accountNames = append(yyDollar[1].accountNames, yyDollar[3].accountName)
+		}
+	case 347:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2146
+		{
+			yyVAL.accountWithAuth = AccountWithAuth{AccountName: yyDollar[1].accountName}
+		}
+	case 348:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2150
+		{
+			yyVAL.accountWithAuth = AccountWithAuth{AccountName: yyDollar[1].accountName, Auth1: yyDollar[2].authentication}
+		}
+	case 349:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2154
+		{
+			yyVAL.accountWithAuth = AccountWithAuth{AccountName: yyDollar[1].accountName, Auth1: yyDollar[2].authentication, AuthInitial: yyDollar[5].authentication}
+		}
+	case 350:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2158
+		{
+			yyVAL.accountWithAuth = AccountWithAuth{AccountName: yyDollar[1].accountName, Auth1: yyDollar[2].authentication, Auth2: yyDollar[4].authentication}
+		}
+	case 351:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2162
+		{
+			yyVAL.accountWithAuth = AccountWithAuth{AccountName: yyDollar[1].accountName, Auth1: yyDollar[2].authentication, Auth2: yyDollar[4].authentication, Auth3: yyDollar[6].authentication}
+		}
+	case 352:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2168
+		{
+			yyVAL.authentication = &Authentication{RandomPassword: true}
+		}
+	case 353:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2172
+		{
+			yyVAL.authentication = &Authentication{Password: string(yyDollar[3].bytes)}
+		}
+	case 354:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2176
+		{
+			yyVAL.authentication = &Authentication{Plugin: string(yyDollar[3].bytes)}
+		}
+	case 355:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2180
+		{
+			yyVAL.authentication = &Authentication{Plugin: string(yyDollar[3].bytes), RandomPassword: true}
+		}
+	case 356:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2184
+		{
+			yyVAL.authentication = &Authentication{Plugin: string(yyDollar[3].bytes), Password: string(yyDollar[5].bytes)}
+		}
+	case 357:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2188
+		{
+			yyVAL.authentication = &Authentication{Plugin: string(yyDollar[3].bytes), Identity: string(yyDollar[5].bytes)}
+		}
+	case 358:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2194
+		{
+			yyVAL.authentication = &Authentication{RandomPassword: true}
+		}
+	case 359:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2198
+		{
+			yyVAL.authentication = &Authentication{Password: string(yyDollar[3].bytes)}
+		}
+	case 360:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2202
+		{
+			yyVAL.authentication = &Authentication{Plugin: string(yyDollar[3].bytes), Identity: string(yyDollar[5].bytes)}
+		}
+	case 361:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2208
+		{
+			yyVAL.accountsWithAuth = []AccountWithAuth{yyDollar[1].accountWithAuth}
+		}
+	case 362:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2212
+		{
+			yyVAL.accountsWithAuth = append(yyDollar[1].accountsWithAuth, yyDollar[3].accountWithAuth)
+		}
+	case 363:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2218
+		{
+			yyVAL.eventName = EventName{Name: yyDollar[1].colIdent}
+		}
+	case 364:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2222
+		{
+			yyVAL.eventName = EventName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent}
+		}
+	case 365:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2228
+		{
+			yyVAL.eventScheduleSpec = &EventScheduleSpec{At: &EventScheduleTimeSpec{EventTimestamp: yyDollar[2].expr, EventIntervals: yyDollar[3].intervalExprs}}
+		}
+	case 366:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2232
+		{
+			yyVAL.eventScheduleSpec = &EventScheduleSpec{EveryInterval: IntervalExpr{Expr: yyDollar[2].expr, Unit: string(yyDollar[3].bytes)}, Starts: yyDollar[4].eventScheduleTimeSpec, Ends: yyDollar[5].eventScheduleTimeSpec}
+		}
+	case 367:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2237
+		{
+			yyVAL.intervalExprs = []IntervalExpr{}
+		}
+	case 368:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2241
+		{
+			yyVAL.intervalExprs = append(yyDollar[1].intervalExprs, IntervalExpr{Expr: yyDollar[4].expr, Unit: string(yyDollar[5].bytes)})
+		}
+	case 369:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2246
+		{
+			yyVAL.eventScheduleTimeSpec = nil
+		}
+	case 370:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2250
+		{
+			yyVAL.eventScheduleTimeSpec = &EventScheduleTimeSpec{EventTimestamp: yyDollar[2].expr, EventIntervals: yyDollar[3].intervalExprs}
+		}
+	case 371:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2255
+		{
+			yyVAL.eventScheduleTimeSpec = nil
+		}
+	case 372:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2259
+		{
+			yyVAL.eventScheduleTimeSpec = &EventScheduleTimeSpec{EventTimestamp: yyDollar[2].expr, EventIntervals: yyDollar[3].intervalExprs}
+		}
+	case 373:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2264
+		{
+			yyVAL.eventOnCompletion = EventOnCompletion_Undefined
+		}
+	case 374:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2268
+		{
+			yyVAL.eventOnCompletion = EventOnCompletion_Preserve
+		}
+	case 375:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2272
+		{
+			yyVAL.eventOnCompletion = EventOnCompletion_NotPreserve
+		}
+	case 376:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2277
+		{
+			yyVAL.eventStatus = EventStatus_Undefined
+		}
+	case 377:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2281
+		{
+			yyVAL.eventStatus = EventStatus_Enable
+		}
+	case 378:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2285
+		{
+			yyVAL.eventStatus = EventStatus_Disable
+		}
+	case 379:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2289
+		{
+			yyVAL.eventStatus = EventStatus_DisableOnSlave
+		}
+	case 380:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2294
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 381:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2298
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
+		}
+	case 382:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2304
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2308
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 384:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2314
+		{
+			yyVAL.str = BeforeStr
+		}
+	case 385:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2318
+		{
+			yyVAL.str = AfterStr
+		}
+	case 386:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2324
+		{
+			yyVAL.str = InsertStr
+		}
+	case 387:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2328
+		{
+			yyVAL.str = UpdateStr
+		}
+	case 388:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2332
+		{
+			yyVAL.str = DeleteStr
+		}
+	case 389:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2337
+		{
+			yyVAL.triggerOrder = nil
+		}
+	case 390:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2341
+		{
+			yyVAL.triggerOrder = &TriggerOrder{PrecedesOrFollows: FollowsStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 391:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2345
+		{
+			yyVAL.triggerOrder = &TriggerOrder{PrecedesOrFollows: PrecedesStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 392:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2351
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 398:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2362
+		{
+			yyVAL.statement = &BeginEndBlock{Statements: yyDollar[2].statements}
+		}
+	case 399:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2366
+		{
+			yyVAL.statement = &BeginEndBlock{}
+		}
+	case 400:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2372
+		{
+			yyVAL.statement = &CaseStatement{Expr: yyDollar[2].expr, Cases: yyDollar[3].caseStatementCases}
+		}
+	case 401:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2376
+		{
+			yyVAL.statement = &CaseStatement{Expr: yyDollar[2].expr, Cases: yyDollar[3].caseStatementCases, Else: yyDollar[5].statements}
+		}
+	case 402:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2380
+		{
+			yyVAL.statement = &CaseStatement{Expr: nil, Cases: yyDollar[2].caseStatementCases}
+		}
+	case 403:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2384
+		{
+			yyVAL.statement = &CaseStatement{Expr: nil, Cases: yyDollar[2].caseStatementCases, Else: yyDollar[4].statements}
+		}
+	case 404:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2390
+		{
+			yyVAL.caseStatementCases = []CaseStatementCase{yyDollar[1].caseStatementCase}
+		}
+	case 405:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2394
+		{
+			yyVAL.caseStatementCases = append(yyVAL.caseStatementCases, yyDollar[2].caseStatementCase)
+		}
+	case 406:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2400
+		{
+			yyVAL.caseStatementCase = CaseStatementCase{Case: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 407:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2406
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}
+			yyVAL.statement = &IfStatement{Conditions: conds}
+		}
+	case 408:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2411
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}
+			yyVAL.statement = &IfStatement{Conditions: conds, Else: yyDollar[7].statements}
+		}
+	case 409:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2416
+		{
+			conds := yyDollar[6].ifStatementConditions
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}, conds...)
+			yyVAL.statement = &IfStatement{Conditions: conds}
+		}
+	case 410:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2422
+		{
+			conds := yyDollar[6].ifStatementConditions
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}, conds...)
+			yyVAL.statement = &IfStatement{Conditions: conds, Else: yyDollar[8].statements}
+		}
+	case 411:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2430
+		{
+			yyVAL.ifStatementConditions = []IfStatementCondition{yyDollar[1].ifStatementCondition}
+		}
+	case 412:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2434
+		{
+			yyVAL.ifStatementConditions = append(yyVAL.ifStatementConditions, yyDollar[2].ifStatementCondition)
+		}
+	case 413:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2440
+		{
+			yyVAL.ifStatementCondition = IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 414:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2446
+		{
+			yyVAL.statement = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), SqlStateValue: string(yyDollar[5].bytes)}}
+		}
+	case 415:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2450
+		{
+			yyVAL.statement = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), MysqlErrorCode: NewIntVal(yyDollar[5].bytes)}}
+		}
+	case 416:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2454
+		{
+			yyVAL.statement = &Declare{Cursor: &DeclareCursor{Name: string(yyDollar[2].bytes), SelectStmt: yyDollar[5].selStmt}}
+		}
+	case 417:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2458
+		{
+			yyVAL.statement = &Declare{Handler: &DeclareHandler{Action: yyDollar[2].declareHandlerAction, ConditionValues: yyDollar[5].declareHandlerConditions, Statement: yyDollar[6].statement}}
+		}
+	case 418:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2462
+		{
+			yyDollar[3].columnType.Charset = yyDollar[4].str
+			yyDollar[3].columnType.Collate = yyDollar[5].str
+			yyVAL.statement = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].colIdents, VarType: yyDollar[3].columnType}}
+		}
+	case 419:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2468
+		{
+			yyDollar[3].columnType.Charset = yyDollar[4].str
+			yyDollar[3].columnType.Collate = yyDollar[5].str
+			yyDollar[3].columnType.Default = yyDollar[7].expr
+			yyVAL.statement = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].colIdents, VarType: yyDollar[3].columnType}}
+		}
+	case 420:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2477
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Continue
+		}
+	case 421:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2481
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Exit
+		}
+	case 422:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2485
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Undo
+		}
+	case 423:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2491
+		{
+			yyVAL.declareHandlerConditions = []DeclareHandlerCondition{yyDollar[1].declareHandlerCondition}
+		}
+	case 424:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2495
+		{
+			yyVAL.declareHandlerConditions = append(yyVAL.declareHandlerConditions, yyDollar[3].declareHandlerCondition)
+		}
+	case 425:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2501
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_MysqlErrorCode, MysqlErrorCode: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 426:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2505
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlState, String: string(yyDollar[1].bytes)}
+		}
+	case 427:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2509
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlWarning}
+		}
+	case 428:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2513
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_NotFound}
+		}
+	case 429:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2517
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlException}
+		}
+	case 430:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2521
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_ConditionName, String: string(yyDollar[1].bytes)}
+		}
+	case 431:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2527
+		{
+			yyVAL.statement = &OpenCursor{Name: string(yyDollar[2].bytes)}
+		}
+	case 432:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2533
+		{
+			yyVAL.statement = &CloseCursor{Name: string(yyDollar[2].bytes)}
+		}
+	case 433:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2539
+		{
+			yyVAL.statement = &FetchCursor{Name: string(yyDollar[3].bytes), Variables: yyDollar[5].strs}
+		}
+	case 434:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2544
+		{
+		}
+	case 436:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2547
+		{
+		}
+	case 437:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2551
+		{
+			yyVAL.strs = []string{string(yyDollar[1].bytes)}
+		}
+	case 438:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2555
+		{
+			yyVAL.strs = append(yyVAL.strs, string(yyDollar[3].bytes))
+		}
+	case 439:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2561
+		{
+			yyVAL.statement = &Loop{Label: "", Statements: yyDollar[2].statements}
+		}
+	case 440:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2565
+		{
+			yyVAL.statement = &Loop{Label: string(yyDollar[1].bytes), Statements: yyDollar[4].statements}
+		}
+	case 441:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2569
+		{
+			label := string(yyDollar[1].bytes)
+			if label != string(yyDollar[8].bytes) {
+				yylex.Error("End-label " + string(yyDollar[8].bytes) + " without match")
+				return 1
+			}
+			yyVAL.statement = &Loop{Label: label, Statements: yyDollar[4].statements}
+		}
+	case 442:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2580
+		{
+			yyVAL.statement = &Repeat{Label: "", Statements: yyDollar[2].statements, Condition: yyDollar[5].expr}
+		}
+	case 443:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2584
+		{
+			yyVAL.statement = &Repeat{Label: string(yyDollar[1].bytes), Statements: yyDollar[4].statements, Condition: yyDollar[7].expr}
+		}
+	case 444:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2588
+		{
+			label := string(yyDollar[1].bytes)
+			if label != string(yyDollar[10].bytes) {
+				yylex.Error("End-label " + string(yyDollar[10].bytes) + " without match")
+				return 1
+			}
+			yyVAL.statement = &Repeat{Label: label, Statements: yyDollar[4].statements, Condition: yyDollar[7].expr}
+		}
+	case 445:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2599
+		{
+			yyVAL.statement = &While{Label: "", Condition: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 446:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2603
+		{
+			yyVAL.statement = &While{Label: string(yyDollar[1].bytes), Condition: yyDollar[4].expr, Statements: yyDollar[6].statements}
+		}
+	case 447:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2607
+		{
+			label := string(yyDollar[1].bytes)
+			if label != string(yyDollar[10].bytes) {
+				yylex.Error("End-label " + string(yyDollar[10].bytes) + " without match")
+				return 1
+			}
+			yyVAL.statement = &While{Label: label, Condition: yyDollar[4].expr, Statements: yyDollar[6].statements}
+		}
+	case 448:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2618
+		{
+			yyVAL.statement = &Leave{Label: string(yyDollar[2].bytes)}
+		}
+	case 449:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2624
+		{
+			yyVAL.statement = &Iterate{Label: string(yyDollar[2].bytes)}
+		}
+	case 450:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2630
+		{
+			yyVAL.statement = &Return{Expr: yyDollar[2].expr}
+		}
+	case 451:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2636
+		{
+			yyVAL.statement = &Signal{SqlStateValue: string(yyDollar[2].bytes)}
+		}
+	case 452:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2640
+		{
+			yyVAL.statement = &Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}
+		}
+	case 453:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2644
+		{
+			yyVAL.statement = &Signal{ConditionName: string(yyDollar[2].bytes)}
+		}
+	case 454:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2648
+		{
+			yyVAL.statement = &Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}
+		}
+	case 455:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2654
+		{
+			yyVAL.bytes = yyDollar[2].bytes
+		}
+	case 456:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2658
+		{
+			yyVAL.bytes = yyDollar[3].bytes
+		}
+	case 457:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2664
+		{
+			yyVAL.signalInfos = []SignalInfo{yyDollar[1].signalInfo}
+		}
+	case 458:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2668
+		{
+			yyVAL.signalInfos = append(yyVAL.signalInfos, yyDollar[3].signalInfo)
+		}
+	case 459:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2674
+		{
+			yyVAL.signalInfo = SignalInfo{ConditionItemName: yyDollar[1].signalConditionItemName, Value: yyDollar[3].expr.(*SQLVal)}
+		}
+	case 460:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2678
+		{
+			yyVAL.signalInfo = SignalInfo{ConditionItemName: yyDollar[1].signalConditionItemName, Value: &ColName{Name: yyDollar[3].colIdent}}
+		}
+	case 461:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2684
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ClassOrigin
+		}
+	case 462:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2688
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_SubclassOrigin
+		}
+	case 463:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2692
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_MessageText
+		}
+	case 464:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2696
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_MysqlErrno
+		}
+	case 465:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2700
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintCatalog
+		}
+	case 466:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2704
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintSchema
+		}
+	case 467:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2708
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintName
+		}
+	case 468:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2712
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_CatalogName
+		}
+	case 469:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2716
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_SchemaName
+		}
+	case 470:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2720
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_TableName
+		}
+	case 471:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2724
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ColumnName
+		}
+	case 472:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2728
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_CursorName
+		}
+	case 473:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2734
+		{
+			yyVAL.statement = &Resignal{}
+		}
+	case 474:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2738
+		{
+			yyVAL.statement = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes)}}
+		}
+	case 475:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2742
+		{
+			yyVAL.statement = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}}
+		}
+	case 476:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2746
+		{
+			yyVAL.statement = &Resignal{Signal{Info: yyDollar[3].signalInfos}}
+		}
+	case 477:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2750
+		{
+			yyVAL.statement = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes)}}
+		}
+	case 478:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2754
+		{
+			yyVAL.statement = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}}
+		}
+	case 479:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2760
+		{
+			yyVAL.statement = &Call{ProcName: yyDollar[2].procedureName, Params: yyDollar[3].exprs, AsOf: yyDollar[4].expr}
+		}
+	case 480:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2765
+		{
+			yyVAL.exprs = nil
+		}
+	case 481:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2769
+		{
+			yyVAL.exprs = nil
+		}
+	case 482:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2773
+		{
+			yyVAL.exprs = yyDollar[2].exprs
+		}
+	case 483:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2779
+		{
+			yyVAL.statements = Statements{yyDollar[1].statement}
+		}
+	case 484:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2783
+		{
+			yyVAL.statements = append(yyVAL.statements, yyDollar[3].statement)
+		}
+	case 485:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2789
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 527:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2836
+		{
+			var ne bool
+			if yyDollar[4].byt != 0 {
+				ne = true
+			}
+
+			var neTemp bool
+			if yyDollar[2].byt != 0 {
+				neTemp = true
+			}
+
+			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[5].tableName, IfNotExists: ne, Temporary: neTemp}
+		}
+	case 528:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2850
+		{
+			var ne bool
+			if yyDollar[4].byt != 0 {
+				ne = true
+			}
+
+			var neTemp bool
+			if yyDollar[2].byt != 0 {
+				neTemp = true
+			}
+
+			yyVAL.ddl = &DDL{Action: CreateStr, Table: TableName{Name: NewTableIdent(string(yyDollar[5].bytes))}, IfNotExists: ne, Temporary: neTemp}
+		}
+	case 529:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2866
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Options = yyDollar[4].str + yyDollar[5].str
+		}
+	case 530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2873
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+		}
+	case 531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2878
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
+		}
+	case 532:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2883
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+		}
+	case 533:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2887
+		{
+			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
+		}
+	case 534:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2891
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 535:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2895
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 536:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2901
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 537:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2909
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 538:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2919
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+		}
+	case 539:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2927
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 540:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2935
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 541:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2943
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 542:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2951
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 543:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2959
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 544:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2968
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 545:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2972
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 546:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2976
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 547:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2981
+		{
+			yyVAL.columnType = ColumnType{}
+		}
+	case 548:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2985
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 549:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2989
+		{
+			opt := ColumnType{Null: BoolVal(true), NotNull: BoolVal(false), sawnull: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 550:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2998
+		{
+			opt := ColumnType{Null: BoolVal(false), NotNull: BoolVal(true), sawnull: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 551:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3007
+		{
+			opt := ColumnType{Charset: yyDollar[2].str}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 552:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3016
+		{
+			opt := ColumnType{Collate: yyDollar[2].str}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 553:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3025
+		{
+			opt := ColumnType{BinaryCollate: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 554:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3034
+		{
+			opt := ColumnType{Default: yyDollar[2].optVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 555:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3043
+		{
+			opt := ColumnType{OnUpdate: yyDollar[2].optVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 556:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3052
+		{
+			opt := ColumnType{Autoincrement: yyDollar[2].boolVal, sawai: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 557:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3061
+		{
+			opt := ColumnType{KeyOpt: yyDollar[2].colKeyOpt}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 558:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3070
+		{
+			opt := ColumnType{Comment: yyDollar[2].sqlVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 559:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3079
+		{
+			opt := ColumnType{GeneratedExpr: &ParenExpr{yyDollar[4].expr}, Stored: yyDollar[6].boolVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 560:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3088
+		{
+			opt := ColumnType{GeneratedExpr: &ParenExpr{yyDollar[6].expr}, Stored: yyDollar[8].boolVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 561:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3097
+		{
+			opt := ColumnType{SRID: NewIntVal(yyDollar[3].bytes)}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 562:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3107
+		{
+			opt := ColumnType{ForeignKeyDef: &ForeignKeyDefinition{ReferencedTable: yyDollar[3].tableName, ReferencedColumns: yyDollar[5].columns}}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 563:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3116
+		{
+			opt := ColumnType{Constraint: yyDollar[2].constraintDefinition}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 564:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3127
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
+			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
+		}
+	case 568:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3138
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Length = yyDollar[2].sqlVal
+		}
+	case 569:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3143
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 570:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3149
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 571:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3153
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 572:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3157
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 573:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3161
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 574:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3165
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 575:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3169
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 576:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3173
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3177
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 578:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3181
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 579:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3185
+		{
+			yyVAL.columnType = ColumnType{Type: "bigint", Unsigned: true, NotNull: true, Autoincrement: true, KeyOpt: colKeyUnique}
+		}
+	case 580:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3189
+		{
+			yyVAL.columnType = ColumnType{Type: "tinyint"}
+		}
+	case 581:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3193
+		{
+			yyVAL.columnType = ColumnType{Type: "smallint"}
+		}
+	case 582:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3197
+		{
+			yyVAL.columnType = ColumnType{Type: "mediumint"}
+		}
+	case 583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3201
+		{
+			yyVAL.columnType = ColumnType{Type: "int"}
+		}
+	case 584:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3205
+		{
+			yyVAL.columnType = ColumnType{Type: "bigint"}
+		}
+	case 585:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3211
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 586:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3217
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 587:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3223
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+			yyVAL.columnType.Length = yyDollar[3].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[3].LengthScaleOption.Scale
+		}
+	case 588:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3229
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 589:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3235
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 590:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3241
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 591:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3247
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 592:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3253
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 593:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3261
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 594:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3265
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 595:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3269
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 596:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3273
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 597:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3277
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 598:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3283
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 599:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3287
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 600:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3291
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 601:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3295
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 602:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3299
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 603:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3303
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 604:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3307
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 605:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3311
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 606:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3315
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 607:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3319
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 608:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3323
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 609:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3327
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 610:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3331
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Length: yyDollar[4].sqlVal}
+		}
+	case 611:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3335
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Length: yyDollar[4].sqlVal}
+		}
+	case 612:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3339
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 613:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3343
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 614:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3347
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 615:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3351
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 616:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3355
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 617:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3359
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 618:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3363
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 619:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3367
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 620:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3371
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 621:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3375
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 622:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3379
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 623:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3383
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 624:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3387
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 625:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3391
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs}
+		}
+	case 626:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3396
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs}
+		}
+	case 627:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3402
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 628:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3406
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 629:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3410
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 630:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3414
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 631:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3418
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 632:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3422
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 633:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3426
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 634:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3430
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 635:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3436
+		{
+			yyVAL.strs = make([]string, 0, 4)
+			yyVAL.strs = append(yyVAL.strs, string(yyDollar[1].bytes))
+		}
+	case 636:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3441
+		{
+			yyVAL.strs = append(yyDollar[1].strs, string(yyDollar[3].bytes))
+		}
+	case 637:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3446
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 638:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3450
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 639:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3455
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 640:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3459
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 641:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3463
+		{
+			yyVAL.sqlVal = NewValArg(yyDollar[2].bytes)
+		}
+	case 642:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3468
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 643:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3472
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 644:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3480
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 645:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3484
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+			}
+		}
+	case 646:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3490
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 647:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3498
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 648:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3502
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 649:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3506
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 650:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3511
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 651:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3515
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 652:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3521
+		{
+			yyVAL.optVal = yyDollar[2].expr
+		}
+	case 653:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3525
+		{
+			if num, ok := yyDollar[3].expr.(*SQLVal); ok && num.Type == IntVal {
+				// Handle double negative
+				if num.Val[0] == '-' {
+					num.Val = num.Val[1:]
+					yyVAL.optVal = num
+				} else {
+					yyVAL.optVal = NewIntVal(append([]byte("-"), num.Val...))
+				}
+			} else {
+				yyVAL.optVal = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[3].expr}
+			}
+		}
+	case 654:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3539
+		{
+			yyVAL.optVal = &UnaryExpr{Operator: yyDollar[2].str, Expr: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 655:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3543
+		{
+			yyVAL.optVal = yyDollar[2].boolVal
+		}
+	case 656:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3547
+		{
+			yyVAL.optVal = yyDollar[2].expr
+		}
+	case 657:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3551
+		{
+			yyVAL.optVal = &ParenExpr{yyDollar[3].expr}
+		}
+	case 658:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3557
+		{
+			yyVAL.optVal = yyDollar[3].expr
+		}
+	case 659:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3563
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 660:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3568
+		{
+			yyVAL.str = ""
+		}
+	case 661:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3572
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 662:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3578
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 663:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3582
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 664:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3586
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 665:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3590
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 666:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3596
+		{
+			yyVAL.str = ""
+		}
+	case 667:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3600
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 668:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3606
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 669:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3610
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 670:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3614
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 671:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3619
+		{
+			yyVAL.boolean = false
+		}
+	case 672:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3623
+		{
+			yyVAL.boolean = true
+		}
+	case 673:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3628
+		{
+			yyVAL.charsetCollates = nil
+		}
+	case 674:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3632
+		{
+			yyVAL.charsetCollates = yyDollar[1].charsetCollates
+		}
+	case 675:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3638
+		{
+			yyVAL.charsetCollates = []*CharsetAndCollate{yyDollar[1].charsetCollate}
+		}
+	case 676:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3642
+		{
+			yyVAL.charsetCollates = []*CharsetAndCollate{yyDollar[1].charsetCollate}
+		}
+	case 677:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3646
+		{
+			yyVAL.charsetCollates = []*CharsetAndCollate{yyDollar[1].charsetCollate}
+		}
+	case 678:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3650
+		{
+			yyVAL.charsetCollates = append(yyDollar[1].charsetCollates, yyDollar[2].charsetCollate)
+		}
+	case 679:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3654
+		{
+			yyVAL.charsetCollates = append(yyDollar[1].charsetCollates, yyDollar[2].charsetCollate)
+		}
+	case 680:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3658
+		{
+			yyVAL.charsetCollates = append(yyDollar[1].charsetCollates, yyDollar[2].charsetCollate)
+		}
+	case 681:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3664
+		{
+			yyVAL.charsetCollate = &CharsetAndCollate{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Value: string(yyDollar[5].bytes), IsDefault: yyDollar[1].boolean}
+		}
+	case 682:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3668
+		{
+			yyVAL.charsetCollate = &CharsetAndCollate{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Value: string(yyDollar[5].bytes), IsDefault: yyDollar[1].boolean}
+		}
+	case 683:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3672
+		{
+			yyVAL.charsetCollate = &CharsetAndCollate{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Value: string(yyDollar[5].bytes), IsDefault: yyDollar[1].boolean}
+		}
+	case 684:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3676
+		{
+			yyVAL.charsetCollate = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].boolean}
+		}
+	case 685:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3680
+		{
+			yyVAL.charsetCollate = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].boolean}
+		}
+	case 686:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3684
+		{
+			yyVAL.charsetCollate = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].boolean}
+		}
+	case 687:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3690
+		{
+			yyVAL.charsetCollate = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].boolean}
+		}
+	case 688:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3694
+		{
+			yyVAL.charsetCollate = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].boolean}
+		}
+	case 689:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3698
+		{
+			yyVAL.charsetCollate = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].boolean}
+		}
+	case 690:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3704
+		{
+			yyVAL.charsetCollate = &CharsetAndCollate{Type: string(yyDollar[2].bytes), Value: string(yyDollar[4].bytes), IsDefault: yyDollar[1].boolean}
+		}
+	case 691:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3710
+		{
+			yyVAL.colKeyOpt = colKeyPrimary
+		}
+	case 692:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3714
+		{
+			yyVAL.colKeyOpt = colKey
+		}
+	case 693:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3718
+		{
+			yyVAL.colKeyOpt = colKeyUniqueKey
+		}
+	case 694:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3722
+		{
+			yyVAL.colKeyOpt = colKeyUnique
+		}
+	case 695:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3726
+		{
+			yyVAL.colKeyOpt = colKeyFulltextKey
+		}
+	case 696:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3732
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
+		}
+	case 697:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3738
+		{
+			yyVAL.statement = &Flush{Type: yyDollar[2].str, Option: yyDollar[3].flushOption}
+		}
+	case 698:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3744
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 699:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3748
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 700:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3752
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 701:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3756
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 702:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3760
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 703:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3764
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 704:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3768
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 705:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3772
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 706:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3776
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Channel: yyDollar[3].str}
+		}
+	case 707:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3780
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+		}
+	case 708:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3784
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 709:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3788
+		{
+			yyVAL.flushOption = &FlushOption{Name: string(yyDollar[1].bytes)}
+		}
+	case 710:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3793
+		{
+			yyVAL.str = ""
+		}
+	case 711:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3795
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 712:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3799
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 713:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3801
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 714:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3804
+		{
+			yyVAL.str = ""
+		}
+	case 715:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3806
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 716:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3810
+		{
+			yyVAL.statement = &ChangeReplicationSource{Options: yyDollar[5].replicationOptions}
+		}
+	case 717:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3814
+		{
+			yyVAL.statement = &ChangeReplicationFilter{Options: yyDollar[4].replicationOptions}
+		}
+	case 718:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3818
+		{
+			yyVAL.statement = &StartReplica{}
+		}
+	case 719:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3822
+		{
+			if !validUntilOptions(yyDollar[4].replicationOptions) {
+				yylex.Error("invalid START REPLICA UNTIL options")
+				return 1
+			}
+			yyVAL.statement = &StartReplica{UntilOptions: yyDollar[4].replicationOptions}
+		}
+	case 720:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3830
+		{
+			yyVAL.statement = &StopReplica{}
+		}
+	case 721:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3834
+		{
+			yyVAL.statement = &ResetReplica{All: yyDollar[3].boolean}
+		}
+	case 722:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3838
+		{
+			if !strings.EqualFold(string(yyDollar[5].bytes), "gtids") {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.statement = &ResetBinaryLogs{To: yyDollar[6].uint64}
+		}
+	case 723:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3846
+		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), "master") {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.statement = &ResetBinaryLogs{To: yyDollar[3].uint64}
+		}
+	case 724:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3854
+		{
+			yyVAL.statement = &ResetPersist{}
+		}
+	case 725:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3858
+		{
+			yyVAL.statement = &ResetPersist{IfExists: yyDollar[3].byt == 1, Name: yyDollar[4].colIdent.String()}
+		}
+	case 728:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3867
+		{
+			yyVAL.uint64 = 0
+		}
+	case 729:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3871
+		{
+			index := mustAtoi(yylex, string(yyDollar[2].bytes))
+			if index < 1 || index > MaxBinaryLogFileIndex {
+				yylex.Error(fmt.Sprintf("binary log file index %s is out of range", yyDollar[2].bytes))
+				return 1
+			}
+			yyVAL.uint64 = uint64(index)
+		}
+	case 730:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3881
+		{
+			yyVAL.str = ""
+		}
+	case 731:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3885
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 732:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3890
+		{
+			yyVAL.uint64 = 0
+		}
+	case 733:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3894
+		{
+			yyVAL.uint64 = uint64(mustAtoi(yylex, string(yyDollar[2].bytes)))
+		}
+	case 734:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3899
+		{
+			yyVAL.boolean = false
+		}
+	case 735:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3901
+		{
+			yyVAL.boolean = true
+		}
+	case 736:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3906
+		{
+			yyVAL.replicationOptions = []*ReplicationOption{yyDollar[1].replicationOption}
+		}
+	case 737:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3910
+		{
+			yyVAL.replicationOptions = append(yyVAL.replicationOptions, yyDollar[3].replicationOption)
+		}
+	case 738:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3918
+		{
+			yyVAL.replicationOption = &ReplicationOption{Name: yyDollar[1].str, Value: string(yyDollar[3].bytes)}
+		}
+	case 739:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3922
+		{
+			yyVAL.replicationOption = &ReplicationOption{Name: yyDollar[1].str, Value: mustAtoi(yylex, string(yyDollar[3].bytes))}
+		}
+	case 740:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3926
+		{
+			value, err := strconv.ParseFloat(string(yyDollar[3].bytes), 64)
+			if err != nil {
+				yylex.Error(fmt.Sprintf("unable to parse float from string '%s'", yyDollar[3].bytes))
+				return 1
+			}
+			yyVAL.replicationOption = &ReplicationOption{Name: yyDollar[1].str, Value: value}
+		}
+	case 741:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3937
+		{
+			yyVAL.str = strings.ToUpper(string(yyDollar[1].bytes))
+		}
+	case 742:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3941
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 743:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3945
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 744:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3949
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 745:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3953
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 746:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3957
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 747:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3961
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 748:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3967
+		{
+			yyVAL.replicationOptions = []*ReplicationOption{yyDollar[1].replicationOption}
+		}
+	case 749:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3971
+		{
+			yyVAL.replicationOptions = append(yyVAL.replicationOptions, yyDollar[3].replicationOption)
+		}
+	case 750:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3977
+		{
+			yyVAL.replicationOption = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: yyDollar[4].tableNames}
+		}
+	case 751:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3981
+		{
+			yyVAL.replicationOption = &ReplicationOption{Name: string(yyDollar[1].bytes), Value: yyDollar[4].tableNames}
+		}
+	case 752:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3987
+		{
+			if !strings.EqualFold(string(yyDollar[5].bytes), "type") {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyDollar[8].resourceGroup.Action, yyDollar[8].resourceGroup.Name, yyDollar[8].resourceGroup.Type = CreateStr, yyDollar[4].colIdent.String(), ResourceGroupTypeUser
+			yyVAL.statement = yyDollar[8].resourceGroup
+		}
+	case 753:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3996
+		{
+			if !strings.EqualFold(string(yyDollar[5].bytes), "type") {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyDollar[8].resourceGroup.Action, yyDollar[8].resourceGroup.Name, yyDollar[8].resourceGroup.Type = CreateStr, yyDollar[4].colIdent.String(), ResourceGroupTypeSystem
+			yyVAL.statement = yyDollar[8].resourceGroup
+		}
+	case 754:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4005
+		{
+			if yyDollar[5].resourceGroup.VCPUs == nil && yyDollar[5].resourceGroup.ThreadPriority == nil && yyDollar[5].resourceGroup.Enabled == nil {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyDollar[5].resourceGroup.Action, yyDollar[5].resourceGroup.Name = AlterStr, yyDollar[4].colIdent.String()
+			yyVAL.statement = yyDollar[5].resourceGroup
+		}
+	case 755:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4014
+		{
+			enabled := false
+			yyVAL.statement = &ResourceGroup{Action: AlterStr, Name: yyDollar[4].colIdent.String(), VCPUs: yyDollar[5].vcpuRanges, ThreadPriority: yyDollar[6].intPtr, Enabled: &enabled, Force: true}
+		}
+	case 756:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4019
+		{
+			yyVAL.statement = &ResourceGroup{Action: DropStr, Name: yyDollar[4].colIdent.String()}
+		}
+	case 757:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4023
+		{
+			yyVAL.statement = &ResourceGroup{Action: DropStr, Name: yyDollar[4].colIdent.String(), Force: true}
+		}
+	case 758:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4029
+		{
+			yyVAL.resourceGroup = &ResourceGroup{VCPUs: yyDollar[1].vcpuRanges, ThreadPriority: yyDollar[2].intPtr, Enabled: yyDollar[3].boolPtr}
+		}
+	case 759:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4034
+		{
+			yyVAL.vcpuRanges = nil
+		}
+	case 760:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4038
+		{
+			yyVAL.vcpuRanges = yyDollar[3].vcpuRanges
+		}
+	case 761:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4044
+		{
+			yyVAL.vcpuRanges = []ResourceGroupVCPURange{yyDollar[1].vcpuRange}
+		}
+	case 762:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4048
+		{
+			yyVAL.vcpuRanges = append(yyDollar[1].vcpuRanges, yyDollar[3].vcpuRange)
+		}
+	case 763:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4054
+		{
+			id := uint(mustAtoi(yylex, string(yyDollar[1].bytes)))
+			yyVAL.vcpuRange = ResourceGroupVCPURange{Start: id, End: id}
+		}
+	case 764:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4059
+		{
+			yyVAL.vcpuRange = ResourceGroupVCPURange{Start: uint(mustAtoi(yylex, string(yyDollar[1].bytes))), End: uint(mustAtoi(yylex, string(yyDollar[3].bytes)))}
+		}
+	case 765:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4064
+		{
+			yyVAL.intPtr = nil
+		}
+	case 766:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4068
+		{
+			priority := mustAtoi(yylex, string(yyDollar[3].bytes))
+			yyVAL.intPtr = &priority
+		}
+	case 767:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4073
+		{
+			priority := -mustAtoi(yylex, string(yyDollar[4].bytes))
+			yyVAL.intPtr = &priority
+		}
+	case 768:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4079
+		{
+			yyVAL.boolPtr = nil
+		}
+	case 769:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4083
+		{
+			enabled := true
+			yyVAL.boolPtr = &enabled
+		}
+	case 770:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4088
+		{
+			enabled := false
+			yyVAL.boolPtr = &enabled
+		}
+	case 771:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4095
+		{
+			yyVAL.uint32s = []uint32{uint32(mustAtoi(yylex, string(yyDollar[1].bytes)))}
+		}
+	case 772:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4099
+		{
+			yyVAL.uint32s = append(yyDollar[1].uint32s, uint32(mustAtoi(yylex, string(yyDollar[3].bytes))))
+		}
+	case 773:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4105
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
+		}
+	case 774:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4109
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
+		}
+	case 775:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4114
+		{
+			yyVAL.indexOptions = nil
+		}
+	case 776:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4118
+		{
+			yyVAL.indexOptions = yyDollar[1].indexOptions
+		}
+	case 777:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4124
+		{
+			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
+		}
+	case 778:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4128
+		{
+			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
+		}
+	case 779:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4134
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+		}
+	case 780:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4138
+		{
+			// should not be string
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 781:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4143
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 782:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4147
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 783:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4151
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 784:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4155
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: nil}
+		}
+	case 785:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4159
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: nil}
+		}
+	case 786:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4165
+		{
+			yyVAL.str = ""
+		}
+	case 787:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4169
+		{
+			yyVAL.str = string(yyDollar[1].str)
+		}
+	case 788:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4175
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 789:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4179
+		{
+			yyVAL.str = ":="
+		}
+	case 790:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4187
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		}
+	case 791:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4191
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Primary: true, Unique: true}
+		}
+	case 792:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4195
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
+		}
+	case 793:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4199
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true}
+		}
+	case 794:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4203
+		{
+			var name string
+			name = yyDollar[2].str
+			if name == "" {
+				name = yyDollar[5].str
+			}
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].str), Name: NewColIdent(name), Unique: true}
+		}
+	case 795:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4212
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
+		}
+	case 796:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4216
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
+		}
+	case 797:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4220
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
+		}
+	case 798:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4226
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 799:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4230
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 800:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4234
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 801:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4240
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 802:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4244
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 803:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4249
+		{
+			yyVAL.str = ""
+		}
+	case 804:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4253
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 805:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4258
+		{
+			yyVAL.str = ""
+		}
+	case 806:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4262
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 807:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4266
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 808:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4272
+		{
+			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
+		}
+	case 809:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4276
+		{
+			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
+		}
+	case 810:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4282
+		{
+			yyVAL.indexColumn = &IndexColumn{Column: NewColIdent(string(yyDollar[1].bytes)), Length: yyDollar[2].sqlVal, Order: yyDollar[3].str}
+		}
+	case 811:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4286
+		{
+			yyVAL.indexColumn = &IndexColumn{Column: NewColIdent(string(yyDollar[1].bytes)), Length: yyDollar[2].sqlVal, Order: yyDollar[3].str}
+		}
+	case 812:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4292
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 813:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4296
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 814:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4300
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+		}
+	case 815:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:4306
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[5].columns, ReferencedTable: yyDollar[8].tableName, ReferencedColumns: yyDollar[10].columns, Index: string(yyDollar[3].bytes)}
+		}
+	case 816:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:4310
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[5].columns, ReferencedTable: yyDollar[8].tableName, ReferencedColumns: yyDollar[10].columns, OnDelete: yyDollar[12].ReferenceAction, Index: string(yyDollar[3].bytes)}
+		}
+	case 817:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:4314
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[5].columns, ReferencedTable: yyDollar[8].tableName, ReferencedColumns: yyDollar[10].columns, OnUpdate: yyDollar[12].ReferenceAction, Index: string(yyDollar[3].bytes)}
+		}
+	case 818:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sql.y:4318
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[5].columns, ReferencedTable: yyDollar[8].tableName, ReferencedColumns: yyDollar[10].columns, OnDelete: yyDollar[12].ReferenceAction, OnUpdate: yyDollar[13].ReferenceAction, Index: string(yyDollar[3].bytes)}
+		}
+	case 819:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sql.y:4322
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[5].columns, ReferencedTable: yyDollar[8].tableName, ReferencedColumns: yyDollar[10].columns, OnDelete: yyDollar[13].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction, Index: string(yyDollar[3].bytes)}
+		}
+	case 820:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4327
+		{
+			yyVAL.bytes = nil
+		}
+	case 821:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4331
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 822:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4337
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 823:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4342
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 824:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4346
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 825:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4350
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[2].constraintInfo}
+		}
+	case 826:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4354
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+		}
+	case 827:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4360
+		{
+			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
+		}
+	case 828:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4366
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 829:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4370
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 830:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4375
+		{
+			yyVAL.str = ""
+		}
+	case 831:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4379
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 832:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4383
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 833:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4389
+		{
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+		}
+	case 834:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4395
+		{
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+		}
+	case 835:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4401
+		{
+			yyVAL.ReferenceAction = Restrict
+		}
+	case 836:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4405
+		{
+			yyVAL.ReferenceAction = Cascade
+		}
+	case 837:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4409
+		{
+			yyVAL.ReferenceAction = NoAction
+		}
+	case 838:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4413
+		{
+			yyVAL.ReferenceAction = SetDefault
+		}
+	case 839:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4417
+		{
+			yyVAL.ReferenceAction = SetNull
+		}
+	case 840:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4422
+		{
+			yyVAL.boolean = true
+		}
+	case 841:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4426
+		{
+			yyVAL.boolean = true
+		}
+	case 842:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4430
+		{
+			yyVAL.boolean = false
+		}
+	case 843:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4435
+		{
+			yyVAL.str = ""
+		}
+	case 844:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4439
+		{
+			yyVAL.str = yyDollar[1].str + " " + string(yyDollar[2].str)
+		}
+	case 845:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4443
+		{
+			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
+		}
+	case 846:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4449
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + yyDollar[3].str
+		}
+	case 847:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4453
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + yyDollar[3].str
+		}
+	case 848:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4457
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + yyDollar[3].str
+		}
+	case 849:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4461
+		{
+			yyVAL.str = "CHARACTER SET " + string(yyDollar[3].str) + " "
+		}
+	case 850:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4465
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + "CHARACTER SET " + yyDollar[4].str
+		}
+	case 851:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4469
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + yyDollar[4].str
+		}
+	case 852:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4473
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " " + yyDollar[5].str
+		}
+	case 853:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4477
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 854:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4481
+		{
+			yyVAL.str = "CHECKSUM" + " " + string(yyDollar[3].bytes)
+		}
+	case 855:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4485
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + yyDollar[3].str
+		}
+	case 856:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4489
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + yyDollar[4].str
+		}
+	case 857:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4493
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + "'" + string(yyDollar[3].bytes) + "'"
+		}
+	case 858:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4497
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + "'" + string(yyDollar[3].bytes) + "'"
+		}
+	case 859:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4501
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + "'" + string(yyDollar[3].bytes) + "'"
+		}
+	case 860:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4505
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + "'" + string(yyDollar[4].bytes) + "'"
+		}
+	case 861:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4509
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + "'" + string(yyDollar[4].bytes) + "'"
+		}
+	case 862:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4513
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 863:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4517
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + "'" + string(yyDollar[3].bytes) + "'"
+		}
+	case 864:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4521
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 865:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4525
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + "'" + string(yyDollar[3].bytes) + "'"
+		}
+	case 866:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4529
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 867:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4533
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 868:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4537
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 869:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4541
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + yyDollar[3].str
+		}
+	case 870:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4545
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + yyDollar[3].str
+		}
+	case 871:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4549
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + yyDollar[3].str
+		}
+	case 872:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4553
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 873:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4557
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + "'" + string(yyDollar[3].bytes) + "'"
+		}
+	case 874:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4561
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + yyDollar[3].str
+		}
+	case 875:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4565
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
+		}
+	case 876:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4569
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 877:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4573
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 878:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4577
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 879:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4581
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + "'" + string(yyDollar[3].bytes) + "'"
+		}
+	case 880:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4585
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 881:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4589
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 882:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4593
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 883:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4597
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 884:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4601
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + yyDollar[3].str
+		}
+	case 885:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4605
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + yyDollar[2].str
+		}
+	case 886:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4609
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
+		}
+	case 887:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4613
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes)
+		}
+	case 888:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4617
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes)
+		}
+	case 889:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4621
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + "(" + yyDollar[4].str + ")"
+		}
+	case 890:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4627
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 891:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4631
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 892:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4637
+		{
+			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+		}
+	case 893:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4641
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 894:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4646
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 895:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4650
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 896:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4654
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 897:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4660
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 898:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4664
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 899:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4668
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 900:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4674
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 901:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4678
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 902:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4682
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 903:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4686
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 904:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4690
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 905:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4694
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 906:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4700
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 907:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4704
+		{
+			yyVAL.str = yyDollar[1].str + "," + string(yyDollar[3].bytes)
+		}
+	case 911:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4715
+		{
+			yyVAL.str = ""
+		}
+	case 912:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4719
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + yyDollar[3].str + yyDollar[4].str + yyDollar[5].str
+		}
+	case 913:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4723
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + yyDollar[3].str + yyDollar[4].str + yyDollar[5].str + "(partition_definitions)"
+		}
+	case 914:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4729
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 915:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4733
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " (" + string(yyDollar[3].bytes) + ")"
+		}
+	case 916:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4737
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " (column_list)"
+		}
+	case 917:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4741
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " (" + string(yyDollar[3].bytes) + ")"
+		}
+	case 918:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4745
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " (column_list)"
+		}
+	case 919:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4751
+		{
+			yyVAL.str = yyDollar[1].str + string(yyDollar[2].bytes) + " (value)"
+		}
+	case 920:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4755
+		{
+			yyVAL.str = yyDollar[1].str + string(yyDollar[2].bytes) + " (" + string(yyDollar[4].bytes) + ")"
+		}
+	case 921:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4759
+		{
+			yyVAL.str = yyDollar[1].str + string(yyDollar[2].bytes) + " (column_list)"
+		}
+	case 922:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4763
+		{
+			yyVAL.str = yyDollar[1].str + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " " + string(yyDollar[5].bytes) + " (column_list)"
+		}
+	case 923:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4768
+		{
+			yyVAL.str = ""
+		}
+	case 924:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4772
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " "
+		}
+	case 925:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4777
+		{
+			yyVAL.str = ""
+		}
+	case 926:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4781
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " "
+		}
+	case 927:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4786
+		{
+			yyVAL.str = ""
+		}
+	case 928:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4790
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + yyDollar[3].str + " " + yyDollar[4].str
+		}
+	case 929:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4795
+		{
+			yyVAL.str = ""
+		}
+	case 930:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4799
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " "
+		}
+	case 931:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4804
+		{
+			yyVAL.str = ""
+		}
+	case 932:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4808
+		{
+			yyVAL.str = ""
+		}
+	case 933:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4812
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 934:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4817
+		{
+			yyVAL.str = string("")
+		}
+	case 935:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4821
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 941:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:4834
+		{
+			yyDollar[2].viewSpec.ViewName = yyDollar[4].tableName.ToViewName()
+			yyDollar[2].viewSpec.ViewExpr = yyDollar[9].selStmt
+			yyDollar[2].viewSpec.Columns = yyDollar[5].columns
+			yyVAL.statement = &DDL{Action: AlterStr, ViewSpec: yyDollar[2].viewSpec, SpecialCommentMode: yyDollar[8].boolean, SubStatementPositionStart: yyDollar[7].int, SubStatementPositionEnd: yyDollar[10].int - 1}
+		}
+	case 942:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:4841
+		{
+			yyDollar[2].viewSpec.ViewName = yyDollar[4].tableName.ToViewName()
+			yyDollar[2].viewSpec.ViewExpr = yyDollar[9].selStmt
+			yyDollar[2].viewSpec.Columns = yyDollar[5].columns
+			yyDollar[2].viewSpec.CheckOption = yyDollar[11].str
+			yyVAL.statement = &DDL{Action: AlterStr, ViewSpec: yyDollar[2].viewSpec, SpecialCommentMode: yyDollar[8].boolean, SubStatementPositionStart: yyDollar[7].int, SubStatementPositionEnd: yyDollar[10].int - 1}
+		}
+	case 943:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4851
+		{
+			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].bytes), CharsetCollate: yyDollar[4].charsetCollates}
+		}
+	case 944:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4855
+		{
+			yyVAL.statement = &DBDDL{Action: AlterStr, CharsetCollate: yyDollar[3].charsetCollates}
+		}
+	case 945:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4861
+		{
+			for i := 0; i < len(yyDollar[5].ddls); i++ {
+				if yyDollar[5].ddls[i].Action == RenameStr {
+					yyDollar[5].ddls[i].FromTables = append(TableNames{yyDollar[4].tableName}, yyDollar[5].ddls[i].FromTables...)
+				} else {
+					yyDollar[5].ddls[i].Table = yyDollar[4].tableName
+				}
+			}
+			yyVAL.statement = &AlterTable{Table: yyDollar[4].tableName, Statements: yyDollar[5].ddls}
+		}
+	case 946:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4874
+		{
+			yyVAL.ddls = []*DDL{yyDollar[1].ddl}
+		}
+	case 947:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4878
+		{
+			yyVAL.ddls = append(yyVAL.ddls, yyDollar[3].ddl)
+		}
+	case 948:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4884
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddColumn(yyDollar[4].columnDefinition)
+			ddl.Column = yyDollar[4].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 949:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4891
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: AddStr, TableSpec: &TableSpec{}, ColumnOrder: yyDollar[4].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			ddl.Column = yyDollar[3].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 950:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4898
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: DropStr, Column: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 951:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4902
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: DropStr, Column: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 952:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4906
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: RenameStr, Column: NewColIdent(string(yyDollar[3].bytes)), ToColumn: NewColIdent(string(yyDollar[5].bytes))}
+		}
+	case 953:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4910
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: RenameStr, Column: NewColIdent(string(yyDollar[3].bytes)), ToColumn: NewColIdent(string(yyDollar[5].bytes))}
+		}
+	case 954:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4914
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: RenameStr, Column: NewColIdent(string(yyDollar[3].bytes)), ToColumn: NewColIdent(string(yyDollar[5].bytes))}
+		}
+	case 955:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4918
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: RenameStr, Column: NewColIdent(string(yyDollar[3].bytes)), ToColumn: NewColIdent(string(yyDollar[5].bytes))}
+		}
+	case 956:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4922
+		{
+			// Change this to a rename statement
+			yyVAL.ddl = &DDL{Action: RenameStr, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 957:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4927
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr, ToName: NewColIdent(yyDollar[3].str), Using: yyDollar[4].colIdent, Columns: yyDollar[6].indexColumns, Options: yyDollar[8].indexOptions}}
+		}
+	case 958:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:4931
+		{
+			idxName := yyDollar[5].str
+			if len(idxName) == 0 {
+				idxName = yyDollar[2].str
+			}
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr, ToName: NewColIdent(idxName), Type: yyDollar[3].str, Using: yyDollar[6].colIdent, Columns: yyDollar[8].indexColumns, Options: yyDollar[10].indexOptions}}
+		}
+	case 959:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4939
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes)}}}}
+		}
+	case 960:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4944
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes)}}}}
+		}
+	case 961:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4949
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes), Details: &CheckConstraintDefinition{}}}}}
+		}
+	case 962:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4954
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes), Details: &CheckConstraintDefinition{}}}}}
+		}
+	case 963:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4959
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: DropStr, ToName: yyDollar[3].colIdent}}
+		}
+	case 964:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4963
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: RenameStr, FromName: yyDollar[3].colIdent, ToName: yyDollar[5].colIdent}}
+		}
+	case 965:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4967
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: ModifyStr, TableSpec: &TableSpec{}, ColumnOrder: yyDollar[4].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			ddl.Column = yyDollar[3].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 966:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4974
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: ChangeStr, TableSpec: &TableSpec{}, Column: NewColIdent(string(yyDollar[3].bytes)), ColumnOrder: yyDollar[5].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[4].columnDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 967:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4980
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, PartitionSpec: yyDollar[1].partSpec}
+		}
+	case 968:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4984
+		{
+			ddl := &DDL{Action: AlterStr, ConstraintAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 969:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4990
+		{
+			ddl := &DDL{Action: AlterStr, ConstraintAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 970:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4996
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[4].bytes), Details: &ForeignKeyDefinition{}}}}}
+		}
+	case 971:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5001
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AutoIncSpec: &AutoIncSpec{Value: yyDollar[3].expr}}
+		}
+	case 972:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5005
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, DefaultSpec: &DefaultSpec{Action: SetStr, Column: yyDollar[3].colIdent, Value: yyDollar[6].expr}}
+		}
+	case 973:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5009
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, DefaultSpec: &DefaultSpec{Action: DropStr, Column: yyDollar[3].colIdent}}
+		}
+	case 974:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5013
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: DropStr, Type: PrimaryStr}}
+		}
+	case 975:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:5019
+		{
+			ddl := &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr}}
+			ddl.IndexSpec = &IndexSpec{Action: CreateStr, Using: NewColIdent(""), ToName: NewColIdent(yyDollar[2].str), Type: PrimaryStr, Columns: yyDollar[7].indexColumns, Options: yyDollar[9].indexOptions}
+			yyVAL.ddl = ddl
+		}
+	case 976:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5025
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: string(yyDollar[1].bytes)}}
+		}
+	case 977:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5029
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: string(yyDollar[1].bytes)}}
+		}
+	case 978:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5033
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterCollationSpec: &AlterCollationSpec{CharacterSet: yyDollar[5].str, Collation: ""}}
+		}
+	case 979:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:5037
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterCollationSpec: &AlterCollationSpec{CharacterSet: yyDollar[5].str, Collation: yyDollar[8].str}}
+		}
+	case 980:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5041
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterCollationSpec: &AlterCollationSpec{CharacterSet: "", Collation: yyDollar[4].str}}
+		}
+	case 981:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5045
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 982:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5049
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 983:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5053
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 984:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5057
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 985:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5061
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 986:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5065
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 987:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5069
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 988:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5073
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 989:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5077
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 990:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5081
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 991:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5085
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterOptionSpec: &AlterOptionSpec{Force: true}}
+		}
+	case 992:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5089
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterOptionSpec: &AlterOptionSpec{Engine: string(yyDollar[3].bytes)}}
+		}
+	case 993:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5093
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterOptionSpec: &AlterOptionSpec{Engine: string(yyDollar[3].bytes)}}
+		}
+	case 994:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5097
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterOptionSpec: &AlterOptionSpec{Algorithm: string(yyDollar[3].bytes)}}
+		}
+	case 995:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5101
+		{
+			switch strings.ToLower(string(yyDollar[3].bytes)) {
+			case "instant", "inplace", "copy":
+			default:
+				yylex.Error(fmt.Sprintf("unknown ALGORITHM '%s'", string(yyDollar[3].bytes)))
+				return 1
+			}
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterOptionSpec: &AlterOptionSpec{Algorithm: string(yyDollar[3].bytes)}}
+		}
+	case 996:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5111
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterOptionSpec: &AlterOptionSpec{Lock: string(yyDollar[3].bytes)}}
+		}
+	case 997:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5115
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterOptionSpec: &AlterOptionSpec{Lock: string(yyDollar[3].bytes)}}
+		}
+	case 998:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5119
+		{
+			switch strings.ToLower(string(yyDollar[3].bytes)) {
+			case "shared", "exclusive":
+			default:
+				yylex.Error(fmt.Sprintf("unknown LOCK type '%s'", string(yyDollar[3].bytes)))
+				return 1
+			}
+			yyVAL.ddl = &DDL{Action: AlterStr, AlterOptionSpec: &AlterOptionSpec{Lock: string(yyDollar[3].bytes)}}
+		}
+	case 999:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5131
+		{
+			var ifExists bool
+			if yyDollar[3].byt != 0 {
+				ifExists = true
+			}
+			yyVAL.statement = &DDL{Action: AlterStr, User: yyDollar[4].accountName, Authentication: yyDollar[5].authentication, IfExists: ifExists}
+		}
+	case 1000:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5140
+		{
+			yyVAL.columnOrder = nil
+		}
+	case 1001:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5144
+		{
+			yyVAL.columnOrder = &ColumnOrder{First: true}
+		}
+	case 1002:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5148
+		{
+			yyVAL.columnOrder = &ColumnOrder{AfterColumn: NewColIdent(string(yyDollar[2].bytes))}
+		}
+	case 1003:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5153
+		{
+		}
+	case 1004:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5155
+		{
+		}
+	case 1005:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:5159
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
+		}
+	case 1006:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5165
+		{
+			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
+		}
+	case 1007:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5169
+		{
+			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
+		}
+	case 1008:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:5175
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
+		}
+	case 1009:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:5179
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+		}
+	case 1010:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:5185
+		{
+			es := &EventSpec{EventName: yyDollar[4].eventName, Definer: yyDollar[2].str, OnCompletionPreserve: yyDollar[5].eventOnCompletion, RenameName: yyDollar[6].eventName, Status: yyDollar[7].eventStatus, Comment: yyDollar[8].sqlVal}
+			if err := es.ValidateAlterEvent(); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.statement = &DDL{Action: AlterStr, EventSpec: es}
+		}
+	case 1011:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:5194
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, EventSpec: &EventSpec{EventName: yyDollar[4].eventName, Definer: yyDollar[2].str, OnSchedule: yyDollar[7].eventScheduleSpec, OnCompletionPreserve: yyDollar[8].eventOnCompletion, RenameName: yyDollar[9].eventName, Status: yyDollar[10].eventStatus, Comment: yyDollar[11].sqlVal}}
+		}
+	case 1012:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:5198
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, EventSpec: &EventSpec{EventName: yyDollar[4].eventName, Definer: yyDollar[2].str, OnCompletionPreserve: yyDollar[5].eventOnCompletion, RenameName: yyDollar[6].eventName, Status: yyDollar[7].eventStatus, Comment: yyDollar[8].sqlVal, Body: yyDollar[11].statement}, SubStatementPositionStart: yyDollar[10].int, SubStatementPositionEnd: yyDollar[12].int - 1}
+		}
+	case 1013:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:5202
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, EventSpec: &EventSpec{EventName: yyDollar[4].eventName, Definer: yyDollar[2].str, OnSchedule: yyDollar[7].eventScheduleSpec, OnCompletionPreserve: yyDollar[8].eventOnCompletion, RenameName: yyDollar[9].eventName, Status: yyDollar[10].eventStatus, Comment: yyDollar[11].sqlVal, Body: yyDollar[14].statement}, SubStatementPositionStart: yyDollar[13].int, SubStatementPositionEnd: yyDollar[15].int - 1}
+		}
+	case 1014:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5207
+		{
+			yyVAL.eventName = EventName{}
+		}
+	case 1015:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5211
+		{
+			yyVAL.eventName = yyDollar[3].eventName
+		}
+	case 1016:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5217
+		{
+			yyVAL.statement = yyDollar[3].ddl
+		}
+	case 1017:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5221
+		{
+			yyVAL.statement = &RenameUser{Accounts: yyDollar[3].accountRenames}
+		}
+	case 1018:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5227
+		{
+			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 1019:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5231
+		{
+			yyVAL.ddl = yyDollar[1].ddl
+			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
+			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
+		}
+	case 1020:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5239
+		{
+			yyVAL.accountRenames = []AccountRename{{From: yyDollar[1].accountName, To: yyDollar[3].accountName}}
+		}
+	case 1021:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5243
+		{
+			yyVAL.accountRenames = append(yyDollar[1].accountRenames, AccountRename{From: yyDollar[3].accountName, To: yyDollar[5].accountName})
+		}
+	case 1022:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5249
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 1023:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5257
+		{
+			// For consistency, we always use a AlterTable for ALTER TABLE equivalent statements
+			ddl := &DDL{Action: AlterStr, Table: yyDollar[5].tableName, IndexSpec: &IndexSpec{Action: DropStr, ToName: yyDollar[3].colIdent}}
+			yyVAL.statement = &AlterTable{Table: yyDollar[5].tableName, Statements: []*DDL{ddl}}
+		}
+	case 1024:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5263
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromViews: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 1025:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5271
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes), IfExists: exists}
+		}
+	case 1026:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5279
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes), IfExists: exists}
+		}
+	case 1027:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5287
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, TriggerSpec: &TriggerSpec{TrigName: yyDollar[4].triggerName}, IfExists: exists}
+		}
+	case 1028:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5295
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, ProcedureSpec: &ProcedureSpec{ProcName: yyDollar[4].procedureName}, IfExists: exists}
+		}
+	case 1029:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5303
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DropUser{IfExists: exists, AccountNames: yyDollar[4].accountNames}
+		}
+	case 1030:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5311
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DropRole{IfExists: exists, Roles: yyDollar[4].accountNames}
+		}
+	case 1031:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5319
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, EventSpec: &EventSpec{EventName: yyDollar[4].eventName}, IfExists: exists}
+		}
+	case 1032:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5328
+		{
+
+		}
+	case 1033:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5332
+		{
+			yyVAL.ReferenceAction = Restrict
+		}
+	case 1034:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5336
+		{
+			yyVAL.ReferenceAction = Cascade
+		}
+	case 1035:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5342
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+		}
+	case 1036:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5346
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+		}
+	case 1037:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5352
+		{
+			yyVAL.statement = yyDollar[3].statement
+		}
+	case 1038:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:5358
+		{
+			yyVAL.statement = &Analyze{Tables: []TableName{yyDollar[1].tableName}, Action: UpdateStr, Columns: yyDollar[5].columns, Using: yyDollar[8].expr}
+		}
+	case 1039:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5362
+		{
+			yyVAL.statement = &Analyze{Tables: []TableName{yyDollar[1].tableName}, Action: DropStr, Columns: yyDollar[5].columns}
+		}
+	case 1040:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5366
+		{
+			yyVAL.statement = &Analyze{Tables: yyDollar[1].tableNames}
+		}
+	case 1045:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5378
+		{
+			yyVAL.statement = &Prepare{Name: string(yyDollar[2].bytes), Expr: string(yyDollar[4].bytes)}
+		}
+	case 1046:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5382
+		{
+			yyVAL.statement = &Prepare{Name: string(yyDollar[2].bytes), Expr: string(yyDollar[4].bytes)}
+		}
+	case 1047:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5386
+		{
+			yyVAL.statement = &Prepare{Name: string(yyDollar[2].bytes), Expr: string(yyDollar[4].str)}
+		}
+	case 1048:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5390
+		{
+			yyVAL.statement = &Prepare{Name: string(yyDollar[2].bytes), Expr: string(yyDollar[4].str)}
+		}
+	case 1049:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5396
+		{
+			yyVAL.strs = []string{yyDollar[1].str}
+		}
+	case 1050:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5400
+		{
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
+		}
+	case 1051:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5407
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 1052:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5411
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 1053:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5417
+		{
+			yyVAL.statement = &Execute{Name: string(yyDollar[2].bytes)}
+		}
+	case 1054:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5421
+		{
+			yyVAL.statement = &Execute{Name: string(yyDollar[2].bytes)}
+		}
+	case 1055:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5425
+		{
+			yyVAL.statement = &Execute{Name: string(yyDollar[2].bytes), VarList: yyDollar[4].strs}
+		}
+	case 1056:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5429
+		{
+			yyVAL.statement = &Execute{Name: string(yyDollar[2].bytes), VarList: yyDollar[4].strs}
+		}
+	case 1057:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5435
+		{
+			yyVAL.statement = &Deallocate{Name: string(yyDollar[3].bytes)}
+		}
+	case 1058:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5439
+		{
+			yyVAL.statement = &Deallocate{Name: string(yyDollar[3].bytes)}
+		}
+	case 1059:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5443
+		{
+			yyVAL.statement = &Deallocate{Name: string(yyDollar[3].bytes)}
+		}
+	case 1060:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5447
+		{
+			yyVAL.statement = &Deallocate{Name: string(yyDollar[3].bytes)}
+		}
+	case 1061:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5453
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 1062:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5457
+		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), "master") {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.statement = &Show{Type: "binary logs"}
+		}
+	case 1063:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5465
+		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), "replicas") {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.statement = &Show{Type: "replicas"}
+		}
+	case 1064:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5473
+		{
+			yyVAL.statement = &Show{Type: "replicas"}
+		}
+	case 1065:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5477
+		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), "binlog") {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.statement = &Show{Type: "binlog events", BinlogEventsOpt: &ShowBinlogEventsOpt{LogName: yyDollar[4].str, Pos: yyDollar[5].uint64}, Limit: yyDollar[6].limit}
+		}
+	case 1066:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5486
+		{
+			yyVAL.statement = &Show{Type: CharsetStr, Filter: yyDollar[4].showFilter}
+		}
+	case 1067:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5490
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), Filter: yyDollar[3].showFilter}
+		}
+	case 1068:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5494
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), IfNotExists: yyDollar[4].byt == 1, Database: string(yyDollar[5].bytes)}
+		}
+	case 1069:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5498
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), IfNotExists: yyDollar[4].byt == 1, Database: string(yyDollar[5].bytes)}
+		}
+	case 1070:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5502
+		{
+			showTablesOpt := &ShowTablesOpt{AsOf: yyDollar[5].expr}
+			yyVAL.statement = &Show{Type: CreateTableStr, Table: yyDollar[4].tableName, ShowTablesOpt: showTablesOpt}
+		}
+	case 1071:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5507
+		{
+			yyVAL.statement = &Show{Type: CreateProcedureStr, Table: yyDollar[4].tableName}
+		}
+	case 1072:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5511
+		{
+			yyVAL.statement = &Show{Type: CreateTriggerStr, Table: yyDollar[4].tableName}
+		}
+	case 1073:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5515
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+		}
+	case 1074:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5519
+		{
+			yyVAL.statement = &Show{Type: CreateEventStr, Table: yyDollar[4].tableName}
+		}
+	case 1075:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5523
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), Filter: yyDollar[3].showFilter}
+		}
+	case 1076:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5527
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 1077:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5531
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 1078:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5535
+		{
+			yyVAL.statement = &Show{Type: IndexStr, Table: yyDollar[4].tableName, Database: yyDollar[5].str, ShowIndexFilterOpt: yyDollar[6].expr}
+		}
+	case 1079:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5539
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 1080:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5543
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 1081:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5547
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 1082:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5551
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 1083:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5555
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 1084:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5559
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Database: yyDollar[4].str, Filter: yyDollar[5].showFilter}
+		}
+	case 1085:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:5563
+		{
+			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[6].str, AsOf: yyDollar[7].expr, Filter: yyDollar[8].showFilter}
+			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, Table: yyDollar[5].tableName, Full: yyDollar[2].boolean}
+		}
+	case 1086:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5568
+		{
+			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[4].str, Filter: yyDollar[6].showFilter, AsOf: yyDollar[5].expr}
+			yyVAL.statement = &Show{Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt, Full: yyDollar[2].boolean}
+		}
+	case 1087:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5573
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[3].bytes), Full: yyDollar[2].boolean}
+		}
+	case 1088:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5577
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowTablesOpt: &ShowTablesOpt{DbName: yyDollar[3].str, Filter: yyDollar[4].showFilter}}
+		}
+	case 1089:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5581
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 1090:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5585
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 1091:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5589
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: yyDollar[4].expr}
+		}
+	case 1092:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5593
+		{
+			cmp := yyDollar[3].expr.(*ComparisonExpr)
+			cmp.Left = &ColName{Name: NewColIdent("collation")}
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: cmp}
+		}
+	case 1093:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5599
+		{
+			yyVAL.statement = &ShowGrants{}
+		}
+	case 1094:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5603
+		{
+			an := yyDollar[4].accountName
+			yyVAL.statement = &ShowGrants{For: &an}
+		}
+	case 1095:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5608
+		{
+			yyVAL.statement = &ShowGrants{CurrentUser: true}
+		}
+	case 1096:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5612
+		{
+			an := yyDollar[4].accountName
+			yyVAL.statement = &ShowGrants{For: &an, Using: yyDollar[6].accountNames}
+		}
+	case 1097:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5617
+		{
+			yyVAL.statement = &ShowPrivileges{}
+		}
+	case 1098:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5621
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[6].bytes), CountStar: true}
+		}
+	case 1099:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5625
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[6].bytes), CountStar: true}
+		}
+	case 1100:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5629
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), Limit: yyDollar[3].limit}
+		}
+	case 1101:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5633
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), Limit: yyDollar[3].limit}
+		}
+	case 1102:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5637
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowTablesOpt: &ShowTablesOpt{DbName: yyDollar[3].str, Filter: yyDollar[4].showFilter}}
+		}
+	case 1103:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5643
+		{
+			yyVAL.expr = &ComparisonExpr{Operator: LikeStr, Right: yyDollar[2].expr, Escape: yyDollar[3].expr}
+		}
+	case 1104:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5649
+		{
+			yyVAL.boolean = false
+		}
+	case 1105:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5653
+		{
+			yyVAL.boolean = true
+		}
+	case 1106:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5659
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 1107:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5663
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 1108:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5669
+		{
+			yyVAL.str = ""
+		}
+	case 1109:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5673
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 1110:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5677
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 1111:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5683
+		{
+			yyVAL.showFilter = nil
+		}
+	case 1112:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5687
+		{
+			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
+		}
+	case 1113:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5691
+		{
+			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[3].bytes)}
+		}
+	case 1114:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5695
+		{
+			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
+		}
+	case 1115:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5701
+		{
+			yyVAL.str = ""
+		}
+	case 1116:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5705
+		{
+			yyVAL.str = SessionStr
+		}
+	case 1117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5709
+		{
+			yyVAL.str = GlobalStr
+		}
+	case 1118:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5715
+		{
+			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
+		}
+	case 1119:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5719
+		{
+			yyVAL.statement = &Use{DBName: TableIdent{v: yyDollar[2].tableIdent.v + "/" + yyDollar[4].tableIdent.v}}
+		}
+	case 1120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5723
+		{
+			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
+		}
+	case 1121:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5728
+		{
+			yyVAL.bytes = nil
+		}
+	case 1122:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5732
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 1123:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5738
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 1124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5742
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 1125:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5748
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 1126:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5752
+		{
+			yyVAL.statement = &Begin{TransactionCharacteristic: TxReadWrite}
+		}
+	case 1127:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5756
+		{
+			yyVAL.statement = &Begin{TransactionCharacteristic: TxReadOnly}
+		}
+	case 1128:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5761
+		{
+			yyVAL.bytes = nil
+		}
+	case 1129:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5765
+		{
+			yyVAL.bytes = nil
+		}
+	case 1130:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5770
+		{
+			yyVAL.bytes = nil
+		}
+	case 1131:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5774
+		{
+			yyVAL.bytes = nil
+		}
+	case 1132:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5779
+		{
+			yyVAL.bytes = nil
+		}
+	case 1133:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5783
+		{
+			yyVAL.bytes = nil
+		}
+	case 1134:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5789
+		{
+			yyVAL.statement = &Commit{}
+		}
+	case 1135:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5795
+		{
+			yyVAL.statement = &Rollback{}
+		}
+	case 1136:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5801
+		{
+			yyVAL.statement = &Savepoint{Identifier: string(yyDollar[2].bytes)}
+		}
+	case 1137:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5807
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 1138:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5811
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 1139:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5815
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 1140:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5819
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[5].bytes)}
+		}
+	case 1141:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5825
+		{
+			yyVAL.statement = &ReleaseSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 1142:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5831
+		{
+			yyVAL.statement = &Explain{ExplainFormat: yyDollar[2].str, Statement: yyDollar[3].statement}
+		}
+	case 1143:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5835
+		{
+			yyVAL.statement = &Explain{ExplainFormat: yyDollar[3].str, Statement: yyDollar[4].statement}
+		}
+	case 1144:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5839
+		{
+			yyVAL.statement = &Explain{Analyze: true, ExplainFormat: TreeStr, Statement: yyDollar[3].selStmt}
+		}
+	case 1145:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5845
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 1149:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5853
+		{
+			yyVAL.str = ""
+		}
+	case 1150:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5857
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 1154:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5869
+		{
+			showTablesOpt := &ShowTablesOpt{AsOf: yyDollar[3].expr}
+			yyVAL.statement = &Show{Type: "columns", Table: yyDollar[2].tableName, ShowTablesOpt: showTablesOpt}
+		}
+	case 1155:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5875
+		{
+			setAllowComments(yylex, true)
+		}
+	case 1156:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5879
+		{
+			yyVAL.bytes2 = yyDollar[2].bytes2
+			setAllowComments(yylex, false)
+		}
+	case 1157:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5885
+		{
+			yyVAL.bytes2 = nil
+		}
+	case 1158:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5889
+		{
+			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
+		}
+	case 1159:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5895
+		{
+			yyVAL.str = UnionStr
+		}
+	case 1160:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5899
+		{
+			yyVAL.str = UnionAllStr
+		}
+	case 1161:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5903
+		{
+			yyVAL.str = UnionDistinctStr
+		}
+	case 1162:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5909
+		{
+			yyVAL.str = IntersectStr
+		}
+	case 1163:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5913
+		{
+			yyVAL.str = IntersectAllStr
+		}
+	case 1164:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5917
+		{
+			yyVAL.str = IntersectDistinctStr
+		}
+	case 1165:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5923
+		{
+			yyVAL.str = ExceptStr
+		}
+	case 1166:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5927
+		{
+			yyVAL.str = ExceptAllStr
+		}
+	case 1167:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5931
+		{
+			yyVAL.str = ExceptDistinctStr
+		}
+	case 1168:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5936
+		{
+			yyVAL.queryOpts = QueryOpts{}
+		}
+	case 1169:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5940
+		{
+			opt := QueryOpts{All: true}
+			if err := yyDollar[1].queryOpts.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.queryOpts = yyDollar[1].queryOpts
+		}
+	case 1170:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5949
+		{
+			opt := QueryOpts{Distinct: true}
+			if err := yyDollar[1].queryOpts.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.queryOpts = yyDollar[1].queryOpts
+		}
+	case 1171:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5958
+		{
+			opt := QueryOpts{StraightJoinHint: true}
+			if err := yyDollar[1].queryOpts.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.queryOpts = yyDollar[1].queryOpts
+		}
+	case 1172:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5967
+		{
+			opt := QueryOpts{SQLSmallResult: true}
+			if err := yyDollar[1].queryOpts.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.queryOpts = yyDollar[1].queryOpts
+		}
+	case 1173:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5976
+		{
+			opt := QueryOpts{SQLBigResult: true}
+			if err := yyDollar[1].queryOpts.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.queryOpts = yyDollar[1].queryOpts
+		}
+	case 1174:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5985
+		{
+			opt := QueryOpts{SQLCalcFoundRows: true}
+			if err := yyDollar[1].queryOpts.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.queryOpts = yyDollar[1].queryOpts
+		}
+	case 1175:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5994
+		{
+			opt := QueryOpts{SQLCache: true}
+			if err := yyDollar[1].queryOpts.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.queryOpts = yyDollar[1].queryOpts
+		}
+	case 1176:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6003
+		{
+			opt := QueryOpts{SQLNoCache: true}
+			if err := yyDollar[1].queryOpts.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.queryOpts = yyDollar[1].queryOpts
+		}
+	case 1177:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6013
+		{
+			yyVAL.str = ""
+		}
+	case 1178:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6017
+		{
+			yyVAL.str = AllStr
+		}
+	case 1179:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6021
+		{
+			yyVAL.str = DistinctStr
+		}
+	case 1180:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6027
+		{
+			if ae, ok := yyDollar[2].selectExpr.(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[1].int
+				ae.EndParsePos = yyDollar[3].int - 1
+			}
+			yyVAL.selectExprs = SelectExprs{yyDollar[2].selectExpr}
+		}
+	case 1181:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6035
+		{
+			if ae, ok := yyDollar[4].selectExpr.(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[3].int
+				ae.EndParsePos = yyDollar[5].int - 1
+			}
+			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[4].selectExpr)
+		}
+	case 1182:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6046
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 1183:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6050
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr}
+		}
+	case 1184:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6054
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 1185:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6058
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 1186:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6064
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 1187:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6068
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
+		}
+	case 1188:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6072
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 1189:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6076
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 1190:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6082
+		{
+			yyVAL.over = &Over{NameRef: yyDollar[2].colIdent}
+		}
+	case 1191:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6086
+		{
+			yyVAL.over = (*Over)(yyDollar[2].windowDef)
+		}
+	case 1192:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:6092
+		{
+			yyVAL.windowDef = &WindowDef{NameRef: yyDollar[2].colIdent, PartitionBy: yyDollar[3].exprs, OrderBy: yyDollar[4].orderBy, Frame: yyDollar[5].frame}
+		}
+	case 1193:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6097
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 1194:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6100
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1195:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6105
+		{
+			yyVAL.exprs = nil
+		}
+	case 1196:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6109
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 1197:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6114
+		{
+			yyVAL.over = nil
+		}
+	case 1198:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6118
+		{
+			yyVAL.over = yyDollar[1].over
+		}
+	case 1199:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6123
+		{
+			yyVAL.frame = nil
+		}
+	case 1200:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6127
+		{
+			yyVAL.frame = &Frame{Unit: RowsUnit, Extent: yyDollar[2].frameExtent}
+		}
+	case 1201:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6131
+		{
+			yyVAL.frame = &Frame{Unit: RangeUnit, Extent: yyDollar[2].frameExtent}
+		}
+	case 1202:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6138
+		{
+			startBound := yyDollar[2].frameBound
+			endBound := yyDollar[4].frameBound
+			switch {
+			case startBound.Type == UnboundedFollowing:
+				yylex.Error("frame start cannot be UNBOUNDED FOLLOWING")
+				return 1
+			case endBound.Type == UnboundedPreceding:
+				yylex.Error("frame end cannot be UNBOUNDED PRECEDING")
+				return 1
+			case startBound.Type == CurrentRow && endBound.Type == ExprPreceding:
+				yylex.Error("frame starting from current row cannot have preceding rows")
+				return 1
+			case startBound.Type == ExprFollowing && endBound.Type == ExprPreceding:
+				yylex.Error("frame starting from following row cannot have preceding rows")
+				return 1
+			case startBound.Type == ExprFollowing && endBound.Type == CurrentRow:
+				yylex.Error("frame starting from following row cannot have preceding rows")
+				return 1
+			}
+			yyVAL.frameExtent = &FrameExtent{Start: startBound, End: endBound}
+		}
+	case 1203:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6161
+		{
+			startBound := yyDollar[1].frameBound
+			switch {
+			case startBound.Type == UnboundedFollowing:
+				yylex.Error("frame start cannot be UNBOUNDED FOLLOWING")
+				return 1
+			case startBound.Type == ExprFollowing:
+				yylex.Error("frame starting from following row cannot end with current row")
+				return 1
+			}
+			yyVAL.frameExtent = &FrameExtent{Start: startBound}
+		}
+	case 1204:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6176
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedPreceding}
+		}
+	case 1205:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6180
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedFollowing}
+		}
+	case 1206:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6184
+		{
+			yyVAL.frameBound = &FrameBound{Type: CurrentRow}
+		}
+	case 1207:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6188
+		{
+			yyVAL.frameBound = &FrameBound{
+				Expr: yyDollar[1].expr,
+				Type: ExprPreceding,
+			}
+		}
+	case 1208:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6195
+		{
+			yyVAL.frameBound = &FrameBound{
+				Expr: yyDollar[1].expr,
+				Type: ExprFollowing,
+			}
+		}
+	case 1209:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6203
+		{
+			yyVAL.window = nil
+		}
+	case 1210:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6206
+		{
+			yyVAL.window = yyDollar[2].window
+		}
+	case 1211:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6212
+		{
+			yyVAL.window = Window{yyDollar[1].windowDef}
+		}
+	case 1212:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6215
+		{
+			yyVAL.window = append(yyDollar[1].window, yyDollar[3].windowDef)
+		}
+	case 1213:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6221
+		{
+			def := yyDollar[3].windowDef
+			def.Name = yyDollar[1].colIdent
+			yyVAL.windowDef = def
+		}
+	case 1235:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6255
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1236:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6259
+		{
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: string(yyDollar[3].bytes)}
+		}
+	case 1237:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6264
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 1238:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6268
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 1239:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6272
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 1240:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6276
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[2].bytes))
+		}
+	case 1241:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6282
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1242:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6286
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1243:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6290
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1244:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6296
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 1245:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6300
+		{
+			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
+		}
+	case 1248:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6310
+		{
+			yyVAL.tableExpr = yyDollar[1].aliasedTableName
+		}
+	case 1249:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6314
+		{
+			switch n := yyDollar[1].simpleTableExpr.(type) {
+			case *Subquery:
+				n.Columns = yyDollar[4].columns
+			case *ValuesStatement:
+				n.Columns = yyDollar[4].columns
+			}
+			yyVAL.tableExpr = &AliasedTableExpr{Lateral: false, Expr: yyDollar[1].simpleTableExpr, As: yyDollar[3].tableIdent}
+		}
+	case 1250:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6324
+		{
+			switch n := yyDollar[2].simpleTableExpr.(type) {
+			case *Subquery:
+				n.Columns = yyDollar[5].columns
+			case *ValuesStatement:
+				n.Columns = yyDollar[5].columns
+			}
+			yyVAL.tableExpr = &AliasedTableExpr{Lateral: true, Expr: yyDollar[2].simpleTableExpr, As: yyDollar[4].tableIdent}
+		}
+	case 1251:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6334
+		{
+			// missed alias for subquery
+			yylex.Error("Every derived table must have its own alias")
+			return 1
+		}
+	case 1252:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6340
+		{
+			// missed alias for subquery
+			yylex.Error("Every derived table must have its own alias")
+			return 1
+		}
+	case 1253:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6346
+		{
+			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
+		}
+	case 1256:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6355
+		{
+			yyVAL.simpleTableExpr = &ValuesStatement{Rows: yyDollar[2].values}
+		}
+	case 1257:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6361
+		{
+			yyVAL.values = Values{yyDollar[2].valTuple}
+		}
+	case 1258:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6365
+		{
+			yyVAL.values = append(yyVAL.values, yyDollar[4].valTuple)
+		}
+	case 1259:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6371
+		{
+			yyVAL.aliasedTableName = yyDollar[2].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+		}
+	case 1260:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:6376
+		{
+			yyVAL.aliasedTableName = yyDollar[6].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+			yyVAL.aliasedTableName.Partitions = yyDollar[4].partitions
+		}
+	case 1261:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6386
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{Hints: yyDollar[1].indexHints}
+		}
+	case 1262:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6390
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
+		}
+	case 1263:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6394
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{AsOf: yyDollar[1].asOf, Hints: yyDollar[2].indexHints}
+		}
+	case 1264:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6398
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{AsOf: yyDollar[1].asOf, As: yyDollar[3].tableIdent, Hints: yyDollar[4].indexHints}
+		}
+	case 1265:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6404
+		{
+			yyVAL.asOf = yyDollar[1].asOf
+		}
+	case 1266:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6408
+		{
+			yyVAL.asOf = yyDollar[1].asOf
+		}
+	case 1267:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6412
+		{
+			yyVAL.asOf = yyDollar[1].asOf
+		}
+	case 1268:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6416
+		{
+			yyVAL.asOf = yyDollar[1].asOf
+		}
+	case 1269:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6420
+		{
+			yyVAL.asOf = yyDollar[1].asOf
+		}
+	case 1270:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6426
+		{
+			yyVAL.asOf = &AsOf{Start: yyDollar[3].expr, End: yyDollar[5].expr, EndInclusive: true}
+		}
+	case 1271:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6430
+		{
+			yyVAL.asOf = &AsOf{Start: yyDollar[3].expr, End: yyDollar[5].expr}
+		}
+	case 1272:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:6434
+		{
+			yyVAL.asOf = &AsOf{Start: yyDollar[5].expr, End: yyDollar[7].expr, StartInclusive: true, EndInclusive: true}
+		}
+	case 1273:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6440
+		{
+			yyVAL.asOf = &AsOf{Start: yyDollar[3].expr, End: yyDollar[5].expr, EndInclusive: true}
+		}
+	case 1274:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6444
+		{
+			yyVAL.asOf = &AsOf{Start: yyDollar[3].expr, End: yyDollar[5].expr}
+		}
+	case 1275:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:6448
+		{
+			yyVAL.asOf = &AsOf{Start: yyDollar[5].expr, End: yyDollar[7].expr, StartInclusive: true, EndInclusive: true}
+		}
+	case 1276:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6452
+		{
+			yyVAL.asOf = &AsOf{Start: yyDollar[3].expr, End: yyDollar[5].expr, EndInclusive: true}
+		}
+	case 1277:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6456
+		{
+			yyVAL.asOf = &AsOf{Start: yyDollar[3].expr, End: yyDollar[5].expr}
+		}
+	case 1278:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:6460
+		{
+			yyVAL.asOf = &AsOf{Start: yyDollar[5].expr, End: yyDollar[7].expr, StartInclusive: true, EndInclusive: true}
+		}
+	case 1279:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6466
+		{
+			yyVAL.asOf = &AsOf{All: true}
+		}
+	case 1280:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6472
+		{
+			yyVAL.asOf = &AsOf{All: true}
+		}
+	case 1281:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6476
+		{
+			yyVAL.asOf = &AsOf{All: true}
+		}
+	case 1282:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6482
+		{
+			yyVAL.asOf = &AsOf{Time: yyDollar[3].expr}
+		}
+	case 1283:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6486
+		{
+			yyVAL.asOf = &AsOf{Time: yyDollar[4].expr}
+		}
+	case 1284:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6490
+		{
+			yyVAL.asOf = &AsOf{Time: yyDollar[4].expr}
+		}
+	case 1285:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6495
+		{
+			yyVAL.expr = nil
+		}
+	case 1286:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6499
+		{
+			yyVAL.expr = yyDollar[1].asOf.Time
+		}
+	case 1287:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6505
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 1288:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6509
+		{
+			yyVAL.columns = yyDollar[1].columns
+		}
+	case 1289:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6514
+		{
+			yyVAL.columns = nil
+		}
+	case 1290:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6518
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 1291:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6524
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 1292:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6528
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 1293:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6534
+		{
+			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
+		}
+	case 1294:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6538
+		{
+			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
+		}
+	case 1295:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6544
+		{
+			yyVAL.tableExpr = &TableFuncExpr{Name: string(yyDollar[1].bytes), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1296:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:6548
+		{
+			yyVAL.tableExpr = &TableFuncExpr{Name: string(yyDollar[1].bytes), Exprs: yyDollar[3].selectExprs, Alias: yyDollar[6].tableIdent}
+		}
+	case 1297:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6562
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 1298:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6566
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 1299:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6570
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 1300:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6574
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
+		}
+	case 1301:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6580
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 1302:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6582
+		{
+			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
+		}
+	case 1303:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6586
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 1304:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6588
+		{
+			yyVAL.joinCondition = yyDollar[1].joinCondition
+		}
+	case 1305:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6592
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 1306:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6594
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 1307:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6597
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1308:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6599
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1310:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6604
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1311:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6610
+		{
+			yyVAL.str = JoinStr
+		}
+	case 1312:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6614
+		{
+			yyVAL.str = JoinStr
+		}
+	case 1313:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6618
+		{
+			yyVAL.str = JoinStr
+		}
+	case 1314:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6624
+		{
+			yyVAL.str = StraightJoinStr
+		}
+	case 1315:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6630
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 1316:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6634
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 1317:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6638
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 1318:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6642
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 1319:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6646
+		{
+			yyVAL.str = FullOuterJoinStr
+		}
+	case 1320:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6650
+		{
+			yyVAL.str = FullOuterJoinStr
+		}
+	case 1321:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6656
+		{
+			yyVAL.str = NaturalJoinStr
+		}
+	case 1322:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6660
+		{
+			if yyDollar[2].str == LeftJoinStr {
+				yyVAL.str = NaturalLeftJoinStr
+			} else {
+				yyVAL.str = NaturalRightJoinStr
+			}
+		}
+	case 1323:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:6670
+		{
+			yyDollar[8].JSONTableSpec.Path = string(yyDollar[5].bytes)
+			yyVAL.tableExpr = &JSONTableExpr{Data: yyDollar[3].expr, Spec: yyDollar[8].JSONTableSpec, Alias: yyDollar[12].tableIdent}
+		}
+	case 1324:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6677
+		{
+			yyVAL.JSONTableSpec = &JSONTableSpec{}
+			yyVAL.JSONTableSpec.AddColumn(yyDollar[1].JSONTableColDef)
+		}
+	case 1325:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6682
+		{
+			yyVAL.JSONTableSpec.AddColumn(yyDollar[3].JSONTableColDef)
+		}
+	case 1326:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6688
+		{
+			yyVAL.JSONTableColDef = &JSONTableColDef{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType, Opts: yyDollar[3].JSONTableColOpts}
+		}
+	case 1327:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6692
+		{
+			yyVAL.JSONTableColDef = &JSONTableColDef{Name: yyDollar[1].colIdent, Type: ColumnType{Type: "INTEGER", Unsigned: true, Autoincrement: true}}
+		}
+	case 1328:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:6696
+		{
+			yyDollar[5].JSONTableSpec.Path = string(yyDollar[2].bytes)
+			yyVAL.JSONTableColDef = &JSONTableColDef{Spec: yyDollar[5].JSONTableSpec}
+		}
+	case 1329:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:6701
+		{
+			yyDollar[6].JSONTableSpec.Path = string(yyDollar[3].bytes)
+			yyVAL.JSONTableColDef = &JSONTableColDef{Spec: yyDollar[6].JSONTableSpec}
+		}
+	case 1330:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6708
+		{
+			yyVAL.JSONTableColOpts = JSONTableColOpts{Path: string(yyDollar[2].bytes)}
+		}
+	case 1331:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6712
+		{
+			yyVAL.JSONTableColOpts = JSONTableColOpts{Path: string(yyDollar[2].bytes), ValOnEmpty: yyDollar[3].expr}
+		}
+	case 1332:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6716
+		{
+			yyVAL.JSONTableColOpts = JSONTableColOpts{Path: string(yyDollar[2].bytes), ValOnError: yyDollar[3].expr}
+		}
+	case 1333:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6720
+		{
+			yyVAL.JSONTableColOpts = JSONTableColOpts{Path: string(yyDollar[2].bytes), ValOnEmpty: yyDollar[3].expr, ValOnError: yyDollar[4].expr}
+		}
+	case 1334:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6724
+		{
+			yyVAL.JSONTableColOpts = JSONTableColOpts{Path: string(yyDollar[2].bytes), ValOnEmpty: yyDollar[4].expr, ValOnError: yyDollar[3].expr}
+		}
+	case 1335:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6728
+		{
+			yyVAL.JSONTableColOpts = JSONTableColOpts{Path: string(yyDollar[2].bytes), ErrorOnEmpty: true}
+		}
+	case 1336:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6732
+		{
+			yyVAL.JSONTableColOpts = JSONTableColOpts{Path: string(yyDollar[2].bytes), ErrorOnError: true}
+		}
+	case 1337:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:6736
+		{
+			yyVAL.JSONTableColOpts = JSONTableColOpts{Path: string(yyDollar[2].bytes), ErrorOnEmpty: true, ErrorOnError: true}
+		}
+	case 1338:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:6740
+		{
+			yyVAL.JSONTableColOpts = JSONTableColOpts{Path: string(yyDollar[2].bytes), ErrorOnEmpty: true, ErrorOnError: true}
+		}
+	case 1339:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6744
+		{
+			yyVAL.JSONTableColOpts = JSONTableColOpts{Path: string(yyDollar[3].bytes), Exists: true}
+		}
+	case 1340:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6750
+		{
+			yyVAL.expr = &NullVal{}
+		}
+	case 1341:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6754
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 1342:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6760
+		{
+			yyVAL.expr = &NullVal{}
+		}
+	case 1343:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6764
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 1344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6770
+		{
+			yyVAL.triggerName = TriggerName{Name: yyDollar[1].colIdent}
+		}
+	case 1345:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6774
+		{
+			yyVAL.triggerName = TriggerName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent}
+		}
+	case 1346:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6780
+		{
+			yyVAL.tableName = yyDollar[3].tableName
+		}
+	case 1347:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6786
+		{
+			yyVAL.tableName = yyDollar[2].tableName
+		}
+	case 1348:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6790
+		{
+			yyVAL.tableName = yyDollar[1].tableName
+		}
+	case 1349:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6796
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 1350:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6800
+		{
+			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
+		}
+	case 1351:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6804
+		{
+			yyVAL.tableName = TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}
+		}
+	case 1352:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6808
+		{
+			yyVAL.tableName = TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}
+		}
+	case 1353:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6812
+		{
+			yyVAL.tableName = TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}
+		}
+	case 1354:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6816
+		{
+			yyVAL.tableName = TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}
+		}
+	case 1355:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6822
+		{
+			yyVAL.procedureName = ProcedureName{Name: yyDollar[1].colIdent}
+		}
+	case 1356:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6826
+		{
+			yyVAL.procedureName = ProcedureName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent}
+		}
+	case 1357:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6832
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 1358:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6837
+		{
+			yyVAL.indexHints = nil
+		}
+	case 1359:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6841
+		{
+			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
+		}
+	case 1360:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6845
+		{
+			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
+		}
+	case 1361:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6849
+		{
+			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
+		}
+	case 1362:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6854
+		{
+			yyVAL.expr = nil
+		}
+	case 1363:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6858
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 1364:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6864
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 1365:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6868
+		{
+			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 1366:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6872
+		{
+			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 1367:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6876
+		{
+			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 1368:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6880
+		{
+			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
+		}
+	case 1369:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6884
+		{
+			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
+		}
+	case 1370:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6888
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 1371:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6892
+		{
+			yyVAL.expr = &Default{ColName: yyDollar[2].str}
+		}
+	case 1372:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6898
+		{
+			yyVAL.str = ""
+		}
+	case 1373:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6902
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 1374:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6908
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 1375:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6912
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 1376:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6918
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
+		}
+	case 1377:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6922
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
+		}
+	case 1378:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6926
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
+		}
+	case 1379:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6930
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+		}
+	case 1380:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6934
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
+		}
+	case 1381:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:6938
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
+		}
+	case 1382:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:6942
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
+		}
+	case 1383:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:6946
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
+		}
+	case 1384:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:6950
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
+		}
+	case 1385:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6954
+		{
+			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
+		}
+	case 1386:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6960
+		{
+			yyVAL.str = IsNullStr
+		}
+	case 1387:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6964
+		{
+			yyVAL.str = IsNotNullStr
+		}
+	case 1388:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6968
+		{
+			yyVAL.str = IsTrueStr
+		}
+	case 1389:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6972
+		{
+			yyVAL.str = IsNotTrueStr
+		}
+	case 1390:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6976
+		{
+			yyVAL.str = IsFalseStr
+		}
+	case 1391:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:6980
+		{
+			yyVAL.str = IsNotFalseStr
+		}
+	case 1392:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6986
+		{
+			yyVAL.str = EqualStr
+		}
+	case 1393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6990
+		{
+			yyVAL.str = LessThanStr
+		}
+	case 1394:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6994
+		{
+			yyVAL.str = GreaterThanStr
+		}
+	case 1395:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6998
+		{
+			yyVAL.str = LessEqualStr
+		}
+	case 1396:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7002
+		{
+			yyVAL.str = GreaterEqualStr
+		}
+	case 1397:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7006
+		{
+			yyVAL.str = NotEqualStr
+		}
+	case 1398:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7010
+		{
+			yyVAL.str = NullSafeEqualStr
+		}
+	case 1399:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7015
+		{
+			yyVAL.expr = nil
+		}
+	case 1400:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7019
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 1401:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7025
+		{
+			yyVAL.colTuple = yyDollar[1].valTuple
+		}
+	case 1402:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7029
+		{
+			yyVAL.colTuple = yyDollar[1].subquery
+		}
+	case 1403:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7033
+		{
+			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
+		}
+	case 1404:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7039
+		{
+			yyVAL.subquery = &Subquery{Select: yyDollar[2].selStmt}
+		}
+	case 1405:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7045
+		{
+			// a parenthesized VALUES statement without trailing clauses is a table value constructor
+			if v, ok := yyDollar[1].subquery.Select.(*ValuesStatement); ok && v.OrderBy == nil && v.Limit == nil && v.With == nil {
+				yyVAL.simpleTableExpr = v
+			} else {
+				yyVAL.simpleTableExpr = yyDollar[1].subquery
+			}
+		}
+	case 1406:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7055
+		{
+			yyVAL.selectExprs = nil
+		}
+	case 1408:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7062
+		{
+			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
+		}
+	case 1409:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7066
+		{
+			yyVAL.selectExprs = append(yyDollar[1].selectExprs, yyDollar[3].selectExpr)
+		}
+	case 1410:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7072
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 1411:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7076
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 1412:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7082
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 1413:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7086
+		{
+			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1414:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7090
+		{
+			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1415:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7094
+		{
+			yyVAL.expr = yyDollar[1].boolVal
+		}
+	case 1416:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7098
+		{
+			yyVAL.expr = yyDollar[1].colName
+		}
+	case 1417:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7102
+		{
+			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1418:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7106
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 1419:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7110
+		{
+			yyVAL.expr = yyDollar[1].subquery
+		}
+	case 1420:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7114
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
+		}
+	case 1421:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7118
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
+		}
+	case 1422:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7122
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
+		}
+	case 1423:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7126
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
+		}
+	case 1424:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7130
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
+		}
+	case 1425:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7134
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
+		}
+	case 1426:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7138
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
+		}
+	case 1427:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7142
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
+		}
+	case 1428:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7146
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 1429:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7150
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 1430:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7154
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
+		}
+	case 1431:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7158
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
+		}
+	case 1432:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7162
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+		}
+	case 1433:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7166
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+		}
+	case 1434:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7170
+		{
+			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
+		}
+	case 1435:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7174
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 1436:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7178
+		{
+			yyVAL.expr = &UnaryExpr{Operator: yyDollar[1].str, Expr: yyDollar[2].expr}
+		}
+	case 1437:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7182
+		{
+			// national character set strings use utf8mb3, which is MySQL's national character set
+			yyVAL.expr = &UnaryExpr{Operator: Utf8mb3Str, Expr: NewStrVal(yyDollar[1].bytes)}
+		}
+	case 1438:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7187
+		{
+			// the collation applies to the string, as it does for a string with a character set introducer
+			yyVAL.expr = &UnaryExpr{Operator: Utf8mb3Str, Expr: &CollateExpr{Expr: NewStrVal(yyDollar[1].bytes), Charset: yyDollar[3].str}}
+		}
+	case 1439:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7192
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				yyVAL.expr = num
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 1440:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7200
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				// Handle double negative
+				if num.Val[0] == '-' {
+					num.Val = num.Val[1:]
+					yyVAL.expr = num
+				} else {
+					yyVAL.expr = NewIntVal(append([]byte("-"), num.Val...))
+				}
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 1441:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7214
+		{
+			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
+		}
+	case 1442:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7218
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
+		}
+	case 1443:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7222
+		{
+			// This rule prevents the usage of INTERVAL
+			// as a function. If support is needed for that,
+			// we'll need to revisit this. The solution
+			// will be non-trivial because of grammar conflicts.
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 1450:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7242
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: yyDollar[3].str == DistinctStr, Exprs: yyDollar[4].selectExprs}
+		}
+	case 1451:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7246
+		{
+			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+		}
+	case 1452:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7256
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 1453:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7260
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 1454:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7264
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1455:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7268
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1456:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7272
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1457:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7276
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 1458:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7280
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1459:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7284
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1460:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7288
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 1461:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7292
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1462:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7296
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1463:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7300
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1464:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7304
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1465:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7308
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 1466:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7312
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1467:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7316
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1468:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7320
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 1469:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7325
+		{
+			yyVAL.boolean = false
+		}
+	case 1470:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7329
+		{
+			yyVAL.boolean = false
+		}
+	case 1471:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7333
+		{
+			yyVAL.boolean = true
+		}
+	case 1472:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7338
+		{
+			yyVAL.boolean = false
+		}
+	case 1473:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7342
+		{
+			yyVAL.boolean = false
+		}
+	case 1474:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7346
+		{
+			yyVAL.boolean = true
+		}
+	case 1475:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7355
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 1476:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7359
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 1477:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7363
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].selectExpr}, Over: yyDollar[5].over}
+		}
+	case 1478:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7367
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, IgnoreNulls: yyDollar[5].boolean, Over: yyDollar[6].over}
+		}
+	case 1479:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7371
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].selectExpr}, Over: yyDollar[5].over}
+		}
+	case 1480:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7375
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, IgnoreNulls: yyDollar[5].boolean, Over: yyDollar[6].over}
+		}
+	case 1481:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:7379
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, FromLast: yyDollar[5].boolean, IgnoreNulls: yyDollar[6].boolean, Over: yyDollar[7].over}
+		}
+	case 1482:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7383
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 1483:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7387
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 1484:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7391
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 1485:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7395
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 1486:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7406
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1487:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7410
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1488:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7414
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1489:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7418
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1490:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7422
+		{
+			if len(yyDollar[3].selectExprs) != 2 {
+				yylex.Error("JSON_VALUE with a RETURNING, ON EMPTY or ON ERROR clause takes a document and a path")
+				return 1
+			}
+			doc, ok := yyDollar[3].selectExprs[0].(*AliasedExpr)
+			path, ok2 := yyDollar[3].selectExprs[1].(*AliasedExpr)
+			if !ok || !ok2 {
+				yylex.Error("JSON_VALUE with a RETURNING, ON EMPTY or ON ERROR clause takes a document and a path")
+				return 1
+			}
+			yyDollar[4].jsonValueExpr.JSON = doc.Expr
+			yyDollar[4].jsonValueExpr.Path = path.Expr
+			yyVAL.expr = yyDollar[4].jsonValueExpr
+		}
+	case 1491:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7438
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1492:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7442
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1493:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7446
+		{
+			yyVAL.expr = &ConvertExpr{Name: string(yyDollar[1].bytes), Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 1494:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7450
+		{
+			yyVAL.expr = &ConvertExpr{Name: string(yyDollar[1].bytes), Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 1495:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7454
+		{
+			yyVAL.expr = &CharExpr{Exprs: yyDollar[3].selectExprs}
+		}
+	case 1496:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7458
+		{
+			yyVAL.expr = &CharExpr{Exprs: yyDollar[3].selectExprs, Type: yyDollar[5].str}
+		}
+	case 1497:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7462
+		{
+			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
+		}
+	case 1498:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7466
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("LOCATE"), Exprs: []SelectExpr{&AliasedExpr{Expr: yyDollar[3].expr}, &AliasedExpr{Expr: yyDollar[5].expr}}}
+		}
+	case 1499:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7470
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1500:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:7474
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 1501:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:7478
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 1502:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:7482
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 1503:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:7486
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 1504:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7490
+		{
+			yyVAL.expr = &TrimExpr{Pattern: NewStrVal([]byte(" ")), Str: yyDollar[3].expr, Dir: Both}
+		}
+	case 1505:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7494
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[3].expr, Str: yyDollar[5].expr, Dir: Both}
+		}
+	case 1506:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:7498
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[4].expr, Str: yyDollar[6].expr, Dir: Leading}
+		}
+	case 1507:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:7502
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[4].expr, Str: yyDollar[6].expr, Dir: Trailing}
+		}
+	case 1508:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:7506
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[4].expr, Str: yyDollar[6].expr, Dir: Both}
+		}
+	case 1509:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:7510
+		{
+			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
+		}
+	case 1510:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7514
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1511:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:7518
+		{
+			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].separator}
+		}
+	case 1512:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:7522
+		{
+			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
+		}
+	case 1513:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7526
+		{
+			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+		}
+	case 1514:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7530
+		{
+			yyVAL.expr = &ValuesFuncExpr{Name: NewColName(string(yyDollar[3].bytes))}
+		}
+	case 1515:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7535
+		{
+			yyVAL.expr = &ValuesFuncExpr{Name: NewColName(string(yyDollar[3].bytes))}
+		}
+	case 1516:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7539
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1517:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7550
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1518:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7554
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1519:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7558
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1520:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7563
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 1521:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7567
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[2].expr}}}
+		}
+	case 1522:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7571
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[2].expr}}}
+		}
+	case 1523:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7575
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[2].expr}}}
+		}
+	case 1524:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:7579
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: string(yyDollar[3].bytes), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 1525:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:7583
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: string(yyDollar[3].bytes), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 1526:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7587
+		{
+			yyVAL.expr = &ExtractFuncExpr{Name: string(yyDollar[1].bytes), Unit: string(yyDollar[3].bytes), Expr: yyDollar[5].expr}
+		}
+	case 1527:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7595
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1528:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7599
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: NewIntVal(yyDollar[3].bytes)}}}
+		}
+	case 1529:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7603
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[2].expr}}}
+		}
+	case 1530:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7607
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[2].expr}}}
+		}
+	case 1531:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7611
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[2].expr}}}
+		}
+	case 1534:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7624
+		{
+			yyVAL.expr = NewIntVal([]byte("0"))
+		}
+	case 1535:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7628
+		{
+			yyVAL.expr = NewIntVal(yyDollar[2].bytes)
+		}
+	case 1536:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7638
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1537:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7642
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1538:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7646
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1539:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7650
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1540:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7654
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1541:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7658
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 1542:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7664
+		{
+			yyVAL.str = ""
+		}
+	case 1543:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7668
+		{
+			yyVAL.str = BooleanModeStr
+		}
+	case 1544:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:7672
+		{
+			yyVAL.str = NaturalLanguageModeStr
+		}
+	case 1545:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:7676
+		{
+			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
+		}
+	case 1546:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7680
+		{
+			yyVAL.str = QueryExpansionStr
+		}
+	case 1547:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7686
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 1548:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7690
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 1549:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7694
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 1550:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7700
+		{
+			yyVAL.str = Armscii8Str
+		}
+	case 1551:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7704
+		{
+			yyVAL.str = AsciiStr
+		}
+	case 1552:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7708
+		{
+			yyVAL.str = Big5Str
+		}
+	case 1553:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7712
+		{
+			yyVAL.str = UBinaryStr
+		}
+	case 1554:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7716
+		{
+			yyVAL.str = Cp1250Str
+		}
+	case 1555:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7720
+		{
+			yyVAL.str = Cp1251Str
+		}
+	case 1556:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7724
+		{
+			yyVAL.str = Cp1256Str
+		}
+	case 1557:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7728
+		{
+			yyVAL.str = Cp1257Str
+		}
+	case 1558:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7732
+		{
+			yyVAL.str = Cp850Str
+		}
+	case 1559:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7736
+		{
+			yyVAL.str = Cp852Str
+		}
+	case 1560:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7740
+		{
+			yyVAL.str = Cp866Str
+		}
+	case 1561:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7744
+		{
+			yyVAL.str = Cp932Str
+		}
+	case 1562:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7748
+		{
+			yyVAL.str = Dec8Str
+		}
+	case 1563:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7752
+		{
+			yyVAL.str = EucjpmsStr
+		}
+	case 1564:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7756
+		{
+			yyVAL.str = EuckrStr
+		}
+	case 1565:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7760
+		{
+			yyVAL.str = Gb18030Str
+		}
+	case 1566:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7764
+		{
+			yyVAL.str = Gb2312Str
+		}
+	case 1567:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7768
+		{
+			yyVAL.str = GbkStr
+		}
+	case 1568:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7772
+		{
+			yyVAL.str = Geostd8Str
+		}
+	case 1569:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7776
+		{
+			yyVAL.str = GreekStr
+		}
+	case 1570:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7780
+		{
+			yyVAL.str = HebrewStr
+		}
+	case 1571:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7784
+		{
+			yyVAL.str = Hp8Str
+		}
+	case 1572:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7788
+		{
+			yyVAL.str = Keybcs2Str
+		}
+	case 1573:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7792
+		{
+			yyVAL.str = Koi8rStr
+		}
+	case 1574:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7796
+		{
+			yyVAL.str = Koi8uStr
+		}
+	case 1575:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7800
+		{
+			yyVAL.str = Latin1Str
+		}
+	case 1576:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7804
+		{
+			yyVAL.str = Latin2Str
+		}
+	case 1577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7808
+		{
+			yyVAL.str = Latin5Str
+		}
+	case 1578:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7812
+		{
+			yyVAL.str = Latin7Str
+		}
+	case 1579:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7816
+		{
+			yyVAL.str = MacceStr
+		}
+	case 1580:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7820
+		{
+			yyVAL.str = MacromanStr
+		}
+	case 1581:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7824
+		{
+			yyVAL.str = SjisStr
+		}
+	case 1582:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7828
+		{
+			yyVAL.str = Swe7Str
+		}
+	case 1583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7832
+		{
+			yyVAL.str = Tis620Str
+		}
+	case 1584:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7836
+		{
+			yyVAL.str = Ucs2Str
+		}
+	case 1585:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7840
+		{
+			yyVAL.str = UjisStr
+		}
+	case 1586:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7844
+		{
+			yyVAL.str = Utf16Str
+		}
+	case 1587:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7848
+		{
+			yyVAL.str = Utf16leStr
+		}
+	case 1588:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7852
+		{
+			yyVAL.str = Utf32Str
+		}
+	case 1589:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7856
+		{
+			yyVAL.str = Utf8mb3Str
+		}
+	case 1590:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7860
+		{
+			yyVAL.str = Utf8mb3Str
+		}
+	case 1591:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7864
+		{
+			yyVAL.str = Utf8mb4Str
+		}
+	case 1592:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7870
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 1593:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7874
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
+		}
+	case 1594:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7878
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
+		}
+	case 1595:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7882
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1596:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7886
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 1597:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7890
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 1598:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7896
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1599:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7900
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1600:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7904
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1601:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7908
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 1602:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7912
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1603:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7916
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1604:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7920
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 1605:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7924
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1606:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7928
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1607:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7932
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 1608:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7938
+		{
+			yyDollar[3].jsonValueExpr.Returning = yyDollar[2].convertType
+			yyVAL.jsonValueExpr = yyDollar[3].jsonValueExpr
+		}
+	case 1609:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7943
+		{
+			yyVAL.jsonValueExpr = yyDollar[1].jsonValueExpr
+		}
+	case 1610:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7948
+		{
+			yyVAL.jsonValueExpr = &JSONValueExpr{}
+		}
+	case 1611:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7952
+		{
+			yyVAL.jsonValueExpr = yyDollar[1].jsonValueExpr
+		}
+	case 1612:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7958
+		{
+			yyVAL.jsonValueExpr = &JSONValueExpr{OnEmpty: yyDollar[1].jsonValueHandler}
+		}
+	case 1613:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:7962
+		{
+			yyVAL.jsonValueExpr = &JSONValueExpr{OnError: yyDollar[1].jsonValueHandler}
+		}
+	case 1614:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:7966
+		{
+			yyVAL.jsonValueExpr = &JSONValueExpr{OnEmpty: yyDollar[1].jsonValueHandler, OnError: yyDollar[4].jsonValueHandler}
+		}
+	case 1615:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7972
+		{
+			yyVAL.jsonValueHandler = &JSONValueHandler{Action: JSONValueNullStr}
+		}
+	case 1616:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7976
+		{
+			yyVAL.jsonValueHandler = &JSONValueHandler{Action: JSONValueErrorStr}
+		}
+	case 1617:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7980
+		{
+			yyVAL.jsonValueHandler = &JSONValueHandler{Action: JSONValueDefaultStr, Default: yyDollar[2].expr}
+		}
+	case 1618:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7985
+		{
+			yyVAL.expr = nil
+		}
+	case 1619:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:7989
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 1620:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:7994
+		{
+			yyVAL.separator = Separator{SeparatorString: "", DefaultSeparator: true}
+		}
+	case 1621:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:7998
+		{
+			yyVAL.separator = Separator{SeparatorString: string(yyDollar[2].bytes), DefaultSeparator: false}
+		}
+	case 1622:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8004
+		{
+			yyVAL.whens = []*When{yyDollar[1].when}
+		}
+	case 1623:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8008
+		{
+			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
+		}
+	case 1624:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8014
+		{
+			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
+		}
+	case 1625:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8019
+		{
+			yyVAL.expr = nil
+		}
+	case 1626:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8023
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 1627:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8029
+		{
+			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
+		}
+	case 1628:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8033
+		{
+			yyVAL.colName = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1629:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8037
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
+		}
+	case 1630:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8041
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1631:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8045
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1632:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8049
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1633:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8053
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1634:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8057
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].colIdent}
+		}
+	case 1635:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8061
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].colIdent}
+		}
+	case 1636:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8065
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].colIdent}
+		}
+	case 1637:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8069
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 1638:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8073
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].colIdent}
+		}
+	case 1639:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8077
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: NewTableIdent(string(yyDollar[1].bytes))}, Name: yyDollar[3].colIdent}
+		}
+	case 1640:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8081
+		{
+			yyVAL.colName = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 1641:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8085
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
+		}
+	case 1642:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8091
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 1643:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8095
+		{
+			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
+		}
+	case 1644:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8099
+		{
+			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
+		}
+	case 1645:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8103
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1646:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8107
+		{
+			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
+		}
+	case 1647:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8111
+		{
+			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
+		}
+	case 1648:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8115
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 1649:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8119
+		{
+			yyVAL.expr = &NullVal{}
+		}
+	case 1650:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8125
+		{
+			yyVAL.expr = NewIntVal([]byte("1"))
+		}
+	case 1651:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8129
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1652:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8133
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 1653:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8138
+		{
+			yyVAL.exprs = nil
+		}
+	case 1654:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8142
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 1655:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8148
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 1656:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8152
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 1657:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8158
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 1658:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8163
+		{
+			yyVAL.expr = nil
+		}
+	case 1659:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8167
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 1660:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8173
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 1661:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8178
+		{
+			yyVAL.orderBy = nil
+		}
+	case 1662:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8182
+		{
+			yyVAL.orderBy = yyDollar[3].orderBy
+		}
+	case 1663:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8188
+		{
+			yyVAL.orderBy = OrderBy{yyDollar[1].order}
+		}
+	case 1664:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8192
+		{
+			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
+		}
+	case 1665:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8198
+		{
+			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
+		}
+	case 1666:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8203
+		{
+			yyVAL.str = AscScr
+		}
+	case 1667:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8207
+		{
+			yyVAL.str = AscScr
+		}
+	case 1668:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8211
+		{
+			yyVAL.str = DescScr
+		}
+	case 1669:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8216
+		{
+			yyVAL.limit = nil
+		}
+	case 1670:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8220
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
+		}
+	case 1671:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8224
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
+		}
+	case 1672:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8228
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
+		}
+	case 1674:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8238
+		{
+			yyDollar[3].limit.Rowcount = yyDollar[2].expr
+			yyVAL.limit = yyDollar[3].limit
+		}
+	case 1675:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8243
+		{
+			yyDollar[5].limit.Offset = yyDollar[2].expr
+			yyDollar[5].limit.Rowcount = yyDollar[4].expr
+			yyVAL.limit = yyDollar[5].limit
+		}
+	case 1676:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8249
+		{
+			yyDollar[3].limit.Offset = yyDollar[5].expr
+			yyDollar[3].limit.Rowcount = yyDollar[2].expr
+			yyVAL.limit = yyDollar[3].limit
+		}
+	case 1677:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8255
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[2].expr}
+		}
+	case 1678:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8259
+		{
+			yyDollar[4].limit.Offset = yyDollar[2].expr
+			yyVAL.limit = yyDollar[4].limit
+		}
+	case 1680:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8267
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[3].expr}
+		}
+	case 1681:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:8271
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[3].expr, WithTies: true}
+		}
+	case 1682:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:8275
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[3].expr, Percent: true}
+		}
+	case 1683:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:8279
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[3].expr, Percent: true, WithTies: true}
+		}
+	case 1684:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8285
+		{
+			yyVAL.limit = &Limit{Percent: true}
+		}
+	case 1685:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8289
+		{
+			yyVAL.limit = &Limit{WithTies: true}
+		}
+	case 1686:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8293
+		{
+			yyVAL.limit = &Limit{Percent: true, WithTies: true}
+		}
+	case 1687:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8298
+		{
+			yyVAL.expr = NewIntVal([]byte("1"))
+		}
+	case 1688:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8302
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1689:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8306
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 1690:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8312
+		{
+		}
+	case 1691:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8314
+		{
+		}
+	case 1692:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8318
+		{
+		}
+	case 1693:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8320
+		{
+		}
+	case 1694:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8324
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1695:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8328
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 1696:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8332
+		{
+			yyVAL.expr = yyDollar[1].colName
+		}
+	case 1697:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8337
+		{
+			yyVAL.str = ""
+		}
+	case 1698:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8341
+		{
+			yyVAL.str = ForUpdateStr
+		}
+	case 1699:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8345
+		{
+			yyVAL.str = ForUpdateSkipLockedStr
+		}
+	case 1700:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8349
+		{
+			yyVAL.str = ShareModeStr
+		}
+	case 1701:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8362
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
+		}
+	case 1702:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8366
+		{
+			yyVAL.ins = &Insert{Columns: []ColIdent{}, Rows: yyDollar[4].values}
+		}
+	case 1703:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8370
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
+		}
+	case 1704:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8374
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
+		}
+	case 1705:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8379
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
+		}
+	case 1706:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8383
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
+		}
+	case 1707:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:8387
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
+		}
+	case 1710:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8397
+		{
+			yyVAL.columns = nil
+		}
+	case 1711:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8401
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 1712:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8407
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 1713:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8411
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 1714:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8417
+		{
+			yyVAL.colIdent = yyDollar[3].colIdent
+		}
+	case 1715:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8421
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 1716:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8425
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1717:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8429
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1718:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8433
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1719:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8437
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1720:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8442
+		{
+			yyVAL.assignExprs = nil
+		}
+	case 1721:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:8446
+		{
+			yyVAL.assignExprs = yyDollar[5].assignExprs
+		}
+	case 1722:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8452
+		{
+			yyVAL.values = Values{yyDollar[1].valTuple}
+		}
+	case 1723:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8456
+		{
+			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
+		}
+	case 1724:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8462
+		{
+			yyVAL.valTuple = yyDollar[1].valTuple
+		}
+	case 1725:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8466
+		{
+			yyVAL.valTuple = ValTuple{}
+		}
+	case 1726:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8472
+		{
+			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
+		}
+	case 1727:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8478
+		{
+			if len(yyDollar[1].valTuple) == 1 {
+				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
+			} else {
+				yyVAL.expr = yyDollar[1].valTuple
+			}
+		}
+	case 1728:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8488
+		{
+			yyVAL.assignExprs = AssignmentExprs{yyDollar[1].assignExpr}
+		}
+	case 1729:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8492
+		{
+			yyVAL.assignExprs = append(yyDollar[1].assignExprs, yyDollar[3].assignExpr)
+		}
+	case 1730:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8498
+		{
+			yyVAL.assignExpr = &AssignmentExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
+		}
+	case 1731:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8501
+		{
+			yyVAL.assignExpr = &AssignmentExpr{Name: &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}, Expr: yyDollar[3].expr}
+		}
+	case 1732:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8505
+		{
+			yyVAL.assignExpr = &AssignmentExpr{Name: &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}, Expr: yyDollar[3].expr}
+		}
+	case 1733:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8509
+		{
+			yyVAL.assignExpr = &AssignmentExpr{Name: &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}, Expr: yyDollar[3].expr}
+		}
+	case 1734:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8515
+		{
+			yyVAL.setVarExprs = SetVarExprs{yyDollar[1].setVarExpr}
+		}
+	case 1735:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8519
+		{
+			yyVAL.setVarExprs = append(yyDollar[1].setVarExprs, yyDollar[3].setVarExpr)
+		}
+	case 1736:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8525
+		{
+			colName, scope, _, err := VarScopeForColName(yyDollar[1].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyDollar[1].setVarExpr.Name = colName
+			yyDollar[1].setVarExpr.Scope = scope
+			yyVAL.setVarExpr = yyDollar[1].setVarExpr
+		}
+	case 1737:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8536
+		{
+			_, scope, _, err := VarScopeForColName(yyDollar[2].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].setVarExpr.Name.Name.val))
+				return 1
+			}
+			yyDollar[2].setVarExpr.Scope = yyDollar[1].setScope
+			yyVAL.setVarExpr = yyDollar[2].setVarExpr
+		}
+	case 1738:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8549
+		{
+			_, scope, _, err := VarScopeForColName(yyDollar[2].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].setVarExpr.Name.Name.val))
+				return 1
+			}
+			yyDollar[2].setVarExpr.Scope = yyDollar[1].setScope
+			yyVAL.setVarExpr = yyDollar[2].setVarExpr
+		}
+	case 1739:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8562
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr, Scope: SetScope_Session}
+		}
+	case 1740:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8568
+		{
+			yyVAL.setScope = SetScope_Global
+		}
+	case 1741:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8572
+		{
+			yyVAL.setScope = SetScope_Session
+		}
+	case 1742:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8578
+		{
+			yyVAL.setScope = SetScope_Session
+		}
+	case 1743:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8582
+		{
+			yyVAL.setScope = SetScope_Persist
+		}
+	case 1744:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8586
+		{
+			yyVAL.setScope = SetScope_PersistOnly
+		}
+	case 1745:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8592
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 1746:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8596
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 1747:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8600
+		{
+			// NOTE: This is a fix to allow MySQL dumps to load cleanly when they contain the following:
+			//       SET @@GLOBAL.GTID_PURGED= /*!80000 '+'*/ 'beabe64c-9dc6-11ed-8021-a0f9021e8e70:1-126';
+			//       The full fix is for any adjacent single-quoted or double-quoted strings to be concatenated but
+			//       this fixes the most pressing case. For more details, see: https://github.com/dolthub/dolt/issues/5232
+			// In other places we can correctly concatenate adjacent string literals, but the special comments break it
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal([]byte(string(yyDollar[3].bytes) + string(yyDollar[4].bytes))), Scope: SetScope_None}
+		}
+	case 1748:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8609
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr, Scope: SetScope_None}
+		}
+	case 1750:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8616
+		{
+			yyVAL.bytes = []byte("charset")
+		}
+	case 1752:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8623
+		{
+			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
+		}
+	case 1753:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8627
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 1754:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8631
+		{
+			yyVAL.expr = &Default{}
+		}
+	case 1757:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8640
+		{
+			yyVAL.byt = 0
+		}
+	case 1758:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8642
+		{
+			yyVAL.byt = 1
+		}
+	case 1759:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8645
+		{
+			yyVAL.byt = 0
+		}
+	case 1760:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8647
+		{
+			yyVAL.byt = 1
+		}
+	case 1761:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8650
+		{
+			yyVAL.byt = 0
+		}
+	case 1762:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8652
+		{
+			yyVAL.byt = 1
+		}
+	case 1763:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8655
+		{
+			yyVAL.str = ""
+		}
+	case 1764:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8657
+		{
+			yyVAL.str = IgnoreStr
+		}
+	case 1765:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8660
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1766:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8662
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 1767:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8664
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 1768:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8668
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1769:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8670
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1770:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8673
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1771:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8675
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1772:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8677
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1773:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8681
+		{
+			yyVAL.str = UniqueStr
+		}
+	case 1774:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8683
+		{
+			yyVAL.str = FulltextStr
+		}
+	case 1775:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8685
+		{
+			yyVAL.str = SpatialStr
+		}
+	case 1776:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8688
+		{
+			yyVAL.str = ""
+		}
+	case 1777:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8690
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1778:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8693
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 1779:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8695
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 1780:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8699
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1781:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8703
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1782:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8709
+		{
+			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
+		}
+	case 1783:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8713
+		{
+			yyVAL.colIdents = append(yyVAL.colIdents, yyDollar[3].colIdent)
+		}
+	case 1785:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8720
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1786:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8726
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1787:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8730
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1789:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8737
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1790:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8741
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1791:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8745
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1792:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8750
+		{
+			yyVAL.str = string("")
+		}
+	case 1793:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8752
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 1794:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8755
+		{
+			yyVAL.str = string("")
+		}
+	case 1795:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8757
+		{
+			yyVAL.str = IgnoreStr
+		}
+	case 1796:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8759
+		{
+			yyVAL.str = ReplaceStr
+		}
+	case 1797:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8762
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 1798:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8764
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 1799:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8767
+		{
+			yyVAL.EnclosedBy = nil
+		}
+	case 1800:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8771
+		{
+			yyVAL.EnclosedBy = &EnclosedBy{Optionally: yyDollar[1].boolVal, Delim: NewStrVal(yyDollar[4].bytes)}
+		}
+	case 1801:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8776
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 1802:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8780
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 1803:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8785
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1804:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8789
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1805:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8794
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1806:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8798
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1807:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8806
+		{
+			yyVAL.Fields = nil
+		}
+	case 1808:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8810
+		{
+			yyVAL.Fields = &Fields{TerminatedBy: yyDollar[2].sqlVal, EnclosedBy: yyDollar[3].EnclosedBy, EscapedBy: yyDollar[4].sqlVal}
+		}
+	case 1809:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8815
+		{
+			yyVAL.Lines = nil
+		}
+	case 1810:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8819
+		{
+			yyVAL.Lines = &Lines{StartingBy: yyDollar[2].sqlVal, TerminatedBy: yyDollar[3].sqlVal}
+		}
+	case 1811:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:8824
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1812:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8828
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1813:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8834
+		{
+			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
+		}
+	case 1814:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8840
+		{
+			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
+		}
+	case 1815:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8844
+		{
+			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
+		}
+	case 1816:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8850
+		{
+			yyVAL.tableAndLockType = &TableAndLockType{Table: &AliasedTableExpr{Expr: yyDollar[1].tableName}, Lock: yyDollar[2].lockType}
+		}
+	case 1817:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:8854
+		{
+			yyVAL.tableAndLockType = &TableAndLockType{Table: &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[3].tableIdent}, Lock: yyDollar[4].lockType}
+		}
+	case 1818:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8860
+		{
+			yyVAL.lockType = LockRead
+		}
+	case 1819:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8864
+		{
+			yyVAL.lockType = LockReadLocal
+		}
+	case 1820:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:8868
+		{
+			yyVAL.lockType = LockWrite
+		}
+	case 1821:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8872
+		{
+			yyVAL.lockType = LockLowPriorityWrite
+		}
+	case 1822:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8878
+		{
+			yyVAL.statement = &UnlockTables{}
+		}
+	case 1823:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:8884
+		{
+			yyVAL.statement = &Kill{Connection: true, ConnID: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 1824:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8888
+		{
+			yyVAL.statement = &Kill{ConnID: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 1825:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:8892
+		{
+			yyVAL.statement = &Kill{Connection: true, ConnID: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 2678:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9789
+		{
+			if incNesting(yylex) {
+				yylex.Error("max nesting level reached")
+				return 1
+			}
+		}
+	case 2679:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:9798
+		{
+			decNesting(yylex)
+		}
+	}
+	goto yystack /* stack new state and value */
+}